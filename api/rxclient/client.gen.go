@@ -0,0 +1,1353 @@
+// Code generated by genclient from api/openapi.yaml. DO NOT EDIT.
+
+// Package rxclient is a typed client for the RxIntake REST API (1.0.0).
+//
+// Requests follow the defaults of the platform HTTP client: a 30s timeout, pooled connections and,
+// when the token source can renew its token, one retry after a 401 response. Idempotent requests
+// may additionally be retried on transient failures by setting Config.MaxRetries.
+package rxclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LoginRequest is the LoginRequest schema of the API
+type LoginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// RefreshRequest is the RefreshRequest schema of the API
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// TokenResponse is the TokenResponse schema of the API
+type TokenResponse struct {
+	AccessToken string `json:"access_token,omitempty"`
+	TokenType   string `json:"token_type,omitempty"`
+	// Seconds until the access token expires
+	ExpiresIn int `json:"expires_in,omitempty"`
+	// Absent when auth.login.refresh_enabled is off
+	RefreshToken     string `json:"refresh_token,omitempty"`
+	RefreshExpiresIn int    `json:"refresh_expires_in,omitempty"`
+	User             *User  `json:"user,omitempty"`
+}
+
+// User is the User schema of the API
+type User struct {
+	ID          string   `json:"id,omitempty"`
+	Email       string   `json:"email,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+	OrgID       string   `json:"orgId,omitempty"`
+}
+
+// Permission is the Permission schema of the API
+type Permission struct {
+	Name        string `json:"name,omitempty"`
+	Domain      string `json:"domain,omitempty"`
+	Description string `json:"description,omitempty"`
+	// A coarse role permission such as doctor:role
+	Role       bool                    `json:"role,omitempty"`
+	RequiredBy []PermissionRequirement `json:"required_by,omitempty"`
+}
+
+// PermissionRequirement is the PermissionRequirement schema of the API
+type PermissionRequirement struct {
+	// route or graphql
+	Kind string `json:"kind,omitempty"`
+	// HTTP method of a route
+	Method string `json:"method,omitempty"`
+	// Route pattern, or Type.field for GraphQL
+	Path string `json:"path,omitempty"`
+	// any or all of the permissions
+	Match       string   `json:"match,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// PermissionCatalogue is the PermissionCatalogue schema of the API
+type PermissionCatalogue struct {
+	Permissions []Permission `json:"permissions,omitempty"`
+}
+
+// CurrentUser is the CurrentUser schema of the API
+type CurrentUser struct {
+	ID        string   `json:"id,omitempty"`
+	Name      string   `json:"name,omitempty"`
+	Email     string   `json:"email,omitempty"`
+	OrgID     string   `json:"org_id,omitempty"`
+	FuncRoles []string `json:"func_roles,omitempty"`
+	// Catalogued permissions of the caller's token, sorted
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// Job is the Job schema of the API
+type Job struct {
+	ID          string `json:"id,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Priority    int    `json:"priority,omitempty"`
+	Status      string `json:"status,omitempty"`
+	Attempts    int    `json:"attempts,omitempty"`
+	MaxAttempts int    `json:"max_attempts,omitempty"`
+	// When a queued job is next attempted
+	RunAt     time.Time `json:"run_at,omitempty"`
+	LastError string    `json:"last_error,omitempty"`
+	CreatedBy string    `json:"created_by,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Start of the latest attempt
+	StartedAt  time.Time `json:"started_at,omitempty"`
+	FinishedAt time.Time `json:"finished_at,omitempty"`
+}
+
+// Patient is the Patient schema of the API
+type Patient struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	// Full or partial date (YYYY, YYYY-MM or YYYY-MM-DD)
+	Dob string `json:"dob,omitempty"`
+	// Completed years; the youngest possible age for a partial DOB. Absent without a DOB
+	Age int `json:"age,omitempty"`
+	// The age, or its possible range such as 44-45 for a partial DOB
+	AgeDisplay string `json:"age_display,omitempty"`
+	Phone      string `json:"phone,omitempty"`
+	// Phone with all but the last four digits masked, e.g. ***-***-1234
+	PhoneMasked        string              `json:"phone_masked,omitempty"`
+	State              string              `json:"state,omitempty"`
+	CreatedAt          time.Time           `json:"created_at,omitempty"`
+	EditBy             string              `json:"edit_by,omitempty"`
+	EditTime           time.Time           `json:"edit_time,omitempty"`
+	ContactPreferences *ContactPreferences `json:"contact_preferences,omitempty"`
+	OrgID              string              `json:"org_id,omitempty"`
+	// Prescriptions of the patient by status, set by the patient list
+	PrescriptionCounts map[string]any `json:"prescription_counts,omitempty"`
+	// Existing patients a patient just created likely duplicates
+	PotentialDuplicates []PotentialDuplicate `json:"potential_duplicates,omitempty"`
+}
+
+// PatientCreateRequest is the PatientCreateRequest schema of the API
+type PatientCreateRequest struct {
+	Name string `json:"name"`
+	// Full or partial date (YYYY, YYYY-MM or YYYY-MM-DD)
+	Dob   string `json:"dob"`
+	Phone string `json:"phone"`
+	State string `json:"state"`
+}
+
+// PotentialDuplicate is the PotentialDuplicate schema of the API
+type PotentialDuplicate struct {
+	Patient *Patient `json:"patient,omitempty"`
+	// phone: the same phone number, ignoring formatting; name_dob: the same DOB and a similar name
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+// ContactPreferences is the ContactPreferences schema of the API
+//
+// Channels the patient agreed to be notified on, in order of preference; absent until the patient agrees
+type ContactPreferences struct {
+	Channels     []string `json:"channels,omitempty"`
+	Email        string   `json:"email,omitempty"`
+	DoNotContact bool     `json:"do_not_contact,omitempty"`
+}
+
+// PatientSearchResult is the PatientSearchResult schema of the API
+type PatientSearchResult struct {
+	Patient   *Patient             `json:"patient,omitempty"`
+	Score     float64              `json:"score,omitempty"`
+	MatchType string               `json:"match_type,omitempty"`
+	Matches   []PatientSearchMatch `json:"matches,omitempty"`
+}
+
+// PatientSearchMatch is the PatientSearchMatch schema of the API
+type PatientSearchMatch struct {
+	Field string `json:"field,omitempty"`
+	Value string `json:"value,omitempty"`
+	// HTML-escaped value with matched terms in <mark>
+	Highlighted string `json:"highlighted,omitempty"`
+}
+
+// Address is the Address schema of the API
+type Address struct {
+	ID        string `json:"id,omitempty"`
+	PatientID string `json:"patient_id,omitempty"`
+	Line1     string `json:"line1,omitempty"`
+	Line2     string `json:"line2,omitempty"`
+	City      string `json:"city,omitempty"`
+	State     string `json:"state,omitempty"`
+	Zip       string `json:"zip,omitempty"`
+	OrgID     string `json:"org_id,omitempty"`
+}
+
+// AddressCreateRequest is the AddressCreateRequest schema of the API
+type AddressCreateRequest struct {
+	Line1 string `json:"line1"`
+	Line2 string `json:"line2,omitempty"`
+	City  string `json:"city"`
+	State string `json:"state"`
+	Zip   string `json:"zip"`
+}
+
+// Prescription is the Prescription schema of the API
+type Prescription struct {
+	ID        string `json:"id,omitempty"`
+	PatientID string `json:"patient_id,omitempty"`
+	// Canonical catalog name when the drug is in the catalog
+	Drug        string `json:"drug,omitempty"`
+	DrugID      string `json:"drug_id,omitempty"`
+	DrugEntered string `json:"drug_entered,omitempty"`
+	// The dosage as text, e.g. "500 mg oral BID"; legacy prescriptions may hold free text without a dosage
+	Dose         string    `json:"dose,omitempty"`
+	Dosage       *Dose     `json:"dosage,omitempty"`
+	Status       string    `json:"status,omitempty"`
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	PrescribedBy string    `json:"prescribed_by,omitempty"`
+	// Clinician the prescription is written under; empty on older prescriptions
+	PrescriberID string `json:"prescriber_id,omitempty"`
+	Sig          *Sig   `json:"sig,omitempty"`
+	// The sig as label text in English
+	Directions string `json:"directions,omitempty"`
+	// The sig as label text in Spanish
+	DirectionsEs string `json:"directions_es,omitempty"`
+	Quantity     int    `json:"quantity,omitempty"`
+	DaysSupply   int    `json:"days_supply,omitempty"`
+	// When the days supply runs out, counted from creation
+	ExpectedEndDate      time.Time                `json:"expected_end_date,omitempty"`
+	InteractionWarnings  []DrugInteractionWarning `json:"interaction_warnings,omitempty"`
+	FulfillmentStatus    string                   `json:"fulfillment_status,omitempty"`
+	FulfillmentUpdatedAt time.Time                `json:"fulfillment_updated_at,omitempty"`
+	Pharmacy             *Pharmacy                `json:"pharmacy,omitempty"`
+}
+
+// PrescriptionCreateRequest is the PrescriptionCreateRequest schema of the API
+type PrescriptionCreateRequest struct {
+	PatientID string `json:"patient_id"`
+	// An existing prescriber
+	PrescriberID string `json:"prescriber_id"`
+	Drug         string `json:"drug"`
+	DrugID       string `json:"drug_id,omitempty"`
+	// Dose text parsed into a dosage, e.g. "500mg po bid"; required without dosage and not allowed with it
+	Dose   string `json:"dose,omitempty"`
+	Dosage *Dose  `json:"dosage,omitempty"`
+	Status string `json:"status,omitempty"`
+	Sig    *Sig   `json:"sig,omitempty"`
+	// Free-text directions parsed into a sig, e.g. "1 tab po bid prn pain"; not allowed with sig
+	SigText  string `json:"sig_text,omitempty"`
+	Quantity int    `json:"quantity,omitempty"`
+	// Derived from quantity when omitted and the sig has a frequency
+	DaysSupply int `json:"days_supply,omitempty"`
+}
+
+// PrescriptionUpdateRequest is the PrescriptionUpdateRequest schema of the API
+//
+// Only the fields that are set are changed
+type PrescriptionUpdateRequest struct {
+	Drug   *string `json:"drug,omitempty"`
+	DrugID *string `json:"drug_id,omitempty"`
+	// Not allowed with dosage
+	Dose       *string `json:"dose,omitempty"`
+	Dosage     *Dose   `json:"dosage,omitempty"`
+	Status     *string `json:"status,omitempty"`
+	Sig        *Sig    `json:"sig,omitempty"`
+	SigText    *string `json:"sig_text,omitempty"`
+	Quantity   *int    `json:"quantity,omitempty"`
+	DaysSupply *int    `json:"days_supply,omitempty"`
+}
+
+// Prescriber is the Prescriber schema of the API
+type Prescriber struct {
+	ID string `json:"id,omitempty"`
+	// National Provider Identifier
+	Npi       string `json:"npi,omitempty"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	// Degree shown after the name, e.g. MD
+	Credential string `json:"credential,omitempty"`
+	// Name as printed on a prescription, e.g. Dana Whitfield, MD
+	DisplayName string    `json:"display_name,omitempty"`
+	Specialty   string    `json:"specialty,omitempty"`
+	Phone       string    `json:"phone,omitempty"`
+	Fax         string    `json:"fax,omitempty"`
+	Email       string    `json:"email,omitempty"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at,omitempty"`
+}
+
+// PrescriberCreateRequest is the PrescriberCreateRequest schema of the API
+type PrescriberCreateRequest struct {
+	// 10 digits whose last is a Luhn check digit
+	Npi        string `json:"npi"`
+	FirstName  string `json:"first_name"`
+	LastName   string `json:"last_name"`
+	Credential string `json:"credential,omitempty"`
+	Specialty  string `json:"specialty,omitempty"`
+	Phone      string `json:"phone,omitempty"`
+	Fax        string `json:"fax,omitempty"`
+	Email      string `json:"email,omitempty"`
+}
+
+// PrescriberUpdateRequest is the PrescriberUpdateRequest schema of the API
+//
+// Only the fields that are set are changed; an empty phone, fax or email clears it
+type PrescriberUpdateRequest struct {
+	Npi        *string `json:"npi,omitempty"`
+	FirstName  *string `json:"first_name,omitempty"`
+	LastName   *string `json:"last_name,omitempty"`
+	Credential *string `json:"credential,omitempty"`
+	Specialty  *string `json:"specialty,omitempty"`
+	Phone      *string `json:"phone,omitempty"`
+	Fax        *string `json:"fax,omitempty"`
+	Email      *string `json:"email,omitempty"`
+}
+
+// Sig is the Sig schema of the API
+//
+// Structured dosing instruction
+type Sig struct {
+	// Units per dose; 1 when omitted
+	DoseQuantity float64 `json:"dose_quantity,omitempty"`
+	DoseUnit     string  `json:"dose_unit,omitempty"`
+	Route        string  `json:"route,omitempty"`
+	Frequency    string  `json:"frequency,omitempty"`
+	Timing       string  `json:"timing,omitempty"`
+	// With as_needed the frequency is the most the patient may take
+	AsNeeded bool `json:"as_needed,omitempty"`
+	// What an as-needed dose is for
+	Indication string `json:"indication,omitempty"`
+}
+
+// Dose is the Dose schema of the API
+//
+// Structured dose; route and frequency must match those of the sig when both are set
+type Dose struct {
+	Value     float64 `json:"value"`
+	Unit      string  `json:"unit"`
+	Route     string  `json:"route,omitempty"`
+	Frequency string  `json:"frequency,omitempty"`
+}
+
+// RoutePrescriptionRequest is the RoutePrescriptionRequest schema of the API
+type RoutePrescriptionRequest struct {
+	PharmacyID string `json:"pharmacy_id"`
+}
+
+// BulkStatusRequest is the BulkStatusRequest schema of the API
+type BulkStatusRequest struct {
+	Ids    []string `json:"ids"`
+	Status string   `json:"status"`
+	Reason string   `json:"reason,omitempty"`
+}
+
+// BulkStatusResult is the BulkStatusResult schema of the API
+type BulkStatusResult struct {
+	PrescriptionID string `json:"prescription_id,omitempty"`
+	Outcome        string `json:"outcome,omitempty"`
+	PreviousStatus string `json:"previous_status,omitempty"`
+	Status         string `json:"status,omitempty"`
+	Message        string `json:"message,omitempty"`
+}
+
+// BulkStatusResponse is the BulkStatusResponse schema of the API
+type BulkStatusResponse struct {
+	Results []BulkStatusResult `json:"results,omitempty"`
+	Updated int                `json:"updated,omitempty"`
+	Failed  int                `json:"failed,omitempty"`
+}
+
+// PrescriptionDraftRequest is the PrescriptionDraftRequest schema of the API
+//
+// A prescription form as entered so far; nothing is required
+type PrescriptionDraftRequest struct {
+	// Makes the draft an edit of that prescription
+	PrescriptionID string `json:"prescription_id,omitempty"`
+	PatientID      string `json:"patient_id,omitempty"`
+	PrescriberID   string `json:"prescriber_id,omitempty"`
+	Drug           string `json:"drug,omitempty"`
+	DrugID         string `json:"drug_id,omitempty"`
+	Dose           string `json:"dose,omitempty"`
+	Dosage         *Dose  `json:"dosage,omitempty"`
+	Status         string `json:"status,omitempty"`
+	Sig            *Sig   `json:"sig,omitempty"`
+	SigText        string `json:"sig_text,omitempty"`
+	Quantity       int    `json:"quantity,omitempty"`
+	DaysSupply     int    `json:"days_supply,omitempty"`
+}
+
+// DraftForm is the DraftForm schema of the API
+type DraftForm struct {
+	PatientID    string `json:"patient_id,omitempty"`
+	PrescriberID string `json:"prescriber_id,omitempty"`
+	Drug         string `json:"drug,omitempty"`
+	DrugID       string `json:"drug_id,omitempty"`
+	Dose         string `json:"dose,omitempty"`
+	Dosage       *Dose  `json:"dosage,omitempty"`
+	Status       string `json:"status,omitempty"`
+	Sig          *Sig   `json:"sig,omitempty"`
+	SigText      string `json:"sig_text,omitempty"`
+	Quantity     int    `json:"quantity,omitempty"`
+	DaysSupply   int    `json:"days_supply,omitempty"`
+}
+
+// PrescriptionDraft is the PrescriptionDraft schema of the API
+type PrescriptionDraft struct {
+	ID string `json:"id,omitempty"`
+	// Set when the draft edits a prescription
+	PrescriptionID string     `json:"prescription_id,omitempty"`
+	Form           *DraftForm `json:"form,omitempty"`
+	CreatedAt      time.Time  `json:"created_at,omitempty"`
+	UpdatedAt      time.Time  `json:"updated_at,omitempty"`
+	ExpiresAt      time.Time  `json:"expires_at,omitempty"`
+}
+
+// DrugInteractionWarning is the DrugInteractionWarning schema of the API
+type DrugInteractionWarning struct {
+	Drug                      string `json:"drug,omitempty"`
+	InteractingDrug           string `json:"interacting_drug,omitempty"`
+	InteractingPrescriptionID string `json:"interacting_prescription_id,omitempty"`
+	Severity                  string `json:"severity,omitempty"`
+	Description               string `json:"description,omitempty"`
+}
+
+// DrugAllergyWarning is the DrugAllergyWarning schema of the API
+type DrugAllergyWarning struct {
+	Drug      string `json:"drug,omitempty"`
+	AllergyID string `json:"allergy_id,omitempty"`
+	Substance string `json:"substance,omitempty"`
+	Reaction  string `json:"reaction,omitempty"`
+	Severity  string `json:"severity,omitempty"`
+}
+
+// InteractionCheckResponse is the InteractionCheckResponse schema of the API
+type InteractionCheckResponse struct {
+	Blocked         bool                     `json:"blocked,omitempty"`
+	Warnings        []DrugInteractionWarning `json:"warnings,omitempty"`
+	AllergyWarnings []DrugAllergyWarning     `json:"allergy_warnings,omitempty"`
+}
+
+// Pharmacy is the Pharmacy schema of the API
+type Pharmacy struct {
+	ID       string    `json:"id,omitempty"`
+	Name     string    `json:"name,omitempty"`
+	Type     string    `json:"type,omitempty"`
+	Address  string    `json:"address,omitempty"`
+	City     string    `json:"city,omitempty"`
+	State    string    `json:"state,omitempty"`
+	Zip      string    `json:"zip,omitempty"`
+	Phone    string    `json:"phone,omitempty"`
+	RoutedAt time.Time `json:"routed_at,omitempty"`
+}
+
+// NetworkPharmacy is the NetworkPharmacy schema of the API
+type NetworkPharmacy struct {
+	ID                     string `json:"id,omitempty"`
+	Name                   string `json:"name,omitempty"`
+	Type                   string `json:"type,omitempty"`
+	Address                string `json:"address,omitempty"`
+	City                   string `json:"city,omitempty"`
+	State                  string `json:"state,omitempty"`
+	Zip                    string `json:"zip,omitempty"`
+	Phone                  string `json:"phone,omitempty"`
+	AcceptingPrescriptions bool   `json:"accepting_prescriptions,omitempty"`
+}
+
+// PharmacySearchResponse is the PharmacySearchResponse schema of the API
+type PharmacySearchResponse struct {
+	Pharmacies []NetworkPharmacy `json:"pharmacies,omitempty"`
+	Total      int               `json:"total,omitempty"`
+}
+
+// PrescriptionAdherence is the PrescriptionAdherence schema of the API
+type PrescriptionAdherence struct {
+	PrescriptionID            string     `json:"prescription_id,omitempty"`
+	Drug                      string     `json:"drug,omitempty"`
+	Status                    string     `json:"status,omitempty"`
+	Dispenses                 int        `json:"dispenses,omitempty"`
+	LastDispensedAt           *time.Time `json:"last_dispensed_at,omitempty"`
+	AverageDaysBetweenRefills *float64   `json:"average_days_between_refills,omitempty"`
+	// From the first dispense with a days supply until today, or until the last supply ran out for a completed prescription
+	ProportionOfDaysCovered *float64 `json:"proportion_of_days_covered,omitempty"`
+}
+
+// AdherenceSnapshot is the AdherenceSnapshot schema of the API
+type AdherenceSnapshot struct {
+	PatientID string `json:"patient_id,omitempty"`
+	// UTC day of the snapshot
+	Date                   string `json:"date,omitempty"`
+	ActivePrescriptions    int    `json:"active_prescriptions,omitempty"`
+	CompletedPrescriptions int    `json:"completed_prescriptions,omitempty"`
+	// Dispenses after the first one of each prescription; reversed ones are not counted
+	Refills int `json:"refills,omitempty"`
+	// Mean gap between consecutive dispenses; absent without a refill
+	AverageDaysBetweenRefills *float64 `json:"average_days_between_refills,omitempty"`
+	// Mean of the prescriptions' proportions of days covered
+	ProportionOfDaysCovered *float64  `json:"proportion_of_days_covered,omitempty"`
+	TakenAt                 time.Time `json:"taken_at,omitempty"`
+	OrgID                   *string   `json:"org_id,omitempty"`
+}
+
+// AdherenceReport is the AdherenceReport schema of the API
+type AdherenceReport struct {
+	PatientID              string `json:"patient_id,omitempty"`
+	ActivePrescriptions    int    `json:"active_prescriptions,omitempty"`
+	CompletedPrescriptions int    `json:"completed_prescriptions,omitempty"`
+	// Dispenses after the first one of each prescription; reversed ones are not counted
+	Refills int `json:"refills,omitempty"`
+	// Mean gap between consecutive dispenses; absent without a refill
+	AverageDaysBetweenRefills *float64 `json:"average_days_between_refills,omitempty"`
+	// Mean of the prescriptions' proportions of days covered
+	ProportionOfDaysCovered *float64                `json:"proportion_of_days_covered,omitempty"`
+	Prescriptions           []PrescriptionAdherence `json:"prescriptions,omitempty"`
+	// Nightly snapshots, newest first
+	History     []AdherenceSnapshot `json:"history,omitempty"`
+	GeneratedAt time.Time           `json:"generated_at,omitempty"`
+}
+
+// ListPatientsParams holds the query parameters of ListPatients; zero values are not sent
+type ListPatientsParams struct {
+	Limit       int
+	Offset      int
+	PatientName string
+	// Full or partial date (YYYY, YYYY-MM or YYYY-MM-DD)
+	BirthDate string
+	State     string
+	// Lowest age in completed years, derived from the DOB; a partial DOB matches when any age it allows is in range
+	MinAge *int
+	// Highest age in completed years, derived from the DOB
+	MaxAge *int
+	// Only patients with (true) or without (false) an active prescription
+	HasActivePrescriptions *bool
+}
+
+// SearchPatientsParams holds the query parameters of SearchPatients; zero values are not sent
+type SearchPatientsParams struct {
+	Q     string
+	Limit int
+}
+
+// ListPrescriptionsParams holds the query parameters of ListPrescriptions; zero values are not sent
+type ListPrescriptionsParams struct {
+	Limit  int
+	Offset int
+	Status string
+}
+
+// CheckInteractionsParams holds the query parameters of CheckInteractions; zero values are not sent
+type CheckInteractionsParams struct {
+	PatientID string
+	Drug      string
+}
+
+// SearchPharmaciesParams holds the query parameters of SearchPharmacies; zero values are not sent
+type SearchPharmaciesParams struct {
+	Zip   string
+	State string
+	Limit int
+}
+
+// ListPrescribersParams holds the query parameters of ListPrescribers; zero values are not sent
+type ListPrescribersParams struct {
+	Limit  int
+	Offset int
+	// Matches the start of a first or last name, or the NPI
+	Query string
+}
+
+// ListPrescriberPrescriptionsParams holds the query parameters of ListPrescriberPrescriptions; zero values are not sent
+type ListPrescriberPrescriptionsParams struct {
+	Limit int
+}
+
+// GetAdherenceReportParams holds the query parameters of GetAdherenceReport; zero values are not sent
+type GetAdherenceReportParams struct {
+	PatientID string
+}
+
+// Login calls POST /auth/login: Exchange a username and password for tokens
+func (c *Client) Login(ctx context.Context, body LoginRequest) (*TokenResponse, error) {
+	var result TokenResponse
+	if err := c.do(ctx, http.MethodPost, "/auth/login", nil, false, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RefreshToken calls POST /auth/refresh: Exchange a refresh token for new tokens; each refresh token works once
+func (c *Client) RefreshToken(ctx context.Context, body RefreshRequest) (*TokenResponse, error) {
+	var result TokenResponse
+	if err := c.do(ctx, http.MethodPost, "/auth/refresh", nil, false, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListPermissions calls GET /api/auth/permissions: List every permission the application checks, with its description and the routes and GraphQL fields that require it
+func (c *Client) ListPermissions(ctx context.Context) (*PermissionCatalogue, error) {
+	var result PermissionCatalogue
+	if err := c.do(ctx, http.MethodGet, "/api/auth/permissions", nil, true, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCurrentUser calls GET /api/auth/me: The caller and their effective permissions, for showing or hiding features
+func (c *Client) GetCurrentUser(ctx context.Context) (*CurrentUser, error) {
+	var result CurrentUser
+	if err := c.do(ctx, http.MethodGet, "/api/auth/me", nil, true, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetJob calls GET /api/jobs/{jobID}: Status of a background job enqueued by the caller; admins can read every job
+func (c *Client) GetJob(ctx context.Context, jobID string) (*Job, error) {
+	var result Job
+	if err := c.do(ctx, http.MethodGet, "/api/jobs/"+url.PathEscape(jobID), nil, true, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListPatients calls GET /api/v1/patients: List patients, restricted to the caller's data-access scope
+//
+// Requires any of patient:read, admin:all.
+func (c *Client) ListPatients(ctx context.Context, params ListPatientsParams) ([]Patient, error) {
+	query := url.Values{}
+	if params.Limit != 0 {
+		query.Set("limit", strconv.Itoa(params.Limit))
+	}
+	if params.Offset != 0 {
+		query.Set("offset", strconv.Itoa(params.Offset))
+	}
+	if params.PatientName != "" {
+		query.Set("patientName", params.PatientName)
+	}
+	if params.BirthDate != "" {
+		query.Set("birthDate", params.BirthDate)
+	}
+	if params.State != "" {
+		query.Set("state", params.State)
+	}
+	if params.MinAge != nil {
+		query.Set("minAge", strconv.Itoa(*params.MinAge))
+	}
+	if params.MaxAge != nil {
+		query.Set("maxAge", strconv.Itoa(*params.MaxAge))
+	}
+	if params.HasActivePrescriptions != nil {
+		query.Set("hasActivePrescriptions", strconv.FormatBool(*params.HasActivePrescriptions))
+	}
+	var result []Patient
+	if err := c.do(ctx, http.MethodGet, "/api/v1/patients", query, true, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListPatientsAll iterates over all results of ListPatients, starting at params.Offset and fetching
+// params.Limit items per request (100 when zero). Iteration stops at the first error.
+func (c *Client) ListPatientsAll(ctx context.Context, params ListPatientsParams) iter.Seq2[Patient, error] {
+	if params.Limit == 0 {
+		params.Limit = 100
+	}
+	return pages(params.Limit, params.Offset, func(limit, offset int) ([]Patient, error) {
+		params.Limit, params.Offset = limit, offset
+		return c.ListPatients(ctx, params)
+	})
+}
+
+// CreatePatient calls POST /api/v1/patients: Create a patient, checking for likely duplicates first (patient_duplicates.mode)
+//
+// Requires any of patient:write, admin:all.
+func (c *Client) CreatePatient(ctx context.Context, body PatientCreateRequest) (*Patient, error) {
+	var result Patient
+	if err := c.do(ctx, http.MethodPost, "/api/v1/patients", nil, true, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SearchPatients calls GET /api/v1/patients/search: Full-text and typo-tolerant search over name, phone, state and address
+//
+// Requires any of patient:read, admin:all.
+func (c *Client) SearchPatients(ctx context.Context, params SearchPatientsParams) ([]PatientSearchResult, error) {
+	query := url.Values{}
+	query.Set("q", params.Q)
+	if params.Limit != 0 {
+		query.Set("limit", strconv.Itoa(params.Limit))
+	}
+	var result []PatientSearchResult
+	if err := c.do(ctx, http.MethodGet, "/api/v1/patients/search", query, true, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetPatient calls GET /api/v1/patients/{patientID}
+//
+// Requires any of patient:read, admin:all.
+func (c *Client) GetPatient(ctx context.Context, patientID string) (*Patient, error) {
+	var result Patient
+	if err := c.do(ctx, http.MethodGet, "/api/v1/patients/"+url.PathEscape(patientID), nil, true, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListAddresses calls GET /api/v1/patients/{patientID}/addresses
+//
+// Requires any of patient:read, admin:all.
+func (c *Client) ListAddresses(ctx context.Context, patientID string) ([]Address, error) {
+	var result []Address
+	if err := c.do(ctx, http.MethodGet, "/api/v1/patients/"+url.PathEscape(patientID)+"/addresses", nil, true, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateAddress calls POST /api/v1/patients/{patientID}/addresses
+//
+// Requires any of patient:write, admin:all.
+func (c *Client) CreateAddress(ctx context.Context, patientID string, body AddressCreateRequest) (*Address, error) {
+	var result Address
+	if err := c.do(ctx, http.MethodPost, "/api/v1/patients/"+url.PathEscape(patientID)+"/addresses", nil, true, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetAddress calls GET /api/v1/patients/{patientID}/addresses/{addressID}
+//
+// Requires any of patient:read, admin:all.
+func (c *Client) GetAddress(ctx context.Context, patientID string, addressID string) (*Address, error) {
+	var result Address
+	if err := c.do(ctx, http.MethodGet, "/api/v1/patients/"+url.PathEscape(patientID)+"/addresses/"+url.PathEscape(addressID), nil, true, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListPrescriptions calls GET /api/v1/prescriptions
+//
+// Requires any of prescription:read, admin:all.
+func (c *Client) ListPrescriptions(ctx context.Context, params ListPrescriptionsParams) ([]Prescription, error) {
+	query := url.Values{}
+	if params.Limit != 0 {
+		query.Set("limit", strconv.Itoa(params.Limit))
+	}
+	if params.Offset != 0 {
+		query.Set("offset", strconv.Itoa(params.Offset))
+	}
+	if params.Status != "" {
+		query.Set("status", params.Status)
+	}
+	var result []Prescription
+	if err := c.do(ctx, http.MethodGet, "/api/v1/prescriptions", query, true, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListPrescriptionsAll iterates over all results of ListPrescriptions, starting at params.Offset and fetching
+// params.Limit items per request (100 when zero). Iteration stops at the first error.
+func (c *Client) ListPrescriptionsAll(ctx context.Context, params ListPrescriptionsParams) iter.Seq2[Prescription, error] {
+	if params.Limit == 0 {
+		params.Limit = 100
+	}
+	return pages(params.Limit, params.Offset, func(limit, offset int) ([]Prescription, error) {
+		params.Limit, params.Offset = limit, offset
+		return c.ListPrescriptions(ctx, params)
+	})
+}
+
+// CreatePrescription calls POST /api/v1/prescriptions
+//
+// Requires any of prescription:write, doctor:role, admin:all.
+func (c *Client) CreatePrescription(ctx context.Context, body PrescriptionCreateRequest) (*Prescription, error) {
+	var result Prescription
+	if err := c.do(ctx, http.MethodPost, "/api/v1/prescriptions", nil, true, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CheckInteractions calls GET /api/v1/prescriptions/interactions/check: Check a drug against the patient's active prescriptions and recorded allergies
+//
+// Requires any of prescription:read, admin:all.
+func (c *Client) CheckInteractions(ctx context.Context, params CheckInteractionsParams) (*InteractionCheckResponse, error) {
+	query := url.Values{}
+	query.Set("patientId", params.PatientID)
+	query.Set("drug", params.Drug)
+	var result InteractionCheckResponse
+	if err := c.do(ctx, http.MethodGet, "/api/v1/prescriptions/interactions/check", query, true, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SearchPharmacies calls GET /api/v1/prescriptions/pharmacies: Search the pharmacy network by zip or state
+//
+// Requires any of prescription:read, admin:all.
+func (c *Client) SearchPharmacies(ctx context.Context, params SearchPharmaciesParams) (*PharmacySearchResponse, error) {
+	query := url.Values{}
+	if params.Zip != "" {
+		query.Set("zip", params.Zip)
+	}
+	if params.State != "" {
+		query.Set("state", params.State)
+	}
+	if params.Limit != 0 {
+		query.Set("limit", strconv.Itoa(params.Limit))
+	}
+	var result PharmacySearchResponse
+	if err := c.do(ctx, http.MethodGet, "/api/v1/prescriptions/pharmacies", query, true, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetPrescription calls GET /api/v1/prescriptions/{prescriptionID}
+//
+// Requires any of prescription:read, admin:all.
+func (c *Client) GetPrescription(ctx context.Context, prescriptionID string) (*Prescription, error) {
+	var result Prescription
+	if err := c.do(ctx, http.MethodGet, "/api/v1/prescriptions/"+url.PathEscape(prescriptionID), nil, true, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdatePrescription calls PUT /api/v1/prescriptions/{prescriptionID}
+//
+// Requires any of prescription:write, doctor:role, admin:all.
+func (c *Client) UpdatePrescription(ctx context.Context, prescriptionID string, body PrescriptionUpdateRequest) (*Prescription, error) {
+	var result Prescription
+	if err := c.do(ctx, http.MethodPut, "/api/v1/prescriptions/"+url.PathEscape(prescriptionID), nil, true, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RoutePrescription calls POST /api/v1/prescriptions/{prescriptionID}/route: Send the prescription to a network pharmacy
+//
+// Requires any of prescription:write, doctor:role, admin:all.
+func (c *Client) RoutePrescription(ctx context.Context, prescriptionID string, body RoutePrescriptionRequest) (*Prescription, error) {
+	var result Prescription
+	if err := c.do(ctx, http.MethodPost, "/api/v1/prescriptions/"+url.PathEscape(prescriptionID)+"/route", nil, true, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// BulkUpdatePrescriptionStatus calls POST /api/v1/prescriptions/bulk-status: Change the status of many prescriptions
+//
+// Requires any of prescription:write, doctor:role, admin:all.
+func (c *Client) BulkUpdatePrescriptionStatus(ctx context.Context, body BulkStatusRequest) (*BulkStatusResponse, error) {
+	var result BulkStatusResponse
+	if err := c.do(ctx, http.MethodPost, "/api/v1/prescriptions/bulk-status", nil, true, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListPrescriptionDrafts calls GET /api/v1/prescriptions/drafts: List the current user's prescription drafts, most recently saved first
+//
+// Requires any of prescription:write, doctor:role, admin:all.
+func (c *Client) ListPrescriptionDrafts(ctx context.Context) ([]PrescriptionDraft, error) {
+	var result []PrescriptionDraft
+	if err := c.do(ctx, http.MethodGet, "/api/v1/prescriptions/drafts", nil, true, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetPrescriptionDraft calls GET /api/v1/prescriptions/drafts/{draftID}
+//
+// Requires any of prescription:write, doctor:role, admin:all.
+func (c *Client) GetPrescriptionDraft(ctx context.Context, draftID string) (*PrescriptionDraft, error) {
+	var result PrescriptionDraft
+	if err := c.do(ctx, http.MethodGet, "/api/v1/prescriptions/drafts/"+url.PathEscape(draftID), nil, true, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SavePrescriptionDraft calls PUT /api/v1/prescriptions/drafts/{draftID}: Autosave a prescription form
+//
+// Requires any of prescription:write, doctor:role, admin:all.
+func (c *Client) SavePrescriptionDraft(ctx context.Context, draftID string, body PrescriptionDraftRequest) (*PrescriptionDraft, error) {
+	var result PrescriptionDraft
+	if err := c.do(ctx, http.MethodPut, "/api/v1/prescriptions/drafts/"+url.PathEscape(draftID), nil, true, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeletePrescriptionDraft calls DELETE /api/v1/prescriptions/drafts/{draftID}
+//
+// Requires any of prescription:write, doctor:role, admin:all.
+func (c *Client) DeletePrescriptionDraft(ctx context.Context, draftID string) error {
+	return c.do(ctx, http.MethodDelete, "/api/v1/prescriptions/drafts/"+url.PathEscape(draftID), nil, true, nil, nil)
+}
+
+// PromotePrescriptionDraft calls POST /api/v1/prescriptions/drafts/{draftID}/promote: Save the prescription of a draft and remove the draft
+//
+// Requires any of prescription:write, doctor:role, admin:all.
+func (c *Client) PromotePrescriptionDraft(ctx context.Context, draftID string) (*Prescription, error) {
+	var result Prescription
+	if err := c.do(ctx, http.MethodPost, "/api/v1/prescriptions/drafts/"+url.PathEscape(draftID)+"/promote", nil, true, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListPrescribers calls GET /api/v1/prescribers
+//
+// Requires any of prescription:read, admin:all.
+func (c *Client) ListPrescribers(ctx context.Context, params ListPrescribersParams) ([]Prescriber, error) {
+	query := url.Values{}
+	if params.Limit != 0 {
+		query.Set("limit", strconv.Itoa(params.Limit))
+	}
+	if params.Offset != 0 {
+		query.Set("offset", strconv.Itoa(params.Offset))
+	}
+	if params.Query != "" {
+		query.Set("query", params.Query)
+	}
+	var result []Prescriber
+	if err := c.do(ctx, http.MethodGet, "/api/v1/prescribers", query, true, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListPrescribersAll iterates over all results of ListPrescribers, starting at params.Offset and fetching
+// params.Limit items per request (100 when zero). Iteration stops at the first error.
+func (c *Client) ListPrescribersAll(ctx context.Context, params ListPrescribersParams) iter.Seq2[Prescriber, error] {
+	if params.Limit == 0 {
+		params.Limit = 100
+	}
+	return pages(params.Limit, params.Offset, func(limit, offset int) ([]Prescriber, error) {
+		params.Limit, params.Offset = limit, offset
+		return c.ListPrescribers(ctx, params)
+	})
+}
+
+// CreatePrescriber calls POST /api/v1/prescribers
+//
+// Requires any of prescription:write, doctor:role, admin:all.
+func (c *Client) CreatePrescriber(ctx context.Context, body PrescriberCreateRequest) (*Prescriber, error) {
+	var result Prescriber
+	if err := c.do(ctx, http.MethodPost, "/api/v1/prescribers", nil, true, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetPrescriber calls GET /api/v1/prescribers/{prescriberID}
+//
+// Requires any of prescription:read, admin:all.
+func (c *Client) GetPrescriber(ctx context.Context, prescriberID string) (*Prescriber, error) {
+	var result Prescriber
+	if err := c.do(ctx, http.MethodGet, "/api/v1/prescribers/"+url.PathEscape(prescriberID), nil, true, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdatePrescriber calls PUT /api/v1/prescribers/{prescriberID}
+//
+// Requires any of prescription:write, doctor:role, admin:all.
+func (c *Client) UpdatePrescriber(ctx context.Context, prescriberID string, body PrescriberUpdateRequest) (*Prescriber, error) {
+	var result Prescriber
+	if err := c.do(ctx, http.MethodPut, "/api/v1/prescribers/"+url.PathEscape(prescriberID), nil, true, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeletePrescriber calls DELETE /api/v1/prescribers/{prescriberID}
+//
+// Requires any of prescription:write, doctor:role, admin:all.
+func (c *Client) DeletePrescriber(ctx context.Context, prescriberID string) error {
+	return c.do(ctx, http.MethodDelete, "/api/v1/prescribers/"+url.PathEscape(prescriberID), nil, true, nil, nil)
+}
+
+// ListPrescriberPrescriptions calls GET /api/v1/prescribers/{prescriberID}/prescriptions
+//
+// Requires any of prescription:read, admin:all.
+func (c *Client) ListPrescriberPrescriptions(ctx context.Context, prescriberID string, params ListPrescriberPrescriptionsParams) ([]Prescription, error) {
+	query := url.Values{}
+	if params.Limit != 0 {
+		query.Set("limit", strconv.Itoa(params.Limit))
+	}
+	var result []Prescription
+	if err := c.do(ctx, http.MethodGet, "/api/v1/prescribers/"+url.PathEscape(prescriberID)+"/prescriptions", query, true, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetAdherenceReport calls GET /api/v1/reports/adherence: A patient's adherence from their active and completed prescriptions and dispenses, with the nightly snapshots of the last month
+//
+// Requires any of adherence:read, admin:all.
+func (c *Client) GetAdherenceReport(ctx context.Context, params GetAdherenceReportParams) (*AdherenceReport, error) {
+	query := url.Values{}
+	query.Set("patientID", params.PatientID)
+	var result AdherenceReport
+	if err := c.do(ctx, http.MethodGet, "/api/v1/reports/adherence", query, true, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Client calls the RxIntake REST API
+type Client struct {
+	baseURL      string
+	httpClient   *http.Client
+	tokens       TokenSource
+	maxRetries   int
+	retryBackoff time.Duration
+}
+
+// Config holds configuration for the client; zero values take the platform HTTP client defaults
+type Config struct {
+	BaseURL         string        // e.g. http://localhost:8080
+	Timeout         time.Duration // Per-request timeout, default 30s
+	MaxIdleConns    int           // Default 100
+	IdleConnTimeout time.Duration // Default 90s
+	Tokens          TokenSource   // Optional: authenticates secured operations
+
+	// MaxRetries retries idempotent requests (GET, HEAD, PUT, DELETE) failing with a network error
+	// or a 429, 502, 503 or 504 response. The default 0 only retries after a 401, like the platform
+	// HTTP client.
+	MaxRetries int
+	// RetryBackoff is the delay before the first retry, doubled for each further one; a Retry-After
+	// header takes precedence. Default 200ms.
+	RetryBackoff time.Duration
+
+	HTTPClient *http.Client // Optional: used instead of a client built from the settings above
+}
+
+// NewClient creates a client for the API at cfg.BaseURL
+func NewClient(cfg Config) *Client {
+	if cfg.Timeout == 0 {
+		cfg.Timeout = 30 * time.Second
+	}
+	if cfg.MaxIdleConns == 0 {
+		cfg.MaxIdleConns = 100
+	}
+	if cfg.IdleConnTimeout == 0 {
+		cfg.IdleConnTimeout = 90 * time.Second
+	}
+	if cfg.RetryBackoff == 0 {
+		cfg.RetryBackoff = 200 * time.Millisecond
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: cfg.Timeout,
+			Transport: &http.Transport{
+				Proxy:               http.ProxyFromEnvironment,
+				MaxIdleConns:        cfg.MaxIdleConns,
+				MaxIdleConnsPerHost: cfg.MaxIdleConns,
+				IdleConnTimeout:     cfg.IdleConnTimeout,
+			},
+		}
+	}
+
+	return &Client{
+		baseURL:      strings.TrimSuffix(cfg.BaseURL, "/"),
+		httpClient:   httpClient,
+		tokens:       cfg.Tokens,
+		maxRetries:   cfg.MaxRetries,
+		retryBackoff: cfg.RetryBackoff,
+	}
+}
+
+// WithTokens returns a copy of the client authenticating with tokens, sharing its connections
+func (c *Client) WithTokens(tokens TokenSource) *Client {
+	clone := *c
+	clone.tokens = tokens
+	return &clone
+}
+
+// TokenSource provides the bearer token sent with secured operations
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// RefreshableTokenSource is a TokenSource whose token the server may reject. After a 401 the client
+// calls Invalidate with the rejected token and sends the request once more.
+type RefreshableTokenSource interface {
+	TokenSource
+	Invalidate(rejected string)
+}
+
+// StaticToken is a fixed bearer token, e.g. a service token issued out of band
+type StaticToken string
+
+func (t StaticToken) Token(context.Context) (string, error) {
+	return string(t), nil
+}
+
+// PasswordTokenSource logs in with a username and password and renews the access token with the
+// refresh token shortly before it expires, logging in again when the refresh is refused
+type PasswordTokenSource struct {
+	client   *Client
+	username string
+	password string
+
+	mu        sync.Mutex
+	token     TokenResponse
+	expiresAt time.Time
+}
+
+// NewPasswordTokenSource creates a token source logging in through client
+func NewPasswordTokenSource(client *Client, username, password string) *PasswordTokenSource {
+	return &PasswordTokenSource{client: client, username: username, password: password}
+}
+
+// tokenRefreshBefore renews tokens this long before they expire
+const tokenRefreshBefore = 30 * time.Second
+
+// Token implements TokenSource
+func (s *PasswordTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.AccessToken != "" && time.Until(s.expiresAt) > tokenRefreshBefore {
+		return s.token.AccessToken, nil
+	}
+
+	var token *TokenResponse
+	if s.token.RefreshToken != "" {
+		token, _ = s.client.RefreshToken(ctx, RefreshRequest{RefreshToken: s.token.RefreshToken})
+	}
+	if token == nil {
+		var err error
+		if token, err = s.client.Login(ctx, LoginRequest{Username: s.username, Password: s.password}); err != nil {
+			return "", err
+		}
+	}
+	s.token = *token
+	s.expiresAt = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	return s.token.AccessToken, nil
+}
+
+// Invalidate implements RefreshableTokenSource
+func (s *PasswordTokenSource) Invalidate(rejected string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token.AccessToken == rejected {
+		s.token.AccessToken = ""
+	}
+}
+
+// APIError is a non-2xx response of the API
+type APIError struct {
+	StatusCode int
+	Code       string // Machine-readable error code, e.g. "not_found"
+	Message    string
+	Details    json.RawMessage // Optional, e.g. the invalid fields of a 400 response
+}
+
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("api error %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("api error %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// IsNotFound reports whether err is a 404 response
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// Ptr returns a pointer to v, for optional request fields
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// do sends a request and decodes the JSON response into result
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, secured bool, body, result any) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return fmt.Errorf("encode request body: %w", err)
+		}
+	}
+
+	idempotent := method == http.MethodGet || method == http.MethodHead || method == http.MethodPut || method == http.MethodDelete
+	renewed := false
+	for retry := 0; ; {
+		response, token, err := c.send(ctx, method, path, query, secured, payload)
+
+		if err == nil && response.StatusCode == http.StatusUnauthorized && secured && !renewed {
+			if refreshable, ok := c.tokens.(RefreshableTokenSource); ok {
+				discard(response)
+				refreshable.Invalidate(token)
+				renewed = true
+				continue
+			}
+		}
+
+		if idempotent && retry < c.maxRetries && transient(response, err) && ctx.Err() == nil {
+			wait := c.retryBackoff << retry
+			if response != nil {
+				if after, convErr := strconv.Atoi(response.Header.Get("Retry-After")); convErr == nil {
+					wait = time.Duration(after) * time.Second
+				}
+				discard(response)
+			}
+			retry++
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+		return decode(response, result)
+	}
+}
+
+// send executes a single attempt of a request and returns the token it was sent with
+func (c *Client) send(ctx context.Context, method, path string, query url.Values, secured bool, payload []byte) (*http.Response, string, error) {
+	target := c.baseURL + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return nil, "", err
+	}
+	request.Header.Set("Accept", "application/json")
+	if payload != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	var token string
+	if secured && c.tokens != nil {
+		if token, err = c.tokens.Token(ctx); err != nil {
+			return nil, "", fmt.Errorf("get token: %w", err)
+		}
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	response, err := c.httpClient.Do(request)
+	return response, token, err
+}
+
+// transient reports whether a failed attempt may succeed when repeated
+func transient(response *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch response.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// decode reads the response into result, or into an *APIError for non-2xx responses
+func decode(response *http.Response, result any) error {
+	defer response.Body.Close()
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return parseError(response.StatusCode, data)
+	}
+	if result == nil || len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, result); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
+
+// parseError reads both error bodies of the API: {"error":{"code","message","details"}} and
+// {"error":"code","message","details"}. Other bodies become the message as they are.
+func parseError(status int, data []byte) *APIError {
+	apiErr := &APIError{StatusCode: status, Message: strings.TrimSpace(string(data))}
+
+	var envelope struct {
+		Error   json.RawMessage `json:"error"`
+		Message string          `json:"message"`
+		Details json.RawMessage `json:"details"`
+	}
+	if json.Unmarshal(data, &envelope) != nil || len(envelope.Error) == 0 {
+		return apiErr
+	}
+
+	var nested struct {
+		Code    string          `json:"code"`
+		Message string          `json:"message"`
+		Details json.RawMessage `json:"details"`
+	}
+	if json.Unmarshal(envelope.Error, &nested) == nil {
+		apiErr.Code, apiErr.Message, apiErr.Details = nested.Code, nested.Message, nested.Details
+		return apiErr
+	}
+	if json.Unmarshal(envelope.Error, &apiErr.Code) == nil {
+		apiErr.Message, apiErr.Details = envelope.Message, envelope.Details
+	}
+	return apiErr
+}
+
+// discard drains and closes a response that is not used, so the connection can be reused
+func discard(response *http.Response) {
+	io.Copy(io.Discard, response.Body)
+	response.Body.Close()
+}
+
+// pages yields the items of consecutive pages until a page comes back short
+func pages[T any](limit, offset int, fetch func(limit, offset int) ([]T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			page, err := fetch(limit, offset)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if len(page) < limit {
+				return
+			}
+			offset += len(page)
+		}
+	}
+}
@@ -0,0 +1,887 @@
+// Code generated by genclient from api/openapi.yaml. DO NOT EDIT.
+//
+// Typed client for the RxIntake REST API (1.0.0). Requests time out after 30s and, when the token
+// source can renew its token, are sent once more after a 401 response. Idempotent requests may
+// additionally be retried on transient failures by setting maxRetries.
+
+export interface LoginRequest {
+  username: string;
+  password: string;
+}
+
+export interface RefreshRequest {
+  refresh_token: string;
+}
+
+export interface TokenResponse {
+  access_token?: string;
+  token_type?: string;
+  /** Seconds until the access token expires */
+  expires_in?: number;
+  /** Absent when auth.login.refresh_enabled is off */
+  refresh_token?: string;
+  refresh_expires_in?: number;
+  user?: User;
+}
+
+export interface User {
+  id?: string;
+  email?: string;
+  name?: string;
+  permissions?: string[];
+  orgId?: string;
+}
+
+export interface Permission {
+  name?: string;
+  domain?: string;
+  description?: string;
+  /** A coarse role permission such as doctor:role */
+  role?: boolean;
+  required_by?: PermissionRequirement[];
+}
+
+export interface PermissionRequirement {
+  /** route or graphql */
+  kind?: string;
+  /** HTTP method of a route */
+  method?: string;
+  /** Route pattern, or Type.field for GraphQL */
+  path?: string;
+  /** any or all of the permissions */
+  match?: string;
+  permissions?: string[];
+}
+
+export interface PermissionCatalogue {
+  permissions?: Permission[];
+}
+
+export interface CurrentUser {
+  id?: string;
+  name?: string;
+  email?: string;
+  org_id?: string;
+  func_roles?: string[];
+  /** Catalogued permissions of the caller's token, sorted */
+  permissions?: string[];
+}
+
+export interface Job {
+  id?: string;
+  type?: string;
+  priority?: number;
+  status?: "queued" | "running" | "succeeded" | "failed";
+  attempts?: number;
+  max_attempts?: number;
+  /** When a queued job is next attempted */
+  run_at?: string;
+  last_error?: string;
+  created_by?: string;
+  created_at?: string;
+  /** Start of the latest attempt */
+  started_at?: string;
+  finished_at?: string;
+}
+
+export interface Patient {
+  id?: string;
+  name?: string;
+  /** Full or partial date (YYYY, YYYY-MM or YYYY-MM-DD) */
+  dob?: string;
+  /** Completed years; the youngest possible age for a partial DOB. Absent without a DOB */
+  age?: number;
+  /** The age, or its possible range such as 44-45 for a partial DOB */
+  age_display?: string;
+  phone?: string;
+  /** Phone with all but the last four digits masked, e.g. ***-***-1234 */
+  phone_masked?: string;
+  state?: string;
+  created_at?: string;
+  edit_by?: string;
+  edit_time?: string;
+  contact_preferences?: ContactPreferences;
+  org_id?: string;
+  /** Prescriptions of the patient by status, set by the patient list */
+  prescription_counts?: Record<string, unknown>;
+  /** Existing patients a patient just created likely duplicates */
+  potential_duplicates?: PotentialDuplicate[];
+}
+
+export interface PatientCreateRequest {
+  name: string;
+  /** Full or partial date (YYYY, YYYY-MM or YYYY-MM-DD) */
+  dob: string;
+  phone: string;
+  state: string;
+}
+
+export interface PotentialDuplicate {
+  patient?: Patient;
+  /** phone: the same phone number, ignoring formatting; name_dob: the same DOB and a similar name */
+  reasons?: ("phone" | "name_dob")[];
+}
+
+/** Channels the patient agreed to be notified on, in order of preference; absent until the patient agrees */
+export interface ContactPreferences {
+  channels?: ("sms" | "email" | "phone")[];
+  email?: string;
+  do_not_contact?: boolean;
+}
+
+export interface PatientSearchResult {
+  patient?: Patient;
+  score?: number;
+  match_type?: "text" | "fuzzy";
+  matches?: PatientSearchMatch[];
+}
+
+export interface PatientSearchMatch {
+  field?: string;
+  value?: string;
+  /** HTML-escaped value with matched terms in <mark> */
+  highlighted?: string;
+}
+
+export interface Address {
+  id?: string;
+  patient_id?: string;
+  line1?: string;
+  line2?: string;
+  city?: string;
+  state?: string;
+  zip?: string;
+  org_id?: string;
+}
+
+export interface AddressCreateRequest {
+  line1: string;
+  line2?: string;
+  city: string;
+  state: string;
+  zip: string;
+}
+
+export interface Prescription {
+  id?: string;
+  patient_id?: string;
+  /** Canonical catalog name when the drug is in the catalog */
+  drug?: string;
+  drug_id?: string;
+  drug_entered?: string;
+  /** The dosage as text, e.g. "500 mg oral BID"; legacy prescriptions may hold free text without a dosage */
+  dose?: string;
+  dosage?: Dose;
+  status?: "Draft" | "Active" | "Paused" | "Completed" | "Expired";
+  created_at?: string;
+  prescribed_by?: string;
+  /** Clinician the prescription is written under; empty on older prescriptions */
+  prescriber_id?: string;
+  sig?: Sig;
+  /** The sig as label text in English */
+  directions?: string;
+  /** The sig as label text in Spanish */
+  directions_es?: string;
+  quantity?: number;
+  days_supply?: number;
+  /** When the days supply runs out, counted from creation */
+  expected_end_date?: string;
+  interaction_warnings?: DrugInteractionWarning[];
+  fulfillment_status?: string;
+  fulfillment_updated_at?: string;
+  pharmacy?: Pharmacy;
+}
+
+export interface PrescriptionCreateRequest {
+  patient_id: string;
+  /** An existing prescriber */
+  prescriber_id: string;
+  drug: string;
+  drug_id?: string;
+  /** Dose text parsed into a dosage, e.g. "500mg po bid"; required without dosage and not allowed with it */
+  dose?: string;
+  dosage?: Dose;
+  status?: "Draft" | "Active" | "Paused" | "Completed";
+  sig?: Sig;
+  /** Free-text directions parsed into a sig, e.g. "1 tab po bid prn pain"; not allowed with sig */
+  sig_text?: string;
+  quantity?: number;
+  /** Derived from quantity when omitted and the sig has a frequency */
+  days_supply?: number;
+}
+
+/** Only the fields that are set are changed */
+export interface PrescriptionUpdateRequest {
+  drug?: string | null;
+  drug_id?: string | null;
+  /** Not allowed with dosage */
+  dose?: string | null;
+  dosage?: Dose;
+  status?: "Draft" | "Active" | "Paused" | "Completed" | null;
+  sig?: Sig;
+  sig_text?: string | null;
+  quantity?: number | null;
+  days_supply?: number | null;
+}
+
+export interface Prescriber {
+  id?: string;
+  /** National Provider Identifier */
+  npi?: string;
+  first_name?: string;
+  last_name?: string;
+  /** Degree shown after the name, e.g. MD */
+  credential?: string;
+  /** Name as printed on a prescription, e.g. Dana Whitfield, MD */
+  display_name?: string;
+  specialty?: string;
+  phone?: string;
+  fax?: string;
+  email?: string;
+  created_at?: string;
+  updated_at?: string;
+}
+
+export interface PrescriberCreateRequest {
+  /** 10 digits whose last is a Luhn check digit */
+  npi: string;
+  first_name: string;
+  last_name: string;
+  credential?: string;
+  specialty?: string;
+  phone?: string;
+  fax?: string;
+  email?: string;
+}
+
+/** Only the fields that are set are changed; an empty phone, fax or email clears it */
+export interface PrescriberUpdateRequest {
+  npi?: string | null;
+  first_name?: string | null;
+  last_name?: string | null;
+  credential?: string | null;
+  specialty?: string | null;
+  phone?: string | null;
+  fax?: string | null;
+  email?: string | null;
+}
+
+/** Structured dosing instruction */
+export interface Sig {
+  /** Units per dose; 1 when omitted */
+  dose_quantity?: number;
+  dose_unit?: "tablet" | "capsule" | "mL" | "puff" | "drop" | "spray" | "patch" | "application" | "unit";
+  route?: "oral" | "sublingual" | "topical" | "transdermal" | "inhaled" | "nasal" | "ophthalmic" | "otic" | "rectal" | "subcutaneous" | "intramuscular";
+  frequency?: "QD" | "BID" | "TID" | "QID" | "Q4H" | "Q6H" | "Q8H" | "Q12H" | "QHS" | "QWK";
+  timing?: "with_food" | "before_meals" | "after_meals" | "empty_stomach" | "morning" | "evening" | "bedtime";
+  /** With as_needed the frequency is the most the patient may take */
+  as_needed?: boolean;
+  /** What an as-needed dose is for */
+  indication?: string;
+}
+
+/** Structured dose; route and frequency must match those of the sig when both are set */
+export interface Dose {
+  value: number;
+  unit: "mg" | "mcg" | "g" | "mL" | "unit" | "IU" | "mEq" | "%" | "tablet" | "capsule" | "puff" | "drop" | "spray" | "patch" | "application";
+  route?: "oral" | "sublingual" | "topical" | "transdermal" | "inhaled" | "nasal" | "ophthalmic" | "otic" | "rectal" | "subcutaneous" | "intramuscular";
+  frequency?: "QD" | "BID" | "TID" | "QID" | "Q4H" | "Q6H" | "Q8H" | "Q12H" | "QHS" | "QWK";
+}
+
+export interface RoutePrescriptionRequest {
+  pharmacy_id: string;
+}
+
+export interface BulkStatusRequest {
+  ids: string[];
+  status: "Active" | "Paused" | "Completed";
+  reason?: string;
+}
+
+export interface BulkStatusResult {
+  prescription_id?: string;
+  outcome?: "updated" | "unchanged" | "rejected" | "not_found" | "conflict";
+  previous_status?: string;
+  status?: string;
+  message?: string;
+}
+
+export interface BulkStatusResponse {
+  results?: BulkStatusResult[];
+  updated?: number;
+  failed?: number;
+}
+
+/** A prescription form as entered so far; nothing is required */
+export interface PrescriptionDraftRequest {
+  /** Makes the draft an edit of that prescription */
+  prescription_id?: string;
+  patient_id?: string;
+  prescriber_id?: string;
+  drug?: string;
+  drug_id?: string;
+  dose?: string;
+  dosage?: Dose;
+  status?: string;
+  sig?: Sig;
+  sig_text?: string;
+  quantity?: number;
+  days_supply?: number;
+}
+
+export interface DraftForm {
+  patient_id?: string;
+  prescriber_id?: string;
+  drug?: string;
+  drug_id?: string;
+  dose?: string;
+  dosage?: Dose;
+  status?: string;
+  sig?: Sig;
+  sig_text?: string;
+  quantity?: number;
+  days_supply?: number;
+}
+
+export interface PrescriptionDraft {
+  id?: string;
+  /** Set when the draft edits a prescription */
+  prescription_id?: string;
+  form?: DraftForm;
+  created_at?: string;
+  updated_at?: string;
+  expires_at?: string;
+}
+
+export interface DrugInteractionWarning {
+  drug?: string;
+  interacting_drug?: string;
+  interacting_prescription_id?: string;
+  severity?: string;
+  description?: string;
+}
+
+export interface DrugAllergyWarning {
+  drug?: string;
+  allergy_id?: string;
+  substance?: string;
+  reaction?: string;
+  severity?: string;
+}
+
+export interface InteractionCheckResponse {
+  blocked?: boolean;
+  warnings?: DrugInteractionWarning[];
+  allergy_warnings?: DrugAllergyWarning[];
+}
+
+export interface Pharmacy {
+  id?: string;
+  name?: string;
+  type?: string;
+  address?: string;
+  city?: string;
+  state?: string;
+  zip?: string;
+  phone?: string;
+  routed_at?: string;
+}
+
+export interface NetworkPharmacy {
+  id?: string;
+  name?: string;
+  type?: string;
+  address?: string;
+  city?: string;
+  state?: string;
+  zip?: string;
+  phone?: string;
+  accepting_prescriptions?: boolean;
+}
+
+export interface PharmacySearchResponse {
+  pharmacies?: NetworkPharmacy[];
+  total?: number;
+}
+
+export interface PrescriptionAdherence {
+  prescription_id?: string;
+  drug?: string;
+  status?: "Active" | "Completed";
+  dispenses?: number;
+  last_dispensed_at?: string | null;
+  average_days_between_refills?: number | null;
+  /** From the first dispense with a days supply until today, or until the last supply ran out for a completed prescription */
+  proportion_of_days_covered?: number | null;
+}
+
+export interface AdherenceSnapshot {
+  patient_id?: string;
+  /** UTC day of the snapshot */
+  date?: string;
+  active_prescriptions?: number;
+  completed_prescriptions?: number;
+  /** Dispenses after the first one of each prescription; reversed ones are not counted */
+  refills?: number;
+  /** Mean gap between consecutive dispenses; absent without a refill */
+  average_days_between_refills?: number | null;
+  /** Mean of the prescriptions' proportions of days covered */
+  proportion_of_days_covered?: number | null;
+  taken_at?: string;
+  org_id?: string | null;
+}
+
+export interface AdherenceReport {
+  patient_id?: string;
+  active_prescriptions?: number;
+  completed_prescriptions?: number;
+  /** Dispenses after the first one of each prescription; reversed ones are not counted */
+  refills?: number;
+  /** Mean gap between consecutive dispenses; absent without a refill */
+  average_days_between_refills?: number | null;
+  /** Mean of the prescriptions' proportions of days covered */
+  proportion_of_days_covered?: number | null;
+  prescriptions?: PrescriptionAdherence[];
+  /** Nightly snapshots, newest first */
+  history?: AdherenceSnapshot[];
+  generated_at?: string;
+}
+
+export interface ListPatientsParams {
+  limit?: number;
+  offset?: number;
+  patientName?: string;
+  /** Full or partial date (YYYY, YYYY-MM or YYYY-MM-DD) */
+  birthDate?: string;
+  state?: string;
+  /** Lowest age in completed years, derived from the DOB; a partial DOB matches when any age it allows is in range */
+  minAge?: number | null;
+  /** Highest age in completed years, derived from the DOB */
+  maxAge?: number | null;
+  /** Only patients with (true) or without (false) an active prescription */
+  hasActivePrescriptions?: boolean | null;
+}
+
+export interface SearchPatientsParams {
+  q: string;
+  limit?: number;
+}
+
+export interface ListPrescriptionsParams {
+  limit?: number;
+  offset?: number;
+  status?: string;
+}
+
+export interface CheckInteractionsParams {
+  patientId: string;
+  drug: string;
+}
+
+export interface SearchPharmaciesParams {
+  zip?: string;
+  state?: string;
+  limit?: number;
+}
+
+export interface ListPrescribersParams {
+  limit?: number;
+  offset?: number;
+  /** Matches the start of a first or last name, or the NPI */
+  query?: string;
+}
+
+export interface ListPrescriberPrescriptionsParams {
+  limit?: number;
+}
+
+export interface GetAdherenceReportParams {
+  patientID: string;
+}
+
+/** Provides the bearer token sent with secured operations */
+export interface TokenSource {
+  token(): Promise<string>;
+  /** Called with a token the server rejected; the request is then sent once more */
+  invalidate?(rejected: string): void;
+}
+
+export interface ClientConfig {
+  /** e.g. http://localhost:8080 */
+  baseUrl: string;
+  /** Per-request timeout, default 30000 */
+  timeoutMs?: number;
+  tokens?: TokenSource;
+  /** Retries idempotent requests failing with a network error or a 429, 502, 503 or 504 response; default 0 */
+  maxRetries?: number;
+  /** Delay before the first retry, doubled for each further one; default 200 */
+  retryBackoffMs?: number;
+  fetch?: typeof fetch;
+}
+
+/** A non-2xx response of the API */
+export class ApiError extends Error {
+  constructor(
+    readonly status: number,
+    readonly code: string,
+    message: string,
+    readonly details?: unknown,
+  ) {
+    super(code ? `api error ${status} ${code}: ${message}` : `api error ${status}: ${message}`);
+    this.name = "ApiError";
+  }
+}
+
+/** A fixed bearer token, e.g. a service token issued out of band */
+export function staticToken(token: string): TokenSource {
+  return { token: async () => token };
+}
+
+type Query = Record<string, string | number | boolean | null | undefined>;
+
+const transientStatuses = new Set([429, 502, 503, 504]);
+const idempotentMethods = new Set(["GET", "HEAD", "PUT", "DELETE"]);
+
+export class Client {
+  private readonly baseUrl: string;
+  private readonly timeoutMs: number;
+  private readonly maxRetries: number;
+  private readonly retryBackoffMs: number;
+  private readonly fetchFn: typeof fetch;
+  tokens?: TokenSource;
+
+  constructor(config: ClientConfig) {
+    this.baseUrl = config.baseUrl.replace(/\/$/, "");
+    this.timeoutMs = config.timeoutMs ?? 30000;
+    this.maxRetries = config.maxRetries ?? 0;
+    this.retryBackoffMs = config.retryBackoffMs ?? 200;
+    this.fetchFn = config.fetch ?? fetch.bind(globalThis);
+    this.tokens = config.tokens;
+  }
+
+  /** POST /auth/login: Exchange a username and password for tokens */
+  login(body: LoginRequest): Promise<TokenResponse> {
+    return this.request("POST", `/auth/login`, undefined, false, body);
+  }
+
+  /** POST /auth/refresh: Exchange a refresh token for new tokens; each refresh token works once */
+  refreshToken(body: RefreshRequest): Promise<TokenResponse> {
+    return this.request("POST", `/auth/refresh`, undefined, false, body);
+  }
+
+  /** GET /api/auth/permissions: List every permission the application checks, with its description and the routes and GraphQL fields that require it */
+  listPermissions(): Promise<PermissionCatalogue> {
+    return this.request("GET", `/api/auth/permissions`, undefined, true);
+  }
+
+  /** GET /api/auth/me: The caller and their effective permissions, for showing or hiding features */
+  getCurrentUser(): Promise<CurrentUser> {
+    return this.request("GET", `/api/auth/me`, undefined, true);
+  }
+
+  /** GET /api/jobs/{jobID}: Status of a background job enqueued by the caller; admins can read every job */
+  getJob(jobID: string, ): Promise<Job> {
+    return this.request("GET", `/api/jobs/${encodeURIComponent(jobID)}`, undefined, true);
+  }
+
+  /** GET /api/v1/patients: List patients, restricted to the caller's data-access scope. Requires any of patient:read, admin:all. */
+  listPatients(params: ListPatientsParams = {}): Promise<Patient[]> {
+    return this.request("GET", `/api/v1/patients`, params as Query, true);
+  }
+
+  /** Iterates over all results of listPatients, fetching params.limit items per request (100 when unset) */
+  async *listPatientsAll(params: ListPatientsParams = {}): AsyncGenerator<Patient> {
+    const limit = params.limit ?? 100;
+    let offset = params.offset ?? 0;
+    for (;;) {
+      const page = await this.listPatients({ ...params, limit, offset });
+      yield* page;
+      if (page.length < limit) {
+        return;
+      }
+      offset += page.length;
+    }
+  }
+
+  /** POST /api/v1/patients: Create a patient, checking for likely duplicates first (patient_duplicates.mode). Requires any of patient:write, admin:all. */
+  createPatient(body: PatientCreateRequest): Promise<Patient> {
+    return this.request("POST", `/api/v1/patients`, undefined, true, body);
+  }
+
+  /** GET /api/v1/patients/search: Full-text and typo-tolerant search over name, phone, state and address. Requires any of patient:read, admin:all. */
+  searchPatients(params: SearchPatientsParams): Promise<PatientSearchResult[]> {
+    return this.request("GET", `/api/v1/patients/search`, params as Query, true);
+  }
+
+  /** GET /api/v1/patients/{patientID}. Requires any of patient:read, admin:all. */
+  getPatient(patientID: string, ): Promise<Patient> {
+    return this.request("GET", `/api/v1/patients/${encodeURIComponent(patientID)}`, undefined, true);
+  }
+
+  /** GET /api/v1/patients/{patientID}/addresses. Requires any of patient:read, admin:all. */
+  listAddresses(patientID: string, ): Promise<Address[]> {
+    return this.request("GET", `/api/v1/patients/${encodeURIComponent(patientID)}/addresses`, undefined, true);
+  }
+
+  /** POST /api/v1/patients/{patientID}/addresses. Requires any of patient:write, admin:all. */
+  createAddress(patientID: string, body: AddressCreateRequest): Promise<Address> {
+    return this.request("POST", `/api/v1/patients/${encodeURIComponent(patientID)}/addresses`, undefined, true, body);
+  }
+
+  /** GET /api/v1/patients/{patientID}/addresses/{addressID}. Requires any of patient:read, admin:all. */
+  getAddress(patientID: string, addressID: string, ): Promise<Address> {
+    return this.request("GET", `/api/v1/patients/${encodeURIComponent(patientID)}/addresses/${encodeURIComponent(addressID)}`, undefined, true);
+  }
+
+  /** GET /api/v1/prescriptions. Requires any of prescription:read, admin:all. */
+  listPrescriptions(params: ListPrescriptionsParams = {}): Promise<Prescription[]> {
+    return this.request("GET", `/api/v1/prescriptions`, params as Query, true);
+  }
+
+  /** Iterates over all results of listPrescriptions, fetching params.limit items per request (100 when unset) */
+  async *listPrescriptionsAll(params: ListPrescriptionsParams = {}): AsyncGenerator<Prescription> {
+    const limit = params.limit ?? 100;
+    let offset = params.offset ?? 0;
+    for (;;) {
+      const page = await this.listPrescriptions({ ...params, limit, offset });
+      yield* page;
+      if (page.length < limit) {
+        return;
+      }
+      offset += page.length;
+    }
+  }
+
+  /** POST /api/v1/prescriptions. Requires any of prescription:write, doctor:role, admin:all. */
+  createPrescription(body: PrescriptionCreateRequest): Promise<Prescription> {
+    return this.request("POST", `/api/v1/prescriptions`, undefined, true, body);
+  }
+
+  /** GET /api/v1/prescriptions/interactions/check: Check a drug against the patient's active prescriptions and recorded allergies. Requires any of prescription:read, admin:all. */
+  checkInteractions(params: CheckInteractionsParams): Promise<InteractionCheckResponse> {
+    return this.request("GET", `/api/v1/prescriptions/interactions/check`, params as Query, true);
+  }
+
+  /** GET /api/v1/prescriptions/pharmacies: Search the pharmacy network by zip or state. Requires any of prescription:read, admin:all. */
+  searchPharmacies(params: SearchPharmaciesParams = {}): Promise<PharmacySearchResponse> {
+    return this.request("GET", `/api/v1/prescriptions/pharmacies`, params as Query, true);
+  }
+
+  /** GET /api/v1/prescriptions/{prescriptionID}. Requires any of prescription:read, admin:all. */
+  getPrescription(prescriptionID: string, ): Promise<Prescription> {
+    return this.request("GET", `/api/v1/prescriptions/${encodeURIComponent(prescriptionID)}`, undefined, true);
+  }
+
+  /** PUT /api/v1/prescriptions/{prescriptionID}. Requires any of prescription:write, doctor:role, admin:all. */
+  updatePrescription(prescriptionID: string, body: PrescriptionUpdateRequest): Promise<Prescription> {
+    return this.request("PUT", `/api/v1/prescriptions/${encodeURIComponent(prescriptionID)}`, undefined, true, body);
+  }
+
+  /** POST /api/v1/prescriptions/{prescriptionID}/route: Send the prescription to a network pharmacy. Requires any of prescription:write, doctor:role, admin:all. */
+  routePrescription(prescriptionID: string, body: RoutePrescriptionRequest): Promise<Prescription> {
+    return this.request("POST", `/api/v1/prescriptions/${encodeURIComponent(prescriptionID)}/route`, undefined, true, body);
+  }
+
+  /** POST /api/v1/prescriptions/bulk-status: Change the status of many prescriptions. Requires any of prescription:write, doctor:role, admin:all. */
+  bulkUpdatePrescriptionStatus(body: BulkStatusRequest): Promise<BulkStatusResponse> {
+    return this.request("POST", `/api/v1/prescriptions/bulk-status`, undefined, true, body);
+  }
+
+  /** GET /api/v1/prescriptions/drafts: List the current user's prescription drafts, most recently saved first. Requires any of prescription:write, doctor:role, admin:all. */
+  listPrescriptionDrafts(): Promise<PrescriptionDraft[]> {
+    return this.request("GET", `/api/v1/prescriptions/drafts`, undefined, true);
+  }
+
+  /** GET /api/v1/prescriptions/drafts/{draftID}. Requires any of prescription:write, doctor:role, admin:all. */
+  getPrescriptionDraft(draftID: string, ): Promise<PrescriptionDraft> {
+    return this.request("GET", `/api/v1/prescriptions/drafts/${encodeURIComponent(draftID)}`, undefined, true);
+  }
+
+  /** PUT /api/v1/prescriptions/drafts/{draftID}: Autosave a prescription form. Requires any of prescription:write, doctor:role, admin:all. */
+  savePrescriptionDraft(draftID: string, body: PrescriptionDraftRequest): Promise<PrescriptionDraft> {
+    return this.request("PUT", `/api/v1/prescriptions/drafts/${encodeURIComponent(draftID)}`, undefined, true, body);
+  }
+
+  /** DELETE /api/v1/prescriptions/drafts/{draftID}. Requires any of prescription:write, doctor:role, admin:all. */
+  deletePrescriptionDraft(draftID: string, ): Promise<void> {
+    return this.request("DELETE", `/api/v1/prescriptions/drafts/${encodeURIComponent(draftID)}`, undefined, true);
+  }
+
+  /** POST /api/v1/prescriptions/drafts/{draftID}/promote: Save the prescription of a draft and remove the draft. Requires any of prescription:write, doctor:role, admin:all. */
+  promotePrescriptionDraft(draftID: string, ): Promise<Prescription> {
+    return this.request("POST", `/api/v1/prescriptions/drafts/${encodeURIComponent(draftID)}/promote`, undefined, true);
+  }
+
+  /** GET /api/v1/prescribers. Requires any of prescription:read, admin:all. */
+  listPrescribers(params: ListPrescribersParams = {}): Promise<Prescriber[]> {
+    return this.request("GET", `/api/v1/prescribers`, params as Query, true);
+  }
+
+  /** Iterates over all results of listPrescribers, fetching params.limit items per request (100 when unset) */
+  async *listPrescribersAll(params: ListPrescribersParams = {}): AsyncGenerator<Prescriber> {
+    const limit = params.limit ?? 100;
+    let offset = params.offset ?? 0;
+    for (;;) {
+      const page = await this.listPrescribers({ ...params, limit, offset });
+      yield* page;
+      if (page.length < limit) {
+        return;
+      }
+      offset += page.length;
+    }
+  }
+
+  /** POST /api/v1/prescribers. Requires any of prescription:write, doctor:role, admin:all. */
+  createPrescriber(body: PrescriberCreateRequest): Promise<Prescriber> {
+    return this.request("POST", `/api/v1/prescribers`, undefined, true, body);
+  }
+
+  /** GET /api/v1/prescribers/{prescriberID}. Requires any of prescription:read, admin:all. */
+  getPrescriber(prescriberID: string, ): Promise<Prescriber> {
+    return this.request("GET", `/api/v1/prescribers/${encodeURIComponent(prescriberID)}`, undefined, true);
+  }
+
+  /** PUT /api/v1/prescribers/{prescriberID}. Requires any of prescription:write, doctor:role, admin:all. */
+  updatePrescriber(prescriberID: string, body: PrescriberUpdateRequest): Promise<Prescriber> {
+    return this.request("PUT", `/api/v1/prescribers/${encodeURIComponent(prescriberID)}`, undefined, true, body);
+  }
+
+  /** DELETE /api/v1/prescribers/{prescriberID}. Requires any of prescription:write, doctor:role, admin:all. */
+  deletePrescriber(prescriberID: string, ): Promise<void> {
+    return this.request("DELETE", `/api/v1/prescribers/${encodeURIComponent(prescriberID)}`, undefined, true);
+  }
+
+  /** GET /api/v1/prescribers/{prescriberID}/prescriptions. Requires any of prescription:read, admin:all. */
+  listPrescriberPrescriptions(prescriberID: string, params: ListPrescriberPrescriptionsParams = {}): Promise<Prescription[]> {
+    return this.request("GET", `/api/v1/prescribers/${encodeURIComponent(prescriberID)}/prescriptions`, params as Query, true);
+  }
+
+  /** GET /api/v1/reports/adherence: A patient's adherence from their active and completed prescriptions and dispenses, with the nightly snapshots of the last month. Requires any of adherence:read, admin:all. */
+  getAdherenceReport(params: GetAdherenceReportParams): Promise<AdherenceReport> {
+    return this.request("GET", `/api/v1/reports/adherence`, params as Query, true);
+  }
+
+  private async request<T>(method: string, path: string, query: Query | undefined, secured: boolean, body?: unknown): Promise<T> {
+    let url = this.baseUrl + path;
+    if (query) {
+      const search = new URLSearchParams();
+      for (const [key, value] of Object.entries(query)) {
+        if (value !== undefined && value !== null && value !== "") {
+          search.set(key, String(value));
+        }
+      }
+      if ([...search].length > 0) {
+        url += "?" + search.toString();
+      }
+    }
+
+    let renewed = false;
+    for (let retry = 0; ; ) {
+      const headers: Record<string, string> = { Accept: "application/json" };
+      if (body !== undefined) {
+        headers["Content-Type"] = "application/json";
+      }
+      let token = "";
+      if (secured && this.tokens) {
+        token = await this.tokens.token();
+        headers["Authorization"] = "Bearer " + token;
+      }
+
+      let response: Response;
+      try {
+        response = await this.fetchFn(url, {
+          method,
+          headers,
+          body: body === undefined ? undefined : JSON.stringify(body),
+          signal: AbortSignal.timeout(this.timeoutMs),
+        });
+      } catch (error) {
+        if (idempotentMethods.has(method) && retry < this.maxRetries) {
+          await sleep(this.retryBackoffMs * 2 ** retry++);
+          continue;
+        }
+        throw error;
+      }
+
+      if (response.status === 401 && secured && !renewed && this.tokens?.invalidate) {
+        this.tokens.invalidate(token);
+        renewed = true;
+        continue;
+      }
+      if (transientStatuses.has(response.status) && idempotentMethods.has(method) && retry < this.maxRetries) {
+        const after = Number(response.headers.get("Retry-After"));
+        await sleep(after > 0 ? after * 1000 : this.retryBackoffMs * 2 ** retry);
+        retry++;
+        continue;
+      }
+
+      const text = await response.text();
+      if (!response.ok) {
+        throw parseError(response.status, text);
+      }
+      return (text.trim() === "" ? undefined : JSON.parse(text)) as T;
+    }
+  }
+}
+
+/**
+ * Logs in with a username and password and renews the access token with the refresh token shortly
+ * before it expires, logging in again when the refresh is refused
+ */
+export class PasswordTokenSource implements TokenSource {
+  private current?: TokenResponse;
+  private expiresAt = 0;
+  private pending?: Promise<string>;
+
+  constructor(
+    private readonly client: Client,
+    private readonly username: string,
+    private readonly password: string,
+  ) {}
+
+  token(): Promise<string> {
+    if (this.current?.access_token && this.expiresAt - Date.now() > 30000) {
+      return Promise.resolve(this.current.access_token);
+    }
+    this.pending ??= this.renew().finally(() => (this.pending = undefined));
+    return this.pending;
+  }
+
+  invalidate(rejected: string): void {
+    if (this.current?.access_token === rejected) {
+      this.current.access_token = "";
+    }
+  }
+
+  private async renew(): Promise<string> {
+    let token: TokenResponse | undefined;
+    if (this.current?.refresh_token) {
+      token = await this.client.refreshToken({ refresh_token: this.current.refresh_token }).catch(() => undefined);
+    }
+    token ??= await this.client.login({ username: this.username, password: this.password });
+    this.current = token;
+    this.expiresAt = Date.now() + (token.expires_in ?? 0) * 1000;
+    return token.access_token ?? "";
+  }
+}
+
+function sleep(ms: number): Promise<void> {
+  return new Promise((resolve) => setTimeout(resolve, ms));
+}
+
+// parseError reads both error bodies of the API: {"error":{"code","message","details"}} and
+// {"error":"code","message","details"}. Other bodies become the message as they are.
+function parseError(status: number, text: string): ApiError {
+  try {
+    const body = JSON.parse(text);
+    if (body && typeof body.error === "object" && body.error !== null) {
+      return new ApiError(status, body.error.code ?? "", body.error.message ?? "", body.error.details);
+    }
+    if (body && typeof body.error === "string") {
+      return new ApiError(status, body.error, body.message ?? "", body.details);
+    }
+  } catch {
+    // Not JSON
+  }
+  return new ApiError(status, "", text.trim());
+}
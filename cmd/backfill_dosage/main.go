@@ -0,0 +1,98 @@
+// Command backfill_dosage gives prescriptions stored before the structured dosage existed the
+// dosage their free-text dose describes, e.g. "500mg po bid" becomes 500 mg, oral, BID. It uses
+// the same configuration as the server (internal/configs/app.yaml, app.<env>.yaml and RX_
+// environment overrides).
+//
+// Doses it cannot read are listed and left alone: the server keeps accepting them until the
+// prescription's dose is edited. Fix them by hand, then run the command again; it only visits
+// prescriptions without a dosage, so it is safe to run repeatedly and against a live database.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"go.uber.org/zap"
+
+	prescriptionrepo "pharmacy-modernization-project-model/domain/prescription/repository"
+	"pharmacy-modernization-project-model/internal/app/builder"
+	"pharmacy-modernization-project-model/internal/platform/config"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+type backfillOptions struct {
+	env       string
+	dryRun    bool
+	batchSize int
+}
+
+func main() {
+	opts, err := parseFlags(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	// config.Load picks app.<env>.yaml from RX_APP_ENV, the same way the server does
+	if opts.env != "" {
+		os.Setenv("RX_APP_ENV", opts.env)
+	}
+	cfg := config.Load()
+	fmt.Printf("💊 Backfilling prescription dosage (env: %s, database: %s)\n", cfg.App.Env, cfg.Database.MongoDB.Database)
+
+	connMgr, err := builder.CreateMongoDBConnection(cfg, zap.NewNop())
+	if err != nil {
+		var cfgErr platformErrors.ConfigurationError
+		if errors.As(err, &cfgErr) && cfgErr.Setting == "mongodb.uri" {
+			log.Fatal("❌ MongoDB URI is not configured. Set RX_DATABASE_MONGODB_URI (see .dev/.env.example)")
+		}
+		log.Fatalf("❌ Failed to connect to MongoDB: %v", err)
+	}
+	defer connMgr.Close()
+	fmt.Println("✅ Connected to MongoDB")
+
+	coll := connMgr.GetCollection("prescriptions")
+	result, err := prescriptionrepo.BackfillDosage(context.Background(), coll, opts.batchSize, opts.dryRun)
+	if err != nil {
+		log.Fatalf("❌ Failed to backfill %s after %d documents: %v", coll.Name(), result.Scanned, err)
+	}
+
+	if len(result.Unreadable) > 0 {
+		fmt.Printf("\n⚠️  %d doses could not be read:\n", len(result.Unreadable))
+		for _, u := range result.Unreadable {
+			fmt.Printf("   %s  %q: %s\n", u.ID, u.Dose, u.Reason)
+		}
+	}
+
+	if opts.dryRun {
+		fmt.Printf("\n🔎 Dry run: %d of %d prescriptions without a dosage would be filled\n", result.Filled, result.Scanned)
+		return
+	}
+	fmt.Printf("\n🎉 %d of %d prescriptions without a dosage filled", result.Filled, result.Scanned)
+	if result.Changed > 0 {
+		fmt.Printf(" (%d changed during the run; run again to fill them)", result.Changed)
+	}
+	fmt.Println()
+}
+
+func parseFlags(args []string) (backfillOptions, error) {
+	opts := backfillOptions{}
+
+	fs := flag.NewFlagSet("backfill_dosage", flag.ContinueOnError)
+	fs.StringVar(&opts.env, "env", "", "config environment to load (sets RX_APP_ENV, e.g. dev or prod)")
+	fs.BoolVar(&opts.dryRun, "dry-run", false, "only parse the doses and count the prescriptions that would be filled")
+	fs.IntVar(&opts.batchSize, "batch-size", 500, "documents fetched per round trip")
+	if err := fs.Parse(args); err != nil {
+		return opts, err
+	}
+	if opts.batchSize <= 0 {
+		return opts, fmt.Errorf("--batch-size must be positive")
+	}
+	return opts, nil
+}
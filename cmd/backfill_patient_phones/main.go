@@ -0,0 +1,94 @@
+// Command backfill_patient_phones gives patients stored before duplicate checks existed the
+// normalized phone number (its digits) that the checks look up, e.g. "(206) 417-8842" is stored
+// as 2064178842 next to it. It uses the same configuration as the server (internal/configs/
+// app.yaml, app.<env>.yaml and RX_ environment overrides).
+//
+// Patients with an encrypted phone number are skipped: their blind index already holds its
+// digits. The command only visits patients without a normalized phone number, so it is safe to
+// run repeatedly and against a live database.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"go.uber.org/zap"
+
+	patientrepo "pharmacy-modernization-project-model/domain/patient/repository"
+	"pharmacy-modernization-project-model/internal/app/builder"
+	"pharmacy-modernization-project-model/internal/platform/config"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+type backfillOptions struct {
+	env       string
+	dryRun    bool
+	batchSize int
+}
+
+func main() {
+	opts, err := parseFlags(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	// config.Load picks app.<env>.yaml from RX_APP_ENV, the same way the server does
+	if opts.env != "" {
+		os.Setenv("RX_APP_ENV", opts.env)
+	}
+	cfg := config.Load()
+	fmt.Printf("📞 Backfilling normalized patient phone numbers (env: %s, database: %s)\n", cfg.App.Env, cfg.Database.MongoDB.Database)
+
+	connMgr, err := builder.CreateMongoDBConnection(cfg, zap.NewNop())
+	if err != nil {
+		var cfgErr platformErrors.ConfigurationError
+		if errors.As(err, &cfgErr) && cfgErr.Setting == "mongodb.uri" {
+			log.Fatal("❌ MongoDB URI is not configured. Set RX_DATABASE_MONGODB_URI (see .dev/.env.example)")
+		}
+		log.Fatalf("❌ Failed to connect to MongoDB: %v", err)
+	}
+	defer connMgr.Close()
+	fmt.Println("✅ Connected to MongoDB")
+
+	coll := connMgr.GetCollection("patients")
+	result, err := patientrepo.BackfillNormalizedPhones(context.Background(), coll, opts.batchSize, opts.dryRun)
+	if err != nil {
+		log.Fatalf("❌ Failed to backfill %s after %d documents: %v", coll.Name(), result.Scanned, err)
+	}
+
+	if opts.dryRun {
+		fmt.Printf("\n🔎 Dry run: %d of %d patients without a normalized phone number would be filled", result.Filled, result.Scanned)
+	} else {
+		fmt.Printf("\n🎉 %d of %d patients without a normalized phone number filled", result.Filled, result.Scanned)
+	}
+	if result.Encrypted > 0 {
+		fmt.Printf(" (%d with an encrypted phone number skipped)", result.Encrypted)
+	}
+	if result.Changed > 0 {
+		fmt.Printf(" (%d changed during the run and were already filled)", result.Changed)
+	}
+	fmt.Println()
+}
+
+func parseFlags(args []string) (backfillOptions, error) {
+	opts := backfillOptions{}
+
+	fs := flag.NewFlagSet("backfill_patient_phones", flag.ContinueOnError)
+	fs.StringVar(&opts.env, "env", "", "config environment to load (sets RX_APP_ENV, e.g. dev or prod)")
+	fs.BoolVar(&opts.dryRun, "dry-run", false, "only count the patients that would be filled")
+	fs.IntVar(&opts.batchSize, "batch-size", 500, "documents fetched per round trip")
+	if err := fs.Parse(args); err != nil {
+		return opts, err
+	}
+	if opts.batchSize <= 0 {
+		return opts, fmt.Errorf("--batch-size must be positive")
+	}
+	return opts, nil
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	eprescribingRepo "pharmacy-modernization-project-model/domain/eprescribing/repository"
+	patientRepo "pharmacy-modernization-project-model/domain/patient/repository"
+	prescriptionRepo "pharmacy-modernization-project-model/domain/prescription/repository"
+)
+
+// Backend is one implementation of the repositories under check
+type Backend struct {
+	Name          string
+	Patients      patientRepo.PatientRepository
+	Addresses     patientRepo.AddressRepository
+	Prescriptions prescriptionRepo.PrescriptionRepository
+
+	// The records of a patient or a prescription carry no organization; their services read the
+	// patient or the prescription first
+	Measurements  patientRepo.MeasurementRepository
+	Allergies     patientRepo.AllergyRepository
+	Insurance     patientRepo.InsuranceRepository
+	Dispenses     prescriptionRepo.DispenseRepository
+	Transmissions eprescribingRepo.TransmissionRepository
+
+	services *Services
+	close    func(ctx context.Context) error
+}
+
+// MemoryBackend returns the in-memory repositories, sample data included
+func MemoryBackend() *Backend {
+	return &Backend{
+		Name:          "memory",
+		Patients:      patientRepo.NewPatientMemoryRepository(),
+		Addresses:     patientRepo.NewAddressMemoryRepository(),
+		Prescriptions: prescriptionRepo.NewPrescriptionMemoryRepository(),
+		Measurements:  patientRepo.NewMeasurementMemoryRepository(),
+		Allergies:     patientRepo.NewAllergyMemoryRepository(),
+		Insurance:     patientRepo.NewInsuranceMemoryRepository(),
+		Dispenses:     prescriptionRepo.NewDispenseMemoryRepository(),
+		Transmissions: eprescribingRepo.NewTransmissionMemoryRepository(),
+	}
+}
+
+// MongoBackend returns the MongoDB repositories over a scratch database, with the indexes the
+// server creates. The database must be empty, as Close drops it.
+func MongoBackend(ctx context.Context, uri, database string, verbose bool) (*Backend, error) {
+	logger := zap.NewNop()
+	if verbose {
+		var err error
+		if logger, err = zap.NewDevelopment(); err != nil {
+			return nil, fmt.Errorf("create logger: %w", err)
+		}
+	}
+
+	connectCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	client, err := mongo.Connect(connectCtx, options.Client().ApplyURI(uri))
+	if err != nil {
+		return nil, fmt.Errorf("connect: %w", err)
+	}
+	if err := client.Ping(connectCtx, nil); err != nil {
+		_ = client.Disconnect(ctx)
+		return nil, fmt.Errorf("ping: %w", err)
+	}
+
+	db := client.Database(database)
+	collections, err := db.ListCollectionNames(connectCtx, bson.M{})
+	if err != nil {
+		_ = client.Disconnect(ctx)
+		return nil, fmt.Errorf("list collections of %s: %w", database, err)
+	}
+	if len(collections) > 0 {
+		_ = client.Disconnect(ctx)
+		return nil, fmt.Errorf("database %s is not empty; pick a scratch database with -database", database)
+	}
+
+	b := &Backend{
+		Name:          "mongodb",
+		Patients:      patientRepo.NewPatientMongoRepository(db.Collection("patients"), nil, logger),
+		Addresses:     patientRepo.NewAddressMongoRepository(db.Collection("addresses"), logger),
+		Prescriptions: prescriptionRepo.NewPrescriptionMongoRepository(db.Collection("prescriptions"), logger),
+		Measurements:  patientRepo.NewMeasurementMongoRepository(db.Collection("measurements"), logger),
+		Allergies:     patientRepo.NewAllergyMongoRepository(db.Collection("allergies"), logger),
+		Insurance:     patientRepo.NewInsuranceMongoRepository(db.Collection("insurance_records"), logger),
+		Dispenses:     prescriptionRepo.NewDispenseMongoRepository(db.Collection("dispenses"), logger),
+		Transmissions: eprescribingRepo.NewTransmissionMongoRepository(db.Collection("transmissions"), logger),
+		close: func(ctx context.Context) error {
+			defer client.Disconnect(ctx)
+			return db.Drop(ctx)
+		},
+	}
+
+	type indexed interface {
+		CreateIndexes(ctx context.Context) error
+	}
+	for _, repo := range []any{b.Patients, b.Prescriptions, b.Measurements, b.Allergies, b.Insurance, b.Dispenses, b.Transmissions} {
+		if repo, ok := repo.(indexed); ok {
+			if err := repo.CreateIndexes(connectCtx); err != nil {
+				b.Close(ctx)
+				return nil, fmt.Errorf("create indexes: %w", err)
+			}
+		}
+	}
+	return b, nil
+}
+
+// Close drops the scratch database of a MongoDB backend
+func (b *Backend) Close(ctx context.Context) {
+	if b.close == nil {
+		return
+	}
+	if err := b.close(ctx); err != nil {
+		log.Printf("⚠️  Failed to clean up %s: %v", b.Name, err)
+	}
+	b.close = nil
+}
@@ -0,0 +1,594 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	eprescribingModel "pharmacy-modernization-project-model/domain/eprescribing/contracts/model"
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+	rx "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/dates"
+	"pharmacy-modernization-project-model/internal/platform/tenancy"
+)
+
+// otherOrg scopes behaviors that look at records through another organization
+const otherOrg = "cf-other-org"
+
+// Behaviors lists what every repository implementation must do alike
+func Behaviors() []Behavior {
+	return []Behavior{
+		{"patient.get_unknown_is_not_found", func(ctx context.Context, b *Backend, f *Fixture) error {
+			_, err := b.Patients.GetByID(ctx, f.ID("P"))
+			return wantNotFound("GetByID", err)
+		}},
+		{"patient.get_other_org_is_not_found", func(ctx context.Context, b *Backend, f *Fixture) error {
+			p, err := b.Patients.Create(ctx, newPatient(f, f.Token(), time.Time{}))
+			if err != nil {
+				return fmt.Errorf("Create: %w", err)
+			}
+			_, err = b.Patients.GetByID(tenancy.WithOrg(ctx, otherOrg), p.ID)
+			return wantNotFound("GetByID", err)
+		}},
+		{"patient.create_sets_created_at", func(ctx context.Context, b *Backend, f *Fixture) error {
+			p, err := b.Patients.Create(ctx, newPatient(f, f.Token(), time.Time{}))
+			if err != nil {
+				return fmt.Errorf("Create: %w", err)
+			}
+			stored, err := b.Patients.GetByID(ctx, p.ID)
+			if err != nil {
+				return fmt.Errorf("GetByID: %w", err)
+			}
+			if p.CreatedAt.IsZero() || stored.CreatedAt.IsZero() {
+				return fmt.Errorf("created_at not set: returned %v, stored %v", p.CreatedAt, stored.CreatedAt)
+			}
+			return nil
+		}},
+		{"patient.create_duplicate_id_fails", func(ctx context.Context, b *Backend, f *Fixture) error {
+			p, err := b.Patients.Create(ctx, newPatient(f, f.Token(), time.Time{}))
+			if err != nil {
+				return fmt.Errorf("Create: %w", err)
+			}
+			again := newPatient(f, f.Token(), time.Time{})
+			again.ID = p.ID
+			// IDs are unique across organizations
+			_, err = b.Patients.Create(tenancy.WithOrg(ctx, otherOrg), again)
+			return wantDuplicate("Create", err)
+		}},
+		{"patient.update_unknown_is_not_found", func(ctx context.Context, b *Backend, f *Fixture) error {
+			p := newPatient(f, f.Token(), time.Time{})
+			_, err := b.Patients.Update(ctx, p.ID, p)
+			if err := wantNotFound("Update", err); err != nil {
+				return err
+			}
+			_, err = b.Patients.GetByID(ctx, p.ID)
+			return wantNotFound("GetByID after Update", err)
+		}},
+		{"patient.update_other_org_is_not_found", func(ctx context.Context, b *Backend, f *Fixture) error {
+			p, err := b.Patients.Create(ctx, newPatient(f, f.Token(), time.Time{}))
+			if err != nil {
+				return fmt.Errorf("Create: %w", err)
+			}
+			_, err = b.Patients.Update(tenancy.WithOrg(ctx, otherOrg), p.ID, p)
+			return wantNotFound("Update", err)
+		}},
+		{"patient.update_keeps_created_at_and_org", func(ctx context.Context, b *Backend, f *Fixture) error {
+			created := f.Times(1)[0].Add(-time.Hour)
+			p, err := b.Patients.Create(ctx, newPatient(f, f.Token(), created))
+			if err != nil {
+				return fmt.Errorf("Create: %w", err)
+			}
+			edit := p
+			edit.Name = "Renamed " + f.Token()
+			edit.State = "OR"
+			edit.CreatedAt = time.Time{}
+			edit.OrgID = otherOrg
+			if _, err := b.Patients.Update(ctx, p.ID, edit); err != nil {
+				return fmt.Errorf("Update: %w", err)
+			}
+			stored, err := b.Patients.GetByID(ctx, p.ID)
+			if err != nil {
+				return fmt.Errorf("GetByID: %w", err)
+			}
+			switch {
+			case stored.Name != edit.Name || stored.State != edit.State:
+				return fmt.Errorf("edit not stored: name %q, state %q", stored.Name, stored.State)
+			case !stored.CreatedAt.Equal(created):
+				return fmt.Errorf("created_at changed from %v to %v", created, stored.CreatedAt)
+			case stored.OrgID != p.OrgID:
+				return fmt.Errorf("org_id changed from %q to %q", p.OrgID, stored.OrgID)
+			}
+			return nil
+		}},
+		{"patient.list_newest_first_paged", func(ctx context.Context, b *Backend, f *Fixture) error {
+			token, want, err := createPatients(ctx, b, f)
+			if err != nil {
+				return err
+			}
+			var got []string
+			for offset := 0; offset < len(want); offset += 2 {
+				page, err := b.Patients.List(ctx, request.PatientListQueryRequest{PatientName: token, Limit: 2, Offset: offset})
+				if err != nil {
+					return fmt.Errorf("List offset %d: %w", offset, err)
+				}
+				got = append(got, idsOf(page, patientID)...)
+			}
+			return wantIDs("List pages", got, want)
+		}},
+		{"patient.list_zero_limit_returns_all", func(ctx context.Context, b *Backend, f *Fixture) error {
+			token, want, err := createPatients(ctx, b, f)
+			if err != nil {
+				return err
+			}
+			all, err := b.Patients.List(ctx, request.PatientListQueryRequest{PatientName: token})
+			if err != nil {
+				return fmt.Errorf("List: %w", err)
+			}
+			return wantIDs("List", idsOf(all, patientID), want)
+		}},
+		{"patient.list_offset_past_end_is_empty", func(ctx context.Context, b *Backend, f *Fixture) error {
+			token, want, err := createPatients(ctx, b, f)
+			if err != nil {
+				return err
+			}
+			page, err := b.Patients.List(ctx, request.PatientListQueryRequest{PatientName: token, Limit: 2, Offset: len(want)})
+			if err != nil {
+				return fmt.Errorf("List: %w", err)
+			}
+			return wantIDs("List", idsOf(page, patientID), nil)
+		}},
+		{"patient.stream_oldest_first", func(ctx context.Context, b *Backend, f *Fixture) error {
+			token, want, err := createPatients(ctx, b, f)
+			if err != nil {
+				return err
+			}
+			var got []string
+			err = b.Patients.Stream(ctx, request.PatientListQueryRequest{PatientName: token}, func(p patientModel.Patient) error {
+				got = append(got, p.ID)
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("Stream: %w", err)
+			}
+			// Oldest first, ties still in ID order
+			return wantIDs("Stream", got, []string{want[4], want[3], want[2], want[0], want[1]})
+		}},
+		{"patient.duplicate_candidates_match_phone_digits", func(ctx context.Context, b *Backend, f *Fixture) error {
+			p, err := b.Patients.Create(ctx, newPatient(f, f.Token(), time.Now()))
+			if err != nil {
+				return fmt.Errorf("Create: %w", err)
+			}
+			if _, err := b.Patients.Create(ctx, newPatient(f, f.Token(), time.Now())); err != nil {
+				return fmt.Errorf("Create: %w", err)
+			}
+			candidates, err := b.Patients.FindDuplicateCandidates(ctx, patientModel.NormalizePhone(p.Phone), dates.PartialDate{}, 10)
+			if err != nil {
+				return fmt.Errorf("FindDuplicateCandidates: %w", err)
+			}
+			return wantIDs("FindDuplicateCandidates", idsOf(candidates, patientID), []string{p.ID})
+		}},
+
+		{"address.get_unknown_is_empty", func(ctx context.Context, b *Backend, f *Fixture) error {
+			// Both implementations answer an unknown address with an empty one, not an error
+			addr, err := b.Addresses.GetByID(ctx, f.ID("P"), f.ID("A"))
+			if err != nil || addr.ID != "" {
+				return fmt.Errorf("GetByID: want an empty address, got %+v, %v", addr, err)
+			}
+			return nil
+		}},
+		{"address.upsert_sets_patient_id_and_replaces", func(ctx context.Context, b *Backend, f *Fixture) error {
+			patient := f.ID("P")
+			addr := patientModel.Address{ID: f.ID("A"), PatientID: "someone-else", Line1: "1 First St", City: "Salem", State: "OR", Zip: "97301"}
+			if _, err := b.Addresses.Upsert(ctx, patient, addr); err != nil {
+				return fmt.Errorf("Upsert: %w", err)
+			}
+			addr.Line1 = "2 Second St"
+			if _, err := b.Addresses.Upsert(ctx, patient, addr); err != nil {
+				return fmt.Errorf("Upsert again: %w", err)
+			}
+			stored, err := b.Addresses.GetByID(ctx, patient, addr.ID)
+			if err != nil {
+				return fmt.Errorf("GetByID: %w", err)
+			}
+			if stored.PatientID != patient || stored.Line1 != addr.Line1 {
+				return fmt.Errorf("stored patient %q and line1 %q, want %q and %q", stored.PatientID, stored.Line1, patient, addr.Line1)
+			}
+			return nil
+		}},
+		{"address.id_of_another_patient_is_duplicate", func(ctx context.Context, b *Backend, f *Fixture) error {
+			addr := patientModel.Address{ID: f.ID("A"), Line1: "1 First St", City: "Salem", State: "OR", Zip: "97301"}
+			if _, err := b.Addresses.Upsert(ctx, f.ID("P"), addr); err != nil {
+				return fmt.Errorf("Upsert: %w", err)
+			}
+			_, err := b.Addresses.Upsert(ctx, f.ID("P"), addr)
+			return wantDuplicate("Upsert", err)
+		}},
+		{"address.list_sorted_by_id", func(ctx context.Context, b *Backend, f *Fixture) error {
+			patient := f.ID("P")
+			base := f.ID("A")
+			want := []string{base + "-a", base + "-b", base + "-c"}
+			for _, id := range []string{want[2], want[0], want[1]} {
+				addr := patientModel.Address{ID: id, Line1: "1 First St", City: "Salem", State: "OR", Zip: "97301"}
+				if _, err := b.Addresses.Upsert(ctx, patient, addr); err != nil {
+					return fmt.Errorf("Upsert %s: %w", id, err)
+				}
+			}
+			addresses, err := b.Addresses.ListByPatientID(ctx, patient)
+			if err != nil {
+				return fmt.Errorf("ListByPatientID: %w", err)
+			}
+			return wantIDs("ListByPatientID", idsOf(addresses, func(a patientModel.Address) string { return a.ID }), want)
+		}},
+		{"address.delete_unknown_is_not_found", func(ctx context.Context, b *Backend, f *Fixture) error {
+			return wantNotFound("Delete", b.Addresses.Delete(ctx, f.ID("P"), f.ID("A")))
+		}},
+
+		{"prescription.get_unknown_is_not_found", func(ctx context.Context, b *Backend, f *Fixture) error {
+			_, err := b.Prescriptions.GetByID(ctx, f.ID("R"))
+			return wantNotFound("GetByID", err)
+		}},
+		{"prescription.create_sets_created_at", func(ctx context.Context, b *Backend, f *Fixture) error {
+			p, err := b.Prescriptions.Create(ctx, newPrescription(f, f.ID("P"), time.Time{}))
+			if err != nil {
+				return fmt.Errorf("Create: %w", err)
+			}
+			stored, err := b.Prescriptions.GetByID(ctx, p.ID)
+			if err != nil {
+				return fmt.Errorf("GetByID: %w", err)
+			}
+			if p.CreatedAt.IsZero() || stored.CreatedAt.IsZero() {
+				return fmt.Errorf("created_at not set: returned %v, stored %v", p.CreatedAt, stored.CreatedAt)
+			}
+			return nil
+		}},
+		{"prescription.create_duplicate_id_fails", func(ctx context.Context, b *Backend, f *Fixture) error {
+			p, err := b.Prescriptions.Create(ctx, newPrescription(f, f.ID("P"), time.Time{}))
+			if err != nil {
+				return fmt.Errorf("Create: %w", err)
+			}
+			again := newPrescription(f, p.PatientID, time.Time{})
+			again.ID = p.ID
+			// IDs are unique across organizations
+			_, err = b.Prescriptions.Create(tenancy.WithOrg(ctx, otherOrg), again)
+			return wantDuplicate("Create", err)
+		}},
+		{"prescription.update_unknown_is_not_found", func(ctx context.Context, b *Backend, f *Fixture) error {
+			p := newPrescription(f, f.ID("P"), time.Time{})
+			_, err := b.Prescriptions.Update(ctx, p.ID, p)
+			if err := wantNotFound("Update", err); err != nil {
+				return err
+			}
+			_, err = b.Prescriptions.GetByID(ctx, p.ID)
+			return wantNotFound("GetByID after Update", err)
+		}},
+		{"prescription.update_writes_clinical_fields_only", func(ctx context.Context, b *Backend, f *Fixture) error {
+			created := f.Times(1)[0].Add(-time.Hour)
+			p, err := b.Prescriptions.Create(ctx, newPrescription(f, f.ID("P"), created))
+			if err != nil {
+				return fmt.Errorf("Create: %w", err)
+			}
+			if err := b.Prescriptions.UpdateFulfillmentStatus(ctx, p.ID, rx.FulfillmentSent, created); err != nil {
+				return fmt.Errorf("UpdateFulfillmentStatus: %w", err)
+			}
+			edit := p
+			edit.Dose = "250mg"
+			edit.Dosage = &rx.Dose{Value: 250, Unit: rx.StrengthMilligram}
+			edit.Status = rx.Paused
+			edit.PrescriberID = "someone-else"
+			edit.CreatedAt = time.Time{}
+			if _, err := b.Prescriptions.Update(ctx, p.ID, edit); err != nil {
+				return fmt.Errorf("Update: %w", err)
+			}
+			stored, err := b.Prescriptions.GetByID(ctx, p.ID)
+			if err != nil {
+				return fmt.Errorf("GetByID: %w", err)
+			}
+			switch {
+			case stored.Dose != edit.Dose || stored.Status != edit.Status:
+				return fmt.Errorf("edit not stored: dose %q, status %q", stored.Dose, stored.Status)
+			case stored.Dosage == nil || stored.Dosage.Value != 250:
+				return fmt.Errorf("dosage not stored: %+v", stored.Dosage)
+			case stored.PrescriberID != p.PrescriberID:
+				return fmt.Errorf("prescriber changed from %q to %q", p.PrescriberID, stored.PrescriberID)
+			case stored.FulfillmentStatus != rx.FulfillmentSent:
+				return fmt.Errorf("fulfillment status changed to %q", stored.FulfillmentStatus)
+			case !stored.CreatedAt.Equal(created):
+				return fmt.Errorf("created_at changed from %v to %v", created, stored.CreatedAt)
+			}
+			return nil
+		}},
+		{"prescription.list_newest_first_paged", func(ctx context.Context, b *Backend, f *Fixture) error {
+			// The memory repository holds sample prescriptions, so pages are checked against the
+			// whole list rather than against known IDs
+			if _, _, err := createPrescriptions(ctx, b, f); err != nil {
+				return err
+			}
+			all, err := b.Prescriptions.List(ctx, string(rx.Draft), 0, 0)
+			if err != nil {
+				return fmt.Errorf("List: %w", err)
+			}
+			if len(all) < 5 {
+				return fmt.Errorf("List: got %d prescriptions, want at least 5", len(all))
+			}
+			for i := 1; i < len(all); i++ {
+				if newerFirst(all[i], all[i-1]) {
+					return fmt.Errorf("List: %s (%v) after %s (%v)", all[i].ID, all[i].CreatedAt, all[i-1].ID, all[i-1].CreatedAt)
+				}
+			}
+			var got []string
+			for offset := 0; offset < len(all); offset += 3 {
+				page, err := b.Prescriptions.List(ctx, string(rx.Draft), 3, offset)
+				if err != nil {
+					return fmt.Errorf("List offset %d: %w", offset, err)
+				}
+				got = append(got, idsOf(page, prescriptionID)...)
+			}
+			return wantIDs("List pages", got, idsOf(all, prescriptionID))
+		}},
+		{"prescription.list_by_patient_newest_first", func(ctx context.Context, b *Backend, f *Fixture) error {
+			patient, want, err := createPrescriptions(ctx, b, f)
+			if err != nil {
+				return err
+			}
+			prescriptions, err := b.Prescriptions.ListByPatientID(ctx, patient)
+			if err != nil {
+				return fmt.Errorf("ListByPatientID: %w", err)
+			}
+			return wantIDs("ListByPatientID", idsOf(prescriptions, prescriptionID), want)
+		}},
+		{"prescription.list_for_patient_by_status", func(ctx context.Context, b *Backend, f *Fixture) error {
+			patient, drafts, err := createPrescriptions(ctx, b, f)
+			if err != nil {
+				return err
+			}
+			times := f.Times(2)
+			var active []string
+			for _, at := range []time.Time{times[1], times[0]} {
+				p := newPrescription(f, patient, at)
+				p.Status = rx.Active
+				created, err := b.Prescriptions.Create(ctx, p)
+				if err != nil {
+					return fmt.Errorf("Create: %w", err)
+				}
+				active = append([]string{created.ID}, active...)
+			}
+
+			for _, tc := range []struct {
+				status rx.Status
+				want   []string
+			}{{rx.Active, active}, {rx.Draft, drafts}, {rx.Completed, nil}} {
+				prescriptions, err := b.Prescriptions.ListForPatient(ctx, patient, tc.status, 0)
+				if err != nil {
+					return fmt.Errorf("ListForPatient %s: %w", tc.status, err)
+				}
+				if err := wantIDs("ListForPatient "+string(tc.status), idsOf(prescriptions, prescriptionID), tc.want); err != nil {
+					return err
+				}
+			}
+			all, err := b.Prescriptions.ListForPatient(ctx, patient, "", 0)
+			if err != nil {
+				return fmt.Errorf("ListForPatient: %w", err)
+			}
+			if len(all) != len(active)+len(drafts) {
+				return fmt.Errorf("ListForPatient without a status: got %d prescriptions, want %d", len(all), len(active)+len(drafts))
+			}
+			return nil
+		}},
+		{"prescription.list_for_patient_limit", func(ctx context.Context, b *Backend, f *Fixture) error {
+			// Two prescriptions share a creation time, so the limit also checks the tie order
+			patient, want, err := createPrescriptions(ctx, b, f)
+			if err != nil {
+				return err
+			}
+			for _, limit := range []int{1, 3, len(want), 0} {
+				prescriptions, err := b.Prescriptions.ListForPatient(ctx, patient, rx.Draft, limit)
+				if err != nil {
+					return fmt.Errorf("ListForPatient limit %d: %w", limit, err)
+				}
+				expected := want
+				if limit > 0 {
+					expected = want[:limit]
+				}
+				if err := wantIDs(fmt.Sprintf("ListForPatient limit %d", limit), idsOf(prescriptions, prescriptionID), expected); err != nil {
+					return err
+				}
+			}
+			return nil
+		}},
+		{"prescription.update_status_unknown_is_not_found", func(ctx context.Context, b *Backend, f *Fixture) error {
+			err := b.Prescriptions.UpdateFulfillmentStatus(ctx, f.ID("R"), rx.FulfillmentSent, time.Now())
+			return wantNotFound("UpdateFulfillmentStatus", err)
+		}},
+		{"measurement.other_org_patient_is_not_found", func(ctx context.Context, b *Backend, f *Fixture) error {
+			svc, patient, err := patientRecordsSetup(ctx, b, f)
+			if err != nil {
+				return err
+			}
+			m, err := b.Measurements.Create(ctx, patientModel.Measurement{ID: f.ID("M"), PatientID: patient, Type: patientModel.MeasurementWeight, Value: 70, Unit: "kg", RecordedAt: f.Times(1)[0]})
+			if err != nil {
+				return fmt.Errorf("Create: %w", err)
+			}
+			return wantHidden(ctx,
+				func(ctx context.Context) error { _, err := svc.Measurements.List(ctx, patient, "", 0); return err },
+				func(ctx context.Context) error { _, err := svc.Measurements.GetByID(ctx, patient, m.ID); return err })
+		}},
+		{"allergy.other_org_patient_is_not_found", func(ctx context.Context, b *Backend, f *Fixture) error {
+			svc, patient, err := patientRecordsSetup(ctx, b, f)
+			if err != nil {
+				return err
+			}
+			a, err := b.Allergies.Create(ctx, patientModel.Allergy{ID: f.ID("A"), PatientID: patient, Substance: "Penicillin", Severity: patientModel.AllergyMild, RecordedAt: f.Times(1)[0]})
+			if err != nil {
+				return fmt.Errorf("Create: %w", err)
+			}
+			return wantHidden(ctx,
+				func(ctx context.Context) error { _, err := svc.Allergies.List(ctx, patient); return err },
+				func(ctx context.Context) error { _, err := svc.Allergies.GetByID(ctx, patient, a.ID); return err })
+		}},
+		{"insurance.other_org_patient_is_not_found", func(ctx context.Context, b *Backend, f *Fixture) error {
+			svc, patient, err := patientRecordsSetup(ctx, b, f)
+			if err != nil {
+				return err
+			}
+			r, err := b.Insurance.Create(ctx, patientModel.InsuranceRecord{ID: f.ID("I"), PatientID: patient, PayerName: "Conformance Health", MemberID: f.Token(), Status: patientModel.InsuranceConfirmed})
+			if err != nil {
+				return fmt.Errorf("Create: %w", err)
+			}
+			return wantHidden(ctx,
+				func(ctx context.Context) error { _, err := svc.Insurance.List(ctx, patient); return err },
+				func(ctx context.Context) error { _, err := svc.Insurance.GetByID(ctx, patient, r.ID); return err })
+		}},
+		{"dispense.other_org_prescription_is_not_found", func(ctx context.Context, b *Backend, f *Fixture) error {
+			svc, prescription, err := prescriptionRecordsSetup(ctx, b, f)
+			if err != nil {
+				return err
+			}
+			d, err := b.Dispenses.Create(ctx, rx.DispenseRecord{ID: f.ID("D"), Kind: rx.DispenseKindDispense, PrescriptionID: prescription.ID, PatientID: prescription.PatientID, Drug: prescription.Drug, Dose: prescription.Dose, DispensedAt: f.Times(1)[0]})
+			if err != nil {
+				return fmt.Errorf("Create: %w", err)
+			}
+			return wantHidden(ctx,
+				func(ctx context.Context) error {
+					_, err := svc.Dispenses.ListByPrescription(ctx, prescription.ID)
+					return err
+				},
+				func(ctx context.Context) error {
+					_, err := svc.Dispenses.GetByID(ctx, prescription.ID, d.ID)
+					return err
+				})
+		}},
+		{"transmission.other_org_prescription_is_not_found", func(ctx context.Context, b *Backend, f *Fixture) error {
+			svc, prescription, err := prescriptionRecordsSetup(ctx, b, f)
+			if err != nil {
+				return err
+			}
+			at := f.Times(1)[0]
+			t, err := b.Transmissions.Create(ctx, eprescribingModel.PrescriptionTransmission{ID: f.ID("T"), PrescriptionID: prescription.ID, PatientID: prescription.PatientID, PharmacyID: "cf-pharmacy", Status: eprescribingModel.Queued, CreatedAt: at, UpdatedAt: at})
+			if err != nil {
+				return fmt.Errorf("Create: %w", err)
+			}
+			return wantHidden(ctx,
+				func(ctx context.Context) error {
+					_, err := svc.Transmissions.ListForPrescription(ctx, prescription.ID)
+					return err
+				},
+				func(ctx context.Context) error { _, err := svc.Transmissions.GetByID(ctx, t.ID, false); return err })
+		}},
+	}
+}
+
+// patientRecordsSetup returns the services and a new patient to record measurements,
+// allergies or insurance of
+func patientRecordsSetup(ctx context.Context, b *Backend, f *Fixture) (*Services, string, error) {
+	svc, err := b.Services()
+	if err != nil {
+		return nil, "", fmt.Errorf("Services: %w", err)
+	}
+	p, err := b.Patients.Create(ctx, newPatient(f, f.Token(), time.Time{}))
+	if err != nil {
+		return nil, "", fmt.Errorf("Create patient: %w", err)
+	}
+	return svc, p.ID, nil
+}
+
+// prescriptionRecordsSetup returns the services and a new prescription to record dispenses or
+// transmissions of
+func prescriptionRecordsSetup(ctx context.Context, b *Backend, f *Fixture) (*Services, rx.Prescription, error) {
+	svc, err := b.Services()
+	if err != nil {
+		return nil, rx.Prescription{}, fmt.Errorf("Services: %w", err)
+	}
+	p, err := b.Prescriptions.Create(ctx, newPrescription(f, f.ID("P"), time.Time{}))
+	if err != nil {
+		return nil, rx.Prescription{}, fmt.Errorf("Create prescription: %w", err)
+	}
+	return svc, p, nil
+}
+
+// wantHidden checks that a record of a patient or prescription is listed and read in the
+// context it was created in, and not found through another organization
+func wantHidden(ctx context.Context, list, get func(ctx context.Context) error) error {
+	if err := list(ctx); err != nil {
+		return fmt.Errorf("List: %w", err)
+	}
+	if err := get(ctx); err != nil {
+		return fmt.Errorf("GetByID: %w", err)
+	}
+	other := tenancy.WithOrg(ctx, otherOrg)
+	if err := wantNotFound("List by another organization", list(other)); err != nil {
+		return err
+	}
+	return wantNotFound("GetByID by another organization", get(other))
+}
+
+func newPatient(f *Fixture, token string, createdAt time.Time) patientModel.Patient {
+	return patientModel.Patient{
+		ID:        f.ID("P"),
+		Name:      "Conformance " + token,
+		Phone:     f.Phone(),
+		State:     "WA",
+		DOB:       dates.Full(1980, time.June, 1),
+		CreatedAt: createdAt,
+	}
+}
+
+// createPatients creates five patients sharing a name token, two of them created at the same
+// time, and returns the token and their IDs newest first
+func createPatients(ctx context.Context, b *Backend, f *Fixture) (string, []string, error) {
+	token := f.Token()
+	times := f.Times(4)
+	created := []time.Time{times[3], times[0], times[2], times[0], times[1]}
+	ids := make([]string, len(created))
+	for i, at := range created {
+		p, err := b.Patients.Create(ctx, newPatient(f, token, at))
+		if err != nil {
+			return "", nil, fmt.Errorf("Create: %w", err)
+		}
+		ids[i] = p.ID
+	}
+	// Newest first, ties in ID order (ids[1] < ids[3] as IDs are numbered in order)
+	return token, []string{ids[1], ids[3], ids[4], ids[2], ids[0]}, nil
+}
+
+func patientID(p patientModel.Patient) string { return p.ID }
+
+func newPrescription(f *Fixture, patientID string, createdAt time.Time) rx.Prescription {
+	return rx.Prescription{
+		ID:           f.ID("R"),
+		PatientID:    patientID,
+		Drug:         "Amoxicillin",
+		Dose:         "500mg",
+		Dosage:       &rx.Dose{Value: 500, Unit: rx.StrengthMilligram},
+		Status:       rx.Draft,
+		PrescriberID: "cf-prescriber",
+		CreatedAt:    createdAt,
+	}
+}
+
+// createPrescriptions creates five draft prescriptions of a new patient, two of them created at
+// the same time, and returns the patient and their IDs newest first
+func createPrescriptions(ctx context.Context, b *Backend, f *Fixture) (string, []string, error) {
+	patient := f.ID("P")
+	times := f.Times(4)
+	created := []time.Time{times[3], times[0], times[2], times[0], times[1]}
+	ids := make([]string, len(created))
+	for i, at := range created {
+		p, err := b.Prescriptions.Create(ctx, newPrescription(f, patient, at))
+		if err != nil {
+			return "", nil, fmt.Errorf("Create: %w", err)
+		}
+		ids[i] = p.ID
+	}
+	return patient, []string{ids[1], ids[3], ids[4], ids[2], ids[0]}, nil
+}
+
+func prescriptionID(p rx.Prescription) string { return p.ID }
+
+// newerFirst reports whether a sorts before b in newest-first lists
+func newerFirst(a, b rx.Prescription) bool {
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		return a.CreatedAt.After(b.CreatedAt)
+	}
+	return a.ID < b.ID
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// Behavior is one thing every implementation of a repository must do alike
+type Behavior struct {
+	Name  string // repository.behavior, e.g. patient.get_unknown_is_not_found
+	Check func(ctx context.Context, b *Backend, f *Fixture) error
+}
+
+// Fixture makes the IDs and names of one run unique, so behaviors never meet the sample data of
+// the memory repositories, each other or an earlier run
+type Fixture struct {
+	run  string
+	next int
+}
+
+func newFixture() *Fixture {
+	return &Fixture{run: strconv.FormatInt(time.Now().UnixNano(), 36)}
+}
+
+// ID returns a new ID, e.g. "cf-P-lx3k9z1a2b-0004"; later IDs sort after earlier ones
+func (f *Fixture) ID(prefix string) string {
+	f.next++
+	return fmt.Sprintf("cf-%s-%s-%04d", prefix, f.run, f.next)
+}
+
+// Token returns a new word for names, so a list filtered by it holds only one behavior's records
+func (f *Fixture) Token() string {
+	f.next++
+	return fmt.Sprintf("cf%s%d", f.run, f.next)
+}
+
+// Phone returns a new valid phone number, as phone numbers are unique in MongoDB
+func (f *Fixture) Phone() string {
+	f.next++
+	n, _ := strconv.ParseInt(f.run, 36, 64)
+	return fmt.Sprintf("+1%010d", (n/1000+int64(f.next))%10_000_000_000)
+}
+
+// Times returns n creation times a minute apart, newest first, at the millisecond precision
+// MongoDB stores
+func (f *Fixture) Times(n int) []time.Time {
+	now := time.Now().Truncate(time.Millisecond)
+	times := make([]time.Time, n)
+	for i := range times {
+		times[i] = now.Add(-time.Duration(i) * time.Minute)
+	}
+	return times
+}
+
+const checkTimeout = 10 * time.Second
+
+// Check runs the behaviors (optionally filtered by name) against a backend, prints what broke
+// and returns the number of failed behaviors
+func Check(ctx context.Context, b *Backend, behaviors []Behavior, filter *regexp.Regexp) int {
+	fmt.Printf("\n🗄️  %s\n", b.Name)
+
+	f := newFixture()
+	var passed, failed int
+	for _, behavior := range behaviors {
+		if filter != nil && !filter.MatchString(behavior.Name) {
+			continue
+		}
+
+		checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+		err := behavior.Check(checkCtx, b, f)
+		cancel()
+		if err == nil {
+			passed++
+			fmt.Printf("   ✅ %s\n", behavior.Name)
+			continue
+		}
+		failed++
+		fmt.Printf("   ❌ %s\n      - %v\n", behavior.Name, err)
+	}
+
+	fmt.Printf("📊 %s: %d passed, %d failed\n", b.Name, passed, failed)
+	return failed
+}
+
+func wantNotFound(operation string, err error) error {
+	if !platformErrors.IsNotFoundError(err) {
+		return fmt.Errorf("%s: want a not-found error, got %v", operation, err)
+	}
+	return nil
+}
+
+func wantDuplicate(operation string, err error) error {
+	if !platformErrors.IsDuplicateError(err) {
+		return fmt.Errorf("%s: want a duplicate error, got %v", operation, err)
+	}
+	return nil
+}
+
+// wantIDs checks that a list holds exactly the IDs, in order
+func wantIDs(operation string, got, want []string) error {
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		return fmt.Errorf("%s: got %v, want %v", operation, got, want)
+	}
+	return nil
+}
+
+// idsOf lists the IDs of records
+func idsOf[T any](records []T, id func(T) string) []string {
+	ids := make([]string, len(records))
+	for i, record := range records {
+		ids[i] = id(record)
+	}
+	return ids
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+)
+
+// Repository conformance suite.
+//
+// Runs one table of behaviors (not-found semantics, duplicate keys, pagination, sorting) against
+// every implementation of the patient, address and prescription repositories, so the in-memory
+// repositories used for local development behave like the MongoDB ones. The memory repositories
+// are always checked; MongoDB is checked too when a URI is given, in a scratch database that is
+// dropped afterwards. The records of a patient or a prescription carry no organization, so the
+// services reading them are checked to hide them from other organizations.
+//
+// Usage:
+//
+//	go run ./cmd/conformance                            # memory repositories only
+//	go run ./cmd/conformance -mongo-uri mongodb://...   # memory and MongoDB
+//	go run ./cmd/conformance -run 'patient\..*paged'    # only behaviors whose name matches
+func main() {
+	mongoURI := flag.String("mongo-uri", os.Getenv("RX_DATABASE_MONGODB_URI"), "MongoDB URI (when empty, only the memory repositories are checked)")
+	database := flag.String("database", "rx_conformance", "scratch MongoDB database; it must be empty and is dropped afterwards")
+	runFilter := flag.String("run", "", "only check behaviors whose name matches this regular expression")
+	verbose := flag.Bool("v", false, "print the repository logs")
+	flag.Parse()
+
+	var filter *regexp.Regexp
+	if *runFilter != "" {
+		var err error
+		if filter, err = regexp.Compile(*runFilter); err != nil {
+			log.Fatalf("❌ Invalid -run expression: %v", err)
+		}
+	}
+
+	fmt.Println("🧪 Checking repository conformance...")
+
+	ctx := context.Background()
+	backends := []*Backend{MemoryBackend()}
+	if *mongoURI != "" {
+		backend, err := MongoBackend(ctx, *mongoURI, *database, *verbose)
+		if err != nil {
+			log.Fatalf("❌ Failed to prepare MongoDB: %v", err)
+		}
+		backends = append(backends, backend)
+	} else {
+		fmt.Println("   ⏭️  MongoDB skipped: set -mongo-uri or RX_DATABASE_MONGODB_URI to check it")
+	}
+
+	failures := 0
+	for _, backend := range backends {
+		failures += Check(ctx, backend, Behaviors(), filter)
+		backend.Close(ctx)
+	}
+	if failures > 0 {
+		os.Exit(1)
+	}
+}
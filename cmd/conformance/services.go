@@ -0,0 +1,45 @@
+package main
+
+import (
+	"go.uber.org/zap"
+
+	eprescribingService "pharmacy-modernization-project-model/domain/eprescribing/service"
+	patientService "pharmacy-modernization-project-model/domain/patient/service"
+	prescriptionService "pharmacy-modernization-project-model/domain/prescription/service"
+	"pharmacy-modernization-project-model/internal/platform/cache"
+	"pharmacy-modernization-project-model/internal/platform/events"
+)
+
+// Services are the services over a backend's repositories that scope the records of a patient
+// or a prescription by reading their parent first; the dependencies the checks do not reach are nil
+type Services struct {
+	Measurements  patientService.MeasurementService
+	Allergies     patientService.AllergyService
+	Insurance     patientService.InsuranceService
+	Prescriptions prescriptionService.PrescriptionService
+	Dispenses     prescriptionService.DispenseService
+	Transmissions eprescribingService.TransmissionService
+}
+
+// Services returns the services over the backend's repositories, built on first use
+func (b *Backend) Services() (*Services, error) {
+	if b.services != nil {
+		return b.services, nil
+	}
+	logger := zap.NewNop()
+	c, err := cache.NewMemoryCache(cache.MemoryConfig{MaxCost: 1 << 20, BufferItems: 64}, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	prescriptions := prescriptionService.New(b.Prescriptions, nil, nil, nil, c, nil, nil, logger, nil, nil, nil, events.Discard)
+	b.services = &Services{
+		Measurements:  patientService.NewMeasurementService(b.Measurements, b.Patients, logger),
+		Allergies:     patientService.NewAllergyService(b.Allergies, b.Patients, logger),
+		Insurance:     patientService.NewInsuranceService(b.Insurance, b.Patients, nil, nil, patientService.InsuranceIntakeConfig{}, logger),
+		Prescriptions: prescriptions,
+		Dispenses:     prescriptionService.NewDispenseService(b.Dispenses, nil, nil, prescriptions, nil, logger),
+		Transmissions: eprescribingService.NewTransmissionService(b.Transmissions, nil, nil, nil, prescriptions, nil, eprescribingService.Config{}, logger),
+	}
+	return b.services, nil
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Contract is one IRIS endpoint as the client calls it and the mock serves it
+type Contract struct {
+	Name  string // service.endpoint, e.g. billing.create_invoice
+	Route string // The mock route every call must reach, e.g. "POST /billing/v1/invoices"
+	Auth  bool   // Calls carry the Stargate bearer token and the global IRIS headers
+	// Headers each call must send, with the value it must start with; "" only requires the header
+	Headers  map[string]string
+	Request  *Schema // JSON request body; nil when there is none
+	Response *Schema // JSON body of successful responses; nil when there is none
+
+	// Call makes the calls through the real client and checks what it returned
+	Call func(ctx context.Context, h *Harness) error
+	// Verify checks the recorded calls further, e.g. that a retry reuses its idempotency key
+	Verify func(calls []Exchange) error
+}
+
+// authHeaders are sent on every IRIS call by the shared client
+var authHeaders = map[string]string{
+	"Authorization":  "Bearer ",
+	"X-IRIS-User-ID": "",
+}
+
+const callTimeout = 10 * time.Second
+
+// Check runs the contracts (optionally filtered by name), prints what broke and returns the
+// number of failed contracts
+func Check(ctx context.Context, h *Harness, contracts []Contract, filter *regexp.Regexp) int {
+	var passed, failed int
+	for _, contract := range contracts {
+		if filter != nil && !filter.MatchString(contract.Name) {
+			continue
+		}
+
+		problems := check(ctx, h, contract)
+		if len(problems) == 0 {
+			passed++
+			fmt.Printf("   ✅ %s\n", contract.Name)
+			continue
+		}
+		failed++
+		fmt.Printf("   ❌ %s\n", contract.Name)
+		for _, problem := range problems {
+			fmt.Printf("      - %s\n", problem)
+		}
+	}
+
+	fmt.Printf("\n📊 %d passed, %d failed\n", passed, failed)
+	return failed
+}
+
+// check runs one contract and lists its problems
+func check(ctx context.Context, h *Harness, contract Contract) []string {
+	var problems []string
+	if contract.Request != nil {
+		problems = append(problems, prefixed("request schema", contract.Request.Drift("client"))...)
+	}
+	if contract.Response != nil {
+		problems = append(problems, prefixed("response schema", contract.Response.Drift("mock"))...)
+	}
+
+	h.recorder.Take()
+	callCtx, cancel := context.WithTimeout(ctx, callTimeout)
+	err := contract.Call(callCtx, h)
+	cancel()
+	if err != nil {
+		problems = append(problems, "call: "+err.Error())
+	}
+
+	var calls []Exchange
+	for _, exchange := range h.recorder.Take() {
+		if exchange.Method+" "+exchange.Route == contract.Route {
+			calls = append(calls, exchange)
+		}
+	}
+	if len(calls) == 0 {
+		return append(problems, "the client never reached "+contract.Route)
+	}
+
+	for i, call := range calls {
+		label := fmt.Sprintf("call %d", i+1)
+		if contract.Auth {
+			problems = append(problems, prefixed(label, missingHeaders(call, authHeaders))...)
+		}
+		problems = append(problems, prefixed(label, missingHeaders(call, contract.Headers))...)
+		if contract.Request != nil {
+			if err := decodeStrict(call.Body, contract.Request.Mock); err != nil {
+				problems = append(problems, fmt.Sprintf("%s: the mock cannot read the request: %v", label, err))
+			}
+		}
+		if contract.Response != nil && call.Status < 300 {
+			if err := decodeStrict(call.Response, contract.Response.Client); err != nil {
+				problems = append(problems, fmt.Sprintf("%s: the client cannot read the response: %v", label, err))
+			}
+		}
+	}
+	if contract.Verify != nil {
+		if err := contract.Verify(calls); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+	return problems
+}
+
+// missingHeaders lists the headers a call lacks or sends with another value
+func missingHeaders(call Exchange, headers map[string]string) []string {
+	var problems []string
+	for name, prefix := range headers {
+		value := call.Header.Get(name)
+		switch {
+		case value == "":
+			problems = append(problems, "missing header "+name)
+		case !strings.HasPrefix(value, prefix):
+			problems = append(problems, fmt.Sprintf("header %s is %q, want %q...", name, value, prefix))
+		}
+	}
+	sort.Strings(problems)
+	return problems
+}
+
+func prefixed(prefix string, problems []string) []string {
+	for i, problem := range problems {
+		problems[i] = prefix + ": " + problem
+	}
+	return problems
+}
@@ -0,0 +1,377 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	cardocr "pharmacy-modernization-project-model/internal/integrations/card_ocr"
+	irisbilling "pharmacy-modernization-project-model/internal/integrations/iris_billing"
+	irismock "pharmacy-modernization-project-model/internal/integrations/iris_mock"
+	irispharmacy "pharmacy-modernization-project-model/internal/integrations/iris_pharmacy"
+	"pharmacy-modernization-project-model/internal/integrations/notifications"
+	"pharmacy-modernization-project-model/internal/integrations/stargate"
+)
+
+const jsonContentType = "application/json"
+
+// Contracts lists the IRIS endpoints the application calls
+func Contracts() []Contract {
+	return []Contract{
+		// Stargate auth
+		{
+			Name:     "stargate.token",
+			Route:    "POST /oauth/token",
+			Headers:  map[string]string{"Content-Type": jsonContentType},
+			Request:  &Schema{Client: stargate.TokenRequest{}, Mock: irismock.TokenRequest{}},
+			Response: &Schema{Client: stargate.TokenResponse{}, Mock: irismock.TokenResponse{}},
+			Call: func(ctx context.Context, h *Harness) error {
+				token, err := h.Stargate.GetAccessToken(ctx)
+				if err != nil {
+					return err
+				}
+				return expect("token type", token.TokenType, "Bearer")
+			},
+		},
+		{
+			Name:     "stargate.refresh",
+			Route:    "POST /oauth/refresh",
+			Headers:  map[string]string{"Content-Type": jsonContentType},
+			Response: &Schema{Client: stargate.TokenResponse{}, Mock: irismock.TokenResponse{}},
+			Call: func(ctx context.Context, h *Harness) error {
+				token, err := h.Stargate.RefreshToken(ctx, "contract-refresh-token")
+				if err != nil {
+					return err
+				}
+				return expect("refresh token", token.RefreshToken, "contract-refresh-token")
+			},
+		},
+
+		// Pharmacy
+		{
+			Name:     "pharmacy.get_prescription",
+			Route:    "GET /pharmacy/v1/prescriptions/{prescriptionID}",
+			Auth:     true,
+			Response: &Schema{Client: irispharmacy.PrescriptionResponse{}, Mock: irismock.PrescriptionResponse{}},
+			Call: func(ctx context.Context, h *Harness) error {
+				prescription, err := h.Clients.PharmacyClient.GetPrescription(ctx, "RX-CONTRACT-1")
+				if err != nil {
+					return err
+				}
+				return expect("prescription ID", prescription.ID, "RX-CONTRACT-1")
+			},
+		},
+		{
+			Name:     "pharmacy.search_pharmacies",
+			Route:    "GET /pharmacy/v1/pharmacies",
+			Auth:     true,
+			Response: &Schema{Client: irispharmacy.PharmacySearchResponse{}, Mock: irismock.PharmacySearchResponse{}},
+			Call: func(ctx context.Context, h *Harness) error {
+				result, err := h.Clients.PharmacyClient.SearchPharmacies(ctx, irispharmacy.PharmacySearchRequest{State: "WA", Limit: 2})
+				if err != nil {
+					return err
+				}
+				if len(result.Pharmacies) != 2 || result.Total < 2 {
+					return fmt.Errorf("got %d of %d pharmacies, want 2 of at least 2: the limit was not applied", len(result.Pharmacies), result.Total)
+				}
+				return nil
+			},
+		},
+		{
+			Name:     "pharmacy.get_pharmacy",
+			Route:    "GET /pharmacy/v1/pharmacies/{pharmacyID}",
+			Auth:     true,
+			Response: &Schema{Client: irispharmacy.PharmacyResponse{}, Mock: irismock.PharmacyResponse{}},
+			Call: func(ctx context.Context, h *Harness) error {
+				pharmacy, err := h.Clients.PharmacyClient.GetPharmacy(ctx, "PH-1001")
+				if err != nil {
+					return err
+				}
+				if err := expect("pharmacy ID", pharmacy.ID, "PH-1001"); err != nil {
+					return err
+				}
+				// The mock answers 404, which the client must report as ErrPharmacyNotFound
+				if _, err := h.Clients.PharmacyClient.GetPharmacy(ctx, "PH-UNKNOWN"); !errors.Is(err, irispharmacy.ErrPharmacyNotFound) {
+					return fmt.Errorf("unknown pharmacy: got %v, want %v", err, irispharmacy.ErrPharmacyNotFound)
+				}
+				return nil
+			},
+		},
+		{
+			Name:     "pharmacy.route_prescription",
+			Route:    "POST /pharmacy/v1/prescriptions/{prescriptionID}/route",
+			Auth:     true,
+			Headers:  map[string]string{"Content-Type": jsonContentType},
+			Request:  &Schema{Client: irispharmacy.RoutePrescriptionRequest{}, Mock: irismock.RoutePrescriptionRequest{}},
+			Response: &Schema{Client: irispharmacy.RoutePrescriptionResponse{}, Mock: irismock.RoutePrescriptionResponse{}},
+			Call: func(ctx context.Context, h *Harness) error {
+				req := irispharmacy.RoutePrescriptionRequest{PharmacyID: "PH-1001", PatientID: "P-CONTRACT", Drug: "Lisinopril", Dose: "10mg"}
+				routed, err := h.Clients.PharmacyClient.RoutePrescription(ctx, "RX-CONTRACT-2", req)
+				if err != nil {
+					return err
+				}
+				if err := expect("routed pharmacy", routed.PharmacyID, "PH-1001"); err != nil {
+					return err
+				}
+				// The mock answers 409, which the client must report as ErrPharmacyUnavailable
+				req.PharmacyID = "PH-1003"
+				if _, err := h.Clients.PharmacyClient.RoutePrescription(ctx, "RX-CONTRACT-2", req); !errors.Is(err, irispharmacy.ErrPharmacyUnavailable) {
+					return fmt.Errorf("pharmacy not accepting prescriptions: got %v, want %v", err, irispharmacy.ErrPharmacyUnavailable)
+				}
+				return nil
+			},
+		},
+		{
+			Name:    "pharmacy.send_script_message",
+			Route:   "POST /pharmacy/v1/script/messages",
+			Auth:    true,
+			Headers: map[string]string{"Content-Type": "application/xml"},
+			Call: func(ctx context.Context, h *Harness) error {
+				response, err := sendNewRx(ctx, h, "CONTRACT-MSG-1")
+				if err != nil {
+					return err
+				}
+				return expectScript(response, irispharmacy.ResponseStatus, irispharmacy.StatusReceived, "CONTRACT-MSG-1")
+			},
+		},
+		{
+			Name:  "pharmacy.get_message_status",
+			Route: "GET /pharmacy/v1/script/messages/{messageID}/status",
+			Auth:  true,
+			Call: func(ctx context.Context, h *Harness) error {
+				if _, err := sendNewRx(ctx, h, "CONTRACT-MSG-2"); err != nil {
+					return err
+				}
+				response, err := h.Clients.PharmacyClient.GetMessageStatus(ctx, "CONTRACT-MSG-2")
+				if err != nil {
+					return err
+				}
+				return expectScript(response, irispharmacy.ResponseVerify, irispharmacy.StatusAccepted, "CONTRACT-MSG-2")
+			},
+		},
+
+		// Billing
+		{
+			Name:     "billing.get_invoice",
+			Route:    "GET /billing/v1/invoices/{prescriptionID}",
+			Auth:     true,
+			Headers:  map[string]string{"X-IRIS-Env-Name": ""},
+			Response: &Schema{Client: irisbilling.InvoiceResponse{}, Mock: irismock.InvoiceResponse{}},
+			Call: func(ctx context.Context, h *Harness) error {
+				invoice, err := h.Clients.BillingClient.GetInvoice(ctx, "RX-CONTRACT-3")
+				if err != nil {
+					return err
+				}
+				return expect("invoice prescription", invoice.PrescriptionID, "RX-CONTRACT-3")
+			},
+		},
+		{
+			Name:     "billing.get_invoices_by_patient",
+			Route:    "GET /billing/v1/patients/{patientID}/invoices",
+			Auth:     true,
+			Response: &Schema{Client: irisbilling.InvoiceListResponse{}, Mock: irismock.InvoiceListResponse{}},
+			Call: func(ctx context.Context, h *Harness) error {
+				list, err := h.Clients.BillingClient.GetInvoicesByPatientID(ctx, "P-CONTRACT")
+				if err != nil {
+					return err
+				}
+				if len(list.Invoices) != list.Total {
+					return fmt.Errorf("got %d invoices, total says %d", len(list.Invoices), list.Total)
+				}
+				return nil
+			},
+		},
+		{
+			Name:     "billing.create_invoice",
+			Route:    "POST /billing/v1/invoices",
+			Auth:     true,
+			Headers:  map[string]string{"Content-Type": jsonContentType, "X-Idempotency-Key": ""},
+			Request:  &Schema{Client: irisbilling.CreateInvoiceRequest{}, Mock: irismock.CreateInvoiceRequest{}},
+			Response: &Schema{Client: irisbilling.CreateInvoiceResponse{}, Mock: irismock.InvoiceResponse{}},
+			Call: func(ctx context.Context, h *Harness) error {
+				req := irisbilling.CreateInvoiceRequest{PrescriptionID: "RX-CONTRACT-4", PatientID: "P-CONTRACT", Amount: 42.5, Description: "Contract check"}
+				// A retry, then a re-bill of the same prescription after a void
+				for _, replaces := range []string{"", "", "INV-VOIDED"} {
+					req.ReplacesInvoiceID = replaces
+					invoice, err := h.Clients.BillingClient.CreateInvoice(ctx, req)
+					if err != nil {
+						return err
+					}
+					if invoice.Amount != req.Amount {
+						return fmt.Errorf("invoice amount is %.2f, want %.2f", invoice.Amount, req.Amount)
+					}
+				}
+				return nil
+			},
+			Verify: func(calls []Exchange) error {
+				if len(calls) != 3 {
+					return fmt.Errorf("got %d create calls, want 3", len(calls))
+				}
+				first, retry, rebill := calls[0].Header.Get("X-Idempotency-Key"), calls[1].Header.Get("X-Idempotency-Key"), calls[2].Header.Get("X-Idempotency-Key")
+				if first != retry {
+					return fmt.Errorf("a retry sent idempotency key %q, the first call %q", retry, first)
+				}
+				if rebill == first {
+					return fmt.Errorf("a re-bill reused idempotency key %q of the voided invoice", first)
+				}
+				return nil
+			},
+		},
+		{
+			Name:     "billing.acknowledge_invoice",
+			Route:    "POST /billing/v1/invoices/{invoiceID}/acknowledge",
+			Auth:     true,
+			Headers:  map[string]string{"Content-Type": jsonContentType},
+			Request:  &Schema{Client: irisbilling.AcknowledgeInvoiceRequest{}, Mock: irismock.AcknowledgeInvoiceRequest{}},
+			Response: &Schema{Client: irisbilling.AcknowledgeInvoiceResponse{}, Mock: irismock.InvoiceResponse{}},
+			Call: func(ctx context.Context, h *Harness) error {
+				invoice, err := h.Clients.BillingClient.AcknowledgeInvoice(ctx, "INV-CONTRACT-1", irisbilling.AcknowledgeInvoiceRequest{AcknowledgedBy: "contracts", Notes: "Contract check"})
+				if err != nil {
+					return err
+				}
+				return expect("invoice ID", invoice.ID, "INV-CONTRACT-1")
+			},
+		},
+		{
+			Name:     "billing.void_invoice",
+			Route:    "POST /billing/v1/invoices/{invoiceID}/void",
+			Auth:     true,
+			Headers:  map[string]string{"Content-Type": jsonContentType},
+			Request:  &Schema{Client: irisbilling.VoidInvoiceRequest{}, Mock: irismock.VoidInvoiceRequest{}},
+			Response: &Schema{Client: irisbilling.VoidInvoiceResponse{}, Mock: irismock.InvoiceResponse{}},
+			Call: func(ctx context.Context, h *Harness) error {
+				invoice, err := h.Clients.BillingClient.VoidInvoice(ctx, "INV-CONTRACT-2", irisbilling.VoidInvoiceRequest{VoidedBy: "contracts", ReasonCode: "duplicate", Reason: "Contract check"})
+				if err != nil {
+					return err
+				}
+				return expect("invoice status", invoice.Status, "voided")
+			},
+		},
+		{
+			Name:     "billing.adjust_invoice",
+			Route:    "POST /billing/v1/invoices/{invoiceID}/adjustments",
+			Auth:     true,
+			Headers:  map[string]string{"Content-Type": jsonContentType},
+			Request:  &Schema{Client: irisbilling.AdjustInvoiceRequest{}, Mock: irismock.AdjustInvoiceRequest{}},
+			Response: &Schema{Client: irisbilling.AdjustInvoiceResponse{}, Mock: irismock.AdjustInvoiceResponse{}},
+			Call: func(ctx context.Context, h *Harness) error {
+				adjustment, err := h.Clients.BillingClient.AdjustInvoice(ctx, "INV-CONTRACT-3", irisbilling.AdjustInvoiceRequest{Amount: -10, AdjustedBy: "contracts", ReasonCode: "overcharge", Reason: "Contract check"})
+				if err != nil {
+					return err
+				}
+				if adjustment.AdjustmentID == "" {
+					return errors.New("adjustment has no ID")
+				}
+				return nil
+			},
+		},
+		{
+			Name:     "billing.get_invoice_payment",
+			Route:    "GET /billing/v1/invoices/{invoiceID}/payment",
+			Auth:     true,
+			Response: &Schema{Client: irisbilling.InvoicePaymentResponse{}, Mock: irismock.InvoicePaymentResponse{}},
+			Call: func(ctx context.Context, h *Harness) error {
+				payment, err := h.Clients.BillingClient.GetInvoicePayment(ctx, "INV-CONTRACT-4")
+				if err != nil {
+					return err
+				}
+				return expect("payment invoice", payment.InvoiceID, "INV-CONTRACT-4")
+			},
+		},
+		{
+			Name:     "billing.estimate_price",
+			Route:    "POST /billing/v1/price-estimates",
+			Auth:     true,
+			Headers:  map[string]string{"Content-Type": jsonContentType},
+			Request:  &Schema{Client: irisbilling.PriceEstimateRequest{}, Mock: irismock.PriceEstimateRequest{}},
+			Response: &Schema{Client: irisbilling.PriceEstimateResponse{}, Mock: irismock.PriceEstimateResponse{}},
+			Call: func(ctx context.Context, h *Harness) error {
+				estimate, err := h.Clients.BillingClient.EstimatePrice(ctx, irisbilling.PriceEstimateRequest{PrescriptionID: "RX-CONTRACT-5", Drug: "Amoxicillin", Dose: "500mg", Quantity: 30})
+				if err != nil {
+					return err
+				}
+				if estimate.Total <= 0 {
+					return errors.New("estimate has no total")
+				}
+				return expect("estimate prescription", estimate.PrescriptionID, "RX-CONTRACT-5")
+			},
+		},
+
+		// Card OCR
+		{
+			Name:     "card_ocr.extract_card",
+			Route:    "POST /ocr/v1/insurance-cards",
+			Auth:     true,
+			Headers:  map[string]string{"Content-Type": jsonContentType},
+			Request:  &Schema{Client: cardocr.ExtractCardRequest{}, Mock: irismock.ExtractCardRequest{}},
+			Response: &Schema{Client: cardocr.ExtractCardResponse{}, Mock: irismock.ExtractCardResponse{}},
+			Call: func(ctx context.Context, h *Harness) error {
+				result, err := h.Clients.CardOCRClient.ExtractInsuranceCard(ctx, cardocr.ExtractCardRequest{Images: []cardocr.CardImage{
+					{Side: cardocr.SideFront, ContentType: "image/png", Data: []byte("front")},
+					{Side: cardocr.SideBack, ContentType: "image/png", Data: []byte("back")},
+				}})
+				if err != nil {
+					return err
+				}
+				for _, field := range result.Fields {
+					if field.Name == cardocr.FieldRxBIN {
+						return nil
+					}
+				}
+				return fmt.Errorf("no %s field read from the back of the card", cardocr.FieldRxBIN)
+			},
+		},
+
+		// Notifications
+		{
+			Name:     "notifications.send_sms",
+			Route:    "POST /sms/v1/messages",
+			Auth:     true,
+			Headers:  map[string]string{"Content-Type": jsonContentType},
+			Request:  &Schema{Client: notifications.SendSMSRequest{}, Mock: irismock.SendSMSRequest{}},
+			Response: &Schema{Client: notifications.SendSMSResponse{}, Mock: irismock.SendSMSResponse{}},
+			Call: func(ctx context.Context, h *Harness) error {
+				result, err := h.Clients.NotificationSender.Send(ctx, notifications.Notification{Channel: notifications.ChannelSMS, To: "+12065550100", Body: "Contract check"})
+				if err != nil {
+					return err
+				}
+				if result.MessageID == "" {
+					return errors.New("text message has no ID")
+				}
+				return nil
+			},
+		},
+	}
+}
+
+// sendNewRx sends a NewRx to a pharmacy accepting prescriptions
+func sendNewRx(ctx context.Context, h *Harness, messageID string) (*irispharmacy.ScriptResponse, error) {
+	message, err := irispharmacy.BuildNewRx(irispharmacy.NewRx{
+		MessageID:             messageID,
+		SentAt:                time.Now(),
+		PrescriberOrderNumber: "RX-CONTRACT-5",
+		Patient:               irispharmacy.ScriptPatient{FirstName: "Jane", LastName: "Doe", DateOfBirth: "1980-01-01"},
+		Pharmacy:              irispharmacy.ScriptPharmacy{ID: "PH-1001", Name: "CVS Pharmacy #1001"},
+		Prescriber:            irispharmacy.ScriptPrescriber{NPI: "1234567893", LastName: "Smith"},
+		Medication:            irispharmacy.ScriptMedication{DrugDescription: "Lisinopril 10 mg", Quantity: 30, DaysSupply: 30, WrittenDate: time.Now(), SigText: "Take 1 tablet daily"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return h.Clients.PharmacyClient.SendScriptMessage(ctx, messageID, message)
+}
+
+func expectScript(response *irispharmacy.ScriptResponse, kind irispharmacy.ResponseKind, code, relatesTo string) error {
+	if response.Kind != kind || response.Code != code {
+		return fmt.Errorf("got SCRIPT %s %s, want %s %s", response.Kind, response.Code, kind, code)
+	}
+	return expect("SCRIPT response relates to", response.RelatesToMessageID, relatesTo)
+}
+
+func expect(what, got, want string) error {
+	if got != want {
+		return fmt.Errorf("%s is %q, want %q", what, got, want)
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/integrations"
+	irismock "pharmacy-modernization-project-model/internal/integrations/iris_mock"
+	"pharmacy-modernization-project-model/internal/integrations/stargate"
+	"pharmacy-modernization-project-model/internal/platform/config"
+	"pharmacy-modernization-project-model/internal/platform/httpclient"
+)
+
+// Harness is the IRIS mock served in-process and the real clients pointed at it
+type Harness struct {
+	Config   *config.Config
+	Clients  integrations.Export
+	Stargate stargate.TokenClient // The token client the integrations layer keeps to itself
+
+	server   *httptest.Server
+	recorder *Recorder
+	close    sync.Once
+}
+
+// StartHarness serves the mock and wires the clients from the app config, with every endpoint
+// moved to the mock's address and every client switched from its in-memory mock to HTTP
+func StartHarness(verbose bool) (*Harness, error) {
+	mockLog := log.New(io.Discard, "", 0)
+	logger := zap.NewNop()
+	if verbose {
+		mockLog = log.New(os.Stderr, "[iris] ", log.LstdFlags)
+		var err error
+		if logger, err = zap.NewDevelopment(); err != nil {
+			return nil, fmt.Errorf("create logger: %w", err)
+		}
+	}
+
+	recorder := &Recorder{}
+	mock := irismock.New(mockLog)
+	server := httptest.NewServer(mock.Handler(recorder.Middleware, mock.LogHeaders))
+
+	cfg := config.Load()
+	if err := useMockServer(cfg, server.URL); err != nil {
+		server.Close()
+		return nil, err
+	}
+
+	h := &Harness{
+		Config:   cfg,
+		Clients:  integrations.New(integrations.Dependencies{Config: cfg, Logger: logger}),
+		server:   server,
+		recorder: recorder,
+	}
+	stargateCfg := cfg.External.Stargate
+	h.Stargate = stargate.NewHTTPClient(stargate.Config{
+		TokenURL:        stargateCfg.Endpoints.Token,
+		RefreshTokenURL: stargateCfg.Endpoints.RefreshToken,
+		ClientID:        stargateCfg.ClientID,
+		ClientSecret:    stargateCfg.ClientSecret,
+		Scope:           stargateCfg.Scope,
+	}, httpclient.NewClient(httpclient.Config{Timeout: 10 * time.Second, ServiceName: "stargate_auth"}, logger), logger)
+	return h, nil
+}
+
+// Close stops the mock server
+func (h *Harness) Close() {
+	h.close.Do(h.server.Close)
+}
+
+// useMockServer points every IRIS endpoint of cfg at baseURL, keeping the configured paths so
+// they are checked against the mock's routes, and turns off the in-memory mocks
+func useMockServer(cfg *config.Config, baseURL string) error {
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return fmt.Errorf("parse mock server URL: %w", err)
+	}
+
+	ext := &cfg.External
+	ext.Stargate.Enabled = true
+	ext.Stargate.UseMock = false
+	ext.Pharmacy.UseMock = false
+	ext.Billing.UseMock = false
+	ext.CardOCR.UseMock = false
+	ext.Notifications.UseMock = false
+	// Calls that fail should fail the contract, not open the circuit for the ones after it
+	ext.HTTP.CircuitBreaker.FailureThreshold = 0
+
+	for _, endpoints := range []any{
+		&ext.Stargate.Endpoints,
+		&ext.Pharmacy.Endpoints,
+		&ext.Billing.Endpoints,
+		&ext.CardOCR.Endpoints,
+		&ext.Notifications.Endpoints,
+	} {
+		v := reflect.ValueOf(endpoints).Elem()
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Field(i)
+			if field.Kind() != reflect.String || field.String() == "" {
+				continue
+			}
+			endpoint, err := url.Parse(field.String())
+			if err != nil {
+				return fmt.Errorf("parse endpoint %s: %w", v.Type().Field(i).Name, err)
+			}
+			// Path parameters such as {invoiceID} stay unescaped for httpclient.ReplacePathParams
+			field.SetString(base.Scheme + "://" + base.Host + endpoint.Path)
+		}
+	}
+	return nil
+}
+
+// Exchange is one request the mock answered
+type Exchange struct {
+	Method string
+	Route  string // The mock route pattern, e.g. /billing/v1/invoices/{invoiceID}/void
+	Header http.Header
+	Body   []byte
+
+	Status   int
+	Response []byte
+}
+
+// Recorder keeps the exchanges with the mock since it was last taken
+type Recorder struct {
+	mu        sync.Mutex
+	exchanges []Exchange
+}
+
+// Middleware records each request and its response
+func (rec *Recorder) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		rw := &recordingWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rw, r)
+
+		route := r.URL.Path
+		if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+			route = rctx.RoutePattern()
+		}
+		rec.mu.Lock()
+		rec.exchanges = append(rec.exchanges, Exchange{
+			Method:   r.Method,
+			Route:    route,
+			Header:   r.Header.Clone(),
+			Body:     body,
+			Status:   rw.status,
+			Response: rw.body.Bytes(),
+		})
+		rec.mu.Unlock()
+	})
+}
+
+// Take returns the recorded exchanges and starts over
+func (rec *Recorder) Take() []Exchange {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	exchanges := rec.exchanges
+	rec.exchanges = nil
+	return exchanges
+}
+
+type recordingWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *recordingWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *recordingWriter) Write(p []byte) (int, error) {
+	w.body.Write(p)
+	return w.ResponseWriter.Write(p)
+}
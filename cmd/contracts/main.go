@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+)
+
+// IRIS contract check harness.
+//
+// Serves the IRIS mock in-process and calls every endpoint through the real HTTP clients, wired
+// by the integrations layer from the app config the same way the server wires them. Each call
+// must reach its mock route with the headers IRIS expects (Stargate bearer token, user ID,
+// idempotency key, env name), and the JSON bodies exchanged must have the same fields and types
+// on both sides, so the clients and the mock cannot drift apart.
+//
+// Usage:
+//
+//	go run ./cmd/contracts               # check every contract
+//	go run ./cmd/contracts -run billing  # only contracts whose name matches
+//	go run ./cmd/contracts -v            # also print the client and mock logs
+func main() {
+	runFilter := flag.String("run", "", "only check contracts whose name matches this regular expression")
+	verbose := flag.Bool("v", false, "print the client and mock logs")
+	flag.Parse()
+
+	var filter *regexp.Regexp
+	if *runFilter != "" {
+		var err error
+		if filter, err = regexp.Compile(*runFilter); err != nil {
+			log.Fatalf("❌ Invalid -run expression: %v", err)
+		}
+	}
+
+	fmt.Println("🤝 Checking IRIS client/mock contracts...")
+
+	h, err := StartHarness(*verbose)
+	if err != nil {
+		log.Fatalf("❌ Failed to start harness: %v", err)
+	}
+	defer h.Close()
+
+	failures := Check(context.Background(), h, Contracts(), filter)
+	if failures > 0 {
+		h.Close()
+		os.Exit(1)
+	}
+}
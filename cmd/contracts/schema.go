@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Schema pairs the types the client and the mock encode or decode one JSON body with
+type Schema struct {
+	Client any // A value of the client's type, e.g. iris_billing.CreateInvoiceRequest{}
+	Mock   any // A value of the mock's type
+}
+
+// Drift lists the differences between the client's and the mock's fields. sender names the
+// side writing the body: a field it writes that the other side does not read is lost, and a
+// field the other side reads that it never writes is always empty.
+func (s Schema) Drift(sender string) []string {
+	client, mock := map[string]string{}, map[string]string{}
+	fieldsOf(reflect.TypeOf(s.Client), "", client)
+	fieldsOf(reflect.TypeOf(s.Mock), "", mock)
+
+	sides := map[string]map[string]string{"client": client, "mock": mock}
+	receiver := "mock"
+	if sender == "mock" {
+		receiver = "client"
+	}
+
+	var drift []string
+	for path, kind := range sides[sender] {
+		other, ok := sides[receiver][path]
+		switch {
+		case !ok:
+			drift = append(drift, fmt.Sprintf("%s: written by the %s, unknown to the %s", path, sender, receiver))
+		case other != kind:
+			drift = append(drift, fmt.Sprintf("%s: %s in the %s, %s in the %s", path, kind, sender, other, receiver))
+		}
+	}
+	for path := range sides[receiver] {
+		if _, ok := sides[sender][path]; !ok {
+			drift = append(drift, fmt.Sprintf("%s: read by the %s, never written by the %s", path, receiver, sender))
+		}
+	}
+	sort.Strings(drift)
+	return drift
+}
+
+// fieldsOf maps the JSON paths of t to their JSON kinds, e.g. "invoices[].amount": "number"
+func fieldsOf(t reflect.Type, path string, out map[string]string) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	kind := jsonKind(t)
+	if path != "" {
+		out[path] = kind
+	}
+
+	switch kind {
+	case "object":
+		if t.Kind() != reflect.Struct {
+			return
+		}
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			tag := f.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			name, _, _ := strings.Cut(tag, ",")
+			if f.Anonymous && name == "" {
+				// Promoted fields, as in CreateInvoiceResponse{InvoiceResponse}
+				fieldsOf(f.Type, path, out)
+				continue
+			}
+			if !f.IsExported() {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			if path != "" {
+				name = path + "." + name
+			}
+			fieldsOf(f.Type, name, out)
+		}
+	case "array":
+		fieldsOf(t.Elem(), path+"[]", out)
+	}
+}
+
+// jsonKind is the JSON type t is encoded as
+func jsonKind(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "bool"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return "string" // Base64
+		}
+		return "array"
+	case reflect.Struct, reflect.Map:
+		return "object"
+	}
+	return "any"
+}
+
+// decodeStrict decodes a body into a new value of the type of into, failing on fields it lacks
+func decodeStrict(body []byte, into any) error {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.DisallowUnknownFields()
+	return dec.Decode(reflect.New(reflect.TypeOf(into)).Interface())
+}
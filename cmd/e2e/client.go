@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Client calls the server and the IRIS mock on behalf of a dev-mode mock user
+type Client struct {
+	baseURL  string
+	mockUser string
+	http     *http.Client
+}
+
+// NewClient creates a client that authenticates as the given dev-mode mock user
+func NewClient(baseURL, mockUser string) *Client {
+	return &Client{
+		baseURL:  strings.TrimRight(baseURL, "/"),
+		mockUser: mockUser,
+		http:     &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+// As returns a copy of the client acting as another mock user
+func (c *Client) As(mockUser string) *Client {
+	return &Client{baseURL: c.baseURL, mockUser: mockUser, http: c.http}
+}
+
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// GraphQL executes a GraphQL operation and decodes its data into out
+func (c *Client) GraphQL(ctx context.Context, query string, variables map[string]any, out any) error {
+	var resp graphQLResponse
+	status, err := c.Do(ctx, http.MethodPost, "/graphql", graphQLRequest{Query: query, Variables: variables}, &resp)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("graphql returned status %d", status)
+	}
+	if len(resp.Errors) > 0 {
+		messages := make([]string, 0, len(resp.Errors))
+		for _, e := range resp.Errors {
+			messages = append(messages, e.Message)
+		}
+		return fmt.Errorf("graphql errors: %s", strings.Join(messages, "; "))
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(resp.Data, out)
+}
+
+// Do sends a JSON request and decodes the JSON response into out, returning the status code
+func (c *Client) Do(ctx context.Context, method, path string, body, out any) (int, error) {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.mockUser != "" {
+		req.Header.Set("X-Mock-User", c.mockUser)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("%s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if out != nil && len(raw) > 0 {
+		if err := json.Unmarshal(raw, out); err != nil {
+			return resp.StatusCode, fmt.Errorf("%s %s: decode response: %w (body: %s)", method, path, err, truncate(string(raw), 200))
+		}
+	}
+	return resp.StatusCode, nil
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"pharmacy-modernization-project-model/internal/app"
+	irismock "pharmacy-modernization-project-model/internal/integrations/iris_mock"
+	"pharmacy-modernization-project-model/internal/platform/config"
+)
+
+const (
+	IRISModeInProcess = "in-process"
+	IRISModeExternal  = "external"
+
+	ServerModeInProcess = "in-process"
+	ServerModeExternal  = "external"
+)
+
+// Options controls how the harness boots its dependencies
+type Options struct {
+	MongoURI       string
+	Database       string
+	ComposeCmd     string
+	ComposeFile    string
+	ComposeDown    bool
+	IRISMode       string
+	IRISURL        string
+	ServerMode     string
+	ServerURL      string
+	StartupTimeout time.Duration
+}
+
+// Environment holds the running systems a scenario interacts with
+type Environment struct {
+	opts Options
+
+	ServerURL string
+	IRISURL   string
+	Mongo     *mongo.Database
+
+	mongoClient   *mongo.Client
+	irisServer    *http.Server
+	server        *httptest.Server
+	startedMongo  bool
+	cleanupErrors []error
+}
+
+// StartEnvironment boots MongoDB, the IRIS mock and the server according to opts
+func StartEnvironment(ctx context.Context, opts Options) (*Environment, error) {
+	env := &Environment{opts: opts, IRISURL: strings.TrimRight(opts.IRISURL, "/")}
+
+	if err := env.startMongo(ctx); err != nil {
+		env.Stop()
+		return nil, err
+	}
+	if err := env.startIRIS(ctx); err != nil {
+		env.Stop()
+		return nil, err
+	}
+	if err := env.startServer(ctx); err != nil {
+		env.Stop()
+		return nil, err
+	}
+
+	return env, nil
+}
+
+// Stop tears down everything the harness started
+func (e *Environment) Stop() {
+	if e.server != nil {
+		e.server.Close()
+		fmt.Println("🛑 Stopped in-process server")
+	}
+
+	if e.irisServer != nil {
+		if err := e.irisServer.Close(); err != nil {
+			e.cleanupErrors = append(e.cleanupErrors, err)
+		}
+		fmt.Println("🛑 Stopped IRIS mock server")
+	}
+
+	if e.mongoClient != nil {
+		_ = e.mongoClient.Disconnect(context.Background())
+	}
+
+	if e.startedMongo && e.opts.ComposeDown {
+		if err := e.compose("down"); err != nil {
+			e.cleanupErrors = append(e.cleanupErrors, err)
+		}
+		fmt.Println("🛑 Stopped compose services")
+	}
+
+	for _, err := range e.cleanupErrors {
+		fmt.Printf("⚠️  Cleanup error: %v\n", err)
+	}
+}
+
+func (e *Environment) startMongo(ctx context.Context) error {
+	if e.opts.MongoURI == "" {
+		username := os.Getenv("MONGO_ROOT_USERNAME")
+		password := os.Getenv("MONGO_ROOT_PASSWORD")
+		if username == "" || password == "" {
+			return errors.New("MONGO_ROOT_USERNAME and MONGO_ROOT_PASSWORD are required when -mongo-uri is not set")
+		}
+
+		fmt.Printf("🐳 Starting MongoDB via %s...\n", e.opts.ComposeCmd)
+		if err := e.compose("up", "-d", "mongodb"); err != nil {
+			return err
+		}
+		e.startedMongo = true
+		e.opts.MongoURI = fmt.Sprintf("mongodb://%s:%s@localhost:27017", username, password)
+	}
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(e.opts.MongoURI))
+	if err != nil {
+		return fmt.Errorf("connect to MongoDB: %w", err)
+	}
+	e.mongoClient = client
+
+	err = waitFor(ctx, e.opts.StartupTimeout, func(ctx context.Context) error {
+		return client.Ping(ctx, nil)
+	})
+	if err != nil {
+		return fmt.Errorf("MongoDB not ready: %w", err)
+	}
+
+	e.Mongo = client.Database(e.opts.Database)
+	fmt.Println("✅ MongoDB is ready")
+	return nil
+}
+
+func (e *Environment) startIRIS(ctx context.Context) error {
+	switch e.opts.IRISMode {
+	case IRISModeExternal:
+	case IRISModeInProcess:
+		fmt.Println("🚀 Starting IRIS mock server in-process...")
+
+		// The app config points the IRIS clients at a fixed address, so listen on the one of -iris-url
+		target, err := url.Parse(e.IRISURL)
+		if err != nil {
+			return fmt.Errorf("invalid IRIS URL: %w", err)
+		}
+		listener, err := net.Listen("tcp", target.Host)
+		if err != nil {
+			return fmt.Errorf("start IRIS mock: %w", err)
+		}
+		mock := irismock.New(log.New(os.Stdout, "[iris] ", log.LstdFlags))
+		e.irisServer = &http.Server{Handler: mock.Handler(), ReadHeaderTimeout: 10 * time.Second}
+		go func() { _ = e.irisServer.Serve(listener) }()
+	default:
+		return fmt.Errorf("unknown IRIS mode %q", e.opts.IRISMode)
+	}
+
+	if err := waitForHTTP(ctx, e.opts.StartupTimeout, e.IRISURL+"/"); err != nil {
+		return fmt.Errorf("IRIS mock not ready: %w", err)
+	}
+
+	fmt.Println("✅ IRIS mock server is ready")
+	return nil
+}
+
+func (e *Environment) startServer(ctx context.Context) error {
+	switch e.opts.ServerMode {
+	case ServerModeExternal:
+		e.ServerURL = strings.TrimRight(e.opts.ServerURL, "/")
+	case ServerModeInProcess:
+		fmt.Println("🚀 Starting application server in-process...")
+
+		// Point the server at the harness dependencies; env overrides win over app.yaml
+		overrides := map[string]string{
+			"RX_DATABASE_MONGODB_URI":       e.opts.MongoURI,
+			"RX_DATABASE_MONGODB_DATABASE":  e.opts.Database,
+			"RX_AUTH_DEV_MODE":              "true",
+			"RX_EXTERNAL_PHARMACY_USE_MOCK": "false",
+			"RX_EXTERNAL_BILLING_USE_MOCK":  "false",
+			// The invoice scenario bills through the billing API rather than on completion
+			"RX_BILLING_AUTO_INVOICE_ON_COMPLETE": "false",
+		}
+		for key, value := range overrides {
+			if err := os.Setenv(key, value); err != nil {
+				return err
+			}
+		}
+
+		application, err := app.New(config.Load())
+		if err != nil {
+			return fmt.Errorf("create application: %w", err)
+		}
+		e.server = httptest.NewServer(application.Router)
+		e.ServerURL = e.server.URL
+	default:
+		return fmt.Errorf("unknown server mode %q", e.opts.ServerMode)
+	}
+
+	if err := waitForHTTP(ctx, e.opts.StartupTimeout, e.ServerURL+"/assets/app.css"); err != nil {
+		return fmt.Errorf("server not ready: %w", err)
+	}
+
+	fmt.Printf("✅ Server is ready at %s\n", e.ServerURL)
+	return nil
+}
+
+// compose runs the configured compose command against the compose file
+func (e *Environment) compose(args ...string) error {
+	parts := strings.Fields(e.opts.ComposeCmd)
+	if len(parts) == 0 {
+		return errors.New("compose command is empty")
+	}
+
+	cmdArgs := append(parts[1:], "-f", e.opts.ComposeFile)
+	cmdArgs = append(cmdArgs, args...)
+
+	cmd := exec.Command(parts[0], cmdArgs...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s %s: %w", e.opts.ComposeCmd, strings.Join(args, " "), err)
+	}
+	return nil
+}
+
+// waitFor polls check until it succeeds or timeout elapses
+func waitFor(ctx context.Context, timeout time.Duration, check func(ctx context.Context) error) error {
+	deadline := time.Now().Add(timeout)
+	var lastErr error
+	for time.Now().Before(deadline) {
+		attemptCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+		lastErr = check(attemptCtx)
+		cancel()
+		if lastErr == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+	return fmt.Errorf("timed out after %s: %w", timeout, lastErr)
+}
+
+// waitForHTTP polls url until it answers with a non-5xx status
+func waitForHTTP(ctx context.Context, timeout time.Duration, url string) error {
+	return waitFor(ctx, timeout, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode >= http.StatusInternalServerError {
+			return fmt.Errorf("%s returned %d", url, resp.StatusCode)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"time"
+)
+
+// End-to-end smoke test harness.
+//
+// Boots MongoDB (via compose), the IRIS mock server and the application server,
+// then runs scenario-driven API flows against them and writes a JUnit-style report.
+//
+// Usage:
+//
+//	go run ./cmd/e2e                                  # boot everything, run all scenarios
+//	go run ./cmd/e2e -mongo-uri mongodb://...         # use an already running MongoDB
+//	go run ./cmd/e2e -server external -server-url ... # run against a deployed server
+//	go run ./cmd/e2e -run prescription -report out.xml
+func main() {
+	opts := Options{}
+	flag.StringVar(&opts.MongoURI, "mongo-uri", os.Getenv("RX_DATABASE_MONGODB_URI"), "MongoDB URI (when empty, MongoDB is started via compose)")
+	flag.StringVar(&opts.Database, "database", "pharmacy_modernization", "MongoDB database used by the server")
+	flag.StringVar(&opts.ComposeCmd, "compose-cmd", "podman compose", "compose command used to start MongoDB (e.g. \"docker compose\")")
+	flag.StringVar(&opts.ComposeFile, "compose-file", "podman/compose.yml", "compose file containing the mongodb service")
+	flag.BoolVar(&opts.ComposeDown, "compose-down", false, "stop compose services when the run finishes")
+	flag.StringVar(&opts.IRISMode, "iris", IRISModeInProcess, "how to run the IRIS mock: \"in-process\" or \"external\"")
+	flag.StringVar(&opts.IRISURL, "iris-url", "http://localhost:8881", "IRIS mock base URL")
+	flag.StringVar(&opts.ServerMode, "server", ServerModeInProcess, "how to run the server: \"in-process\" or \"external\"")
+	flag.StringVar(&opts.ServerURL, "server-url", "http://localhost:8080", "server base URL (external mode only)")
+	flag.DurationVar(&opts.StartupTimeout, "startup-timeout", 90*time.Second, "maximum time to wait for each dependency to become ready")
+	reportPath := flag.String("report", "e2e-report.xml", "path of the JUnit XML report")
+	runFilter := flag.String("run", "", "only run scenarios whose name matches this regular expression")
+	flag.Parse()
+
+	var filter *regexp.Regexp
+	if *runFilter != "" {
+		var err error
+		if filter, err = regexp.Compile(*runFilter); err != nil {
+			log.Fatalf("❌ Invalid -run expression: %v", err)
+		}
+	}
+
+	fmt.Println("🧪 Starting end-to-end smoke tests...")
+
+	ctx := context.Background()
+	env, err := StartEnvironment(ctx, opts)
+	if err != nil {
+		log.Fatalf("❌ Failed to start environment: %v", err)
+	}
+
+	report := Run(ctx, env, Scenarios(), filter)
+	env.Stop()
+
+	if err := report.WriteJUnit(*reportPath); err != nil {
+		log.Fatalf("❌ Failed to write report: %v", err)
+	}
+
+	report.PrintSummary()
+	fmt.Printf("📄 JUnit report written to %s\n", *reportPath)
+
+	if report.Failures() > 0 {
+		os.Exit(1)
+	}
+}
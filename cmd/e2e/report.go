@@ -0,0 +1,153 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Report holds the results of one harness run
+type Report struct {
+	Timestamp time.Time
+	Suites    []SuiteResult
+}
+
+// SuiteResult holds the step results of one scenario
+type SuiteResult struct {
+	Name  string
+	Cases []CaseResult
+}
+
+// CaseResult is the outcome of a single scenario step
+type CaseResult struct {
+	Scenario string
+	Name     string
+	Duration time.Duration
+	Failure  string
+	Skipped  string
+}
+
+// Failures returns the number of failed steps across all scenarios
+func (r *Report) Failures() int {
+	count := 0
+	for _, suite := range r.Suites {
+		count += suite.failures()
+	}
+	return count
+}
+
+func (s SuiteResult) failures() int {
+	count := 0
+	for _, c := range s.Cases {
+		if c.Failure != "" {
+			count++
+		}
+	}
+	return count
+}
+
+func (s SuiteResult) skipped() int {
+	count := 0
+	for _, c := range s.Cases {
+		if c.Skipped != "" {
+			count++
+		}
+	}
+	return count
+}
+
+func (s SuiteResult) duration() time.Duration {
+	var total time.Duration
+	for _, c := range s.Cases {
+		total += c.Duration
+	}
+	return total
+}
+
+// PrintSummary prints a one-line summary per scenario
+func (r *Report) PrintSummary() {
+	fmt.Println("\n📊 End-to-end summary:")
+	for _, suite := range r.Suites {
+		icon := "✅"
+		if suite.failures() > 0 {
+			icon = "❌"
+		}
+		fmt.Printf("   %s %s: %d steps, %d failed, %d skipped\n",
+			icon, suite.Name, len(suite.Cases), suite.failures(), suite.skipped())
+	}
+}
+
+// JUnit XML structures (subset understood by common CI systems)
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+}
+
+// WriteJUnit writes the report as JUnit XML to path
+func (r *Report) WriteJUnit(path string) error {
+	out := junitTestSuites{}
+	var total time.Duration
+
+	for _, suite := range r.Suites {
+		js := junitTestSuite{
+			Name:      "e2e." + suite.Name,
+			Tests:     len(suite.Cases),
+			Failures:  suite.failures(),
+			Skipped:   suite.skipped(),
+			Time:      seconds(suite.duration()),
+			Timestamp: r.Timestamp.UTC().Format(time.RFC3339),
+		}
+		for _, c := range suite.Cases {
+			jc := junitTestCase{ClassName: "e2e." + c.Scenario, Name: c.Name, Time: seconds(c.Duration)}
+			if c.Failure != "" {
+				jc.Failure = &junitMessage{Message: c.Failure}
+			}
+			if c.Skipped != "" {
+				jc.Skipped = &junitMessage{Message: c.Skipped}
+			}
+			js.Cases = append(js.Cases, jc)
+		}
+
+		out.Suites = append(out.Suites, js)
+		out.Tests += js.Tests
+		out.Failures += js.Failures
+		total += suite.duration()
+	}
+	out.Time = seconds(total)
+
+	data, err := xml.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append([]byte(xml.Header), data...), 0o644)
+}
+
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
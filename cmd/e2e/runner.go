@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// StepFunc executes one step of a scenario against the shared scenario state
+type StepFunc func(ctx context.Context, s *State) error
+
+// Step is a named unit of work within a scenario; each step is reported as a test case
+type Step struct {
+	Name string
+	Run  StepFunc
+}
+
+// Scenario is an ordered API flow; a failing step skips the remaining steps
+type Scenario struct {
+	Name  string
+	Steps []Step
+}
+
+// State carries clients and identifiers between the steps of a single scenario
+type State struct {
+	Env    *Environment
+	Server *Client
+	IRIS   *Client
+	RunID  string
+
+	PatientID      string
+	PrescriptionID string
+}
+
+const stepTimeout = 30 * time.Second
+
+// Run executes the scenarios (optionally filtered by name) and collects their results
+func Run(ctx context.Context, env *Environment, scenarios []Scenario, filter *regexp.Regexp) *Report {
+	report := &Report{Timestamp: time.Now()}
+
+	for _, scenario := range scenarios {
+		if filter != nil && !filter.MatchString(scenario.Name) {
+			continue
+		}
+
+		fmt.Printf("\n▶️  Scenario: %s\n", scenario.Name)
+		state := &State{
+			Env:    env,
+			Server: NewClient(env.ServerURL, "doctor"),
+			IRIS:   NewClient(env.IRISURL, ""),
+			RunID:  fmt.Sprintf("%d", time.Now().UnixNano()),
+		}
+
+		suite := SuiteResult{Name: scenario.Name}
+		var failed error
+		for _, step := range scenario.Steps {
+			result := CaseResult{Scenario: scenario.Name, Name: step.Name}
+			if failed != nil {
+				result.Skipped = "previous step failed"
+				fmt.Printf("   ⏭️  %s (skipped)\n", step.Name)
+				suite.Cases = append(suite.Cases, result)
+				continue
+			}
+
+			stepCtx, cancel := context.WithTimeout(ctx, stepTimeout)
+			start := time.Now()
+			err := step.Run(stepCtx, state)
+			result.Duration = time.Since(start)
+			cancel()
+
+			if err != nil {
+				failed = err
+				result.Failure = err.Error()
+				fmt.Printf("   ❌ %s: %v\n", step.Name, err)
+			} else {
+				fmt.Printf("   ✅ %s (%s)\n", step.Name, result.Duration.Round(time.Millisecond))
+			}
+			suite.Cases = append(suite.Cases, result)
+		}
+
+		report.Suites = append(report.Suites, suite)
+	}
+
+	return report
+}
+
+// expectDocument asserts that the document with the given id exists and matches every expected field
+func (s *State) expectDocument(ctx context.Context, collection, id string, expected bson.M) error {
+	var doc bson.M
+	err := s.Env.Mongo.Collection(collection).FindOne(ctx, bson.M{"_id": id}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return fmt.Errorf("%s/%s not found in MongoDB", collection, id)
+	}
+	if err != nil {
+		return fmt.Errorf("read %s/%s from MongoDB: %w", collection, id, err)
+	}
+
+	for field, want := range expected {
+		if got := doc[field]; got != want {
+			return fmt.Errorf("%s/%s: field %q is %v, want %v", collection, id, field, got, want)
+		}
+	}
+	return nil
+}
+
+// expectCount asserts how many documents in collection match filter
+func (s *State) expectCount(ctx context.Context, collection string, filter bson.M, want int64) error {
+	got, err := s.Env.Mongo.Collection(collection).CountDocuments(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("count %s in MongoDB: %w", collection, err)
+	}
+	if got != want {
+		return fmt.Errorf("%s: found %d matching documents, want %d", collection, got, want)
+	}
+	return nil
+}
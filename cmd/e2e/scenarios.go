@@ -0,0 +1,300 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Scenarios returns every scenario the harness knows how to run, in execution order
+func Scenarios() []Scenario {
+	return []Scenario{
+		{
+			Name: "prescription_lifecycle",
+			Steps: []Step{
+				{Name: "register patient", Run: registerPatient},
+				{Name: "create prescription", Run: createPrescription("Lisinopril", "10mg")},
+				{Name: "activate prescription", Run: updatePrescriptionStatus("doctor", "ACTIVE", "Active")},
+				{Name: "dispense prescription", Run: dispensePrescription},
+				{Name: "invoice prescription", Run: invoicePrescription},
+			},
+		},
+		{
+			Name: "severe_interaction_blocked",
+			Steps: []Step{
+				{Name: "register patient", Run: registerPatient},
+				{Name: "create prescription", Run: createPrescription("Warfarin", "5mg")},
+				{Name: "activate prescription", Run: updatePrescriptionStatus("doctor", "ACTIVE", "Active")},
+				{Name: "reject interacting prescription", Run: rejectInteractingPrescription("Aspirin", "81mg")},
+			},
+		},
+		{
+			Name: "readonly_user_cannot_prescribe",
+			Steps: []Step{
+				{Name: "register patient", Run: registerPatient},
+				{Name: "reject readonly create", Run: rejectReadonlyCreate},
+			},
+		},
+	}
+}
+
+// seededPrescriberID is a sample prescriber written by cmd/seed, like the drug interactions the
+// interaction scenario relies on
+const seededPrescriberID = "DR001"
+
+const createPatientMutation = `mutation($input: CreatePatientInput!) {
+  createPatient(input: $input) { patient { id name state } userErrors { field code message } }
+}`
+
+const createPrescriptionMutation = `mutation($input: CreatePrescriptionInput!) {
+  createPrescription(input: $input) { prescription { id patientID drug status } userErrors { field code message } }
+}`
+
+const updatePrescriptionMutation = `mutation($id: ID!, $input: UpdatePrescriptionInput!) {
+  updatePrescription(id: $id, input: $input) { prescription { id status } userErrors { field code message } }
+}`
+
+type prescriptionResult struct {
+	ID        string `json:"id"`
+	PatientID string `json:"patientID"`
+	Drug      string `json:"drug"`
+	Status    string `json:"status"`
+}
+
+type invoiceResult struct {
+	ID             string  `json:"id"`
+	PrescriptionID string  `json:"prescription_id"`
+	PatientID      string  `json:"patient_id"`
+	Amount         float64 `json:"amount"`
+	Status         string  `json:"status"`
+}
+
+type prescriptionPayload struct {
+	Prescription *prescriptionResult `json:"prescription"`
+	UserErrors   userErrors          `json:"userErrors"`
+}
+
+type userErrors []struct {
+	Field   *string `json:"field"`
+	Code    string  `json:"code"`
+	Message string  `json:"message"`
+}
+
+// err reports the userErrors of a mutation payload as one error, or nil when there are none
+func (u userErrors) err() error {
+	if len(u) == 0 {
+		return nil
+	}
+	messages := make([]string, 0, len(u))
+	for _, userErr := range u {
+		messages = append(messages, userErr.Code+": "+userErr.Message)
+	}
+	return fmt.Errorf("user errors: %s", strings.Join(messages, "; "))
+}
+
+func registerPatient(ctx context.Context, s *State) error {
+	var out struct {
+		CreatePatient struct {
+			Patient struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+			} `json:"patient"`
+			UserErrors userErrors `json:"userErrors"`
+		} `json:"createPatient"`
+	}
+	err := s.Server.GraphQL(ctx, createPatientMutation, map[string]any{
+		"input": map[string]any{
+			"name":  "E2E Patient " + s.RunID,
+			"dob":   time.Date(1980, time.March, 14, 0, 0, 0, 0, time.UTC).Format(time.RFC3339),
+			"phone": "(555) 010-2030",
+			"state": "CA",
+		},
+	}, &out)
+	if err != nil {
+		return err
+	}
+	if err := out.CreatePatient.UserErrors.err(); err != nil {
+		return err
+	}
+	if out.CreatePatient.Patient.ID == "" {
+		return fmt.Errorf("createPatient returned no id")
+	}
+	s.PatientID = out.CreatePatient.Patient.ID
+
+	// Cross-system check: the patient must be persisted in MongoDB
+	return s.expectDocument(ctx, "patients", s.PatientID, bson.M{"state": "CA"})
+}
+
+func createPrescription(drug, dose string) StepFunc {
+	return func(ctx context.Context, s *State) error {
+		var out struct {
+			CreatePrescription prescriptionPayload `json:"createPrescription"`
+		}
+		err := s.Server.GraphQL(ctx, createPrescriptionMutation, map[string]any{
+			"input": map[string]any{
+				"patientID":    s.PatientID,
+				"prescriberID": seededPrescriberID,
+				"drug":         drug,
+				"dose":         dose,
+				"status":       "DRAFT",
+			},
+		}, &out)
+		if err != nil {
+			return err
+		}
+		if err := out.CreatePrescription.UserErrors.err(); err != nil {
+			return err
+		}
+		if out.CreatePrescription.Prescription == nil || out.CreatePrescription.Prescription.ID == "" {
+			return fmt.Errorf("createPrescription returned no id")
+		}
+		s.PrescriptionID = out.CreatePrescription.Prescription.ID
+
+		return s.expectDocument(ctx, "prescriptions", s.PrescriptionID, bson.M{
+			"patient_id": s.PatientID,
+			"drug":       drug,
+			"status":     "Draft",
+		})
+	}
+}
+
+func updatePrescriptionStatus(mockUser, graphQLStatus, storedStatus string) StepFunc {
+	return func(ctx context.Context, s *State) error {
+		var out struct {
+			UpdatePrescription prescriptionPayload `json:"updatePrescription"`
+		}
+		err := s.Server.As(mockUser).GraphQL(ctx, updatePrescriptionMutation, map[string]any{
+			"id":    s.PrescriptionID,
+			"input": map[string]any{"status": graphQLStatus},
+		}, &out)
+		if err != nil {
+			return err
+		}
+		if err := out.UpdatePrescription.UserErrors.err(); err != nil {
+			return err
+		}
+		if out.UpdatePrescription.Prescription == nil || out.UpdatePrescription.Prescription.Status != graphQLStatus {
+			return fmt.Errorf("expected status %s, got %+v", graphQLStatus, out.UpdatePrescription.Prescription)
+		}
+
+		return s.expectDocument(ctx, "prescriptions", s.PrescriptionID, bson.M{"status": storedStatus})
+	}
+}
+
+// dispensePrescription has the pharmacist complete the prescription and confirms
+// the IRIS pharmacy system knows about it
+func dispensePrescription(ctx context.Context, s *State) error {
+	if err := updatePrescriptionStatus("pharmacist", "COMPLETED", "Completed")(ctx, s); err != nil {
+		return err
+	}
+
+	var pharmacyRecord struct {
+		ID           string `json:"id"`
+		PharmacyName string `json:"pharmacy_name"`
+	}
+	status, err := s.IRIS.Do(ctx, http.MethodGet, "/pharmacy/v1/prescriptions/"+s.PrescriptionID, nil, &pharmacyRecord)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("IRIS pharmacy returned status %d", status)
+	}
+	if pharmacyRecord.ID != s.PrescriptionID {
+		return fmt.Errorf("IRIS pharmacy returned prescription %q, want %q", pharmacyRecord.ID, s.PrescriptionID)
+	}
+	return nil
+}
+
+// invoicePrescription has the pharmacist bill the dispensed prescription through the billing
+// API, then confirms IRIS billing holds the invoice the server reported
+func invoicePrescription(ctx context.Context, s *State) error {
+	var created invoiceResult
+	status, err := s.Server.As("pharmacist").Do(ctx, http.MethodPost, "/api/v1/billing/prescriptions/"+s.PrescriptionID+"/invoice", map[string]any{
+		"amount":      42.50,
+		"description": "E2E dispense " + s.RunID,
+	}, &created)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusCreated {
+		return fmt.Errorf("billing API returned status %d on create", status)
+	}
+	if created.ID == "" || created.PrescriptionID != s.PrescriptionID || created.PatientID != s.PatientID || created.Amount != 42.50 {
+		return fmt.Errorf("unexpected invoice %+v", created)
+	}
+
+	// Cross-system check: IRIS billing must have received the invoice for the prescription
+	var received invoiceResult
+	status, err = s.IRIS.Do(ctx, http.MethodGet, "/billing/v1/invoices/"+s.PrescriptionID, nil, &received)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("IRIS billing returned status %d", status)
+	}
+	if received.ID != created.ID || received.PrescriptionID != s.PrescriptionID || received.Amount != 42.50 || received.Status != "pending" {
+		return fmt.Errorf("IRIS billing holds %+v, want invoice %s of 42.50 pending", received, created.ID)
+	}
+
+	// A second invoice for the billed prescription is refused
+	status, err = s.Server.As("pharmacist").Do(ctx, http.MethodPost, "/api/v1/billing/prescriptions/"+s.PrescriptionID+"/invoice", map[string]any{}, nil)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusConflict {
+		return fmt.Errorf("billing API returned status %d on a second invoice, want %d", status, http.StatusConflict)
+	}
+
+	// The prescription must remain completed locally once billed
+	return s.expectDocument(ctx, "prescriptions", s.PrescriptionID, bson.M{"status": "Completed"})
+}
+
+func rejectInteractingPrescription(drug, dose string) StepFunc {
+	return func(ctx context.Context, s *State) error {
+		var out struct {
+			CreatePrescription prescriptionPayload `json:"createPrescription"`
+		}
+		err := s.Server.GraphQL(ctx, createPrescriptionMutation, map[string]any{
+			"input": map[string]any{
+				"patientID":    s.PatientID,
+				"prescriberID": seededPrescriberID,
+				"drug":         drug,
+				"dose":         dose,
+				"status":       "DRAFT",
+			},
+		}, &out)
+		if err != nil {
+			return fmt.Errorf("unexpected error: %w", err)
+		}
+		blocked := out.CreatePrescription.UserErrors.err()
+		if blocked == nil {
+			return fmt.Errorf("expected %s to be blocked by a severe interaction", drug)
+		}
+		if !strings.Contains(blocked.Error(), "BUSINESS_RULE_VIOLATION: severe drug interaction") {
+			return fmt.Errorf("unexpected user errors: %w", blocked)
+		}
+
+		return s.expectCount(ctx, "prescriptions", bson.M{"patient_id": s.PatientID}, 1)
+	}
+}
+
+func rejectReadonlyCreate(ctx context.Context, s *State) error {
+	err := s.Server.As("readonly").GraphQL(ctx, createPrescriptionMutation, map[string]any{
+		"input": map[string]any{
+			"patientID":    s.PatientID,
+			"prescriberID": seededPrescriberID,
+			"drug":         "Metformin",
+			"dose":         "500mg",
+			"status":       "DRAFT",
+		},
+	}, nil)
+	if err == nil {
+		return fmt.Errorf("expected readonly user to be denied")
+	}
+
+	return s.expectCount(ctx, "prescriptions", bson.M{"patient_id": s.PatientID}, 0)
+}
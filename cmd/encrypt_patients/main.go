@@ -0,0 +1,103 @@
+// Command encrypt_patients encrypts patient PHI (name, DOB and phone) that is stored in
+// plaintext or under a retired key, using the same configuration as the server
+// (internal/configs/app.yaml, app.<env>.yaml and RX_ environment overrides).
+//
+// Run it after enabling field_encryption to migrate existing documents, and after making a new
+// key active to re-encrypt everything under it; a retired key can be removed from the config once
+// a run reports nothing left to encrypt. --all rewrites every document, which rebuilds the blind
+// indexes after field_encryption.index_key changed. The server keeps reading plaintext and
+// retired-key documents meanwhile, so the command is safe to run against a live database.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"go.uber.org/zap"
+
+	patientrepo "pharmacy-modernization-project-model/domain/patient/repository"
+	"pharmacy-modernization-project-model/internal/app/builder"
+	"pharmacy-modernization-project-model/internal/platform/config"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+type migrateOptions struct {
+	env       string
+	all       bool
+	dryRun    bool
+	batchSize int
+}
+
+func main() {
+	opts, err := parseFlags(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	// config.Load picks app.<env>.yaml from RX_APP_ENV, the same way the server does
+	if opts.env != "" {
+		os.Setenv("RX_APP_ENV", opts.env)
+	}
+	cfg := config.Load()
+	fmt.Printf("🔐 Encrypting patient PHI (env: %s, database: %s)\n", cfg.App.Env, cfg.Database.MongoDB.Database)
+
+	cipher, err := builder.CreateFieldCipher(cfg)
+	if err != nil {
+		log.Fatalf("❌ Invalid field encryption config: %v", err)
+	}
+	if cipher == nil {
+		log.Fatal("❌ Field encryption is disabled; set field_encryption.enabled to true first")
+	}
+	fmt.Printf("🔑 Active key: %s\n", cipher.ActiveKeyID())
+
+	connMgr, err := builder.CreateMongoDBConnection(cfg, zap.NewNop())
+	if err != nil {
+		var cfgErr platformErrors.ConfigurationError
+		if errors.As(err, &cfgErr) && cfgErr.Setting == "mongodb.uri" {
+			log.Fatal("❌ MongoDB URI is not configured. Set RX_DATABASE_MONGODB_URI (see .dev/.env.example)")
+		}
+		log.Fatalf("❌ Failed to connect to MongoDB: %v", err)
+	}
+	defer connMgr.Close()
+	fmt.Println("✅ Connected to MongoDB")
+
+	coll := connMgr.GetCollection("patients")
+	result, err := patientrepo.MigratePatientEncryption(context.Background(), coll, cipher, opts.batchSize, opts.all, opts.dryRun)
+	if err != nil {
+		log.Fatalf("❌ Failed to encrypt %s after %d documents: %v", coll.Name(), result.Scanned, err)
+	}
+
+	if opts.dryRun {
+		fmt.Printf("\n🔎 Dry run: %d of %d documents would be encrypted\n", result.Encrypted, result.Scanned)
+		return
+	}
+	fmt.Printf("\n🎉 %d of %d documents encrypted with key %s", result.Encrypted, result.Scanned, cipher.ActiveKeyID())
+	if result.Changed > 0 {
+		fmt.Printf(" (%d changed during the run and were already written with the active key)", result.Changed)
+	}
+	fmt.Println()
+}
+
+func parseFlags(args []string) (migrateOptions, error) {
+	opts := migrateOptions{}
+
+	fs := flag.NewFlagSet("encrypt_patients", flag.ContinueOnError)
+	fs.StringVar(&opts.env, "env", "", "config environment to load (sets RX_APP_ENV, e.g. dev or prod)")
+	fs.BoolVar(&opts.all, "all", false, "rewrite every document, also those already under the active key (rebuilds blind indexes)")
+	fs.BoolVar(&opts.dryRun, "dry-run", false, "only count the documents that would be encrypted")
+	fs.IntVar(&opts.batchSize, "batch-size", 500, "documents fetched per round trip")
+	if err := fs.Parse(args); err != nil {
+		return opts, err
+	}
+	if opts.batchSize <= 0 {
+		return opts, fmt.Errorf("--batch-size must be positive")
+	}
+	return opts, nil
+}
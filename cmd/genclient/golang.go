@@ -0,0 +1,601 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// generateGo renders the Go client as a single gofmt-ed file of package pkg
+func generateGo(model *api, pkg, source string) ([]byte, error) {
+	tmpl, err := template.New("go").Funcs(template.FuncMap{
+		"fieldType":  goFieldType,
+		"paramType":  func(p *param) string { return goType(p.Schema) },
+		"resultType": goResultType,
+		"elemType":   func(s *schema) string { return goType(s.Items) },
+		"bodyType":   goType,
+		"pathExpr":   goPathExpr,
+		"setQuery":   goSetQuery,
+		"comment":    goComment,
+		"pathVar":    paramVar,
+		"methodName": func(method string) string { return method[:1] + strings.ToLower(method[1:]) },
+		"hasOp":      func(id string) bool { return model.operation(id) != nil },
+	}).Parse(goTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	data := struct {
+		*api
+		Package string
+		Source  string
+	}{model, pkg, source}
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated code: %w\n%s", err, buf.Bytes())
+	}
+	return formatted, nil
+}
+
+func (a *api) operation(id string) *operation {
+	for _, op := range a.Operations {
+		if op.ID == id {
+			return op
+		}
+	}
+	return nil
+}
+
+// goType returns the Go type of a schema
+func goType(s *schema) string {
+	if s.Ref != "" {
+		return refName(s.Ref)
+	}
+	var t string
+	switch s.Type {
+	case "string":
+		t = "string"
+		if s.Format == "date-time" {
+			t = "time.Time"
+		}
+	case "integer":
+		t = "int"
+	case "number":
+		t = "float64"
+	case "boolean":
+		t = "bool"
+	case "array":
+		return "[]" + goType(s.Items)
+	default:
+		return "map[string]any"
+	}
+	if s.Nullable {
+		return "*" + t
+	}
+	return t
+}
+
+// goFieldType returns the type of a struct field; optional objects are pointers so they can be absent
+func goFieldType(f *field) string {
+	if f.Schema.Ref != "" && !f.Required {
+		return "*" + refName(f.Schema.Ref)
+	}
+	return goType(f.Schema)
+}
+
+func goResultType(s *schema) string {
+	if s.Type == "array" {
+		return goType(s)
+	}
+	return "*" + goType(s)
+}
+
+var pathParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// goPathExpr returns a Go expression building the request path from the path parameters
+func goPathExpr(op *operation) string {
+	var parts []string
+	rest := op.Path
+	for _, match := range pathParamPattern.FindAllStringSubmatchIndex(op.Path, -1) {
+		offset := len(op.Path) - len(rest)
+		if literal := rest[:match[0]-offset]; literal != "" {
+			parts = append(parts, fmt.Sprintf("%q", literal))
+		}
+		parts = append(parts, fmt.Sprintf("url.PathEscape(%s)", paramVar(op.Path[match[2]:match[3]])))
+		rest = op.Path[match[1]:]
+	}
+	if rest != "" {
+		parts = append(parts, fmt.Sprintf("%q", rest))
+	}
+	return strings.Join(parts, " + ")
+}
+
+// paramVar is the Go argument name of a path parameter
+func paramVar(name string) string {
+	n := goName(name)
+	if initialisms[n] {
+		return strings.ToLower(n)
+	}
+	return strings.ToLower(n[:1]) + n[1:]
+}
+
+// goSetQuery returns the statement adding a query parameter; unset optional values are left out.
+// Nullable parameters are pointers, so their zero value can be sent.
+func goSetQuery(p *param) string {
+	value := "params." + p.Name
+	var set string
+	switch goType(p.Schema) {
+	case "int":
+		set = fmt.Sprintf("query.Set(%q, strconv.Itoa(%s))", p.JSONName, value)
+		if !p.Required {
+			return fmt.Sprintf("if %s != 0 {\n%s\n}", value, set)
+		}
+	case "bool":
+		set = fmt.Sprintf("query.Set(%q, strconv.FormatBool(%s))", p.JSONName, value)
+		if !p.Required {
+			return fmt.Sprintf("if %s {\n%s\n}", value, set)
+		}
+	case "*int":
+		set = fmt.Sprintf("query.Set(%q, strconv.Itoa(*%s))", p.JSONName, value)
+		return fmt.Sprintf("if %s != nil {\n%s\n}", value, set)
+	case "*bool":
+		set = fmt.Sprintf("query.Set(%q, strconv.FormatBool(*%s))", p.JSONName, value)
+		return fmt.Sprintf("if %s != nil {\n%s\n}", value, set)
+	default:
+		set = fmt.Sprintf("query.Set(%q, %s)", p.JSONName, value)
+		if !p.Required {
+			return fmt.Sprintf("if %s != \"\" {\n%s\n}", value, set)
+		}
+	}
+	return set
+}
+
+// goComment turns text into // comment lines
+func goComment(text string) string {
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	for i, line := range lines {
+		lines[i] = "// " + strings.TrimSpace(line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+const goTemplate = `// Code generated by genclient from {{.Source}}. DO NOT EDIT.
+
+// Package {{.Package}} is a typed client for the {{.Title}} ({{.Version}}).
+//
+// Requests follow the defaults of the platform HTTP client: a 30s timeout, pooled connections and,
+// when the token source can renew its token, one retry after a 401 response. Idempotent requests
+// may additionally be retried on transient failures by setting Config.MaxRetries.
+package {{.Package}}
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+{{- if and (hasOp "login") (hasOp "refreshToken")}}
+	"sync"
+{{- end}}
+	"time"
+)
+
+{{range .Types}}
+// {{.Name}} is the {{.Name}} schema of the API
+{{- if .Description}}
+//
+{{comment .Description}}{{end}}
+type {{.Name}} struct {
+{{- range .Fields}}
+	{{- if .Description}}
+	{{comment .Description}}{{end}}
+	{{.Name}} {{fieldType .}} ` + "`" + `json:"{{.JSONName}}{{if not .Required}},omitempty{{end}}"` + "`" + `
+{{- end}}
+}
+
+{{end -}}
+
+{{range .Operations}}{{if .QueryParams}}
+// {{.Name}}Params holds the query parameters of {{.Name}}; zero values are not sent
+type {{.Name}}Params struct {
+{{- range .QueryParams}}
+	{{- if .Description}}
+	{{comment .Description}}{{end}}
+	{{.Name}} {{paramType .}}
+{{- end}}
+}
+{{end}}{{end}}
+
+{{range .Operations}}
+// {{.Name}} calls {{.Method}} {{.Path}}
+{{- if .Summary}}: {{.Summary}}{{end}}
+{{- if .Requires}}
+//
+// {{.Requires}}{{end}}
+func (c *Client) {{.Name}}(ctx context.Context
+	{{- range .PathParams}}, {{pathVar .JSONName}} string{{end}}
+	{{- if .QueryParams}}, params {{.Name}}Params{{end}}
+	{{- if .Body}}, body {{bodyType .Body}}{{end}}) ({{if .Result}}{{resultType .Result}}, {{end}}error) {
+	{{- if .QueryParams}}
+	query := url.Values{}
+	{{- range .QueryParams}}
+	{{setQuery .}}
+	{{- end}}
+	{{- end}}
+	{{- if .Result}}
+	var result {{bodyType .Result}}
+	if err := c.do(ctx, http.Method{{methodName .Method}}, {{pathExpr .}}, {{if .QueryParams}}query{{else}}nil{{end}}, {{.Secured}}, {{if .Body}}body{{else}}nil{{end}}, &result); err != nil {
+		return nil, err
+	}
+	return {{if ne .Result.Type "array"}}&{{end}}result, nil
+	{{- else}}
+	return c.do(ctx, http.Method{{methodName .Method}}, {{pathExpr .}}, {{if .QueryParams}}query{{else}}nil{{end}}, {{.Secured}}, {{if .Body}}body{{else}}nil{{end}}, nil)
+	{{- end}}
+}
+{{if .PageSize}}
+// {{.Name}}All iterates over all results of {{.Name}}, starting at params.Offset and fetching
+// params.Limit items per request ({{.PageSize}} when zero). Iteration stops at the first error.
+func (c *Client) {{.Name}}All(ctx context.Context, params {{.Name}}Params) iter.Seq2[{{elemType .Result}}, error] {
+	if params.Limit == 0 {
+		params.Limit = {{.PageSize}}
+	}
+	return pages(params.Limit, params.Offset, func(limit, offset int) ({{resultType .Result}}, error) {
+		params.Limit, params.Offset = limit, offset
+		return c.{{.Name}}(ctx, params)
+	})
+}
+{{end}}{{end}}
+
+// Client calls the {{.Title}}
+type Client struct {
+	baseURL      string
+	httpClient   *http.Client
+	tokens       TokenSource
+	maxRetries   int
+	retryBackoff time.Duration
+}
+
+// Config holds configuration for the client; zero values take the platform HTTP client defaults
+type Config struct {
+	BaseURL         string        // e.g. http://localhost:8080
+	Timeout         time.Duration // Per-request timeout, default 30s
+	MaxIdleConns    int           // Default 100
+	IdleConnTimeout time.Duration // Default 90s
+	Tokens          TokenSource   // Optional: authenticates secured operations
+
+	// MaxRetries retries idempotent requests (GET, HEAD, PUT, DELETE) failing with a network error
+	// or a 429, 502, 503 or 504 response. The default 0 only retries after a 401, like the platform
+	// HTTP client.
+	MaxRetries int
+	// RetryBackoff is the delay before the first retry, doubled for each further one; a Retry-After
+	// header takes precedence. Default 200ms.
+	RetryBackoff time.Duration
+
+	HTTPClient *http.Client // Optional: used instead of a client built from the settings above
+}
+
+// NewClient creates a client for the API at cfg.BaseURL
+func NewClient(cfg Config) *Client {
+	if cfg.Timeout == 0 {
+		cfg.Timeout = 30 * time.Second
+	}
+	if cfg.MaxIdleConns == 0 {
+		cfg.MaxIdleConns = 100
+	}
+	if cfg.IdleConnTimeout == 0 {
+		cfg.IdleConnTimeout = 90 * time.Second
+	}
+	if cfg.RetryBackoff == 0 {
+		cfg.RetryBackoff = 200 * time.Millisecond
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: cfg.Timeout,
+			Transport: &http.Transport{
+				Proxy:               http.ProxyFromEnvironment,
+				MaxIdleConns:        cfg.MaxIdleConns,
+				MaxIdleConnsPerHost: cfg.MaxIdleConns,
+				IdleConnTimeout:     cfg.IdleConnTimeout,
+			},
+		}
+	}
+
+	return &Client{
+		baseURL:      strings.TrimSuffix(cfg.BaseURL, "/"),
+		httpClient:   httpClient,
+		tokens:       cfg.Tokens,
+		maxRetries:   cfg.MaxRetries,
+		retryBackoff: cfg.RetryBackoff,
+	}
+}
+
+// WithTokens returns a copy of the client authenticating with tokens, sharing its connections
+func (c *Client) WithTokens(tokens TokenSource) *Client {
+	clone := *c
+	clone.tokens = tokens
+	return &clone
+}
+
+// TokenSource provides the bearer token sent with secured operations
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// RefreshableTokenSource is a TokenSource whose token the server may reject. After a 401 the client
+// calls Invalidate with the rejected token and sends the request once more.
+type RefreshableTokenSource interface {
+	TokenSource
+	Invalidate(rejected string)
+}
+
+// StaticToken is a fixed bearer token, e.g. a service token issued out of band
+type StaticToken string
+
+func (t StaticToken) Token(context.Context) (string, error) {
+	return string(t), nil
+}
+{{if and (hasOp "login") (hasOp "refreshToken")}}
+// PasswordTokenSource logs in with a username and password and renews the access token with the
+// refresh token shortly before it expires, logging in again when the refresh is refused
+type PasswordTokenSource struct {
+	client   *Client
+	username string
+	password string
+
+	mu        sync.Mutex
+	token     TokenResponse
+	expiresAt time.Time
+}
+
+// NewPasswordTokenSource creates a token source logging in through client
+func NewPasswordTokenSource(client *Client, username, password string) *PasswordTokenSource {
+	return &PasswordTokenSource{client: client, username: username, password: password}
+}
+
+// tokenRefreshBefore renews tokens this long before they expire
+const tokenRefreshBefore = 30 * time.Second
+
+// Token implements TokenSource
+func (s *PasswordTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.AccessToken != "" && time.Until(s.expiresAt) > tokenRefreshBefore {
+		return s.token.AccessToken, nil
+	}
+
+	var token *TokenResponse
+	if s.token.RefreshToken != "" {
+		token, _ = s.client.RefreshToken(ctx, RefreshRequest{RefreshToken: s.token.RefreshToken})
+	}
+	if token == nil {
+		var err error
+		if token, err = s.client.Login(ctx, LoginRequest{Username: s.username, Password: s.password}); err != nil {
+			return "", err
+		}
+	}
+	s.token = *token
+	s.expiresAt = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	return s.token.AccessToken, nil
+}
+
+// Invalidate implements RefreshableTokenSource
+func (s *PasswordTokenSource) Invalidate(rejected string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token.AccessToken == rejected {
+		s.token.AccessToken = ""
+	}
+}
+{{end}}
+// APIError is a non-2xx response of the API
+type APIError struct {
+	StatusCode int
+	Code       string          // Machine-readable error code, e.g. "not_found"
+	Message    string
+	Details    json.RawMessage // Optional, e.g. the invalid fields of a 400 response
+}
+
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("api error %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("api error %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// IsNotFound reports whether err is a 404 response
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// Ptr returns a pointer to v, for optional request fields
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// do sends a request and decodes the JSON response into result
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, secured bool, body, result any) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return fmt.Errorf("encode request body: %w", err)
+		}
+	}
+
+	idempotent := method == http.MethodGet || method == http.MethodHead || method == http.MethodPut || method == http.MethodDelete
+	renewed := false
+	for retry := 0; ; {
+		response, token, err := c.send(ctx, method, path, query, secured, payload)
+
+		if err == nil && response.StatusCode == http.StatusUnauthorized && secured && !renewed {
+			if refreshable, ok := c.tokens.(RefreshableTokenSource); ok {
+				discard(response)
+				refreshable.Invalidate(token)
+				renewed = true
+				continue
+			}
+		}
+
+		if idempotent && retry < c.maxRetries && transient(response, err) && ctx.Err() == nil {
+			wait := c.retryBackoff << retry
+			if response != nil {
+				if after, convErr := strconv.Atoi(response.Header.Get("Retry-After")); convErr == nil {
+					wait = time.Duration(after) * time.Second
+				}
+				discard(response)
+			}
+			retry++
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+		return decode(response, result)
+	}
+}
+
+// send executes a single attempt of a request and returns the token it was sent with
+func (c *Client) send(ctx context.Context, method, path string, query url.Values, secured bool, payload []byte) (*http.Response, string, error) {
+	target := c.baseURL + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return nil, "", err
+	}
+	request.Header.Set("Accept", "application/json")
+	if payload != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	var token string
+	if secured && c.tokens != nil {
+		if token, err = c.tokens.Token(ctx); err != nil {
+			return nil, "", fmt.Errorf("get token: %w", err)
+		}
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	response, err := c.httpClient.Do(request)
+	return response, token, err
+}
+
+// transient reports whether a failed attempt may succeed when repeated
+func transient(response *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch response.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// decode reads the response into result, or into an *APIError for non-2xx responses
+func decode(response *http.Response, result any) error {
+	defer response.Body.Close()
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return parseError(response.StatusCode, data)
+	}
+	if result == nil || len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, result); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
+
+// parseError reads both error bodies of the API: {"error":{"code","message","details"}} and
+// {"error":"code","message","details"}. Other bodies become the message as they are.
+func parseError(status int, data []byte) *APIError {
+	apiErr := &APIError{StatusCode: status, Message: strings.TrimSpace(string(data))}
+
+	var envelope struct {
+		Error   json.RawMessage ` + "`" + `json:"error"` + "`" + `
+		Message string          ` + "`" + `json:"message"` + "`" + `
+		Details json.RawMessage ` + "`" + `json:"details"` + "`" + `
+	}
+	if json.Unmarshal(data, &envelope) != nil || len(envelope.Error) == 0 {
+		return apiErr
+	}
+
+	var nested struct {
+		Code    string          ` + "`" + `json:"code"` + "`" + `
+		Message string          ` + "`" + `json:"message"` + "`" + `
+		Details json.RawMessage ` + "`" + `json:"details"` + "`" + `
+	}
+	if json.Unmarshal(envelope.Error, &nested) == nil {
+		apiErr.Code, apiErr.Message, apiErr.Details = nested.Code, nested.Message, nested.Details
+		return apiErr
+	}
+	if json.Unmarshal(envelope.Error, &apiErr.Code) == nil {
+		apiErr.Message, apiErr.Details = envelope.Message, envelope.Details
+	}
+	return apiErr
+}
+
+// discard drains and closes a response that is not used, so the connection can be reused
+func discard(response *http.Response) {
+	io.Copy(io.Discard, response.Body)
+	response.Body.Close()
+}
+
+// pages yields the items of consecutive pages until a page comes back short
+func pages[T any](limit, offset int, fetch func(limit, offset int) ([]T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			page, err := fetch(limit, offset)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if len(page) < limit {
+				return
+			}
+			offset += len(page)
+		}
+	}
+}
+`
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// api is the language-neutral description of the client rendered by the generators
+type api struct {
+	Title      string
+	Version    string
+	Types      []*typeDef
+	Operations []*operation
+}
+
+// typeDef is an object schema of the components section
+type typeDef struct {
+	Name        string
+	Description string
+	Fields      []*field
+}
+
+// field is a property of an object schema
+type field struct {
+	JSONName    string
+	Name        string // Go identifier
+	Description string
+	Required    bool
+	Schema      *schema
+}
+
+// operation is one method and path of the spec
+type operation struct {
+	ID          string // operationId, used as the TypeScript method name
+	Name        string // Go method name
+	Method      string
+	Path        string
+	Summary     string
+	Requires    string // Permissions the route checks, as a sentence; set from the permission catalogue
+	Secured     bool
+	PathParams  []*param
+	QueryParams []*param
+	Body        *schema // nil without a request body
+	Result      *schema // nil when the response has no body
+	Status      int     // Documented success status
+	PageSize    int     // Default page size of paged operations; 0 when the operation is not paged
+}
+
+// param is a path or query parameter
+type param struct {
+	JSONName    string
+	Name        string // Go identifier
+	Description string
+	Required    bool
+	Schema      *schema
+}
+
+// buildAPI resolves references and turns the spec into the generator model
+func buildAPI(doc *document) (*api, error) {
+	model := &api{Title: doc.Info.Title, Version: doc.Info.Version}
+
+	for _, s := range doc.Components.Schemas {
+		if s.Value.Type != "object" {
+			return nil, fmt.Errorf("schema %s: only object schemas are supported in components", s.Key)
+		}
+		def := &typeDef{Name: s.Key, Description: s.Value.Description}
+		for _, p := range s.Value.Properties {
+			def.Fields = append(def.Fields, &field{
+				JSONName:    p.Key,
+				Name:        goName(p.Key),
+				Description: p.Value.Description,
+				Required:    slices.Contains(s.Value.Required, p.Key),
+				Schema:      p.Value,
+			})
+		}
+		model.Types = append(model.Types, def)
+	}
+
+	for _, path := range doc.Paths {
+		for _, method := range path.Value {
+			op, err := buildOperation(doc, path.Key, strings.ToUpper(method.Key), method.Value)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", strings.ToUpper(method.Key), path.Key, err)
+			}
+			model.Operations = append(model.Operations, op)
+		}
+	}
+	return model, nil
+}
+
+func buildOperation(doc *document, path, method string, spec *operationSpec) (*operation, error) {
+	if spec.OperationID == "" {
+		return nil, fmt.Errorf("missing operationId")
+	}
+	op := &operation{
+		ID:      spec.OperationID,
+		Name:    goName(spec.OperationID),
+		Method:  method,
+		Path:    path,
+		Summary: spec.Summary,
+		Secured: len(doc.Security) > 0,
+	}
+	if spec.Security != nil {
+		op.Secured = len(*spec.Security) > 0
+	}
+
+	for _, p := range spec.Parameters {
+		if p.Ref != "" {
+			resolved, ok := doc.Components.Parameters[refName(p.Ref)]
+			if !ok {
+				return nil, fmt.Errorf("unknown parameter %s", p.Ref)
+			}
+			p = resolved
+		}
+		par := &param{JSONName: p.Name, Name: goName(p.Name), Description: p.Description, Required: p.Required, Schema: p.Schema}
+		switch p.In {
+		case "path":
+			op.PathParams = append(op.PathParams, par)
+		case "query":
+			op.QueryParams = append(op.QueryParams, par)
+		default:
+			return nil, fmt.Errorf("parameter %s: unsupported location %q", p.Name, p.In)
+		}
+	}
+
+	if spec.RequestBody != nil {
+		media, ok := spec.RequestBody.Content["application/json"]
+		if !ok {
+			return nil, fmt.Errorf("request body must be application/json")
+		}
+		op.Body = media.Schema
+	}
+
+	for _, response := range spec.Responses {
+		status, err := strconv.Atoi(response.Key)
+		if err != nil || status < 200 || status > 299 {
+			continue
+		}
+		op.Status = status
+		if media, ok := response.Value.Content["application/json"]; ok {
+			op.Result = media.Schema
+		}
+		break
+	}
+	if op.Status == 0 {
+		return nil, fmt.Errorf("no success response")
+	}
+
+	// Operations taking limit and offset and returning a list can be iterated page by page
+	limit := op.queryParam("limit")
+	if limit != nil && op.queryParam("offset") != nil && op.Result != nil && op.Result.Type == "array" {
+		op.PageSize = 100
+		if limit.Schema.Maximum != nil {
+			op.PageSize = *limit.Schema.Maximum
+		}
+	}
+	return op, nil
+}
+
+func (op *operation) queryParam(name string) *param {
+	for _, p := range op.QueryParams {
+		if p.JSONName == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// Idempotent reports whether the operation may be retried after a failure
+func (op *operation) Idempotent() bool {
+	switch op.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// initialisms are kept upper case in Go identifiers
+var initialisms = map[string]bool{"ID": true, "URL": true, "API": true, "HTTP": true, "JSON": true, "IP": true}
+
+// goName turns a JSON name or operationId into an exported Go identifier,
+// e.g. "patient_id" and "patientId" into "PatientID"
+func goName(name string) string {
+	var words []string
+	start := 0
+	runes := []rune(name)
+	for i, r := range runes {
+		switch {
+		case r == '_' || r == '-' || r == ' ':
+			words = append(words, string(runes[start:i]))
+			start = i + 1
+		case i > 0 && unicode.IsUpper(r) && unicode.IsLower(runes[i-1]):
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	words = append(words, string(runes[start:]))
+
+	var b strings.Builder
+	for _, word := range words {
+		if word == "" {
+			continue
+		}
+		if upper := strings.ToUpper(word); initialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return b.String()
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// permissionDoc is the subset of the permission catalogue (GET /api/auth/permissions, or
+// cmd/permdoc) the generator reads
+type permissionDoc struct {
+	Permissions []struct {
+		RequiredBy []permissionRequirement `json:"required_by"`
+	} `json:"permissions"`
+}
+
+type permissionRequirement struct {
+	Kind        string   `json:"kind"`
+	Method      string   `json:"method"`
+	Path        string   `json:"path"`
+	Match       string   `json:"match"`
+	Permissions []string `json:"permissions"`
+}
+
+// annotatePermissions sets Requires on the operations whose route the catalogue lists as guarded.
+// Routes are matched by method and path, ignoring the names of path parameters.
+func annotatePermissions(model *api, catalogue string) error {
+	data, err := os.ReadFile(catalogue)
+	if err != nil {
+		return err
+	}
+	var doc permissionDoc
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("%s: %w", catalogue, err)
+	}
+
+	// A requirement is listed under each permission it names; keep one copy
+	byRoute := map[string][]permissionRequirement{}
+	seen := map[string]bool{}
+	for _, p := range doc.Permissions {
+		for _, req := range p.RequiredBy {
+			if req.Kind != "route" {
+				continue
+			}
+			route := routeKey(req.Method, req.Path)
+			key := route + " " + req.Match + " " + strings.Join(req.Permissions, ",")
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			byRoute[route] = append(byRoute[route], req)
+		}
+	}
+
+	for _, op := range model.Operations {
+		reqs := byRoute[routeKey(op.Method, op.Path)]
+		clauses := make([]string, 0, len(reqs))
+		for _, req := range reqs {
+			clauses = append(clauses, requirementText(req))
+		}
+		if len(clauses) > 0 {
+			op.Requires = "Requires " + strings.Join(clauses, " and ") + "."
+		}
+	}
+	return nil
+}
+
+func routeKey(method, path string) string {
+	return strings.ToUpper(method) + " " + pathParamPattern.ReplaceAllString(strings.TrimSuffix(path, "/"), "{}")
+}
+
+func requirementText(req permissionRequirement) string {
+	if len(req.Permissions) == 1 {
+		return req.Permissions[0]
+	}
+	return req.Match + " of " + strings.Join(req.Permissions, ", ")
+}
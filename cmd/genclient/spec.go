@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// document is the subset of OpenAPI 3.0 the generator understands
+type document struct {
+	OpenAPI    string                           `yaml:"openapi"`
+	Info       info                             `yaml:"info"`
+	Security   []map[string][]string            `yaml:"security"`
+	Paths      ordered[ordered[*operationSpec]] `yaml:"paths"`
+	Components components                       `yaml:"components"`
+}
+
+type info struct {
+	Title   string `yaml:"title"`
+	Version string `yaml:"version"`
+}
+
+type components struct {
+	Schemas    ordered[*schema]          `yaml:"schemas"`
+	Parameters map[string]*parameterSpec `yaml:"parameters"`
+}
+
+type operationSpec struct {
+	OperationID string                 `yaml:"operationId"`
+	Summary     string                 `yaml:"summary"`
+	Security    *[]map[string][]string `yaml:"security"` // nil inherits the document security
+	Parameters  []*parameterSpec       `yaml:"parameters"`
+	RequestBody *requestBodySpec       `yaml:"requestBody"`
+	Responses   ordered[*responseSpec] `yaml:"responses"`
+}
+
+type parameterSpec struct {
+	Ref         string  `yaml:"$ref"`
+	Name        string  `yaml:"name"`
+	In          string  `yaml:"in"`
+	Description string  `yaml:"description"`
+	Required    bool    `yaml:"required"`
+	Schema      *schema `yaml:"schema"`
+}
+
+type requestBodySpec struct {
+	Required bool                  `yaml:"required"`
+	Content  map[string]*mediaType `yaml:"content"`
+}
+
+type responseSpec struct {
+	Description string                `yaml:"description"`
+	Content     map[string]*mediaType `yaml:"content"`
+}
+
+type mediaType struct {
+	Schema *schema `yaml:"schema"`
+}
+
+type schema struct {
+	Ref         string           `yaml:"$ref"`
+	Type        string           `yaml:"type"`
+	Format      string           `yaml:"format"`
+	Description string           `yaml:"description"`
+	Nullable    bool             `yaml:"nullable"`
+	Enum        []string         `yaml:"enum"`
+	Maximum     *int             `yaml:"maximum"`
+	Required    []string         `yaml:"required"`
+	Properties  ordered[*schema] `yaml:"properties"`
+	Items       *schema          `yaml:"items"`
+}
+
+// entry is one key of a YAML mapping
+type entry[T any] struct {
+	Key   string
+	Value T
+}
+
+// ordered decodes a YAML mapping keeping the order of its keys, so the generated code follows
+// the order of the spec
+type ordered[T any] []entry[T]
+
+func (o *ordered[T]) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: expected a mapping", node.Line)
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		var value T
+		if err := node.Content[i+1].Decode(&value); err != nil {
+			return err
+		}
+		*o = append(*o, entry[T]{Key: node.Content[i].Value, Value: value})
+	}
+	return nil
+}
+
+// lookup returns the value of key
+func (o ordered[T]) lookup(key string) (T, bool) {
+	for _, e := range o {
+		if e.Key == key {
+			return e.Value, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// loadSpec reads and decodes the OpenAPI document at path
+func loadSpec(path string) (*document, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc document
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if !strings.HasPrefix(doc.OpenAPI, "3.") {
+		return nil, fmt.Errorf("%s: unsupported OpenAPI version %q, expected 3.x", path, doc.OpenAPI)
+	}
+	return &doc, nil
+}
+
+// refName returns the component name a $ref points to, e.g. "Patient" for
+// "#/components/schemas/Patient"
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
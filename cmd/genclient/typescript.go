@@ -0,0 +1,321 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+// generateTS renders the TypeScript client as a single module using fetch
+func generateTS(model *api, source string) ([]byte, error) {
+	tmpl, err := template.New("ts").Funcs(template.FuncMap{
+		"tsType":   tsType,
+		"elemType": func(s *schema) string { return tsType(s.Items) },
+		"pathExpr": tsPathExpr,
+		"pathVar":  paramVar,
+		"comment":  tsComment,
+		"hasOp":    func(id string) bool { return model.operation(id) != nil },
+		"requiredQuery": func(op *operation) bool {
+			return slices.ContainsFunc(op.QueryParams, func(p *param) bool { return p.Required })
+		},
+	}).Parse(tsTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	data := struct {
+		*api
+		Source string
+	}{model, source}
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// tsType returns the TypeScript type of a schema
+func tsType(s *schema) string {
+	if s.Ref != "" {
+		return refName(s.Ref)
+	}
+	var t string
+	switch s.Type {
+	case "string":
+		t = "string"
+		if len(s.Enum) > 0 {
+			values := make([]string, len(s.Enum))
+			for i, value := range s.Enum {
+				values[i] = fmt.Sprintf("%q", value)
+			}
+			t = strings.Join(values, " | ")
+		}
+	case "integer", "number":
+		t = "number"
+	case "boolean":
+		t = "boolean"
+	case "array":
+		item := tsType(s.Items)
+		if strings.Contains(item, " ") {
+			item = "(" + item + ")"
+		}
+		return item + "[]"
+	default:
+		return "Record<string, unknown>"
+	}
+	if s.Nullable {
+		return t + " | null"
+	}
+	return t
+}
+
+// tsPathExpr returns a template literal building the request path from the path parameters
+func tsPathExpr(op *operation) string {
+	return "`" + pathParamPattern.ReplaceAllStringFunc(op.Path, func(match string) string {
+		return "${encodeURIComponent(" + paramVar(match[1:len(match)-1]) + ")}"
+	}) + "`"
+}
+
+// tsComment turns text into a /** */ doc comment at the given indentation
+func tsComment(indent, text string) string {
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	if len(lines) == 1 {
+		return "/** " + lines[0] + " */\n" + indent
+	}
+	var b strings.Builder
+	b.WriteString("/**\n")
+	for _, line := range lines {
+		b.WriteString(indent + " * " + strings.TrimSpace(line) + "\n")
+	}
+	b.WriteString(indent + " */\n" + indent)
+	return b.String()
+}
+
+const tsTemplate = `// Code generated by genclient from {{.Source}}. DO NOT EDIT.
+//
+// Typed client for the {{.Title}} ({{.Version}}). Requests time out after 30s and, when the token
+// source can renew its token, are sent once more after a 401 response. Idempotent requests may
+// additionally be retried on transient failures by setting maxRetries.
+{{range .Types}}
+{{if .Description}}{{comment "" .Description}}{{end}}export interface {{.Name}} {
+{{- range .Fields}}
+  {{if .Description}}{{comment "  " .Description}}{{end}}{{.JSONName}}{{if not .Required}}?{{end}}: {{tsType .Schema}};
+{{- end}}
+}
+{{end}}
+{{- range .Operations}}{{if .QueryParams}}
+export interface {{.Name}}Params {
+{{- range .QueryParams}}
+  {{if .Description}}{{comment "  " .Description}}{{end}}{{.JSONName}}{{if not .Required}}?{{end}}: {{tsType .Schema}};
+{{- end}}
+}
+{{end}}{{end}}
+/** Provides the bearer token sent with secured operations */
+export interface TokenSource {
+  token(): Promise<string>;
+  /** Called with a token the server rejected; the request is then sent once more */
+  invalidate?(rejected: string): void;
+}
+
+export interface ClientConfig {
+  /** e.g. http://localhost:8080 */
+  baseUrl: string;
+  /** Per-request timeout, default 30000 */
+  timeoutMs?: number;
+  tokens?: TokenSource;
+  /** Retries idempotent requests failing with a network error or a 429, 502, 503 or 504 response; default 0 */
+  maxRetries?: number;
+  /** Delay before the first retry, doubled for each further one; default 200 */
+  retryBackoffMs?: number;
+  fetch?: typeof fetch;
+}
+
+/** A non-2xx response of the API */
+export class ApiError extends Error {
+  constructor(
+    readonly status: number,
+    readonly code: string,
+    message: string,
+    readonly details?: unknown,
+  ) {
+    super(code ? ` + "`" + `api error ${status} ${code}: ${message}` + "`" + ` : ` + "`" + `api error ${status}: ${message}` + "`" + `);
+    this.name = "ApiError";
+  }
+}
+
+/** A fixed bearer token, e.g. a service token issued out of band */
+export function staticToken(token: string): TokenSource {
+  return { token: async () => token };
+}
+
+type Query = Record<string, string | number | boolean | null | undefined>;
+
+const transientStatuses = new Set([429, 502, 503, 504]);
+const idempotentMethods = new Set(["GET", "HEAD", "PUT", "DELETE"]);
+
+export class Client {
+  private readonly baseUrl: string;
+  private readonly timeoutMs: number;
+  private readonly maxRetries: number;
+  private readonly retryBackoffMs: number;
+  private readonly fetchFn: typeof fetch;
+  tokens?: TokenSource;
+
+  constructor(config: ClientConfig) {
+    this.baseUrl = config.baseUrl.replace(/\/$/, "");
+    this.timeoutMs = config.timeoutMs ?? 30000;
+    this.maxRetries = config.maxRetries ?? 0;
+    this.retryBackoffMs = config.retryBackoffMs ?? 200;
+    this.fetchFn = config.fetch ?? fetch.bind(globalThis);
+    this.tokens = config.tokens;
+  }
+{{range .Operations}}
+  /** {{.Method}} {{.Path}}{{if .Summary}}: {{.Summary}}{{end}}{{if .Requires}}. {{.Requires}}{{end}} */
+  {{.ID}}(
+    {{- range .PathParams}}{{pathVar .JSONName}}: string, {{end}}
+    {{- if .QueryParams}}params: {{.Name}}Params{{if not (requiredQuery .)}} = {}{{end}}{{if .Body}}, {{end}}{{end}}
+    {{- if .Body}}body: {{tsType .Body}}{{end}}): Promise<{{if .Result}}{{tsType .Result}}{{else}}void{{end}}> {
+    return this.request("{{.Method}}", {{pathExpr .}}, {{if .QueryParams}}params as Query{{else}}undefined{{end}}, {{.Secured}}{{if .Body}}, body{{end}});
+  }
+{{if .PageSize}}
+  /** Iterates over all results of {{.ID}}, fetching params.limit items per request ({{.PageSize}} when unset) */
+  async *{{.ID}}All(params: {{.Name}}Params = {}): AsyncGenerator<{{elemType .Result}}> {
+    const limit = params.limit ?? {{.PageSize}};
+    let offset = params.offset ?? 0;
+    for (;;) {
+      const page = await this.{{.ID}}({ ...params, limit, offset });
+      yield* page;
+      if (page.length < limit) {
+        return;
+      }
+      offset += page.length;
+    }
+  }
+{{end}}{{end}}
+  private async request<T>(method: string, path: string, query: Query | undefined, secured: boolean, body?: unknown): Promise<T> {
+    let url = this.baseUrl + path;
+    if (query) {
+      const search = new URLSearchParams();
+      for (const [key, value] of Object.entries(query)) {
+        if (value !== undefined && value !== null && value !== "") {
+          search.set(key, String(value));
+        }
+      }
+      if ([...search].length > 0) {
+        url += "?" + search.toString();
+      }
+    }
+
+    let renewed = false;
+    for (let retry = 0; ; ) {
+      const headers: Record<string, string> = { Accept: "application/json" };
+      if (body !== undefined) {
+        headers["Content-Type"] = "application/json";
+      }
+      let token = "";
+      if (secured && this.tokens) {
+        token = await this.tokens.token();
+        headers["Authorization"] = "Bearer " + token;
+      }
+
+      let response: Response;
+      try {
+        response = await this.fetchFn(url, {
+          method,
+          headers,
+          body: body === undefined ? undefined : JSON.stringify(body),
+          signal: AbortSignal.timeout(this.timeoutMs),
+        });
+      } catch (error) {
+        if (idempotentMethods.has(method) && retry < this.maxRetries) {
+          await sleep(this.retryBackoffMs * 2 ** retry++);
+          continue;
+        }
+        throw error;
+      }
+
+      if (response.status === 401 && secured && !renewed && this.tokens?.invalidate) {
+        this.tokens.invalidate(token);
+        renewed = true;
+        continue;
+      }
+      if (transientStatuses.has(response.status) && idempotentMethods.has(method) && retry < this.maxRetries) {
+        const after = Number(response.headers.get("Retry-After"));
+        await sleep(after > 0 ? after * 1000 : this.retryBackoffMs * 2 ** retry);
+        retry++;
+        continue;
+      }
+
+      const text = await response.text();
+      if (!response.ok) {
+        throw parseError(response.status, text);
+      }
+      return (text.trim() === "" ? undefined : JSON.parse(text)) as T;
+    }
+  }
+}
+{{if and (hasOp "login") (hasOp "refreshToken")}}
+/**
+ * Logs in with a username and password and renews the access token with the refresh token shortly
+ * before it expires, logging in again when the refresh is refused
+ */
+export class PasswordTokenSource implements TokenSource {
+  private current?: TokenResponse;
+  private expiresAt = 0;
+  private pending?: Promise<string>;
+
+  constructor(
+    private readonly client: Client,
+    private readonly username: string,
+    private readonly password: string,
+  ) {}
+
+  token(): Promise<string> {
+    if (this.current?.access_token && this.expiresAt - Date.now() > 30000) {
+      return Promise.resolve(this.current.access_token);
+    }
+    this.pending ??= this.renew().finally(() => (this.pending = undefined));
+    return this.pending;
+  }
+
+  invalidate(rejected: string): void {
+    if (this.current?.access_token === rejected) {
+      this.current.access_token = "";
+    }
+  }
+
+  private async renew(): Promise<string> {
+    let token: TokenResponse | undefined;
+    if (this.current?.refresh_token) {
+      token = await this.client.refreshToken({ refresh_token: this.current.refresh_token }).catch(() => undefined);
+    }
+    token ??= await this.client.login({ username: this.username, password: this.password });
+    this.current = token;
+    this.expiresAt = Date.now() + (token.expires_in ?? 0) * 1000;
+    return token.access_token ?? "";
+  }
+}
+{{end}}
+function sleep(ms: number): Promise<void> {
+  return new Promise((resolve) => setTimeout(resolve, ms));
+}
+
+// parseError reads both error bodies of the API: {"error":{"code","message","details"}} and
+// {"error":"code","message","details"}. Other bodies become the message as they are.
+function parseError(status: number, text: string): ApiError {
+  try {
+    const body = JSON.parse(text);
+    if (body && typeof body.error === "object" && body.error !== null) {
+      return new ApiError(status, body.error.code ?? "", body.error.message ?? "", body.error.details);
+    }
+    if (body && typeof body.error === "string") {
+      return new ApiError(status, body.error, body.message ?? "", body.details);
+    }
+  } catch {
+    // Not JSON
+  }
+  return new ApiError(status, "", text.trim());
+}
+`
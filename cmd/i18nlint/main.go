@@ -0,0 +1,158 @@
+// Command i18nlint checks the message catalogs of internal/platform/i18n against each other and
+// against the code, so no page or validation message falls back to English or to a bare key:
+//
+//   - every locale has exactly the keys of the default (en) catalog, with the same placeholders
+//   - every locale has a locale.<code> name for the language switcher
+//   - every key given as a literal to i18n.T, i18n.Translate or i18n.Key in .go and .templ files
+//     has a message
+//
+// Keys no code refers to are listed as warnings. Usage:
+//
+//	go run ./cmd/i18nlint            # check the repository in the working directory
+//	go run ./cmd/i18nlint -root ../x # check another checkout
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"pharmacy-modernization-project-model/internal/platform/i18n"
+)
+
+var (
+	// keyUses find the literal keys of T, Translate and Key calls, also unqualified ones within
+	// the i18n package; a key built by concatenation is not a literal and is skipped
+	keyUses = []*regexp.Regexp{
+		regexp.MustCompile(`(?:i18n\.|^|[^\w.])(?:T|Translate)\(\s*[^,"]+,\s*"([^"]+)"\s*[,)]`),
+		regexp.MustCompile(`(?:i18n\.|^|[^\w.])Key\(\s*"([^"]+)"\s*\)`),
+	}
+	// placeholder matches the fmt verbs of a message
+	placeholder = regexp.MustCompile(`%[-+# 0]*[0-9]*(?:\.[0-9]+)?[a-zA-Z%]`)
+	// skippedDirs are never scanned for keys
+	skippedDirs = []string{".git", "node_modules", "vendor", "tmp"}
+)
+
+// use is a key found in the code
+type use struct {
+	key  string
+	file string
+	line int
+}
+
+func main() {
+	root := flag.String("root", ".", "repository to scan for message keys")
+	flag.Parse()
+
+	fmt.Println("🌐 Checking i18n message catalogs...")
+
+	reference := i18n.Messages(i18n.DefaultLocale)
+	var problems []string
+	for _, locale := range i18n.Locales() {
+		problems = append(problems, checkCatalog(locale, reference)...)
+	}
+
+	uses, err := findUses(*root)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Failed to scan %s: %v\n", *root, err)
+		os.Exit(1)
+	}
+	used := map[string]bool{}
+	for _, u := range uses {
+		used[u.key] = true
+		if _, ok := reference[u.key]; !ok {
+			problems = append(problems, fmt.Sprintf("%s:%d: key %q has no message in %s.yaml", u.file, u.line, u.key, i18n.DefaultLocale))
+		}
+	}
+
+	for _, key := range sortedKeys(reference) {
+		if !used[key] && !strings.HasPrefix(key, "locale.") {
+			fmt.Printf("   ⚠️  %s is not used by any code\n", key)
+		}
+	}
+
+	if len(problems) > 0 {
+		for _, problem := range problems {
+			fmt.Printf("   ❌ %s\n", problem)
+		}
+		fmt.Printf("📊 %d problems in %d locales (%d keys, %d uses)\n", len(problems), len(i18n.Locales()), len(reference), len(uses))
+		os.Exit(1)
+	}
+	fmt.Printf("📊 %d locales, %d keys, %d uses: ok\n", len(i18n.Locales()), len(reference), len(uses))
+}
+
+// checkCatalog compares a locale's catalog with the reference one
+func checkCatalog(locale string, reference map[string]string) []string {
+	messages := i18n.Messages(locale)
+	var problems []string
+	for _, key := range sortedKeys(reference) {
+		message, ok := messages[key]
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("%s.yaml: missing %s", locale, key))
+		case strings.TrimSpace(message) == "":
+			problems = append(problems, fmt.Sprintf("%s.yaml: %s is empty", locale, key))
+		case !slices.Equal(placeholder.FindAllString(message, -1), placeholder.FindAllString(reference[key], -1)):
+			problems = append(problems, fmt.Sprintf("%s.yaml: %s has placeholders %v, %s.yaml has %v", locale, key,
+				placeholder.FindAllString(message, -1), i18n.DefaultLocale, placeholder.FindAllString(reference[key], -1)))
+		}
+	}
+	for _, key := range sortedKeys(messages) {
+		if _, ok := reference[key]; !ok {
+			problems = append(problems, fmt.Sprintf("%s.yaml: %s is not in %s.yaml", locale, key, i18n.DefaultLocale))
+		}
+	}
+	if _, ok := reference["locale."+locale]; !ok {
+		problems = append(problems, fmt.Sprintf("%s.yaml: no locale.%s name for the language switcher", i18n.DefaultLocale, locale))
+	}
+	return problems
+}
+
+// findUses returns the literal keys of the .go and .templ files under root; generated templ
+// code is skipped, its .templ source is scanned instead
+func findUses(root string) ([]use, error) {
+	var uses []use
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != root && slices.Contains(skippedDirs, d.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		name := d.Name()
+		if strings.HasSuffix(name, "_templ.go") || (!strings.HasSuffix(name, ".go") && !strings.HasSuffix(name, ".templ")) {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		for i, line := range strings.Split(string(data), "\n") {
+			for _, re := range keyUses {
+				for _, match := range re.FindAllStringSubmatch(line, -1) {
+					uses = append(uses, use{key: match[1], file: path, line: i + 1})
+				}
+			}
+		}
+		return nil
+	})
+	return uses, err
+}
+
+func sortedKeys(messages map[string]string) []string {
+	keys := make([]string, 0, len(messages))
+	for key := range messages {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
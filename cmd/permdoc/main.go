@@ -0,0 +1,49 @@
+// Command permdoc writes the permission catalogue as served by GET /api/auth/permissions: each
+// permission with its description and the routes and GraphQL fields that require it. It wires the
+// application with the same configuration as the server (internal/configs/app.yaml, app.<env>.yaml
+// and RX_ environment overrides) without serving, so the route list matches the server's.
+//
+// genclient reads the output to annotate the client operations with the permissions they need:
+//
+//	go run ./cmd/permdoc -out api/permissions.json
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	"pharmacy-modernization-project-model/internal/app"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/config"
+	"pharmacy-modernization-project-model/internal/platform/permissions"
+)
+
+func main() {
+	out := flag.String("out", "", "output file (default stdout)")
+	flag.Parse()
+
+	if _, err := app.New(config.Load()); err != nil {
+		log.Fatal(err)
+	}
+
+	doc, err := json.MarshalIndent(auth.PermissionsResponse{Permissions: permissions.Describe()}, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	doc = append(doc, '\n')
+
+	if *out == "" {
+		os.Stdout.Write(doc)
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(*out), 0o755); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, doc, 0o644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("Documented %d permissions and %d requirements into %s", len(permissions.All()), len(permissions.Requirements()), *out)
+}
@@ -0,0 +1,201 @@
+// Command reindex builds the indexes of a plan file on a live database without blocking writes,
+// using the same configuration as the server (internal/configs/app.yaml, app.<env>.yaml and RX_
+// environment overrides). See internal/platform/reindex for the plan format; plans/ has examples.
+//
+// The rolling strategy (the default) builds one index at a time and waits for secondaries to be
+// within --max-lag of the primary before each; batch builds all indexes of a collection at once.
+// Progress is printed while a build runs. Once an index exists, the plan's target queries for it
+// are explained and the step fails unless their winning plan scans it.
+//
+// Every run is recorded in the migrations collection. Ctrl-C (or --pause <run> from another
+// shell) stops the run after the build in progress; a second Ctrl-C exits at once, leaving the
+// server to finish that build. --resume <run> continues a paused or failed run with the indexes
+// not built yet, waiting for builds still running on the server. --list shows the latest runs.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/app/builder"
+	"pharmacy-modernization-project-model/internal/platform/config"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/migrations"
+	"pharmacy-modernization-project-model/internal/platform/reindex"
+)
+
+type reindexOptions struct {
+	env          string
+	plan         string
+	resume       string
+	pause        string
+	list         bool
+	maxLag       time.Duration
+	pollInterval time.Duration
+}
+
+func main() {
+	opts, err := parseFlags(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	var plan reindex.Plan
+	if opts.plan != "" {
+		if plan, err = reindex.LoadPlan(opts.plan); err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+	}
+
+	// config.Load picks app.<env>.yaml from RX_APP_ENV, the same way the server does
+	if opts.env != "" {
+		os.Setenv("RX_APP_ENV", opts.env)
+	}
+	cfg := config.Load()
+	// Index builds of large collections outlast the server's socket timeout
+	cfg.Database.MongoDB.Connection.SocketTimeout = "0s"
+	fmt.Printf("🗂️  Reindexing (env: %s, database: %s)\n", cfg.App.Env, cfg.Database.MongoDB.Database)
+
+	connMgr, err := builder.CreateMongoDBConnection(cfg, zap.NewNop())
+	if err != nil {
+		var cfgErr platformErrors.ConfigurationError
+		if errors.As(err, &cfgErr) && cfgErr.Setting == "mongodb.uri" {
+			log.Fatal("❌ MongoDB URI is not configured. Set RX_DATABASE_MONGODB_URI (see .dev/.env.example)")
+		}
+		log.Fatalf("❌ Failed to connect to MongoDB: %v", err)
+	}
+	defer connMgr.Close()
+
+	ctx := context.Background()
+	history := migrations.NewHistory(builder.GetMigrationsCollection(connMgr), zap.NewNop())
+	if err := history.CreateIndexes(ctx); err != nil {
+		log.Printf("⚠️  Failed to create migration history indexes: %v", err)
+	}
+
+	switch {
+	case opts.list:
+		listRuns(ctx, history)
+		return
+	case opts.pause != "":
+		if err := history.RequestPause(ctx, opts.pause); err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+		fmt.Printf("⏸️  Pause requested; run %s stops after its current build\n", opts.pause)
+		return
+	}
+
+	// The first interrupt pauses after the build in progress, the second cancels it
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	reindexer := reindex.New(connMgr.GetClient(), connMgr.GetCollection, history, reindex.Options{
+		MaxLag:       opts.maxLag,
+		PollInterval: opts.pollInterval,
+		Notify:       func(message string) { fmt.Printf("   %s %s\n", time.Now().Format("15:04:05"), message) },
+	})
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-signals
+		fmt.Println("\n⏸️  Pausing after the build in progress (interrupt again to stop now)")
+		reindexer.Pause()
+		<-signals
+		cancel()
+	}()
+
+	var run migrations.Run
+	if opts.resume != "" {
+		fmt.Printf("▶️  Resuming run %s\n", opts.resume)
+		run, err = reindexer.Resume(ctx, opts.resume)
+	} else {
+		fmt.Printf("📋 Plan %s: %d indexes, %s strategy\n", plan.Name, len(plan.Indexes), plan.Strategy)
+		run, err = reindexer.Start(ctx, plan)
+	}
+	if run.ID != "" {
+		printRun(run)
+	}
+
+	switch {
+	case errors.Is(err, reindex.ErrPaused):
+		fmt.Printf("\n⏸️  Paused; continue with: go run ./cmd/reindex --resume %s\n", run.ID)
+	case err != nil:
+		log.Fatalf("❌ %v", err)
+	case run.Status == migrations.StatusFailed:
+		fmt.Printf("\n❌ Finished with failures; fix them and run: go run ./cmd/reindex --resume %s\n", run.ID)
+		os.Exit(1)
+	default:
+		fmt.Println("\n🎉 All indexes built and verified")
+	}
+}
+
+func listRuns(ctx context.Context, history *migrations.History) {
+	runs, err := history.List(ctx, reindex.Kind, 10)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	if len(runs) == 0 {
+		fmt.Println("No reindex runs recorded")
+		return
+	}
+	for _, run := range runs {
+		printRun(run)
+	}
+}
+
+func printRun(run migrations.Run) {
+	fmt.Printf("\n%s  %s  %s  started %s on %s\n", run.ID, run.Name, run.Status, run.StartedAt.Local().Format(time.DateTime), run.Host)
+	for _, step := range run.Steps {
+		line := fmt.Sprintf("   %-10s %s", step.Status, step.Name)
+		if step.DurationMs > 0 {
+			line += fmt.Sprintf(" (%s)", (time.Duration(step.DurationMs) * time.Millisecond).Round(time.Second))
+		}
+		fmt.Println(line)
+		for _, note := range step.Notes {
+			fmt.Printf("              %s\n", note)
+		}
+		if step.Error != "" {
+			fmt.Printf("              ❌ %s\n", step.Error)
+		}
+	}
+	if run.Error != "" {
+		fmt.Printf("   ❌ %s\n", run.Error)
+	}
+}
+
+func parseFlags(args []string) (reindexOptions, error) {
+	opts := reindexOptions{}
+
+	fs := flag.NewFlagSet("reindex", flag.ContinueOnError)
+	fs.StringVar(&opts.env, "env", "", "config environment to load (sets RX_APP_ENV, e.g. dev or prod)")
+	fs.StringVar(&opts.plan, "plan", "", "plan file listing the indexes to build (extended JSON)")
+	fs.StringVar(&opts.resume, "resume", "", "ID of a paused or failed run to continue")
+	fs.StringVar(&opts.pause, "pause", "", "ID of a running run to pause after its current build")
+	fs.BoolVar(&opts.list, "list", false, "show the latest reindex runs")
+	fs.DurationVar(&opts.maxLag, "max-lag", 10*time.Second, "rolling builds wait until secondaries are this close to the primary")
+	fs.DurationVar(&opts.pollInterval, "poll", 5*time.Second, "how often build progress and replication lag are checked")
+	if err := fs.Parse(args); err != nil {
+		return opts, err
+	}
+
+	modes := 0
+	for _, set := range []bool{opts.plan != "", opts.resume != "", opts.pause != "", opts.list} {
+		if set {
+			modes++
+		}
+	}
+	if modes != 1 {
+		return opts, fmt.Errorf("use exactly one of --plan, --resume, --pause or --list")
+	}
+	return opts, nil
+}
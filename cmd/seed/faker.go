@@ -0,0 +1,216 @@
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	prescriptionModel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	prescriptionrepo "pharmacy-modernization-project-model/domain/prescription/repository"
+	"pharmacy-modernization-project-model/internal/platform/dates"
+)
+
+// faker generates realistic-looking patients, addresses and prescriptions. The same seed
+// always produces the same records, so generated IDs stay stable across runs and upserts
+// do not pile up duplicates.
+type faker struct {
+	rnd *rand.Rand
+	now time.Time
+}
+
+type fakeState struct {
+	code      string
+	cities    []string
+	zipPrefix string
+	areaCodes []string
+}
+
+var (
+	firstNames = []string{
+		"Aiden", "Amara", "Benjamin", "Camila", "Caleb", "Chloe", "Daniel", "Elena", "Elijah", "Fatima",
+		"Gabriel", "Grace", "Hannah", "Henry", "Isaac", "Jasmine", "Julian", "Layla", "Leo", "Maya",
+		"Mateo", "Nora", "Oliver", "Priya", "Quinn", "Riley", "Samuel", "Sofia", "Theo", "Valentina",
+		"William", "Yusuf", "Zoe", "Aaliyah", "Diego", "Hiro", "Ingrid", "Kwame", "Lucia", "Omar",
+	}
+	lastNames = []string{
+		"Adams", "Baker", "Campbell", "Nguyen", "Diaz", "Evans", "Fischer", "Gonzalez", "Hughes", "Ito",
+		"Jackson", "Kim", "Lopez", "Mitchell", "Nelson", "O'Brien", "Perez", "Reyes", "Singh", "Turner",
+		"Walker", "Young", "Zhang", "Okafor", "Schmidt", "Kowalski", "Haddad", "Murphy", "Sato", "Silva",
+	}
+	streetNames = []string{
+		"Oak", "Maple", "Cedar", "Pine", "Elm", "Washington", "Lincoln", "Jefferson", "Highland", "Lakeview",
+		"Sunset", "Riverside", "Hillcrest", "Meadow", "Park", "Church", "Mill", "Spring", "Willow", "Franklin",
+	}
+	streetSuffixes = []string{"St", "Ave", "Blvd", "Rd", "Ln", "Dr", "Ct", "Way", "Pl"}
+	unitPrefixes   = []string{"Apt", "Unit", "Suite", "Floor"}
+
+	fakeStates = []fakeState{
+		{code: "WA", cities: []string{"Seattle", "Spokane", "Tacoma"}, zipPrefix: "981", areaCodes: []string{"206", "253", "509"}},
+		{code: "CA", cities: []string{"Los Angeles", "San Diego", "San Jose", "Sacramento"}, zipPrefix: "900", areaCodes: []string{"213", "619", "408", "916"}},
+		{code: "TX", cities: []string{"Houston", "Austin", "Dallas", "San Antonio"}, zipPrefix: "770", areaCodes: []string{"713", "512", "214", "210"}},
+		{code: "NY", cities: []string{"New York", "Buffalo", "Rochester"}, zipPrefix: "100", areaCodes: []string{"212", "716", "585"}},
+		{code: "FL", cities: []string{"Miami", "Orlando", "Tampa"}, zipPrefix: "331", areaCodes: []string{"305", "407", "813"}},
+		{code: "IL", cities: []string{"Chicago", "Springfield", "Naperville"}, zipPrefix: "606", areaCodes: []string{"312", "217", "630"}},
+		{code: "MA", cities: []string{"Boston", "Worcester", "Cambridge"}, zipPrefix: "021", areaCodes: []string{"617", "508"}},
+		{code: "CO", cities: []string{"Denver", "Boulder", "Colorado Springs"}, zipPrefix: "802", areaCodes: []string{"303", "720", "719"}},
+		{code: "GA", cities: []string{"Atlanta", "Savannah", "Augusta"}, zipPrefix: "303", areaCodes: []string{"404", "912", "706"}},
+		{code: "AZ", cities: []string{"Phoenix", "Tucson", "Mesa"}, zipPrefix: "850", areaCodes: []string{"602", "520", "480"}},
+		{code: "OR", cities: []string{"Portland", "Eugene", "Salem"}, zipPrefix: "972", areaCodes: []string{"503", "541"}},
+		{code: "NV", cities: []string{"Las Vegas", "Reno", "Henderson"}, zipPrefix: "891", areaCodes: []string{"702", "775"}},
+	}
+
+	// Typical doses by generic name; drugs not listed fall back to fakeDefaultDose
+	fakeDoses = map[string][]string{
+		"Acetaminophen":       {"325mg", "500mg", "650mg"},
+		"Albuterol":           {"90mcg"},
+		"Amlodipine":          {"2.5mg", "5mg", "10mg"},
+		"Amoxicillin":         {"250mg", "500mg", "875mg"},
+		"Aspirin":             {"81mg", "325mg"},
+		"Atorvastatin":        {"10mg", "20mg", "40mg", "80mg"},
+		"Azithromycin":        {"250mg", "500mg"},
+		"Clopidogrel":         {"75mg"},
+		"Escitalopram":        {"5mg", "10mg", "20mg"},
+		"Furosemide":          {"20mg", "40mg"},
+		"Gabapentin":          {"100mg", "300mg", "600mg"},
+		"Hydrochlorothiazide": {"12.5mg", "25mg"},
+		"Ibuprofen":           {"200mg", "400mg", "600mg"},
+		"Insulin Glargine":    {"10 units", "20 units", "30 units"},
+		"Levothyroxine":       {"25mcg", "50mcg", "75mcg", "100mcg"},
+		"Lisinopril":          {"5mg", "10mg", "20mg"},
+		"Losartan":            {"25mg", "50mg", "100mg"},
+		"Metformin":           {"500mg", "850mg", "1000mg"},
+		"Metoprolol":          {"25mg", "50mg", "100mg"},
+		"Montelukast":         {"10mg"},
+		"Omeprazole":          {"20mg", "40mg"},
+		"Prednisone":          {"5mg", "10mg", "20mg"},
+		"Rosuvastatin":        {"5mg", "10mg", "20mg"},
+		"Sertraline":          {"25mg", "50mg", "100mg"},
+		"Simvastatin":         {"20mg", "40mg"},
+		"Tramadol":            {"50mg"},
+		"Warfarin":            {"2mg", "5mg"},
+	}
+
+	// Status weights roughly follow a live pharmacy: mostly active, some history, a few drafts
+	fakeStatuses = []struct {
+		status prescriptionModel.Status
+		weight int
+	}{
+		{prescriptionModel.Active, 55},
+		{prescriptionModel.Completed, 20},
+		{prescriptionModel.Paused, 10},
+		{prescriptionModel.Draft, 15},
+	}
+)
+
+const fakeDefaultDose = "1 tablet"
+
+func newFaker(seed uint64, now time.Time) *faker {
+	return &faker{rnd: rand.New(rand.NewPCG(seed, seed^0x5eed)), now: now}
+}
+
+// generate builds count patients with one or two addresses and up to four prescriptions each
+func (f *faker) generate(count int) dataset {
+	data := dataset{}
+	for i := 1; i <= count; i++ {
+		patient := f.patient(fmt.Sprintf("FP%05d", i))
+		data.patients = append(data.patients, patient)
+
+		state := f.state(patient.State)
+		addresses := 1 + f.rnd.IntN(2)
+		for n := 1; n <= addresses; n++ {
+			data.addresses = append(data.addresses, f.address(fmt.Sprintf("FA%05d-%d", i, n), patient.ID, state))
+		}
+		prescriptions := f.rnd.IntN(5)
+		for n := 1; n <= prescriptions; n++ {
+			data.prescriptions = append(data.prescriptions, f.prescription(fmt.Sprintf("FRX%05d-%d", i, n), patient))
+		}
+	}
+	return data
+}
+
+func (f *faker) patient(id string) patientModel.Patient {
+	state := fakeStates[f.rnd.IntN(len(fakeStates))]
+	born := time.Date(1940, time.January, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, f.rnd.IntN(72*365))
+	return patientModel.Patient{
+		ID:        id,
+		Name:      pick(f.rnd, firstNames) + " " + pick(f.rnd, lastNames),
+		DOB:       dates.Full(born.Year(), born.Month(), born.Day()),
+		Phone:     fmt.Sprintf("(%s) %03d-%04d", pick(f.rnd, state.areaCodes), 200+f.rnd.IntN(800), f.rnd.IntN(10000)),
+		State:     state.code,
+		CreatedAt: f.now.Add(-time.Duration(f.rnd.IntN(365*24)) * time.Hour),
+	}
+}
+
+func (f *faker) address(id, patientID string, state fakeState) patientModel.Address {
+	line2 := ""
+	if f.rnd.IntN(4) == 0 {
+		line2 = fmt.Sprintf("%s %d", pick(f.rnd, unitPrefixes), 1+f.rnd.IntN(40))
+	}
+	return patientModel.Address{
+		ID:        id,
+		PatientID: patientID,
+		Line1:     fmt.Sprintf("%d %s %s", 100+f.rnd.IntN(9900), pick(f.rnd, streetNames), pick(f.rnd, streetSuffixes)),
+		Line2:     line2,
+		City:      pick(f.rnd, state.cities),
+		State:     state.code,
+		Zip:       fmt.Sprintf("%s%02d", state.zipPrefix, f.rnd.IntN(100)),
+	}
+}
+
+// prescription is dated between the patient's creation and now
+func (f *faker) prescription(id string, patient patientModel.Patient) prescriptionModel.Prescription {
+	drug := pick(f.rnd, prescriptionrepo.DefaultDrugCatalog)
+	entered := drug.Name
+	if len(drug.BrandNames) > 0 && f.rnd.IntN(3) == 0 {
+		entered = pick(f.rnd, drug.BrandNames)
+	}
+	dose := fakeDefaultDose
+	if doses, ok := fakeDoses[drug.Name]; ok {
+		dose = pick(f.rnd, doses)
+	}
+	var dosage *prescriptionModel.Dose
+	if parsed, err := prescriptionModel.ParseDose(dose); err == nil {
+		dosage = &parsed
+	}
+	return prescriptionModel.Prescription{
+		ID:           id,
+		PatientID:    patient.ID,
+		PrescriberID: pick(f.rnd, prescriptionrepo.DefaultPrescribers).ID,
+		Drug:         drug.Name,
+		DrugID:       drug.ID,
+		DrugEntered:  entered,
+		Dose:         dose,
+		Dosage:       dosage,
+		Status:       f.status(),
+		CreatedAt:    patient.CreatedAt.Add(time.Duration(f.rnd.Int64N(int64(f.now.Sub(patient.CreatedAt)) + 1))),
+	}
+}
+
+func (f *faker) status() prescriptionModel.Status {
+	total := 0
+	for _, s := range fakeStatuses {
+		total += s.weight
+	}
+	n := f.rnd.IntN(total)
+	for _, s := range fakeStatuses {
+		if n < s.weight {
+			return s.status
+		}
+		n -= s.weight
+	}
+	return prescriptionModel.Active
+}
+
+func (f *faker) state(code string) fakeState {
+	for _, s := range fakeStates {
+		if s.code == code {
+			return s
+		}
+	}
+	return fakeStates[0]
+}
+
+func pick[T any](rnd *rand.Rand, items []T) T {
+	return items[rnd.IntN(len(items))]
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"time"
+
+	patientrepo "pharmacy-modernization-project-model/domain/patient/repository"
+	prescriptionrepo "pharmacy-modernization-project-model/domain/prescription/repository"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	prescriptionModel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/dates"
+)
+
+// fixtureData returns the hand-written sample patients that demos and docs refer to (P001-P015)
+func fixtureData(now time.Time) dataset {
+	patients := []patientModel.Patient{
+		{ID: "P001", Name: "Ava Thompson2", DOB: dates.Full(1988, time.January, 12), Phone: "(206) 417-8842", State: "WA"},
+		{ID: "P002", Name: "Liam Anderson", DOB: dates.Full(1979, time.March, 3), Phone: "(415) 736-5528", State: "CA"},
+		{ID: "P003", Name: "Sophia Martinez", DOB: dates.Full(1992, time.July, 27), Phone: "(617) 980-3314", State: "MA"},
+		{ID: "P004", Name: "Noah Patel", DOB: dates.Full(1985, time.May, 5), Phone: "(972) 645-2091", State: "TX"},
+		{ID: "P005", Name: "Mia Chen", DOB: dates.Full(1996, time.September, 19), Phone: "(312) 478-6605", State: "IL"},
+		{ID: "P006", Name: "Ethan Johnson", DOB: dates.Full(1975, time.November, 8), Phone: "(303) 825-1947", State: "CO"},
+		{ID: "P007", Name: "Olivia Rossi", DOB: dates.Full(1990, time.February, 22), Phone: "(646) 291-0743", State: "NY"},
+		{ID: "P008", Name: "Jackson Lee", DOB: dates.Full(1983, time.April, 16), Phone: "(503) 913-2286", State: "OR"},
+		{ID: "P009", Name: "Emma Davis", DOB: dates.Full(1998, time.December, 2), Phone: "(305) 744-1189", State: "FL"},
+		{ID: "P010", Name: "Lucas Hernandez", DOB: dates.Full(1981, time.June, 14), Phone: "(713) 402-5378", State: "TX"},
+		{ID: "P011", Name: "Isabella Rodriguez", DOB: dates.Full(1994, time.August, 30), Phone: "(212) 555-9876", State: "NY"},
+		{ID: "P012", Name: "Mason Williams", DOB: dates.Full(1987, time.October, 18), Phone: "(404) 555-4321", State: "GA"},
+		{ID: "P013", Name: "Charlotte Brown", DOB: dates.Full(1991, time.April, 7), Phone: "(602) 555-1111", State: "AZ"},
+		{ID: "P014", Name: "James Taylor", DOB: dates.Full(1980, time.December, 25), Phone: "(702) 555-2222", State: "NV"},
+		{ID: "P015", Name: "Amelia Garcia", DOB: dates.Full(1995, time.June, 15), Phone: "(214) 555-3333", State: "TX"},
+	}
+	for i := range patients {
+		patients[i].CreatedAt = now
+	}
+
+	addresses := []patientModel.Address{
+		// Ava Thompson (P001)
+		{ID: "A001", PatientID: "P001", Line1: "123 Main St", Line2: "Apt 4B", City: "Seattle", State: "WA", Zip: "98101"},
+		{ID: "A002", PatientID: "P001", Line1: "456 Market Ave", Line2: "", City: "Seattle", State: "WA", Zip: "98102"},
+
+		// Liam Anderson (P002)
+		{ID: "A003", PatientID: "P002", Line1: "789 Sunset Blvd", Line2: "", City: "San Francisco", State: "CA", Zip: "94102"},
+
+		// Sophia Martinez (P003)
+		{ID: "A004", PatientID: "P003", Line1: "321 Commonwealth Ave", Line2: "Unit 12", City: "Boston", State: "MA", Zip: "02215"},
+
+		// Noah Patel (P004)
+		{ID: "A005", PatientID: "P004", Line1: "555 Ranch Road", Line2: "", City: "Dallas", State: "TX", Zip: "75201"},
+		{ID: "A006", PatientID: "P004", Line1: "888 Business Park Dr", Line2: "Suite 300", City: "Dallas", State: "TX", Zip: "75202"},
+
+		// Mia Chen (P005)
+		{ID: "A007", PatientID: "P005", Line1: "999 Lake Shore Dr", Line2: "", City: "Chicago", State: "IL", Zip: "60611"},
+
+		// Ethan Johnson (P006)
+		{ID: "A008", PatientID: "P006", Line1: "777 Mountain View Rd", Line2: "", City: "Denver", State: "CO", Zip: "80202"},
+
+		// Olivia Rossi (P007)
+		{ID: "A009", PatientID: "P007", Line1: "222 Broadway", Line2: "Floor 15", City: "New York", State: "NY", Zip: "10007"},
+		{ID: "A010", PatientID: "P007", Line1: "333 Park Ave", Line2: "", City: "New York", State: "NY", Zip: "10022"},
+
+		// Jackson Lee (P008)
+		{ID: "A011", PatientID: "P008", Line1: "444 Forest Lane", Line2: "", City: "Portland", State: "OR", Zip: "97201"},
+
+		// Emma Davis (P009)
+		{ID: "A012", PatientID: "P009", Line1: "666 Ocean Drive", Line2: "Apt 23", City: "Miami", State: "FL", Zip: "33139"},
+
+		// Lucas Hernandez (P010)
+		{ID: "A013", PatientID: "P010", Line1: "111 Heritage St", Line2: "", City: "Houston", State: "TX", Zip: "77002"},
+	}
+
+	rx := func(id, patientID, drug, dose string, status prescriptionModel.Status, daysAgo int) prescriptionModel.Prescription {
+		var dosage *prescriptionModel.Dose
+		if parsed, err := prescriptionModel.ParseDose(dose); err == nil {
+			dosage = &parsed
+		}
+		return prescriptionModel.Prescription{
+			ID:          id,
+			PatientID:   patientID,
+			Drug:        drug,
+			DrugID:      catalogID(drug),
+			DrugEntered: drug,
+			Dose:        dose,
+			Dosage:      dosage,
+			Status:      status,
+			CreatedAt:   now.AddDate(0, 0, -daysAgo),
+		}
+	}
+	prescriptions := []prescriptionModel.Prescription{
+		// Ava Thompson (P001)
+		rx("RX001", "P001", "Lisinopril", "10mg", prescriptionModel.Active, 30),
+		rx("RX002", "P001", "Metformin", "500mg", prescriptionModel.Active, 25),
+
+		// Liam Anderson (P002)
+		rx("RX003", "P002", "Atorvastatin", "20mg", prescriptionModel.Active, 45),
+		rx("RX004", "P002", "Omeprazole", "40mg", prescriptionModel.Active, 20),
+		rx("RX005", "P002", "Amoxicillin", "500mg", prescriptionModel.Completed, 60),
+
+		// Sophia Martinez (P003)
+		rx("RX006", "P003", "Albuterol", "90mcg", prescriptionModel.Active, 15),
+		rx("RX007", "P003", "Montelukast", "10mg", prescriptionModel.Active, 10),
+
+		// Noah Patel (P004)
+		rx("RX008", "P004", "Losartan", "50mg", prescriptionModel.Active, 40),
+		rx("RX009", "P004", "Amlodipine", "5mg", prescriptionModel.Paused, 35),
+
+		// Mia Chen (P005)
+		rx("RX010", "P005", "Sertraline", "50mg", prescriptionModel.Active, 90),
+		rx("RX011", "P005", "Ibuprofen", "400mg", prescriptionModel.Completed, 100),
+
+		// Ethan Johnson (P006)
+		rx("RX012", "P006", "Warfarin", "5mg", prescriptionModel.Active, 120),
+		rx("RX013", "P006", "Furosemide", "40mg", prescriptionModel.Active, 115),
+		rx("RX014", "P006", "Levothyroxine", "75mcg", prescriptionModel.Active, 110),
+
+		// Olivia Rossi (P007)
+		rx("RX015", "P007", "Escitalopram", "10mg", prescriptionModel.Active, 55),
+
+		// Jackson Lee (P008)
+		rx("RX016", "P008", "Gabapentin", "300mg", prescriptionModel.Active, 70),
+		rx("RX017", "P008", "Tramadol", "50mg", prescriptionModel.Paused, 65),
+
+		// Emma Davis (P009)
+		rx("RX018", "P009", "Azithromycin", "250mg", prescriptionModel.Completed, 5),
+		rx("RX019", "P009", "Birth Control", "Daily", prescriptionModel.Active, 180),
+
+		// Lucas Hernandez (P010)
+		rx("RX020", "P010", "Simvastatin", "40mg", prescriptionModel.Active, 200),
+		rx("RX021", "P010", "Aspirin", "81mg", prescriptionModel.Active, 195),
+		rx("RX022", "P010", "Clopidogrel", "75mg", prescriptionModel.Active, 190),
+
+		// Additional prescriptions for variety
+		rx("RX023", "P011", "Prednisone", "20mg", prescriptionModel.Active, 12),
+		rx("RX024", "P012", "Metoprolol", "50mg", prescriptionModel.Active, 80),
+		rx("RX025", "P013", "Hydrochlorothiazide", "25mg", prescriptionModel.Active, 50),
+		rx("RX026", "P014", "Insulin Glargine", "20 units", prescriptionModel.Active, 150),
+		rx("RX027", "P015", "Rosuvastatin", "10mg", prescriptionModel.Draft, 2),
+	}
+	// Each sample prescription is written under one of the sample prescribers in turn
+	for i := range prescriptions {
+		prescriptions[i].PrescriberID = prescriptionrepo.DefaultPrescribers[i%len(prescriptionrepo.DefaultPrescribers)].ID
+	}
+
+	return dataset{
+		patients:      patients,
+		addresses:     addresses,
+		prescriptions: prescriptions,
+		insurance:     patientrepo.SeedInsuranceRecords(now),
+		allergies:     patientrepo.SeedAllergies(now),
+	}
+}
+
+// catalogID returns the drug catalog ID for a generic name, or "" for drugs not in the catalog
+func catalogID(name string) string {
+	normalized := prescriptionModel.NormalizeDrugName(name)
+	for _, drug := range prescriptionrepo.DefaultDrugCatalog {
+		if prescriptionModel.NormalizeDrugName(drug.Name) == normalized {
+			return drug.ID
+		}
+	}
+	return ""
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// bulkBatchSize caps the number of writes sent in one BulkWrite call
+const bulkBatchSize = 1000
+
+// writeResult counts what a seeding step changed
+type writeResult struct {
+	inserted int64
+	updated  int64
+	skipped  int64
+}
+
+// insertMissing inserts documents whose _id does not exist yet and leaves existing documents
+// untouched, so records edited through the app survive a re-seed
+func insertMissing[T any](ctx context.Context, coll *mongo.Collection, docs []T, id func(T) string) (writeResult, error) {
+	models := make([]mongo.WriteModel, 0, len(docs))
+	for _, doc := range docs {
+		fields, err := toFields(doc)
+		if err != nil {
+			return writeResult{}, err
+		}
+		delete(fields, "_id")
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": id(doc)}).
+			SetUpdate(bson.M{"$setOnInsert": fields}).
+			SetUpsert(true))
+	}
+	return bulkWrite(ctx, coll, models)
+}
+
+// replaceAll upserts every document as given. Used for reference data that the code owns,
+// such as the drug catalog, so a re-seed brings it up to date.
+func replaceAll[T any](ctx context.Context, coll *mongo.Collection, docs []T, id func(T) string) (writeResult, error) {
+	models := make([]mongo.WriteModel, 0, len(docs))
+	for _, doc := range docs {
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"_id": id(doc)}).
+			SetReplacement(doc).
+			SetUpsert(true))
+	}
+	return bulkWrite(ctx, coll, models)
+}
+
+func bulkWrite(ctx context.Context, coll *mongo.Collection, models []mongo.WriteModel) (writeResult, error) {
+	result := writeResult{}
+	opts := options.BulkWrite().SetOrdered(false)
+	for start := 0; start < len(models); start += bulkBatchSize {
+		end := min(start+bulkBatchSize, len(models))
+		res, err := coll.BulkWrite(ctx, models[start:end], opts)
+		if err != nil {
+			return result, fmt.Errorf("bulk write to %s: %w", coll.Name(), err)
+		}
+		result.inserted += res.UpsertedCount
+		result.updated += res.ModifiedCount
+		result.skipped += int64(end-start) - res.UpsertedCount - res.ModifiedCount
+	}
+	return result, nil
+}
+
+func toFields(doc any) (bson.M, error) {
+	raw, err := bson.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	fields := bson.M{}
+	if err := bson.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
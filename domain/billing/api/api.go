@@ -0,0 +1,42 @@
+package api
+
+import (
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	controllers "pharmacy-modernization-project-model/domain/billing/api/controllers"
+	"pharmacy-modernization-project-model/domain/billing/service"
+	platformpaths "pharmacy-modernization-project-model/internal/platform/paths"
+	"pharmacy-modernization-project-model/internal/platform/schemas"
+)
+
+// APIPath is the base path of the billing API
+const APIPath = platformpaths.APIV1Path + "/billing"
+
+type Dependencies struct {
+	BillingService        service.BillingService
+	ReconciliationService service.ReconciliationService
+	// WebhookSecret signs IRIS billing webhooks; the webhook endpoint is not mounted without it
+	WebhookSecret string
+	// Contracts validates webhook bodies against the consumed IRIS contract; EnforceContracts rejects violations
+	Contracts        *schemas.Registry
+	EnforceContracts bool
+	Logger           *zap.Logger
+}
+
+func MountAPI(r chi.Router, deps *Dependencies) {
+	invoiceController := controllers.NewInvoiceController(deps.BillingService, deps.Logger)
+	discrepancyController := controllers.NewDiscrepancyController(deps.ReconciliationService, deps.Logger)
+
+	r.Route(APIPath, func(router chi.Router) {
+		// Webhooks are signed by IRIS rather than authenticated with a user token
+		if deps.WebhookSecret != "" {
+			controllers.NewWebhookController(deps.BillingService, deps.WebhookSecret, deps.Contracts, deps.EnforceContracts, deps.Logger).RegisterRoutes(router)
+		} else {
+			deps.Logger.Info("Billing webhooks disabled: billing.webhook_secret is not set")
+		}
+
+		router.Group(invoiceController.RegisterRoutes)
+		router.Group(discrepancyController.RegisterRoutes)
+	})
+}
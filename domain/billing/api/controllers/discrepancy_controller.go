@@ -0,0 +1,87 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/billing/contracts/model"
+	"pharmacy-modernization-project-model/domain/billing/contracts/request"
+	billingsecurity "pharmacy-modernization-project-model/domain/billing/security"
+	"pharmacy-modernization-project-model/domain/billing/service"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+)
+
+type DiscrepancyController struct {
+	reconciliationService service.ReconciliationService
+	log                   *zap.Logger
+}
+
+func NewDiscrepancyController(reconciliation service.ReconciliationService, log *zap.Logger) *DiscrepancyController {
+	return &DiscrepancyController{reconciliationService: reconciliation, log: log}
+}
+
+func (c *DiscrepancyController) RegisterRoutes(r chi.Router) {
+	// All billing API routes require authentication (header-based for API)
+	r.Use(auth.RequireAuthFromHeader())
+
+	// Reconciliation report - requires billing:read or admin:all
+	r.With(auth.RequirePermissionsMatchAny(billingsecurity.ReadAccess)).Get("/discrepancies", c.Report)
+
+	// Resolution - requires billing:write or admin:all
+	r.With(auth.RequirePermissionsMatchAny(billingsecurity.WriteAccess)).Post("/discrepancies/{discrepancyID}/resolve", c.Resolve)
+}
+
+// Report returns the discrepancies found by billing reconciliation, newest first
+func (c *DiscrepancyController) Report(w http.ResponseWriter, r *http.Request) {
+	query, fieldErrors, err := bind.Query[request.DiscrepancyListQueryRequest](r)
+	if err != nil {
+		c.log.Error("failed to bind query parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	if query.Limit == 0 {
+		query.Limit = 100
+	}
+
+	report, err := c.reconciliationService.Report(r.Context(), model.DiscrepancyFilter{
+		Status: model.DiscrepancyStatus(query.Status),
+		Kind:   model.DiscrepancyKind(query.Kind),
+		Limit:  query.Limit,
+	})
+	if err != nil {
+		c.log.Error("billing discrepancy report", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, report)
+}
+
+func (c *DiscrepancyController) Resolve(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.DiscrepancyPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	req, fieldErrors, err := bind.JSON[request.DiscrepancyResolveRequest](r)
+	if err != nil {
+		c.log.Warn("invalid resolution payload", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	discrepancy, err := c.reconciliationService.Resolve(r.Context(), pathVars.DiscrepancyID, currentUser(r), req.Resolution)
+	if err != nil {
+		c.log.Error("resolve billing discrepancy", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, discrepancy)
+}
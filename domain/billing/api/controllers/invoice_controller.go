@@ -0,0 +1,170 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/billing/contracts/request"
+	billingsecurity "pharmacy-modernization-project-model/domain/billing/security"
+	"pharmacy-modernization-project-model/domain/billing/service"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+)
+
+type InvoiceController struct {
+	billingService service.BillingService
+	log            *zap.Logger
+}
+
+func NewInvoiceController(billing service.BillingService, log *zap.Logger) *InvoiceController {
+	return &InvoiceController{billingService: billing, log: log}
+}
+
+func (c *InvoiceController) RegisterRoutes(r chi.Router) {
+	// All billing API routes require authentication (header-based for API)
+	r.Use(auth.RequireAuthFromHeader())
+
+	// Read operations - requires billing:read or admin:all
+	r.With(auth.RequirePermissionsMatchAny(billingsecurity.ReadAccess)).Get("/patients/{patientID}/invoices", c.ListByPatient)
+	r.With(auth.RequirePermissionsMatchAny(billingsecurity.ReadAccess)).Get("/prescriptions/{prescriptionID}/invoice", c.GetByPrescription)
+	r.With(auth.RequirePermissionsMatchAny(billingsecurity.ReadAccess)).Get("/prescriptions/{prescriptionID}/invoice/payment", c.Payment)
+	r.With(auth.RequirePermissionsMatchAny(billingsecurity.ReadAccess)).Get("/prescriptions/{prescriptionID}/price-estimate", c.PriceEstimate)
+
+	// Invoice creation - requires billing:write or admin:all
+	r.With(auth.RequirePermissionsMatchAny(billingsecurity.WriteAccess)).Post("/prescriptions/{prescriptionID}/invoice", c.Create)
+
+	// Acknowledgment - requires billing:acknowledge or admin:all
+	r.With(auth.RequirePermissionsMatchAny(billingsecurity.AcknowledgeAccess)).Post("/prescriptions/{prescriptionID}/invoice/acknowledge", c.Acknowledge)
+}
+
+func (c *InvoiceController) ListByPatient(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.PatientPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	summary, err := c.billingService.InvoiceSummaryByPatient(r.Context(), pathVars.PatientID)
+	if err != nil {
+		c.log.Error("list invoices", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, summary)
+}
+
+func (c *InvoiceController) GetByPrescription(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.PrescriptionPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	invoice, err := c.billingService.InvoiceForPrescription(r.Context(), pathVars.PrescriptionID)
+	if err != nil {
+		c.log.Error("get invoice", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, invoice)
+}
+
+func (c *InvoiceController) Payment(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.PrescriptionPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	payment, err := c.billingService.Payment(r.Context(), pathVars.PrescriptionID)
+	if err != nil {
+		c.log.Error("get invoice payment", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, payment)
+}
+
+func (c *InvoiceController) PriceEstimate(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.PrescriptionPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	estimate, err := c.billingService.PriceEstimate(r.Context(), pathVars.PrescriptionID)
+	if err != nil {
+		c.log.Error("estimate prescription price", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, estimate)
+}
+
+func (c *InvoiceController) Create(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.PrescriptionPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	req, fieldErrors, err := bind.JSON[request.InvoiceCreateRequest](r)
+	if err != nil {
+		c.log.Warn("invalid invoice payload", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	invoice, err := c.billingService.CreateInvoiceForPrescription(r.Context(), pathVars.PrescriptionID, req)
+	if err != nil {
+		c.log.Error("create invoice", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteCreated(w, invoice)
+}
+
+func (c *InvoiceController) Acknowledge(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.PrescriptionPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	req, fieldErrors, err := bind.JSON[request.InvoiceAcknowledgeRequest](r)
+	if err != nil {
+		c.log.Warn("invalid acknowledgment payload", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	invoice, err := c.billingService.Acknowledge(r.Context(), pathVars.PrescriptionID, currentUser(r), req)
+	if err != nil {
+		c.log.Error("acknowledge invoice", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, invoice)
+}
+
+// currentUser identifies the authenticated user acknowledging an invoice
+func currentUser(r *http.Request) string {
+	user, err := auth.GetCurrentUser(r.Context())
+	if err != nil {
+		return ""
+	}
+	if user.Email != "" {
+		return user.Email
+	}
+	return user.ID
+}
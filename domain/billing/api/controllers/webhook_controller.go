@@ -0,0 +1,125 @@
+package controllers
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/billing/contracts/model"
+	"pharmacy-modernization-project-model/domain/billing/contracts/request"
+	"pharmacy-modernization-project-model/domain/billing/service"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/schemas"
+)
+
+const (
+	// SignatureHeader carries "sha256=" and the hex HMAC-SHA256 of the body, keyed with the webhook secret
+	SignatureHeader = "X-Iris-Signature"
+
+	maxWebhookBodyBytes = 64 << 10
+
+	// InvoiceEventContract names the schema of IRIS invoice events in the contract registry
+	InvoiceEventContract = "iris.invoice_event"
+)
+
+// WebhookController receives invoice events from IRIS billing. IRIS authenticates by signing the
+// body with a shared secret instead of sending a user token.
+type WebhookController struct {
+	billingService service.BillingService
+	secret         []byte
+	contracts      *schemas.Registry // Optional; checks bodies against the iris.invoice_event contract
+	enforce        bool              // Reject bodies that break the contract instead of only counting them
+	log            *zap.Logger
+}
+
+func NewWebhookController(billing service.BillingService, secret string, contracts *schemas.Registry, enforce bool, log *zap.Logger) *WebhookController {
+	return &WebhookController{billingService: billing, secret: []byte(secret), contracts: contracts, enforce: enforce, log: log}
+}
+
+func (c *WebhookController) RegisterRoutes(r chi.Router) {
+	r.Post("/webhooks/invoices", c.InvoiceEvent)
+}
+
+func (c *WebhookController) InvoiceEvent(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBodyBytes+1))
+	if err != nil || len(body) > maxWebhookBodyBytes {
+		c.log.Warn("unreadable billing webhook body", zap.Error(err), zap.Int("bytes", len(body)))
+		helper.Respond400(w, []bind.FieldError{{Tag: "body", Message: "body is unreadable or too large"}})
+		return
+	}
+	if !c.validSignature(body, r.Header.Get(SignatureHeader)) {
+		c.log.Warn("billing webhook rejected: invalid signature")
+		helper.WriteUnauthorized(w, "invalid webhook signature")
+		return
+	}
+
+	if fieldErrors := c.contractViolations(body); len(fieldErrors) > 0 {
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	req, fieldErrors, err := bind.JSON[request.InvoiceEventRequest](r, bind.AllowUnknownFields())
+	if err != nil {
+		c.log.Warn("invalid billing webhook payload", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	c.billingService.HandleInvoiceEvent(r.Context(), model.InvoiceEvent{
+		Type:           req.EventType,
+		InvoiceID:      req.InvoiceID,
+		PrescriptionID: req.PrescriptionID,
+		PatientID:      req.PatientID,
+	})
+	helper.WriteNoContent(w)
+}
+
+// contractViolations checks the body against the IRIS invoice event contract. Violations are
+// logged and counted; they are only returned, to reject the event, when the contract is enforced.
+func (c *WebhookController) contractViolations(body []byte) []bind.FieldError {
+	if c.contracts == nil {
+		return nil
+	}
+	err := c.contracts.Validate(schemas.Consumed, InvoiceEventContract, body)
+	if err == nil {
+		return nil
+	}
+	c.log.Warn("billing webhook breaks its contract", zap.Bool("rejected", c.enforce), zap.Error(err))
+	if !c.enforce {
+		return nil
+	}
+
+	var violation *schemas.ViolationError
+	if !errors.As(err, &violation) {
+		return []bind.FieldError{{Tag: "contract", Message: err.Error()}}
+	}
+	fieldErrors := make([]bind.FieldError, len(violation.Violations))
+	for i, v := range violation.Violations {
+		fieldErrors[i] = bind.FieldError{Field: strings.TrimPrefix(v.Path, "/"), Tag: "contract", Message: v.Message}
+	}
+	return fieldErrors
+}
+
+func (c *WebhookController) validSignature(body []byte, header string) bool {
+	signature, ok := strings.CutPrefix(header, "sha256=")
+	if !ok {
+		return false
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
@@ -0,0 +1,29 @@
+package builder
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	billingrepo "pharmacy-modernization-project-model/domain/billing/repository"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// CreateDiscrepancyRepository creates the appropriate billing discrepancy repository based on
+// dependencies; with MongoDB it creates the lookup indexes if missing
+func CreateDiscrepancyRepository(logger *zap.Logger, mongoCollection *mongo.Collection, retrier *database.Retrier) billingrepo.DiscrepancyRepository {
+	if mongoCollection != nil {
+		repo := billingrepo.NewDiscrepancyMongoRepository(mongoCollection, logger)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := repo.CreateIndexes(ctx); err != nil {
+			logger.Warn("Failed to create billing discrepancy indexes", zap.Error(err))
+		}
+		return billingrepo.NewDiscrepancyRetryRepository(repo, retrier)
+	}
+
+	return billingrepo.NewDiscrepancyMemoryRepository()
+}
@@ -0,0 +1,67 @@
+package model
+
+import "time"
+
+// DiscrepancyKind is what reconciliation found wrong with a completed prescription's invoice
+type DiscrepancyKind string
+
+const (
+	// MissingInvoice is a completed prescription without an invoice, or whose invoice was voided or credited
+	MissingInvoice DiscrepancyKind = "missing_invoice"
+	// AmountMismatch is an invoice whose amount differs from the dispensing fee
+	AmountMismatch DiscrepancyKind = "amount_mismatch"
+)
+
+// DiscrepancyStatus tells whether a discrepancy still needs attention
+type DiscrepancyStatus string
+
+const (
+	DiscrepancyOpen     DiscrepancyStatus = "open"
+	DiscrepancyResolved DiscrepancyStatus = "resolved"
+)
+
+// ReconciliationResolver is the ResolvedBy of discrepancies closed by the reconciliation job
+// because the invoice was corrected in IRIS billing
+const ReconciliationResolver = "reconciliation"
+
+// Discrepancy is a completed prescription whose IRIS invoice does not match it, as flagged by the
+// billing reconciliation job. A prescription has at most one open discrepancy; later runs update
+// it, and close it once the invoice matches.
+type Discrepancy struct {
+	ID             string          `json:"id" bson:"_id"`
+	PrescriptionID string          `json:"prescription_id" bson:"prescription_id"`
+	PatientID      string          `json:"patient_id" bson:"patient_id"`
+	Kind           DiscrepancyKind `json:"kind" bson:"kind"`
+	// InvoiceID and InvoiceStatus are empty when IRIS billing has no invoice
+	InvoiceID     string `json:"invoice_id,omitempty" bson:"invoice_id,omitempty"`
+	InvoiceStatus string `json:"invoice_status,omitempty" bson:"invoice_status,omitempty"`
+	// ExpectedAmount is the dispensing fee when the discrepancy was last seen
+	ExpectedAmount float64           `json:"expected_amount" bson:"expected_amount"`
+	InvoicedAmount float64           `json:"invoiced_amount" bson:"invoiced_amount"`
+	Status         DiscrepancyStatus `json:"status" bson:"status"`
+	FirstSeenAt    time.Time         `json:"first_seen_at" bson:"first_seen_at"`
+	LastSeenAt     time.Time         `json:"last_seen_at" bson:"last_seen_at"`
+	ResolvedAt     *time.Time        `json:"resolved_at,omitempty" bson:"resolved_at,omitempty"`
+	// ResolvedBy is the user who resolved the discrepancy, or ReconciliationResolver
+	ResolvedBy string `json:"resolved_by,omitempty" bson:"resolved_by,omitempty"`
+	Resolution string `json:"resolution,omitempty" bson:"resolution,omitempty"`
+	// OrgID is the organization of the prescription
+	OrgID string `json:"org_id,omitempty" bson:"org_id,omitempty"`
+}
+
+// DiscrepancyFilter narrows a discrepancy list; empty fields match everything
+type DiscrepancyFilter struct {
+	Status DiscrepancyStatus
+	Kind   DiscrepancyKind
+	// Limit caps the list; 0 returns all discrepancies
+	Limit int
+}
+
+// DiscrepancyReport is the discrepancy list with how many discrepancies are open of each kind
+type DiscrepancyReport struct {
+	Open             int           `json:"open"`
+	MissingInvoices  int           `json:"missing_invoices"`
+	AmountMismatches int           `json:"amount_mismatches"`
+	Discrepancies    []Discrepancy `json:"discrepancies"`
+	AsOf             time.Time     `json:"as_of"`
+}
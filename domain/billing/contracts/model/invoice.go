@@ -0,0 +1,63 @@
+package model
+
+import "time"
+
+// Invoice statuses reported by IRIS billing
+const (
+	InvoiceUnbilled     = "unbilled"
+	InvoicePending      = "pending"
+	InvoiceAcknowledged = "acknowledged"
+	InvoicePaid         = "paid"
+	InvoiceVoided       = "voided"
+	InvoiceCredited     = "credited"
+)
+
+// Invoice is a prescription's invoice in IRIS billing
+type Invoice struct {
+	ID             string  `json:"id"`
+	PrescriptionID string  `json:"prescription_id"`
+	PatientID      string  `json:"patient_id,omitempty"`
+	Amount         float64 `json:"amount"`
+	Status         string  `json:"status"`
+	CreatedAt      string  `json:"created_at,omitempty"`
+	UpdatedAt      string  `json:"updated_at,omitempty"`
+}
+
+// InvoiceSummary is a patient's invoice list as last read from IRIS billing
+type InvoiceSummary struct {
+	PatientID string    `json:"patient_id"`
+	Invoices  []Invoice `json:"invoices"`
+	Total     int       `json:"total"`
+	FetchedAt time.Time `json:"fetched_at"`
+	// Stale is set when IRIS billing could not be reached and an expired cached copy is returned
+	Stale bool `json:"stale"`
+}
+
+// InvoicePayment is the payment recorded against an invoice
+type InvoicePayment struct {
+	InvoiceID     string  `json:"invoice_id"`
+	PaymentID     string  `json:"payment_id"`
+	Amount        float64 `json:"amount"`
+	PaymentMethod string  `json:"payment_method"`
+	Status        string  `json:"status"`
+	PaidAt        string  `json:"paid_at,omitempty"`
+}
+
+// InvoiceAging groups outstanding (pending or acknowledged) invoices by how long ago they were created
+type InvoiceAging struct {
+	Buckets     []InvoiceAgingBucket `json:"buckets"`
+	Outstanding int                  `json:"outstanding"`
+	Amount      float64              `json:"amount"`
+	// Checked is how many completed prescriptions were looked up; older ones are not included
+	Checked int       `json:"checked"`
+	AsOf    time.Time `json:"as_of"`
+}
+
+// InvoiceAgingBucket counts the outstanding invoices between MinDays and MaxDays old; MaxDays 0 has no upper bound
+type InvoiceAgingBucket struct {
+	Label   string  `json:"label"`
+	MinDays int     `json:"min_days"`
+	MaxDays int     `json:"max_days"`
+	Count   int     `json:"count"`
+	Amount  float64 `json:"amount"`
+}
@@ -0,0 +1,18 @@
+package model
+
+// Invoice event types sent by IRIS billing webhooks
+const (
+	EventInvoiceCreated      = "invoice.created"
+	EventInvoiceAcknowledged = "invoice.acknowledged"
+	EventInvoicePaid         = "invoice.paid"
+	EventInvoiceVoided       = "invoice.voided"
+	EventInvoiceCredited     = "invoice.credited"
+)
+
+// InvoiceEvent reports a change to an invoice made in IRIS billing, outside this application
+type InvoiceEvent struct {
+	Type           string
+	InvoiceID      string
+	PrescriptionID string
+	PatientID      string // Optional; looked up from the prescription when empty
+}
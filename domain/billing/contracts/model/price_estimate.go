@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// Where a price estimate came from
+const (
+	EstimateSourceIRIS = "iris"
+	// EstimateSourceDefault marks an estimate priced from the configured table while IRIS billing was unavailable
+	EstimateSourceDefault = "default"
+)
+
+// PriceEstimate is what a prescription is expected to cost before it is billed
+type PriceEstimate struct {
+	PrescriptionID string `json:"prescription_id"`
+	Drug           string `json:"drug"`
+	// Quantity is the number of units priced; a prescription without a quantity is priced as one unit
+	Quantity       int     `json:"quantity"`
+	UnitPrice      float64 `json:"unit_price"`
+	IngredientCost float64 `json:"ingredient_cost"`
+	DispensingFee  float64 `json:"dispensing_fee"`
+	Total          float64 `json:"total"`
+	Currency       string  `json:"currency"`
+	// Source is iris, or default when the configured pricing table was used
+	Source      string    `json:"source"`
+	EstimatedAt time.Time `json:"estimated_at"`
+}
@@ -0,0 +1,29 @@
+package request
+
+type DiscrepancyListQueryRequest struct {
+	Status string `form:"status" validate:"omitempty,oneof=open resolved"`
+	Kind   string `form:"kind" validate:"omitempty,oneof=missing_invoice amount_mismatch"`
+	Limit  int    `form:"limit" validate:"omitempty,min=1,max=500"`
+}
+
+// DiscrepancyPathVars represents path parameters for a billing discrepancy
+type DiscrepancyPathVars struct {
+	DiscrepancyID string `path:"discrepancyID" validate:"required,min=1"`
+}
+
+// DiscrepancyResolveRequest closes a discrepancy; Resolution says why it needs no further action
+type DiscrepancyResolveRequest struct {
+	Resolution string `json:"resolution" validate:"required,max=500"`
+}
+
+// DiscrepancyResolveFormRequest is the resolve form of the discrepancy admin page
+type DiscrepancyResolveFormRequest struct {
+	Resolution string `form:"resolution" validate:"required,max=500"`
+}
+
+// DiscrepancyPageRequest filters the discrepancy admin page; Status defaults to open, and "all"
+// lists every status
+type DiscrepancyPageRequest struct {
+	Status string `form:"status" validate:"omitempty,oneof=open resolved all"`
+	Kind   string `form:"kind" validate:"omitempty,oneof=missing_invoice amount_mismatch"`
+}
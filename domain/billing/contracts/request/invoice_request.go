@@ -0,0 +1,32 @@
+package request
+
+// InvoiceCreateRequest bills a prescription; Amount defaults to the configured dispensing fee
+type InvoiceCreateRequest struct {
+	Amount      float64 `json:"amount" validate:"omitempty,gt=0,max=100000"`
+	Description string  `json:"description" validate:"omitempty,max=200"`
+}
+
+type InvoiceAcknowledgeRequest struct {
+	Notes string `json:"notes" validate:"omitempty,max=500"`
+}
+
+// PrescriptionPathVars represents path parameters for a prescription's invoice
+type PrescriptionPathVars struct {
+	PrescriptionID string `path:"prescriptionID" validate:"required,min=1"`
+}
+
+// PatientPathVars represents path parameters for a patient's invoices
+type PatientPathVars struct {
+	PatientID string `path:"patientID" validate:"required,min=1"`
+}
+
+// InvoiceEventRequest is the body of an IRIS billing webhook. Unknown event types are accepted
+// so new IRIS events do not fail delivery.
+type InvoiceEventRequest struct {
+	EventID        string `json:"event_id" validate:"omitempty,max=100"`
+	EventType      string `json:"event_type" validate:"required,max=100"`
+	InvoiceID      string `json:"invoice_id" validate:"omitempty,max=100"`
+	PrescriptionID string `json:"prescription_id" validate:"required,max=100"`
+	PatientID      string `json:"patient_id" validate:"omitempty,max=100"`
+	OccurredAt     string `json:"occurred_at" validate:"omitempty"`
+}
@@ -0,0 +1,130 @@
+package graphql
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/billing/contracts/model"
+	"pharmacy-modernization-project-model/domain/billing/contracts/request"
+	billingservice "pharmacy-modernization-project-model/domain/billing/service"
+	prescriptionmodel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/internal/graphql/validation"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+)
+
+// BillingResolver handles all Billing domain GraphQL operations
+type BillingResolver struct {
+	BillingService billingservice.BillingService
+	Logger         *zap.Logger
+}
+
+// NewBillingResolver creates a new billing resolver
+func NewBillingResolver(
+	billingSvc billingservice.BillingService,
+	logger *zap.Logger,
+) *BillingResolver {
+	return &BillingResolver{
+		BillingService: billingSvc,
+		Logger:         logger,
+	}
+}
+
+// ============================================================================
+// Query Resolvers
+// ============================================================================
+
+// InvoicesByPatient resolves the invoicesByPatient query
+func (r *BillingResolver) InvoicesByPatient(ctx context.Context, patientID string) ([]model.Invoice, error) {
+	if _, validationErrors := validation.ValidateGraphQLInput(ctx, request.PatientPathVars{PatientID: patientID}); validationErrors != nil {
+		return nil, validationErrors
+	}
+
+	invoices, err := r.BillingService.InvoicesByPatient(ctx, patientID)
+	if err != nil {
+		r.Logger.Error("Failed to fetch invoices for patient",
+			zap.String("patient_id", patientID),
+			zap.Error(err))
+		return nil, err
+	}
+	return invoices, nil
+}
+
+// PrescriptionPriceEstimate resolves Prescription.priceEstimate
+func (r *BillingResolver) PrescriptionPriceEstimate(ctx context.Context, prescription *prescriptionmodel.Prescription) (*model.PriceEstimate, error) {
+	estimate, err := r.BillingService.PriceEstimate(ctx, prescription.ID)
+	if err != nil {
+		r.Logger.Error("Failed to estimate prescription price",
+			zap.String("prescription_id", prescription.ID),
+			zap.Error(err))
+		return nil, err
+	}
+	return &estimate, nil
+}
+
+// ============================================================================
+// Mutation Resolvers
+// ============================================================================
+
+// CreateInvoiceForPrescription resolves the createInvoiceForPrescription mutation
+func (r *BillingResolver) CreateInvoiceForPrescription(ctx context.Context, prescriptionID string, amount *float64, description *string) (*model.Invoice, error) {
+	if _, validationErrors := validation.ValidateGraphQLInput(ctx, request.PrescriptionPathVars{PrescriptionID: prescriptionID}); validationErrors != nil {
+		return nil, validationErrors
+	}
+
+	req := request.InvoiceCreateRequest{}
+	if amount != nil {
+		req.Amount = *amount
+	}
+	if description != nil {
+		req.Description = *description
+	}
+	if _, validationErrors := validation.ValidateGraphQLInput(ctx, req); validationErrors != nil {
+		r.Logger.Error("Invoice input validation failed",
+			zap.Any("validation_errors", validationErrors.Errors))
+		return nil, validationErrors
+	}
+
+	invoice, err := r.BillingService.CreateInvoiceForPrescription(ctx, prescriptionID, req)
+	if err != nil {
+		r.Logger.Error("Failed to create invoice",
+			zap.Error(err))
+		return nil, err
+	}
+	return &invoice, nil
+}
+
+// AcknowledgeInvoice resolves the acknowledgeInvoice mutation
+func (r *BillingResolver) AcknowledgeInvoice(ctx context.Context, prescriptionID string, notes *string) (*model.Invoice, error) {
+	if _, validationErrors := validation.ValidateGraphQLInput(ctx, request.PrescriptionPathVars{PrescriptionID: prescriptionID}); validationErrors != nil {
+		return nil, validationErrors
+	}
+
+	req := request.InvoiceAcknowledgeRequest{}
+	if notes != nil {
+		req.Notes = *notes
+	}
+	if _, validationErrors := validation.ValidateGraphQLInput(ctx, req); validationErrors != nil {
+		return nil, validationErrors
+	}
+
+	invoice, err := r.BillingService.Acknowledge(ctx, prescriptionID, currentUserRef(ctx), req)
+	if err != nil {
+		r.Logger.Error("Failed to acknowledge invoice",
+			zap.Error(err))
+		return nil, err
+	}
+	return &invoice, nil
+}
+
+// currentUserRef identifies the authenticated user acknowledging an invoice
+func currentUserRef(ctx context.Context) string {
+	user, err := auth.GetCurrentUser(ctx)
+	if err != nil {
+		return ""
+	}
+	if user.Email != "" {
+		return user.Email
+	}
+	return user.ID
+}
@@ -0,0 +1,68 @@
+package billing
+
+import (
+	"github.com/go-chi/chi/v5"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	billingapi "pharmacy-modernization-project-model/domain/billing/api"
+	billingbuilder "pharmacy-modernization-project-model/domain/billing/builder"
+	billingproviders "pharmacy-modernization-project-model/domain/billing/providers"
+	billingservice "pharmacy-modernization-project-model/domain/billing/service"
+	uibilling "pharmacy-modernization-project-model/domain/billing/ui"
+	billingworker "pharmacy-modernization-project-model/domain/billing/worker"
+	irisbilling "pharmacy-modernization-project-model/internal/integrations/iris_billing"
+	"pharmacy-modernization-project-model/internal/platform/cache"
+	"pharmacy-modernization-project-model/internal/platform/database"
+	"pharmacy-modernization-project-model/internal/platform/schemas"
+)
+
+type ModuleDependencies struct {
+	Logger               *zap.Logger
+	BillingClient        irisbilling.BillingClient
+	PrescriptionProvider billingproviders.PrescriptionProvider
+	CacheService         cache.Cache
+	Config               billingservice.Config
+	WebhookSecret        string
+	Contracts            *schemas.Registry
+	EnforceContracts     bool
+	// DiscrepanciesMongoCollection keeps the reconciliation discrepancies; nil keeps them in memory
+	DiscrepanciesMongoCollection *mongo.Collection
+	Retrier                      *database.Retrier // Retries the calls of the MongoDB repository; nil runs them once
+	Reconciliation               billingworker.ReconciliationJobConfig
+}
+
+type ModuleExport struct {
+	BillingService        billingservice.BillingService
+	ReconciliationService billingservice.ReconciliationService
+	// ReconciliationJob is registered on the scheduler when reconciliation is enabled
+	ReconciliationJob *billingworker.ReconciliationJob
+}
+
+func Module(r chi.Router, deps *ModuleDependencies) ModuleExport {
+	billingClient := deps.BillingClient
+	if billingClient == nil {
+		billingClient = irisbilling.NewMockClient(deps.Logger)
+	}
+
+	svc := billingservice.New(billingClient, deps.PrescriptionProvider, deps.CacheService, deps.Config, deps.Logger)
+
+	discrepancyRepo := billingbuilder.CreateDiscrepancyRepository(deps.Logger, deps.DiscrepanciesMongoCollection, deps.Retrier)
+	reconciliationSvc := billingservice.NewReconciliationService(billingClient, discrepancyRepo,
+		billingservice.ReconciliationConfig{DispensingFee: deps.Config.DispensingFee}, deps.Logger)
+
+	billingapi.MountAPI(r, &billingapi.Dependencies{
+		BillingService:        svc,
+		ReconciliationService: reconciliationSvc,
+		WebhookSecret:         deps.WebhookSecret,
+		Contracts:             deps.Contracts,
+		EnforceContracts:      deps.EnforceContracts,
+		Logger:                deps.Logger,
+	})
+
+	uibilling.MountUI(r, &uibilling.BillingDependencies{ReconciliationSvc: reconciliationSvc, Log: deps.Logger})
+
+	reconciliation := billingworker.NewReconciliationJob(deps.PrescriptionProvider, reconciliationSvc, deps.Logger, deps.Reconciliation)
+
+	return ModuleExport{BillingService: svc, ReconciliationService: reconciliationSvc, ReconciliationJob: reconciliation}
+}
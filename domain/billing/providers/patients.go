@@ -0,0 +1,11 @@
+package providers
+
+import (
+	"context"
+
+	patientmodel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+)
+
+type PatientProvider interface {
+	GetByID(ctx context.Context, id string) (patientmodel.Patient, error)
+}
@@ -0,0 +1,12 @@
+package providers
+
+import (
+	"context"
+
+	prescriptionmodel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+)
+
+type PrescriptionProvider interface {
+	GetByID(ctx context.Context, id string) (prescriptionmodel.Prescription, error)
+	List(ctx context.Context, status string, limit, offset int) ([]prescriptionmodel.Prescription, error)
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"pharmacy-modernization-project-model/domain/billing/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/tenancy"
+)
+
+type discrepancyMemoryRepository struct {
+	mu            sync.RWMutex
+	discrepancies map[string]model.Discrepancy
+}
+
+func NewDiscrepancyMemoryRepository() DiscrepancyRepository {
+	return &discrepancyMemoryRepository{discrepancies: map[string]model.Discrepancy{}}
+}
+
+func (r *discrepancyMemoryRepository) GetByID(ctx context.Context, id string) (model.Discrepancy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	discrepancy, ok := r.discrepancies[id]
+	if !ok || !tenancy.Visible(ctx, discrepancy.OrgID) {
+		return model.Discrepancy{}, platformErrors.NewRecordNotFoundError("discrepancy", id)
+	}
+	return discrepancy, nil
+}
+
+func (r *discrepancyMemoryRepository) List(ctx context.Context, filter model.DiscrepancyFilter) ([]model.Discrepancy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.Discrepancy{}
+	for _, discrepancy := range r.discrepancies {
+		if !tenancy.Visible(ctx, discrepancy.OrgID) {
+			continue
+		}
+		if filter.Status != "" && discrepancy.Status != filter.Status {
+			continue
+		}
+		if filter.Kind != "" && discrepancy.Kind != filter.Kind {
+			continue
+		}
+		out = append(out, discrepancy)
+	}
+	sortNewestFirst(out)
+	if filter.Limit > 0 && len(out) > filter.Limit {
+		out = out[:filter.Limit]
+	}
+	return out, nil
+}
+
+func (r *discrepancyMemoryRepository) CountOpenByKind(ctx context.Context) (map[model.DiscrepancyKind]int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	counts := map[model.DiscrepancyKind]int{}
+	for _, discrepancy := range r.discrepancies {
+		if discrepancy.Status == model.DiscrepancyOpen && tenancy.Visible(ctx, discrepancy.OrgID) {
+			counts[discrepancy.Kind]++
+		}
+	}
+	return counts, nil
+}
+
+func (r *discrepancyMemoryRepository) LatestForPrescription(ctx context.Context, prescriptionID string) (model.Discrepancy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	found := []model.Discrepancy{}
+	for _, discrepancy := range r.discrepancies {
+		if discrepancy.PrescriptionID == prescriptionID && tenancy.Visible(ctx, discrepancy.OrgID) {
+			found = append(found, discrepancy)
+		}
+	}
+	if len(found) == 0 {
+		return model.Discrepancy{}, platformErrors.NewRecordNotFoundError("discrepancy", prescriptionID)
+	}
+	sortNewestFirst(found)
+	return found[0], nil
+}
+
+func (r *discrepancyMemoryRepository) Save(_ context.Context, discrepancy model.Discrepancy) (model.Discrepancy, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.discrepancies[discrepancy.ID] = discrepancy
+	return discrepancy, nil
+}
+
+// sortNewestFirst orders discrepancies as MongoDB does: first seen descending, then ID
+func sortNewestFirst(discrepancies []model.Discrepancy) {
+	sort.Slice(discrepancies, func(i, j int) bool {
+		if !discrepancies[i].FirstSeenAt.Equal(discrepancies[j].FirstSeenAt) {
+			return discrepancies[i].FirstSeenAt.After(discrepancies[j].FirstSeenAt)
+		}
+		return discrepancies[i].ID < discrepancies[j].ID
+	})
+}
@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/billing/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/tenancy"
+	"pharmacy-modernization-project-model/internal/validators/validation_logic"
+)
+
+// newestFirst is the order of discrepancy lists
+var newestFirst = bson.D{{Key: "first_seen_at", Value: -1}, {Key: "_id", Value: 1}}
+
+// DiscrepancyMongoRepository implements DiscrepancyRepository interface using MongoDB
+type DiscrepancyMongoRepository struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewDiscrepancyMongoRepository creates a new MongoDB billing discrepancy repository
+func NewDiscrepancyMongoRepository(collection *mongo.Collection, logger *zap.Logger) *DiscrepancyMongoRepository {
+	return &DiscrepancyMongoRepository{
+		collection: collection,
+		logger:     logger,
+	}
+}
+
+// handleError processes MongoDB errors and converts them to appropriate repository errors
+func (r *DiscrepancyMongoRepository) handleError(operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	r.logger.Error("MongoDB operation failed",
+		zap.String("operation", operation),
+		zap.Error(err))
+
+	return platformErrors.HandleMongoError(operation, err)
+}
+
+// GetByID retrieves a discrepancy by its ID
+func (r *DiscrepancyMongoRepository) GetByID(ctx context.Context, id string) (model.Discrepancy, error) {
+	// Validate input to prevent NoSQL injection
+	if err := validation_logic.ValidateID("discrepancy_id", id); err != nil {
+		return model.Discrepancy{}, platformErrors.NewValidationError("discrepancy_id", id, "Invalid discrepancy ID format")
+	}
+
+	var discrepancy model.Discrepancy
+	err := r.collection.FindOne(ctx, tenancy.Filter(ctx, bson.M{"_id": id})).Decode(&discrepancy)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.Discrepancy{}, platformErrors.NewRecordNotFoundError("discrepancy", id)
+		}
+		return model.Discrepancy{}, r.handleError("GetByID", err)
+	}
+	return discrepancy, nil
+}
+
+// List retrieves the discrepancies matching the filter, newest first
+func (r *DiscrepancyMongoRepository) List(ctx context.Context, filter model.DiscrepancyFilter) ([]model.Discrepancy, error) {
+	query := tenancy.Filter(ctx, bson.M{})
+	if filter.Status != "" {
+		query["status"] = filter.Status
+	}
+	if filter.Kind != "" {
+		query["kind"] = filter.Kind
+	}
+
+	opts := options.Find().SetSort(newestFirst)
+	if filter.Limit > 0 {
+		opts.SetLimit(int64(filter.Limit))
+	}
+	cursor, err := r.collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, r.handleError("List", err)
+	}
+	defer cursor.Close(ctx)
+
+	discrepancies := []model.Discrepancy{}
+	if err := cursor.All(ctx, &discrepancies); err != nil {
+		return nil, r.handleError("List", err)
+	}
+	return discrepancies, nil
+}
+
+// CountOpenByKind counts the open discrepancies of each kind; it is safe for secondary reads
+func (r *DiscrepancyMongoRepository) CountOpenByKind(ctx context.Context) (map[model.DiscrepancyKind]int, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: tenancy.Filter(ctx, bson.M{"status": model.DiscrepancyOpen})}},
+		{{Key: "$group", Value: bson.D{{Key: "_id", Value: "$kind"}, {Key: "count", Value: bson.M{"$sum": 1}}}}},
+	}
+	cursor, err := database.ForReads(r.collection, database.ReadAnalytics).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, r.handleError("CountOpenByKind", err)
+	}
+	defer cursor.Close(ctx)
+
+	var groups []struct {
+		Kind  model.DiscrepancyKind `bson:"_id"`
+		Count int                   `bson:"count"`
+	}
+	if err := cursor.All(ctx, &groups); err != nil {
+		return nil, r.handleError("CountOpenByKind", err)
+	}
+
+	counts := map[model.DiscrepancyKind]int{}
+	for _, group := range groups {
+		counts[group.Kind] = group.Count
+	}
+	return counts, nil
+}
+
+// LatestForPrescription retrieves the newest discrepancy of a prescription
+func (r *DiscrepancyMongoRepository) LatestForPrescription(ctx context.Context, prescriptionID string) (model.Discrepancy, error) {
+	if err := validation_logic.ValidateID("prescription_id", prescriptionID); err != nil {
+		return model.Discrepancy{}, platformErrors.NewValidationError("prescription_id", prescriptionID, "Invalid prescription ID format")
+	}
+
+	var discrepancy model.Discrepancy
+	opts := options.FindOne().SetSort(newestFirst)
+	err := r.collection.FindOne(ctx, tenancy.Filter(ctx, bson.M{"prescription_id": prescriptionID}), opts).Decode(&discrepancy)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.Discrepancy{}, platformErrors.NewRecordNotFoundError("discrepancy", prescriptionID)
+		}
+		return model.Discrepancy{}, r.handleError("LatestForPrescription", err)
+	}
+	return discrepancy, nil
+}
+
+// Save upserts a discrepancy by its ID
+func (r *DiscrepancyMongoRepository) Save(ctx context.Context, discrepancy model.Discrepancy) (model.Discrepancy, error) {
+	opts := options.Replace().SetUpsert(true)
+	if _, err := r.collection.ReplaceOne(ctx, bson.M{"_id": discrepancy.ID}, discrepancy, opts); err != nil {
+		return model.Discrepancy{}, r.handleError("Save", err)
+	}
+	return discrepancy, nil
+}
+
+// CreateIndexes creates the indexes used by LatestForPrescription and the filtered lists
+func (r *DiscrepancyMongoRepository) CreateIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "prescription_id", Value: 1}, {Key: "first_seen_at", Value: -1}},
+			Options: options.Index().SetName("prescription_id_1_first_seen_at_-1"),
+		},
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "first_seen_at", Value: -1}},
+			Options: options.Index().SetName("status_1_first_seen_at_-1"),
+		},
+	})
+	if err != nil {
+		return r.handleError("CreateIndexes", err)
+	}
+	return nil
+}
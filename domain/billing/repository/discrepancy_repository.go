@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"pharmacy-modernization-project-model/domain/billing/contracts/model"
+)
+
+type DiscrepancyRepository interface {
+	// GetByID returns a RecordNotFoundError when the discrepancy does not exist
+	GetByID(ctx context.Context, id string) (model.Discrepancy, error)
+	// List returns the discrepancies matching the filter, newest first
+	List(ctx context.Context, filter model.DiscrepancyFilter) ([]model.Discrepancy, error)
+	// CountOpenByKind counts the open discrepancies of each kind
+	CountOpenByKind(ctx context.Context) (map[model.DiscrepancyKind]int, error)
+	// LatestForPrescription returns the newest discrepancy of a prescription, or a
+	// RecordNotFoundError when it never had one
+	LatestForPrescription(ctx context.Context, prescriptionID string) (model.Discrepancy, error)
+	// Save creates the discrepancy or replaces the one with its ID
+	Save(ctx context.Context, discrepancy model.Discrepancy) (model.Discrepancy, error)
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+
+	"pharmacy-modernization-project-model/domain/billing/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// DiscrepancyRetryRepository retries the calls of a discrepancy repository that fail with a
+// retryable error; all of them are idempotent
+type DiscrepancyRetryRepository struct {
+	next    DiscrepancyRepository
+	retrier *database.Retrier
+}
+
+// NewDiscrepancyRetryRepository wraps next with retries; without a retrier next is returned as is
+func NewDiscrepancyRetryRepository(next DiscrepancyRepository, retrier *database.Retrier) DiscrepancyRepository {
+	if retrier == nil {
+		return next
+	}
+	return &DiscrepancyRetryRepository{next: next, retrier: retrier}
+}
+
+func (r *DiscrepancyRetryRepository) GetByID(ctx context.Context, id string) (model.Discrepancy, error) {
+	return database.Retry(ctx, r.retrier, "billing_discrepancies.GetByID", func(ctx context.Context) (model.Discrepancy, error) {
+		return r.next.GetByID(ctx, id)
+	})
+}
+
+func (r *DiscrepancyRetryRepository) List(ctx context.Context, filter model.DiscrepancyFilter) ([]model.Discrepancy, error) {
+	return database.Retry(ctx, r.retrier, "billing_discrepancies.List", func(ctx context.Context) ([]model.Discrepancy, error) {
+		return r.next.List(ctx, filter)
+	})
+}
+
+func (r *DiscrepancyRetryRepository) CountOpenByKind(ctx context.Context) (map[model.DiscrepancyKind]int, error) {
+	return database.Retry(ctx, r.retrier, "billing_discrepancies.CountOpenByKind", func(ctx context.Context) (map[model.DiscrepancyKind]int, error) {
+		return r.next.CountOpenByKind(ctx)
+	})
+}
+
+func (r *DiscrepancyRetryRepository) LatestForPrescription(ctx context.Context, prescriptionID string) (model.Discrepancy, error) {
+	return database.Retry(ctx, r.retrier, "billing_discrepancies.LatestForPrescription", func(ctx context.Context) (model.Discrepancy, error) {
+		return r.next.LatestForPrescription(ctx, prescriptionID)
+	})
+}
+
+// Save is retried: replacing a discrepancy by its ID again leaves the same document
+func (r *DiscrepancyRetryRepository) Save(ctx context.Context, discrepancy model.Discrepancy) (model.Discrepancy, error) {
+	return database.Retry(ctx, r.retrier, "billing_discrepancies.Save", func(ctx context.Context) (model.Discrepancy, error) {
+		return r.next.Save(ctx, discrepancy)
+	})
+}
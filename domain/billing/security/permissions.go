@@ -0,0 +1,25 @@
+package security
+
+import (
+	commonsecurity "pharmacy-modernization-project-model/domain/common/security"
+	"pharmacy-modernization-project-model/internal/platform/permissions"
+)
+
+// Billing permissions
+const (
+	PermissionRead        = commonsecurity.BillingPermissionRead
+	PermissionWrite       = commonsecurity.BillingPermissionWrite
+	PermissionAcknowledge = permissions.BillingAcknowledge
+)
+
+// Common permission sets for reuse in routes
+var (
+	// ReadAccess - user needs ANY of these permissions to view invoices and payments
+	ReadAccess = commonsecurity.BillingReadAccess
+
+	// WriteAccess - user needs ANY of these permissions to create invoices and resolve discrepancies
+	WriteAccess = commonsecurity.BillingWriteAccess
+
+	// AcknowledgeAccess - user needs ANY of these permissions to acknowledge invoices
+	AcknowledgeAccess = []string{PermissionAcknowledge, permissions.AdminAll}
+)
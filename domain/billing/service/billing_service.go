@@ -0,0 +1,711 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/billing/contracts/model"
+	"pharmacy-modernization-project-model/domain/billing/contracts/request"
+	"pharmacy-modernization-project-model/domain/billing/providers"
+	commonmodel "pharmacy-modernization-project-model/domain/common/model"
+	prescriptionmodel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	irisbilling "pharmacy-modernization-project-model/internal/integrations/iris_billing"
+	"pharmacy-modernization-project-model/internal/platform/cache"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/metrics"
+)
+
+const billingServiceName = "iris_billing"
+
+// defaultCurrency is the currency of estimates priced from the default pricing table
+const defaultCurrency = "USD"
+
+// agingPrescriptionLimit caps how many completed prescriptions InvoiceAging looks up, since IRIS
+// billing has no list of outstanding invoices
+const agingPrescriptionLimit = 100
+
+// agingBuckets are the invoice aging ranges in days
+var agingBuckets = []model.InvoiceAgingBucket{
+	{Label: "0-30 days", MinDays: 0, MaxDays: 30},
+	{Label: "31-60 days", MinDays: 31, MaxDays: 60},
+	{Label: "61-90 days", MinDays: 61, MaxDays: 90},
+	{Label: "Over 90 days", MinDays: 91},
+}
+
+// Config controls automatic invoicing and how long invoice lookups are cached
+type Config struct {
+	// AutoInvoiceOnComplete bills a prescription as soon as its status changes to Completed
+	AutoInvoiceOnComplete bool
+	// DispensingFee is the amount billed when no amount is given
+	DispensingFee float64
+	CacheTTL      time.Duration
+	// SummaryCacheTTL is how long a patient's invoice list is served from the cache before IRIS is asked again
+	SummaryCacheTTL time.Duration
+	// SummaryMaxStale is how much longer an expired invoice list is kept to be served, flagged stale,
+	// while IRIS billing cannot be reached
+	SummaryMaxStale time.Duration
+	// EstimateCacheTTL is how long a prescription's price estimate from IRIS is reused
+	EstimateCacheTTL time.Duration
+	// DefaultPricing prices estimates while IRIS billing cannot be reached
+	DefaultPricing PricingTable
+}
+
+// PricingTable is a fallback price list; DispensingFee is added to the drug cost
+type PricingTable struct {
+	// UnitPrice is the price per unit of drugs not in Drugs
+	UnitPrice float64
+	// Drugs maps drug names to their unit price; names are matched ignoring case
+	Drugs map[string]float64
+}
+
+func (t PricingTable) unitPrice(drug string) float64 {
+	if price, ok := t.Drugs[strings.ToLower(drug)]; ok {
+		return price
+	}
+	return t.UnitPrice
+}
+
+type BillingService interface {
+	InvoicesByPatient(ctx context.Context, patientID string) ([]model.Invoice, error)
+	// InvoiceSummaryByPatient returns the patient's invoices with when they were read from IRIS
+	InvoiceSummaryByPatient(ctx context.Context, patientID string) (model.InvoiceSummary, error)
+	// PatientInvoiceSummaryByPatientID is InvoiceSummaryByPatient for the patient page
+	PatientInvoiceSummaryByPatientID(ctx context.Context, patientID string) (commonmodel.PatientInvoiceSummary, error)
+	// InvoiceForPrescription returns a RecordNotFoundError when the prescription has not been billed
+	InvoiceForPrescription(ctx context.Context, prescriptionID string) (model.Invoice, error)
+	CreateInvoiceForPrescription(ctx context.Context, prescriptionID string, req request.InvoiceCreateRequest) (model.Invoice, error)
+	// Acknowledge confirms a pending invoice on behalf of the given user
+	Acknowledge(ctx context.Context, prescriptionID, acknowledgedBy string, req request.InvoiceAcknowledgeRequest) (model.Invoice, error)
+	Payment(ctx context.Context, prescriptionID string) (model.InvoicePayment, error)
+	// PriceEstimate returns what the prescription is expected to cost. While IRIS billing cannot be
+	// reached it is priced from the default pricing table and flagged with that source.
+	PriceEstimate(ctx context.Context, prescriptionID string) (model.PriceEstimate, error)
+	// PrescriptionPriceEstimateByID is PriceEstimate for the prescription pages
+	PrescriptionPriceEstimateByID(ctx context.Context, prescriptionID string) (commonmodel.PrescriptionPriceEstimate, error)
+	// InvoiceAging buckets the outstanding invoices of the newest completed prescriptions by age
+	InvoiceAging(ctx context.Context) (model.InvoiceAging, error)
+	// HandlePrescriptionCompleted bills a prescription that has just been completed; failures are logged, not returned
+	HandlePrescriptionCompleted(ctx context.Context, prescription prescriptionmodel.Prescription)
+	// HandleDispenseReversed voids the prescription's invoice, or credits it once paid; failures are logged, not returned
+	HandleDispenseReversed(ctx context.Context, dispense, reversal prescriptionmodel.DispenseRecord)
+	// HandleInvoiceEvent drops cached invoice data that an IRIS billing event reports as changed
+	HandleInvoiceEvent(ctx context.Context, event model.InvoiceEvent)
+	// OnInvoiceCreated registers a handler called after an invoice is created in IRIS billing
+	OnInvoiceCreated(handler InvoiceCreatedHandler)
+	// UpdateConfig applies reloaded settings to the calls that start after it
+	UpdateConfig(cfg Config)
+	// UsePatientProvider sets where patients are read before their invoices are listed, so those of
+	// another organization are not found; until it is set any patient's invoices are listed
+	UsePatientProvider(provider providers.PatientProvider)
+}
+
+// InvoiceCreatedHandler reacts to a new invoice; it runs after IRIS billing accepted the invoice
+type InvoiceCreatedHandler func(ctx context.Context, invoice model.Invoice)
+
+type billingSvc struct {
+	client        irisbilling.BillingClient
+	prescriptions providers.PrescriptionProvider
+	patients      providers.PatientProvider
+	cache         cache.Cache
+	cacheKeys     *CacheKeys
+	cfg           atomic.Pointer[Config]
+	log           *zap.Logger
+	onCreated     []InvoiceCreatedHandler
+}
+
+func New(client irisbilling.BillingClient, prescriptions providers.PrescriptionProvider, c cache.Cache, cfg Config, l *zap.Logger) BillingService {
+	s := &billingSvc{
+		client:        client,
+		prescriptions: prescriptions,
+		cache:         c,
+		cacheKeys:     NewCacheKeys(),
+		log:           l,
+	}
+	s.UpdateConfig(cfg)
+	return s
+}
+
+func (s *billingSvc) UpdateConfig(cfg Config) {
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = 5 * time.Minute
+	}
+	if cfg.SummaryCacheTTL <= 0 {
+		cfg.SummaryCacheTTL = 30 * time.Second
+	}
+	if cfg.SummaryMaxStale < 0 {
+		cfg.SummaryMaxStale = 0
+	}
+	if cfg.EstimateCacheTTL <= 0 {
+		cfg.EstimateCacheTTL = 2 * time.Minute
+	}
+	drugs := make(map[string]float64, len(cfg.DefaultPricing.Drugs))
+	for drug, price := range cfg.DefaultPricing.Drugs {
+		drugs[strings.ToLower(drug)] = price
+	}
+	cfg.DefaultPricing.Drugs = drugs
+	s.cfg.Store(&cfg)
+}
+
+func (s *billingSvc) UsePatientProvider(provider providers.PatientProvider) {
+	s.patients = provider
+}
+
+func (s *billingSvc) InvoicesByPatient(ctx context.Context, patientID string) ([]model.Invoice, error) {
+	summary, err := s.InvoiceSummaryByPatient(ctx, patientID)
+	if err != nil {
+		return nil, err
+	}
+	return summary.Invoices, nil
+}
+
+func (s *billingSvc) InvoiceSummaryByPatient(ctx context.Context, patientID string) (model.InvoiceSummary, error) {
+	const operation = "invoices_by_patient"
+	if s.patients != nil {
+		// IRIS billing knows nothing of organizations; reading the patient first answers one of another organization with a 404
+		if _, err := s.patients.GetByID(ctx, patientID); err != nil {
+			return model.InvoiceSummary{}, err
+		}
+	}
+	cfg := s.cfg.Load()
+	cacheKey := s.cacheKeys.InvoicesByPatientID(patientID)
+	var cached model.InvoiceSummary
+	found := s.getCached(ctx, cacheKey, &cached)
+	if found && time.Since(cached.FetchedAt) < cfg.SummaryCacheTTL {
+		metrics.ObserveCacheLookup(billingServiceName, operation, metrics.CacheHit)
+		return cached, nil
+	}
+
+	resp, err := s.client.GetInvoicesByPatientID(ctx, patientID)
+	if err != nil {
+		// An expired copy is better than no billing history while IRIS is down
+		if found {
+			metrics.ObserveCacheLookup(billingServiceName, operation, metrics.CacheStale)
+			s.log.Warn("Serving stale invoices: IRIS billing unavailable",
+				zap.String("patient_id", patientID),
+				zap.Time("fetched_at", cached.FetchedAt),
+				zap.Error(err))
+			cached.Stale = true
+			return cached, nil
+		}
+		s.log.Error("Failed to fetch invoices for patient",
+			zap.String("patient_id", patientID),
+			zap.Error(err))
+		return model.InvoiceSummary{}, platformErrors.NewExternalServiceError(billingServiceName, "GetInvoicesByPatientID", err.Error())
+	}
+	metrics.ObserveCacheLookup(billingServiceName, operation, metrics.CacheMiss)
+
+	summary := model.InvoiceSummary{
+		PatientID: patientID,
+		Invoices:  make([]model.Invoice, 0, len(resp.Invoices)),
+		Total:     resp.Total,
+		FetchedAt: time.Now().UTC(),
+	}
+	for _, inv := range resp.Invoices {
+		summary.Invoices = append(summary.Invoices, toInvoice(inv, patientID))
+	}
+	if summary.Total < len(summary.Invoices) {
+		summary.Total = len(summary.Invoices)
+	}
+
+	s.setCached(ctx, cacheKey, summary, cfg.SummaryCacheTTL+cfg.SummaryMaxStale)
+	return summary, nil
+}
+
+func (s *billingSvc) PatientInvoiceSummaryByPatientID(ctx context.Context, patientID string) (commonmodel.PatientInvoiceSummary, error) {
+	summary, err := s.InvoiceSummaryByPatient(ctx, patientID)
+	if err != nil {
+		return commonmodel.PatientInvoiceSummary{}, err
+	}
+
+	invoices := make([]commonmodel.PatientInvoice, 0, len(summary.Invoices))
+	for _, inv := range summary.Invoices {
+		invoices = append(invoices, commonmodel.PatientInvoice{
+			ID:             inv.ID,
+			PrescriptionID: inv.PrescriptionID,
+			Amount:         inv.Amount,
+			Status:         inv.Status,
+			CreatedAt:      inv.CreatedAt,
+		})
+	}
+	return commonmodel.PatientInvoiceSummary{
+		Invoices:  invoices,
+		FetchedAt: summary.FetchedAt,
+		Stale:     summary.Stale,
+	}, nil
+}
+
+func (s *billingSvc) InvoiceForPrescription(ctx context.Context, prescriptionID string) (model.Invoice, error) {
+	const operation = "invoice_by_prescription"
+	cacheKey := s.cacheKeys.InvoiceByPrescriptionID(prescriptionID)
+	var invoice model.Invoice
+	if s.getCached(ctx, cacheKey, &invoice) {
+		metrics.ObserveCacheLookup(billingServiceName, operation, metrics.CacheHit)
+		return invoice, nil
+	}
+	metrics.ObserveCacheLookup(billingServiceName, operation, metrics.CacheMiss)
+
+	invoice, err := s.fetchInvoice(ctx, prescriptionID)
+	if err != nil {
+		return model.Invoice{}, err
+	}
+	if invoice.ID == "" {
+		return model.Invoice{}, platformErrors.NewRecordNotFoundError("invoice", prescriptionID)
+	}
+
+	s.setCached(ctx, cacheKey, invoice, s.cfg.Load().CacheTTL)
+	return invoice, nil
+}
+
+func (s *billingSvc) CreateInvoiceForPrescription(ctx context.Context, prescriptionID string, req request.InvoiceCreateRequest) (model.Invoice, error) {
+	prescription, err := s.prescription(ctx, prescriptionID)
+	if err != nil {
+		return model.Invoice{}, err
+	}
+	if prescription.Status != prescriptionmodel.Completed {
+		return model.Invoice{}, platformErrors.NewBusinessLogicError("CreateInvoice",
+			fmt.Sprintf("only completed prescriptions can be invoiced (status is %s)", prescription.Status))
+	}
+
+	// Bypass the cache: a stale "unbilled" entry must not lead to a second invoice
+	existing, err := s.fetchInvoice(ctx, prescriptionID)
+	if err != nil {
+		return model.Invoice{}, err
+	}
+	// A voided or credited invoice no longer bills the prescription, so a reversed dispense can be billed again
+	replaces := ""
+	switch {
+	case existing.ID == "":
+	case existing.Status == model.InvoiceVoided || existing.Status == model.InvoiceCredited:
+		replaces = existing.ID
+	default:
+		return model.Invoice{}, platformErrors.NewConflictError("invoice", prescriptionID,
+			fmt.Sprintf("prescription is already billed by invoice %s", existing.ID))
+	}
+
+	amount := req.Amount
+	if amount == 0 {
+		amount = s.cfg.Load().DispensingFee
+	}
+	if amount <= 0 {
+		return model.Invoice{}, platformErrors.NewValidationError("amount", req.Amount, "amount is required when no dispensing fee is configured")
+	}
+	description := req.Description
+	if description == "" {
+		description = fmt.Sprintf("%s %s", prescription.Drug, prescription.Dose)
+	}
+
+	resp, err := s.client.CreateInvoice(ctx, irisbilling.CreateInvoiceRequest{
+		PrescriptionID:    prescriptionID,
+		PatientID:         prescription.PatientID,
+		Amount:            amount,
+		Description:       description,
+		ReplacesInvoiceID: replaces,
+	})
+	if err != nil {
+		s.log.Error("Failed to create invoice",
+			zap.String("prescription_id", prescriptionID),
+			zap.Error(err))
+		return model.Invoice{}, platformErrors.NewExternalServiceError(billingServiceName, "CreateInvoice", err.Error())
+	}
+
+	s.invalidate(ctx, prescriptionID, prescription.PatientID)
+
+	s.log.Info("Invoice created for prescription",
+		zap.String("prescription_id", prescriptionID),
+		zap.String("invoice_id", resp.ID))
+
+	invoice := toInvoice(resp.InvoiceResponse, prescription.PatientID)
+	for _, handler := range s.onCreated {
+		handler(ctx, invoice)
+	}
+	return invoice, nil
+}
+
+func (s *billingSvc) OnInvoiceCreated(handler InvoiceCreatedHandler) {
+	s.onCreated = append(s.onCreated, handler)
+}
+
+func (s *billingSvc) Acknowledge(ctx context.Context, prescriptionID, acknowledgedBy string, req request.InvoiceAcknowledgeRequest) (model.Invoice, error) {
+	if acknowledgedBy == "" {
+		return model.Invoice{}, platformErrors.NewAuthorizationError("invoice", "acknowledge", "acknowledging an invoice requires an identified user")
+	}
+
+	invoice, err := s.fetchInvoice(ctx, prescriptionID)
+	if err != nil {
+		return model.Invoice{}, err
+	}
+	switch invoice.Status {
+	case model.InvoicePending:
+	case model.InvoiceAcknowledged:
+		return model.Invoice{}, platformErrors.NewConflictError("invoice", invoice.ID, "invoice is already acknowledged")
+	default:
+		if invoice.ID == "" {
+			return model.Invoice{}, platformErrors.NewRecordNotFoundError("invoice", prescriptionID)
+		}
+		return model.Invoice{}, platformErrors.NewBusinessLogicError("AcknowledgeInvoice",
+			fmt.Sprintf("only pending invoices can be acknowledged (status is %s)", invoice.Status))
+	}
+
+	resp, err := s.client.AcknowledgeInvoice(ctx, invoice.ID, irisbilling.AcknowledgeInvoiceRequest{
+		AcknowledgedBy: acknowledgedBy,
+		Notes:          req.Notes,
+	})
+	if err != nil {
+		s.log.Error("Failed to acknowledge invoice",
+			zap.String("invoice_id", invoice.ID),
+			zap.Error(err))
+		return model.Invoice{}, platformErrors.NewExternalServiceError(billingServiceName, "AcknowledgeInvoice", err.Error())
+	}
+
+	patientID := ""
+	if prescription, err := s.prescriptions.GetByID(ctx, prescriptionID); err == nil {
+		patientID = prescription.PatientID
+	}
+	s.invalidate(ctx, prescriptionID, patientID)
+
+	s.log.Info("Invoice acknowledged",
+		zap.String("invoice_id", invoice.ID))
+	return toInvoice(resp.InvoiceResponse, patientID), nil
+}
+
+func (s *billingSvc) Payment(ctx context.Context, prescriptionID string) (model.InvoicePayment, error) {
+	invoice, err := s.InvoiceForPrescription(ctx, prescriptionID)
+	if err != nil {
+		return model.InvoicePayment{}, err
+	}
+
+	resp, err := s.client.GetInvoicePayment(ctx, invoice.ID)
+	if err != nil {
+		s.log.Error("Failed to fetch invoice payment",
+			zap.String("invoice_id", invoice.ID),
+			zap.Error(err))
+		return model.InvoicePayment{}, platformErrors.NewExternalServiceError(billingServiceName, "GetInvoicePayment", err.Error())
+	}
+
+	return model.InvoicePayment{
+		InvoiceID:     resp.InvoiceID,
+		PaymentID:     resp.PaymentID,
+		Amount:        resp.Amount,
+		PaymentMethod: resp.PaymentMethod,
+		Status:        resp.Status,
+		PaidAt:        resp.PaidAt,
+	}, nil
+}
+
+func (s *billingSvc) PriceEstimate(ctx context.Context, prescriptionID string) (model.PriceEstimate, error) {
+	const operation = "price_estimate"
+	cacheKey := s.cacheKeys.PriceEstimateByPrescriptionID(prescriptionID)
+	var estimate model.PriceEstimate
+	if s.getCached(ctx, cacheKey, &estimate) {
+		metrics.ObserveCacheLookup(billingServiceName, operation, metrics.CacheHit)
+		return estimate, nil
+	}
+	metrics.ObserveCacheLookup(billingServiceName, operation, metrics.CacheMiss)
+
+	prescription, err := s.prescription(ctx, prescriptionID)
+	if err != nil {
+		return model.PriceEstimate{}, err
+	}
+	// A prescription without a quantity is priced as a single unit
+	quantity := max(prescription.Quantity, 1)
+
+	cfg := s.cfg.Load()
+	resp, err := s.client.EstimatePrice(ctx, irisbilling.PriceEstimateRequest{
+		PrescriptionID: prescriptionID,
+		PatientID:      prescription.PatientID,
+		Drug:           prescription.Drug,
+		DrugID:         prescription.DrugID,
+		Dose:           prescription.Dose,
+		Quantity:       quantity,
+		DaysSupply:     prescription.DaysSupply,
+	})
+	if err != nil {
+		// Not cached, so the next estimate asks IRIS again
+		s.log.Warn("Estimating price from the default pricing table: IRIS billing unavailable",
+			zap.String("prescription_id", prescriptionID),
+			zap.Error(err))
+		return defaultEstimate(prescription, quantity, cfg), nil
+	}
+
+	estimate = model.PriceEstimate{
+		PrescriptionID: prescriptionID,
+		Drug:           prescription.Drug,
+		Quantity:       quantity,
+		UnitPrice:      resp.UnitPrice,
+		IngredientCost: resp.IngredientCost,
+		DispensingFee:  resp.DispensingFee,
+		Total:          resp.Total,
+		Currency:       resp.Currency,
+		Source:         model.EstimateSourceIRIS,
+		EstimatedAt:    time.Now().UTC(),
+	}
+	if estimate.Currency == "" {
+		estimate.Currency = defaultCurrency
+	}
+	s.setCached(ctx, cacheKey, estimate, cfg.EstimateCacheTTL)
+	return estimate, nil
+}
+
+func (s *billingSvc) PrescriptionPriceEstimateByID(ctx context.Context, prescriptionID string) (commonmodel.PrescriptionPriceEstimate, error) {
+	estimate, err := s.PriceEstimate(ctx, prescriptionID)
+	if err != nil {
+		return commonmodel.PrescriptionPriceEstimate{}, err
+	}
+	return commonmodel.PrescriptionPriceEstimate{
+		Quantity:       estimate.Quantity,
+		UnitPrice:      estimate.UnitPrice,
+		IngredientCost: estimate.IngredientCost,
+		DispensingFee:  estimate.DispensingFee,
+		Total:          estimate.Total,
+		Currency:       estimate.Currency,
+		Default:        estimate.Source == model.EstimateSourceDefault,
+		EstimatedAt:    estimate.EstimatedAt,
+	}, nil
+}
+
+func (s *billingSvc) HandlePrescriptionCompleted(ctx context.Context, prescription prescriptionmodel.Prescription) {
+	cfg := s.cfg.Load()
+	if !cfg.AutoInvoiceOnComplete {
+		return
+	}
+	if cfg.DispensingFee <= 0 {
+		s.log.Warn("Skipping automatic invoice: no dispensing fee configured",
+			zap.String("prescription_id", prescription.ID))
+		return
+	}
+
+	_, err := s.CreateInvoiceForPrescription(ctx, prescription.ID, request.InvoiceCreateRequest{})
+	var conflict platformErrors.ConflictError
+	switch {
+	case err == nil:
+	case errors.As(err, &conflict):
+		s.log.Debug("Prescription already invoiced",
+			zap.String("prescription_id", prescription.ID))
+	default:
+		// The invoice can still be created manually; completing the prescription must not fail
+		s.log.Error("Automatic invoice creation failed",
+			zap.String("prescription_id", prescription.ID),
+			zap.Error(err))
+	}
+}
+
+func (s *billingSvc) HandleDispenseReversed(ctx context.Context, dispense, reversal prescriptionmodel.DispenseRecord) {
+	invoice, err := s.fetchInvoice(ctx, dispense.PrescriptionID)
+	if err != nil {
+		s.log.Error("Reversed dispense: invoice lookup failed",
+			zap.String("prescription_id", dispense.PrescriptionID),
+			zap.String("reversal_id", reversal.ID),
+			zap.Error(err))
+		return
+	}
+
+	reasonCode, reason := "", ""
+	if reversal.Reason != nil {
+		reasonCode = string(reversal.Reason.Code)
+		reason = reversal.Reason.Note
+	}
+
+	switch invoice.Status {
+	case model.InvoicePending, model.InvoiceAcknowledged:
+		_, err = s.client.VoidInvoice(ctx, invoice.ID, irisbilling.VoidInvoiceRequest{
+			VoidedBy:   reversal.DispensedBy,
+			ReasonCode: reasonCode,
+			Reason:     reason,
+		})
+	case model.InvoicePaid:
+		// Paid invoices cannot be voided; credit the full amount instead
+		_, err = s.client.AdjustInvoice(ctx, invoice.ID, irisbilling.AdjustInvoiceRequest{
+			Amount:     -invoice.Amount,
+			AdjustedBy: reversal.DispensedBy,
+			ReasonCode: reasonCode,
+			Reason:     reason,
+		})
+	default:
+		// Unbilled, or already voided or credited: nothing to undo
+		s.log.Debug("Reversed dispense has no open invoice",
+			zap.String("prescription_id", dispense.PrescriptionID),
+			zap.String("status", invoice.Status))
+		return
+	}
+	if err != nil {
+		// Billing has to be corrected by hand; the reversal itself stands
+		s.log.Error("Reversed dispense: invoice correction failed",
+			zap.String("prescription_id", dispense.PrescriptionID),
+			zap.String("invoice_id", invoice.ID),
+			zap.String("reversal_id", reversal.ID),
+			zap.Error(err))
+		return
+	}
+
+	s.invalidate(ctx, dispense.PrescriptionID, dispense.PatientID)
+	s.log.Info("Invoice corrected for reversed dispense",
+		zap.String("invoice_id", invoice.ID),
+		zap.String("previous_status", invoice.Status),
+		zap.String("reversal_id", reversal.ID))
+}
+
+func (s *billingSvc) HandleInvoiceEvent(ctx context.Context, event model.InvoiceEvent) {
+	patientID := event.PatientID
+	if patientID == "" {
+		if prescription, err := s.prescriptions.GetByID(ctx, event.PrescriptionID); err == nil {
+			patientID = prescription.PatientID
+		}
+	}
+	s.invalidate(ctx, event.PrescriptionID, patientID)
+
+	s.log.Info("Billing cache invalidated by invoice event",
+		zap.String("event_type", event.Type),
+		zap.String("invoice_id", event.InvoiceID),
+		zap.String("prescription_id", event.PrescriptionID),
+		zap.String("patient_id", patientID))
+}
+
+func (s *billingSvc) InvoiceAging(ctx context.Context) (model.InvoiceAging, error) {
+	prescriptions, err := s.prescriptions.List(ctx, string(prescriptionmodel.Completed), agingPrescriptionLimit, 0)
+	if err != nil {
+		return model.InvoiceAging{}, err
+	}
+
+	now := time.Now()
+	aging := model.InvoiceAging{
+		Buckets: append([]model.InvoiceAgingBucket(nil), agingBuckets...),
+		Checked: len(prescriptions),
+		AsOf:    now,
+	}
+	for _, prescription := range prescriptions {
+		invoice, err := s.InvoiceForPrescription(ctx, prescription.ID)
+		if platformErrors.IsNotFoundError(err) {
+			continue
+		}
+		if err != nil {
+			return model.InvoiceAging{}, err
+		}
+		if invoice.Status != model.InvoicePending && invoice.Status != model.InvoiceAcknowledged {
+			continue
+		}
+
+		// Invoices without a readable creation time count as new
+		days := 0
+		if createdAt, err := time.Parse(time.RFC3339, invoice.CreatedAt); err == nil {
+			days = int(now.Sub(createdAt).Hours() / 24)
+		}
+		for i := range aging.Buckets {
+			bucket := &aging.Buckets[i]
+			if days >= bucket.MinDays && (bucket.MaxDays == 0 || days <= bucket.MaxDays) {
+				bucket.Count++
+				bucket.Amount += invoice.Amount
+				break
+			}
+		}
+		aging.Outstanding++
+		aging.Amount += invoice.Amount
+	}
+	return aging, nil
+}
+
+// fetchInvoice reads the invoice from IRIS without the cache; an unbilled prescription has an empty ID
+func (s *billingSvc) fetchInvoice(ctx context.Context, prescriptionID string) (model.Invoice, error) {
+	resp, err := s.client.GetInvoice(ctx, prescriptionID)
+	if err != nil {
+		s.log.Error("Failed to fetch invoice",
+			zap.String("prescription_id", prescriptionID),
+			zap.Error(err))
+		return model.Invoice{}, platformErrors.NewExternalServiceError(billingServiceName, "GetInvoice", err.Error())
+	}
+	return toInvoice(*resp, ""), nil
+}
+
+func (s *billingSvc) prescription(ctx context.Context, prescriptionID string) (prescriptionmodel.Prescription, error) {
+	prescription, err := s.prescriptions.GetByID(ctx, prescriptionID)
+	if err != nil {
+		return prescriptionmodel.Prescription{}, err
+	}
+	if prescription.ID == "" {
+		return prescriptionmodel.Prescription{}, platformErrors.NewRecordNotFoundError("prescription", prescriptionID)
+	}
+	return prescription, nil
+}
+
+func (s *billingSvc) getCached(ctx context.Context, key string, v interface{}) bool {
+	if s.cache == nil {
+		return false
+	}
+	cached, err := s.cache.Get(ctx, key)
+	if err != nil {
+		return false
+	}
+	if err := json.Unmarshal(cached, v); err != nil {
+		return false
+	}
+	s.log.Debug("Billing data retrieved from cache", zap.String("key", key))
+	return true
+}
+
+func (s *billingSvc) setCached(ctx context.Context, key string, v interface{}, ttl time.Duration) {
+	if s.cache == nil {
+		return
+	}
+	if data, err := json.Marshal(v); err == nil {
+		if err := s.cache.Set(ctx, key, data, ttl); err != nil {
+			s.log.Warn("Failed to cache billing data", zap.Error(err))
+		}
+	}
+}
+
+func (s *billingSvc) invalidate(ctx context.Context, prescriptionID, patientID string) {
+	if s.cache == nil {
+		return
+	}
+	keys := []string{s.cacheKeys.InvoiceByPrescriptionID(prescriptionID)}
+	if patientID != "" {
+		keys = append(keys, s.cacheKeys.InvoicesByPatientID(patientID))
+	}
+	for _, key := range keys {
+		if err := s.cache.Delete(ctx, key); err != nil {
+			s.log.Warn("Failed to invalidate billing cache",
+				zap.Error(err))
+		}
+	}
+}
+
+// defaultEstimate prices a prescription from the default pricing table and the dispensing fee
+func defaultEstimate(prescription prescriptionmodel.Prescription, quantity int, cfg *Config) model.PriceEstimate {
+	unitPrice := cfg.DefaultPricing.unitPrice(prescription.Drug)
+	ingredientCost := roundCents(unitPrice * float64(quantity))
+	return model.PriceEstimate{
+		PrescriptionID: prescription.ID,
+		Drug:           prescription.Drug,
+		Quantity:       quantity,
+		UnitPrice:      unitPrice,
+		IngredientCost: ingredientCost,
+		DispensingFee:  cfg.DispensingFee,
+		Total:          roundCents(ingredientCost + cfg.DispensingFee),
+		Currency:       defaultCurrency,
+		Source:         model.EstimateSourceDefault,
+		EstimatedAt:    time.Now().UTC(),
+	}
+}
+
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+func toInvoice(inv irisbilling.InvoiceResponse, patientID string) model.Invoice {
+	return model.Invoice{
+		ID:             inv.ID,
+		PrescriptionID: inv.PrescriptionID,
+		PatientID:      patientID,
+		Amount:         inv.Amount,
+		Status:         inv.Status,
+		CreatedAt:      inv.CreatedAt,
+		UpdatedAt:      inv.UpdatedAt,
+	}
+}
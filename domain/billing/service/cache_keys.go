@@ -0,0 +1,39 @@
+package service
+
+import (
+	"fmt"
+
+	"pharmacy-modernization-project-model/internal/platform/cache"
+)
+
+// CacheKeys provides centralized cache key management for the billing domain
+type CacheKeys struct{}
+
+// NewCacheKeys creates a new cache keys instance
+func NewCacheKeys() *CacheKeys {
+	return &CacheKeys{}
+}
+
+// InvoiceByPrescriptionID returns cache key for a prescription's invoice
+func (k *CacheKeys) InvoiceByPrescriptionID(prescriptionID string) string {
+	if !cache.ValidateID(prescriptionID) {
+		return "invoice:prescription:invalid"
+	}
+	return fmt.Sprintf("invoice:prescription:%s", cache.SanitizeKey(prescriptionID))
+}
+
+// InvoicesByPatientID returns cache key for a patient's invoices
+func (k *CacheKeys) InvoicesByPatientID(patientID string) string {
+	if !cache.ValidateID(patientID) {
+		return "invoice:patient:invalid"
+	}
+	return fmt.Sprintf("invoice:patient:%s", cache.SanitizeKey(patientID))
+}
+
+// PriceEstimateByPrescriptionID returns cache key for a prescription's price estimate
+func (k *CacheKeys) PriceEstimateByPrescriptionID(prescriptionID string) string {
+	if !cache.ValidateID(prescriptionID) {
+		return "price-estimate:prescription:invalid"
+	}
+	return fmt.Sprintf("price-estimate:prescription:%s", cache.SanitizeKey(prescriptionID))
+}
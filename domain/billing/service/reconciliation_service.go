@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/billing/contracts/model"
+	"pharmacy-modernization-project-model/domain/billing/repository"
+	prescriptionmodel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	irisbilling "pharmacy-modernization-project-model/internal/integrations/iris_billing"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// amountTolerance is how far an invoiced amount may be from the dispensing fee, to absorb rounding
+const amountTolerance = 0.005
+
+// ReconciliationConfig sets what a completed prescription's invoice is expected to hold
+type ReconciliationConfig struct {
+	// DispensingFee is the expected invoice amount; amounts are not compared when it is 0
+	DispensingFee float64
+}
+
+// ReconciliationService compares completed prescriptions with their IRIS invoices and keeps the
+// discrepancies found for billing staff to resolve
+type ReconciliationService interface {
+	// Reconcile checks a completed prescription's invoice, recording, updating or closing its
+	// discrepancy; it returns the open discrepancy, or nil when the invoice matches. IRIS billing
+	// failures are ExternalServiceErrors.
+	Reconcile(ctx context.Context, prescription prescriptionmodel.Prescription) (*model.Discrepancy, error)
+	// Report lists the discrepancies matching the filter with the open counts of each kind
+	Report(ctx context.Context, filter model.DiscrepancyFilter) (model.DiscrepancyReport, error)
+	// Resolve closes an open discrepancy on behalf of the given user; while the invoice stays as
+	// it is, reconciliation does not flag the prescription again
+	Resolve(ctx context.Context, id, resolvedBy, resolution string) (model.Discrepancy, error)
+}
+
+type reconciliationSvc struct {
+	client irisbilling.BillingClient
+	repo   repository.DiscrepancyRepository
+	cfg    ReconciliationConfig
+	log    *zap.Logger
+}
+
+func NewReconciliationService(client irisbilling.BillingClient, repo repository.DiscrepancyRepository, cfg ReconciliationConfig, l *zap.Logger) ReconciliationService {
+	return &reconciliationSvc{client: client, repo: repo, cfg: cfg, log: l}
+}
+
+func (s *reconciliationSvc) Reconcile(ctx context.Context, prescription prescriptionmodel.Prescription) (*model.Discrepancy, error) {
+	// Read IRIS directly: a cached invoice could hide a correction made since
+	resp, err := s.client.GetInvoice(ctx, prescription.ID)
+	if err != nil {
+		s.log.Error("Failed to fetch invoice for reconciliation",
+			zap.String("prescription_id", prescription.ID),
+			zap.Error(err))
+		return nil, platformErrors.NewExternalServiceError(billingServiceName, "GetInvoice", err.Error())
+	}
+	invoice := toInvoice(*resp, prescription.PatientID)
+	kind := s.discrepancyKind(invoice)
+
+	latest, err := s.repo.LatestForPrescription(ctx, prescription.ID)
+	if err != nil && !platformErrors.IsNotFoundError(err) {
+		return nil, err
+	}
+	found := err == nil
+	now := time.Now().UTC()
+
+	switch {
+	case found && latest.Status == model.DiscrepancyOpen && kind == "":
+		latest.Status = model.DiscrepancyResolved
+		latest.ResolvedAt = &now
+		latest.ResolvedBy = model.ReconciliationResolver
+		latest.Resolution = "Invoice matches the prescription"
+		if _, err := s.repo.Save(ctx, latest); err != nil {
+			return nil, err
+		}
+		s.log.Info("Billing discrepancy closed: invoice corrected",
+			zap.String("discrepancy_id", latest.ID),
+			zap.String("prescription_id", prescription.ID))
+		return nil, nil
+
+	case found && latest.Status == model.DiscrepancyOpen:
+		s.observe(&latest, kind, invoice, now)
+		if _, err := s.repo.Save(ctx, latest); err != nil {
+			return nil, err
+		}
+		return &latest, nil
+
+	case kind == "":
+		return nil, nil
+
+	case found && latest.ResolvedBy != model.ReconciliationResolver && sameFinding(latest, kind, invoice):
+		// Resolved by billing staff as it stands, e.g. a prescription billed at a negotiated price
+		return nil, nil
+	}
+
+	discrepancy := model.Discrepancy{
+		ID:             uuid.NewString(),
+		PrescriptionID: prescription.ID,
+		PatientID:      prescription.PatientID,
+		Status:         model.DiscrepancyOpen,
+		FirstSeenAt:    now,
+		OrgID:          prescription.OrgID,
+	}
+	s.observe(&discrepancy, kind, invoice, now)
+	if _, err := s.repo.Save(ctx, discrepancy); err != nil {
+		return nil, err
+	}
+	s.log.Warn("Billing discrepancy found",
+		zap.String("discrepancy_id", discrepancy.ID),
+		zap.String("prescription_id", prescription.ID),
+		zap.String("kind", string(kind)),
+		zap.String("invoice_id", invoice.ID),
+		zap.Float64("invoiced_amount", invoice.Amount))
+	return &discrepancy, nil
+}
+
+func (s *reconciliationSvc) Report(ctx context.Context, filter model.DiscrepancyFilter) (model.DiscrepancyReport, error) {
+	discrepancies, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return model.DiscrepancyReport{}, err
+	}
+	counts, err := s.repo.CountOpenByKind(ctx)
+	if err != nil {
+		return model.DiscrepancyReport{}, err
+	}
+
+	report := model.DiscrepancyReport{
+		MissingInvoices:  counts[model.MissingInvoice],
+		AmountMismatches: counts[model.AmountMismatch],
+		Discrepancies:    discrepancies,
+		AsOf:             time.Now().UTC(),
+	}
+	for _, count := range counts {
+		report.Open += count
+	}
+	return report, nil
+}
+
+func (s *reconciliationSvc) Resolve(ctx context.Context, id, resolvedBy, resolution string) (model.Discrepancy, error) {
+	discrepancy, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return model.Discrepancy{}, err
+	}
+	if discrepancy.Status != model.DiscrepancyOpen {
+		return model.Discrepancy{}, platformErrors.NewBusinessLogicError("resolve billing discrepancy", "discrepancy is already resolved")
+	}
+
+	now := time.Now().UTC()
+	discrepancy.Status = model.DiscrepancyResolved
+	discrepancy.ResolvedAt = &now
+	discrepancy.ResolvedBy = resolvedBy
+	discrepancy.Resolution = resolution
+	if _, err := s.repo.Save(ctx, discrepancy); err != nil {
+		return model.Discrepancy{}, err
+	}
+
+	s.log.Info("Billing discrepancy resolved",
+		zap.String("discrepancy_id", discrepancy.ID),
+		zap.String("prescription_id", discrepancy.PrescriptionID),
+		zap.String("resolved_by", resolvedBy))
+	return discrepancy, nil
+}
+
+// discrepancyKind returns what is wrong with a completed prescription's invoice; empty when nothing is
+func (s *reconciliationSvc) discrepancyKind(invoice model.Invoice) model.DiscrepancyKind {
+	switch {
+	case invoice.ID == "", invoice.Status == model.InvoiceUnbilled,
+		invoice.Status == model.InvoiceVoided, invoice.Status == model.InvoiceCredited:
+		return model.MissingInvoice
+	case s.cfg.DispensingFee > 0 && math.Abs(invoice.Amount-s.cfg.DispensingFee) > amountTolerance:
+		return model.AmountMismatch
+	}
+	return ""
+}
+
+// observe records what the latest run saw on a discrepancy
+func (s *reconciliationSvc) observe(discrepancy *model.Discrepancy, kind model.DiscrepancyKind, invoice model.Invoice, now time.Time) {
+	discrepancy.Kind = kind
+	discrepancy.InvoiceID = invoice.ID
+	discrepancy.InvoiceStatus = invoice.Status
+	discrepancy.ExpectedAmount = s.cfg.DispensingFee
+	discrepancy.InvoicedAmount = invoice.Amount
+	discrepancy.LastSeenAt = now
+}
+
+// sameFinding reports whether an invoice still shows what a resolved discrepancy recorded
+func sameFinding(discrepancy model.Discrepancy, kind model.DiscrepancyKind, invoice model.Invoice) bool {
+	return discrepancy.Kind == kind &&
+		discrepancy.InvoiceID == invoice.ID &&
+		math.Abs(discrepancy.InvoicedAmount-invoice.Amount) <= amountTolerance
+}
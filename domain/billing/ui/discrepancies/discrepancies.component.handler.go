@@ -0,0 +1,118 @@
+package discrepancies
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/billing/contracts/model"
+	"pharmacy-modernization-project-model/domain/billing/contracts/request"
+	billingservice "pharmacy-modernization-project-model/domain/billing/service"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// pageDiscrepancies is how many discrepancies the page lists
+const pageDiscrepancies = 200
+
+// statusAll is the status filter listing open and resolved discrepancies
+const statusAll = "all"
+
+type DiscrepanciesHandler struct {
+	reconciliationService billingservice.ReconciliationService
+	log                   *zap.Logger
+}
+
+func NewDiscrepanciesHandler(reconciliation billingservice.ReconciliationService, log *zap.Logger) *DiscrepanciesHandler {
+	return &DiscrepanciesHandler{reconciliationService: reconciliation, log: log}
+}
+
+// Handler lists the discrepancies, open ones unless another status is asked for
+func (h *DiscrepanciesHandler) Handler(w http.ResponseWriter, r *http.Request) {
+	h.renderPage(w, r, pageQuery(r), "", http.StatusOK)
+}
+
+// Resolve closes a discrepancy and returns to the list it was resolved from
+func (h *DiscrepanciesHandler) Resolve(w http.ResponseWriter, r *http.Request) {
+	query := pageQuery(r)
+
+	form, _, err := bind.Form[request.DiscrepancyResolveFormRequest](r)
+	if err != nil {
+		h.renderPage(w, r, query, "A resolution note of at most 500 characters is required", http.StatusBadRequest)
+		return
+	}
+
+	_, err = h.reconciliationService.Resolve(r.Context(), chi.URLParam(r, "discrepancyID"), currentUser(r), form.Resolution)
+	if err == nil {
+		http.Redirect(w, r, pageURL(query.Status, query.Kind), http.StatusSeeOther)
+		return
+	}
+
+	status := http.StatusInternalServerError
+	var business platformErrors.BusinessLogicError
+	switch {
+	case platformErrors.IsNotFoundError(err):
+		status = http.StatusNotFound
+	case errors.As(err, &business):
+		status = http.StatusUnprocessableEntity
+	default:
+		h.log.Error("resolve billing discrepancy", zap.Error(err))
+	}
+	h.renderPage(w, r, query, err.Error(), status)
+}
+
+func (h *DiscrepanciesHandler) renderPage(w http.ResponseWriter, r *http.Request, query request.DiscrepancyPageRequest, message string, status int) {
+	filter := model.DiscrepancyFilter{
+		Status: model.DiscrepancyStatus(query.Status),
+		Kind:   model.DiscrepancyKind(query.Kind),
+		Limit:  pageDiscrepancies,
+	}
+	if query.Status == statusAll {
+		filter.Status = ""
+	}
+	report, err := h.reconciliationService.Report(r.Context(), filter)
+	if err != nil {
+		h.log.Error("billing discrepancy report", zap.Error(err))
+		helper.WriteUIInternalError(w, "Failed to load billing discrepancies")
+		return
+	}
+
+	w.WriteHeader(status)
+	page := DiscrepanciesPageComponent(DiscrepanciesPageParam{
+		Report: report,
+		Status: query.Status,
+		Kind:   query.Kind,
+		Error:  message,
+	})
+	if err := page.Render(r.Context(), w); err != nil {
+		h.log.Error("failed to render billing discrepancies", zap.Error(err))
+	}
+}
+
+// pageQuery reads the page filters; invalid filters show the open discrepancies
+func pageQuery(r *http.Request) request.DiscrepancyPageRequest {
+	query, _, err := bind.Query[request.DiscrepancyPageRequest](r)
+	if err != nil {
+		query = request.DiscrepancyPageRequest{}
+	}
+	if query.Status == "" {
+		query.Status = string(model.DiscrepancyOpen)
+	}
+	return query
+}
+
+// currentUser identifies the authenticated user resolving a discrepancy
+func currentUser(r *http.Request) string {
+	user, err := auth.GetCurrentUser(r.Context())
+	if err != nil {
+		return ""
+	}
+	if user.Email != "" {
+		return user.Email
+	}
+	return user.ID
+}
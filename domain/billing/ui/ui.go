@@ -0,0 +1,30 @@
+package billing
+
+import (
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	billingsecurity "pharmacy-modernization-project-model/domain/billing/security"
+	billingservice "pharmacy-modernization-project-model/domain/billing/service"
+	"pharmacy-modernization-project-model/domain/billing/ui/discrepancies"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/paths"
+)
+
+type BillingDependencies struct {
+	ReconciliationSvc billingservice.ReconciliationService
+	Log               *zap.Logger
+}
+
+func MountUI(r chi.Router, deps *BillingDependencies) {
+	discrepanciesHandler := discrepancies.NewDiscrepanciesHandler(deps.ReconciliationSvc, deps.Log)
+
+	// Discrepancies found by billing reconciliation, resolved by billing staff
+	r.Route(paths.AdminBillingDiscrepanciesPath, func(r chi.Router) {
+		r.Use(auth.RequireAuthWithDevMode())
+		r.Use(auth.RequirePermissionsMatchAny(billingsecurity.WriteAccess))
+
+		r.Get("/", discrepanciesHandler.Handler)
+		r.Post("/{discrepancyID}/resolve", discrepanciesHandler.Resolve)
+	})
+}
@@ -0,0 +1,103 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/billing/providers"
+	billingservice "pharmacy-modernization-project-model/domain/billing/service"
+	prescriptionmodel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/scheduler"
+)
+
+// ReconciliationJobConfig controls how many completed prescriptions a reconciliation run checks
+type ReconciliationJobConfig struct {
+	// BatchSize limits how many prescriptions are loaded at a time
+	BatchSize int
+	// MaxPrescriptions caps the newest completed prescriptions checked per run, as every one
+	// costs an IRIS billing call
+	MaxPrescriptions int
+}
+
+func (c *ReconciliationJobConfig) setDefaults() {
+	if c.BatchSize <= 0 {
+		c.BatchSize = 200
+	}
+	if c.MaxPrescriptions <= 0 {
+		c.MaxPrescriptions = 5000
+	}
+}
+
+// ReconciliationJob compares completed prescriptions, newest first, with their IRIS invoices and
+// records the discrepancies found. It runs on the scheduler.
+type ReconciliationJob struct {
+	prescriptions providers.PrescriptionProvider
+	svc           billingservice.ReconciliationService
+	log           *zap.Logger
+	cfg           ReconciliationJobConfig
+}
+
+// NewReconciliationJob creates the job; zero config values fall back to defaults
+func NewReconciliationJob(prescriptions providers.PrescriptionProvider, svc billingservice.ReconciliationService, log *zap.Logger, cfg ReconciliationJobConfig) *ReconciliationJob {
+	cfg.setDefaults()
+	if log == nil {
+		log = zap.NewNop()
+	}
+	return &ReconciliationJob{prescriptions: prescriptions, svc: svc, log: log, cfg: cfg}
+}
+
+// Run checks the completed prescriptions a batch at a time. The checkpoint is the number of
+// prescriptions checked, so a retry of a failed run continues after them; prescriptions
+// completed in between shift the list, which the next run catches up on.
+func (j *ReconciliationJob) Run(ctx context.Context) error {
+	offset := 0
+	if resume, err := strconv.Atoi(scheduler.ResumeFrom(ctx)); err == nil && resume > 0 {
+		offset = resume
+	}
+
+	checked, open := 0, 0
+	for offset < j.cfg.MaxPrescriptions {
+		size := min(j.cfg.BatchSize, j.cfg.MaxPrescriptions-offset)
+		prescriptions, err := j.prescriptions.List(ctx, string(prescriptionmodel.Completed), size, offset)
+		if err != nil {
+			return err
+		}
+
+		for _, prescription := range prescriptions {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			discrepancy, err := j.svc.Reconcile(ctx, prescription)
+			var external platformErrors.ExternalServiceError
+			if errors.As(err, &external) {
+				// IRIS billing is down; every other prescription would fail the same way
+				return err
+			}
+			if err != nil {
+				scheduler.ItemFailed(ctx, fmt.Errorf("prescription %s: %w", prescription.ID, err))
+				continue
+			}
+			if discrepancy != nil {
+				open++
+			}
+			checked++
+			scheduler.Processed(ctx, 1)
+		}
+		offset += len(prescriptions)
+		scheduler.SetCheckpoint(ctx, strconv.Itoa(offset))
+
+		if len(prescriptions) < size {
+			break
+		}
+	}
+
+	j.log.Info("Billing reconciliation finished",
+		zap.Int("checked", checked),
+		zap.Int("open_discrepancies", open))
+	return nil
+}
@@ -0,0 +1,9 @@
+package model
+
+// PatientAllergy is a recorded allergy as checked when a drug is prescribed
+type PatientAllergy struct {
+	ID        string
+	Substance string
+	Reaction  string
+	Severity  string
+}
@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// PatientInvoiceSummary is a patient's invoices as shown on the patient page
+type PatientInvoiceSummary struct {
+	Invoices  []PatientInvoice
+	FetchedAt time.Time
+	// Stale is set when billing could not be reached and older cached invoices are shown
+	Stale bool
+}
+
+type PatientInvoice struct {
+	ID             string
+	PrescriptionID string
+	Amount         float64
+	Status         string
+	CreatedAt      string
+}
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// PrescriptionPriceEstimate is a prescription's expected cost as shown on its pages
+type PrescriptionPriceEstimate struct {
+	Quantity       int
+	UnitPrice      float64
+	IngredientCost float64
+	DispensingFee  float64
+	Total          float64
+	Currency       string
+	// Default is set when billing could not be reached and the configured pricing table was used
+	Default     bool
+	EstimatedAt time.Time
+}
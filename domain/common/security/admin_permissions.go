@@ -0,0 +1,7 @@
+package security
+
+// Common permission sets for reuse in routes
+var (
+	// AdminAccess - only admins see the operational pages, such as the integrations status
+	AdminAccess = []string{PermissionAdminAll}
+)
@@ -0,0 +1,19 @@
+package security
+
+import "pharmacy-modernization-project-model/internal/platform/permissions"
+
+// Billing domain permissions
+const (
+	// Resource-based permissions
+	BillingPermissionRead  = permissions.BillingRead
+	BillingPermissionWrite = permissions.BillingWrite
+)
+
+// Common permission sets for reuse in routes
+var (
+	// BillingReadAccess - user needs ANY of these permissions to view invoices and payments
+	BillingReadAccess = []string{BillingPermissionRead, permissions.AdminAll}
+
+	// BillingWriteAccess - user needs ANY of these permissions to create invoices and resolve discrepancies
+	BillingWriteAccess = []string{BillingPermissionWrite, permissions.AdminAll}
+)
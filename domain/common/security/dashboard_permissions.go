@@ -1,10 +1,12 @@
 package security
 
+import "pharmacy-modernization-project-model/internal/platform/permissions"
+
 // Dashboard domain permissions
 const (
 	// Resource-based permissions
-	DashboardPermissionView = "dashboard:view"
-	PermissionAdminAll      = "admin:all"
+	DashboardPermissionView = permissions.DashboardView
+	PermissionAdminAll      = permissions.AdminAll
 )
 
 // Common permission sets for reuse in routes
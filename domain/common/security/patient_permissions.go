@@ -1,13 +1,15 @@
 package security
 
+import "pharmacy-modernization-project-model/internal/platform/permissions"
+
 // Patient domain permissions
 const (
 	// Resource-based permissions
-	PatientPermissionRead = "patient:read"
+	PatientPermissionRead = permissions.PatientRead
 )
 
 // Common permission sets for reuse in routes
 var (
 	// ReadAccess - user needs ANY of these permissions to read patient data
-	PatientReadAccess = []string{PatientPermissionRead, "admin:all"}
+	PatientReadAccess = []string{PatientPermissionRead, permissions.AdminAll}
 )
@@ -1,13 +1,23 @@
 package security
 
+import "pharmacy-modernization-project-model/internal/platform/permissions"
+
 // Prescription domain permissions
 const (
 	// Resource-based permissions
-	PrescriptionPermissionRead = "prescription:read"
+	PrescriptionPermissionRead     = permissions.PrescriptionRead
+	PrescriptionPermissionWrite    = permissions.PrescriptionWrite
+	PrescriptionPermissionDispense = permissions.PrescriptionDispense
 )
 
 // Common permission sets for reuse in routes
 var (
 	// ReadAccess - user needs ANY of these permissions to read patient data
-	PrescriptionReadAccess = []string{PrescriptionPermissionRead, "admin:all"}
+	PrescriptionReadAccess = []string{PrescriptionPermissionRead, permissions.AdminAll}
+
+	// PrescriptionWriteAccess - user needs ANY of these permissions to create/edit prescriptions
+	PrescriptionWriteAccess = []string{PrescriptionPermissionWrite, permissions.DoctorRole, permissions.AdminAll}
+
+	// PrescriptionDispenseAccess - user needs ANY of these permissions to dispense prescriptions
+	PrescriptionDispenseAccess = []string{PrescriptionPermissionDispense, permissions.PharmacistRole, permissions.AdminAll}
 )
@@ -0,0 +1,26 @@
+package api
+
+import (
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	controllers "pharmacy-modernization-project-model/domain/communications/api/controllers"
+	"pharmacy-modernization-project-model/domain/communications/service"
+	platformpaths "pharmacy-modernization-project-model/internal/platform/paths"
+)
+
+// APIPath is the base path of the communications API
+const APIPath = platformpaths.APIV1Path + "/communications"
+
+type Dependencies struct {
+	CommunicationService service.CommunicationService
+	Logger               *zap.Logger
+}
+
+func MountAPI(r chi.Router, deps *Dependencies) {
+	messageController := controllers.NewMessageController(deps.CommunicationService, deps.Logger)
+
+	r.Route(APIPath, func(router chi.Router) {
+		messageController.RegisterRoutes(router)
+	})
+}
@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/communications/contracts/request"
+	commsecurity "pharmacy-modernization-project-model/domain/communications/security"
+	"pharmacy-modernization-project-model/domain/communications/service"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+)
+
+type MessageController struct {
+	communicationService service.CommunicationService
+	log                  *zap.Logger
+}
+
+func NewMessageController(communications service.CommunicationService, log *zap.Logger) *MessageController {
+	return &MessageController{communicationService: communications, log: log}
+}
+
+func (c *MessageController) RegisterRoutes(r chi.Router) {
+	// All communications routes require authentication (header-based for API)
+	r.Use(auth.RequireAuthFromHeader())
+
+	// Read the communications log - requires patient:read or admin:all
+	r.With(auth.RequirePermissionsMatchAny(commsecurity.ReadAccess)).Get("/messages", c.List)
+}
+
+// List returns the messages sent to a patient, newest first
+func (c *MessageController) List(w http.ResponseWriter, r *http.Request) {
+	query, fieldErrors, err := bind.Query[request.MessageListQueryRequest](r)
+	if err != nil {
+		c.log.Error("failed to bind query parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	if query.Limit == 0 {
+		query.Limit = 50
+	}
+
+	messages, err := c.communicationService.ListForPatient(r.Context(), query.PatientID, query.Limit)
+	if err != nil {
+		c.log.Error("list patient messages", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, messages)
+}
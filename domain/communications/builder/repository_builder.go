@@ -0,0 +1,29 @@
+package builder
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	messagerepo "pharmacy-modernization-project-model/domain/communications/repository"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// CreateMessageRepository creates the appropriate communications log repository based on
+// dependencies; with MongoDB it creates the lookup index if missing
+func CreateMessageRepository(logger *zap.Logger, mongoCollection *mongo.Collection, retrier *database.Retrier) messagerepo.MessageRepository {
+	if mongoCollection != nil {
+		repo := messagerepo.NewMessageMongoRepository(mongoCollection, logger)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := repo.CreateIndexes(ctx); err != nil {
+			logger.Warn("Failed to create communications indexes", zap.Error(err))
+		}
+		return messagerepo.NewMessageRetryRepository(repo, retrier)
+	}
+
+	return messagerepo.NewMessageMemoryRepository()
+}
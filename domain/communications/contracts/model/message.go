@@ -0,0 +1,48 @@
+package model
+
+import "time"
+
+// Event is what a patient is notified of
+type Event string
+
+const (
+	// PrescriptionActive is sent when a prescription is created active or activated
+	PrescriptionActive Event = "prescription_active"
+	// InvoiceCreated is sent when a prescription is invoiced
+	InvoiceCreated Event = "invoice_created"
+)
+
+// Status is the outcome of a message
+type Status string
+
+const (
+	// Sent messages were accepted by the SMS provider or the mail server
+	Sent Status = "sent"
+	// Failed messages could not be sent; they are sent again while the notification has attempts left
+	Failed Status = "failed"
+	// Skipped messages were not sent because the patient's contact preferences don't allow it
+	Skipped Status = "skipped"
+)
+
+// Message is a notification to a patient, as kept in the communications log. Messages hold no
+// phone numbers or email addresses; those stay on the patient.
+type Message struct {
+	ID        string `json:"id" bson:"_id"`
+	PatientID string `json:"patient_id" bson:"patient_id"`
+	Event     Event  `json:"event" bson:"event"`
+	// ReferenceID is the prescription or invoice the message is about
+	ReferenceID string `json:"reference_id" bson:"reference_id"`
+	// Channel is empty on skipped messages
+	Channel string `json:"channel,omitempty" bson:"channel,omitempty"`
+	Subject string `json:"subject,omitempty" bson:"subject,omitempty"`
+	Body    string `json:"body,omitempty" bson:"body,omitempty"`
+	Status  Status `json:"status" bson:"status"`
+	// Reason explains why a message was skipped or failed
+	Reason            string `json:"reason,omitempty" bson:"reason,omitempty"`
+	Attempts          int    `json:"attempts" bson:"attempts"`
+	Provider          string `json:"provider,omitempty" bson:"provider,omitempty"`
+	ProviderMessageID string `json:"provider_message_id,omitempty" bson:"provider_message_id,omitempty"`
+
+	CreatedAt time.Time  `json:"created_at" bson:"created_at"`
+	SentAt    *time.Time `json:"sent_at,omitempty" bson:"sent_at,omitempty"`
+}
@@ -0,0 +1,6 @@
+package request
+
+type MessageListQueryRequest struct {
+	PatientID string `form:"patientId" validate:"required,min=1"`
+	Limit     int    `form:"limit" validate:"omitempty,min=1,max=200"`
+}
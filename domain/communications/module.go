@@ -0,0 +1,42 @@
+package communications
+
+import (
+	"github.com/go-chi/chi/v5"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	commapi "pharmacy-modernization-project-model/domain/communications/api"
+	commbuilder "pharmacy-modernization-project-model/domain/communications/builder"
+	commproviders "pharmacy-modernization-project-model/domain/communications/providers"
+	commservice "pharmacy-modernization-project-model/domain/communications/service"
+	"pharmacy-modernization-project-model/internal/integrations/notifications"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+type ModuleDependencies struct {
+	Logger                        *zap.Logger
+	NotificationSender            notifications.NotificationSender
+	Patients                      commproviders.PatientProvider
+	Prescriptions                 commproviders.PrescriptionProvider
+	CommunicationsMongoCollection *mongo.Collection
+	Retrier                       *database.Retrier // Retries the calls of the MongoDB repository; nil runs them once
+	Config                        commservice.Config
+}
+
+type ModuleExport struct {
+	CommunicationService commservice.CommunicationService
+}
+
+// Module notifies patients of their prescriptions and invoices and serves the log of the messages
+func Module(r chi.Router, deps *ModuleDependencies) ModuleExport {
+	messageRepo := commbuilder.CreateMessageRepository(deps.Logger, deps.CommunicationsMongoCollection, deps.Retrier)
+
+	svc := commservice.NewCommunicationService(messageRepo, deps.NotificationSender, deps.Patients, deps.Prescriptions, deps.Config, deps.Logger)
+
+	commapi.MountAPI(r, &commapi.Dependencies{
+		CommunicationService: svc,
+		Logger:               deps.Logger,
+	})
+
+	return ModuleExport{CommunicationService: svc}
+}
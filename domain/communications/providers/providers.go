@@ -0,0 +1,16 @@
+package providers
+
+import (
+	"context"
+
+	patientmodel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	prescriptionmodel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+)
+
+type PatientProvider interface {
+	GetByID(ctx context.Context, id string) (patientmodel.Patient, error)
+}
+
+type PrescriptionProvider interface {
+	GetByID(ctx context.Context, id string) (prescriptionmodel.Prescription, error)
+}
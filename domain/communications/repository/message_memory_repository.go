@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"pharmacy-modernization-project-model/domain/communications/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+type messageMemoryRepository struct {
+	mu       sync.RWMutex
+	messages map[string]model.Message
+}
+
+func NewMessageMemoryRepository() MessageRepository {
+	return &messageMemoryRepository{messages: map[string]model.Message{}}
+}
+
+func (r *messageMemoryRepository) GetByID(_ context.Context, id string) (model.Message, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	message, ok := r.messages[id]
+	if !ok {
+		return model.Message{}, platformErrors.NewRecordNotFoundError("message", id)
+	}
+	return message, nil
+}
+
+func (r *messageMemoryRepository) ListByPatientID(_ context.Context, patientID string, limit int) ([]model.Message, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.Message{}
+	for _, message := range r.messages {
+		if message.PatientID == patientID {
+			out = append(out, message)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.After(out[j].CreatedAt) })
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (r *messageMemoryRepository) Save(_ context.Context, message model.Message) (model.Message, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.messages[message.ID] = message
+	return message, nil
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/communications/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/validators/validation_logic"
+)
+
+// MessageMongoRepository implements MessageRepository interface using MongoDB
+type MessageMongoRepository struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewMessageMongoRepository creates a new MongoDB communications log repository
+func NewMessageMongoRepository(collection *mongo.Collection, logger *zap.Logger) *MessageMongoRepository {
+	return &MessageMongoRepository{
+		collection: collection,
+		logger:     logger,
+	}
+}
+
+// handleError processes MongoDB errors and converts them to appropriate repository errors
+func (r *MessageMongoRepository) handleError(operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	r.logger.Error("MongoDB operation failed",
+		zap.String("operation", operation),
+		zap.Error(err))
+
+	return platformErrors.HandleMongoError(operation, err)
+}
+
+// GetByID retrieves a message by its ID
+func (r *MessageMongoRepository) GetByID(ctx context.Context, id string) (model.Message, error) {
+	// Validate input to prevent NoSQL injection
+	if err := validation_logic.ValidateID("message_id", id); err != nil {
+		return model.Message{}, platformErrors.NewValidationError("message_id", id, "Invalid message ID format")
+	}
+
+	var message model.Message
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&message)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.Message{}, platformErrors.NewRecordNotFoundError("message", id)
+		}
+		return model.Message{}, r.handleError("GetByID", err)
+	}
+	return message, nil
+}
+
+// ListByPatientID retrieves the messages of a patient, newest first
+func (r *MessageMongoRepository) ListByPatientID(ctx context.Context, patientID string, limit int) ([]model.Message, error) {
+	if err := validation_logic.ValidateID("patient_id", patientID); err != nil {
+		return nil, platformErrors.NewValidationError("patient_id", patientID, "Invalid patient ID format")
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	cursor, err := r.collection.Find(ctx, bson.M{"patient_id": patientID}, opts)
+	if err != nil {
+		return nil, r.handleError("ListByPatientID", err)
+	}
+	defer cursor.Close(ctx)
+
+	messages := []model.Message{}
+	if err := cursor.All(ctx, &messages); err != nil {
+		return nil, r.handleError("ListByPatientID", err)
+	}
+	return messages, nil
+}
+
+// Save upserts a message by its ID
+func (r *MessageMongoRepository) Save(ctx context.Context, message model.Message) (model.Message, error) {
+	opts := options.Replace().SetUpsert(true)
+	if _, err := r.collection.ReplaceOne(ctx, bson.M{"_id": message.ID}, message, opts); err != nil {
+		return model.Message{}, r.handleError("Save", err)
+	}
+	return message, nil
+}
+
+// CreateIndexes creates the index used by ListByPatientID
+func (r *MessageMongoRepository) CreateIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "patient_id", Value: 1}, {Key: "created_at", Value: -1}},
+		Options: options.Index().SetName("patient_id_1_created_at_-1"),
+	})
+	if err != nil {
+		return r.handleError("CreateIndexes", err)
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"pharmacy-modernization-project-model/domain/communications/contracts/model"
+)
+
+type MessageRepository interface {
+	// GetByID returns a RecordNotFoundError when the message does not exist
+	GetByID(ctx context.Context, id string) (model.Message, error)
+	// ListByPatientID returns up to limit messages of a patient, newest first; 0 returns them all
+	ListByPatientID(ctx context.Context, patientID string, limit int) ([]model.Message, error)
+	// Save creates the message or replaces the one with its ID
+	Save(ctx context.Context, message model.Message) (model.Message, error)
+}
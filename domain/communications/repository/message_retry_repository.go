@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+
+	"pharmacy-modernization-project-model/domain/communications/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// MessageRetryRepository retries the calls of a message repository that fail with a retryable
+// error; all of them are idempotent
+type MessageRetryRepository struct {
+	next    MessageRepository
+	retrier *database.Retrier
+}
+
+// NewMessageRetryRepository wraps next with retries; without a retrier next is returned as is
+func NewMessageRetryRepository(next MessageRepository, retrier *database.Retrier) MessageRepository {
+	if retrier == nil {
+		return next
+	}
+	return &MessageRetryRepository{next: next, retrier: retrier}
+}
+
+func (r *MessageRetryRepository) GetByID(ctx context.Context, id string) (model.Message, error) {
+	return database.Retry(ctx, r.retrier, "communications.GetByID", func(ctx context.Context) (model.Message, error) {
+		return r.next.GetByID(ctx, id)
+	})
+}
+
+func (r *MessageRetryRepository) ListByPatientID(ctx context.Context, patientID string, limit int) ([]model.Message, error) {
+	return database.Retry(ctx, r.retrier, "communications.ListByPatientID", func(ctx context.Context) ([]model.Message, error) {
+		return r.next.ListByPatientID(ctx, patientID, limit)
+	})
+}
+
+// Save is retried: replacing a message by its ID again leaves the same document
+func (r *MessageRetryRepository) Save(ctx context.Context, message model.Message) (model.Message, error) {
+	return database.Retry(ctx, r.retrier, "communications.Save", func(ctx context.Context) (model.Message, error) {
+		return r.next.Save(ctx, message)
+	})
+}
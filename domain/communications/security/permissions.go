@@ -0,0 +1,9 @@
+package security
+
+import commonsecurity "pharmacy-modernization-project-model/domain/common/security"
+
+// Common permission sets for reuse in routes
+var (
+	// ReadAccess - the communications log is part of the patient record, so reading patients suffices
+	ReadAccess = commonsecurity.PatientReadAccess
+)
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	billingmodel "pharmacy-modernization-project-model/domain/billing/contracts/model"
+	"pharmacy-modernization-project-model/domain/communications/contracts/model"
+	"pharmacy-modernization-project-model/domain/communications/providers"
+	messagerepo "pharmacy-modernization-project-model/domain/communications/repository"
+	patientmodel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/internal/integrations/notifications"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// Config controls the text of the messages sent to patients
+type Config struct {
+	// PharmacyName signs the messages
+	PharmacyName string
+}
+
+func (c *Config) setDefaults() {
+	if c.PharmacyName == "" {
+		c.PharmacyName = "your pharmacy"
+	}
+}
+
+type CommunicationService interface {
+	// NotifyPrescriptionActive tells the patient of a prescription that it is active. Like every
+	// notification it is sent on the first channel the patient's contact preferences allow, and
+	// logged under messageID: a notification already sent or skipped under it is returned as is,
+	// and one that failed is sent again. The error is only set when sending may succeed later.
+	NotifyPrescriptionActive(ctx context.Context, messageID, prescriptionID string) (model.Message, error)
+	// NotifyInvoiceCreated tells the patient of an invoice that it was created
+	NotifyInvoiceCreated(ctx context.Context, messageID string, invoice billingmodel.Invoice) (model.Message, error)
+	// ListForPatient returns up to limit messages of a patient, newest first
+	ListForPatient(ctx context.Context, patientID string, limit int) ([]model.Message, error)
+}
+
+type communicationSvc struct {
+	repo          messagerepo.MessageRepository
+	sender        notifications.NotificationSender
+	patients      providers.PatientProvider
+	prescriptions providers.PrescriptionProvider
+	cfg           Config
+	log           *zap.Logger
+	now           func() time.Time
+}
+
+func NewCommunicationService(
+	repo messagerepo.MessageRepository,
+	sender notifications.NotificationSender,
+	patients providers.PatientProvider,
+	prescriptions providers.PrescriptionProvider,
+	cfg Config,
+	l *zap.Logger,
+) CommunicationService {
+	cfg.setDefaults()
+	return &communicationSvc{
+		repo:          repo,
+		sender:        sender,
+		patients:      patients,
+		prescriptions: prescriptions,
+		cfg:           cfg,
+		log:           l,
+		now:           func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Message texts name no drug: texts and emails are often read on a locked screen
+func (s *communicationSvc) NotifyPrescriptionActive(ctx context.Context, messageID, prescriptionID string) (model.Message, error) {
+	prescription, err := s.prescriptions.GetByID(ctx, prescriptionID)
+	if err != nil {
+		return model.Message{}, err
+	}
+	return s.notify(ctx, model.Message{
+		ID:          messageID,
+		PatientID:   prescription.PatientID,
+		Event:       model.PrescriptionActive,
+		ReferenceID: prescription.ID,
+		Subject:     "Your prescription is active",
+		Body: fmt.Sprintf("Your prescription %s is active and being prepared by %s. Contact us with any questions.",
+			prescription.ID, s.cfg.PharmacyName),
+	})
+}
+
+func (s *communicationSvc) NotifyInvoiceCreated(ctx context.Context, messageID string, invoice billingmodel.Invoice) (model.Message, error) {
+	// IRIS billing does not always return the patient of an invoice
+	patientID := invoice.PatientID
+	if patientID == "" {
+		prescription, err := s.prescriptions.GetByID(ctx, invoice.PrescriptionID)
+		if err != nil {
+			return model.Message{}, err
+		}
+		patientID = prescription.PatientID
+	}
+	return s.notify(ctx, model.Message{
+		ID:          messageID,
+		PatientID:   patientID,
+		Event:       model.InvoiceCreated,
+		ReferenceID: invoice.ID,
+		Subject:     "Your invoice is ready",
+		Body: fmt.Sprintf("Invoice %s of $%.2f for your prescription %s is ready. Thank you, %s.",
+			invoice.ID, invoice.Amount, invoice.PrescriptionID, s.cfg.PharmacyName),
+	})
+}
+
+func (s *communicationSvc) ListForPatient(ctx context.Context, patientID string, limit int) ([]model.Message, error) {
+	// Only patients the caller can see have their messages listed
+	if _, err := s.patients.GetByID(ctx, patientID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByPatientID(ctx, patientID, limit)
+}
+
+// notify sends message to its patient and logs the outcome
+func (s *communicationSvc) notify(ctx context.Context, message model.Message) (model.Message, error) {
+	existing, err := s.repo.GetByID(ctx, message.ID)
+	switch {
+	case err == nil && existing.Status != model.Failed:
+		return existing, nil
+	case err == nil:
+		message.Attempts = existing.Attempts
+		message.CreatedAt = existing.CreatedAt
+	case platformErrors.IsNotFoundError(err):
+		message.CreatedAt = s.now()
+	default:
+		return model.Message{}, err
+	}
+
+	patient, err := s.patients.GetByID(ctx, message.PatientID)
+	if err != nil {
+		return model.Message{}, err
+	}
+
+	channels := contactChannels(patient)
+	if len(channels) == 0 {
+		message.Status = model.Skipped
+		message.Reason = skipReason(patient.ContactPreferences)
+		message.Subject, message.Body = "", ""
+		return s.save(ctx, message, nil)
+	}
+
+	message.Attempts++
+	for _, channel := range channels {
+		result, err := s.sender.Send(ctx, notifications.Notification{
+			Channel: string(channel.channel),
+			To:      channel.to,
+			Subject: message.Subject,
+			Body:    message.Body,
+		})
+		message.Channel = string(channel.channel)
+		if errors.Is(err, notifications.ErrUnsupportedChannel) {
+			// Try the patient's next channel
+			continue
+		}
+		if err != nil {
+			message.Status = model.Failed
+			message.Reason = err.Error()
+			return s.save(ctx, message, err)
+		}
+
+		sentAt := s.now()
+		message.Status = model.Sent
+		message.Reason = ""
+		message.Provider = result.Provider
+		message.ProviderMessageID = result.MessageID
+		message.SentAt = &sentAt
+		return s.save(ctx, message, nil)
+	}
+
+	// Sending again won't help until a provider is configured for one of the channels
+	message.Status = model.Failed
+	message.Reason = "no provider is configured for the patient's contact channels"
+	return s.save(ctx, message, nil)
+}
+
+// save logs message; sendErr is returned after it, so a failed send is retried
+func (s *communicationSvc) save(ctx context.Context, message model.Message, sendErr error) (model.Message, error) {
+	saved, err := s.repo.Save(ctx, message)
+	if err != nil {
+		return model.Message{}, err
+	}
+	s.log.Info("Patient notification processed",
+		zap.String("message_id", saved.ID),
+		zap.String("event", string(saved.Event)),
+		zap.String("channel", saved.Channel),
+		zap.String("status", string(saved.Status)),
+		zap.Int("attempts", saved.Attempts))
+	return saved, sendErr
+}
+
+type contactChannel struct {
+	channel patientmodel.ContactChannel
+	to      string
+}
+
+// contactChannels lists the channels a notification may be sent on, in the patient's order of
+// preference, with the patient's number or address on each. Phone calls are made by staff, so
+// they are left out.
+func contactChannels(patient patientmodel.Patient) []contactChannel {
+	prefs := patient.ContactPreferences
+	var out []contactChannel
+	for _, channel := range prefs.AllowedChannels() {
+		switch {
+		case channel == patientmodel.ContactSMS && patient.Phone != "":
+			out = append(out, contactChannel{channel: channel, to: patient.Phone})
+		case channel == patientmodel.ContactEmail && prefs.Email != "":
+			out = append(out, contactChannel{channel: channel, to: prefs.Email})
+		}
+	}
+	return out
+}
+
+func skipReason(prefs *patientmodel.ContactPreferences) string {
+	switch {
+	case prefs == nil:
+		return "the patient has no contact preferences"
+	case prefs.DoNotContact:
+		return "the patient asked not to be contacted"
+	default:
+		return "the patient has no text or email channel with a phone number or address"
+	}
+}
@@ -1,6 +1,43 @@
 package model
 
+import (
+	"time"
+
+	patientmodel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	prescriptionmodel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+)
+
 type DashboardSummary struct {
 	TotalPatients       int
 	ActivePrescriptions int
+	// PatientsByState only lists the states in the user's data-access scope
+	PatientsByState       []patientmodel.StateCount
+	PrescriptionsByStatus []prescriptionmodel.StatusCount
+}
+
+// DispenseQueueItem is an active prescription waiting to be dispensed in the pharmacy
+type DispenseQueueItem struct {
+	PrescriptionID string
+	PatientID      string
+	Drug           string
+	Dose           string
+	CreatedAt      time.Time
+}
+
+// RecentPrescription is a prescription in the dashboard's recent activity
+type RecentPrescription struct {
+	PrescriptionID string
+	PatientID      string
+	Drug           string
+	Dose           string
+	Status         prescriptionmodel.Status
+	CreatedAt      time.Time
+}
+
+// PrescriberPatient is a patient the current user has written prescriptions for
+type PrescriberPatient struct {
+	PatientID        string
+	Name             string
+	Prescriptions    int
+	LastPrescribedAt time.Time
 }
@@ -39,7 +39,9 @@ func (r *DashboardResolver) DashboardStats(ctx context.Context) (*generated.Dash
 	}
 
 	return &generated.DashboardStats{
-		TotalPatients:       summary.TotalPatients,
-		ActivePrescriptions: summary.ActivePrescriptions,
+		TotalPatients:         summary.TotalPatients,
+		ActivePrescriptions:   summary.ActivePrescriptions,
+		PatientsByState:       summary.PatientsByState,
+		PrescriptionsByStatus: summary.PrescriptionsByStatus,
 	}, nil
 }
@@ -2,15 +2,22 @@ package dashboard
 
 import (
 	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
 
 	dashboardproviders "pharmacy-modernization-project-model/domain/dashboard/providers"
 	dashboardservice "pharmacy-modernization-project-model/domain/dashboard/service"
 	dashboardsvc "pharmacy-modernization-project-model/domain/dashboard/ui"
+	dashboardpage "pharmacy-modernization-project-model/domain/dashboard/ui/dashboard_page"
+	"pharmacy-modernization-project-model/internal/platform/navigation"
 )
 
 type ModuleDependencies struct {
+	Logger            *zap.Logger
 	PatientStats      dashboardproviders.PatientStatsProvider
 	PrescriptionStats dashboardproviders.PrescriptionStatsProvider
+	InvoiceAging      dashboardproviders.InvoiceAgingProvider
+	Navigation        *navigation.BackStack
+	LiveUpdates       dashboardpage.LiveUpdates
 }
 
 type ModuleExport struct {
@@ -18,8 +25,13 @@ type ModuleExport struct {
 }
 
 func Module(r chi.Router, deps *ModuleDependencies) ModuleExport {
-	service := dashboardservice.New(deps.PatientStats, deps.PrescriptionStats)
-	dashboardsvc.MountUI(r, &dashboardsvc.DashboardUiDependencies{Service: service})
+	service := dashboardservice.New(deps.PatientStats, deps.PrescriptionStats, deps.InvoiceAging)
+	dashboardsvc.MountUI(r, &dashboardsvc.DashboardUiDependencies{
+		Service:     service,
+		Navigation:  deps.Navigation,
+		LiveUpdates: deps.LiveUpdates,
+		Log:         deps.Logger,
+	})
 	return ModuleExport{
 		DashboardService: service,
 	}
@@ -2,14 +2,27 @@ package providers
 
 import (
 	"context"
+	"time"
 
+	billingmodel "pharmacy-modernization-project-model/domain/billing/contracts/model"
+	patientmodel "pharmacy-modernization-project-model/domain/patient/contracts/model"
 	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+	prescriptionmodel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
 )
 
 type PatientStatsProvider interface {
 	Count(ctx context.Context, req request.PatientListQueryRequest) (int, error)
+	CountByState(ctx context.Context, allowedStates []string) ([]patientmodel.StateCount, error)
+	GetByID(ctx context.Context, id string) (patientmodel.Patient, error)
 }
 
 type PrescriptionStatsProvider interface {
-	CountByStatus(ctx context.Context, status string) (int, error)
+	CountGroupedByStatus(ctx context.Context) ([]prescriptionmodel.StatusCount, error)
+	List(ctx context.Context, status string, limit, offset int) ([]prescriptionmodel.Prescription, error)
+	ListActiveCreatedBefore(ctx context.Context, before time.Time, limit int) ([]prescriptionmodel.Prescription, error)
+	ListByPrescriber(ctx context.Context, prescribedBy string, limit int) ([]prescriptionmodel.Prescription, error)
+}
+
+type InvoiceAgingProvider interface {
+	InvoiceAging(ctx context.Context) (billingmodel.InvoiceAging, error)
 }
@@ -1,16 +1,18 @@
 package security
 
+import "pharmacy-modernization-project-model/internal/platform/permissions"
+
 // Dashboard domain permissions
 const (
-	PermissionView      = "dashboard:view"
-	PermissionAnalytics = "dashboard:analytics"
-	PermissionReports   = "dashboard:reports"
+	PermissionView      = permissions.DashboardView
+	PermissionAnalytics = permissions.DashboardAnalytics
+	PermissionReports   = permissions.DashboardReports
 )
 
 // Common permission sets for reuse in routes
 var (
 	// ViewAccess - any authenticated user with dashboard permission can view
-	ViewAccess = []string{PermissionView, "admin:all"}
+	ViewAccess = []string{PermissionView, permissions.AdminAll}
 
 	// AnalyticsAccess - needs dashboard view and analytics permissions
 	AnalyticsAccess = []string{PermissionView, PermissionAnalytics}
@@ -2,23 +2,40 @@ package service
 
 import (
 	"context"
+	"time"
 
+	billingmodel "pharmacy-modernization-project-model/domain/billing/contracts/model"
 	model "pharmacy-modernization-project-model/domain/dashboard/contracts/model"
 	"pharmacy-modernization-project-model/domain/dashboard/providers"
 	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+	patientsecurity "pharmacy-modernization-project-model/domain/patient/security"
+	prescriptionmodel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
 )
 
+// prescriberHistoryLimit caps how many of the prescriber's prescriptions are grouped into patients
+const prescriberHistoryLimit = 200
+
 type IDashboardService interface {
 	Summary(ctx context.Context) (model.DashboardSummary, error)
+	// DispenseQueue returns active prescriptions not routed to a network pharmacy, oldest first
+	DispenseQueue(ctx context.Context, limit int) ([]model.DispenseQueueItem, error)
+	InvoiceAging(ctx context.Context) (billingmodel.InvoiceAging, error)
+	// RecentPrescriptions returns the latest prescriptions, newest first
+	RecentPrescriptions(ctx context.Context, limit int) ([]model.RecentPrescription, error)
+	// PrescriberPatients returns the patients the user prescribed for, most recent first
+	PrescriberPatients(ctx context.Context, prescribedBy string, limit int) ([]model.PrescriberPatient, error)
 }
 
 type dashboardService struct {
 	patients      providers.PatientStatsProvider
 	prescriptions providers.PrescriptionStatsProvider
+	invoices      providers.InvoiceAgingProvider
 }
 
-func New(patients providers.PatientStatsProvider, prescriptions providers.PrescriptionStatsProvider) IDashboardService {
-	return &dashboardService{patients: patients, prescriptions: prescriptions}
+func New(patients providers.PatientStatsProvider, prescriptions providers.PrescriptionStatsProvider, invoices providers.InvoiceAgingProvider) IDashboardService {
+	return &dashboardService{patients: patients, prescriptions: prescriptions, invoices: invoices}
 }
 
 func (s *dashboardService) Summary(ctx context.Context) (model.DashboardSummary, error) {
@@ -31,10 +48,113 @@ func (s *dashboardService) Summary(ctx context.Context) (model.DashboardSummary,
 		return model.DashboardSummary{}, err
 	}
 
-	active, err := s.prescriptions.CountByStatus(ctx, "Active")
+	user, _ := auth.GetCurrentUser(ctx)
+	byState, err := s.patients.CountByState(ctx, patientsecurity.AllowedStates(user))
+	if err != nil {
+		return model.DashboardSummary{}, err
+	}
+
+	byStatus, err := s.prescriptions.CountGroupedByStatus(ctx)
 	if err != nil {
 		return model.DashboardSummary{}, err
 	}
+	active := 0
+	for _, count := range byStatus {
+		if count.Status == prescriptionmodel.Active {
+			active = count.Count
+		}
+	}
+
+	return model.DashboardSummary{
+		TotalPatients:         total,
+		ActivePrescriptions:   active,
+		PatientsByState:       byState,
+		PrescriptionsByStatus: byStatus,
+	}, nil
+}
 
-	return model.DashboardSummary{TotalPatients: total, ActivePrescriptions: active}, nil
+func (s *dashboardService) DispenseQueue(ctx context.Context, limit int) ([]model.DispenseQueueItem, error) {
+	// Routed prescriptions are filled by the network pharmacy, so read more than needed
+	active, err := s.prescriptions.ListActiveCreatedBefore(ctx, time.Now(), limit*5)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := make([]model.DispenseQueueItem, 0, limit)
+	for _, prescription := range active {
+		if prescription.Pharmacy != nil {
+			continue
+		}
+		queue = append(queue, model.DispenseQueueItem{
+			PrescriptionID: prescription.ID,
+			PatientID:      prescription.PatientID,
+			Drug:           prescription.Drug,
+			Dose:           prescription.Dose,
+			CreatedAt:      prescription.CreatedAt,
+		})
+		if len(queue) == limit {
+			break
+		}
+	}
+	return queue, nil
+}
+
+func (s *dashboardService) InvoiceAging(ctx context.Context) (billingmodel.InvoiceAging, error) {
+	return s.invoices.InvoiceAging(ctx)
+}
+
+func (s *dashboardService) RecentPrescriptions(ctx context.Context, limit int) ([]model.RecentPrescription, error) {
+	prescriptions, err := s.prescriptions.List(ctx, "", limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	recent := make([]model.RecentPrescription, 0, len(prescriptions))
+	for _, prescription := range prescriptions {
+		recent = append(recent, model.RecentPrescription{
+			PrescriptionID: prescription.ID,
+			PatientID:      prescription.PatientID,
+			Drug:           prescription.Drug,
+			Dose:           prescription.Dose,
+			Status:         prescription.Status,
+			CreatedAt:      prescription.CreatedAt,
+		})
+	}
+	return recent, nil
+}
+
+func (s *dashboardService) PrescriberPatients(ctx context.Context, prescribedBy string, limit int) ([]model.PrescriberPatient, error) {
+	prescriptions, err := s.prescriptions.ListByPrescriber(ctx, prescribedBy, prescriberHistoryLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prescriptions come newest first, so patients are added in order of their latest prescription
+	patients := []model.PrescriberPatient{}
+	index := map[string]int{}
+	for _, prescription := range prescriptions {
+		if i, ok := index[prescription.PatientID]; ok {
+			patients[i].Prescriptions++
+			continue
+		}
+		index[prescription.PatientID] = len(patients)
+		patients = append(patients, model.PrescriberPatient{
+			PatientID:        prescription.PatientID,
+			Prescriptions:    1,
+			LastPrescribedAt: prescription.CreatedAt,
+		})
+	}
+	if len(patients) > limit {
+		patients = patients[:limit]
+	}
+
+	for i := range patients {
+		// A deleted patient is still listed, by ID
+		patient, err := s.patients.GetByID(ctx, patients[i].PatientID)
+		if err != nil && !platformErrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		patients[i].Name = patient.Name
+	}
+	return patients, nil
 }
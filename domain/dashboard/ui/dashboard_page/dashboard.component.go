@@ -2,16 +2,68 @@ package dashboard_page
 
 import (
 	"net/http"
+	"time"
 
+	"github.com/a-h/templ"
+	"go.uber.org/zap"
+
+	commonsecurity "pharmacy-modernization-project-model/domain/common/security"
 	dashboardservice "pharmacy-modernization-project-model/domain/dashboard/service"
+	"pharmacy-modernization-project-model/domain/dashboard/ui/paths"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/navigation"
+	"pharmacy-modernization-project-model/internal/platform/sse"
 )
 
+// landingPanelLimit is how many rows the list panels show
+const landingPanelLimit = 10
+
+// eventsRetry is how long the browser waits before reconnecting a closed event stream
+const eventsRetry = 2 * time.Second
+
+// landingPanel is a dashboard section shown to users with ANY of its permissions. Render shows an
+// error in the panel's place when its data cannot be loaded, so the rest of the dashboard still renders.
+type landingPanel struct {
+	Permissions []string
+	Render      func(h *DashboardPageHandler, r *http.Request) templ.Component
+}
+
+// landingPanels composes the dashboard by role: pharmacists see the dispense queue, billing staff
+// the invoice aging and prescribers their patients. Only the panels a user can see are loaded.
+var landingPanels = []landingPanel{
+	{Permissions: commonsecurity.PrescriptionDispenseAccess, Render: (*DashboardPageHandler).dispenseQueuePanel},
+	{Permissions: commonsecurity.BillingReadAccess, Render: (*DashboardPageHandler).invoiceAgingPanel},
+	{Permissions: commonsecurity.PrescriptionWriteAccess, Render: (*DashboardPageHandler).prescriberPatientsPanel},
+	{Permissions: commonsecurity.PrescriptionReadAccess, Render: (*DashboardPageHandler).recentPrescriptionsPanel},
+}
+
+// LiveUpdates configures the event stream that updates an open dashboard
+type LiveUpdates struct {
+	Enabled           bool
+	Interval          time.Duration // The stats and recent prescriptions are pushed this often
+	HeartbeatInterval time.Duration // An idle stream gets a comment this often
+	// Changes is published when prescriptions change, so the dashboard is pushed right away
+	Changes *sse.Broker
+}
+
 type DashboardPageHandler struct {
 	service dashboardservice.IDashboardService
+	nav     *navigation.BackStack
+	live    LiveUpdates
+	log     *zap.Logger
 }
 
-func NewDashboardPageHandler(service dashboardservice.IDashboardService) *DashboardPageHandler {
-	return &DashboardPageHandler{service: service}
+func NewDashboardPageHandler(service dashboardservice.IDashboardService, nav *navigation.BackStack, live LiveUpdates, log *zap.Logger) *DashboardPageHandler {
+	if live.Interval <= 0 {
+		live.Interval = 30 * time.Second
+	}
+	if live.HeartbeatInterval <= 0 {
+		live.HeartbeatInterval = 15 * time.Second
+	}
+	if live.Changes == nil {
+		live.Changes = sse.NewBroker()
+	}
+	return &DashboardPageHandler{service: service, nav: nav, live: live, log: log}
 }
 
 func (u *DashboardPageHandler) Handler(w http.ResponseWriter, r *http.Request) {
@@ -21,12 +73,135 @@ func (u *DashboardPageHandler) Handler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	panels := []templ.Component{}
+	for _, panel := range landingPanels {
+		if navigation.Allowed(r.Context(), panel.Permissions) {
+			panels = append(panels, panel.Render(u, r))
+		}
+	}
+
+	// Patients opened from the dashboard link back to it
+	u.nav.Root(w, r, "Dashboard")
+
 	page := DashboardPage(r.Context(), DashboardPageParam{
 		NumberOfPatients:    summary.TotalPatients,
 		ActivePrescriptions: summary.ActivePrescriptions,
+		Panels:              panels,
+		EventsURL:           u.eventsURL(),
 	})
 	if err := page.Render(r.Context(), w); err != nil {
 		http.Error(w, "failed to render dashboard", http.StatusInternalServerError)
 		return
 	}
 }
+
+func (u *DashboardPageHandler) eventsURL() string {
+	if !u.live.Enabled {
+		return ""
+	}
+	return paths.DashboardEventsPath
+}
+
+// Events streams the dashboard as Server-Sent Events: "stats" and "activity" events carry the
+// HTML of the stats and recent prescriptions sections, sent on connect, every interval and when
+// prescriptions change. The stream ends with the request timeout, when the client goes away or
+// when the server shuts down; the browser then reconnects.
+func (u *DashboardPageHandler) Events(w http.ResponseWriter, r *http.Request) {
+	stream, err := sse.Open(w, eventsRetry)
+	if err != nil {
+		u.log.Error("failed to open dashboard event stream", zap.Error(err))
+		return
+	}
+	changes, unsubscribe := u.live.Changes.Subscribe()
+	defer unsubscribe()
+	u.log.Debug("dashboard event stream opened", zap.Int("streams", u.live.Changes.Subscribers()))
+
+	interval := time.NewTicker(u.live.Interval)
+	defer interval.Stop()
+	heartbeat := time.NewTicker(u.live.HeartbeatInterval)
+	defer heartbeat.Stop()
+
+	ctx := r.Context()
+	err = u.pushUpdates(r, stream)
+	for err == nil {
+		select {
+		case <-ctx.Done():
+			return
+		case <-interval.C:
+			err = u.pushUpdates(r, stream)
+		case _, open := <-changes:
+			if !open {
+				// The server is shutting down; the browser reconnects to another instance
+				return
+			}
+			err = u.pushUpdates(r, stream)
+		case <-heartbeat.C:
+			err = stream.Heartbeat()
+		}
+	}
+	u.log.Debug("dashboard event stream closed", zap.Error(err))
+}
+
+// pushUpdates sends the sections the user can see. A section that fails to load is skipped, so
+// the page keeps its last version; only a failed write ends the stream.
+func (u *DashboardPageHandler) pushUpdates(r *http.Request, stream *sse.Stream) error {
+	ctx := r.Context()
+	if summary, err := u.service.Summary(ctx); err != nil {
+		u.log.Warn("failed to load dashboard stats for the event stream", zap.Error(err))
+	} else if err := sendComponent(r, stream, "stats", dashboardStats(ctx, summary.TotalPatients, summary.ActivePrescriptions)); err != nil {
+		return err
+	}
+
+	if navigation.Allowed(ctx, commonsecurity.PrescriptionReadAccess) {
+		return sendComponent(r, stream, "activity", u.recentPrescriptionsPanel(r))
+	}
+	return nil
+}
+
+func sendComponent(r *http.Request, stream *sse.Stream, event string, component templ.Component) error {
+	html, err := templ.ToGoHTML(r.Context(), component)
+	if err != nil {
+		return err
+	}
+	return stream.Send(event, string(html))
+}
+
+func (u *DashboardPageHandler) dispenseQueuePanel(r *http.Request) templ.Component {
+	queue, err := u.service.DispenseQueue(r.Context(), landingPanelLimit)
+	if err != nil {
+		u.log.Error("failed to load dispense queue", zap.Error(err))
+		return panelError(dispenseQueueTitle, "The dispense queue could not be loaded.")
+	}
+	return dispenseQueue(queue)
+}
+
+func (u *DashboardPageHandler) invoiceAgingPanel(r *http.Request) templ.Component {
+	aging, err := u.service.InvoiceAging(r.Context())
+	if err != nil {
+		u.log.Error("failed to load invoice aging", zap.Error(err))
+		return panelError(invoiceAgingTitle, "Billing is unavailable. Invoice aging could not be loaded.")
+	}
+	return invoiceAging(aging)
+}
+
+func (u *DashboardPageHandler) prescriberPatientsPanel(r *http.Request) templ.Component {
+	user, err := auth.GetCurrentUser(r.Context())
+	if err != nil {
+		return panelError(prescriberPatientsTitle, "Sign in to see your patients.")
+	}
+	patients, err := u.service.PrescriberPatients(r.Context(), user.ID, landingPanelLimit)
+	if err != nil {
+		u.log.Error("failed to load prescriber patients", zap.Error(err))
+		return panelError(prescriberPatientsTitle, "Your patients could not be loaded.")
+	}
+	return prescriberPatients(patients)
+}
+
+func (u *DashboardPageHandler) recentPrescriptionsPanel(r *http.Request) templ.Component {
+	recent, err := u.service.RecentPrescriptions(r.Context(), landingPanelLimit)
+	if err != nil {
+		u.log.Error("failed to load recent prescriptions", zap.Error(err))
+		return recentActivity(panelError(recentPrescriptionsTitle, "Recent prescriptions could not be loaded."))
+	}
+	return recentActivity(recentPrescriptions(recent))
+}
@@ -3,13 +3,54 @@
  * Handles dashboard page interactions and functionality
  */
 
+// Stream events and the section each one replaces
+const liveSections: Record<string, string> = {
+  stats: 'dashboard-stats',
+  activity: 'dashboard-activity',
+}
+
 export class DashboardPageComponent {
+  private events: EventSource | null = null
+
   constructor() {
     this.init()
   }
 
   private init(): void {
     console.log('Dashboard Page component initialized')
+    this.setupLiveUpdates()
+  }
+
+  /**
+   * Replace the stats and recent prescriptions with the HTML the server pushes,
+   * instead of polling. The browser reconnects the stream when it closes.
+   */
+  private setupLiveUpdates(): void {
+    const root = document.querySelector<HTMLElement>('[data-component="dashboard.dashboard-page"]')
+    const url = root?.dataset.eventsUrl
+    if (!root || !url) return
+
+    this.events = new EventSource(url, { withCredentials: true })
+    Object.entries(liveSections).forEach(([event, id]) => {
+      this.events?.addEventListener(event, (e) => {
+        // The dashboard was navigated away from without a page load
+        if (!root.isConnected) {
+          this.close()
+          return
+        }
+        const section = document.getElementById(id)
+        if (section) {
+          section.outerHTML = (e as MessageEvent<string>).data
+        }
+      })
+    })
+
+    window.addEventListener('pagehide', () => this.close())
+  }
+
+  private close(): void {
+    this.events?.close()
+    this.events = null
   }
 
 }
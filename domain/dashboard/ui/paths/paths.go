@@ -6,6 +6,9 @@ const (
 
 	// UI Routes
 	DashboardPath = BasePath
+
+	// DashboardEventsPath streams dashboard updates as Server-Sent Events
+	DashboardEventsPath = "/events/dashboard"
 )
 
 // Helper functions for path generation
@@ -2,6 +2,7 @@ package dashboard
 
 import (
 	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
 
 	dashboardservice "pharmacy-modernization-project-model/domain/dashboard/service"
 	dashboardPage "pharmacy-modernization-project-model/domain/dashboard/ui/dashboard_page"
@@ -9,19 +10,27 @@ import (
 
 	dashboardsecurity "pharmacy-modernization-project-model/domain/dashboard/security"
 	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/navigation"
 )
 
 type DashboardUiDependencies struct {
-	Service dashboardservice.IDashboardService
+	Service     dashboardservice.IDashboardService
+	Navigation  *navigation.BackStack
+	LiveUpdates dashboardPage.LiveUpdates
+	Log         *zap.Logger
 }
 
 func MountUI(r chi.Router, deps *DashboardUiDependencies) {
-	handler := dashboardPage.NewDashboardPageHandler(deps.Service)
+	handler := dashboardPage.NewDashboardPageHandler(deps.Service, deps.Navigation, deps.LiveUpdates, deps.Log)
 
 	// Dashboard requires authentication and dashboard:view permission
 	// Uses dev mode if enabled, otherwise cookie-based auth
-	r.With(
+	viewer := r.With(
 		auth.RequireAuthWithDevMode(),
 		auth.RequirePermissionsMatchAny(dashboardsecurity.ViewAccess),
-	).Get(paths.DashboardPath, handler.Handler)
+	)
+	viewer.Get(paths.DashboardPath, handler.Handler)
+	if deps.LiveUpdates.Enabled {
+		viewer.Get(paths.DashboardEventsPath, handler.Events)
+	}
 }
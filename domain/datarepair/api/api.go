@@ -0,0 +1,26 @@
+package api
+
+import (
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	controllers "pharmacy-modernization-project-model/domain/datarepair/api/controllers"
+	"pharmacy-modernization-project-model/domain/datarepair/service"
+	platformpaths "pharmacy-modernization-project-model/internal/platform/paths"
+)
+
+// APIPath is the base path of the data repair API
+const APIPath = platformpaths.APIV1Path + "/data-repairs"
+
+type Dependencies struct {
+	RepairService service.RepairService
+	Logger        *zap.Logger
+}
+
+func MountAPI(r chi.Router, deps *Dependencies) {
+	repairController := controllers.NewRepairController(deps.RepairService, deps.Logger)
+
+	r.Route(APIPath, func(router chi.Router) {
+		repairController.RegisterRoutes(router)
+	})
+}
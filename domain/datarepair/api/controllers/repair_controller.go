@@ -0,0 +1,167 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/datarepair/contracts/model"
+	"pharmacy-modernization-project-model/domain/datarepair/contracts/request"
+	repairsecurity "pharmacy-modernization-project-model/domain/datarepair/security"
+	"pharmacy-modernization-project-model/domain/datarepair/service"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+)
+
+type RepairController struct {
+	repairService service.RepairService
+	log           *zap.Logger
+}
+
+func NewRepairController(repairs service.RepairService, log *zap.Logger) *RepairController {
+	return &RepairController{repairService: repairs, log: log}
+}
+
+func (c *RepairController) RegisterRoutes(r chi.Router) {
+	// All data repair routes require authentication (header-based for API)
+	r.Use(auth.RequireAuthFromHeader())
+
+	// Preview, inspect and execute - requires datarepair:request or admin:all
+	r.With(auth.RequirePermissionsMatchAny(repairsecurity.RequestAccess)).Get("/", c.List)
+	r.With(auth.RequirePermissionsMatchAny(repairsecurity.RequestAccess)).Post("/", c.Preview)
+	r.With(auth.RequirePermissionsMatchAny(repairsecurity.RequestAccess)).Get("/{repairID}", c.GetByID)
+	r.With(auth.RequirePermissionsMatchAny(repairsecurity.RequestAccess)).Post("/{repairID}/execute", c.Execute)
+
+	// Approval decisions - requires datarepair:approve or admin:all
+	r.With(auth.RequirePermissionsMatchAny(repairsecurity.ApproveAccess)).Post("/{repairID}/approve", c.Approve)
+	r.With(auth.RequirePermissionsMatchAny(repairsecurity.ApproveAccess)).Post("/{repairID}/reject", c.Reject)
+}
+
+func (c *RepairController) List(w http.ResponseWriter, r *http.Request) {
+	query, fieldErrors, err := bind.Query[request.RepairListQueryRequest](r)
+	if err != nil {
+		c.log.Error("failed to bind query parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	if query.Limit == 0 {
+		query.Limit = 50
+	}
+
+	repairs, err := c.repairService.List(r.Context(), model.RepairStatus(query.Status), query.Limit)
+	if err != nil {
+		c.log.Error("list data repairs", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, repairs)
+}
+
+// Preview returns the diff the patch would produce; nothing is written until the repair is executed
+func (c *RepairController) Preview(w http.ResponseWriter, r *http.Request) {
+	req, fieldErrors, err := bind.JSON[request.RepairCreateRequest](r)
+	if err != nil {
+		c.log.Warn("invalid data repair payload", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	repair, err := c.repairService.Preview(r.Context(), actor(r), req)
+	if err != nil {
+		c.log.Error("preview data repair", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteCreated(w, repair)
+}
+
+func (c *RepairController) GetByID(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.RepairPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	repair, err := c.repairService.GetByID(r.Context(), pathVars.RepairID)
+	if err != nil {
+		c.log.Error("get data repair", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, repair)
+}
+
+func (c *RepairController) Approve(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.RepairPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	repair, err := c.repairService.Approve(r.Context(), pathVars.RepairID, actor(r))
+	if err != nil {
+		c.log.Error("approve data repair", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, repair)
+}
+
+func (c *RepairController) Reject(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.RepairPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	req, fieldErrors, err := bind.JSON[request.RepairRejectRequest](r)
+	if err != nil {
+		c.log.Warn("invalid reject payload", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	repair, err := c.repairService.Reject(r.Context(), pathVars.RepairID, actor(r), req.Reason)
+	if err != nil {
+		c.log.Error("reject data repair", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, repair)
+}
+
+func (c *RepairController) Execute(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.RepairPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	repair, err := c.repairService.Execute(r.Context(), pathVars.RepairID, actor(r))
+	if err != nil {
+		c.log.Error("execute data repair", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, repair)
+}
+
+// actor identifies the authenticated user acting on a repair
+func actor(r *http.Request) string {
+	user, err := auth.GetCurrentUser(r.Context())
+	if err != nil {
+		return ""
+	}
+	if user.Email != "" {
+		return user.Email
+	}
+	return user.ID
+}
@@ -0,0 +1,29 @@
+package builder
+
+import (
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	repairrepo "pharmacy-modernization-project-model/domain/datarepair/repository"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// CreateRepairRepository creates the appropriate repair repository based on dependencies
+func CreateRepairRepository(logger *zap.Logger, mongoCollection *mongo.Collection, retrier *database.Retrier) repairrepo.RepairRepository {
+	// Use MongoDB repository if collection is provided, otherwise fallback to memory
+	if mongoCollection != nil {
+		return repairrepo.NewRepairRetryRepository(repairrepo.NewRepairMongoRepository(mongoCollection, logger), retrier)
+	}
+
+	return repairrepo.NewRepairMemoryRepository()
+}
+
+// CreateDocumentRepository returns nil without a MongoDB connection; the in-memory
+// domain repositories are not reachable as raw documents, so repairs are unavailable
+func CreateDocumentRepository(logger *zap.Logger, mongoConnMgr *database.ConnectionManager, retrier *database.Retrier) repairrepo.DocumentRepository {
+	if mongoConnMgr == nil {
+		return nil
+	}
+
+	return repairrepo.NewDocumentRetryRepository(repairrepo.NewDocumentMongoRepository(mongoConnMgr, logger), retrier)
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"pharmacy-modernization-project-model/internal/platform/jsonpatch"
+)
+
+type RepairStatus string
+
+const (
+	RepairPendingApproval RepairStatus = "pending_approval"
+	RepairApproved        RepairStatus = "approved"
+	RepairRejected        RepairStatus = "rejected"
+	RepairExecuted        RepairStatus = "executed"
+)
+
+// Repair is a JSON Patch against a single document, previewed before it is approved and executed.
+// Documents are exchanged as MongoDB relaxed extended JSON, so dates appear as {"$date": "..."}.
+type Repair struct {
+	ID         string       `json:"id" bson:"_id"`
+	Collection string       `json:"collection" bson:"collection"`
+	DocumentID string       `json:"document_id" bson:"document_id"`
+	Reason     string       `json:"reason" bson:"reason"`
+	Status     RepairStatus `json:"status" bson:"status"`
+
+	// Patch, Before and After are stored as raw JSON; field names such as "$date" are not valid in stored documents
+	Patch  json.RawMessage `json:"patch" bson:"patch"`
+	Before json.RawMessage `json:"before" bson:"before"`
+	After  json.RawMessage `json:"after" bson:"after"`
+	// Changes is the preview diff between Before and After, computed on read
+	Changes []jsonpatch.Change `json:"changes" bson:"-"`
+	// BaseRevision fingerprints the document at preview time; execution fails if it has changed since
+	BaseRevision string `json:"base_revision" bson:"base_revision"`
+
+	RequestedBy     string     `json:"requested_by" bson:"requested_by"`
+	RequestedAt     time.Time  `json:"requested_at" bson:"requested_at"`
+	ApprovedBy      string     `json:"approved_by,omitempty" bson:"approved_by,omitempty"`
+	ApprovedAt      *time.Time `json:"approved_at,omitempty" bson:"approved_at,omitempty"`
+	RejectedBy      string     `json:"rejected_by,omitempty" bson:"rejected_by,omitempty"`
+	RejectionReason string     `json:"rejection_reason,omitempty" bson:"rejection_reason,omitempty"`
+	ExecutedBy      string     `json:"executed_by,omitempty" bson:"executed_by,omitempty"`
+	ExecutedAt      *time.Time `json:"executed_at,omitempty" bson:"executed_at,omitempty"`
+	AuditID         string     `json:"audit_id,omitempty" bson:"audit_id,omitempty"`
+}
+
+// IsOpen reports whether the repair can still be approved, rejected or executed
+func (r Repair) IsOpen() bool {
+	return r.Status == RepairPendingApproval || r.Status == RepairApproved
+}
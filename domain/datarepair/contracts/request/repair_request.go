@@ -0,0 +1,25 @@
+package request
+
+import "pharmacy-modernization-project-model/internal/platform/jsonpatch"
+
+// RepairCreateRequest previews a JSON Patch against a single document
+type RepairCreateRequest struct {
+	Collection string                `json:"collection" validate:"required,max=64"`
+	DocumentID string                `json:"document_id" validate:"required,max=128"`
+	Patch      []jsonpatch.Operation `json:"patch" validate:"required,min=1,max=50,dive"`
+	Reason     string                `json:"reason" validate:"required,min=10,max=500"`
+}
+
+type RepairRejectRequest struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+}
+
+type RepairListQueryRequest struct {
+	Status string `form:"status" validate:"omitempty,oneof=pending_approval approved rejected executed"`
+	Limit  int    `form:"limit" validate:"omitempty,min=1,max=200"`
+}
+
+// RepairPathVars represents path parameters for repair endpoints
+type RepairPathVars struct {
+	RepairID string `path:"repairID" validate:"required,min=1"`
+}
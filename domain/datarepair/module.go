@@ -0,0 +1,41 @@
+package datarepair
+
+import (
+	"github.com/go-chi/chi/v5"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	repairapi "pharmacy-modernization-project-model/domain/datarepair/api"
+	repairbuilder "pharmacy-modernization-project-model/domain/datarepair/builder"
+	repairservice "pharmacy-modernization-project-model/domain/datarepair/service"
+	"pharmacy-modernization-project-model/internal/platform/audit"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+type ModuleDependencies struct {
+	Logger                 *zap.Logger
+	MongoConnection        *database.ConnectionManager
+	RepairsMongoCollection *mongo.Collection
+	Transactions           database.TransactionRunner
+	Retrier                *database.Retrier // Retries the reads and idempotent writes of the MongoDB repositories; nil runs them once
+	AuditStore             audit.Store
+	Config                 repairservice.Config
+}
+
+type ModuleExport struct {
+	RepairService repairservice.RepairService
+}
+
+func Module(r chi.Router, deps *ModuleDependencies) ModuleExport {
+	repairRepo := repairbuilder.CreateRepairRepository(deps.Logger, deps.RepairsMongoCollection, deps.Retrier)
+	documentRepo := repairbuilder.CreateDocumentRepository(deps.Logger, deps.MongoConnection, deps.Retrier)
+
+	repairSvc := repairservice.NewRepairService(repairRepo, documentRepo, deps.Transactions, deps.AuditStore, deps.Config, deps.Logger)
+
+	repairapi.MountAPI(r, &repairapi.Dependencies{
+		RepairService: repairSvc,
+		Logger:        deps.Logger,
+	})
+
+	return ModuleExport{RepairService: repairSvc}
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/database"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// DocumentRepository reads and replaces raw documents in any configured collection
+type DocumentRepository interface {
+	// Get returns the raw document, or nil when it does not exist
+	Get(ctx context.Context, collection, documentID string) (bson.Raw, error)
+	// ReplaceIfUnchanged replaces before with after only if the stored document still equals before
+	ReplaceIfUnchanged(ctx context.Context, collection string, before bson.Raw, after bson.D) error
+}
+
+// DocumentMongoRepository implements DocumentRepository on the main MongoDB connection
+type DocumentMongoRepository struct {
+	connMgr *database.ConnectionManager
+	logger  *zap.Logger
+}
+
+// NewDocumentMongoRepository creates a document repository over the given connection
+func NewDocumentMongoRepository(connMgr *database.ConnectionManager, logger *zap.Logger) DocumentRepository {
+	return &DocumentMongoRepository{connMgr: connMgr, logger: logger}
+}
+
+func (r *DocumentMongoRepository) Get(ctx context.Context, collection, documentID string) (bson.Raw, error) {
+	coll := r.connMgr.GetCollection(collection)
+
+	raw, err := coll.FindOne(ctx, bson.M{"_id": documentID}).Raw()
+	if err == mongo.ErrNoDocuments {
+		// Fall back to ObjectID keys for collections that don't use string IDs
+		if oid, oidErr := primitive.ObjectIDFromHex(documentID); oidErr == nil {
+			raw, err = coll.FindOne(ctx, bson.M{"_id": oid}).Raw()
+		}
+	}
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, platformErrors.HandleMongoError("Get", err)
+	}
+	return raw, nil
+}
+
+// ReplaceIfUnchanged uses the whole previous document as the filter: there is no version field,
+// so any concurrent write to any field makes the replace match nothing
+func (r *DocumentMongoRepository) ReplaceIfUnchanged(ctx context.Context, collection string, before bson.Raw, after bson.D) error {
+	result, err := r.connMgr.GetCollection(collection).ReplaceOne(ctx, before, after)
+	if err != nil {
+		r.logger.Error("Failed to replace repaired document",
+			zap.String("collection", collection),
+			zap.Error(err))
+		return platformErrors.HandleMongoError("ReplaceIfUnchanged", err)
+	}
+	if result.MatchedCount == 0 {
+		id := before.Lookup("_id")
+		documentID, ok := id.StringValueOK()
+		if !ok {
+			documentID = id.String()
+		}
+		return platformErrors.NewConflictError(collection, documentID, "document changed during repair")
+	}
+	return nil
+}
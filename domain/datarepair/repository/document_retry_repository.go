@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// DocumentRetryRepository retries the reads and idempotent writes of a document repository that
+// fail with a retryable error; ReplaceIfUnchanged runs once
+type DocumentRetryRepository struct {
+	next    DocumentRepository
+	retrier *database.Retrier
+}
+
+// NewDocumentRetryRepository wraps next with retries; without a retrier next is returned as is
+func NewDocumentRetryRepository(next DocumentRepository, retrier *database.Retrier) DocumentRepository {
+	if retrier == nil {
+		return next
+	}
+	return &DocumentRetryRepository{next: next, retrier: retrier}
+}
+
+func (r *DocumentRetryRepository) Get(ctx context.Context, collection, documentID string) (bson.Raw, error) {
+	return database.Retry(ctx, r.retrier, "documents.Get", func(ctx context.Context) (bson.Raw, error) {
+		return r.next.Get(ctx, collection, documentID)
+	})
+}
+
+// ReplaceIfUnchanged runs once: it only applies while the record is unchanged, which a retried call that reached the server no longer finds
+func (r *DocumentRetryRepository) ReplaceIfUnchanged(ctx context.Context, collection string, before bson.Raw, after bson.D) error {
+	return r.next.ReplaceIfUnchanged(ctx, collection, before, after)
+}
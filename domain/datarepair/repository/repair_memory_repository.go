@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"pharmacy-modernization-project-model/domain/datarepair/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+type repairMemoryRepository struct {
+	mu      sync.RWMutex
+	repairs map[string]model.Repair
+}
+
+func NewRepairMemoryRepository() RepairRepository {
+	return &repairMemoryRepository{repairs: map[string]model.Repair{}}
+}
+
+func (r *repairMemoryRepository) List(_ context.Context, status model.RepairStatus, limit int) ([]model.Repair, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.Repair{}
+	for _, repair := range r.repairs {
+		if status == "" || repair.Status == status {
+			out = append(out, repair)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].RequestedAt.After(out[j].RequestedAt) })
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (r *repairMemoryRepository) GetByID(_ context.Context, id string) (model.Repair, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.repairs[id], nil
+}
+
+func (r *repairMemoryRepository) Create(_ context.Context, repair model.Repair) (model.Repair, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.repairs[repair.ID]; exists {
+		return model.Repair{}, platformErrors.NewDuplicateRecordError("repair", repair.ID)
+	}
+	r.repairs[repair.ID] = repair
+	return repair, nil
+}
+
+func (r *repairMemoryRepository) Update(_ context.Context, repair model.Repair, expectedStatus model.RepairStatus) (model.Repair, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.repairs[repair.ID]
+	if !ok {
+		return model.Repair{}, platformErrors.NewRecordNotFoundError("repair", repair.ID)
+	}
+	if existing.Status != expectedStatus {
+		return model.Repair{}, platformErrors.NewConflictError("repair", repair.ID, "repair is now "+string(existing.Status))
+	}
+	r.repairs[repair.ID] = repair
+	return repair, nil
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/datarepair/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// RepairMongoRepository implements RepairRepository interface using MongoDB
+type RepairMongoRepository struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewRepairMongoRepository creates a new MongoDB repair repository
+func NewRepairMongoRepository(collection *mongo.Collection, logger *zap.Logger) RepairRepository {
+	return &RepairMongoRepository{
+		collection: collection,
+		logger:     logger,
+	}
+}
+
+// handleError processes MongoDB errors and converts them to appropriate repository errors
+func (r *RepairMongoRepository) handleError(operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	r.logger.Error("MongoDB operation failed",
+		zap.String("operation", operation),
+		zap.Error(err))
+
+	return platformErrors.HandleMongoError(operation, err)
+}
+
+// List retrieves repairs newest first
+func (r *RepairMongoRepository) List(ctx context.Context, status model.RepairStatus, limit int) ([]model.Repair, error) {
+	filter := bson.M{}
+	if status != "" {
+		filter["status"] = status
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "requested_at", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, r.handleError("List", err)
+	}
+	defer cursor.Close(ctx)
+
+	repairs := []model.Repair{}
+	if err := cursor.All(ctx, &repairs); err != nil {
+		return nil, r.handleError("List", err)
+	}
+	return repairs, nil
+}
+
+// GetByID retrieves a repair; an empty repair is returned when it does not exist
+func (r *RepairMongoRepository) GetByID(ctx context.Context, id string) (model.Repair, error) {
+	var repair model.Repair
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&repair)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.Repair{}, nil // Matches memory repo behavior
+		}
+		return model.Repair{}, r.handleError("GetByID", err)
+	}
+	return repair, nil
+}
+
+// Create inserts a new repair
+func (r *RepairMongoRepository) Create(ctx context.Context, repair model.Repair) (model.Repair, error) {
+	if _, err := r.collection.InsertOne(ctx, repair); err != nil {
+		return model.Repair{}, r.handleError("Create", err)
+	}
+	return repair, nil
+}
+
+// Update replaces a repair if its stored status still matches expectedStatus
+func (r *RepairMongoRepository) Update(ctx context.Context, repair model.Repair, expectedStatus model.RepairStatus) (model.Repair, error) {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": repair.ID, "status": expectedStatus}, repair)
+	if err != nil {
+		return model.Repair{}, r.handleError("Update", err)
+	}
+	if result.MatchedCount == 0 {
+		return model.Repair{}, platformErrors.NewConflictError("repair", repair.ID, "repair was modified by another request")
+	}
+	return repair, nil
+}
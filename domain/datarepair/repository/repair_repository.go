@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"pharmacy-modernization-project-model/domain/datarepair/contracts/model"
+)
+
+type RepairRepository interface {
+	// List returns repairs newest first, optionally filtered by status
+	List(ctx context.Context, status model.RepairStatus, limit int) ([]model.Repair, error)
+	// GetByID returns an empty repair when it does not exist
+	GetByID(ctx context.Context, id string) (model.Repair, error)
+	Create(ctx context.Context, repair model.Repair) (model.Repair, error)
+	// Update replaces a repair only if it is still in expectedStatus, so two approvers or executors can't race
+	Update(ctx context.Context, repair model.Repair, expectedStatus model.RepairStatus) (model.Repair, error)
+}
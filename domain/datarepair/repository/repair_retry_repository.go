@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+
+	"pharmacy-modernization-project-model/domain/datarepair/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// RepairRetryRepository retries the reads and idempotent writes of a data repair repository that
+// fail with a retryable error; Create and Update run once
+type RepairRetryRepository struct {
+	next    RepairRepository
+	retrier *database.Retrier
+}
+
+// NewRepairRetryRepository wraps next with retries; without a retrier next is returned as is
+func NewRepairRetryRepository(next RepairRepository, retrier *database.Retrier) RepairRepository {
+	if retrier == nil {
+		return next
+	}
+	return &RepairRetryRepository{next: next, retrier: retrier}
+}
+
+func (r *RepairRetryRepository) List(ctx context.Context, status model.RepairStatus, limit int) ([]model.Repair, error) {
+	return database.Retry(ctx, r.retrier, "data_repairs.List", func(ctx context.Context) ([]model.Repair, error) {
+		return r.next.List(ctx, status, limit)
+	})
+}
+
+func (r *RepairRetryRepository) GetByID(ctx context.Context, id string) (model.Repair, error) {
+	return database.Retry(ctx, r.retrier, "data_repairs.GetByID", func(ctx context.Context) (model.Repair, error) {
+		return r.next.GetByID(ctx, id)
+	})
+}
+
+// Create runs once: a retried insert that reached the server would fail as a duplicate
+func (r *RepairRetryRepository) Create(ctx context.Context, repair model.Repair) (model.Repair, error) {
+	return r.next.Create(ctx, repair)
+}
+
+// Update runs once: it only applies while the repair has the expected status, which a retried call that reached the server no longer finds
+func (r *RepairRetryRepository) Update(ctx context.Context, repair model.Repair, expectedStatus model.RepairStatus) (model.Repair, error) {
+	return r.next.Update(ctx, repair, expectedStatus)
+}
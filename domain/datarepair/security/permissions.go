@@ -0,0 +1,18 @@
+package security
+
+import "pharmacy-modernization-project-model/internal/platform/permissions"
+
+// Data repair permissions
+const (
+	PermissionRequest = permissions.DataRepairRequest
+	PermissionApprove = permissions.DataRepairApprove
+)
+
+// Common permission sets for reuse in routes
+var (
+	// RequestAccess - support engineers or admins can preview, list and execute repairs
+	RequestAccess = []string{PermissionRequest, permissions.AdminAll}
+
+	// ApproveAccess - approvers or admins can approve or reject pending repairs
+	ApproveAccess = []string{PermissionApprove, permissions.AdminAll}
+)
@@ -0,0 +1,424 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/datarepair/contracts/model"
+	"pharmacy-modernization-project-model/domain/datarepair/contracts/request"
+	"pharmacy-modernization-project-model/domain/datarepair/repository"
+	"pharmacy-modernization-project-model/internal/platform/audit"
+	"pharmacy-modernization-project-model/internal/platform/database"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/jsonpatch"
+)
+
+// AuditAction is the audit trail action recorded for executed repairs
+const AuditAction = "data_repair"
+
+// Config controls which collections can be repaired and whether a second person must approve
+type Config struct {
+	RequireSecondApprover bool
+	Collections           []string
+	// ProtectedFields lists, per collection, the top-level fields only their repository may
+	// write, e.g. encrypted patient PHI and its blind indexes; patches touching them are refused
+	ProtectedFields map[string][]string
+}
+
+type RepairService interface {
+	// Preview applies the patch to a copy of the document and stores the repair for approval
+	Preview(ctx context.Context, requestedBy string, req request.RepairCreateRequest) (model.Repair, error)
+	List(ctx context.Context, status model.RepairStatus, limit int) ([]model.Repair, error)
+	GetByID(ctx context.Context, id string) (model.Repair, error)
+	Approve(ctx context.Context, id, approvedBy string) (model.Repair, error)
+	Reject(ctx context.Context, id, rejectedBy, reason string) (model.Repair, error)
+	// Execute writes the patched document if it is unchanged since the preview and records it in the audit trail
+	Execute(ctx context.Context, id, executedBy string) (model.Repair, error)
+	// OnExecuted registers a handler called after a document in collection is repaired, e.g. a cache invalidator
+	OnExecuted(collection string, handler database.ChangeHandler)
+}
+
+type repairSvc struct {
+	repairs      repository.RepairRepository
+	documents    repository.DocumentRepository
+	transactions database.TransactionRunner
+	audit        audit.Store
+	cfg          Config
+	log          *zap.Logger
+	invalidators map[string][]database.ChangeHandler
+}
+
+// NewRepairService creates the repair service; documents is nil when MongoDB is not configured
+func NewRepairService(repairs repository.RepairRepository, documents repository.DocumentRepository, transactions database.TransactionRunner, auditStore audit.Store, cfg Config, log *zap.Logger) RepairService {
+	return &repairSvc{
+		repairs:      repairs,
+		documents:    documents,
+		transactions: transactions,
+		audit:        auditStore,
+		cfg:          cfg,
+		log:          log,
+		invalidators: map[string][]database.ChangeHandler{},
+	}
+}
+
+// OnExecuted handlers run in-process, so caches are evicted even when change streams are disabled
+func (s *repairSvc) OnExecuted(collection string, handler database.ChangeHandler) {
+	s.invalidators[collection] = append(s.invalidators[collection], handler)
+}
+
+func (s *repairSvc) Preview(ctx context.Context, requestedBy string, req request.RepairCreateRequest) (model.Repair, error) {
+	if !slices.Contains(s.cfg.Collections, req.Collection) {
+		return model.Repair{}, platformErrors.NewValidationError("collection", req.Collection, "collection is not enabled for data repair")
+	}
+	for _, op := range req.Patch {
+		if op.Path == "" || op.Touches("/_id") {
+			return model.Repair{}, platformErrors.NewValidationError("patch", op.Path, "the document _id cannot be changed")
+		}
+		for _, field := range s.cfg.ProtectedFields[req.Collection] {
+			if op.Touches("/" + field) {
+				return model.Repair{}, platformErrors.NewValidationError("patch", op.Path, "field "+field+" is written by its repository only, e.g. encrypted; change it through the API")
+			}
+		}
+	}
+	if s.documents == nil {
+		return model.Repair{}, platformErrors.NewBusinessLogicError("PreviewRepair", "data repair requires a MongoDB connection")
+	}
+
+	current, err := s.documents.Get(ctx, req.Collection, req.DocumentID)
+	if err != nil {
+		return model.Repair{}, err
+	}
+	if current == nil {
+		return model.Repair{}, platformErrors.NewRecordNotFoundError(req.Collection, req.DocumentID)
+	}
+
+	before, err := bson.MarshalExtJSON(current, false, false)
+	if err != nil {
+		return model.Repair{}, err
+	}
+	after, err := jsonpatch.Apply(before, req.Patch)
+	if err != nil {
+		return model.Repair{}, platformErrors.NewValidationError("patch", req.DocumentID, err.Error())
+	}
+	if jsonpatch.EqualJSON(before, after) {
+		return model.Repair{}, platformErrors.NewValidationError("patch", req.DocumentID, "patch does not change the document")
+	}
+	if _, err := replacement(current, after); err != nil {
+		return model.Repair{}, platformErrors.NewValidationError("patch", req.DocumentID, "patched document is not valid extended JSON: "+err.Error())
+	}
+
+	patch, err := json.Marshal(req.Patch)
+	if err != nil {
+		return model.Repair{}, err
+	}
+
+	repair := model.Repair{
+		ID:           uuid.NewString(),
+		Collection:   req.Collection,
+		DocumentID:   req.DocumentID,
+		Reason:       req.Reason,
+		Status:       model.RepairPendingApproval,
+		Patch:        patch,
+		Before:       before,
+		After:        after,
+		BaseRevision: revision(current),
+		RequestedBy:  requestedBy,
+		RequestedAt:  time.Now(),
+	}
+
+	created, err := s.repairs.Create(ctx, repair)
+	if err != nil {
+		return model.Repair{}, err
+	}
+
+	s.log.Info("Data repair previewed",
+		zap.String("repair_id", created.ID),
+		zap.String("collection", created.Collection),
+		zap.String("document_id", created.DocumentID),
+		zap.String("requested_by", requestedBy))
+
+	return s.withChanges(created), nil
+}
+
+func (s *repairSvc) List(ctx context.Context, status model.RepairStatus, limit int) ([]model.Repair, error) {
+	repairs, err := s.repairs.List(ctx, status, limit)
+	if err != nil {
+		return nil, err
+	}
+	for i := range repairs {
+		repairs[i] = s.withChanges(repairs[i])
+	}
+	return repairs, nil
+}
+
+func (s *repairSvc) GetByID(ctx context.Context, id string) (model.Repair, error) {
+	repair, err := s.find(ctx, id)
+	if err != nil {
+		return model.Repair{}, err
+	}
+	return s.withChanges(repair), nil
+}
+
+func (s *repairSvc) Approve(ctx context.Context, id, approvedBy string) (model.Repair, error) {
+	repair, err := s.find(ctx, id)
+	if err != nil {
+		return model.Repair{}, err
+	}
+	if repair.Status != model.RepairPendingApproval {
+		return model.Repair{}, platformErrors.NewBusinessLogicError("ApproveRepair", "repair is "+string(repair.Status))
+	}
+	if approvedBy == "" || approvedBy == repair.RequestedBy {
+		return model.Repair{}, platformErrors.NewAuthorizationError("repair", "approve", "a repair must be approved by someone other than its requester")
+	}
+
+	now := time.Now()
+	repair.Status = model.RepairApproved
+	repair.ApprovedBy = approvedBy
+	repair.ApprovedAt = &now
+
+	updated, err := s.repairs.Update(ctx, repair, model.RepairPendingApproval)
+	if err != nil {
+		return model.Repair{}, err
+	}
+
+	s.log.Info("Data repair approved",
+		zap.String("repair_id", id),
+		zap.String("approved_by", approvedBy))
+
+	return s.withChanges(updated), nil
+}
+
+func (s *repairSvc) Reject(ctx context.Context, id, rejectedBy, reason string) (model.Repair, error) {
+	repair, err := s.find(ctx, id)
+	if err != nil {
+		return model.Repair{}, err
+	}
+	if !repair.IsOpen() {
+		return model.Repair{}, platformErrors.NewBusinessLogicError("RejectRepair", "repair is "+string(repair.Status))
+	}
+
+	expected := repair.Status
+	repair.Status = model.RepairRejected
+	repair.RejectedBy = rejectedBy
+	repair.RejectionReason = reason
+
+	updated, err := s.repairs.Update(ctx, repair, expected)
+	if err != nil {
+		return model.Repair{}, err
+	}
+
+	s.log.Info("Data repair rejected",
+		zap.String("repair_id", id),
+		zap.String("rejected_by", rejectedBy))
+
+	return s.withChanges(updated), nil
+}
+
+func (s *repairSvc) Execute(ctx context.Context, id, executedBy string) (model.Repair, error) {
+	repair, err := s.find(ctx, id)
+	if err != nil {
+		return model.Repair{}, err
+	}
+	if !repair.IsOpen() {
+		return model.Repair{}, platformErrors.NewBusinessLogicError("ExecuteRepair", "repair is "+string(repair.Status))
+	}
+	if s.cfg.RequireSecondApprover && repair.Status != model.RepairApproved {
+		return model.Repair{}, platformErrors.NewBusinessLogicError("ExecuteRepair", "repair must be approved by a second engineer before execution")
+	}
+	if s.documents == nil {
+		return model.Repair{}, platformErrors.NewBusinessLogicError("ExecuteRepair", "data repair requires a MongoDB connection")
+	}
+
+	current, err := s.documents.Get(ctx, repair.Collection, repair.DocumentID)
+	if err != nil {
+		return model.Repair{}, err
+	}
+	if current == nil {
+		return model.Repair{}, platformErrors.NewRecordNotFoundError(repair.Collection, repair.DocumentID)
+	}
+	if revision(current) != repair.BaseRevision {
+		return model.Repair{}, platformErrors.NewConflictError(repair.Collection, repair.DocumentID, "document changed since the preview; create a new repair")
+	}
+
+	after, err := replacement(current, repair.After)
+	if err != nil {
+		return model.Repair{}, err
+	}
+
+	// The document, its audit entry and the repair status commit together where transactions are available
+	expected := repair.Status
+	var updated model.Repair
+	err = s.transactions.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := s.documents.ReplaceIfUnchanged(ctx, repair.Collection, current, after); err != nil {
+			return err
+		}
+
+		// Without a transaction the document is already written; an audit failure must not hide
+		// that, and the repair record itself keeps the before/after snapshots
+		entry, err := s.recordAudit(ctx, repair, executedBy, current, after)
+		if err != nil {
+			s.log.Error("Failed to record data repair in audit trail",
+				zap.String("repair_id", repair.ID),
+				zap.Error(err))
+			if database.InTransaction(ctx) {
+				return err
+			}
+		}
+
+		executed := repair
+		now := time.Now()
+		executed.Status = model.RepairExecuted
+		executed.ExecutedBy = executedBy
+		executed.ExecutedAt = &now
+		executed.AuditID = entry.ID
+
+		updated, err = s.repairs.Update(ctx, executed, expected)
+		return err
+	})
+	if err != nil {
+		return model.Repair{}, err
+	}
+
+	s.log.Info("Data repair executed",
+		zap.String("repair_id", repair.ID),
+		zap.String("collection", repair.Collection),
+		zap.String("document_id", repair.DocumentID),
+		zap.String("executed_by", executedBy))
+
+	s.notifyExecuted(ctx, repair, after)
+	return s.withChanges(updated), nil
+}
+
+func (s *repairSvc) find(ctx context.Context, id string) (model.Repair, error) {
+	repair, err := s.repairs.GetByID(ctx, id)
+	if err != nil {
+		return model.Repair{}, err
+	}
+	if repair.ID == "" {
+		return model.Repair{}, platformErrors.NewRecordNotFoundError("repair", id)
+	}
+	return repair, nil
+}
+
+func (s *repairSvc) withChanges(repair model.Repair) model.Repair {
+	changes, err := jsonpatch.Diff(repair.Before, repair.After)
+	if err != nil {
+		s.log.Warn("Failed to diff repair snapshots",
+			zap.String("repair_id", repair.ID),
+			zap.Error(err))
+		return repair
+	}
+	repair.Changes = changes
+	return repair
+}
+
+func (s *repairSvc) recordAudit(ctx context.Context, repair model.Repair, actor string, before bson.Raw, after bson.D) (audit.Entry, error) {
+	var beforeDoc, afterDoc bson.M
+	if err := bson.Unmarshal(before, &beforeDoc); err != nil {
+		return audit.Entry{}, err
+	}
+	afterBytes, err := bson.Marshal(after)
+	if err != nil {
+		return audit.Entry{}, err
+	}
+	if err := bson.Unmarshal(afterBytes, &afterDoc); err != nil {
+		return audit.Entry{}, err
+	}
+
+	return s.audit.Record(ctx, audit.Entry{
+		Action:     AuditAction,
+		Collection: repair.Collection,
+		DocumentID: repair.DocumentID,
+		Actor:      actor,
+		Reason:     repair.Reason,
+		Before:     beforeDoc,
+		After:      afterDoc,
+		Metadata: bson.M{
+			"repair_id":    repair.ID,
+			"requested_by": repair.RequestedBy,
+			"approved_by":  repair.ApprovedBy,
+		},
+	})
+}
+
+func (s *repairSvc) notifyExecuted(ctx context.Context, repair model.Repair, after bson.D) {
+	handlers := s.invalidators[repair.Collection]
+	if len(handlers) == 0 {
+		return
+	}
+	fullDocument := make(bson.M, len(after))
+	for _, elem := range after {
+		fullDocument[elem.Key] = elem.Value
+	}
+	event := database.ChangeEvent{
+		Collection:   repair.Collection,
+		Operation:    "replace",
+		DocumentID:   repair.DocumentID,
+		FullDocument: fullDocument,
+	}
+	for _, h := range handlers {
+		h(ctx, event)
+	}
+}
+
+// revision fingerprints the stored BSON bytes of a document
+func revision(doc bson.Raw) string {
+	sum := sha256.Sum256(doc)
+	return hex.EncodeToString(sum[:])
+}
+
+// replacement converts the patched extended JSON back into a document. Top-level fields the
+// patch did not change keep their original BSON value and order, so relaxed JSON round-trips
+// (e.g. int64 read back as int32) never alter untouched data.
+func replacement(before bson.Raw, after []byte) (bson.D, error) {
+	var patched bson.D
+	if err := bson.UnmarshalExtJSON(after, false, &patched); err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]interface{}, len(patched))
+	for _, elem := range patched {
+		fields[elem.Key] = elem.Value
+	}
+
+	elems, err := before.Elements()
+	if err != nil {
+		return nil, err
+	}
+
+	doc := make(bson.D, 0, len(patched))
+	for _, elem := range elems {
+		key := elem.Key()
+		value, ok := fields[key]
+		if !ok {
+			continue // removed by the patch
+		}
+		delete(fields, key)
+		if sameValue(key, elem.Value(), value) {
+			doc = append(doc, bson.E{Key: key, Value: elem.Value()})
+		} else {
+			doc = append(doc, bson.E{Key: key, Value: value})
+		}
+	}
+	// Fields added by the patch, in patch output order
+	for _, elem := range patched {
+		if _, added := fields[elem.Key]; added {
+			doc = append(doc, elem)
+		}
+	}
+	return doc, nil
+}
+
+func sameValue(key string, original bson.RawValue, patched interface{}) bool {
+	a, errA := bson.MarshalExtJSON(bson.D{{Key: key, Value: original}}, false, false)
+	b, errB := bson.MarshalExtJSON(bson.D{{Key: key, Value: patched}}, false, false)
+	return errA == nil && errB == nil && jsonpatch.EqualJSON(a, b)
+}
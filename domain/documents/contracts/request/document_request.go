@@ -0,0 +1,16 @@
+package request
+
+// PrescriptionLabelPathVars represents path parameters for the prescription label
+type PrescriptionLabelPathVars struct {
+	PrescriptionID string `path:"prescriptionID" validate:"required,min=1"`
+}
+
+// PrescriptionLabelQueryRequest selects the language the directions are printed in
+type PrescriptionLabelQueryRequest struct {
+	Lang string `form:"lang" validate:"omitempty,oneof=en es"`
+}
+
+// PatientSummaryPathVars represents path parameters for the patient medication summary
+type PatientSummaryPathVars struct {
+	PatientID string `path:"patientID" validate:"required,min=1"`
+}
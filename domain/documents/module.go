@@ -0,0 +1,35 @@
+package documents
+
+import (
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	documentproviders "pharmacy-modernization-project-model/domain/documents/providers"
+	documentservice "pharmacy-modernization-project-model/domain/documents/service"
+	uidocuments "pharmacy-modernization-project-model/domain/documents/ui"
+	"pharmacy-modernization-project-model/internal/platform/audit"
+)
+
+type ModuleDependencies struct {
+	Logger        *zap.Logger
+	Patients      documentproviders.PatientProvider
+	Prescriptions documentproviders.PrescriptionProvider
+	Prescribers   documentproviders.PrescriberProvider
+	AuditStore    audit.Store // Records every generated document
+}
+
+type ModuleExport struct {
+	DocumentService documentservice.DocumentService
+}
+
+func Module(r chi.Router, deps *ModuleDependencies) ModuleExport {
+	auditStore := deps.AuditStore
+	if auditStore == nil {
+		auditStore = audit.NewMemoryStore()
+	}
+
+	svc := documentservice.New(deps.Patients, deps.Prescriptions, deps.Prescribers, auditStore, deps.Logger)
+	uidocuments.MountUI(r, &uidocuments.DocumentDependencies{DocumentSvc: svc, Log: deps.Logger})
+
+	return ModuleExport{DocumentService: svc}
+}
@@ -0,0 +1,16 @@
+package security
+
+import (
+	patientsecurity "pharmacy-modernization-project-model/domain/patient/security"
+	prescriptionsecurity "pharmacy-modernization-project-model/domain/prescription/security"
+)
+
+// Common permission sets for reuse in routes
+var (
+	// LabelAccess - labels are printed when dispensing, so pharmacists or admins print them
+	LabelAccess = prescriptionsecurity.DispenseAccess
+
+	// SummaryAccess - a summary takes patient data out of the system, so it needs ALL of the
+	// patient export permissions
+	SummaryAccess = patientsecurity.ExportAccess
+)
@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/documents/providers"
+	patientmodel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	prescriptionmodel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/audit"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/pdf"
+)
+
+// Audit trail actions of generated documents; they are recorded on the printed record
+const (
+	ActionPrescriptionLabel = "document.prescription_label"
+	ActionPatientSummary    = "document.patient_summary"
+)
+
+// summaryLimit bounds the prescriptions listed in a patient summary
+const summaryLimit = 200
+
+// labelStatuses are the prescriptions a label is printed for; drafts are not filled yet
+var labelStatuses = []prescriptionmodel.Status{prescriptionmodel.Active, prescriptionmodel.Completed}
+
+type DocumentService interface {
+	// PrescriptionLabel renders the label of an active or completed prescription, with the
+	// directions in the language
+	PrescriptionLabel(ctx context.Context, prescriptionID string, language prescriptionmodel.Language) (*pdf.Document, error)
+	// PatientSummary renders the patient's current medications and past prescriptions
+	PatientSummary(ctx context.Context, patientID string) (*pdf.Document, error)
+}
+
+type documentSvc struct {
+	patients      providers.PatientProvider
+	prescriptions providers.PrescriptionProvider
+	prescribers   providers.PrescriberProvider
+	audit         audit.Store
+	log           *zap.Logger
+	now           func() time.Time
+}
+
+func New(patients providers.PatientProvider, prescriptions providers.PrescriptionProvider, prescribers providers.PrescriberProvider, store audit.Store, l *zap.Logger) DocumentService {
+	return &documentSvc{
+		patients:      patients,
+		prescriptions: prescriptions,
+		prescribers:   prescribers,
+		audit:         store,
+		log:           l,
+		now:           time.Now,
+	}
+}
+
+func (s *documentSvc) PrescriptionLabel(ctx context.Context, prescriptionID string, language prescriptionmodel.Language) (*pdf.Document, error) {
+	prescription, err := s.prescriptions.GetByID(ctx, prescriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(labelStatuses, prescription.Status) {
+		return nil, platformErrors.NewConflictError("prescription", prescriptionID,
+			"labels are printed for active or completed prescriptions, this one is "+string(prescription.Status))
+	}
+	patient, err := s.patients.GetByID(ctx, prescription.PatientID)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := pdf.New(pdf.Label4x6, "Rx "+prescription.ID)
+	if pharmacy := prescription.Pharmacy; pharmacy != nil {
+		doc.Title(pharmacy.Name)
+		doc.Text(strings.Join(nonEmpty(pharmacy.Address, pharmacy.City, strings.TrimSpace(pharmacy.State+" "+pharmacy.Zip)), ", "))
+		doc.Field("Phone", pharmacy.Phone)
+		doc.Rule()
+	}
+	doc.Field("Rx", prescription.ID)
+	doc.Field("Date", s.now().Format("01/02/2006"))
+	doc.Paragraph(patient.Name, 14, true)
+	doc.Space(4)
+	doc.Paragraph(drugLine(prescription), 12, true)
+	doc.Space(4)
+	doc.Text(directions(prescription, language))
+	doc.Space(4)
+	doc.Field("Qty", quantity(prescription.Quantity))
+	doc.Field("Days supply", quantity(prescription.DaysSupply))
+	doc.Field("Prescriber", s.prescriberName(ctx, prescription.PrescriberID))
+
+	err = s.record(ctx, audit.Entry{
+		Action:     ActionPrescriptionLabel,
+		Collection: "prescriptions",
+		DocumentID: prescription.ID,
+		Metadata:   bson.M{"patient_id": patient.ID, "language": string(language)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func (s *documentSvc) PatientSummary(ctx context.Context, patientID string) (*pdf.Document, error) {
+	patient, err := s.patients.GetByID(ctx, patientID)
+	if err != nil {
+		return nil, err
+	}
+	prescriptions, err := s.prescriptions.ListForPatient(ctx, patientID, "", summaryLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	var current, past []prescriptionmodel.Prescription
+	for _, p := range prescriptions {
+		switch p.Status {
+		case prescriptionmodel.Active:
+			current = append(current, p)
+		case prescriptionmodel.Draft:
+			// Not prescribed yet
+		default:
+			past = append(past, p)
+		}
+	}
+
+	doc := pdf.New(pdf.Letter, "Medication summary - "+patient.Name)
+	doc.Title("Medication Summary")
+	doc.Text("Generated " + s.now().Format("January 2, 2006 15:04 MST"))
+	doc.Rule()
+
+	doc.Heading("Patient")
+	writePatient(doc, patient)
+
+	doc.Heading(fmt.Sprintf("Current medications (%d)", len(current)))
+	if len(current) == 0 {
+		doc.Text("No active prescriptions.")
+	}
+	for _, p := range current {
+		s.writePrescription(ctx, doc, p)
+	}
+
+	doc.Heading(fmt.Sprintf("Past and paused prescriptions (%d)", len(past)))
+	if len(past) == 0 {
+		doc.Text("None.")
+	}
+	for _, p := range past {
+		s.writePrescription(ctx, doc, p)
+	}
+
+	if len(prescriptions) == summaryLimit {
+		doc.Space(6)
+		doc.Text(fmt.Sprintf("Only the %d most recent prescriptions are listed.", summaryLimit))
+	}
+
+	err = s.record(ctx, audit.Entry{
+		Action:     ActionPatientSummary,
+		Collection: "patients",
+		DocumentID: patient.ID,
+		Metadata:   bson.M{"prescriptions": len(current) + len(past)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func writePatient(doc *pdf.Document, patient patientmodel.Patient) {
+	doc.Field("Name", patient.Name)
+	doc.Field("Date of birth", patient.DOB.String())
+	doc.Field("Phone", patient.Phone)
+	doc.Field("State", patient.State)
+	doc.Field("Patient ID", patient.ID)
+}
+
+func (s *documentSvc) writePrescription(ctx context.Context, doc *pdf.Document, p prescriptionmodel.Prescription) {
+	doc.Space(6)
+	doc.Paragraph(drugLine(p), 11, true)
+	doc.Field("Directions", directions(p, prescriptionmodel.LanguageEnglish))
+	doc.Field("Quantity", quantity(p.Quantity))
+	doc.Field("Days supply", quantity(p.DaysSupply))
+	doc.Field("Prescriber", s.prescriberName(ctx, p.PrescriberID))
+	doc.Field("Started", p.CreatedAt.Format("01/02/2006"))
+	doc.Field("Status", string(p.Status))
+}
+
+// record audits a generated document before it is handed out; without the entry it is not handed out
+func (s *documentSvc) record(ctx context.Context, entry audit.Entry) error {
+	entry.Actor = actor(ctx)
+	if entry.Metadata == nil {
+		entry.Metadata = bson.M{}
+	}
+	entry.Metadata["format"] = "pdf"
+	if _, err := s.audit.Record(ctx, entry); err != nil {
+		s.log.Error("Failed to audit document generation",
+			zap.String("action", entry.Action),
+			zap.String("document_id", entry.DocumentID),
+			zap.Error(err))
+		return err
+	}
+	s.log.Info("Document generated",
+		zap.String("action", entry.Action),
+		zap.String("document_id", entry.DocumentID))
+	return nil
+}
+
+// prescriberName is printed on the document; a prescriber that cannot be loaded is left out
+func (s *documentSvc) prescriberName(ctx context.Context, prescriberID string) string {
+	if prescriberID == "" {
+		return ""
+	}
+	prescriber, err := s.prescribers.GetByID(ctx, prescriberID)
+	if err != nil {
+		s.log.Warn("Failed to load prescriber for document",
+			zap.String("prescriber_id", prescriberID),
+			zap.Error(err))
+		return ""
+	}
+	return prescriber.DisplayName() + " (NPI " + prescriber.NPI + ")"
+}
+
+// drugLine is the drug with its dose, e.g. "Lisinopril 10 mg oral"
+func drugLine(p prescriptionmodel.Prescription) string {
+	if p.Dose == "" {
+		return p.Drug
+	}
+	return p.Drug + " " + p.Dose
+}
+
+// asDirected is printed for prescriptions written before sigs, which have no directions
+var asDirected = map[prescriptionmodel.Language]string{
+	prescriptionmodel.LanguageEnglish: "Use as directed.",
+	prescriptionmodel.LanguageSpanish: "Úsese según las indicaciones.",
+}
+
+// directions are the rendered sig in the language
+func directions(p prescriptionmodel.Prescription, language prescriptionmodel.Language) string {
+	if text := p.Sig.Render(language); text != "" {
+		return text
+	}
+	if text, ok := asDirected[language]; ok {
+		return text
+	}
+	return asDirected[prescriptionmodel.LanguageEnglish]
+}
+
+func nonEmpty(values ...string) []string {
+	return slices.DeleteFunc(values, func(v string) bool { return v == "" })
+}
+
+func quantity(n int) string {
+	if n <= 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
+
+func actor(ctx context.Context) string {
+	user, err := auth.GetCurrentUser(ctx)
+	if err != nil {
+		return ""
+	}
+	if user.Email != "" {
+		return user.Email
+	}
+	return user.ID
+}
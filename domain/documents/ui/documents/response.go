@@ -0,0 +1,39 @@
+package documents
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	helper "pharmacy-modernization-project-model/internal/helper"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/pdf"
+)
+
+// WritePDF sends the document inline, so browsers show it and it can be printed from there
+func WritePDF(w http.ResponseWriter, log *zap.Logger, doc *pdf.Document, fileName string) {
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`inline; filename="%s"`, fileName))
+	w.Header().Set("Cache-Control", "private, no-store")
+	if _, err := doc.WriteTo(w); err != nil {
+		log.Warn("failed to write document", zap.String("file", fileName), zap.Error(err))
+	}
+}
+
+// WriteError reports a document that could not be generated
+func WriteError(w http.ResponseWriter, log *zap.Logger, err error, notFoundMessage, message string) {
+	var notFound platformErrors.RecordNotFoundError
+	if errors.As(err, &notFound) {
+		helper.WriteUINotFound(w, notFoundMessage)
+		return
+	}
+	var conflict platformErrors.ConflictError
+	if errors.As(err, &conflict) {
+		helper.WriteUIError(w, conflict.Reason, http.StatusConflict)
+		return
+	}
+	log.Error(message, zap.Error(err))
+	helper.WriteUIInternalError(w, message)
+}
@@ -0,0 +1,18 @@
+package paths
+
+import "strings"
+
+const (
+	// Documents are served next to the pages of the records they print
+	PrescriptionLabelPath = "/prescriptions/{prescriptionID}/label.pdf"
+	PatientSummaryPath    = "/patients/{patientID}/summary.pdf"
+)
+
+// Helper functions for path generation
+func PrescriptionLabelURL(prescriptionID string) string {
+	return strings.Replace(PrescriptionLabelPath, "{prescriptionID}", prescriptionID, 1)
+}
+
+func PatientSummaryURL(patientID string) string {
+	return strings.Replace(PatientSummaryPath, "{patientID}", patientID, 1)
+}
@@ -0,0 +1,40 @@
+package patient_summary
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/documents/contracts/request"
+	docSvc "pharmacy-modernization-project-model/domain/documents/service"
+	"pharmacy-modernization-project-model/domain/documents/ui/documents"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+)
+
+type PatientSummaryHandler struct {
+	documentService docSvc.DocumentService
+	log             *zap.Logger
+}
+
+func NewPatientSummaryHandler(documents docSvc.DocumentService, log *zap.Logger) *PatientSummaryHandler {
+	return &PatientSummaryHandler{documentService: documents, log: log}
+}
+
+// Handler serves the patient's medication summary as a PDF
+func (h *PatientSummaryHandler) Handler(w http.ResponseWriter, r *http.Request) {
+	pathVars, _, err := bind.ChiPath[request.PatientSummaryPathVars](r, chi.URLParam)
+	if err != nil {
+		h.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.WriteUIError(w, "Invalid patient", http.StatusBadRequest)
+		return
+	}
+
+	doc, err := h.documentService.PatientSummary(r.Context(), pathVars.PatientID)
+	if err != nil {
+		documents.WriteError(w, h.log, err, "Patient not found", "Failed to generate medication summary")
+		return
+	}
+	documents.WritePDF(w, h.log, doc, "patient-"+pathVars.PatientID+"-summary.pdf")
+}
@@ -0,0 +1,50 @@
+package prescription_label
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/documents/contracts/request"
+	docSvc "pharmacy-modernization-project-model/domain/documents/service"
+	"pharmacy-modernization-project-model/domain/documents/ui/documents"
+	prescriptionmodel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+)
+
+type PrescriptionLabelHandler struct {
+	documentService docSvc.DocumentService
+	log             *zap.Logger
+}
+
+func NewPrescriptionLabelHandler(documents docSvc.DocumentService, log *zap.Logger) *PrescriptionLabelHandler {
+	return &PrescriptionLabelHandler{documentService: documents, log: log}
+}
+
+// Handler serves the label of a prescription as a PDF; ?lang=es prints the directions in Spanish
+func (h *PrescriptionLabelHandler) Handler(w http.ResponseWriter, r *http.Request) {
+	pathVars, _, err := bind.ChiPath[request.PrescriptionLabelPathVars](r, chi.URLParam)
+	if err != nil {
+		h.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.WriteUIError(w, "Invalid prescription", http.StatusBadRequest)
+		return
+	}
+	query, _, err := bind.Query[request.PrescriptionLabelQueryRequest](r)
+	if err != nil {
+		helper.WriteUIError(w, "Unsupported label language", http.StatusBadRequest)
+		return
+	}
+	language := prescriptionmodel.LanguageEnglish
+	if query.Lang != "" {
+		language = prescriptionmodel.Language(query.Lang)
+	}
+
+	doc, err := h.documentService.PrescriptionLabel(r.Context(), pathVars.PrescriptionID, language)
+	if err != nil {
+		documents.WriteError(w, h.log, err, "Prescription not found", "Failed to generate prescription label")
+		return
+	}
+	documents.WritePDF(w, h.log, doc, "rx-"+pathVars.PrescriptionID+"-label.pdf")
+}
@@ -0,0 +1,31 @@
+package documents
+
+import (
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	documentsecurity "pharmacy-modernization-project-model/domain/documents/security"
+	docSvc "pharmacy-modernization-project-model/domain/documents/service"
+	"pharmacy-modernization-project-model/domain/documents/ui/paths"
+	patientSummary "pharmacy-modernization-project-model/domain/documents/ui/patient_summary"
+	prescriptionLabel "pharmacy-modernization-project-model/domain/documents/ui/prescription_label"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+)
+
+type DocumentDependencies struct {
+	DocumentSvc docSvc.DocumentService
+	Log         *zap.Logger
+}
+
+func MountUI(r chi.Router, deps *DocumentDependencies) {
+	prescriptionLabelHandler := prescriptionLabel.NewPrescriptionLabelHandler(deps.DocumentSvc, deps.Log)
+	patientSummaryHandler := patientSummary.NewPatientSummaryHandler(deps.DocumentSvc, deps.Log)
+
+	r.Group(func(r chi.Router) {
+		// Documents require authentication like the pages they are printed from
+		r.Use(auth.RequireAuthWithDevMode())
+
+		r.With(auth.RequirePermissionsMatchAny(documentsecurity.LabelAccess)).Get(paths.PrescriptionLabelPath, prescriptionLabelHandler.Handler)
+		r.With(auth.RequirePermissionsMatchAll(documentsecurity.SummaryAccess)).Get(paths.PatientSummaryPath, patientSummaryHandler.Handler)
+	})
+}
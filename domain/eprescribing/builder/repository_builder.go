@@ -0,0 +1,29 @@
+package builder
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	transmissionrepo "pharmacy-modernization-project-model/domain/eprescribing/repository"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// CreateTransmissionRepository creates the appropriate transmission repository based on dependencies;
+// with MongoDB it creates the lookup indexes if missing
+func CreateTransmissionRepository(logger *zap.Logger, mongoCollection *mongo.Collection, retrier *database.Retrier) transmissionrepo.TransmissionRepository {
+	if mongoCollection != nil {
+		repo := transmissionrepo.NewTransmissionMongoRepository(mongoCollection, logger)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := repo.CreateIndexes(ctx); err != nil {
+			logger.Warn("Failed to create transmission indexes", zap.Error(err))
+		}
+		return transmissionrepo.NewTransmissionRetryRepository(repo, retrier)
+	}
+
+	return transmissionrepo.NewTransmissionMemoryRepository()
+}
@@ -0,0 +1,59 @@
+package model
+
+import "time"
+
+// Status is where a prescription transmission is in its exchange with the pharmacy
+type Status string
+
+const (
+	// Queued transmissions are waiting to be sent, or to be sent again after a failed attempt
+	Queued Status = "queued"
+	// Sent transmissions were received by IRIS and are waiting for the pharmacy to verify them
+	Sent Status = "sent"
+	// Verified transmissions were accepted by the pharmacy system
+	Verified Status = "verified"
+	// Rejected transmissions were refused by IRIS or the pharmacy; sending again won't help
+	Rejected Status = "rejected"
+	// Failed transmissions ran out of attempts
+	Failed Status = "failed"
+)
+
+// IsOpen reports whether the transmission is still being sent or awaiting verification
+func (s Status) IsOpen() bool {
+	return s == Queued || s == Sent
+}
+
+// PrescriptionTransmission is an NCPDP SCRIPT NewRx message sent to the prescription's pharmacy,
+// with every acknowledgment received for it
+type PrescriptionTransmission struct {
+	// ID is the SCRIPT message ID; every attempt sends the same message under it
+	ID             string `json:"id" bson:"_id"`
+	PrescriptionID string `json:"prescription_id" bson:"prescription_id"`
+	PatientID      string `json:"patient_id" bson:"patient_id"`
+	PharmacyID     string `json:"pharmacy_id" bson:"pharmacy_id"`
+	PharmacyName   string `json:"pharmacy_name" bson:"pharmacy_name"`
+	// Message is the NewRx XML as built when the transmission was requested
+	Message string `json:"message" bson:"message"`
+	Status  Status `json:"status" bson:"status"`
+
+	// Attempts counts the sends; NextAttemptAt is when a queued transmission is sent again, or a
+	// sent one polled for its status
+	Attempts      int        `json:"attempts" bson:"attempts"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty" bson:"next_attempt_at,omitempty"`
+	LastError     string     `json:"last_error,omitempty" bson:"last_error,omitempty"`
+
+	Acknowledgments []TransmissionAcknowledgment `json:"acknowledgments" bson:"acknowledgments"`
+
+	CreatedBy string    `json:"created_by,omitempty" bson:"created_by,omitempty"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" bson:"updated_at"`
+}
+
+// TransmissionAcknowledgment is a SCRIPT Status, Verify or Error message relating to the transmission
+type TransmissionAcknowledgment struct {
+	MessageID   string    `json:"message_id,omitempty" bson:"message_id,omitempty"`
+	Kind        string    `json:"kind" bson:"kind"` // Status, Verify or Error
+	Code        string    `json:"code" bson:"code"`
+	Description string    `json:"description,omitempty" bson:"description,omitempty"`
+	ReceivedAt  time.Time `json:"received_at" bson:"received_at"`
+}
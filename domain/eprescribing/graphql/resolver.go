@@ -0,0 +1,102 @@
+package graphql
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/eprescribing/contracts/model"
+	transmissionservice "pharmacy-modernization-project-model/domain/eprescribing/service"
+	"pharmacy-modernization-project-model/internal/graphql/generated"
+	"pharmacy-modernization-project-model/internal/graphql/validation"
+)
+
+// TransmissionResolver handles all e-prescribing GraphQL operations
+type TransmissionResolver struct {
+	TransmissionService transmissionservice.TransmissionService
+	Logger              *zap.Logger
+}
+
+// NewTransmissionResolver creates a new transmission resolver
+func NewTransmissionResolver(
+	transmissionSvc transmissionservice.TransmissionService,
+	logger *zap.Logger,
+) *TransmissionResolver {
+	return &TransmissionResolver{
+		TransmissionService: transmissionSvc,
+		Logger:              logger,
+	}
+}
+
+// ============================================================================
+// Query Resolvers
+// ============================================================================
+
+// PrescriptionTransmission resolves the prescriptionTransmission query
+func (r *TransmissionResolver) PrescriptionTransmission(ctx context.Context, id string, refresh *bool) (*model.PrescriptionTransmission, error) {
+	transmission, err := r.TransmissionService.GetByID(ctx, id, refresh != nil && *refresh)
+	if err != nil {
+		r.Logger.Error("Failed to get prescription transmission",
+			zap.String("transmission_id", id),
+			zap.Error(err))
+		return nil, err
+	}
+	return &transmission, nil
+}
+
+// PrescriptionTransmissions resolves the prescriptionTransmissions query
+func (r *TransmissionResolver) PrescriptionTransmissions(ctx context.Context, prescriptionID string) ([]model.PrescriptionTransmission, error) {
+	idValidation := validation.PrescriptionQueryValidation{ID: prescriptionID}
+	if _, validationErrors := validation.ValidateGraphQLInput(ctx, idValidation); validationErrors != nil {
+		return nil, validationErrors
+	}
+
+	transmissions, err := r.TransmissionService.ListForPrescription(ctx, prescriptionID)
+	if err != nil {
+		r.Logger.Error("Failed to list prescription transmissions",
+			zap.String("prescription_id", prescriptionID),
+			zap.Error(err))
+		return nil, err
+	}
+	return transmissions, nil
+}
+
+// ============================================================================
+// Mutation Resolvers
+// ============================================================================
+
+// TransmitPrescription resolves the transmitPrescription mutation
+func (r *TransmissionResolver) TransmitPrescription(ctx context.Context, prescriptionID string) (*generated.TransmitPrescriptionPayload, error) {
+	record, err := r.transmitPrescription(ctx, prescriptionID)
+	userErrors, err := validation.UserErrors(err)
+	if err != nil {
+		return nil, err
+	}
+	return &generated.TransmitPrescriptionPayload{Transmission: record, UserErrors: userErrors}, nil
+}
+
+func (r *TransmissionResolver) transmitPrescription(ctx context.Context, prescriptionID string) (*model.PrescriptionTransmission, error) {
+	idValidation := validation.PrescriptionQueryValidation{ID: prescriptionID}
+	if _, validationErrors := validation.ValidateGraphQLInput(ctx, idValidation); validationErrors != nil {
+		return nil, validationErrors
+	}
+
+	transmission, err := r.TransmissionService.Transmit(ctx, prescriptionID)
+	if err != nil {
+		r.Logger.Error("Failed to transmit prescription",
+			zap.String("prescription_id", prescriptionID),
+			zap.Error(err))
+		return nil, err
+	}
+	return &transmission, nil
+}
+
+// ============================================================================
+// Field Resolvers
+// ============================================================================
+
+// Status converts the domain status to the GraphQL enum
+func (r *TransmissionResolver) Status(ctx context.Context, obj *model.PrescriptionTransmission) (generated.TransmissionStatus, error) {
+	return generated.TransmissionStatus(strings.ToUpper(string(obj.Status))), nil
+}
@@ -0,0 +1,45 @@
+package eprescribing
+
+import (
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	transmissionbuilder "pharmacy-modernization-project-model/domain/eprescribing/builder"
+	eprescribingproviders "pharmacy-modernization-project-model/domain/eprescribing/providers"
+	transmissionservice "pharmacy-modernization-project-model/domain/eprescribing/service"
+	transmissionworker "pharmacy-modernization-project-model/domain/eprescribing/worker"
+	irispharmacy "pharmacy-modernization-project-model/internal/integrations/iris_pharmacy"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+type ModuleDependencies struct {
+	Logger                       *zap.Logger
+	PharmacyClient               irispharmacy.PharmacyClient
+	Patients                     eprescribingproviders.PatientProvider
+	Addresses                    eprescribingproviders.AddressProvider
+	Prescriptions                eprescribingproviders.PrescriptionProvider
+	Prescribers                  eprescribingproviders.PrescriberProvider
+	TransmissionsMongoCollection *mongo.Collection
+	Retrier                      *database.Retrier // Retries the reads and idempotent writes of the MongoDB repository; nil runs them once
+	Transmission                 transmissionservice.Config
+	Worker                       transmissionworker.TransmissionWorkerConfig
+}
+
+type ModuleExport struct {
+	TransmissionService transmissionservice.TransmissionService
+	TransmissionWorker  *transmissionworker.TransmissionWorker
+}
+
+// Module sends prescriptions to their pharmacy as NCPDP SCRIPT messages; it is served over
+// GraphQL only, so it mounts no routes
+func Module(deps *ModuleDependencies) ModuleExport {
+	transmissionRepo := transmissionbuilder.CreateTransmissionRepository(deps.Logger, deps.TransmissionsMongoCollection, deps.Retrier)
+
+	svc := transmissionservice.NewTransmissionService(transmissionRepo, deps.PharmacyClient,
+		deps.Patients, deps.Addresses, deps.Prescriptions, deps.Prescribers, deps.Transmission, deps.Logger)
+
+	return ModuleExport{
+		TransmissionService: svc,
+		TransmissionWorker:  transmissionworker.NewTransmissionWorker(svc, deps.Logger, deps.Worker),
+	}
+}
@@ -0,0 +1,24 @@
+package providers
+
+import (
+	"context"
+
+	patientmodel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	prescriptionmodel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+)
+
+type PatientProvider interface {
+	GetByID(ctx context.Context, id string) (patientmodel.Patient, error)
+}
+
+type AddressProvider interface {
+	GetByPatientID(ctx context.Context, patientID string) ([]patientmodel.Address, error)
+}
+
+type PrescriptionProvider interface {
+	GetByID(ctx context.Context, id string) (prescriptionmodel.Prescription, error)
+}
+
+type PrescriberProvider interface {
+	GetByID(ctx context.Context, id string) (prescriptionmodel.Prescriber, error)
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"pharmacy-modernization-project-model/domain/eprescribing/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+type transmissionMemoryRepository struct {
+	mu            sync.RWMutex
+	transmissions map[string]model.PrescriptionTransmission
+}
+
+func NewTransmissionMemoryRepository() TransmissionRepository {
+	return &transmissionMemoryRepository{transmissions: map[string]model.PrescriptionTransmission{}}
+}
+
+func (r *transmissionMemoryRepository) GetByID(_ context.Context, id string) (model.PrescriptionTransmission, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	transmission, ok := r.transmissions[id]
+	if !ok {
+		return model.PrescriptionTransmission{}, platformErrors.NewRecordNotFoundError("transmission", id)
+	}
+	return transmission, nil
+}
+
+func (r *transmissionMemoryRepository) ListByPrescriptionID(_ context.Context, prescriptionID string) ([]model.PrescriptionTransmission, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.PrescriptionTransmission{}
+	for _, transmission := range r.transmissions {
+		if transmission.PrescriptionID == prescriptionID {
+			out = append(out, transmission)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.After(out[j].CreatedAt) })
+	return out, nil
+}
+
+func (r *transmissionMemoryRepository) ListDue(_ context.Context, now time.Time, limit int) ([]model.PrescriptionTransmission, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.PrescriptionTransmission{}
+	for _, transmission := range r.transmissions {
+		if transmission.Status.IsOpen() && transmission.NextAttemptAt != nil && !transmission.NextAttemptAt.After(now) {
+			out = append(out, transmission)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].NextAttemptAt.Before(*out[j].NextAttemptAt) })
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (r *transmissionMemoryRepository) Create(_ context.Context, transmission model.PrescriptionTransmission) (model.PrescriptionTransmission, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.transmissions[transmission.ID]; exists {
+		return model.PrescriptionTransmission{}, platformErrors.NewDuplicateRecordError("transmission", transmission.ID)
+	}
+	r.transmissions[transmission.ID] = transmission
+	return transmission, nil
+}
+
+func (r *transmissionMemoryRepository) Update(_ context.Context, transmission model.PrescriptionTransmission, expectedUpdatedAt time.Time) (model.PrescriptionTransmission, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.transmissions[transmission.ID]
+	if !ok {
+		return model.PrescriptionTransmission{}, platformErrors.NewRecordNotFoundError("transmission", transmission.ID)
+	}
+	if !existing.UpdatedAt.Equal(expectedUpdatedAt) {
+		return model.PrescriptionTransmission{}, platformErrors.NewConflictError("transmission", transmission.ID, "transmission was updated by another request")
+	}
+	r.transmissions[transmission.ID] = transmission
+	return transmission, nil
+}
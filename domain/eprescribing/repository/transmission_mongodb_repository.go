@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/eprescribing/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/validators/validation_logic"
+)
+
+// TransmissionMongoRepository implements TransmissionRepository interface using MongoDB
+type TransmissionMongoRepository struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewTransmissionMongoRepository creates a new MongoDB transmission repository
+func NewTransmissionMongoRepository(collection *mongo.Collection, logger *zap.Logger) *TransmissionMongoRepository {
+	return &TransmissionMongoRepository{
+		collection: collection,
+		logger:     logger,
+	}
+}
+
+// handleError processes MongoDB errors and converts them to appropriate repository errors
+func (r *TransmissionMongoRepository) handleError(operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	r.logger.Error("MongoDB operation failed",
+		zap.String("operation", operation),
+		zap.Error(err))
+
+	return platformErrors.HandleMongoError(operation, err)
+}
+
+// GetByID retrieves a transmission by its message ID
+func (r *TransmissionMongoRepository) GetByID(ctx context.Context, id string) (model.PrescriptionTransmission, error) {
+	// Validate input to prevent NoSQL injection
+	if err := validation_logic.ValidateID("transmission_id", id); err != nil {
+		return model.PrescriptionTransmission{}, platformErrors.NewValidationError("transmission_id", id, "Invalid transmission ID format")
+	}
+
+	var transmission model.PrescriptionTransmission
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&transmission)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return model.PrescriptionTransmission{}, platformErrors.NewRecordNotFoundError("transmission", id)
+		}
+		return model.PrescriptionTransmission{}, r.handleError("GetByID", err)
+	}
+	return transmission, nil
+}
+
+// ListByPrescriptionID retrieves the transmissions of a prescription, newest first
+func (r *TransmissionMongoRepository) ListByPrescriptionID(ctx context.Context, prescriptionID string) ([]model.PrescriptionTransmission, error) {
+	if err := validation_logic.ValidateID("prescription_id", prescriptionID); err != nil {
+		return nil, platformErrors.NewValidationError("prescription_id", prescriptionID, "Invalid prescription ID format")
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"prescription_id": prescriptionID}, opts)
+	if err != nil {
+		return nil, r.handleError("ListByPrescriptionID", err)
+	}
+	defer cursor.Close(ctx)
+
+	transmissions := []model.PrescriptionTransmission{}
+	if err := cursor.All(ctx, &transmissions); err != nil {
+		return nil, r.handleError("ListByPrescriptionID", err)
+	}
+	return transmissions, nil
+}
+
+// ListDue retrieves open transmissions whose next attempt is due, oldest first
+func (r *TransmissionMongoRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]model.PrescriptionTransmission, error) {
+	filter := bson.M{
+		"status":          bson.M{"$in": []model.Status{model.Queued, model.Sent}},
+		"next_attempt_at": bson.M{"$lte": now},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "next_attempt_at", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, r.handleError("ListDue", err)
+	}
+	defer cursor.Close(ctx)
+
+	transmissions := []model.PrescriptionTransmission{}
+	if err := cursor.All(ctx, &transmissions); err != nil {
+		return nil, r.handleError("ListDue", err)
+	}
+	return transmissions, nil
+}
+
+// Create inserts a new transmission
+func (r *TransmissionMongoRepository) Create(ctx context.Context, transmission model.PrescriptionTransmission) (model.PrescriptionTransmission, error) {
+	if _, err := r.collection.InsertOne(ctx, transmission); err != nil {
+		return model.PrescriptionTransmission{}, r.handleError("Create", err)
+	}
+	return transmission, nil
+}
+
+// Update replaces a transmission if it was not updated since expectedUpdatedAt
+func (r *TransmissionMongoRepository) Update(ctx context.Context, transmission model.PrescriptionTransmission, expectedUpdatedAt time.Time) (model.PrescriptionTransmission, error) {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": transmission.ID, "updated_at": expectedUpdatedAt}, transmission)
+	if err != nil {
+		return model.PrescriptionTransmission{}, r.handleError("Update", err)
+	}
+	if result.MatchedCount == 0 {
+		return model.PrescriptionTransmission{}, platformErrors.NewConflictError("transmission", transmission.ID, "transmission was updated by another request")
+	}
+	return transmission, nil
+}
+
+// CreateIndexes creates the indexes used by ListByPrescriptionID and ListDue
+func (r *TransmissionMongoRepository) CreateIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "prescription_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("prescription_id_1_created_at_-1"),
+		},
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "next_attempt_at", Value: 1}},
+			Options: options.Index().SetName("status_1_next_attempt_at_1"),
+		},
+	})
+	if err != nil {
+		return r.handleError("CreateIndexes", err)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"pharmacy-modernization-project-model/domain/eprescribing/contracts/model"
+)
+
+type TransmissionRepository interface {
+	// GetByID returns a RecordNotFoundError when the transmission does not exist
+	GetByID(ctx context.Context, id string) (model.PrescriptionTransmission, error)
+	// ListByPrescriptionID returns the transmissions of a prescription, newest first
+	ListByPrescriptionID(ctx context.Context, prescriptionID string) ([]model.PrescriptionTransmission, error)
+	// ListDue returns open transmissions whose next attempt is at or before now, oldest first
+	ListDue(ctx context.Context, now time.Time, limit int) ([]model.PrescriptionTransmission, error)
+	Create(ctx context.Context, transmission model.PrescriptionTransmission) (model.PrescriptionTransmission, error)
+	// Update replaces a transmission only if it was not updated since expectedUpdatedAt, so the
+	// worker and a user refreshing the status can't both record the same exchange
+	Update(ctx context.Context, transmission model.PrescriptionTransmission, expectedUpdatedAt time.Time) (model.PrescriptionTransmission, error)
+}
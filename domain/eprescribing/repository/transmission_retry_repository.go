@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"pharmacy-modernization-project-model/domain/eprescribing/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// TransmissionRetryRepository retries the reads and idempotent writes of a transmission repository
+// that fail with a retryable error; Create and Update run once
+type TransmissionRetryRepository struct {
+	next    TransmissionRepository
+	retrier *database.Retrier
+}
+
+// NewTransmissionRetryRepository wraps next with retries; without a retrier next is returned as is
+func NewTransmissionRetryRepository(next TransmissionRepository, retrier *database.Retrier) TransmissionRepository {
+	if retrier == nil {
+		return next
+	}
+	return &TransmissionRetryRepository{next: next, retrier: retrier}
+}
+
+func (r *TransmissionRetryRepository) GetByID(ctx context.Context, id string) (model.PrescriptionTransmission, error) {
+	return database.Retry(ctx, r.retrier, "transmissions.GetByID", func(ctx context.Context) (model.PrescriptionTransmission, error) {
+		return r.next.GetByID(ctx, id)
+	})
+}
+
+func (r *TransmissionRetryRepository) ListByPrescriptionID(ctx context.Context, prescriptionID string) ([]model.PrescriptionTransmission, error) {
+	return database.Retry(ctx, r.retrier, "transmissions.ListByPrescriptionID", func(ctx context.Context) ([]model.PrescriptionTransmission, error) {
+		return r.next.ListByPrescriptionID(ctx, prescriptionID)
+	})
+}
+
+func (r *TransmissionRetryRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]model.PrescriptionTransmission, error) {
+	return database.Retry(ctx, r.retrier, "transmissions.ListDue", func(ctx context.Context) ([]model.PrescriptionTransmission, error) {
+		return r.next.ListDue(ctx, now, limit)
+	})
+}
+
+// Create runs once: a retried insert that reached the server would fail as a duplicate
+func (r *TransmissionRetryRepository) Create(ctx context.Context, transmission model.PrescriptionTransmission) (model.PrescriptionTransmission, error) {
+	return r.next.Create(ctx, transmission)
+}
+
+// Update runs once: it only applies while the transmission has the expected update time, which a retried call that reached the server no longer finds
+func (r *TransmissionRetryRepository) Update(ctx context.Context, transmission model.PrescriptionTransmission, expectedUpdatedAt time.Time) (model.PrescriptionTransmission, error) {
+	return r.next.Update(ctx, transmission, expectedUpdatedAt)
+}
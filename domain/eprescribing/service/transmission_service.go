@@ -0,0 +1,463 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/eprescribing/contracts/model"
+	"pharmacy-modernization-project-model/domain/eprescribing/providers"
+	transmissionrepo "pharmacy-modernization-project-model/domain/eprescribing/repository"
+	patientmodel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	prescriptionmodel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	irispharmacy "pharmacy-modernization-project-model/internal/integrations/iris_pharmacy"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// Config controls how transmissions are retried and their status polled
+type Config struct {
+	// MaxAttempts is how many times a message is sent before the transmission fails
+	MaxAttempts int
+	// RetryBackoff is the delay before the first resend; it doubles with every attempt
+	RetryBackoff time.Duration
+	// MaxBackoff caps the delay between resends
+	MaxBackoff time.Duration
+	// PollInterval is how often a sent message's status is asked for
+	PollInterval time.Duration
+	// VerifyTimeout is how long a transmission may wait for the pharmacy to verify it before it fails
+	VerifyTimeout time.Duration
+}
+
+func (c *Config) setDefaults() {
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = 5
+	}
+	if c.RetryBackoff <= 0 {
+		c.RetryBackoff = time.Minute
+	}
+	if c.MaxBackoff < c.RetryBackoff {
+		c.MaxBackoff = 30 * time.Minute
+	}
+	if c.PollInterval <= 0 {
+		c.PollInterval = time.Minute
+	}
+	if c.VerifyTimeout <= 0 {
+		c.VerifyTimeout = 24 * time.Hour
+	}
+}
+
+type TransmissionService interface {
+	// Transmit builds the NewRx of an active prescription routed to a pharmacy and sends it. A
+	// send that fails is kept queued and retried, so the transmission is returned either way.
+	Transmit(ctx context.Context, prescriptionID string) (model.PrescriptionTransmission, error)
+	// GetByID returns a transmission; with refresh, an open one is sent or polled right away
+	GetByID(ctx context.Context, id string, refresh bool) (model.PrescriptionTransmission, error)
+	// ListForPrescription returns the transmissions of a prescription, newest first
+	ListForPrescription(ctx context.Context, prescriptionID string) ([]model.PrescriptionTransmission, error)
+	// ProcessDue resends queued transmissions and polls sent ones whose next attempt is due,
+	// up to limit of them, and returns how many it processed
+	ProcessDue(ctx context.Context, limit int) (int, error)
+}
+
+type transmissionSvc struct {
+	repo          transmissionrepo.TransmissionRepository
+	pharmacy      irispharmacy.PharmacyClient
+	patients      providers.PatientProvider
+	addresses     providers.AddressProvider
+	prescriptions providers.PrescriptionProvider
+	prescribers   providers.PrescriberProvider
+	cfg           Config
+	log           *zap.Logger
+	now           func() time.Time
+}
+
+func NewTransmissionService(
+	repo transmissionrepo.TransmissionRepository,
+	pharmacy irispharmacy.PharmacyClient,
+	patients providers.PatientProvider,
+	addresses providers.AddressProvider,
+	prescriptions providers.PrescriptionProvider,
+	prescribers providers.PrescriberProvider,
+	cfg Config,
+	l *zap.Logger,
+) TransmissionService {
+	cfg.setDefaults()
+	return &transmissionSvc{
+		repo:          repo,
+		pharmacy:      pharmacy,
+		patients:      patients,
+		addresses:     addresses,
+		prescriptions: prescriptions,
+		prescribers:   prescribers,
+		cfg:           cfg,
+		log:           l,
+		// Stored times keep milliseconds, so updates can match the stored updated_at exactly
+		now: func() time.Time { return time.Now().UTC().Truncate(time.Millisecond) },
+	}
+}
+
+func (s *transmissionSvc) Transmit(ctx context.Context, prescriptionID string) (model.PrescriptionTransmission, error) {
+	prescription, err := s.prescriptions.GetByID(ctx, prescriptionID)
+	if err != nil {
+		return model.PrescriptionTransmission{}, err
+	}
+	if prescription.Status != prescriptionmodel.Active {
+		return model.PrescriptionTransmission{}, platformErrors.NewBusinessLogicError("transmit prescription",
+			"only active prescriptions are transmitted, this one is "+string(prescription.Status))
+	}
+	if prescription.Pharmacy == nil {
+		return model.PrescriptionTransmission{}, platformErrors.NewBusinessLogicError("transmit prescription",
+			"route the prescription to a pharmacy before transmitting it")
+	}
+
+	previous, err := s.repo.ListByPrescriptionID(ctx, prescriptionID)
+	if err != nil {
+		return model.PrescriptionTransmission{}, err
+	}
+	for _, t := range previous {
+		if t.PharmacyID != prescription.Pharmacy.ID {
+			continue
+		}
+		if t.Status.IsOpen() {
+			return model.PrescriptionTransmission{}, platformErrors.NewConflictError("prescription", prescriptionID,
+				"the prescription is already being transmitted to "+t.PharmacyName)
+		}
+		if t.Status == model.Verified {
+			return model.PrescriptionTransmission{}, platformErrors.NewConflictError("prescription", prescriptionID,
+				"the prescription was already transmitted to "+t.PharmacyName)
+		}
+	}
+
+	now := s.now()
+	rx, err := s.newRx(ctx, prescription, now)
+	if err != nil {
+		return model.PrescriptionTransmission{}, err
+	}
+	message, err := irispharmacy.BuildNewRx(rx)
+	if errors.Is(err, irispharmacy.ErrIncompleteNewRx) {
+		return model.PrescriptionTransmission{}, platformErrors.NewBusinessLogicError("transmit prescription", err.Error())
+	}
+	if err != nil {
+		return model.PrescriptionTransmission{}, err
+	}
+
+	transmission, err := s.repo.Create(ctx, model.PrescriptionTransmission{
+		ID:              rx.MessageID,
+		PrescriptionID:  prescription.ID,
+		PatientID:       prescription.PatientID,
+		PharmacyID:      prescription.Pharmacy.ID,
+		PharmacyName:    prescription.Pharmacy.Name,
+		Message:         string(message),
+		Status:          model.Queued,
+		NextAttemptAt:   &now,
+		Acknowledgments: []model.TransmissionAcknowledgment{},
+		CreatedBy:       actor(ctx),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	})
+	if err != nil {
+		return model.PrescriptionTransmission{}, err
+	}
+	s.log.Info("Prescription transmission queued",
+		zap.String("transmission_id", transmission.ID),
+		zap.String("prescription_id", transmission.PrescriptionID),
+		zap.String("pharmacy_id", transmission.PharmacyID))
+
+	return s.process(ctx, transmission)
+}
+
+// newRx gathers the patient, pharmacy, prescriber and medication of the message
+func (s *transmissionSvc) newRx(ctx context.Context, prescription prescriptionmodel.Prescription, now time.Time) (irispharmacy.NewRx, error) {
+	patient, err := s.patients.GetByID(ctx, prescription.PatientID)
+	if err != nil {
+		return irispharmacy.NewRx{}, err
+	}
+	if !patient.DOB.IsFull() {
+		return irispharmacy.NewRx{}, platformErrors.NewBusinessLogicError("transmit prescription",
+			"the patient's full date of birth is required to transmit a prescription")
+	}
+	addresses, err := s.addresses.GetByPatientID(ctx, patient.ID)
+	if err != nil {
+		return irispharmacy.NewRx{}, err
+	}
+	if prescription.PrescriberID == "" {
+		return irispharmacy.NewRx{}, platformErrors.NewBusinessLogicError("transmit prescription",
+			"the prescription has no prescriber, whose NPI a transmission requires")
+	}
+	prescriber, err := s.prescribers.GetByID(ctx, prescription.PrescriberID)
+	if err != nil {
+		return irispharmacy.NewRx{}, err
+	}
+
+	firstName, lastName := splitName(patient.Name)
+	rx := irispharmacy.NewRx{
+		MessageID:             uuid.NewString(),
+		SentAt:                now,
+		PrescriberOrderNumber: prescription.ID,
+		Patient: irispharmacy.ScriptPatient{
+			FirstName:   firstName,
+			LastName:    lastName,
+			DateOfBirth: patient.DOB.String(),
+			Phone:       patient.Phone,
+		},
+		Pharmacy: irispharmacy.ScriptPharmacy{
+			ID:    prescription.Pharmacy.ID,
+			Name:  prescription.Pharmacy.Name,
+			Phone: prescription.Pharmacy.Phone,
+			Address: irispharmacy.ScriptAddress{
+				Line1: prescription.Pharmacy.Address,
+				City:  prescription.Pharmacy.City,
+				State: prescription.Pharmacy.State,
+				Zip:   prescription.Pharmacy.Zip,
+			},
+		},
+		Prescriber: irispharmacy.ScriptPrescriber{
+			NPI:       prescriber.NPI,
+			FirstName: prescriber.FirstName,
+			LastName:  prescriber.LastName,
+			Phone:     prescriber.Phone,
+			Fax:       prescriber.Fax,
+		},
+		Medication: irispharmacy.ScriptMedication{
+			DrugDescription:     drugDescription(prescription),
+			Quantity:            prescription.Quantity,
+			DaysSupply:          prescription.DaysSupply,
+			WrittenDate:         prescription.CreatedAt,
+			SigText:             prescription.Sig.Render(prescriptionmodel.LanguageEnglish),
+			SubstitutionAllowed: true,
+		},
+	}
+	if rx.Medication.SigText == "" {
+		rx.Medication.SigText = prescription.Dose
+	}
+	if address := currentAddress(addresses); address != nil {
+		rx.Patient.Address = irispharmacy.ScriptAddress{
+			Line1: address.Line1,
+			Line2: address.Line2,
+			City:  address.City,
+			State: address.State,
+			Zip:   address.Zip,
+		}
+	}
+	return rx, nil
+}
+
+func (s *transmissionSvc) GetByID(ctx context.Context, id string, refresh bool) (model.PrescriptionTransmission, error) {
+	transmission, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return model.PrescriptionTransmission{}, err
+	}
+	// Transmissions are not scoped themselves; one of a prescription the caller may not see is not found
+	if _, err := s.prescriptions.GetByID(ctx, transmission.PrescriptionID); err != nil {
+		if platformErrors.IsNotFoundError(err) {
+			return model.PrescriptionTransmission{}, platformErrors.NewRecordNotFoundError("transmission", id)
+		}
+		return model.PrescriptionTransmission{}, err
+	}
+	if !refresh || !transmission.Status.IsOpen() {
+		return transmission, nil
+	}
+	return s.process(ctx, transmission)
+}
+
+func (s *transmissionSvc) ListForPrescription(ctx context.Context, prescriptionID string) ([]model.PrescriptionTransmission, error) {
+	if _, err := s.prescriptions.GetByID(ctx, prescriptionID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByPrescriptionID(ctx, prescriptionID)
+}
+
+func (s *transmissionSvc) ProcessDue(ctx context.Context, limit int) (int, error) {
+	due, err := s.repo.ListDue(ctx, s.now(), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	for _, transmission := range due {
+		if err := ctx.Err(); err != nil {
+			return processed, err
+		}
+		if _, err := s.process(ctx, transmission); err != nil {
+			// A conflict means a user refreshed it meanwhile; anything else is retried next time
+			var conflict platformErrors.ConflictError
+			if !errors.As(err, &conflict) {
+				s.log.Warn("Failed to process prescription transmission",
+					zap.String("transmission_id", transmission.ID),
+					zap.Error(err))
+			}
+			continue
+		}
+		processed++
+	}
+	return processed, nil
+}
+
+// process sends a queued transmission or polls a sent one, and stores the outcome
+func (s *transmissionSvc) process(ctx context.Context, transmission model.PrescriptionTransmission) (model.PrescriptionTransmission, error) {
+	expectedUpdatedAt := transmission.UpdatedAt
+	previous := transmission.Status
+	switch transmission.Status {
+	case model.Queued:
+		s.send(ctx, &transmission)
+	case model.Sent:
+		s.poll(ctx, &transmission)
+	default:
+		return transmission, nil
+	}
+
+	transmission.UpdatedAt = s.now()
+	updated, err := s.repo.Update(ctx, transmission, expectedUpdatedAt)
+	if err != nil {
+		return model.PrescriptionTransmission{}, err
+	}
+	if updated.Status != previous {
+		s.log.Info("Prescription transmission status changed",
+			zap.String("transmission_id", updated.ID),
+			zap.String("prescription_id", updated.PrescriptionID),
+			zap.String("from", string(previous)),
+			zap.String("to", string(updated.Status)),
+			zap.Int("attempts", updated.Attempts),
+			zap.String("last_error", updated.LastError))
+	}
+	return updated, nil
+}
+
+// send delivers the message to IRIS; the same message ID is sent on every attempt
+func (s *transmissionSvc) send(ctx context.Context, t *model.PrescriptionTransmission) {
+	t.Attempts++
+	resp, err := s.pharmacy.SendScriptMessage(ctx, t.ID, []byte(t.Message))
+	now := s.now()
+	if err != nil {
+		s.retry(t, err.Error(), now)
+		return
+	}
+	s.acknowledge(t, *resp, now)
+
+	switch resp.Kind {
+	case irispharmacy.ResponseVerify:
+		s.finish(t, model.Verified, "")
+	case irispharmacy.ResponseError:
+		if resp.Retryable() {
+			s.retry(t, scriptError(*resp), now)
+			return
+		}
+		s.finish(t, model.Rejected, scriptError(*resp))
+	default:
+		t.Status, t.LastError = model.Sent, ""
+		next := now.Add(s.cfg.PollInterval)
+		t.NextAttemptAt = &next
+	}
+}
+
+// poll asks IRIS whether the pharmacy verified a sent message
+func (s *transmissionSvc) poll(ctx context.Context, t *model.PrescriptionTransmission) {
+	resp, err := s.pharmacy.GetMessageStatus(ctx, t.ID)
+	now := s.now()
+	if err == nil {
+		s.acknowledge(t, *resp, now)
+		switch {
+		case resp.Kind == irispharmacy.ResponseVerify:
+			s.finish(t, model.Verified, "")
+			return
+		case resp.Kind == irispharmacy.ResponseError && !resp.Retryable():
+			s.finish(t, model.Rejected, scriptError(*resp))
+			return
+		case resp.Kind == irispharmacy.ResponseError:
+			t.LastError = scriptError(*resp)
+		}
+	} else {
+		t.LastError = err.Error()
+	}
+
+	if now.Sub(t.CreatedAt) >= s.cfg.VerifyTimeout {
+		s.finish(t, model.Failed, "the pharmacy did not verify the prescription within "+s.cfg.VerifyTimeout.String())
+		return
+	}
+	next := now.Add(s.cfg.PollInterval)
+	t.NextAttemptAt = &next
+}
+
+// retry queues the transmission again after a backoff, or fails it once out of attempts
+func (s *transmissionSvc) retry(t *model.PrescriptionTransmission, reason string, now time.Time) {
+	if t.Attempts >= s.cfg.MaxAttempts {
+		s.finish(t, model.Failed, reason)
+		return
+	}
+	backoff := s.cfg.RetryBackoff << (t.Attempts - 1)
+	if backoff <= 0 || backoff > s.cfg.MaxBackoff {
+		backoff = s.cfg.MaxBackoff
+	}
+	next := now.Add(backoff)
+	t.Status, t.LastError, t.NextAttemptAt = model.Queued, reason, &next
+}
+
+func (s *transmissionSvc) finish(t *model.PrescriptionTransmission, status model.Status, reason string) {
+	t.Status, t.LastError, t.NextAttemptAt = status, reason, nil
+}
+
+// acknowledge records a response, unless it repeats the last one as polls do while nothing changes
+func (s *transmissionSvc) acknowledge(t *model.PrescriptionTransmission, resp irispharmacy.ScriptResponse, now time.Time) {
+	if n := len(t.Acknowledgments); n > 0 {
+		last := t.Acknowledgments[n-1]
+		if last.Kind == string(resp.Kind) && last.Code == resp.Code {
+			return
+		}
+	}
+	t.Acknowledgments = append(t.Acknowledgments, model.TransmissionAcknowledgment{
+		MessageID:   resp.MessageID,
+		Kind:        string(resp.Kind),
+		Code:        resp.Code,
+		Description: resp.Description,
+		ReceivedAt:  now,
+	})
+}
+
+func scriptError(resp irispharmacy.ScriptResponse) string {
+	reason := "SCRIPT error " + resp.Code
+	if resp.Description != "" {
+		reason += ": " + resp.Description
+	}
+	return reason
+}
+
+// splitName splits a full name at its last space, as the domain keeps names in one field
+func splitName(name string) (first, last string) {
+	name = strings.TrimSpace(name)
+	if i := strings.LastIndex(name, " "); i > 0 {
+		return strings.TrimSpace(name[:i]), name[i+1:]
+	}
+	return "", name
+}
+
+// drugDescription is the drug with its strength when the dose is structured, e.g. "Lisinopril 10 mg"
+func drugDescription(p prescriptionmodel.Prescription) string {
+	if p.Dosage != nil && p.Dosage.Value > 0 {
+		return p.Drug + " " + p.Dosage.Amount()
+	}
+	return p.Drug
+}
+
+// currentAddress is the first address on file; nil when there is none
+func currentAddress(addresses []patientmodel.Address) *patientmodel.Address {
+	if len(addresses) == 0 {
+		return nil
+	}
+	return &addresses[0]
+}
+
+func actor(ctx context.Context) string {
+	user, err := auth.GetCurrentUser(ctx)
+	if err != nil {
+		return ""
+	}
+	if user.Email != "" {
+		return user.Email
+	}
+	return user.ID
+}
@@ -0,0 +1,66 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	transmissionservice "pharmacy-modernization-project-model/domain/eprescribing/service"
+)
+
+// TransmissionWorkerConfig controls how often due transmissions are processed
+type TransmissionWorkerConfig struct {
+	// Interval is how often the worker wakes up to look for due transmissions
+	Interval time.Duration
+	// BatchSize limits how many transmissions are processed per tick
+	BatchSize int
+}
+
+func (c *TransmissionWorkerConfig) setDefaults() {
+	if c.Interval <= 0 {
+		c.Interval = 30 * time.Second
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = 100
+	}
+}
+
+// TransmissionWorker resends queued prescription transmissions and polls the status of sent
+// ones; the backoff of each transmission is kept on the transmission itself
+type TransmissionWorker struct {
+	svc transmissionservice.TransmissionService
+	log *zap.Logger
+	cfg TransmissionWorkerConfig
+}
+
+// NewTransmissionWorker creates a worker; zero config values fall back to defaults
+func NewTransmissionWorker(svc transmissionservice.TransmissionService, log *zap.Logger, cfg TransmissionWorkerConfig) *TransmissionWorker {
+	cfg.setDefaults()
+	if log == nil {
+		log = zap.NewNop()
+	}
+	return &TransmissionWorker{svc: svc, log: log, cfg: cfg}
+}
+
+// Run processes due transmissions until ctx is cancelled
+func (w *TransmissionWorker) Run(ctx context.Context) {
+	w.log.Info("Transmission worker started",
+		zap.Duration("interval", w.cfg.Interval),
+		zap.Int("batch_size", w.cfg.BatchSize))
+
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := w.svc.ProcessDue(ctx, w.cfg.BatchSize); err != nil && ctx.Err() == nil {
+			w.log.Error("Failed to process due transmissions", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			w.log.Info("Transmission worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -10,19 +10,45 @@ import (
 )
 
 type Dependencies struct {
-	PatientService service.PatientService
-	AddressService service.AddressService
-	Logger         *zap.Logger
+	PatientService     service.PatientService
+	AddressService     service.AddressService
+	MeasurementService service.MeasurementService
+	AllergyService     service.AllergyService
+	SearchService      service.PatientSearchService
+	ExportService      service.PatientExportService
+	InsuranceService   service.InsuranceService
+	DocumentService    service.DocumentService
+	Logger             *zap.Logger
 }
 
 func MountAPI(r chi.Router, deps *Dependencies) {
 	patientController := controllers.NewPatientController(deps.PatientService, deps.Logger)
 	addressController := controllers.NewAddressController(deps.AddressService, deps.Logger)
+	measurementController := controllers.NewMeasurementController(deps.MeasurementService, deps.Logger)
+	allergyController := controllers.NewAllergyController(deps.AllergyService, deps.Logger)
+	searchController := controllers.NewPatientSearchController(deps.SearchService, deps.Logger)
+	exportController := controllers.NewPatientExportController(deps.ExportService, deps.Logger)
+	insuranceController := controllers.NewInsuranceController(deps.InsuranceService, deps.Logger)
+	documentController := controllers.NewDocumentController(deps.DocumentService, deps.Logger)
 
 	r.Route(paths.APIPath, func(router chi.Router) {
 		patientController.RegisterRoutes(router)
+		searchController.RegisterRoutes(router)
+		exportController.RegisterRoutes(router)
 		router.Route(paths.AddressSubRoute, func(addressRouter chi.Router) {
 			addressController.RegisterRoutes(addressRouter)
 		})
+		router.Route(paths.MeasurementSubRoute, func(measurementRouter chi.Router) {
+			measurementController.RegisterRoutes(measurementRouter)
+		})
+		router.Route(paths.AllergySubRoute, func(allergyRouter chi.Router) {
+			allergyController.RegisterRoutes(allergyRouter)
+		})
+		router.Route(paths.InsuranceSubRoute, func(insuranceRouter chi.Router) {
+			insuranceController.RegisterRoutes(insuranceRouter)
+		})
+		router.Route(paths.DocumentSubRoute, func(documentRouter chi.Router) {
+			documentController.RegisterRoutes(documentRouter)
+		})
 	})
 }
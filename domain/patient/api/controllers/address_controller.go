@@ -8,6 +8,7 @@ import (
 	"go.uber.org/zap"
 
 	addressRequest "pharmacy-modernization-project-model/domain/patient/contracts/request"
+	"pharmacy-modernization-project-model/domain/patient/contracts/response"
 	patientsecurity "pharmacy-modernization-project-model/domain/patient/security"
 	service "pharmacy-modernization-project-model/domain/patient/service"
 	"pharmacy-modernization-project-model/internal/bind"
@@ -50,7 +51,7 @@ func (c *AddressController) ListByPatient(w http.ResponseWriter, r *http.Request
 		helper.WriteInternalError(w, "failed to load addresses")
 		return
 	}
-	helper.WriteOK(w, addr)
+	helper.WriteOK(w, response.FromAddresses(addr))
 }
 
 func (c *AddressController) GetByID(w http.ResponseWriter, r *http.Request) {
@@ -68,7 +69,7 @@ func (c *AddressController) GetByID(w http.ResponseWriter, r *http.Request) {
 		helper.WriteInternalError(w, "failed to load address")
 		return
 	}
-	helper.WriteOK(w, address)
+	helper.WriteOK(w, response.FromAddress(address))
 }
 
 func (c *AddressController) Create(w http.ResponseWriter, r *http.Request) {
@@ -102,5 +103,5 @@ func (c *AddressController) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	helper.WriteCreated(w, created)
+	helper.WriteCreated(w, response.FromAddress(created))
 }
@@ -0,0 +1,141 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	patientRequest "pharmacy-modernization-project-model/domain/patient/contracts/request"
+	"pharmacy-modernization-project-model/domain/patient/contracts/response"
+	patientsecurity "pharmacy-modernization-project-model/domain/patient/security"
+	service "pharmacy-modernization-project-model/domain/patient/service"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+)
+
+type AllergyController struct {
+	allergyService service.AllergyService
+	log            *zap.Logger
+}
+
+func NewAllergyController(allergies service.AllergyService, log *zap.Logger) *AllergyController {
+	return &AllergyController{allergyService: allergies, log: log}
+}
+
+func (c *AllergyController) RegisterRoutes(r chi.Router) {
+	// Allergy routes inherit auth from parent but add specific permissions
+
+	// Read operations - requires patient:read or admin:all
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.ReadAccess)).Get("/", c.List)
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.ReadAccess)).Get("/{allergyID}", c.GetByID)
+
+	// Write operations - requires patient:write or admin:all
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.WriteAccess)).Post("/", c.Create)
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.WriteAccess)).Put("/{allergyID}", c.Update)
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.WriteAccess)).Delete("/{allergyID}", c.Delete)
+}
+
+func (c *AllergyController) List(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.PatientPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	allergies, err := c.allergyService.List(r.Context(), pathVars.PatientID)
+	if err != nil {
+		c.log.Error("list allergies", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, response.FromAllergies(allergies))
+}
+
+func (c *AllergyController) GetByID(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.AllergyPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	allergy, err := c.allergyService.GetByID(r.Context(), pathVars.PatientID, pathVars.AllergyID)
+	if err != nil {
+		c.log.Error("get allergy", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	if allergy.ID == "" {
+		helper.WriteNotFound(w, "allergy not found")
+		return
+	}
+	helper.WriteOK(w, response.FromAllergy(allergy))
+}
+
+func (c *AllergyController) Create(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.PatientPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	req, fieldErrors, err := bind.JSON[patientRequest.AllergyCreateRequest](r)
+	if err != nil {
+		c.log.Warn("invalid allergy payload", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	created, err := c.allergyService.Create(r.Context(), pathVars.PatientID, recordedBy(r), req)
+	if err != nil {
+		c.log.Error("create allergy", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteCreated(w, response.FromAllergy(created))
+}
+
+func (c *AllergyController) Update(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.AllergyPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	req, fieldErrors, err := bind.JSON[patientRequest.AllergyUpdateRequest](r)
+	if err != nil {
+		c.log.Warn("invalid allergy payload", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	updated, err := c.allergyService.Update(r.Context(), pathVars.PatientID, pathVars.AllergyID, recordedBy(r), req)
+	if err != nil {
+		c.log.Error("update allergy", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, response.FromAllergy(updated))
+}
+
+func (c *AllergyController) Delete(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.AllergyPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	if err := c.allergyService.Delete(r.Context(), pathVars.PatientID, pathVars.AllergyID); err != nil {
+		c.log.Error("delete allergy", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteNoContent(w)
+}
@@ -0,0 +1,134 @@
+package controllers
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	patientRequest "pharmacy-modernization-project-model/domain/patient/contracts/request"
+	"pharmacy-modernization-project-model/domain/patient/contracts/response"
+	patientsecurity "pharmacy-modernization-project-model/domain/patient/security"
+	service "pharmacy-modernization-project-model/domain/patient/service"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+)
+
+// documentFormField is the multipart field holding the uploaded file
+const documentFormField = "file"
+
+type DocumentController struct {
+	documentService service.DocumentService
+	log             *zap.Logger
+}
+
+func NewDocumentController(documents service.DocumentService, log *zap.Logger) *DocumentController {
+	return &DocumentController{documentService: documents, log: log}
+}
+
+func (c *DocumentController) RegisterRoutes(r chi.Router) {
+	// Document routes inherit auth from parent but add specific permissions
+
+	// Read operations - requires patient:read or admin:all
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.ReadAccess)).Get("/", c.List)
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.ReadAccess)).Get("/{documentID}/download", c.Download)
+
+	// Write operations - requires patient:write or admin:all
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.WriteAccess)).Post("/", c.Upload)
+}
+
+func (c *DocumentController) List(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.PatientPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	documents, err := c.documentService.List(r.Context(), pathVars.PatientID)
+	if err != nil {
+		c.log.Error("list patient documents", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, response.FromDocuments(documents))
+}
+
+// Upload stores the file sent as the "file" field of a multipart/form-data body
+func (c *DocumentController) Upload(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.PatientPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	part, err := filePart(r)
+	if err != nil {
+		c.log.Warn("invalid document upload", zap.Error(err))
+		helper.Respond400(w, []bind.FieldError{{Field: documentFormField, Tag: "required", Message: "send the file as the \"file\" field of a multipart/form-data body"}})
+		return
+	}
+	defer part.Close()
+
+	document, err := c.documentService.Upload(r.Context(), pathVars.PatientID, recordedBy(r), part.FileName(), part)
+	if err != nil {
+		c.log.Error("upload patient document", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteCreated(w, response.FromDocument(document))
+}
+
+// Download returns the stored file as an attachment
+func (c *DocumentController) Download(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.DocumentPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	document, content, err := c.documentService.Download(r.Context(), pathVars.PatientID, pathVars.DocumentID)
+	if err != nil {
+		c.log.Error("download patient document", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", document.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": document.Name}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("X-Content-SHA256", document.SHA256)
+	w.Header().Set("Cache-Control", "private, no-store")
+	_, _ = w.Write(content)
+}
+
+// filePart returns the first file of the upload field, streamed from the request body
+func filePart(r *http.Request) (*multipart.Part, error) {
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("no file in the upload")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if part.FormName() == documentFormField && part.FileName() != "" {
+			return part, nil
+		}
+		part.Close()
+	}
+}
@@ -0,0 +1,221 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	patientRequest "pharmacy-modernization-project-model/domain/patient/contracts/request"
+	"pharmacy-modernization-project-model/domain/patient/contracts/response"
+	patientsecurity "pharmacy-modernization-project-model/domain/patient/security"
+	service "pharmacy-modernization-project-model/domain/patient/service"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+)
+
+type InsuranceController struct {
+	insuranceService service.InsuranceService
+	log              *zap.Logger
+}
+
+func NewInsuranceController(insurance service.InsuranceService, log *zap.Logger) *InsuranceController {
+	return &InsuranceController{insuranceService: insurance, log: log}
+}
+
+func (c *InsuranceController) RegisterRoutes(r chi.Router) {
+	// Insurance routes inherit auth from parent but add specific permissions
+
+	// Read operations - requires patient:read or admin:all
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.ReadAccess)).Get("/", c.List)
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.ReadAccess)).Get("/{insuranceID}", c.GetByID)
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.ReadAccess)).Get("/{insuranceID}/card/{side}", c.CardImage)
+
+	// Write operations - requires patient:write or admin:all
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.WriteAccess)).Post("/", c.Create)
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.WriteAccess)).Put("/{insuranceID}", c.Update)
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.WriteAccess)).Post("/intake", c.Intake)
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.WriteAccess)).Post("/{insuranceID}/confirm", c.Confirm)
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.WriteAccess)).Post("/{insuranceID}/reject", c.Reject)
+}
+
+func (c *InsuranceController) List(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.PatientPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	records, err := c.insuranceService.List(r.Context(), pathVars.PatientID)
+	if err != nil {
+		c.log.Error("list insurance records", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, response.FromInsuranceRecords(records))
+}
+
+func (c *InsuranceController) GetByID(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.InsurancePathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	record, err := c.insuranceService.GetByID(r.Context(), pathVars.PatientID, pathVars.InsuranceID)
+	if err != nil {
+		c.log.Error("get insurance record", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, response.FromInsurance(record))
+}
+
+// Create enters a confirmed insurance record by hand
+func (c *InsuranceController) Create(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.PatientPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	req, fieldErrors, err := bind.JSON[patientRequest.InsuranceCreateRequest](r)
+	if err != nil {
+		c.log.Warn("invalid insurance payload", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	record, err := c.insuranceService.Create(r.Context(), pathVars.PatientID, recordedBy(r), req)
+	if err != nil {
+		c.log.Error("create insurance record", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteCreated(w, response.FromInsurance(record))
+}
+
+func (c *InsuranceController) Update(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.InsurancePathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	req, fieldErrors, err := bind.JSON[patientRequest.InsuranceUpdateRequest](r)
+	if err != nil {
+		c.log.Warn("invalid insurance update payload", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	record, err := c.insuranceService.Update(r.Context(), pathVars.PatientID, pathVars.InsuranceID, req)
+	if err != nil {
+		c.log.Error("update insurance record", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, response.FromInsurance(record))
+}
+
+// Intake stores photos of an insurance card and returns the record pre-filled by OCR
+func (c *InsuranceController) Intake(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.PatientPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	req, fieldErrors, err := bind.JSON[patientRequest.InsuranceCardIntakeRequest](r)
+	if err != nil {
+		c.log.Warn("invalid insurance card payload", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	record, err := c.insuranceService.IntakeCard(r.Context(), pathVars.PatientID, recordedBy(r), req)
+	if err != nil {
+		c.log.Error("insurance card intake", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteCreated(w, response.FromInsurance(record))
+}
+
+func (c *InsuranceController) Confirm(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.InsurancePathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	req, fieldErrors, err := bind.JSON[patientRequest.InsuranceConfirmRequest](r)
+	if err != nil {
+		c.log.Warn("invalid insurance confirm payload", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	record, err := c.insuranceService.Confirm(r.Context(), pathVars.PatientID, pathVars.InsuranceID, recordedBy(r), req)
+	if err != nil {
+		c.log.Error("confirm insurance record", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, response.FromInsurance(record))
+}
+
+func (c *InsuranceController) Reject(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.InsurancePathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	req, fieldErrors, err := bind.JSON[patientRequest.InsuranceRejectRequest](r)
+	if err != nil {
+		c.log.Warn("invalid insurance reject payload", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	record, err := c.insuranceService.Reject(r.Context(), pathVars.PatientID, pathVars.InsuranceID, recordedBy(r), req)
+	if err != nil {
+		c.log.Error("reject insurance record", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, response.FromInsurance(record))
+}
+
+// CardImage returns the stored photo of the front or back of the card
+func (c *InsuranceController) CardImage(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.InsuranceCardPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	attachment, content, err := c.insuranceService.CardImage(r.Context(), pathVars.PatientID, pathVars.InsuranceID, pathVars.Side)
+	if err != nil {
+		c.log.Error("get insurance card image", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("X-Content-SHA256", attachment.SHA256)
+	w.Header().Set("Cache-Control", "private, no-store")
+	_, _ = w.Write(content)
+}
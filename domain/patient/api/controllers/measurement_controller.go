@@ -0,0 +1,183 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	patientRequest "pharmacy-modernization-project-model/domain/patient/contracts/request"
+	"pharmacy-modernization-project-model/domain/patient/contracts/response"
+	patientsecurity "pharmacy-modernization-project-model/domain/patient/security"
+	service "pharmacy-modernization-project-model/domain/patient/service"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+)
+
+type MeasurementController struct {
+	measurementService service.MeasurementService
+	log                *zap.Logger
+}
+
+func NewMeasurementController(measurements service.MeasurementService, log *zap.Logger) *MeasurementController {
+	return &MeasurementController{measurementService: measurements, log: log}
+}
+
+func (c *MeasurementController) RegisterRoutes(r chi.Router) {
+	// Measurement routes inherit auth from parent but add specific permissions
+
+	// Read operations - requires patient:read or admin:all
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.ReadAccess)).Get("/", c.List)
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.ReadAccess)).Get("/latest/{type}", c.Latest)
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.ReadAccess)).Get("/{measurementID}", c.GetByID)
+
+	// Write operations - requires patient:write or admin:all
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.WriteAccess)).Post("/", c.Create)
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.WriteAccess)).Put("/{measurementID}", c.Update)
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.WriteAccess)).Delete("/{measurementID}", c.Delete)
+}
+
+func (c *MeasurementController) List(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.PatientPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	query, fieldErrors, err := bind.Query[patientRequest.MeasurementListQueryRequest](r)
+	if err != nil {
+		c.log.Error("failed to bind query parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	measurements, err := c.measurementService.List(r.Context(), pathVars.PatientID, patientModel.MeasurementType(query.Type), query.Limit)
+	if err != nil {
+		c.log.Error("list measurements", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, response.FromMeasurements(measurements))
+}
+
+func (c *MeasurementController) Latest(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.MeasurementTypePathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	latest, err := c.measurementService.Latest(r.Context(), pathVars.PatientID, patientModel.MeasurementType(pathVars.Type))
+	if err != nil {
+		c.log.Error("get latest measurement", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	if latest.ID == "" {
+		helper.WriteNotFound(w, "no measurement recorded")
+		return
+	}
+	helper.WriteOK(w, response.FromMeasurement(latest))
+}
+
+func (c *MeasurementController) GetByID(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.MeasurementPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	measurement, err := c.measurementService.GetByID(r.Context(), pathVars.PatientID, pathVars.MeasurementID)
+	if err != nil {
+		c.log.Error("get measurement", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	if measurement.ID == "" {
+		helper.WriteNotFound(w, "measurement not found")
+		return
+	}
+	helper.WriteOK(w, response.FromMeasurement(measurement))
+}
+
+func (c *MeasurementController) Create(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.PatientPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	req, fieldErrors, err := bind.JSON[patientRequest.MeasurementCreateRequest](r)
+	if err != nil {
+		c.log.Warn("invalid measurement payload", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	created, err := c.measurementService.Create(r.Context(), pathVars.PatientID, recordedBy(r), req)
+	if err != nil {
+		c.log.Error("create measurement", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteCreated(w, response.FromMeasurement(created))
+}
+
+func (c *MeasurementController) Update(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.MeasurementPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	req, fieldErrors, err := bind.JSON[patientRequest.MeasurementUpdateRequest](r)
+	if err != nil {
+		c.log.Warn("invalid measurement payload", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	updated, err := c.measurementService.Update(r.Context(), pathVars.PatientID, pathVars.MeasurementID, recordedBy(r), req)
+	if err != nil {
+		c.log.Error("update measurement", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, response.FromMeasurement(updated))
+}
+
+func (c *MeasurementController) Delete(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.MeasurementPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	if err := c.measurementService.Delete(r.Context(), pathVars.PatientID, pathVars.MeasurementID); err != nil {
+		c.log.Error("delete measurement", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteNoContent(w)
+}
+
+// recordedBy identifies the authenticated user recording a measurement
+func recordedBy(r *http.Request) string {
+	user, err := auth.GetCurrentUser(r.Context())
+	if err != nil {
+		return ""
+	}
+	if user.Email != "" {
+		return user.Email
+	}
+	return user.ID
+}
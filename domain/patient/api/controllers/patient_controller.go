@@ -1,17 +1,22 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
 
+	model "pharmacy-modernization-project-model/domain/patient/contracts/model"
 	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+	"pharmacy-modernization-project-model/domain/patient/contracts/response"
+	patientErrors "pharmacy-modernization-project-model/domain/patient/errors"
 	patientsecurity "pharmacy-modernization-project-model/domain/patient/security"
 	service "pharmacy-modernization-project-model/domain/patient/service"
 	"pharmacy-modernization-project-model/internal/bind"
 	helper "pharmacy-modernization-project-model/internal/helper"
 	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/dates"
 	"pharmacy-modernization-project-model/internal/platform/httpx"
 )
 
@@ -31,6 +36,9 @@ func (c *PatientController) RegisterRoutes(r chi.Router) {
 	// Read operations - requires patient:read or admin:all
 	r.With(auth.RequirePermissionsMatchAny(patientsecurity.ReadAccess)).Get("/", c.List)
 	r.With(auth.RequirePermissionsMatchAny(patientsecurity.ReadAccess)).Get("/{patientID}", c.GetByID)
+
+	// Write operations - requires patient:write or admin:all
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.WriteAccess)).Post("/", c.Create)
 }
 
 func (c *PatientController) List(w http.ResponseWriter, r *http.Request) {
@@ -47,14 +55,22 @@ func (c *PatientController) List(w http.ResponseWriter, r *http.Request) {
 		req.Limit = 20
 	}
 
+	// Limit results to the caller's data-access scope
+	user, _ := auth.GetCurrentUser(r.Context())
+	req.AllowedStates = patientsecurity.AllowedStates(user)
+
 	items, err := c.patientService.List(r.Context(), req)
 	if err != nil {
 		c.log.Error("list patients", zap.Error(err))
 		helper.WriteInternalError(w, "failed to list patients")
 		return
 	}
+	// The counts are a convenience; without them the patients are still listed
+	if err := c.patientService.AttachPrescriptionCounts(r.Context(), items); err != nil {
+		c.log.Warn("count prescriptions of patients", zap.Error(err))
+	}
 
-	helper.WriteOK(w, items)
+	helper.WriteOK(w, response.FromModels(items))
 }
 
 func (c *PatientController) GetByID(w http.ResponseWriter, r *http.Request) {
@@ -73,11 +89,48 @@ func (c *PatientController) GetByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	helper.WriteOK(w, item)
+	helper.WriteOK(w, response.FromModel(item))
+}
+
+// Create saves a new patient; the response lists the existing patients it likely duplicates
+func (c *PatientController) Create(w http.ResponseWriter, r *http.Request) {
+	// Bind and validate JSON body
+	req, fieldErrors, err := bind.JSON[request.PatientCreateRequest](r)
+	if err != nil {
+		c.log.Warn("invalid patient payload", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+	dob, _ := dates.Parse(req.DOB) // Checked by the dob validation
+
+	created, err := c.patientService.Create(r.Context(), model.Patient{
+		Name:  req.Name,
+		DOB:   dob,
+		Phone: req.Phone,
+		State: req.State,
+	})
+	if err != nil {
+		c.log.Error("create patient", zap.Error(err))
+		c.handleError(w, r, err)
+		return
+	}
+
+	helper.WriteCreated(w, response.FromModel(created))
 }
 
-// handleError handles different types of errors and returns appropriate HTTP responses
+// handleError handles different types of errors and returns appropriate HTTP responses,
+// returning the likely duplicates when creating a patient is blocked
 func (c *PatientController) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	var duplicateErr patientErrors.DuplicatePatientError
+	if errors.As(err, &duplicateErr) {
+		helper.WriteError(w, http.StatusUnprocessableEntity, helper.APIError{
+			Code:    "duplicate_patient",
+			Message: duplicateErr.Error(),
+			Details: response.FromPotentialDuplicates(duplicateErr.Duplicates),
+		})
+		return
+	}
+
 	// Use the shared error handler
 	httpx.WriteError(w, r, err)
 }
@@ -0,0 +1,161 @@
+package controllers
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	patientRequest "pharmacy-modernization-project-model/domain/patient/contracts/request"
+	patientsecurity "pharmacy-modernization-project-model/domain/patient/security"
+	service "pharmacy-modernization-project-model/domain/patient/service"
+	"pharmacy-modernization-project-model/domain/patient/ui/paths"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+	"pharmacy-modernization-project-model/internal/platform/tabular"
+)
+
+type PatientExportController struct {
+	exportService service.PatientExportService
+	log           *zap.Logger
+}
+
+func NewPatientExportController(exports service.PatientExportService, log *zap.Logger) *PatientExportController {
+	return &PatientExportController{exportService: exports, log: log}
+}
+
+func (c *PatientExportController) RegisterRoutes(r chi.Router) {
+	// Export routes inherit auth from the patient routes; requires patient:read AND patient:export
+	r.With(auth.RequirePermissionsMatchAll(patientsecurity.ExportAccess)).Get(paths.ExportRoute, c.Export)
+	r.With(auth.RequirePermissionsMatchAll(patientsecurity.ExportAccess)).Get(paths.ExportJobRoute, c.GetJob)
+	r.With(auth.RequirePermissionsMatchAll(patientsecurity.ExportAccess)).Get(paths.ExportJobDownloadRoute, c.Download)
+}
+
+// Export streams the file, or with async=true starts a background export and returns 202
+func (c *PatientExportController) Export(w http.ResponseWriter, r *http.Request) {
+	query, fieldErrors, err := bind.Query[patientRequest.PatientExportQueryRequest](r)
+	if err != nil {
+		c.log.Error("failed to bind query parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	if query.Async {
+		job, err := c.exportService.StartJob(r.Context(), query)
+		if err != nil {
+			c.log.Error("start patient export", zap.Error(err))
+			httpx.WriteError(w, r, err)
+			return
+		}
+		w.Header().Set("Location", paths.PatientExportJobURL(job.ID))
+		helper.WriteAccepted(w, withDownloadURL(job))
+		return
+	}
+
+	// Headers are only sent once the first row is written, so a failure before that (e.g. an
+	// export too large to stream) is still reported as a regular error response
+	out := &lazyHeaderWriter{w: w, setHeaders: func() {
+		setExportHeaders(w, query.ExportFormat(), query.Gzip, exportFileName(query.ExportFormat(), time.Now()))
+	}}
+	var dst io.Writer = out
+	var zw *gzip.Writer
+	if query.Gzip {
+		zw = gzip.NewWriter(out)
+		dst = zw
+	}
+
+	if err := c.exportService.Export(r.Context(), query, dst); err != nil {
+		c.log.Error("export patients", zap.Error(err))
+		if !out.started {
+			httpx.WriteError(w, r, err)
+			return
+		}
+		// Once streaming has begun the status is sent; abort so the client sees a broken download
+		// rather than a truncated file that looks complete
+		panic(http.ErrAbortHandler)
+	}
+	if zw != nil {
+		if err := zw.Close(); err != nil {
+			c.log.Error("finish gzip stream", zap.Error(err))
+		}
+	}
+}
+
+func (c *PatientExportController) GetJob(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.PatientExportJobPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	job, err := c.exportService.GetJob(r.Context(), pathVars.JobID)
+	if err != nil {
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, withDownloadURL(job))
+}
+
+func (c *PatientExportController) Download(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[patientRequest.PatientExportJobPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	job, file, err := c.exportService.OpenJobFile(r.Context(), pathVars.JobID)
+	if err != nil {
+		httpx.WriteError(w, r, err)
+		return
+	}
+	defer file.Close()
+
+	setExportHeaders(w, job.Format, job.Gzip, exportFileName(job.Format, job.CreatedAt))
+	if _, err := io.Copy(w, file); err != nil {
+		c.log.Warn("download patient export", zap.String("job_id", job.ID), zap.Error(err))
+	}
+}
+
+func withDownloadURL(job patientModel.PatientExportJob) patientModel.PatientExportJob {
+	if job.Status == patientModel.ExportJobCompleted {
+		job.DownloadURL = paths.PatientExportJobDownloadURL(job.ID)
+	}
+	return job
+}
+
+func setExportHeaders(w http.ResponseWriter, format string, compressed bool, fileName string) {
+	w.Header().Set("Content-Type", tabular.ContentType(format))
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, fileName))
+	w.Header().Set("Cache-Control", "no-store")
+	if compressed {
+		w.Header().Set("Content-Encoding", "gzip")
+	}
+}
+
+func exportFileName(format string, at time.Time) string {
+	return fmt.Sprintf("patients-%s.%s", at.UTC().Format("20060102-150405"), format)
+}
+
+// lazyHeaderWriter sets the response headers just before the first byte is written
+type lazyHeaderWriter struct {
+	w          http.ResponseWriter
+	setHeaders func()
+	started    bool
+}
+
+func (l *lazyHeaderWriter) Write(b []byte) (int, error) {
+	if !l.started {
+		l.started = true
+		l.setHeaders()
+	}
+	return l.w.Write(b)
+}
@@ -0,0 +1,49 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	patientRequest "pharmacy-modernization-project-model/domain/patient/contracts/request"
+	"pharmacy-modernization-project-model/domain/patient/contracts/response"
+	patientsecurity "pharmacy-modernization-project-model/domain/patient/security"
+	service "pharmacy-modernization-project-model/domain/patient/service"
+	"pharmacy-modernization-project-model/domain/patient/ui/paths"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+)
+
+type PatientSearchController struct {
+	searchService service.PatientSearchService
+	log           *zap.Logger
+}
+
+func NewPatientSearchController(search service.PatientSearchService, log *zap.Logger) *PatientSearchController {
+	return &PatientSearchController{searchService: search, log: log}
+}
+
+func (c *PatientSearchController) RegisterRoutes(r chi.Router) {
+	// Search routes inherit auth from the patient routes; requires patient:read or admin:all
+	r.With(auth.RequirePermissionsMatchAny(patientsecurity.ReadAccess)).Get(paths.SearchRoute, c.Search)
+}
+
+func (c *PatientSearchController) Search(w http.ResponseWriter, r *http.Request) {
+	query, fieldErrors, err := bind.Query[patientRequest.PatientSearchQueryRequest](r)
+	if err != nil {
+		c.log.Error("failed to bind query parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	results, err := c.searchService.Search(r.Context(), query)
+	if err != nil {
+		c.log.Error("search patients", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, response.FromSearchResults(results))
+}
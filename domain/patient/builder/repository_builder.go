@@ -1,28 +1,135 @@
 package builder
 
 import (
+	"context"
+	"time"
+
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
 
+	patientproviders "pharmacy-modernization-project-model/domain/patient/providers"
 	patientrepo "pharmacy-modernization-project-model/domain/patient/repository"
+	"pharmacy-modernization-project-model/internal/platform/database"
+	"pharmacy-modernization-project-model/internal/platform/fieldcrypt"
 )
 
-// CreatePatientRepository creates the appropriate patient repository based on dependencies
-func CreatePatientRepository(logger *zap.Logger, mongoCollection *mongo.Collection) patientrepo.PatientRepository {
+// CreatePatientRepository creates the appropriate patient repository based on dependencies. The
+// cipher, when set, encrypts patient PHI in MongoDB; the memory repository never persists it.
+func CreatePatientRepository(logger *zap.Logger, mongoCollection *mongo.Collection, cipher *fieldcrypt.Cipher, retrier *database.Retrier) patientrepo.PatientRepository {
 	// Use MongoDB repository if collection is provided, otherwise fallback to memory
 	if mongoCollection != nil {
-		return patientrepo.NewPatientMongoRepository(mongoCollection, logger)
+		return patientrepo.NewPatientRetryRepository(patientrepo.NewPatientMongoRepository(mongoCollection, cipher, logger), retrier)
 	}
 
 	return patientrepo.NewPatientMemoryRepository()
 }
 
 // CreateAddressRepository creates the appropriate address repository based on dependencies
-func CreateAddressRepository(logger *zap.Logger, mongoCollection *mongo.Collection) patientrepo.AddressRepository {
+func CreateAddressRepository(logger *zap.Logger, mongoCollection *mongo.Collection, retrier *database.Retrier) patientrepo.AddressRepository {
 	// Use MongoDB repository if collection is provided, otherwise fallback to memory
 	if mongoCollection != nil {
-		return patientrepo.NewAddressMongoRepository(mongoCollection, logger)
+		return patientrepo.NewAddressRetryRepository(patientrepo.NewAddressMongoRepository(mongoCollection, logger), retrier)
 	}
 
 	return patientrepo.NewAddressMemoryRepository()
 }
+
+// CreateMeasurementRepository creates the appropriate measurement repository based on dependencies
+func CreateMeasurementRepository(logger *zap.Logger, mongoCollection *mongo.Collection, retrier *database.Retrier) patientrepo.MeasurementRepository {
+	// Use MongoDB repository if collection is provided, otherwise fallback to memory
+	if mongoCollection != nil {
+		return patientrepo.NewMeasurementRetryRepository(patientrepo.NewMeasurementMongoRepository(mongoCollection, logger), retrier)
+	}
+
+	return patientrepo.NewMeasurementMemoryRepository()
+}
+
+// CreateAllergyRepository creates the appropriate allergy repository based on dependencies; with
+// MongoDB it creates the patient index if missing
+func CreateAllergyRepository(logger *zap.Logger, mongoCollection *mongo.Collection, retrier *database.Retrier) patientrepo.AllergyRepository {
+	if mongoCollection != nil {
+		repo := patientrepo.NewAllergyMongoRepository(mongoCollection, logger)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := repo.CreateIndexes(ctx); err != nil {
+			logger.Warn("Failed to create allergy indexes", zap.Error(err))
+		}
+		return patientrepo.NewAllergyRetryRepository(repo, retrier)
+	}
+
+	return patientrepo.NewAllergyMemoryRepository()
+}
+
+// CreatePatientSearchRepository creates the patient search repository. With both MongoDB
+// collections it uses text indexes (created here if missing); otherwise it scans the given repositories.
+func CreatePatientSearchRepository(logger *zap.Logger, patientsCollection, addressesCollection *mongo.Collection, cipher *fieldcrypt.Cipher, patients patientrepo.PatientRepository, addresses patientrepo.AddressRepository, retrier *database.Retrier) patientrepo.PatientSearchRepository {
+	if patientsCollection != nil && addressesCollection != nil {
+		repo := patientrepo.NewPatientSearchMongoRepository(patientsCollection, addressesCollection, cipher, logger)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := repo.CreateIndexes(ctx); err != nil {
+			// Search falls back to typo-tolerant matching until the index exists
+			logger.Warn("Failed to create patient search text indexes", zap.Error(err))
+		}
+		return patientrepo.NewPatientSearchRetryRepository(repo, retrier)
+	}
+
+	return patientrepo.NewPatientSearchMemoryRepository(patients, addresses)
+}
+
+// CreateInsuranceRepository creates the appropriate insurance record repository based on dependencies
+func CreateInsuranceRepository(logger *zap.Logger, mongoCollection *mongo.Collection, retrier *database.Retrier) patientrepo.InsuranceRepository {
+	// Use MongoDB repository if collection is provided, otherwise fallback to memory
+	if mongoCollection != nil {
+		return patientrepo.NewInsuranceRetryRepository(patientrepo.NewInsuranceMongoRepository(mongoCollection, logger), retrier)
+	}
+
+	return patientrepo.NewInsuranceMemoryRepository()
+}
+
+// CreateImportTemplateRepository creates the repository of saved import column mappings; with
+// MongoDB it creates the unique name index if missing
+func CreateImportTemplateRepository(logger *zap.Logger, mongoCollection *mongo.Collection, retrier *database.Retrier) patientrepo.ImportTemplateRepository {
+	if mongoCollection != nil {
+		repo := patientrepo.NewImportTemplateMongoRepository(mongoCollection, logger)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := repo.CreateIndexes(ctx); err != nil {
+			logger.Warn("Failed to create import template indexes", zap.Error(err))
+		}
+		return patientrepo.NewImportTemplateRetryRepository(repo, retrier)
+	}
+
+	return patientrepo.NewImportTemplateMemoryRepository()
+}
+
+// CreateDocumentRepository creates the repository of patient document metadata; with MongoDB it
+// creates the patient list index if missing
+func CreateDocumentRepository(logger *zap.Logger, mongoCollection *mongo.Collection, retrier *database.Retrier) patientrepo.DocumentRepository {
+	if mongoCollection != nil {
+		repo := patientrepo.NewDocumentMongoRepository(mongoCollection, logger)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := repo.CreateIndexes(ctx); err != nil {
+			logger.Warn("Failed to create patient document indexes", zap.Error(err))
+		}
+		return patientrepo.NewDocumentRetryRepository(repo, retrier)
+	}
+
+	return patientrepo.NewDocumentMemoryRepository()
+}
+
+// CreatePrescriptionCountRepository creates the repository counting patients' prescriptions. With
+// both MongoDB collections it joins them in one aggregation; otherwise it asks the provider about
+// the patients of the given repository.
+func CreatePrescriptionCountRepository(logger *zap.Logger, patientsCollection, prescriptionsCollection *mongo.Collection, provider patientproviders.PatientPrescriptionProvider, patients patientrepo.PatientRepository, retrier *database.Retrier) patientrepo.PrescriptionCountRepository {
+	if patientsCollection != nil && prescriptionsCollection != nil {
+		return patientrepo.NewPrescriptionCountRetryRepository(patientrepo.NewPrescriptionCountMongoRepository(patientsCollection, prescriptionsCollection.Name(), logger), retrier)
+	}
+
+	return patientrepo.NewPrescriptionCountMemoryRepository(provider, patients)
+}
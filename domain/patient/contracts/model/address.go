@@ -8,4 +8,5 @@ type Address struct {
 	City      string `json:"city" bson:"city"`
 	State     string `json:"state" bson:"state"`
 	Zip       string `json:"zip" bson:"zip"`
+	OrgID     string `json:"org_id,omitempty" bson:"org_id,omitempty"` // Organization of the patient
 }
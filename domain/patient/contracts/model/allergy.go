@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// AllergySeverity grades how strongly a patient reacts to a substance
+type AllergySeverity string
+
+const (
+	AllergyMild     AllergySeverity = "mild"
+	AllergyModerate AllergySeverity = "moderate"
+	AllergySevere   AllergySeverity = "severe"
+)
+
+// AllergySeverities lists every supported allergy severity
+var AllergySeverities = []AllergySeverity{AllergyMild, AllergyModerate, AllergySevere}
+
+// Allergy is a substance a patient is allergic to. Substance is a drug name, brand name or drug
+// class such as "Penicillin" or "NSAID"; prescribing a matching drug is blocked.
+type Allergy struct {
+	ID         string          `json:"id" bson:"_id"`
+	PatientID  string          `json:"patient_id" bson:"patient_id"`
+	Substance  string          `json:"substance" bson:"substance"`
+	Reaction   string          `json:"reaction" bson:"reaction"`
+	Severity   AllergySeverity `json:"severity" bson:"severity"`
+	RecordedAt time.Time       `json:"recorded_at" bson:"recorded_at"`
+	RecordedBy string          `json:"recorded_by" bson:"recorded_by"`
+}
@@ -0,0 +1,32 @@
+package model
+
+// ContactChannel is a way to reach a patient about their prescriptions and invoices
+type ContactChannel string
+
+const (
+	ContactSMS   ContactChannel = "sms"
+	ContactEmail ContactChannel = "email"
+	// ContactPhone is a call from the pharmacy staff; notifications are never sent over it
+	ContactPhone ContactChannel = "phone"
+)
+
+// ContactChannels lists every supported contact channel
+var ContactChannels = []ContactChannel{ContactSMS, ContactEmail, ContactPhone}
+
+// ContactPreferences are the channels a patient agreed to be contacted on, in order of preference.
+// SMS goes to the patient's phone and email to Email. Patients without preferences are not notified.
+type ContactPreferences struct {
+	Channels []ContactChannel `json:"channels" bson:"channels"`
+	Email    string           `json:"email,omitempty" bson:"email,omitempty"`
+	// DoNotContact stops every notification whatever the channels, e.g. at the patient's request
+	DoNotContact bool `json:"do_not_contact" bson:"do_not_contact"`
+}
+
+// AllowedChannels returns the channels the patient may be contacted on, in order of preference;
+// none without preferences or when the patient asked not to be contacted
+func (p *ContactPreferences) AllowedChannels() []ContactChannel {
+	if p == nil || p.DoNotContact {
+		return nil
+	}
+	return p.Channels
+}
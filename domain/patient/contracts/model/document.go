@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// PatientDocument describes a file attached to a patient, such as a photo ID or a scanned
+// referral. The content is kept in a blob store; the hash is checked on every download.
+type PatientDocument struct {
+	ID          string    `json:"id" bson:"_id"`
+	PatientID   string    `json:"patient_id" bson:"patient_id"`
+	Name        string    `json:"name" bson:"name"` // File name as uploaded
+	ContentType string    `json:"content_type" bson:"content_type"`
+	Size        int       `json:"size" bson:"size"`
+	SHA256      string    `json:"sha256" bson:"sha256"`
+	BlobKey     string    `json:"-" bson:"blob_key"`
+	ScanStatus  string    `json:"scan_status" bson:"scan_status"` // "clean", or "unscanned" when no scanner is configured
+	ScanEngine  string    `json:"scan_engine,omitempty" bson:"scan_engine,omitempty"`
+	UploadedBy  string    `json:"uploaded_by" bson:"uploaded_by"`
+	UploadedAt  time.Time `json:"uploaded_at" bson:"uploaded_at"`
+}
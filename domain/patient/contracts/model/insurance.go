@@ -0,0 +1,122 @@
+package model
+
+import "time"
+
+// InsuranceStatus tracks an insurance record through human confirmation
+type InsuranceStatus string
+
+const (
+	InsurancePendingConfirmation InsuranceStatus = "pending_confirmation"
+	InsuranceConfirmed           InsuranceStatus = "confirmed"
+	InsuranceRejected            InsuranceStatus = "rejected"
+)
+
+// Insurance card fields read by OCR
+const (
+	InsuranceFieldPayerName   = "payer_name"
+	InsuranceFieldMemberID    = "member_id"
+	InsuranceFieldGroupNumber = "group_number"
+	InsuranceFieldMemberName  = "member_name"
+	InsuranceFieldRxBIN       = "rx_bin"
+	InsuranceFieldRxPCN       = "rx_pcn"
+)
+
+// InsuranceFields lists every insurance card field in display order
+var InsuranceFields = []string{
+	InsuranceFieldPayerName,
+	InsuranceFieldMemberID,
+	InsuranceFieldGroupNumber,
+	InsuranceFieldMemberName,
+	InsuranceFieldRxBIN,
+	InsuranceFieldRxPCN,
+}
+
+// InsuranceRecord is a patient's insurance coverage. Records taken from a card photo start
+// pending confirmation with the OCR values pre-filled; records entered by hand are confirmed.
+type InsuranceRecord struct {
+	ID          string          `json:"id" bson:"_id"`
+	PatientID   string          `json:"patient_id" bson:"patient_id"`
+	PayerName   string          `json:"payer_name" bson:"payer_name"`
+	MemberID    string          `json:"member_id" bson:"member_id"`
+	GroupNumber string          `json:"group_number,omitempty" bson:"group_number,omitempty"`
+	MemberName  string          `json:"member_name,omitempty" bson:"member_name,omitempty"`
+	RxBIN       string          `json:"rx_bin,omitempty" bson:"rx_bin,omitempty"`
+	RxPCN       string          `json:"rx_pcn,omitempty" bson:"rx_pcn,omitempty"`
+	Status      InsuranceStatus `json:"status" bson:"status"`
+
+	// Coverage period, as calendar dates at midnight UTC; no expiry date means open-ended
+	EffectiveDate *time.Time `json:"effective_date,omitempty" bson:"effective_date,omitempty"`
+	ExpiryDate    *time.Time `json:"expiry_date,omitempty" bson:"expiry_date,omitempty"`
+
+	CardFrontAttachmentID string        `json:"card_front_attachment_id,omitempty" bson:"card_front_attachment_id,omitempty"`
+	CardBackAttachmentID  string        `json:"card_back_attachment_id,omitempty" bson:"card_back_attachment_id,omitempty"`
+	OCR                   *InsuranceOCR `json:"ocr,omitempty" bson:"ocr,omitempty"`
+
+	CreatedBy      string     `json:"created_by" bson:"created_by"`
+	CreatedAt      time.Time  `json:"created_at" bson:"created_at"`
+	ReviewedBy     string     `json:"reviewed_by,omitempty" bson:"reviewed_by,omitempty"`
+	ReviewedAt     *time.Time `json:"reviewed_at,omitempty" bson:"reviewed_at,omitempty"`
+	RejectedReason string     `json:"rejected_reason,omitempty" bson:"rejected_reason,omitempty"`
+}
+
+// InsuranceOCR records what the OCR provider read from the card, per field
+type InsuranceOCR struct {
+	Provider    string                     `json:"provider" bson:"provider"`
+	Fields      map[string]OCRFieldReading `json:"fields" bson:"fields"`
+	Error       string                     `json:"error,omitempty" bson:"error,omitempty"` // Set when the provider failed; the fields are then entered by hand
+	ExtractedAt time.Time                  `json:"extracted_at" bson:"extracted_at"`
+}
+
+// OCRFieldReading is one field as read by OCR
+type OCRFieldReading struct {
+	Value       string  `json:"value" bson:"value"`
+	Confidence  float64 `json:"confidence" bson:"confidence"`     // 0 to 1, as reported by the provider
+	NeedsReview bool    `json:"needs_review" bson:"needs_review"` // Confidence is below the review threshold
+	Corrected   bool    `json:"corrected" bson:"corrected"`       // The confirmed value differs from what OCR read
+}
+
+// CoversOn reports whether the record is confirmed coverage in effect on the given day; the expiry
+// date is the last day covered
+func (r InsuranceRecord) CoversOn(day time.Time) bool {
+	if r.Status != InsuranceConfirmed {
+		return false
+	}
+	day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	if r.EffectiveDate != nil && day.Before(*r.EffectiveDate) {
+		return false
+	}
+	return r.ExpiryDate == nil || !day.After(*r.ExpiryDate)
+}
+
+// Field returns the value of an insurance card field
+func (r InsuranceRecord) Field(name string) string {
+	if p := r.fieldRef(name); p != nil {
+		return *p
+	}
+	return ""
+}
+
+// SetField sets an insurance card field; unknown names are ignored
+func (r *InsuranceRecord) SetField(name, value string) {
+	if p := r.fieldRef(name); p != nil {
+		*p = value
+	}
+}
+
+func (r *InsuranceRecord) fieldRef(name string) *string {
+	switch name {
+	case InsuranceFieldPayerName:
+		return &r.PayerName
+	case InsuranceFieldMemberID:
+		return &r.MemberID
+	case InsuranceFieldGroupNumber:
+		return &r.GroupNumber
+	case InsuranceFieldMemberName:
+		return &r.MemberName
+	case InsuranceFieldRxBIN:
+		return &r.RxBIN
+	case InsuranceFieldRxPCN:
+		return &r.RxPCN
+	}
+	return nil
+}
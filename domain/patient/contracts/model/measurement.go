@@ -0,0 +1,36 @@
+package model
+
+import "time"
+
+// MeasurementType identifies a vital statistic or body measurement
+type MeasurementType string
+
+const (
+	MeasurementWeight                 MeasurementType = "weight"
+	MeasurementHeight                 MeasurementType = "height"
+	MeasurementTemperature            MeasurementType = "temperature"
+	MeasurementHeartRate              MeasurementType = "heart_rate"
+	MeasurementBloodPressureSystolic  MeasurementType = "bp_systolic"
+	MeasurementBloodPressureDiastolic MeasurementType = "bp_diastolic"
+)
+
+// MeasurementTypes lists every supported measurement type
+var MeasurementTypes = []MeasurementType{
+	MeasurementWeight,
+	MeasurementHeight,
+	MeasurementTemperature,
+	MeasurementHeartRate,
+	MeasurementBloodPressureSystolic,
+	MeasurementBloodPressureDiastolic,
+}
+
+// Measurement is a single recorded value for a patient
+type Measurement struct {
+	ID         string          `json:"id" bson:"_id"`
+	PatientID  string          `json:"patient_id" bson:"patient_id"`
+	Type       MeasurementType `json:"type" bson:"type"`
+	Value      float64         `json:"value" bson:"value"`
+	Unit       string          `json:"unit" bson:"unit"`
+	RecordedAt time.Time       `json:"recorded_at" bson:"recorded_at"`
+	RecordedBy string          `json:"recorded_by" bson:"recorded_by"`
+}
@@ -0,0 +1,112 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Units accepted for measurements
+const (
+	UnitKilogram   = "kg"
+	UnitGram       = "g"
+	UnitPound      = "lb"
+	UnitCentimeter = "cm"
+	UnitMeter      = "m"
+	UnitInch       = "in"
+	UnitCelsius    = "C"
+	UnitFahrenheit = "F"
+	UnitBPM        = "bpm"
+	UnitMmHg       = "mmHg"
+)
+
+// unitConversion converts a value to (toBase) and from (fromBase) the base unit of its type
+type unitConversion struct {
+	toBase   func(float64) float64
+	fromBase func(float64) float64
+}
+
+func linear(factor float64) unitConversion {
+	return unitConversion{
+		toBase:   func(v float64) float64 { return v * factor },
+		fromBase: func(v float64) float64 { return v / factor },
+	}
+}
+
+var identity = linear(1)
+
+// measurementUnits maps each type to its supported units
+var measurementUnits = map[MeasurementType]map[string]unitConversion{
+	MeasurementWeight: {
+		UnitKilogram: identity,
+		UnitGram:     linear(0.001),
+		UnitPound:    linear(0.45359237),
+	},
+	MeasurementHeight: {
+		UnitCentimeter: identity,
+		UnitMeter:      linear(100),
+		UnitInch:       linear(2.54),
+	},
+	MeasurementTemperature: {
+		UnitCelsius: identity,
+		UnitFahrenheit: {
+			toBase:   func(v float64) float64 { return (v - 32) * 5 / 9 },
+			fromBase: func(v float64) float64 { return v*9/5 + 32 },
+		},
+	},
+	MeasurementHeartRate:              {UnitBPM: identity},
+	MeasurementBloodPressureSystolic:  {UnitMmHg: identity},
+	MeasurementBloodPressureDiastolic: {UnitMmHg: identity},
+}
+
+// baseUnits is the unit values are normalized to for comparisons and dosing
+var baseUnits = map[MeasurementType]string{
+	MeasurementWeight:                 UnitKilogram,
+	MeasurementHeight:                 UnitCentimeter,
+	MeasurementTemperature:            UnitCelsius,
+	MeasurementHeartRate:              UnitBPM,
+	MeasurementBloodPressureSystolic:  UnitMmHg,
+	MeasurementBloodPressureDiastolic: UnitMmHg,
+}
+
+// IsValidMeasurementType reports whether t is a supported measurement type
+func IsValidMeasurementType(t MeasurementType) bool {
+	_, ok := measurementUnits[t]
+	return ok
+}
+
+// BaseUnit returns the unit that values of type t are normalized to
+func BaseUnit(t MeasurementType) string {
+	return baseUnits[t]
+}
+
+// NormalizeUnit matches unit case-insensitively against the units supported for t
+func NormalizeUnit(t MeasurementType, unit string) (string, bool) {
+	for u := range measurementUnits[t] {
+		if strings.EqualFold(u, strings.TrimSpace(unit)) {
+			return u, true
+		}
+	}
+	return "", false
+}
+
+// ConvertUnit converts value between two units of the same measurement type
+func ConvertUnit(t MeasurementType, value float64, from, to string) (float64, error) {
+	units, ok := measurementUnits[t]
+	if !ok {
+		return 0, fmt.Errorf("unsupported measurement type %q", t)
+	}
+	fromConv, ok := units[from]
+	if !ok {
+		return 0, fmt.Errorf("unsupported unit %q for %s", from, t)
+	}
+	toConv, ok := units[to]
+	if !ok {
+		return 0, fmt.Errorf("unsupported unit %q for %s", to, t)
+	}
+	return toConv.fromBase(fromConv.toBase(value)), nil
+}
+
+// InBaseUnit returns the measurement's value converted to the base unit of its type
+func (m Measurement) InBaseUnit() (float64, error) {
+	return ConvertUnit(m.Type, m.Value, m.Unit, BaseUnit(m.Type))
+}
@@ -1,14 +1,29 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"pharmacy-modernization-project-model/internal/platform/dates"
+)
 
 type Patient struct {
-	ID        string     `json:"id" bson:"_id"`
-	Name      string     `json:"name" bson:"name"`
-	DOB       time.Time  `json:"dob" bson:"dob"`
-	Phone     string     `json:"phone" bson:"phone"`
-	State     string     `json:"state" bson:"state"`
-	CreatedAt time.Time  `json:"created_at" bson:"created_at"`
-	EditBy    *string    `json:"edit_by,omitempty" bson:"edit_by,omitempty"`
-	EditTime  *time.Time `json:"edit_time,omitempty" bson:"edit_time,omitempty"`
+	ID        string            `json:"id" bson:"_id"`
+	Name      string            `json:"name" bson:"name"`
+	DOB       dates.PartialDate `json:"dob" bson:"dob"`
+	Phone     string            `json:"phone" bson:"phone"`
+	State     string            `json:"state" bson:"state"`
+	CreatedAt time.Time         `json:"created_at" bson:"created_at"`
+	EditBy    *string           `json:"edit_by,omitempty" bson:"edit_by,omitempty"`
+	EditTime  *time.Time        `json:"edit_time,omitempty" bson:"edit_time,omitempty"`
+	// ContactPreferences is nil until the patient agrees to be notified
+	ContactPreferences *ContactPreferences `json:"contact_preferences,omitempty" bson:"contact_preferences,omitempty"`
+	// OrgID is the organization the patient belongs to when tenancy is enabled
+	OrgID string `json:"org_id,omitempty" bson:"org_id,omitempty"`
+	// PrescriptionCounts is only filled in on patient lists
+	PrescriptionCounts PrescriptionCounts `json:"prescription_counts,omitempty" bson:"-"`
+	// PotentialDuplicates is only filled in on a patient just created, see patient_duplicates.mode
+	PotentialDuplicates []PotentialDuplicate `json:"potential_duplicates,omitempty" bson:"-"`
 }
+
+// IsEntity marks Patient as a GraphQL federation entity
+func (Patient) IsEntity() {}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Reasons a patient is a potential duplicate of a new one
+const (
+	DuplicateMatchPhone   = "phone"    // Same normalized phone number
+	DuplicateMatchNameDOB = "name_dob" // Same DOB and a similar name
+)
+
+// PotentialDuplicate is an existing patient a new patient likely duplicates
+type PotentialDuplicate struct {
+	Patient Patient  `json:"patient"`
+	Reasons []string `json:"reasons"`
+}
+
+// NormalizePhone keeps the digits of a phone number, so "(206) 417-8842" and "206.417.8842"
+// compare equal
+func NormalizePhone(phone string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, phone)
+}
+
+// NormalizeName lower-cases a name and reduces it to its words, separated by single spaces
+func NormalizeName(name string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
@@ -0,0 +1,94 @@
+package model
+
+import "time"
+
+// Import job statuses
+const (
+	ImportJobUploaded  = "uploaded" // Waiting for the column mapping
+	ImportJobRunning   = "running"
+	ImportJobCompleted = "completed"
+)
+
+// Patient fields a CSV column can be mapped to
+const (
+	ImportFieldID    = "id"
+	ImportFieldName  = "name"
+	ImportFieldDOB   = "dob"
+	ImportFieldPhone = "phone"
+	ImportFieldState = "state"
+)
+
+// PatientImportField is a patient field offered in the column mapping
+type PatientImportField struct {
+	Key      string `json:"key"`
+	Label    string `json:"label"`
+	Required bool   `json:"required"`
+}
+
+// PatientImportFields lists the mappable fields in the order the wizard shows them
+var PatientImportFields = []PatientImportField{
+	{Key: ImportFieldName, Label: "Name", Required: true},
+	{Key: ImportFieldDOB, Label: "Date of Birth", Required: true},
+	{Key: ImportFieldPhone, Label: "Phone", Required: true},
+	{Key: ImportFieldState, Label: "State", Required: true},
+	{Key: ImportFieldID, Label: "Patient ID"}, // Generated when not mapped
+}
+
+// PatientImportMapping maps patient fields to the CSV header of their column
+type PatientImportMapping map[string]string
+
+// PatientImportRowError is a problem with one row; Line counts like a spreadsheet, the header being line 1
+type PatientImportRowError struct {
+	Line    int    `json:"line"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// PatientImportValidation is the outcome of a dry run; Errors is capped, InvalidRows is not
+type PatientImportValidation struct {
+	Rows        int                     `json:"rows"`
+	ValidRows   int                     `json:"valid_rows"`
+	InvalidRows int                     `json:"invalid_rows"`
+	Errors      []PatientImportRowError `json:"errors"`
+}
+
+// PatientImportJob is an uploaded CSV file on its way through the import wizard
+type PatientImportJob struct {
+	ID         string                   `json:"id"`
+	Status     string                   `json:"status"`
+	FileName   string                   `json:"file_name"`
+	Headers    []string                 `json:"headers"`
+	Preview    [][]string               `json:"preview"` // First rows of the file
+	Mapping    PatientImportMapping     `json:"mapping"`
+	Validation *PatientImportValidation `json:"validation,omitempty"` // Last dry run
+
+	TotalRows int                     `json:"total_rows"`
+	Processed int                     `json:"processed"`
+	Created   int                     `json:"created"`
+	Failed    int                     `json:"failed"`
+	Errors    []PatientImportRowError `json:"errors,omitempty"` // Rows that failed during the import; capped
+
+	CreatedAt   time.Time  `json:"created_at"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+
+	OwnerID string `json:"-"`
+}
+
+// Percent is how much of the import is done, from 0 to 100
+func (j PatientImportJob) Percent() int {
+	if j.TotalRows == 0 {
+		return 100
+	}
+	return j.Processed * 100 / j.TotalRows
+}
+
+// PatientImportTemplate is a saved column mapping, shared by everyone who imports patients
+type PatientImportTemplate struct {
+	ID        string               `json:"id" bson:"_id"`
+	Name      string               `json:"name" bson:"name"`
+	Mapping   PatientImportMapping `json:"mapping" bson:"mapping"`
+	UpdatedBy string               `json:"updated_by" bson:"updated_by"`
+	UpdatedAt time.Time            `json:"updated_at" bson:"updated_at"`
+}
@@ -0,0 +1,30 @@
+package model
+
+// Search match types
+const (
+	SearchMatchText  = "text"
+	SearchMatchFuzzy = "fuzzy"
+)
+
+// PatientSearchCandidate is a patient returned by the search repository with its addresses
+// and, for full-text matches, the relevance score
+type PatientSearchCandidate struct {
+	Patient   Patient
+	Addresses []Address
+	Score     float64
+}
+
+// PatientSearchMatch is a field that matched the query; Highlighted wraps matched terms in <mark> and is HTML-escaped
+type PatientSearchMatch struct {
+	Field       string `json:"field"`
+	Value       string `json:"value"`
+	Highlighted string `json:"highlighted"`
+}
+
+// PatientSearchResult is a ranked search hit
+type PatientSearchResult struct {
+	Patient   Patient              `json:"patient"`
+	Score     float64              `json:"score"`
+	MatchType string               `json:"match_type"`
+	Matches   []PatientSearchMatch `json:"matches"`
+}
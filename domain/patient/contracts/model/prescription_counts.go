@@ -0,0 +1,13 @@
+package model
+
+// PrescriptionCounts is the number of a patient's prescriptions by status, e.g. {"Active": 2}
+type PrescriptionCounts map[string]int
+
+// Total is the number of prescriptions of every status
+func (c PrescriptionCounts) Total() int {
+	total := 0
+	for _, count := range c {
+		total += count
+	}
+	return total
+}
@@ -0,0 +1,7 @@
+package model
+
+// StateCount is the number of patients living in a state
+type StateCount struct {
+	State string `json:"state" bson:"_id"`
+	Count int    `json:"count" bson:"count"`
+}
@@ -7,3 +7,12 @@ type AddressCreateRequest struct {
 	State string `json:"state" validate:"required,min=2,max=2"`
 	Zip   string `json:"zip" validate:"required,len=5,numeric"`
 }
+
+// AddressUpdateRequest changes the fields that are set; an empty line2 clears it
+type AddressUpdateRequest struct {
+	Line1 *string `json:"line1" validate:"omitempty,min=1,max=100"`
+	Line2 *string `json:"line2" validate:"omitempty,max=100"`
+	City  *string `json:"city" validate:"omitempty,min=1,max=50"`
+	State *string `json:"state" validate:"omitempty,min=2,max=2"`
+	Zip   *string `json:"zip" validate:"omitempty,len=5,numeric"`
+}
@@ -0,0 +1,19 @@
+package request
+
+type AllergyCreateRequest struct {
+	Substance string `json:"substance" validate:"required,min=2,max=100"`
+	Reaction  string `json:"reaction" validate:"omitempty,max=200"`
+	Severity  string `json:"severity" validate:"required,oneof=mild moderate severe"`
+}
+
+type AllergyUpdateRequest struct {
+	Substance *string `json:"substance" validate:"omitempty,min=2,max=100"`
+	Reaction  *string `json:"reaction" validate:"omitempty,max=200"`
+	Severity  *string `json:"severity" validate:"omitempty,oneof=mild moderate severe"`
+}
+
+// AllergyPathVars represents path parameters for allergy endpoints
+type AllergyPathVars struct {
+	PatientID string `path:"patientID" validate:"required,min=1"`
+	AllergyID string `path:"allergyID" validate:"required,min=1"`
+}
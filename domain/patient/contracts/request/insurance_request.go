@@ -0,0 +1,88 @@
+package request
+
+// InsuranceCardIntakeRequest carries photos of an insurance card as base64 PNG/JPEG (data URLs accepted)
+type InsuranceCardIntakeRequest struct {
+	FrontImage string `json:"front_image" validate:"required"`
+	BackImage  string `json:"back_image" validate:"omitempty"`
+}
+
+// InsuranceCreateRequest enters an insurance record by hand. Dates are YYYY-MM-DD; the expiry
+// date, when given, must be after the effective date.
+type InsuranceCreateRequest struct {
+	PayerName     string `json:"payer_name" validate:"required,max=100"`
+	MemberID      string `json:"member_id" validate:"required,max=50"`
+	GroupNumber   string `json:"group_number" validate:"omitempty,max=50"`
+	MemberName    string `json:"member_name" validate:"omitempty,max=100"`
+	RxBIN         string `json:"rx_bin" validate:"omitempty,max=10"`
+	RxPCN         string `json:"rx_pcn" validate:"omitempty,max=20"`
+	EffectiveDate string `json:"effective_date" validate:"required,datetime=2006-01-02"`
+	ExpiryDate    string `json:"expiry_date" validate:"omitempty,datetime=2006-01-02"`
+}
+
+// InsuranceUpdateRequest changes a confirmed insurance record; omitted fields are left unchanged
+// and an empty expiry date makes the coverage open-ended
+type InsuranceUpdateRequest struct {
+	PayerName     *string `json:"payer_name" validate:"omitempty,min=1,max=100"`
+	MemberID      *string `json:"member_id" validate:"omitempty,min=1,max=50"`
+	GroupNumber   *string `json:"group_number" validate:"omitempty,max=50"`
+	MemberName    *string `json:"member_name" validate:"omitempty,max=100"`
+	RxBIN         *string `json:"rx_bin" validate:"omitempty,max=10"`
+	RxPCN         *string `json:"rx_pcn" validate:"omitempty,max=20"`
+	EffectiveDate *string `json:"effective_date" validate:"omitempty,datetime=2006-01-02"`
+	ExpiryDate    *string `json:"expiry_date" validate:"omitempty,datetime=2006-01-02|eq="`
+}
+
+// Fields returns the submitted values keyed by insurance field name
+func (r InsuranceUpdateRequest) Fields() map[string]*string {
+	return map[string]*string{
+		"payer_name":   r.PayerName,
+		"member_id":    r.MemberID,
+		"group_number": r.GroupNumber,
+		"member_name":  r.MemberName,
+		"rx_bin":       r.RxBIN,
+		"rx_pcn":       r.RxPCN,
+	}
+}
+
+// InsuranceConfirmRequest confirms a pre-filled insurance record; omitted fields keep the OCR value.
+// Card photos rarely show the coverage period, so the dates are entered here.
+type InsuranceConfirmRequest struct {
+	PayerName     *string `json:"payer_name" validate:"omitempty,max=100"`
+	MemberID      *string `json:"member_id" validate:"omitempty,max=50"`
+	GroupNumber   *string `json:"group_number" validate:"omitempty,max=50"`
+	MemberName    *string `json:"member_name" validate:"omitempty,max=100"`
+	RxBIN         *string `json:"rx_bin" validate:"omitempty,max=10"`
+	RxPCN         *string `json:"rx_pcn" validate:"omitempty,max=20"`
+	EffectiveDate *string `json:"effective_date" validate:"omitempty,datetime=2006-01-02"`
+	ExpiryDate    *string `json:"expiry_date" validate:"omitempty,datetime=2006-01-02"`
+}
+
+// Fields returns the submitted values keyed by insurance field name
+func (r InsuranceConfirmRequest) Fields() map[string]*string {
+	return map[string]*string{
+		"payer_name":   r.PayerName,
+		"member_id":    r.MemberID,
+		"group_number": r.GroupNumber,
+		"member_name":  r.MemberName,
+		"rx_bin":       r.RxBIN,
+		"rx_pcn":       r.RxPCN,
+	}
+}
+
+// InsuranceRejectRequest discards a pre-filled insurance record, e.g. for an unreadable photo
+type InsuranceRejectRequest struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+}
+
+// InsurancePathVars represents path parameters for insurance endpoints
+type InsurancePathVars struct {
+	PatientID   string `path:"patientID" validate:"required,min=1"`
+	InsuranceID string `path:"insuranceID" validate:"required,min=1"`
+}
+
+// InsuranceCardPathVars represents path parameters for insurance card image endpoints
+type InsuranceCardPathVars struct {
+	PatientID   string `path:"patientID" validate:"required,min=1"`
+	InsuranceID string `path:"insuranceID" validate:"required,min=1"`
+	Side        string `path:"side" validate:"required,oneof=front back"`
+}
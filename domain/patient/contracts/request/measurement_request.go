@@ -0,0 +1,33 @@
+package request
+
+import "time"
+
+type MeasurementCreateRequest struct {
+	Type       string     `json:"type" validate:"required,oneof=weight height temperature heart_rate bp_systolic bp_diastolic"`
+	Value      float64    `json:"value" validate:"required,gt=0"`
+	Unit       string     `json:"unit" validate:"required,max=10"`
+	RecordedAt *time.Time `json:"recorded_at" validate:"omitempty"`
+}
+
+type MeasurementUpdateRequest struct {
+	Value      *float64   `json:"value" validate:"omitempty,gt=0"`
+	Unit       *string    `json:"unit" validate:"omitempty,max=10"`
+	RecordedAt *time.Time `json:"recorded_at" validate:"omitempty"`
+}
+
+type MeasurementListQueryRequest struct {
+	Type  string `form:"type" validate:"omitempty,oneof=weight height temperature heart_rate bp_systolic bp_diastolic"`
+	Limit int    `form:"limit" validate:"omitempty,min=1,max=500"`
+}
+
+// MeasurementPathVars represents path parameters for measurement endpoints
+type MeasurementPathVars struct {
+	PatientID     string `path:"patientID" validate:"required,min=1"`
+	MeasurementID string `path:"measurementID" validate:"required,min=1"`
+}
+
+// MeasurementTypePathVars represents path parameters for latest-measurement endpoints
+type MeasurementTypePathVars struct {
+	PatientID string `path:"patientID" validate:"required,min=1"`
+	Type      string `path:"type" validate:"required,oneof=weight height temperature heart_rate bp_systolic bp_diastolic"`
+}
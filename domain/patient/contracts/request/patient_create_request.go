@@ -0,0 +1,9 @@
+package request
+
+// PatientCreateRequest is the body of POST /api/v1/patients
+type PatientCreateRequest struct {
+	Name  string `json:"name" validate:"required,min=2,max=100"`
+	DOB   string `json:"dob" validate:"required,dob"` // YYYY-MM-DD, YYYY-MM or YYYY
+	Phone string `json:"phone" validate:"required,min=10,max=15"`
+	State string `json:"state" validate:"required,min=2,max=50"`
+}
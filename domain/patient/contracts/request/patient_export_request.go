@@ -0,0 +1,33 @@
+package request
+
+// PatientExportQueryRequest selects the patients to export with the same filters as the patient list
+type PatientExportQueryRequest struct {
+	Format      string `form:"format" validate:"omitempty,oneof=csv xlsx"`
+	PatientName string `form:"patientName" validate:"omitempty,min=3"`
+	BirthDate   string `form:"birthDate" validate:"omitempty"`
+	State       string `form:"state" validate:"omitempty,min=1"`
+	Gzip        bool   `form:"gzip"`  // Compress the file (Content-Encoding: gzip)
+	Async       bool   `form:"async"` // Export in the background and download the file when ready
+}
+
+// ListQuery returns the list filters of the export; paging does not apply
+func (r PatientExportQueryRequest) ListQuery() PatientListQueryRequest {
+	return PatientListQueryRequest{
+		PatientName: r.PatientName,
+		BirthDate:   r.BirthDate,
+		State:       r.State,
+	}
+}
+
+// ExportFormat returns the requested format, CSV by default
+func (r PatientExportQueryRequest) ExportFormat() string {
+	if r.Format == "" {
+		return "csv"
+	}
+	return r.Format
+}
+
+// PatientExportJobPathVars represents path parameters for export job endpoints
+type PatientExportJobPathVars struct {
+	JobID string `path:"jobID" validate:"required,uuid"`
+}
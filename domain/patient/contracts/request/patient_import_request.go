@@ -0,0 +1,41 @@
+package request
+
+import m "pharmacy-modernization-project-model/domain/patient/contracts/model"
+
+// PatientImportPathVars represents path parameters for the import wizard steps
+type PatientImportPathVars struct {
+	ImportID string `path:"importID" validate:"required,uuid"`
+}
+
+// PatientImportPageRequest selects a saved template to apply on the mapping step
+type PatientImportPageRequest struct {
+	TemplateID string `form:"templateId" validate:"omitempty,uuid"`
+}
+
+// PatientImportMappingFormRequest is the column mapping step; each field holds the CSV header
+// mapped to that patient field, empty when the field is not mapped
+type PatientImportMappingFormRequest struct {
+	ID           string `form:"id" validate:"max=200"`
+	Name         string `form:"name" validate:"max=200"`
+	DOB          string `form:"dob" validate:"max=200"`
+	Phone        string `form:"phone" validate:"max=200"`
+	State        string `form:"state" validate:"max=200"`
+	TemplateName string `form:"templateName" validate:"max=100"` // Saves the mapping under this name
+}
+
+// Mapping returns the mapped fields
+func (r PatientImportMappingFormRequest) Mapping() m.PatientImportMapping {
+	mapping := m.PatientImportMapping{}
+	for field, header := range map[string]string{
+		m.ImportFieldID:    r.ID,
+		m.ImportFieldName:  r.Name,
+		m.ImportFieldDOB:   r.DOB,
+		m.ImportFieldPhone: r.Phone,
+		m.ImportFieldState: r.State,
+	} {
+		if header != "" {
+			mapping[field] = header
+		}
+	}
+	return mapping
+}
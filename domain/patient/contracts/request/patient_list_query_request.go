@@ -1,9 +1,30 @@
 package request
 
+// MaxAgeFilter is the highest age the minAge and maxAge filters accept
+const MaxAgeFilter = 150
+
 type PatientListQueryRequest struct {
 	Limit       int    `form:"limit" validate:"omitempty,min=1,max=100"`
 	Offset      int    `form:"offset" validate:"omitempty,min=0"`
 	PatientName string `form:"patientName" validate:"omitempty,min=3"`
 	BirthDate   string `form:"birthDate" validate:"omitempty"`
 	State       string `form:"state" validate:"omitempty,min=1"`
+	// MinAge and MaxAge bound the age in completed years derived from the DOB; a partial DOB matches
+	// when any age it allows is within the bounds. Patients without a DOB never match.
+	MinAge *int `form:"minAge" validate:"omitempty,min=0,max=150"`
+	MaxAge *int `form:"maxAge" validate:"omitempty,min=0,max=150"`
+	// HasActivePrescriptions keeps only patients with (true) or without (false) an active prescription
+	HasActivePrescriptions *bool `form:"hasActivePrescriptions"`
+
+	// AllowedStates limits results to the caller's data-access scope; empty means unrestricted.
+	// Set by the server from the authenticated user, never from the query string.
+	AllowedStates []string `form:"-" schema:"-"`
+	// ActivePrescriptionPatientIDs are the patients with an active prescription, which the service
+	// looks up when HasActivePrescriptions is set; never read from the query string
+	ActivePrescriptionPatientIDs []string `form:"-" schema:"-"`
+}
+
+// FiltersByAge reports whether MinAge or MaxAge is set
+func (r PatientListQueryRequest) FiltersByAge() bool {
+	return r.MinAge != nil || r.MaxAge != nil
 }
@@ -4,3 +4,9 @@ package request
 type PatientPathVars struct {
 	PatientID string `path:"patientID" validate:"required,min=1"`
 }
+
+// DocumentPathVars represents path parameters for patient document endpoints
+type DocumentPathVars struct {
+	PatientID  string `path:"patientID" validate:"required,min=1"`
+	DocumentID string `path:"documentID" validate:"required,min=1"`
+}
@@ -0,0 +1,6 @@
+package request
+
+// PatientPrescriptionsQueryRequest represents the arguments of a patient's prescriptions field
+type PatientPrescriptionsQueryRequest struct {
+	Limit int `form:"limit" validate:"omitempty,min=1,max=200"`
+}
@@ -0,0 +1,34 @@
+package request
+
+import (
+	"strings"
+	"unicode"
+)
+
+// PatientSearchQueryRequest is the free-text patient search query
+type PatientSearchQueryRequest struct {
+	Q     string `form:"q" validate:"required,min=2,max=100"`
+	Limit int    `form:"limit" validate:"omitempty,min=1,max=50"`
+}
+
+// Terms splits the query into lower-cased, de-duplicated search terms.
+// Punctuation separates terms, so "(206) 417-8842" becomes 206, 417 and 8842.
+func (r PatientSearchQueryRequest) Terms() []string {
+	return SearchTerms(r.Q)
+}
+
+// SearchTerms splits text into lower-cased, de-duplicated words of letters and digits
+func SearchTerms(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	})
+	seen := make(map[string]bool, len(words))
+	terms := make([]string, 0, len(words))
+	for _, w := range words {
+		if !seen[w] {
+			seen[w] = true
+			terms = append(terms, w)
+		}
+	}
+	return terms
+}
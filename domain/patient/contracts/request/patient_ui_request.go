@@ -1,14 +1,49 @@
 package request
 
+import "strconv"
+
 // PatientListPageRequest represents query parameters for patient list page
 type PatientListPageRequest struct {
 	Page        int    `form:"page" validate:"omitempty,min=1"`
 	PatientName string `form:"patientName" validate:"omitempty,min=1"`
 	BirthDate   string `form:"birthDate" validate:"omitempty"`
 	State       string `form:"state" validate:"omitempty,min=1"`
+	// MinAge and MaxAge stay text, as the search form sends empty fields for unset ages
+	MinAge string `form:"minAge" validate:"omitempty,number,max=3"`
+	MaxAge string `form:"maxAge" validate:"omitempty,number,max=3"`
+	// Prescriptions is "active" for patients with an active prescription and "none" for the others
+	Prescriptions string `form:"prescriptions" validate:"omitempty,oneof=active none"`
+}
+
+// Ages converts the age filters to the list query's; empty ones are unset
+func (r PatientListPageRequest) Ages() (minAge, maxAge *int) {
+	return optionalInt(r.MinAge), optionalInt(r.MaxAge)
+}
+
+// HasActivePrescriptions converts the prescriptions filter to the list query's
+func (r PatientListPageRequest) HasActivePrescriptions() *bool {
+	if r.Prescriptions == "" {
+		return nil
+	}
+	active := r.Prescriptions == "active"
+	return &active
+}
+
+func optionalInt(value string) *int {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return nil
+	}
+	return &n
 }
 
 // PatientComponentRequest represents query parameters for patient-related components
 type PatientComponentRequest struct {
 	PatientID string `form:"patientId" validate:"required,min=1"`
 }
+
+// PatientDetailPageRequest represents query parameters for the patient detail page
+type PatientDetailPageRequest struct {
+	// Document reports the outcome of the document upload that redirected to the page
+	Document string `form:"document" validate:"omitempty,oneof=uploaded invalid rejected failed"`
+}
@@ -0,0 +1,36 @@
+package response
+
+import model "pharmacy-modernization-project-model/domain/patient/contracts/model"
+
+// AddressResponse is the transport representation of a patient address
+type AddressResponse struct {
+	ID        string `json:"id"`
+	PatientID string `json:"patient_id"`
+	Line1     string `json:"line1"`
+	Line2     string `json:"line2"`
+	City      string `json:"city"`
+	State     string `json:"state"`
+	Zip       string `json:"zip"`
+	OrgID     string `json:"org_id,omitempty"`
+}
+
+func FromAddress(m model.Address) AddressResponse {
+	return AddressResponse{
+		ID:        m.ID,
+		PatientID: m.PatientID,
+		Line1:     m.Line1,
+		Line2:     m.Line2,
+		City:      m.City,
+		State:     m.State,
+		Zip:       m.Zip,
+		OrgID:     m.OrgID,
+	}
+}
+
+func FromAddresses(items []model.Address) []AddressResponse {
+	out := make([]AddressResponse, 0, len(items))
+	for _, item := range items {
+		out = append(out, FromAddress(item))
+	}
+	return out
+}
@@ -0,0 +1,38 @@
+package response
+
+import (
+	"time"
+
+	model "pharmacy-modernization-project-model/domain/patient/contracts/model"
+)
+
+// AllergyResponse is the transport representation of a patient allergy
+type AllergyResponse struct {
+	ID         string    `json:"id"`
+	PatientID  string    `json:"patient_id"`
+	Substance  string    `json:"substance"`
+	Reaction   string    `json:"reaction"`
+	Severity   string    `json:"severity"`
+	RecordedAt time.Time `json:"recorded_at"`
+	RecordedBy string    `json:"recorded_by"`
+}
+
+func FromAllergy(m model.Allergy) AllergyResponse {
+	return AllergyResponse{
+		ID:         m.ID,
+		PatientID:  m.PatientID,
+		Substance:  m.Substance,
+		Reaction:   m.Reaction,
+		Severity:   string(m.Severity),
+		RecordedAt: m.RecordedAt,
+		RecordedBy: m.RecordedBy,
+	}
+}
+
+func FromAllergies(items []model.Allergy) []AllergyResponse {
+	out := make([]AllergyResponse, 0, len(items))
+	for _, item := range items {
+		out = append(out, FromAllergy(item))
+	}
+	return out
+}
@@ -0,0 +1,44 @@
+package response
+
+import (
+	"time"
+
+	model "pharmacy-modernization-project-model/domain/patient/contracts/model"
+)
+
+// DocumentResponse is the transport representation of a patient document; the blob key stays internal
+type DocumentResponse struct {
+	ID          string    `json:"id"`
+	PatientID   string    `json:"patient_id"`
+	Name        string    `json:"name"`
+	ContentType string    `json:"content_type"`
+	Size        int       `json:"size"`
+	SHA256      string    `json:"sha256"`
+	ScanStatus  string    `json:"scan_status"`
+	ScanEngine  string    `json:"scan_engine,omitempty"`
+	UploadedBy  string    `json:"uploaded_by"`
+	UploadedAt  time.Time `json:"uploaded_at"`
+}
+
+func FromDocument(m model.PatientDocument) DocumentResponse {
+	return DocumentResponse{
+		ID:          m.ID,
+		PatientID:   m.PatientID,
+		Name:        m.Name,
+		ContentType: m.ContentType,
+		Size:        m.Size,
+		SHA256:      m.SHA256,
+		ScanStatus:  m.ScanStatus,
+		ScanEngine:  m.ScanEngine,
+		UploadedBy:  m.UploadedBy,
+		UploadedAt:  m.UploadedAt,
+	}
+}
+
+func FromDocuments(items []model.PatientDocument) []DocumentResponse {
+	out := make([]DocumentResponse, 0, len(items))
+	for _, item := range items {
+		out = append(out, FromDocument(item))
+	}
+	return out
+}
@@ -0,0 +1,65 @@
+package response
+
+import (
+	"time"
+
+	model "pharmacy-modernization-project-model/domain/patient/contracts/model"
+)
+
+// InsuranceResponse is the transport representation of an insurance record
+type InsuranceResponse struct {
+	ID          string `json:"id"`
+	PatientID   string `json:"patient_id"`
+	PayerName   string `json:"payer_name"`
+	MemberID    string `json:"member_id"`
+	GroupNumber string `json:"group_number,omitempty"`
+	MemberName  string `json:"member_name,omitempty"`
+	RxBIN       string `json:"rx_bin,omitempty"`
+	RxPCN       string `json:"rx_pcn,omitempty"`
+	Status      string `json:"status"`
+
+	EffectiveDate *time.Time `json:"effective_date,omitempty"`
+	ExpiryDate    *time.Time `json:"expiry_date,omitempty"`
+
+	CardFrontAttachmentID string              `json:"card_front_attachment_id,omitempty"`
+	CardBackAttachmentID  string              `json:"card_back_attachment_id,omitempty"`
+	OCR                   *model.InsuranceOCR `json:"ocr,omitempty"`
+
+	CreatedBy      string     `json:"created_by"`
+	CreatedAt      time.Time  `json:"created_at"`
+	ReviewedBy     string     `json:"reviewed_by,omitempty"`
+	ReviewedAt     *time.Time `json:"reviewed_at,omitempty"`
+	RejectedReason string     `json:"rejected_reason,omitempty"`
+}
+
+func FromInsurance(m model.InsuranceRecord) InsuranceResponse {
+	return InsuranceResponse{
+		ID:                    m.ID,
+		PatientID:             m.PatientID,
+		PayerName:             m.PayerName,
+		MemberID:              m.MemberID,
+		GroupNumber:           m.GroupNumber,
+		MemberName:            m.MemberName,
+		RxBIN:                 m.RxBIN,
+		RxPCN:                 m.RxPCN,
+		Status:                string(m.Status),
+		EffectiveDate:         m.EffectiveDate,
+		ExpiryDate:            m.ExpiryDate,
+		CardFrontAttachmentID: m.CardFrontAttachmentID,
+		CardBackAttachmentID:  m.CardBackAttachmentID,
+		OCR:                   m.OCR,
+		CreatedBy:             m.CreatedBy,
+		CreatedAt:             m.CreatedAt,
+		ReviewedBy:            m.ReviewedBy,
+		ReviewedAt:            m.ReviewedAt,
+		RejectedReason:        m.RejectedReason,
+	}
+}
+
+func FromInsuranceRecords(items []model.InsuranceRecord) []InsuranceResponse {
+	out := make([]InsuranceResponse, 0, len(items))
+	for _, item := range items {
+		out = append(out, FromInsurance(item))
+	}
+	return out
+}
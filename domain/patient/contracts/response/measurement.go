@@ -0,0 +1,38 @@
+package response
+
+import (
+	"time"
+
+	model "pharmacy-modernization-project-model/domain/patient/contracts/model"
+)
+
+// MeasurementResponse is the transport representation of a recorded measurement
+type MeasurementResponse struct {
+	ID         string    `json:"id"`
+	PatientID  string    `json:"patient_id"`
+	Type       string    `json:"type"`
+	Value      float64   `json:"value"`
+	Unit       string    `json:"unit"`
+	RecordedAt time.Time `json:"recorded_at"`
+	RecordedBy string    `json:"recorded_by"`
+}
+
+func FromMeasurement(m model.Measurement) MeasurementResponse {
+	return MeasurementResponse{
+		ID:         m.ID,
+		PatientID:  m.PatientID,
+		Type:       string(m.Type),
+		Value:      m.Value,
+		Unit:       m.Unit,
+		RecordedAt: m.RecordedAt,
+		RecordedBy: m.RecordedBy,
+	}
+}
+
+func FromMeasurements(items []model.Measurement) []MeasurementResponse {
+	out := make([]MeasurementResponse, 0, len(items))
+	for _, item := range items {
+		out = append(out, FromMeasurement(item))
+	}
+	return out
+}
@@ -0,0 +1,136 @@
+package response
+
+import (
+	"strconv"
+	"time"
+
+	model "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/dates"
+	"pharmacy-modernization-project-model/internal/platform/sanitizer"
+)
+
+// PatientResponse is the transport representation returned by the API.
+type PatientResponse struct {
+	ID   string            `json:"id"`
+	Name string            `json:"name"`
+	DOB  dates.PartialDate `json:"dob"`
+	// Age is in completed years; for a partial DOB it is the youngest possible age
+	Age *int `json:"age,omitempty"`
+	// AgeDisplay is the age, or its possible range such as "44-45" when a partial DOB leaves it open
+	AgeDisplay string `json:"age_display,omitempty"`
+	Phone      string `json:"phone"`
+	// PhoneMasked keeps the last four digits, e.g. "***-***-1234", for screens that only confirm the number
+	PhoneMasked string     `json:"phone_masked,omitempty"`
+	State       string     `json:"state"`
+	CreatedAt   time.Time  `json:"created_at"`
+	EditBy      *string    `json:"edit_by,omitempty"`
+	EditTime    *time.Time `json:"edit_time,omitempty"`
+
+	ContactPreferences *ContactPreferencesResponse `json:"contact_preferences,omitempty"`
+	OrgID              string                      `json:"org_id,omitempty"`
+	// PrescriptionCounts is only set on patient lists
+	PrescriptionCounts map[string]int `json:"prescription_counts,omitempty"`
+	// PotentialDuplicates is only set on a patient just created that likely duplicates others
+	PotentialDuplicates []PotentialDuplicateResponse `json:"potential_duplicates,omitempty"`
+}
+
+// ContactPreferencesResponse is the transport representation of a patient's contact preferences
+type ContactPreferencesResponse struct {
+	Channels     []string `json:"channels"`
+	Email        string   `json:"email,omitempty"`
+	DoNotContact bool     `json:"do_not_contact"`
+}
+
+func FromModel(m model.Patient) PatientResponse {
+	return fromModelAt(m, time.Now())
+}
+
+func FromModels(items []model.Patient) []PatientResponse {
+	now := time.Now()
+	out := make([]PatientResponse, 0, len(items))
+	for _, item := range items {
+		out = append(out, fromModelAt(item, now))
+	}
+	return out
+}
+
+func fromModelAt(m model.Patient, now time.Time) PatientResponse {
+	out := PatientResponse{
+		ID:                 m.ID,
+		Name:               m.Name,
+		DOB:                m.DOB,
+		Phone:              m.Phone,
+		PhoneMasked:        sanitizer.MaskPhone(m.Phone),
+		State:              m.State,
+		CreatedAt:          m.CreatedAt,
+		EditBy:             m.EditBy,
+		EditTime:           m.EditTime,
+		ContactPreferences: fromContactPreferences(m.ContactPreferences),
+		OrgID:              m.OrgID,
+		PrescriptionCounts: m.PrescriptionCounts,
+	}
+	if len(m.PotentialDuplicates) > 0 {
+		out.PotentialDuplicates = fromPotentialDuplicatesAt(m.PotentialDuplicates, now)
+	}
+	if !m.DOB.IsZero() {
+		minAge, maxAge := m.DOB.AgeRange(now)
+		out.Age = &minAge
+		out.AgeDisplay = strconv.Itoa(minAge)
+		if maxAge != minAge {
+			out.AgeDisplay += "-" + strconv.Itoa(maxAge)
+		}
+	}
+	return out
+}
+
+func fromContactPreferences(p *model.ContactPreferences) *ContactPreferencesResponse {
+	if p == nil {
+		return nil
+	}
+	channels := make([]string, 0, len(p.Channels))
+	for _, channel := range p.Channels {
+		channels = append(channels, string(channel))
+	}
+	return &ContactPreferencesResponse{Channels: channels, Email: p.Email, DoNotContact: p.DoNotContact}
+}
+
+// PotentialDuplicateResponse is the transport representation of a likely duplicate; reasons are
+// "phone" and "name_dob"
+type PotentialDuplicateResponse struct {
+	Patient PatientResponse `json:"patient"`
+	Reasons []string        `json:"reasons"`
+}
+
+func FromPotentialDuplicates(items []model.PotentialDuplicate) []PotentialDuplicateResponse {
+	return fromPotentialDuplicatesAt(items, time.Now())
+}
+
+func fromPotentialDuplicatesAt(items []model.PotentialDuplicate, now time.Time) []PotentialDuplicateResponse {
+	out := make([]PotentialDuplicateResponse, 0, len(items))
+	for _, item := range items {
+		out = append(out, PotentialDuplicateResponse{Patient: fromModelAt(item.Patient, now), Reasons: item.Reasons})
+	}
+	return out
+}
+
+// PatientSearchResultResponse is the transport representation of a ranked search hit
+type PatientSearchResultResponse struct {
+	Patient   PatientResponse            `json:"patient"`
+	Score     float64                    `json:"score"`
+	MatchType string                     `json:"match_type"`
+	Matches   []model.PatientSearchMatch `json:"matches"`
+}
+
+func FromSearchResults(items []model.PatientSearchResult) []PatientSearchResultResponse {
+	now := time.Now()
+	out := make([]PatientSearchResultResponse, 0, len(items))
+	for _, item := range items {
+		out = append(out, PatientSearchResultResponse{
+			Patient:   fromModelAt(item.Patient, now),
+			Score:     item.Score,
+			MatchType: item.MatchType,
+			Matches:   item.Matches,
+		})
+	}
+	return out
+}
@@ -2,7 +2,9 @@ package errors
 
 import (
 	"errors"
+	"strings"
 
+	m "pharmacy-modernization-project-model/domain/patient/contracts/model"
 	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
 )
 
@@ -15,11 +17,40 @@ var (
 	ErrPatientIDRequired = errors.New("patient ID is required")
 )
 
+// DuplicatePatientError is returned when creating a patient is blocked because existing patients
+// likely describe the same person
+type DuplicatePatientError struct {
+	Operation  string
+	Duplicates []m.PotentialDuplicate
+}
+
+func (e DuplicatePatientError) Error() string {
+	return e.Unwrap().Error()
+}
+
+// Unwrap exposes the error as a BusinessLogicError so shared handlers map it to 422
+func (e DuplicatePatientError) Unwrap() error {
+	ids := make([]string, 0, len(e.Duplicates))
+	for _, d := range e.Duplicates {
+		ids = append(ids, d.Patient.ID)
+	}
+	return platformErrors.NewBusinessLogicError(e.Operation, "likely duplicate of patient "+strings.Join(ids, ", "))
+}
+
+// NewDuplicatePatientError creates a new duplicate patient error
+func NewDuplicatePatientError(operation string, duplicates []m.PotentialDuplicate) DuplicatePatientError {
+	return DuplicatePatientError{
+		Operation:  operation,
+		Duplicates: duplicates,
+	}
+}
+
 // Re-export platform errors for convenience
 type ValidationError = platformErrors.ValidationError
 type RecordNotFoundError = platformErrors.RecordNotFoundError
 type DuplicateRecordError = platformErrors.DuplicateRecordError
 type BusinessLogicError = platformErrors.BusinessLogicError
+type ConflictError = platformErrors.ConflictError
 type ConfigurationError = platformErrors.ConfigurationError
 type AuthorizationError = platformErrors.AuthorizationError
 type ExternalServiceError = platformErrors.ExternalServiceError
@@ -31,6 +62,7 @@ var (
 	NewRecordNotFoundError  = platformErrors.NewRecordNotFoundError
 	NewDuplicateRecordError = platformErrors.NewDuplicateRecordError
 	NewBusinessLogicError   = platformErrors.NewBusinessLogicError
+	NewConflictError        = platformErrors.NewConflictError
 	NewConfigurationError   = platformErrors.NewConfigurationError
 	NewAuthorizationError   = platformErrors.NewAuthorizationError
 	NewExternalServiceError = platformErrors.NewExternalServiceError
@@ -2,11 +2,16 @@ package graphql
 
 import (
 	"context"
+	"errors"
 
 	"go.uber.org/zap"
 
 	"pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/domain/patient/contracts/request"
 	patientservice "pharmacy-modernization-project-model/domain/patient/service"
+	"pharmacy-modernization-project-model/internal/graphql/generated"
+	"pharmacy-modernization-project-model/internal/graphql/validation"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
 )
 
 // AddressResolver handles address-specific GraphQL operations
@@ -63,3 +68,123 @@ func (r *AddressResolver) FormattedAddress(ctx context.Context, obj *model.Addre
 	formatted += ", " + obj.City + ", " + obj.State + " " + obj.Zip
 	return formatted, nil
 }
+
+// ============================================================================
+// Mutation Resolvers
+// ============================================================================
+
+// CreateAddress resolves the createAddress mutation
+func (r *AddressResolver) CreateAddress(ctx context.Context, patientID string, input generated.CreateAddressInput) (*generated.CreateAddressPayload, error) {
+	record, err := r.createAddress(ctx, patientID, input)
+	userErrors, err := validation.UserErrors(err)
+	if err != nil {
+		return nil, err
+	}
+	return &generated.CreateAddressPayload{Address: record, UserErrors: userErrors}, nil
+}
+
+func (r *AddressResolver) createAddress(ctx context.Context, patientID string, input generated.CreateAddressInput) (*model.Address, error) {
+	if validationErrors := validatePatientID(ctx, patientID); validationErrors != nil {
+		return nil, validationErrors
+	}
+
+	req := request.AddressCreateRequest{
+		Line1: input.Line1,
+		City:  input.City,
+		State: input.State,
+		Zip:   input.Zip,
+	}
+	if input.Line2 != nil {
+		req.Line2 = *input.Line2
+	}
+	if _, validationErrors := validation.ValidateGraphQLInput(ctx, req); validationErrors != nil {
+		r.Logger.Error("Address input validation failed",
+			zap.Any("validation_errors", validationErrors.Errors))
+		return nil, validationErrors
+	}
+
+	created, err := r.AddressService.Create(ctx, patientID, req)
+	if err != nil {
+		r.Logger.Error("Failed to create address",
+			zap.String("patient_id", patientID),
+			zap.Error(err))
+		return nil, addressError(err)
+	}
+	return &created, nil
+}
+
+// UpdateAddress resolves the updateAddress mutation
+func (r *AddressResolver) UpdateAddress(ctx context.Context, patientID string, id string, input generated.UpdateAddressInput) (*generated.UpdateAddressPayload, error) {
+	record, err := r.updateAddress(ctx, patientID, id, input)
+	userErrors, err := validation.UserErrors(err)
+	if err != nil {
+		return nil, err
+	}
+	return &generated.UpdateAddressPayload{Address: record, UserErrors: userErrors}, nil
+}
+
+func (r *AddressResolver) updateAddress(ctx context.Context, patientID string, id string, input generated.UpdateAddressInput) (*model.Address, error) {
+	if validationErrors := validatePatientID(ctx, patientID); validationErrors != nil {
+		return nil, validationErrors
+	}
+
+	req := request.AddressUpdateRequest{
+		Line1: input.Line1,
+		Line2: input.Line2,
+		City:  input.City,
+		State: input.State,
+		Zip:   input.Zip,
+	}
+	if _, validationErrors := validation.ValidateGraphQLInput(ctx, req); validationErrors != nil {
+		r.Logger.Error("Address update validation failed",
+			zap.Any("validation_errors", validationErrors.Errors))
+		return nil, validationErrors
+	}
+
+	updated, err := r.AddressService.Update(ctx, patientID, id, req)
+	if err != nil {
+		r.Logger.Error("Failed to update address",
+			zap.String("patient_id", patientID),
+			zap.String("address_id", id),
+			zap.Error(err))
+		return nil, addressError(err)
+	}
+	return &updated, nil
+}
+
+// DeleteAddress resolves the deleteAddress mutation
+func (r *AddressResolver) DeleteAddress(ctx context.Context, patientID string, id string) (*generated.DeleteAddressPayload, error) {
+	err := r.deleteAddress(ctx, patientID, id)
+	userErrors, err := validation.UserErrors(err)
+	if err != nil {
+		return nil, err
+	}
+	payload := &generated.DeleteAddressPayload{UserErrors: userErrors}
+	if len(userErrors) == 0 {
+		payload.DeletedID = &id
+	}
+	return payload, nil
+}
+
+func (r *AddressResolver) deleteAddress(ctx context.Context, patientID string, id string) error {
+	if validationErrors := validatePatientID(ctx, patientID); validationErrors != nil {
+		return validationErrors
+	}
+
+	if err := r.AddressService.Delete(ctx, patientID, id); err != nil {
+		r.Logger.Error("Failed to delete address",
+			zap.String("patient_id", patientID),
+			zap.String("address_id", id),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// addressError reports an address left without a required field as a validation error
+func addressError(err error) error {
+	if errors.Is(err, patientservice.ErrInvalidAddress) {
+		return platformErrors.NewValidationError("", nil, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,154 @@
+package graphql
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+	patientservice "pharmacy-modernization-project-model/domain/patient/service"
+	"pharmacy-modernization-project-model/internal/graphql/generated"
+	"pharmacy-modernization-project-model/internal/graphql/validation"
+)
+
+// AllergyResolver handles patient allergy GraphQL operations
+type AllergyResolver struct {
+	AllergyService patientservice.AllergyService
+	Logger         *zap.Logger
+}
+
+// NewAllergyResolver creates a new allergy resolver
+func NewAllergyResolver(
+	allergySvc patientservice.AllergyService,
+	logger *zap.Logger,
+) *AllergyResolver {
+	return &AllergyResolver{
+		AllergyService: allergySvc,
+		Logger:         logger,
+	}
+}
+
+// ============================================================================
+// Field Resolvers
+// ============================================================================
+
+// Allergies resolves the allergies field on Patient, newest first
+func (r *AllergyResolver) Allergies(ctx context.Context, obj *model.Patient) ([]model.Allergy, error) {
+	allergies, err := r.AllergyService.List(ctx, obj.ID)
+	if err != nil {
+		r.Logger.Error("Failed to fetch allergies for patient",
+			zap.String("patient_id", obj.ID),
+			zap.Error(err))
+		return []model.Allergy{}, nil // Return empty array on error to avoid null
+	}
+	return allergies, nil
+}
+
+// Severity resolves the allergy severity as a plain string
+func (r *AllergyResolver) Severity(ctx context.Context, obj *model.Allergy) (string, error) {
+	return string(obj.Severity), nil
+}
+
+// ============================================================================
+// Mutation Resolvers
+// ============================================================================
+
+// RecordAllergy resolves the recordAllergy mutation
+func (r *AllergyResolver) RecordAllergy(ctx context.Context, patientID string, input generated.RecordAllergyInput) (*generated.RecordAllergyPayload, error) {
+	record, err := r.recordAllergy(ctx, patientID, input)
+	userErrors, err := validation.UserErrors(err)
+	if err != nil {
+		return nil, err
+	}
+	return &generated.RecordAllergyPayload{Allergy: record, UserErrors: userErrors}, nil
+}
+
+func (r *AllergyResolver) recordAllergy(ctx context.Context, patientID string, input generated.RecordAllergyInput) (*model.Allergy, error) {
+	if validationErrors := validatePatientID(ctx, patientID); validationErrors != nil {
+		return nil, validationErrors
+	}
+
+	req := request.AllergyCreateRequest{
+		Substance: input.Substance,
+		Severity:  input.Severity,
+	}
+	if input.Reaction != nil {
+		req.Reaction = *input.Reaction
+	}
+	if _, validationErrors := validation.ValidateGraphQLInput(ctx, req); validationErrors != nil {
+		r.Logger.Error("Allergy input validation failed",
+			zap.Any("validation_errors", validationErrors.Errors))
+		return nil, validationErrors
+	}
+
+	created, err := r.AllergyService.Create(ctx, patientID, currentUserRef(ctx), req)
+	if err != nil {
+		r.Logger.Error("Failed to record allergy",
+			zap.Error(err))
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateAllergy resolves the updateAllergy mutation
+func (r *AllergyResolver) UpdateAllergy(ctx context.Context, patientID string, id string, input generated.UpdateAllergyInput) (*generated.UpdateAllergyPayload, error) {
+	record, err := r.updateAllergy(ctx, patientID, id, input)
+	userErrors, err := validation.UserErrors(err)
+	if err != nil {
+		return nil, err
+	}
+	return &generated.UpdateAllergyPayload{Allergy: record, UserErrors: userErrors}, nil
+}
+
+func (r *AllergyResolver) updateAllergy(ctx context.Context, patientID string, id string, input generated.UpdateAllergyInput) (*model.Allergy, error) {
+	if validationErrors := validatePatientID(ctx, patientID); validationErrors != nil {
+		return nil, validationErrors
+	}
+
+	req := request.AllergyUpdateRequest{
+		Substance: input.Substance,
+		Reaction:  input.Reaction,
+		Severity:  input.Severity,
+	}
+	if _, validationErrors := validation.ValidateGraphQLInput(ctx, req); validationErrors != nil {
+		r.Logger.Error("Allergy update validation failed",
+			zap.Any("validation_errors", validationErrors.Errors))
+		return nil, validationErrors
+	}
+
+	updated, err := r.AllergyService.Update(ctx, patientID, id, currentUserRef(ctx), req)
+	if err != nil {
+		r.Logger.Error("Failed to update allergy",
+			zap.Error(err))
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteAllergy resolves the deleteAllergy mutation
+func (r *AllergyResolver) DeleteAllergy(ctx context.Context, patientID string, id string) (*generated.DeleteAllergyPayload, error) {
+	err := r.deleteAllergy(ctx, patientID, id)
+	userErrors, err := validation.UserErrors(err)
+	if err != nil {
+		return nil, err
+	}
+	payload := &generated.DeleteAllergyPayload{UserErrors: userErrors}
+	if len(userErrors) == 0 {
+		payload.DeletedID = &id
+	}
+	return payload, nil
+}
+
+func (r *AllergyResolver) deleteAllergy(ctx context.Context, patientID string, id string) error {
+	if validationErrors := validatePatientID(ctx, patientID); validationErrors != nil {
+		return validationErrors
+	}
+
+	if err := r.AllergyService.Delete(ctx, patientID, id); err != nil {
+		r.Logger.Error("Failed to delete allergy",
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package graphql
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/patient/contracts/model"
+	patientservice "pharmacy-modernization-project-model/domain/patient/service"
+)
+
+// InsuranceResolver handles insurance coverage GraphQL operations
+type InsuranceResolver struct {
+	InsuranceService patientservice.InsuranceService
+	Logger           *zap.Logger
+}
+
+// NewInsuranceResolver creates a new insurance resolver
+func NewInsuranceResolver(
+	insuranceSvc patientservice.InsuranceService,
+	logger *zap.Logger,
+) *InsuranceResolver {
+	return &InsuranceResolver{
+		InsuranceService: insuranceSvc,
+		Logger:           logger,
+	}
+}
+
+// ============================================================================
+// Field Resolvers
+// ============================================================================
+
+// Insurance resolves the insurance field on Patient, newest first
+func (r *InsuranceResolver) Insurance(ctx context.Context, obj *model.Patient, activeOnly *bool) ([]model.InsuranceRecord, error) {
+	records, err := r.InsuranceService.List(ctx, obj.ID)
+	if err != nil {
+		r.Logger.Error("Failed to fetch insurance for patient",
+			zap.String("patient_id", obj.ID),
+			zap.Error(err))
+		return nil, err
+	}
+	if activeOnly == nil || !*activeOnly {
+		return records, nil
+	}
+
+	today := time.Now()
+	active := []model.InsuranceRecord{}
+	for _, record := range records {
+		if record.CoversOn(today) {
+			active = append(active, record)
+		}
+	}
+	return active, nil
+}
+
+// Status resolves the insurance status as a plain string
+func (r *InsuranceResolver) Status(ctx context.Context, obj *model.InsuranceRecord) (string, error) {
+	return string(obj.Status), nil
+}
+
+// Active resolves whether the record is confirmed coverage in effect today
+func (r *InsuranceResolver) Active(ctx context.Context, obj *model.InsuranceRecord) (bool, error) {
+	return obj.CoversOn(time.Now()), nil
+}
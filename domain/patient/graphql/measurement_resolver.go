@@ -0,0 +1,200 @@
+package graphql
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+	patientservice "pharmacy-modernization-project-model/domain/patient/service"
+	"pharmacy-modernization-project-model/internal/graphql/generated"
+	"pharmacy-modernization-project-model/internal/graphql/validation"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+)
+
+// MeasurementResolver handles vital statistics and measurement GraphQL operations
+type MeasurementResolver struct {
+	MeasurementService patientservice.MeasurementService
+	Logger             *zap.Logger
+}
+
+// NewMeasurementResolver creates a new measurement resolver
+func NewMeasurementResolver(
+	measurementSvc patientservice.MeasurementService,
+	logger *zap.Logger,
+) *MeasurementResolver {
+	return &MeasurementResolver{
+		MeasurementService: measurementSvc,
+		Logger:             logger,
+	}
+}
+
+// ============================================================================
+// Field Resolvers
+// ============================================================================
+
+// Measurements resolves the measurements field on Patient, newest first
+func (r *MeasurementResolver) Measurements(ctx context.Context, obj *model.Patient, measurementType *string, limit *int) ([]model.Measurement, error) {
+	query := request.MeasurementListQueryRequest{}
+	if measurementType != nil {
+		query.Type = *measurementType
+	}
+	if limit != nil {
+		query.Limit = *limit
+	}
+	if _, validationErrors := validation.ValidateGraphQLInput(ctx, query); validationErrors != nil {
+		return nil, validationErrors
+	}
+
+	measurements, err := r.MeasurementService.List(ctx, obj.ID, model.MeasurementType(query.Type), query.Limit)
+	if err != nil {
+		r.Logger.Error("Failed to fetch measurements for patient",
+			zap.String("patient_id", obj.ID),
+			zap.Error(err))
+		return []model.Measurement{}, nil // Return empty array on error to avoid null
+	}
+	return measurements, nil
+}
+
+// LatestMeasurement resolves the latestMeasurement field on Patient
+func (r *MeasurementResolver) LatestMeasurement(ctx context.Context, obj *model.Patient, measurementType string) (*model.Measurement, error) {
+	latest, err := r.MeasurementService.Latest(ctx, obj.ID, model.MeasurementType(measurementType))
+	if err != nil {
+		return nil, err
+	}
+	if latest.ID == "" {
+		return nil, nil
+	}
+	return &latest, nil
+}
+
+// Type resolves the measurement type as a plain string
+func (r *MeasurementResolver) Type(ctx context.Context, obj *model.Measurement) (string, error) {
+	return string(obj.Type), nil
+}
+
+// ============================================================================
+// Mutation Resolvers
+// ============================================================================
+
+// RecordMeasurement resolves the recordMeasurement mutation
+func (r *MeasurementResolver) RecordMeasurement(ctx context.Context, patientID string, input generated.RecordMeasurementInput) (*generated.RecordMeasurementPayload, error) {
+	record, err := r.recordMeasurement(ctx, patientID, input)
+	userErrors, err := validation.UserErrors(err)
+	if err != nil {
+		return nil, err
+	}
+	return &generated.RecordMeasurementPayload{Measurement: record, UserErrors: userErrors}, nil
+}
+
+func (r *MeasurementResolver) recordMeasurement(ctx context.Context, patientID string, input generated.RecordMeasurementInput) (*model.Measurement, error) {
+	if validationErrors := validatePatientID(ctx, patientID); validationErrors != nil {
+		return nil, validationErrors
+	}
+
+	req := request.MeasurementCreateRequest{
+		Type:       input.Type,
+		Value:      input.Value,
+		Unit:       input.Unit,
+		RecordedAt: input.RecordedAt,
+	}
+	if _, validationErrors := validation.ValidateGraphQLInput(ctx, req); validationErrors != nil {
+		r.Logger.Error("Measurement input validation failed",
+			zap.Any("validation_errors", validationErrors.Errors))
+		return nil, validationErrors
+	}
+
+	created, err := r.MeasurementService.Create(ctx, patientID, currentUserRef(ctx), req)
+	if err != nil {
+		r.Logger.Error("Failed to record measurement",
+			zap.Error(err))
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateMeasurement resolves the updateMeasurement mutation
+func (r *MeasurementResolver) UpdateMeasurement(ctx context.Context, patientID string, id string, input generated.UpdateMeasurementInput) (*generated.UpdateMeasurementPayload, error) {
+	record, err := r.updateMeasurement(ctx, patientID, id, input)
+	userErrors, err := validation.UserErrors(err)
+	if err != nil {
+		return nil, err
+	}
+	return &generated.UpdateMeasurementPayload{Measurement: record, UserErrors: userErrors}, nil
+}
+
+func (r *MeasurementResolver) updateMeasurement(ctx context.Context, patientID string, id string, input generated.UpdateMeasurementInput) (*model.Measurement, error) {
+	if validationErrors := validatePatientID(ctx, patientID); validationErrors != nil {
+		return nil, validationErrors
+	}
+
+	req := request.MeasurementUpdateRequest{
+		Value:      input.Value,
+		Unit:       input.Unit,
+		RecordedAt: input.RecordedAt,
+	}
+	if _, validationErrors := validation.ValidateGraphQLInput(ctx, req); validationErrors != nil {
+		r.Logger.Error("Measurement update validation failed",
+			zap.Any("validation_errors", validationErrors.Errors))
+		return nil, validationErrors
+	}
+
+	updated, err := r.MeasurementService.Update(ctx, patientID, id, currentUserRef(ctx), req)
+	if err != nil {
+		r.Logger.Error("Failed to update measurement",
+			zap.Error(err))
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteMeasurement resolves the deleteMeasurement mutation
+func (r *MeasurementResolver) DeleteMeasurement(ctx context.Context, patientID string, id string) (*generated.DeleteMeasurementPayload, error) {
+	err := r.deleteMeasurement(ctx, patientID, id)
+	userErrors, err := validation.UserErrors(err)
+	if err != nil {
+		return nil, err
+	}
+	payload := &generated.DeleteMeasurementPayload{UserErrors: userErrors}
+	if len(userErrors) == 0 {
+		payload.DeletedID = &id
+	}
+	return payload, nil
+}
+
+func (r *MeasurementResolver) deleteMeasurement(ctx context.Context, patientID string, id string) error {
+	if validationErrors := validatePatientID(ctx, patientID); validationErrors != nil {
+		return validationErrors
+	}
+
+	if err := r.MeasurementService.Delete(ctx, patientID, id); err != nil {
+		r.Logger.Error("Failed to delete measurement",
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func validatePatientID(ctx context.Context, patientID string) *validation.GraphQLValidationErrors {
+	_, validationErrors := validation.ValidateGraphQLInput(ctx, validation.PatientQueryValidation{ID: patientID})
+	if validationErrors != nil {
+		// Reported against the argument rather than the query struct's id
+		for i := range validationErrors.Errors {
+			validationErrors.Errors[i].Field = "patientID"
+		}
+	}
+	return validationErrors
+}
+
+// currentUserRef identifies the authenticated user recording a measurement
+func currentUserRef(ctx context.Context) string {
+	user, err := auth.GetCurrentUser(ctx)
+	if err != nil {
+		return ""
+	}
+	if user.Email != "" {
+		return user.Email
+	}
+	return user.ID
+}
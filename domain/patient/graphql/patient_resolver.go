@@ -2,17 +2,23 @@ package graphql
 
 import (
 	"context"
+	"errors"
+	"slices"
 
 	"go.uber.org/zap"
 
 	"pharmacy-modernization-project-model/domain/patient/contracts/model"
 	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+	patientErrors "pharmacy-modernization-project-model/domain/patient/errors"
+	patientsecurity "pharmacy-modernization-project-model/domain/patient/security"
 	patientservice "pharmacy-modernization-project-model/domain/patient/service"
 	model1 "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	prescriptiongraphql "pharmacy-modernization-project-model/domain/prescription/graphql"
 	prescriptionservice "pharmacy-modernization-project-model/domain/prescription/service"
 	"pharmacy-modernization-project-model/internal/graphql/generated"
 	"pharmacy-modernization-project-model/internal/graphql/validation"
-	"pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
 )
 
 // PatientResolver handles Patient domain GraphQL operations
@@ -21,7 +27,11 @@ import (
 type PatientResolver struct {
 	PatientService      patientservice.PatientService
 	PrescriptionService prescriptionservice.PrescriptionService
-	AddressResolver     *AddressResolver // Delegates address operations
+	AddressResolver     *AddressResolver     // Delegates address operations
+	MeasurementResolver *MeasurementResolver // Delegates vital statistics operations
+	AllergyResolver     *AllergyResolver     // Delegates allergy operations
+	InsuranceResolver   *InsuranceResolver   // Delegates insurance coverage
+	SearchResolver      *SearchResolver      // Delegates patient search
 	Logger              *zap.Logger
 }
 
@@ -29,6 +39,10 @@ type PatientResolver struct {
 func NewPatientResolver(
 	patientSvc patientservice.PatientService,
 	addressSvc patientservice.AddressService,
+	measurementSvc patientservice.MeasurementService,
+	allergySvc patientservice.AllergyService,
+	searchSvc patientservice.PatientSearchService,
+	insuranceSvc patientservice.InsuranceService,
 	prescriptionSvc prescriptionservice.PrescriptionService,
 	logger *zap.Logger,
 ) *PatientResolver {
@@ -36,6 +50,10 @@ func NewPatientResolver(
 		PatientService:      patientSvc,
 		PrescriptionService: prescriptionSvc,
 		AddressResolver:     NewAddressResolver(addressSvc, logger),
+		MeasurementResolver: NewMeasurementResolver(measurementSvc, logger),
+		AllergyResolver:     NewAllergyResolver(allergySvc, logger),
+		InsuranceResolver:   NewInsuranceResolver(insuranceSvc, logger),
+		SearchResolver:      NewSearchResolver(searchSvc, logger),
 		Logger:              logger,
 	}
 }
@@ -48,7 +66,7 @@ func NewPatientResolver(
 func (r *PatientResolver) Patient(ctx context.Context, id string) (*model.Patient, error) {
 	// Validate ID parameter using bind validation
 	idValidation := validation.PatientQueryValidation{ID: id}
-	_, validationErrors := validation.ValidateGraphQLInput(idValidation)
+	_, validationErrors := validation.ValidateGraphQLInput(ctx, idValidation)
 	if validationErrors != nil {
 		r.Logger.Error("Patient ID validation failed",
 			zap.Any("validation_errors", validationErrors.Errors))
@@ -59,30 +77,24 @@ func (r *PatientResolver) Patient(ctx context.Context, id string) (*model.Patien
 	if err != nil {
 		r.Logger.Error("Failed to fetch patient",
 			zap.Error(err))
-
-		if errors.IsNotFoundError(err) {
-			return nil, nil // Return null for not found
-		}
 		return nil, err
 	}
 	return &patient, nil
 }
 
 // Patients resolves the patients query
-func (r *PatientResolver) Patients(ctx context.Context, query *string, limit *int, offset *int) ([]model.Patient, error) {
+func (r *PatientResolver) Patients(ctx context.Context, query *string, state *string, minAge *int, maxAge *int, hasActivePrescriptions *bool, limit *int, offset *int) ([]model.Patient, error) {
 	// Validate query parameters using bind validation
-	queryValidation := validation.PatientsQueryValidation{}
-	if query != nil {
-		queryValidation.Query = query
-	}
-	if limit != nil {
-		queryValidation.Limit = limit
-	}
-	if offset != nil {
-		queryValidation.Offset = offset
+	queryValidation := validation.PatientsQueryValidation{
+		Query:  query,
+		State:  state,
+		MinAge: minAge,
+		MaxAge: maxAge,
+		Limit:  limit,
+		Offset: offset,
 	}
 
-	_, validationErrors := validation.ValidateGraphQLInput(queryValidation)
+	_, validationErrors := validation.ValidateGraphQLInput(ctx, queryValidation)
 	if validationErrors != nil {
 		r.Logger.Error("Patients query validation failed",
 			zap.Any("validation_errors", validationErrors.Errors))
@@ -90,14 +102,21 @@ func (r *PatientResolver) Patients(ctx context.Context, query *string, limit *in
 	}
 
 	req := request.PatientListQueryRequest{
-		Limit:  50, // default limit
-		Offset: 0,
+		Limit:                  50, // default limit
+		Offset:                 0,
+		MinAge:                 minAge,
+		MaxAge:                 maxAge,
+		HasActivePrescriptions: hasActivePrescriptions,
 	}
 
 	if query != nil {
 		req.PatientName = *query
 	}
 
+	if state != nil {
+		req.State = *state
+	}
+
 	if limit != nil {
 		req.Limit = *limit
 	}
@@ -106,6 +125,10 @@ func (r *PatientResolver) Patients(ctx context.Context, query *string, limit *in
 		req.Offset = *offset
 	}
 
+	// Limit results to the caller's data-access scope
+	user, _ := auth.GetCurrentUser(ctx)
+	req.AllowedStates = patientsecurity.AllowedStates(user)
+
 	patients, err := r.PatientService.List(ctx, req)
 	if err != nil {
 		r.Logger.Error("Failed to list patients",
@@ -118,15 +141,67 @@ func (r *PatientResolver) Patients(ctx context.Context, query *string, limit *in
 	return patients, nil
 }
 
+// PatientCountByState resolves the patientCountByState query
+func (r *PatientResolver) PatientCountByState(ctx context.Context) ([]model.StateCount, error) {
+	// Limit the counts to the caller's data-access scope
+	user, _ := auth.GetCurrentUser(ctx)
+	return r.PatientService.CountByState(ctx, patientsecurity.AllowedStates(user))
+}
+
+// ============================================================================
+// Entity Resolvers
+// ============================================================================
+
+// FindPatientByID resolves a patient a federated gateway references by id. _entities carries no
+// directives, so the read permission is checked here; a patient that does not exist or is
+// outside the caller's data-access scope resolves to null.
+func (r *PatientResolver) FindPatientByID(ctx context.Context, id string) (*model.Patient, error) {
+	if err := auth.RequireAnyPermission(ctx, patientsecurity.ReadAccess); err != nil {
+		return nil, err
+	}
+
+	patient, err := r.Patient(ctx, id)
+	if platformErrors.IsNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	user, _ := auth.GetCurrentUser(ctx)
+	if allowed := patientsecurity.AllowedStates(user); allowed != nil && !slices.Contains(allowed, patient.State) {
+		return nil, nil
+	}
+	return patient, nil
+}
+
 // ============================================================================
 // Mutation Resolvers
 // ============================================================================
 
-// CreatePatient resolves the createPatient mutation
-func (r *PatientResolver) CreatePatient(ctx context.Context, input generated.CreatePatientInput) (*model.Patient, error) {
+// CreatePatient resolves the createPatient mutation; likely duplicates are returned whether they
+// only warn or blocked the patient
+func (r *PatientResolver) CreatePatient(ctx context.Context, input generated.CreatePatientInput) (*generated.CreatePatientPayload, error) {
+	record, err := r.createPatient(ctx, input)
+	duplicates := []model.PotentialDuplicate{}
+	var duplicateErr patientErrors.DuplicatePatientError
+	switch {
+	case errors.As(err, &duplicateErr):
+		duplicates = duplicateErr.Duplicates
+	case record != nil && record.PotentialDuplicates != nil:
+		duplicates = record.PotentialDuplicates
+	}
+	userErrors, err := validation.UserErrors(err)
+	if err != nil {
+		return nil, err
+	}
+	return &generated.CreatePatientPayload{Patient: record, PotentialDuplicates: duplicates, UserErrors: userErrors}, nil
+}
+
+func (r *PatientResolver) createPatient(ctx context.Context, input generated.CreatePatientInput) (*model.Patient, error) {
 	// Validate input using bind validation
 	validationInput := validation.ConvertCreatePatientInput(input)
-	_, validationErrors := validation.ValidateGraphQLInput(validationInput)
+	_, validationErrors := validation.ValidateGraphQLInput(ctx, validationInput)
 	if validationErrors != nil {
 		r.Logger.Error("Patient creation validation failed",
 			zap.Any("validation_errors", validationErrors.Errors))
@@ -153,10 +228,19 @@ func (r *PatientResolver) CreatePatient(ctx context.Context, input generated.Cre
 }
 
 // UpdatePatient resolves the updatePatient mutation
-func (r *PatientResolver) UpdatePatient(ctx context.Context, id string, input generated.UpdatePatientInput) (*model.Patient, error) {
+func (r *PatientResolver) UpdatePatient(ctx context.Context, id string, input generated.UpdatePatientInput) (*generated.UpdatePatientPayload, error) {
+	record, err := r.updatePatient(ctx, id, input)
+	userErrors, err := validation.UserErrors(err)
+	if err != nil {
+		return nil, err
+	}
+	return &generated.UpdatePatientPayload{Patient: record, UserErrors: userErrors}, nil
+}
+
+func (r *PatientResolver) updatePatient(ctx context.Context, id string, input generated.UpdatePatientInput) (*model.Patient, error) {
 	// Validate ID parameter
 	idValidation := validation.PatientQueryValidation{ID: id}
-	_, validationErrors := validation.ValidateGraphQLInput(idValidation)
+	_, validationErrors := validation.ValidateGraphQLInput(ctx, idValidation)
 	if validationErrors != nil {
 		r.Logger.Error("Patient ID validation failed",
 			zap.Any("validation_errors", validationErrors.Errors))
@@ -165,7 +249,7 @@ func (r *PatientResolver) UpdatePatient(ctx context.Context, id string, input ge
 
 	// Validate input using bind validation
 	validationInput := validation.ConvertUpdatePatientInput(input)
-	_, validationErrors = validation.ValidateGraphQLInput(validationInput)
+	_, validationErrors = validation.ValidateGraphQLInput(ctx, validationInput)
 	if validationErrors != nil {
 		r.Logger.Error("Patient update validation failed",
 			zap.Any("validation_errors", validationErrors.Errors))
@@ -192,6 +276,9 @@ func (r *PatientResolver) UpdatePatient(ctx context.Context, id string, input ge
 	if input.State != nil {
 		existingPatient.State = *input.State
 	}
+	if input.ContactPreferences != nil {
+		existingPatient.ContactPreferences = contactPreferences(*input.ContactPreferences)
+	}
 
 	// Update patient
 	err = r.PatientService.Update(ctx, existingPatient)
@@ -204,27 +291,77 @@ func (r *PatientResolver) UpdatePatient(ctx context.Context, id string, input ge
 	return &existingPatient, nil
 }
 
+// contactPreferences converts validated GraphQL contact preferences to the domain model
+func contactPreferences(input generated.ContactPreferencesInput) *model.ContactPreferences {
+	preferences := &model.ContactPreferences{
+		Channels:     make([]model.ContactChannel, 0, len(input.Channels)),
+		DoNotContact: input.DoNotContact,
+	}
+	for _, channel := range input.Channels {
+		preferences.Channels = append(preferences.Channels, model.ContactChannel(channel))
+	}
+	if input.Email != nil {
+		preferences.Email = *input.Email
+	}
+	return preferences
+}
+
+// ContactChannels resolves the channels of the contact preferences as plain strings
+func (r *PatientResolver) ContactChannels(ctx context.Context, obj *model.ContactPreferences) ([]string, error) {
+	channels := make([]string, 0, len(obj.Channels))
+	for _, channel := range obj.Channels {
+		channels = append(channels, string(channel))
+	}
+	return channels, nil
+}
+
 // Addresses resolves the addresses field on Patient
 // Phase 2: Delegates to AddressResolver for better separation of concerns
 func (r *PatientResolver) Addresses(ctx context.Context, obj *model.Patient) ([]model.Address, error) {
 	return r.AddressResolver.Addresses(ctx, obj)
 }
 
+// Measurements resolves the measurements field on Patient
+// Delegates to MeasurementResolver
+func (r *PatientResolver) Measurements(ctx context.Context, obj *model.Patient, measurementType *string, limit *int) ([]model.Measurement, error) {
+	return r.MeasurementResolver.Measurements(ctx, obj, measurementType, limit)
+}
+
+// LatestMeasurement resolves the latestMeasurement field on Patient
+func (r *PatientResolver) LatestMeasurement(ctx context.Context, obj *model.Patient, measurementType string) (*model.Measurement, error) {
+	return r.MeasurementResolver.LatestMeasurement(ctx, obj, measurementType)
+}
+
+// Allergies resolves the allergies field on Patient
+// Delegates to AllergyResolver
+func (r *PatientResolver) Allergies(ctx context.Context, obj *model.Patient) ([]model.Allergy, error) {
+	return r.AllergyResolver.Allergies(ctx, obj)
+}
+
 // Prescriptions resolves the prescriptions field on Patient
-func (r *PatientResolver) Prescriptions(ctx context.Context, obj *model.Patient) ([]model1.Prescription, error) {
-	prescriptions, err := r.PrescriptionService.List(ctx, "", 100, 0)
-	if err != nil {
-		r.Logger.Error("Failed to fetch prescriptions for patient",
-			zap.Error(err))
-		return []model1.Prescription{}, nil
+func (r *PatientResolver) Prescriptions(ctx context.Context, obj *model.Patient, status *generated.PrescriptionStatus, limit *int) ([]model1.Prescription, error) {
+	query := request.PatientPrescriptionsQueryRequest{}
+	if limit != nil {
+		query.Limit = *limit
+	}
+	if _, validationErrors := validation.ValidateGraphQLInput(ctx, query); validationErrors != nil {
+		return nil, validationErrors
 	}
 
-	// Filter by patient ID
-	var patientPrescriptions []model1.Prescription
-	for _, p := range prescriptions {
-		if p.PatientID == obj.ID {
-			patientPrescriptions = append(patientPrescriptions, p)
+	var domainStatus model1.Status
+	if status != nil {
+		converted, err := prescriptiongraphql.DomainStatus(*status)
+		if err != nil {
+			return nil, err
 		}
+		domainStatus = converted
+	}
+	prescriptions, err := r.PrescriptionService.ListForPatient(ctx, obj.ID, domainStatus, query.Limit)
+	if err != nil {
+		r.Logger.Error("Failed to fetch prescriptions for patient",
+			zap.String("patient_id", obj.ID),
+			zap.Error(err))
+		return nil, err
 	}
-	return patientPrescriptions, nil
+	return prescriptions, nil
 }
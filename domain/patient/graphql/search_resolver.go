@@ -0,0 +1,47 @@
+package graphql
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+	patientservice "pharmacy-modernization-project-model/domain/patient/service"
+	"pharmacy-modernization-project-model/internal/graphql/validation"
+)
+
+// SearchResolver handles patient search GraphQL operations
+type SearchResolver struct {
+	SearchService patientservice.PatientSearchService
+	Logger        *zap.Logger
+}
+
+// NewSearchResolver creates a new patient search resolver
+func NewSearchResolver(searchSvc patientservice.PatientSearchService, logger *zap.Logger) *SearchResolver {
+	return &SearchResolver{
+		SearchService: searchSvc,
+		Logger:        logger,
+	}
+}
+
+// SearchPatients resolves the searchPatients query
+func (r *SearchResolver) SearchPatients(ctx context.Context, query string, limit *int) ([]model.PatientSearchResult, error) {
+	req := request.PatientSearchQueryRequest{Q: query}
+	if limit != nil {
+		req.Limit = *limit
+	}
+	if _, validationErrors := validation.ValidateGraphQLInput(ctx, req); validationErrors != nil {
+		r.Logger.Error("Patient search validation failed",
+			zap.Any("validation_errors", validationErrors.Errors))
+		return nil, validationErrors
+	}
+
+	results, err := r.SearchService.Search(ctx, req)
+	if err != nil {
+		r.Logger.Error("Failed to search patients",
+			zap.Error(err))
+		return nil, err
+	}
+	return results, nil
+}
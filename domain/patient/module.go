@@ -13,40 +13,99 @@ import (
 	uipatient "pharmacy-modernization-project-model/domain/patient/ui"
 	uipatientContracts "pharmacy-modernization-project-model/domain/patient/ui/contracts"
 	"pharmacy-modernization-project-model/internal/platform/cache"
+	"pharmacy-modernization-project-model/internal/platform/database"
+	"pharmacy-modernization-project-model/internal/platform/events"
+	"pharmacy-modernization-project-model/internal/platform/fieldcrypt"
+	"pharmacy-modernization-project-model/internal/platform/navigation"
 )
 
 type ModuleDependencies struct {
-	Logger                   *zap.Logger
-	PrescriptionProvider     patientproviders.PatientPrescriptionProvider
-	InvoiceProvider          patientproviders.PatientInvoiceProvider
-	PatientsMongoCollection  *mongo.Collection
-	AddressesMongoCollection *mongo.Collection
-	CacheService             cache.Cache
+	Logger                         *zap.Logger
+	PrescriptionProvider           patientproviders.PatientPrescriptionProvider
+	InvoiceProvider                patientproviders.PatientInvoiceProvider
+	PatientsMongoCollection        *mongo.Collection
+	AddressesMongoCollection       *mongo.Collection
+	MeasurementsMongoCollection    *mongo.Collection
+	AllergiesMongoCollection       *mongo.Collection
+	InsuranceMongoCollection       *mongo.Collection
+	ImportTemplatesMongoCollection *mongo.Collection
+	DocumentsMongoCollection       *mongo.Collection
+	PrescriptionsMongoCollection   *mongo.Collection  // Joined to count prescriptions on patient lists
+	FieldCipher                    *fieldcrypt.Cipher // Encrypts patient PHI in MongoDB; nil keeps it in plaintext
+	Retrier                        *database.Retrier  // Retries the reads and idempotent writes of the MongoDB repositories; nil runs them once
+	Events                         events.Publisher   // Publishes the services' domain events; nil publishes none
+	AttachmentProvider             patientproviders.AttachmentProvider
+	CardOCRProvider                patientproviders.InsuranceCardOCRProvider
+	DocumentBlobProvider           patientproviders.DocumentBlobProvider
+	DocumentScanProvider           patientproviders.DocumentScanProvider // nil stores documents unscanned
+	CacheService                   cache.Cache
+	CacheLoader                    *cache.Loader         // Reads patients through CacheService; nil to use it directly
+	CacheSerializer                *cache.Serializer     // Encodes cached patients and counts; nil stores JSON
+	Navigation                     *navigation.BackStack // Back links and breadcrumbs of the UI pages
+	Export                         patientservice.ExportConfig
+	Import                         patientservice.ImportConfig
+	Duplicates                     patientservice.DuplicateConfig
+	InsuranceIntake                patientservice.InsuranceIntakeConfig
+	Documents                      patientservice.DocumentConfig
 }
 
 type ModuleExport struct {
-	PatientService patientservice.PatientService
-	AddressService patientservice.AddressService
+	PatientService     patientservice.PatientService
+	AddressService     patientservice.AddressService
+	MeasurementService patientservice.MeasurementService
+	AllergyService     patientservice.AllergyService
+	SearchService      patientservice.PatientSearchService
+	InsuranceService   patientservice.InsuranceService
 }
 
 func Module(r chi.Router, deps *ModuleDependencies) ModuleExport {
-	patRepo := patientbuilder.CreatePatientRepository(deps.Logger, deps.PatientsMongoCollection)
-	addrRepo := patientbuilder.CreateAddressRepository(deps.Logger, deps.AddressesMongoCollection)
+	patRepo := patientbuilder.CreatePatientRepository(deps.Logger, deps.PatientsMongoCollection, deps.FieldCipher, deps.Retrier)
+	addrRepo := patientbuilder.CreateAddressRepository(deps.Logger, deps.AddressesMongoCollection, deps.Retrier)
+	measurementRepo := patientbuilder.CreateMeasurementRepository(deps.Logger, deps.MeasurementsMongoCollection, deps.Retrier)
+	allergyRepo := patientbuilder.CreateAllergyRepository(deps.Logger, deps.AllergiesMongoCollection, deps.Retrier)
+	insuranceRepo := patientbuilder.CreateInsuranceRepository(deps.Logger, deps.InsuranceMongoCollection, deps.Retrier)
+	importTemplateRepo := patientbuilder.CreateImportTemplateRepository(deps.Logger, deps.ImportTemplatesMongoCollection, deps.Retrier)
+	documentRepo := patientbuilder.CreateDocumentRepository(deps.Logger, deps.DocumentsMongoCollection, deps.Retrier)
+	searchRepo := patientbuilder.CreatePatientSearchRepository(deps.Logger, deps.PatientsMongoCollection, deps.AddressesMongoCollection, deps.FieldCipher, patRepo, addrRepo, deps.Retrier)
 
-	patSvc := patientservice.New(patRepo, deps.CacheService, deps.Logger)
-	addrSvc := patientservice.NewAddressService(addrRepo)
+	publisher := deps.Events
+	if publisher == nil {
+		publisher = events.Discard
+	}
+
+	countRepo := patientbuilder.CreatePrescriptionCountRepository(deps.Logger, deps.PatientsMongoCollection, deps.PrescriptionsMongoCollection, deps.PrescriptionProvider, patRepo, deps.Retrier)
+
+	patSvc := patientservice.New(patRepo, countRepo, deps.CacheService, deps.CacheLoader, deps.CacheSerializer, deps.Duplicates, publisher, deps.Logger)
+	addrSvc := patientservice.NewAddressService(addrRepo, deps.CacheService, publisher, deps.Logger)
+	measurementSvc := patientservice.NewMeasurementService(measurementRepo, patSvc, deps.Logger)
+	allergySvc := patientservice.NewAllergyService(allergyRepo, patSvc, deps.Logger)
+	searchSvc := patientservice.NewPatientSearchService(searchRepo, deps.Logger)
+	exportSvc := patientservice.NewPatientExportService(patRepo, deps.Export, deps.Logger)
+	importSvc := patientservice.NewPatientImportService(patSvc, importTemplateRepo, deps.Import, deps.Logger)
+	insuranceSvc := patientservice.NewInsuranceService(insuranceRepo, patRepo, deps.AttachmentProvider, deps.CardOCRProvider, deps.InsuranceIntake, deps.Logger)
+	documentSvc := patientservice.NewDocumentService(documentRepo, patRepo, deps.DocumentBlobProvider, deps.DocumentScanProvider, deps.Documents, deps.Logger)
 
 	patientapi.MountAPI(r, &patientapi.Dependencies{
-		PatientService: patSvc,
-		AddressService: addrSvc,
-		Logger:         deps.Logger,
+		PatientService:     patSvc,
+		AddressService:     addrSvc,
+		MeasurementService: measurementSvc,
+		AllergyService:     allergySvc,
+		SearchService:      searchSvc,
+		ExportService:      exportSvc,
+		InsuranceService:   insuranceSvc,
+		DocumentService:    documentSvc,
+		Logger:             deps.Logger,
 	})
 
 	uipatient.MountUI(r, &uipatientContracts.UiDependencies{
 		PatientSvc:           patSvc,
 		AddressSvc:           addrSvc,
+		MeasurementSvc:       measurementSvc,
+		ImportSvc:            importSvc,
+		DocumentSvc:          documentSvc,
 		PrescriptionProvider: deps.PrescriptionProvider,
 		InvoiceProvider:      deps.InvoiceProvider,
+		Navigation:           deps.Navigation,
 		Log:                  deps.Logger,
 	})
 
@@ -55,5 +114,5 @@ func Module(r chi.Router, deps *ModuleDependencies) ModuleExport {
 		Log:        deps.Logger,
 	})
 
-	return ModuleExport{PatientService: patSvc, AddressService: addrSvc}
+	return ModuleExport{PatientService: patSvc, AddressService: addrSvc, MeasurementService: measurementSvc, AllergyService: allergySvc, SearchService: searchSvc, InsuranceService: insuranceSvc}
 }
@@ -0,0 +1,19 @@
+package providers
+
+import (
+	"context"
+
+	"pharmacy-modernization-project-model/internal/platform/attachments"
+)
+
+// DocumentBlobProvider keeps the content of patient documents
+type DocumentBlobProvider interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// DocumentScanProvider checks uploaded patient documents for malware before they are stored
+type DocumentScanProvider interface {
+	Scan(ctx context.Context, name string, data []byte) (attachments.ScanResult, error)
+}
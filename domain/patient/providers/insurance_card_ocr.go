@@ -0,0 +1,12 @@
+package providers
+
+import (
+	"context"
+
+	cardocr "pharmacy-modernization-project-model/internal/integrations/card_ocr"
+)
+
+// InsuranceCardOCRProvider reads payer, member and group fields from insurance card photos
+type InsuranceCardOCRProvider interface {
+	ExtractInsuranceCard(ctx context.Context, request cardocr.ExtractCardRequest) (*cardocr.ExtractCardResponse, error)
+}
@@ -3,9 +3,9 @@ package providers
 import (
 	"context"
 
-	irisbilling "pharmacy-modernization-project-model/internal/integrations/iris_billing"
+	commonmodel "pharmacy-modernization-project-model/domain/common/model"
 )
 
 type PatientInvoiceProvider interface {
-	GetInvoicesByPatientID(ctx context.Context, patientID string) (*irisbilling.InvoiceListResponse, error)
+	PatientInvoiceSummaryByPatientID(ctx context.Context, patientID string) (commonmodel.PatientInvoiceSummary, error)
 }
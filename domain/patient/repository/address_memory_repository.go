@@ -2,11 +2,18 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
 
 	addressModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/tenancy"
 )
 
 type addressMemoryRepository struct {
+	mu    sync.RWMutex
 	items map[string]map[string]addressModel.Address
 }
 
@@ -25,6 +32,7 @@ func NewAddressMemoryRepository() AddressRepository {
 
 	for patientID, addresses := range sample {
 		for _, addr := range addresses {
+			addr.OrgID = tenancy.DefaultOrgID
 			r.Upsert(context.Background(), patientID, addr)
 		}
 	}
@@ -32,21 +40,29 @@ func NewAddressMemoryRepository() AddressRepository {
 	return r
 }
 
+// ListByPatientID returns the addresses of a patient sorted by ID
 func (r *addressMemoryRepository) ListByPatientID(ctx context.Context, patientID string) ([]addressModel.Address, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 	addressesMap, ok := r.items[patientID]
 	if !ok {
 		return []addressModel.Address{}, nil
 	}
 	addresses := make([]addressModel.Address, 0, len(addressesMap))
 	for _, addr := range addressesMap {
-		addresses = append(addresses, addr)
+		if tenancy.Visible(ctx, addr.OrgID) {
+			addresses = append(addresses, addr)
+		}
 	}
+	sort.Slice(addresses, func(i, j int) bool { return addresses[i].ID < addresses[j].ID })
 	return addresses, nil
 }
 
 func (r *addressMemoryRepository) GetByID(ctx context.Context, patientID, addressID string) (addressModel.Address, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 	if addressesMap, ok := r.items[patientID]; ok {
-		if addr, ok := addressesMap[addressID]; ok {
+		if addr, ok := addressesMap[addressID]; ok && tenancy.Visible(ctx, addr.OrgID) {
 			return addr, nil
 		}
 	}
@@ -54,12 +70,36 @@ func (r *addressMemoryRepository) GetByID(ctx context.Context, patientID, addres
 }
 
 func (r *addressMemoryRepository) Upsert(ctx context.Context, patientID string, address addressModel.Address) (addressModel.Address, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	if address.ID == "" {
-		address.ID = patientID + "-addr"
+		address.ID = fmt.Sprintf("%s-addr-%d", patientID, time.Now().Unix())
 	}
 	if _, ok := r.items[patientID]; !ok {
 		r.items[patientID] = make(map[string]addressModel.Address)
 	}
+	if existing, ok := r.items[patientID][address.ID]; ok && !tenancy.Visible(ctx, existing.OrgID) {
+		return addressModel.Address{}, platformErrors.NewDuplicateRecordError("address", address.ID)
+	}
+	// Address IDs are unique across patients, as they are in the collection
+	for otherPatientID, addresses := range r.items {
+		if _, ok := addresses[address.ID]; ok && otherPatientID != patientID {
+			return addressModel.Address{}, platformErrors.NewDuplicateRecordError("address", address.ID)
+		}
+	}
+	address.PatientID = patientID
+	address.OrgID = tenancy.Assign(ctx, address.OrgID)
 	r.items[patientID][address.ID] = address
 	return address, nil
 }
+
+func (r *addressMemoryRepository) Delete(ctx context.Context, patientID, addressID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	addr, ok := r.items[patientID][addressID]
+	if !ok || !tenancy.Visible(ctx, addr.OrgID) {
+		return platformErrors.NewRecordNotFoundError("address", addressID)
+	}
+	delete(r.items[patientID], addressID)
+	return nil
+}
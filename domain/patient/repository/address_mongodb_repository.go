@@ -12,6 +12,7 @@ import (
 
 	addressModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
 	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/tenancy"
 	"pharmacy-modernization-project-model/internal/validators/validation_logic"
 )
 
@@ -58,7 +59,7 @@ func (r *AddressMongoRepository) ListByPatientID(ctx context.Context, patientID
 		return nil, platformErrors.NewValidationError("patient_id", patientID, "Invalid patient ID format")
 	}
 
-	filter := bson.M{"patient_id": patientID}
+	filter := tenancy.Filter(ctx, bson.M{"patient_id": patientID})
 	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
 
 	cursor, err := r.collection.Find(ctx, filter, opts)
@@ -101,10 +102,10 @@ func (r *AddressMongoRepository) GetByID(ctx context.Context, patientID, address
 		return addressModel.Address{}, platformErrors.NewValidationError("address_id", addressID, "Invalid address ID format")
 	}
 
-	filter := bson.M{
+	filter := tenancy.Filter(ctx, bson.M{
 		"_id":        addressID,
 		"patient_id": patientID,
-	}
+	})
 
 	var address addressModel.Address
 	err := r.collection.FindOne(ctx, filter).Decode(&address)
@@ -153,23 +154,29 @@ func (r *AddressMongoRepository) Upsert(ctx context.Context, patientID string, a
 
 	// Ensure patient ID is set
 	address.PatientID = patientID
+	address.OrgID = tenancy.Assign(ctx, address.OrgID)
 
-	filter := bson.M{
+	// An address of another organization with the same ID fails the upsert as a duplicate
+	filter := tenancy.Filter(ctx, bson.M{
 		"_id":        address.ID,
 		"patient_id": patientID,
-	}
+	})
 
+	set := bson.M{
+		"_id":        address.ID,
+		"patient_id": address.PatientID,
+		"line1":      address.Line1,
+		"line2":      address.Line2,
+		"city":       address.City,
+		"state":      address.State,
+		"zip":        address.Zip,
+		"updated_at": time.Now(),
+	}
+	if address.OrgID != "" {
+		set[tenancy.Field] = address.OrgID
+	}
 	update := bson.M{
-		"$set": bson.M{
-			"_id":        address.ID,
-			"patient_id": address.PatientID,
-			"line1":      address.Line1,
-			"line2":      address.Line2,
-			"city":       address.City,
-			"state":      address.State,
-			"zip":        address.Zip,
-			"updated_at": time.Now(),
-		},
+		"$set": set,
 		"$setOnInsert": bson.M{
 			"created_at": time.Now(),
 		},
@@ -188,6 +195,31 @@ func (r *AddressMongoRepository) Upsert(ctx context.Context, patientID string, a
 	return address, nil
 }
 
+// Delete removes an address of a patient
+func (r *AddressMongoRepository) Delete(ctx context.Context, patientID, addressID string) error {
+	// Validate input to prevent NoSQL injection
+	if err := validation_logic.ValidateID("patient_id", patientID); err != nil {
+		return platformErrors.NewValidationError("patient_id", patientID, "Invalid patient ID format")
+	}
+	if err := validation_logic.ValidateID("address_id", addressID); err != nil {
+		return platformErrors.NewValidationError("address_id", addressID, "Invalid address ID format")
+	}
+
+	result, err := r.collection.DeleteOne(ctx, tenancy.Filter(ctx, bson.M{"_id": addressID, "patient_id": patientID}))
+	if err != nil {
+		return r.handleError("Delete", err)
+	}
+	if result.DeletedCount == 0 {
+		return platformErrors.NewRecordNotFoundError("address", addressID)
+	}
+
+	r.logger.Info("Successfully deleted address from MongoDB",
+		zap.String("patient_id", patientID),
+		zap.String("address_id", addressID))
+
+	return nil
+}
+
 // CreateIndexes creates recommended indexes for optimal performance
 func (r *AddressMongoRepository) CreateIndexes(ctx context.Context) error {
 	indexes := []mongo.IndexModel{
@@ -197,6 +229,12 @@ func (r *AddressMongoRepository) CreateIndexes(ctx context.Context) error {
 				SetName("patient_id_1").
 				SetBackground(true),
 		},
+		{
+			Keys: bson.D{{Key: "org_id", Value: 1}, {Key: "patient_id", Value: 1}},
+			Options: options.Index().
+				SetName("org_id_1_patient_id_1").
+				SetBackground(true),
+		},
 		{
 			Keys: bson.D{{Key: "_id", Value: 1}, {Key: "patient_id", Value: 1}},
 			Options: options.Index().
@@ -204,6 +242,7 @@ func (r *AddressMongoRepository) CreateIndexes(ctx context.Context) error {
 				SetUnique(true).
 				SetBackground(true),
 		},
+		addressTextIndex(),
 	}
 
 	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
@@ -10,4 +10,5 @@ type AddressRepository interface {
 	ListByPatientID(ctx context.Context, patientID string) ([]addressModel.Address, error)
 	GetByID(ctx context.Context, patientID, addressID string) (addressModel.Address, error)
 	Upsert(ctx context.Context, patientID string, address addressModel.Address) (addressModel.Address, error)
+	Delete(ctx context.Context, patientID, addressID string) error
 }
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+
+	addressModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// AddressRetryRepository retries the reads and idempotent writes of an address repository that fail
+// with a retryable error; Delete runs once
+type AddressRetryRepository struct {
+	next    AddressRepository
+	retrier *database.Retrier
+}
+
+// NewAddressRetryRepository wraps next with retries; without a retrier next is returned as is
+func NewAddressRetryRepository(next AddressRepository, retrier *database.Retrier) AddressRepository {
+	if retrier == nil {
+		return next
+	}
+	return &AddressRetryRepository{next: next, retrier: retrier}
+}
+
+func (r *AddressRetryRepository) ListByPatientID(ctx context.Context, patientID string) ([]addressModel.Address, error) {
+	return database.Retry(ctx, r.retrier, "addresses.ListByPatientID", func(ctx context.Context) ([]addressModel.Address, error) {
+		return r.next.ListByPatientID(ctx, patientID)
+	})
+}
+
+func (r *AddressRetryRepository) GetByID(ctx context.Context, patientID, addressID string) (addressModel.Address, error) {
+	return database.Retry(ctx, r.retrier, "addresses.GetByID", func(ctx context.Context) (addressModel.Address, error) {
+		return r.next.GetByID(ctx, patientID, addressID)
+	})
+}
+
+func (r *AddressRetryRepository) Upsert(ctx context.Context, patientID string, address addressModel.Address) (addressModel.Address, error) {
+	return database.Retry(ctx, r.retrier, "addresses.Upsert", func(ctx context.Context) (addressModel.Address, error) {
+		return r.next.Upsert(ctx, patientID, address)
+	})
+}
+
+// Delete runs once: a retried delete that reached the server would report the record missing
+func (r *AddressRetryRepository) Delete(ctx context.Context, patientID, addressID string) error {
+	return r.next.Delete(ctx, patientID, addressID)
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+type allergyMemoryRepository struct {
+	mu    sync.RWMutex
+	items map[string]map[string]patientModel.Allergy
+}
+
+func NewAllergyMemoryRepository() AllergyRepository {
+	r := &allergyMemoryRepository{items: make(map[string]map[string]patientModel.Allergy)}
+	for _, allergy := range SeedAllergies(time.Now()) {
+		r.Create(context.Background(), allergy)
+	}
+	return r
+}
+
+// SeedAllergies returns sample allergies of fixture patients. P003's NSAID allergy blocks
+// Ibuprofen by its drug class and P006's Aspirin allergy blocks Aspirin under any of its names.
+func SeedAllergies(now time.Time) []patientModel.Allergy {
+	return []patientModel.Allergy{
+		{ID: "ALG001", PatientID: "P001", Substance: "Codeine", Reaction: "Hives", Severity: patientModel.AllergyModerate, RecordedAt: now.AddDate(-2, 0, 0), RecordedBy: "seed"},
+		{ID: "ALG002", PatientID: "P003", Substance: "NSAID", Reaction: "Bronchospasm", Severity: patientModel.AllergySevere, RecordedAt: now.AddDate(-1, -3, 0), RecordedBy: "seed"},
+		{ID: "ALG003", PatientID: "P005", Substance: "Amoxicillin", Reaction: "Rash", Severity: patientModel.AllergyMild, RecordedAt: now.AddDate(0, -8, 0), RecordedBy: "seed"},
+		{ID: "ALG004", PatientID: "P006", Substance: "Aspirin", Reaction: "Gastrointestinal bleeding", Severity: patientModel.AllergySevere, RecordedAt: now.AddDate(-3, 0, 0), RecordedBy: "seed"},
+	}
+}
+
+func (r *allergyMemoryRepository) ListByPatientID(ctx context.Context, patientID string) ([]patientModel.Allergy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := []patientModel.Allergy{}
+	for _, a := range r.items[patientID] {
+		result = append(result, a)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].RecordedAt.After(result[j].RecordedAt)
+	})
+	return result, nil
+}
+
+func (r *allergyMemoryRepository) GetByID(ctx context.Context, patientID, allergyID string) (patientModel.Allergy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.items[patientID][allergyID], nil
+}
+
+func (r *allergyMemoryRepository) Create(ctx context.Context, allergy patientModel.Allergy) (patientModel.Allergy, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.items[allergy.PatientID]; !ok {
+		r.items[allergy.PatientID] = make(map[string]patientModel.Allergy)
+	}
+	r.items[allergy.PatientID][allergy.ID] = allergy
+	return allergy, nil
+}
+
+func (r *allergyMemoryRepository) Update(ctx context.Context, allergy patientModel.Allergy) (patientModel.Allergy, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.items[allergy.PatientID][allergy.ID]; !ok {
+		return patientModel.Allergy{}, platformErrors.NewRecordNotFoundError("allergy", allergy.ID)
+	}
+	r.items[allergy.PatientID][allergy.ID] = allergy
+	return allergy, nil
+}
+
+func (r *allergyMemoryRepository) Delete(ctx context.Context, patientID, allergyID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.items[patientID][allergyID]; !ok {
+		return platformErrors.NewRecordNotFoundError("allergy", allergyID)
+	}
+	delete(r.items[patientID], allergyID)
+	return nil
+}
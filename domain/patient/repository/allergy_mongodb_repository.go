@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/validators/validation_logic"
+)
+
+// AllergyMongoRepository implements AllergyRepository interface using MongoDB
+type AllergyMongoRepository struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewAllergyMongoRepository creates a new MongoDB allergy repository
+func NewAllergyMongoRepository(collection *mongo.Collection, logger *zap.Logger) *AllergyMongoRepository {
+	return &AllergyMongoRepository{
+		collection: collection,
+		logger:     logger,
+	}
+}
+
+// handleError processes MongoDB errors and converts them to appropriate repository errors
+func (r *AllergyMongoRepository) handleError(operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	r.logger.Error("MongoDB operation failed",
+		zap.String("operation", operation),
+		zap.Error(err))
+
+	return platformErrors.HandleMongoError(operation, err)
+}
+
+// validateIDs guards patient and allergy IDs against NoSQL injection
+func (r *AllergyMongoRepository) validateIDs(patientID, allergyID string) error {
+	if err := validation_logic.ValidateID("patient_id", patientID); err != nil {
+		r.logger.Warn("Invalid patient_id provided",
+			zap.Error(err))
+		return platformErrors.NewValidationError("patient_id", patientID, "Invalid patient ID format")
+	}
+	if allergyID != "" {
+		if err := validation_logic.ValidateID("allergy_id", allergyID); err != nil {
+			r.logger.Warn("Invalid allergy_id provided",
+				zap.Error(err))
+			return platformErrors.NewValidationError("allergy_id", allergyID, "Invalid allergy ID format")
+		}
+	}
+	return nil
+}
+
+// ListByPatientID retrieves a patient's allergies, newest first
+func (r *AllergyMongoRepository) ListByPatientID(ctx context.Context, patientID string) ([]patientModel.Allergy, error) {
+	if err := r.validateIDs(patientID, ""); err != nil {
+		return nil, err
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "recorded_at", Value: -1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"patient_id": patientID}, opts)
+	if err != nil {
+		return nil, r.handleError("ListByPatientID", err)
+	}
+	defer cursor.Close(ctx)
+
+	allergies := []patientModel.Allergy{}
+	if err := cursor.All(ctx, &allergies); err != nil {
+		return nil, r.handleError("ListByPatientID", err)
+	}
+
+	return allergies, nil
+}
+
+// GetByID retrieves an allergy; an empty allergy is returned when it does not exist
+func (r *AllergyMongoRepository) GetByID(ctx context.Context, patientID, allergyID string) (patientModel.Allergy, error) {
+	if err := r.validateIDs(patientID, allergyID); err != nil {
+		return patientModel.Allergy{}, err
+	}
+
+	var allergy patientModel.Allergy
+	err := r.collection.FindOne(ctx, bson.M{"_id": allergyID, "patient_id": patientID}).Decode(&allergy)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return patientModel.Allergy{}, nil // Matches memory repo behavior
+		}
+		return patientModel.Allergy{}, r.handleError("GetByID", err)
+	}
+
+	return allergy, nil
+}
+
+// Create inserts a new allergy
+func (r *AllergyMongoRepository) Create(ctx context.Context, allergy patientModel.Allergy) (patientModel.Allergy, error) {
+	if err := r.validateIDs(allergy.PatientID, allergy.ID); err != nil {
+		return patientModel.Allergy{}, err
+	}
+
+	if _, err := r.collection.InsertOne(ctx, allergy); err != nil {
+		return patientModel.Allergy{}, r.handleError("Create", err)
+	}
+
+	r.logger.Info("Successfully created allergy in MongoDB",
+		zap.String("patient_id", allergy.PatientID),
+		zap.String("allergy_id", allergy.ID))
+
+	return allergy, nil
+}
+
+// Update replaces the substance, reaction, severity and recording details of an allergy
+func (r *AllergyMongoRepository) Update(ctx context.Context, allergy patientModel.Allergy) (patientModel.Allergy, error) {
+	if err := r.validateIDs(allergy.PatientID, allergy.ID); err != nil {
+		return patientModel.Allergy{}, err
+	}
+
+	filter := bson.M{"_id": allergy.ID, "patient_id": allergy.PatientID}
+	update := bson.M{
+		"$set": bson.M{
+			"substance":   allergy.Substance,
+			"reaction":    allergy.Reaction,
+			"severity":    allergy.Severity,
+			"recorded_at": allergy.RecordedAt,
+			"recorded_by": allergy.RecordedBy,
+			"updated_at":  time.Now(),
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return patientModel.Allergy{}, r.handleError("Update", err)
+	}
+	if result.MatchedCount == 0 {
+		return patientModel.Allergy{}, platformErrors.NewRecordNotFoundError("allergy", allergy.ID)
+	}
+
+	return allergy, nil
+}
+
+// Delete removes an allergy
+func (r *AllergyMongoRepository) Delete(ctx context.Context, patientID, allergyID string) error {
+	if err := r.validateIDs(patientID, allergyID); err != nil {
+		return err
+	}
+
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": allergyID, "patient_id": patientID})
+	if err != nil {
+		return r.handleError("Delete", err)
+	}
+	if result.DeletedCount == 0 {
+		return platformErrors.NewRecordNotFoundError("allergy", allergyID)
+	}
+
+	r.logger.Info("Successfully deleted allergy from MongoDB",
+		zap.String("patient_id", patientID),
+		zap.String("allergy_id", allergyID))
+
+	return nil
+}
+
+// CreateIndexes creates recommended indexes for optimal performance
+func (r *AllergyMongoRepository) CreateIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "patient_id", Value: 1}, {Key: "recorded_at", Value: -1}},
+			Options: options.Index().
+				SetName("patient_id_1_recorded_at_-1").
+				SetBackground(true),
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return r.handleError("CreateIndexes", err)
+	}
+
+	r.logger.Info("Successfully created MongoDB indexes for allergies collection")
+	return nil
+}
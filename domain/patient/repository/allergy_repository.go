@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+)
+
+type AllergyRepository interface {
+	// ListByPatientID returns the patient's allergies, newest first
+	ListByPatientID(ctx context.Context, patientID string) ([]patientModel.Allergy, error)
+	GetByID(ctx context.Context, patientID, allergyID string) (patientModel.Allergy, error)
+	Create(ctx context.Context, allergy patientModel.Allergy) (patientModel.Allergy, error)
+	Update(ctx context.Context, allergy patientModel.Allergy) (patientModel.Allergy, error)
+	Delete(ctx context.Context, patientID, allergyID string) error
+}
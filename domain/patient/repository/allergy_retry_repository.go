@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// AllergyRetryRepository retries the reads and idempotent writes of an allergy repository that fail
+// with a retryable error; Create and Delete run once
+type AllergyRetryRepository struct {
+	next    AllergyRepository
+	retrier *database.Retrier
+}
+
+// NewAllergyRetryRepository wraps next with retries; without a retrier next is returned as is
+func NewAllergyRetryRepository(next AllergyRepository, retrier *database.Retrier) AllergyRepository {
+	if retrier == nil {
+		return next
+	}
+	return &AllergyRetryRepository{next: next, retrier: retrier}
+}
+
+func (r *AllergyRetryRepository) ListByPatientID(ctx context.Context, patientID string) ([]patientModel.Allergy, error) {
+	return database.Retry(ctx, r.retrier, "allergies.ListByPatientID", func(ctx context.Context) ([]patientModel.Allergy, error) {
+		return r.next.ListByPatientID(ctx, patientID)
+	})
+}
+
+func (r *AllergyRetryRepository) GetByID(ctx context.Context, patientID, allergyID string) (patientModel.Allergy, error) {
+	return database.Retry(ctx, r.retrier, "allergies.GetByID", func(ctx context.Context) (patientModel.Allergy, error) {
+		return r.next.GetByID(ctx, patientID, allergyID)
+	})
+}
+
+// Create runs once: a retried insert that reached the server would fail as a duplicate
+func (r *AllergyRetryRepository) Create(ctx context.Context, allergy patientModel.Allergy) (patientModel.Allergy, error) {
+	return r.next.Create(ctx, allergy)
+}
+
+func (r *AllergyRetryRepository) Update(ctx context.Context, allergy patientModel.Allergy) (patientModel.Allergy, error) {
+	return database.Retry(ctx, r.retrier, "allergies.Update", func(ctx context.Context) (patientModel.Allergy, error) {
+		return r.next.Update(ctx, allergy)
+	})
+}
+
+// Delete runs once: a retried delete that reached the server would report the record missing
+func (r *AllergyRetryRepository) Delete(ctx context.Context, patientID, allergyID string) error {
+	return r.next.Delete(ctx, patientID, allergyID)
+}
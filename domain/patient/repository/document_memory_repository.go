@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+type documentMemoryRepository struct {
+	mu    sync.RWMutex
+	items map[string]map[string]patientModel.PatientDocument
+}
+
+func NewDocumentMemoryRepository() DocumentRepository {
+	return &documentMemoryRepository{items: make(map[string]map[string]patientModel.PatientDocument)}
+}
+
+func (r *documentMemoryRepository) ListByPatientID(ctx context.Context, patientID string) ([]patientModel.PatientDocument, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := []patientModel.PatientDocument{}
+	for _, document := range r.items[patientID] {
+		result = append(result, document)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].UploadedAt.Equal(result[j].UploadedAt) {
+			return result[i].UploadedAt.After(result[j].UploadedAt)
+		}
+		return result[i].ID < result[j].ID
+	})
+	return result, nil
+}
+
+func (r *documentMemoryRepository) GetByID(ctx context.Context, patientID, documentID string) (patientModel.PatientDocument, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	document, ok := r.items[patientID][documentID]
+	if !ok {
+		return patientModel.PatientDocument{}, platformErrors.NewRecordNotFoundError("patient document", documentID)
+	}
+	return document, nil
+}
+
+func (r *documentMemoryRepository) Create(ctx context.Context, document patientModel.PatientDocument) (patientModel.PatientDocument, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.items[document.PatientID][document.ID]; ok {
+		return patientModel.PatientDocument{}, platformErrors.NewDuplicateRecordError("patient document", document.ID)
+	}
+	if _, ok := r.items[document.PatientID]; !ok {
+		r.items[document.PatientID] = make(map[string]patientModel.PatientDocument)
+	}
+	r.items[document.PatientID][document.ID] = document
+	return document, nil
+}
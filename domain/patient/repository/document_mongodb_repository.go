@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/validators/validation_logic"
+)
+
+// DocumentMongoRepository implements DocumentRepository interface using MongoDB
+type DocumentMongoRepository struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewDocumentMongoRepository creates a new MongoDB patient document repository
+func NewDocumentMongoRepository(collection *mongo.Collection, logger *zap.Logger) *DocumentMongoRepository {
+	return &DocumentMongoRepository{
+		collection: collection,
+		logger:     logger,
+	}
+}
+
+// handleError processes MongoDB errors and converts them to appropriate repository errors
+func (r *DocumentMongoRepository) handleError(operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	r.logger.Error("MongoDB operation failed",
+		zap.String("operation", operation),
+		zap.Error(err))
+
+	return platformErrors.HandleMongoError(operation, err)
+}
+
+// validateIDs guards patient and document IDs against NoSQL injection
+func (r *DocumentMongoRepository) validateIDs(patientID, documentID string) error {
+	if err := validation_logic.ValidateID("patient_id", patientID); err != nil {
+		r.logger.Warn("Invalid patient_id provided",
+			zap.Error(err))
+		return platformErrors.NewValidationError("patient_id", patientID, "Invalid patient ID format")
+	}
+	if documentID != "" {
+		if err := validation_logic.ValidateID("document_id", documentID); err != nil {
+			r.logger.Warn("Invalid document_id provided",
+				zap.Error(err))
+			return platformErrors.NewValidationError("document_id", documentID, "Invalid document ID format")
+		}
+	}
+	return nil
+}
+
+// CreateIndexes creates the index the patient's document list is read by
+func (r *DocumentMongoRepository) CreateIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "patient_id", Value: 1}, {Key: "uploaded_at", Value: -1}},
+		Options: options.Index().SetName("patient_id_1_uploaded_at_-1"),
+	})
+	if err != nil {
+		return r.handleError("CreateIndexes", err)
+	}
+	return nil
+}
+
+// ListByPatientID retrieves a patient's documents, newest first
+func (r *DocumentMongoRepository) ListByPatientID(ctx context.Context, patientID string) ([]patientModel.PatientDocument, error) {
+	start := time.Now()
+	defer func() {
+		r.logger.Debug("MongoDB ListByPatientID operation completed",
+			zap.String("patient_id", patientID),
+			zap.Duration("duration", time.Since(start)))
+	}()
+
+	if err := r.validateIDs(patientID, ""); err != nil {
+		return nil, err
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "uploaded_at", Value: -1}, {Key: "_id", Value: 1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"patient_id": patientID}, opts)
+	if err != nil {
+		return nil, r.handleError("ListByPatientID", err)
+	}
+	defer cursor.Close(ctx)
+
+	documents := []patientModel.PatientDocument{}
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, r.handleError("ListByPatientID", err)
+	}
+
+	return documents, nil
+}
+
+// GetByID retrieves a patient document
+func (r *DocumentMongoRepository) GetByID(ctx context.Context, patientID, documentID string) (patientModel.PatientDocument, error) {
+	if err := r.validateIDs(patientID, documentID); err != nil {
+		return patientModel.PatientDocument{}, err
+	}
+
+	var document patientModel.PatientDocument
+	err := r.collection.FindOne(ctx, bson.M{"_id": documentID, "patient_id": patientID}).Decode(&document)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return patientModel.PatientDocument{}, platformErrors.NewRecordNotFoundError("patient document", documentID)
+		}
+		return patientModel.PatientDocument{}, r.handleError("GetByID", err)
+	}
+
+	return document, nil
+}
+
+// Create inserts the metadata of a new document
+func (r *DocumentMongoRepository) Create(ctx context.Context, document patientModel.PatientDocument) (patientModel.PatientDocument, error) {
+	if err := r.validateIDs(document.PatientID, document.ID); err != nil {
+		return patientModel.PatientDocument{}, err
+	}
+
+	if _, err := r.collection.InsertOne(ctx, document); err != nil {
+		return patientModel.PatientDocument{}, r.handleError("Create", err)
+	}
+
+	r.logger.Info("Successfully created patient document in MongoDB",
+		zap.String("patient_id", document.PatientID),
+		zap.String("document_id", document.ID))
+
+	return document, nil
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+)
+
+// DocumentRepository keeps the metadata of patient documents; the content is in a blob store
+type DocumentRepository interface {
+	// ListByPatientID returns a patient's documents, newest first
+	ListByPatientID(ctx context.Context, patientID string) ([]patientModel.PatientDocument, error)
+	GetByID(ctx context.Context, patientID, documentID string) (patientModel.PatientDocument, error)
+	Create(ctx context.Context, document patientModel.PatientDocument) (patientModel.PatientDocument, error)
+}
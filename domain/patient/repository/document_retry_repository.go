@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// DocumentRetryRepository retries the reads of a patient document repository that fail with a
+// retryable error; Create runs once
+type DocumentRetryRepository struct {
+	next    DocumentRepository
+	retrier *database.Retrier
+}
+
+// NewDocumentRetryRepository wraps next with retries; without a retrier next is returned as is
+func NewDocumentRetryRepository(next DocumentRepository, retrier *database.Retrier) DocumentRepository {
+	if retrier == nil {
+		return next
+	}
+	return &DocumentRetryRepository{next: next, retrier: retrier}
+}
+
+func (r *DocumentRetryRepository) ListByPatientID(ctx context.Context, patientID string) ([]patientModel.PatientDocument, error) {
+	return database.Retry(ctx, r.retrier, "patient_documents.ListByPatientID", func(ctx context.Context) ([]patientModel.PatientDocument, error) {
+		return r.next.ListByPatientID(ctx, patientID)
+	})
+}
+
+func (r *DocumentRetryRepository) GetByID(ctx context.Context, patientID, documentID string) (patientModel.PatientDocument, error) {
+	return database.Retry(ctx, r.retrier, "patient_documents.GetByID", func(ctx context.Context) (patientModel.PatientDocument, error) {
+		return r.next.GetByID(ctx, patientID, documentID)
+	})
+}
+
+// Create runs once: a retried insert that reached the server would fail as a duplicate
+func (r *DocumentRetryRepository) Create(ctx context.Context, document patientModel.PatientDocument) (patientModel.PatientDocument, error) {
+	return r.next.Create(ctx, document)
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"maps"
+	"sort"
+	"sync"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+type importTemplateMemoryRepository struct {
+	mu    sync.RWMutex
+	items map[string]patientModel.PatientImportTemplate
+}
+
+func NewImportTemplateMemoryRepository() ImportTemplateRepository {
+	return &importTemplateMemoryRepository{items: make(map[string]patientModel.PatientImportTemplate)}
+}
+
+func (r *importTemplateMemoryRepository) List(ctx context.Context) ([]patientModel.PatientImportTemplate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := []patientModel.PatientImportTemplate{}
+	for _, template := range r.items {
+		result = append(result, cloneImportTemplate(template))
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+func (r *importTemplateMemoryRepository) GetByID(ctx context.Context, id string) (patientModel.PatientImportTemplate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	template, ok := r.items[id]
+	if !ok {
+		return patientModel.PatientImportTemplate{}, platformErrors.NewRecordNotFoundError("import template", id)
+	}
+	return cloneImportTemplate(template), nil
+}
+
+func (r *importTemplateMemoryRepository) Save(ctx context.Context, template patientModel.PatientImportTemplate) (patientModel.PatientImportTemplate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, existing := range r.items {
+		if existing.Name == template.Name {
+			template.ID = id
+		}
+	}
+	r.items[template.ID] = cloneImportTemplate(template)
+	return template, nil
+}
+
+// cloneImportTemplate copies the mapping so callers cannot change stored templates
+func cloneImportTemplate(template patientModel.PatientImportTemplate) patientModel.PatientImportTemplate {
+	template.Mapping = maps.Clone(template.Mapping)
+	return template
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/validators/validation_logic"
+)
+
+// ImportTemplateMongoRepository implements ImportTemplateRepository interface using MongoDB
+type ImportTemplateMongoRepository struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewImportTemplateMongoRepository creates a new MongoDB import template repository
+func NewImportTemplateMongoRepository(collection *mongo.Collection, logger *zap.Logger) *ImportTemplateMongoRepository {
+	return &ImportTemplateMongoRepository{
+		collection: collection,
+		logger:     logger,
+	}
+}
+
+// handleError processes MongoDB errors and converts them to appropriate repository errors
+func (r *ImportTemplateMongoRepository) handleError(operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	r.logger.Error("MongoDB operation failed",
+		zap.String("operation", operation),
+		zap.Error(err))
+
+	return platformErrors.HandleMongoError(operation, err)
+}
+
+// List retrieves every template, sorted by name
+func (r *ImportTemplateMongoRepository) List(ctx context.Context) ([]patientModel.PatientImportTemplate, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "name", Value: 1}}))
+	if err != nil {
+		return nil, r.handleError("List", err)
+	}
+	defer cursor.Close(ctx)
+
+	templates := []patientModel.PatientImportTemplate{}
+	if err := cursor.All(ctx, &templates); err != nil {
+		return nil, r.handleError("List", err)
+	}
+	return templates, nil
+}
+
+// GetByID retrieves a template
+func (r *ImportTemplateMongoRepository) GetByID(ctx context.Context, id string) (patientModel.PatientImportTemplate, error) {
+	if err := validation_logic.ValidateID("template_id", id); err != nil {
+		return patientModel.PatientImportTemplate{}, platformErrors.NewValidationError("template_id", id, "Invalid template ID format")
+	}
+
+	var template patientModel.PatientImportTemplate
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&template)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return patientModel.PatientImportTemplate{}, platformErrors.NewRecordNotFoundError("import template", id)
+		}
+		return patientModel.PatientImportTemplate{}, r.handleError("GetByID", err)
+	}
+	return template, nil
+}
+
+// Save upserts the template by name; an existing template keeps its ID
+func (r *ImportTemplateMongoRepository) Save(ctx context.Context, template patientModel.PatientImportTemplate) (patientModel.PatientImportTemplate, error) {
+	update := bson.M{
+		"$set": bson.M{
+			"mapping":    template.Mapping,
+			"updated_by": template.UpdatedBy,
+			"updated_at": template.UpdatedAt,
+		},
+		"$setOnInsert": bson.M{"_id": template.ID},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var saved patientModel.PatientImportTemplate
+	if err := r.collection.FindOneAndUpdate(ctx, bson.M{"name": template.Name}, update, opts).Decode(&saved); err != nil {
+		return patientModel.PatientImportTemplate{}, r.handleError("Save", err)
+	}
+	return saved, nil
+}
+
+// CreateIndexes creates the unique name index Save relies on
+func (r *ImportTemplateMongoRepository) CreateIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "name", Value: 1}},
+		Options: options.Index().SetName("name_1").SetUnique(true),
+	})
+	if err != nil {
+		return r.handleError("CreateIndexes", err)
+	}
+	return nil
+}
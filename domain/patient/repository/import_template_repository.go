@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+)
+
+type ImportTemplateRepository interface {
+	// List returns every saved column mapping, sorted by name
+	List(ctx context.Context) ([]patientModel.PatientImportTemplate, error)
+	GetByID(ctx context.Context, id string) (patientModel.PatientImportTemplate, error)
+	// Save stores the template, replacing the mapping of a template with the same name
+	Save(ctx context.Context, template patientModel.PatientImportTemplate) (patientModel.PatientImportTemplate, error)
+}
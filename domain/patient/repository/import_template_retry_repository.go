@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// ImportTemplateRetryRepository retries the reads and idempotent writes of an import template
+// repository that fail with a retryable error
+type ImportTemplateRetryRepository struct {
+	next    ImportTemplateRepository
+	retrier *database.Retrier
+}
+
+// NewImportTemplateRetryRepository wraps next with retries; without a retrier next is returned as is
+func NewImportTemplateRetryRepository(next ImportTemplateRepository, retrier *database.Retrier) ImportTemplateRepository {
+	if retrier == nil {
+		return next
+	}
+	return &ImportTemplateRetryRepository{next: next, retrier: retrier}
+}
+
+func (r *ImportTemplateRetryRepository) List(ctx context.Context) ([]patientModel.PatientImportTemplate, error) {
+	return database.Retry(ctx, r.retrier, "patient_import_templates.List", func(ctx context.Context) ([]patientModel.PatientImportTemplate, error) {
+		return r.next.List(ctx)
+	})
+}
+
+func (r *ImportTemplateRetryRepository) GetByID(ctx context.Context, id string) (patientModel.PatientImportTemplate, error) {
+	return database.Retry(ctx, r.retrier, "patient_import_templates.GetByID", func(ctx context.Context) (patientModel.PatientImportTemplate, error) {
+		return r.next.GetByID(ctx, id)
+	})
+}
+
+func (r *ImportTemplateRetryRepository) Save(ctx context.Context, template patientModel.PatientImportTemplate) (patientModel.PatientImportTemplate, error) {
+	return database.Retry(ctx, r.retrier, "patient_import_templates.Save", func(ctx context.Context) (patientModel.PatientImportTemplate, error) {
+		return r.next.Save(ctx, template)
+	})
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+type insuranceMemoryRepository struct {
+	mu    sync.RWMutex
+	items map[string]map[string]patientModel.InsuranceRecord
+}
+
+func NewInsuranceMemoryRepository() InsuranceRepository {
+	r := &insuranceMemoryRepository{items: make(map[string]map[string]patientModel.InsuranceRecord)}
+	for _, record := range SeedInsuranceRecords(time.Now()) {
+		r.Create(context.Background(), record)
+	}
+	return r
+}
+
+// SeedInsuranceRecords returns sample coverage for the first fixture patients: current coverage
+// for P001 and P002, P002's previous plan, and a card awaiting confirmation for P003
+func SeedInsuranceRecords(now time.Time) []patientModel.InsuranceRecord {
+	date := func(years int) *time.Time {
+		d := time.Date(now.Year()+years, time.January, 1, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+	lastDay := func(years int) *time.Time {
+		d := time.Date(now.Year()+years, time.December, 31, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+	return []patientModel.InsuranceRecord{
+		{ID: "INS001", PatientID: "P001", PayerName: "Blue Cross Blue Shield", MemberID: "XYZ123456789", GroupNumber: "GRP-10045", MemberName: "Ava Thompson", RxBIN: "610014", RxPCN: "BCBSRX",
+			Status: patientModel.InsuranceConfirmed, EffectiveDate: date(0), ExpiryDate: lastDay(0), CreatedBy: "seed", CreatedAt: now.AddDate(0, -2, 0)},
+		{ID: "INS002", PatientID: "P002", PayerName: "Aetna", MemberID: "W998877665", GroupNumber: "AET-2201", MemberName: "Liam Anderson", RxBIN: "610502", RxPCN: "AETNA",
+			Status: patientModel.InsuranceConfirmed, EffectiveDate: date(-1), CreatedBy: "seed", CreatedAt: now.AddDate(-1, 0, 0)},
+		{ID: "INS003", PatientID: "P002", PayerName: "UnitedHealthcare", MemberID: "U445566778", GroupNumber: "UHC-7781", MemberName: "Liam Anderson",
+			Status: patientModel.InsuranceConfirmed, EffectiveDate: date(-3), ExpiryDate: lastDay(-2), CreatedBy: "seed", CreatedAt: now.AddDate(-3, 0, 0)},
+		{ID: "INS004", PatientID: "P003", PayerName: "Cigna", MemberID: "C11223344", MemberName: "Sophia Martinez",
+			Status: patientModel.InsurancePendingConfirmation, CreatedBy: "seed", CreatedAt: now.AddDate(0, 0, -1)},
+	}
+}
+
+func (r *insuranceMemoryRepository) ListByPatientID(ctx context.Context, patientID string) ([]patientModel.InsuranceRecord, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := []patientModel.InsuranceRecord{}
+	for _, record := range r.items[patientID] {
+		result = append(result, record)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+func (r *insuranceMemoryRepository) GetByID(ctx context.Context, patientID, insuranceID string) (patientModel.InsuranceRecord, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	record, ok := r.items[patientID][insuranceID]
+	if !ok {
+		return patientModel.InsuranceRecord{}, platformErrors.NewRecordNotFoundError("insurance record", insuranceID)
+	}
+	return record, nil
+}
+
+func (r *insuranceMemoryRepository) Create(ctx context.Context, record patientModel.InsuranceRecord) (patientModel.InsuranceRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.items[record.PatientID]; !ok {
+		r.items[record.PatientID] = make(map[string]patientModel.InsuranceRecord)
+	}
+	r.items[record.PatientID][record.ID] = record
+	return record, nil
+}
+
+func (r *insuranceMemoryRepository) Review(ctx context.Context, record patientModel.InsuranceRecord) (patientModel.InsuranceRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.items[record.PatientID][record.ID]
+	if !ok {
+		return patientModel.InsuranceRecord{}, platformErrors.NewRecordNotFoundError("insurance record", record.ID)
+	}
+	if existing.Status != patientModel.InsurancePendingConfirmation {
+		return patientModel.InsuranceRecord{}, platformErrors.NewConflictError("insurance record", record.ID, "insurance record is already "+string(existing.Status))
+	}
+	r.items[record.PatientID][record.ID] = record
+	return record, nil
+}
+
+func (r *insuranceMemoryRepository) Update(ctx context.Context, record patientModel.InsuranceRecord) (patientModel.InsuranceRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.items[record.PatientID][record.ID]
+	if !ok {
+		return patientModel.InsuranceRecord{}, platformErrors.NewRecordNotFoundError("insurance record", record.ID)
+	}
+	if existing.Status != patientModel.InsuranceConfirmed {
+		return patientModel.InsuranceRecord{}, platformErrors.NewConflictError("insurance record", record.ID, "only confirmed insurance records can be changed; this one is "+string(existing.Status))
+	}
+	r.items[record.PatientID][record.ID] = record
+	return record, nil
+}
@@ -0,0 +1,232 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/validators/validation_logic"
+)
+
+// InsuranceMongoRepository implements InsuranceRepository interface using MongoDB
+type InsuranceMongoRepository struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewInsuranceMongoRepository creates a new MongoDB insurance record repository
+func NewInsuranceMongoRepository(collection *mongo.Collection, logger *zap.Logger) InsuranceRepository {
+	return &InsuranceMongoRepository{
+		collection: collection,
+		logger:     logger,
+	}
+}
+
+// handleError processes MongoDB errors and converts them to appropriate repository errors
+func (r *InsuranceMongoRepository) handleError(operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	r.logger.Error("MongoDB operation failed",
+		zap.String("operation", operation),
+		zap.Error(err))
+
+	return platformErrors.HandleMongoError(operation, err)
+}
+
+// validateIDs guards patient and insurance record IDs against NoSQL injection
+func (r *InsuranceMongoRepository) validateIDs(patientID, insuranceID string) error {
+	if err := validation_logic.ValidateID("patient_id", patientID); err != nil {
+		r.logger.Warn("Invalid patient_id provided",
+			zap.Error(err))
+		return platformErrors.NewValidationError("patient_id", patientID, "Invalid patient ID format")
+	}
+	if insuranceID != "" {
+		if err := validation_logic.ValidateID("insurance_id", insuranceID); err != nil {
+			r.logger.Warn("Invalid insurance_id provided",
+				zap.Error(err))
+			return platformErrors.NewValidationError("insurance_id", insuranceID, "Invalid insurance ID format")
+		}
+	}
+	return nil
+}
+
+// ListByPatientID retrieves a patient's insurance records, newest first
+func (r *InsuranceMongoRepository) ListByPatientID(ctx context.Context, patientID string) ([]patientModel.InsuranceRecord, error) {
+	start := time.Now()
+	defer func() {
+		r.logger.Debug("MongoDB ListByPatientID operation completed",
+			zap.String("patient_id", patientID),
+			zap.Duration("duration", time.Since(start)))
+	}()
+
+	if err := r.validateIDs(patientID, ""); err != nil {
+		return nil, err
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"patient_id": patientID}, opts)
+	if err != nil {
+		return nil, r.handleError("ListByPatientID", err)
+	}
+	defer cursor.Close(ctx)
+
+	records := []patientModel.InsuranceRecord{}
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, r.handleError("ListByPatientID", err)
+	}
+
+	return records, nil
+}
+
+// GetByID retrieves an insurance record
+func (r *InsuranceMongoRepository) GetByID(ctx context.Context, patientID, insuranceID string) (patientModel.InsuranceRecord, error) {
+	if err := r.validateIDs(patientID, insuranceID); err != nil {
+		return patientModel.InsuranceRecord{}, err
+	}
+
+	var record patientModel.InsuranceRecord
+	err := r.collection.FindOne(ctx, bson.M{"_id": insuranceID, "patient_id": patientID}).Decode(&record)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return patientModel.InsuranceRecord{}, platformErrors.NewRecordNotFoundError("insurance record", insuranceID)
+		}
+		return patientModel.InsuranceRecord{}, r.handleError("GetByID", err)
+	}
+
+	return record, nil
+}
+
+// Create inserts a new insurance record
+func (r *InsuranceMongoRepository) Create(ctx context.Context, record patientModel.InsuranceRecord) (patientModel.InsuranceRecord, error) {
+	if err := r.validateIDs(record.PatientID, record.ID); err != nil {
+		return patientModel.InsuranceRecord{}, err
+	}
+
+	if _, err := r.collection.InsertOne(ctx, record); err != nil {
+		return patientModel.InsuranceRecord{}, r.handleError("Create", err)
+	}
+
+	r.logger.Info("Successfully created insurance record in MongoDB",
+		zap.String("patient_id", record.PatientID),
+		zap.String("insurance_id", record.ID))
+
+	return record, nil
+}
+
+// Review saves the reviewed fields and status while the record is still pending confirmation
+func (r *InsuranceMongoRepository) Review(ctx context.Context, record patientModel.InsuranceRecord) (patientModel.InsuranceRecord, error) {
+	if err := r.validateIDs(record.PatientID, record.ID); err != nil {
+		return patientModel.InsuranceRecord{}, err
+	}
+
+	filter := bson.M{
+		"_id":        record.ID,
+		"patient_id": record.PatientID,
+		"status":     patientModel.InsurancePendingConfirmation,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"payer_name":      record.PayerName,
+			"member_id":       record.MemberID,
+			"group_number":    record.GroupNumber,
+			"member_name":     record.MemberName,
+			"rx_bin":          record.RxBIN,
+			"rx_pcn":          record.RxPCN,
+			"status":          record.Status,
+			"ocr":             record.OCR,
+			"reviewed_by":     record.ReviewedBy,
+			"reviewed_at":     record.ReviewedAt,
+			"rejected_reason": record.RejectedReason,
+			"effective_date":  record.EffectiveDate,
+			"expiry_date":     record.ExpiryDate,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return patientModel.InsuranceRecord{}, r.handleError("Review", err)
+	}
+	if result.MatchedCount == 0 {
+		existing, err := r.GetByID(ctx, record.PatientID, record.ID)
+		if err != nil {
+			return patientModel.InsuranceRecord{}, err
+		}
+		return patientModel.InsuranceRecord{}, platformErrors.NewConflictError("insurance record", record.ID, "insurance record is already "+string(existing.Status))
+	}
+
+	r.logger.Info("Successfully reviewed insurance record in MongoDB",
+		zap.String("insurance_id", record.ID),
+		zap.String("status", string(record.Status)))
+
+	return record, nil
+}
+
+// Update saves the fields and coverage dates of a confirmed record
+func (r *InsuranceMongoRepository) Update(ctx context.Context, record patientModel.InsuranceRecord) (patientModel.InsuranceRecord, error) {
+	if err := r.validateIDs(record.PatientID, record.ID); err != nil {
+		return patientModel.InsuranceRecord{}, err
+	}
+
+	filter := bson.M{
+		"_id":        record.ID,
+		"patient_id": record.PatientID,
+		"status":     patientModel.InsuranceConfirmed,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"payer_name":     record.PayerName,
+			"member_id":      record.MemberID,
+			"group_number":   record.GroupNumber,
+			"member_name":    record.MemberName,
+			"rx_bin":         record.RxBIN,
+			"rx_pcn":         record.RxPCN,
+			"effective_date": record.EffectiveDate,
+			"expiry_date":    record.ExpiryDate,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return patientModel.InsuranceRecord{}, r.handleError("Update", err)
+	}
+	if result.MatchedCount == 0 {
+		existing, err := r.GetByID(ctx, record.PatientID, record.ID)
+		if err != nil {
+			return patientModel.InsuranceRecord{}, err
+		}
+		return patientModel.InsuranceRecord{}, platformErrors.NewConflictError("insurance record", record.ID, "only confirmed insurance records can be changed; this one is "+string(existing.Status))
+	}
+
+	r.logger.Info("Successfully updated insurance record in MongoDB",
+		zap.String("insurance_id", record.ID))
+
+	return record, nil
+}
+
+// CreateIndexes creates recommended indexes for optimal performance
+func (r *InsuranceMongoRepository) CreateIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "patient_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().
+				SetName("patient_id_1_created_at_-1").
+				SetBackground(true),
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return r.handleError("CreateIndexes", err)
+	}
+
+	r.logger.Info("Successfully created MongoDB indexes for insurance_records collection")
+	return nil
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+)
+
+type InsuranceRepository interface {
+	// ListByPatientID returns a patient's insurance records, newest first
+	ListByPatientID(ctx context.Context, patientID string) ([]patientModel.InsuranceRecord, error)
+	GetByID(ctx context.Context, patientID, insuranceID string) (patientModel.InsuranceRecord, error)
+	Create(ctx context.Context, record patientModel.InsuranceRecord) (patientModel.InsuranceRecord, error)
+	// Review saves the outcome of a human review; it fails with a conflict unless the stored
+	// record is still pending confirmation
+	Review(ctx context.Context, record patientModel.InsuranceRecord) (patientModel.InsuranceRecord, error)
+	// Update saves the fields and coverage dates of a record; it fails with a conflict unless the
+	// stored record is confirmed
+	Update(ctx context.Context, record patientModel.InsuranceRecord) (patientModel.InsuranceRecord, error)
+}
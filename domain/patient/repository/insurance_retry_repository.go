@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// InsuranceRetryRepository retries the reads and idempotent writes of an insurance record
+// repository that fail with a retryable error; Create and Review run once
+type InsuranceRetryRepository struct {
+	next    InsuranceRepository
+	retrier *database.Retrier
+}
+
+// NewInsuranceRetryRepository wraps next with retries; without a retrier next is returned as is
+func NewInsuranceRetryRepository(next InsuranceRepository, retrier *database.Retrier) InsuranceRepository {
+	if retrier == nil {
+		return next
+	}
+	return &InsuranceRetryRepository{next: next, retrier: retrier}
+}
+
+func (r *InsuranceRetryRepository) ListByPatientID(ctx context.Context, patientID string) ([]patientModel.InsuranceRecord, error) {
+	return database.Retry(ctx, r.retrier, "insurance_records.ListByPatientID", func(ctx context.Context) ([]patientModel.InsuranceRecord, error) {
+		return r.next.ListByPatientID(ctx, patientID)
+	})
+}
+
+func (r *InsuranceRetryRepository) GetByID(ctx context.Context, patientID, insuranceID string) (patientModel.InsuranceRecord, error) {
+	return database.Retry(ctx, r.retrier, "insurance_records.GetByID", func(ctx context.Context) (patientModel.InsuranceRecord, error) {
+		return r.next.GetByID(ctx, patientID, insuranceID)
+	})
+}
+
+// Create runs once: a retried insert that reached the server would fail as a duplicate
+func (r *InsuranceRetryRepository) Create(ctx context.Context, record patientModel.InsuranceRecord) (patientModel.InsuranceRecord, error) {
+	return r.next.Create(ctx, record)
+}
+
+// Review runs once: it only applies while the record is pending confirmation, which a retried call that reached the server no longer finds
+func (r *InsuranceRetryRepository) Review(ctx context.Context, record patientModel.InsuranceRecord) (patientModel.InsuranceRecord, error) {
+	return r.next.Review(ctx, record)
+}
+
+func (r *InsuranceRetryRepository) Update(ctx context.Context, record patientModel.InsuranceRecord) (patientModel.InsuranceRecord, error) {
+	return database.Retry(ctx, r.retrier, "insurance_records.Update", func(ctx context.Context) (patientModel.InsuranceRecord, error) {
+		return r.next.Update(ctx, record)
+	})
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+type measurementMemoryRepository struct {
+	mu    sync.RWMutex
+	items map[string]map[string]patientModel.Measurement
+}
+
+func NewMeasurementMemoryRepository() MeasurementRepository {
+	r := &measurementMemoryRepository{items: make(map[string]map[string]patientModel.Measurement)}
+
+	// A few months of weights plus a height for the first patients
+	for p := 1; p <= 3; p++ {
+		patientID := fmt.Sprintf("P%03d", p)
+		for i := 0; i < 6; i++ {
+			r.Create(context.Background(), patientModel.Measurement{
+				ID:         fmt.Sprintf("M%03d-W%d", p, i),
+				PatientID:  patientID,
+				Type:       patientModel.MeasurementWeight,
+				Value:      float64(60+p*8) + float64(i%3) - float64(i)*0.4,
+				Unit:       patientModel.UnitKilogram,
+				RecordedAt: time.Now().AddDate(0, -i, 0),
+				RecordedBy: "seed",
+			})
+		}
+		r.Create(context.Background(), patientModel.Measurement{
+			ID:         fmt.Sprintf("M%03d-H", p),
+			PatientID:  patientID,
+			Type:       patientModel.MeasurementHeight,
+			Value:      float64(160 + p*5),
+			Unit:       patientModel.UnitCentimeter,
+			RecordedAt: time.Now().AddDate(0, -6, 0),
+			RecordedBy: "seed",
+		})
+	}
+
+	return r
+}
+
+func (r *measurementMemoryRepository) ListByPatientID(ctx context.Context, patientID string, measurementType patientModel.MeasurementType, limit int) ([]patientModel.Measurement, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := []patientModel.Measurement{}
+	for _, m := range r.items[patientID] {
+		if measurementType == "" || m.Type == measurementType {
+			result = append(result, m)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].RecordedAt.After(result[j].RecordedAt)
+	})
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (r *measurementMemoryRepository) GetByID(ctx context.Context, patientID, measurementID string) (patientModel.Measurement, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.items[patientID][measurementID], nil
+}
+
+func (r *measurementMemoryRepository) Latest(ctx context.Context, patientID string, measurementType patientModel.MeasurementType) (patientModel.Measurement, error) {
+	items, err := r.ListByPatientID(ctx, patientID, measurementType, 1)
+	if err != nil || len(items) == 0 {
+		return patientModel.Measurement{}, err
+	}
+	return items[0], nil
+}
+
+func (r *measurementMemoryRepository) Create(ctx context.Context, measurement patientModel.Measurement) (patientModel.Measurement, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.items[measurement.PatientID]; !ok {
+		r.items[measurement.PatientID] = make(map[string]patientModel.Measurement)
+	}
+	r.items[measurement.PatientID][measurement.ID] = measurement
+	return measurement, nil
+}
+
+func (r *measurementMemoryRepository) Update(ctx context.Context, measurement patientModel.Measurement) (patientModel.Measurement, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.items[measurement.PatientID][measurement.ID]; !ok {
+		return patientModel.Measurement{}, platformErrors.NewRecordNotFoundError("measurement", measurement.ID)
+	}
+	r.items[measurement.PatientID][measurement.ID] = measurement
+	return measurement, nil
+}
+
+func (r *measurementMemoryRepository) Delete(ctx context.Context, patientID, measurementID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.items[patientID][measurementID]; !ok {
+		return platformErrors.NewRecordNotFoundError("measurement", measurementID)
+	}
+	delete(r.items[patientID], measurementID)
+	return nil
+}
@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/validators/validation_logic"
+)
+
+// MeasurementMongoRepository implements MeasurementRepository interface using MongoDB
+type MeasurementMongoRepository struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewMeasurementMongoRepository creates a new MongoDB measurement repository
+func NewMeasurementMongoRepository(collection *mongo.Collection, logger *zap.Logger) MeasurementRepository {
+	return &MeasurementMongoRepository{
+		collection: collection,
+		logger:     logger,
+	}
+}
+
+// handleError processes MongoDB errors and converts them to appropriate repository errors
+func (r *MeasurementMongoRepository) handleError(operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	r.logger.Error("MongoDB operation failed",
+		zap.String("operation", operation),
+		zap.Error(err))
+
+	return platformErrors.HandleMongoError(operation, err)
+}
+
+// validateIDs guards patient and measurement IDs against NoSQL injection
+func (r *MeasurementMongoRepository) validateIDs(patientID, measurementID string) error {
+	if err := validation_logic.ValidateID("patient_id", patientID); err != nil {
+		r.logger.Warn("Invalid patient_id provided",
+			zap.Error(err))
+		return platformErrors.NewValidationError("patient_id", patientID, "Invalid patient ID format")
+	}
+	if measurementID != "" {
+		if err := validation_logic.ValidateID("measurement_id", measurementID); err != nil {
+			r.logger.Warn("Invalid measurement_id provided",
+				zap.Error(err))
+			return platformErrors.NewValidationError("measurement_id", measurementID, "Invalid measurement ID format")
+		}
+	}
+	return nil
+}
+
+// ListByPatientID retrieves a patient's measurements, newest first
+func (r *MeasurementMongoRepository) ListByPatientID(ctx context.Context, patientID string, measurementType patientModel.MeasurementType, limit int) ([]patientModel.Measurement, error) {
+	start := time.Now()
+	defer func() {
+		r.logger.Debug("MongoDB ListByPatientID operation completed",
+			zap.String("patient_id", patientID),
+			zap.Duration("duration", time.Since(start)))
+	}()
+
+	if err := r.validateIDs(patientID, ""); err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"patient_id": patientID}
+	if measurementType != "" {
+		if !patientModel.IsValidMeasurementType(measurementType) {
+			return nil, platformErrors.NewValidationError("type", string(measurementType), "Invalid measurement type")
+		}
+		filter["type"] = measurementType
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "recorded_at", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, r.handleError("ListByPatientID", err)
+	}
+	defer cursor.Close(ctx)
+
+	measurements := []patientModel.Measurement{}
+	if err := cursor.All(ctx, &measurements); err != nil {
+		return nil, r.handleError("ListByPatientID", err)
+	}
+
+	return measurements, nil
+}
+
+// GetByID retrieves a measurement; an empty measurement is returned when it does not exist
+func (r *MeasurementMongoRepository) GetByID(ctx context.Context, patientID, measurementID string) (patientModel.Measurement, error) {
+	if err := r.validateIDs(patientID, measurementID); err != nil {
+		return patientModel.Measurement{}, err
+	}
+
+	var measurement patientModel.Measurement
+	err := r.collection.FindOne(ctx, bson.M{"_id": measurementID, "patient_id": patientID}).Decode(&measurement)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return patientModel.Measurement{}, nil // Matches memory repo behavior
+		}
+		return patientModel.Measurement{}, r.handleError("GetByID", err)
+	}
+
+	return measurement, nil
+}
+
+// Latest retrieves the most recent measurement of a type
+func (r *MeasurementMongoRepository) Latest(ctx context.Context, patientID string, measurementType patientModel.MeasurementType) (patientModel.Measurement, error) {
+	items, err := r.ListByPatientID(ctx, patientID, measurementType, 1)
+	if err != nil || len(items) == 0 {
+		return patientModel.Measurement{}, err
+	}
+	return items[0], nil
+}
+
+// Create inserts a new measurement
+func (r *MeasurementMongoRepository) Create(ctx context.Context, measurement patientModel.Measurement) (patientModel.Measurement, error) {
+	if err := r.validateIDs(measurement.PatientID, measurement.ID); err != nil {
+		return patientModel.Measurement{}, err
+	}
+
+	if _, err := r.collection.InsertOne(ctx, measurement); err != nil {
+		return patientModel.Measurement{}, r.handleError("Create", err)
+	}
+
+	r.logger.Info("Successfully created measurement in MongoDB",
+		zap.String("patient_id", measurement.PatientID),
+		zap.String("measurement_id", measurement.ID))
+
+	return measurement, nil
+}
+
+// Update replaces the value, unit and recording details of a measurement
+func (r *MeasurementMongoRepository) Update(ctx context.Context, measurement patientModel.Measurement) (patientModel.Measurement, error) {
+	if err := r.validateIDs(measurement.PatientID, measurement.ID); err != nil {
+		return patientModel.Measurement{}, err
+	}
+
+	filter := bson.M{"_id": measurement.ID, "patient_id": measurement.PatientID}
+	update := bson.M{
+		"$set": bson.M{
+			"value":       measurement.Value,
+			"unit":        measurement.Unit,
+			"recorded_at": measurement.RecordedAt,
+			"recorded_by": measurement.RecordedBy,
+			"updated_at":  time.Now(),
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return patientModel.Measurement{}, r.handleError("Update", err)
+	}
+	if result.MatchedCount == 0 {
+		return patientModel.Measurement{}, platformErrors.NewRecordNotFoundError("measurement", measurement.ID)
+	}
+
+	return measurement, nil
+}
+
+// Delete removes a measurement
+func (r *MeasurementMongoRepository) Delete(ctx context.Context, patientID, measurementID string) error {
+	if err := r.validateIDs(patientID, measurementID); err != nil {
+		return err
+	}
+
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": measurementID, "patient_id": patientID})
+	if err != nil {
+		return r.handleError("Delete", err)
+	}
+	if result.DeletedCount == 0 {
+		return platformErrors.NewRecordNotFoundError("measurement", measurementID)
+	}
+
+	r.logger.Info("Successfully deleted measurement from MongoDB",
+		zap.String("patient_id", patientID),
+		zap.String("measurement_id", measurementID))
+
+	return nil
+}
+
+// CreateIndexes creates recommended indexes for optimal performance
+func (r *MeasurementMongoRepository) CreateIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "patient_id", Value: 1}, {Key: "type", Value: 1}, {Key: "recorded_at", Value: -1}},
+			Options: options.Index().
+				SetName("patient_id_1_type_1_recorded_at_-1").
+				SetBackground(true),
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return r.handleError("CreateIndexes", err)
+	}
+
+	r.logger.Info("Successfully created MongoDB indexes for measurements collection")
+	return nil
+}
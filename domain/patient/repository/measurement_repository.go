@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+)
+
+type MeasurementRepository interface {
+	// ListByPatientID returns measurements newest first; an empty type returns all types
+	ListByPatientID(ctx context.Context, patientID string, measurementType patientModel.MeasurementType, limit int) ([]patientModel.Measurement, error)
+	GetByID(ctx context.Context, patientID, measurementID string) (patientModel.Measurement, error)
+	Latest(ctx context.Context, patientID string, measurementType patientModel.MeasurementType) (patientModel.Measurement, error)
+	Create(ctx context.Context, measurement patientModel.Measurement) (patientModel.Measurement, error)
+	Update(ctx context.Context, measurement patientModel.Measurement) (patientModel.Measurement, error)
+	Delete(ctx context.Context, patientID, measurementID string) error
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// MeasurementRetryRepository retries the reads and idempotent writes of a measurement repository
+// that fail with a retryable error; Create and Delete run once
+type MeasurementRetryRepository struct {
+	next    MeasurementRepository
+	retrier *database.Retrier
+}
+
+// NewMeasurementRetryRepository wraps next with retries; without a retrier next is returned as is
+func NewMeasurementRetryRepository(next MeasurementRepository, retrier *database.Retrier) MeasurementRepository {
+	if retrier == nil {
+		return next
+	}
+	return &MeasurementRetryRepository{next: next, retrier: retrier}
+}
+
+func (r *MeasurementRetryRepository) ListByPatientID(ctx context.Context, patientID string, measurementType patientModel.MeasurementType, limit int) ([]patientModel.Measurement, error) {
+	return database.Retry(ctx, r.retrier, "measurements.ListByPatientID", func(ctx context.Context) ([]patientModel.Measurement, error) {
+		return r.next.ListByPatientID(ctx, patientID, measurementType, limit)
+	})
+}
+
+func (r *MeasurementRetryRepository) GetByID(ctx context.Context, patientID, measurementID string) (patientModel.Measurement, error) {
+	return database.Retry(ctx, r.retrier, "measurements.GetByID", func(ctx context.Context) (patientModel.Measurement, error) {
+		return r.next.GetByID(ctx, patientID, measurementID)
+	})
+}
+
+func (r *MeasurementRetryRepository) Latest(ctx context.Context, patientID string, measurementType patientModel.MeasurementType) (patientModel.Measurement, error) {
+	return database.Retry(ctx, r.retrier, "measurements.Latest", func(ctx context.Context) (patientModel.Measurement, error) {
+		return r.next.Latest(ctx, patientID, measurementType)
+	})
+}
+
+// Create runs once: a retried insert that reached the server would fail as a duplicate
+func (r *MeasurementRetryRepository) Create(ctx context.Context, measurement patientModel.Measurement) (patientModel.Measurement, error) {
+	return r.next.Create(ctx, measurement)
+}
+
+func (r *MeasurementRetryRepository) Update(ctx context.Context, measurement patientModel.Measurement) (patientModel.Measurement, error) {
+	return database.Retry(ctx, r.retrier, "measurements.Update", func(ctx context.Context) (patientModel.Measurement, error) {
+		return r.next.Update(ctx, measurement)
+	})
+}
+
+// Delete runs once: a retried delete that reached the server would report the record missing
+func (r *MeasurementRetryRepository) Delete(ctx context.Context, patientID, measurementID string) error {
+	return r.next.Delete(ctx, patientID, measurementID)
+}
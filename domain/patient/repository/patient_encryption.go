@@ -0,0 +1,435 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	m "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+	"pharmacy-modernization-project-model/internal/platform/dates"
+	"pharmacy-modernization-project-model/internal/platform/fieldcrypt"
+)
+
+// Blind index fields stored next to the encrypted PHI fields
+const (
+	nameIndexField  = "name_idx"  // Words and word prefixes of the name
+	dobIndexField   = "dob_idx"   // The DOB and every coarser date containing it
+	phoneIndexField = "phone_idx" // Digits of the phone number
+)
+
+// EncryptedFields are the stored patient fields the codec writes when encryption is enabled: the
+// sealed PHI and its blind indexes. Writers outside this package, such as data repairs, must not
+// set them, or they store plaintext PHI next to stale indexes.
+var EncryptedFields = []string{"name", "dob", "phone", nameIndexField, dobIndexField, phoneIndexField}
+
+// phoneNormalizedField holds the digits of plaintext phone numbers, which duplicate checks look
+// up; encrypted phone numbers are looked up by their blind index instead
+const phoneNormalizedField = "phone_normalized"
+
+// Blind index kinds; namePrefixLength matches the prefix length of typo-tolerant search
+const (
+	indexNameWord   = "name:word"
+	indexNamePrefix = "name:prefix"
+	indexDOBWithin  = "dob:within"
+	indexDOBExact   = "dob:exact"
+	indexPhone      = "phone"
+
+	namePrefixLength = 2
+)
+
+// patientCodec encrypts the PHI fields (name, DOB, phone) of patient documents on write and
+// decrypts them on read. Without a cipher documents are stored as plain patients.
+type patientCodec struct {
+	cipher *fieldcrypt.Cipher
+}
+
+func (c patientCodec) enabled() bool {
+	return c.cipher != nil
+}
+
+// encode returns the document to insert for p
+func (c patientCodec) encode(ctx context.Context, p m.Patient) (any, error) {
+	raw, err := bson.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	var doc bson.D
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	if !c.enabled() {
+		return append(doc, bson.E{Key: phoneNormalizedField, Value: m.NormalizePhone(p.Phone)}), nil
+	}
+
+	fields, err := c.encryptedFields(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	for i, e := range doc {
+		if value, ok := fields[e.Key]; ok {
+			doc[i].Value = value
+			delete(fields, e.Key)
+		}
+	}
+	for _, key := range []string{nameIndexField, dobIndexField, phoneIndexField} {
+		if value, ok := fields[key]; ok {
+			doc = append(doc, bson.E{Key: key, Value: value})
+		}
+	}
+	return doc, nil
+}
+
+// setFields returns the PHI fields of p for an update's $set
+func (c patientCodec) setFields(ctx context.Context, p m.Patient) (bson.M, error) {
+	if !c.enabled() {
+		return bson.M{"name": p.Name, "phone": p.Phone, phoneNormalizedField: m.NormalizePhone(p.Phone), "dob": p.DOB}, nil
+	}
+	return c.encryptedFields(ctx, p)
+}
+
+// unsetFields returns the fields an update's $unset removes: the plaintext normalized phone
+// number of a patient stored before encryption was enabled
+func (c patientCodec) unsetFields() bson.M {
+	if !c.enabled() {
+		return nil
+	}
+	return bson.M{phoneNormalizedField: ""}
+}
+
+// encryptedFields seals name, DOB and phone with the active key and derives their blind indexes
+func (c patientCodec) encryptedFields(ctx context.Context, p m.Patient) (bson.M, error) {
+	name, err := c.cipher.Encrypt(ctx, p.Name)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt name: %w", err)
+	}
+	dob, err := c.cipher.Encrypt(ctx, p.DOB.String())
+	if err != nil {
+		return nil, fmt.Errorf("encrypt dob: %w", err)
+	}
+	phone, err := c.cipher.Encrypt(ctx, p.Phone)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt phone: %w", err)
+	}
+
+	fields := bson.M{
+		"name":         name,
+		"dob":          dob,
+		"phone":        phone,
+		nameIndexField: c.nameIndex(p.Name),
+		dobIndexField:  c.dobIndex(p.DOB),
+	}
+	// Unset rather than empty, so the sparse unique index ignores patients without a phone
+	if digits := m.NormalizePhone(p.Phone); digits != "" {
+		fields[phoneIndexField] = c.cipher.BlindIndex(indexPhone, digits)
+	}
+	return fields, nil
+}
+
+// decode reads a stored patient, decrypting any encrypted fields. Plaintext documents, written
+// before encryption was enabled or not yet migrated, decode unchanged.
+func (c patientCodec) decode(ctx context.Context, raw bson.Raw) (m.Patient, error) {
+	var p m.Patient
+	if !c.enabled() {
+		err := bson.Unmarshal(raw, &p)
+		return p, err
+	}
+
+	var doc bson.D
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		return p, err
+	}
+	for i, e := range doc {
+		value, ok := e.Value.(string)
+		if !ok || !fieldcrypt.IsEncrypted(value) {
+			continue
+		}
+		plain, err := c.cipher.Decrypt(ctx, value)
+		if err != nil {
+			return p, fmt.Errorf("decrypt %s: %w", e.Key, err)
+		}
+		doc[i].Value = plain
+		if e.Key == "dob" {
+			dob, err := dates.Parse(plain)
+			if err != nil {
+				return p, fmt.Errorf("decrypt dob: %w", err)
+			}
+			doc[i].Value = dob
+		}
+	}
+
+	decrypted, err := bson.Marshal(doc)
+	if err != nil {
+		return p, err
+	}
+	err = bson.Unmarshal(decrypted, &p)
+	return p, err
+}
+
+// decodeAll reads every remaining patient from the cursor
+func (c patientCodec) decodeAll(ctx context.Context, cursor *mongo.Cursor) ([]m.Patient, error) {
+	patients := []m.Patient{}
+	for cursor.Next(ctx) {
+		p, err := c.decode(ctx, cursor.Current)
+		if err != nil {
+			return nil, err
+		}
+		patients = append(patients, p)
+	}
+	return patients, cursor.Err()
+}
+
+// nameFilter matches patients whose name contains query. Encrypted names only match whole
+// words: every word of the query must be a word of the name.
+func (c patientCodec) nameFilter(query string) bson.M {
+	if !c.enabled() {
+		return bson.M{"name": bson.M{"$regex": escapeRegexChars(query), "$options": "i"}}
+	}
+	hashes := bson.A{}
+	for _, word := range nameWords(query) {
+		hashes = append(hashes, c.cipher.BlindIndex(indexNameWord, word))
+	}
+	return bson.M{nameIndexField: bson.M{"$all": hashes}}
+}
+
+// namePrefixFilter matches patients with a name word starting with prefix. Encrypted names only
+// match prefixes of namePrefixLength characters, which is what typo-tolerant search sends.
+func (c patientCodec) namePrefixFilter(prefix string) bson.M {
+	if !c.enabled() {
+		return bson.M{"name": bson.M{"$regex": `(^|\s)` + escapeRegexChars(prefix), "$options": "i"}}
+	}
+	return bson.M{nameIndexField: c.cipher.BlindIndex(indexNamePrefix, strings.ToLower(prefix))}
+}
+
+// phoneFilter matches patients whose phone number contains digits. Encrypted phone numbers
+// only match the whole number.
+func (c patientCodec) phoneFilter(digits string) bson.M {
+	if !c.enabled() {
+		return bson.M{"phone": bson.M{"$regex": `(^|\D)` + escapeRegexChars(digits)}}
+	}
+	return bson.M{phoneIndexField: c.cipher.BlindIndex(indexPhone, m.NormalizePhone(digits))}
+}
+
+// duplicateFilter matches patients with the normalized phone number or exactly the DOB; an
+// empty phone or zero DOB is not looked up
+func (c patientCodec) duplicateFilter(phone string, dob dates.PartialDate) bson.A {
+	or := bson.A{}
+	switch {
+	case phone == "":
+	case c.enabled():
+		or = append(or, bson.M{phoneIndexField: c.cipher.BlindIndex(indexPhone, phone)})
+	default:
+		or = append(or, bson.M{phoneNormalizedField: phone})
+	}
+	switch {
+	case dob.IsZero():
+	case c.enabled():
+		or = append(or, bson.M{dobIndexField: c.cipher.BlindIndex(indexDOBExact, dob.String())})
+	default:
+		or = append(or, bson.M{"dob": dob})
+	}
+	return or
+}
+
+// birthDateFilter matches every stored DOB that can refer to the same day as birthDate
+func (c patientCodec) birthDateFilter(birthDate dates.PartialDate) bson.A {
+	if !c.enabled() {
+		return birthDateFilter(birthDate)
+	}
+	// Stored DOBs within birthDate, and coarser stored DOBs that contain it
+	containing := bson.A{}
+	for _, d := range coarserDates(birthDate) {
+		containing = append(containing, c.cipher.BlindIndex(indexDOBExact, d.String()))
+	}
+	return bson.A{
+		bson.M{dobIndexField: c.cipher.BlindIndex(indexDOBWithin, birthDate.String())},
+		bson.M{dobIndexField: bson.M{"$in": containing}},
+	}
+}
+
+// birthDateRangeFilter matches every stored DOB that can refer to a day from first to last. An
+// open first end starts request.MaxAgeFilter years before now and an open last end is today, as
+// the blind index can only match the whole years, months and days covering the range.
+func (c patientCodec) birthDateRangeFilter(first, last dates.PartialDate, now time.Time) bson.M {
+	if !c.enabled() {
+		return birthDateRangeFilter(first, last)
+	}
+	if first.IsZero() {
+		first = dates.Full(now.Year()-request.MaxAgeFilter-1, time.January, 1)
+	}
+	if last.IsZero() {
+		last = dates.FromTime(now)
+	}
+
+	hashes := bson.A{}
+	seen := map[string]bool{}
+	add := func(kind string, d dates.PartialDate) {
+		if hash := c.cipher.BlindIndex(kind, d.String()); !seen[hash] {
+			seen[hash] = true
+			hashes = append(hashes, hash)
+		}
+	}
+	for _, d := range coveringDates(first, last) {
+		// Stored DOBs within the part, and coarser ones that contain it but not the whole range
+		add(indexDOBWithin, d)
+		for _, coarser := range coarserDates(d) {
+			add(indexDOBExact, coarser)
+		}
+	}
+	return bson.M{dobIndexField: bson.M{"$in": hashes}}
+}
+
+// coveringDates splits the days from first to last into the fewest whole years, months and days
+func coveringDates(first, last dates.PartialDate) []dates.PartialDate {
+	var covering []dates.PartialDate
+	end := last.End()
+	for day := first.Earliest(); day.Before(end); {
+		switch {
+		case day.YearDay() == 1 && !day.AddDate(1, 0, 0).After(end):
+			covering = append(covering, dates.PartialDate{Year: day.Year()})
+			day = day.AddDate(1, 0, 0)
+		case day.Day() == 1 && !day.AddDate(0, 1, 0).After(end):
+			covering = append(covering, dates.PartialDate{Year: day.Year(), Month: day.Month()})
+			day = day.AddDate(0, 1, 0)
+		default:
+			covering = append(covering, dates.FromTime(day))
+			day = day.AddDate(0, 0, 1)
+		}
+	}
+	return covering
+}
+
+// nameIndex hashes every word of the name and its prefix
+func (c patientCodec) nameIndex(name string) []string {
+	index := []string{}
+	for _, word := range nameWords(name) {
+		index = append(index, c.cipher.BlindIndex(indexNameWord, word))
+		if prefix := []rune(word); len(prefix) >= namePrefixLength {
+			index = append(index, c.cipher.BlindIndex(indexNamePrefix, string(prefix[:namePrefixLength])))
+		}
+	}
+	return index
+}
+
+// dobIndex hashes the date itself and every date it lies within (its month and year)
+func (c patientCodec) dobIndex(dob dates.PartialDate) []string {
+	if dob.IsZero() {
+		return []string{}
+	}
+	index := []string{
+		c.cipher.BlindIndex(indexDOBExact, dob.String()),
+		c.cipher.BlindIndex(indexDOBWithin, dob.String()),
+	}
+	for _, d := range coarserDates(dob) {
+		index = append(index, c.cipher.BlindIndex(indexDOBWithin, d.String()))
+	}
+	return index
+}
+
+// coarserDates returns the year, and the month for full dates, that contain d
+func coarserDates(d dates.PartialDate) []dates.PartialDate {
+	var coarser []dates.PartialDate
+	if d.Precision() == dates.PrecisionMonth || d.Precision() == dates.PrecisionDay {
+		coarser = append(coarser, dates.PartialDate{Year: d.Year})
+	}
+	if d.Precision() == dates.PrecisionDay {
+		coarser = append(coarser, dates.PartialDate{Year: d.Year, Month: d.Month})
+	}
+	return coarser
+}
+
+// nameWords lower-cases a name and splits it into words
+func nameWords(name string) []string {
+	return strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// EncryptionMigrationResult counts the patient documents visited by MigratePatientEncryption
+type EncryptionMigrationResult struct {
+	Scanned   int
+	Encrypted int // Rewritten with the active key
+	Changed   int // Modified by someone else while migrating; the new write already used the active key
+}
+
+// MigratePatientEncryption encrypts every patient document whose PHI is still plaintext or sealed
+// with a key other than the active one. With all, every document is rewritten, which also rebuilds
+// the blind indexes after the index key changed. With dryRun documents are only counted.
+func MigratePatientEncryption(ctx context.Context, collection *mongo.Collection, cipher *fieldcrypt.Cipher, batchSize int, all, dryRun bool) (EncryptionMigrationResult, error) {
+	codec := patientCodec{cipher: cipher}
+	result := EncryptionMigrationResult{}
+
+	cursor, err := collection.Find(ctx, bson.M{}, options.Find().
+		SetBatchSize(int32(batchSize)).
+		SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return result, err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		result.Scanned++
+		raw := cursor.Current
+		if !all && !codec.needsEncryption(raw) {
+			continue
+		}
+		if dryRun {
+			result.Encrypted++
+			continue
+		}
+
+		p, err := codec.decode(ctx, raw)
+		if err != nil {
+			return result, fmt.Errorf("patient %v: %w", raw.Lookup("_id"), err)
+		}
+		set, err := codec.encryptedFields(ctx, p)
+		if err != nil {
+			return result, fmt.Errorf("patient %s: %w", p.ID, err)
+		}
+
+		// Only replace the values that were read, so a concurrent update is never overwritten
+		filter := bson.D{{Key: "_id", Value: raw.Lookup("_id")}}
+		for _, field := range []string{"name", "dob", "phone"} {
+			if value, err := raw.LookupErr(field); err == nil {
+				filter = append(filter, bson.E{Key: field, Value: value})
+			} else {
+				filter = append(filter, bson.E{Key: field, Value: bson.M{"$exists": false}})
+			}
+		}
+		updated, err := collection.UpdateOne(ctx, filter, bson.M{"$set": set, "$unset": codec.unsetFields()})
+		if err != nil {
+			return result, fmt.Errorf("patient %s: %w", p.ID, err)
+		}
+		if updated.MatchedCount == 0 {
+			result.Changed++
+			continue
+		}
+		result.Encrypted++
+	}
+	return result, cursor.Err()
+}
+
+// needsEncryption reports whether a stored patient has a PHI field in plaintext or under a retired key
+func (c patientCodec) needsEncryption(raw bson.Raw) bool {
+	for _, field := range []string{"name", "dob", "phone"} {
+		value, err := raw.LookupErr(field)
+		if err != nil || value.Type == bson.TypeNull {
+			continue
+		}
+		s, ok := value.StringValueOK()
+		if !ok {
+			// Full DOBs are stored as datetimes until encrypted
+			return true
+		}
+		if c.cipher.NeedsRotation(s) {
+			return true
+		}
+	}
+	return false
+}
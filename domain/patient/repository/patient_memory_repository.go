@@ -2,15 +2,25 @@ package repository
 
 import (
 	"context"
+	"slices"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	m "pharmacy-modernization-project-model/domain/patient/contracts/model"
 	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+	"pharmacy-modernization-project-model/internal/platform/dates"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/tenancy"
 )
 
-type PatientMemoryRepository struct{ items map[string]m.Patient }
+// PatientMemoryRepository keeps patients in memory for running without MongoDB; it behaves like
+// PatientMongoRepository, which cmd/conformance checks
+type PatientMemoryRepository struct {
+	mu    sync.RWMutex
+	items map[string]m.Patient
+}
 
 func NewPatientMemoryRepository() PatientRepository {
 	r := &PatientMemoryRepository{items: map[string]m.Patient{}}
@@ -19,126 +29,221 @@ func NewPatientMemoryRepository() PatientRepository {
 		name  string
 		phone string
 		state string
-		dob   time.Time
+		dob   dates.PartialDate
 	}{
-		{"P001", "Ava Thompson", "(206) 417-8842", "WA", time.Date(1988, time.January, 12, 0, 0, 0, 0, time.UTC)},
-		{"P002", "Liam Anderson", "(415) 736-5528", "CA", time.Date(1979, time.March, 3, 0, 0, 0, 0, time.UTC)},
-		{"P003", "Sophia Martinez", "(617) 980-3314", "MA", time.Date(1992, time.July, 27, 0, 0, 0, 0, time.UTC)},
-		{"P004", "Noah Patel", "(972) 645-2091", "TX", time.Date(1985, time.May, 5, 0, 0, 0, 0, time.UTC)},
-		{"P005", "Mia Chen", "(312) 478-6605", "IL", time.Date(1996, time.September, 19, 0, 0, 0, 0, time.UTC)},
-		{"P006", "Ethan Johnson", "(303) 825-1947", "CO", time.Date(1975, time.November, 8, 0, 0, 0, 0, time.UTC)},
-		{"P007", "Olivia Rossi", "(646) 291-0743", "NY", time.Date(1990, time.February, 22, 0, 0, 0, 0, time.UTC)},
-		{"P008", "Jackson Lee", "(503) 913-2286", "OR", time.Date(1983, time.April, 16, 0, 0, 0, 0, time.UTC)},
-		{"P009", "Emma Davis", "(305) 744-1189", "FL", time.Date(1998, time.December, 2, 0, 0, 0, 0, time.UTC)},
-		{"P010", "Lucas Hernandez", "(713) 402-5378", "TX", time.Date(1981, time.June, 14, 0, 0, 0, 0, time.UTC)},
-	}
-
-	for _, s := range sample {
+		{"P001", "Ava Thompson", "(206) 417-8842", "WA", dates.Full(1988, time.January, 12)},
+		{"P002", "Liam Anderson", "(415) 736-5528", "CA", dates.Full(1979, time.March, 3)},
+		{"P003", "Sophia Martinez", "(617) 980-3314", "MA", dates.Full(1992, time.July, 27)},
+		{"P004", "Noah Patel", "(972) 645-2091", "TX", dates.Full(1985, time.May, 5)},
+		{"P005", "Mia Chen", "(312) 478-6605", "IL", dates.Full(1996, time.September, 19)},
+		{"P006", "Ethan Johnson", "(303) 825-1947", "CO", dates.Full(1975, time.November, 8)},
+		{"P007", "Olivia Rossi", "(646) 291-0743", "NY", dates.Full(1990, time.February, 22)},
+		{"P008", "Jackson Lee", "(503) 913-2286", "OR", dates.Full(1983, time.April, 16)},
+		{"P009", "Emma Davis", "(305) 744-1189", "FL", dates.Full(1998, time.December, 2)},
+		{"P010", "Lucas Hernandez", "(713) 402-5378", "TX", dates.Full(1981, time.June, 14)},
+	}
+
+	// Lists are newest first, so the samples are created a minute apart in reverse order
+	now := time.Now()
+	for i, s := range sample {
 		r.items[s.id] = m.Patient{
 			ID:        s.id,
 			Name:      s.name,
 			Phone:     s.phone,
 			State:     s.state,
 			DOB:       s.dob,
-			CreatedAt: time.Now(),
+			CreatedAt: now.Add(-time.Duration(i) * time.Minute),
+			OrgID:     tenancy.DefaultOrgID,
 		}
 	}
 
 	return r
 }
 
+// List returns a page of the matching patients, newest first; a zero limit returns all of them
 func (r *PatientMemoryRepository) List(ctx context.Context, req request.PatientListQueryRequest) ([]m.Patient, error) {
-	keys := make([]string, 0, len(r.items))
-	for k := range r.items {
-		keys = append(keys, k)
+	res := r.filter(ctx, req)
+	if req.Offset >= len(res) {
+		return []m.Patient{}, nil
 	}
-	sort.Strings(keys)
-
-	res := make([]m.Patient, 0, len(keys))
-	for _, k := range keys {
-		v := r.items[k]
-
-		// Apply all filters
-		matches := true
+	end := len(res)
+	if req.Limit > 0 && req.Offset+req.Limit < end {
+		end = req.Offset + req.Limit
+	}
+	return res[req.Offset:end], nil
+}
 
-		// Filter by patient name if provided
-		if req.PatientName != "" && !strings.Contains(strings.ToLower(v.Name), strings.ToLower(req.PatientName)) {
-			matches = false
+// filter returns the patients matching the query filters, newest first
+func (r *PatientMemoryRepository) filter(ctx context.Context, req request.PatientListQueryRequest) []m.Patient {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	res := make([]m.Patient, 0, len(r.items))
+	for _, v := range r.items {
+		if tenancy.Visible(ctx, v.OrgID) && matchesListQuery(v, req) {
+			res = append(res, v)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if !res[i].CreatedAt.Equal(res[j].CreatedAt) {
+			return res[i].CreatedAt.After(res[j].CreatedAt)
 		}
+		return res[i].ID < res[j].ID
+	})
+	return res
+}
+
+func matchesListQuery(v m.Patient, req request.PatientListQueryRequest) bool {
+	// Filter by patient name if provided
+	if req.PatientName != "" && !strings.Contains(strings.ToLower(v.Name), strings.ToLower(req.PatientName)) {
+		return false
+	}
 
-		// Filter by birth date if provided
-		if req.BirthDate != "" && matches {
-			if birthDate, err := time.Parse("2006-01-02", req.BirthDate); err == nil {
-				// Compare dates (ignoring time)
-				vDate := time.Date(v.DOB.Year(), v.DOB.Month(), v.DOB.Day(), 0, 0, 0, 0, v.DOB.Location())
-				reqDate := time.Date(birthDate.Year(), birthDate.Month(), birthDate.Day(), 0, 0, 0, 0, birthDate.Location())
-				if !vDate.Equal(reqDate) {
-					matches = false
-				}
+	// Filter by birth date if provided
+	if req.BirthDate != "" {
+		if birthDate, err := dates.Parse(req.BirthDate); err == nil {
+			// Partial dates match any date they can refer to, e.g. 1988 matches 1988-01-12
+			if !v.DOB.Overlaps(birthDate) {
+				return false
 			}
 		}
+	}
 
-		// Filter by state if provided
-		if req.State != "" && matches && !strings.Contains(strings.ToLower(v.State), strings.ToLower(req.State)) {
-			matches = false
-		}
+	// Filter by state if provided
+	if req.State != "" && !strings.Contains(strings.ToLower(v.State), strings.ToLower(req.State)) {
+		return false
+	}
 
-		if matches {
-			res = append(res, v)
-		}
+	// Restrict to the caller's data-access scope
+	if len(req.AllowedStates) > 0 && !slices.Contains(req.AllowedStates, v.State) {
+		return false
 	}
-	if req.Offset >= len(res) {
-		return []m.Patient{}, nil
+
+	// Filter by age; a partial DOB matches when any of its possible ages is in range
+	if req.FiltersByAge() {
+		if v.DOB.IsZero() {
+			return false
+		}
+		youngest, oldest := v.DOB.AgeRange(time.Now())
+		if (req.MinAge != nil && oldest < *req.MinAge) || (req.MaxAge != nil && youngest > *req.MaxAge) {
+			return false
+		}
 	}
-	end := req.Offset + req.Limit
-	if end > len(res) {
-		end = len(res)
+
+	// Filter by active prescriptions, which the service looked up
+	if req.HasActivePrescriptions != nil && slices.Contains(req.ActivePrescriptionPatientIDs, v.ID) != *req.HasActivePrescriptions {
+		return false
 	}
-	return res[req.Offset:end], nil
+
+	return true
 }
+
 func (r *PatientMemoryRepository) GetByID(ctx context.Context, id string) (m.Patient, error) {
-	return r.items[id], nil
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if p, ok := r.items[id]; ok && tenancy.Visible(ctx, p.OrgID) {
+		return p, nil
+	}
+	return m.Patient{}, platformErrors.NewRecordNotFoundError("Patient", id)
 }
+
 func (r *PatientMemoryRepository) Create(ctx context.Context, p m.Patient) (m.Patient, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// IDs are unique across organizations, as they are in the collection
+	if _, ok := r.items[p.ID]; ok {
+		return m.Patient{}, platformErrors.NewDuplicateRecordError("Patient", p.ID)
+	}
+	if p.CreatedAt.IsZero() {
+		p.CreatedAt = time.Now()
+	}
+	p.OrgID = tenancy.Assign(ctx, p.OrgID)
 	r.items[p.ID] = p
 	return p, nil
 }
+
+// Update writes the fields an edit may change; the ID, creation time and organization are kept
 func (r *PatientMemoryRepository) Update(ctx context.Context, id string, p m.Patient) (m.Patient, error) {
-	r.items[id] = p
-	return p, nil
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.items[id]
+	if !ok || !tenancy.Visible(ctx, existing.OrgID) {
+		return m.Patient{}, platformErrors.NewRecordNotFoundError("Patient", id)
+	}
+	existing.Name = p.Name
+	existing.Phone = p.Phone
+	existing.DOB = p.DOB
+	existing.State = p.State
+	existing.ContactPreferences = p.ContactPreferences
+	if p.EditBy != nil {
+		existing.EditBy = p.EditBy
+	}
+	if p.EditTime != nil {
+		existing.EditTime = p.EditTime
+	}
+	r.items[id] = existing
+	return existing, nil
 }
 
 func (r *PatientMemoryRepository) Count(ctx context.Context, req request.PatientListQueryRequest) (int, error) {
-	count := 0
-	for _, v := range r.items {
-		// Apply all filters (same logic as List function)
-		matches := true
+	return len(r.filter(ctx, req)), nil
+}
 
-		// Filter by patient name if provided
-		if req.PatientName != "" && !strings.Contains(strings.ToLower(v.Name), strings.ToLower(req.PatientName)) {
-			matches = false
-		}
+func (r *PatientMemoryRepository) CountByState(ctx context.Context) ([]m.StateCount, error) {
+	byState := map[string]int{}
+	for _, p := range r.filter(ctx, request.PatientListQueryRequest{}) {
+		byState[p.State]++
+	}
+	counts := make([]m.StateCount, 0, len(byState))
+	for state, count := range byState {
+		counts = append(counts, m.StateCount{State: state, Count: count})
+	}
+	sort.Slice(counts, func(i, j int) bool { return counts[i].State < counts[j].State })
+	return counts, nil
+}
 
-		// Filter by birth date if provided
-		if req.BirthDate != "" && matches {
-			if birthDate, err := time.Parse("2006-01-02", req.BirthDate); err == nil {
-				// Compare dates (ignoring time)
-				vDate := time.Date(v.DOB.Year(), v.DOB.Month(), v.DOB.Day(), 0, 0, 0, 0, v.DOB.Location())
-				reqDate := time.Date(birthDate.Year(), birthDate.Month(), birthDate.Day(), 0, 0, 0, 0, birthDate.Location())
-				if !vDate.Equal(reqDate) {
-					matches = false
-				}
-			}
+// Stream visits the matching patients oldest first
+func (r *PatientMemoryRepository) Stream(ctx context.Context, req request.PatientListQueryRequest, fn func(m.Patient) error) error {
+	res := r.filter(ctx, req)
+	sort.SliceStable(res, func(i, j int) bool { return res[i].CreatedAt.Before(res[j].CreatedAt) })
+	for _, p := range res {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(p); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		// Filter by state if provided
-		if req.State != "" && matches && !strings.Contains(strings.ToLower(v.State), strings.ToLower(req.State)) {
-			matches = false
+// ListRecentlyUpdated orders patients by their last edit, falling back to creation
+func (r *PatientMemoryRepository) ListRecentlyUpdated(ctx context.Context, limit int) ([]m.Patient, error) {
+	res := r.filter(ctx, request.PatientListQueryRequest{})
+	changedAt := func(p m.Patient) time.Time {
+		if p.EditTime != nil {
+			return *p.EditTime
 		}
+		return p.CreatedAt
+	}
+	sort.SliceStable(res, func(i, j int) bool { return changedAt(res[i]).After(changedAt(res[j])) })
+	if limit > 0 && len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
 
-		if matches {
-			count++
+// FindDuplicateCandidates compares the digits of every visible patient's phone number and DOB
+func (r *PatientMemoryRepository) FindDuplicateCandidates(ctx context.Context, phone string, dob dates.PartialDate, limit int) ([]m.Patient, error) {
+	res := []m.Patient{}
+	for _, p := range r.filter(ctx, request.PatientListQueryRequest{}) {
+		samePhone := phone != "" && m.NormalizePhone(p.Phone) == phone
+		sameDOB := !dob.IsZero() && p.DOB == dob
+		if samePhone || sameDOB {
+			res = append(res, p)
 		}
 	}
-	return count, nil
+	if limit > 0 && len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
 }
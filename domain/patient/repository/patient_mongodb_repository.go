@@ -14,16 +14,115 @@ import (
 	m "pharmacy-modernization-project-model/domain/patient/contracts/model"
 	"pharmacy-modernization-project-model/domain/patient/contracts/request"
 	patientErrors "pharmacy-modernization-project-model/domain/patient/errors"
+	"pharmacy-modernization-project-model/internal/platform/database"
+	"pharmacy-modernization-project-model/internal/platform/dates"
 	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/fieldcrypt"
+	"pharmacy-modernization-project-model/internal/platform/tenancy"
 	"pharmacy-modernization-project-model/internal/validators/validation_logic"
 )
 
-// PatientMongoRepository implements PatientRepository interface using MongoDB
+// birthDateFilter matches every stored DOB that can refer to the same day as birthDate: full
+// dates (BSON datetimes) within its range, and partial dates (strings) that contain it or lie in it
+func birthDateFilter(birthDate dates.PartialDate) bson.A {
+	partial := bson.M{"$regex": "^" + escapeRegexChars(birthDate.String())}
+	if birthDate.Precision() != dates.PrecisionYear {
+		year := dates.PartialDate{Year: birthDate.Year}
+		month := dates.PartialDate{Year: birthDate.Year, Month: birthDate.Month}
+		partial = bson.M{"$in": bson.A{year.String(), month.String()}}
+	}
+	return bson.A{
+		bson.M{"dob": bson.M{"$gte": birthDate.Earliest(), "$lt": birthDate.End()}},
+		bson.M{"dob": partial},
+	}
+}
+
+// listFilter builds the query filter shared by List, Count and Stream, with input sanitization to
+// prevent regex injection
+func listFilter(ctx context.Context, codec patientCodec, req request.PatientListQueryRequest) bson.M {
+	filter := tenancy.Filter(ctx, bson.M{})
+
+	// Filter by patient name (the codec escapes regex characters)
+	if req.PatientName != "" {
+		for key, value := range codec.nameFilter(req.PatientName) {
+			filter[key] = value
+		}
+	}
+
+	// Filter by birth date
+	if req.BirthDate != "" {
+		if birthDate, err := dates.Parse(req.BirthDate); err == nil {
+			filter["$or"] = codec.birthDateFilter(birthDate)
+		}
+	}
+
+	// Filter by state
+	if req.State != "" {
+		filter["state"] = bson.M{"$regex": escapeRegexChars(req.State), "$options": "i"}
+	}
+
+	// Filter by active prescriptions, which the service looked up
+	if req.HasActivePrescriptions != nil {
+		ids := append([]string{}, req.ActivePrescriptionPatientIDs...)
+		if *req.HasActivePrescriptions {
+			filter["_id"] = bson.M{"$in": ids}
+		} else {
+			filter["_id"] = bson.M{"$nin": ids}
+		}
+	}
+
+	// Conditions with their own $or are combined with $and, so they don't replace each other
+	and := bson.A{}
+
+	// Restrict to the caller's data-access scope
+	if len(req.AllowedStates) > 0 {
+		and = append(and, bson.M{"state": bson.M{"$in": req.AllowedStates}})
+	}
+
+	// Filter by age; a partial DOB matches when any of its possible ages is in range
+	if req.FiltersByAge() {
+		now := time.Now()
+		first, last := dates.BirthDates(now, req.MinAge, req.MaxAge)
+		and = append(and, codec.birthDateRangeFilter(first, last, now))
+	}
+
+	if len(and) > 0 {
+		filter["$and"] = and
+	}
+	return filter
+}
+
+// birthDateRangeFilter matches every stored DOB that can refer to a day from first to last; a
+// zero bound leaves that end of the range open. Partial dates are stored as YYYY or YYYY-MM
+// strings, which compare in date order with the year or month of the bounds.
+func birthDateRangeFilter(first, last dates.PartialDate) bson.M {
+	full := bson.M{}
+	year := bson.M{"$regex": `^\d{4}$`}
+	month := bson.M{"$regex": `^\d{4}-\d{2}$`}
+	if !first.IsZero() {
+		full["$gte"] = first.Earliest()
+		year["$gte"] = dates.PartialDate{Year: first.Year}.String()
+		month["$gte"] = dates.PartialDate{Year: first.Year, Month: first.Month}.String()
+	}
+	if !last.IsZero() {
+		full["$lt"] = last.End()
+		year["$lte"] = dates.PartialDate{Year: last.Year}.String()
+		month["$lte"] = dates.PartialDate{Year: last.Year, Month: last.Month}.String()
+	}
+	return bson.M{"$or": bson.A{bson.M{"dob": full}, bson.M{"dob": year}, bson.M{"dob": month}}}
+}
+
+// PatientMongoRepository implements PatientRepository interface using MongoDB. With a field
+// cipher, name, DOB and phone are encrypted at rest.
 type PatientMongoRepository struct {
 	collection *mongo.Collection
+	codec      patientCodec
 	logger     *zap.Logger
 }
 
+// streamBatchSize is how many documents Stream fetches from the server per round trip
+const streamBatchSize = 500
+
 type patientID string
 type patientPhone string
 type patientState string
@@ -33,10 +132,12 @@ func escapeRegexChars(input string) string {
 	return regexp.QuoteMeta(input)
 }
 
-// NewPatientMongoRepository creates a new MongoDB patient repository
-func NewPatientMongoRepository(collection *mongo.Collection, logger *zap.Logger) PatientRepository {
+// NewPatientMongoRepository creates a new MongoDB patient repository; a nil cipher stores PHI
+// fields in plaintext
+func NewPatientMongoRepository(collection *mongo.Collection, cipher *fieldcrypt.Cipher, logger *zap.Logger) PatientRepository {
 	return &PatientMongoRepository{
 		collection: collection,
+		codec:      patientCodec{cipher: cipher},
 		logger:     logger,
 	}
 }
@@ -65,39 +166,13 @@ func (r *PatientMongoRepository) List(ctx context.Context, req request.PatientLi
 			zap.Duration("duration", time.Since(start)))
 	}()
 
-	// Build filter with input sanitization to prevent regex injection
-	filter := bson.M{}
-
-	// Filter by patient name
-	if req.PatientName != "" {
-		// Escape special regex characters to prevent regex injection
-		escapedQuery := escapeRegexChars(req.PatientName)
-		filter["name"] = bson.M{"$regex": escapedQuery, "$options": "i"}
-	}
-
-	// Filter by birth date
-	if req.BirthDate != "" {
-		if birthDate, err := time.Parse("2006-01-02", req.BirthDate); err == nil {
-			// Filter for exact date match
-			startOfDay := time.Date(birthDate.Year(), birthDate.Month(), birthDate.Day(), 0, 0, 0, 0, birthDate.Location())
-			endOfDay := startOfDay.Add(24 * time.Hour)
-			filter["dob"] = bson.M{
-				"$gte": startOfDay,
-				"$lt":  endOfDay,
-			}
-		}
-	}
-
-	// Filter by state
-	if req.State != "" {
-		filter["state"] = bson.M{"$regex": escapeRegexChars(req.State), "$options": "i"}
-	}
+	filter := listFilter(ctx, r.codec, req)
 
 	// Configure options
 	opts := options.Find().
 		SetLimit(int64(req.Limit)).
 		SetSkip(int64(req.Offset)).
-		SetSort(bson.D{{Key: "created_at", Value: -1}}) // Sort by creation date descending
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: 1}}) // Newest first; _id keeps pages stable on ties
 
 	// Execute query
 	cursor, err := r.collection.Find(ctx, filter, opts)
@@ -107,8 +182,8 @@ func (r *PatientMongoRepository) List(ctx context.Context, req request.PatientLi
 	defer cursor.Close(ctx)
 
 	// Decode results
-	var patients []m.Patient
-	if err := cursor.All(ctx, &patients); err != nil {
+	patients, err := r.codec.decodeAll(ctx, cursor)
+	if err != nil {
 		return nil, r.handleError("List", err)
 	}
 
@@ -131,16 +206,19 @@ func (r *PatientMongoRepository) GetByID(ctx context.Context, id string) (m.Pati
 			zap.Duration("duration", time.Since(start)))
 	}()
 
-	filter := bson.M{"_id": patientID(id)}
+	filter := tenancy.Filter(ctx, bson.M{"_id": patientID(id)})
 
-	var patient m.Patient
-	err := r.collection.FindOne(ctx, filter).Decode(&patient)
+	raw, err := r.collection.FindOne(ctx, filter).Raw()
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return m.Patient{}, patientErrors.NewRecordNotFoundError("Patient", id)
 		}
 		return m.Patient{}, fmt.Errorf("failed to get patient: %w", err)
 	}
+	patient, err := r.codec.decode(ctx, raw)
+	if err != nil {
+		return m.Patient{}, fmt.Errorf("failed to get patient: %w", err)
+	}
 
 	r.logger.Debug("Successfully retrieved patient from MongoDB")
 
@@ -176,9 +254,15 @@ func (r *PatientMongoRepository) Create(ctx context.Context, p m.Patient) (m.Pat
 	if p.CreatedAt.IsZero() {
 		p.CreatedAt = time.Now()
 	}
+	p.OrgID = tenancy.Assign(ctx, p.OrgID)
+
+	doc, err := r.codec.encode(ctx, p)
+	if err != nil {
+		return m.Patient{}, fmt.Errorf("failed to create patient: %w", err)
+	}
 
 	// Insert document
-	_, err := r.collection.InsertOne(ctx, p)
+	_, err = r.collection.InsertOne(ctx, doc)
 	if err != nil {
 		if mongo.IsDuplicateKeyError(err) {
 			return m.Patient{}, patientErrors.NewDuplicateRecordError("Patient", p.ID)
@@ -200,15 +284,19 @@ func (r *PatientMongoRepository) Update(ctx context.Context, id string, p m.Pati
 			zap.Duration("duration", time.Since(start)))
 	}()
 
-	filter := bson.M{"_id": patientID(id)}
-	update := bson.M{
-		"$set": bson.M{
-			"name":       p.Name,
-			"phone":      p.Phone,
-			"state":      p.State,
-			"dob":        p.DOB,
-			"updated_at": time.Now(),
-		},
+	set, err := r.codec.setFields(ctx, p)
+	if err != nil {
+		return m.Patient{}, fmt.Errorf("failed to update patient: %w", err)
+	}
+	set["state"] = p.State
+	set["contact_preferences"] = p.ContactPreferences
+	set["updated_at"] = time.Now()
+
+	// The organization is never changed by an update
+	filter := tenancy.Filter(ctx, bson.M{"_id": patientID(id)})
+	update := bson.M{"$set": set}
+	if unset := r.codec.unsetFields(); unset != nil {
+		update["$unset"] = unset
 	}
 
 	// Add edit tracking fields if they exist
@@ -252,15 +340,7 @@ func (r *PatientMongoRepository) Count(ctx context.Context, req request.PatientL
 			zap.Duration("duration", time.Since(start)))
 	}()
 
-	// Build filter with input sanitization to prevent regex injection
-	filter := bson.M{}
-	if req.PatientName != "" {
-		// Escape special regex characters to prevent regex injection
-		escapedQuery := escapeRegexChars(req.PatientName)
-		filter = bson.M{
-			"name": bson.M{"$regex": escapedQuery, "$options": "i"},
-		}
-	}
+	filter := listFilter(ctx, r.codec, req)
 
 	count, err := r.collection.CountDocuments(ctx, filter)
 	if err != nil {
@@ -273,6 +353,134 @@ func (r *PatientMongoRepository) Count(ctx context.Context, req request.PatientL
 	return int(count), nil
 }
 
+// CountByState groups the patients by state with one aggregation, served by the state_1 index.
+// It feeds the dashboard and is safe for secondary reads.
+func (r *PatientMongoRepository) CountByState(ctx context.Context) ([]m.StateCount, error) {
+	start := time.Now()
+	defer func() {
+		r.logger.Debug("MongoDB CountByState operation completed",
+			zap.Duration("duration", time.Since(start)))
+	}()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: tenancy.Filter(ctx, bson.M{})}},
+		{{Key: "$group", Value: bson.D{{Key: "_id", Value: "$state"}, {Key: "count", Value: bson.M{"$sum": 1}}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+	}
+	cursor, err := database.ForReads(r.collection, database.ReadAnalytics).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, r.handleError("CountByState", err)
+	}
+	defer cursor.Close(ctx)
+
+	counts := []m.StateCount{}
+	if err := cursor.All(ctx, &counts); err != nil {
+		return nil, r.handleError("CountByState", err)
+	}
+
+	r.logger.Debug("Successfully counted patients by state in MongoDB",
+		zap.Int("states", len(counts)))
+
+	return counts, nil
+}
+
+// Stream calls fn for every patient matching the query filters, ignoring paging, in creation
+// order. Documents are read through a cursor in batches so memory stays flat for large results.
+// A non-nil error from fn stops the iteration and is returned. Stream serves exports and is safe
+// for secondary reads.
+func (r *PatientMongoRepository) Stream(ctx context.Context, req request.PatientListQueryRequest, fn func(m.Patient) error) error {
+	start := time.Now()
+	count := 0
+	defer func() {
+		r.logger.Debug("MongoDB Stream operation completed",
+			zap.Int("count", count),
+			zap.Duration("duration", time.Since(start)))
+	}()
+
+	opts := options.Find().
+		SetBatchSize(streamBatchSize).
+		SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}})
+
+	cursor, err := database.ForReads(r.collection, database.ReadAnalytics).Find(ctx, listFilter(ctx, r.codec, req), opts)
+	if err != nil {
+		return r.handleError("Stream", err)
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		patient, err := r.codec.decode(ctx, cursor.Current)
+		if err != nil {
+			return r.handleError("Stream", err)
+		}
+		if err := fn(patient); err != nil {
+			return err
+		}
+		count++
+	}
+	if err := cursor.Err(); err != nil {
+		return r.handleError("Stream", err)
+	}
+	return nil
+}
+
+// ListRecentlyUpdated retrieves the patients updated most recently; patients never updated
+// follow, newest first
+func (r *PatientMongoRepository) ListRecentlyUpdated(ctx context.Context, limit int) ([]m.Patient, error) {
+	start := time.Now()
+	defer func() {
+		r.logger.Debug("MongoDB ListRecentlyUpdated operation completed",
+			zap.Int("limit", limit),
+			zap.Duration("duration", time.Since(start)))
+	}()
+
+	opts := options.Find().
+		SetLimit(int64(limit)).
+		SetSort(bson.D{{Key: "updated_at", Value: -1}, {Key: "created_at", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, tenancy.Filter(ctx, bson.M{}), opts)
+	if err != nil {
+		return nil, r.handleError("ListRecentlyUpdated", err)
+	}
+	defer cursor.Close(ctx)
+
+	patients, err := r.codec.decodeAll(ctx, cursor)
+	if err != nil {
+		return nil, r.handleError("ListRecentlyUpdated", err)
+	}
+	return patients, nil
+}
+
+// FindDuplicateCandidates looks patients up by the phone_normalized_1 and dob indexes, or by the
+// blind indexes when PHI is encrypted
+func (r *PatientMongoRepository) FindDuplicateCandidates(ctx context.Context, phone string, dob dates.PartialDate, limit int) ([]m.Patient, error) {
+	or := r.codec.duplicateFilter(phone, dob)
+	if len(or) == 0 {
+		return []m.Patient{}, nil
+	}
+
+	start := time.Now()
+	defer func() {
+		r.logger.Debug("MongoDB FindDuplicateCandidates operation completed",
+			zap.Duration("duration", time.Since(start)))
+	}()
+
+	opts := options.Find().
+		SetLimit(int64(limit)).
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, tenancy.Filter(ctx, bson.M{"$or": or}), opts)
+	if err != nil {
+		return nil, r.handleError("FindDuplicateCandidates", err)
+	}
+	defer cursor.Close(ctx)
+
+	patients, err := r.codec.decodeAll(ctx, cursor)
+	if err != nil {
+		return nil, r.handleError("FindDuplicateCandidates", err)
+	}
+	return patients, nil
+}
+
 // HealthCheck performs a health check on the repository
 func (r *PatientMongoRepository) HealthCheck(ctx context.Context) error {
 	// Try to count documents as a simple health check
@@ -286,11 +494,8 @@ func (r *PatientMongoRepository) HealthCheck(ctx context.Context) error {
 // CreateIndexes creates recommended indexes for optimal performance
 func (r *PatientMongoRepository) CreateIndexes(ctx context.Context) error {
 	indexes := []mongo.IndexModel{
-		{
-			Keys: bson.D{{Key: "name", Value: "text"}},
-			Options: options.Index().
-				SetName("name_text"),
-		},
+		// Shared with patient search: a collection can only have one text index
+		patientTextIndex(),
 		{
 			Keys: bson.D{{Key: "state", Value: 1}},
 			Options: options.Index().
@@ -301,12 +506,39 @@ func (r *PatientMongoRepository) CreateIndexes(ctx context.Context) error {
 			Options: options.Index().
 				SetName("created_at_-1"),
 		},
+		{
+			Keys: bson.D{{Key: "updated_at", Value: -1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().
+				SetName("updated_at_-1_created_at_-1"),
+		},
+		{
+			// List filters: state equality, newest first, then the DOB range of age filters
+			Keys: bson.D{{Key: "state", Value: 1}, {Key: "created_at", Value: -1}, {Key: "dob", Value: 1}},
+			Options: options.Index().
+				SetName("state_1_created_at_-1_dob_1"),
+		},
+		{
+			Keys: bson.D{{Key: "org_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().
+				SetName("org_id_1_created_at_-1"),
+		},
 		{
 			Keys: bson.D{{Key: "phone", Value: 1}},
 			Options: options.Index().
 				SetName("phone_1").
 				SetUnique(true),
 		},
+		{
+			// Duplicate checks before a patient is created; not unique, as they may only warn
+			Keys: bson.D{{Key: phoneNormalizedField, Value: 1}},
+			Options: options.Index().
+				SetName("phone_normalized_1"),
+		},
+		{
+			Keys: bson.D{{Key: "dob", Value: 1}},
+			Options: options.Index().
+				SetName("dob_1"),
+		},
 		{
 			Keys: bson.D{{Key: "_id", Value: 1}},
 			Options: options.Index().
@@ -314,6 +546,23 @@ func (r *PatientMongoRepository) CreateIndexes(ctx context.Context) error {
 				SetUnique(true),
 		},
 	}
+	if r.codec.enabled() {
+		// Encrypted phone numbers differ on every write, so uniqueness moves to the blind index
+		indexes = append(indexes,
+			mongo.IndexModel{
+				Keys:    bson.D{{Key: phoneIndexField, Value: 1}},
+				Options: options.Index().SetName("phone_idx_1").SetUnique(true).SetSparse(true),
+			},
+			mongo.IndexModel{
+				Keys:    bson.D{{Key: nameIndexField, Value: 1}},
+				Options: options.Index().SetName("name_idx_1"),
+			},
+			mongo.IndexModel{
+				Keys:    bson.D{{Key: dobIndexField, Value: 1}},
+				Options: options.Index().SetName("dob_idx_1"),
+			},
+		)
+	}
 
 	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
 	if err != nil {
@@ -343,7 +592,12 @@ func (r *PatientMongoRepository) BulkInsert(ctx context.Context, patients []m.Pa
 		if patient.CreatedAt.IsZero() {
 			patient.CreatedAt = time.Now()
 		}
-		docs[i] = patient
+		patient.OrgID = tenancy.Assign(ctx, patient.OrgID)
+		doc, err := r.codec.encode(ctx, patient)
+		if err != nil {
+			return r.handleError("BulkInsert", err)
+		}
+		docs[i] = doc
 	}
 
 	opts := options.InsertMany().SetOrdered(false)
@@ -358,7 +612,8 @@ func (r *PatientMongoRepository) BulkInsert(ctx context.Context, patients []m.Pa
 	return nil
 }
 
-// FindByState retrieves patients by state with pagination
+// FindByState retrieves patients by state with pagination, newest first. It does not sort by
+// name, which holds ciphertext when field encryption is on.
 func (r *PatientMongoRepository) FindByState(ctx context.Context, state string, limit, offset int) ([]m.Patient, error) {
 	start := time.Now()
 	defer func() {
@@ -378,11 +633,11 @@ func (r *PatientMongoRepository) FindByState(ctx context.Context, state string,
 		}
 	}
 
-	filter := bson.M{"state": patientState(state)}
+	filter := tenancy.Filter(ctx, bson.M{"state": patientState(state)})
 	opts := options.Find().
 		SetLimit(int64(limit)).
 		SetSkip(int64(offset)).
-		SetSort(bson.D{{Key: "name", Value: 1}})
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: 1}}) // _id keeps pages stable on ties
 
 	cursor, err := r.collection.Find(ctx, filter, opts)
 	if err != nil {
@@ -390,8 +645,8 @@ func (r *PatientMongoRepository) FindByState(ctx context.Context, state string,
 	}
 	defer cursor.Close(ctx)
 
-	var patients []m.Patient
-	if err := cursor.All(ctx, &patients); err != nil {
+	patients, err := r.codec.decodeAll(ctx, cursor)
+	if err != nil {
 		return nil, r.handleError("FindByState", err)
 	}
 
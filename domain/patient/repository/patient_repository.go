@@ -5,6 +5,7 @@ import (
 
 	m "pharmacy-modernization-project-model/domain/patient/contracts/model"
 	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+	"pharmacy-modernization-project-model/internal/platform/dates"
 )
 
 type PatientRepository interface {
@@ -13,4 +14,14 @@ type PatientRepository interface {
 	Create(ctx context.Context, p m.Patient) (m.Patient, error)
 	Update(ctx context.Context, id string, p m.Patient) (m.Patient, error)
 	Count(ctx context.Context, req request.PatientListQueryRequest) (int, error)
+	// CountByState returns the number of patients in each state, ordered by state
+	CountByState(ctx context.Context) ([]m.StateCount, error)
+	// Stream calls fn for every patient matching the filters of req, ignoring paging
+	Stream(ctx context.Context, req request.PatientListQueryRequest, fn func(m.Patient) error) error
+	// ListRecentlyUpdated returns the most recently updated patients, then the newest ones
+	ListRecentlyUpdated(ctx context.Context, limit int) ([]m.Patient, error)
+	// FindDuplicateCandidates returns up to limit patients, newest first, with the normalized
+	// phone number (see model.NormalizePhone) or exactly the DOB; an empty phone or zero DOB is
+	// not looked up
+	FindDuplicateCandidates(ctx context.Context, phone string, dob dates.PartialDate, limit int) ([]m.Patient, error)
 }
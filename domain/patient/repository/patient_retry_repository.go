@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+
+	m "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+	"pharmacy-modernization-project-model/internal/platform/database"
+	"pharmacy-modernization-project-model/internal/platform/dates"
+)
+
+// PatientRetryRepository retries the reads and idempotent writes of a patient repository that fail
+// with a retryable error; Create and Stream run once
+type PatientRetryRepository struct {
+	next    PatientRepository
+	retrier *database.Retrier
+}
+
+// NewPatientRetryRepository wraps next with retries; without a retrier next is returned as is
+func NewPatientRetryRepository(next PatientRepository, retrier *database.Retrier) PatientRepository {
+	if retrier == nil {
+		return next
+	}
+	return &PatientRetryRepository{next: next, retrier: retrier}
+}
+
+func (r *PatientRetryRepository) List(ctx context.Context, req request.PatientListQueryRequest) ([]m.Patient, error) {
+	return database.Retry(ctx, r.retrier, "patients.List", func(ctx context.Context) ([]m.Patient, error) {
+		return r.next.List(ctx, req)
+	})
+}
+
+func (r *PatientRetryRepository) GetByID(ctx context.Context, id string) (m.Patient, error) {
+	return database.Retry(ctx, r.retrier, "patients.GetByID", func(ctx context.Context) (m.Patient, error) {
+		return r.next.GetByID(ctx, id)
+	})
+}
+
+// Create runs once: a retried insert that reached the server would fail as a duplicate
+func (r *PatientRetryRepository) Create(ctx context.Context, p m.Patient) (m.Patient, error) {
+	return r.next.Create(ctx, p)
+}
+
+func (r *PatientRetryRepository) Update(ctx context.Context, id string, p m.Patient) (m.Patient, error) {
+	return database.Retry(ctx, r.retrier, "patients.Update", func(ctx context.Context) (m.Patient, error) {
+		return r.next.Update(ctx, id, p)
+	})
+}
+
+func (r *PatientRetryRepository) Count(ctx context.Context, req request.PatientListQueryRequest) (int, error) {
+	return database.Retry(ctx, r.retrier, "patients.Count", func(ctx context.Context) (int, error) {
+		return r.next.Count(ctx, req)
+	})
+}
+
+func (r *PatientRetryRepository) CountByState(ctx context.Context) ([]m.StateCount, error) {
+	return database.Retry(ctx, r.retrier, "patients.CountByState", func(ctx context.Context) ([]m.StateCount, error) {
+		return r.next.CountByState(ctx)
+	})
+}
+
+// Stream runs once: a retry would call fn again for the patients already streamed
+func (r *PatientRetryRepository) Stream(ctx context.Context, req request.PatientListQueryRequest, fn func(m.Patient) error) error {
+	return r.next.Stream(ctx, req, fn)
+}
+
+func (r *PatientRetryRepository) ListRecentlyUpdated(ctx context.Context, limit int) ([]m.Patient, error) {
+	return database.Retry(ctx, r.retrier, "patients.ListRecentlyUpdated", func(ctx context.Context) ([]m.Patient, error) {
+		return r.next.ListRecentlyUpdated(ctx, limit)
+	})
+}
+
+func (r *PatientRetryRepository) FindDuplicateCandidates(ctx context.Context, phone string, dob dates.PartialDate, limit int) ([]m.Patient, error) {
+	return database.Retry(ctx, r.retrier, "patients.FindDuplicateCandidates", func(ctx context.Context) ([]m.Patient, error) {
+		return r.next.FindDuplicateCandidates(ctx, phone, dob, limit)
+	})
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	m "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+)
+
+// patientSearchMemoryRepository scans the in-memory patient and address repositories,
+// scoring whole-word matches with the same weights as the MongoDB text indexes
+type patientSearchMemoryRepository struct {
+	patients  PatientRepository
+	addresses AddressRepository
+}
+
+func NewPatientSearchMemoryRepository(patients PatientRepository, addresses AddressRepository) PatientSearchRepository {
+	return &patientSearchMemoryRepository{patients: patients, addresses: addresses}
+}
+
+func (r *patientSearchMemoryRepository) TextSearch(ctx context.Context, terms []string, limit int) ([]m.PatientSearchCandidate, error) {
+	all, err := r.all(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wordScore := func(text string, weight float64) float64 {
+		score := 0.0
+		for _, word := range request.SearchTerms(text) {
+			for _, term := range terms {
+				if word == term {
+					score += weight
+				}
+			}
+		}
+		return score
+	}
+
+	var hits []m.PatientSearchCandidate
+	for _, c := range all {
+		score := wordScore(c.Patient.Name, searchWeightName) +
+			wordScore(c.Patient.Phone, searchWeightPhone) +
+			wordScore(c.Patient.State, searchWeightState)
+		best := 0.0
+		for _, a := range c.Addresses {
+			if s := wordScore(a.City, searchWeightCity) + wordScore(a.Zip, searchWeightZip); s > best {
+				best = s
+			}
+		}
+		if score += best; score > 0 {
+			c.Score = score
+			hits = append(hits, c)
+		}
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool { return hits[i].Score > hits[j].Score })
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits, nil
+}
+
+func (r *patientSearchMemoryRepository) PrefixCandidates(ctx context.Context, prefixes []string, limit int) ([]m.PatientSearchCandidate, error) {
+	all, err := r.all(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hasPrefix := func(texts ...string) bool {
+		for _, text := range texts {
+			for _, word := range request.SearchTerms(text) {
+				for _, prefix := range prefixes {
+					if strings.HasPrefix(word, prefix) {
+						return true
+					}
+				}
+			}
+		}
+		return false
+	}
+
+	var candidates []m.PatientSearchCandidate
+	for _, c := range all {
+		match := hasPrefix(c.Patient.Name, c.Patient.Phone, c.Patient.State)
+		for _, a := range c.Addresses {
+			match = match || hasPrefix(a.City, a.Zip)
+		}
+		if match {
+			candidates = append(candidates, c)
+			if len(candidates) == limit {
+				break
+			}
+		}
+	}
+	return candidates, nil
+}
+
+// all returns every patient, ordered by ID, with its addresses
+func (r *patientSearchMemoryRepository) all(ctx context.Context) ([]m.PatientSearchCandidate, error) {
+	total, err := r.patients.Count(ctx, request.PatientListQueryRequest{})
+	if err != nil {
+		return nil, err
+	}
+	patients, err := r.patients.List(ctx, request.PatientListQueryRequest{Limit: total})
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]m.PatientSearchCandidate, len(patients))
+	for i, p := range patients {
+		addresses, err := r.addresses.ListByPatientID(ctx, p.ID)
+		if err != nil {
+			return nil, err
+		}
+		candidates[i] = m.PatientSearchCandidate{Patient: p, Addresses: addresses}
+	}
+	return candidates, nil
+}
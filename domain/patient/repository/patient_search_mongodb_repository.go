@@ -0,0 +1,353 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	m "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/fieldcrypt"
+	"pharmacy-modernization-project-model/internal/platform/tenancy"
+)
+
+// Text index weights: a name hit outranks a zip hit, which outranks a city or state hit
+const (
+	searchWeightName  = 10
+	searchWeightPhone = 5
+	searchWeightZip   = 5
+	searchWeightCity  = 3
+	searchWeightState = 2
+)
+
+// patientTextIndex is the only text index on the patients collection (MongoDB allows one per collection)
+func patientTextIndex() mongo.IndexModel {
+	return mongo.IndexModel{
+		Keys: bson.D{{Key: "name", Value: "text"}, {Key: "phone", Value: "text"}, {Key: "state", Value: "text"}},
+		Options: options.Index().
+			SetName("patient_search_text").
+			SetWeights(bson.D{
+				{Key: "name", Value: searchWeightName},
+				{Key: "phone", Value: searchWeightPhone},
+				{Key: "state", Value: searchWeightState},
+			}).
+			SetDefaultLanguage("none"),
+	}
+}
+
+// addressTextIndex is the only text index on the addresses collection
+func addressTextIndex() mongo.IndexModel {
+	return mongo.IndexModel{
+		Keys: bson.D{{Key: "city", Value: "text"}, {Key: "zip", Value: "text"}},
+		Options: options.Index().
+			SetName("address_search_text").
+			SetWeights(bson.D{
+				{Key: "city", Value: searchWeightCity},
+				{Key: "zip", Value: searchWeightZip},
+			}).
+			SetDefaultLanguage("none"),
+	}
+}
+
+// PatientSearchMongoRepository implements PatientSearchRepository using MongoDB text indexes
+// on the patients and addresses collections. When patient PHI is encrypted, names and phone
+// numbers are matched through their blind indexes instead.
+type PatientSearchMongoRepository struct {
+	patients  *mongo.Collection
+	addresses *mongo.Collection
+	codec     patientCodec
+	logger    *zap.Logger
+}
+
+// NewPatientSearchMongoRepository creates a new MongoDB patient search repository; cipher must
+// match the one of the patient repository (nil when PHI is stored in plaintext)
+func NewPatientSearchMongoRepository(patients, addresses *mongo.Collection, cipher *fieldcrypt.Cipher, logger *zap.Logger) *PatientSearchMongoRepository {
+	return &PatientSearchMongoRepository{
+		patients:  patients,
+		addresses: addresses,
+		codec:     patientCodec{cipher: cipher},
+		logger:    logger,
+	}
+}
+
+// handleError processes MongoDB errors and converts them to appropriate repository errors
+func (r *PatientSearchMongoRepository) handleError(operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	r.logger.Error("MongoDB operation failed",
+		zap.String("operation", operation),
+		zap.Error(err))
+
+	return platformErrors.HandleMongoError(operation, err)
+}
+
+type scoredAddress struct {
+	m.Address `bson:",inline"`
+	Score     float64 `bson:"score"`
+}
+
+// TextSearch runs $text on both collections and sums the scores per patient
+func (r *PatientSearchMongoRepository) TextSearch(ctx context.Context, terms []string, limit int) ([]m.PatientSearchCandidate, error) {
+	start := time.Now()
+	defer func() {
+		r.logger.Debug("MongoDB TextSearch operation completed",
+			zap.Int("terms", len(terms)),
+			zap.Duration("duration", time.Since(start)))
+	}()
+
+	if len(terms) == 0 {
+		return []m.PatientSearchCandidate{}, nil
+	}
+
+	// Addresses carry their patient's organization, so the filter scopes both collections
+	filter := tenancy.Filter(ctx, bson.M{"$text": bson.M{"$search": strings.Join(terms, " ")}})
+	opts := options.Find().
+		SetProjection(bson.M{"score": bson.M{"$meta": "textScore"}}).
+		SetSort(bson.D{{Key: "score", Value: bson.M{"$meta": "textScore"}}}).
+		SetLimit(int64(limit))
+
+	patientCursor, err := r.patients.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, r.handleError("TextSearch", err)
+	}
+	defer patientCursor.Close(ctx)
+	scores := map[string]float64{}
+	patients := map[string]m.Patient{}
+	for patientCursor.Next(ctx) {
+		p, err := r.codec.decode(ctx, patientCursor.Current)
+		if err != nil {
+			return nil, r.handleError("TextSearch", err)
+		}
+		score, _ := patientCursor.Current.Lookup("score").DoubleOK()
+		scores[p.ID] += score
+		patients[p.ID] = p
+	}
+	if err := patientCursor.Err(); err != nil {
+		return nil, r.handleError("TextSearch", err)
+	}
+	if r.codec.enabled() {
+		// The text index only sees ciphertext for names and phone numbers
+		if err := r.blindIndexHits(ctx, terms, limit, scores, patients); err != nil {
+			return nil, r.handleError("TextSearch", err)
+		}
+	}
+
+	// Several addresses can belong to one patient, so fetch more than limit
+	addressCursor, err := r.addresses.Find(ctx, filter, opts.SetLimit(int64(limit*3)))
+	if err != nil {
+		return nil, r.handleError("TextSearch", err)
+	}
+	var addressHits []scoredAddress
+	if err := addressCursor.All(ctx, &addressHits); err != nil {
+		return nil, r.handleError("TextSearch", err)
+	}
+
+	// A patient's best-matching address counts once
+	addressScores := map[string]float64{}
+	for _, hit := range addressHits {
+		if hit.Score > addressScores[hit.PatientID] {
+			addressScores[hit.PatientID] = hit.Score
+		}
+	}
+	var missing []string
+	for patientID, score := range addressScores {
+		scores[patientID] += score
+		if _, ok := patients[patientID]; !ok {
+			missing = append(missing, patientID)
+		}
+	}
+
+	if len(missing) > 0 {
+		cursor, err := r.patients.Find(ctx, tenancy.Filter(ctx, bson.M{"_id": bson.M{"$in": missing}}))
+		if err != nil {
+			return nil, r.handleError("TextSearch", err)
+		}
+		defer cursor.Close(ctx)
+		found, err := r.codec.decodeAll(ctx, cursor)
+		if err != nil {
+			return nil, r.handleError("TextSearch", err)
+		}
+		for _, p := range found {
+			patients[p.ID] = p
+		}
+	}
+
+	candidates := make([]m.PatientSearchCandidate, 0, len(patients))
+	for id, p := range patients {
+		candidates = append(candidates, m.PatientSearchCandidate{Patient: p, Score: scores[id]})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].Patient.ID < candidates[j].Patient.ID
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	return r.withAddresses(ctx, "TextSearch", candidates)
+}
+
+// PrefixCandidates matches case-insensitive word prefixes; the result is capped at limit
+// since every candidate is scored in memory
+func (r *PatientSearchMongoRepository) PrefixCandidates(ctx context.Context, prefixes []string, limit int) ([]m.PatientSearchCandidate, error) {
+	start := time.Now()
+	defer func() {
+		r.logger.Debug("MongoDB PrefixCandidates operation completed",
+			zap.Int("prefixes", len(prefixes)),
+			zap.Duration("duration", time.Since(start)))
+	}()
+
+	if len(prefixes) == 0 {
+		return []m.PatientSearchCandidate{}, nil
+	}
+
+	var patientOr, addressOr bson.A
+	for _, prefix := range prefixes {
+		escaped := escapeRegexChars(prefix)
+		patientOr = append(patientOr,
+			r.codec.namePrefixFilter(prefix),
+			r.codec.phoneFilter(prefix),
+			bson.M{"state": bson.M{"$regex": "^" + escaped, "$options": "i"}},
+		)
+		addressOr = append(addressOr,
+			bson.M{"city": bson.M{"$regex": `(^|\s)` + escaped, "$options": "i"}},
+			bson.M{"zip": bson.M{"$regex": "^" + escaped}},
+		)
+	}
+
+	cursor, err := r.patients.Find(ctx, tenancy.Filter(ctx, bson.M{"$or": patientOr}), options.Find().SetLimit(int64(limit)))
+	if err != nil {
+		return nil, r.handleError("PrefixCandidates", err)
+	}
+	defer cursor.Close(ctx)
+	found, err := r.codec.decodeAll(ctx, cursor)
+	if err != nil {
+		return nil, r.handleError("PrefixCandidates", err)
+	}
+
+	seen := make(map[string]bool, len(found))
+	for _, p := range found {
+		seen[p.ID] = true
+	}
+
+	if len(found) < limit {
+		patientIDs, err := r.addresses.Distinct(ctx, "patient_id", tenancy.Filter(ctx, bson.M{"$or": addressOr}))
+		if err != nil {
+			return nil, r.handleError("PrefixCandidates", err)
+		}
+		var extra []string
+		for _, raw := range patientIDs {
+			if id, ok := raw.(string); ok && !seen[id] && len(found)+len(extra) < limit {
+				extra = append(extra, id)
+			}
+		}
+		if len(extra) > 0 {
+			cursor, err := r.patients.Find(ctx, tenancy.Filter(ctx, bson.M{"_id": bson.M{"$in": extra}}))
+			if err != nil {
+				return nil, r.handleError("PrefixCandidates", err)
+			}
+			defer cursor.Close(ctx)
+			more, err := r.codec.decodeAll(ctx, cursor)
+			if err != nil {
+				return nil, r.handleError("PrefixCandidates", err)
+			}
+			found = append(found, more...)
+		}
+	}
+
+	candidates := make([]m.PatientSearchCandidate, len(found))
+	for i, p := range found {
+		candidates[i] = m.PatientSearchCandidate{Patient: p}
+	}
+	return r.withAddresses(ctx, "PrefixCandidates", candidates)
+}
+
+// blindIndexHits adds patients whose encrypted name has a word equal to a term, or whose phone
+// number equals a term, scored with the text index weights
+func (r *PatientSearchMongoRepository) blindIndexHits(ctx context.Context, terms []string, limit int, scores map[string]float64, patients map[string]m.Patient) error {
+	var or bson.A
+	for _, term := range terms {
+		or = append(or, r.codec.nameFilter(term))
+		if m.NormalizePhone(term) != "" {
+			or = append(or, r.codec.phoneFilter(term))
+		}
+	}
+
+	cursor, err := r.patients.Find(ctx, tenancy.Filter(ctx, bson.M{"$or": or}), options.Find().SetLimit(int64(limit)))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	found, err := r.codec.decodeAll(ctx, cursor)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range found {
+		words := nameWords(p.Name)
+		for _, term := range terms {
+			if slices.Contains(words, strings.ToLower(term)) {
+				scores[p.ID] += searchWeightName
+			}
+			if digits := m.NormalizePhone(term); digits != "" && digits == m.NormalizePhone(p.Phone) {
+				scores[p.ID] += searchWeightPhone
+			}
+		}
+		patients[p.ID] = p
+	}
+	return nil
+}
+
+// withAddresses loads the addresses of all candidates in one query
+func (r *PatientSearchMongoRepository) withAddresses(ctx context.Context, operation string, candidates []m.PatientSearchCandidate) ([]m.PatientSearchCandidate, error) {
+	if len(candidates) == 0 {
+		return candidates, nil
+	}
+
+	ids := make([]string, len(candidates))
+	for i, c := range candidates {
+		ids[i] = c.Patient.ID
+	}
+	cursor, err := r.addresses.Find(ctx, tenancy.Filter(ctx, bson.M{"patient_id": bson.M{"$in": ids}}),
+		options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, r.handleError(operation, err)
+	}
+	var addresses []m.Address
+	if err := cursor.All(ctx, &addresses); err != nil {
+		return nil, r.handleError(operation, err)
+	}
+
+	byPatient := map[string][]m.Address{}
+	for _, a := range addresses {
+		byPatient[a.PatientID] = append(byPatient[a.PatientID], a)
+	}
+	for i := range candidates {
+		candidates[i].Addresses = byPatient[candidates[i].Patient.ID]
+	}
+	return candidates, nil
+}
+
+// CreateIndexes creates the text indexes used by TextSearch
+func (r *PatientSearchMongoRepository) CreateIndexes(ctx context.Context) error {
+	if _, err := r.patients.Indexes().CreateOne(ctx, patientTextIndex()); err != nil {
+		return r.handleError("CreateIndexes", err)
+	}
+	if _, err := r.addresses.Indexes().CreateOne(ctx, addressTextIndex()); err != nil {
+		return r.handleError("CreateIndexes", err)
+	}
+
+	r.logger.Info("Successfully created MongoDB text indexes for patient search")
+	return nil
+}
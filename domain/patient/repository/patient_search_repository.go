@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	m "pharmacy-modernization-project-model/domain/patient/contracts/model"
+)
+
+// PatientSearchRepository finds patients by name, phone, state and address city/zip
+type PatientSearchRepository interface {
+	// TextSearch returns full-text matches ordered by relevance, with addresses loaded
+	TextSearch(ctx context.Context, terms []string, limit int) ([]m.PatientSearchCandidate, error)
+	// PrefixCandidates returns patients with a name word, phone, state, city or zip starting
+	// with any of the prefixes; used as the candidate set for typo-tolerant matching
+	PrefixCandidates(ctx context.Context, prefixes []string, limit int) ([]m.PatientSearchCandidate, error)
+}
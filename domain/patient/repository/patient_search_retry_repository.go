@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+
+	m "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// PatientSearchRetryRepository retries the reads and idempotent writes of a patient search
+// repository that fail with a retryable error
+type PatientSearchRetryRepository struct {
+	next    PatientSearchRepository
+	retrier *database.Retrier
+}
+
+// NewPatientSearchRetryRepository wraps next with retries; without a retrier next is returned as is
+func NewPatientSearchRetryRepository(next PatientSearchRepository, retrier *database.Retrier) PatientSearchRepository {
+	if retrier == nil {
+		return next
+	}
+	return &PatientSearchRetryRepository{next: next, retrier: retrier}
+}
+
+func (r *PatientSearchRetryRepository) TextSearch(ctx context.Context, terms []string, limit int) ([]m.PatientSearchCandidate, error) {
+	return database.Retry(ctx, r.retrier, "patient_search.TextSearch", func(ctx context.Context) ([]m.PatientSearchCandidate, error) {
+		return r.next.TextSearch(ctx, terms, limit)
+	})
+}
+
+func (r *PatientSearchRetryRepository) PrefixCandidates(ctx context.Context, prefixes []string, limit int) ([]m.PatientSearchCandidate, error) {
+	return database.Retry(ctx, r.retrier, "patient_search.PrefixCandidates", func(ctx context.Context) ([]m.PatientSearchCandidate, error) {
+		return r.next.PrefixCandidates(ctx, prefixes, limit)
+	})
+}
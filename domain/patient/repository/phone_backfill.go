@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	m "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/fieldcrypt"
+)
+
+// PhoneBackfillResult counts the patient documents visited by BackfillNormalizedPhones
+type PhoneBackfillResult struct {
+	Scanned   int
+	Filled    int // Given the normalized phone number
+	Encrypted int // Skipped: encrypted phone numbers are looked up by their blind index
+	Changed   int // Modified by someone else while backfilling; the new write already stored it
+}
+
+// BackfillNormalizedPhones gives every patient stored before duplicate checks existed the
+// normalized phone number they look up. With dryRun documents are only counted.
+func BackfillNormalizedPhones(ctx context.Context, collection *mongo.Collection, batchSize int, dryRun bool) (PhoneBackfillResult, error) {
+	result := PhoneBackfillResult{}
+
+	cursor, err := collection.Find(ctx, bson.M{phoneNormalizedField: bson.M{"$exists": false}}, options.Find().
+		SetBatchSize(int32(batchSize)).
+		SetProjection(bson.M{"_id": 1, "phone": 1}).
+		SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return result, err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		result.Scanned++
+		var doc struct {
+			ID    string `bson:"_id"`
+			Phone string `bson:"phone"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return result, fmt.Errorf("patient %v: %w", cursor.Current.Lookup("_id"), err)
+		}
+		if fieldcrypt.IsEncrypted(doc.Phone) {
+			result.Encrypted++
+			continue
+		}
+		if dryRun {
+			result.Filled++
+			continue
+		}
+
+		// Only fill documents whose phone number is still the one that was normalized
+		filter := bson.M{"_id": doc.ID, "phone": doc.Phone, phoneNormalizedField: bson.M{"$exists": false}}
+		updated, err := collection.UpdateOne(ctx, filter, bson.M{"$set": bson.M{phoneNormalizedField: m.NormalizePhone(doc.Phone)}})
+		if err != nil {
+			return result, fmt.Errorf("patient %s: %w", doc.ID, err)
+		}
+		if updated.MatchedCount == 0 {
+			result.Changed++
+			continue
+		}
+		result.Filled++
+	}
+	return result, cursor.Err()
+}
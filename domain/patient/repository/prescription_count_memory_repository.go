@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"slices"
+
+	m "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+	"pharmacy-modernization-project-model/domain/patient/providers"
+)
+
+// prescriptionCountMemoryRepository counts through the prescription provider, one call per patient;
+// used when MongoDB is not configured
+type prescriptionCountMemoryRepository struct {
+	provider providers.PatientPrescriptionProvider
+	patients PatientRepository // Whose prescriptions PatientIDsWithStatus reads
+}
+
+func NewPrescriptionCountMemoryRepository(provider providers.PatientPrescriptionProvider, patients PatientRepository) PrescriptionCountRepository {
+	return &prescriptionCountMemoryRepository{provider: provider, patients: patients}
+}
+
+func (r *prescriptionCountMemoryRepository) CountByStatus(ctx context.Context, patientIDs []string) (map[string]m.PrescriptionCounts, error) {
+	counts := map[string]m.PrescriptionCounts{}
+	if r.provider == nil {
+		return counts, nil
+	}
+	for _, patientID := range patientIDs {
+		prescriptions, err := r.provider.PatientPrescriptionListByPatientID(ctx, patientID)
+		if err != nil {
+			return nil, err
+		}
+		for _, prescription := range prescriptions {
+			if counts[patientID] == nil {
+				counts[patientID] = m.PrescriptionCounts{}
+			}
+			counts[patientID][prescription.Status]++
+		}
+	}
+	return counts, nil
+}
+
+// PatientIDsWithStatus reads the prescriptions of every patient
+func (r *prescriptionCountMemoryRepository) PatientIDsWithStatus(ctx context.Context, statuses ...string) ([]string, error) {
+	ids := []string{}
+	if r.provider == nil {
+		return ids, nil
+	}
+	err := r.patients.Stream(ctx, request.PatientListQueryRequest{}, func(patient m.Patient) error {
+		prescriptions, err := r.provider.PatientPrescriptionListByPatientID(ctx, patient.ID)
+		if err != nil {
+			return err
+		}
+		for _, prescription := range prescriptions {
+			if slices.Contains(statuses, prescription.Status) {
+				ids = append(ids, patient.ID)
+				break
+			}
+		}
+		return nil
+	})
+	return ids, err
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	m "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/tenancy"
+)
+
+// PrescriptionCountMongoRepository counts prescriptions with one aggregation over the patients of
+// a page, joining the prescriptions collection with $lookup (served by its patient_id_1_status_1 index)
+type PrescriptionCountMongoRepository struct {
+	patients      *mongo.Collection
+	prescriptions string
+	logger        *zap.Logger
+}
+
+// NewPrescriptionCountMongoRepository creates the repository; prescriptions is the name of the
+// prescriptions collection in the patients collection's database
+func NewPrescriptionCountMongoRepository(patients *mongo.Collection, prescriptions string, logger *zap.Logger) PrescriptionCountRepository {
+	return &PrescriptionCountMongoRepository{patients: patients, prescriptions: prescriptions, logger: logger}
+}
+
+// CountByStatus counts the prescriptions of each patient by status; the counts are badges on the
+// patient list and are safe for secondary reads
+func (r *PrescriptionCountMongoRepository) CountByStatus(ctx context.Context, patientIDs []string) (map[string]m.PrescriptionCounts, error) {
+	counts := map[string]m.PrescriptionCounts{}
+	if len(patientIDs) == 0 {
+		return counts, nil
+	}
+	start := time.Now()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: tenancy.Filter(ctx, bson.M{"_id": bson.M{"$in": patientIDs}})}},
+		{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: r.prescriptions},
+			{Key: "let", Value: bson.D{{Key: "patient_id", Value: "$_id"}}},
+			{Key: "pipeline", Value: mongo.Pipeline{
+				{{Key: "$match", Value: tenancy.Filter(ctx, bson.M{"$expr": bson.M{"$eq": bson.A{"$patient_id", "$$patient_id"}}})}},
+				{{Key: "$group", Value: bson.D{{Key: "_id", Value: "$status"}, {Key: "count", Value: bson.M{"$sum": 1}}}}},
+			}},
+			{Key: "as", Value: "prescription_counts"},
+		}}},
+		{{Key: "$project", Value: bson.D{{Key: "prescription_counts", Value: 1}}}},
+	}
+	cursor, err := database.ForReads(r.patients, database.ReadAnalytics).Aggregate(ctx, pipeline)
+	if err != nil {
+		r.logger.Error("MongoDB operation failed", zap.String("operation", "CountByStatus"), zap.Error(err))
+		return nil, platformErrors.HandleMongoError("CountByStatus", err)
+	}
+	defer cursor.Close(ctx)
+
+	var rows []struct {
+		ID                 string `bson:"_id"`
+		PrescriptionCounts []struct {
+			Status string `bson:"_id"`
+			Count  int    `bson:"count"`
+		} `bson:"prescription_counts"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, platformErrors.HandleMongoError("CountByStatus", err)
+	}
+	for _, row := range rows {
+		if len(row.PrescriptionCounts) == 0 {
+			continue
+		}
+		counts[row.ID] = m.PrescriptionCounts{}
+		for _, status := range row.PrescriptionCounts {
+			counts[row.ID][status.Status] = status.Count
+		}
+	}
+
+	r.logger.Debug("MongoDB CountByStatus operation completed",
+		zap.Int("patients", len(patientIDs)),
+		zap.Duration("duration", time.Since(start)))
+	return counts, nil
+}
+
+// PatientIDsWithStatus reads the distinct patients of the matching prescriptions, served by the
+// prescriptions collection's status_1_patient_id_1 index
+func (r *PrescriptionCountMongoRepository) PatientIDsWithStatus(ctx context.Context, statuses ...string) ([]string, error) {
+	start := time.Now()
+
+	filter := tenancy.Filter(ctx, bson.M{"status": bson.M{"$in": statuses}})
+	values, err := r.patients.Database().Collection(r.prescriptions).Distinct(ctx, "patient_id", filter)
+	if err != nil {
+		r.logger.Error("MongoDB operation failed", zap.String("operation", "PatientIDsWithStatus"), zap.Error(err))
+		return nil, platformErrors.HandleMongoError("PatientIDsWithStatus", err)
+	}
+	ids := make([]string, 0, len(values))
+	for _, value := range values {
+		if id, ok := value.(string); ok {
+			ids = append(ids, id)
+		}
+	}
+
+	r.logger.Debug("MongoDB PatientIDsWithStatus operation completed",
+		zap.Int("patients", len(ids)),
+		zap.Duration("duration", time.Since(start)))
+	return ids, nil
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	m "pharmacy-modernization-project-model/domain/patient/contracts/model"
+)
+
+// PrescriptionCountRepository counts the prescriptions of a page of patients and finds the
+// patients with prescriptions of a status
+type PrescriptionCountRepository interface {
+	// CountByStatus returns the prescription counts of each patient that has prescriptions
+	CountByStatus(ctx context.Context, patientIDs []string) (map[string]m.PrescriptionCounts, error)
+	// PatientIDsWithStatus returns the patients that have a prescription in one of statuses
+	PatientIDsWithStatus(ctx context.Context, statuses ...string) ([]string, error)
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+
+	m "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// PrescriptionCountRetryRepository retries the reads and idempotent writes of a prescription count
+// repository that fail with a retryable error
+type PrescriptionCountRetryRepository struct {
+	next    PrescriptionCountRepository
+	retrier *database.Retrier
+}
+
+// NewPrescriptionCountRetryRepository wraps next with retries; without a retrier next is returned as is
+func NewPrescriptionCountRetryRepository(next PrescriptionCountRepository, retrier *database.Retrier) PrescriptionCountRepository {
+	if retrier == nil {
+		return next
+	}
+	return &PrescriptionCountRetryRepository{next: next, retrier: retrier}
+}
+
+func (r *PrescriptionCountRetryRepository) CountByStatus(ctx context.Context, patientIDs []string) (map[string]m.PrescriptionCounts, error) {
+	return database.Retry(ctx, r.retrier, "prescription_counts.CountByStatus", func(ctx context.Context) (map[string]m.PrescriptionCounts, error) {
+		return r.next.CountByStatus(ctx, patientIDs)
+	})
+}
+
+func (r *PrescriptionCountRetryRepository) PatientIDsWithStatus(ctx context.Context, statuses ...string) ([]string, error) {
+	return database.Retry(ctx, r.retrier, "prescription_counts.PatientIDsWithStatus", func(ctx context.Context) ([]string, error) {
+		return r.next.PatientIDsWithStatus(ctx, statuses...)
+	})
+}
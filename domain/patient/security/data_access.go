@@ -0,0 +1,27 @@
+package security
+
+import (
+	"slices"
+	"strings"
+
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/permissions"
+)
+
+// stateRolePrefix marks data access roles that scope a user to patients of one state, e.g. "state:WA"
+const stateRolePrefix = "state:"
+
+// AllowedStates returns the states whose patients the user may see, or nil when the user is
+// not restricted (no state roles, or admin:all)
+func AllowedStates(user *auth.User) []string {
+	if user == nil || slices.Contains(user.Permissions, permissions.AdminAll) {
+		return nil
+	}
+	var states []string
+	for _, role := range user.DataAccessRoles {
+		if state, ok := strings.CutPrefix(role, stateRolePrefix); ok && state != "" {
+			states = append(states, strings.ToUpper(state))
+		}
+	}
+	return states
+}
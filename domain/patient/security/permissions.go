@@ -2,15 +2,16 @@ package security
 
 import (
 	commonsecurity "pharmacy-modernization-project-model/domain/common/security"
+	"pharmacy-modernization-project-model/internal/platform/permissions"
 )
 
 // Patient domain permissions
 const (
 	// Resource-based permissions
 	PermissionRead   = commonsecurity.PatientPermissionRead
-	PermissionWrite  = "patient:write"
-	PermissionDelete = "patient:delete"
-	PermissionExport = "patient:export"
+	PermissionWrite  = permissions.PatientWrite
+	PermissionDelete = permissions.PatientDelete
+	PermissionExport = permissions.PatientExport
 )
 
 // Common permission sets for reuse in routes
@@ -19,7 +20,7 @@ var (
 	ReadAccess = commonsecurity.PatientReadAccess
 
 	// WriteAccess - user needs ANY of these permissions to create/update patients
-	WriteAccess = []string{PermissionWrite, "admin:all"}
+	WriteAccess = []string{PermissionWrite, permissions.AdminAll}
 
 	// ExportAccess - user needs ALL of these permissions to export patient data
 	ExportAccess = []string{commonsecurity.PatientPermissionRead, PermissionExport}
@@ -6,10 +6,14 @@ import (
 	"strings"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	addressModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
 	addressRequest "pharmacy-modernization-project-model/domain/patient/contracts/request"
+	patientErrors "pharmacy-modernization-project-model/domain/patient/errors"
 	addressrepo "pharmacy-modernization-project-model/domain/patient/repository"
+	"pharmacy-modernization-project-model/internal/platform/cache"
+	"pharmacy-modernization-project-model/internal/platform/events"
 )
 
 var ErrInvalidAddress = errors.New("missing required address fields")
@@ -18,15 +22,23 @@ type AddressService interface {
 	GetByPatientID(ctx context.Context, patientID string) ([]addressModel.Address, error)
 	GetByID(ctx context.Context, patientID, addressID string) (addressModel.Address, error)
 	Create(ctx context.Context, patientID string, req addressRequest.AddressCreateRequest) (addressModel.Address, error)
+	Update(ctx context.Context, patientID, addressID string, req addressRequest.AddressUpdateRequest) (addressModel.Address, error)
+	Delete(ctx context.Context, patientID, addressID string) error
 	Upsert(ctx context.Context, patientID string, address addressModel.Address) (addressModel.Address, error)
 }
 
 type addressSvc struct {
-	repo addressrepo.AddressRepository
+	repo      addressrepo.AddressRepository
+	cache     cache.Cache
+	cacheKeys *CacheKeys
+	publisher events.Publisher
+	log       *zap.Logger
 }
 
-func NewAddressService(r addressrepo.AddressRepository) AddressService {
-	return &addressSvc{repo: r}
+// NewAddressService creates the address service; writes evict the patient's cached address
+// entries from c, which may be nil, and are published as events
+func NewAddressService(r addressrepo.AddressRepository, c cache.Cache, publisher events.Publisher, l *zap.Logger) AddressService {
+	return &addressSvc{repo: r, cache: c, cacheKeys: NewCacheKeys(), publisher: publisher, log: l}
 }
 
 func (s *addressSvc) GetByPatientID(ctx context.Context, patientID string) ([]addressModel.Address, error) {
@@ -52,9 +64,67 @@ func (s *addressSvc) Create(ctx context.Context, patientID string, req addressRe
 		Zip:       req.Zip,
 	}
 
-	return s.repo.Upsert(ctx, patientID, address)
+	return s.Upsert(ctx, patientID, address)
+}
+
+func (s *addressSvc) Update(ctx context.Context, patientID, addressID string, req addressRequest.AddressUpdateRequest) (addressModel.Address, error) {
+	existing, err := s.repo.GetByID(ctx, patientID, addressID)
+	if err != nil {
+		return addressModel.Address{}, err
+	}
+	if existing.ID == "" {
+		return addressModel.Address{}, patientErrors.NewRecordNotFoundError("address", addressID)
+	}
+
+	for _, field := range []struct {
+		value  *string
+		target *string
+	}{
+		{req.Line1, &existing.Line1},
+		{req.Line2, &existing.Line2},
+		{req.City, &existing.City},
+		{req.State, &existing.State},
+		{req.Zip, &existing.Zip},
+	} {
+		if field.value != nil {
+			*field.target = *field.value
+		}
+	}
+	if strings.TrimSpace(existing.Line1) == "" || strings.TrimSpace(existing.City) == "" || strings.TrimSpace(existing.State) == "" || strings.TrimSpace(existing.Zip) == "" {
+		return addressModel.Address{}, ErrInvalidAddress
+	}
+
+	return s.Upsert(ctx, patientID, existing)
+}
+
+func (s *addressSvc) Delete(ctx context.Context, patientID, addressID string) error {
+	if err := s.repo.Delete(ctx, patientID, addressID); err != nil {
+		return err
+	}
+	s.evict(ctx, patientID, addressID)
+	return nil
 }
 
 func (s *addressSvc) Upsert(ctx context.Context, patientID string, address addressModel.Address) (addressModel.Address, error) {
-	return s.repo.Upsert(ctx, patientID, address)
+	saved, err := s.repo.Upsert(ctx, patientID, address)
+	if err != nil {
+		return addressModel.Address{}, err
+	}
+	s.evict(ctx, patientID, saved.ID)
+	s.publisher.Publish(ctx, events.AddressUpserted{AddressID: saved.ID, PatientID: patientID})
+	return saved, nil
+}
+
+// evict removes the cached address and address list of the patient after a write
+func (s *addressSvc) evict(ctx context.Context, patientID, addressID string) {
+	if s.cache == nil {
+		return
+	}
+	for _, key := range []string{s.cacheKeys.AddressByID(patientID, addressID), s.cacheKeys.AddressesByPatientID(patientID)} {
+		if err := s.cache.Delete(ctx, key); err != nil {
+			s.log.Warn("Failed to invalidate address cache",
+				zap.String("patient_id", patientID),
+				zap.Error(err))
+		}
+	}
 }
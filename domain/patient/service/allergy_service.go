@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	commonmodel "pharmacy-modernization-project-model/domain/common/model"
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	patientRequest "pharmacy-modernization-project-model/domain/patient/contracts/request"
+	patientErrors "pharmacy-modernization-project-model/domain/patient/errors"
+	patientrepo "pharmacy-modernization-project-model/domain/patient/repository"
+)
+
+type AllergyService interface {
+	List(ctx context.Context, patientID string) ([]patientModel.Allergy, error)
+	GetByID(ctx context.Context, patientID, allergyID string) (patientModel.Allergy, error)
+	Create(ctx context.Context, patientID, recordedBy string, req patientRequest.AllergyCreateRequest) (patientModel.Allergy, error)
+	Update(ctx context.Context, patientID, allergyID, recordedBy string, req patientRequest.AllergyUpdateRequest) (patientModel.Allergy, error)
+	Delete(ctx context.Context, patientID, allergyID string) error
+	// PatientAllergyListByPatientID returns the patient's allergies for the prescription domain's allergy check
+	PatientAllergyListByPatientID(ctx context.Context, patientID string) ([]commonmodel.PatientAllergy, error)
+}
+
+// PatientReader reads a patient as the caller may see it; PatientService and PatientRepository
+// both answer a patient of another organization as not found
+type PatientReader interface {
+	GetByID(ctx context.Context, id string) (patientModel.Patient, error)
+}
+
+type allergySvc struct {
+	repo     patientrepo.AllergyRepository
+	patients PatientReader
+	log      *zap.Logger
+}
+
+func NewAllergyService(r patientrepo.AllergyRepository, patients PatientReader, l *zap.Logger) AllergyService {
+	return &allergySvc{repo: r, patients: patients, log: l}
+}
+
+func (s *allergySvc) List(ctx context.Context, patientID string) ([]patientModel.Allergy, error) {
+	if err := s.requirePatient(ctx, patientID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByPatientID(ctx, patientID)
+}
+
+func (s *allergySvc) GetByID(ctx context.Context, patientID, allergyID string) (patientModel.Allergy, error) {
+	if err := s.requirePatient(ctx, patientID); err != nil {
+		return patientModel.Allergy{}, err
+	}
+	return s.repo.GetByID(ctx, patientID, allergyID)
+}
+
+func (s *allergySvc) Create(ctx context.Context, patientID, recordedBy string, req patientRequest.AllergyCreateRequest) (patientModel.Allergy, error) {
+	if err := s.requirePatient(ctx, patientID); err != nil {
+		return patientModel.Allergy{}, err
+	}
+	substance := strings.TrimSpace(req.Substance)
+	existing, err := s.repo.ListByPatientID(ctx, patientID)
+	if err != nil {
+		return patientModel.Allergy{}, err
+	}
+	for _, a := range existing {
+		if strings.EqualFold(a.Substance, substance) {
+			return patientModel.Allergy{}, patientErrors.NewConflictError("allergy", a.ID, "an allergy to "+a.Substance+" is already recorded")
+		}
+	}
+
+	allergy := patientModel.Allergy{
+		ID:         uuid.NewString(),
+		PatientID:  patientID,
+		Substance:  substance,
+		Reaction:   strings.TrimSpace(req.Reaction),
+		Severity:   patientModel.AllergySeverity(req.Severity),
+		RecordedAt: time.Now(),
+		RecordedBy: recordedBy,
+	}
+
+	created, err := s.repo.Create(ctx, allergy)
+	if err != nil {
+		s.log.Error("Failed to record allergy", zap.Error(err))
+		return patientModel.Allergy{}, err
+	}
+	return created, nil
+}
+
+func (s *allergySvc) Update(ctx context.Context, patientID, allergyID, recordedBy string, req patientRequest.AllergyUpdateRequest) (patientModel.Allergy, error) {
+	if err := s.requirePatient(ctx, patientID); err != nil {
+		return patientModel.Allergy{}, err
+	}
+	existing, err := s.repo.GetByID(ctx, patientID, allergyID)
+	if err != nil {
+		return patientModel.Allergy{}, err
+	}
+	if existing.ID == "" {
+		return patientModel.Allergy{}, patientErrors.NewRecordNotFoundError("allergy", allergyID)
+	}
+
+	if req.Substance != nil {
+		existing.Substance = strings.TrimSpace(*req.Substance)
+	}
+	if req.Reaction != nil {
+		existing.Reaction = strings.TrimSpace(*req.Reaction)
+	}
+	if req.Severity != nil {
+		existing.Severity = patientModel.AllergySeverity(*req.Severity)
+	}
+	existing.RecordedAt = time.Now()
+	existing.RecordedBy = recordedBy
+
+	updated, err := s.repo.Update(ctx, existing)
+	if err != nil {
+		s.log.Error("Failed to update allergy", zap.Error(err))
+		return patientModel.Allergy{}, err
+	}
+	return updated, nil
+}
+
+func (s *allergySvc) Delete(ctx context.Context, patientID, allergyID string) error {
+	if err := s.requirePatient(ctx, patientID); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, patientID, allergyID)
+}
+
+func (s *allergySvc) PatientAllergyListByPatientID(ctx context.Context, patientID string) ([]commonmodel.PatientAllergy, error) {
+	if err := s.requirePatient(ctx, patientID); err != nil {
+		return nil, err
+	}
+	items, err := s.repo.ListByPatientID(ctx, patientID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]commonmodel.PatientAllergy, 0, len(items))
+	for _, item := range items {
+		result = append(result, commonmodel.PatientAllergy{
+			ID:        item.ID,
+			Substance: item.Substance,
+			Reaction:  item.Reaction,
+			Severity:  string(item.Severity),
+		})
+	}
+	return result, nil
+}
+
+// requirePatient fails with not found unless the patient exists and belongs to the caller's organization
+func (s *allergySvc) requirePatient(ctx context.Context, patientID string) error {
+	patient, err := s.patients.GetByID(ctx, patientID)
+	if err != nil {
+		return err
+	}
+	if patient.ID == "" {
+		return patientErrors.NewRecordNotFoundError("patient", patientID)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/cache"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// CacheInvalidator evicts patient cache entries when the patients collection changes,
+// including writes made by other instances
+type CacheInvalidator struct {
+	cache     cache.Cache
+	cacheKeys *CacheKeys
+	log       *zap.Logger
+}
+
+// NewCacheInvalidator creates an invalidator for the patient cache keys
+func NewCacheInvalidator(c cache.Cache, l *zap.Logger) *CacheInvalidator {
+	return &CacheInvalidator{cache: c, cacheKeys: NewCacheKeys(), log: l}
+}
+
+// HandleChange is a database.ChangeHandler for the patients collection.
+// List and count keys are keyed by arbitrary queries and can't be enumerated,
+// so only the unfiltered count and the counts by state are evicted, in every scope; the rest
+// expire with their TTL.
+func (i *CacheInvalidator) HandleChange(ctx context.Context, event database.ChangeEvent) {
+	if i.cache == nil || event.DocumentID == "" {
+		return
+	}
+
+	// An update can move a patient to another state, so the counts by state are always stale
+	countKeys := []string{i.cacheKeys.PatientCountByState(cache.Scope{})}
+	if event.Operation == "insert" || event.Operation == "delete" {
+		countKeys = append(countKeys, i.cacheKeys.PatientCount(cache.Scope{}, ""))
+	}
+
+	for _, key := range append(countKeys, i.cacheKeys.PatientByID(event.DocumentID)) {
+		if err := i.cache.Delete(ctx, key); err != nil {
+			i.log.Warn("Failed to invalidate patient cache",
+				zap.String("operation", event.Operation),
+				zap.Error(err))
+		}
+	}
+	for _, key := range countKeys {
+		if err := cache.DeleteScoped(ctx, i.cache, key); err != nil {
+			i.log.Warn("Failed to invalidate scoped patient counts",
+				zap.String("operation", event.Operation),
+				zap.Error(err))
+		}
+	}
+}
@@ -28,10 +28,15 @@ func (k *CacheKeys) PatientList(query string, limit, offset int) string {
 	return fmt.Sprintf("patient:list:%s:%d:%d", sanitizedQuery, limit, offset)
 }
 
-// PatientCount returns cache key for patient count
-func (k *CacheKeys) PatientCount(query string) string {
+// PatientCount returns cache key for patient count in the caller's scope
+func (k *CacheKeys) PatientCount(scope cache.Scope, query string) string {
 	sanitizedQuery := cache.SanitizeKey(query)
-	return fmt.Sprintf("patient:count:%s", sanitizedQuery)
+	return scope.Key(fmt.Sprintf("patient:count:%s", sanitizedQuery))
+}
+
+// PatientCountByState returns cache key for the patient counts grouped by state in the caller's scope
+func (k *CacheKeys) PatientCountByState(scope cache.Scope) string {
+	return scope.Key("patient:count-by:state")
 }
 
 // AddressByID returns cache key for address by ID
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	patientErrors "pharmacy-modernization-project-model/domain/patient/errors"
+	patientproviders "pharmacy-modernization-project-model/domain/patient/providers"
+	patientrepo "pharmacy-modernization-project-model/domain/patient/repository"
+	patientsecurity "pharmacy-modernization-project-model/domain/patient/security"
+	"pharmacy-modernization-project-model/internal/platform/attachments"
+)
+
+// DocumentConfig controls the documents attached to patients
+type DocumentConfig struct {
+	// MaxFileBytes caps the size of each file
+	MaxFileBytes int64
+	// ContentTypes lists the types accepted, as detected from the content
+	ContentTypes []string
+}
+
+type DocumentService interface {
+	List(ctx context.Context, patientID string) ([]patientModel.PatientDocument, error)
+	// Upload scans the file and stores it. An infected file is refused; when the scanner cannot
+	// be reached the upload fails rather than storing an unchecked file.
+	Upload(ctx context.Context, patientID, uploadedBy, fileName string, r io.Reader) (patientModel.PatientDocument, error)
+	// Download returns a document and its content, or attachments.ErrIntegrity when the stored
+	// content no longer matches its hash
+	Download(ctx context.Context, patientID, documentID string) (patientModel.PatientDocument, []byte, error)
+	// Limits returns the size limit and accepted types, for upload forms
+	Limits() DocumentConfig
+}
+
+type documentSvc struct {
+	repo     patientrepo.DocumentRepository
+	patients patientrepo.PatientRepository
+	blobs    patientproviders.DocumentBlobProvider
+	scanner  patientproviders.DocumentScanProvider
+	cfg      DocumentConfig
+	log      *zap.Logger
+}
+
+func NewDocumentService(r patientrepo.DocumentRepository, patients patientrepo.PatientRepository, blobs patientproviders.DocumentBlobProvider, scanner patientproviders.DocumentScanProvider, cfg DocumentConfig, l *zap.Logger) DocumentService {
+	if cfg.MaxFileBytes <= 0 {
+		cfg.MaxFileBytes = 10 << 20
+	}
+	if len(cfg.ContentTypes) == 0 {
+		cfg.ContentTypes = []string{"application/pdf", "image/png", "image/jpeg"}
+	}
+	if scanner == nil {
+		scanner = attachments.NoopScanner{}
+	}
+	return &documentSvc{repo: r, patients: patients, blobs: blobs, scanner: scanner, cfg: cfg, log: l}
+}
+
+func (s *documentSvc) Limits() DocumentConfig {
+	return DocumentConfig{MaxFileBytes: s.cfg.MaxFileBytes, ContentTypes: slices.Clone(s.cfg.ContentTypes)}
+}
+
+func (s *documentSvc) List(ctx context.Context, patientID string) ([]patientModel.PatientDocument, error) {
+	if err := s.requirePatient(ctx, patientID, "read"); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByPatientID(ctx, patientID)
+}
+
+func (s *documentSvc) Upload(ctx context.Context, patientID, uploadedBy, fileName string, r io.Reader) (patientModel.PatientDocument, error) {
+	if err := s.requirePatient(ctx, patientID, "upload"); err != nil {
+		return patientModel.PatientDocument{}, err
+	}
+
+	fileName = cleanFileName(fileName)
+	data, err := io.ReadAll(io.LimitReader(r, s.cfg.MaxFileBytes+1))
+	var tooLarge *http.MaxBytesError
+	if err != nil && !errors.As(err, &tooLarge) {
+		return patientModel.PatientDocument{}, fmt.Errorf("read document: %w", err)
+	}
+	// The request body limit may cut the upload short before the file limit is reached
+	if int64(len(data)) > s.cfg.MaxFileBytes || tooLarge != nil {
+		return patientModel.PatientDocument{}, patientErrors.NewValidationError("file", fileName,
+			fmt.Sprintf("file is larger than %d KB", s.cfg.MaxFileBytes>>10))
+	}
+	if len(data) == 0 {
+		return patientModel.PatientDocument{}, patientErrors.NewValidationError("file", fileName, "file is empty")
+	}
+
+	// Trust the content, not the declared type
+	contentType, _, _ := strings.Cut(http.DetectContentType(data), ";")
+	if !slices.Contains(s.cfg.ContentTypes, contentType) {
+		return patientModel.PatientDocument{}, patientErrors.NewValidationError("file", fileName,
+			"file type "+contentType+" is not accepted; upload one of "+strings.Join(s.cfg.ContentTypes, ", "))
+	}
+
+	scan, err := s.scanner.Scan(ctx, fileName, data)
+	if err != nil {
+		s.log.Error("Patient document could not be scanned", zap.String("patient_id", patientID), zap.Error(err))
+		return patientModel.PatientDocument{}, err
+	}
+	if scan.Status == attachments.ScanInfected {
+		s.log.Warn("Infected patient document refused",
+			zap.String("patient_id", patientID),
+			zap.String("threat", scan.Threat),
+			zap.String("uploaded_by", uploadedBy))
+		return patientModel.PatientDocument{}, patientErrors.NewBusinessLogicError("upload document", "the file failed the virus scan and was not stored")
+	}
+
+	document := patientModel.PatientDocument{
+		ID:          uuid.NewString(),
+		PatientID:   patientID,
+		Name:        fileName,
+		ContentType: contentType,
+		Size:        len(data),
+		SHA256:      attachments.Hash(data),
+		ScanStatus:  string(scan.Status),
+		ScanEngine:  scan.Engine,
+		UploadedBy:  uploadedBy,
+		UploadedAt:  time.Now(),
+	}
+	document.BlobKey = "patients/" + patientID + "/" + document.ID
+
+	if err := s.blobs.Put(ctx, document.BlobKey, data, contentType); err != nil {
+		s.log.Error("Failed to store patient document", zap.String("patient_id", patientID), zap.Error(err))
+		return patientModel.PatientDocument{}, err
+	}
+	created, err := s.repo.Create(ctx, document)
+	if err != nil {
+		s.log.Error("Failed to save patient document", zap.String("patient_id", patientID), zap.Error(err))
+		// Without its metadata the blob could never be reached
+		if err := s.blobs.Delete(context.WithoutCancel(ctx), document.BlobKey); err != nil {
+			s.log.Warn("Failed to remove orphaned document content", zap.String("blob_key", document.BlobKey), zap.Error(err))
+		}
+		return patientModel.PatientDocument{}, err
+	}
+
+	s.log.Info("Patient document uploaded",
+		zap.String("patient_id", patientID),
+		zap.String("document_id", created.ID),
+		zap.String("content_type", contentType),
+		zap.Int("size", created.Size),
+		zap.String("scan_status", created.ScanStatus))
+	return created, nil
+}
+
+func (s *documentSvc) Download(ctx context.Context, patientID, documentID string) (patientModel.PatientDocument, []byte, error) {
+	if err := s.requirePatient(ctx, patientID, "read"); err != nil {
+		return patientModel.PatientDocument{}, nil, err
+	}
+	document, err := s.repo.GetByID(ctx, patientID, documentID)
+	if err != nil {
+		return patientModel.PatientDocument{}, nil, err
+	}
+
+	data, err := s.blobs.Get(ctx, document.BlobKey)
+	if err != nil {
+		s.log.Error("Failed to read patient document", zap.String("document_id", documentID), zap.Error(err))
+		return patientModel.PatientDocument{}, nil, err
+	}
+	if attachments.Hash(data) != document.SHA256 {
+		s.log.Error("Patient document failed integrity check", zap.String("document_id", documentID))
+		return patientModel.PatientDocument{}, nil, fmt.Errorf("patient document %s: %w", documentID, attachments.ErrIntegrity)
+	}
+	return document, data, nil
+}
+
+// requirePatient fails with not found unless the patient exists, and with an authorization error
+// when the caller's data access roles do not cover the patient's state
+func (s *documentSvc) requirePatient(ctx context.Context, patientID, action string) error {
+	patient, err := s.patients.GetByID(ctx, patientID)
+	if err != nil {
+		return err
+	}
+	if patient.ID == "" {
+		return patientErrors.NewRecordNotFoundError("patient", patientID)
+	}
+	if allowed := patientsecurity.AllowedStates(currentUser(ctx)); allowed != nil && !slices.Contains(allowed, patient.State) {
+		return patientErrors.NewAuthorizationError("patient documents", action, "patients in "+patient.State+" are outside your data access")
+	}
+	return nil
+}
+
+// cleanFileName keeps the end of the base name of an uploaded file, as browsers may send a full path
+func cleanFileName(name string) string {
+	name = path.Base(strings.ReplaceAll(strings.TrimSpace(name), `\`, "/"))
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || r == '"' {
+			return -1
+		}
+		return r
+	}, name)
+	if name == "" || name == "." || name == "/" {
+		return "document"
+	}
+	if runes := []rune(name); len(runes) > 200 {
+		name = string(runes[len(runes)-200:])
+	}
+	return name
+}
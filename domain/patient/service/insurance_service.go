@@ -0,0 +1,395 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	patientRequest "pharmacy-modernization-project-model/domain/patient/contracts/request"
+	patientErrors "pharmacy-modernization-project-model/domain/patient/errors"
+	patientproviders "pharmacy-modernization-project-model/domain/patient/providers"
+	patientrepo "pharmacy-modernization-project-model/domain/patient/repository"
+	cardocr "pharmacy-modernization-project-model/internal/integrations/card_ocr"
+	"pharmacy-modernization-project-model/internal/platform/attachments"
+)
+
+const insuranceCardOwnerType = "insurance_record"
+
+// InsuranceIntakeConfig controls insurance card intake
+type InsuranceIntakeConfig struct {
+	// ReviewConfidence flags OCR fields read with a lower confidence for review
+	ReviewConfidence float64
+	// MaxImageBytes caps the size of each card photo
+	MaxImageBytes int
+}
+
+type InsuranceService interface {
+	List(ctx context.Context, patientID string) ([]patientModel.InsuranceRecord, error)
+	GetByID(ctx context.Context, patientID, insuranceID string) (patientModel.InsuranceRecord, error)
+	// IntakeCard stores the card photos and pre-fills a record pending confirmation from OCR. An
+	// OCR failure still creates the record, with the error noted, so the fields can be typed in.
+	IntakeCard(ctx context.Context, patientID, createdBy string, req patientRequest.InsuranceCardIntakeRequest) (patientModel.InsuranceRecord, error)
+	// Create enters a confirmed record by hand, e.g. from coverage read out over the phone
+	Create(ctx context.Context, patientID, createdBy string, req patientRequest.InsuranceCreateRequest) (patientModel.InsuranceRecord, error)
+	// Update corrects a confirmed record, e.g. to end its coverage
+	Update(ctx context.Context, patientID, insuranceID string, req patientRequest.InsuranceUpdateRequest) (patientModel.InsuranceRecord, error)
+	// Confirm accepts the pre-filled record with any corrections made by the reviewer
+	Confirm(ctx context.Context, patientID, insuranceID, reviewedBy string, req patientRequest.InsuranceConfirmRequest) (patientModel.InsuranceRecord, error)
+	Reject(ctx context.Context, patientID, insuranceID, reviewedBy string, req patientRequest.InsuranceRejectRequest) (patientModel.InsuranceRecord, error)
+	// CardImage returns the stored photo of one side of the card
+	CardImage(ctx context.Context, patientID, insuranceID, side string) (attachments.Attachment, []byte, error)
+}
+
+type insuranceSvc struct {
+	repo        patientrepo.InsuranceRepository
+	patients    patientrepo.PatientRepository
+	attachments patientproviders.AttachmentProvider
+	ocr         patientproviders.InsuranceCardOCRProvider
+	cfg         InsuranceIntakeConfig
+	log         *zap.Logger
+}
+
+func NewInsuranceService(r patientrepo.InsuranceRepository, patients patientrepo.PatientRepository, attachmentProvider patientproviders.AttachmentProvider, ocr patientproviders.InsuranceCardOCRProvider, cfg InsuranceIntakeConfig, l *zap.Logger) InsuranceService {
+	if cfg.ReviewConfidence <= 0 {
+		cfg.ReviewConfidence = 0.85
+	}
+	if cfg.MaxImageBytes <= 0 {
+		cfg.MaxImageBytes = 5 << 20
+	}
+	return &insuranceSvc{repo: r, patients: patients, attachments: attachmentProvider, ocr: ocr, cfg: cfg, log: l}
+}
+
+func (s *insuranceSvc) List(ctx context.Context, patientID string) ([]patientModel.InsuranceRecord, error) {
+	if err := s.requirePatient(ctx, patientID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByPatientID(ctx, patientID)
+}
+
+func (s *insuranceSvc) GetByID(ctx context.Context, patientID, insuranceID string) (patientModel.InsuranceRecord, error) {
+	if err := s.requirePatient(ctx, patientID); err != nil {
+		return patientModel.InsuranceRecord{}, err
+	}
+	return s.repo.GetByID(ctx, patientID, insuranceID)
+}
+
+func (s *insuranceSvc) Create(ctx context.Context, patientID, createdBy string, req patientRequest.InsuranceCreateRequest) (patientModel.InsuranceRecord, error) {
+	if err := s.requirePatient(ctx, patientID); err != nil {
+		return patientModel.InsuranceRecord{}, err
+	}
+
+	now := time.Now()
+	record := patientModel.InsuranceRecord{
+		ID:          uuid.NewString(),
+		PatientID:   patientID,
+		PayerName:   strings.TrimSpace(req.PayerName),
+		MemberID:    strings.TrimSpace(req.MemberID),
+		GroupNumber: strings.TrimSpace(req.GroupNumber),
+		MemberName:  strings.TrimSpace(req.MemberName),
+		RxBIN:       strings.TrimSpace(req.RxBIN),
+		RxPCN:       strings.TrimSpace(req.RxPCN),
+		Status:      patientModel.InsuranceConfirmed,
+		CreatedBy:   createdBy,
+		CreatedAt:   now,
+		ReviewedBy:  createdBy,
+		ReviewedAt:  &now,
+	}
+	if err := setCoverageDates(&record, &req.EffectiveDate, &req.ExpiryDate); err != nil {
+		return patientModel.InsuranceRecord{}, err
+	}
+
+	created, err := s.repo.Create(ctx, record)
+	if err != nil {
+		s.log.Error("Failed to create insurance record", zap.String("patient_id", patientID), zap.Error(err))
+		return patientModel.InsuranceRecord{}, err
+	}
+	s.log.Info("Insurance record entered",
+		zap.String("patient_id", patientID),
+		zap.String("insurance_id", created.ID))
+	return created, nil
+}
+
+func (s *insuranceSvc) Update(ctx context.Context, patientID, insuranceID string, req patientRequest.InsuranceUpdateRequest) (patientModel.InsuranceRecord, error) {
+	record, err := s.GetByID(ctx, patientID, insuranceID)
+	if err != nil {
+		return patientModel.InsuranceRecord{}, err
+	}
+
+	for name, value := range req.Fields() {
+		if value != nil {
+			record.SetField(name, strings.TrimSpace(*value))
+		}
+	}
+	if err := setCoverageDates(&record, req.EffectiveDate, req.ExpiryDate); err != nil {
+		return patientModel.InsuranceRecord{}, err
+	}
+
+	return s.repo.Update(ctx, record)
+}
+
+func (s *insuranceSvc) IntakeCard(ctx context.Context, patientID, createdBy string, req patientRequest.InsuranceCardIntakeRequest) (patientModel.InsuranceRecord, error) {
+	if err := s.requirePatient(ctx, patientID); err != nil {
+		return patientModel.InsuranceRecord{}, err
+	}
+
+	images := []cardocr.CardImage{}
+	front, frontType, err := s.decodeCardImage("front_image", req.FrontImage)
+	if err != nil {
+		return patientModel.InsuranceRecord{}, err
+	}
+	images = append(images, cardocr.CardImage{Side: cardocr.SideFront, ContentType: frontType, Data: front})
+	if req.BackImage != "" {
+		back, backType, err := s.decodeCardImage("back_image", req.BackImage)
+		if err != nil {
+			return patientModel.InsuranceRecord{}, err
+		}
+		images = append(images, cardocr.CardImage{Side: cardocr.SideBack, ContentType: backType, Data: back})
+	}
+
+	record := patientModel.InsuranceRecord{
+		ID:        uuid.NewString(),
+		PatientID: patientID,
+		Status:    patientModel.InsurancePendingConfirmation,
+		CreatedBy: createdBy,
+		CreatedAt: time.Now(),
+	}
+
+	for _, image := range images {
+		stored, err := s.attachments.Put(ctx, attachments.Attachment{
+			OwnerType:   insuranceCardOwnerType,
+			OwnerID:     record.ID,
+			Name:        "insurance-card-" + image.Side + "-" + record.ID,
+			ContentType: image.ContentType,
+			CreatedBy:   createdBy,
+		}, image.Data)
+		if err != nil {
+			s.log.Error("Failed to store insurance card image",
+				zap.String("patient_id", patientID),
+				zap.String("side", image.Side),
+				zap.Error(err))
+			return patientModel.InsuranceRecord{}, err
+		}
+		if image.Side == cardocr.SideFront {
+			record.CardFrontAttachmentID = stored.ID
+		} else {
+			record.CardBackAttachmentID = stored.ID
+		}
+	}
+
+	record.OCR = s.extract(ctx, &record, images)
+
+	created, err := s.repo.Create(ctx, record)
+	if err != nil {
+		s.log.Error("Failed to create insurance record", zap.String("patient_id", patientID), zap.Error(err))
+		return patientModel.InsuranceRecord{}, err
+	}
+	return created, nil
+}
+
+// extract runs OCR on the card photos and pre-fills the record with what was read
+func (s *insuranceSvc) extract(ctx context.Context, record *patientModel.InsuranceRecord, images []cardocr.CardImage) *patientModel.InsuranceOCR {
+	ocr := &patientModel.InsuranceOCR{
+		Fields:      map[string]patientModel.OCRFieldReading{},
+		ExtractedAt: time.Now(),
+	}
+
+	response, err := s.ocr.ExtractInsuranceCard(ctx, cardocr.ExtractCardRequest{Images: images})
+	if err != nil {
+		s.log.Warn("Insurance card OCR failed; fields must be entered by hand",
+			zap.String("insurance_id", record.ID),
+			zap.Error(err))
+		ocr.Error = "card could not be read automatically"
+		return ocr
+	}
+
+	ocr.Provider = response.Provider
+	for _, field := range response.Fields {
+		value := strings.TrimSpace(field.Value)
+		if value == "" || !slices.Contains(patientModel.InsuranceFields, field.Name) || record.Field(field.Name) != "" {
+			continue
+		}
+		record.SetField(field.Name, value)
+		ocr.Fields[field.Name] = patientModel.OCRFieldReading{
+			Value:       value,
+			Confidence:  field.Confidence,
+			NeedsReview: field.Confidence < s.cfg.ReviewConfidence,
+		}
+	}
+
+	s.log.Info("Insurance card read",
+		zap.String("insurance_id", record.ID),
+		zap.String("provider", response.Provider),
+		zap.Int("fields", len(ocr.Fields)))
+	return ocr
+}
+
+func (s *insuranceSvc) Confirm(ctx context.Context, patientID, insuranceID, reviewedBy string, req patientRequest.InsuranceConfirmRequest) (patientModel.InsuranceRecord, error) {
+	record, err := s.pending(ctx, patientID, insuranceID)
+	if err != nil {
+		return patientModel.InsuranceRecord{}, err
+	}
+
+	for name, value := range req.Fields() {
+		if value != nil {
+			record.SetField(name, strings.TrimSpace(*value))
+		}
+	}
+	for _, name := range []string{patientModel.InsuranceFieldPayerName, patientModel.InsuranceFieldMemberID} {
+		if record.Field(name) == "" {
+			return patientModel.InsuranceRecord{}, patientErrors.NewValidationError(name, "", name+" is required to confirm insurance")
+		}
+	}
+	if err := setCoverageDates(&record, req.EffectiveDate, req.ExpiryDate); err != nil {
+		return patientModel.InsuranceRecord{}, err
+	}
+
+	// Keep the OCR reading and note which fields the reviewer changed
+	if record.OCR != nil {
+		ocr := *record.OCR
+		ocr.Fields = maps.Clone(ocr.Fields)
+		for name, reading := range ocr.Fields {
+			reading.Corrected = record.Field(name) != reading.Value
+			ocr.Fields[name] = reading
+		}
+		record.OCR = &ocr
+	}
+
+	now := time.Now()
+	record.Status = patientModel.InsuranceConfirmed
+	record.ReviewedBy = reviewedBy
+	record.ReviewedAt = &now
+
+	confirmed, err := s.repo.Review(ctx, record)
+	if err != nil {
+		return patientModel.InsuranceRecord{}, err
+	}
+	s.log.Info("Insurance record confirmed",
+		zap.String("patient_id", patientID),
+		zap.String("insurance_id", insuranceID))
+	return confirmed, nil
+}
+
+func (s *insuranceSvc) Reject(ctx context.Context, patientID, insuranceID, reviewedBy string, req patientRequest.InsuranceRejectRequest) (patientModel.InsuranceRecord, error) {
+	record, err := s.pending(ctx, patientID, insuranceID)
+	if err != nil {
+		return patientModel.InsuranceRecord{}, err
+	}
+
+	now := time.Now()
+	record.Status = patientModel.InsuranceRejected
+	record.RejectedReason = strings.TrimSpace(req.Reason)
+	record.ReviewedBy = reviewedBy
+	record.ReviewedAt = &now
+
+	return s.repo.Review(ctx, record)
+}
+
+func (s *insuranceSvc) CardImage(ctx context.Context, patientID, insuranceID, side string) (attachments.Attachment, []byte, error) {
+	record, err := s.GetByID(ctx, patientID, insuranceID)
+	if err != nil {
+		return attachments.Attachment{}, nil, err
+	}
+
+	attachmentID := record.CardFrontAttachmentID
+	if side == cardocr.SideBack {
+		attachmentID = record.CardBackAttachmentID
+	}
+	if attachmentID == "" {
+		return attachments.Attachment{}, nil, patientErrors.NewRecordNotFoundError("insurance card "+side, insuranceID)
+	}
+
+	attachment, content, err := s.attachments.Get(ctx, attachmentID)
+	if err != nil {
+		if errors.Is(err, attachments.ErrIntegrity) {
+			s.log.Error("Insurance card image failed integrity check", zap.String("insurance_id", insuranceID))
+		}
+		return attachments.Attachment{}, nil, err
+	}
+	return attachment, content, nil
+}
+
+// requirePatient fails with not found unless the patient exists and belongs to the caller's organization
+func (s *insuranceSvc) requirePatient(ctx context.Context, patientID string) error {
+	patient, err := s.patients.GetByID(ctx, patientID)
+	if err != nil {
+		return err
+	}
+	if patient.ID == "" {
+		return patientErrors.NewRecordNotFoundError("patient", patientID)
+	}
+	return nil
+}
+
+// setCoverageDates applies the YYYY-MM-DD dates given (nil leaves a date unchanged, an empty
+// expiry date removes it) and checks the coverage starts before it expires
+func setCoverageDates(record *patientModel.InsuranceRecord, effective, expiry *string) error {
+	parse := func(field, value string) (*time.Time, error) {
+		if value == "" {
+			return nil, nil
+		}
+		day, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			return nil, patientErrors.NewValidationError(field, value, field+" must be a date (YYYY-MM-DD)")
+		}
+		return &day, nil
+	}
+
+	var err error
+	if effective != nil {
+		if record.EffectiveDate, err = parse("effective_date", strings.TrimSpace(*effective)); err != nil {
+			return err
+		}
+	}
+	if expiry != nil {
+		if record.ExpiryDate, err = parse("expiry_date", strings.TrimSpace(*expiry)); err != nil {
+			return err
+		}
+	}
+	if record.EffectiveDate != nil && record.ExpiryDate != nil && !record.EffectiveDate.Before(*record.ExpiryDate) {
+		return patientErrors.NewValidationError("expiry_date", record.ExpiryDate.Format(time.DateOnly), "expiry_date must be after effective_date")
+	}
+	return nil
+}
+
+// pending loads a record that is still awaiting review
+func (s *insuranceSvc) pending(ctx context.Context, patientID, insuranceID string) (patientModel.InsuranceRecord, error) {
+	record, err := s.GetByID(ctx, patientID, insuranceID)
+	if err != nil {
+		return patientModel.InsuranceRecord{}, err
+	}
+	if record.Status != patientModel.InsurancePendingConfirmation {
+		return patientModel.InsuranceRecord{}, patientErrors.NewConflictError("insurance record", insuranceID, "insurance record is already "+string(record.Status))
+	}
+	return record, nil
+}
+
+// decodeCardImage decodes a base64 photo (a data URL prefix is accepted) and checks it is a PNG or JPEG
+func (s *insuranceSvc) decodeCardImage(field, encoded string) ([]byte, string, error) {
+	if i := strings.Index(encoded, ","); strings.HasPrefix(encoded, "data:") && i > 0 {
+		encoded = encoded[i+1:]
+	}
+	if base64.StdEncoding.DecodedLen(len(encoded)) > s.cfg.MaxImageBytes {
+		return nil, "", patientErrors.NewValidationError(field, "", fmt.Sprintf("card image exceeds %d KB", s.cfg.MaxImageBytes>>10))
+	}
+	data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, "", patientErrors.NewValidationError(field, "", "card image is not valid base64")
+	}
+
+	// Trust the content, not the declared type
+	contentType := http.DetectContentType(data)
+	if contentType != "image/png" && contentType != "image/jpeg" {
+		return nil, "", patientErrors.NewValidationError(field, "", "card image must be a PNG or JPEG")
+	}
+	return data, contentType, nil
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	patientRequest "pharmacy-modernization-project-model/domain/patient/contracts/request"
+	patientErrors "pharmacy-modernization-project-model/domain/patient/errors"
+	patientrepo "pharmacy-modernization-project-model/domain/patient/repository"
+)
+
+type MeasurementService interface {
+	List(ctx context.Context, patientID string, measurementType patientModel.MeasurementType, limit int) ([]patientModel.Measurement, error)
+	GetByID(ctx context.Context, patientID, measurementID string) (patientModel.Measurement, error)
+	// Latest returns the most recent measurement of a type, or an empty measurement when none is recorded
+	Latest(ctx context.Context, patientID string, measurementType patientModel.MeasurementType) (patientModel.Measurement, error)
+	Create(ctx context.Context, patientID, recordedBy string, req patientRequest.MeasurementCreateRequest) (patientModel.Measurement, error)
+	Update(ctx context.Context, patientID, measurementID, recordedBy string, req patientRequest.MeasurementUpdateRequest) (patientModel.Measurement, error)
+	Delete(ctx context.Context, patientID, measurementID string) error
+}
+
+type measurementSvc struct {
+	repo     patientrepo.MeasurementRepository
+	patients PatientReader
+	log      *zap.Logger
+}
+
+func NewMeasurementService(r patientrepo.MeasurementRepository, patients PatientReader, l *zap.Logger) MeasurementService {
+	return &measurementSvc{repo: r, patients: patients, log: l}
+}
+
+func (s *measurementSvc) List(ctx context.Context, patientID string, measurementType patientModel.MeasurementType, limit int) ([]patientModel.Measurement, error) {
+	if err := s.requirePatient(ctx, patientID); err != nil {
+		return nil, err
+	}
+	if measurementType != "" && !patientModel.IsValidMeasurementType(measurementType) {
+		return nil, patientErrors.NewValidationError("type", string(measurementType), "unsupported measurement type")
+	}
+	return s.repo.ListByPatientID(ctx, patientID, measurementType, limit)
+}
+
+func (s *measurementSvc) GetByID(ctx context.Context, patientID, measurementID string) (patientModel.Measurement, error) {
+	if err := s.requirePatient(ctx, patientID); err != nil {
+		return patientModel.Measurement{}, err
+	}
+	return s.repo.GetByID(ctx, patientID, measurementID)
+}
+
+func (s *measurementSvc) Latest(ctx context.Context, patientID string, measurementType patientModel.MeasurementType) (patientModel.Measurement, error) {
+	if err := s.requirePatient(ctx, patientID); err != nil {
+		return patientModel.Measurement{}, err
+	}
+	if !patientModel.IsValidMeasurementType(measurementType) {
+		return patientModel.Measurement{}, patientErrors.NewValidationError("type", string(measurementType), "unsupported measurement type")
+	}
+	return s.repo.Latest(ctx, patientID, measurementType)
+}
+
+func (s *measurementSvc) Create(ctx context.Context, patientID, recordedBy string, req patientRequest.MeasurementCreateRequest) (patientModel.Measurement, error) {
+	if err := s.requirePatient(ctx, patientID); err != nil {
+		return patientModel.Measurement{}, err
+	}
+	measurementType := patientModel.MeasurementType(req.Type)
+	if !patientModel.IsValidMeasurementType(measurementType) {
+		return patientModel.Measurement{}, patientErrors.NewValidationError("type", req.Type, "unsupported measurement type")
+	}
+	unit, ok := patientModel.NormalizeUnit(measurementType, req.Unit)
+	if !ok {
+		return patientModel.Measurement{}, patientErrors.NewValidationError("unit", req.Unit, "unsupported unit for "+req.Type)
+	}
+
+	recordedAt := time.Now()
+	if req.RecordedAt != nil {
+		if req.RecordedAt.After(recordedAt) {
+			return patientModel.Measurement{}, patientErrors.NewValidationError("recorded_at", req.RecordedAt, "cannot be in the future")
+		}
+		recordedAt = *req.RecordedAt
+	}
+
+	measurement := patientModel.Measurement{
+		ID:         uuid.NewString(),
+		PatientID:  patientID,
+		Type:       measurementType,
+		Value:      req.Value,
+		Unit:       unit,
+		RecordedAt: recordedAt,
+		RecordedBy: recordedBy,
+	}
+
+	created, err := s.repo.Create(ctx, measurement)
+	if err != nil {
+		s.log.Error("Failed to record measurement",
+			zap.String("type", req.Type),
+			zap.Error(err))
+		return patientModel.Measurement{}, err
+	}
+	return created, nil
+}
+
+func (s *measurementSvc) Update(ctx context.Context, patientID, measurementID, recordedBy string, req patientRequest.MeasurementUpdateRequest) (patientModel.Measurement, error) {
+	if err := s.requirePatient(ctx, patientID); err != nil {
+		return patientModel.Measurement{}, err
+	}
+	existing, err := s.repo.GetByID(ctx, patientID, measurementID)
+	if err != nil {
+		return patientModel.Measurement{}, err
+	}
+	if existing.ID == "" {
+		return patientModel.Measurement{}, patientErrors.NewRecordNotFoundError("measurement", measurementID)
+	}
+
+	if req.Value != nil {
+		existing.Value = *req.Value
+	}
+	if req.Unit != nil {
+		unit, ok := patientModel.NormalizeUnit(existing.Type, *req.Unit)
+		if !ok {
+			return patientModel.Measurement{}, patientErrors.NewValidationError("unit", *req.Unit, "unsupported unit for "+string(existing.Type))
+		}
+		existing.Unit = unit
+	}
+	if req.RecordedAt != nil {
+		if req.RecordedAt.After(time.Now()) {
+			return patientModel.Measurement{}, patientErrors.NewValidationError("recorded_at", req.RecordedAt, "cannot be in the future")
+		}
+		existing.RecordedAt = *req.RecordedAt
+	}
+	existing.RecordedBy = recordedBy
+
+	updated, err := s.repo.Update(ctx, existing)
+	if err != nil {
+		s.log.Error("Failed to update measurement", zap.Error(err))
+		return patientModel.Measurement{}, err
+	}
+	return updated, nil
+}
+
+func (s *measurementSvc) Delete(ctx context.Context, patientID, measurementID string) error {
+	if err := s.requirePatient(ctx, patientID); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, patientID, measurementID)
+}
+
+// requirePatient fails with not found unless the patient exists and belongs to the caller's organization
+func (s *measurementSvc) requirePatient(ctx context.Context, patientID string) error {
+	patient, err := s.patients.GetByID(ctx, patientID)
+	if err != nil {
+		return err
+	}
+	if patient.ID == "" {
+		return patientErrors.NewRecordNotFoundError("patient", patientID)
+	}
+	return nil
+}
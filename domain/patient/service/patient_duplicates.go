@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	m "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	patientsecurity "pharmacy-modernization-project-model/domain/patient/security"
+)
+
+// Duplicate check modes of DuplicateConfig
+const (
+	DuplicateModeOff   = "off"   // Patients are created without looking for duplicates
+	DuplicateModeWarn  = "warn"  // Likely duplicates are returned with the created patient
+	DuplicateModeBlock = "block" // A patient with likely duplicates is not created
+)
+
+// DuplicateConfig controls the duplicate check before a patient is created
+type DuplicateConfig struct {
+	// Mode is DuplicateModeOff, DuplicateModeWarn (the default) or DuplicateModeBlock
+	Mode string
+	// MaxNameDistance is how many edits may separate the names of patients with the same DOB
+	MaxNameDistance int
+}
+
+// duplicateCandidateLimit bounds the patients compared with a new one, as a common DOB can
+// match many
+const duplicateCandidateLimit = 100
+
+// normalizePatient tidies the fields people type: spaces around and within the name, and
+// around the phone number
+func normalizePatient(p m.Patient) m.Patient {
+	p.Name = strings.Join(strings.Fields(p.Name), " ")
+	p.Phone = strings.TrimSpace(p.Phone)
+	return p
+}
+
+// findDuplicates returns the existing patients p likely duplicates: those with the same
+// normalized phone number, and those with the same DOB and a similar name. Only patients within
+// the caller's data-access scope are compared, so none are disclosed to callers who may not see them.
+func (s *patientSvc) findDuplicates(ctx context.Context, p m.Patient) ([]m.PotentialDuplicate, error) {
+	duplicates := []m.PotentialDuplicate{}
+	if s.duplicates.Mode == DuplicateModeOff {
+		return duplicates, nil
+	}
+
+	phone := m.NormalizePhone(p.Phone)
+	candidates, err := s.repo.FindDuplicateCandidates(ctx, phone, p.DOB, duplicateCandidateLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedStates := patientsecurity.AllowedStates(currentUser(ctx))
+	name := m.NormalizeName(p.Name)
+	for _, candidate := range candidates {
+		if candidate.ID == p.ID || len(allowedStates) > 0 && !slices.Contains(allowedStates, candidate.State) {
+			continue
+		}
+		var reasons []string
+		if phone != "" && m.NormalizePhone(candidate.Phone) == phone {
+			reasons = append(reasons, m.DuplicateMatchPhone)
+		}
+		if !p.DOB.IsZero() && candidate.DOB == p.DOB && s.similarNames(name, m.NormalizeName(candidate.Name)) {
+			reasons = append(reasons, m.DuplicateMatchNameDOB)
+		}
+		if len(reasons) > 0 {
+			duplicates = append(duplicates, m.PotentialDuplicate{Patient: candidate, Reasons: reasons})
+		}
+	}
+	return duplicates, nil
+}
+
+// similarNames reports whether two normalized names are at most MaxNameDistance edits apart,
+// as written or with their words sorted, so "ava thompson" matches "thompson ava"
+func (s *patientSvc) similarNames(a, b string) bool {
+	limit := s.duplicates.MaxNameDistance
+	if editDistance([]rune(a), []rune(b), limit) <= limit {
+		return true
+	}
+	return editDistance([]rune(sortedWords(a)), []rune(sortedWords(b)), limit) <= limit
+}
+
+func sortedWords(name string) string {
+	words := strings.Fields(name)
+	slices.Sort(words)
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,303 @@
+package service
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	m "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+	repo "pharmacy-modernization-project-model/domain/patient/repository"
+	patientsecurity "pharmacy-modernization-project-model/domain/patient/security"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/redaction"
+	"pharmacy-modernization-project-model/internal/platform/tabular"
+)
+
+// ExportConfig controls patient list exports
+type ExportConfig struct {
+	// Dir holds the files of background exports; a directory under the OS temp dir when empty
+	Dir string
+	// JobTTL is how long a finished background export can be downloaded
+	JobTTL time.Duration
+	// MaxSyncRows is the largest export streamed in the request; larger ones must run in the
+	// background. Zero means no limit.
+	MaxSyncRows int
+}
+
+// exportColumn is one column of the export, keyed by the JSON field name used for redaction
+type exportColumn struct {
+	field  string
+	header string
+	value  func(m.Patient) string
+}
+
+var exportColumns = []exportColumn{
+	{"id", "ID", func(p m.Patient) string { return p.ID }},
+	{"name", "Name", func(p m.Patient) string { return p.Name }},
+	{"dob", "Date of Birth", func(p m.Patient) string { return p.DOB.String() }},
+	{"phone", "Phone", func(p m.Patient) string { return p.Phone }},
+	{"state", "State", func(p m.Patient) string { return p.State }},
+	{"created_at", "Created At", func(p m.Patient) string { return p.CreatedAt.UTC().Format(time.RFC3339) }},
+}
+
+type PatientExportService interface {
+	// Export streams the filtered patient list to w in the requested format
+	Export(ctx context.Context, req request.PatientExportQueryRequest, w io.Writer) error
+	// StartJob runs the export in the background and returns the pending job
+	StartJob(ctx context.Context, req request.PatientExportQueryRequest) (m.PatientExportJob, error)
+	// GetJob returns a job started by the current user
+	GetJob(ctx context.Context, jobID string) (m.PatientExportJob, error)
+	// OpenJobFile opens the file of a completed job started by the current user
+	OpenJobFile(ctx context.Context, jobID string) (m.PatientExportJob, *os.File, error)
+}
+
+type patientExportSvc struct {
+	repo repo.PatientRepository
+	cfg  ExportConfig
+	log  *zap.Logger
+
+	mu   sync.Mutex
+	jobs map[string]*m.PatientExportJob
+}
+
+func NewPatientExportService(r repo.PatientRepository, cfg ExportConfig, l *zap.Logger) PatientExportService {
+	if cfg.Dir == "" {
+		cfg.Dir = filepath.Join(os.TempDir(), "patient-exports")
+	}
+	if cfg.JobTTL <= 0 {
+		cfg.JobTTL = time.Hour
+	}
+	return &patientExportSvc{repo: r, cfg: cfg, log: l, jobs: map[string]*m.PatientExportJob{}}
+}
+
+func (s *patientExportSvc) Export(ctx context.Context, req request.PatientExportQueryRequest, w io.Writer) error {
+	query := s.scopedQuery(ctx, req)
+
+	if s.cfg.MaxSyncRows > 0 {
+		count, err := s.repo.Count(ctx, query)
+		if err != nil {
+			return err
+		}
+		if count > s.cfg.MaxSyncRows {
+			return platformErrors.NewValidationError("async", false,
+				fmt.Sprintf("export of %d patients exceeds %d rows; use async=true", count, s.cfg.MaxSyncRows))
+		}
+	}
+
+	rows, err := s.write(ctx, req.ExportFormat(), query, hiddenFields(ctx), w, nil)
+	if err != nil {
+		s.log.Error("Failed to export patients", zap.Int("rows", rows), zap.Error(err))
+		return err
+	}
+	s.log.Info("Patients exported", zap.String("format", req.ExportFormat()), zap.Int("rows", rows))
+	return nil
+}
+
+func (s *patientExportSvc) StartJob(ctx context.Context, req request.PatientExportQueryRequest) (m.PatientExportJob, error) {
+	s.purgeExpired()
+
+	if err := os.MkdirAll(s.cfg.Dir, 0o700); err != nil {
+		return m.PatientExportJob{}, fmt.Errorf("create export directory: %w", err)
+	}
+
+	now := time.Now()
+	job := &m.PatientExportJob{
+		ID:        uuid.NewString(),
+		Status:    m.ExportJobPending,
+		Format:    req.ExportFormat(),
+		Gzip:      req.Gzip,
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.cfg.JobTTL),
+		OwnerID:   ownerID(ctx),
+	}
+	job.FilePath = filepath.Join(s.cfg.Dir, job.ID+"."+job.Format)
+
+	s.mu.Lock()
+	s.jobs[job.ID] = job
+	snapshot := *job
+	s.mu.Unlock()
+
+	// Scope and redaction are resolved now, while the request's user is still known. The job
+	// outlives the request, so it must not inherit its cancellation or timeout.
+	query := s.scopedQuery(ctx, req)
+	hidden := hiddenFields(ctx)
+	go s.runJob(context.WithoutCancel(ctx), job.ID, query, hidden)
+
+	s.log.Info("Patient export job started", zap.String("job_id", job.ID), zap.String("format", job.Format))
+	return snapshot, nil
+}
+
+func (s *patientExportSvc) GetJob(ctx context.Context, jobID string) (m.PatientExportJob, error) {
+	s.purgeExpired()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[jobID]
+	// Other users' jobs are reported as missing rather than forbidden
+	if !ok || job.OwnerID != ownerID(ctx) {
+		return m.PatientExportJob{}, platformErrors.NewRecordNotFoundError("export job", jobID)
+	}
+	return *job, nil
+}
+
+func (s *patientExportSvc) OpenJobFile(ctx context.Context, jobID string) (m.PatientExportJob, *os.File, error) {
+	job, err := s.GetJob(ctx, jobID)
+	if err != nil {
+		return m.PatientExportJob{}, nil, err
+	}
+	if job.Status != m.ExportJobCompleted {
+		return m.PatientExportJob{}, nil, platformErrors.NewConflictError("export job", jobID, "export is "+job.Status)
+	}
+	f, err := os.Open(job.FilePath)
+	if err != nil {
+		return m.PatientExportJob{}, nil, fmt.Errorf("open export file: %w", err)
+	}
+	return job, f, nil
+}
+
+func (s *patientExportSvc) runJob(ctx context.Context, jobID string, query request.PatientListQueryRequest, hidden map[string]bool) {
+	s.mu.Lock()
+	job := s.jobs[jobID]
+	job.Status = m.ExportJobRunning
+	format, compress, path := job.Format, job.Gzip, job.FilePath
+	s.mu.Unlock()
+
+	rows, err := s.writeFile(ctx, path, format, compress, query, hidden, func(rows int) {
+		s.mu.Lock()
+		job.Rows = rows
+		s.mu.Unlock()
+	})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	job.Rows = rows
+	job.CompletedAt = &now
+	job.ExpiresAt = now.Add(s.cfg.JobTTL)
+	if err != nil {
+		job.Status = m.ExportJobFailed
+		job.Error = "export failed"
+		_ = os.Remove(path)
+		s.log.Error("Patient export job failed", zap.String("job_id", jobID), zap.Int("rows", rows), zap.Error(err))
+		return
+	}
+	job.Status = m.ExportJobCompleted
+	s.log.Info("Patient export job completed", zap.String("job_id", jobID), zap.Int("rows", rows))
+}
+
+func (s *patientExportSvc) writeFile(ctx context.Context, path, format string, compress bool, query request.PatientListQueryRequest, hidden map[string]bool, progress func(int)) (int, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	var w io.Writer = f
+	var zw *gzip.Writer
+	if compress {
+		zw = gzip.NewWriter(f)
+		w = zw
+	}
+	rows, err := s.write(ctx, format, query, hidden, w, progress)
+	if err != nil {
+		return rows, err
+	}
+	if zw != nil {
+		if err := zw.Close(); err != nil {
+			return rows, err
+		}
+	}
+	return rows, f.Close()
+}
+
+// write streams the header and one row per patient, reporting progress every batch of rows
+func (s *patientExportSvc) write(ctx context.Context, format string, query request.PatientListQueryRequest, hidden map[string]bool, w io.Writer, progress func(int)) (int, error) {
+	tw, err := tabular.NewWriter(format, w)
+	if err != nil {
+		return 0, err
+	}
+
+	header := make([]string, len(exportColumns))
+	for i, c := range exportColumns {
+		header[i] = c.header
+	}
+	if err := tw.WriteRow(header); err != nil {
+		return 0, err
+	}
+
+	rows := 0
+	cells := make([]string, len(exportColumns))
+	err = s.repo.Stream(ctx, query, func(p m.Patient) error {
+		for i, c := range exportColumns {
+			cells[i] = ""
+			if !hidden[c.field] {
+				cells[i] = c.value(p)
+			}
+		}
+		if err := tw.WriteRow(cells); err != nil {
+			return err
+		}
+		rows++
+		if progress != nil && rows%1000 == 0 {
+			progress(rows)
+		}
+		return nil
+	})
+	if err != nil {
+		return rows, err
+	}
+	return rows, tw.Close()
+}
+
+// purgeExpired forgets finished jobs past their TTL and deletes their files
+func (s *patientExportSvc) purgeExpired() {
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, job := range s.jobs {
+		finished := job.Status == m.ExportJobCompleted || job.Status == m.ExportJobFailed
+		if finished && now.After(job.ExpiresAt) {
+			if err := os.Remove(job.FilePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+				s.log.Warn("Failed to remove expired export file", zap.String("job_id", id), zap.Error(err))
+			}
+			delete(s.jobs, id)
+		}
+	}
+}
+
+// scopedQuery converts the export filters to a list query limited to the user's data access
+func (s *patientExportSvc) scopedQuery(ctx context.Context, req request.PatientExportQueryRequest) request.PatientListQueryRequest {
+	query := req.ListQuery()
+	user, _ := auth.GetCurrentUser(ctx)
+	query.AllowedStates = patientsecurity.AllowedStates(user)
+	return query
+}
+
+// hiddenFields returns the export columns the caller's redaction profile hides
+func hiddenFields(ctx context.Context) map[string]bool {
+	hidden := map[string]bool{}
+	for _, c := range exportColumns {
+		if redaction.FieldRedacted(ctx, c.field) {
+			hidden[c.field] = true
+		}
+	}
+	return hidden
+}
+
+func ownerID(ctx context.Context) string {
+	if user, err := auth.GetCurrentUser(ctx); err == nil && user != nil {
+		return user.ID
+	}
+	return ""
+}
@@ -0,0 +1,528 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	m "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	patientErrors "pharmacy-modernization-project-model/domain/patient/errors"
+	repo "pharmacy-modernization-project-model/domain/patient/repository"
+	patientsecurity "pharmacy-modernization-project-model/domain/patient/security"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/dates"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/validators/validation_logic"
+)
+
+// ImportConfig controls patient CSV imports
+type ImportConfig struct {
+	// MaxFileBytes is the largest CSV file accepted
+	MaxFileBytes int64
+	// MaxRows is the most patients one file may hold
+	MaxRows int
+	// JobTTL is how long an upload is kept, waiting for its mapping or showing its result
+	JobTTL time.Duration
+}
+
+const (
+	importPreviewRows = 5   // Rows shown next to the column mapping
+	importMaxErrors   = 200 // Row errors kept for display; the counts cover every row
+)
+
+// importHeaderAliases are the normalized CSV headers mapped to a field when a file is uploaded
+var importHeaderAliases = map[string][]string{
+	m.ImportFieldID:    {"id", "patientid", "mrn", "externalid"},
+	m.ImportFieldName:  {"name", "fullname", "patientname", "patient"},
+	m.ImportFieldDOB:   {"dob", "dateofbirth", "birthdate", "birthday"},
+	m.ImportFieldPhone: {"phone", "phonenumber", "telephone", "mobile"},
+	m.ImportFieldState: {"state", "st"},
+}
+
+type PatientImportService interface {
+	// Upload reads a CSV file with a header row and keeps it for the current user until it is
+	// imported or expires. Columns whose header names a patient field are mapped already.
+	Upload(ctx context.Context, fileName string, r io.Reader) (m.PatientImportJob, error)
+	// GetJob returns an import uploaded by the current user
+	GetJob(ctx context.Context, jobID string) (m.PatientImportJob, error)
+	// Validate checks every row with the mapping without saving anything (dry run)
+	Validate(ctx context.Context, jobID string, mapping m.PatientImportMapping) (m.PatientImportJob, error)
+	// Start imports the rows in the background; rows with errors are skipped and reported
+	Start(ctx context.Context, jobID string, mapping m.PatientImportMapping) (m.PatientImportJob, error)
+
+	ListTemplates(ctx context.Context) ([]m.PatientImportTemplate, error)
+	GetTemplate(ctx context.Context, id string) (m.PatientImportTemplate, error)
+	// SaveTemplate saves a mapping under a name, replacing the template of that name
+	SaveTemplate(ctx context.Context, name string, mapping m.PatientImportMapping) (m.PatientImportTemplate, error)
+}
+
+// importUpload is a job with the rows of its file
+type importUpload struct {
+	job  *m.PatientImportJob
+	rows []importRow
+}
+
+// importRow is a non-blank line of the file, padded to the header width
+type importRow struct {
+	line   int // As counted by a spreadsheet, the header being line 1
+	values []string
+}
+
+type patientImportSvc struct {
+	patients  PatientService
+	templates repo.ImportTemplateRepository
+	cfg       ImportConfig
+	log       *zap.Logger
+
+	mu   sync.Mutex
+	jobs map[string]*importUpload
+}
+
+func NewPatientImportService(patients PatientService, templates repo.ImportTemplateRepository, cfg ImportConfig, l *zap.Logger) PatientImportService {
+	if cfg.MaxFileBytes <= 0 {
+		cfg.MaxFileBytes = 5 << 20
+	}
+	if cfg.MaxRows <= 0 {
+		cfg.MaxRows = 5000
+	}
+	if cfg.JobTTL <= 0 {
+		cfg.JobTTL = time.Hour
+	}
+	return &patientImportSvc{patients: patients, templates: templates, cfg: cfg, log: l, jobs: map[string]*importUpload{}}
+}
+
+func (s *patientImportSvc) Upload(ctx context.Context, fileName string, r io.Reader) (m.PatientImportJob, error) {
+	s.purgeExpired()
+
+	data, err := io.ReadAll(io.LimitReader(r, s.cfg.MaxFileBytes+1))
+	if err != nil {
+		return m.PatientImportJob{}, fmt.Errorf("read import file: %w", err)
+	}
+	if int64(len(data)) > s.cfg.MaxFileBytes {
+		return m.PatientImportJob{}, platformErrors.NewValidationError("file", fileName,
+			fmt.Sprintf("file is larger than %d KB", s.cfg.MaxFileBytes>>10))
+	}
+
+	headers, rows, err := s.parseCSV(data)
+	if err != nil {
+		return m.PatientImportJob{}, platformErrors.NewValidationError("file", fileName, err.Error())
+	}
+
+	now := time.Now()
+	job := &m.PatientImportJob{
+		ID:        uuid.NewString(),
+		Status:    m.ImportJobUploaded,
+		FileName:  fileName,
+		Headers:   headers,
+		Preview:   previewRows(rows),
+		Mapping:   suggestMapping(headers),
+		TotalRows: len(rows),
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.cfg.JobTTL),
+		OwnerID:   ownerID(ctx),
+	}
+
+	s.mu.Lock()
+	s.jobs[job.ID] = &importUpload{job: job, rows: rows}
+	snapshot := cloneImportJob(job)
+	s.mu.Unlock()
+
+	s.log.Info("Patient import uploaded", zap.String("job_id", job.ID), zap.Int("rows", len(rows)))
+	return snapshot, nil
+}
+
+// parseCSV returns the trimmed headers and the non-blank rows
+func (s *patientImportSvc) parseCSV(data []byte) ([]string, []importRow, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xEF\xBB\xBF"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, fmt.Errorf("file is empty")
+	}
+	if err != nil {
+		return nil, nil, csvError(err)
+	}
+
+	headers := make([]string, len(header))
+	for i, header := range header {
+		headers[i] = strings.TrimSpace(header)
+		if headers[i] == "" {
+			headers[i] = fmt.Sprintf("Column %d", i+1)
+		}
+		if slices.Contains(headers[:i], headers[i]) {
+			return nil, nil, fmt.Errorf("header %q appears more than once", headers[i])
+		}
+	}
+
+	rows := []importRow{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, csvError(err)
+		}
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+		if len(rows) == s.cfg.MaxRows {
+			return nil, nil, fmt.Errorf("file has more than %d patients; at most %d can be imported at once", s.cfg.MaxRows, s.cfg.MaxRows)
+		}
+		line, _ := reader.FieldPos(0)
+		row := importRow{line: line, values: make([]string, len(headers))}
+		copy(row.values, record)
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 {
+		return nil, nil, fmt.Errorf("file has a header row but no patients")
+	}
+	return headers, rows, nil
+}
+
+func csvError(err error) error {
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return fmt.Errorf("not a valid CSV file: line %d: %v", parseErr.Line, parseErr.Err)
+	}
+	return fmt.Errorf("not a valid CSV file")
+}
+
+func previewRows(rows []importRow) [][]string {
+	preview := make([][]string, 0, importPreviewRows)
+	for _, row := range rows[:min(len(rows), importPreviewRows)] {
+		preview = append(preview, row.values)
+	}
+	return preview
+}
+
+func (s *patientImportSvc) GetJob(ctx context.Context, jobID string) (m.PatientImportJob, error) {
+	s.purgeExpired()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	upload, err := s.upload(ctx, jobID)
+	if err != nil {
+		return m.PatientImportJob{}, err
+	}
+	return cloneImportJob(upload.job), nil
+}
+
+// upload returns the caller's upload; s.mu must be held. Other users' imports are reported as
+// missing rather than forbidden.
+func (s *patientImportSvc) upload(ctx context.Context, jobID string) (*importUpload, error) {
+	upload, ok := s.jobs[jobID]
+	if !ok || upload.job.OwnerID != ownerID(ctx) {
+		return nil, platformErrors.NewRecordNotFoundError("import", jobID)
+	}
+	return upload, nil
+}
+
+func (s *patientImportSvc) Validate(ctx context.Context, jobID string, mapping m.PatientImportMapping) (m.PatientImportJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	upload, err := s.upload(ctx, jobID)
+	if err != nil {
+		return m.PatientImportJob{}, err
+	}
+	if upload.job.Status != m.ImportJobUploaded {
+		return m.PatientImportJob{}, platformErrors.NewConflictError("import", jobID, "import is "+upload.job.Status)
+	}
+	columns, err := resolveMapping(upload.job.Headers, mapping)
+	if err != nil {
+		return m.PatientImportJob{}, err
+	}
+
+	rows := newImportRows(columns, patientsecurity.AllowedStates(currentUser(ctx)))
+	validation := &m.PatientImportValidation{Rows: len(upload.rows), Errors: []m.PatientImportRowError{}}
+	for _, row := range upload.rows {
+		if _, rowErrors := rows.parse(row); len(rowErrors) > 0 {
+			validation.InvalidRows++
+			validation.Errors = appendRowErrors(validation.Errors, rowErrors)
+			continue
+		}
+		validation.ValidRows++
+	}
+
+	upload.job.Mapping = mapping
+	upload.job.Validation = validation
+	return cloneImportJob(upload.job), nil
+}
+
+func (s *patientImportSvc) Start(ctx context.Context, jobID string, mapping m.PatientImportMapping) (m.PatientImportJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	upload, err := s.upload(ctx, jobID)
+	if err != nil {
+		return m.PatientImportJob{}, err
+	}
+	if upload.job.Status != m.ImportJobUploaded {
+		return m.PatientImportJob{}, platformErrors.NewConflictError("import", jobID, "import is "+upload.job.Status)
+	}
+	columns, err := resolveMapping(upload.job.Headers, mapping)
+	if err != nil {
+		return m.PatientImportJob{}, err
+	}
+
+	now := time.Now()
+	upload.job.Status = m.ImportJobRunning
+	upload.job.Mapping = mapping
+	upload.job.StartedAt = &now
+
+	// Data access is resolved now, while the request's user is still known. The import outlives
+	// the request, so it must not inherit its cancellation or timeout.
+	rows := newImportRows(columns, patientsecurity.AllowedStates(currentUser(ctx)))
+	go s.run(context.WithoutCancel(ctx), upload, rows)
+
+	s.log.Info("Patient import started", zap.String("job_id", jobID), zap.Int("rows", len(upload.rows)))
+	return cloneImportJob(upload.job), nil
+}
+
+// run creates a patient per valid row, recording progress after each row
+func (s *patientImportSvc) run(ctx context.Context, upload *importUpload, rows *importRows) {
+	job := upload.job
+	for _, row := range upload.rows {
+		patient, rowErrors := rows.parse(row)
+		if len(rowErrors) == 0 {
+			if _, err := s.patients.Create(ctx, patient); err != nil {
+				s.log.Warn("Failed to import patient row", zap.String("job_id", job.ID), zap.Int("line", row.line), zap.Error(err))
+				rowErrors = []m.PatientImportRowError{{Line: row.line, Message: createErrorMessage(err)}}
+			}
+		}
+
+		s.mu.Lock()
+		job.Processed++
+		if len(rowErrors) > 0 {
+			job.Failed++
+			job.Errors = appendRowErrors(job.Errors, rowErrors)
+		} else {
+			job.Created++
+		}
+		s.mu.Unlock()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	job.Status = m.ImportJobCompleted
+	job.CompletedAt = &now
+	job.ExpiresAt = now.Add(s.cfg.JobTTL)
+	upload.rows = nil
+	s.log.Info("Patient import completed", zap.String("job_id", job.ID), zap.Int("created", job.Created), zap.Int("failed", job.Failed))
+}
+
+func (s *patientImportSvc) ListTemplates(ctx context.Context) ([]m.PatientImportTemplate, error) {
+	return s.templates.List(ctx)
+}
+
+func (s *patientImportSvc) GetTemplate(ctx context.Context, id string) (m.PatientImportTemplate, error) {
+	return s.templates.GetByID(ctx, id)
+}
+
+func (s *patientImportSvc) SaveTemplate(ctx context.Context, name string, mapping m.PatientImportMapping) (m.PatientImportTemplate, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return m.PatientImportTemplate{}, platformErrors.NewValidationError("templateName", name, "template name is required")
+	}
+	return s.templates.Save(ctx, m.PatientImportTemplate{
+		ID:        uuid.NewString(),
+		Name:      name,
+		Mapping:   mapping,
+		UpdatedBy: ownerID(ctx),
+		UpdatedAt: time.Now(),
+	})
+}
+
+// purgeExpired forgets imports past their TTL; running imports are kept until they finish
+func (s *patientImportSvc) purgeExpired() {
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, upload := range s.jobs {
+		if upload.job.Status != m.ImportJobRunning && now.After(upload.job.ExpiresAt) {
+			delete(s.jobs, id)
+		}
+	}
+}
+
+// resolveMapping returns the column index of each mapped field. Every required field must be
+// mapped, to a header of the file, and no column may feed two fields.
+func resolveMapping(headers []string, mapping m.PatientImportMapping) (map[string]int, error) {
+	columns := map[string]int{}
+	used := map[int]string{}
+	for _, field := range m.PatientImportFields {
+		header, ok := mapping[field.Key]
+		if !ok || header == "" {
+			if field.Required {
+				return nil, platformErrors.NewValidationError(field.Key, "", field.Label+" must be mapped to a column")
+			}
+			continue
+		}
+		column := slices.Index(headers, header)
+		if column < 0 {
+			return nil, platformErrors.NewValidationError(field.Key, header, fmt.Sprintf("the file has no column %q", header))
+		}
+		if other, taken := used[column]; taken {
+			return nil, platformErrors.NewValidationError(field.Key, header, fmt.Sprintf("column %q is already mapped to %s", header, other))
+		}
+		used[column] = field.Label
+		columns[field.Key] = column
+	}
+	return columns, nil
+}
+
+// suggestMapping maps the columns whose header names a patient field
+func suggestMapping(headers []string) m.PatientImportMapping {
+	mapping := m.PatientImportMapping{}
+	for _, header := range headers {
+		normalized := strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				return unicode.ToLower(r)
+			}
+			return -1
+		}, header)
+		for field, aliases := range importHeaderAliases {
+			if _, mapped := mapping[field]; !mapped && slices.Contains(aliases, normalized) {
+				mapping[field] = header
+			}
+		}
+	}
+	return mapping
+}
+
+// importRows turns rows into patients, remembering IDs and phone numbers already seen so that
+// duplicates within the file are reported
+type importRows struct {
+	columns       map[string]int
+	allowedStates []string
+	ids           map[string]int
+	phones        map[string]int
+}
+
+func newImportRows(columns map[string]int, allowedStates []string) *importRows {
+	return &importRows{columns: columns, allowedStates: allowedStates, ids: map[string]int{}, phones: map[string]int{}}
+}
+
+// parse validates a row with the rules of the patient edit form
+func (r *importRows) parse(row importRow) (m.Patient, []m.PatientImportRowError) {
+	line := row.line
+	value := func(field string) string {
+		column, ok := r.columns[field]
+		if !ok {
+			return ""
+		}
+		return strings.TrimSpace(row.values[column])
+	}
+	var rowErrors []m.PatientImportRowError
+	check := func(field string, err error) {
+		var fieldErr *validation_logic.FieldError
+		if errors.As(err, &fieldErr) {
+			rowErrors = append(rowErrors, m.PatientImportRowError{Line: line, Field: field, Message: fieldErr.Error()})
+		}
+	}
+
+	patient := m.Patient{
+		ID:    value(m.ImportFieldID),
+		Name:  value(m.ImportFieldName),
+		Phone: value(m.ImportFieldPhone),
+		State: strings.ToUpper(value(m.ImportFieldState)),
+	}
+
+	if _, mapped := r.columns[m.ImportFieldID]; mapped {
+		check(m.ImportFieldID, validation_logic.ValidateID(m.ImportFieldID, patient.ID))
+	}
+	if err := validation_logic.ValidateRequired(m.ImportFieldName, patient.Name); err != nil {
+		check(m.ImportFieldName, err)
+	} else {
+		check(m.ImportFieldName, validation_logic.ValidateLength(m.ImportFieldName, patient.Name, 2, 100))
+	}
+	if dob := value(m.ImportFieldDOB); dob == "" {
+		check(m.ImportFieldDOB, validation_logic.ValidateRequired(m.ImportFieldDOB, dob))
+	} else if err := validation_logic.ValidateDOBValue(m.ImportFieldDOB, dob); err != nil {
+		check(m.ImportFieldDOB, err)
+	} else {
+		patient.DOB, _ = dates.Parse(dob)
+	}
+	check(m.ImportFieldPhone, validation_logic.ValidatePhone(m.ImportFieldPhone, patient.Phone))
+	switch {
+	case len(patient.State) != 2 || strings.IndexFunc(patient.State, func(c rune) bool { return c < 'A' || c > 'Z' }) >= 0:
+		rowErrors = append(rowErrors, m.PatientImportRowError{Line: line, Field: m.ImportFieldState, Message: "must be a two-letter state code"})
+	case r.allowedStates != nil && !slices.Contains(r.allowedStates, patient.State):
+		rowErrors = append(rowErrors, m.PatientImportRowError{Line: line, Field: m.ImportFieldState, Message: "you cannot add patients in " + patient.State})
+	}
+	if len(rowErrors) > 0 {
+		return m.Patient{}, rowErrors
+	}
+
+	if first, seen := r.ids[patient.ID]; seen && patient.ID != "" {
+		return m.Patient{}, []m.PatientImportRowError{{Line: line, Field: m.ImportFieldID, Message: fmt.Sprintf("same patient ID as line %d", first)}}
+	}
+	digits := strings.Map(func(c rune) rune {
+		if unicode.IsDigit(c) {
+			return c
+		}
+		return -1
+	}, patient.Phone)
+	if first, seen := r.phones[digits]; seen {
+		return m.Patient{}, []m.PatientImportRowError{{Line: line, Field: m.ImportFieldPhone, Message: fmt.Sprintf("same phone number as line %d", first)}}
+	}
+	if patient.ID != "" {
+		r.ids[patient.ID] = line
+	}
+	r.phones[digits] = line
+	return patient, nil
+}
+
+// appendRowErrors keeps at most importMaxErrors errors
+func appendRowErrors(errs, rowErrors []m.PatientImportRowError) []m.PatientImportRowError {
+	room := importMaxErrors - len(errs)
+	if room <= 0 {
+		return errs
+	}
+	return append(errs, rowErrors[:min(room, len(rowErrors))]...)
+}
+
+// createErrorMessage describes why saving a valid row failed without exposing internals
+func createErrorMessage(err error) string {
+	var dupErr platformErrors.DuplicateRecordError
+	if errors.As(err, &dupErr) {
+		return "a patient with this ID or phone number already exists"
+	}
+	var likelyDupErr patientErrors.DuplicatePatientError
+	if errors.As(err, &likelyDupErr) {
+		return "likely duplicate of an existing patient (same phone number, or same DOB and a similar name)"
+	}
+	return "patient could not be saved"
+}
+
+func cloneImportJob(job *m.PatientImportJob) m.PatientImportJob {
+	clone := *job
+	clone.Mapping = m.PatientImportMapping{}
+	for field, header := range job.Mapping {
+		clone.Mapping[field] = header
+	}
+	clone.Errors = slices.Clone(job.Errors)
+	if job.Validation != nil {
+		validation := *job.Validation
+		clone.Validation = &validation
+	}
+	return clone
+}
+
+func currentUser(ctx context.Context) *auth.User {
+	user, _ := auth.GetCurrentUser(ctx)
+	return user
+}
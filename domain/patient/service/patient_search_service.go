@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+
+	"go.uber.org/zap"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	patientRequest "pharmacy-modernization-project-model/domain/patient/contracts/request"
+	patientErrors "pharmacy-modernization-project-model/domain/patient/errors"
+	patientrepo "pharmacy-modernization-project-model/domain/patient/repository"
+)
+
+const (
+	defaultSearchLimit = 10
+	// fuzzyCandidateLimit caps how many prefix candidates are scored for typo tolerance
+	fuzzyCandidateLimit = 200
+	// fuzzyPrefixLength is how many leading characters of a term must be typed correctly
+	// for the typo-tolerant fallback to consider a patient
+	fuzzyPrefixLength = 2
+)
+
+// searchField is a searchable patient field; weights mirror the MongoDB text index weights
+type searchField struct {
+	name   string
+	weight float64
+	values func(c patientModel.PatientSearchCandidate) []string
+}
+
+var searchFields = []searchField{
+	{"name", 10, func(c patientModel.PatientSearchCandidate) []string { return []string{c.Patient.Name} }},
+	{"phone", 5, func(c patientModel.PatientSearchCandidate) []string { return []string{c.Patient.Phone} }},
+	{"state", 2, func(c patientModel.PatientSearchCandidate) []string { return []string{c.Patient.State} }},
+	{"address.city", 3, func(c patientModel.PatientSearchCandidate) []string {
+		values := make([]string, len(c.Addresses))
+		for i, a := range c.Addresses {
+			values[i] = a.City
+		}
+		return values
+	}},
+	{"address.zip", 5, func(c patientModel.PatientSearchCandidate) []string {
+		values := make([]string, len(c.Addresses))
+		for i, a := range c.Addresses {
+			values[i] = a.Zip
+		}
+		return values
+	}},
+}
+
+type PatientSearchService interface {
+	// Search returns full-text matches ranked by relevance, followed by typo-tolerant
+	// matches when there are fewer text matches than the limit
+	Search(ctx context.Context, req patientRequest.PatientSearchQueryRequest) ([]patientModel.PatientSearchResult, error)
+}
+
+type patientSearchSvc struct {
+	repo patientrepo.PatientSearchRepository
+	log  *zap.Logger
+}
+
+func NewPatientSearchService(r patientrepo.PatientSearchRepository, l *zap.Logger) PatientSearchService {
+	return &patientSearchSvc{repo: r, log: l}
+}
+
+func (s *patientSearchSvc) Search(ctx context.Context, req patientRequest.PatientSearchQueryRequest) ([]patientModel.PatientSearchResult, error) {
+	terms := req.Terms()
+	if len(terms) == 0 {
+		return nil, patientErrors.NewValidationError("q", req.Q, "query must contain letters or digits")
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+
+	results := []patientModel.PatientSearchResult{}
+	seen := map[string]bool{}
+
+	textHits, err := s.repo.TextSearch(ctx, terms, limit)
+	if err != nil {
+		// A missing text index should degrade search, not break it
+		s.log.Warn("text search failed, using typo-tolerant matching only", zap.Error(err))
+		textHits = nil
+	}
+	for _, c := range textHits {
+		matches, _ := matchCandidate(c, terms, false)
+		results = append(results, patientModel.PatientSearchResult{
+			Patient:   c.Patient,
+			Score:     c.Score,
+			MatchType: patientModel.SearchMatchText,
+			Matches:   matches,
+		})
+		seen[c.Patient.ID] = true
+	}
+
+	if len(results) >= limit {
+		return results, nil
+	}
+
+	candidates, err := s.repo.PrefixCandidates(ctx, fuzzyPrefixes(terms), fuzzyCandidateLimit)
+	if err != nil {
+		if len(results) > 0 {
+			s.log.Warn("typo-tolerant search failed, returning text matches only", zap.Error(err))
+			return results, nil
+		}
+		return nil, err
+	}
+
+	var fuzzy []patientModel.PatientSearchResult
+	for _, c := range candidates {
+		if seen[c.Patient.ID] {
+			continue
+		}
+		matches, score := matchCandidate(c, terms, true)
+		if score == 0 {
+			continue
+		}
+		fuzzy = append(fuzzy, patientModel.PatientSearchResult{
+			Patient:   c.Patient,
+			Score:     score,
+			MatchType: patientModel.SearchMatchFuzzy,
+			Matches:   matches,
+		})
+	}
+	sort.SliceStable(fuzzy, func(i, j int) bool { return fuzzy[i].Score > fuzzy[j].Score })
+
+	for _, r := range fuzzy {
+		if len(results) == limit {
+			break
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+func fuzzyPrefixes(terms []string) []string {
+	prefixes := make([]string, 0, len(terms))
+	for _, term := range terms {
+		runes := []rune(term)
+		if len(runes) > fuzzyPrefixLength {
+			runes = runes[:fuzzyPrefixLength]
+		}
+		prefixes = append(prefixes, string(runes))
+	}
+	return prefixes
+}
+
+// matchCandidate highlights the words matching any term in each field. In fuzzy mode every
+// term must match some field and the score rewards close matches in heavily weighted fields;
+// otherwise the score is zero and the candidate is dropped.
+func matchCandidate(c patientModel.PatientSearchCandidate, terms []string, fuzzy bool) ([]patientModel.PatientSearchMatch, float64) {
+	matches := []patientModel.PatientSearchMatch{}
+	best := make(map[string]float64, len(terms))
+
+	for _, field := range searchFields {
+		seen := map[string]bool{}
+		for _, value := range field.values(c) {
+			if value == "" || seen[value] {
+				continue
+			}
+			seen[value] = true
+
+			highlighted, matched := highlight(value, func(word string) bool {
+				hit := false
+				for _, term := range terms {
+					if distance, ok := wordMatch(word, term, fuzzy); ok {
+						hit = true
+						similarity := field.weight * (1 - float64(distance)/float64(len([]rune(term))+1))
+						if similarity > best[term] {
+							best[term] = similarity
+						}
+					}
+				}
+				return hit
+			})
+			if matched {
+				matches = append(matches, patientModel.PatientSearchMatch{
+					Field:       field.name,
+					Value:       value,
+					Highlighted: highlighted,
+				})
+			}
+		}
+	}
+
+	score := 0.0
+	for _, term := range terms {
+		if fuzzy && best[term] == 0 {
+			return matches, 0
+		}
+		score += best[term]
+	}
+	return matches, score
+}
+
+// wordMatch reports whether a word matches a term and at what edit distance. Exact matches
+// always count; in fuzzy mode a word starting with the term (3+ characters) counts as distance 1,
+// and longer terms tolerate one typo (4-6 characters) or two (7+).
+func wordMatch(word, term string, fuzzy bool) (int, bool) {
+	if word == term {
+		return 0, true
+	}
+	if !fuzzy {
+		return 0, false
+	}
+
+	termLen := len([]rune(term))
+	if termLen >= 3 && strings.HasPrefix(word, term) {
+		return 1, true
+	}
+
+	allowed := 0
+	switch {
+	case termLen >= 7:
+		allowed = 2
+	case termLen >= 4:
+		allowed = 1
+	}
+	if allowed == 0 {
+		return 0, false
+	}
+	if d := editDistance([]rune(word), []rune(term), allowed); d <= allowed {
+		return d, true
+	}
+	return 0, false
+}
+
+// editDistance is the Levenshtein distance, returning max+1 early once it is exceeded
+func editDistance(a, b []rune, max int) int {
+	if diff := len(a) - len(b); diff > max || -diff > max {
+		return max + 1
+	}
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			rowMin = min(rowMin, curr[j])
+		}
+		if rowMin > max {
+			return max + 1
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// highlight HTML-escapes value and wraps each word accepted by match in <mark>
+func highlight(value string, match func(word string) bool) (string, bool) {
+	var sb strings.Builder
+	matched := false
+	runes := []rune(value)
+	isWord := func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }
+
+	for i := 0; i < len(runes); {
+		j := i
+		word := isWord(runes[i])
+		for j < len(runes) && isWord(runes[j]) == word {
+			j++
+		}
+		chunk := string(runes[i:j])
+		if word && match(strings.ToLower(chunk)) {
+			matched = true
+			sb.WriteString("<mark>")
+			sb.WriteString(html.EscapeString(chunk))
+			sb.WriteString("</mark>")
+		} else {
+			sb.WriteString(html.EscapeString(chunk))
+		}
+		i = j
+	}
+	return sb.String(), matched
+}
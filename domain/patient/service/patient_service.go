@@ -2,44 +2,111 @@ package service
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	m "pharmacy-modernization-project-model/domain/patient/contracts/model"
 	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+	patientErrors "pharmacy-modernization-project-model/domain/patient/errors"
 	repo "pharmacy-modernization-project-model/domain/patient/repository"
 	"pharmacy-modernization-project-model/internal/platform/cache"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/events"
+	"pharmacy-modernization-project-model/internal/platform/tenancy"
 )
 
 type PatientService interface {
 	List(ctx context.Context, req request.PatientListQueryRequest) ([]m.Patient, error)
 	GetByID(ctx context.Context, id string) (m.Patient, error)
+	// Create normalizes and saves a new patient. Existing patients it likely duplicates are
+	// returned in its PotentialDuplicates, or block it with a DuplicatePatientError, as the
+	// DuplicateConfig sets.
 	Create(ctx context.Context, patient m.Patient) (m.Patient, error)
 	Update(ctx context.Context, patient m.Patient) error
 	Count(ctx context.Context, req request.PatientListQueryRequest) (int, error)
+	// CountByState returns the number of patients in each state, limited to allowedStates when
+	// it is not empty
+	CountByState(ctx context.Context, allowedStates []string) ([]m.StateCount, error)
+	// AttachPrescriptionCounts fills in the prescription counts of a page of patients with one query
+	AttachPrescriptionCounts(ctx context.Context, patients []m.Patient) error
+	// OnUpdated registers a handler called after a patient update is saved
+	OnUpdated(handler UpdateHandler)
+	// CacheWarmupTasks loads the most recently updated patients and returns a task caching each
+	CacheWarmupTasks(ctx context.Context, limit int) ([]cache.WarmupTask, error)
 }
 
+// UpdateHandler reacts to a patient being updated; it runs after the update is saved
+type UpdateHandler func(ctx context.Context, patient m.Patient)
+
+const patientCacheTTL = 30 * time.Minute
+
+// countByStateCacheTTL is short, as the dashboard shows the counts by state
+const countByStateCacheTTL = time.Minute
+
+// activePrescriptionStatus is the prescription status the HasActivePrescriptions filter looks for
+const activePrescriptionStatus = "Active"
+
 type patientSvc struct {
-	repo      repo.PatientRepository
-	cache     cache.Cache
-	cacheKeys *CacheKeys
-	log       *zap.Logger
+	repo            repo.PatientRepository
+	counts          repo.PrescriptionCountRepository
+	cache           cache.Cache
+	patientCache    *cache.TypedCache[m.Patient]
+	countCache      *cache.TypedCache[int]
+	stateCountCache *cache.TypedCache[[]m.StateCount]
+	cacheKeys       *CacheKeys
+	duplicates      DuplicateConfig
+	publisher       events.Publisher
+	log             *zap.Logger
+	onUpdated       []UpdateHandler
 }
 
-func New(r repo.PatientRepository, c cache.Cache, l *zap.Logger) PatientService {
+// New creates the patient service. The loader reads patients through the cache; without one the
+// cache is read and written directly. The serializer encodes the cached values. Creations and
+// updates are published as events.
+func New(r repo.PatientRepository, counts repo.PrescriptionCountRepository, c cache.Cache, loader *cache.Loader, serializer *cache.Serializer, duplicates DuplicateConfig, publisher events.Publisher, l *zap.Logger) PatientService {
+	if duplicates.Mode == "" {
+		duplicates.Mode = DuplicateModeWarn
+	}
 	return &patientSvc{
-		repo:      r,
-		cache:     c,
-		cacheKeys: NewCacheKeys(),
-		log:       l,
+		repo:            r,
+		counts:          counts,
+		cache:           c,
+		patientCache:    cache.NewTypedCache[m.Patient](c, loader, serializer),
+		countCache:      cache.NewTypedCache[int](c, loader, serializer),
+		stateCountCache: cache.NewTypedCache[[]m.StateCount](c, loader, serializer),
+		cacheKeys:       NewCacheKeys(),
+		duplicates:      duplicates,
+		publisher:       publisher,
+		log:             l,
 	}
 }
 
 func (s *patientSvc) Create(ctx context.Context, patient m.Patient) (m.Patient, error) {
 	s.log.Info("Creating patient")
 
+	patient = normalizePatient(patient)
+	if patient.ID == "" {
+		patient.ID = uuid.NewString()
+	}
+
+	duplicates, err := s.findDuplicates(ctx, patient)
+	if err != nil {
+		s.log.Error("Failed to check for duplicate patients",
+			zap.Error(err))
+		return m.Patient{}, err
+	}
+	if len(duplicates) > 0 && s.duplicates.Mode == DuplicateModeBlock {
+		s.log.Warn("Patient creation blocked by likely duplicates",
+			zap.Int("duplicates", len(duplicates)))
+		return m.Patient{}, patientErrors.NewDuplicatePatientError("CreatePatient", duplicates)
+	}
+
 	// Set creation tracking fields
 	now := time.Now()
 	patient.CreatedAt = now
@@ -53,25 +120,54 @@ func (s *patientSvc) Create(ctx context.Context, patient m.Patient) (m.Patient,
 	}
 
 	s.log.Info("Patient created successfully")
+	if len(duplicates) > 0 {
+		s.log.Warn("Patient created with likely duplicates",
+			zap.String("patient_id", createdPatient.ID),
+			zap.Int("duplicates", len(duplicates)))
+		createdPatient.PotentialDuplicates = duplicates
+	}
 
+	// The ID may have been looked up before it existed
+	if err := s.patientCache.Forget(ctx, s.cacheKeys.PatientByID(createdPatient.ID)); err != nil {
+		s.log.Warn("Failed to clear cached patient lookup", zap.Error(err))
+	}
+
+	s.publisher.Publish(ctx, events.PatientCreated{PatientID: createdPatient.ID, CreatedAt: createdPatient.CreatedAt})
 	return createdPatient, nil
 }
 func (s *patientSvc) List(ctx context.Context, req request.PatientListQueryRequest) ([]m.Patient, error) {
+	req, err := s.withActivePrescriptions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
 	return s.repo.List(ctx, req)
 }
 
+// withActivePrescriptions looks up the patients with an active prescription when req filters by them
+func (s *patientSvc) withActivePrescriptions(ctx context.Context, req request.PatientListQueryRequest) (request.PatientListQueryRequest, error) {
+	if req.HasActivePrescriptions == nil || s.counts == nil {
+		return req, nil
+	}
+	ids, err := s.counts.PatientIDsWithStatus(ctx, activePrescriptionStatus)
+	if err != nil {
+		s.log.Error("Failed to find patients with active prescriptions", zap.Error(err))
+		return req, err
+	}
+	req.ActivePrescriptionPatientIDs = ids
+	return req, nil
+}
+
 func (s *patientSvc) GetByID(ctx context.Context, id string) (m.Patient, error) {
+	// An organization may not see a patient another one loaded, so only unscoped reads share loads
+	if _, scoped := tenancy.OrgID(ctx); !scoped {
+		return s.loadByID(ctx, id)
+	}
 	cacheKey := s.cacheKeys.PatientByID(id)
 
-	// Try cache first
-	if s.cache != nil {
-		if cached, err := s.cache.Get(ctx, cacheKey); err == nil {
-			var patient m.Patient
-			if err := json.Unmarshal(cached, &patient); err == nil {
-				s.log.Debug("Patient retrieved from cache")
-				return patient, nil
-			}
-		}
+	// Try cache first; entries are shared by all organizations, the repository decides for other ones
+	if patient, err := s.patientCache.Get(ctx, cacheKey); err == nil && tenancy.Visible(ctx, patient.OrgID) {
+		s.log.Debug("Patient retrieved from cache")
+		return patient, nil
 	}
 
 	s.log.Info("Getting patient from repository")
@@ -84,18 +180,61 @@ func (s *patientSvc) GetByID(ctx context.Context, id string) (m.Patient, error)
 	}
 
 	// Cache the result
-	if s.cache != nil {
-		if data, err := json.Marshal(patient); err == nil {
-			if err := s.cache.Set(ctx, cacheKey, data, 30*time.Minute); err != nil {
-				s.log.Warn("Failed to cache patient", zap.Error(err))
-			}
-		}
+	if err := s.cachePatient(ctx, patient); err != nil {
+		s.log.Warn("Failed to cache patient", zap.Error(err))
 	}
 
 	s.log.Info("Patient retrieved successfully")
 	return patient, nil
 }
 
+// loadByID reads the patient through the loader, so repeated lookups of a missing ID and
+// concurrent lookups of the same ID reach the repository once
+func (s *patientSvc) loadByID(ctx context.Context, id string) (m.Patient, error) {
+	patient, err := s.patientCache.Load(ctx, s.cacheKeys.PatientByID(id), patientCacheTTL, func(ctx context.Context) (m.Patient, error) {
+		s.log.Info("Getting patient from repository")
+		patient, err := s.repo.GetByID(ctx, id)
+		if err == nil && patient.ID == "" {
+			err = platformErrors.NewRecordNotFoundError("Patient", id)
+		}
+		return patient, err
+	})
+	if errors.Is(err, cache.ErrCachedNotFound) {
+		s.log.Debug("Patient not found, from cache")
+		return m.Patient{}, platformErrors.NewRecordNotFoundError("Patient", id)
+	}
+	if err != nil {
+		if !platformErrors.IsNotFoundError(err) {
+			s.log.Error("Failed to get patient",
+				zap.Error(err))
+		}
+		return m.Patient{}, err
+	}
+	return patient, nil
+}
+
+func (s *patientSvc) cachePatient(ctx context.Context, patient m.Patient) error {
+	return s.patientCache.Set(ctx, s.cacheKeys.PatientByID(patient.ID), patient, patientCacheTTL)
+}
+
+func (s *patientSvc) CacheWarmupTasks(ctx context.Context, limit int) ([]cache.WarmupTask, error) {
+	if s.cache == nil || limit <= 0 {
+		return nil, nil
+	}
+	patients, err := s.repo.ListRecentlyUpdated(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]cache.WarmupTask, 0, len(patients))
+	for _, patient := range patients {
+		tasks = append(tasks, func(ctx context.Context) error {
+			return s.cachePatient(ctx, patient)
+		})
+	}
+	return tasks, nil
+}
+
 func (s *patientSvc) Update(ctx context.Context, patient m.Patient) error {
 	s.log.Info("Updating patient")
 
@@ -112,45 +251,105 @@ func (s *patientSvc) Update(ctx context.Context, patient m.Patient) error {
 	}
 
 	// Invalidate cache for this patient
-	if s.cache != nil {
-		cacheKey := s.cacheKeys.PatientByID(patient.ID)
-		if err := s.cache.Delete(ctx, cacheKey); err != nil {
-			s.log.Warn("Failed to invalidate patient cache",
-				zap.Error(err))
-		}
+	if err := s.patientCache.Delete(ctx, s.cacheKeys.PatientByID(patient.ID)); err != nil {
+		s.log.Warn("Failed to invalidate patient cache",
+			zap.Error(err))
 	}
 
 	s.log.Info("Patient updated successfully")
+
+	s.publisher.Publish(ctx, events.PatientUpdated{PatientID: patient.ID, UpdatedAt: now})
+
+	for _, handler := range s.onUpdated {
+		handler(ctx, patient)
+	}
 	return nil
 }
 
+func (s *patientSvc) OnUpdated(handler UpdateHandler) {
+	s.onUpdated = append(s.onUpdated, handler)
+}
+
 func (s *patientSvc) Count(ctx context.Context, req request.PatientListQueryRequest) (int, error) {
-	cacheKey := s.cacheKeys.PatientCount(req.PatientName)
-
-	// Try cache first
-	if s.cache != nil {
-		if cached, err := s.cache.Get(ctx, cacheKey); err == nil {
-			var count int
-			if err := json.Unmarshal(cached, &count); err == nil {
-				s.log.Debug("Patient count retrieved from cache")
-				return count, nil
-			}
+	// The allowed states are part of the scope, so they aren't repeated in the query
+	cacheKey := s.cacheKeys.PatientCount(cache.ScopeOf(ctx, req.AllowedStates), countCacheQuery(req))
+	return s.countCache.Load(ctx, cacheKey, 5*time.Minute, func(ctx context.Context) (int, error) {
+		return s.count(ctx, req)
+	})
+}
+
+func (s *patientSvc) CountByState(ctx context.Context, allowedStates []string) ([]m.StateCount, error) {
+	counts, err := s.countByState(ctx)
+	if err != nil {
+		s.log.Error("Failed to count patients by state", zap.Error(err))
+		return nil, err
+	}
+	// Every state is cached together, so the caller's scope is applied afterwards
+	if len(allowedStates) == 0 {
+		return counts, nil
+	}
+	allowed := make([]m.StateCount, 0, len(allowedStates))
+	for _, count := range counts {
+		if slices.Contains(allowedStates, count.State) {
+			allowed = append(allowed, count)
 		}
 	}
+	return allowed, nil
+}
+
+// countByState reads the counts of every state through the cache
+func (s *patientSvc) countByState(ctx context.Context) ([]m.StateCount, error) {
+	// Every state is counted, so only the organization scopes the entry
+	cacheKey := s.cacheKeys.PatientCountByState(cache.ScopeOf(ctx, nil))
+	return s.stateCountCache.Load(ctx, cacheKey, countByStateCacheTTL, s.repo.CountByState)
+}
 
-	count, err := s.repo.Count(ctx, req)
+// count counts the patients matching req in the repository
+func (s *patientSvc) count(ctx context.Context, req request.PatientListQueryRequest) (int, error) {
+	req, err := s.withActivePrescriptions(ctx, req)
 	if err != nil {
 		return 0, err
 	}
+	return s.repo.Count(ctx, req)
+}
+
+func (s *patientSvc) AttachPrescriptionCounts(ctx context.Context, patients []m.Patient) error {
+	if s.counts == nil || len(patients) == 0 {
+		return nil
+	}
+	ids := make([]string, len(patients))
+	for i, patient := range patients {
+		ids[i] = patient.ID
+	}
 
-	// Cache the result with shorter TTL for counts
-	if s.cache != nil {
-		if data, err := json.Marshal(count); err == nil {
-			if err := s.cache.Set(ctx, cacheKey, data, 5*time.Minute); err != nil {
-				s.log.Warn("Failed to cache patient count", zap.Error(err))
-			}
+	counts, err := s.counts.CountByStatus(ctx, ids)
+	if err != nil {
+		s.log.Error("Failed to count prescriptions of patients", zap.Int("patients", len(ids)), zap.Error(err))
+		return err
+	}
+	for i := range patients {
+		patients[i].PrescriptionCounts = counts[patients[i].ID]
+		if patients[i].PrescriptionCounts == nil {
+			patients[i].PrescriptionCounts = m.PrescriptionCounts{}
 		}
 	}
+	return nil
+}
 
-	return count, nil
+// countCacheQuery identifies the filters of a count in its cache key; an unfiltered count keeps
+// the key that write invalidation clears
+func countCacheQuery(req request.PatientListQueryRequest) string {
+	if req.BirthDate == "" && req.State == "" && !req.FiltersByAge() && req.HasActivePrescriptions == nil {
+		return req.PatientName
+	}
+	return "filtered-" + strings.Join([]string{req.PatientName, req.BirthDate, req.State,
+		optionalFilter(req.MinAge), optionalFilter(req.MaxAge), optionalFilter(req.HasActivePrescriptions)}, "--")
+}
+
+// optionalFilter renders an optional filter value for a cache key, "" when unset
+func optionalFilter[T any](value *T) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(*value)
 }
@@ -0,0 +1,111 @@
+package measurementtrend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/a-h/templ"
+	"go.uber.org/zap"
+
+	patientModel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	patSvc "pharmacy-modernization-project-model/domain/patient/service"
+	contracts "pharmacy-modernization-project-model/domain/patient/ui/contracts"
+)
+
+const (
+	chartWidth   = 320.0
+	chartHeight  = 100.0
+	chartPadding = 8.0
+	maxPoints    = 12
+)
+
+type MeasurementTrendComponent struct {
+	service patSvc.MeasurementService
+	log     *zap.Logger
+}
+
+// Server side component
+func NewMeasurementTrendComponent(deps *contracts.UiDependencies) *MeasurementTrendComponent {
+	return &MeasurementTrendComponent{service: deps.MeasurementSvc, log: deps.Log}
+}
+
+// View renders the weight history of a patient as a small line chart
+func (c *MeasurementTrendComponent) View(ctx context.Context, patientID string) (templ.Component, error) {
+	if patientID == "" {
+		return nil, errors.New("patient id is required")
+	}
+
+	measurements, err := c.service.List(ctx, patientID, patientModel.MeasurementWeight, maxPoints)
+	if err != nil {
+		if c.log != nil {
+			c.log.Error("failed to load patient measurements", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	// Measurements come newest first; the chart reads left to right
+	values := make([]float64, 0, len(measurements))
+	labels := make([]string, 0, len(measurements))
+	for i := len(measurements) - 1; i >= 0; i-- {
+		kg, err := measurements[i].InBaseUnit()
+		if err != nil {
+			continue
+		}
+		values = append(values, kg)
+		labels = append(labels, measurements[i].RecordedAt.Format("Jan 2, 2006"))
+	}
+
+	params := MeasurementTrendParams{
+		Title:        "Weight Trend",
+		Unit:         patientModel.UnitKilogram,
+		EmptyMessage: "No weight has been recorded for this patient.",
+		Width:        chartWidth,
+		Height:       chartHeight,
+	}
+	if len(values) > 0 {
+		params.Points = chartPoints(values, labels)
+		params.Polyline = polyline(params.Points)
+		params.Latest = fmt.Sprintf("%.1f", values[len(values)-1])
+		params.LatestDate = labels[len(labels)-1]
+	}
+
+	return MeasurementTrendComponentView(params), nil
+}
+
+// chartPoints scales values into the SVG viewBox, keeping a padding on every side
+func chartPoints(values []float64, labels []string) []TrendPoint {
+	minV, maxV := values[0], values[0]
+	for _, v := range values {
+		minV = min(minV, v)
+		maxV = max(maxV, v)
+	}
+	spread := maxV - minV
+	if spread == 0 {
+		spread = 1
+	}
+
+	points := make([]TrendPoint, len(values))
+	for i, v := range values {
+		x := chartWidth / 2
+		if len(values) > 1 {
+			x = chartPadding + float64(i)*(chartWidth-2*chartPadding)/float64(len(values)-1)
+		}
+		y := chartHeight - chartPadding - (v-minV)/spread*(chartHeight-2*chartPadding)
+		points[i] = TrendPoint{
+			X:     x,
+			Y:     y,
+			Label: fmt.Sprintf("%s: %.1f", labels[i], v),
+		}
+	}
+	return points
+}
+
+func polyline(points []TrendPoint) string {
+	coords := make([]string, len(points))
+	for i, p := range points {
+		coords[i] = fmt.Sprintf("%.1f,%.1f", p.X, p.Y)
+	}
+	return strings.Join(coords, " ")
+}
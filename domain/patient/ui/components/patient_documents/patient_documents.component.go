@@ -0,0 +1,211 @@
+package patientdocuments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/a-h/templ"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+	patSvc "pharmacy-modernization-project-model/domain/patient/service"
+	contracts "pharmacy-modernization-project-model/domain/patient/ui/contracts"
+	"pharmacy-modernization-project-model/domain/patient/ui/paths"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/attachments"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// uploadFormField is the multipart field holding the file
+const uploadFormField = "file"
+
+// notices are the messages shown for the upload outcomes of PatientDetailPageRequest.Document
+var notices = map[string]DocumentNotice{
+	"uploaded": {Class: "alert-success", Message: "The document was uploaded."},
+	"invalid":  {Class: "alert-error", Message: "The file was empty, too large or not an accepted type."},
+	"rejected": {Class: "alert-error", Message: "The file failed the virus scan and was not stored."},
+	"failed":   {Class: "alert-error", Message: "The document could not be uploaded. Please try again."},
+}
+
+type PatientDocumentsComponent struct {
+	service patSvc.DocumentService
+	log     *zap.Logger
+}
+
+func NewPatientDocumentsComponent(deps *contracts.UiDependencies) *PatientDocumentsComponent {
+	return &PatientDocumentsComponent{service: deps.DocumentSvc, log: deps.Log}
+}
+
+// View renders the documents card, or nothing for a patient outside the user's data access;
+// notice is the outcome of the upload that led to the page
+func (c *PatientDocumentsComponent) View(ctx context.Context, patientID, notice string) (templ.Component, error) {
+	if patientID == "" {
+		return nil, errors.New("patient id is required")
+	}
+
+	documents, err := c.service.List(ctx, patientID)
+	var denied platformErrors.AuthorizationError
+	if errors.As(err, &denied) {
+		return nil, nil
+	}
+	if err != nil {
+		c.log.Error("failed to load patient documents", zap.Error(err))
+		return nil, err
+	}
+
+	limits := c.service.Limits()
+	params := PatientDocumentsParams{
+		UploadPath: paths.PatientDocumentsURL(patientID),
+		Accept:     strings.Join(limits.ContentTypes, ","),
+		Help:       fmt.Sprintf("%s, up to %d MB.", acceptedKinds(limits.ContentTypes), limits.MaxFileBytes>>20),
+		Notice:     notices[notice],
+	}
+	for _, document := range documents {
+		params.Documents = append(params.Documents, DocumentRow{
+			Name:         document.Name,
+			Size:         formatSize(document.Size),
+			UploadedBy:   document.UploadedBy,
+			UploadedAt:   document.UploadedAt.Format("Jan 2, 2006 3:04 PM"),
+			Unscanned:    document.ScanStatus != string(attachments.ScanClean),
+			DownloadPath: paths.PatientDocumentDownloadURL(patientID, document.ID),
+		})
+	}
+	return PatientDocumentsComponentView(params), nil
+}
+
+// Upload stores the file and returns to the patient page, which reports the outcome
+func (c *PatientDocumentsComponent) Upload(w http.ResponseWriter, r *http.Request) {
+	pathVars, _, err := bind.ChiPath[request.PatientPathVars](r, chi.URLParam)
+	if err != nil {
+		helper.WriteUIError(w, "Invalid patient ID", http.StatusBadRequest)
+		return
+	}
+
+	outcome := "invalid"
+	if reader, err := r.MultipartReader(); err == nil {
+		for {
+			part, err := reader.NextPart()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				c.log.Warn("failed to read document upload", zap.Error(err))
+				outcome = "failed"
+				break
+			}
+			if part.FormName() != uploadFormField || part.FileName() == "" {
+				continue
+			}
+
+			_, err = c.service.Upload(r.Context(), pathVars.PatientID, uploadedBy(r), part.FileName(), part)
+			if writeAccessError(w, err) {
+				return
+			}
+			outcome = uploadOutcome(err)
+			break
+		}
+	}
+	http.Redirect(w, r, paths.PatientDetailURL(pathVars.PatientID)+"?document="+outcome, http.StatusSeeOther)
+}
+
+// Download sends the stored file as an attachment
+func (c *PatientDocumentsComponent) Download(w http.ResponseWriter, r *http.Request) {
+	pathVars, _, err := bind.ChiPath[request.DocumentPathVars](r, chi.URLParam)
+	if err != nil {
+		helper.WriteUIError(w, "Invalid document", http.StatusBadRequest)
+		return
+	}
+
+	document, content, err := c.service.Download(r.Context(), pathVars.PatientID, pathVars.DocumentID)
+	if writeAccessError(w, err) {
+		return
+	}
+	if err != nil {
+		c.log.Error("failed to download patient document", zap.Error(err))
+		helper.WriteUIInternalError(w, "Failed to load the document")
+		return
+	}
+
+	w.Header().Set("Content-Type", document.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": document.Name}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "private, no-store")
+	_, _ = w.Write(content)
+}
+
+// writeAccessError answers for a missing patient or document, or a patient outside the user's
+// data access, and reports whether it did
+func writeAccessError(w http.ResponseWriter, err error) bool {
+	var denied platformErrors.AuthorizationError
+	switch {
+	case platformErrors.IsNotFoundError(err):
+		helper.WriteUINotFound(w, "Patient or document not found")
+		return true
+	case errors.As(err, &denied):
+		helper.WriteUIError(w, "You cannot access the documents of this patient", http.StatusForbidden)
+		return true
+	}
+	return false
+}
+
+// uploadOutcome names the notice for an upload result
+func uploadOutcome(err error) string {
+	var invalid platformErrors.ValidationError
+	var rejected platformErrors.BusinessLogicError
+	switch {
+	case err == nil:
+		return "uploaded"
+	case errors.As(err, &invalid):
+		return "invalid"
+	case errors.As(err, &rejected):
+		return "rejected"
+	default:
+		return "failed"
+	}
+}
+
+// uploadedBy identifies the signed-in user uploading a document
+func uploadedBy(r *http.Request) string {
+	user, err := auth.GetCurrentUser(r.Context())
+	if err != nil {
+		return ""
+	}
+	if user.Email != "" {
+		return user.Email
+	}
+	return user.ID
+}
+
+// acceptedKinds describes content types for people, e.g. "PDF, PNG or JPEG"
+func acceptedKinds(contentTypes []string) string {
+	kinds := make([]string, len(contentTypes))
+	for i, contentType := range contentTypes {
+		_, subtype, _ := strings.Cut(contentType, "/")
+		kinds[i] = strings.ToUpper(subtype)
+	}
+	if len(kinds) < 2 {
+		return strings.Join(kinds, "")
+	}
+	return strings.Join(kinds[:len(kinds)-1], ", ") + " or " + kinds[len(kinds)-1]
+}
+
+func formatSize(bytes int) string {
+	switch {
+	case bytes >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(bytes)/(1<<20))
+	case bytes >= 1<<10:
+		return fmt.Sprintf("%d KB", bytes>>10)
+	default:
+		return fmt.Sprintf("%d B", bytes)
+	}
+}
@@ -76,7 +76,7 @@ func (h *InvoiceListComponent) componentView(ctx context.Context, patientID stri
 		return nil, errors.New("invoice provider is missing")
 	}
 
-	invoiceResponse, err := h.provider.GetInvoicesByPatientID(ctx, patientID)
+	summary, err := h.provider.PatientInvoiceSummaryByPatientID(ctx, patientID)
 	if err != nil {
 		if h.log != nil {
 			h.log.Error("failed to load patient invoices", zap.Error(err))
@@ -88,8 +88,10 @@ func (h *InvoiceListComponent) componentView(ctx context.Context, patientID stri
 		Title:        "Invoices",
 		EmptyMessage: "No invoices found for this patient.",
 		PatientID:    patientID,
-		Invoices:     invoiceResponse.Invoices,
-		Total:        invoiceResponse.Total,
+		Invoices:     summary.Invoices,
+		Total:        len(summary.Invoices),
+		FetchedAt:    summary.FetchedAt,
+		Stale:        summary.Stale,
 	}
 
 	return InvoiceListComponentView(params), nil
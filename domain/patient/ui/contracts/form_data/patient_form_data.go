@@ -5,7 +5,7 @@ type PatientFormData struct {
 	ID     string
 	Name   string
 	Phone  string
-	DOB    string // Format: YYYY-MM-DD
+	DOB    string // Format: YYYY-MM-DD, or YYYY-MM / YYYY when only partly known
 	State  string
 	Errors map[string]string
 }
@@ -3,6 +3,7 @@ package ui
 import (
 	patientproviders "pharmacy-modernization-project-model/domain/patient/providers"
 	patSvc "pharmacy-modernization-project-model/domain/patient/service"
+	"pharmacy-modernization-project-model/internal/platform/navigation"
 
 	"go.uber.org/zap"
 )
@@ -10,7 +11,11 @@ import (
 type UiDependencies struct {
 	PatientSvc           patSvc.PatientService
 	AddressSvc           patSvc.AddressService
+	MeasurementSvc       patSvc.MeasurementService
+	ImportSvc            patSvc.PatientImportService
+	DocumentSvc          patSvc.DocumentService
 	PrescriptionProvider patientproviders.PatientPrescriptionProvider
 	InvoiceProvider      patientproviders.PatientInvoiceProvider
+	Navigation           *navigation.BackStack // Back links and breadcrumbs; nil uses the default parents
 	Log                  *zap.Logger
 }
@@ -1,6 +1,10 @@
 package paths
 
-import "strings"
+import (
+	"strings"
+
+	platformpaths "pharmacy-modernization-project-model/internal/platform/paths"
+)
 
 const (
 	// Base path for patient domain
@@ -12,6 +16,7 @@ const (
 	DetailPath    = BasePath + "/{patientID}"
 	EditPath      = BasePath + "/{patientID}/edit"
 	ComponentPath = BasePath + "/components/patient-prescriptions-card"
+	ImportPath    = BasePath + "/import"
 
 	// Relative route patterns (for use within Route() blocks)
 	ListRoute                             = "/"
@@ -21,11 +26,40 @@ const (
 	PatientPrescriptionCardComponentRoute = "/components/patient-prescriptions-card"
 	PatientInvoiceCardComponentRoute      = "/components/patient-invoices-card"
 
+	// CSV import wizard routes
+	ImportRoute          = "/import"
+	ImportMappingRoute   = "/import/{importID}"
+	ImportValidateRoute  = "/import/{importID}/validate"
+	ImportTemplatesRoute = "/import/{importID}/templates"
+	ImportSubmitRoute    = "/import/{importID}/submit"
+	ImportProgressRoute  = "/import/{importID}/progress"
+
+	// Patient document routes, relative to BasePath
+	DocumentsRoute        = "/{patientID}/documents"
+	DocumentDownloadRoute = "/{patientID}/documents/{documentID}/download"
+
 	// API paths
-	APIPath = "/api/v1/patients"
+	APIPath = platformpaths.APIV1Path + "/patients"
 
 	// Address sub-routes
 	AddressSubRoute = "/{patientID}/addresses"
+
+	// Measurement sub-routes
+	MeasurementSubRoute = "/{patientID}/measurements"
+
+	// Allergy sub-routes
+	AllergySubRoute = "/{patientID}/allergies"
+
+	// Insurance sub-routes
+	InsuranceSubRoute = "/{patientID}/insurance"
+
+	// Document sub-routes
+	DocumentSubRoute = "/{patientID}/documents"
+
+	// Export routes (relative to APIPath)
+	ExportRoute            = "/export"
+	ExportJobRoute         = "/export/jobs/{jobID}"
+	ExportJobDownloadRoute = "/export/jobs/{jobID}/download"
 )
 
 // Helper functions for path generation with parameters
@@ -41,6 +75,46 @@ func PatientAddressAPIURL(patientID string) string {
 	return APIPath + "/" + patientID + "/addresses"
 }
 
+func PatientDocumentsURL(patientID string) string {
+	return BasePath + strings.Replace(DocumentsRoute, "{patientID}", patientID, 1)
+}
+
+func PatientDocumentDownloadURL(patientID, documentID string) string {
+	return BasePath + strings.NewReplacer("{patientID}", patientID, "{documentID}", documentID).Replace(DocumentDownloadRoute)
+}
+
+func PatientExportJobURL(jobID string) string {
+	return APIPath + strings.Replace(ExportJobRoute, "{jobID}", jobID, 1)
+}
+
+func PatientExportJobDownloadURL(jobID string) string {
+	return APIPath + strings.Replace(ExportJobDownloadRoute, "{jobID}", jobID, 1)
+}
+
+func PatientImportURL() string {
+	return ImportPath
+}
+
+func PatientImportMappingURL(importID string) string {
+	return BasePath + strings.Replace(ImportMappingRoute, "{importID}", importID, 1)
+}
+
+func PatientImportValidateURL(importID string) string {
+	return BasePath + strings.Replace(ImportValidateRoute, "{importID}", importID, 1)
+}
+
+func PatientImportTemplatesURL(importID string) string {
+	return BasePath + strings.Replace(ImportTemplatesRoute, "{importID}", importID, 1)
+}
+
+func PatientImportSubmitURL(importID string) string {
+	return BasePath + strings.Replace(ImportSubmitRoute, "{importID}", importID, 1)
+}
+
+func PatientImportProgressURL(importID string) string {
+	return BasePath + strings.Replace(ImportProgressRoute, "{importID}", importID, 1)
+}
+
 func PatientListURL() string {
 	return ListPath
 }
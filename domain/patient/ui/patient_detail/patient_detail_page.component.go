@@ -10,12 +10,15 @@ import (
 	"pharmacy-modernization-project-model/domain/patient/contracts/request"
 	patSvc "pharmacy-modernization-project-model/domain/patient/service"
 	addresscomponents "pharmacy-modernization-project-model/domain/patient/ui/components/address_list"
+	measurementtrend "pharmacy-modernization-project-model/domain/patient/ui/components/measurement_trend"
+	patientdocuments "pharmacy-modernization-project-model/domain/patient/ui/components/patient_documents"
 	patientinvoices "pharmacy-modernization-project-model/domain/patient/ui/components/patient_invoices"
 	patientprescriptions "pharmacy-modernization-project-model/domain/patient/ui/components/patient_prescriptions"
 	contracts "pharmacy-modernization-project-model/domain/patient/ui/contracts"
 	"pharmacy-modernization-project-model/domain/patient/ui/paths"
 	"pharmacy-modernization-project-model/internal/bind"
 	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/navigation"
 )
 
 type PatientDetailComponent struct {
@@ -23,6 +26,9 @@ type PatientDetailComponent struct {
 	addressListComponent      *addresscomponents.AddressListComponent
 	prescriptionListComponent *patientprescriptions.PrescriptionListComponent
 	invoiceListComponent      *patientinvoices.InvoiceListComponent
+	weightTrendComponent      *measurementtrend.MeasurementTrendComponent
+	documentsComponent        *patientdocuments.PatientDocumentsComponent
+	nav                       *navigation.BackStack
 	log                       *zap.Logger
 }
 
@@ -31,12 +37,17 @@ func NewPatientDetailComponent(
 	addressListComponent *addresscomponents.AddressListComponent,
 	prescriptionListComponent *patientprescriptions.PrescriptionListComponent,
 	invoiceListComponent *patientinvoices.InvoiceListComponent,
+	weightTrendComponent *measurementtrend.MeasurementTrendComponent,
+	documentsComponent *patientdocuments.PatientDocumentsComponent,
 ) *PatientDetailComponent {
 	return &PatientDetailComponent{
 		patientsService:           deps.PatientSvc,
 		addressListComponent:      addressListComponent,
 		prescriptionListComponent: prescriptionListComponent,
 		invoiceListComponent:      invoiceListComponent,
+		weightTrendComponent:      weightTrendComponent,
+		documentsComponent:        documentsComponent,
+		nav:                       deps.Navigation,
 		log:                       deps.Log,
 	}
 }
@@ -61,7 +72,7 @@ func (h *PatientDetailComponent) Handler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	var addressComponent, prescriptionComponent, invoiceComponent templ.Component
+	var addressComponent, prescriptionComponent, invoiceComponent, weightTrend, documents templ.Component
 
 	if h.addressListComponent != nil {
 		component, err := h.addressListComponent.View(r.Context(), pathVars.PatientID)
@@ -80,13 +91,35 @@ func (h *PatientDetailComponent) Handler(w http.ResponseWriter, r *http.Request)
 		invoiceComponent = patientinvoices.PlaceHolder(pathVars.PatientID)
 	}
 
+	if h.weightTrendComponent != nil {
+		component, err := h.weightTrendComponent.View(r.Context(), pathVars.PatientID)
+		if err != nil {
+			helper.WriteUIInternalError(w, "Failed to load patient measurements")
+			return
+		}
+		weightTrend = component
+	}
+
+	if h.documentsComponent != nil {
+		// An unknown upload outcome only loses the notice
+		pageReq, _, _ := bind.Query[request.PatientDetailPageRequest](r)
+		component, err := h.documentsComponent.View(r.Context(), pathVars.PatientID, pageReq.Document)
+		if err != nil {
+			helper.WriteUIInternalError(w, "Failed to load patient documents")
+			return
+		}
+		documents = component
+	}
+
 	view := PatientDetailPageComponentView(r.Context(), PatientDetailPageParam{
 		Patient:       patient,
-		Age:           helper.CalculateAge(patient.DOB),
+		Age:           helper.FormatAge(patient.DOB),
 		AddressList:   addressComponent,
 		Prescriptions: prescriptionComponent,
 		Invoices:      invoiceComponent,
-		BackPath:      paths.PatientListURL(),
+		WeightTrend:   weightTrend,
+		Documents:     documents,
+		Trail:         h.nav.Visit(w, r, patient.Name, navigation.Crumb{Label: "Patients", Path: paths.PatientListURL()}),
 		EditPath:      paths.PatientEditURL(pathVars.PatientID),
 	})
 
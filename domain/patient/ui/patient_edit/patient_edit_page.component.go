@@ -2,7 +2,6 @@ package patient_edit
 
 import (
 	"net/http"
-	"time"
 
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
@@ -14,16 +13,20 @@ import (
 	"pharmacy-modernization-project-model/domain/patient/ui/paths"
 	"pharmacy-modernization-project-model/internal/bind"
 	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/dates"
+	"pharmacy-modernization-project-model/internal/platform/navigation"
 )
 
 type PatientEditComponent struct {
 	patientsService patSvc.PatientService
+	nav             *navigation.BackStack
 	log             *zap.Logger
 }
 
 func NewPatientEditComponent(deps *contracts.UiDependencies) *PatientEditComponent {
 	return &PatientEditComponent{
 		patientsService: deps.PatientSvc,
+		nav:             deps.Navigation,
 		log:             deps.Log,
 	}
 }
@@ -74,15 +77,20 @@ func (h *PatientEditComponent) showEditForm(w http.ResponseWriter, r *http.Reque
 			ID:    patient.ID,
 			Name:  patient.Name,
 			Phone: patient.Phone,
-			DOB:   patient.DOB.Format("2006-01-02"),
+			DOB:   patient.DOB.String(),
 			State: patient.State,
 		}
 	}
 
+	trail := h.nav.Visit(w, r, "Edit",
+		navigation.Crumb{Label: "Patients", Path: paths.PatientListURL()},
+		navigation.Crumb{Label: patient.Name, Path: paths.PatientDetailURL(patientID)},
+	)
+
 	view := PatientEditPageComponentView(PatientEditPageParam{
 		Patient:    patient,
 		FormData:   formData,
-		BackPath:   paths.PatientDetailURL(patientID),
+		Trail:      trail,
 		SubmitPath: paths.PatientEditURL(patientID),
 	})
 
@@ -100,14 +108,14 @@ func (h *PatientEditComponent) handleFormSubmission(w http.ResponseWriter, r *ht
 		h.log.Error("failed to bind form data", zap.Error(err))
 		formData := form_data.PatientFormData{
 			ID:     patientID,
-			Errors: helper.ConvertFieldErrorsToUIErrors(fieldErrors),
+			Errors: helper.ConvertFieldErrorsToUIErrors(r.Context(), fieldErrors),
 		}
 		h.showEditForm(w, r, patientID, formData)
 		return
 	}
 
 	// Parse DOB (validation already handled by custom validator)
-	dob, err := time.Parse("2006-01-02", formReq.DOB)
+	dob, err := dates.Parse(formReq.DOB)
 	if err != nil {
 		// This should not happen due to validation, but just in case
 		formData := form_data.PatientFormData{
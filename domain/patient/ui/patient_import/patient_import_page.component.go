@@ -0,0 +1,289 @@
+package patient_import
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/a-h/templ"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	patientsmodel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+	patSvc "pharmacy-modernization-project-model/domain/patient/service"
+	contracts "pharmacy-modernization-project-model/domain/patient/ui/contracts"
+	"pharmacy-modernization-project-model/domain/patient/ui/paths"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+const (
+	// uploadFormField is the multipart field holding the CSV file
+	uploadFormField = "file"
+	// invalidFormMessage is shown when the mapping form does not bind; the selects and the
+	// template name input cannot produce such values
+	invalidFormMessage = "The mapping could not be read. Please check it and try again."
+)
+
+type PatientImportComponent struct {
+	importService patSvc.PatientImportService
+	log           *zap.Logger
+}
+
+func NewPatientImportComponent(deps *contracts.UiDependencies) *PatientImportComponent {
+	return &PatientImportComponent{
+		importService: deps.ImportSvc,
+		log:           deps.Log,
+	}
+}
+
+// ShowUploadForm handles GET requests for the first step, choosing a CSV file
+func (h *PatientImportComponent) ShowUploadForm(w http.ResponseWriter, r *http.Request) {
+	h.render(w, r, PatientImportUploadPageComponentView(h.uploadParam("")))
+}
+
+// HandleUpload handles the CSV file upload and moves on to the column mapping
+func (h *PatientImportComponent) HandleUpload(w http.ResponseWriter, r *http.Request) {
+	// The file is streamed to the import service, which enforces the size limit
+	reader, err := r.MultipartReader()
+	if err != nil {
+		h.render(w, r, PatientImportUploadPageComponentView(h.uploadParam("Choose a CSV file to upload.")))
+		return
+	}
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			h.log.Warn("failed to read import upload", zap.Error(err))
+			h.render(w, r, PatientImportUploadPageComponentView(h.uploadParam("The file could not be uploaded. Please try again.")))
+			return
+		}
+		if part.FormName() != uploadFormField || part.FileName() == "" {
+			continue
+		}
+
+		job, err := h.importService.Upload(r.Context(), part.FileName(), part)
+		if err != nil {
+			h.render(w, r, PatientImportUploadPageComponentView(h.uploadParam(h.errorMessage(err))))
+			return
+		}
+		http.Redirect(w, r, paths.PatientImportMappingURL(job.ID), http.StatusSeeOther)
+		return
+	}
+	h.render(w, r, PatientImportUploadPageComponentView(h.uploadParam("Choose a CSV file to upload.")))
+}
+
+// ShowMapping handles GET requests for the column mapping step; once the import has started it
+// shows its progress instead
+func (h *PatientImportComponent) ShowMapping(w http.ResponseWriter, r *http.Request) {
+	job, ok := h.job(w, r)
+	if !ok {
+		return
+	}
+	if job.Status != patientsmodel.ImportJobUploaded {
+		h.render(w, r, PatientImportProgressPageComponentView(h.progressParam(job)))
+		return
+	}
+
+	pageReq, _, err := bind.Query[request.PatientImportPageRequest](r)
+	if err != nil {
+		helper.WriteUIError(w, "Invalid template", http.StatusBadRequest)
+		return
+	}
+	pageParam := h.mappingParam(r, job, job.Mapping)
+	if pageReq.TemplateID != "" {
+		template, err := h.importService.GetTemplate(r.Context(), pageReq.TemplateID)
+		if err != nil {
+			h.render(w, r, PatientImportMappingPageComponentView(withError(pageParam, h.errorMessage(err))))
+			return
+		}
+		pageParam.TemplateID = template.ID
+		pageParam.Mapping = applyTemplate(job.Headers, template.Mapping)
+		pageParam.Message = "Applied template " + template.Name + ". Review the mapping, then run a dry run."
+	}
+	h.render(w, r, PatientImportMappingPageComponentView(pageParam))
+}
+
+// HandleValidate runs the dry run with the submitted mapping
+func (h *PatientImportComponent) HandleValidate(w http.ResponseWriter, r *http.Request) {
+	job, mapping, ok := h.jobAndMapping(w, r)
+	if !ok {
+		return
+	}
+	validated, err := h.importService.Validate(r.Context(), job.ID, mapping)
+	if err != nil {
+		h.renderMappingError(w, r, job, mapping, err)
+		return
+	}
+	h.render(w, r, PatientImportMappingPageComponentView(h.mappingParam(r, validated, mapping)))
+}
+
+// HandleSaveTemplate saves the submitted mapping as a template
+func (h *PatientImportComponent) HandleSaveTemplate(w http.ResponseWriter, r *http.Request) {
+	job, ok := h.job(w, r)
+	if !ok {
+		return
+	}
+	formReq, _, err := bind.Form[request.PatientImportMappingFormRequest](r)
+	if err != nil {
+		h.render(w, r, PatientImportMappingPageComponentView(withError(h.mappingParam(r, job, formReq.Mapping()), invalidFormMessage)))
+		return
+	}
+
+	template, err := h.importService.SaveTemplate(r.Context(), formReq.TemplateName, formReq.Mapping())
+	if err != nil {
+		h.renderMappingError(w, r, job, formReq.Mapping(), err)
+		return
+	}
+	pageParam := h.mappingParam(r, job, formReq.Mapping())
+	pageParam.TemplateID = template.ID
+	pageParam.Message = "Saved template " + template.Name + "."
+	h.render(w, r, PatientImportMappingPageComponentView(pageParam))
+}
+
+// HandleSubmit starts the import and moves on to its progress
+func (h *PatientImportComponent) HandleSubmit(w http.ResponseWriter, r *http.Request) {
+	job, mapping, ok := h.jobAndMapping(w, r)
+	if !ok {
+		return
+	}
+	if _, err := h.importService.Start(r.Context(), job.ID, mapping); err != nil {
+		h.renderMappingError(w, r, job, mapping, err)
+		return
+	}
+	http.Redirect(w, r, paths.PatientImportMappingURL(job.ID), http.StatusSeeOther)
+}
+
+// ProgressFragment renders the progress card, which polls itself while the import runs
+func (h *PatientImportComponent) ProgressFragment(w http.ResponseWriter, r *http.Request) {
+	job, ok := h.job(w, r)
+	if !ok {
+		return
+	}
+	h.render(w, r, patientImportProgress(h.progressParam(job)))
+}
+
+func (h *PatientImportComponent) job(w http.ResponseWriter, r *http.Request) (patientsmodel.PatientImportJob, bool) {
+	pathVars, _, err := bind.ChiPath[request.PatientImportPathVars](r, chi.URLParam)
+	if err != nil {
+		h.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.WriteUIError(w, "Invalid import ID", http.StatusBadRequest)
+		return patientsmodel.PatientImportJob{}, false
+	}
+	job, err := h.importService.GetJob(r.Context(), pathVars.ImportID)
+	if err != nil {
+		if platformErrors.IsNotFoundError(err) {
+			helper.WriteUINotFound(w, "This import has expired or does not exist. Please upload the file again.")
+			return patientsmodel.PatientImportJob{}, false
+		}
+		h.log.Error("failed to load patient import", zap.Error(err))
+		helper.WriteUIInternalError(w, "Failed to load import")
+		return patientsmodel.PatientImportJob{}, false
+	}
+	return job, true
+}
+
+func (h *PatientImportComponent) jobAndMapping(w http.ResponseWriter, r *http.Request) (patientsmodel.PatientImportJob, patientsmodel.PatientImportMapping, bool) {
+	job, ok := h.job(w, r)
+	if !ok {
+		return job, nil, false
+	}
+	formReq, _, err := bind.Form[request.PatientImportMappingFormRequest](r)
+	if err != nil {
+		h.render(w, r, PatientImportMappingPageComponentView(withError(h.mappingParam(r, job, formReq.Mapping()), invalidFormMessage)))
+		return job, nil, false
+	}
+	return job, formReq.Mapping(), true
+}
+
+// renderMappingError shows the mapping step again with the error next to its field
+func (h *PatientImportComponent) renderMappingError(w http.ResponseWriter, r *http.Request, job patientsmodel.PatientImportJob, mapping patientsmodel.PatientImportMapping, err error) {
+	var validationErr platformErrors.ValidationError
+	if errors.As(err, &validationErr) && validationErr.Field != "" {
+		pageParam := h.mappingParam(r, job, mapping)
+		pageParam.Errors = map[string]string{validationErr.Field: validationErr.Message}
+		h.render(w, r, PatientImportMappingPageComponentView(pageParam))
+		return
+	}
+	h.render(w, r, PatientImportMappingPageComponentView(withError(h.mappingParam(r, job, mapping), h.errorMessage(err))))
+}
+
+// errorMessage returns the message shown for a service error; unexpected errors are logged
+func (h *PatientImportComponent) errorMessage(err error) string {
+	var validationErr platformErrors.ValidationError
+	var conflictErr platformErrors.ConflictError
+	switch {
+	case errors.As(err, &validationErr):
+		return validationErr.Message
+	case errors.As(err, &conflictErr):
+		return "This import has already been started."
+	case platformErrors.IsNotFoundError(err):
+		return "The selected template no longer exists."
+	}
+	h.log.Error("patient import failed", zap.Error(err))
+	return "Something went wrong. Please try again."
+}
+
+func (h *PatientImportComponent) uploadParam(errMessage string) PatientImportUploadPageParam {
+	return PatientImportUploadPageParam{
+		SubmitPath: paths.PatientImportURL(),
+		BackPath:   paths.PatientListURL(),
+		Error:      errMessage,
+	}
+}
+
+func (h *PatientImportComponent) mappingParam(r *http.Request, job patientsmodel.PatientImportJob, mapping patientsmodel.PatientImportMapping) PatientImportMappingPageParam {
+	templates, err := h.importService.ListTemplates(r.Context())
+	if err != nil {
+		h.log.Error("failed to load import templates", zap.Error(err))
+	}
+	return PatientImportMappingPageParam{
+		Job:           job,
+		Fields:        patientsmodel.PatientImportFields,
+		Mapping:       mapping,
+		Templates:     templates,
+		Errors:        map[string]string{},
+		BackPath:      paths.PatientImportURL(),
+		PagePath:      paths.PatientImportMappingURL(job.ID),
+		ValidatePath:  paths.PatientImportValidateURL(job.ID),
+		TemplatesPath: paths.PatientImportTemplatesURL(job.ID),
+		SubmitPath:    paths.PatientImportSubmitURL(job.ID),
+	}
+}
+
+func (h *PatientImportComponent) progressParam(job patientsmodel.PatientImportJob) PatientImportProgressParam {
+	return PatientImportProgressParam{
+		Job:          job,
+		ProgressPath: paths.PatientImportProgressURL(job.ID),
+		ListPath:     paths.PatientListURL(),
+		ImportPath:   paths.PatientImportURL(),
+	}
+}
+
+func (h *PatientImportComponent) render(w http.ResponseWriter, r *http.Request, view templ.Component) {
+	if err := view.Render(r.Context(), w); err != nil {
+		h.log.Error("failed to render patient import", zap.Error(err))
+		http.Error(w, "failed to render patient import", http.StatusInternalServerError)
+	}
+}
+
+func withError(pageParam PatientImportMappingPageParam, message string) PatientImportMappingPageParam {
+	pageParam.Errors["general"] = message
+	return pageParam
+}
+
+// applyTemplate keeps the template's columns that this file has
+func applyTemplate(headers []string, templateMapping patientsmodel.PatientImportMapping) patientsmodel.PatientImportMapping {
+	mapping := patientsmodel.PatientImportMapping{}
+	for field, header := range templateMapping {
+		if slices.Contains(headers, header) {
+			mapping[field] = header
+		}
+	}
+	return mapping
+}
@@ -7,20 +7,24 @@ import (
 
 	patientmodel "pharmacy-modernization-project-model/domain/patient/contracts/model"
 	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+	patientsecurity "pharmacy-modernization-project-model/domain/patient/security"
 	patSvc "pharmacy-modernization-project-model/domain/patient/service"
 	contracts "pharmacy-modernization-project-model/domain/patient/ui/contracts"
 	"pharmacy-modernization-project-model/domain/patient/ui/paths"
 	"pharmacy-modernization-project-model/internal/bind"
 	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/navigation"
 )
 
 type PatientListComponent struct {
 	patientsService patSvc.PatientService
+	nav             *navigation.BackStack
 	log             *zap.Logger
 }
 
 func NewPatientListComponent(deps *contracts.UiDependencies) *PatientListComponent {
-	return &PatientListComponent{patientsService: deps.PatientSvc, log: deps.Log}
+	return &PatientListComponent{patientsService: deps.PatientSvc, nav: deps.Navigation, log: deps.Log}
 }
 
 const pageSize = 5
@@ -63,12 +67,16 @@ func (c *PatientListComponent) Handler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Get patients with search filters
+	minAge, maxAge := pageReq.Ages()
 	req := request.PatientListQueryRequest{
-		Limit:       1000,
-		Offset:      0,
-		PatientName: pageReq.PatientName,
-		BirthDate:   pageReq.BirthDate,
-		State:       pageReq.State,
+		Limit:                  1000,
+		Offset:                 0,
+		PatientName:            pageReq.PatientName,
+		BirthDate:              pageReq.BirthDate,
+		State:                  pageReq.State,
+		MinAge:                 minAge,
+		MaxAge:                 maxAge,
+		HasActivePrescriptions: pageReq.HasActivePrescriptions(),
 	}
 	patients, err := c.patientsService.List(r.Context(), req)
 	if err != nil {
@@ -80,14 +88,29 @@ func (c *PatientListComponent) Handler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	patientsPage, totalPages, currentPage := paginatePatients(patients, pageNum)
+	// One query for the whole page; without counts the rows just show no badges
+	if err := c.patientsService.AttachPrescriptionCounts(r.Context(), patientsPage); err != nil {
+		c.log.Warn("failed to count prescriptions of patients", zap.Error(err))
+	}
 
 	// Create search form with current values
 	searchForm := PatientSearchForm{
-		PatientName: pageReq.PatientName,
-		BirthDate:   pageReq.BirthDate,
-		State:       pageReq.State,
+		PatientName:   pageReq.PatientName,
+		BirthDate:     pageReq.BirthDate,
+		State:         pageReq.State,
+		MinAge:        pageReq.MinAge,
+		MaxAge:        pageReq.MaxAge,
+		Prescriptions: pageReq.Prescriptions,
+	}
+
+	importPath := ""
+	if auth.HasAnyPermissionCtx(r.Context(), patientsecurity.WriteAccess) {
+		importPath = paths.PatientImportURL()
 	}
 
+	// The list is where patients are found, so its filters are kept for the back links
+	c.nav.Root(w, r, "Patients")
+
 	view := PatientListPageComponentView(PatientListPageParam{
 		Patients:    patientsPage,
 		CurrentPage: currentPage,
@@ -95,6 +118,7 @@ func (c *PatientListComponent) Handler(w http.ResponseWriter, r *http.Request) {
 		ListPath:    paths.PatientListURL(),
 		DetailPath:  paths.PatientDetailURL,
 		SearchForm:  searchForm,
+		ImportPath:  importPath,
 	})
 
 	if err := view.Render(r.Context(), w); err != nil {
@@ -1,10 +1,59 @@
 package patient_list
 
-import dataTableComponents "pharmacy-modernization-project-model/web/components/elements/data_table"
+import (
+	"sort"
+
+	patientsmodel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	dataTableComponents "pharmacy-modernization-project-model/web/components/elements/data_table"
+)
 
 var patientTableColumns = []dataTableComponents.DataTableColumn{
 	{Title: "", Class: "w-0"},
 	{Title: "Patient"},
 	{Title: "Birthdate"},
 	{Title: "State"},
+	{Title: "Prescriptions"},
+}
+
+// prescriptionBadge is a count badge of the prescriptions column
+type prescriptionBadge struct {
+	Status string
+	Count  int
+	Class  string
+}
+
+// badgeStatuses are shown first, in this order, with their badge class; other statuses follow
+// alphabetically as outlined badges
+var badgeStatuses = []struct {
+	status string
+	class  string
+}{
+	{"Active", "badge-success"},
+	{"Paused", "badge-warning"},
+	{"Draft", "badge-ghost"},
+	{"Completed", "badge-neutral badge-outline"},
+	{"Expired", "badge-outline"},
+}
+
+func prescriptionBadges(counts patientsmodel.PrescriptionCounts) []prescriptionBadge {
+	badges := []prescriptionBadge{}
+	known := map[string]bool{}
+	for _, s := range badgeStatuses {
+		known[s.status] = true
+		if counts[s.status] > 0 {
+			badges = append(badges, prescriptionBadge{Status: s.status, Count: counts[s.status], Class: s.class})
+		}
+	}
+
+	var others []string
+	for status, count := range counts {
+		if !known[status] && count > 0 {
+			others = append(others, status)
+		}
+	}
+	sort.Strings(others)
+	for _, status := range others {
+		badges = append(badges, prescriptionBadge{Status: status, Count: counts[status], Class: "badge-outline"})
+	}
+	return badges
 }
@@ -6,14 +6,16 @@ import (
 	"go.uber.org/zap"
 
 	contracts "pharmacy-modernization-project-model/domain/patient/ui/contracts"
+	"pharmacy-modernization-project-model/internal/platform/navigation"
 )
 
 type PatientSearchComponent struct {
+	nav *navigation.BackStack
 	log *zap.Logger
 }
 
 func NewPatientSearchPageComponent(deps *contracts.UiDependencies) *PatientSearchComponent {
-	return &PatientSearchComponent{log: deps.Log}
+	return &PatientSearchComponent{nav: deps.Navigation, log: deps.Log}
 }
 
 func (c *PatientSearchComponent) Handler(w http.ResponseWriter, r *http.Request) {
@@ -22,6 +24,8 @@ func (c *PatientSearchComponent) Handler(w http.ResponseWriter, r *http.Request)
 	w.Header().Set("Pragma", "no-cache")
 	w.Header().Set("Expires", "0")
 
+	c.nav.Root(w, r, "Patient Search")
+
 	view := PatientSearchPageComponentView()
 
 	if err := view.Render(r.Context(), w); err != nil {
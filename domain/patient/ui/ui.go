@@ -4,12 +4,15 @@ import (
 	"github.com/go-chi/chi/v5"
 
 	addresscomponents "pharmacy-modernization-project-model/domain/patient/ui/components/address_list"
+	measurementtrend "pharmacy-modernization-project-model/domain/patient/ui/components/measurement_trend"
+	patientdocuments "pharmacy-modernization-project-model/domain/patient/ui/components/patient_documents"
 	patientinvoicecomponents "pharmacy-modernization-project-model/domain/patient/ui/components/patient_invoices"
 	patientprescriptioncomponents "pharmacy-modernization-project-model/domain/patient/ui/components/patient_prescriptions"
 	contracts "pharmacy-modernization-project-model/domain/patient/ui/contracts"
 	"pharmacy-modernization-project-model/domain/patient/ui/paths"
 	patientdetail "pharmacy-modernization-project-model/domain/patient/ui/patient_detail"
 	patientedit "pharmacy-modernization-project-model/domain/patient/ui/patient_edit"
+	patientimport "pharmacy-modernization-project-model/domain/patient/ui/patient_import"
 	patientlist "pharmacy-modernization-project-model/domain/patient/ui/patient_list"
 	patientsearch "pharmacy-modernization-project-model/domain/patient/ui/patient_search"
 
@@ -23,8 +26,11 @@ func MountUI(r chi.Router, dep *contracts.UiDependencies) {
 	addressListComponent := addresscomponents.NewAddressListComponent(dep)
 	prescriptionListComponent := patientprescriptioncomponents.NewPrescriptionListComponent(dep)
 	invoiceListComponent := patientinvoicecomponents.NewInvoiceListComponent(dep)
-	patientDetailComponent := patientdetail.NewPatientDetailComponent(dep, addressListComponent, prescriptionListComponent, invoiceListComponent)
+	weightTrendComponent := measurementtrend.NewMeasurementTrendComponent(dep)
+	documentsComponent := patientdocuments.NewPatientDocumentsComponent(dep)
+	patientDetailComponent := patientdetail.NewPatientDetailComponent(dep, addressListComponent, prescriptionListComponent, invoiceListComponent, weightTrendComponent, documentsComponent)
 	patientEditComponent := patientedit.NewPatientEditComponent(dep)
+	patientImportComponent := patientimport.NewPatientImportComponent(dep)
 
 	r.Route(paths.BasePath, func(r chi.Router) {
 		// All patient UI routes require authentication
@@ -41,5 +47,20 @@ func MountUI(r chi.Router, dep *contracts.UiDependencies) {
 		r.Get(paths.DetailRoute, patientDetailComponent.Handler)
 		r.Get(paths.EditRoute, patientEditComponent.ShowEditForm)
 		r.Post(paths.EditRoute, patientEditComponent.HandleFormSubmission)
+		r.Get(paths.DocumentDownloadRoute, documentsComponent.Download)
+		r.With(auth.RequirePermissionsMatchAny(patientsecurity.WriteAccess)).Post(paths.DocumentsRoute, documentsComponent.Upload)
+
+		// The CSV import wizard creates patients, so it also requires write access
+		r.Group(func(r chi.Router) {
+			r.Use(auth.RequirePermissionsMatchAny(patientsecurity.WriteAccess))
+
+			r.Get(paths.ImportRoute, patientImportComponent.ShowUploadForm)
+			r.Post(paths.ImportRoute, patientImportComponent.HandleUpload)
+			r.Get(paths.ImportMappingRoute, patientImportComponent.ShowMapping)
+			r.Post(paths.ImportValidateRoute, patientImportComponent.HandleValidate)
+			r.Post(paths.ImportTemplatesRoute, patientImportComponent.HandleSaveTemplate)
+			r.Post(paths.ImportSubmitRoute, patientImportComponent.HandleSubmit)
+			r.Get(paths.ImportProgressRoute, patientImportComponent.ProgressFragment)
+		})
 	})
 }
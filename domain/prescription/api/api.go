@@ -10,14 +10,35 @@ import (
 )
 
 type Dependencies struct {
-	Service service.PrescriptionService
-	Logger  *zap.Logger
+	Service            service.PrescriptionService
+	DispenseService    service.DispenseService
+	DrugCatalogService service.DrugCatalogService
+	PrescriberService  service.PrescriberService
+	DraftService       service.DraftService
+	Logger             *zap.Logger
 }
 
 func MountAPI(r chi.Router, deps *Dependencies) {
 	controller := controllers.NewPrescriptionController(deps.Service, deps.Logger)
+	dispenseController := controllers.NewDispenseController(deps.DispenseService, deps.Logger)
+	drugController := controllers.NewDrugCatalogController(deps.DrugCatalogService, deps.Logger)
+	prescriberController := controllers.NewPrescriberController(deps.PrescriberService, deps.Logger)
+	draftController := controllers.NewDraftController(deps.DraftService, deps.Service, deps.Logger)
 
 	r.Route(paths.APIPath, func(router chi.Router) {
 		controller.RegisterRoutes(router)
+		router.Route(paths.DispensesSubRoute, func(dispenseRouter chi.Router) {
+			dispenseController.RegisterRoutes(dispenseRouter)
+		})
+		router.Route(paths.DrugsSubRoute, func(drugRouter chi.Router) {
+			drugController.RegisterRoutes(drugRouter)
+		})
+		router.Route(paths.DraftsSubRoute, func(draftRouter chi.Router) {
+			draftController.RegisterRoutes(draftRouter)
+		})
+	})
+
+	r.Route(paths.PrescribersAPIPath, func(router chi.Router) {
+		prescriberController.RegisterRoutes(router)
 	})
 }
@@ -0,0 +1,149 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	request "pharmacy-modernization-project-model/domain/prescription/contracts/request"
+	response "pharmacy-modernization-project-model/domain/prescription/contracts/response"
+	prescriptionsecurity "pharmacy-modernization-project-model/domain/prescription/security"
+	"pharmacy-modernization-project-model/domain/prescription/service"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+)
+
+type DispenseController struct {
+	svc service.DispenseService
+	log *zap.Logger
+}
+
+func NewDispenseController(s service.DispenseService, log *zap.Logger) *DispenseController {
+	return &DispenseController{svc: s, log: log}
+}
+
+func (c *DispenseController) RegisterRoutes(r chi.Router) {
+	// Dispense routes inherit auth from the prescription routes
+
+	// Read operations - requires prescription:read or healthcare role or admin
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.ReadAccess)).Get("/", c.List)
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.ReadAccess)).Get("/{dispenseID}", c.GetByID)
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.ReadAccess)).Get("/{dispenseID}/signature", c.GetSignature)
+
+	// Signatures are captured at the pickup counter - requires dispense access
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.DispenseAccess)).Post("/{dispenseID}/signature", c.CaptureSignature)
+
+	// Reversals undo a dispense and void its billing - requires the reverse permission
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.ReverseDispenseAccess)).Post("/{dispenseID}/reverse", c.Reverse)
+}
+
+func (c *DispenseController) List(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.PrescriptionPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	dispenses, err := c.svc.ListByPrescription(r.Context(), pathVars.PrescriptionID)
+	if err != nil {
+		c.log.Error("list dispenses", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, response.FromDispenses(dispenses))
+}
+
+func (c *DispenseController) GetByID(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.DispensePathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	dispense, err := c.svc.GetByID(r.Context(), pathVars.PrescriptionID, pathVars.DispenseID)
+	if err != nil {
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, response.FromDispense(dispense))
+}
+
+func (c *DispenseController) CaptureSignature(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.DispensePathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	req, fieldErrors, err := bind.JSON[request.CaptureSignatureRequest](r)
+	if err != nil {
+		c.log.Error("failed to bind request body", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	dispense, err := c.svc.CaptureSignature(r.Context(), pathVars.PrescriptionID, pathVars.DispenseID, req)
+	if err != nil {
+		c.log.Error("capture pickup signature", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteCreated(w, response.FromDispense(dispense))
+}
+
+// Reverse records a reversal of the dispense and returns the reversal record
+func (c *DispenseController) Reverse(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.DispensePathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	req, fieldErrors, err := bind.JSON[request.ReverseDispenseRequest](r)
+	if err != nil {
+		c.log.Error("failed to bind request body", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	reversal, err := c.svc.ReverseDispense(r.Context(), pathVars.PrescriptionID, pathVars.DispenseID, req)
+	if err != nil {
+		c.log.Error("reverse dispense", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteCreated(w, response.FromDispense(reversal))
+}
+
+// GetSignature returns the signature image, or the typed attestation as text
+func (c *DispenseController) GetSignature(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.DispensePathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	signature, content, err := c.svc.Signature(r.Context(), pathVars.PrescriptionID, pathVars.DispenseID)
+	if err != nil {
+		c.log.Error("get pickup signature", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	writeSignature(w, signature.ContentType, signature.SHA256, content)
+}
+
+func writeSignature(w http.ResponseWriter, contentType, sha256 string, content []byte) {
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("X-Content-SHA256", sha256)
+	w.Header().Set("Cache-Control", "private, no-store")
+	_, _ = w.Write(content)
+}
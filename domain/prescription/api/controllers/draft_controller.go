@@ -0,0 +1,171 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	model "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	request "pharmacy-modernization-project-model/domain/prescription/contracts/request"
+	response "pharmacy-modernization-project-model/domain/prescription/contracts/response"
+	prescriptionsecurity "pharmacy-modernization-project-model/domain/prescription/security"
+	"pharmacy-modernization-project-model/domain/prescription/service"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+)
+
+// DraftController serves the drafts the prescription create and edit pages autosave
+type DraftController struct {
+	svc           service.DraftService
+	prescriptions service.PrescriptionService
+	log           *zap.Logger
+}
+
+func NewDraftController(s service.DraftService, prescriptions service.PrescriptionService, log *zap.Logger) *DraftController {
+	return &DraftController{svc: s, prescriptions: prescriptions, log: log}
+}
+
+func (c *DraftController) RegisterRoutes(r chi.Router) {
+	// Draft routes inherit auth from the prescription routes. Drafts are forms being written, so
+	// every route requires write access; each user only reaches their own drafts.
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.WriteAccess)).Get("/", c.List)
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.WriteAccess)).Get("/{draftID}", c.GetByID)
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.WriteAccess)).Put("/{draftID}", c.Save)
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.WriteAccess)).Delete("/{draftID}", c.Delete)
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.WriteAccess)).Post("/{draftID}/promote", c.Promote)
+}
+
+func (c *DraftController) List(w http.ResponseWriter, r *http.Request) {
+	drafts, err := c.svc.List(r.Context())
+	if err != nil {
+		c.log.Error("list prescription drafts", zap.Error(err))
+		writePrescriptionError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, response.FromDrafts(drafts))
+}
+
+func (c *DraftController) GetByID(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.DraftPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	draft, err := c.svc.GetByID(r.Context(), pathVars.DraftID)
+	if err != nil {
+		writePrescriptionError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, response.FromDraft(draft))
+}
+
+// Save creates or replaces the draft; the UI calls it every few seconds while a form is edited
+func (c *DraftController) Save(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.DraftPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	req, fieldErrors, err := bind.JSON[request.PrescriptionDraftRequest](r)
+	if err != nil {
+		c.log.Error("failed to bind request body", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	draft, err := c.svc.Save(r.Context(), pathVars.DraftID, req)
+	if err != nil {
+		c.log.Error("save prescription draft", zap.Error(err))
+		writePrescriptionError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, response.FromDraft(draft))
+}
+
+func (c *DraftController) Delete(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.DraftPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	if err := c.svc.Delete(r.Context(), pathVars.DraftID); err != nil {
+		writePrescriptionError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Promote validates the draft like a create or update request and saves the prescription. Field
+// errors come back as a 400, and the draft is kept until the prescription is saved.
+func (c *DraftController) Promote(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.DraftPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	draft, err := c.svc.GetByID(r.Context(), pathVars.DraftID)
+	if err != nil {
+		writePrescriptionError(w, r, err)
+		return
+	}
+
+	prescription, fieldErrors, err := c.prescriptionFromDraft(r.Context(), draft)
+	if len(fieldErrors) > 0 {
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+	if err != nil {
+		writePrescriptionError(w, r, err)
+		return
+	}
+
+	promoted, err := c.svc.Promote(r.Context(), draft, prescription)
+	if err != nil {
+		c.log.Error("promote prescription draft", zap.Error(err))
+		writePrescriptionError(w, r, err)
+		return
+	}
+
+	if draft.PrescriptionID == "" {
+		helper.WriteCreated(w, response.FromModel(promoted))
+		return
+	}
+	helper.WriteOK(w, response.FromModel(promoted))
+}
+
+// prescriptionFromDraft validates the draft's form as a create request, or as an update of the
+// prescription the draft edits, and returns the prescription to save
+func (c *DraftController) prescriptionFromDraft(ctx context.Context, draft model.PrescriptionDraft) (model.Prescription, []bind.FieldError, error) {
+	if draft.PrescriptionID == "" {
+		req, fieldErrors, err := bind.Struct(request.DraftCreateRequest(draft.Form))
+		if err != nil {
+			return model.Prescription{}, fieldErrors, err
+		}
+		prescription, err := req.Prescription()
+		return prescription, nil, err
+	}
+
+	req, fieldErrors, err := bind.Struct(request.DraftUpdateRequest(draft.Form))
+	if err != nil {
+		return model.Prescription{}, fieldErrors, err
+	}
+	existing, err := c.prescriptions.GetByID(ctx, draft.PrescriptionID)
+	if err != nil {
+		return model.Prescription{}, nil, err
+	}
+	if err := req.Apply(&existing); err != nil {
+		return model.Prescription{}, nil, err
+	}
+	return existing, nil, nil
+}
@@ -0,0 +1,69 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	request "pharmacy-modernization-project-model/domain/prescription/contracts/request"
+	response "pharmacy-modernization-project-model/domain/prescription/contracts/response"
+	prescriptionsecurity "pharmacy-modernization-project-model/domain/prescription/security"
+	"pharmacy-modernization-project-model/domain/prescription/service"
+	"pharmacy-modernization-project-model/domain/prescription/ui/paths"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+)
+
+type DrugCatalogController struct {
+	svc service.DrugCatalogService
+	log *zap.Logger
+}
+
+func NewDrugCatalogController(s service.DrugCatalogService, log *zap.Logger) *DrugCatalogController {
+	return &DrugCatalogController{svc: s, log: log}
+}
+
+func (c *DrugCatalogController) RegisterRoutes(r chi.Router) {
+	// Drug routes inherit auth from the prescription routes
+
+	// Read operations - requires prescription:read or healthcare role or admin
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.ReadAccess)).Get(paths.DrugAutocompleteRoute, c.Autocomplete)
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.ReadAccess)).Get("/{drugID}", c.GetByID)
+}
+
+// Autocomplete matches brand names and synonyms as well as generic names, returning canonical entries
+func (c *DrugCatalogController) Autocomplete(w http.ResponseWriter, r *http.Request) {
+	req, fieldErrors, err := bind.Query[request.DrugAutocompleteQueryRequest](r)
+	if err != nil {
+		c.log.Error("failed to bind query parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	suggestions, err := c.svc.Autocomplete(r.Context(), req.Query, req.Limit)
+	if err != nil {
+		c.log.Error("drug autocomplete", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, suggestions)
+}
+
+func (c *DrugCatalogController) GetByID(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.DrugPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	drug, err := c.svc.GetByID(r.Context(), pathVars.DrugID)
+	if err != nil {
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, response.FromDrug(drug))
+}
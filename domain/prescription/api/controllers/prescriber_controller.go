@@ -0,0 +1,157 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	request "pharmacy-modernization-project-model/domain/prescription/contracts/request"
+	response "pharmacy-modernization-project-model/domain/prescription/contracts/response"
+	prescriptionsecurity "pharmacy-modernization-project-model/domain/prescription/security"
+	"pharmacy-modernization-project-model/domain/prescription/service"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+)
+
+type PrescriberController struct {
+	svc service.PrescriberService
+	log *zap.Logger
+}
+
+func NewPrescriberController(s service.PrescriberService, log *zap.Logger) *PrescriberController {
+	return &PrescriberController{svc: s, log: log}
+}
+
+func (c *PrescriberController) RegisterRoutes(r chi.Router) {
+	// All prescriber API routes require authentication (header-based for API)
+	r.Use(auth.RequireAuthFromHeader())
+
+	// Read operations - requires prescription:read or healthcare role or admin
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.ReadAccess)).Get("/", c.List)
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.ReadAccess)).Get("/{prescriberID}", c.GetByID)
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.ReadAccess)).Get("/{prescriberID}/prescriptions", c.Prescriptions)
+
+	// Write operations - requires prescription:write or doctor role or admin
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.WriteAccess)).Post("/", c.Create)
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.WriteAccess)).Put("/{prescriberID}", c.Update)
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.WriteAccess)).Delete("/{prescriberID}", c.Delete)
+}
+
+func (c *PrescriberController) List(w http.ResponseWriter, r *http.Request) {
+	req, fieldErrors, err := bind.Query[request.PrescriberListQueryRequest](r)
+	if err != nil {
+		c.log.Error("failed to bind query parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	prescribers, err := c.svc.List(r.Context(), req.Query, req.Limit, req.Offset)
+	if err != nil {
+		c.log.Error("list prescribers", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, response.FromPrescribers(prescribers))
+}
+
+func (c *PrescriberController) GetByID(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.PrescriberPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	prescriber, err := c.svc.GetByID(r.Context(), pathVars.PrescriberID)
+	if err != nil {
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, response.FromPrescriber(prescriber))
+}
+
+// Prescriptions lists the prescriptions written under the prescriber, newest first
+func (c *PrescriberController) Prescriptions(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.PrescriberPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	req, fieldErrors, err := bind.Query[request.PrescriberPrescriptionsQueryRequest](r)
+	if err != nil {
+		c.log.Error("failed to bind query parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	prescriptions, err := c.svc.Prescriptions(r.Context(), pathVars.PrescriberID, req.Limit)
+	if err != nil {
+		c.log.Error("list prescriber prescriptions", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, response.FromModels(prescriptions))
+}
+
+func (c *PrescriberController) Create(w http.ResponseWriter, r *http.Request) {
+	req, fieldErrors, err := bind.JSON[request.PrescriberCreateRequest](r)
+	if err != nil {
+		c.log.Warn("invalid prescriber payload", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	prescriber, err := c.svc.Create(r.Context(), req)
+	if err != nil {
+		c.log.Error("create prescriber", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteCreated(w, response.FromPrescriber(prescriber))
+}
+
+func (c *PrescriberController) Update(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.PrescriberPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	req, fieldErrors, err := bind.JSON[request.PrescriberUpdateRequest](r)
+	if err != nil {
+		c.log.Warn("invalid prescriber update payload", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	prescriber, err := c.svc.Update(r.Context(), pathVars.PrescriberID, req)
+	if err != nil {
+		c.log.Error("update prescriber", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, response.FromPrescriber(prescriber))
+}
+
+// Delete removes a prescriber who has not written any prescriptions
+func (c *PrescriberController) Delete(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[request.PrescriberPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	if err := c.svc.Delete(r.Context(), pathVars.PrescriberID); err != nil {
+		c.log.Error("delete prescriber", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteNoContent(w)
+}
@@ -1,18 +1,22 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
 
+	model "pharmacy-modernization-project-model/domain/prescription/contracts/model"
 	request "pharmacy-modernization-project-model/domain/prescription/contracts/request"
 	response "pharmacy-modernization-project-model/domain/prescription/contracts/response"
+	prescriptionErrors "pharmacy-modernization-project-model/domain/prescription/errors"
 	prescriptionsecurity "pharmacy-modernization-project-model/domain/prescription/security"
 	"pharmacy-modernization-project-model/domain/prescription/service"
 	"pharmacy-modernization-project-model/internal/bind"
 	helper "pharmacy-modernization-project-model/internal/helper"
 	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
 )
 
 type PrescriptionController struct {
@@ -30,7 +34,15 @@ func (c *PrescriptionController) RegisterRoutes(r chi.Router) {
 
 	// Read operations - requires prescription:read or healthcare role or admin
 	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.ReadAccess)).Get("/", c.List)
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.ReadAccess)).Get("/interactions/check", c.CheckInteractions)
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.ReadAccess)).Get("/pharmacies", c.SearchPharmacies)
 	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.ReadAccess)).Get("/{prescriptionID}", c.GetByID)
+
+	// Write operations - requires prescription:write or doctor role or admin
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.WriteAccess)).Post("/", c.Create)
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.WriteAccess)).Post("/bulk-status", c.BulkUpdateStatus)
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.WriteAccess)).Put("/{prescriptionID}", c.Update)
+	r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.WriteAccess)).Post("/{prescriptionID}/route", c.RouteToPharmacy)
 }
 
 func (c *PrescriptionController) List(w http.ResponseWriter, r *http.Request) {
@@ -75,3 +87,193 @@ func (c *PrescriptionController) GetByID(w http.ResponseWriter, r *http.Request)
 
 	helper.WriteOK(w, response.FromModel(item))
 }
+
+func (c *PrescriptionController) Create(w http.ResponseWriter, r *http.Request) {
+	// Bind and validate JSON body
+	req, fieldErrors, err := bind.JSON[request.PrescriptionCreateRequest](r)
+	if err != nil {
+		c.log.Error("failed to bind request body", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	prescription, err := req.Prescription()
+	if err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+
+	created, err := c.svc.Create(r.Context(), prescription)
+	if err != nil {
+		c.log.Error("create prescription", zap.Error(err))
+		c.handleError(w, r, err)
+		return
+	}
+
+	helper.WriteCreated(w, response.FromModel(created))
+}
+
+func (c *PrescriptionController) Update(w http.ResponseWriter, r *http.Request) {
+	// Bind and validate path parameters
+	pathVars, fieldErrors, err := bind.ChiPath[request.PrescriptionPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	// Bind and validate JSON body
+	req, fieldErrors, err := bind.JSON[request.PrescriptionUpdateRequest](r)
+	if err != nil {
+		c.log.Error("failed to bind request body", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	existing, err := c.svc.GetByID(r.Context(), pathVars.PrescriptionID)
+	if err != nil {
+		c.log.Error("get prescription", zap.Error(err))
+		c.handleError(w, r, err)
+		return
+	}
+	if existing.ID == "" {
+		helper.WriteNotFound(w, "prescription not found")
+		return
+	}
+
+	if err := req.Apply(&existing); err != nil {
+		c.handleError(w, r, err)
+		return
+	}
+
+	if err := c.svc.Update(r.Context(), existing); err != nil {
+		c.log.Error("update prescription", zap.Error(err))
+		c.handleError(w, r, err)
+		return
+	}
+
+	updated, err := c.svc.GetByID(r.Context(), existing.ID)
+	if err != nil {
+		c.log.Error("get updated prescription", zap.Error(err))
+		c.handleError(w, r, err)
+		return
+	}
+
+	helper.WriteOK(w, response.FromModel(updated))
+}
+
+func (c *PrescriptionController) CheckInteractions(w http.ResponseWriter, r *http.Request) {
+	// Bind and validate query parameters
+	req, fieldErrors, err := bind.Query[request.InteractionCheckQueryRequest](r)
+	if err != nil {
+		c.log.Error("failed to bind query parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	result, err := c.svc.CheckInteractions(r.Context(), req.PatientID, req.Drug)
+	if err != nil {
+		c.log.Error("check drug interactions", zap.Error(err))
+		c.handleError(w, r, err)
+		return
+	}
+
+	helper.WriteOK(w, response.FromInteractionCheckResult(result))
+}
+
+func (c *PrescriptionController) SearchPharmacies(w http.ResponseWriter, r *http.Request) {
+	// Bind and validate query parameters
+	req, fieldErrors, err := bind.Query[request.PharmacySearchQueryRequest](r)
+	if err != nil {
+		c.log.Error("failed to bind query parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	result, err := c.svc.SearchPharmacies(r.Context(), req.Zip, req.State, req.Limit)
+	if err != nil {
+		c.log.Error("search pharmacies", zap.Error(err))
+		c.handleError(w, r, err)
+		return
+	}
+
+	helper.WriteOK(w, response.FromPharmacySearchResult(result))
+}
+
+func (c *PrescriptionController) RouteToPharmacy(w http.ResponseWriter, r *http.Request) {
+	// Bind and validate path parameters
+	pathVars, fieldErrors, err := bind.ChiPath[request.PrescriptionPathVars](r, chi.URLParam)
+	if err != nil {
+		c.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	// Bind and validate JSON body
+	req, fieldErrors, err := bind.JSON[request.RoutePrescriptionRequest](r)
+	if err != nil {
+		c.log.Error("failed to bind request body", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	routed, err := c.svc.RouteToPharmacy(r.Context(), pathVars.PrescriptionID, req.PharmacyID)
+	if err != nil {
+		c.log.Error("route prescription", zap.Error(err))
+		c.handleError(w, r, err)
+		return
+	}
+
+	helper.WriteOK(w, response.FromModel(routed))
+}
+
+// BulkUpdateStatus changes the status of many prescriptions at once. Prescriptions that cannot
+// change are reported in the results rather than failing the request.
+func (c *PrescriptionController) BulkUpdateStatus(w http.ResponseWriter, r *http.Request) {
+	req, fieldErrors, err := bind.JSON[request.BulkStatusRequest](r)
+	if err != nil {
+		c.log.Error("failed to bind request body", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	results, err := c.svc.BulkUpdateStatus(r.Context(), req.IDs, model.Status(req.Status), req.Reason)
+	if err != nil {
+		c.log.Error("bulk update prescription status", zap.Error(err))
+		c.handleError(w, r, err)
+		return
+	}
+
+	helper.WriteOK(w, response.FromBulkStatusResults(results))
+}
+
+// handleError maps service errors to HTTP responses, returning allergy or interaction details
+// when a prescription is blocked
+func (c *PrescriptionController) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	writePrescriptionError(w, r, err)
+}
+
+// writePrescriptionError is handleError for the controllers that save prescriptions
+func writePrescriptionError(w http.ResponseWriter, r *http.Request, err error) {
+	var allergyErr prescriptionErrors.DrugAllergyError
+	if errors.As(err, &allergyErr) {
+		helper.WriteError(w, http.StatusUnprocessableEntity, helper.APIError{
+			Code:    "drug_allergy",
+			Message: allergyErr.Error(),
+			Details: allergyErr.Warnings,
+		})
+		return
+	}
+
+	var interactionErr prescriptionErrors.SevereInteractionError
+	if errors.As(err, &interactionErr) {
+		helper.WriteError(w, http.StatusUnprocessableEntity, helper.APIError{
+			Code:    "severe_drug_interaction",
+			Message: interactionErr.Error(),
+			Details: interactionErr.Warnings,
+		})
+		return
+	}
+
+	httpx.WriteError(w, r, err)
+}
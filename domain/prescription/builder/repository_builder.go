@@ -1,18 +1,96 @@
 package builder
 
 import (
+	"context"
+	"time"
+
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
 
 	prescriptionrepo "pharmacy-modernization-project-model/domain/prescription/repository"
+	"pharmacy-modernization-project-model/internal/platform/database"
 )
 
 // CreatePrescriptionRepository creates the appropriate prescription repository based on dependencies
-func CreatePrescriptionRepository(logger *zap.Logger, mongoCollection *mongo.Collection) prescriptionrepo.PrescriptionRepository {
+func CreatePrescriptionRepository(logger *zap.Logger, mongoCollection *mongo.Collection, retrier *database.Retrier) prescriptionrepo.PrescriptionRepository {
 	// Use MongoDB repository if collection is provided, otherwise fallback to memory
 	if mongoCollection != nil {
-		return prescriptionrepo.NewPrescriptionMongoRepository(mongoCollection, logger)
+		return prescriptionrepo.NewPrescriptionRetryRepository(prescriptionrepo.NewPrescriptionMongoRepository(mongoCollection, logger), retrier)
 	}
 
 	return prescriptionrepo.NewPrescriptionMemoryRepository()
 }
+
+// CreateDrugInteractionRepository creates the appropriate drug interaction repository based on
+// dependencies; with MongoDB it creates the drug_names index and fills drug_names where missing
+func CreateDrugInteractionRepository(logger *zap.Logger, mongoCollection *mongo.Collection, retrier *database.Retrier) prescriptionrepo.DrugInteractionRepository {
+	if mongoCollection != nil {
+		repo := prescriptionrepo.NewDrugInteractionMongoRepository(mongoCollection, logger)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := repo.CreateIndexes(ctx); err != nil {
+			logger.Warn("Failed to create drug interaction indexes", zap.Error(err))
+		}
+		if err := repo.BackfillDrugNames(ctx); err != nil {
+			logger.Warn("Failed to backfill drug interaction names", zap.Error(err))
+		}
+		return prescriptionrepo.NewDrugInteractionRetryRepository(repo, retrier)
+	}
+
+	return prescriptionrepo.NewDrugInteractionMemoryRepository()
+}
+
+// CreateDispenseRepository creates the appropriate dispense repository based on dependencies
+func CreateDispenseRepository(logger *zap.Logger, mongoCollection *mongo.Collection, retrier *database.Retrier) prescriptionrepo.DispenseRepository {
+	// Use MongoDB repository if collection is provided, otherwise fallback to memory
+	if mongoCollection != nil {
+		return prescriptionrepo.NewDispenseRetryRepository(prescriptionrepo.NewDispenseMongoRepository(mongoCollection, logger), retrier)
+	}
+
+	return prescriptionrepo.NewDispenseMemoryRepository()
+}
+
+// CreateDrugCatalogRepository creates the appropriate drug catalog repository based on dependencies
+func CreateDrugCatalogRepository(logger *zap.Logger, mongoCollection *mongo.Collection, retrier *database.Retrier) prescriptionrepo.DrugCatalogRepository {
+	// Use MongoDB repository if collection is provided, otherwise fallback to memory
+	if mongoCollection != nil {
+		return prescriptionrepo.NewDrugCatalogRetryRepository(prescriptionrepo.NewDrugCatalogMongoRepository(mongoCollection, logger), retrier)
+	}
+
+	return prescriptionrepo.NewDrugCatalogMemoryRepository()
+}
+
+// CreateDraftRepository creates the appropriate prescription draft repository based on
+// dependencies; with MongoDB it creates the owner and TTL indexes if missing
+func CreateDraftRepository(logger *zap.Logger, mongoCollection *mongo.Collection, retrier *database.Retrier) prescriptionrepo.DraftRepository {
+	if mongoCollection != nil {
+		repo := prescriptionrepo.NewDraftMongoRepository(mongoCollection, logger)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := repo.CreateIndexes(ctx); err != nil {
+			logger.Warn("Failed to create prescription draft indexes", zap.Error(err))
+		}
+		return prescriptionrepo.NewDraftRetryRepository(repo, retrier)
+	}
+
+	return prescriptionrepo.NewDraftMemoryRepository()
+}
+
+// CreatePrescriberRepository creates the appropriate prescriber repository based on dependencies;
+// with MongoDB it creates the unique NPI index if missing
+func CreatePrescriberRepository(logger *zap.Logger, mongoCollection *mongo.Collection, retrier *database.Retrier) prescriptionrepo.PrescriberRepository {
+	if mongoCollection != nil {
+		repo := prescriptionrepo.NewPrescriberMongoRepository(mongoCollection, logger)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := repo.CreateIndexes(ctx); err != nil {
+			logger.Warn("Failed to create prescriber indexes", zap.Error(err))
+		}
+		return prescriptionrepo.NewPrescriberRetryRepository(repo, retrier)
+	}
+
+	return prescriptionrepo.NewPrescriberMemoryRepository()
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+// Adherence summarizes how much of a period a prescription's dispenses covered
+type Adherence struct {
+	From        time.Time
+	To          time.Time
+	DaysInRange int
+	DaysCovered int
+}
+
+// ProportionOfDaysCovered returns the covered share of the period, between 0 and 1
+func (a Adherence) ProportionOfDaysCovered() float64 {
+	if a.DaysInRange == 0 {
+		return 0
+	}
+	return float64(a.DaysCovered) / float64(a.DaysInRange)
+}
+
+// CalculateAdherence counts the days between from and to covered by the dispenses' days supply.
+// Reversed dispenses and reversals are ignored, and a refill picked up early starts once the
+// previous supply runs out, the usual proportion-of-days-covered method.
+func CalculateAdherence(dispenses []DispenseRecord, from, to time.Time) Adherence {
+	from = startOfDay(from)
+	to = startOfDay(to)
+	result := Adherence{From: from, To: to}
+	if !to.After(from) {
+		return result
+	}
+	result.DaysInRange = int(to.Sub(from).Hours()/24 + 0.5)
+
+	supplies := make([]DispenseRecord, 0, len(dispenses))
+	for _, d := range dispenses {
+		if !d.IsReversal() && !d.IsReversed() && d.DaysSupply > 0 {
+			supplies = append(supplies, d)
+		}
+	}
+	sort.Slice(supplies, func(i, j int) bool { return supplies[i].DispensedAt.Before(supplies[j].DispensedAt) })
+
+	var coveredUntil time.Time
+	for _, d := range supplies {
+		start := startOfDay(d.DispensedAt)
+		if start.Before(coveredUntil) {
+			start = coveredUntil
+		}
+		end := start.AddDate(0, 0, d.DaysSupply)
+		coveredUntil = end
+
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		if end.After(start) {
+			result.DaysCovered += int(end.Sub(start).Hours()/24 + 0.5)
+		}
+	}
+	return result
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,20 @@
+package model
+
+// DrugAllergyWarning describes a recorded patient allergy that the prescribed drug matches.
+// Such a prescription is always blocked.
+type DrugAllergyWarning struct {
+	Drug      string `json:"drug"`
+	AllergyID string `json:"allergy_id"`
+	Substance string `json:"substance"`
+	Reaction  string `json:"reaction,omitempty"`
+	Severity  string `json:"severity"`
+}
+
+// MatchesAllergen reports whether a patient allergic to the substance must not take the drug:
+// the substance is one of the drug's names, or its drug class such as "NSAID"
+func (d Drug) MatchesAllergen(substance string) bool {
+	if _, _, ok := d.MatchExact(substance); ok {
+		return true
+	}
+	return d.DrugClass != "" && NormalizeDrugName(d.DrugClass) == NormalizeDrugName(substance)
+}
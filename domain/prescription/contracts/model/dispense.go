@@ -0,0 +1,106 @@
+package model
+
+import "time"
+
+// SignatureMethod is how the patient signed for a pickup
+type SignatureMethod string
+
+const (
+	SignatureImage SignatureMethod = "image" // Drawn on a signature pad
+	SignatureTyped SignatureMethod = "typed" // Typed name with attestation
+)
+
+// PickupAttestation is the statement a patient accepts when signing by typing their name
+const PickupAttestation = "I acknowledge that I received this prescription and the counseling offered to me."
+
+// DispenseKind tells hand-overs apart from the reversals that undo them
+type DispenseKind string
+
+const (
+	DispenseKindDispense DispenseKind = "dispense"
+	DispenseKindReversal DispenseKind = "reversal"
+)
+
+// ReversalReasonCode is why a dispense was reversed
+type ReversalReasonCode string
+
+const (
+	ReversalInsuranceRejected ReversalReasonCode = "insurance_rejected"
+	ReversalNotPickedUp       ReversalReasonCode = "not_picked_up"
+	ReversalPatientReturned   ReversalReasonCode = "patient_returned"
+	ReversalDispensingError   ReversalReasonCode = "dispensing_error"
+	ReversalOther             ReversalReasonCode = "other" // Requires a note
+)
+
+var reversalReasonLabels = map[ReversalReasonCode]string{
+	ReversalInsuranceRejected: "Insurance rejected",
+	ReversalNotPickedUp:       "Not picked up",
+	ReversalPatientReturned:   "Returned by patient",
+	ReversalDispensingError:   "Dispensing error",
+	ReversalOther:             "Other",
+}
+
+// Label returns the display name of the reason code
+func (c ReversalReasonCode) Label() string {
+	if label, ok := reversalReasonLabels[c]; ok {
+		return label
+	}
+	return string(c)
+}
+
+// DispenseRecord is one hand-over of a prescription to the patient, or the reversal of one.
+// A reversal is its own record: DispensedAt and DispensedBy say when and by whom it was reversed.
+type DispenseRecord struct {
+	ID             string       `json:"id" bson:"_id"`
+	Kind           DispenseKind `json:"kind" bson:"kind"`
+	PrescriptionID string       `json:"prescription_id" bson:"prescription_id"`
+	PatientID      string       `json:"patient_id" bson:"patient_id"`
+	Drug           string       `json:"drug" bson:"drug"`
+	Dose           string       `json:"dose" bson:"dose"`
+	DispensedAt    time.Time    `json:"dispensed_at" bson:"dispensed_at"`
+	DispensedBy    string       `json:"dispensed_by,omitempty" bson:"dispensed_by,omitempty"`
+
+	// Sig, Quantity and DaysSupply are copied from the prescription; SupplyEndsAt is when the
+	// days supply handed over runs out
+	Sig          Sig        `json:"sig,omitempty" bson:"sig,omitempty"`
+	Quantity     int        `json:"quantity,omitempty" bson:"quantity,omitempty"`
+	DaysSupply   int        `json:"days_supply,omitempty" bson:"days_supply,omitempty"`
+	SupplyEndsAt *time.Time `json:"supply_ends_at,omitempty" bson:"supply_ends_at,omitempty"`
+
+	Signature *PickupSignature `json:"signature,omitempty" bson:"signature,omitempty"`
+
+	// ReversedByID links a dispense to the reversal that undid it
+	ReversedByID string `json:"reversed_by_id,omitempty" bson:"reversed_by_id,omitempty"`
+	// ReversesID and Reason are set on reversals
+	ReversesID string          `json:"reverses_id,omitempty" bson:"reverses_id,omitempty"`
+	Reason     *ReversalReason `json:"reason,omitempty" bson:"reason,omitempty"`
+}
+
+// IsReversal reports whether the record undoes an earlier dispense
+func (d DispenseRecord) IsReversal() bool {
+	return d.Kind == DispenseKindReversal
+}
+
+// IsReversed reports whether the dispense has been undone
+func (d DispenseRecord) IsReversed() bool {
+	return d.ReversedByID != ""
+}
+
+// ReversalReason is the coded reason, with an optional note, for a reversal
+type ReversalReason struct {
+	Code ReversalReasonCode `json:"code" bson:"code"`
+	Note string             `json:"note,omitempty" bson:"note,omitempty"`
+}
+
+// PickupSignature is the patient's signature for receipt of a dispense. The signature image, or
+// the typed name and attestation, is stored as an attachment whose hash is recorded here.
+type PickupSignature struct {
+	Method       SignatureMethod `json:"method" bson:"method"`
+	SignerName   string          `json:"signer_name" bson:"signer_name"`
+	Attestation  string          `json:"attestation,omitempty" bson:"attestation,omitempty"`
+	AttachmentID string          `json:"attachment_id" bson:"attachment_id"`
+	ContentType  string          `json:"content_type" bson:"content_type"`
+	SHA256       string          `json:"sha256" bson:"sha256"`
+	SignedAt     time.Time       `json:"signed_at" bson:"signed_at"`
+	CapturedBy   string          `json:"captured_by" bson:"captured_by"`
+}
@@ -0,0 +1,182 @@
+package model
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Dose is the amount of drug given at a time, e.g. 10 mg, with the route and frequency it is
+// written for. Prescriptions keep it in Dosage and its text, or the legacy free text, in Dose.
+type Dose struct {
+	Value     float64      `json:"value" bson:"value"`
+	Unit      StrengthUnit `json:"unit" bson:"unit"`
+	Frequency Frequency    `json:"frequency,omitempty" bson:"frequency,omitempty"`
+	Route     Route        `json:"route,omitempty" bson:"route,omitempty"`
+}
+
+// StrengthUnit is the unit of a dose value: a mass, volume or activity, or a count of a dosage form
+type StrengthUnit string
+
+const (
+	StrengthMilligram   StrengthUnit = "mg"
+	StrengthMicrogram   StrengthUnit = "mcg"
+	StrengthGram        StrengthUnit = "g"
+	StrengthMilliliter  StrengthUnit = "mL"
+	StrengthUnitUnit    StrengthUnit = "unit" // Insulin and other unit-dosed drugs
+	StrengthIU          StrengthUnit = "IU"
+	StrengthMilliequiv  StrengthUnit = "mEq"
+	StrengthPercent     StrengthUnit = "%" // Topicals, e.g. 1% cream
+	StrengthTablet      StrengthUnit = "tablet"
+	StrengthCapsule     StrengthUnit = "capsule"
+	StrengthPuff        StrengthUnit = "puff"
+	StrengthDrop        StrengthUnit = "drop"
+	StrengthSpray       StrengthUnit = "spray"
+	StrengthPatch       StrengthUnit = "patch"
+	StrengthApplication StrengthUnit = "application"
+)
+
+// MaxDoseValue bounds the value of a dose in any unit
+const MaxDoseValue = 10000
+
+// StrengthUnits lists the accepted dose units in the order the UI offers them
+var StrengthUnits = []StrengthUnit{
+	StrengthMilligram, StrengthMicrogram, StrengthGram, StrengthMilliliter, StrengthUnitUnit, StrengthIU,
+	StrengthMilliequiv, StrengthPercent, StrengthTablet, StrengthCapsule, StrengthPuff, StrengthDrop,
+	StrengthSpray, StrengthPatch, StrengthApplication,
+}
+
+// strengthUnitNames maps the spellings found in legacy dose text to units
+var strengthUnitNames = map[string]StrengthUnit{
+	"mg": StrengthMilligram, "milligram": StrengthMilligram, "milligrams": StrengthMilligram,
+	"mcg": StrengthMicrogram, "µg": StrengthMicrogram, "ug": StrengthMicrogram, "microgram": StrengthMicrogram, "micrograms": StrengthMicrogram,
+	"g": StrengthGram, "gm": StrengthGram, "gram": StrengthGram, "grams": StrengthGram,
+	"ml": StrengthMilliliter, "cc": StrengthMilliliter, "milliliter": StrengthMilliliter, "milliliters": StrengthMilliliter,
+	"unit": StrengthUnitUnit, "units": StrengthUnitUnit, "u": StrengthUnitUnit,
+	"iu": StrengthIU, "meq": StrengthMilliequiv, "%": StrengthPercent,
+	"tablet": StrengthTablet, "tablets": StrengthTablet, "tab": StrengthTablet, "tabs": StrengthTablet,
+	"capsule": StrengthCapsule, "capsules": StrengthCapsule, "cap": StrengthCapsule, "caps": StrengthCapsule,
+	"puff": StrengthPuff, "puffs": StrengthPuff,
+	"drop": StrengthDrop, "drops": StrengthDrop, "gtt": StrengthDrop, "gtts": StrengthDrop,
+	"spray": StrengthSpray, "sprays": StrengthSpray,
+	"patch": StrengthPatch, "patches": StrengthPatch,
+	"application": StrengthApplication, "applications": StrengthApplication,
+}
+
+// Valid reports whether the unit is a known one
+func (u StrengthUnit) Valid() bool {
+	for _, known := range StrengthUnits {
+		if u == known {
+			return true
+		}
+	}
+	return false
+}
+
+// counted reports whether the unit counts things, so its name takes a plural
+func (u StrengthUnit) counted() bool {
+	switch u {
+	case StrengthUnitUnit, StrengthTablet, StrengthCapsule, StrengthPuff, StrengthDrop, StrengthSpray, StrengthApplication:
+		return true
+	}
+	return false
+}
+
+// IsZero reports whether no part of the dose is set
+func (d Dose) IsZero() bool {
+	return d == Dose{}
+}
+
+// Amount returns the value and unit, e.g. "10 mg", "20 units" or "1%"
+func (d Dose) Amount() string {
+	value := strconv.FormatFloat(d.Value, 'f', -1, 64)
+	switch {
+	case d.Unit == StrengthPercent:
+		return value + "%"
+	case d.Unit == StrengthPatch && d.Value != 1:
+		return value + " patches"
+	case d.Unit.counted() && d.Value != 1:
+		return value + " " + string(d.Unit) + "s"
+	}
+	return value + " " + string(d.Unit)
+}
+
+// String returns the dose as prescription text, e.g. "10 mg oral BID"; ParseDose reads it back
+func (d Dose) String() string {
+	parts := []string{d.Amount()}
+	if d.Route != "" {
+		parts = append(parts, string(d.Route))
+	}
+	if d.Frequency != "" {
+		parts = append(parts, string(d.Frequency))
+	}
+	return strings.Join(parts, " ")
+}
+
+// DoseParseError explains why ParseDose could not read a dose
+type DoseParseError struct {
+	Text   string
+	Reason string
+}
+
+func (e *DoseParseError) Error() string {
+	return "cannot read dose " + strconv.Quote(e.Text) + ": " + e.Reason
+}
+
+var doseAmountPattern = regexp.MustCompile(`^(\d*\.?\d+)(.*)$`)
+
+// ParseDose reads free-text doses such as "10mg", "20 units", "2.5 mg po daily" or the text
+// String returns. The amount comes first; route and frequency words after it are read like a sig.
+func ParseDose(text string) (Dose, error) {
+	words := strings.Fields(strings.NewReplacer(",", " ", ";", " ").Replace(text))
+	if len(words) == 0 {
+		return Dose{}, &DoseParseError{Text: text, Reason: "no amount"}
+	}
+
+	match := doseAmountPattern.FindStringSubmatch(words[0])
+	if match == nil {
+		return Dose{}, &DoseParseError{Text: text, Reason: "it does not start with an amount"}
+	}
+	value, err := strconv.ParseFloat(match[1], 64)
+	if err != nil || value <= 0 {
+		return Dose{}, &DoseParseError{Text: text, Reason: "the amount is not a positive number"}
+	}
+	unitWord, rest := match[2], words[1:]
+	if unitWord == "" {
+		if len(rest) == 0 {
+			return Dose{}, &DoseParseError{Text: text, Reason: "no unit"}
+		}
+		unitWord, rest = rest[0], rest[1:]
+	}
+	unit, ok := strengthUnitNames[strings.ToLower(strings.TrimSuffix(unitWord, "."))]
+	if !ok {
+		return Dose{}, &DoseParseError{Text: text, Reason: "unknown unit " + strconv.Quote(unitWord)}
+	}
+	dose := Dose{Value: value, Unit: unit}
+	if len(rest) == 0 {
+		return dose, nil
+	}
+
+	// Route and frequency, as their codes or as words a sig understands; anything else a sig sets
+	// is not part of a dose
+	var sigWords []string
+	for _, w := range rest {
+		if route := Route(strings.ToLower(w)); route.Valid() {
+			dose.Route = route
+			continue
+		}
+		sigWords = append(sigWords, w)
+	}
+	if len(sigWords) == 0 {
+		return dose, nil
+	}
+	sig, err := ParseSig(strings.Join(sigWords, " "))
+	if err != nil || sig.DoseQuantity != 0 || sig.DoseUnit != "" || sig.Timing != "" || sig.AsNeeded {
+		return Dose{}, &DoseParseError{Text: text, Reason: "only a route and a frequency may follow the amount"}
+	}
+	if sig.Route != "" {
+		dose.Route = sig.Route
+	}
+	dose.Frequency = sig.Frequency
+	return dose, nil
+}
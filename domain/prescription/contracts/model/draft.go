@@ -0,0 +1,39 @@
+package model
+
+import "time"
+
+// PrescriptionDraft is a prescription form saved while it is filled in, so work survives a closed
+// tab or an expired session. Only its owner sees it; it is removed when promoted to a
+// prescription, or when it has not been saved for the drafts TTL.
+type PrescriptionDraft struct {
+	ID      string `json:"id" bson:"_id"` // Chosen by the client, so autosaves of one form replace each other
+	OwnerID string `json:"owner_id" bson:"owner_id"`
+	// PrescriptionID is the prescription the draft edits; empty for a new prescription
+	PrescriptionID string    `json:"prescription_id,omitempty" bson:"prescription_id,omitempty"`
+	Form           DraftForm `json:"form" bson:"form"`
+	CreatedAt      time.Time `json:"created_at" bson:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at" bson:"updated_at"`
+	ExpiresAt      time.Time `json:"expires_at" bson:"expires_at"` // Removed by the expires_at_ttl index
+}
+
+// Expired reports whether the draft is past its expiry; expired drafts may linger in MongoDB until
+// the TTL monitor runs, and are treated as gone
+func (d PrescriptionDraft) Expired(now time.Time) bool {
+	return !d.ExpiresAt.IsZero() && !now.Before(d.ExpiresAt)
+}
+
+// DraftForm holds the fields of a prescription form as entered so far. Nothing is required and
+// values are not checked until the draft is promoted.
+type DraftForm struct {
+	PatientID    string `json:"patient_id,omitempty" bson:"patient_id,omitempty"`
+	PrescriberID string `json:"prescriber_id,omitempty" bson:"prescriber_id,omitempty"`
+	Drug         string `json:"drug,omitempty" bson:"drug,omitempty"`
+	DrugID       string `json:"drug_id,omitempty" bson:"drug_id,omitempty"`
+	Dose         string `json:"dose,omitempty" bson:"dose,omitempty"`
+	Dosage       *Dose  `json:"dosage,omitempty" bson:"dosage,omitempty"`
+	Status       string `json:"status,omitempty" bson:"status,omitempty"`
+	Sig          *Sig   `json:"sig,omitempty" bson:"sig,omitempty"`
+	SigText      string `json:"sig_text,omitempty" bson:"sig_text,omitempty"`
+	Quantity     int    `json:"quantity,omitempty" bson:"quantity,omitempty"`
+	DaysSupply   int    `json:"days_supply,omitempty" bson:"days_supply,omitempty"`
+}
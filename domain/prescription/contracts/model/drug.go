@@ -0,0 +1,105 @@
+package model
+
+import (
+	"slices"
+	"strings"
+)
+
+// DrugMatchType tells which of a drug's names matched a search
+type DrugMatchType string
+
+const (
+	DrugMatchGeneric DrugMatchType = "generic"
+	DrugMatchBrand   DrugMatchType = "brand"
+	DrugMatchSynonym DrugMatchType = "synonym"
+)
+
+// Drug is a drug catalog entry. Name is the canonical generic name; brand names and synonyms
+// resolve to it.
+type Drug struct {
+	ID         string   `json:"id" bson:"_id"`
+	Name       string   `json:"name" bson:"name"`
+	BrandNames []string `json:"brand_names,omitempty" bson:"brand_names,omitempty"`
+	Synonyms   []string `json:"synonyms,omitempty" bson:"synonyms,omitempty"`
+	DrugClass  string   `json:"drug_class,omitempty" bson:"drug_class,omitempty"`
+
+	// SearchNames holds every name normalized, so the catalog can be searched with one index
+	SearchNames []string `json:"-" bson:"search_names"`
+}
+
+// DrugSuggestion is an autocomplete result: the canonical entry plus the name that matched
+type DrugSuggestion struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	MatchedName string        `json:"matched_name"`
+	MatchType   DrugMatchType `json:"match_type"`
+	BrandNames  []string      `json:"brand_names,omitempty"`
+}
+
+// WithSearchNames returns the drug with SearchNames rebuilt from its names
+func (d Drug) WithSearchNames() Drug {
+	names := []string{}
+	for _, name := range d.allNames() {
+		normalized := NormalizeDrugName(name.value)
+		if normalized != "" && !slices.Contains(names, normalized) {
+			names = append(names, normalized)
+		}
+	}
+	d.SearchNames = names
+	return d
+}
+
+// MatchExact reports which name of the drug equals the given name (case-insensitive)
+func (d Drug) MatchExact(name string) (string, DrugMatchType, bool) {
+	normalized := NormalizeDrugName(name)
+	for _, n := range d.allNames() {
+		if NormalizeDrugName(n.value) == normalized {
+			return n.value, n.matchType, true
+		}
+	}
+	return "", "", false
+}
+
+// MatchPrefix reports the first name of the drug, or word within a name, that starts with the
+// query. The generic name is preferred over brand names, and brand names over synonyms.
+func (d Drug) MatchPrefix(query string) (string, DrugMatchType, bool) {
+	normalized := NormalizeDrugName(query)
+	if normalized == "" {
+		return "", "", false
+	}
+	for _, n := range d.allNames() {
+		value := NormalizeDrugName(n.value)
+		if strings.HasPrefix(value, normalized) || strings.Contains(value, " "+normalized) {
+			return n.value, n.matchType, true
+		}
+	}
+	return "", "", false
+}
+
+// Suggestion builds the autocomplete result for a matched name
+func (d Drug) Suggestion(matchedName string, matchType DrugMatchType) DrugSuggestion {
+	return DrugSuggestion{
+		ID:          d.ID,
+		Name:        d.Name,
+		MatchedName: matchedName,
+		MatchType:   matchType,
+		BrandNames:  d.BrandNames,
+	}
+}
+
+type drugName struct {
+	value     string
+	matchType DrugMatchType
+}
+
+func (d Drug) allNames() []drugName {
+	names := make([]drugName, 0, 1+len(d.BrandNames)+len(d.Synonyms))
+	names = append(names, drugName{d.Name, DrugMatchGeneric})
+	for _, brand := range d.BrandNames {
+		names = append(names, drugName{brand, DrugMatchBrand})
+	}
+	for _, synonym := range d.Synonyms {
+		names = append(names, drugName{synonym, DrugMatchSynonym})
+	}
+	return names
+}
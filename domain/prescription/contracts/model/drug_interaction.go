@@ -0,0 +1,59 @@
+package model
+
+import "strings"
+
+type InteractionSeverity string
+
+const (
+	SeverityMinor    InteractionSeverity = "Minor"
+	SeverityModerate InteractionSeverity = "Moderate"
+	SeveritySevere   InteractionSeverity = "Severe"
+)
+
+// DrugInteraction is a known interaction between two drugs
+type DrugInteraction struct {
+	ID          string              `json:"id" bson:"_id"`
+	DrugA       string              `json:"drug_a" bson:"drug_a"`
+	DrugB       string              `json:"drug_b" bson:"drug_b"`
+	Severity    InteractionSeverity `json:"severity" bson:"severity"`
+	Description string              `json:"description" bson:"description"`
+
+	// DrugNames holds both drug names normalized, so a drug's pairs are found with one index
+	DrugNames []string `json:"-" bson:"drug_names"`
+}
+
+// WithDrugNames returns the interaction with DrugNames rebuilt from its drugs
+func (i DrugInteraction) WithDrugNames() DrugInteraction {
+	i.DrugNames = []string{NormalizeDrugName(i.DrugA), NormalizeDrugName(i.DrugB)}
+	return i
+}
+
+// Involves reports whether the interaction pairs the two given drugs (in either order)
+func (i DrugInteraction) Involves(drug, otherDrug string) bool {
+	a, b := NormalizeDrugName(i.DrugA), NormalizeDrugName(i.DrugB)
+	x, y := NormalizeDrugName(drug), NormalizeDrugName(otherDrug)
+	return (a == x && b == y) || (a == y && b == x)
+}
+
+// DrugInteractionWarning describes an interaction found between a new drug and
+// one of the patient's existing prescriptions
+type DrugInteractionWarning struct {
+	Drug                      string              `json:"drug" bson:"drug"`
+	InteractingDrug           string              `json:"interacting_drug" bson:"interacting_drug"`
+	InteractingPrescriptionID string              `json:"interacting_prescription_id" bson:"interacting_prescription_id"`
+	Severity                  InteractionSeverity `json:"severity" bson:"severity"`
+	Description               string              `json:"description" bson:"description"`
+}
+
+// InteractionCheckResult is the outcome of checking a new drug against a patient's prescriptions
+// and recorded allergies; any allergy warning blocks the drug
+type InteractionCheckResult struct {
+	Warnings        []DrugInteractionWarning `json:"warnings"`
+	AllergyWarnings []DrugAllergyWarning     `json:"allergy_warnings"`
+	Blocked         bool                     `json:"blocked"`
+}
+
+// NormalizeDrugName lower-cases and trims a drug name for comparison
+func NormalizeDrugName(drug string) string {
+	return strings.ToLower(strings.TrimSpace(drug))
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// FulfillmentStatus tracks a prescription's progress at the external pharmacy
+type FulfillmentStatus string
+
+const (
+	FulfillmentSent           FulfillmentStatus = "Sent"
+	FulfillmentReceived       FulfillmentStatus = "Received"
+	FulfillmentInProgress     FulfillmentStatus = "InProgress"
+	FulfillmentReadyForPickup FulfillmentStatus = "ReadyForPickup"
+	FulfillmentDispensed      FulfillmentStatus = "Dispensed"
+	FulfillmentCancelled      FulfillmentStatus = "Cancelled"
+)
+
+// IsTerminal reports whether the pharmacy will not move the prescription any further
+func (s FulfillmentStatus) IsTerminal() bool {
+	return s == FulfillmentDispensed || s == FulfillmentCancelled
+}
+
+// vendorFulfillmentStatuses maps the status strings returned by pharmacy vendors
+var vendorFulfillmentStatuses = map[string]FulfillmentStatus{
+	"sent":             FulfillmentSent,
+	"submitted":        FulfillmentSent,
+	"received":         FulfillmentReceived,
+	"queued":           FulfillmentReceived,
+	"processing":       FulfillmentInProgress,
+	"in_progress":      FulfillmentInProgress,
+	"filling":          FulfillmentInProgress,
+	"ready":            FulfillmentReadyForPickup,
+	"ready_for_pickup": FulfillmentReadyForPickup,
+	"dispensed":        FulfillmentDispensed,
+	"picked_up":        FulfillmentDispensed,
+	"shipped":          FulfillmentDispensed,
+	"completed":        FulfillmentDispensed,
+	"cancelled":        FulfillmentCancelled,
+	"canceled":         FulfillmentCancelled,
+	"rejected":         FulfillmentCancelled,
+}
+
+// MapVendorFulfillmentStatus converts a vendor status into a FulfillmentStatus.
+// The second return value is false for statuses we don't recognize.
+func MapVendorFulfillmentStatus(vendorStatus string) (FulfillmentStatus, bool) {
+	key := strings.ToLower(strings.TrimSpace(vendorStatus))
+	key = strings.NewReplacer(" ", "_", "-", "_").Replace(key)
+	s, ok := vendorFulfillmentStatuses[key]
+	return s, ok
+}
+
+// FulfillmentStatusChanged is emitted when polling detects a new fulfillment status
+type FulfillmentStatusChanged struct {
+	PrescriptionID string            `json:"prescription_id"`
+	PatientID      string            `json:"patient_id"`
+	Previous       FulfillmentStatus `json:"previous,omitempty"`
+	Current        FulfillmentStatus `json:"current"`
+	VendorStatus   string            `json:"vendor_status"`
+	ChangedAt      time.Time         `json:"changed_at"`
+}
@@ -0,0 +1,47 @@
+package model
+
+import "time"
+
+// HistoryEventType is a step in a prescription's lifecycle
+type HistoryEventType string
+
+const (
+	HistoryCreated       HistoryEventType = "CREATED"
+	HistoryStatusChanged HistoryEventType = "STATUS_CHANGED"
+	HistoryDispensed     HistoryEventType = "DISPENSED"
+	// HistoryDispenseReversed is a dispense given back; Reason holds the reversal reason code and note
+	HistoryDispenseReversed HistoryEventType = "DISPENSE_REVERSED"
+	// HistoryRouted is the prescription sent to a network pharmacy; a re-route also names the
+	// pharmacy it was transferred from
+	HistoryRouted HistoryEventType = "ROUTED"
+)
+
+// PrescriptionHistoryEvent is one entry of a prescription's lifecycle, read from the audit trail
+type PrescriptionHistoryEvent struct {
+	ID             string           `json:"id"`
+	PrescriptionID string           `json:"prescription_id"`
+	Type           HistoryEventType `json:"type"`
+	At             time.Time        `json:"at"`
+	// Actor is the user who caused the event; empty for scheduled jobs
+	Actor  string `json:"actor,omitempty"`
+	Reason string `json:"reason,omitempty"`
+
+	// FromStatus is empty for Created events
+	FromStatus Status `json:"from_status,omitempty"`
+	ToStatus   Status `json:"to_status,omitempty"`
+
+	DispenseID string `json:"dispense_id,omitempty"`
+
+	PharmacyID   string `json:"pharmacy_id,omitempty"`
+	PharmacyName string `json:"pharmacy_name,omitempty"`
+	// PreviousPharmacyID is set when a routed prescription is moved to another pharmacy
+	PreviousPharmacyID string `json:"previous_pharmacy_id,omitempty"`
+}
+
+// PrescriptionHistoryConnection is a page of history events, oldest first
+type PrescriptionHistoryConnection struct {
+	Events []PrescriptionHistoryEvent `json:"events"`
+	// EndCursor continues after the last event of the page; empty when the page is empty
+	EndCursor   string `json:"end_cursor,omitempty"`
+	HasNextPage bool   `json:"has_next_page"`
+}
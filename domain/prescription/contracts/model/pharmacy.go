@@ -0,0 +1,45 @@
+package model
+
+import "time"
+
+// Pharmacy is the network pharmacy a prescription was routed to
+type Pharmacy struct {
+	ID       string    `json:"id" bson:"id"`
+	Name     string    `json:"name" bson:"name"`
+	Type     string    `json:"type" bson:"type"`
+	Address  string    `json:"address" bson:"address"`
+	City     string    `json:"city" bson:"city"`
+	State    string    `json:"state" bson:"state"`
+	Zip      string    `json:"zip" bson:"zip"`
+	Phone    string    `json:"phone" bson:"phone"`
+	RoutedAt time.Time `json:"routed_at" bson:"routed_at"`
+}
+
+// NetworkPharmacy is a pharmacy in the IRIS pharmacy network
+type NetworkPharmacy struct {
+	ID                     string `json:"id"`
+	Name                   string `json:"name"`
+	Type                   string `json:"type"`
+	Address                string `json:"address"`
+	City                   string `json:"city"`
+	State                  string `json:"state"`
+	Zip                    string `json:"zip"`
+	Phone                  string `json:"phone"`
+	AcceptingPrescriptions bool   `json:"accepting_prescriptions"`
+}
+
+// PharmacySearchResult is a page of network pharmacies; Total counts every match
+type PharmacySearchResult struct {
+	Pharmacies []NetworkPharmacy `json:"pharmacies"`
+	Total      int               `json:"total"`
+}
+
+// CanReroute reports whether a prescription with this fulfillment status may be routed to a
+// pharmacy; once the pharmacy has started filling it, the route is final
+func (s FulfillmentStatus) CanReroute() bool {
+	switch s {
+	case FulfillmentInProgress, FulfillmentReadyForPickup, FulfillmentDispensed:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// Prescriber is a clinician who writes prescriptions, identified by their National Provider Identifier
+type Prescriber struct {
+	ID  string `json:"id" bson:"_id"`
+	NPI string `json:"npi" bson:"npi"` // 10 digits with a check digit; unique across prescribers
+
+	FirstName string `json:"first_name" bson:"first_name"`
+	LastName  string `json:"last_name" bson:"last_name"`
+	// Credential is the degree shown after the name, e.g. MD, DO or NP
+	Credential string `json:"credential,omitempty" bson:"credential,omitempty"`
+	Specialty  string `json:"specialty,omitempty" bson:"specialty,omitempty"`
+
+	Phone string `json:"phone,omitempty" bson:"phone,omitempty"`
+	Fax   string `json:"fax,omitempty" bson:"fax,omitempty"`
+	Email string `json:"email,omitempty" bson:"email,omitempty"`
+
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" bson:"updated_at"`
+}
+
+// DisplayName returns the name as printed on a prescription, e.g. "Dana Whitfield, MD"
+func (p Prescriber) DisplayName() string {
+	name := strings.TrimSpace(p.FirstName + " " + p.LastName)
+	if p.Credential != "" {
+		name += ", " + p.Credential
+	}
+	return name
+}
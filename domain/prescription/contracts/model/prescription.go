@@ -9,13 +9,54 @@ const (
 	Active    Status = "Active"
 	Paused    Status = "Paused"
 	Completed Status = "Completed"
+	// Expired is set by the expiration job on active prescriptions that were never completed
+	Expired Status = "Expired"
 )
 
+// Closed reports whether the prescription is no longer current: completed or expired
+func (s Status) Closed() bool {
+	return s == Completed || s == Expired
+}
+
 type Prescription struct {
-	ID        string    `json:"id" bson:"_id"`
-	PatientID string    `json:"patient_id" bson:"patient_id"`
-	Drug      string    `json:"drug" bson:"drug"`
-	Dose      string    `json:"dose" bson:"dose"`
-	Status    Status    `json:"status" bson:"status"`
-	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+	ID        string `json:"id" bson:"_id"`
+	PatientID string `json:"patient_id" bson:"patient_id"`
+	Drug      string `json:"drug" bson:"drug"` // Canonical catalog name when the drug is in the catalog
+	// DrugID is the drug catalog entry; empty when the drug is not in the catalog
+	DrugID string `json:"drug_id,omitempty" bson:"drug_id,omitempty"`
+	// DrugEntered is the name as the prescriber entered it, which may be a brand name or synonym
+	DrugEntered string    `json:"drug_entered,omitempty" bson:"drug_entered,omitempty"`
+	Dose        string    `json:"dose" bson:"dose"` // Dosage as text, or the free text of a dose that predates it
+	Status      Status    `json:"status" bson:"status"`
+	CreatedAt   time.Time `json:"created_at" bson:"created_at"`
+
+	// Dosage is the structured dose; nil on prescriptions whose free-text dose could not be read
+	Dosage *Dose `json:"dosage,omitempty" bson:"dosage,omitempty"`
+
+	// Sig is the dosing instruction; the zero value means not specified
+	Sig Sig `json:"sig,omitempty" bson:"sig,omitempty"`
+	// Quantity and DaysSupply describe the supply; zero values mean not specified
+	Quantity   int `json:"quantity,omitempty" bson:"quantity,omitempty"`
+	DaysSupply int `json:"days_supply,omitempty" bson:"days_supply,omitempty"`
+	// ExpectedEndDate is when the days supply runs out, counted from creation
+	ExpectedEndDate *time.Time `json:"expected_end_date,omitempty" bson:"expected_end_date,omitempty"`
+	// PrescribedBy is the ID of the user who created the prescription
+	PrescribedBy string `json:"prescribed_by,omitempty" bson:"prescribed_by,omitempty"`
+	// PrescriberID is the clinician the prescription is written under; required on new
+	// prescriptions, empty on ones that predate prescribers
+	PrescriberID string `json:"prescriber_id,omitempty" bson:"prescriber_id,omitempty"`
+
+	InteractionWarnings []DrugInteractionWarning `json:"interaction_warnings,omitempty" bson:"interaction_warnings,omitempty"`
+
+	FulfillmentStatus    FulfillmentStatus `json:"fulfillment_status,omitempty" bson:"fulfillment_status,omitempty"`
+	FulfillmentUpdatedAt *time.Time        `json:"fulfillment_updated_at,omitempty" bson:"fulfillment_updated_at,omitempty"`
+
+	// Pharmacy is set once the prescription is routed to a network pharmacy
+	Pharmacy *Pharmacy `json:"pharmacy,omitempty" bson:"pharmacy,omitempty"`
+
+	// OrgID is the organization the prescription belongs to when tenancy is enabled
+	OrgID string `json:"org_id,omitempty" bson:"org_id,omitempty"`
 }
+
+// IsEntity marks Prescription as a GraphQL federation entity
+func (Prescription) IsEntity() {}
@@ -0,0 +1,301 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Sig is the structured dosing instruction of a prescription, e.g. 1 tablet by mouth twice daily
+// with food. Render turns it into label text.
+type Sig struct {
+	DoseQuantity float64   `json:"dose_quantity,omitempty" bson:"dose_quantity,omitempty"` // Units per dose; 1 when not set
+	DoseUnit     DoseUnit  `json:"dose_unit,omitempty" bson:"dose_unit,omitempty"`
+	Route        Route     `json:"route,omitempty" bson:"route,omitempty"`
+	Frequency    Frequency `json:"frequency,omitempty" bson:"frequency,omitempty"`
+	Timing       SigTiming `json:"timing,omitempty" bson:"timing,omitempty"`
+	// AsNeeded (PRN) makes the frequency the most the patient may take
+	AsNeeded   bool   `json:"as_needed,omitempty" bson:"as_needed,omitempty"`
+	Indication string `json:"indication,omitempty" bson:"indication,omitempty"` // What an as-needed dose is for, e.g. "pain"
+}
+
+// IsZero reports whether no part of the sig is set
+func (s Sig) IsZero() bool {
+	return s == Sig{}
+}
+
+// UnitsPerDose returns the dose quantity, defaulting to one unit
+func (s Sig) UnitsPerDose() float64 {
+	if s.DoseQuantity <= 0 {
+		return 1
+	}
+	return s.DoseQuantity
+}
+
+// UnitsPerDay returns the units the sig schedules per day; for as-needed sigs this is the
+// maximum. ok is false when the sig has no scheduled frequency.
+func (s Sig) UnitsPerDay() (float64, bool) {
+	perDay, ok := s.Frequency.DosesPerDay()
+	if !ok {
+		return 0, false
+	}
+	return perDay * s.UnitsPerDose(), true
+}
+
+// DoseUnit is what one unit of a dose is
+type DoseUnit string
+
+const (
+	UnitTablet      DoseUnit = "tablet"
+	UnitCapsule     DoseUnit = "capsule"
+	UnitMilliliter  DoseUnit = "mL"
+	UnitPuff        DoseUnit = "puff"
+	UnitDrop        DoseUnit = "drop"
+	UnitSpray       DoseUnit = "spray"
+	UnitPatch       DoseUnit = "patch"
+	UnitApplication DoseUnit = "application"
+	UnitUnit        DoseUnit = "unit" // Insulin and other unit-dosed drugs
+)
+
+// MaxSigIndicationLength bounds the free-text indication of an as-needed sig
+const MaxSigIndicationLength = 100
+
+var maxDosePerUnit = map[DoseUnit]float64{
+	UnitTablet:      4,
+	UnitCapsule:     4,
+	UnitMilliliter:  30,
+	UnitPuff:        4,
+	UnitDrop:        4,
+	UnitSpray:       4,
+	UnitPatch:       2,
+	UnitApplication: 2,
+	UnitUnit:        100,
+}
+
+// MaxPerDose returns the largest dose quantity accepted in the unit; without a unit a dose is
+// counted in tablets
+func (u DoseUnit) MaxPerDose() float64 {
+	if limit, ok := maxDosePerUnit[u]; ok {
+		return limit
+	}
+	return maxDosePerUnit[UnitTablet]
+}
+
+// Route is how a dose is given
+type Route string
+
+const (
+	RouteOral          Route = "oral"
+	RouteSublingual    Route = "sublingual"
+	RouteTopical       Route = "topical"
+	RouteTransdermal   Route = "transdermal"
+	RouteInhaled       Route = "inhaled"
+	RouteNasal         Route = "nasal"
+	RouteOphthalmic    Route = "ophthalmic"
+	RouteOtic          Route = "otic"
+	RouteRectal        Route = "rectal"
+	RouteSubcutaneous  Route = "subcutaneous"
+	RouteIntramuscular Route = "intramuscular"
+)
+
+// SigTiming ties doses to meals or a time of day
+type SigTiming string
+
+const (
+	TimingWithFood     SigTiming = "with_food"
+	TimingBeforeMeals  SigTiming = "before_meals"
+	TimingAfterMeals   SigTiming = "after_meals"
+	TimingEmptyStomach SigTiming = "empty_stomach"
+	TimingMorning      SigTiming = "morning"
+	TimingEvening      SigTiming = "evening"
+	TimingBedtime      SigTiming = "bedtime"
+)
+
+// Language selects the text of a rendered sig
+type Language string
+
+const (
+	LanguageEnglish Language = "en"
+	LanguageSpanish Language = "es"
+)
+
+// Languages lists the languages sigs are rendered in
+var Languages = []Language{LanguageEnglish, LanguageSpanish}
+
+// sigText holds the words of one language
+type sigText struct {
+	unit      map[DoseUnit][2]string // Singular and plural
+	verb      map[Route]string
+	route     map[Route]string
+	frequency map[Frequency]string
+	timing    map[SigTiming]string
+	defVerb   string
+	asNeeded  string
+	forWord   string
+}
+
+var sigTexts = map[Language]sigText{
+	LanguageEnglish: {
+		unit: map[DoseUnit][2]string{
+			UnitTablet: {"tablet", "tablets"}, UnitCapsule: {"capsule", "capsules"}, UnitMilliliter: {"mL", "mL"},
+			UnitPuff: {"puff", "puffs"}, UnitDrop: {"drop", "drops"}, UnitSpray: {"spray", "sprays"},
+			UnitPatch: {"patch", "patches"}, UnitApplication: {"application", "applications"}, UnitUnit: {"unit", "units"},
+		},
+		verb: map[Route]string{
+			RouteOral: "Take", RouteSublingual: "Dissolve", RouteTopical: "Apply", RouteTransdermal: "Apply",
+			RouteInhaled: "Inhale", RouteNasal: "Use", RouteOphthalmic: "Instill", RouteOtic: "Instill",
+			RouteRectal: "Insert", RouteSubcutaneous: "Inject", RouteIntramuscular: "Inject",
+		},
+		route: map[Route]string{
+			RouteOral: "by mouth", RouteSublingual: "under the tongue", RouteTopical: "to the skin",
+			RouteTransdermal: "to the skin", RouteInhaled: "by mouth", RouteNasal: "in each nostril",
+			RouteOphthalmic: "in the affected eye", RouteOtic: "in the affected ear", RouteRectal: "rectally",
+			RouteSubcutaneous: "under the skin", RouteIntramuscular: "into the muscle",
+		},
+		frequency: map[Frequency]string{
+			FrequencyDaily: "once daily", FrequencyBID: "twice daily", FrequencyTID: "three times daily",
+			FrequencyQID: "four times daily", FrequencyEvery4h: "every 4 hours", FrequencyEvery6h: "every 6 hours",
+			FrequencyEvery8h: "every 8 hours", FrequencyEvery12h: "every 12 hours", FrequencyBedtime: "at bedtime",
+			FrequencyWeekly: "once weekly",
+		},
+		timing: map[SigTiming]string{
+			TimingWithFood: "with food", TimingBeforeMeals: "before meals", TimingAfterMeals: "after meals",
+			TimingEmptyStomach: "on an empty stomach", TimingMorning: "in the morning",
+			TimingEvening: "in the evening", TimingBedtime: "at bedtime",
+		},
+		defVerb:  "Take",
+		asNeeded: "as needed",
+		forWord:  "for",
+	},
+	LanguageSpanish: {
+		unit: map[DoseUnit][2]string{
+			UnitTablet: {"tableta", "tabletas"}, UnitCapsule: {"cápsula", "cápsulas"}, UnitMilliliter: {"mL", "mL"},
+			UnitPuff: {"inhalación", "inhalaciones"}, UnitDrop: {"gota", "gotas"}, UnitSpray: {"atomización", "atomizaciones"},
+			UnitPatch: {"parche", "parches"}, UnitApplication: {"aplicación", "aplicaciones"}, UnitUnit: {"unidad", "unidades"},
+		},
+		verb: map[Route]string{
+			RouteOral: "Tome", RouteSublingual: "Disuelva", RouteTopical: "Aplique", RouteTransdermal: "Aplique",
+			RouteInhaled: "Inhale", RouteNasal: "Use", RouteOphthalmic: "Aplique", RouteOtic: "Aplique",
+			RouteRectal: "Introduzca", RouteSubcutaneous: "Inyecte", RouteIntramuscular: "Inyecte",
+		},
+		route: map[Route]string{
+			RouteOral: "por vía oral", RouteSublingual: "debajo de la lengua", RouteTopical: "sobre la piel",
+			RouteTransdermal: "sobre la piel", RouteInhaled: "por la boca", RouteNasal: "en cada fosa nasal",
+			RouteOphthalmic: "en el ojo afectado", RouteOtic: "en el oído afectado", RouteRectal: "por vía rectal",
+			RouteSubcutaneous: "debajo de la piel", RouteIntramuscular: "en el músculo",
+		},
+		frequency: map[Frequency]string{
+			FrequencyDaily: "una vez al día", FrequencyBID: "dos veces al día", FrequencyTID: "tres veces al día",
+			FrequencyQID: "cuatro veces al día", FrequencyEvery4h: "cada 4 horas", FrequencyEvery6h: "cada 6 horas",
+			FrequencyEvery8h: "cada 8 horas", FrequencyEvery12h: "cada 12 horas", FrequencyBedtime: "al acostarse",
+			FrequencyWeekly: "una vez a la semana",
+		},
+		timing: map[SigTiming]string{
+			TimingWithFood: "con alimentos", TimingBeforeMeals: "antes de las comidas", TimingAfterMeals: "después de las comidas",
+			TimingEmptyStomach: "con el estómago vacío", TimingMorning: "por la mañana",
+			TimingEvening: "por la noche", TimingBedtime: "al acostarse",
+		},
+		defVerb:  "Tome",
+		asNeeded: "según sea necesario",
+		forWord:  "para",
+	},
+}
+
+// Valid reports whether the unit is a known one
+func (u DoseUnit) Valid() bool {
+	_, ok := sigTexts[LanguageEnglish].unit[u]
+	return ok
+}
+
+// Valid reports whether the route is a known one
+func (r Route) Valid() bool {
+	_, ok := sigTexts[LanguageEnglish].route[r]
+	return ok
+}
+
+// Valid reports whether the timing is a known one
+func (t SigTiming) Valid() bool {
+	_, ok := sigTexts[LanguageEnglish].timing[t]
+	return ok
+}
+
+// Text returns the frequency as it reads in rendered directions, e.g. "twice daily"
+func (f Frequency) Text(language Language) string {
+	return sigTexts[language].frequency[f]
+}
+
+// Text returns the route as it reads in rendered directions, e.g. "by mouth"
+func (r Route) Text(language Language) string {
+	return sigTexts[language].route[r]
+}
+
+// Text returns the timing as it reads in rendered directions, e.g. "with food"
+func (t SigTiming) Text(language Language) string {
+	return sigTexts[language].timing[t]
+}
+
+// DoseUnits, Routes and SigTimings list the accepted values in the order the UI offers them
+var (
+	DoseUnits  = []DoseUnit{UnitTablet, UnitCapsule, UnitMilliliter, UnitPuff, UnitDrop, UnitSpray, UnitPatch, UnitApplication, UnitUnit}
+	Routes     = []Route{RouteOral, RouteSublingual, RouteTopical, RouteTransdermal, RouteInhaled, RouteNasal, RouteOphthalmic, RouteOtic, RouteRectal, RouteSubcutaneous, RouteIntramuscular}
+	SigTimings = []SigTiming{TimingWithFood, TimingBeforeMeals, TimingAfterMeals, TimingEmptyStomach, TimingMorning, TimingEvening, TimingBedtime}
+)
+
+// Render returns the sig as label text in the language, e.g. "Take 1 tablet by mouth twice daily
+// with food." Unknown languages render in English, and an empty sig renders as "".
+// The indication is free text and is not translated.
+func (s Sig) Render(language Language) string {
+	if s.IsZero() {
+		return ""
+	}
+	text, ok := sigTexts[language]
+	if !ok {
+		text = sigTexts[LanguageEnglish]
+	}
+
+	verb := text.defVerb
+	if v, ok := text.verb[s.Route]; ok {
+		verb = v
+	}
+	parts := []string{verb}
+	if s.DoseQuantity > 0 || s.DoseUnit != "" {
+		parts = append(parts, formatDoseQuantity(s.UnitsPerDose()))
+		if names, ok := text.unit[s.DoseUnit]; ok {
+			name := names[0]
+			if s.UnitsPerDose() > 1 {
+				name = names[1]
+			}
+			parts = append(parts, name)
+		}
+	}
+	for _, part := range []string{text.route[s.Route], text.frequency[s.Frequency]} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	// "at bedtime" once is enough
+	if timing := text.timing[s.Timing]; timing != "" && timing != text.frequency[s.Frequency] {
+		parts = append(parts, timing)
+	}
+	if s.AsNeeded {
+		parts = append(parts, text.asNeeded)
+		if s.Indication != "" {
+			parts = append(parts, text.forWord, s.Indication)
+		}
+	}
+	return strings.Join(parts, " ") + "."
+}
+
+// formatDoseQuantity writes halves the way labels do, e.g. "1/2" and "1 1/2"
+func formatDoseQuantity(q float64) string {
+	whole := float64(int(q))
+	switch q - whole {
+	case 0:
+		return strconv.Itoa(int(whole))
+	case 0.5:
+		if whole == 0 {
+			return "1/2"
+		}
+		return strconv.Itoa(int(whole)) + " 1/2"
+	}
+	return strconv.FormatFloat(q, 'f', -1, 64)
+}
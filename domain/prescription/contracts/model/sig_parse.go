@@ -0,0 +1,207 @@
+package model
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SigParseError lists the words ParseSig could not place
+type SigParseError struct {
+	Unrecognized []string
+}
+
+func (e *SigParseError) Error() string {
+	return "unrecognized sig text: " + strings.Join(e.Unrecognized, ", ")
+}
+
+// sigPhrase is a run of words that sets one part of a sig
+type sigPhrase struct {
+	words []string
+	apply func(*Sig)
+}
+
+// sigPhrases holds every phrase ParseSig knows, longest first so "every 4 hours" wins over
+// "4". Phrases come from the rendered text of every language plus the usual abbreviations.
+var sigPhrases = buildSigPhrases()
+
+var sigAbbreviations = map[string]func(*Sig){
+	"po": setRoute(RouteOral), "sl": setRoute(RouteSublingual), "top": setRoute(RouteTopical),
+	"inh": setRoute(RouteInhaled), "subq": setRoute(RouteSubcutaneous), "sc": setRoute(RouteSubcutaneous),
+	"im": setRoute(RouteIntramuscular), "pr": setRoute(RouteRectal), "od": setRoute(RouteOphthalmic),
+	"daily": setFrequency(FrequencyDaily), "q day": setFrequency(FrequencyDaily),
+	"once a day": setFrequency(FrequencyDaily), "twice a day": setFrequency(FrequencyBID),
+	"three times a day": setFrequency(FrequencyTID), "four times a day": setFrequency(FrequencyQID),
+	"weekly": setFrequency(FrequencyWeekly), "hs": setFrequency(FrequencyBedtime),
+	"ac": setTiming(TimingBeforeMeals), "pc": setTiming(TimingAfterMeals), "with meals": setTiming(TimingWithFood),
+	"prn": setAsNeeded, "as needed": setAsNeeded, "as directed": func(*Sig) {},
+	"tab": setUnit(UnitTablet), "tabs": setUnit(UnitTablet), "cap": setUnit(UnitCapsule),
+	"caps": setUnit(UnitCapsule), "gtt": setUnit(UnitDrop), "gtts": setUnit(UnitDrop),
+	"units": setUnit(UnitUnit), "ml": setUnit(UnitMilliliter),
+}
+
+var sigNumberWords = map[string]float64{
+	"half": 0.5, "one": 1, "two": 2, "three": 3, "four": 4,
+	"media": 0.5, "una": 1, "uno": 1, "dos": 2, "tres": 3, "cuatro": 4,
+}
+
+func buildSigPhrases() []sigPhrase {
+	seen := map[string]bool{}
+	var phrases []sigPhrase
+	add := func(text string, apply func(*Sig)) {
+		text = strings.ToLower(text)
+		if seen[text] {
+			return // The first meaning wins, e.g. "by mouth" is oral rather than inhaled
+		}
+		seen[text] = true
+		phrases = append(phrases, sigPhrase{words: strings.Fields(text), apply: apply})
+	}
+
+	// The frequency codes themselves, e.g. "BID"
+	for _, f := range Frequencies {
+		add(string(f), setFrequency(f))
+	}
+	for _, language := range Languages {
+		text := sigTexts[language]
+		for _, r := range Routes {
+			add(text.route[r], setRoute(r))
+			add(text.verb[r], func(*Sig) {})
+		}
+		for _, f := range Frequencies {
+			add(text.frequency[f], setFrequency(f))
+		}
+		for _, t := range SigTimings {
+			add(text.timing[t], setTiming(t))
+		}
+		for _, u := range DoseUnits {
+			add(text.unit[u][0], setUnit(u))
+			add(text.unit[u][1], setUnit(u))
+		}
+		add(text.asNeeded, setAsNeeded)
+	}
+	for text, apply := range sigAbbreviations {
+		add(text, apply)
+	}
+
+	sort.SliceStable(phrases, func(i, j int) bool { return len(phrases[i].words) > len(phrases[j].words) })
+	return phrases
+}
+
+func setRoute(r Route) func(*Sig)      { return func(s *Sig) { s.Route = r } }
+func setUnit(u DoseUnit) func(*Sig)    { return func(s *Sig) { s.DoseUnit = u } }
+func setTiming(t SigTiming) func(*Sig) { return func(s *Sig) { s.Timing = t } }
+func setAsNeeded(s *Sig)               { s.AsNeeded = true }
+func setFrequency(f Frequency) func(*Sig) {
+	return func(s *Sig) {
+		// "at bedtime" after a frequency is the timing of that frequency
+		if s.Frequency != "" && f == FrequencyBedtime {
+			s.Timing = TimingBedtime
+			return
+		}
+		s.Frequency = f
+	}
+}
+
+// ParseSig reads free-text directions, such as those of imported prescriptions, into a Sig. It
+// understands the text Render produces in every language and the common abbreviations, e.g.
+// "1 tab po bid prn pain". Words after "for" or "para" on an as-needed sig become the indication.
+// When some words are not understood the sig parsed so far is returned with a *SigParseError.
+func ParseSig(text string) (Sig, error) {
+	original := strings.Fields(strings.NewReplacer(",", " ", ";", " ", "\n", " ").Replace(text))
+	words := make([]string, len(original))
+	for i, w := range original {
+		original[i] = strings.TrimSuffix(w, ".")
+		words[i] = strings.ToLower(original[i])
+	}
+
+	var sig Sig
+	var unrecognized []string
+	for i := 0; i < len(words); {
+		if words[i] == "" {
+			i++
+			continue
+		}
+		if (words[i] == "for" || words[i] == "para") && sig.AsNeeded && i+1 < len(words) {
+			sig.Indication = strings.Join(original[i+1:], " ")
+			break
+		}
+		if n := matchSigPhrase(words[i:], &sig); n > 0 {
+			i += n
+			continue
+		}
+		if q, ok := parseSigNumber(words[i]); ok && sig.DoseQuantity == 0 {
+			// "1 1/2"
+			if i+1 < len(words) {
+				if fraction, ok := parseSigNumber(words[i+1]); ok && fraction < 1 {
+					q += fraction
+					i++
+				}
+			}
+			sig.DoseQuantity = q
+			i++
+			continue
+		}
+		unrecognized = append(unrecognized, original[i])
+		i++
+	}
+
+	// "prn pain" without "for"
+	if sig.AsNeeded && sig.Indication == "" && len(unrecognized) > 0 && isTrailing(original, unrecognized) {
+		sig.Indication = strings.Join(unrecognized, " ")
+		unrecognized = nil
+	}
+	if len(unrecognized) > 0 {
+		return sig, &SigParseError{Unrecognized: unrecognized}
+	}
+	return sig, nil
+}
+
+func matchSigPhrase(words []string, sig *Sig) int {
+	for _, phrase := range sigPhrases {
+		if len(phrase.words) > len(words) {
+			continue
+		}
+		matched := true
+		for j, w := range phrase.words {
+			if words[j] != w {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			phrase.apply(sig)
+			return len(phrase.words)
+		}
+	}
+	return 0
+}
+
+func parseSigNumber(word string) (float64, bool) {
+	if n, ok := sigNumberWords[word]; ok {
+		return n, true
+	}
+	if num, den, found := strings.Cut(word, "/"); found {
+		n, err1 := strconv.ParseFloat(num, 64)
+		d, err2 := strconv.ParseFloat(den, 64)
+		if err1 != nil || err2 != nil || d == 0 {
+			return 0, false
+		}
+		return n / d, true
+	}
+	n, err := strconv.ParseFloat(word, 64)
+	return n, err == nil && n > 0
+}
+
+// isTrailing reports whether tail is the last words of words
+func isTrailing(words, tail []string) bool {
+	if len(tail) > len(words) {
+		return false
+	}
+	offset := len(words) - len(tail)
+	for i, w := range tail {
+		if words[offset+i] != w {
+			return false
+		}
+	}
+	return true
+}
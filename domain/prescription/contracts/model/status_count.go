@@ -0,0 +1,7 @@
+package model
+
+// StatusCount is the number of prescriptions with a status
+type StatusCount struct {
+	Status Status `json:"status" bson:"_id"`
+	Count  int    `json:"count" bson:"count"`
+}
@@ -0,0 +1,44 @@
+package model
+
+import "slices"
+
+// statusTransitions are the statuses a prescription may move to from each status. Completed
+// prescriptions only go back to Active by being reopened, and Expired is set by the expiration
+// job alone, so neither is reached or left here.
+var statusTransitions = map[Status][]Status{
+	Draft:  {Active},
+	Active: {Paused, Completed},
+	Paused: {Active, Completed},
+}
+
+// CanTransition reports whether a prescription may move from one status to another
+func CanTransition(from, to Status) bool {
+	return slices.Contains(statusTransitions[from], to)
+}
+
+// BulkStatusOutcome says what a bulk status change did to one prescription
+type BulkStatusOutcome string
+
+const (
+	BulkStatusUpdated   BulkStatusOutcome = "updated"
+	BulkStatusUnchanged BulkStatusOutcome = "unchanged" // Already had the status
+	BulkStatusRejected  BulkStatusOutcome = "rejected"  // The status cannot be reached from the current one
+	BulkStatusNotFound  BulkStatusOutcome = "not_found"
+	BulkStatusConflict  BulkStatusOutcome = "conflict" // Changed by someone else while the operation ran
+)
+
+// BulkStatusResult is the outcome of a bulk status change for one prescription
+type BulkStatusResult struct {
+	PrescriptionID string            `json:"prescription_id"`
+	Outcome        BulkStatusOutcome `json:"outcome"`
+	PreviousStatus Status            `json:"previous_status,omitempty"`
+	Status         Status            `json:"status,omitempty"` // Status after the operation
+	Message        string            `json:"message,omitempty"`
+}
+
+// StatusChange moves one prescription from a status to another
+type StatusChange struct {
+	ID   string
+	From Status
+	To   Status
+}
@@ -0,0 +1,88 @@
+package model
+
+import (
+	"math"
+	"time"
+)
+
+// Frequency is how often a dose is taken, as the usual sig abbreviation
+type Frequency string
+
+const (
+	FrequencyDaily    Frequency = "QD"
+	FrequencyBID      Frequency = "BID"
+	FrequencyTID      Frequency = "TID"
+	FrequencyQID      Frequency = "QID"
+	FrequencyEvery4h  Frequency = "Q4H"
+	FrequencyEvery6h  Frequency = "Q6H"
+	FrequencyEvery8h  Frequency = "Q8H"
+	FrequencyEvery12h Frequency = "Q12H"
+	FrequencyBedtime  Frequency = "QHS"
+	FrequencyWeekly   Frequency = "QWK"
+)
+
+// Supply limits accepted on a prescription
+const (
+	MaxDaysSupply = 365
+	MaxQuantity   = 10000
+)
+
+var frequencyDosesPerDay = map[Frequency]float64{
+	FrequencyDaily:    1,
+	FrequencyBID:      2,
+	FrequencyTID:      3,
+	FrequencyQID:      4,
+	FrequencyEvery4h:  6,
+	FrequencyEvery6h:  4,
+	FrequencyEvery8h:  3,
+	FrequencyEvery12h: 2,
+	FrequencyBedtime:  1,
+	FrequencyWeekly:   1.0 / 7,
+}
+
+// Frequencies lists the accepted frequency codes in the order the UI offers them
+var Frequencies = []Frequency{
+	FrequencyDaily, FrequencyBID, FrequencyTID, FrequencyQID, FrequencyEvery4h, FrequencyEvery6h,
+	FrequencyEvery8h, FrequencyEvery12h, FrequencyBedtime, FrequencyWeekly,
+}
+
+// Valid reports whether the frequency is a known code
+func (f Frequency) Valid() bool {
+	_, ok := frequencyDosesPerDay[f]
+	return ok
+}
+
+// DosesPerDay returns how many doses the frequency schedules per day; ok is false for an empty or
+// unknown code
+func (f Frequency) DosesPerDay() (perDay float64, ok bool) {
+	perDay, ok = frequencyDosesPerDay[f]
+	return perDay, ok
+}
+
+// UnitsNeeded returns the units a days supply takes at the sig's dose and frequency
+func (s Sig) UnitsNeeded(daysSupply int) (int, bool) {
+	perDay, ok := s.UnitsPerDay()
+	if !ok {
+		return 0, false
+	}
+	return int(math.Ceil(perDay*float64(daysSupply) - 1e-9)), true
+}
+
+// DaysCovered returns how many whole days a quantity lasts at the sig's dose and frequency
+func (s Sig) DaysCovered(quantity int) (int, bool) {
+	perDay, ok := s.UnitsPerDay()
+	if !ok {
+		return 0, false
+	}
+	return int(math.Floor(float64(quantity)/perDay + 1e-9)), true
+}
+
+// SupplyEndDate returns the day after the last day covered by a supply starting at start, or nil
+// when no days supply is known
+func SupplyEndDate(start time.Time, daysSupply int) *time.Time {
+	if daysSupply <= 0 || start.IsZero() {
+		return nil
+	}
+	end := start.AddDate(0, 0, daysSupply)
+	return &end
+}
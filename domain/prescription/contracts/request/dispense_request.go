@@ -0,0 +1,22 @@
+package request
+
+// DispensePathVars represents path parameters for dispense endpoints
+type DispensePathVars struct {
+	PrescriptionID string `path:"prescriptionID" validate:"required,min=1"`
+	DispenseID     string `path:"dispenseID" validate:"required,min=1"`
+}
+
+// CaptureSignatureRequest is the patient's pickup signature: either a base64 PNG/JPEG image
+// (a data URL is accepted) or a typed name with the attestation accepted
+type CaptureSignatureRequest struct {
+	SignerName          string `json:"signer_name" validate:"required,min=2,max=100"`
+	SignatureImage      string `json:"signature_image"`
+	TypedName           string `json:"typed_name" validate:"omitempty,min=2,max=100"`
+	AttestationAccepted bool   `json:"attestation_accepted"`
+}
+
+// ReverseDispenseRequest undoes a dispense; a note is required for the "other" reason
+type ReverseDispenseRequest struct {
+	ReasonCode string `json:"reason_code" validate:"required,oneof=insurance_rejected not_picked_up patient_returned dispensing_error other"`
+	Note       string `json:"note" validate:"omitempty,max=500"`
+}
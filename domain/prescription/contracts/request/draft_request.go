@@ -0,0 +1,122 @@
+package request
+
+import (
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+)
+
+// DraftPathVars represents path parameters for prescription draft endpoints
+type DraftPathVars struct {
+	DraftID string `path:"draftID" validate:"required,min=1,max=50"`
+}
+
+// PrescriptionDraftRequest is a prescription form as entered so far, saved by the UI's autosave.
+// It has the fields of PrescriptionCreateRequest, but none is required and values are only checked
+// for size: a half-typed form must save. Promoting the draft runs the full validation.
+type PrescriptionDraftRequest struct {
+	// PrescriptionID makes the draft an edit of that prescription
+	PrescriptionID string       `json:"prescription_id" validate:"omitempty,max=50"`
+	PatientID      string       `json:"patient_id" validate:"omitempty,max=50"`
+	PrescriberID   string       `json:"prescriber_id" validate:"omitempty,max=50"`
+	Drug           string       `json:"drug" validate:"omitempty,max=100"`
+	DrugID         string       `json:"drug_id" validate:"omitempty,max=50"`
+	Dose           string       `json:"dose" validate:"omitempty,max=50"`
+	Dosage         *DoseRequest `json:"dosage" validate:"-"`
+	Status         string       `json:"status" validate:"omitempty,max=20"`
+	Sig            *SigRequest  `json:"sig" validate:"-"`
+	SigText        string       `json:"sig_text" validate:"omitempty,max=200"`
+	Quantity       int          `json:"quantity"`
+	DaysSupply     int          `json:"days_supply"`
+}
+
+// Form returns the form fields of the draft
+func (r PrescriptionDraftRequest) Form() m.DraftForm {
+	form := m.DraftForm{
+		PatientID:    r.PatientID,
+		PrescriberID: r.PrescriberID,
+		Drug:         r.Drug,
+		DrugID:       r.DrugID,
+		Dose:         r.Dose,
+		Status:       r.Status,
+		SigText:      r.SigText,
+		Quantity:     r.Quantity,
+		DaysSupply:   r.DaysSupply,
+	}
+	if r.Dosage != nil {
+		form.Dosage = r.Dosage.Model()
+	}
+	if r.Sig != nil {
+		sig := r.Sig.Model()
+		form.Sig = &sig
+	}
+	return form
+}
+
+// DraftCreateRequest returns the create request of a draft of a new prescription, to validate
+// and create it like a POST of the form
+func DraftCreateRequest(form m.DraftForm) PrescriptionCreateRequest {
+	return PrescriptionCreateRequest{
+		PatientID:    form.PatientID,
+		PrescriberID: form.PrescriberID,
+		Drug:         form.Drug,
+		DrugID:       form.DrugID,
+		Dose:         form.Dose,
+		Dosage:       doseRequestFrom(form.Dosage),
+		Status:       form.Status,
+		Sig:          sigRequestFrom(form.Sig),
+		SigText:      form.SigText,
+		Quantity:     form.Quantity,
+		DaysSupply:   form.DaysSupply,
+	}
+}
+
+// DraftUpdateRequest returns the update request of a draft of an edit; the fields the draft
+// leaves empty keep their values
+func DraftUpdateRequest(form m.DraftForm) PrescriptionUpdateRequest {
+	return PrescriptionUpdateRequest{
+		Drug:       nonZero(form.Drug),
+		DrugID:     nonZero(form.DrugID),
+		Dose:       nonZero(form.Dose),
+		Dosage:     doseRequestFrom(form.Dosage),
+		Status:     nonZero(form.Status),
+		Sig:        sigRequestFrom(form.Sig),
+		SigText:    nonZero(form.SigText),
+		Quantity:   nonZero(form.Quantity),
+		DaysSupply: nonZero(form.DaysSupply),
+	}
+}
+
+func doseRequestFrom(dose *m.Dose) *DoseRequest {
+	if dose == nil {
+		return nil
+	}
+	return &DoseRequest{
+		Value:     dose.Value,
+		Unit:      string(dose.Unit),
+		Frequency: string(dose.Frequency),
+		Route:     string(dose.Route),
+	}
+}
+
+func sigRequestFrom(sig *m.Sig) *SigRequest {
+	if sig == nil {
+		return nil
+	}
+	return &SigRequest{
+		DoseQuantity: sig.DoseQuantity,
+		DoseUnit:     string(sig.DoseUnit),
+		Route:        string(sig.Route),
+		Frequency:    string(sig.Frequency),
+		Timing:       string(sig.Timing),
+		AsNeeded:     sig.AsNeeded,
+		Indication:   sig.Indication,
+	}
+}
+
+// nonZero returns a pointer to the value, or nil for the zero value
+func nonZero[T comparable](value T) *T {
+	var zero T
+	if value == zero {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,38 @@
+package request
+
+// PrescriberCreateRequest represents the JSON body accepted when adding a prescriber
+type PrescriberCreateRequest struct {
+	NPI        string `json:"npi" validate:"required,npi"`
+	FirstName  string `json:"first_name" validate:"required,min=1,max=50"`
+	LastName   string `json:"last_name" validate:"required,min=1,max=50"`
+	Credential string `json:"credential" validate:"omitempty,max=20"`
+	Specialty  string `json:"specialty" validate:"omitempty,max=100"`
+	Phone      string `json:"phone" validate:"omitempty,min=10,max=20"`
+	Fax        string `json:"fax" validate:"omitempty,min=10,max=20"`
+	Email      string `json:"email" validate:"omitempty,email,max=100"`
+}
+
+// PrescriberUpdateRequest represents the JSON body accepted when updating a prescriber; omitted
+// fields are left unchanged and an empty contact field clears it
+type PrescriberUpdateRequest struct {
+	NPI        *string `json:"npi,omitempty" validate:"omitempty,npi"`
+	FirstName  *string `json:"first_name,omitempty" validate:"omitempty,min=1,max=50"`
+	LastName   *string `json:"last_name,omitempty" validate:"omitempty,min=1,max=50"`
+	Credential *string `json:"credential,omitempty" validate:"omitempty,max=20"`
+	Specialty  *string `json:"specialty,omitempty" validate:"omitempty,max=100"`
+	Phone      *string `json:"phone,omitempty" validate:"omitempty,eq=|min=10,max=20"`
+	Fax        *string `json:"fax,omitempty" validate:"omitempty,eq=|min=10,max=20"`
+	Email      *string `json:"email,omitempty" validate:"omitempty,eq=|email,max=100"`
+}
+
+// PrescriberListQueryRequest represents query parameters for the prescriber list endpoint
+type PrescriberListQueryRequest struct {
+	Query  string `form:"query" validate:"omitempty,min=2,max=100"`
+	Limit  int    `form:"limit" validate:"omitempty,min=1,max=100"`
+	Offset int    `form:"offset" validate:"omitempty,min=0"`
+}
+
+// PrescriberPrescriptionsQueryRequest represents query parameters for a prescriber's prescriptions
+type PrescriberPrescriptionsQueryRequest struct {
+	Limit int `form:"limit" validate:"omitempty,min=1,max=200"`
+}
@@ -4,3 +4,13 @@ package request
 type PrescriptionPathVars struct {
 	PrescriptionID string `path:"prescriptionID" validate:"required,min=1"`
 }
+
+// DrugPathVars represents path parameters for drug catalog endpoints
+type DrugPathVars struct {
+	DrugID string `path:"drugID" validate:"required,min=1,max=50"`
+}
+
+// PrescriberPathVars represents path parameters for prescriber endpoints
+type PrescriberPathVars struct {
+	PrescriberID string `path:"prescriberID" validate:"required,min=1,max=50"`
+}
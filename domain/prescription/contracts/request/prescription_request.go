@@ -0,0 +1,243 @@
+package request
+
+import (
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// PrescriptionCreateRequest represents the JSON body accepted when creating a prescription
+type PrescriptionCreateRequest struct {
+	PatientID    string `json:"patient_id" validate:"required,min=1,max=50"`
+	PrescriberID string `json:"prescriber_id" validate:"required,min=1,max=50"`
+	Drug         string `json:"drug" validate:"required,min=2,max=100"`
+	DrugID       string `json:"drug_id" validate:"omitempty,max=50"` // Catalog ID picked from autocomplete
+	// The dose is given structured or as free text, e.g. "10mg" or "500 mg po bid", which is parsed
+	Dose   string       `json:"dose" validate:"required_without=Dosage,excluded_with=Dosage,omitempty,min=1,max=50"`
+	Dosage *DoseRequest `json:"dosage"`
+	Status string       `json:"status" validate:"omitempty,oneof=Draft Active Paused Completed"`
+	// The sig is given structured or as free text, which is parsed. Sig, Quantity and DaysSupply
+	// are optional; the days supply is derived from the quantity when the sig has a frequency.
+	Sig        *SigRequest `json:"sig"`
+	SigText    string      `json:"sig_text" validate:"omitempty,max=200,excluded_with=Sig"`
+	Quantity   int         `json:"quantity" validate:"omitempty,min=1,max=10000"`
+	DaysSupply int         `json:"days_supply" validate:"omitempty,min=1,max=365"`
+}
+
+// Prescription returns the prescription to create; the status defaults to Draft. A free-text sig
+// that cannot be read is a validation error on sig_text.
+func (r PrescriptionCreateRequest) Prescription() (m.Prescription, error) {
+	status := m.Draft
+	if r.Status != "" {
+		status = m.Status(r.Status)
+	}
+
+	sig, err := SigFrom(r.Sig, r.SigText)
+	if err != nil {
+		return m.Prescription{}, err
+	}
+
+	prescription := m.Prescription{
+		PatientID:    r.PatientID,
+		PrescriberID: r.PrescriberID,
+		Drug:         r.Drug,
+		DrugID:       r.DrugID,
+		Dose:         r.Dose,
+		Status:       status,
+
+		Sig:        sig,
+		Quantity:   r.Quantity,
+		DaysSupply: r.DaysSupply,
+	}
+	if r.Dosage != nil {
+		prescription.Dosage = r.Dosage.Model()
+	}
+	return prescription, nil
+}
+
+// PrescriptionUpdateRequest represents the JSON body accepted when updating a prescription
+type PrescriptionUpdateRequest struct {
+	Drug   *string      `json:"drug,omitempty" validate:"omitempty,min=2,max=100"`
+	DrugID *string      `json:"drug_id,omitempty" validate:"omitempty,max=50"`
+	Dose   *string      `json:"dose,omitempty" validate:"omitempty,min=1,max=50,excluded_with=Dosage"`
+	Dosage *DoseRequest `json:"dosage,omitempty"`
+	Status *string      `json:"status,omitempty" validate:"omitempty,oneof=Draft Active Paused Completed"`
+	// A new sig or quantity without a days supply derives the days supply again
+	Sig        *SigRequest `json:"sig,omitempty"`
+	SigText    *string     `json:"sig_text,omitempty" validate:"omitempty,max=200,excluded_with=Sig"`
+	Quantity   *int        `json:"quantity,omitempty" validate:"omitempty,min=1,max=10000"`
+	DaysSupply *int        `json:"days_supply,omitempty" validate:"omitempty,min=1,max=365"`
+}
+
+// Apply merges the fields the update sets into the existing prescription
+func (r PrescriptionUpdateRequest) Apply(existing *m.Prescription) error {
+	// A new drug name is resolved against the catalog again unless a catalog ID comes with it
+	if r.Drug != nil {
+		existing.Drug = *r.Drug
+		existing.DrugEntered = *r.Drug
+		existing.DrugID = ""
+	}
+	if r.DrugID != nil {
+		existing.DrugID = *r.DrugID
+	}
+	// A new dose is read again from its text unless it comes structured
+	if r.Dose != nil {
+		existing.Dose = *r.Dose
+		existing.Dosage = nil
+	}
+	if r.Dosage != nil {
+		existing.Dosage = r.Dosage.Model()
+	}
+	if r.Status != nil {
+		existing.Status = m.Status(*r.Status)
+	}
+	// A new sig or quantity derives the days supply again unless one comes with it
+	if r.Sig != nil || r.SigText != nil {
+		text := ""
+		if r.SigText != nil {
+			text = *r.SigText
+		}
+		sig, err := SigFrom(r.Sig, text)
+		if err != nil {
+			return err
+		}
+		existing.Sig = sig
+		existing.DaysSupply = 0
+	}
+	if r.Quantity != nil {
+		existing.Quantity = *r.Quantity
+		existing.DaysSupply = 0
+	}
+	if r.DaysSupply != nil {
+		existing.DaysSupply = *r.DaysSupply
+	}
+	return nil
+}
+
+// DoseRequest is the structured dose of a prescription; a frequency or route must match the sig's
+type DoseRequest struct {
+	Value     float64 `json:"value" validate:"gt=0,max=10000"`
+	Unit      string  `json:"unit" validate:"required,oneof=mg mcg g mL unit IU mEq % tablet capsule puff drop spray patch application"`
+	Frequency string  `json:"frequency" validate:"omitempty,oneof=QD BID TID QID Q4H Q6H Q8H Q12H QHS QWK"`
+	Route     string  `json:"route" validate:"omitempty,oneof=oral sublingual topical transdermal inhaled nasal ophthalmic otic rectal subcutaneous intramuscular"`
+}
+
+// Model returns the dose
+func (r DoseRequest) Model() *m.Dose {
+	return &m.Dose{
+		Value:     r.Value,
+		Unit:      m.StrengthUnit(r.Unit),
+		Frequency: m.Frequency(r.Frequency),
+		Route:     m.Route(r.Route),
+	}
+}
+
+// SigRequest is the structured dosing instruction of a prescription
+type SigRequest struct {
+	DoseQuantity float64 `json:"dose_quantity" validate:"omitempty,gt=0,max=100"`
+	DoseUnit     string  `json:"dose_unit" validate:"omitempty,oneof=tablet capsule mL puff drop spray patch application unit"`
+	Route        string  `json:"route" validate:"omitempty,oneof=oral sublingual topical transdermal inhaled nasal ophthalmic otic rectal subcutaneous intramuscular"`
+	Frequency    string  `json:"frequency" validate:"omitempty,oneof=QD BID TID QID Q4H Q6H Q8H Q12H QHS QWK"`
+	Timing       string  `json:"timing" validate:"omitempty,oneof=with_food before_meals after_meals empty_stomach morning evening bedtime"`
+	AsNeeded     bool    `json:"as_needed"`
+	Indication   string  `json:"indication" validate:"omitempty,max=100"`
+}
+
+// Model returns the sig
+func (r SigRequest) Model() m.Sig {
+	return m.Sig{
+		DoseQuantity: r.DoseQuantity,
+		DoseUnit:     m.DoseUnit(r.DoseUnit),
+		Route:        m.Route(r.Route),
+		Frequency:    m.Frequency(r.Frequency),
+		Timing:       m.SigTiming(r.Timing),
+		AsNeeded:     r.AsNeeded,
+		Indication:   r.Indication,
+	}
+}
+
+// SigFrom returns the structured sig, or parses the free-text one
+func SigFrom(structured *SigRequest, text string) (m.Sig, error) {
+	if structured != nil {
+		return structured.Model(), nil
+	}
+	if text == "" {
+		return m.Sig{}, nil
+	}
+	sig, err := m.ParseSig(text)
+	if err != nil {
+		return m.Sig{}, platformErrors.NewValidationError("sig_text", text, err.Error())
+	}
+	return sig, nil
+}
+
+// InteractionCheckQueryRequest represents query parameters for the interaction check endpoint
+type InteractionCheckQueryRequest struct {
+	PatientID string `form:"patientId" validate:"required,min=1,max=50"`
+	Drug      string `form:"drug" validate:"required,min=2,max=100"`
+}
+
+// DrugAutocompleteQueryRequest represents query parameters for the drug autocomplete endpoint
+type DrugAutocompleteQueryRequest struct {
+	Query string `form:"query" validate:"required,min=2,max=100"`
+	Limit int    `form:"limit" validate:"omitempty,min=1,max=25"`
+}
+
+// PharmacySearchQueryRequest represents query parameters for the pharmacy search endpoint; zip or
+// state is required
+type PharmacySearchQueryRequest struct {
+	Zip   string `form:"zip" validate:"required_without=State,omitempty,len=5,numeric"`
+	State string `form:"state" validate:"required_without=Zip,omitempty,len=2,alpha"`
+	Limit int    `form:"limit" validate:"omitempty,min=1,max=50"`
+}
+
+// RoutePrescriptionRequest represents the JSON body accepted when routing a prescription to a pharmacy
+type RoutePrescriptionRequest struct {
+	PharmacyID string `json:"pharmacy_id" validate:"required,min=1,max=50"`
+}
+
+// BulkStatusRequest represents the JSON body accepted when changing the status of many prescriptions
+type BulkStatusRequest struct {
+	IDs    []string `json:"ids" validate:"required,min=1,max=100,dive,required,max=50"`
+	Status string   `json:"status" validate:"required,oneof=Active Paused Completed"`
+	Reason string   `json:"reason" validate:"omitempty,max=200"` // Recorded in each prescription's history
+}
+
+// PrescriptionCreateFormRequest represents form data submitted from the prescription create page
+type PrescriptionCreateFormRequest struct {
+	PatientID    string `form:"patientId" validate:"required,min=1,max=50"`
+	PrescriberID string `form:"prescriberId" validate:"required,min=1,max=50"`
+	Drug         string `form:"drug" validate:"required,min=2,max=100"`
+	Status       string `form:"status" validate:"required,oneof=Draft Active Paused Completed"`
+	Quantity     int    `form:"quantity" validate:"omitempty,min=1,max=10000"`
+	DaysSupply   int    `form:"daysSupply" validate:"omitempty,min=1,max=365"`
+
+	// The dose takes its route and frequency from the sig
+	DoseValue float64 `form:"doseValue" validate:"required,gt=0,max=10000"`
+	DoseUnit  string  `form:"doseUnit" validate:"required,oneof=mg mcg g mL unit IU mEq % tablet capsule puff drop spray patch application"`
+
+	SigDoseQuantity float64 `form:"sigDoseQuantity" validate:"omitempty,gt=0,max=100"`
+	SigDoseUnit     string  `form:"sigDoseUnit" validate:"omitempty,oneof=tablet capsule mL puff drop spray patch application unit"`
+	SigRoute        string  `form:"sigRoute" validate:"omitempty,oneof=oral sublingual topical transdermal inhaled nasal ophthalmic otic rectal subcutaneous intramuscular"`
+	SigFrequency    string  `form:"sigFrequency" validate:"omitempty,oneof=QD BID TID QID Q4H Q6H Q8H Q12H QHS QWK"`
+	SigTiming       string  `form:"sigTiming" validate:"omitempty,oneof=with_food before_meals after_meals empty_stomach morning evening bedtime"`
+	SigAsNeeded     bool    `form:"sigAsNeeded"`
+	SigIndication   string  `form:"sigIndication" validate:"omitempty,max=100"`
+}
+
+// Dosage returns the dose entered on the form, with the route and frequency of its sig
+func (r PrescriptionCreateFormRequest) Dosage() *m.Dose {
+	return DoseRequest{Value: r.DoseValue, Unit: r.DoseUnit, Frequency: r.SigFrequency, Route: r.SigRoute}.Model()
+}
+
+// Sig returns the sig entered on the form
+func (r PrescriptionCreateFormRequest) Sig() m.Sig {
+	return SigRequest{
+		DoseQuantity: r.SigDoseQuantity,
+		DoseUnit:     r.SigDoseUnit,
+		Route:        r.SigRoute,
+		Frequency:    r.SigFrequency,
+		Timing:       r.SigTiming,
+		AsNeeded:     r.SigAsNeeded,
+		Indication:   r.SigIndication,
+	}.Model()
+}
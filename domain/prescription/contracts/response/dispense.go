@@ -0,0 +1,59 @@
+package response
+
+import (
+	"time"
+
+	model "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+)
+
+// DispenseResponse is the transport representation of a dispense or its reversal
+type DispenseResponse struct {
+	ID             string    `json:"id"`
+	Kind           string    `json:"kind"`
+	PrescriptionID string    `json:"prescription_id"`
+	PatientID      string    `json:"patient_id"`
+	Drug           string    `json:"drug"`
+	Dose           string    `json:"dose"`
+	DispensedAt    time.Time `json:"dispensed_at"`
+	DispensedBy    string    `json:"dispensed_by,omitempty"`
+
+	Sig          model.Sig  `json:"sig,omitempty"`
+	Quantity     int        `json:"quantity,omitempty"`
+	DaysSupply   int        `json:"days_supply,omitempty"`
+	SupplyEndsAt *time.Time `json:"supply_ends_at,omitempty"`
+
+	Signature *model.PickupSignature `json:"signature,omitempty"`
+
+	ReversedByID string                `json:"reversed_by_id,omitempty"`
+	ReversesID   string                `json:"reverses_id,omitempty"`
+	Reason       *model.ReversalReason `json:"reason,omitempty"`
+}
+
+func FromDispense(m model.DispenseRecord) DispenseResponse {
+	return DispenseResponse{
+		ID:             m.ID,
+		Kind:           string(m.Kind),
+		PrescriptionID: m.PrescriptionID,
+		PatientID:      m.PatientID,
+		Drug:           m.Drug,
+		Dose:           m.Dose,
+		DispensedAt:    m.DispensedAt,
+		DispensedBy:    m.DispensedBy,
+		Sig:            m.Sig,
+		Quantity:       m.Quantity,
+		DaysSupply:     m.DaysSupply,
+		SupplyEndsAt:   m.SupplyEndsAt,
+		Signature:      m.Signature,
+		ReversedByID:   m.ReversedByID,
+		ReversesID:     m.ReversesID,
+		Reason:         m.Reason,
+	}
+}
+
+func FromDispenses(items []model.DispenseRecord) []DispenseResponse {
+	out := make([]DispenseResponse, 0, len(items))
+	for _, item := range items {
+		out = append(out, FromDispense(item))
+	}
+	return out
+}
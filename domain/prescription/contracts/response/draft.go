@@ -0,0 +1,37 @@
+package response
+
+import (
+	"time"
+
+	model "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+)
+
+// DraftResponse is the transport representation of a prescription draft; drafts are only shown
+// to their owner, so the owner is left out
+type DraftResponse struct {
+	ID             string          `json:"id"`
+	PrescriptionID string          `json:"prescription_id,omitempty"`
+	Form           model.DraftForm `json:"form"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+	ExpiresAt      time.Time       `json:"expires_at"`
+}
+
+func FromDraft(d model.PrescriptionDraft) DraftResponse {
+	return DraftResponse{
+		ID:             d.ID,
+		PrescriptionID: d.PrescriptionID,
+		Form:           d.Form,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+		ExpiresAt:      d.ExpiresAt,
+	}
+}
+
+func FromDrafts(items []model.PrescriptionDraft) []DraftResponse {
+	out := make([]DraftResponse, 0, len(items))
+	for _, item := range items {
+		out = append(out, FromDraft(item))
+	}
+	return out
+}
@@ -0,0 +1,22 @@
+package response
+
+import model "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+
+// DrugResponse is the transport representation of a catalog drug; the normalized search names stay internal
+type DrugResponse struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	BrandNames []string `json:"brand_names,omitempty"`
+	Synonyms   []string `json:"synonyms,omitempty"`
+	DrugClass  string   `json:"drug_class,omitempty"`
+}
+
+func FromDrug(m model.Drug) DrugResponse {
+	return DrugResponse{
+		ID:         m.ID,
+		Name:       m.Name,
+		BrandNames: m.BrandNames,
+		Synonyms:   m.Synonyms,
+		DrugClass:  m.DrugClass,
+	}
+}
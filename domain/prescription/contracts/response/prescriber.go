@@ -0,0 +1,52 @@
+package response
+
+import (
+	"time"
+
+	model "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+)
+
+// PrescriberResponse is the transport representation of a prescriber
+type PrescriberResponse struct {
+	ID  string `json:"id"`
+	NPI string `json:"npi"`
+
+	FirstName  string `json:"first_name"`
+	LastName   string `json:"last_name"`
+	Credential string `json:"credential,omitempty"`
+	// DisplayName is the name as printed on a prescription, e.g. "Dana Whitfield, MD"
+	DisplayName string `json:"display_name"`
+	Specialty   string `json:"specialty,omitempty"`
+
+	Phone string `json:"phone,omitempty"`
+	Fax   string `json:"fax,omitempty"`
+	Email string `json:"email,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func FromPrescriber(m model.Prescriber) PrescriberResponse {
+	return PrescriberResponse{
+		ID:          m.ID,
+		NPI:         m.NPI,
+		FirstName:   m.FirstName,
+		LastName:    m.LastName,
+		Credential:  m.Credential,
+		DisplayName: m.DisplayName(),
+		Specialty:   m.Specialty,
+		Phone:       m.Phone,
+		Fax:         m.Fax,
+		Email:       m.Email,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func FromPrescribers(items []model.Prescriber) []PrescriberResponse {
+	out := make([]PrescriberResponse, 0, len(items))
+	for _, item := range items {
+		out = append(out, FromPrescriber(item))
+	}
+	return out
+}
@@ -8,22 +8,69 @@ import (
 
 // PrescriptionResponse is the transport representation returned by the API.
 type PrescriptionResponse struct {
-	ID        string    `json:"id"`
-	PatientID string    `json:"patient_id"`
-	Drug      string    `json:"drug"`
-	Dose      string    `json:"dose"`
-	Status    string    `json:"status"`
-	CreatedAt time.Time `json:"created_at"`
+	ID        string `json:"id"`
+	PatientID string `json:"patient_id"`
+	Drug      string `json:"drug"`
+	DrugID    string `json:"drug_id,omitempty"`
+	// DrugEntered is the name as entered when it differs from the canonical name
+	DrugEntered string    `json:"drug_entered,omitempty"`
+	Dose        string    `json:"dose"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"created_at"`
+	// Dosage is the structured dose; omitted when the free-text dose could not be read
+	Dosage *model.Dose `json:"dosage,omitempty"`
+
+	Sig *model.Sig `json:"sig,omitempty"`
+	// Directions is the sig as label text, in English and Spanish
+	Directions      string     `json:"directions,omitempty"`
+	DirectionsES    string     `json:"directions_es,omitempty"`
+	Quantity        int        `json:"quantity,omitempty"`
+	DaysSupply      int        `json:"days_supply,omitempty"`
+	ExpectedEndDate *time.Time `json:"expected_end_date,omitempty"`
+	// PrescribedBy is the ID of the user who created the prescription
+	PrescribedBy string `json:"prescribed_by,omitempty"`
+	// PrescriberID is the clinician the prescription is written under
+	PrescriberID string `json:"prescriber_id,omitempty"`
+
+	InteractionWarnings []model.DrugInteractionWarning `json:"interaction_warnings,omitempty"`
+
+	FulfillmentStatus    string     `json:"fulfillment_status,omitempty"`
+	FulfillmentUpdatedAt *time.Time `json:"fulfillment_updated_at,omitempty"`
+
+	Pharmacy *model.Pharmacy `json:"pharmacy,omitempty"`
 }
 
 func FromModel(m model.Prescription) PrescriptionResponse {
+	var sig *model.Sig
+	if !m.Sig.IsZero() {
+		sig = &m.Sig
+	}
 	return PrescriptionResponse{
-		ID:        m.ID,
-		PatientID: m.PatientID,
-		Drug:      m.Drug,
-		Dose:      m.Dose,
-		Status:    string(m.Status),
-		CreatedAt: m.CreatedAt,
+		ID:           m.ID,
+		PatientID:    m.PatientID,
+		Drug:         m.Drug,
+		DrugID:       m.DrugID,
+		DrugEntered:  m.DrugEntered,
+		Dose:         m.Dose,
+		Dosage:       m.Dosage,
+		Status:       string(m.Status),
+		CreatedAt:    m.CreatedAt,
+		PrescribedBy: m.PrescribedBy,
+		PrescriberID: m.PrescriberID,
+		Sig:          sig,
+
+		Directions:      m.Sig.Render(model.LanguageEnglish),
+		DirectionsES:    m.Sig.Render(model.LanguageSpanish),
+		Quantity:        m.Quantity,
+		DaysSupply:      m.DaysSupply,
+		ExpectedEndDate: m.ExpectedEndDate,
+
+		InteractionWarnings: m.InteractionWarnings,
+
+		FulfillmentStatus:    string(m.FulfillmentStatus),
+		FulfillmentUpdatedAt: m.FulfillmentUpdatedAt,
+
+		Pharmacy: m.Pharmacy,
 	}
 }
 
@@ -34,3 +81,58 @@ func FromModels(items []model.Prescription) []PrescriptionResponse {
 	}
 	return out
 }
+
+// InteractionCheckResponse is the transport representation of an interaction check
+type InteractionCheckResponse struct {
+	Blocked         bool                           `json:"blocked"`
+	Warnings        []model.DrugInteractionWarning `json:"warnings"`
+	AllergyWarnings []model.DrugAllergyWarning     `json:"allergy_warnings"`
+}
+
+func FromInteractionCheckResult(r model.InteractionCheckResult) InteractionCheckResponse {
+	warnings := r.Warnings
+	if warnings == nil {
+		warnings = []model.DrugInteractionWarning{}
+	}
+	allergyWarnings := r.AllergyWarnings
+	if allergyWarnings == nil {
+		allergyWarnings = []model.DrugAllergyWarning{}
+	}
+	return InteractionCheckResponse{Blocked: r.Blocked, Warnings: warnings, AllergyWarnings: allergyWarnings}
+}
+
+// PharmacySearchResponse is the transport representation of a pharmacy search
+type PharmacySearchResponse struct {
+	Pharmacies []model.NetworkPharmacy `json:"pharmacies"`
+	Total      int                     `json:"total"`
+}
+
+// BulkStatusResponse is the transport representation of a bulk status change: one result per
+// prescription, in request order, and how many were updated
+type BulkStatusResponse struct {
+	Results []model.BulkStatusResult `json:"results"`
+	Updated int                      `json:"updated"`
+	Failed  int                      `json:"failed"` // Rejected, not found or changed meanwhile
+}
+
+func FromBulkStatusResults(results []model.BulkStatusResult) BulkStatusResponse {
+	resp := BulkStatusResponse{Results: results}
+	for _, result := range results {
+		switch result.Outcome {
+		case model.BulkStatusUpdated:
+			resp.Updated++
+		case model.BulkStatusUnchanged:
+		default:
+			resp.Failed++
+		}
+	}
+	return resp
+}
+
+func FromPharmacySearchResult(r model.PharmacySearchResult) PharmacySearchResponse {
+	pharmacies := r.Pharmacies
+	if pharmacies == nil {
+		pharmacies = []model.NetworkPharmacy{}
+	}
+	return PharmacySearchResponse{Pharmacies: pharmacies, Total: r.Total}
+}
@@ -0,0 +1,83 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// Domain-specific errors for prescription operations
+var (
+	ErrPrescriptionNotFound = errors.New("prescription not found")
+	ErrInvalidPrescription  = errors.New("invalid prescription data")
+)
+
+// SevereInteractionError is returned when a prescription is blocked because the
+// new drug has a severe interaction with one of the patient's active prescriptions
+type SevereInteractionError struct {
+	Operation string
+	Warnings  []m.DrugInteractionWarning
+}
+
+func (e SevereInteractionError) Error() string {
+	return e.Unwrap().Error()
+}
+
+// Unwrap exposes the error as a BusinessLogicError so shared handlers map it to 422
+func (e SevereInteractionError) Unwrap() error {
+	pairs := make([]string, 0, len(e.Warnings))
+	for _, w := range e.Warnings {
+		if w.Severity == m.SeveritySevere {
+			pairs = append(pairs, fmt.Sprintf("%s + %s", w.Drug, w.InteractingDrug))
+		}
+	}
+	return platformErrors.NewBusinessLogicError(e.Operation, "severe drug interaction: "+strings.Join(pairs, ", "))
+}
+
+// NewSevereInteractionError creates a new severe interaction error
+func NewSevereInteractionError(operation string, warnings []m.DrugInteractionWarning) SevereInteractionError {
+	return SevereInteractionError{
+		Operation: operation,
+		Warnings:  warnings,
+	}
+}
+
+// DrugAllergyError is returned when a prescription is blocked because the drug matches one of
+// the patient's recorded allergies
+type DrugAllergyError struct {
+	Operation string
+	Warnings  []m.DrugAllergyWarning
+}
+
+func (e DrugAllergyError) Error() string {
+	return e.Unwrap().Error()
+}
+
+// Unwrap exposes the error as a BusinessLogicError so shared handlers map it to 422
+func (e DrugAllergyError) Unwrap() error {
+	allergies := make([]string, 0, len(e.Warnings))
+	for _, w := range e.Warnings {
+		allergies = append(allergies, fmt.Sprintf("%s (%s)", w.Drug, w.Substance))
+	}
+	return platformErrors.NewBusinessLogicError(e.Operation, "patient is allergic: "+strings.Join(allergies, ", "))
+}
+
+// NewDrugAllergyError creates a new drug allergy error
+func NewDrugAllergyError(operation string, warnings []m.DrugAllergyWarning) DrugAllergyError {
+	return DrugAllergyError{
+		Operation: operation,
+		Warnings:  warnings,
+	}
+}
+
+// Re-export platform errors for convenience
+type ValidationError = platformErrors.ValidationError
+type BusinessLogicError = platformErrors.BusinessLogicError
+
+var (
+	NewValidationError    = platformErrors.NewValidationError
+	NewBusinessLogicError = platformErrors.NewBusinessLogicError
+)
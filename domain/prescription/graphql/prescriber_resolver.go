@@ -0,0 +1,217 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/domain/prescription/contracts/request"
+	prescriptionservice "pharmacy-modernization-project-model/domain/prescription/service"
+	"pharmacy-modernization-project-model/internal/graphql/generated"
+	"pharmacy-modernization-project-model/internal/graphql/validation"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// PrescriberResolver handles prescriber GraphQL operations
+type PrescriberResolver struct {
+	PrescriberService prescriptionservice.PrescriberService
+	Logger            *zap.Logger
+}
+
+// NewPrescriberResolver creates a new prescriber resolver
+func NewPrescriberResolver(
+	prescriberSvc prescriptionservice.PrescriberService,
+	logger *zap.Logger,
+) *PrescriberResolver {
+	return &PrescriberResolver{
+		PrescriberService: prescriberSvc,
+		Logger:            logger,
+	}
+}
+
+// ============================================================================
+// Query Resolvers
+// ============================================================================
+
+// Prescriber resolves the prescriber query; nil when there is no such prescriber
+func (r *PrescriberResolver) Prescriber(ctx context.Context, id string) (*model.Prescriber, error) {
+	prescriber, err := r.PrescriberService.GetByID(ctx, id)
+	if err != nil {
+		var notFound platformErrors.RecordNotFoundError
+		if errors.As(err, &notFound) {
+			return nil, nil
+		}
+		r.Logger.Error("Failed to fetch prescriber",
+			zap.String("prescriber_id", id),
+			zap.Error(err))
+		return nil, err
+	}
+	return &prescriber, nil
+}
+
+// Prescribers resolves the prescribers query
+func (r *PrescriberResolver) Prescribers(ctx context.Context, query *string, limit *int, offset *int) ([]model.Prescriber, error) {
+	req := request.PrescriberListQueryRequest{}
+	if query != nil {
+		req.Query = *query
+	}
+	if limit != nil {
+		req.Limit = *limit
+	}
+	if offset != nil {
+		req.Offset = *offset
+	}
+	if _, validationErrors := validation.ValidateGraphQLInput(ctx, req); validationErrors != nil {
+		r.Logger.Error("Prescribers query validation failed",
+			zap.Any("validation_errors", validationErrors.Errors))
+		return nil, validationErrors
+	}
+
+	prescribers, err := r.PrescriberService.List(ctx, req.Query, req.Limit, req.Offset)
+	if err != nil {
+		r.Logger.Error("Failed to list prescribers",
+			zap.Error(err))
+		return nil, err
+	}
+	return prescribers, nil
+}
+
+// ============================================================================
+// Field Resolvers
+// ============================================================================
+
+// Prescriptions resolves the prescriptions field on Prescriber
+func (r *PrescriberResolver) Prescriptions(ctx context.Context, obj *model.Prescriber, limit *int) ([]model.Prescription, error) {
+	req := request.PrescriberPrescriptionsQueryRequest{}
+	if limit != nil {
+		req.Limit = *limit
+	}
+	if _, validationErrors := validation.ValidateGraphQLInput(ctx, req); validationErrors != nil {
+		return nil, validationErrors
+	}
+
+	prescriptions, err := r.PrescriberService.Prescriptions(ctx, obj.ID, req.Limit)
+	if err != nil {
+		r.Logger.Error("Failed to fetch prescriptions for prescriber",
+			zap.String("prescriber_id", obj.ID),
+			zap.Error(err))
+		return nil, err
+	}
+	return prescriptions, nil
+}
+
+// PrescriptionPrescriber resolves the prescriber field on Prescription; nil when none is recorded
+func (r *PrescriberResolver) PrescriptionPrescriber(ctx context.Context, obj *model.Prescription) (*model.Prescriber, error) {
+	if obj.PrescriberID == "" {
+		return nil, nil
+	}
+	return r.Prescriber(ctx, obj.PrescriberID)
+}
+
+// ============================================================================
+// Mutation Resolvers
+// ============================================================================
+
+// CreatePrescriber resolves the createPrescriber mutation
+func (r *PrescriberResolver) CreatePrescriber(ctx context.Context, input generated.CreatePrescriberInput) (*generated.CreatePrescriberPayload, error) {
+	record, err := r.createPrescriber(ctx, input)
+	userErrors, err := validation.UserErrors(err)
+	if err != nil {
+		return nil, err
+	}
+	return &generated.CreatePrescriberPayload{Prescriber: record, UserErrors: userErrors}, nil
+}
+
+func (r *PrescriberResolver) createPrescriber(ctx context.Context, input generated.CreatePrescriberInput) (*model.Prescriber, error) {
+	req := request.PrescriberCreateRequest{
+		NPI:       input.Npi,
+		FirstName: input.FirstName,
+		LastName:  input.LastName,
+	}
+	if input.Credential != nil {
+		req.Credential = *input.Credential
+	}
+	if input.Specialty != nil {
+		req.Specialty = *input.Specialty
+	}
+	if input.Phone != nil {
+		req.Phone = *input.Phone
+	}
+	if input.Fax != nil {
+		req.Fax = *input.Fax
+	}
+	if input.Email != nil {
+		req.Email = *input.Email
+	}
+	if _, validationErrors := validation.ValidateGraphQLInput(ctx, req); validationErrors != nil {
+		r.Logger.Error("Prescriber input validation failed",
+			zap.Any("validation_errors", validationErrors.Errors))
+		return nil, validationErrors
+	}
+
+	created, err := r.PrescriberService.Create(ctx, req)
+	if err != nil {
+		r.Logger.Error("Failed to create prescriber",
+			zap.Error(err))
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdatePrescriber resolves the updatePrescriber mutation
+func (r *PrescriberResolver) UpdatePrescriber(ctx context.Context, id string, input generated.UpdatePrescriberInput) (*generated.UpdatePrescriberPayload, error) {
+	record, err := r.updatePrescriber(ctx, id, input)
+	userErrors, err := validation.UserErrors(err)
+	if err != nil {
+		return nil, err
+	}
+	return &generated.UpdatePrescriberPayload{Prescriber: record, UserErrors: userErrors}, nil
+}
+
+func (r *PrescriberResolver) updatePrescriber(ctx context.Context, id string, input generated.UpdatePrescriberInput) (*model.Prescriber, error) {
+	req := request.PrescriberUpdateRequest{
+		NPI:        input.Npi,
+		FirstName:  input.FirstName,
+		LastName:   input.LastName,
+		Credential: input.Credential,
+		Specialty:  input.Specialty,
+		Phone:      input.Phone,
+		Fax:        input.Fax,
+		Email:      input.Email,
+	}
+	if _, validationErrors := validation.ValidateGraphQLInput(ctx, req); validationErrors != nil {
+		r.Logger.Error("Prescriber update validation failed",
+			zap.Any("validation_errors", validationErrors.Errors))
+		return nil, validationErrors
+	}
+
+	updated, err := r.PrescriberService.Update(ctx, id, req)
+	if err != nil {
+		r.Logger.Error("Failed to update prescriber",
+			zap.String("prescriber_id", id),
+			zap.Error(err))
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeletePrescriber resolves the deletePrescriber mutation
+func (r *PrescriberResolver) DeletePrescriber(ctx context.Context, id string) (*generated.DeletePrescriberPayload, error) {
+	err := r.PrescriberService.Delete(ctx, id)
+	if err != nil {
+		r.Logger.Error("Failed to delete prescriber",
+			zap.String("prescriber_id", id),
+			zap.Error(err))
+	}
+	userErrors, err := validation.UserErrors(err)
+	if err != nil {
+		return nil, err
+	}
+	payload := &generated.DeletePrescriberPayload{UserErrors: userErrors}
+	if len(userErrors) == 0 {
+		payload.DeletedID = &id
+	}
+	return payload, nil
+}
@@ -2,34 +2,43 @@ package graphql
 
 import (
 	"context"
+	"fmt"
 
 	"go.uber.org/zap"
 
 	patientmodel "pharmacy-modernization-project-model/domain/patient/contracts/model"
 	patientservice "pharmacy-modernization-project-model/domain/patient/service"
 	"pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	prescriptionsecurity "pharmacy-modernization-project-model/domain/prescription/security"
 	prescriptionservice "pharmacy-modernization-project-model/domain/prescription/service"
 	"pharmacy-modernization-project-model/internal/graphql/generated"
 	"pharmacy-modernization-project-model/internal/graphql/validation"
+	"pharmacy-modernization-project-model/internal/platform/auth"
 	"pharmacy-modernization-project-model/internal/platform/errors"
 )
 
 // PrescriptionResolver handles all Prescription domain GraphQL operations
 type PrescriptionResolver struct {
 	PrescriptionService prescriptionservice.PrescriptionService
+	HistoryService      prescriptionservice.HistoryService
 	PatientService      patientservice.PatientService
+	PrescriberResolver  *PrescriberResolver // Delegates prescriber operations
 	Logger              *zap.Logger
 }
 
 // NewPrescriptionResolver creates a new prescription resolver
 func NewPrescriptionResolver(
 	prescriptionSvc prescriptionservice.PrescriptionService,
+	historySvc prescriptionservice.HistoryService,
 	patientSvc patientservice.PatientService,
+	prescriberSvc prescriptionservice.PrescriberService,
 	logger *zap.Logger,
 ) *PrescriptionResolver {
 	return &PrescriptionResolver{
 		PrescriptionService: prescriptionSvc,
+		HistoryService:      historySvc,
 		PatientService:      patientSvc,
+		PrescriberResolver:  NewPrescriberResolver(prescriberSvc, logger),
 		Logger:              logger,
 	}
 }
@@ -42,7 +51,7 @@ func NewPrescriptionResolver(
 func (r *PrescriptionResolver) Prescription(ctx context.Context, id string) (*model.Prescription, error) {
 	// Validate ID parameter using bind validation
 	idValidation := validation.PrescriptionQueryValidation{ID: id}
-	_, validationErrors := validation.ValidateGraphQLInput(idValidation)
+	_, validationErrors := validation.ValidateGraphQLInput(ctx, idValidation)
 	if validationErrors != nil {
 		r.Logger.Error("Prescription ID validation failed",
 			zap.Any("validation_errors", validationErrors.Errors))
@@ -53,10 +62,6 @@ func (r *PrescriptionResolver) Prescription(ctx context.Context, id string) (*mo
 	if err != nil {
 		r.Logger.Error("Failed to fetch prescription",
 			zap.Error(err))
-
-		if errors.IsNotFoundError(err) {
-			return nil, nil
-		}
 		return nil, err
 	}
 	return &prescription, nil
@@ -76,7 +81,7 @@ func (r *PrescriptionResolver) Prescriptions(ctx context.Context, status *string
 		queryValidation.Offset = offset
 	}
 
-	_, validationErrors := validation.ValidateGraphQLInput(queryValidation)
+	_, validationErrors := validation.ValidateGraphQLInput(ctx, queryValidation)
 	if validationErrors != nil {
 		r.Logger.Error("Prescriptions query validation failed",
 			zap.Any("validation_errors", validationErrors.Errors))
@@ -110,42 +115,94 @@ func (r *PrescriptionResolver) Prescriptions(ctx context.Context, status *string
 	return prescriptions, nil
 }
 
+// CheckDrugInteractions resolves the checkDrugInteractions query
+func (r *PrescriptionResolver) CheckDrugInteractions(ctx context.Context, patientID string, drug string) (*model.InteractionCheckResult, error) {
+	result, err := r.PrescriptionService.CheckInteractions(ctx, patientID, drug)
+	if err != nil {
+		r.Logger.Error("Failed to check drug interactions",
+			zap.Error(err))
+		return nil, err
+	}
+	if result.Warnings == nil {
+		result.Warnings = []model.DrugInteractionWarning{}
+	}
+	if result.AllergyWarnings == nil {
+		result.AllergyWarnings = []model.DrugAllergyWarning{}
+	}
+	return &result, nil
+}
+
+// ============================================================================
+// Entity Resolvers
+// ============================================================================
+
+// FindPrescriptionByID resolves a prescription a federated gateway references by id. _entities
+// carries no directives, so the read permission is checked here; a prescription that does not
+// exist resolves to null.
+func (r *PrescriptionResolver) FindPrescriptionByID(ctx context.Context, id string) (*model.Prescription, error) {
+	if err := auth.RequireAnyPermission(ctx, prescriptionsecurity.ReadAccess); err != nil {
+		return nil, err
+	}
+
+	prescription, err := r.Prescription(ctx, id)
+	if errors.IsNotFoundError(err) {
+		return nil, nil
+	}
+	return prescription, err
+}
+
 // ============================================================================
 // Mutation Resolvers
 // ============================================================================
 
 // CreatePrescription resolves the createPrescription mutation
-func (r *PrescriptionResolver) CreatePrescription(ctx context.Context, input generated.CreatePrescriptionInput) (*model.Prescription, error) {
+func (r *PrescriptionResolver) CreatePrescription(ctx context.Context, input generated.CreatePrescriptionInput) (*generated.CreatePrescriptionPayload, error) {
+	record, err := r.createPrescription(ctx, input)
+	userErrors, err := validation.UserErrors(err)
+	if err != nil {
+		return nil, err
+	}
+	return &generated.CreatePrescriptionPayload{Prescription: record, UserErrors: userErrors}, nil
+}
+
+func (r *PrescriptionResolver) createPrescription(ctx context.Context, input generated.CreatePrescriptionInput) (*model.Prescription, error) {
 	// Validate input using bind validation
 	validationInput := validation.ConvertCreatePrescriptionInput(input)
-	_, validationErrors := validation.ValidateGraphQLInput(validationInput)
+	_, validationErrors := validation.ValidateGraphQLInput(ctx, validationInput)
 	if validationErrors != nil {
 		r.Logger.Error("Prescription creation validation failed",
 			zap.Any("validation_errors", validationErrors.Errors))
 		return nil, validationErrors
 	}
 
-	// Convert GraphQL status to domain status
-	var domainStatus model.Status
-	switch input.Status {
-	case generated.PrescriptionStatusDraft:
-		domainStatus = model.Draft
-	case generated.PrescriptionStatusActive:
-		domainStatus = model.Active
-	case generated.PrescriptionStatusPaused:
-		domainStatus = model.Paused
-	case generated.PrescriptionStatusCompleted:
-		domainStatus = model.Completed
-	default:
-		domainStatus = model.Draft
+	domainStatus, err := DomainStatus(input.Status)
+	if err != nil {
+		return nil, err
 	}
 
 	// Convert GraphQL input to domain model
 	prescription := model.Prescription{
-		PatientID: input.PatientID,
-		Drug:      input.Drug,
-		Dose:      input.Dose,
-		Status:    domainStatus,
+		PatientID:    input.PatientID,
+		PrescriberID: input.PrescriberID,
+		Drug:         input.Drug,
+		Status:       domainStatus,
+		Dosage:       doseFromInput(input.Dosage),
+	}
+	if input.Dose != nil && prescription.Dosage == nil {
+		prescription.Dose = *input.Dose
+	}
+	if input.Sig != nil || input.SigText != nil {
+		sig, err := sigFromInput(input.Sig, input.SigText)
+		if err != nil {
+			return nil, err
+		}
+		prescription.Sig = sig
+	}
+	if input.Quantity != nil {
+		prescription.Quantity = *input.Quantity
+	}
+	if input.DaysSupply != nil {
+		prescription.DaysSupply = *input.DaysSupply
 	}
 
 	// Create prescription
@@ -160,10 +217,19 @@ func (r *PrescriptionResolver) CreatePrescription(ctx context.Context, input gen
 }
 
 // UpdatePrescription resolves the updatePrescription mutation
-func (r *PrescriptionResolver) UpdatePrescription(ctx context.Context, id string, input generated.UpdatePrescriptionInput) (*model.Prescription, error) {
+func (r *PrescriptionResolver) UpdatePrescription(ctx context.Context, id string, input generated.UpdatePrescriptionInput) (*generated.UpdatePrescriptionPayload, error) {
+	record, err := r.updatePrescription(ctx, id, input)
+	userErrors, err := validation.UserErrors(err)
+	if err != nil {
+		return nil, err
+	}
+	return &generated.UpdatePrescriptionPayload{Prescription: record, UserErrors: userErrors}, nil
+}
+
+func (r *PrescriptionResolver) updatePrescription(ctx context.Context, id string, input generated.UpdatePrescriptionInput) (*model.Prescription, error) {
 	// Validate ID parameter
 	idValidation := validation.PrescriptionQueryValidation{ID: id}
-	_, validationErrors := validation.ValidateGraphQLInput(idValidation)
+	_, validationErrors := validation.ValidateGraphQLInput(ctx, idValidation)
 	if validationErrors != nil {
 		r.Logger.Error("Prescription ID validation failed",
 			zap.Any("validation_errors", validationErrors.Errors))
@@ -172,7 +238,7 @@ func (r *PrescriptionResolver) UpdatePrescription(ctx context.Context, id string
 
 	// Validate input using bind validation
 	validationInput := validation.ConvertUpdatePrescriptionInput(input)
-	_, validationErrors = validation.ValidateGraphQLInput(validationInput)
+	_, validationErrors = validation.ValidateGraphQLInput(ctx, validationInput)
 	if validationErrors != nil {
 		r.Logger.Error("Prescription update validation failed",
 			zap.Any("validation_errors", validationErrors.Errors))
@@ -191,20 +257,34 @@ func (r *PrescriptionResolver) UpdatePrescription(ctx context.Context, id string
 	if input.Drug != nil {
 		existingPrescription.Drug = *input.Drug
 	}
+	// A new dose is read again from its text unless it comes structured
 	if input.Dose != nil {
 		existingPrescription.Dose = *input.Dose
+		existingPrescription.Dosage = nil
+	}
+	if input.Dosage != nil {
+		existingPrescription.Dosage = doseFromInput(input.Dosage)
+	}
+	// A new sig or quantity derives the days supply again unless one comes with it
+	if input.Sig != nil || input.SigText != nil {
+		sig, err := sigFromInput(input.Sig, input.SigText)
+		if err != nil {
+			return nil, err
+		}
+		existingPrescription.Sig = sig
+		existingPrescription.DaysSupply = 0
+	}
+	if input.Quantity != nil {
+		existingPrescription.Quantity = *input.Quantity
+		existingPrescription.DaysSupply = 0
+	}
+	if input.DaysSupply != nil {
+		existingPrescription.DaysSupply = *input.DaysSupply
 	}
 	if input.Status != nil {
-		// Convert GraphQL status to domain status
-		switch *input.Status {
-		case generated.PrescriptionStatusDraft:
-			existingPrescription.Status = model.Draft
-		case generated.PrescriptionStatusActive:
-			existingPrescription.Status = model.Active
-		case generated.PrescriptionStatusPaused:
-			existingPrescription.Status = model.Paused
-		case generated.PrescriptionStatusCompleted:
-			existingPrescription.Status = model.Completed
+		existingPrescription.Status, err = DomainStatus(*input.Status)
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -230,19 +310,171 @@ func (r *PrescriptionResolver) Patient(ctx context.Context, obj *model.Prescript
 	return &patient, nil
 }
 
+// PrescriptionCountByStatus resolves the prescriptionCountByStatus query
+func (r *PrescriptionResolver) PrescriptionCountByStatus(ctx context.Context) ([]model.StatusCount, error) {
+	counts, err := r.PrescriptionService.CountGroupedByStatus(ctx)
+	if err != nil {
+		r.Logger.Error("Failed to count prescriptions by status", zap.Error(err))
+		return nil, err
+	}
+	return counts, nil
+}
+
 // Status resolves the status field on Prescription (converts domain enum to GraphQL enum)
 func (r *PrescriptionResolver) Status(ctx context.Context, obj *model.Prescription) (generated.PrescriptionStatus, error) {
-	// Convert domain status to GraphQL enum
-	switch obj.Status {
+	return graphQLStatus(obj.Status), nil
+}
+
+// CountStatus resolves the status field on StatusCount
+func (r *PrescriptionResolver) CountStatus(ctx context.Context, obj *model.StatusCount) (generated.PrescriptionStatus, error) {
+	return graphQLStatus(obj.Status), nil
+}
+
+// graphQLStatus converts a domain status to the GraphQL enum
+func graphQLStatus(status model.Status) generated.PrescriptionStatus {
+	switch status {
 	case model.Draft:
-		return generated.PrescriptionStatusDraft, nil
+		return generated.PrescriptionStatusDraft
 	case model.Active:
-		return generated.PrescriptionStatusActive, nil
+		return generated.PrescriptionStatusActive
 	case model.Paused:
-		return generated.PrescriptionStatusPaused, nil
+		return generated.PrescriptionStatusPaused
 	case model.Completed:
-		return generated.PrescriptionStatusCompleted, nil
+		return generated.PrescriptionStatusCompleted
+	case model.Expired:
+		return generated.PrescriptionStatusExpired
+	default:
+		return generated.PrescriptionStatusDraft
+	}
+}
+
+// DomainStatus converts the GraphQL enum to the domain status; an unknown value is a validation error
+func DomainStatus(status generated.PrescriptionStatus) (model.Status, error) {
+	switch status {
+	case generated.PrescriptionStatusDraft:
+		return model.Draft, nil
+	case generated.PrescriptionStatusActive:
+		return model.Active, nil
+	case generated.PrescriptionStatusPaused:
+		return model.Paused, nil
+	case generated.PrescriptionStatusCompleted:
+		return model.Completed, nil
+	case generated.PrescriptionStatusExpired:
+		return model.Expired, nil
 	default:
-		return generated.PrescriptionStatusDraft, nil
+		return "", errors.NewValidationError("status", string(status), "unknown prescription status")
 	}
 }
+
+// Directions resolves the sig as label text in the requested language, English by default
+func (r *PrescriptionResolver) Directions(ctx context.Context, obj *model.Prescription, language *generated.SigLanguage) (string, error) {
+	if language != nil && *language == generated.SigLanguageEs {
+		return obj.Sig.Render(model.LanguageSpanish), nil
+	}
+	return obj.Sig.Render(model.LanguageEnglish), nil
+}
+
+// doseFromInput returns the structured dose; nil without one
+func doseFromInput(input *generated.DoseInput) *model.Dose {
+	if input == nil {
+		return nil
+	}
+	dose := &model.Dose{Value: input.Value, Unit: model.StrengthUnit(input.Unit)}
+	if input.Frequency != nil {
+		dose.Frequency = model.Frequency(*input.Frequency)
+	}
+	if input.Route != nil {
+		dose.Route = model.Route(*input.Route)
+	}
+	return dose
+}
+
+// sigFromInput returns the structured sig, or parses the free-text one
+func sigFromInput(input *generated.SigInput, text *string) (model.Sig, error) {
+	if input != nil {
+		sig := model.Sig{}
+		if input.DoseQuantity != nil {
+			sig.DoseQuantity = *input.DoseQuantity
+		}
+		if input.DoseUnit != nil {
+			sig.DoseUnit = model.DoseUnit(*input.DoseUnit)
+		}
+		if input.Route != nil {
+			sig.Route = model.Route(*input.Route)
+		}
+		if input.Frequency != nil {
+			sig.Frequency = model.Frequency(*input.Frequency)
+		}
+		if input.Timing != nil {
+			sig.Timing = model.SigTiming(*input.Timing)
+		}
+		if input.AsNeeded != nil {
+			sig.AsNeeded = *input.AsNeeded
+		}
+		if input.Indication != nil {
+			sig.Indication = *input.Indication
+		}
+		return sig, nil
+	}
+	if text == nil || *text == "" {
+		return model.Sig{}, nil
+	}
+	sig, err := model.ParseSig(*text)
+	if err != nil {
+		return model.Sig{}, errors.NewValidationError("sigText", *text, err.Error())
+	}
+	return sig, nil
+}
+
+// FulfillmentStatus resolves the pharmacy fulfillment status; nil until it has been polled
+func (r *PrescriptionResolver) FulfillmentStatus(ctx context.Context, obj *model.Prescription) (*string, error) {
+	if obj.FulfillmentStatus == "" {
+		return nil, nil
+	}
+	status := string(obj.FulfillmentStatus)
+	return &status, nil
+}
+
+// History resolves the history field on Prescription
+func (r *PrescriptionResolver) History(ctx context.Context, obj *model.Prescription, limit *int, after *string) (*model.PrescriptionHistoryConnection, error) {
+	lim := 0
+	if limit != nil {
+		lim = *limit
+	}
+	cursor := ""
+	if after != nil {
+		cursor = *after
+	}
+
+	page, err := r.HistoryService.History(ctx, obj.ID, lim, cursor)
+	if err != nil {
+		r.Logger.Error("Failed to fetch prescription history",
+			zap.String("prescription_id", obj.ID),
+			zap.Error(err))
+		return nil, err
+	}
+	return &page, nil
+}
+
+// HistoryEventType resolves the type field on PrescriptionHistoryEvent
+func (r *PrescriptionResolver) HistoryEventType(ctx context.Context, obj *model.PrescriptionHistoryEvent) (generated.PrescriptionHistoryEventType, error) {
+	eventType := generated.PrescriptionHistoryEventType(obj.Type)
+	if !eventType.IsValid() {
+		return "", fmt.Errorf("unknown prescription history event type %q", obj.Type)
+	}
+	return eventType, nil
+}
+
+// HistoryStatus resolves the fromStatus and toStatus fields on PrescriptionHistoryEvent; nil when unset
+func (r *PrescriptionResolver) HistoryStatus(status model.Status) *generated.PrescriptionStatus {
+	if status == "" {
+		return nil
+	}
+	converted := graphQLStatus(status)
+	return &converted
+}
+
+// Severity resolves the severity field on DrugInteractionWarning
+func (r *PrescriptionResolver) Severity(ctx context.Context, obj *model.DrugInteractionWarning) (string, error) {
+	return string(obj.Severity), nil
+}
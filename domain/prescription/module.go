@@ -8,27 +8,63 @@ import (
 	prescriptionapi "pharmacy-modernization-project-model/domain/prescription/api"
 	prescriptionbuilder "pharmacy-modernization-project-model/domain/prescription/builder"
 	microui "pharmacy-modernization-project-model/domain/prescription/micro_ui"
+	prescriptionproviders "pharmacy-modernization-project-model/domain/prescription/providers"
 	prescriptionservice "pharmacy-modernization-project-model/domain/prescription/service"
 	uiprescription "pharmacy-modernization-project-model/domain/prescription/ui"
+	prescriptionworker "pharmacy-modernization-project-model/domain/prescription/worker"
 	irisbilling "pharmacy-modernization-project-model/internal/integrations/iris_billing"
 	irispharmacy "pharmacy-modernization-project-model/internal/integrations/iris_pharmacy"
+	"pharmacy-modernization-project-model/internal/platform/attachments"
+	"pharmacy-modernization-project-model/internal/platform/audit"
 	"pharmacy-modernization-project-model/internal/platform/cache"
+	"pharmacy-modernization-project-model/internal/platform/database"
+	"pharmacy-modernization-project-model/internal/platform/events"
+	"pharmacy-modernization-project-model/internal/platform/navigation"
+	"pharmacy-modernization-project-model/internal/platform/pagination"
 )
 
 type ModuleDependencies struct {
-	Logger                       *zap.Logger
-	PharmacyClient               irispharmacy.PharmacyClient
-	BillingClient                irisbilling.BillingClient
-	PrescriptionsMongoCollection *mongo.Collection
-	CacheService                 cache.Cache
+	Logger                          *zap.Logger
+	PharmacyClient                  irispharmacy.PharmacyClient
+	BillingClient                   irisbilling.BillingClient
+	PrescriptionsMongoCollection    *mongo.Collection
+	DrugInteractionsMongoCollection *mongo.Collection
+	DispensesMongoCollection        *mongo.Collection
+	DrugCatalogMongoCollection      *mongo.Collection
+	PrescribersMongoCollection      *mongo.Collection
+	DraftsMongoCollection           *mongo.Collection
+	Transactions                    database.TransactionRunner // Nil runs the writes of a dispense reversal one by one
+	Retrier                         *database.Retrier          // Retries the reads and idempotent writes of the MongoDB repositories; nil runs them once
+	Events                          events.Publisher           // Publishes the services' domain events; nil publishes none
+	AttachmentProvider              prescriptionproviders.AttachmentProvider
+	AuditStore                      audit.Store
+	CacheService                    cache.Cache
+	CacheLoader                     *cache.Loader         // Reads prescriptions through CacheService; nil to use it directly
+	CacheSerializer                 *cache.Serializer     // Encodes cached prescriptions and counts; nil stores JSON
+	Navigation                      *navigation.BackStack // Back links and breadcrumbs of the UI pages
+	Cursors                         *pagination.Codec     // Seals the cursors of the history pages
+	FulfillmentPolling              prescriptionworker.FulfillmentPollerConfig
+	Expiration                      prescriptionworker.ExpirationJobConfig
+	Drafts                          prescriptionservice.DraftConfig
 }
 
 type ModuleExport struct {
 	PrescriptionService prescriptionservice.PrescriptionService
+	PrescriberService   prescriptionservice.PrescriberService
+	DispenseService     prescriptionservice.DispenseService
+	DraftService        prescriptionservice.DraftService
+	HistoryService      prescriptionservice.HistoryService
+	FulfillmentPoller   *prescriptionworker.FulfillmentPoller
+	ExpirationJob       *prescriptionworker.ExpirationJob
 }
 
 func Module(r chi.Router, deps *ModuleDependencies) ModuleExport {
-	repo := prescriptionbuilder.CreatePrescriptionRepository(deps.Logger, deps.PrescriptionsMongoCollection)
+	repo := prescriptionbuilder.CreatePrescriptionRepository(deps.Logger, deps.PrescriptionsMongoCollection, deps.Retrier)
+	interactionRepo := prescriptionbuilder.CreateDrugInteractionRepository(deps.Logger, deps.DrugInteractionsMongoCollection, deps.Retrier)
+	dispenseRepo := prescriptionbuilder.CreateDispenseRepository(deps.Logger, deps.DispensesMongoCollection, deps.Retrier)
+	drugCatalogRepo := prescriptionbuilder.CreateDrugCatalogRepository(deps.Logger, deps.DrugCatalogMongoCollection, deps.Retrier)
+	prescriberRepo := prescriptionbuilder.CreatePrescriberRepository(deps.Logger, deps.PrescribersMongoCollection, deps.Retrier)
+	draftRepo := prescriptionbuilder.CreateDraftRepository(deps.Logger, deps.DraftsMongoCollection, deps.Retrier)
 	pharmacyClient := deps.PharmacyClient
 	if pharmacyClient == nil {
 		pharmacyClient = irispharmacy.NewMockClient(deps.Logger)
@@ -38,11 +74,41 @@ func Module(r chi.Router, deps *ModuleDependencies) ModuleExport {
 		billingClient = irisbilling.NewMockClient(deps.Logger)
 	}
 
-	svc := prescriptionservice.New(repo, deps.CacheService, deps.Logger, pharmacyClient, billingClient)
+	attachmentProvider := deps.AttachmentProvider
+	if attachmentProvider == nil {
+		attachmentProvider = attachments.NewMemoryStore()
+	}
+
+	auditStore := deps.AuditStore
+	if auditStore == nil {
+		auditStore = audit.NewMemoryStore()
+	}
 
-	prescriptionapi.MountAPI(r, &prescriptionapi.Dependencies{Service: svc, Logger: deps.Logger})
-	uiprescription.MountUI(r, &uiprescription.PrescriptionDependencies{PrescriptionSvc: svc, Log: deps.Logger})
+	transactions := deps.Transactions
+	if transactions == nil {
+		transactions = database.NewTransactionRunner(nil, database.TransactionConfig{}, deps.Logger)
+	}
+	publisher := deps.Events
+	if publisher == nil {
+		publisher = events.Discard
+	}
+
+	drugCatalogSvc := prescriptionservice.NewDrugCatalogService(drugCatalogRepo, deps.Logger)
+	prescriberSvc := prescriptionservice.NewPrescriberService(prescriberRepo, repo, deps.Logger)
+	historySvc := prescriptionservice.NewHistoryService(auditStore, deps.Cursors, deps.Logger)
+	svc := prescriptionservice.New(repo, interactionRepo, drugCatalogSvc, prescriberSvc, deps.CacheService, deps.CacheLoader, deps.CacheSerializer, deps.Logger, pharmacyClient, billingClient, historySvc, publisher)
+	dispenseSvc := prescriptionservice.NewDispenseService(dispenseRepo, transactions, attachmentProvider, svc, historySvc, deps.Logger)
+	draftSvc := prescriptionservice.NewDraftService(draftRepo, svc, deps.Drafts, deps.Logger)
+
+	// Completing a prescription hands it over to the patient
+	svc.OnCompleted(dispenseSvc.RecordDispense)
+
+	prescriptionapi.MountAPI(r, &prescriptionapi.Dependencies{Service: svc, DispenseService: dispenseSvc, DrugCatalogService: drugCatalogSvc, PrescriberService: prescriberSvc, DraftService: draftSvc, Logger: deps.Logger})
+	uiprescription.MountUI(r, &uiprescription.PrescriptionDependencies{PrescriptionSvc: svc, DispenseSvc: dispenseSvc, DrugCatalogSvc: drugCatalogSvc, PrescriberSvc: prescriberSvc, Navigation: deps.Navigation, Log: deps.Logger})
 	microui.Mount(r, &microui.Dependencies{PrescriptionSvc: svc, Log: deps.Logger})
 
-	return ModuleExport{PrescriptionService: svc}
+	poller := prescriptionworker.NewFulfillmentPoller(svc, pharmacyClient, deps.Logger, deps.FulfillmentPolling)
+	expiration := prescriptionworker.NewExpirationJob(svc, deps.Logger, deps.Expiration)
+
+	return ModuleExport{PrescriptionService: svc, PrescriberService: prescriberSvc, DispenseService: dispenseSvc, DraftService: draftSvc, HistoryService: historySvc, FulfillmentPoller: poller, ExpirationJob: expiration}
 }
@@ -0,0 +1,12 @@
+package providers
+
+import (
+	"context"
+
+	commonmodel "pharmacy-modernization-project-model/domain/common/model"
+)
+
+// AllergyProvider reads the allergies recorded for a patient, which block prescribing a matching drug
+type AllergyProvider interface {
+	PatientAllergyListByPatientID(ctx context.Context, patientID string) ([]commonmodel.PatientAllergy, error)
+}
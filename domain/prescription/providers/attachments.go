@@ -0,0 +1,13 @@
+package providers
+
+import (
+	"context"
+
+	"pharmacy-modernization-project-model/internal/platform/attachments"
+)
+
+// AttachmentProvider stores the files linked to prescription records, such as pickup signatures
+type AttachmentProvider interface {
+	Put(ctx context.Context, attachment attachments.Attachment, data []byte) (attachments.Attachment, error)
+	Get(ctx context.Context, id string) (attachments.Attachment, []byte, error)
+}
@@ -0,0 +1,12 @@
+package providers
+
+import (
+	"context"
+
+	commonmodel "pharmacy-modernization-project-model/domain/common/model"
+)
+
+// PriceEstimateProvider estimates what a prescription will cost, for the prescription pages
+type PriceEstimateProvider interface {
+	PrescriptionPriceEstimateByID(ctx context.Context, prescriptionID string) (commonmodel.PrescriptionPriceEstimate, error)
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+type dispenseMemoryRepository struct {
+	mu    sync.RWMutex
+	items map[string]m.DispenseRecord
+}
+
+func NewDispenseMemoryRepository() DispenseRepository {
+	r := &dispenseMemoryRepository{items: map[string]m.DispenseRecord{}}
+
+	// Unsigned pickups for two of the completed sample prescriptions
+	for _, d := range []m.DispenseRecord{
+		{ID: "D001", Kind: m.DispenseKindDispense, PrescriptionID: "R003", PatientID: "P004", Drug: "Amoxicillin_*P004", Dose: "500mg", DispensedBy: "pharmacist@dev.local", DispensedAt: time.Now().AddDate(0, 0, -2)},
+		{ID: "D002", Kind: m.DispenseKindDispense, PrescriptionID: "R007", PatientID: "P008", Drug: "Amoxicillin_*P008", Dose: "500mg", DispensedBy: "pharmacist@dev.local", DispensedAt: time.Now().AddDate(0, 0, -1)},
+	} {
+		r.items[d.ID] = d
+	}
+	return r
+}
+
+func (r *dispenseMemoryRepository) Create(ctx context.Context, d m.DispenseRecord) (m.DispenseRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.items[d.ID] = d
+	return d, nil
+}
+
+func (r *dispenseMemoryRepository) GetByID(ctx context.Context, id string) (m.DispenseRecord, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	d, ok := r.items[id]
+	if !ok {
+		return m.DispenseRecord{}, platformErrors.NewRecordNotFoundError("dispense", id)
+	}
+	return d, nil
+}
+
+func (r *dispenseMemoryRepository) ListByPrescriptionID(ctx context.Context, prescriptionID string) ([]m.DispenseRecord, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	res := []m.DispenseRecord{}
+	for _, d := range r.items {
+		if d.PrescriptionID == prescriptionID {
+			res = append(res, d)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].DispensedAt.After(res[j].DispensedAt) })
+	return res, nil
+}
+
+func (r *dispenseMemoryRepository) SetSignature(ctx context.Context, id string, signature m.PickupSignature) (m.DispenseRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	d, ok := r.items[id]
+	if !ok {
+		return m.DispenseRecord{}, platformErrors.NewRecordNotFoundError("dispense", id)
+	}
+	if d.Signature != nil {
+		return m.DispenseRecord{}, platformErrors.NewConflictError("dispense", id, "pickup is already signed")
+	}
+	d.Signature = &signature
+	r.items[id] = d
+	return d, nil
+}
+
+func (r *dispenseMemoryRepository) MarkReversed(ctx context.Context, id, reversalID string) (m.DispenseRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	d, ok := r.items[id]
+	if !ok {
+		return m.DispenseRecord{}, platformErrors.NewRecordNotFoundError("dispense", id)
+	}
+	if d.IsReversed() {
+		return m.DispenseRecord{}, platformErrors.NewConflictError("dispense", id, "dispense is already reversed")
+	}
+	d.ReversedByID = reversalID
+	r.items[id] = d
+	return d, nil
+}
+
+func (r *dispenseMemoryRepository) ClearReversed(ctx context.Context, id, reversalID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if d, ok := r.items[id]; ok && d.ReversedByID == reversalID {
+		d.ReversedByID = ""
+		r.items[id] = d
+	}
+	return nil
+}
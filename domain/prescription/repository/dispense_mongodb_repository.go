@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/validators/validation_logic"
+)
+
+// DispenseMongoRepository implements DispenseRepository interface using MongoDB
+type DispenseMongoRepository struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewDispenseMongoRepository creates a new MongoDB dispense repository
+func NewDispenseMongoRepository(collection *mongo.Collection, logger *zap.Logger) DispenseRepository {
+	return &DispenseMongoRepository{
+		collection: collection,
+		logger:     logger,
+	}
+}
+
+// handleError processes errors and converts them to appropriate repository errors
+func (r *DispenseMongoRepository) handleError(operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	r.logger.Error("MongoDB operation failed",
+		zap.String("operation", operation),
+		zap.Error(err))
+
+	return platformErrors.HandleMongoError(operation, err)
+}
+
+// Create inserts a new dispense record
+func (r *DispenseMongoRepository) Create(ctx context.Context, d m.DispenseRecord) (m.DispenseRecord, error) {
+	if _, err := r.collection.InsertOne(ctx, d); err != nil {
+		return m.DispenseRecord{}, r.handleError("Create", err)
+	}
+	return d, nil
+}
+
+// GetByID retrieves a dispense record by ID
+func (r *DispenseMongoRepository) GetByID(ctx context.Context, id string) (m.DispenseRecord, error) {
+	// Validate input to prevent NoSQL injection
+	if err := validation_logic.ValidateID("dispense_id", id); err != nil {
+		return m.DispenseRecord{}, platformErrors.NewValidationError("dispense_id", id, "Invalid dispense ID format")
+	}
+
+	var d m.DispenseRecord
+	if err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&d); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return m.DispenseRecord{}, platformErrors.NewRecordNotFoundError("dispense", id)
+		}
+		return m.DispenseRecord{}, r.handleError("GetByID", err)
+	}
+	return d, nil
+}
+
+// ListByPrescriptionID retrieves the dispenses of a prescription, newest first
+func (r *DispenseMongoRepository) ListByPrescriptionID(ctx context.Context, prescriptionID string) ([]m.DispenseRecord, error) {
+	start := time.Now()
+	defer func() {
+		r.logger.Debug("MongoDB ListByPrescriptionID operation completed",
+			zap.Duration("duration", time.Since(start)))
+	}()
+
+	// Validate input to prevent NoSQL injection
+	if err := validation_logic.ValidateID("prescription_id", prescriptionID); err != nil {
+		return nil, platformErrors.NewValidationError("prescription_id", prescriptionID, "Invalid prescription ID format")
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "dispensed_at", Value: -1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"prescription_id": prescriptionID}, opts)
+	if err != nil {
+		return nil, r.handleError("ListByPrescriptionID", err)
+	}
+	defer cursor.Close(ctx)
+
+	dispenses := []m.DispenseRecord{}
+	if err := cursor.All(ctx, &dispenses); err != nil {
+		return nil, r.handleError("ListByPrescriptionID", err)
+	}
+	return dispenses, nil
+}
+
+// SetSignature stores the pickup signature on an unsigned dispense
+func (r *DispenseMongoRepository) SetSignature(ctx context.Context, id string, signature m.PickupSignature) (m.DispenseRecord, error) {
+	// Only unsigned dispenses match, so a concurrent second signature cannot overwrite the first
+	filter := bson.M{"_id": id, "signature": bson.M{"$exists": false}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var d m.DispenseRecord
+	err := r.collection.FindOneAndUpdate(ctx, filter, bson.M{"$set": bson.M{"signature": signature}}, opts).Decode(&d)
+	if err == mongo.ErrNoDocuments {
+		if _, getErr := r.GetByID(ctx, id); getErr != nil {
+			return m.DispenseRecord{}, getErr
+		}
+		return m.DispenseRecord{}, platformErrors.NewConflictError("dispense", id, "pickup is already signed")
+	}
+	if err != nil {
+		return m.DispenseRecord{}, r.handleError("SetSignature", err)
+	}
+	return d, nil
+}
+
+// MarkReversed links an unreversed dispense to its reversal
+func (r *DispenseMongoRepository) MarkReversed(ctx context.Context, id, reversalID string) (m.DispenseRecord, error) {
+	// Only unreversed dispenses match, so two concurrent reversals cannot both succeed
+	filter := bson.M{"_id": id, "reversed_by_id": bson.M{"$exists": false}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var d m.DispenseRecord
+	err := r.collection.FindOneAndUpdate(ctx, filter, bson.M{"$set": bson.M{"reversed_by_id": reversalID}}, opts).Decode(&d)
+	if err == mongo.ErrNoDocuments {
+		if _, getErr := r.GetByID(ctx, id); getErr != nil {
+			return m.DispenseRecord{}, getErr
+		}
+		return m.DispenseRecord{}, platformErrors.NewConflictError("dispense", id, "dispense is already reversed")
+	}
+	if err != nil {
+		return m.DispenseRecord{}, r.handleError("MarkReversed", err)
+	}
+	return d, nil
+}
+
+// ClearReversed removes the reversal link, if it still points at the given reversal
+func (r *DispenseMongoRepository) ClearReversed(ctx context.Context, id, reversalID string) error {
+	_, err := r.collection.UpdateOne(ctx,
+		bson.M{"_id": id, "reversed_by_id": reversalID},
+		bson.M{"$unset": bson.M{"reversed_by_id": ""}})
+	if err != nil {
+		return r.handleError("ClearReversed", err)
+	}
+	return nil
+}
+
+// CreateIndexes creates the prescription lookup index used by ListByPrescriptionID
+func (r *DispenseMongoRepository) CreateIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "prescription_id", Value: 1}, {Key: "dispensed_at", Value: -1}},
+		Options: options.Index().SetName("prescription_id_1_dispensed_at_-1"),
+	})
+	if err != nil {
+		return r.handleError("CreateIndexes", err)
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+)
+
+type DispenseRepository interface {
+	Create(ctx context.Context, d m.DispenseRecord) (m.DispenseRecord, error)
+	GetByID(ctx context.Context, id string) (m.DispenseRecord, error)
+	// ListByPrescriptionID returns the dispenses of a prescription, newest first
+	ListByPrescriptionID(ctx context.Context, prescriptionID string) ([]m.DispenseRecord, error)
+	// SetSignature attaches the pickup signature; a dispense can only be signed once
+	SetSignature(ctx context.Context, id string, signature m.PickupSignature) (m.DispenseRecord, error)
+	// MarkReversed links a dispense to its reversal; a dispense can only be reversed once
+	MarkReversed(ctx context.Context, id, reversalID string) (m.DispenseRecord, error)
+	// ClearReversed removes the link again when the reversal could not be recorded
+	ClearReversed(ctx context.Context, id, reversalID string) error
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// DispenseRetryRepository retries the reads and idempotent writes of a dispense repository that
+// fail with a retryable error; Create, SetSignature, MarkReversed and ClearReversed run once
+type DispenseRetryRepository struct {
+	next    DispenseRepository
+	retrier *database.Retrier
+}
+
+// NewDispenseRetryRepository wraps next with retries; without a retrier next is returned as is
+func NewDispenseRetryRepository(next DispenseRepository, retrier *database.Retrier) DispenseRepository {
+	if retrier == nil {
+		return next
+	}
+	return &DispenseRetryRepository{next: next, retrier: retrier}
+}
+
+// Create runs once: a retried insert that reached the server would fail as a duplicate
+func (r *DispenseRetryRepository) Create(ctx context.Context, d m.DispenseRecord) (m.DispenseRecord, error) {
+	return r.next.Create(ctx, d)
+}
+
+func (r *DispenseRetryRepository) GetByID(ctx context.Context, id string) (m.DispenseRecord, error) {
+	return database.Retry(ctx, r.retrier, "dispenses.GetByID", func(ctx context.Context) (m.DispenseRecord, error) {
+		return r.next.GetByID(ctx, id)
+	})
+}
+
+func (r *DispenseRetryRepository) ListByPrescriptionID(ctx context.Context, prescriptionID string) ([]m.DispenseRecord, error) {
+	return database.Retry(ctx, r.retrier, "dispenses.ListByPrescriptionID", func(ctx context.Context) ([]m.DispenseRecord, error) {
+		return r.next.ListByPrescriptionID(ctx, prescriptionID)
+	})
+}
+
+// SetSignature runs once: it only applies while the dispense has no signature, which a retried call that reached the server no longer finds
+func (r *DispenseRetryRepository) SetSignature(ctx context.Context, id string, signature m.PickupSignature) (m.DispenseRecord, error) {
+	return r.next.SetSignature(ctx, id, signature)
+}
+
+// MarkReversed runs once: it only applies while the dispense is not reversed, which a retried call that reached the server no longer finds
+func (r *DispenseRetryRepository) MarkReversed(ctx context.Context, id, reversalID string) (m.DispenseRecord, error) {
+	return r.next.MarkReversed(ctx, id, reversalID)
+}
+
+// ClearReversed runs once: it only applies while the dispense is reversed by reversalID, which a retried call that reached the server no longer finds
+func (r *DispenseRetryRepository) ClearReversed(ctx context.Context, id, reversalID string) error {
+	return r.next.ClearReversed(ctx, id, reversalID)
+}
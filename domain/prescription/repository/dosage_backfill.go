@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+)
+
+// UnreadableDose is a prescription whose dose text BackfillDosage could not parse
+type UnreadableDose struct {
+	ID     string
+	Dose   string
+	Reason string
+}
+
+// DosageBackfillResult counts the prescription documents visited by BackfillDosage
+type DosageBackfillResult struct {
+	Scanned    int
+	Filled     int // Given a dosage parsed from their dose text
+	Changed    int // Modified by someone else while backfilling; left for the next run
+	Unreadable []UnreadableDose
+}
+
+// BackfillDosage gives every prescription without a dosage the one its legacy dose text
+// describes. Texts ParseDose cannot read are reported and left as they are, to be fixed by hand;
+// the text itself is never rewritten. With dryRun documents are only parsed and counted.
+func BackfillDosage(ctx context.Context, collection *mongo.Collection, batchSize int, dryRun bool) (DosageBackfillResult, error) {
+	result := DosageBackfillResult{}
+
+	cursor, err := collection.Find(ctx, bson.M{"dosage": bson.M{"$exists": false}}, options.Find().
+		SetBatchSize(int32(batchSize)).
+		SetProjection(bson.M{"_id": 1, "dose": 1}).
+		SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return result, err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		result.Scanned++
+		var doc struct {
+			ID   string `bson:"_id"`
+			Dose string `bson:"dose"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return result, fmt.Errorf("prescription %v: %w", cursor.Current.Lookup("_id"), err)
+		}
+
+		dose, err := m.ParseDose(doc.Dose)
+		if err != nil {
+			reason := err.Error()
+			if parseErr, ok := err.(*m.DoseParseError); ok {
+				reason = parseErr.Reason
+			}
+			result.Unreadable = append(result.Unreadable, UnreadableDose{ID: doc.ID, Dose: doc.Dose, Reason: reason})
+			continue
+		}
+		if dryRun {
+			result.Filled++
+			continue
+		}
+
+		// Only fill documents whose dose is still the text that was parsed
+		filter := bson.M{"_id": doc.ID, "dose": doc.Dose, "dosage": bson.M{"$exists": false}}
+		updated, err := collection.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"dosage": dose}})
+		if err != nil {
+			return result, fmt.Errorf("prescription %s: %w", doc.ID, err)
+		}
+		if updated.MatchedCount == 0 {
+			result.Changed++
+			continue
+		}
+		result.Filled++
+	}
+	return result, cursor.Err()
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+type draftMemoryRepository struct {
+	mu    sync.Mutex
+	items map[string]m.PrescriptionDraft
+}
+
+func NewDraftMemoryRepository() DraftRepository {
+	return &draftMemoryRepository{items: map[string]m.PrescriptionDraft{}}
+}
+
+// pruneLocked removes the expired drafts, as the TTL index does in MongoDB
+func (r *draftMemoryRepository) pruneLocked() {
+	now := time.Now()
+	for id, d := range r.items {
+		if d.Expired(now) {
+			delete(r.items, id)
+		}
+	}
+}
+
+func (r *draftMemoryRepository) GetByID(ctx context.Context, id string) (m.PrescriptionDraft, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pruneLocked()
+	d, ok := r.items[id]
+	if !ok {
+		return m.PrescriptionDraft{}, platformErrors.NewRecordNotFoundError("prescription draft", id)
+	}
+	return d, nil
+}
+
+func (r *draftMemoryRepository) ListByOwner(ctx context.Context, ownerID string) ([]m.PrescriptionDraft, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pruneLocked()
+	res := []m.PrescriptionDraft{}
+	for _, d := range r.items {
+		if d.OwnerID == ownerID {
+			res = append(res, d)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].UpdatedAt.After(res[j].UpdatedAt) })
+	return res, nil
+}
+
+func (r *draftMemoryRepository) CountByOwner(ctx context.Context, ownerID string) (int, error) {
+	drafts, err := r.ListByOwner(ctx, ownerID)
+	return len(drafts), err
+}
+
+func (r *draftMemoryRepository) Save(ctx context.Context, d m.PrescriptionDraft) (m.PrescriptionDraft, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pruneLocked()
+	if existing, ok := r.items[d.ID]; ok {
+		if existing.OwnerID != d.OwnerID {
+			return m.PrescriptionDraft{}, platformErrors.NewDuplicateRecordError("prescription draft", d.ID)
+		}
+		d.CreatedAt = existing.CreatedAt
+	}
+	r.items[d.ID] = d
+	return d, nil
+}
+
+func (r *draftMemoryRepository) Take(ctx context.Context, id, ownerID string, savedAt time.Time) (m.PrescriptionDraft, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.takeLocked(id, ownerID, func(d m.PrescriptionDraft) bool { return d.UpdatedAt.Equal(savedAt) })
+}
+
+func (r *draftMemoryRepository) Delete(ctx context.Context, id, ownerID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, err := r.takeLocked(id, ownerID, func(m.PrescriptionDraft) bool { return true })
+	return err
+}
+
+func (r *draftMemoryRepository) takeLocked(id, ownerID string, match func(m.PrescriptionDraft) bool) (m.PrescriptionDraft, error) {
+	r.pruneLocked()
+	d, ok := r.items[id]
+	if !ok || d.OwnerID != ownerID || !match(d) {
+		return m.PrescriptionDraft{}, platformErrors.NewRecordNotFoundError("prescription draft", id)
+	}
+	delete(r.items, id)
+	return d, nil
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// DraftMongoRepository implements DraftRepository interface using MongoDB
+type DraftMongoRepository struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewDraftMongoRepository creates a new MongoDB prescription draft repository
+func NewDraftMongoRepository(collection *mongo.Collection, logger *zap.Logger) *DraftMongoRepository {
+	return &DraftMongoRepository{
+		collection: collection,
+		logger:     logger,
+	}
+}
+
+// handleError processes errors and converts them to appropriate repository errors
+func (r *DraftMongoRepository) handleError(operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	r.logger.Error("MongoDB operation failed",
+		zap.String("operation", operation),
+		zap.Error(err))
+
+	return platformErrors.HandleMongoError(operation, err)
+}
+
+// liveDrafts matches the drafts not yet expired; the TTL monitor only runs once a minute
+func liveDrafts(filter bson.M) bson.M {
+	filter["expires_at"] = bson.M{"$gt": time.Now()}
+	return filter
+}
+
+// GetByID retrieves a draft by ID
+func (r *DraftMongoRepository) GetByID(ctx context.Context, id string) (m.PrescriptionDraft, error) {
+	var d m.PrescriptionDraft
+	if err := r.collection.FindOne(ctx, liveDrafts(bson.M{"_id": id})).Decode(&d); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return m.PrescriptionDraft{}, platformErrors.NewRecordNotFoundError("prescription draft", id)
+		}
+		return m.PrescriptionDraft{}, r.handleError("GetByID", err)
+	}
+	return d, nil
+}
+
+// ListByOwner retrieves the owner's drafts, most recently saved first
+func (r *DraftMongoRepository) ListByOwner(ctx context.Context, ownerID string) ([]m.PrescriptionDraft, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "updated_at", Value: -1}})
+	cursor, err := r.collection.Find(ctx, liveDrafts(bson.M{"owner_id": ownerID}), opts)
+	if err != nil {
+		return nil, r.handleError("ListByOwner", err)
+	}
+	defer cursor.Close(ctx)
+
+	drafts := []m.PrescriptionDraft{}
+	if err := cursor.All(ctx, &drafts); err != nil {
+		return nil, r.handleError("ListByOwner", err)
+	}
+	return drafts, nil
+}
+
+// CountByOwner counts the owner's drafts
+func (r *DraftMongoRepository) CountByOwner(ctx context.Context, ownerID string) (int, error) {
+	count, err := r.collection.CountDocuments(ctx, liveDrafts(bson.M{"owner_id": ownerID}))
+	if err != nil {
+		return 0, r.handleError("CountByOwner", err)
+	}
+	return int(count), nil
+}
+
+// Save upserts the owner's draft. An expired draft of the ID is replaced whoever owned it; a live
+// one of another owner misses the filter, and the upsert collides on _id.
+func (r *DraftMongoRepository) Save(ctx context.Context, d m.PrescriptionDraft) (m.PrescriptionDraft, error) {
+	filter := bson.M{"_id": d.ID, "$or": bson.A{
+		bson.M{"owner_id": d.OwnerID},
+		bson.M{"expires_at": bson.M{"$lte": time.Now()}},
+	}}
+	update := bson.M{
+		"$set": bson.M{
+			"owner_id":        d.OwnerID,
+			"prescription_id": d.PrescriptionID,
+			"form":            d.Form,
+			"updated_at":      d.UpdatedAt,
+			"expires_at":      d.ExpiresAt,
+		},
+		"$setOnInsert": bson.M{"created_at": d.CreatedAt},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var saved m.PrescriptionDraft
+	if err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&saved); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return m.PrescriptionDraft{}, platformErrors.NewDuplicateRecordError("prescription draft", d.ID)
+		}
+		return m.PrescriptionDraft{}, r.handleError("Save", err)
+	}
+	return saved, nil
+}
+
+// Take removes the owner's draft as it was saved at savedAt and returns it
+func (r *DraftMongoRepository) Take(ctx context.Context, id, ownerID string, savedAt time.Time) (m.PrescriptionDraft, error) {
+	filter := liveDrafts(bson.M{"_id": id, "owner_id": ownerID, "updated_at": savedAt})
+	var d m.PrescriptionDraft
+	if err := r.collection.FindOneAndDelete(ctx, filter).Decode(&d); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return m.PrescriptionDraft{}, platformErrors.NewRecordNotFoundError("prescription draft", id)
+		}
+		return m.PrescriptionDraft{}, r.handleError("Take", err)
+	}
+	return d, nil
+}
+
+// Delete removes the owner's draft
+func (r *DraftMongoRepository) Delete(ctx context.Context, id, ownerID string) error {
+	result, err := r.collection.DeleteOne(ctx, liveDrafts(bson.M{"_id": id, "owner_id": ownerID}))
+	if err != nil {
+		return r.handleError("Delete", err)
+	}
+	if result.DeletedCount == 0 {
+		return platformErrors.NewRecordNotFoundError("prescription draft", id)
+	}
+	return nil
+}
+
+// CreateIndexes creates the owner index used by ListByOwner and the TTL index that removes
+// abandoned drafts
+func (r *DraftMongoRepository) CreateIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "owner_id", Value: 1}, {Key: "updated_at", Value: -1}},
+			Options: options.Index().SetName("owner_id_1_updated_at_-1"),
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetName("expires_at_ttl").SetExpireAfterSeconds(0),
+		},
+	})
+	if err != nil {
+		return r.handleError("CreateIndexes", err)
+	}
+	return nil
+}
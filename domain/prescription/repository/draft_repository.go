@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+)
+
+// DraftRepository stores prescription drafts; expired drafts are treated as not found
+type DraftRepository interface {
+	GetByID(ctx context.Context, id string) (m.PrescriptionDraft, error)
+	// ListByOwner returns the owner's drafts, most recently saved first
+	ListByOwner(ctx context.Context, ownerID string) ([]m.PrescriptionDraft, error)
+	CountByOwner(ctx context.Context, ownerID string) (int, error)
+	// Save creates the draft or replaces the owner's draft of the ID, keeping its creation time; a
+	// draft of the ID owned by someone else is a duplicate
+	Save(ctx context.Context, d m.PrescriptionDraft) (m.PrescriptionDraft, error)
+	// Take removes the owner's draft as it was saved at savedAt and returns it, so only one
+	// promotion of a draft succeeds; a draft saved again since is not found
+	Take(ctx context.Context, id, ownerID string, savedAt time.Time) (m.PrescriptionDraft, error)
+	// Delete removes the owner's draft
+	Delete(ctx context.Context, id, ownerID string) error
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// DraftRetryRepository retries the reads and idempotent writes of a draft repository that fail
+// with a retryable error; Take and Delete run once
+type DraftRetryRepository struct {
+	next    DraftRepository
+	retrier *database.Retrier
+}
+
+// NewDraftRetryRepository wraps next with retries; without a retrier next is returned as is
+func NewDraftRetryRepository(next DraftRepository, retrier *database.Retrier) DraftRepository {
+	if retrier == nil {
+		return next
+	}
+	return &DraftRetryRepository{next: next, retrier: retrier}
+}
+
+func (r *DraftRetryRepository) GetByID(ctx context.Context, id string) (m.PrescriptionDraft, error) {
+	return database.Retry(ctx, r.retrier, "prescription_drafts.GetByID", func(ctx context.Context) (m.PrescriptionDraft, error) {
+		return r.next.GetByID(ctx, id)
+	})
+}
+
+func (r *DraftRetryRepository) ListByOwner(ctx context.Context, ownerID string) ([]m.PrescriptionDraft, error) {
+	return database.Retry(ctx, r.retrier, "prescription_drafts.ListByOwner", func(ctx context.Context) ([]m.PrescriptionDraft, error) {
+		return r.next.ListByOwner(ctx, ownerID)
+	})
+}
+
+func (r *DraftRetryRepository) CountByOwner(ctx context.Context, ownerID string) (int, error) {
+	return database.Retry(ctx, r.retrier, "prescription_drafts.CountByOwner", func(ctx context.Context) (int, error) {
+		return r.next.CountByOwner(ctx, ownerID)
+	})
+}
+
+// Save is retried: saving the same draft again leaves the same document
+func (r *DraftRetryRepository) Save(ctx context.Context, d m.PrescriptionDraft) (m.PrescriptionDraft, error) {
+	return database.Retry(ctx, r.retrier, "prescription_drafts.Save", func(ctx context.Context) (m.PrescriptionDraft, error) {
+		return r.next.Save(ctx, d)
+	})
+}
+
+// Take runs once: a retried call that reached the server would no longer find the draft
+func (r *DraftRetryRepository) Take(ctx context.Context, id, ownerID string, savedAt time.Time) (m.PrescriptionDraft, error) {
+	return r.next.Take(ctx, id, ownerID, savedAt)
+}
+
+// Delete runs once: a retried call that reached the server would no longer find the draft
+func (r *DraftRetryRepository) Delete(ctx context.Context, id, ownerID string) error {
+	return r.next.Delete(ctx, id, ownerID)
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+type drugCatalogMemoryRepository struct {
+	mu    sync.RWMutex
+	items map[string]m.Drug
+}
+
+// DefaultDrugCatalog is the reference catalog used to seed the in-memory repository and the
+// drug_catalog collection. It covers the drugs in the sample prescriptions and interaction pairs.
+var DefaultDrugCatalog = []m.Drug{
+	{ID: "DRG001", Name: "Acetaminophen", BrandNames: []string{"Tylenol"}, Synonyms: []string{"Paracetamol", "APAP"}, DrugClass: "Analgesic"},
+	{ID: "DRG002", Name: "Albuterol", BrandNames: []string{"ProAir", "Ventolin"}, Synonyms: []string{"Salbutamol"}, DrugClass: "Bronchodilator"},
+	{ID: "DRG003", Name: "Amlodipine", BrandNames: []string{"Norvasc"}, DrugClass: "Calcium channel blocker"},
+	{ID: "DRG004", Name: "Amoxicillin", BrandNames: []string{"Amoxil"}, Synonyms: []string{"Amoxycillin"}, DrugClass: "Antibiotic"},
+	{ID: "DRG005", Name: "Aspirin", BrandNames: []string{"Bayer", "Ecotrin"}, Synonyms: []string{"Acetylsalicylic acid", "ASA"}, DrugClass: "Antiplatelet"},
+	{ID: "DRG006", Name: "Atorvastatin", BrandNames: []string{"Lipitor"}, DrugClass: "Statin"},
+	{ID: "DRG007", Name: "Azithromycin", BrandNames: []string{"Zithromax"}, Synonyms: []string{"Z-Pak"}, DrugClass: "Antibiotic"},
+	{ID: "DRG008", Name: "Clopidogrel", BrandNames: []string{"Plavix"}, DrugClass: "Antiplatelet"},
+	{ID: "DRG009", Name: "Escitalopram", BrandNames: []string{"Lexapro"}, DrugClass: "SSRI"},
+	{ID: "DRG010", Name: "Furosemide", BrandNames: []string{"Lasix"}, Synonyms: []string{"Frusemide"}, DrugClass: "Diuretic"},
+	{ID: "DRG011", Name: "Gabapentin", BrandNames: []string{"Neurontin"}, DrugClass: "Anticonvulsant"},
+	{ID: "DRG012", Name: "Hydrochlorothiazide", BrandNames: []string{"Microzide"}, Synonyms: []string{"HCTZ"}, DrugClass: "Diuretic"},
+	{ID: "DRG013", Name: "Ibuprofen", BrandNames: []string{"Advil", "Motrin"}, DrugClass: "NSAID"},
+	{ID: "DRG014", Name: "Insulin Glargine", BrandNames: []string{"Lantus", "Basaglar"}, DrugClass: "Insulin"},
+	{ID: "DRG015", Name: "Levothyroxine", BrandNames: []string{"Synthroid", "Levoxyl"}, Synonyms: []string{"L-thyroxine", "T4"}, DrugClass: "Thyroid hormone"},
+	{ID: "DRG016", Name: "Lisinopril", BrandNames: []string{"Prinivil", "Zestril"}, DrugClass: "ACE inhibitor"},
+	{ID: "DRG017", Name: "Losartan", BrandNames: []string{"Cozaar"}, DrugClass: "Angiotensin receptor blocker"},
+	{ID: "DRG018", Name: "Metformin", BrandNames: []string{"Glucophage"}, DrugClass: "Biguanide"},
+	{ID: "DRG019", Name: "Metoprolol", BrandNames: []string{"Lopressor", "Toprol-XL"}, DrugClass: "Beta blocker"},
+	{ID: "DRG020", Name: "Montelukast", BrandNames: []string{"Singulair"}, DrugClass: "Leukotriene receptor antagonist"},
+	{ID: "DRG021", Name: "Omeprazole", BrandNames: []string{"Prilosec"}, DrugClass: "Proton pump inhibitor"},
+	{ID: "DRG022", Name: "Prednisone", BrandNames: []string{"Deltasone", "Rayos"}, DrugClass: "Corticosteroid"},
+	{ID: "DRG023", Name: "Rosuvastatin", BrandNames: []string{"Crestor"}, DrugClass: "Statin"},
+	{ID: "DRG024", Name: "Sertraline", BrandNames: []string{"Zoloft"}, DrugClass: "SSRI"},
+	{ID: "DRG025", Name: "Simvastatin", BrandNames: []string{"Zocor"}, DrugClass: "Statin"},
+	{ID: "DRG026", Name: "Tramadol", BrandNames: []string{"Ultram"}, DrugClass: "Opioid analgesic"},
+	{ID: "DRG027", Name: "Warfarin", BrandNames: []string{"Coumadin", "Jantoven"}, DrugClass: "Anticoagulant"},
+}
+
+func NewDrugCatalogMemoryRepository() DrugCatalogRepository {
+	r := &drugCatalogMemoryRepository{items: map[string]m.Drug{}}
+	for _, drug := range DefaultDrugCatalog {
+		r.items[drug.ID] = drug.WithSearchNames()
+	}
+	return r
+}
+
+func (r *drugCatalogMemoryRepository) GetByID(ctx context.Context, id string) (m.Drug, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	drug, ok := r.items[id]
+	if !ok {
+		return m.Drug{}, platformErrors.NewRecordNotFoundError("drug", id)
+	}
+	return drug, nil
+}
+
+func (r *drugCatalogMemoryRepository) FindByName(ctx context.Context, name string) (m.Drug, bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, drug := range r.sorted() {
+		if _, _, ok := drug.MatchExact(name); ok {
+			return drug, true, nil
+		}
+	}
+	return m.Drug{}, false, nil
+}
+
+func (r *drugCatalogMemoryRepository) Search(ctx context.Context, query string, limit int) ([]m.Drug, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := []m.Drug{}
+	for _, drug := range r.sorted() {
+		if _, _, ok := drug.MatchPrefix(query); !ok {
+			continue
+		}
+		result = append(result, drug)
+		if limit > 0 && len(result) == limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+func (r *drugCatalogMemoryRepository) Upsert(ctx context.Context, drug m.Drug) (m.Drug, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	drug = drug.WithSearchNames()
+	r.items[drug.ID] = drug
+	return drug, nil
+}
+
+// sorted returns the entries ordered by generic name; callers hold the lock
+func (r *drugCatalogMemoryRepository) sorted() []m.Drug {
+	result := make([]m.Drug, 0, len(r.items))
+	for _, v := range r.items {
+		result = append(result, v)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
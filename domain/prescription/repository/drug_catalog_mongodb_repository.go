@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/validators/validation_logic"
+)
+
+// DrugCatalogMongoRepository implements DrugCatalogRepository interface using MongoDB
+type DrugCatalogMongoRepository struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewDrugCatalogMongoRepository creates a new MongoDB drug catalog repository
+func NewDrugCatalogMongoRepository(collection *mongo.Collection, logger *zap.Logger) DrugCatalogRepository {
+	return &DrugCatalogMongoRepository{
+		collection: collection,
+		logger:     logger,
+	}
+}
+
+// handleError processes errors and converts them to appropriate repository errors
+func (r *DrugCatalogMongoRepository) handleError(operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	r.logger.Error("MongoDB operation failed",
+		zap.String("operation", operation),
+		zap.Error(err))
+
+	return platformErrors.HandleMongoError(operation, err)
+}
+
+// GetByID retrieves a catalog entry by ID
+func (r *DrugCatalogMongoRepository) GetByID(ctx context.Context, id string) (m.Drug, error) {
+	// Validate input to prevent NoSQL injection
+	if err := validation_logic.ValidateID("drug_id", id); err != nil {
+		return m.Drug{}, platformErrors.NewValidationError("drug_id", id, "Invalid drug ID format")
+	}
+
+	var drug m.Drug
+	if err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&drug); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return m.Drug{}, platformErrors.NewRecordNotFoundError("drug", id)
+		}
+		return m.Drug{}, r.handleError("GetByID", err)
+	}
+	return drug, nil
+}
+
+// FindByName retrieves the entry with the given generic name, brand name or synonym
+func (r *DrugCatalogMongoRepository) FindByName(ctx context.Context, name string) (m.Drug, bool, error) {
+	if err := validation_logic.ValidateLength("drug", name, 1, 100); err != nil {
+		return m.Drug{}, false, platformErrors.NewValidationError("drug", name, "Invalid drug name")
+	}
+
+	// search_names is stored normalized, so an equality match is case-insensitive
+	var drug m.Drug
+	err := r.collection.FindOne(ctx, bson.M{"search_names": m.NormalizeDrugName(name)}).Decode(&drug)
+	if err == mongo.ErrNoDocuments {
+		return m.Drug{}, false, nil
+	}
+	if err != nil {
+		return m.Drug{}, false, r.handleError("FindByName", err)
+	}
+	return drug, true, nil
+}
+
+// Search retrieves entries with a name, or a word within a name, starting with the query
+func (r *DrugCatalogMongoRepository) Search(ctx context.Context, query string, limit int) ([]m.Drug, error) {
+	start := time.Now()
+	defer func() {
+		r.logger.Debug("MongoDB Search operation completed",
+			zap.Duration("duration", time.Since(start)))
+	}()
+
+	// Validate input to prevent NoSQL injection
+	if err := validation_logic.ValidateLength("query", query, 1, 100); err != nil {
+		return nil, platformErrors.NewValidationError("query", query, "Invalid drug search")
+	}
+
+	pattern := "(^|\\s)" + regexp.QuoteMeta(m.NormalizeDrugName(query))
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"search_names": bson.M{"$regex": pattern}}, opts)
+	if err != nil {
+		return nil, r.handleError("Search", err)
+	}
+	defer cursor.Close(ctx)
+
+	drugs := []m.Drug{}
+	if err := cursor.All(ctx, &drugs); err != nil {
+		return nil, r.handleError("Search", err)
+	}
+	return drugs, nil
+}
+
+// Upsert inserts or replaces a catalog entry, rebuilding its search names
+func (r *DrugCatalogMongoRepository) Upsert(ctx context.Context, drug m.Drug) (m.Drug, error) {
+	if err := validation_logic.ValidateID("id", drug.ID); err != nil {
+		return m.Drug{}, platformErrors.NewValidationError("id", drug.ID, "Invalid drug ID format")
+	}
+
+	drug = drug.WithSearchNames()
+	opts := options.Replace().SetUpsert(true)
+	if _, err := r.collection.ReplaceOne(ctx, bson.M{"_id": drug.ID}, drug, opts); err != nil {
+		return m.Drug{}, r.handleError("Upsert", err)
+	}
+
+	return drug, nil
+}
+
+// CreateIndexes creates recommended indexes for optimal performance
+func (r *DrugCatalogMongoRepository) CreateIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "search_names", Value: 1}},
+			Options: options.Index().SetName("search_names_1"),
+		},
+		{
+			Keys:    bson.D{{Key: "name", Value: 1}},
+			Options: options.Index().SetName("name_1"),
+		},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(ctx, indexes); err != nil {
+		return r.handleError("CreateIndexes", err)
+	}
+
+	r.logger.Info("Successfully created MongoDB indexes for drug_catalog collection")
+	return nil
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+)
+
+type DrugCatalogRepository interface {
+	GetByID(ctx context.Context, id string) (m.Drug, error)
+	// FindByName matches the generic name, a brand name or a synonym exactly (case-insensitive);
+	// the bool is false when no entry has that name
+	FindByName(ctx context.Context, name string) (m.Drug, bool, error)
+	// Search returns entries with any name starting with the query, ordered by generic name
+	Search(ctx context.Context, query string, limit int) ([]m.Drug, error)
+	Upsert(ctx context.Context, drug m.Drug) (m.Drug, error)
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// DrugCatalogRetryRepository retries the reads and idempotent writes of a drug catalog repository
+// that fail with a retryable error
+type DrugCatalogRetryRepository struct {
+	next    DrugCatalogRepository
+	retrier *database.Retrier
+}
+
+// NewDrugCatalogRetryRepository wraps next with retries; without a retrier next is returned as is
+func NewDrugCatalogRetryRepository(next DrugCatalogRepository, retrier *database.Retrier) DrugCatalogRepository {
+	if retrier == nil {
+		return next
+	}
+	return &DrugCatalogRetryRepository{next: next, retrier: retrier}
+}
+
+func (r *DrugCatalogRetryRepository) GetByID(ctx context.Context, id string) (m.Drug, error) {
+	return database.Retry(ctx, r.retrier, "drug_catalog.GetByID", func(ctx context.Context) (m.Drug, error) {
+		return r.next.GetByID(ctx, id)
+	})
+}
+
+func (r *DrugCatalogRetryRepository) FindByName(ctx context.Context, name string) (m.Drug, bool, error) {
+	var (
+		value m.Drug
+		found bool
+	)
+	err := r.retrier.Do(ctx, "drug_catalog.FindByName", func(ctx context.Context) error {
+		var err error
+		value, found, err = r.next.FindByName(ctx, name)
+		return err
+	})
+	return value, found, err
+}
+
+func (r *DrugCatalogRetryRepository) Search(ctx context.Context, query string, limit int) ([]m.Drug, error) {
+	return database.Retry(ctx, r.retrier, "drug_catalog.Search", func(ctx context.Context) ([]m.Drug, error) {
+		return r.next.Search(ctx, query, limit)
+	})
+}
+
+func (r *DrugCatalogRetryRepository) Upsert(ctx context.Context, drug m.Drug) (m.Drug, error) {
+	return database.Retry(ctx, r.retrier, "drug_catalog.Upsert", func(ctx context.Context) (m.Drug, error) {
+		return r.next.Upsert(ctx, drug)
+	})
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+)
+
+type DrugInteractionMemoryRepository struct {
+	mu    sync.RWMutex
+	items map[string]m.DrugInteraction
+}
+
+// DefaultDrugInteractions is the reference set of interaction pairs used to seed
+// the in-memory repository and the drug_interactions collection
+var DefaultDrugInteractions = []m.DrugInteraction{
+	{ID: "DI001", DrugA: "Warfarin", DrugB: "Aspirin", Severity: m.SeveritySevere, Description: "Concurrent use significantly increases the risk of major bleeding."},
+	{ID: "DI002", DrugA: "Warfarin", DrugB: "Ibuprofen", Severity: m.SeveritySevere, Description: "NSAIDs increase anticoagulant effect and gastrointestinal bleeding risk."},
+	{ID: "DI003", DrugA: "Clopidogrel", DrugB: "Omeprazole", Severity: m.SeverityModerate, Description: "Omeprazole reduces the antiplatelet effect of clopidogrel."},
+	{ID: "DI004", DrugA: "Simvastatin", DrugB: "Azithromycin", Severity: m.SeverityModerate, Description: "Macrolides may raise statin levels and the risk of myopathy."},
+	{ID: "DI005", DrugA: "Sertraline", DrugB: "Tramadol", Severity: m.SeveritySevere, Description: "Combined serotonergic effect may cause serotonin syndrome."},
+	{ID: "DI006", DrugA: "Escitalopram", DrugB: "Tramadol", Severity: m.SeveritySevere, Description: "Combined serotonergic effect may cause serotonin syndrome."},
+	{ID: "DI007", DrugA: "Lisinopril", DrugB: "Ibuprofen", Severity: m.SeverityModerate, Description: "NSAIDs may reduce the antihypertensive effect and impair kidney function."},
+	{ID: "DI008", DrugA: "Metformin", DrugB: "Prednisone", Severity: m.SeverityMinor, Description: "Corticosteroids may raise blood glucose and reduce glycemic control."},
+	{ID: "DI009", DrugA: "Levothyroxine", DrugB: "Omeprazole", Severity: m.SeverityMinor, Description: "Reduced stomach acidity may decrease levothyroxine absorption."},
+	{ID: "DI010", DrugA: "Aspirin", DrugB: "Clopidogrel", Severity: m.SeverityModerate, Description: "Dual antiplatelet therapy increases bleeding risk; monitor closely."},
+}
+
+func NewDrugInteractionMemoryRepository() DrugInteractionRepository {
+	r := &DrugInteractionMemoryRepository{items: map[string]m.DrugInteraction{}}
+	for _, interaction := range DefaultDrugInteractions {
+		r.items[interaction.ID] = interaction.WithDrugNames()
+	}
+	return r
+}
+
+func (r *DrugInteractionMemoryRepository) ListByDrug(ctx context.Context, drug string) ([]m.DrugInteraction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	normalized := m.NormalizeDrugName(drug)
+	result := []m.DrugInteraction{}
+	for _, v := range r.items {
+		if m.NormalizeDrugName(v.DrugA) == normalized || m.NormalizeDrugName(v.DrugB) == normalized {
+			result = append(result, v)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result, nil
+}
+
+func (r *DrugInteractionMemoryRepository) List(ctx context.Context) ([]m.DrugInteraction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := make([]m.DrugInteraction, 0, len(r.items))
+	for _, v := range r.items {
+		result = append(result, v)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result, nil
+}
+
+func (r *DrugInteractionMemoryRepository) Upsert(ctx context.Context, interaction m.DrugInteraction) (m.DrugInteraction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	interaction = interaction.WithDrugNames()
+	r.items[interaction.ID] = interaction
+	return interaction, nil
+}
@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/validators/validation_logic"
+)
+
+// DrugInteractionMongoRepository implements DrugInteractionRepository interface using MongoDB
+type DrugInteractionMongoRepository struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewDrugInteractionMongoRepository creates a new MongoDB drug interaction repository
+func NewDrugInteractionMongoRepository(collection *mongo.Collection, logger *zap.Logger) *DrugInteractionMongoRepository {
+	return &DrugInteractionMongoRepository{
+		collection: collection,
+		logger:     logger,
+	}
+}
+
+// handleError processes errors and converts them to appropriate repository errors
+func (r *DrugInteractionMongoRepository) handleError(operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	r.logger.Error("MongoDB operation failed",
+		zap.String("operation", operation),
+		zap.Error(err))
+
+	return platformErrors.HandleMongoError(operation, err)
+}
+
+// ListByDrug retrieves all interaction pairs that include the given drug (case-insensitive)
+func (r *DrugInteractionMongoRepository) ListByDrug(ctx context.Context, drug string) ([]m.DrugInteraction, error) {
+	start := time.Now()
+	defer func() {
+		r.logger.Debug("MongoDB ListByDrug operation completed",
+			zap.Duration("duration", time.Since(start)))
+	}()
+
+	// Validate input to prevent NoSQL injection
+	if err := validation_logic.ValidateLength("drug", drug, 1, 100); err != nil {
+		r.logger.Warn("Invalid drug name provided",
+			zap.Error(err))
+		return nil, platformErrors.NewValidationError("drug", drug, "Invalid drug name")
+	}
+
+	// drug_names is stored normalized, so an equality match on either side is case-insensitive
+	filter := bson.M{"drug_names": m.NormalizeDrugName(drug)}
+
+	cursor, err := r.collection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, r.handleError("ListByDrug", err)
+	}
+	defer cursor.Close(ctx)
+
+	var interactions []m.DrugInteraction
+	if err := cursor.All(ctx, &interactions); err != nil {
+		return nil, r.handleError("ListByDrug", err)
+	}
+
+	return interactions, nil
+}
+
+// List retrieves all known interaction pairs
+func (r *DrugInteractionMongoRepository) List(ctx context.Context) ([]m.DrugInteraction, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, r.handleError("List", err)
+	}
+	defer cursor.Close(ctx)
+
+	var interactions []m.DrugInteraction
+	if err := cursor.All(ctx, &interactions); err != nil {
+		return nil, r.handleError("List", err)
+	}
+
+	return interactions, nil
+}
+
+// Upsert inserts or replaces an interaction pair
+func (r *DrugInteractionMongoRepository) Upsert(ctx context.Context, interaction m.DrugInteraction) (m.DrugInteraction, error) {
+	if err := validation_logic.ValidateID("id", interaction.ID); err != nil {
+		return m.DrugInteraction{}, platformErrors.NewValidationError("id", interaction.ID, "Invalid drug interaction ID format")
+	}
+
+	interaction = interaction.WithDrugNames()
+	filter := bson.M{"_id": interaction.ID}
+	opts := options.Replace().SetUpsert(true)
+	if _, err := r.collection.ReplaceOne(ctx, filter, interaction, opts); err != nil {
+		return m.DrugInteraction{}, r.handleError("Upsert", err)
+	}
+
+	return interaction, nil
+}
+
+// CreateIndexes creates recommended indexes for optimal performance
+func (r *DrugInteractionMongoRepository) CreateIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "drug_names", Value: 1}},
+			Options: options.Index().SetName("drug_names_1"),
+		},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(ctx, indexes); err != nil {
+		return r.handleError("CreateIndexes", err)
+	}
+
+	r.logger.Info("Successfully created MongoDB indexes for drug_interactions collection")
+	return nil
+}
+
+// BackfillDrugNames sets drug_names on pairs written before it was stored, so ListByDrug finds them
+func (r *DrugInteractionMongoRepository) BackfillDrugNames(ctx context.Context) error {
+	normalized := func(field string) bson.M {
+		return bson.M{"$toLower": bson.M{"$trim": bson.M{"input": field}}}
+	}
+	result, err := r.collection.UpdateMany(ctx,
+		bson.M{"drug_names": bson.M{"$exists": false}},
+		mongo.Pipeline{{{Key: "$set", Value: bson.M{
+			"drug_names": bson.A{normalized("$drug_a"), normalized("$drug_b")},
+		}}}})
+	if err != nil {
+		return r.handleError("BackfillDrugNames", err)
+	}
+	if result.ModifiedCount > 0 {
+		r.logger.Info("Backfilled drug_interactions drug_names", zap.Int64("modified", result.ModifiedCount))
+	}
+	return nil
+}
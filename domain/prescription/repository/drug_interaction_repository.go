@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+)
+
+type DrugInteractionRepository interface {
+	ListByDrug(ctx context.Context, drug string) ([]m.DrugInteraction, error)
+	List(ctx context.Context) ([]m.DrugInteraction, error)
+	Upsert(ctx context.Context, interaction m.DrugInteraction) (m.DrugInteraction, error)
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// DrugInteractionRetryRepository retries the reads and idempotent writes of a drug interaction
+// repository that fail with a retryable error
+type DrugInteractionRetryRepository struct {
+	next    DrugInteractionRepository
+	retrier *database.Retrier
+}
+
+// NewDrugInteractionRetryRepository wraps next with retries; without a retrier next is returned as is
+func NewDrugInteractionRetryRepository(next DrugInteractionRepository, retrier *database.Retrier) DrugInteractionRepository {
+	if retrier == nil {
+		return next
+	}
+	return &DrugInteractionRetryRepository{next: next, retrier: retrier}
+}
+
+func (r *DrugInteractionRetryRepository) ListByDrug(ctx context.Context, drug string) ([]m.DrugInteraction, error) {
+	return database.Retry(ctx, r.retrier, "drug_interactions.ListByDrug", func(ctx context.Context) ([]m.DrugInteraction, error) {
+		return r.next.ListByDrug(ctx, drug)
+	})
+}
+
+func (r *DrugInteractionRetryRepository) List(ctx context.Context) ([]m.DrugInteraction, error) {
+	return database.Retry(ctx, r.retrier, "drug_interactions.List", func(ctx context.Context) ([]m.DrugInteraction, error) {
+		return r.next.List(ctx)
+	})
+}
+
+func (r *DrugInteractionRetryRepository) Upsert(ctx context.Context, interaction m.DrugInteraction) (m.DrugInteraction, error) {
+	return database.Retry(ctx, r.retrier, "drug_interactions.Upsert", func(ctx context.Context) (m.DrugInteraction, error) {
+		return r.next.Upsert(ctx, interaction)
+	})
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+type prescriberMemoryRepository struct {
+	mu    sync.RWMutex
+	items map[string]m.Prescriber
+}
+
+// DefaultPrescribers seed the in-memory repository and the prescribers collection; the sample
+// prescriptions are attributed to them in turn
+var DefaultPrescribers = []m.Prescriber{
+	{ID: "DR001", NPI: "1234567893", FirstName: "Dana", LastName: "Whitfield", Credential: "MD", Specialty: "Family Medicine", Phone: "555-201-4410", Fax: "555-201-4411", Email: "dwhitfield@example.org"},
+	{ID: "DR002", NPI: "1245319599", FirstName: "Marcus", LastName: "Okafor", Credential: "MD", Specialty: "Internal Medicine", Phone: "555-201-5520", Fax: "555-201-5521", Email: "mokafor@example.org"},
+	{ID: "DR003", NPI: "1326046442", FirstName: "Priya", LastName: "Raman", Credential: "DO", Specialty: "Cardiology", Phone: "555-201-6630", Email: "praman@example.org"},
+	{ID: "DR004", NPI: "1457390874", FirstName: "Elena", LastName: "Castillo", Credential: "NP", Specialty: "Pediatrics", Phone: "555-201-7740", Fax: "555-201-7741"},
+	{ID: "DR005", NPI: "1538162110", FirstName: "Thomas", LastName: "Brennan", Credential: "MD", Specialty: "Endocrinology", Phone: "555-201-8850", Email: "tbrennan@example.org"},
+}
+
+func NewPrescriberMemoryRepository() PrescriberRepository {
+	r := &prescriberMemoryRepository{items: map[string]m.Prescriber{}}
+	created := time.Now().AddDate(-1, 0, 0)
+	for _, p := range DefaultPrescribers {
+		p.CreatedAt = created
+		p.UpdatedAt = created
+		r.items[p.ID] = p
+	}
+	return r
+}
+
+func (r *prescriberMemoryRepository) List(ctx context.Context, query string, limit, offset int) ([]m.Prescriber, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	query = strings.ToLower(strings.TrimSpace(query))
+	result := []m.Prescriber{}
+	for _, p := range r.items {
+		if query == "" ||
+			strings.HasPrefix(strings.ToLower(p.FirstName), query) ||
+			strings.HasPrefix(strings.ToLower(p.LastName), query) ||
+			strings.HasPrefix(p.NPI, query) {
+			result = append(result, p)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].LastName != result[j].LastName {
+			return result[i].LastName < result[j].LastName
+		}
+		return result[i].FirstName < result[j].FirstName
+	})
+	if offset >= len(result) {
+		return []m.Prescriber{}, nil
+	}
+	result = result[offset:]
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (r *prescriberMemoryRepository) GetByID(ctx context.Context, id string) (m.Prescriber, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	p, ok := r.items[id]
+	if !ok {
+		return m.Prescriber{}, platformErrors.NewRecordNotFoundError("prescriber", id)
+	}
+	return p, nil
+}
+
+func (r *prescriberMemoryRepository) GetByNPI(ctx context.Context, npi string) (m.Prescriber, bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, p := range r.items {
+		if p.NPI == npi {
+			return p, true, nil
+		}
+	}
+	return m.Prescriber{}, false, nil
+}
+
+func (r *prescriberMemoryRepository) Create(ctx context.Context, p m.Prescriber) (m.Prescriber, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.items {
+		if existing.NPI == p.NPI {
+			return m.Prescriber{}, platformErrors.NewDuplicateRecordError("prescriber", p.NPI)
+		}
+	}
+	r.items[p.ID] = p
+	return p, nil
+}
+
+func (r *prescriberMemoryRepository) Update(ctx context.Context, p m.Prescriber) (m.Prescriber, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.items[p.ID]; !ok {
+		return m.Prescriber{}, platformErrors.NewRecordNotFoundError("prescriber", p.ID)
+	}
+	for _, existing := range r.items {
+		if existing.NPI == p.NPI && existing.ID != p.ID {
+			return m.Prescriber{}, platformErrors.NewDuplicateRecordError("prescriber", p.NPI)
+		}
+	}
+	r.items[p.ID] = p
+	return p, nil
+}
+
+func (r *prescriberMemoryRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.items[id]; !ok {
+		return platformErrors.NewRecordNotFoundError("prescriber", id)
+	}
+	delete(r.items, id)
+	return nil
+}
@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/validators/validation_logic"
+)
+
+// PrescriberMongoRepository implements PrescriberRepository interface using MongoDB
+type PrescriberMongoRepository struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewPrescriberMongoRepository creates a new MongoDB prescriber repository
+func NewPrescriberMongoRepository(collection *mongo.Collection, logger *zap.Logger) *PrescriberMongoRepository {
+	return &PrescriberMongoRepository{
+		collection: collection,
+		logger:     logger,
+	}
+}
+
+// handleError processes errors and converts them to appropriate repository errors
+func (r *PrescriberMongoRepository) handleError(operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	r.logger.Error("MongoDB operation failed",
+		zap.String("operation", operation),
+		zap.Error(err))
+
+	return platformErrors.HandleMongoError(operation, err)
+}
+
+// List retrieves prescribers ordered by name, optionally matching the start of a name or the NPI
+func (r *PrescriberMongoRepository) List(ctx context.Context, query string, limit, offset int) ([]m.Prescriber, error) {
+	start := time.Now()
+	defer func() {
+		r.logger.Debug("MongoDB List operation completed",
+			zap.Int("limit", limit),
+			zap.Int("offset", offset),
+			zap.Duration("duration", time.Since(start)))
+	}()
+
+	filter := bson.M{}
+	if query != "" {
+		// Validate input to prevent NoSQL injection
+		if err := validation_logic.ValidateLength("query", query, 1, 100); err != nil {
+			return nil, platformErrors.NewValidationError("query", query, "Invalid prescriber search")
+		}
+		prefix := bson.M{"$regex": "^" + regexp.QuoteMeta(query), "$options": "i"}
+		filter["$or"] = bson.A{
+			bson.M{"first_name": prefix},
+			bson.M{"last_name": prefix},
+			bson.M{"npi": prefix},
+		}
+	}
+
+	opts := options.Find().
+		SetSkip(int64(offset)).
+		SetSort(bson.D{{Key: "last_name", Value: 1}, {Key: "first_name", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, r.handleError("List", err)
+	}
+	defer cursor.Close(ctx)
+
+	prescribers := []m.Prescriber{}
+	if err := cursor.All(ctx, &prescribers); err != nil {
+		return nil, r.handleError("List", err)
+	}
+	return prescribers, nil
+}
+
+// GetByID retrieves a prescriber by ID
+func (r *PrescriberMongoRepository) GetByID(ctx context.Context, id string) (m.Prescriber, error) {
+	// Validate input to prevent NoSQL injection
+	if err := validation_logic.ValidateID("prescriber_id", id); err != nil {
+		return m.Prescriber{}, platformErrors.NewValidationError("prescriber_id", id, "Invalid prescriber ID format")
+	}
+
+	var prescriber m.Prescriber
+	if err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&prescriber); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return m.Prescriber{}, platformErrors.NewRecordNotFoundError("prescriber", id)
+		}
+		return m.Prescriber{}, r.handleError("GetByID", err)
+	}
+	return prescriber, nil
+}
+
+// GetByNPI retrieves the prescriber with the given NPI
+func (r *PrescriberMongoRepository) GetByNPI(ctx context.Context, npi string) (m.Prescriber, bool, error) {
+	if err := validation_logic.ValidateNPIValue("npi", npi); err != nil {
+		return m.Prescriber{}, false, platformErrors.NewValidationError("npi", npi, "Invalid NPI")
+	}
+
+	var prescriber m.Prescriber
+	err := r.collection.FindOne(ctx, bson.M{"npi": npi}).Decode(&prescriber)
+	if err == mongo.ErrNoDocuments {
+		return m.Prescriber{}, false, nil
+	}
+	if err != nil {
+		return m.Prescriber{}, false, r.handleError("GetByNPI", err)
+	}
+	return prescriber, true, nil
+}
+
+// Create inserts a prescriber; the unique NPI index rejects a second prescriber with the same NPI
+func (r *PrescriberMongoRepository) Create(ctx context.Context, p m.Prescriber) (m.Prescriber, error) {
+	if _, err := r.collection.InsertOne(ctx, p); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return m.Prescriber{}, platformErrors.NewDuplicateRecordError("prescriber", p.NPI)
+		}
+		return m.Prescriber{}, r.handleError("Create", err)
+	}
+	return p, nil
+}
+
+// Update replaces a prescriber
+func (r *PrescriberMongoRepository) Update(ctx context.Context, p m.Prescriber) (m.Prescriber, error) {
+	if err := validation_logic.ValidateID("prescriber_id", p.ID); err != nil {
+		return m.Prescriber{}, platformErrors.NewValidationError("prescriber_id", p.ID, "Invalid prescriber ID format")
+	}
+
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": p.ID}, p)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return m.Prescriber{}, platformErrors.NewDuplicateRecordError("prescriber", p.NPI)
+		}
+		return m.Prescriber{}, r.handleError("Update", err)
+	}
+	if result.MatchedCount == 0 {
+		return m.Prescriber{}, platformErrors.NewRecordNotFoundError("prescriber", p.ID)
+	}
+	return p, nil
+}
+
+// Delete removes a prescriber
+func (r *PrescriberMongoRepository) Delete(ctx context.Context, id string) error {
+	if err := validation_logic.ValidateID("prescriber_id", id); err != nil {
+		return platformErrors.NewValidationError("prescriber_id", id, "Invalid prescriber ID format")
+	}
+
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return r.handleError("Delete", err)
+	}
+	if result.DeletedCount == 0 {
+		return platformErrors.NewRecordNotFoundError("prescriber", id)
+	}
+	return nil
+}
+
+// CreateIndexes creates recommended indexes for optimal performance
+func (r *PrescriberMongoRepository) CreateIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "npi", Value: 1}},
+			Options: options.Index().SetName("npi_1").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "last_name", Value: 1}, {Key: "first_name", Value: 1}},
+			Options: options.Index().SetName("last_name_1_first_name_1"),
+		},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(ctx, indexes); err != nil {
+		return r.handleError("CreateIndexes", err)
+	}
+
+	r.logger.Info("Successfully created MongoDB indexes for prescribers collection")
+	return nil
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+)
+
+type PrescriberRepository interface {
+	// List returns prescribers ordered by last name; a query matches the start of the first or
+	// last name, or the NPI
+	List(ctx context.Context, query string, limit, offset int) ([]m.Prescriber, error)
+	GetByID(ctx context.Context, id string) (m.Prescriber, error)
+	// GetByNPI returns the prescriber with the NPI; the bool is false when there is none
+	GetByNPI(ctx context.Context, npi string) (m.Prescriber, bool, error)
+	Create(ctx context.Context, p m.Prescriber) (m.Prescriber, error)
+	Update(ctx context.Context, p m.Prescriber) (m.Prescriber, error)
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// PrescriberRetryRepository retries the reads and idempotent writes of a prescriber repository that
+// fail with a retryable error; Create and Delete run once
+type PrescriberRetryRepository struct {
+	next    PrescriberRepository
+	retrier *database.Retrier
+}
+
+// NewPrescriberRetryRepository wraps next with retries; without a retrier next is returned as is
+func NewPrescriberRetryRepository(next PrescriberRepository, retrier *database.Retrier) PrescriberRepository {
+	if retrier == nil {
+		return next
+	}
+	return &PrescriberRetryRepository{next: next, retrier: retrier}
+}
+
+func (r *PrescriberRetryRepository) List(ctx context.Context, query string, limit, offset int) ([]m.Prescriber, error) {
+	return database.Retry(ctx, r.retrier, "prescribers.List", func(ctx context.Context) ([]m.Prescriber, error) {
+		return r.next.List(ctx, query, limit, offset)
+	})
+}
+
+func (r *PrescriberRetryRepository) GetByID(ctx context.Context, id string) (m.Prescriber, error) {
+	return database.Retry(ctx, r.retrier, "prescribers.GetByID", func(ctx context.Context) (m.Prescriber, error) {
+		return r.next.GetByID(ctx, id)
+	})
+}
+
+func (r *PrescriberRetryRepository) GetByNPI(ctx context.Context, npi string) (m.Prescriber, bool, error) {
+	var (
+		value m.Prescriber
+		found bool
+	)
+	err := r.retrier.Do(ctx, "prescribers.GetByNPI", func(ctx context.Context) error {
+		var err error
+		value, found, err = r.next.GetByNPI(ctx, npi)
+		return err
+	})
+	return value, found, err
+}
+
+// Create runs once: a retried insert that reached the server would fail as a duplicate
+func (r *PrescriberRetryRepository) Create(ctx context.Context, p m.Prescriber) (m.Prescriber, error) {
+	return r.next.Create(ctx, p)
+}
+
+func (r *PrescriberRetryRepository) Update(ctx context.Context, p m.Prescriber) (m.Prescriber, error) {
+	return database.Retry(ctx, r.retrier, "prescribers.Update", func(ctx context.Context) (m.Prescriber, error) {
+		return r.next.Update(ctx, p)
+	})
+}
+
+// Delete runs once: a retried delete that reached the server would report the record missing
+func (r *PrescriberRetryRepository) Delete(ctx context.Context, id string) error {
+	return r.next.Delete(ctx, id)
+}
@@ -4,10 +4,20 @@ import (
 	"context"
 	"fmt"
 	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/tenancy"
+	"slices"
+	"sort"
+	"sync"
 	"time"
 )
 
-type PrescriptionMemoryRepository struct{ items map[string]m.Prescription }
+// PrescriptionMemoryRepository keeps prescriptions in memory for running without MongoDB; it
+// behaves like PrescriptionMongoRepository, which cmd/conformance checks
+type PrescriptionMemoryRepository struct {
+	mu    sync.RWMutex
+	items map[string]m.Prescription
+}
 
 func NewPrescriptionMemoryRepository() PrescriptionRepository {
 	r := &PrescriptionMemoryRepository{
@@ -29,60 +39,295 @@ func NewPrescriptionMemoryRepository() PrescriptionRepository {
 			PatientID: patientID,
 			Drug:      "Amoxicillin_*" + patientID,
 			Dose:      "500mg",
+			Dosage:    &m.Dose{Value: 500, Unit: m.StrengthMilligram},
 			Status:    statuses[i%len(statuses)],
 			CreatedAt: time.Now().AddDate(0, 0, -i),
+			OrgID:     tenancy.DefaultOrgID,
+
+			PrescriberID: DefaultPrescribers[i%len(DefaultPrescribers)].ID,
 		}
 	}
 	return r
 }
 
+// List returns a page of the prescriptions with a status, newest first; a zero limit returns
+// all of them
 func (r *PrescriptionMemoryRepository) List(ctx context.Context, status string, limit, offset int) ([]m.Prescription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 	res := []m.Prescription{}
 	for _, v := range r.items {
-		if status == "" || string(v.Status) == status {
+		if tenancy.Visible(ctx, v.OrgID) && (status == "" || string(v.Status) == status) {
 			res = append(res, v)
 		}
 	}
+	sortNewestFirst(res)
 	if offset >= len(res) {
 		return []m.Prescription{}, nil
 	}
-	end := offset + limit
-	if end > len(res) {
-		end = len(res)
+	end := len(res)
+	if limit > 0 && offset+limit < end {
+		end = offset + limit
 	}
 	return res[offset:end], nil
 }
+
 func (r *PrescriptionMemoryRepository) GetByID(ctx context.Context, id string) (m.Prescription, error) {
-	return r.items[id], nil
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if p, ok := r.items[id]; ok && tenancy.Visible(ctx, p.OrgID) {
+		return p, nil
+	}
+	return m.Prescription{}, platformErrors.NewRecordNotFoundError("Prescription", id)
 }
+
 func (r *PrescriptionMemoryRepository) Create(ctx context.Context, p m.Prescription) (m.Prescription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// IDs are unique across organizations, as they are in the collection
+	if _, ok := r.items[p.ID]; ok {
+		return m.Prescription{}, platformErrors.NewDuplicateRecordError("Prescription", p.ID)
+	}
+	if p.CreatedAt.IsZero() {
+		p.CreatedAt = time.Now()
+	}
+	p.OrgID = tenancy.Assign(ctx, p.OrgID)
 	r.items[p.ID] = p
 	return p, nil
 }
+
+// Update writes the clinical fields of a prescription. Fulfillment is owned by the polling
+// worker and the prescriber by the creation, so both are kept, as are the organization and
+// creation time.
 func (r *PrescriptionMemoryRepository) Update(ctx context.Context, id string, p m.Prescription) (m.Prescription, error) {
-	r.items[id] = p
-	return p, nil
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.items[id]
+	if !ok || !tenancy.Visible(ctx, existing.OrgID) {
+		return m.Prescription{}, platformErrors.NewRecordNotFoundError("Prescription", id)
+	}
+	existing.PatientID = p.PatientID
+	existing.Drug = p.Drug
+	existing.DrugID = p.DrugID
+	existing.DrugEntered = p.DrugEntered
+	existing.Dose = p.Dose
+	existing.Dosage = p.Dosage
+	existing.Sig = p.Sig
+	existing.Quantity = p.Quantity
+	existing.DaysSupply = p.DaysSupply
+	existing.ExpectedEndDate = p.ExpectedEndDate
+	existing.Status = p.Status
+	existing.InteractionWarnings = p.InteractionWarnings
+	r.items[id] = existing
+	return existing, nil
 }
 
+// ListByPatientID returns the prescriptions of a patient, newest first
 func (r *PrescriptionMemoryRepository) ListByPatientID(ctx context.Context, patientID string) ([]m.Prescription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 	result := []m.Prescription{}
 	for _, v := range r.items {
-		if v.PatientID == patientID {
+		if v.PatientID == patientID && tenancy.Visible(ctx, v.OrgID) {
 			result = append(result, v)
 		}
 	}
+	sortNewestFirst(result)
 	return result, nil
 }
 
-func (r *PrescriptionMemoryRepository) CountByStatus(ctx context.Context, status string) (int, error) {
-	if status == "" {
-		return len(r.items), nil
+func (r *PrescriptionMemoryRepository) ListForPatient(ctx context.Context, patientID string, status m.Status, limit int) ([]m.Prescription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := []m.Prescription{}
+	for _, v := range r.items {
+		if v.PatientID == patientID && (status == "" || v.Status == status) && tenancy.Visible(ctx, v.OrgID) {
+			result = append(result, v)
+		}
+	}
+	sortNewestFirst(result)
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
 	}
+	return result, nil
+}
+
+func (r *PrescriptionMemoryRepository) CountByStatus(ctx context.Context, status string) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 	count := 0
 	for _, v := range r.items {
-		if string(v.Status) == status {
+		if tenancy.Visible(ctx, v.OrgID) && (status == "" || string(v.Status) == status) {
 			count++
 		}
 	}
 	return count, nil
 }
+
+func (r *PrescriptionMemoryRepository) CountGroupedByStatus(ctx context.Context) ([]m.StatusCount, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	byStatus := map[m.Status]int{}
+	for _, v := range r.items {
+		if tenancy.Visible(ctx, v.OrgID) {
+			byStatus[v.Status]++
+		}
+	}
+	counts := make([]m.StatusCount, 0, len(byStatus))
+	for status, count := range byStatus {
+		counts = append(counts, m.StatusCount{Status: status, Count: count})
+	}
+	sort.Slice(counts, func(i, j int) bool { return counts[i].Status < counts[j].Status })
+	return counts, nil
+}
+
+func (r *PrescriptionMemoryRepository) ListByPrescriberID(ctx context.Context, prescriberID string, limit int) ([]m.Prescription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := []m.Prescription{}
+	for _, v := range r.items {
+		if v.PrescriberID == prescriberID && tenancy.Visible(ctx, v.OrgID) {
+			result = append(result, v)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+// ListInFlight returns active prescriptions whose fulfillment has not reached a terminal status,
+// in ID order starting after afterID
+func (r *PrescriptionMemoryRepository) ListInFlight(ctx context.Context, afterID string, limit int) ([]m.Prescription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := []m.Prescription{}
+	for _, v := range r.items {
+		if v.Status != m.Active || v.FulfillmentStatus.IsTerminal() || !tenancy.Visible(ctx, v.OrgID) {
+			continue
+		}
+		if afterID != "" && v.ID <= afterID {
+			continue
+		}
+		result = append(result, v)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (r *PrescriptionMemoryRepository) ListByPrescriber(ctx context.Context, prescribedBy string, limit int) ([]m.Prescription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := []m.Prescription{}
+	for _, v := range r.items {
+		if v.PrescribedBy == prescribedBy && tenancy.Visible(ctx, v.OrgID) {
+			result = append(result, v)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (r *PrescriptionMemoryRepository) UpdateFulfillmentStatus(ctx context.Context, id string, status m.FulfillmentStatus, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p, ok := r.items[id]
+	if !ok || !tenancy.Visible(ctx, p.OrgID) {
+		return platformErrors.NewRecordNotFoundError("Prescription", id)
+	}
+	p.FulfillmentStatus = status
+	p.FulfillmentUpdatedAt = &at
+	r.items[id] = p
+	return nil
+}
+
+func (r *PrescriptionMemoryRepository) UpdatePharmacy(ctx context.Context, id string, pharmacy m.Pharmacy, status m.FulfillmentStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p, ok := r.items[id]
+	if !ok || !tenancy.Visible(ctx, p.OrgID) {
+		return platformErrors.NewRecordNotFoundError("Prescription", id)
+	}
+	p.Pharmacy = &pharmacy
+	p.FulfillmentStatus = status
+	p.FulfillmentUpdatedAt = &pharmacy.RoutedAt
+	r.items[id] = p
+	return nil
+}
+
+func (r *PrescriptionMemoryRepository) ListByStatusCreatedBefore(ctx context.Context, status m.Status, before time.Time, limit int) ([]m.Prescription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := []m.Prescription{}
+	for _, v := range r.items {
+		if v.Status == status && v.CreatedAt.Before(before) && tenancy.Visible(ctx, v.OrgID) {
+			result = append(result, v)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.Before(result[j].CreatedAt) })
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (r *PrescriptionMemoryRepository) UpdateStatus(ctx context.Context, id string, from, to m.Status) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p, ok := r.items[id]
+	if !ok || !tenancy.Visible(ctx, p.OrgID) {
+		return false, platformErrors.NewRecordNotFoundError("Prescription", id)
+	}
+	if p.Status != from {
+		return false, nil
+	}
+	p.Status = to
+	r.items[id] = p
+	return true, nil
+}
+
+func (r *PrescriptionMemoryRepository) ListByIDs(ctx context.Context, ids []string) ([]m.Prescription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := []m.Prescription{}
+	for _, id := range ids {
+		if p, ok := r.items[id]; ok && tenancy.Visible(ctx, p.OrgID) && !slices.ContainsFunc(result, func(found m.Prescription) bool { return found.ID == id }) {
+			result = append(result, p)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result, nil
+}
+
+func (r *PrescriptionMemoryRepository) UpdateStatuses(ctx context.Context, changes []m.StatusChange) ([]bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	applied := make([]bool, len(changes))
+	for i, change := range changes {
+		p, ok := r.items[change.ID]
+		if !ok || !tenancy.Visible(ctx, p.OrgID) || p.Status != change.From {
+			continue
+		}
+		p.Status = change.To
+		r.items[change.ID] = p
+		applied[i] = true
+	}
+	return applied, nil
+}
+
+// sortNewestFirst orders prescriptions by creation time, newest first, and then by ID
+func sortNewestFirst(prescriptions []m.Prescription) {
+	sort.Slice(prescriptions, func(i, j int) bool {
+		if !prescriptions[i].CreatedAt.Equal(prescriptions[j].CreatedAt) {
+			return prescriptions[i].CreatedAt.After(prescriptions[j].CreatedAt)
+		}
+		return prescriptions[i].ID < prescriptions[j].ID
+	})
+}
@@ -10,8 +10,10 @@ import (
 	"go.uber.org/zap"
 
 	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
 	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
 	"pharmacy-modernization-project-model/internal/platform/sanitizer"
+	"pharmacy-modernization-project-model/internal/platform/tenancy"
 	"pharmacy-modernization-project-model/internal/validators/validation_logic"
 )
 
@@ -54,10 +56,10 @@ func (r *PrescriptionMongoRepository) List(ctx context.Context, status string, l
 	}()
 
 	// Build filter with input validation
-	filter := bson.M{}
+	filter := tenancy.Filter(ctx, bson.M{})
 	if status != "" {
 		// Validate status input to prevent NoSQL injection
-		if err := validation_logic.ValidateOneOf("status", status, "Draft", "Active", "Paused", "Completed"); err != nil {
+		if err := validation_logic.ValidateOneOf("status", status, "Draft", "Active", "Paused", "Completed", "Expired"); err != nil {
 			r.logger.Warn("Invalid status provided",
 				zap.Error(err))
 			return nil, platformErrors.NewValidationError("status", status, "Invalid status value")
@@ -69,7 +71,7 @@ func (r *PrescriptionMongoRepository) List(ctx context.Context, status string, l
 	opts := options.Find().
 		SetLimit(int64(limit)).
 		SetSkip(int64(offset)).
-		SetSort(bson.D{{Key: "created_at", Value: -1}})
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: 1}}) // _id keeps pages stable on ties
 
 	// Execute query
 	cursor, err := r.collection.Find(ctx, filter, opts)
@@ -107,7 +109,7 @@ func (r *PrescriptionMongoRepository) GetByID(ctx context.Context, id string) (m
 		return m.Prescription{}, platformErrors.NewValidationError("id", id, "Invalid prescription ID format")
 	}
 
-	filter := bson.M{"_id": id}
+	filter := tenancy.Filter(ctx, bson.M{"_id": id})
 
 	var prescription m.Prescription
 	err := r.collection.FindOne(ctx, filter).Decode(&prescription)
@@ -134,6 +136,7 @@ func (r *PrescriptionMongoRepository) Create(ctx context.Context, p m.Prescripti
 	if p.CreatedAt.IsZero() {
 		p.CreatedAt = time.Now()
 	}
+	p.OrgID = tenancy.Assign(ctx, p.OrgID)
 
 	// Insert document
 	_, err := r.collection.InsertOne(ctx, p)
@@ -164,14 +167,22 @@ func (r *PrescriptionMongoRepository) Update(ctx context.Context, id string, p m
 		return m.Prescription{}, platformErrors.NewValidationError("id", id, "Invalid prescription ID format")
 	}
 
-	filter := bson.M{"_id": id}
+	filter := tenancy.Filter(ctx, bson.M{"_id": id})
 	update := bson.M{
 		"$set": bson.M{
-			"patient_id": p.PatientID,
-			"drug":       p.Drug,
-			"dose":       p.Dose,
-			"status":     p.Status,
-			"updated_at": time.Now(),
+			"patient_id":           p.PatientID,
+			"drug":                 p.Drug,
+			"drug_id":              p.DrugID,
+			"drug_entered":         p.DrugEntered,
+			"dose":                 p.Dose,
+			"dosage":               p.Dosage,
+			"sig":                  p.Sig,
+			"quantity":             p.Quantity,
+			"days_supply":          p.DaysSupply,
+			"expected_end_date":    p.ExpectedEndDate,
+			"status":               p.Status,
+			"interaction_warnings": p.InteractionWarnings,
+			"updated_at":           time.Now(),
 		},
 	}
 
@@ -216,9 +227,9 @@ func (r *PrescriptionMongoRepository) ListByPatientID(ctx context.Context, patie
 		return nil, platformErrors.NewValidationError("patient_id", patientID, "Invalid patient ID format")
 	}
 
-	filter := bson.M{"patient_id": patientID}
+	filter := tenancy.Filter(ctx, bson.M{"patient_id": patientID})
 	opts := options.Find().
-		SetSort(bson.D{{Key: "created_at", Value: -1}})
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: 1}})
 
 	cursor, err := r.collection.Find(ctx, filter, opts)
 	if err != nil {
@@ -238,7 +249,8 @@ func (r *PrescriptionMongoRepository) ListByPatientID(ctx context.Context, patie
 	return prescriptions, nil
 }
 
-// CountByStatus returns the total number of prescriptions matching the status
+// CountByStatus returns the total number of prescriptions matching the status. It is safe for
+// secondary reads.
 func (r *PrescriptionMongoRepository) CountByStatus(ctx context.Context, status string) (int, error) {
 	start := time.Now()
 	defer func() {
@@ -247,10 +259,10 @@ func (r *PrescriptionMongoRepository) CountByStatus(ctx context.Context, status
 	}()
 
 	// Build filter with input validation
-	filter := bson.M{}
+	filter := tenancy.Filter(ctx, bson.M{})
 	if status != "" {
 		// Validate status input to prevent NoSQL injection
-		if err := validation_logic.ValidateOneOf("status", status, "Draft", "Active", "Paused", "Completed"); err != nil {
+		if err := validation_logic.ValidateOneOf("status", status, "Draft", "Active", "Paused", "Completed", "Expired"); err != nil {
 			r.logger.Warn("Invalid status provided for count",
 				zap.Error(err))
 			return 0, platformErrors.NewValidationError("status", status, "Invalid status value")
@@ -258,7 +270,7 @@ func (r *PrescriptionMongoRepository) CountByStatus(ctx context.Context, status
 		filter["status"] = status
 	}
 
-	count, err := r.collection.CountDocuments(ctx, filter)
+	count, err := database.ForReads(r.collection, database.ReadAnalytics).CountDocuments(ctx, filter)
 	if err != nil {
 		return 0, r.handleError("CountByStatus", err)
 	}
@@ -269,6 +281,361 @@ func (r *PrescriptionMongoRepository) CountByStatus(ctx context.Context, status
 	return int(count), nil
 }
 
+// CountGroupedByStatus groups the prescriptions by status with one aggregation, served by the
+// status_1 index. It feeds the dashboard and is safe for secondary reads.
+func (r *PrescriptionMongoRepository) CountGroupedByStatus(ctx context.Context) ([]m.StatusCount, error) {
+	start := time.Now()
+	defer func() {
+		r.logger.Debug("MongoDB CountGroupedByStatus operation completed",
+			zap.Duration("duration", time.Since(start)))
+	}()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: tenancy.Filter(ctx, bson.M{})}},
+		{{Key: "$group", Value: bson.D{{Key: "_id", Value: "$status"}, {Key: "count", Value: bson.M{"$sum": 1}}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+	}
+	cursor, err := database.ForReads(r.collection, database.ReadAnalytics).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, r.handleError("CountGroupedByStatus", err)
+	}
+	defer cursor.Close(ctx)
+
+	counts := []m.StatusCount{}
+	if err := cursor.All(ctx, &counts); err != nil {
+		return nil, r.handleError("CountGroupedByStatus", err)
+	}
+
+	r.logger.Debug("Successfully counted prescriptions by status in MongoDB",
+		zap.Int("statuses", len(counts)))
+
+	return counts, nil
+}
+
+// ListByPrescriber retrieves the prescriptions created by the user, newest first
+func (r *PrescriptionMongoRepository) ListByPrescriber(ctx context.Context, prescribedBy string, limit int) ([]m.Prescription, error) {
+	start := time.Now()
+	defer func() {
+		r.logger.Debug("MongoDB ListByPrescriber operation completed",
+			zap.Duration("duration", time.Since(start)))
+	}()
+
+	filter := tenancy.Filter(ctx, bson.M{"prescribed_by": prescribedBy})
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, r.handleError("ListByPrescriber", err)
+	}
+	defer cursor.Close(ctx)
+
+	var prescriptions []m.Prescription
+	if err := cursor.All(ctx, &prescriptions); err != nil {
+		return nil, r.handleError("ListByPrescriber", err)
+	}
+
+	return prescriptions, nil
+}
+
+// ListForPatient retrieves the patient's prescriptions, newest first, optionally with one status
+func (r *PrescriptionMongoRepository) ListForPatient(ctx context.Context, patientID string, status m.Status, limit int) ([]m.Prescription, error) {
+	start := time.Now()
+	defer func() {
+		r.logger.Debug("MongoDB ListForPatient operation completed",
+			zap.Duration("duration", time.Since(start)))
+	}()
+
+	// Validate input to prevent NoSQL injection
+	if err := validation_logic.ValidateID("patient_id", patientID); err != nil {
+		return nil, platformErrors.NewValidationError("patient_id", patientID, "Invalid patient ID format")
+	}
+
+	filter := bson.M{"patient_id": patientID}
+	if status != "" {
+		filter["status"] = string(status)
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, tenancy.Filter(ctx, filter), opts)
+	if err != nil {
+		return nil, r.handleError("ListForPatient", err)
+	}
+	defer cursor.Close(ctx)
+
+	prescriptions := []m.Prescription{}
+	if err := cursor.All(ctx, &prescriptions); err != nil {
+		return nil, r.handleError("ListForPatient", err)
+	}
+
+	return prescriptions, nil
+}
+
+// ListByPrescriberID retrieves the prescriptions attributed to the prescriber, newest first
+func (r *PrescriptionMongoRepository) ListByPrescriberID(ctx context.Context, prescriberID string, limit int) ([]m.Prescription, error) {
+	start := time.Now()
+	defer func() {
+		r.logger.Debug("MongoDB ListByPrescriberID operation completed",
+			zap.Duration("duration", time.Since(start)))
+	}()
+
+	// Validate input to prevent NoSQL injection
+	if err := validation_logic.ValidateID("prescriber_id", prescriberID); err != nil {
+		return nil, platformErrors.NewValidationError("prescriber_id", prescriberID, "Invalid prescriber ID format")
+	}
+
+	filter := tenancy.Filter(ctx, bson.M{"prescriber_id": prescriberID})
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, r.handleError("ListByPrescriberID", err)
+	}
+	defer cursor.Close(ctx)
+
+	prescriptions := []m.Prescription{}
+	if err := cursor.All(ctx, &prescriptions); err != nil {
+		return nil, r.handleError("ListByPrescriberID", err)
+	}
+
+	return prescriptions, nil
+}
+
+// ListInFlight retrieves active prescriptions whose fulfillment has not reached a terminal status,
+// one page at a time in _id order so callers can walk every one of them
+func (r *PrescriptionMongoRepository) ListInFlight(ctx context.Context, afterID string, limit int) ([]m.Prescription, error) {
+	start := time.Now()
+	defer func() {
+		r.logger.Debug("MongoDB ListInFlight operation completed",
+			zap.Duration("duration", time.Since(start)))
+	}()
+
+	query := bson.M{
+		"status": m.Active,
+		"fulfillment_status": bson.M{
+			"$nin": []m.FulfillmentStatus{m.FulfillmentDispensed, m.FulfillmentCancelled},
+		},
+	}
+	if afterID != "" {
+		query["_id"] = bson.M{"$gt": afterID}
+	}
+	filter := tenancy.Filter(ctx, query)
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, r.handleError("ListInFlight", err)
+	}
+	defer cursor.Close(ctx)
+
+	var prescriptions []m.Prescription
+	if err := cursor.All(ctx, &prescriptions); err != nil {
+		return nil, r.handleError("ListInFlight", err)
+	}
+
+	return prescriptions, nil
+}
+
+// UpdateFulfillmentStatus sets only the fulfillment fields so it never races with clinical edits
+func (r *PrescriptionMongoRepository) UpdateFulfillmentStatus(ctx context.Context, id string, status m.FulfillmentStatus, at time.Time) error {
+	if err := validation_logic.ValidateID("id", id); err != nil {
+		r.logger.Warn("Invalid prescription ID provided for fulfillment update",
+			zap.String("id", sanitizer.ForLogging(id)),
+			zap.Error(err))
+		return platformErrors.NewValidationError("id", id, "Invalid prescription ID format")
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"fulfillment_status":     status,
+			"fulfillment_updated_at": at,
+		},
+	}
+	result, err := r.collection.UpdateOne(ctx, tenancy.Filter(ctx, bson.M{"_id": id}), update)
+	if err != nil {
+		return r.handleError("UpdateFulfillmentStatus", err)
+	}
+	if result.MatchedCount == 0 {
+		return platformErrors.NewRepositoryError(
+			platformErrors.ErrorTypeNotFound,
+			"Prescription not found",
+			mongo.ErrNoDocuments,
+		)
+	}
+	return nil
+}
+
+// UpdatePharmacy sets only the pharmacy and fulfillment fields, like UpdateFulfillmentStatus
+func (r *PrescriptionMongoRepository) UpdatePharmacy(ctx context.Context, id string, pharmacy m.Pharmacy, status m.FulfillmentStatus) error {
+	if err := validation_logic.ValidateID("id", id); err != nil {
+		r.logger.Warn("Invalid prescription ID provided for pharmacy update",
+			zap.String("id", sanitizer.ForLogging(id)),
+			zap.Error(err))
+		return platformErrors.NewValidationError("id", id, "Invalid prescription ID format")
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"pharmacy":               pharmacy,
+			"fulfillment_status":     status,
+			"fulfillment_updated_at": pharmacy.RoutedAt,
+		},
+	}
+	result, err := r.collection.UpdateOne(ctx, tenancy.Filter(ctx, bson.M{"_id": id}), update)
+	if err != nil {
+		return r.handleError("UpdatePharmacy", err)
+	}
+	if result.MatchedCount == 0 {
+		return platformErrors.NewRepositoryError(
+			platformErrors.ErrorTypeNotFound,
+			"Prescription not found",
+			mongo.ErrNoDocuments,
+		)
+	}
+	return nil
+}
+
+// ListByStatusCreatedBefore retrieves prescriptions with the status created before the time, oldest first
+func (r *PrescriptionMongoRepository) ListByStatusCreatedBefore(ctx context.Context, status m.Status, before time.Time, limit int) ([]m.Prescription, error) {
+	start := time.Now()
+	defer func() {
+		r.logger.Debug("MongoDB ListByStatusCreatedBefore operation completed",
+			zap.Duration("duration", time.Since(start)))
+	}()
+
+	filter := tenancy.Filter(ctx, bson.M{
+		"status":     status,
+		"created_at": bson.M{"$lt": before},
+	})
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, r.handleError("ListByStatusCreatedBefore", err)
+	}
+	defer cursor.Close(ctx)
+
+	var prescriptions []m.Prescription
+	if err := cursor.All(ctx, &prescriptions); err != nil {
+		return nil, r.handleError("ListByStatusCreatedBefore", err)
+	}
+
+	return prescriptions, nil
+}
+
+// UpdateStatus sets the status only while the prescription still has the from status, so an
+// edit made in the meantime is never overwritten
+func (r *PrescriptionMongoRepository) UpdateStatus(ctx context.Context, id string, from, to m.Status) (bool, error) {
+	if err := validation_logic.ValidateID("id", id); err != nil {
+		r.logger.Warn("Invalid prescription ID provided for status update",
+			zap.String("id", sanitizer.ForLogging(id)),
+			zap.Error(err))
+		return false, platformErrors.NewValidationError("id", id, "Invalid prescription ID format")
+	}
+
+	result, err := r.collection.UpdateOne(ctx,
+		tenancy.Filter(ctx, bson.M{"_id": id, "status": from}),
+		bson.M{"$set": bson.M{"status": to}})
+	if err != nil {
+		return false, r.handleError("UpdateStatus", err)
+	}
+	return result.ModifiedCount == 1, nil
+}
+
+// ListByIDs returns the prescriptions with the given IDs, ordered by ID
+func (r *PrescriptionMongoRepository) ListByIDs(ctx context.Context, ids []string) ([]m.Prescription, error) {
+	for _, id := range ids {
+		if err := validation_logic.ValidateID("id", id); err != nil {
+			r.logger.Warn("Invalid prescription ID provided",
+				zap.String("id", sanitizer.ForLogging(id)),
+				zap.Error(err))
+			return nil, platformErrors.NewValidationError("id", id, "Invalid prescription ID format")
+		}
+	}
+
+	cursor, err := r.collection.Find(ctx,
+		tenancy.Filter(ctx, bson.M{"_id": bson.M{"$in": ids}}),
+		options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, r.handleError("ListByIDs", err)
+	}
+	defer cursor.Close(ctx)
+
+	prescriptions := []m.Prescription{}
+	if err := cursor.All(ctx, &prescriptions); err != nil {
+		return nil, r.handleError("ListByIDs", err)
+	}
+	return prescriptions, nil
+}
+
+// UpdateStatuses sends every change in one unordered bulk write. A bulk write only counts the
+// documents it modified, so when some change did not apply the statuses are read back to tell
+// which ones did.
+func (r *PrescriptionMongoRepository) UpdateStatuses(ctx context.Context, changes []m.StatusChange) ([]bool, error) {
+	applied := make([]bool, len(changes))
+	if len(changes) == 0 {
+		return applied, nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(changes))
+	ids := make([]string, 0, len(changes))
+	for _, change := range changes {
+		if err := validation_logic.ValidateID("id", change.ID); err != nil {
+			return nil, platformErrors.NewValidationError("id", change.ID, "Invalid prescription ID format")
+		}
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(tenancy.Filter(ctx, bson.M{"_id": change.ID, "status": change.From})).
+			SetUpdate(bson.M{"$set": bson.M{"status": change.To}}))
+		ids = append(ids, change.ID)
+	}
+
+	result, err := r.collection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		return nil, r.handleError("UpdateStatuses", err)
+	}
+	if int(result.ModifiedCount) == len(changes) {
+		for i := range applied {
+			applied[i] = true
+		}
+		return applied, nil
+	}
+
+	current, err := r.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make(map[string]m.Status, len(current))
+	for _, p := range current {
+		statuses[p.ID] = p.Status
+	}
+	for i, change := range changes {
+		applied[i] = statuses[change.ID] == change.To
+	}
+	r.logger.Debug("Some bulk status changes did not apply",
+		zap.Int("changes", len(changes)),
+		zap.Int64("modified", result.ModifiedCount))
+	return applied, nil
+}
+
 // HealthCheck performs a health check on the repository
 func (r *PrescriptionMongoRepository) HealthCheck(ctx context.Context) error {
 	// Try to count documents as a simple health check
@@ -307,12 +674,49 @@ func (r *PrescriptionMongoRepository) CreateIndexes(ctx context.Context) error {
 				SetUnique(true).
 				SetBackground(true),
 		},
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "fulfillment_status", Value: 1}},
+			Options: options.Index().
+				SetName("status_1_fulfillment_status_1").
+				SetBackground(true),
+		},
 		{
 			Keys: bson.D{{Key: "patient_id", Value: 1}, {Key: "status", Value: 1}},
 			Options: options.Index().
 				SetName("patient_id_1_status_1").
 				SetBackground(true),
 		},
+		{
+			// Distinct patients with prescriptions of a status, for the patient list filter
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "patient_id", Value: 1}},
+			Options: options.Index().
+				SetName("status_1_patient_id_1").
+				SetBackground(true),
+		},
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: 1}},
+			Options: options.Index().
+				SetName("status_1_created_at_1").
+				SetBackground(true),
+		},
+		{
+			Keys: bson.D{{Key: "org_id", Value: 1}, {Key: "status", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().
+				SetName("org_id_1_status_1_created_at_-1").
+				SetBackground(true),
+		},
+		{
+			Keys: bson.D{{Key: "prescribed_by", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().
+				SetName("prescribed_by_1_created_at_-1").
+				SetBackground(true),
+		},
+		{
+			Keys: bson.D{{Key: "prescriber_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().
+				SetName("prescriber_id_1_created_at_-1").
+				SetBackground(true),
+		},
 	}
 
 	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
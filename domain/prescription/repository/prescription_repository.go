@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"time"
+
 	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
 )
 
@@ -11,5 +13,29 @@ type PrescriptionRepository interface {
 	Create(ctx context.Context, p m.Prescription) (m.Prescription, error)
 	Update(ctx context.Context, id string, p m.Prescription) (m.Prescription, error)
 	CountByStatus(ctx context.Context, status string) (int, error)
+	// CountGroupedByStatus returns the number of prescriptions of each status, ordered by status
+	CountGroupedByStatus(ctx context.Context) ([]m.StatusCount, error)
 	ListByPatientID(ctx context.Context, patientID string) ([]m.Prescription, error)
+	// ListForPatient returns the patient's prescriptions, newest first; an empty status matches every status
+	ListForPatient(ctx context.Context, patientID string, status m.Status, limit int) ([]m.Prescription, error)
+	// ListByPrescriber returns the prescriptions created by the user, newest first
+	ListByPrescriber(ctx context.Context, prescribedBy string, limit int) ([]m.Prescription, error)
+	// ListByPrescriberID returns the prescriptions attributed to the prescriber, newest first
+	ListByPrescriberID(ctx context.Context, prescriberID string, limit int) ([]m.Prescription, error)
+	// ListInFlight pages through prescriptions whose fulfillment is not terminal in ID order,
+	// starting after afterID; an empty afterID starts from the beginning
+	ListInFlight(ctx context.Context, afterID string, limit int) ([]m.Prescription, error)
+	UpdateFulfillmentStatus(ctx context.Context, id string, status m.FulfillmentStatus, at time.Time) error
+	// UpdatePharmacy records the pharmacy a prescription was routed to along with its fulfillment status
+	UpdatePharmacy(ctx context.Context, id string, pharmacy m.Pharmacy, status m.FulfillmentStatus) error
+	// ListByStatusCreatedBefore returns prescriptions with the status created before the time, oldest first
+	ListByStatusCreatedBefore(ctx context.Context, status m.Status, before time.Time, limit int) ([]m.Prescription, error)
+	// UpdateStatus moves a prescription from one status to another; it reports false when the
+	// prescription no longer has the from status
+	UpdateStatus(ctx context.Context, id string, from, to m.Status) (bool, error)
+	// ListByIDs returns the prescriptions with the given IDs, ordered by ID; unknown IDs are left out
+	ListByIDs(ctx context.Context, ids []string) ([]m.Prescription, error)
+	// UpdateStatuses applies status changes in one bulk write. Like UpdateStatus, each change only
+	// applies while the prescription has its from status; the result reports which ones did.
+	UpdateStatuses(ctx context.Context, changes []m.StatusChange) ([]bool, error)
 }
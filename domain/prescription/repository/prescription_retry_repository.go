@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// PrescriptionRetryRepository retries the reads and idempotent writes of a prescription repository
+// that fail with a retryable error; Create, UpdateStatus and UpdateStatuses run once
+type PrescriptionRetryRepository struct {
+	next    PrescriptionRepository
+	retrier *database.Retrier
+}
+
+// NewPrescriptionRetryRepository wraps next with retries; without a retrier next is returned as is
+func NewPrescriptionRetryRepository(next PrescriptionRepository, retrier *database.Retrier) PrescriptionRepository {
+	if retrier == nil {
+		return next
+	}
+	return &PrescriptionRetryRepository{next: next, retrier: retrier}
+}
+
+func (r *PrescriptionRetryRepository) List(ctx context.Context, status string, limit, offset int) ([]m.Prescription, error) {
+	return database.Retry(ctx, r.retrier, "prescriptions.List", func(ctx context.Context) ([]m.Prescription, error) {
+		return r.next.List(ctx, status, limit, offset)
+	})
+}
+
+func (r *PrescriptionRetryRepository) GetByID(ctx context.Context, id string) (m.Prescription, error) {
+	return database.Retry(ctx, r.retrier, "prescriptions.GetByID", func(ctx context.Context) (m.Prescription, error) {
+		return r.next.GetByID(ctx, id)
+	})
+}
+
+// Create runs once: a retried insert that reached the server would fail as a duplicate
+func (r *PrescriptionRetryRepository) Create(ctx context.Context, p m.Prescription) (m.Prescription, error) {
+	return r.next.Create(ctx, p)
+}
+
+func (r *PrescriptionRetryRepository) Update(ctx context.Context, id string, p m.Prescription) (m.Prescription, error) {
+	return database.Retry(ctx, r.retrier, "prescriptions.Update", func(ctx context.Context) (m.Prescription, error) {
+		return r.next.Update(ctx, id, p)
+	})
+}
+
+func (r *PrescriptionRetryRepository) CountByStatus(ctx context.Context, status string) (int, error) {
+	return database.Retry(ctx, r.retrier, "prescriptions.CountByStatus", func(ctx context.Context) (int, error) {
+		return r.next.CountByStatus(ctx, status)
+	})
+}
+
+func (r *PrescriptionRetryRepository) CountGroupedByStatus(ctx context.Context) ([]m.StatusCount, error) {
+	return database.Retry(ctx, r.retrier, "prescriptions.CountGroupedByStatus", func(ctx context.Context) ([]m.StatusCount, error) {
+		return r.next.CountGroupedByStatus(ctx)
+	})
+}
+
+func (r *PrescriptionRetryRepository) ListByPatientID(ctx context.Context, patientID string) ([]m.Prescription, error) {
+	return database.Retry(ctx, r.retrier, "prescriptions.ListByPatientID", func(ctx context.Context) ([]m.Prescription, error) {
+		return r.next.ListByPatientID(ctx, patientID)
+	})
+}
+
+func (r *PrescriptionRetryRepository) ListForPatient(ctx context.Context, patientID string, status m.Status, limit int) ([]m.Prescription, error) {
+	return database.Retry(ctx, r.retrier, "prescriptions.ListForPatient", func(ctx context.Context) ([]m.Prescription, error) {
+		return r.next.ListForPatient(ctx, patientID, status, limit)
+	})
+}
+
+func (r *PrescriptionRetryRepository) ListByPrescriber(ctx context.Context, prescribedBy string, limit int) ([]m.Prescription, error) {
+	return database.Retry(ctx, r.retrier, "prescriptions.ListByPrescriber", func(ctx context.Context) ([]m.Prescription, error) {
+		return r.next.ListByPrescriber(ctx, prescribedBy, limit)
+	})
+}
+
+func (r *PrescriptionRetryRepository) ListByPrescriberID(ctx context.Context, prescriberID string, limit int) ([]m.Prescription, error) {
+	return database.Retry(ctx, r.retrier, "prescriptions.ListByPrescriberID", func(ctx context.Context) ([]m.Prescription, error) {
+		return r.next.ListByPrescriberID(ctx, prescriberID, limit)
+	})
+}
+
+func (r *PrescriptionRetryRepository) ListInFlight(ctx context.Context, afterID string, limit int) ([]m.Prescription, error) {
+	return database.Retry(ctx, r.retrier, "prescriptions.ListInFlight", func(ctx context.Context) ([]m.Prescription, error) {
+		return r.next.ListInFlight(ctx, afterID, limit)
+	})
+}
+
+func (r *PrescriptionRetryRepository) UpdateFulfillmentStatus(ctx context.Context, id string, status m.FulfillmentStatus, at time.Time) error {
+	return r.retrier.Do(ctx, "prescriptions.UpdateFulfillmentStatus", func(ctx context.Context) error {
+		return r.next.UpdateFulfillmentStatus(ctx, id, status, at)
+	})
+}
+
+func (r *PrescriptionRetryRepository) UpdatePharmacy(ctx context.Context, id string, pharmacy m.Pharmacy, status m.FulfillmentStatus) error {
+	return r.retrier.Do(ctx, "prescriptions.UpdatePharmacy", func(ctx context.Context) error {
+		return r.next.UpdatePharmacy(ctx, id, pharmacy, status)
+	})
+}
+
+func (r *PrescriptionRetryRepository) ListByStatusCreatedBefore(ctx context.Context, status m.Status, before time.Time, limit int) ([]m.Prescription, error) {
+	return database.Retry(ctx, r.retrier, "prescriptions.ListByStatusCreatedBefore", func(ctx context.Context) ([]m.Prescription, error) {
+		return r.next.ListByStatusCreatedBefore(ctx, status, before, limit)
+	})
+}
+
+func (r *PrescriptionRetryRepository) ListByIDs(ctx context.Context, ids []string) ([]m.Prescription, error) {
+	return database.Retry(ctx, r.retrier, "prescriptions.ListByIDs", func(ctx context.Context) ([]m.Prescription, error) {
+		return r.next.ListByIDs(ctx, ids)
+	})
+}
+
+// UpdateStatuses runs once, for the reason UpdateStatus does
+func (r *PrescriptionRetryRepository) UpdateStatuses(ctx context.Context, changes []m.StatusChange) ([]bool, error) {
+	return r.next.UpdateStatuses(ctx, changes)
+}
+
+// UpdateStatus runs once: it only applies while the prescription has the from status, which a retried call that reached the server no longer finds
+func (r *PrescriptionRetryRepository) UpdateStatus(ctx context.Context, id string, from, to m.Status) (bool, error) {
+	return r.next.UpdateStatus(ctx, id, from, to)
+}
@@ -2,15 +2,17 @@ package security
 
 import (
 	commonsecurity "pharmacy-modernization-project-model/domain/common/security"
+	"pharmacy-modernization-project-model/internal/platform/permissions"
 )
 
 // Prescription domain permissions
 const (
 	PermissionRead     = commonsecurity.PrescriptionPermissionRead
-	PermissionWrite    = "prescription:write"
-	PermissionApprove  = "prescription:approve"
-	PermissionDispense = "prescription:dispense"
-	PermissionCancel   = "prescription:cancel"
+	PermissionWrite    = commonsecurity.PrescriptionPermissionWrite
+	PermissionApprove  = permissions.PrescriptionApprove
+	PermissionDispense = commonsecurity.PrescriptionPermissionDispense
+	PermissionCancel   = permissions.PrescriptionCancel
+	PermissionReverse  = permissions.PrescriptionReverseDispense
 )
 
 // Common permission sets for reuse in routes
@@ -19,13 +21,16 @@ var (
 	ReadAccess = commonsecurity.PrescriptionReadAccess
 
 	// WriteAccess - only doctors or admins can create/edit prescriptions
-	WriteAccess = []string{PermissionWrite, "doctor:role", "admin:all"}
+	WriteAccess = commonsecurity.PrescriptionWriteAccess
 
 	// ApproveAccess - needs ALL of these permissions to approve prescriptions
 	ApproveAccess = []string{PermissionWrite, PermissionApprove}
 
 	// DispenseAccess - only pharmacists or admins can dispense
-	DispenseAccess = []string{PermissionDispense, "pharmacist:role", "admin:all"}
+	DispenseAccess = commonsecurity.PrescriptionDispenseAccess
+
+	// ReverseDispenseAccess - reversing a dispense is granted explicitly; the pharmacist role alone is not enough
+	ReverseDispenseAccess = []string{PermissionReverse, permissions.AdminAll}
 
 	// CancelAccess - needs both write and cancel permissions
 	CancelAccess = []string{PermissionWrite, PermissionCancel}
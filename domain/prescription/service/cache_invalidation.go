@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/cache"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// CacheInvalidator evicts prescription cache entries when the prescriptions collection changes,
+// including writes made by other instances
+type CacheInvalidator struct {
+	cache     cache.Cache
+	cacheKeys *CacheKeys
+	log       *zap.Logger
+}
+
+// NewCacheInvalidator creates an invalidator for the prescription cache keys
+func NewCacheInvalidator(c cache.Cache, l *zap.Logger) *CacheInvalidator {
+	return &CacheInvalidator{cache: c, cacheKeys: NewCacheKeys(), log: l}
+}
+
+// HandleChange is a database.ChangeHandler for the prescriptions collection. Deletes only name
+// their patient in the pre-image, so watch with database.ChangeStreamListener.WatchWithPreImages.
+func (i *CacheInvalidator) HandleChange(ctx context.Context, event database.ChangeEvent) {
+	if i.cache == nil || event.DocumentID == "" {
+		return
+	}
+
+	keys := []string{i.cacheKeys.PrescriptionByID(event.DocumentID)}
+	// A write can move a prescription between statuses, so every count is stale, in every scope
+	scopedKeys := i.cacheKeys.statusCounts()
+	// The patient's list before the change too, as a delete or a move to another patient drops the
+	// prescription from it
+	patientIDs := map[string]struct{}{}
+	for _, doc := range []bson.M{event.FullDocument, event.FullDocumentBeforeChange} {
+		if patientID, ok := doc["patient_id"].(string); ok && patientID != "" {
+			patientIDs[patientID] = struct{}{}
+		}
+	}
+	for patientID := range patientIDs {
+		scopedKeys = append(scopedKeys, i.cacheKeys.PrescriptionsByPatientID(patientID))
+	}
+
+	for _, key := range append(keys, scopedKeys...) {
+		if err := i.cache.Delete(ctx, key); err != nil {
+			i.log.Warn("Failed to invalidate prescription cache",
+				zap.String("operation", event.Operation),
+				zap.Error(err))
+		}
+	}
+	for _, key := range scopedKeys {
+		if err := cache.DeleteScoped(ctx, i.cache, key); err != nil {
+			i.log.Warn("Failed to invalidate scoped prescription cache",
+				zap.String("operation", event.Operation),
+				zap.Error(err))
+		}
+	}
+}
@@ -2,9 +2,14 @@ package service
 
 import (
 	"fmt"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
 	"pharmacy-modernization-project-model/internal/platform/cache"
 )
 
+// countedStatuses are the statuses with a cached count; "" counts every prescription
+var countedStatuses = []string{"", string(m.Draft), string(m.Active), string(m.Paused), string(m.Completed), string(m.Expired)}
+
 // CacheKeys provides centralized cache key management for the prescription domain
 type CacheKeys struct{}
 
@@ -28,10 +33,16 @@ func (k *CacheKeys) PrescriptionList(status string, limit, offset int) string {
 	return fmt.Sprintf("prescription:list:%s:%d:%d", sanitizedStatus, limit, offset)
 }
 
-// PrescriptionCountByStatus returns cache key for prescription count by status
-func (k *CacheKeys) PrescriptionCountByStatus(status string) string {
+// PrescriptionCountByStatus returns cache key for prescription count by status in the caller's scope
+func (k *CacheKeys) PrescriptionCountByStatus(scope cache.Scope, status string) string {
 	sanitizedStatus := cache.SanitizeKey(status)
-	return fmt.Sprintf("prescription:count:status:%s", sanitizedStatus)
+	return scope.Key(fmt.Sprintf("prescription:count:status:%s", sanitizedStatus))
+}
+
+// PrescriptionCountGroupedByStatus returns cache key for the prescription counts grouped by status
+// in the caller's scope
+func (k *CacheKeys) PrescriptionCountGroupedByStatus(scope cache.Scope) string {
+	return scope.Key("prescription:count-by:status")
 }
 
 // PrescriptionsByPatientID returns cache key for prescriptions by patient ID
@@ -42,3 +53,12 @@ func (k *CacheKeys) PrescriptionsByPatientID(patientID string) string {
 	sanitizedPatientID := cache.SanitizeKey(patientID)
 	return fmt.Sprintf("prescription:patient:%s", sanitizedPatientID)
 }
+
+// statusCounts returns the unscoped keys of every cached count by status
+func (k *CacheKeys) statusCounts() []string {
+	keys := []string{k.PrescriptionCountGroupedByStatus(cache.Scope{})}
+	for _, status := range countedStatuses {
+		keys = append(keys, k.PrescriptionCountByStatus(cache.Scope{}, status))
+	}
+	return keys
+}
@@ -0,0 +1,343 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/domain/prescription/contracts/request"
+	"pharmacy-modernization-project-model/domain/prescription/providers"
+	repo "pharmacy-modernization-project-model/domain/prescription/repository"
+	"pharmacy-modernization-project-model/internal/platform/attachments"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/database"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// maxSignatureImageBytes bounds decoded signature images; pad captures are a few KB
+const maxSignatureImageBytes = 256 << 10
+
+// signatureOwnerType is the attachment owner type of pickup signatures
+const signatureOwnerType = "dispense"
+
+type DispenseService interface {
+	// RecordDispense creates the dispense record of a completed prescription; it is a CompletionHandler
+	RecordDispense(ctx context.Context, prescription m.Prescription)
+	ListByPrescription(ctx context.Context, prescriptionID string) ([]m.DispenseRecord, error)
+	GetByID(ctx context.Context, prescriptionID, dispenseID string) (m.DispenseRecord, error)
+	// CaptureSignature stores the patient's pickup signature for a dispense
+	CaptureSignature(ctx context.Context, prescriptionID, dispenseID string, req request.CaptureSignatureRequest) (m.DispenseRecord, error)
+	// Signature returns the stored signature content after checking it against the recorded hash
+	Signature(ctx context.Context, prescriptionID, dispenseID string) (m.PickupSignature, []byte, error)
+	// ReverseDispense undoes a dispense and reopens its prescription; it returns the reversal record
+	ReverseDispense(ctx context.Context, prescriptionID, dispenseID string, req request.ReverseDispenseRequest) (m.DispenseRecord, error)
+	// OnReversed registers a handler called after a dispense is reversed
+	OnReversed(handler ReversalHandler)
+}
+
+// ReversalHandler reacts to a dispense being reversed; it runs after the reversal is saved
+type ReversalHandler func(ctx context.Context, dispense, reversal m.DispenseRecord)
+
+type dispenseSvc struct {
+	repo          repo.DispenseRepository
+	transactions  database.TransactionRunner
+	attachments   providers.AttachmentProvider
+	prescriptions PrescriptionService
+	history       HistoryService
+	log           *zap.Logger
+	onReversed    []ReversalHandler
+}
+
+func NewDispenseService(r repo.DispenseRepository, transactions database.TransactionRunner, attachmentProvider providers.AttachmentProvider, prescriptions PrescriptionService, history HistoryService, l *zap.Logger) DispenseService {
+	return &dispenseSvc{repo: r, transactions: transactions, attachments: attachmentProvider, prescriptions: prescriptions, history: history, log: l}
+}
+
+func (s *dispenseSvc) RecordDispense(ctx context.Context, prescription m.Prescription) {
+	record := m.DispenseRecord{
+		ID:             uuid.NewString(),
+		Kind:           m.DispenseKindDispense,
+		PrescriptionID: prescription.ID,
+		PatientID:      prescription.PatientID,
+		Drug:           prescription.Drug,
+		Dose:           prescription.Dose,
+		DispensedAt:    time.Now(),
+		DispensedBy:    actor(ctx),
+		Sig:            prescription.Sig,
+		Quantity:       prescription.Quantity,
+		DaysSupply:     prescription.DaysSupply,
+	}
+	record.SupplyEndsAt = m.SupplyEndDate(record.DispensedAt, record.DaysSupply)
+	if _, err := s.repo.Create(ctx, record); err != nil {
+		s.log.Error("Failed to record dispense",
+			zap.String("prescription_id", prescription.ID),
+			zap.Error(err))
+		return
+	}
+	s.log.Info("Dispense recorded",
+		zap.String("prescription_id", prescription.ID),
+		zap.String("dispense_id", record.ID))
+	s.history.Record(ctx, m.PrescriptionHistoryEvent{
+		PrescriptionID: prescription.ID,
+		Type:           m.HistoryDispensed,
+		At:             record.DispensedAt,
+		Actor:          record.DispensedBy,
+		DispenseID:     record.ID,
+	})
+}
+
+func (s *dispenseSvc) ListByPrescription(ctx context.Context, prescriptionID string) ([]m.DispenseRecord, error) {
+	// Dispense records are not scoped themselves; reading the prescription first answers those of
+	// another organization with a 404
+	if _, err := s.prescriptions.GetByID(ctx, prescriptionID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByPrescriptionID(ctx, prescriptionID)
+}
+
+func (s *dispenseSvc) GetByID(ctx context.Context, prescriptionID, dispenseID string) (m.DispenseRecord, error) {
+	if _, err := s.prescriptions.GetByID(ctx, prescriptionID); err != nil {
+		return m.DispenseRecord{}, err
+	}
+	record, err := s.repo.GetByID(ctx, dispenseID)
+	if err != nil {
+		return m.DispenseRecord{}, err
+	}
+	// A dispense is only reachable through its own prescription
+	if record.PrescriptionID != prescriptionID {
+		return m.DispenseRecord{}, platformErrors.NewRecordNotFoundError("dispense", dispenseID)
+	}
+	return record, nil
+}
+
+func (s *dispenseSvc) CaptureSignature(ctx context.Context, prescriptionID, dispenseID string, req request.CaptureSignatureRequest) (m.DispenseRecord, error) {
+	record, err := s.GetByID(ctx, prescriptionID, dispenseID)
+	if err != nil {
+		return m.DispenseRecord{}, err
+	}
+	if record.IsReversal() {
+		return m.DispenseRecord{}, platformErrors.NewBusinessLogicError("CaptureSignature", "a reversal has no pickup to sign for")
+	}
+	if record.Signature != nil {
+		return m.DispenseRecord{}, platformErrors.NewConflictError("dispense", dispenseID, "pickup is already signed")
+	}
+
+	signature := m.PickupSignature{
+		SignerName: strings.TrimSpace(req.SignerName),
+		SignedAt:   time.Now(),
+		CapturedBy: actor(ctx),
+	}
+	var content []byte
+	switch {
+	case req.SignatureImage != "" && req.TypedName != "":
+		return m.DispenseRecord{}, platformErrors.NewValidationError("signature_image", "", "provide either a signature image or a typed name, not both")
+	case req.SignatureImage != "":
+		content, signature.ContentType, err = decodeSignatureImage(req.SignatureImage)
+		if err != nil {
+			return m.DispenseRecord{}, err
+		}
+		signature.Method = m.SignatureImage
+	case req.TypedName != "":
+		if !req.AttestationAccepted {
+			return m.DispenseRecord{}, platformErrors.NewValidationError("attestation_accepted", false, "the attestation must be accepted to sign by typing a name")
+		}
+		signature.Method = m.SignatureTyped
+		signature.Attestation = m.PickupAttestation
+		signature.ContentType = "text/plain; charset=utf-8"
+		content = typedSignatureContent(record, strings.TrimSpace(req.TypedName), signature.SignedAt)
+	default:
+		return m.DispenseRecord{}, platformErrors.NewValidationError("signature_image", "", "a signature image or a typed name is required")
+	}
+
+	stored, err := s.attachments.Put(ctx, attachments.Attachment{
+		OwnerType:   signatureOwnerType,
+		OwnerID:     record.ID,
+		Name:        "pickup-signature-" + record.ID,
+		ContentType: signature.ContentType,
+		CreatedBy:   signature.CapturedBy,
+	}, content)
+	if err != nil {
+		s.log.Error("Failed to store pickup signature",
+			zap.String("dispense_id", record.ID),
+			zap.Error(err))
+		return m.DispenseRecord{}, err
+	}
+	signature.AttachmentID = stored.ID
+	signature.SHA256 = stored.SHA256
+
+	signed, err := s.repo.SetSignature(ctx, record.ID, signature)
+	if err != nil {
+		return m.DispenseRecord{}, err
+	}
+	s.log.Info("Pickup signature captured",
+		zap.String("dispense_id", record.ID),
+		zap.String("method", string(signature.Method)))
+	return signed, nil
+}
+
+func (s *dispenseSvc) Signature(ctx context.Context, prescriptionID, dispenseID string) (m.PickupSignature, []byte, error) {
+	record, err := s.GetByID(ctx, prescriptionID, dispenseID)
+	if err != nil {
+		return m.PickupSignature{}, nil, err
+	}
+	if record.Signature == nil {
+		return m.PickupSignature{}, nil, platformErrors.NewRecordNotFoundError("pickup signature", dispenseID)
+	}
+
+	attachment, content, err := s.attachments.Get(ctx, record.Signature.AttachmentID)
+	if err == nil && attachment.SHA256 != record.Signature.SHA256 {
+		err = fmt.Errorf("attachment %s: %w", attachment.ID, attachments.ErrIntegrity)
+	}
+	if err != nil {
+		if errors.Is(err, attachments.ErrIntegrity) {
+			s.log.Error("Pickup signature failed integrity check", zap.String("dispense_id", dispenseID))
+		}
+		return m.PickupSignature{}, nil, err
+	}
+	return *record.Signature, content, nil
+}
+
+func (s *dispenseSvc) ReverseDispense(ctx context.Context, prescriptionID, dispenseID string, req request.ReverseDispenseRequest) (m.DispenseRecord, error) {
+	reason := m.ReversalReason{Code: m.ReversalReasonCode(req.ReasonCode), Note: strings.TrimSpace(req.Note)}
+	if reason.Code == m.ReversalOther && reason.Note == "" {
+		return m.DispenseRecord{}, platformErrors.NewValidationError("note", "", "a note is required when the reason is other")
+	}
+
+	record, err := s.GetByID(ctx, prescriptionID, dispenseID)
+	if err != nil {
+		return m.DispenseRecord{}, err
+	}
+	if record.IsReversal() {
+		return m.DispenseRecord{}, platformErrors.NewBusinessLogicError("ReverseDispense", "a reversal cannot be reversed")
+	}
+	if record.IsReversed() {
+		return m.DispenseRecord{}, platformErrors.NewConflictError("dispense", dispenseID, "dispense is already reversed")
+	}
+
+	reversal := m.DispenseRecord{
+		ID:             uuid.NewString(),
+		Kind:           m.DispenseKindReversal,
+		PrescriptionID: record.PrescriptionID,
+		PatientID:      record.PatientID,
+		Drug:           record.Drug,
+		Dose:           record.Dose,
+		DispensedAt:    time.Now(),
+		DispensedBy:    actor(ctx),
+		ReversesID:     record.ID,
+		Reason:         &reason,
+	}
+
+	// Claim the dispense first, so concurrent reversals cannot both be recorded. Both writes
+	// commit together; without transactions a failed insert releases the claim again.
+	var reversed m.DispenseRecord
+	err = s.transactions.RunInTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if reversed, err = s.repo.MarkReversed(ctx, record.ID, reversal.ID); err != nil {
+			return err
+		}
+		if _, err := s.repo.Create(ctx, reversal); err != nil {
+			s.log.Error("Failed to record dispense reversal",
+				zap.String("dispense_id", record.ID),
+				zap.Error(err))
+			if !database.InTransaction(ctx) {
+				s.releaseReversed(ctx, record.ID, reversal.ID)
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return m.DispenseRecord{}, err
+	}
+
+	historyReason := reason.Code.Label()
+	if reason.Note != "" {
+		historyReason += ": " + reason.Note
+	}
+	s.history.Record(ctx, m.PrescriptionHistoryEvent{
+		PrescriptionID: record.PrescriptionID,
+		Type:           m.HistoryDispenseReversed,
+		At:             reversal.DispensedAt,
+		Actor:          reversal.DispensedBy,
+		Reason:         historyReason,
+		DispenseID:     record.ID,
+	})
+
+	// The fill is given back: the prescription can be dispensed again. It may already have been
+	// reopened or changed by hand, which is not a reason to fail the reversal.
+	if err := s.prescriptions.Reopen(ctx, record.PrescriptionID); err != nil {
+		s.log.Warn("Reversed dispense did not reopen its prescription",
+			zap.String("prescription_id", record.PrescriptionID),
+			zap.Error(err))
+	}
+
+	s.log.Info("Dispense reversed",
+		zap.String("dispense_id", record.ID),
+		zap.String("reversal_id", reversal.ID),
+		zap.String("reason", string(reason.Code)))
+
+	for _, handler := range s.onReversed {
+		handler(ctx, reversed, reversal)
+	}
+	return reversal, nil
+}
+
+// releaseReversed undoes the claim of a dispense whose reversal could not be recorded
+func (s *dispenseSvc) releaseReversed(ctx context.Context, dispenseID, reversalID string) {
+	if err := s.repo.ClearReversed(ctx, dispenseID, reversalID); err != nil {
+		s.log.Error("Failed to release reversed dispense",
+			zap.String("dispense_id", dispenseID),
+			zap.Error(err))
+	}
+}
+
+func (s *dispenseSvc) OnReversed(handler ReversalHandler) {
+	s.onReversed = append(s.onReversed, handler)
+}
+
+// decodeSignatureImage decodes a base64 PNG or JPEG, optionally given as a data URL
+func decodeSignatureImage(encoded string) ([]byte, string, error) {
+	if i := strings.Index(encoded, ","); strings.HasPrefix(encoded, "data:") && i > 0 {
+		encoded = encoded[i+1:]
+	}
+	if base64.StdEncoding.DecodedLen(len(encoded)) > maxSignatureImageBytes {
+		return nil, "", platformErrors.NewValidationError("signature_image", "", fmt.Sprintf("signature image exceeds %d KB", maxSignatureImageBytes>>10))
+	}
+	data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, "", platformErrors.NewValidationError("signature_image", "", "signature image is not valid base64")
+	}
+
+	// Trust the content, not the declared type
+	contentType := http.DetectContentType(data)
+	if contentType != "image/png" && contentType != "image/jpeg" {
+		return nil, "", platformErrors.NewValidationError("signature_image", "", "signature image must be a PNG or JPEG")
+	}
+	return data, contentType, nil
+}
+
+// typedSignatureContent is the stored form of a typed signature. It names the dispense it was
+// given for, so the hashed content cannot be reused for another pickup.
+func typedSignatureContent(record m.DispenseRecord, typedName string, signedAt time.Time) []byte {
+	return []byte(fmt.Sprintf("Dispense: %s\nPrescription: %s\nSigned by: %s\nSigned at: %s\nAttestation: %s\n",
+		record.ID, record.PrescriptionID, typedName, signedAt.UTC().Format(time.RFC3339), m.PickupAttestation))
+}
+
+// actor identifies the current user for dispense records
+func actor(ctx context.Context) string {
+	user, err := auth.GetCurrentUser(ctx)
+	if err != nil {
+		return ""
+	}
+	if user.Email != "" {
+		return user.Email
+	}
+	return user.ID
+}
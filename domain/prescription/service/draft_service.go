@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/domain/prescription/contracts/request"
+	repo "pharmacy-modernization-project-model/domain/prescription/repository"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/validators/validation_logic"
+)
+
+const (
+	defaultDraftTTL         = 7 * 24 * time.Hour
+	defaultMaxDraftsPerUser = 20
+)
+
+// DraftConfig controls prescription drafts
+type DraftConfig struct {
+	TTL         time.Duration // Drafts not saved for this long are removed; 7 days when zero
+	MaxPerOwner int           // Drafts a user may keep; 20 when zero, unlimited when negative
+}
+
+// DraftService keeps the prescription forms users are filling in. Every operation acts on the
+// current user's drafts; another user's draft is forbidden.
+type DraftService interface {
+	// Save creates or replaces the draft of the ID; each save extends its expiry
+	Save(ctx context.Context, id string, req request.PrescriptionDraftRequest) (m.PrescriptionDraft, error)
+	GetByID(ctx context.Context, id string) (m.PrescriptionDraft, error)
+	// List returns the current user's drafts, most recently saved first
+	List(ctx context.Context) ([]m.PrescriptionDraft, error)
+	Delete(ctx context.Context, id string) error
+	// Promote saves the prescription built from the draft and removes the draft: a draft of a new
+	// prescription creates it, a draft of an edit updates its prescription. The caller validates
+	// the draft as read by GetByID; a draft saved again since is a conflict, and the draft is kept
+	// when the prescription cannot be saved.
+	Promote(ctx context.Context, draft m.PrescriptionDraft, prescription m.Prescription) (m.Prescription, error)
+}
+
+type draftSvc struct {
+	repo          repo.DraftRepository
+	prescriptions PrescriptionService
+	cfg           DraftConfig
+	log           *zap.Logger
+}
+
+func NewDraftService(r repo.DraftRepository, prescriptions PrescriptionService, cfg DraftConfig, l *zap.Logger) DraftService {
+	if cfg.TTL <= 0 {
+		cfg.TTL = defaultDraftTTL
+	}
+	if cfg.MaxPerOwner == 0 {
+		cfg.MaxPerOwner = defaultMaxDraftsPerUser
+	}
+	return &draftSvc{repo: r, prescriptions: prescriptions, cfg: cfg, log: l}
+}
+
+func (s *draftSvc) Save(ctx context.Context, id string, req request.PrescriptionDraftRequest) (m.PrescriptionDraft, error) {
+	owner, err := s.owner(ctx, "save")
+	if err != nil {
+		return m.PrescriptionDraft{}, err
+	}
+	if err := validation_logic.ValidateID("draft_id", id); err != nil {
+		return m.PrescriptionDraft{}, platformErrors.NewValidationError("draft_id", id, "Invalid draft ID format")
+	}
+
+	existing, err := s.repo.GetByID(ctx, id)
+	switch {
+	case err == nil && existing.OwnerID != owner:
+		return m.PrescriptionDraft{}, platformErrors.NewAuthorizationError("prescription draft", "save", "the draft belongs to another user")
+	case platformErrors.IsNotFoundError(err):
+		if err := s.checkDraftLimit(ctx, owner); err != nil {
+			return m.PrescriptionDraft{}, err
+		}
+	case err != nil:
+		return m.PrescriptionDraft{}, err
+	}
+
+	// An edit draft must be of a prescription the user can see
+	if req.PrescriptionID != "" && req.PrescriptionID != existing.PrescriptionID {
+		if _, err := s.prescriptions.GetByID(ctx, req.PrescriptionID); err != nil {
+			return m.PrescriptionDraft{}, err
+		}
+	}
+
+	// Millisecond precision, as MongoDB stores it, so the time read back matches for Promote
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	saved, err := s.repo.Save(ctx, m.PrescriptionDraft{
+		ID:             id,
+		OwnerID:        owner,
+		PrescriptionID: req.PrescriptionID,
+		Form:           req.Form(),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		ExpiresAt:      now.Add(s.cfg.TTL),
+	})
+	if err != nil {
+		return m.PrescriptionDraft{}, err
+	}
+	s.log.Debug("Prescription draft saved", zap.String("draft_id", id))
+	return saved, nil
+}
+
+func (s *draftSvc) GetByID(ctx context.Context, id string) (m.PrescriptionDraft, error) {
+	draft, _, err := s.ownDraft(ctx, id, "read")
+	return draft, err
+}
+
+func (s *draftSvc) List(ctx context.Context) ([]m.PrescriptionDraft, error) {
+	owner, err := s.owner(ctx, "list")
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListByOwner(ctx, owner)
+}
+
+func (s *draftSvc) Delete(ctx context.Context, id string) error {
+	_, owner, err := s.ownDraft(ctx, id, "delete")
+	if err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id, owner)
+}
+
+func (s *draftSvc) Promote(ctx context.Context, draft m.PrescriptionDraft, prescription m.Prescription) (m.Prescription, error) {
+	owner, err := s.owner(ctx, "promote")
+	if err != nil {
+		return m.Prescription{}, err
+	}
+	if draft.OwnerID != owner {
+		return m.Prescription{}, platformErrors.NewAuthorizationError("prescription draft", "promote", "the draft belongs to another user")
+	}
+
+	// Taking the draft as validated makes concurrent promotions, and autosaves racing one, fail
+	// instead of saving the prescription twice or from a form nobody checked
+	if _, err := s.repo.Take(ctx, draft.ID, owner, draft.UpdatedAt); err != nil {
+		if platformErrors.IsNotFoundError(err) {
+			if _, getErr := s.repo.GetByID(ctx, draft.ID); getErr == nil {
+				return m.Prescription{}, platformErrors.NewConflictError("prescription draft", draft.ID, "the draft was saved again while it was promoted; promote it again")
+			}
+		}
+		return m.Prescription{}, err
+	}
+
+	promoted, err := s.savePrescription(ctx, draft, prescription)
+	if err != nil {
+		// Put the draft back so the user can fix the form
+		if _, restoreErr := s.repo.Save(ctx, draft); restoreErr != nil {
+			s.log.Error("Failed to restore prescription draft after a failed promotion",
+				zap.String("draft_id", draft.ID), zap.Error(restoreErr))
+		}
+		return m.Prescription{}, err
+	}
+
+	s.log.Info("Prescription draft promoted",
+		zap.String("draft_id", draft.ID),
+		zap.String("prescription_id", promoted.ID))
+	return promoted, nil
+}
+
+// savePrescription creates the prescription of a draft, or updates the one it edits
+func (s *draftSvc) savePrescription(ctx context.Context, draft m.PrescriptionDraft, prescription m.Prescription) (m.Prescription, error) {
+	if draft.PrescriptionID == "" {
+		prescription.ID = ""
+		return s.prescriptions.Create(ctx, prescription)
+	}
+	if prescription.ID != draft.PrescriptionID {
+		return m.Prescription{}, platformErrors.NewValidationError("prescription_id", prescription.ID, "the prescription is not the one the draft edits")
+	}
+	if err := s.prescriptions.Update(ctx, prescription); err != nil {
+		return m.Prescription{}, err
+	}
+	// The update is saved, so the draft is gone even if reading the result back fails
+	if updated, err := s.prescriptions.GetByID(ctx, prescription.ID); err == nil {
+		return updated, nil
+	}
+	return prescription, nil
+}
+
+// checkDraftLimit refuses a new draft when the user keeps the most drafts allowed
+func (s *draftSvc) checkDraftLimit(ctx context.Context, owner string) error {
+	if s.cfg.MaxPerOwner < 0 {
+		return nil
+	}
+	count, err := s.repo.CountByOwner(ctx, owner)
+	if err != nil {
+		return err
+	}
+	if count >= s.cfg.MaxPerOwner {
+		return platformErrors.NewBusinessLogicError("save prescription draft", "too many drafts; promote or delete some first")
+	}
+	return nil
+}
+
+// ownDraft reads a draft of the current user, who is returned as its owner
+func (s *draftSvc) ownDraft(ctx context.Context, id, action string) (m.PrescriptionDraft, string, error) {
+	owner, err := s.owner(ctx, action)
+	if err != nil {
+		return m.PrescriptionDraft{}, "", err
+	}
+	draft, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return m.PrescriptionDraft{}, "", err
+	}
+	if draft.OwnerID != owner {
+		return m.PrescriptionDraft{}, "", platformErrors.NewAuthorizationError("prescription draft", action, "the draft belongs to another user")
+	}
+	return draft, owner, nil
+}
+
+// owner identifies the current user, who owns the drafts they save
+func (s *draftSvc) owner(ctx context.Context, action string) (string, error) {
+	user, err := auth.GetCurrentUser(ctx)
+	if err != nil || user.ID == "" {
+		return "", platformErrors.NewAuthorizationError("prescription draft", action, "drafts require an identified user")
+	}
+	return user.ID, nil
+}
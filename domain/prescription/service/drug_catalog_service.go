@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"go.uber.org/zap"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	repo "pharmacy-modernization-project-model/domain/prescription/repository"
+)
+
+const defaultAutocompleteLimit = 10
+
+type DrugCatalogService interface {
+	// Autocomplete matches the query against every name of a drug but returns the canonical entries
+	Autocomplete(ctx context.Context, query string, limit int) ([]m.DrugSuggestion, error)
+	GetByID(ctx context.Context, id string) (m.Drug, error)
+	// Resolve finds the catalog entry for a name as entered; the bool is false for drugs not in the catalog
+	Resolve(ctx context.Context, name string) (m.Drug, bool, error)
+}
+
+type drugCatalogSvc struct {
+	repo repo.DrugCatalogRepository
+	log  *zap.Logger
+}
+
+func NewDrugCatalogService(r repo.DrugCatalogRepository, l *zap.Logger) DrugCatalogService {
+	return &drugCatalogSvc{repo: r, log: l}
+}
+
+func (s *drugCatalogSvc) Autocomplete(ctx context.Context, query string, limit int) ([]m.DrugSuggestion, error) {
+	if limit <= 0 {
+		limit = defaultAutocompleteLimit
+	}
+
+	drugs, err := s.repo.Search(ctx, query, limit)
+	if err != nil {
+		s.log.Error("Failed to search drug catalog", zap.Error(err))
+		return nil, err
+	}
+
+	suggestions := make([]m.DrugSuggestion, 0, len(drugs))
+	for _, drug := range drugs {
+		if name, matchType, ok := drug.MatchExact(query); ok {
+			suggestions = append(suggestions, drug.Suggestion(name, matchType))
+		} else if name, matchType, ok := drug.MatchPrefix(query); ok {
+			suggestions = append(suggestions, drug.Suggestion(name, matchType))
+		}
+	}
+
+	// An exact name first, then generic names ahead of brand names ahead of synonyms
+	normalized := m.NormalizeDrugName(query)
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		ei := m.NormalizeDrugName(suggestions[i].MatchedName) == normalized
+		ej := m.NormalizeDrugName(suggestions[j].MatchedName) == normalized
+		if ei != ej {
+			return ei
+		}
+		return matchRank(suggestions[i].MatchType) < matchRank(suggestions[j].MatchType)
+	})
+	return suggestions, nil
+}
+
+func (s *drugCatalogSvc) GetByID(ctx context.Context, id string) (m.Drug, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *drugCatalogSvc) Resolve(ctx context.Context, name string) (m.Drug, bool, error) {
+	return s.repo.FindByName(ctx, name)
+}
+
+func matchRank(t m.DrugMatchType) int {
+	switch t {
+	case m.DrugMatchGeneric:
+		return 0
+	case m.DrugMatchBrand:
+		return 1
+	default:
+		return 2
+	}
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.uber.org/zap"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/audit"
+	"pharmacy-modernization-project-model/internal/platform/pagination"
+)
+
+// HistoryCollection is the audit trail collection name of prescription events
+const HistoryCollection = "prescriptions"
+
+// historyList names the history pages in their cursors
+const historyList = "prescription.history"
+
+// historyActionPrefix namespaces prescription events among other audit trail actions
+const historyActionPrefix = "prescription."
+
+const (
+	defaultHistoryPageSize = 20
+	maxHistoryPageSize     = 100
+)
+
+// HistoryService keeps the lifecycle of prescriptions in the audit trail
+type HistoryService interface {
+	// Record appends an event to its prescription's history. A failure is logged and not returned:
+	// the change the event describes is already saved.
+	Record(ctx context.Context, event m.PrescriptionHistoryEvent)
+	// History returns up to limit events of the prescription, oldest first, continuing after the
+	// cursor of a previous page of the same prescription when after is set. A cursor that was
+	// altered, expired or issued for another prescription is rejected with a pagination.CursorError.
+	History(ctx context.Context, prescriptionID string, limit int, after string) (m.PrescriptionHistoryConnection, error)
+}
+
+type historySvc struct {
+	audit   audit.Store
+	cursors *pagination.Codec
+	log     *zap.Logger
+}
+
+func NewHistoryService(store audit.Store, cursors *pagination.Codec, l *zap.Logger) HistoryService {
+	return &historySvc{audit: store, cursors: cursors, log: l}
+}
+
+func (s *historySvc) Record(ctx context.Context, event m.PrescriptionHistoryEvent) {
+	if event.Actor == "" {
+		event.Actor = actor(ctx)
+	}
+
+	entry := audit.Entry{
+		Action:     historyActionPrefix + strings.ToLower(string(event.Type)),
+		Collection: HistoryCollection,
+		DocumentID: event.PrescriptionID,
+		Actor:      event.Actor,
+		Reason:     event.Reason,
+		At:         event.At,
+	}
+	if event.FromStatus != "" {
+		entry.Before = bson.M{"status": string(event.FromStatus)}
+	}
+	if event.ToStatus != "" {
+		entry.After = bson.M{"status": string(event.ToStatus)}
+	}
+	metadata := bson.M{}
+	for key, value := range map[string]string{
+		"dispense_id":          event.DispenseID,
+		"pharmacy_id":          event.PharmacyID,
+		"pharmacy_name":        event.PharmacyName,
+		"previous_pharmacy_id": event.PreviousPharmacyID,
+	} {
+		if value != "" {
+			metadata[key] = value
+		}
+	}
+	if len(metadata) > 0 {
+		entry.Metadata = metadata
+	}
+
+	if _, err := s.audit.Record(ctx, entry); err != nil {
+		s.log.Error("Failed to record prescription history",
+			zap.String("prescription_id", event.PrescriptionID),
+			zap.String("event", string(event.Type)),
+			zap.Error(err))
+	}
+}
+
+func (s *historySvc) History(ctx context.Context, prescriptionID string, limit int, after string) (m.PrescriptionHistoryConnection, error) {
+	limit, err := pagination.PageSize(limit, defaultHistoryPageSize, maxHistoryPageSize)
+	if err != nil {
+		return m.PrescriptionHistoryConnection{}, err
+	}
+	filters := pagination.Filters(historyList, prescriptionID)
+	var position audit.Position
+	if after != "" {
+		if err := s.cursors.Decode(after, filters, &position); err != nil {
+			return m.PrescriptionHistoryConnection{}, err
+		}
+	}
+
+	page := m.PrescriptionHistoryConnection{Events: []m.PrescriptionHistoryEvent{}}
+	var last audit.Position
+	for {
+		// One extra entry tells whether another page follows
+		entries, err := s.audit.ListByDocumentAfter(ctx, HistoryCollection, prescriptionID, position, limit+1)
+		if err != nil {
+			s.log.Error("Failed to load prescription history",
+				zap.String("prescription_id", prescriptionID),
+				zap.Error(err))
+			return m.PrescriptionHistoryConnection{}, err
+		}
+
+		for _, entry := range entries {
+			position = audit.PositionOf(entry)
+			// Data repairs are audited on the same documents; only lifecycle events belong here
+			if !strings.HasPrefix(entry.Action, historyActionPrefix) {
+				continue
+			}
+			if len(page.Events) == limit {
+				page.HasNextPage = true
+				return s.withEndCursor(page, filters, last)
+			}
+			page.Events = append(page.Events, historyEvent(entry))
+			last = position
+		}
+		if len(entries) <= limit {
+			return s.withEndCursor(page, filters, last)
+		}
+	}
+}
+
+// withEndCursor seals the position after the page's last event as its end cursor
+func (s *historySvc) withEndCursor(page m.PrescriptionHistoryConnection, filters string, last audit.Position) (m.PrescriptionHistoryConnection, error) {
+	if len(page.Events) == 0 {
+		return page, nil
+	}
+	cursor, err := s.cursors.Encode(filters, last)
+	if err != nil {
+		return m.PrescriptionHistoryConnection{}, err
+	}
+	page.EndCursor = cursor
+	return page, nil
+}
+
+// historyEvent converts an audit entry recorded by Record back into an event
+func historyEvent(entry audit.Entry) m.PrescriptionHistoryEvent {
+	event := m.PrescriptionHistoryEvent{
+		ID:             entry.ID,
+		PrescriptionID: entry.DocumentID,
+		Type:           m.HistoryEventType(strings.ToUpper(strings.TrimPrefix(entry.Action, historyActionPrefix))),
+		At:             entry.At,
+		Actor:          entry.Actor,
+		Reason:         entry.Reason,
+	}
+	if status, ok := entry.Before["status"].(string); ok {
+		event.FromStatus = m.Status(status)
+	}
+	if status, ok := entry.After["status"].(string); ok {
+		event.ToStatus = m.Status(status)
+	}
+	event.DispenseID, _ = entry.Metadata["dispense_id"].(string)
+	event.PharmacyID, _ = entry.Metadata["pharmacy_id"].(string)
+	event.PharmacyName, _ = entry.Metadata["pharmacy_name"].(string)
+	event.PreviousPharmacyID, _ = entry.Metadata["previous_pharmacy_id"].(string)
+	return event
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/domain/prescription/contracts/request"
+	repo "pharmacy-modernization-project-model/domain/prescription/repository"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/validators/validation_logic"
+)
+
+const defaultPrescriberListLimit = 50
+
+type PrescriberService interface {
+	// List returns prescribers ordered by name; a query matches the start of a name or the NPI
+	List(ctx context.Context, query string, limit, offset int) ([]m.Prescriber, error)
+	GetByID(ctx context.Context, id string) (m.Prescriber, error)
+	Create(ctx context.Context, req request.PrescriberCreateRequest) (m.Prescriber, error)
+	Update(ctx context.Context, id string, req request.PrescriberUpdateRequest) (m.Prescriber, error)
+	// Delete removes a prescriber who has no prescriptions; one with prescriptions is a conflict
+	Delete(ctx context.Context, id string) error
+	// Prescriptions returns the prescriptions written under the prescriber, newest first
+	Prescriptions(ctx context.Context, id string, limit int) ([]m.Prescription, error)
+}
+
+type prescriberSvc struct {
+	repo          repo.PrescriberRepository
+	prescriptions repo.PrescriptionRepository
+	log           *zap.Logger
+}
+
+func NewPrescriberService(r repo.PrescriberRepository, prescriptions repo.PrescriptionRepository, l *zap.Logger) PrescriberService {
+	return &prescriberSvc{repo: r, prescriptions: prescriptions, log: l}
+}
+
+func (s *prescriberSvc) List(ctx context.Context, query string, limit, offset int) ([]m.Prescriber, error) {
+	if limit <= 0 {
+		limit = defaultPrescriberListLimit
+	}
+	return s.repo.List(ctx, strings.TrimSpace(query), limit, offset)
+}
+
+func (s *prescriberSvc) GetByID(ctx context.Context, id string) (m.Prescriber, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *prescriberSvc) Create(ctx context.Context, req request.PrescriberCreateRequest) (m.Prescriber, error) {
+	now := time.Now()
+	prescriber := m.Prescriber{
+		ID:         uuid.NewString(),
+		NPI:        strings.TrimSpace(req.NPI),
+		FirstName:  strings.TrimSpace(req.FirstName),
+		LastName:   strings.TrimSpace(req.LastName),
+		Credential: strings.TrimSpace(req.Credential),
+		Specialty:  strings.TrimSpace(req.Specialty),
+		Phone:      strings.TrimSpace(req.Phone),
+		Fax:        strings.TrimSpace(req.Fax),
+		Email:      strings.TrimSpace(req.Email),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := s.checkNPI(ctx, prescriber); err != nil {
+		return m.Prescriber{}, err
+	}
+
+	created, err := s.repo.Create(ctx, prescriber)
+	if err != nil {
+		s.log.Error("Failed to create prescriber", zap.Error(err))
+		return m.Prescriber{}, err
+	}
+	s.log.Info("Prescriber created", zap.String("prescriber_id", created.ID))
+	return created, nil
+}
+
+func (s *prescriberSvc) Update(ctx context.Context, id string, req request.PrescriberUpdateRequest) (m.Prescriber, error) {
+	prescriber, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return m.Prescriber{}, err
+	}
+
+	set := func(field *string, value *string) {
+		if value != nil {
+			*field = strings.TrimSpace(*value)
+		}
+	}
+	set(&prescriber.NPI, req.NPI)
+	set(&prescriber.FirstName, req.FirstName)
+	set(&prescriber.LastName, req.LastName)
+	set(&prescriber.Credential, req.Credential)
+	set(&prescriber.Specialty, req.Specialty)
+	set(&prescriber.Phone, req.Phone)
+	set(&prescriber.Fax, req.Fax)
+	set(&prescriber.Email, req.Email)
+	if err := s.checkNPI(ctx, prescriber); err != nil {
+		return m.Prescriber{}, err
+	}
+	prescriber.UpdatedAt = time.Now()
+
+	updated, err := s.repo.Update(ctx, prescriber)
+	if err != nil {
+		s.log.Error("Failed to update prescriber", zap.String("prescriber_id", id), zap.Error(err))
+		return m.Prescriber{}, err
+	}
+	return updated, nil
+}
+
+func (s *prescriberSvc) Delete(ctx context.Context, id string) error {
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return err
+	}
+
+	// Prescriptions keep their attribution, so a prescriber who wrote any stays on record
+	written, err := s.prescriptions.ListByPrescriberID(ctx, id, 1)
+	if err != nil {
+		return err
+	}
+	if len(written) > 0 {
+		return platformErrors.NewConflictError("prescriber", id, "prescriber has prescriptions and cannot be deleted")
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		s.log.Error("Failed to delete prescriber", zap.String("prescriber_id", id), zap.Error(err))
+		return err
+	}
+	s.log.Info("Prescriber deleted", zap.String("prescriber_id", id))
+	return nil
+}
+
+func (s *prescriberSvc) Prescriptions(ctx context.Context, id string, limit int) ([]m.Prescription, error) {
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.prescriptions.ListByPrescriberID(ctx, id, limit)
+}
+
+// checkNPI checks the NPI's check digit and that no other prescriber has it
+func (s *prescriberSvc) checkNPI(ctx context.Context, prescriber m.Prescriber) error {
+	if err := validation_logic.ValidateNPIValue("npi", prescriber.NPI); err != nil {
+		return platformErrors.NewValidationError("npi", prescriber.NPI, err.Error())
+	}
+	existing, found, err := s.repo.GetByNPI(ctx, prescriber.NPI)
+	if err != nil {
+		return err
+	}
+	if found && existing.ID != prescriber.ID {
+		return platformErrors.NewDuplicateRecordError("prescriber", prescriber.NPI)
+	}
+	return nil
+}
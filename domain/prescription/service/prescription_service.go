@@ -2,17 +2,31 @@ package service
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
 	"time"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	commonmodel "pharmacy-modernization-project-model/domain/common/model"
 	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	prescriptionErrors "pharmacy-modernization-project-model/domain/prescription/errors"
+	prescriptionproviders "pharmacy-modernization-project-model/domain/prescription/providers"
 	repo "pharmacy-modernization-project-model/domain/prescription/repository"
 	irisbilling "pharmacy-modernization-project-model/internal/integrations/iris_billing"
 	irispharmacy "pharmacy-modernization-project-model/internal/integrations/iris_pharmacy"
+	"pharmacy-modernization-project-model/internal/platform/auth"
 	"pharmacy-modernization-project-model/internal/platform/cache"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/events"
+	"pharmacy-modernization-project-model/internal/platform/tenancy"
+)
+
+const (
+	defaultPatientPrescriptionLimit = 100
+	maxPatientPrescriptionLimit     = 200
 )
 
 type PrescriptionService interface {
@@ -21,34 +35,157 @@ type PrescriptionService interface {
 	Create(ctx context.Context, prescription m.Prescription) (m.Prescription, error)
 	Update(ctx context.Context, prescription m.Prescription) error
 	CountByStatus(ctx context.Context, status string) (int, error)
+	// CountGroupedByStatus returns the number of prescriptions of each status
+	CountGroupedByStatus(ctx context.Context) ([]m.StatusCount, error)
+	// CacheWarmupTasks returns a task caching the prescription count of each status
+	CacheWarmupTasks() []cache.WarmupTask
 	PatientPrescriptionListByPatientID(ctx context.Context, patientID string) ([]commonmodel.PatientPrescription, error)
+	// ListForPatient returns the patient's prescriptions, newest first; an empty status matches every status.
+	// A limit of 0 means defaultPatientPrescriptionLimit and larger limits are capped at maxPatientPrescriptionLimit
+	ListForPatient(ctx context.Context, patientID string, status m.Status, limit int) ([]m.Prescription, error)
+	CheckInteractions(ctx context.Context, patientID, newDrug string) (m.InteractionCheckResult, error)
+	// ListInFlight pages through in-flight prescriptions in ID order, starting after afterID
+	ListInFlight(ctx context.Context, afterID string, limit int) ([]m.Prescription, error)
+	// ListByPrescriber returns the prescriptions created by the user, newest first
+	ListByPrescriber(ctx context.Context, prescribedBy string, limit int) ([]m.Prescription, error)
+	UpdateFulfillmentStatus(ctx context.Context, id string, status m.FulfillmentStatus) error
+	// OnCreated registers a handler called after a prescription is created
+	OnCreated(handler CreationHandler)
+	// OnCompleted registers a handler called after an update moves a prescription to Completed
+	OnCompleted(handler CompletionHandler)
+	// OnStatusChanged registers a handler called after an update or reopen changes a prescription's status
+	OnStatusChanged(handler StatusChangeHandler)
+	// UseAllergyProvider sets where patient allergies are read; until it is set no allergy is checked
+	UseAllergyProvider(provider prescriptionproviders.AllergyProvider)
+	// UsePriceEstimateProvider sets where price estimates are read; until it is set prescriptions have none
+	UsePriceEstimateProvider(provider prescriptionproviders.PriceEstimateProvider)
+	// PriceEstimate returns what the prescription is expected to cost, or nil without an estimate provider
+	PriceEstimate(ctx context.Context, id string) (*commonmodel.PrescriptionPriceEstimate, error)
+	// Reopen moves a completed prescription back to Active so it can be dispensed again
+	Reopen(ctx context.Context, id string) error
+	// SearchPharmacies looks up network pharmacies by zip code and/or state
+	SearchPharmacies(ctx context.Context, zip, state string, limit int) (m.PharmacySearchResult, error)
+	// RouteToPharmacy sends an active prescription to a network pharmacy and records the pharmacy
+	RouteToPharmacy(ctx context.Context, id, pharmacyID string) (m.Prescription, error)
+	// ListActiveCreatedBefore returns active prescriptions created before the time, oldest first
+	ListActiveCreatedBefore(ctx context.Context, before time.Time, limit int) ([]m.Prescription, error)
+	// Expire moves an active prescription to Expired or Completed; it reports false when the
+	// prescription was no longer active
+	Expire(ctx context.Context, prescription m.Prescription, status m.Status) (bool, error)
+	// BulkUpdateStatus moves each prescription to the status in one write, where the status can
+	// be reached from its current one, and reports what happened to each
+	BulkUpdateStatus(ctx context.Context, ids []string, status m.Status, reason string) ([]m.BulkStatusResult, error)
 }
 
+// CreationHandler reacts to a prescription being created; it runs after the prescription is saved
+type CreationHandler func(ctx context.Context, prescription m.Prescription)
+
+// CompletionHandler reacts to a prescription being completed; it runs after the update is saved
+type CompletionHandler func(ctx context.Context, prescription m.Prescription)
+
+// StatusChangeHandler reacts to a prescription's status changing; it runs after the change is saved
+type StatusChangeHandler func(ctx context.Context, prescription m.Prescription, previous m.Status)
+
 type svc struct {
-	repo      repo.PrescriptionRepository
-	cache     cache.Cache
-	cacheKeys *CacheKeys
-	log       *zap.Logger
-	pharmacy  irispharmacy.PharmacyClient
-	billing   irisbilling.BillingClient
+	repo         repo.PrescriptionRepository
+	interactions repo.DrugInteractionRepository
+	drugs        DrugCatalogService
+	prescribers  PrescriberService
+	cache        cache.Cache
+	cacheKeys    *CacheKeys
+	log          *zap.Logger
+	pharmacy     irispharmacy.PharmacyClient
+	billing      irisbilling.BillingClient
+	history      HistoryService
+	publisher    events.Publisher
+	allergies    prescriptionproviders.AllergyProvider
+	pricing      prescriptionproviders.PriceEstimateProvider
+	onCreated    []CreationHandler
+	onCompleted  []CompletionHandler
+	onStatus     []StatusChangeHandler
+
+	prescriptionCache        *cache.TypedCache[m.Prescription]
+	countCache               *cache.TypedCache[int]
+	statusCountCache         *cache.TypedCache[[]m.StatusCount]
+	patientPrescriptionCache *cache.TypedCache[[]commonmodel.PatientPrescription]
 }
 
-func New(r repo.PrescriptionRepository, c cache.Cache, l *zap.Logger, pharmacy irispharmacy.PharmacyClient, billing irisbilling.BillingClient) PrescriptionService {
+// New creates the prescription service. The loader reads prescriptions and counts through the
+// cache; without one the cache is read and written directly. The serializer encodes the cached
+// values. Status changes are published as events.
+func New(r repo.PrescriptionRepository, interactions repo.DrugInteractionRepository, drugs DrugCatalogService, prescribers PrescriberService, c cache.Cache, loader *cache.Loader, serializer *cache.Serializer, l *zap.Logger, pharmacy irispharmacy.PharmacyClient, billing irisbilling.BillingClient, history HistoryService, publisher events.Publisher) PrescriptionService {
 	return &svc{
-		repo:      r,
-		cache:     c,
-		cacheKeys: NewCacheKeys(),
-		log:       l,
-		pharmacy:  pharmacy,
-		billing:   billing,
+		repo:         r,
+		interactions: interactions,
+		drugs:        drugs,
+		prescribers:  prescribers,
+		cache:        c,
+		cacheKeys:    NewCacheKeys(),
+		log:          l,
+		pharmacy:     pharmacy,
+		billing:      billing,
+		history:      history,
+		publisher:    publisher,
+
+		prescriptionCache:        cache.NewTypedCache[m.Prescription](c, loader, serializer),
+		countCache:               cache.NewTypedCache[int](c, loader, serializer),
+		statusCountCache:         cache.NewTypedCache[[]m.StatusCount](c, loader, serializer),
+		patientPrescriptionCache: cache.NewTypedCache[[]commonmodel.PatientPrescription](c, loader, serializer),
 	}
 }
 
 func (s *svc) Create(ctx context.Context, prescription m.Prescription) (m.Prescription, error) {
 	s.log.Info("Creating prescription")
 
+	if err := s.requirePrescriber(ctx, prescription.PrescriberID); err != nil {
+		return m.Prescription{}, err
+	}
+	if err := s.resolveDrug(ctx, &prescription); err != nil {
+		return m.Prescription{}, err
+	}
+
+	// A drug the patient is allergic to is never prescribed
+	allergyWarnings, err := s.checkAllergies(ctx, prescription)
+	if err != nil {
+		s.log.Error("Failed to check patient allergies",
+			zap.Error(err))
+		return m.Prescription{}, err
+	}
+	if len(allergyWarnings) > 0 {
+		s.log.Warn("Prescription blocked by patient allergy",
+			zap.Int("warnings", len(allergyWarnings)))
+		return m.Prescription{}, prescriptionErrors.NewDrugAllergyError("CreatePrescription", allergyWarnings)
+	}
+
+	// Check the new drug against the patient's current prescriptions
+	result, err := s.checkInteractions(ctx, prescription.PatientID, prescription.Drug, "")
+	if err != nil {
+		s.log.Error("Failed to check drug interactions",
+			zap.Error(err))
+		return m.Prescription{}, err
+	}
+	if result.Blocked {
+		s.log.Warn("Prescription blocked by severe drug interaction",
+			zap.Int("warnings", len(result.Warnings)))
+		return m.Prescription{}, prescriptionErrors.NewSevereInteractionError("CreatePrescription", result.Warnings)
+	}
+	prescription.InteractionWarnings = result.Warnings
+
+	if prescription.ID == "" {
+		prescription.ID = uuid.NewString()
+	}
+
 	// Set creation timestamp
 	prescription.CreatedAt = time.Now()
+	if err := resolveDose(&prescription, ""); err != nil {
+		return m.Prescription{}, err
+	}
+	if err := resolveSupply(&prescription); err != nil {
+		return m.Prescription{}, err
+	}
+	if user, err := auth.GetCurrentUser(ctx); err == nil && prescription.PrescribedBy == "" {
+		prescription.PrescribedBy = user.ID
+	}
 
 	// Create prescription in repository
 	createdPrescription, err := s.repo.Create(ctx, prescription)
@@ -61,14 +198,76 @@ func (s *svc) Create(ctx context.Context, prescription m.Prescription) (m.Prescr
 	s.log.Info("Prescription created successfully",
 		zap.String("prescription_id", createdPrescription.ID))
 
+	// The ID may have been looked up before it existed
+	if err := s.prescriptionCache.Forget(ctx, s.cacheKeys.PrescriptionByID(createdPrescription.ID)); err != nil {
+		s.log.Warn("Failed to clear cached prescription lookup", zap.Error(err))
+	}
+
+	s.history.Record(ctx, m.PrescriptionHistoryEvent{
+		PrescriptionID: createdPrescription.ID,
+		Type:           m.HistoryCreated,
+		At:             createdPrescription.CreatedAt,
+		ToStatus:       createdPrescription.Status,
+	})
+
+	s.forgetStatusCounts(ctx)
+	s.forgetPatientList(ctx, createdPrescription.PatientID)
+	for _, handler := range s.onCreated {
+		handler(ctx, createdPrescription)
+	}
+
 	return createdPrescription, nil
 }
 
 func (s *svc) Update(ctx context.Context, prescription m.Prescription) error {
 	s.log.Info("Updating prescription")
 
+	if err := s.resolveDrug(ctx, &prescription); err != nil {
+		return err
+	}
+
+	// Remember the previous status to detect completion, and the dose to leave unread legacy text be
+	previous, _ := s.repo.GetByID(ctx, prescription.ID)
+
+	if err := resolveDose(&prescription, previous.Dose); err != nil {
+		return err
+	}
+	if err := resolveSupply(&prescription); err != nil {
+		return err
+	}
+
+	// Changing the drug checks allergies again; other edits leave an allergy recorded after the
+	// prescription was written to the prescriber
+	if m.NormalizeDrugName(prescription.Drug) != m.NormalizeDrugName(previous.Drug) {
+		allergyWarnings, err := s.checkAllergies(ctx, prescription)
+		if err != nil {
+			s.log.Error("Failed to check patient allergies",
+				zap.Error(err))
+			return err
+		}
+		if len(allergyWarnings) > 0 {
+			s.log.Warn("Prescription update blocked by patient allergy",
+				zap.String("prescription_id", prescription.ID))
+			return prescriptionErrors.NewDrugAllergyError("UpdatePrescription", allergyWarnings)
+		}
+	}
+
+	// Re-check interactions, ignoring the prescription being updated
+	result, err := s.checkInteractions(ctx, prescription.PatientID, prescription.Drug, prescription.ID)
+	if err != nil {
+		s.log.Error("Failed to check drug interactions",
+			zap.Error(err))
+		return err
+	}
+	if result.Blocked {
+		s.log.Warn("Prescription update blocked by severe drug interaction",
+			zap.String("prescription_id", prescription.ID))
+		return prescriptionErrors.NewSevereInteractionError("UpdatePrescription", result.Warnings)
+	}
+	prescription.InteractionWarnings = result.Warnings
+
 	// Update prescription in repository
-	_, err := s.repo.Update(ctx, prescription.ID, prescription)
+	_, err = s.repo.Update(ctx, prescription.ID, prescription)
 	if err != nil {
 		s.log.Error("Failed to update prescription",
 			zap.Error(err))
@@ -83,82 +282,391 @@ func (s *svc) Update(ctx context.Context, prescription m.Prescription) error {
 				zap.Error(err))
 		}
 	}
+	// A prescription moved to another patient leaves the previous patient's list too
+	s.forgetPatientList(ctx, prescription.PatientID, previous.PatientID)
 
 	s.log.Info("Prescription updated successfully")
 
+	// Recorded before the handlers run, so the status change precedes the dispense it causes
+	if previous.ID != "" && prescription.Status != previous.Status {
+		s.history.Record(ctx, m.PrescriptionHistoryEvent{
+			PrescriptionID: prescription.ID,
+			Type:           m.HistoryStatusChanged,
+			FromStatus:     previous.Status,
+			ToStatus:       prescription.Status,
+		})
+	}
+
+	if prescription.Status == m.Completed && previous.Status != m.Completed {
+		for _, handler := range s.onCompleted {
+			handler(ctx, prescription)
+		}
+	}
+	if previous.ID != "" && prescription.Status != previous.Status {
+		s.statusChanged(ctx, prescription, previous.Status)
+	}
+
 	return nil
 }
-func (s *svc) List(ctx context.Context, status string, limit, offset int) ([]m.Prescription, error) {
-	return s.repo.List(ctx, status, limit, offset)
+
+func (s *svc) OnCreated(handler CreationHandler) {
+	s.onCreated = append(s.onCreated, handler)
 }
 
-func (s *svc) GetByID(ctx context.Context, id string) (m.Prescription, error) {
-	cacheKey := s.cacheKeys.PrescriptionByID(id)
+func (s *svc) OnCompleted(handler CompletionHandler) {
+	s.onCompleted = append(s.onCompleted, handler)
+}
 
-	// Try cache first
-	if s.cache != nil {
-		if cached, err := s.cache.Get(ctx, cacheKey); err == nil {
-			var prescription m.Prescription
-			if err := json.Unmarshal(cached, &prescription); err == nil {
-				if s.log != nil {
-					s.log.Debug("Prescription retrieved from cache")
-				}
-				return prescription, nil
-			}
+func (s *svc) OnStatusChanged(handler StatusChangeHandler) {
+	s.onStatus = append(s.onStatus, handler)
+}
+
+func (s *svc) UseAllergyProvider(provider prescriptionproviders.AllergyProvider) {
+	s.allergies = provider
+}
+
+func (s *svc) UsePriceEstimateProvider(provider prescriptionproviders.PriceEstimateProvider) {
+	s.pricing = provider
+}
+
+func (s *svc) PriceEstimate(ctx context.Context, id string) (*commonmodel.PrescriptionPriceEstimate, error) {
+	if s.pricing == nil {
+		return nil, nil
+	}
+	estimate, err := s.pricing.PrescriptionPriceEstimateByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &estimate, nil
+}
+
+func (s *svc) statusChanged(ctx context.Context, prescription m.Prescription, previous m.Status) {
+	s.forgetStatusCounts(ctx)
+	for _, handler := range s.onStatus {
+		handler(ctx, prescription, previous)
+	}
+	s.publisher.Publish(ctx, events.PrescriptionStatusChanged{
+		PrescriptionID: prescription.ID,
+		PatientID:      prescription.PatientID,
+		PreviousStatus: string(previous),
+		Status:         string(prescription.Status),
+	})
+}
+
+// forgetStatusCounts drops the cached counts by status in every scope, so handlers of the change,
+// such as the dashboard's live updates, read the new counts. Other instances rely on the cache
+// invalidation of the prescriptions collection.
+func (s *svc) forgetStatusCounts(ctx context.Context) {
+	if s.cache == nil {
+		return
+	}
+	for _, key := range s.cacheKeys.statusCounts() {
+		if err := s.cache.Delete(ctx, key); err != nil {
+			s.log.Warn("Failed to clear cached prescription counts", zap.Error(err))
+			return
+		}
+		if err := cache.DeleteScoped(ctx, s.cache, key); err != nil {
+			s.log.Warn("Failed to clear cached prescription counts", zap.Error(err))
+			return
+		}
+	}
+}
+
+// forgetPatientList drops the cached prescription list of each patient in every organization.
+// Other instances rely on the cache invalidation of the prescriptions collection.
+func (s *svc) forgetPatientList(ctx context.Context, patientIDs ...string) {
+	if s.cache == nil {
+		return
+	}
+	for _, patientID := range patientIDs {
+		if patientID == "" {
+			continue
+		}
+		key := s.cacheKeys.PrescriptionsByPatientID(patientID)
+		if err := s.cache.Delete(ctx, key); err != nil {
+			s.log.Warn("Failed to clear cached patient prescriptions", zap.Error(err))
+			continue
+		}
+		if err := cache.DeleteScoped(ctx, s.cache, key); err != nil {
+			s.log.Warn("Failed to clear cached patient prescriptions", zap.Error(err))
 		}
 	}
+}
 
+func (s *svc) Reopen(ctx context.Context, id string) error {
 	prescription, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		return m.Prescription{}, err
+		return err
+	}
+	if prescription.Status != m.Completed {
+		return platformErrors.NewBusinessLogicError("ReopenPrescription",
+			fmt.Sprintf("only completed prescriptions can be reopened (status is %s)", prescription.Status))
+	}
+
+	// The drug is unchanged, so unlike Update there is nothing to re-check for interactions
+	prescription.Status = m.Active
+	if _, err := s.repo.Update(ctx, id, prescription); err != nil {
+		s.log.Error("Failed to reopen prescription",
+			zap.String("prescription_id", id),
+			zap.Error(err))
+		return err
 	}
 
-	// Cache the result
 	if s.cache != nil {
-		if data, err := json.Marshal(prescription); err == nil {
-			if err := s.cache.Set(ctx, cacheKey, data, 15*time.Minute); err != nil && s.log != nil {
-				s.log.Warn("Failed to cache prescription", zap.Error(err))
-			}
+		if err := s.cache.Delete(ctx, s.cacheKeys.PrescriptionByID(id)); err != nil {
+			s.log.Warn("Failed to invalidate prescription cache",
+				zap.Error(err))
 		}
 	}
 
-	return prescription, nil
+	s.forgetPatientList(ctx, prescription.PatientID)
+
+	s.log.Info("Prescription reopened", zap.String("prescription_id", id))
+	s.history.Record(ctx, m.PrescriptionHistoryEvent{
+		PrescriptionID: id,
+		Type:           m.HistoryStatusChanged,
+		Reason:         "Reopened to be dispensed again",
+		FromStatus:     m.Completed,
+		ToStatus:       m.Active,
+	})
+	s.statusChanged(ctx, prescription, m.Completed)
+	return nil
 }
 
-func (s *svc) CountByStatus(ctx context.Context, status string) (int, error) {
-	cacheKey := s.cacheKeys.PrescriptionCountByStatus(status)
+func (s *svc) ListActiveCreatedBefore(ctx context.Context, before time.Time, limit int) ([]m.Prescription, error) {
+	return s.repo.ListByStatusCreatedBefore(ctx, m.Active, before, limit)
+}
+
+func (s *svc) Expire(ctx context.Context, prescription m.Prescription, status m.Status) (bool, error) {
+	if status != m.Expired && status != m.Completed {
+		return false, platformErrors.NewValidationError("status", status, "prescriptions can only expire to Expired or Completed")
+	}
+
+	// Conditional, so an edit made since the prescription was listed wins
+	updated, err := s.repo.UpdateStatus(ctx, prescription.ID, m.Active, status)
+	if err != nil {
+		s.log.Error("Failed to expire prescription",
+			zap.String("prescription_id", prescription.ID),
+			zap.Error(err))
+		return false, err
+	}
+	if !updated {
+		return false, nil
+	}
 
-	// Try cache first
 	if s.cache != nil {
-		if cached, err := s.cache.Get(ctx, cacheKey); err == nil {
-			var count int
-			if err := json.Unmarshal(cached, &count); err == nil {
-				if s.log != nil {
-					s.log.Debug("Prescription count retrieved from cache")
-				}
-				return count, nil
-			}
+		if err := s.cache.Delete(ctx, s.cacheKeys.PrescriptionByID(prescription.ID)); err != nil {
+			s.log.Warn("Failed to invalidate prescription cache",
+				zap.Error(err))
 		}
 	}
 
-	count, err := s.repo.CountByStatus(ctx, status)
+	s.forgetPatientList(ctx, prescription.PatientID)
+
+	s.history.Record(ctx, m.PrescriptionHistoryEvent{
+		PrescriptionID: prescription.ID,
+		Type:           m.HistoryStatusChanged,
+		Reason:         "Active longer than the maximum age",
+		FromStatus:     m.Active,
+		ToStatus:       status,
+	})
+
+	prescription.Status = status
+	if status == m.Completed {
+		for _, handler := range s.onCompleted {
+			handler(ctx, prescription)
+		}
+	}
+	s.statusChanged(ctx, prescription, m.Active)
+	return true, nil
+}
+
+func (s *svc) BulkUpdateStatus(ctx context.Context, ids []string, status m.Status, reason string) ([]m.BulkStatusResult, error) {
+	// Results follow the request order; an ID given twice is changed and reported once
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !slices.Contains(unique, id) {
+			unique = append(unique, id)
+		}
+	}
+	ids = unique
+
+	found, err := s.repo.ListByIDs(ctx, ids)
 	if err != nil {
-		return 0, err
+		return nil, err
+	}
+	byID := make(map[string]m.Prescription, len(found))
+	for _, prescription := range found {
+		byID[prescription.ID] = prescription
 	}
 
-	// Cache the result with shorter TTL for counts
-	if s.cache != nil {
-		if data, err := json.Marshal(count); err == nil {
-			if err := s.cache.Set(ctx, cacheKey, data, 5*time.Minute); err != nil && s.log != nil {
-				s.log.Warn("Failed to cache prescription count", zap.Error(err))
+	results := make([]m.BulkStatusResult, len(ids))
+	var changes []m.StatusChange
+	var changed []int // Index in results of each change
+	for i, id := range ids {
+		prescription, ok := byID[id]
+		switch {
+		case !ok:
+			results[i] = m.BulkStatusResult{PrescriptionID: id, Outcome: m.BulkStatusNotFound, Message: "prescription not found"}
+		case prescription.Status == status:
+			results[i] = m.BulkStatusResult{PrescriptionID: id, Outcome: m.BulkStatusUnchanged, PreviousStatus: status, Status: status}
+		case !m.CanTransition(prescription.Status, status):
+			results[i] = m.BulkStatusResult{PrescriptionID: id, Outcome: m.BulkStatusRejected, PreviousStatus: prescription.Status, Status: prescription.Status,
+				Message: fmt.Sprintf("cannot move from %s to %s", prescription.Status, status)}
+		default:
+			results[i] = m.BulkStatusResult{PrescriptionID: id, PreviousStatus: prescription.Status}
+			changes = append(changes, m.StatusChange{ID: id, From: prescription.Status, To: status})
+			changed = append(changed, i)
+		}
+	}
+	if len(changes) == 0 {
+		return results, nil
+	}
+
+	// Conditional on the status read above, so an edit made meanwhile wins
+	applied, err := s.repo.UpdateStatuses(ctx, changes)
+	if err != nil {
+		s.log.Error("Failed to update prescription statuses",
+			zap.Int("changes", len(changes)),
+			zap.Error(err))
+		return nil, err
+	}
+
+	updated := 0
+	for n, change := range changes {
+		result := &results[changed[n]]
+		if !applied[n] {
+			result.Outcome = m.BulkStatusConflict
+			result.Status = ""
+			result.Message = "prescription changed while the operation ran"
+			continue
+		}
+		result.Outcome = m.BulkStatusUpdated
+		result.Status = status
+		updated++
+
+		prescription := byID[change.ID]
+		if s.cache != nil {
+			if err := s.cache.Delete(ctx, s.cacheKeys.PrescriptionByID(change.ID)); err != nil {
+				s.log.Warn("Failed to invalidate prescription cache",
+					zap.Error(err))
 			}
 		}
+		s.forgetPatientList(ctx, prescription.PatientID)
+
+		s.history.Record(ctx, m.PrescriptionHistoryEvent{
+			PrescriptionID: change.ID,
+			Type:           m.HistoryStatusChanged,
+			Reason:         reason,
+			FromStatus:     change.From,
+			ToStatus:       status,
+		})
+
+		prescription.Status = status
+		if status == m.Completed {
+			for _, handler := range s.onCompleted {
+				handler(ctx, prescription)
+			}
+		}
+		s.statusChanged(ctx, prescription, change.From)
 	}
 
-	return count, nil
+	s.log.Info("Prescription statuses updated in bulk",
+		zap.String("status", string(status)),
+		zap.Int("requested", len(ids)),
+		zap.Int("updated", updated))
+	return results, nil
+}
+
+func (s *svc) List(ctx context.Context, status string, limit, offset int) ([]m.Prescription, error) {
+	return s.repo.List(ctx, status, limit, offset)
 }
 
+func (s *svc) GetByID(ctx context.Context, id string) (m.Prescription, error) {
+	// An organization may not see a prescription another one loaded, so only unscoped reads share loads
+	if _, scoped := tenancy.OrgID(ctx); !scoped {
+		return s.loadByID(ctx, id)
+	}
+	cacheKey := s.cacheKeys.PrescriptionByID(id)
+
+	// Try cache first; entries are shared by all organizations, the repository decides for other ones
+	if prescription, err := s.prescriptionCache.Get(ctx, cacheKey); err == nil && tenancy.Visible(ctx, prescription.OrgID) {
+		if s.log != nil {
+			s.log.Debug("Prescription retrieved from cache")
+		}
+		return prescription, nil
+	}
+
+	prescription, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return m.Prescription{}, err
+	}
+
+	// Cache the result
+	if err := s.prescriptionCache.Set(ctx, cacheKey, prescription, 15*time.Minute); err != nil && s.log != nil {
+		s.log.Warn("Failed to cache prescription", zap.Error(err))
+	}
+
+	return prescription, nil
+}
+
+// loadByID reads the prescription through the loader, so repeated lookups of a missing ID and
+// concurrent lookups of the same ID reach the repository once
+func (s *svc) loadByID(ctx context.Context, id string) (m.Prescription, error) {
+	prescription, err := s.prescriptionCache.Load(ctx, s.cacheKeys.PrescriptionByID(id), 15*time.Minute, func(ctx context.Context) (m.Prescription, error) {
+		prescription, err := s.repo.GetByID(ctx, id)
+		if err == nil && prescription.ID == "" {
+			err = platformErrors.NewRecordNotFoundError("Prescription", id)
+		}
+		return prescription, err
+	})
+	if errors.Is(err, cache.ErrCachedNotFound) {
+		return m.Prescription{}, platformErrors.NewRecordNotFoundError("Prescription", id)
+	}
+	if err != nil {
+		return m.Prescription{}, err
+	}
+	return prescription, nil
+}
+
+func (s *svc) CountByStatus(ctx context.Context, status string) (int, error) {
+	cacheKey := s.cacheKeys.PrescriptionCountByStatus(cache.ScopeOf(ctx, nil), status)
+	return s.countCache.Load(ctx, cacheKey, 5*time.Minute, func(ctx context.Context) (int, error) {
+		return s.repo.CountByStatus(ctx, status)
+	})
+}
+
+// CountGroupedByStatus caches the counts with a short TTL, as the dashboard shows them
+func (s *svc) CountGroupedByStatus(ctx context.Context) ([]m.StatusCount, error) {
+	cacheKey := s.cacheKeys.PrescriptionCountGroupedByStatus(cache.ScopeOf(ctx, nil))
+	return s.statusCountCache.Load(ctx, cacheKey, time.Minute, s.repo.CountGroupedByStatus)
+}
+
+func (s *svc) CacheWarmupTasks() []cache.WarmupTask {
+	if s.cache == nil {
+		return nil
+	}
+	tasks := make([]cache.WarmupTask, 0, len(countedStatuses))
+	for _, status := range countedStatuses {
+		tasks = append(tasks, func(ctx context.Context) error {
+			_, err := s.CountByStatus(ctx, status)
+			return err
+		})
+	}
+	return tasks
+}
+
+// PatientPrescriptionListByPatientID is read on every patient page, so the list is cached per
+// patient in the caller's organization until a prescription of the patient changes
 func (s *svc) PatientPrescriptionListByPatientID(ctx context.Context, patientID string) ([]commonmodel.PatientPrescription, error) {
+	cacheKey := cache.ScopeOf(ctx, nil).Key(s.cacheKeys.PrescriptionsByPatientID(patientID))
+	if result, err := s.patientPrescriptionCache.Get(ctx, cacheKey); err == nil {
+		if s.log != nil {
+			s.log.Debug("Patient prescriptions retrieved from cache")
+		}
+		return result, nil
+	}
+
 	items, err := s.repo.ListByPatientID(ctx, patientID)
 	if err != nil {
 		if s.log != nil {
@@ -176,5 +684,455 @@ func (s *svc) PatientPrescriptionListByPatientID(ctx context.Context, patientID
 			CreatedAt: item.CreatedAt,
 		})
 	}
+
+	if err := s.patientPrescriptionCache.Set(ctx, cacheKey, result, 5*time.Minute); err != nil && s.log != nil {
+		s.log.Warn("Failed to cache patient prescriptions", zap.Error(err))
+	}
+	return result, nil
+}
+
+func (s *svc) CheckInteractions(ctx context.Context, patientID, newDrug string) (m.InteractionCheckResult, error) {
+	// Interactions are recorded under generic names, so check a brand name as its generic drug
+	p := m.Prescription{Drug: newDrug}
+	if err := s.resolveDrug(ctx, &p); err != nil {
+		return m.InteractionCheckResult{}, err
+	}
+	p.PatientID = patientID
+
+	result, err := s.checkInteractions(ctx, patientID, p.Drug, "")
+	if err != nil {
+		return m.InteractionCheckResult{}, err
+	}
+	result.AllergyWarnings, err = s.checkAllergies(ctx, p)
+	if err != nil {
+		return m.InteractionCheckResult{}, err
+	}
+	if len(result.AllergyWarnings) > 0 {
+		result.Blocked = true
+	}
 	return result, nil
 }
+
+// requirePrescriber checks a new prescription names a prescriber on record
+func (s *svc) requirePrescriber(ctx context.Context, prescriberID string) error {
+	if prescriberID == "" {
+		return platformErrors.NewValidationError("prescriber_id", "", "prescriber_id is required")
+	}
+	if s.prescribers == nil {
+		return nil
+	}
+	_, err := s.prescribers.GetByID(ctx, prescriberID)
+	var notFound platformErrors.RecordNotFoundError
+	if errors.As(err, &notFound) {
+		return platformErrors.NewValidationError("prescriber_id", prescriberID, "prescriber does not exist")
+	}
+	return err
+}
+
+// resolveDrug maps the prescription's drug to its catalog entry: Drug becomes the canonical name
+// and DrugID its ID, while DrugEntered keeps the name as entered. A DrugID picked from
+// autocomplete takes precedence over the name. Drugs missing from the catalog are kept as entered.
+func (s *svc) resolveDrug(ctx context.Context, prescription *m.Prescription) error {
+	if prescription.DrugEntered == "" {
+		prescription.DrugEntered = prescription.Drug
+	}
+	if s.drugs == nil {
+		return nil
+	}
+
+	var drug m.Drug
+	if prescription.DrugID != "" {
+		found, err := s.drugs.GetByID(ctx, prescription.DrugID)
+		var notFound platformErrors.RecordNotFoundError
+		if errors.As(err, &notFound) {
+			return platformErrors.NewValidationError("drug_id", prescription.DrugID, "drug is not in the catalog")
+		}
+		if err != nil {
+			return err
+		}
+		drug = found
+	} else {
+		found, ok, err := s.drugs.Resolve(ctx, prescription.DrugEntered)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		drug = found
+	}
+
+	prescription.DrugID = drug.ID
+	prescription.Drug = drug.Name
+	return nil
+}
+
+// checkSig is the dose check of a prescription's sig: every code must be known and the dose must
+// stay within the usual maximum per dose for its unit.
+func checkSig(sig m.Sig) error {
+	if sig.Frequency != "" && !sig.Frequency.Valid() {
+		return platformErrors.NewValidationError("sig.frequency", sig.Frequency, "unknown frequency code")
+	}
+	if sig.Route != "" && !sig.Route.Valid() {
+		return platformErrors.NewValidationError("sig.route", sig.Route, "unknown route")
+	}
+	if sig.Timing != "" && !sig.Timing.Valid() {
+		return platformErrors.NewValidationError("sig.timing", sig.Timing, "unknown timing")
+	}
+	if sig.DoseUnit != "" && !sig.DoseUnit.Valid() {
+		return platformErrors.NewValidationError("sig.dose_unit", sig.DoseUnit, "unknown dose unit")
+	}
+	if sig.DoseQuantity < 0 {
+		return platformErrors.NewValidationError("sig.dose_quantity", sig.DoseQuantity, "must not be negative")
+	}
+	if maxDose := sig.DoseUnit.MaxPerDose(); sig.DoseQuantity > maxDose {
+		return platformErrors.NewValidationError("sig.dose_quantity", sig.DoseQuantity,
+			fmt.Sprintf("exceeds the maximum of %g per dose", maxDose))
+	}
+	if sig.Indication != "" && !sig.AsNeeded {
+		return platformErrors.NewValidationError("sig.indication", sig.Indication, "an indication is only given for as-needed sigs")
+	}
+	if len(sig.Indication) > m.MaxSigIndicationLength {
+		return platformErrors.NewValidationError("sig.indication", sig.Indication,
+			fmt.Sprintf("must be at most %d characters", m.MaxSigIndicationLength))
+	}
+	return nil
+}
+
+// resolveDose checks the structured dose and writes its text, or reads the text when only that is
+// given. Free text equal to previousText is kept as is even when it cannot be read, so prescriptions
+// from before structured doses can still be updated; the backfill command reports them.
+func resolveDose(prescription *m.Prescription, previousText string) error {
+	if prescription.Dosage == nil {
+		if prescription.Dose == "" {
+			return platformErrors.NewValidationError("dose", "", "a dose is required")
+		}
+		dose, err := m.ParseDose(prescription.Dose)
+		if err != nil {
+			if prescription.Dose == previousText {
+				return nil
+			}
+			return platformErrors.NewValidationError("dose", prescription.Dose, err.Error())
+		}
+		prescription.Dosage = &dose
+	}
+
+	dose := prescription.Dosage
+	if !dose.Unit.Valid() {
+		return platformErrors.NewValidationError("dosage.unit", dose.Unit, "unknown dose unit")
+	}
+	if dose.Value <= 0 || dose.Value > m.MaxDoseValue {
+		return platformErrors.NewValidationError("dosage.value", dose.Value,
+			fmt.Sprintf("must be greater than 0 and at most %d", m.MaxDoseValue))
+	}
+	if dose.Frequency != "" && !dose.Frequency.Valid() {
+		return platformErrors.NewValidationError("dosage.frequency", dose.Frequency, "unknown frequency code")
+	}
+	if dose.Route != "" && !dose.Route.Valid() {
+		return platformErrors.NewValidationError("dosage.route", dose.Route, "unknown route")
+	}
+
+	// The dose and the sig describe the same administration
+	sig := prescription.Sig
+	if dose.Frequency != "" && sig.Frequency != "" && dose.Frequency != sig.Frequency {
+		return platformErrors.NewValidationError("dosage.frequency", dose.Frequency,
+			fmt.Sprintf("does not match the sig frequency %s", sig.Frequency))
+	}
+	if dose.Route != "" && sig.Route != "" && dose.Route != sig.Route {
+		return platformErrors.NewValidationError("dosage.route", dose.Route,
+			fmt.Sprintf("does not match the sig route %s", sig.Route))
+	}
+
+	prescription.Dose = dose.String()
+	return nil
+}
+
+// resolveSupply checks the sig, quantity and days supply against each other and computes the
+// expected end date. Without a days supply it is derived from the quantity when the sig has a
+// fixed frequency; for as-needed sigs the frequency is the most the patient may take.
+func resolveSupply(prescription *m.Prescription) error {
+	if err := checkSig(prescription.Sig); err != nil {
+		return err
+	}
+	if prescription.Quantity < 0 || prescription.Quantity > m.MaxQuantity {
+		return platformErrors.NewValidationError("quantity", prescription.Quantity,
+			fmt.Sprintf("must be between 1 and %d", m.MaxQuantity))
+	}
+	if prescription.DaysSupply < 0 || prescription.DaysSupply > m.MaxDaysSupply {
+		return platformErrors.NewValidationError("days_supply", prescription.DaysSupply,
+			fmt.Sprintf("must be between 1 and %d", m.MaxDaysSupply))
+	}
+	if prescription.DaysSupply > 0 && prescription.Quantity == 0 {
+		return platformErrors.NewValidationError("quantity", 0, "a quantity is required with a days supply")
+	}
+
+	sig := prescription.Sig
+	if prescription.Quantity > 0 && prescription.DaysSupply == 0 {
+		days, ok := sig.DaysCovered(prescription.Quantity)
+		if !ok {
+			return platformErrors.NewValidationError("days_supply", 0,
+				"a days supply is required unless the sig has a frequency")
+		}
+		if days == 0 {
+			return platformErrors.NewValidationError("quantity", prescription.Quantity,
+				fmt.Sprintf("does not cover one day of %q", sig.Render(m.LanguageEnglish)))
+		}
+		prescription.DaysSupply = min(days, m.MaxDaysSupply)
+	}
+	// An as-needed sig may use less than its maximum, so only scheduled sigs need the full amount
+	if needed, ok := sig.UnitsNeeded(prescription.DaysSupply); ok && !sig.AsNeeded && prescription.Quantity < needed {
+		return platformErrors.NewValidationError("quantity", prescription.Quantity,
+			fmt.Sprintf("%d days of %q need at least %d units", prescription.DaysSupply, sig.Render(m.LanguageEnglish), needed))
+	}
+
+	prescription.ExpectedEndDate = m.SupplyEndDate(prescription.CreatedAt, prescription.DaysSupply)
+	return nil
+}
+
+// checkAllergies compares the prescribed drug against the patient's recorded allergies. A catalog
+// drug matches an allergy to any of its names or to its drug class; other drugs match by name.
+func (s *svc) checkAllergies(ctx context.Context, prescription m.Prescription) ([]m.DrugAllergyWarning, error) {
+	warnings := []m.DrugAllergyWarning{}
+	if s.allergies == nil || prescription.PatientID == "" || prescription.Drug == "" {
+		return warnings, nil
+	}
+
+	allergies, err := s.allergies.PatientAllergyListByPatientID(ctx, prescription.PatientID)
+	if err != nil || len(allergies) == 0 {
+		return warnings, err
+	}
+
+	drug := m.Drug{Name: prescription.Drug}
+	if prescription.DrugID != "" && s.drugs != nil {
+		if drug, err = s.drugs.GetByID(ctx, prescription.DrugID); err != nil {
+			return warnings, err
+		}
+	}
+
+	for _, allergy := range allergies {
+		if !drug.MatchesAllergen(allergy.Substance) {
+			continue
+		}
+		warnings = append(warnings, m.DrugAllergyWarning{
+			Drug:      prescription.Drug,
+			AllergyID: allergy.ID,
+			Substance: allergy.Substance,
+			Reaction:  allergy.Reaction,
+			Severity:  allergy.Severity,
+		})
+	}
+	return warnings, nil
+}
+
+// checkInteractions compares newDrug against every prescription of the patient that is
+// still current, not completed or expired. excludeID skips the prescription currently being updated.
+func (s *svc) checkInteractions(ctx context.Context, patientID, newDrug, excludeID string) (m.InteractionCheckResult, error) {
+	result := m.InteractionCheckResult{Warnings: []m.DrugInteractionWarning{}}
+	if s.interactions == nil || patientID == "" || newDrug == "" {
+		return result, nil
+	}
+
+	known, err := s.interactions.ListByDrug(ctx, newDrug)
+	if err != nil {
+		return result, err
+	}
+	if len(known) == 0 {
+		return result, nil
+	}
+
+	current, err := s.repo.ListByPatientID(ctx, patientID)
+	if err != nil {
+		return result, err
+	}
+
+	for _, existing := range current {
+		if existing.ID == excludeID || existing.Status.Closed() {
+			continue
+		}
+		for _, interaction := range known {
+			if !interaction.Involves(newDrug, existing.Drug) {
+				continue
+			}
+			result.Warnings = append(result.Warnings, m.DrugInteractionWarning{
+				Drug:                      newDrug,
+				InteractingDrug:           existing.Drug,
+				InteractingPrescriptionID: existing.ID,
+				Severity:                  interaction.Severity,
+				Description:               interaction.Description,
+			})
+			if interaction.Severity == m.SeveritySevere {
+				result.Blocked = true
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (s *svc) ListInFlight(ctx context.Context, afterID string, limit int) ([]m.Prescription, error) {
+	return s.repo.ListInFlight(ctx, afterID, limit)
+}
+
+func (s *svc) ListForPatient(ctx context.Context, patientID string, status m.Status, limit int) ([]m.Prescription, error) {
+	if limit <= 0 {
+		limit = defaultPatientPrescriptionLimit
+	}
+	limit = min(limit, maxPatientPrescriptionLimit)
+	return s.repo.ListForPatient(ctx, patientID, status, limit)
+}
+
+func (s *svc) ListByPrescriber(ctx context.Context, prescribedBy string, limit int) ([]m.Prescription, error) {
+	return s.repo.ListByPrescriber(ctx, prescribedBy, limit)
+}
+
+func (s *svc) UpdateFulfillmentStatus(ctx context.Context, id string, status m.FulfillmentStatus) error {
+	if err := s.repo.UpdateFulfillmentStatus(ctx, id, status, time.Now()); err != nil {
+		s.log.Error("Failed to update fulfillment status",
+			zap.String("prescription_id", id),
+			zap.Error(err))
+		return err
+	}
+
+	// Invalidate cache for this prescription
+	if s.cache != nil {
+		if err := s.cache.Delete(ctx, s.cacheKeys.PrescriptionByID(id)); err != nil {
+			s.log.Warn("Failed to invalidate prescription cache",
+				zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+func (s *svc) SearchPharmacies(ctx context.Context, zip, state string, limit int) (m.PharmacySearchResult, error) {
+	resp, err := s.pharmacy.SearchPharmacies(ctx, irispharmacy.PharmacySearchRequest{Zip: zip, State: state, Limit: limit})
+	if err != nil {
+		s.log.Error("Failed to search pharmacies",
+			zap.String("zip", zip),
+			zap.String("state", state),
+			zap.Error(err))
+		return m.PharmacySearchResult{}, err
+	}
+
+	result := m.PharmacySearchResult{
+		Pharmacies: make([]m.NetworkPharmacy, 0, len(resp.Pharmacies)),
+		Total:      resp.Total,
+	}
+	for _, pharmacy := range resp.Pharmacies {
+		result.Pharmacies = append(result.Pharmacies, m.NetworkPharmacy{
+			ID:                     pharmacy.ID,
+			Name:                   pharmacy.Name,
+			Type:                   pharmacy.Type,
+			Address:                pharmacy.Address,
+			City:                   pharmacy.City,
+			State:                  pharmacy.State,
+			Zip:                    pharmacy.Zip,
+			Phone:                  pharmacy.Phone,
+			AcceptingPrescriptions: pharmacy.AcceptingPrescriptions,
+		})
+	}
+	return result, nil
+}
+
+func (s *svc) RouteToPharmacy(ctx context.Context, id, pharmacyID string) (m.Prescription, error) {
+	prescription, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return m.Prescription{}, err
+	}
+	if prescription.ID == "" {
+		return m.Prescription{}, platformErrors.NewRecordNotFoundError("prescription", id)
+	}
+	if prescription.Status != m.Active {
+		return m.Prescription{}, platformErrors.NewBusinessLogicError("RoutePrescription",
+			fmt.Sprintf("only active prescriptions can be routed (status is %s)", prescription.Status))
+	}
+	if !prescription.FulfillmentStatus.CanReroute() {
+		return m.Prescription{}, platformErrors.NewBusinessLogicError("RoutePrescription",
+			fmt.Sprintf("the pharmacy has already started filling the prescription (fulfillment status is %s)", prescription.FulfillmentStatus))
+	}
+
+	pharmacy, err := s.pharmacy.GetPharmacy(ctx, pharmacyID)
+	if err != nil {
+		return m.Prescription{}, s.pharmacyError(pharmacyID, err)
+	}
+	if !pharmacy.AcceptingPrescriptions {
+		return m.Prescription{}, s.pharmacyError(pharmacyID, irispharmacy.ErrPharmacyUnavailable)
+	}
+
+	routed, err := s.pharmacy.RoutePrescription(ctx, id, irispharmacy.RoutePrescriptionRequest{
+		PharmacyID: pharmacy.ID,
+		PatientID:  prescription.PatientID,
+		Drug:       prescription.Drug,
+		Dose:       prescription.Dose,
+	})
+	if err != nil {
+		return m.Prescription{}, s.pharmacyError(pharmacyID, err)
+	}
+
+	// The route itself succeeded, so an unrecognized vendor status still means the pharmacy has it
+	status, ok := m.MapVendorFulfillmentStatus(routed.Status)
+	if !ok {
+		status = m.FulfillmentSent
+	}
+	selected := m.Pharmacy{
+		ID:       pharmacy.ID,
+		Name:     pharmacy.Name,
+		Type:     pharmacy.Type,
+		Address:  pharmacy.Address,
+		City:     pharmacy.City,
+		State:    pharmacy.State,
+		Zip:      pharmacy.Zip,
+		Phone:    pharmacy.Phone,
+		RoutedAt: time.Now().UTC(),
+	}
+	if err := s.repo.UpdatePharmacy(ctx, id, selected, status); err != nil {
+		s.log.Error("Failed to save routed pharmacy",
+			zap.String("prescription_id", id),
+			zap.String("pharmacy_id", pharmacy.ID),
+			zap.Error(err))
+		return m.Prescription{}, err
+	}
+
+	if s.cache != nil {
+		if err := s.cache.Delete(ctx, s.cacheKeys.PrescriptionByID(id)); err != nil {
+			s.log.Warn("Failed to invalidate prescription cache",
+				zap.Error(err))
+		}
+	}
+
+	s.log.Info("Prescription routed to pharmacy",
+		zap.String("prescription_id", id),
+		zap.String("pharmacy_id", pharmacy.ID),
+		zap.String("fulfillment_status", string(status)))
+
+	event := m.PrescriptionHistoryEvent{
+		PrescriptionID: id,
+		Type:           m.HistoryRouted,
+		At:             selected.RoutedAt,
+		PharmacyID:     selected.ID,
+		PharmacyName:   selected.Name,
+	}
+	if prescription.Pharmacy != nil {
+		event.PreviousPharmacyID = prescription.Pharmacy.ID
+	}
+	s.history.Record(ctx, event)
+
+	prescription.Pharmacy = &selected
+	prescription.FulfillmentStatus = status
+	prescription.FulfillmentUpdatedAt = &selected.RoutedAt
+	return prescription, nil
+}
+
+// pharmacyError maps pharmacy client errors to errors the API can report
+func (s *svc) pharmacyError(pharmacyID string, err error) error {
+	switch {
+	case errors.Is(err, irispharmacy.ErrPharmacyNotFound):
+		return platformErrors.NewValidationError("pharmacy_id", pharmacyID, "pharmacy not found in the network")
+	case errors.Is(err, irispharmacy.ErrPharmacyUnavailable):
+		return platformErrors.NewBusinessLogicError("RoutePrescription", "the pharmacy is not accepting prescriptions")
+	}
+	s.log.Error("Failed to route prescription",
+		zap.String("pharmacy_id", pharmacyID),
+		zap.Error(err))
+	return err
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	repo "pharmacy-modernization-project-model/domain/prescription/repository"
+)
+
+const testPatientID = "P900"
+
+// newListService returns a service over a memory repository holding count prescriptions for
+// testPatientID, created a minute apart with R900000 the oldest; every third one is Completed
+// and the rest are Active
+func newListService(t *testing.T, count int) PrescriptionService {
+	t.Helper()
+	r := repo.NewPrescriptionMemoryRepository()
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := range count {
+		status := m.Active
+		if i%3 == 0 {
+			status = m.Completed
+		}
+		_, err := r.Create(context.Background(), m.Prescription{
+			ID:        fmt.Sprintf("R9%05d", i),
+			PatientID: testPatientID,
+			Drug:      "Amoxicillin",
+			Status:    status,
+			CreatedAt: base.Add(time.Duration(i) * time.Minute),
+		})
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+	}
+	return New(r, nil, nil, nil, nil, nil, nil, zap.NewNop(), nil, nil, nil, nil)
+}
+
+func TestListForPatientFiltersByStatus(t *testing.T) {
+	svc := newListService(t, 9)
+
+	for _, tc := range []struct {
+		status m.Status
+		want   []string
+	}{
+		{m.Completed, []string{"R900006", "R900003", "R900000"}},
+		{m.Active, []string{"R900008", "R900007", "R900005", "R900004", "R900002", "R900001"}},
+		{m.Paused, nil},
+		{"", []string{"R900008", "R900007", "R900006", "R900005", "R900004", "R900003", "R900002", "R900001", "R900000"}},
+	} {
+		got, err := svc.ListForPatient(context.Background(), testPatientID, tc.status, 0)
+		if err != nil {
+			t.Fatalf("ListForPatient(%q): %v", tc.status, err)
+		}
+		if ids := idsOf(got); fmt.Sprint(ids) != fmt.Sprint(tc.want) {
+			t.Errorf("ListForPatient(%q) = %v, want %v", tc.status, ids, tc.want)
+		}
+	}
+}
+
+func TestListForPatientLimit(t *testing.T) {
+	svc := newListService(t, 9)
+
+	for _, tc := range []struct {
+		status m.Status
+		limit  int
+		want   []string
+	}{
+		{"", 1, []string{"R900008"}},
+		{"", 3, []string{"R900008", "R900007", "R900006"}},
+		{m.Completed, 2, []string{"R900006", "R900003"}},
+		{m.Completed, 50, []string{"R900006", "R900003", "R900000"}},
+	} {
+		got, err := svc.ListForPatient(context.Background(), testPatientID, tc.status, tc.limit)
+		if err != nil {
+			t.Fatalf("ListForPatient(%q, %d): %v", tc.status, tc.limit, err)
+		}
+		if ids := idsOf(got); fmt.Sprint(ids) != fmt.Sprint(tc.want) {
+			t.Errorf("ListForPatient(%q, %d) = %v, want %v", tc.status, tc.limit, ids, tc.want)
+		}
+	}
+}
+
+func TestListForPatientDefaultsAndCapsLimit(t *testing.T) {
+	svc := newListService(t, maxPatientPrescriptionLimit+50)
+
+	for _, tc := range []struct {
+		limit int
+		want  int
+	}{
+		{0, defaultPatientPrescriptionLimit},
+		{-1, defaultPatientPrescriptionLimit},
+		{maxPatientPrescriptionLimit + 1, maxPatientPrescriptionLimit},
+	} {
+		got, err := svc.ListForPatient(context.Background(), testPatientID, "", tc.limit)
+		if err != nil {
+			t.Fatalf("ListForPatient(%d): %v", tc.limit, err)
+		}
+		if len(got) != tc.want {
+			t.Errorf("ListForPatient(%d) returned %d prescriptions, want %d", tc.limit, len(got), tc.want)
+		}
+		if len(got) > 0 && got[0].ID != fmt.Sprintf("R9%05d", maxPatientPrescriptionLimit+49) {
+			t.Errorf("ListForPatient(%d) starts at %s, want the newest", tc.limit, got[0].ID)
+		}
+	}
+}
+
+func idsOf(prescriptions []m.Prescription) []string {
+	var ids []string
+	for _, p := range prescriptions {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
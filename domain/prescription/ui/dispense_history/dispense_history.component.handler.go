@@ -0,0 +1,102 @@
+package dispense_history
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	commonmodel "pharmacy-modernization-project-model/domain/common/model"
+	commonsecurity "pharmacy-modernization-project-model/domain/common/security"
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/domain/prescription/contracts/request"
+	presSvc "pharmacy-modernization-project-model/domain/prescription/service"
+	"pharmacy-modernization-project-model/domain/prescription/ui/paths"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/navigation"
+)
+
+type DispenseHistoryHandler struct {
+	prescriptionsService presSvc.PrescriptionService
+	dispenseService      presSvc.DispenseService
+	nav                  *navigation.BackStack
+	log                  *zap.Logger
+}
+
+func NewDispenseHistoryHandler(prescriptions presSvc.PrescriptionService, dispenses presSvc.DispenseService, nav *navigation.BackStack, log *zap.Logger) *DispenseHistoryHandler {
+	return &DispenseHistoryHandler{prescriptionsService: prescriptions, dispenseService: dispenses, nav: nav, log: log}
+}
+
+func (h *DispenseHistoryHandler) Handler(w http.ResponseWriter, r *http.Request) {
+	pathVars, _, err := bind.ChiPath[request.PrescriptionPathVars](r, chi.URLParam)
+	if err != nil {
+		h.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.WriteUIError(w, "Invalid prescription ID", http.StatusBadRequest)
+		return
+	}
+
+	prescription, err := h.prescriptionsService.GetByID(r.Context(), pathVars.PrescriptionID)
+	if err != nil {
+		h.log.Error("failed to load prescription", zap.Error(err))
+		helper.WriteUIInternalError(w, "Failed to load prescription")
+		return
+	}
+	if prescription.ID == "" {
+		helper.WriteUINotFound(w, "Prescription not found")
+		return
+	}
+
+	dispenses, err := h.dispenseService.ListByPrescription(r.Context(), prescription.ID)
+	if err != nil {
+		h.log.Error("failed to load dispense history", zap.Error(err))
+		helper.WriteUIInternalError(w, "Failed to load dispense history")
+		return
+	}
+
+	page := DispenseHistoryPageComponent(DispenseHistoryPageParam{
+		Prescription:  prescription,
+		Dispenses:     dispenses,
+		Adherence:     adherence(dispenses),
+		PriceEstimate: h.priceEstimate(r.Context(), prescription.ID),
+		Trail:         h.nav.Visit(w, r, prescription.Drug+" Dispenses", navigation.Crumb{Label: "Prescriptions", Path: paths.PrescriptionListURL()}),
+	})
+	if err := page.Render(r.Context(), w); err != nil {
+		h.log.Error("failed to render dispense history", zap.Error(err))
+		helper.WriteUIInternalError(w, "Failed to render dispense history")
+		return
+	}
+}
+
+// priceEstimate returns the prescription's expected cost for users who may see billing data; the
+// page is shown without it when it cannot be estimated
+func (h *DispenseHistoryHandler) priceEstimate(ctx context.Context, prescriptionID string) *commonmodel.PrescriptionPriceEstimate {
+	if !auth.HasAnyPermissionCtx(ctx, commonsecurity.BillingReadAccess) {
+		return nil
+	}
+	estimate, err := h.prescriptionsService.PriceEstimate(ctx, prescriptionID)
+	if err != nil {
+		h.log.Warn("failed to estimate prescription price", zap.String("prescription_id", prescriptionID), zap.Error(err))
+		return nil
+	}
+	return estimate
+}
+
+// adherence measures the days covered from the first dispense with a days supply until today
+func adherence(dispenses []m.DispenseRecord) *m.Adherence {
+	var first time.Time
+	for _, d := range dispenses {
+		if d.DaysSupply > 0 && !d.IsReversal() && !d.IsReversed() && (first.IsZero() || d.DispensedAt.Before(first)) {
+			first = d.DispensedAt
+		}
+	}
+	if first.IsZero() {
+		return nil
+	}
+	// Today counts as covered when a supply reaches it
+	result := m.CalculateAdherence(dispenses, first, time.Now().AddDate(0, 0, 1))
+	return &result
+}
@@ -0,0 +1,92 @@
+package dispense_receipt
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/prescription/contracts/request"
+	presSvc "pharmacy-modernization-project-model/domain/prescription/service"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/attachments"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+type DispenseReceiptHandler struct {
+	dispenseService presSvc.DispenseService
+	log             *zap.Logger
+}
+
+func NewDispenseReceiptHandler(dispenses presSvc.DispenseService, log *zap.Logger) *DispenseReceiptHandler {
+	return &DispenseReceiptHandler{dispenseService: dispenses, log: log}
+}
+
+// Handler renders the printable dispense receipt, including the pickup signature when captured
+func (h *DispenseReceiptHandler) Handler(w http.ResponseWriter, r *http.Request) {
+	pathVars, _, err := bind.ChiPath[request.DispensePathVars](r, chi.URLParam)
+	if err != nil {
+		h.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.WriteUIError(w, "Invalid dispense", http.StatusBadRequest)
+		return
+	}
+
+	dispense, err := h.dispenseService.GetByID(r.Context(), pathVars.PrescriptionID, pathVars.DispenseID)
+	if err != nil {
+		h.writeError(w, err, "Failed to load dispense")
+		return
+	}
+
+	param := DispenseReceiptPageParam{Dispense: dispense}
+	if dispense.Signature != nil {
+		_, content, err := h.dispenseService.Signature(r.Context(), pathVars.PrescriptionID, pathVars.DispenseID)
+		switch {
+		case errors.Is(err, attachments.ErrIntegrity):
+			// Still print the receipt, flagged, so the discrepancy is visible
+			param.SignatureStatus = SignatureTampered
+		case err != nil:
+			h.writeError(w, err, "Failed to load pickup signature")
+			return
+		default:
+			param.SignatureStatus = SignatureVerified
+			param.TypedSignature = string(content)
+		}
+	}
+
+	if err := DispenseReceiptPage(param).Render(r.Context(), w); err != nil {
+		h.log.Error("failed to render dispense receipt", zap.Error(err))
+		helper.WriteUIInternalError(w, "Failed to render dispense receipt")
+		return
+	}
+}
+
+// Signature serves the pickup signature for the history view and the receipt
+func (h *DispenseReceiptHandler) Signature(w http.ResponseWriter, r *http.Request) {
+	pathVars, _, err := bind.ChiPath[request.DispensePathVars](r, chi.URLParam)
+	if err != nil {
+		helper.WriteUIError(w, "Invalid dispense", http.StatusBadRequest)
+		return
+	}
+
+	signature, content, err := h.dispenseService.Signature(r.Context(), pathVars.PrescriptionID, pathVars.DispenseID)
+	if err != nil {
+		h.writeError(w, err, "Failed to load pickup signature")
+		return
+	}
+	w.Header().Set("Content-Type", signature.ContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "private, no-store")
+	_, _ = w.Write(content)
+}
+
+func (h *DispenseReceiptHandler) writeError(w http.ResponseWriter, err error, message string) {
+	var notFound platformErrors.RecordNotFoundError
+	if errors.As(err, &notFound) {
+		helper.WriteUINotFound(w, "Dispense not found")
+		return
+	}
+	h.log.Error(message, zap.Error(err))
+	helper.WriteUIInternalError(w, message)
+}
@@ -1,14 +1,39 @@
 package paths
 
+import platformpaths "pharmacy-modernization-project-model/internal/platform/paths"
+
 const (
 	// Base path for prescription domain
 	BasePath = "/prescriptions"
 
 	// UI Routes
 	ListPath = BasePath + "/"
+	NewPath  = BasePath + "/new"
+
+	// Relative route patterns (for use within Route() blocks)
+	ListRoute = "/"
+	NewRoute  = "/new"
+
+	// Dispense history (relative to BasePath and APIPath)
+	DispensesSubRoute      = "/{prescriptionID}/dispenses"
+	DispenseReceiptRoute   = "/{dispenseID}/receipt"
+	DispenseSignatureRoute = "/{dispenseID}/signature"
+
+	// Prescription drafts autosaved by the create and edit pages (relative to APIPath)
+	DraftsSubRoute = "/drafts"
+
+	// Drug catalog (relative to BasePath and APIPath)
+	DrugsSubRoute         = "/drugs"
+	DrugAutocompleteRoute = "/autocomplete"
+	DrugSuggestionsRoute  = "/suggestions"
+
+	// Prescribers, with a detail page listing their prescriptions
+	PrescribersBasePath = "/prescribers"
+	PrescriberRoute     = "/{prescriberID}"
 
 	// API paths
-	APIPath = "/api/v1/prescriptions"
+	APIPath            = platformpaths.APIV1Path + "/prescriptions"
+	PrescribersAPIPath = platformpaths.APIV1Path + "/prescribers"
 )
 
 // Helper functions for path generation
@@ -16,6 +41,30 @@ func PrescriptionListURL() string {
 	return ListPath
 }
 
+func PrescriptionNewURL() string {
+	return NewPath
+}
+
+func DispenseHistoryURL(prescriptionID string) string {
+	return BasePath + "/" + prescriptionID + "/dispenses"
+}
+
+func DispenseReceiptURL(prescriptionID, dispenseID string) string {
+	return DispenseHistoryURL(prescriptionID) + "/" + dispenseID + "/receipt"
+}
+
+func DispenseSignatureURL(prescriptionID, dispenseID string) string {
+	return DispenseHistoryURL(prescriptionID) + "/" + dispenseID + "/signature"
+}
+
+func DrugSuggestionsURL() string {
+	return BasePath + DrugsSubRoute + DrugSuggestionsRoute
+}
+
+func PrescriberURL(prescriberID string) string {
+	return PrescribersBasePath + "/" + prescriberID
+}
+
 func PrescriptionAPIURL() string {
 	return APIPath
 }
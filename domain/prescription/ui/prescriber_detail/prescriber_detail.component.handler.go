@@ -0,0 +1,70 @@
+package prescriber_detail
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/prescription/contracts/request"
+	presSvc "pharmacy-modernization-project-model/domain/prescription/service"
+	"pharmacy-modernization-project-model/domain/prescription/ui/paths"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/navigation"
+)
+
+// prescriptionLimit caps the prescriptions listed on the page, newest first
+const prescriptionLimit = 100
+
+type PrescriberDetailHandler struct {
+	prescriberService presSvc.PrescriberService
+	nav               *navigation.BackStack
+	log               *zap.Logger
+}
+
+func NewPrescriberDetailHandler(prescribers presSvc.PrescriberService, nav *navigation.BackStack, log *zap.Logger) *PrescriberDetailHandler {
+	return &PrescriberDetailHandler{prescriberService: prescribers, nav: nav, log: log}
+}
+
+func (h *PrescriberDetailHandler) Handler(w http.ResponseWriter, r *http.Request) {
+	pathVars, _, err := bind.ChiPath[request.PrescriberPathVars](r, chi.URLParam)
+	if err != nil {
+		h.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.WriteUIError(w, "Invalid prescriber ID", http.StatusBadRequest)
+		return
+	}
+
+	prescriber, err := h.prescriberService.GetByID(r.Context(), pathVars.PrescriberID)
+	if err != nil {
+		var notFound platformErrors.RecordNotFoundError
+		if errors.As(err, &notFound) {
+			helper.WriteUINotFound(w, "Prescriber not found")
+			return
+		}
+		h.log.Error("failed to load prescriber", zap.Error(err))
+		helper.WriteUIInternalError(w, "Failed to load prescriber")
+		return
+	}
+
+	prescriptions, err := h.prescriberService.Prescriptions(r.Context(), prescriber.ID, prescriptionLimit)
+	if err != nil {
+		h.log.Error("failed to load prescriber prescriptions", zap.Error(err))
+		helper.WriteUIInternalError(w, "Failed to load prescriptions")
+		return
+	}
+
+	page := PrescriberDetailPageComponent(PrescriberDetailPageParam{
+		Prescriber:    prescriber,
+		Prescriptions: prescriptions,
+		Limit:         prescriptionLimit,
+		Trail:         h.nav.Visit(w, r, prescriber.DisplayName(), navigation.Crumb{Label: "Prescriptions", Path: paths.PrescriptionListURL()}),
+	})
+	if err := page.Render(r.Context(), w); err != nil {
+		h.log.Error("failed to render prescriber detail", zap.Error(err))
+		helper.WriteUIInternalError(w, "Failed to render prescriber detail")
+		return
+	}
+}
@@ -0,0 +1,255 @@
+package prescription_create
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/domain/prescription/contracts/request"
+	prescriptionErrors "pharmacy-modernization-project-model/domain/prescription/errors"
+	presSvc "pharmacy-modernization-project-model/domain/prescription/service"
+	"pharmacy-modernization-project-model/domain/prescription/ui/paths"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/navigation"
+)
+
+// PrescriptionFormData represents the form data for the prescription create page
+type PrescriptionFormData struct {
+	PatientID    string
+	PrescriberID string
+	Drug         string
+	Status       string
+	// Dose, sig and supply fields are kept as entered so an invalid value is shown again
+	DoseValue       string
+	DoseUnit        string
+	SigDoseQuantity string
+	SigDoseUnit     string
+	SigRoute        string
+	SigFrequency    string
+	SigTiming       string
+	SigAsNeeded     bool
+	SigIndication   string
+	Quantity        string
+	DaysSupply      string
+	Errors          map[string]string
+}
+
+// supplyFormFields maps the fields of dose, sig and supply validation errors to form fields
+var supplyFormFields = map[string]string{
+	"dosage.value":      "DoseValue",
+	"dosage.unit":       "DoseUnit",
+	"dosage.route":      "SigRoute",
+	"dosage.frequency":  "SigFrequency",
+	"sig.dose_quantity": "SigDoseQuantity",
+	"sig.dose_unit":     "SigDoseUnit",
+	"sig.route":         "SigRoute",
+	"sig.frequency":     "SigFrequency",
+	"sig.timing":        "SigTiming",
+	"sig.indication":    "SigIndication",
+	"quantity":          "Quantity",
+	"days_supply":       "DaysSupply",
+	"prescriber_id":     "PrescriberID",
+}
+
+// selectOption is one choice of a select field
+type selectOption struct {
+	Value string
+	Label string
+}
+
+var (
+	strengthUnitOptions = sigOptions(model.StrengthUnits, func(u model.StrengthUnit) string { return string(u) })
+	doseUnitOptions     = sigOptions(model.DoseUnits, func(u model.DoseUnit) string { return string(u) })
+	routeOptions        = sigOptions(model.Routes, func(r model.Route) string { return string(r) + " (" + r.Text(model.LanguageEnglish) + ")" })
+	frequencyOptions    = sigOptions(model.Frequencies, func(f model.Frequency) string { return string(f) + " (" + f.Text(model.LanguageEnglish) + ")" })
+	timingOptions       = sigOptions(model.SigTimings, func(t model.SigTiming) string { return t.Text(model.LanguageEnglish) })
+)
+
+func sigOptions[T ~string](values []T, label func(T) string) []selectOption {
+	options := make([]selectOption, 0, len(values))
+	for _, v := range values {
+		options = append(options, selectOption{Value: string(v), Label: label(v)})
+	}
+	return options
+}
+
+type PrescriptionCreateComponent struct {
+	prescriptionsService presSvc.PrescriptionService
+	drugCatalog          presSvc.DrugCatalogService
+	prescribers          presSvc.PrescriberService
+	nav                  *navigation.BackStack
+	log                  *zap.Logger
+}
+
+func NewPrescriptionCreateComponent(prescriptions presSvc.PrescriptionService, drugCatalog presSvc.DrugCatalogService, prescribers presSvc.PrescriberService, nav *navigation.BackStack, log *zap.Logger) *PrescriptionCreateComponent {
+	return &PrescriptionCreateComponent{prescriptionsService: prescriptions, drugCatalog: drugCatalog, prescribers: prescribers, nav: nav, log: log}
+}
+
+// ShowCreateForm handles GET requests to show the create form
+func (h *PrescriptionCreateComponent) ShowCreateForm(w http.ResponseWriter, r *http.Request) {
+	h.render(w, r, PrescriptionCreatePageParam{
+		FormData: PrescriptionFormData{
+			PatientID:    r.URL.Query().Get("patientId"),
+			PrescriberID: r.URL.Query().Get("prescriberId"),
+			Status:       string(model.Draft),
+		},
+	})
+}
+
+// HandleFormSubmission handles POST requests to create the prescription
+func (h *PrescriptionCreateComponent) HandleFormSubmission(w http.ResponseWriter, r *http.Request) {
+	formReq, fieldErrors, err := bind.Form[request.PrescriptionCreateFormRequest](r)
+	formData := PrescriptionFormData{
+		PatientID:    formReq.PatientID,
+		PrescriberID: formReq.PrescriberID,
+		Drug:         formReq.Drug,
+		Status:       formReq.Status,
+
+		DoseValue:       r.PostFormValue("doseValue"),
+		DoseUnit:        formReq.DoseUnit,
+		SigDoseQuantity: r.PostFormValue("sigDoseQuantity"),
+		SigDoseUnit:     formReq.SigDoseUnit,
+		SigRoute:        formReq.SigRoute,
+		SigFrequency:    formReq.SigFrequency,
+		SigTiming:       formReq.SigTiming,
+		SigAsNeeded:     formReq.SigAsNeeded,
+		SigIndication:   formReq.SigIndication,
+		Quantity:        r.PostFormValue("quantity"),
+		DaysSupply:      r.PostFormValue("daysSupply"),
+	}
+	if err != nil {
+		h.log.Error("failed to bind form data", zap.Error(err))
+		formData.Errors = helper.ConvertFieldErrorsToUIErrors(r.Context(), fieldErrors)
+		h.render(w, r, PrescriptionCreatePageParam{FormData: formData})
+		return
+	}
+
+	created, err := h.prescriptionsService.Create(r.Context(), model.Prescription{
+		PatientID:    formReq.PatientID,
+		PrescriberID: formReq.PrescriberID,
+		Drug:         formReq.Drug,
+		Dosage:       formReq.Dosage(),
+		Status:       model.Status(formReq.Status),
+
+		Sig:        formReq.Sig(),
+		Quantity:   formReq.Quantity,
+		DaysSupply: formReq.DaysSupply,
+	})
+	if err != nil {
+		var allergyErr prescriptionErrors.DrugAllergyError
+		if errors.As(err, &allergyErr) {
+			formData.Errors = map[string]string{"general": "This prescription was blocked because the patient is allergic to the drug."}
+			h.render(w, r, PrescriptionCreatePageParam{FormData: formData, AllergyWarnings: allergyErr.Warnings, Blocked: true})
+			return
+		}
+		var interactionErr prescriptionErrors.SevereInteractionError
+		if errors.As(err, &interactionErr) {
+			formData.Errors = map[string]string{"general": "This prescription was blocked because of a severe drug interaction."}
+			h.render(w, r, PrescriptionCreatePageParam{FormData: formData, Warnings: interactionErr.Warnings, Blocked: true})
+			return
+		}
+		var validationErr prescriptionErrors.ValidationError
+		if errors.As(err, &validationErr) && supplyFormFields[validationErr.Field] != "" {
+			formData.Errors = map[string]string{supplyFormFields[validationErr.Field]: validationErr.Message}
+			h.render(w, r, PrescriptionCreatePageParam{FormData: formData})
+			return
+		}
+
+		h.log.Error("failed to create prescription", zap.Error(err))
+		formData.Errors = map[string]string{"general": "Failed to create prescription. Please try again."}
+		h.render(w, r, PrescriptionCreatePageParam{FormData: formData})
+		return
+	}
+
+	// No warnings - go back to the list
+	if len(created.InteractionWarnings) == 0 {
+		http.Redirect(w, r, paths.PrescriptionListURL(), http.StatusSeeOther)
+		return
+	}
+
+	// Created with non-blocking warnings - show them to the prescriber
+	h.render(w, r, PrescriptionCreatePageParam{
+		FormData: PrescriptionFormData{Status: string(model.Draft)},
+		Created:  &created,
+		Warnings: created.InteractionWarnings,
+	})
+}
+
+// DrugSuggestions renders the datalist options for the drug field as the prescriber types
+func (h *PrescriptionCreateComponent) DrugSuggestions(w http.ResponseWriter, r *http.Request) {
+	query := strings.TrimSpace(r.URL.Query().Get("drug"))
+	suggestions := []model.DrugSuggestion{}
+	if len(query) >= 2 && len(query) <= 100 {
+		found, err := h.drugCatalog.Autocomplete(r.Context(), query, 0)
+		if err != nil {
+			h.log.Error("failed to search drug catalog", zap.Error(err))
+		} else {
+			suggestions = found
+		}
+	}
+
+	if err := DrugSuggestionOptions(suggestions).Render(r.Context(), w); err != nil {
+		h.log.Error("failed to render drug suggestions", zap.Error(err))
+		helper.WriteUIInternalError(w, "Failed to render drug suggestions")
+	}
+}
+
+func (h *PrescriptionCreateComponent) render(w http.ResponseWriter, r *http.Request, param PrescriptionCreatePageParam) {
+	param.Trail = h.nav.Visit(w, r, "New Prescription", navigation.Crumb{Label: "Prescriptions", Path: paths.PrescriptionListURL()})
+	param.SubmitPath = paths.PrescriptionNewURL()
+	param.DrugSuggestionsPath = paths.DrugSuggestionsURL()
+	param.PrescriberOptions = h.prescriberOptions(r)
+
+	view := PrescriptionCreatePageComponentView(param)
+	if err := view.Render(r.Context(), w); err != nil {
+		h.log.Error("failed to render prescription create", zap.Error(err))
+		helper.WriteUIInternalError(w, "Failed to render prescription create page")
+		return
+	}
+}
+
+// prescriberOptions lists the prescribers by name; the form still renders when they fail to load
+func (h *PrescriptionCreateComponent) prescriberOptions(r *http.Request) []selectOption {
+	prescribers, err := h.prescribers.List(r.Context(), "", 0, 0)
+	if err != nil {
+		h.log.Error("failed to load prescribers", zap.Error(err))
+		return nil
+	}
+	options := make([]selectOption, 0, len(prescribers))
+	for _, p := range prescribers {
+		label := p.DisplayName() + " · NPI " + p.NPI
+		if p.Specialty != "" {
+			label += " · " + p.Specialty
+		}
+		options = append(options, selectOption{Value: p.ID, Label: label})
+	}
+	return options
+}
+
+// supplyLabel summarizes the supply of a created prescription, e.g. "60 units · 30 days until Mar 3, 2026"
+func supplyLabel(p model.Prescription) string {
+	if p.Quantity == 0 {
+		return ""
+	}
+	label := fmt.Sprintf("%d units", p.Quantity)
+	if p.DaysSupply > 0 {
+		label += fmt.Sprintf(" · %d days", p.DaysSupply)
+	}
+	if p.ExpectedEndDate != nil {
+		label += " until " + helper.FormatShortDate(*p.ExpectedEndDate)
+	}
+	return label
+}
+
+// drugLabel shows the canonical name, followed by the name as entered when that was a brand or synonym
+func drugLabel(p model.Prescription) string {
+	if p.DrugEntered == "" || model.NormalizeDrugName(p.DrugEntered) == model.NormalizeDrugName(p.Drug) {
+		return p.Drug
+	}
+	return p.Drug + " (entered as " + p.DrugEntered + ")"
+}
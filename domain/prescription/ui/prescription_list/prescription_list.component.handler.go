@@ -5,17 +5,19 @@ import (
 
 	presSvc "pharmacy-modernization-project-model/domain/prescription/service"
 	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/navigation"
 
 	"go.uber.org/zap"
 )
 
 type PrescriptionListHandler struct {
 	prescriptionsService presSvc.PrescriptionService
+	nav                  *navigation.BackStack
 	log                  *zap.Logger
 }
 
-func NewPrescriptionListHandler(prescriptions presSvc.PrescriptionService, log *zap.Logger) *PrescriptionListHandler {
-	return &PrescriptionListHandler{prescriptionsService: prescriptions, log: log}
+func NewPrescriptionListHandler(prescriptions presSvc.PrescriptionService, nav *navigation.BackStack, log *zap.Logger) *PrescriptionListHandler {
+	return &PrescriptionListHandler{prescriptionsService: prescriptions, nav: nav, log: log}
 }
 
 func (h *PrescriptionListHandler) Handler(w http.ResponseWriter, r *http.Request) {
@@ -26,6 +28,8 @@ func (h *PrescriptionListHandler) Handler(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	h.nav.Root(w, r, "Prescriptions")
+
 	page := PrescriptionListPageComponent(PrescriptionListPageParam{
 		NumberOfPrescriptions: len(prescriptions),
 	})
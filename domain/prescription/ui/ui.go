@@ -2,7 +2,11 @@ package prescription
 
 import (
 	presSvc "pharmacy-modernization-project-model/domain/prescription/service"
+	dispenseHistory "pharmacy-modernization-project-model/domain/prescription/ui/dispense_history"
+	dispenseReceipt "pharmacy-modernization-project-model/domain/prescription/ui/dispense_receipt"
 	"pharmacy-modernization-project-model/domain/prescription/ui/paths"
+	prescriberDetail "pharmacy-modernization-project-model/domain/prescription/ui/prescriber_detail"
+	prescriptionCreate "pharmacy-modernization-project-model/domain/prescription/ui/prescription_create"
 	prescriptionList "pharmacy-modernization-project-model/domain/prescription/ui/prescription_list"
 
 	"github.com/go-chi/chi/v5"
@@ -10,15 +14,24 @@ import (
 
 	prescriptionsecurity "pharmacy-modernization-project-model/domain/prescription/security"
 	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/navigation"
 )
 
 type PrescriptionDependencies struct {
 	PrescriptionSvc presSvc.PrescriptionService
+	DispenseSvc     presSvc.DispenseService
+	DrugCatalogSvc  presSvc.DrugCatalogService
+	PrescriberSvc   presSvc.PrescriberService
+	Navigation      *navigation.BackStack // Back links and breadcrumbs; nil uses the default parents
 	Log             *zap.Logger
 }
 
 func MountUI(r chi.Router, deps *PrescriptionDependencies) {
-	prescriptionListHandler := prescriptionList.NewPrescriptionListHandler(deps.PrescriptionSvc, deps.Log)
+	prescriptionListHandler := prescriptionList.NewPrescriptionListHandler(deps.PrescriptionSvc, deps.Navigation, deps.Log)
+	prescriptionCreateComponent := prescriptionCreate.NewPrescriptionCreateComponent(deps.PrescriptionSvc, deps.DrugCatalogSvc, deps.PrescriberSvc, deps.Navigation, deps.Log)
+	dispenseHistoryHandler := dispenseHistory.NewDispenseHistoryHandler(deps.PrescriptionSvc, deps.DispenseSvc, deps.Navigation, deps.Log)
+	dispenseReceiptHandler := dispenseReceipt.NewDispenseReceiptHandler(deps.DispenseSvc, deps.Log)
+	prescriberDetailHandler := prescriberDetail.NewPrescriberDetailHandler(deps.PrescriberSvc, deps.Navigation, deps.Log)
 
 	r.Route(paths.BasePath, func(r chi.Router) {
 		// All prescription UI routes require authentication
@@ -28,6 +41,26 @@ func MountUI(r chi.Router, deps *PrescriptionDependencies) {
 		// All routes require prescription:read or healthcare role or admin
 		r.Use(auth.RequirePermissionsMatchAny(prescriptionsecurity.ReadAccess))
 
-		r.Get("/", prescriptionListHandler.Handler)
+		r.Get(paths.ListRoute, prescriptionListHandler.Handler)
+
+		// Dispense history with pickup signatures and printable receipts
+		r.Route(paths.DispensesSubRoute, func(r chi.Router) {
+			r.Get("/", dispenseHistoryHandler.Handler)
+			r.Get(paths.DispenseReceiptRoute, dispenseReceiptHandler.Handler)
+			r.Get(paths.DispenseSignatureRoute, dispenseReceiptHandler.Signature)
+		})
+
+		// Creating prescriptions additionally requires write access
+		r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.WriteAccess)).Get(paths.NewRoute, prescriptionCreateComponent.ShowCreateForm)
+		r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.WriteAccess)).Get(paths.DrugsSubRoute+paths.DrugSuggestionsRoute, prescriptionCreateComponent.DrugSuggestions)
+		r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.WriteAccess)).Post(paths.NewRoute, prescriptionCreateComponent.HandleFormSubmission)
+	})
+
+	// Prescriber details with the prescriptions written under them
+	r.Route(paths.PrescribersBasePath, func(r chi.Router) {
+		r.Use(auth.RequireAuthWithDevMode())
+		r.Use(auth.RequirePermissionsMatchAny(prescriptionsecurity.ReadAccess))
+
+		r.Get(paths.PrescriberRoute, prescriberDetailHandler.Handler)
 	})
 }
@@ -0,0 +1,107 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	prescriptionservice "pharmacy-modernization-project-model/domain/prescription/service"
+	"pharmacy-modernization-project-model/internal/platform/scheduler"
+)
+
+// ExpirationJobConfig controls which active prescriptions the expiration job closes
+type ExpirationJobConfig struct {
+	// MaxAge is how long a prescription may stay Active after it was created
+	MaxAge time.Duration
+	// Status is set on expired prescriptions: Expired, or Completed, which also records the
+	// dispense and bills the prescription like any other completion
+	Status m.Status
+	// BatchSize limits how many prescriptions are loaded at a time
+	BatchSize int
+}
+
+func (c *ExpirationJobConfig) setDefaults() {
+	if c.MaxAge <= 0 {
+		c.MaxAge = 365 * 24 * time.Hour
+	}
+	if c.Status != m.Completed {
+		c.Status = m.Expired
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = 200
+	}
+}
+
+// ExpirationJob closes active prescriptions older than the configured age. It runs on the
+// scheduler; every transition is logged and raises the service's status change handlers.
+type ExpirationJob struct {
+	svc prescriptionservice.PrescriptionService
+	log *zap.Logger
+	cfg ExpirationJobConfig
+	now func() time.Time
+}
+
+// NewExpirationJob creates the job; zero config values fall back to defaults
+func NewExpirationJob(svc prescriptionservice.PrescriptionService, log *zap.Logger, cfg ExpirationJobConfig) *ExpirationJob {
+	cfg.setDefaults()
+	if log == nil {
+		log = zap.NewNop()
+	}
+	return &ExpirationJob{svc: svc, log: log, cfg: cfg, now: time.Now}
+}
+
+// Run expires every prescription that is due, a batch at a time. A retry of a failed run keeps
+// the cutoff of that run, so it finishes the same set of prescriptions.
+func (j *ExpirationJob) Run(ctx context.Context) error {
+	cutoff := j.now().Add(-j.cfg.MaxAge)
+	if resume, err := time.Parse(time.RFC3339Nano, scheduler.ResumeFrom(ctx)); err == nil {
+		cutoff = resume
+	}
+	scheduler.SetCheckpoint(ctx, cutoff.Format(time.RFC3339Nano))
+	expired := 0
+	for {
+		prescriptions, err := j.svc.ListActiveCreatedBefore(ctx, cutoff, j.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		progress := 0
+		for _, prescription := range prescriptions {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			ok, err := j.svc.Expire(ctx, prescription, j.cfg.Status)
+			if err != nil {
+				// Left Active, so the next run retries it
+				scheduler.ItemFailed(ctx, fmt.Errorf("prescription %s: %w", prescription.ID, err))
+				continue
+			}
+			if !ok {
+				continue
+			}
+			progress++
+			scheduler.Processed(ctx, 1)
+			j.log.Info("Prescription expired",
+				zap.String("prescription_id", prescription.ID),
+				zap.String("patient_id", prescription.PatientID),
+				zap.Time("created_at", prescription.CreatedAt),
+				zap.String("status", string(j.cfg.Status)))
+		}
+		expired += progress
+
+		// Stop on a short batch, or when a full batch could not be expired, to avoid reloading it forever
+		if len(prescriptions) < j.cfg.BatchSize || progress == 0 {
+			break
+		}
+	}
+
+	if expired > 0 {
+		j.log.Info("Prescription expiration finished",
+			zap.Int("expired", expired),
+			zap.Time("cutoff", cutoff))
+	}
+	return nil
+}
@@ -0,0 +1,269 @@
+package worker
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	prescriptionservice "pharmacy-modernization-project-model/domain/prescription/service"
+	irispharmacy "pharmacy-modernization-project-model/internal/integrations/iris_pharmacy"
+)
+
+// FulfillmentEventHandler is notified whenever a prescription's fulfillment status changes
+type FulfillmentEventHandler func(ctx context.Context, event m.FulfillmentStatusChanged)
+
+// FulfillmentPollerConfig controls how often the pharmacy is polled
+type FulfillmentPollerConfig struct {
+	// Interval is how often the poller wakes up to look for due prescriptions
+	Interval time.Duration
+	// BaseBackoff is the delay after the first unchanged or failed poll of a prescription
+	BaseBackoff time.Duration
+	// MaxBackoff caps the per-prescription delay
+	MaxBackoff time.Duration
+	// BatchSize is how many in-flight prescriptions are loaded per page; each tick walks every page
+	BatchSize int
+	// Jitter is the +/- fraction applied to each backoff (0.2 = ±20%)
+	Jitter float64
+}
+
+func (c *FulfillmentPollerConfig) setDefaults() {
+	if c.Interval <= 0 {
+		c.Interval = 30 * time.Second
+	}
+	if c.BaseBackoff <= 0 {
+		c.BaseBackoff = c.Interval
+	}
+	if c.MaxBackoff <= 0 {
+		c.MaxBackoff = 30 * time.Minute
+	}
+	if c.MaxBackoff < c.BaseBackoff {
+		c.MaxBackoff = c.BaseBackoff
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = 100
+	}
+	if c.Jitter <= 0 || c.Jitter >= 1 {
+		c.Jitter = 0.2
+	}
+}
+
+// pollState is the backoff bookkeeping for one prescription
+type pollState struct {
+	attempts int
+	nextPoll time.Time
+}
+
+// FulfillmentPoller periodically asks the pharmacy for the status of in-flight
+// prescriptions and records changes on the prescription
+type FulfillmentPoller struct {
+	svc      prescriptionservice.PrescriptionService
+	pharmacy irispharmacy.PharmacyClient
+	log      *zap.Logger
+	cfg      FulfillmentPollerConfig
+
+	mu       sync.Mutex
+	state    map[string]*pollState
+	handlers []FulfillmentEventHandler
+	rand     *rand.Rand
+	now      func() time.Time
+}
+
+// NewFulfillmentPoller creates a poller; zero config values fall back to defaults
+func NewFulfillmentPoller(svc prescriptionservice.PrescriptionService, pharmacy irispharmacy.PharmacyClient, log *zap.Logger, cfg FulfillmentPollerConfig) *FulfillmentPoller {
+	cfg.setDefaults()
+	if log == nil {
+		log = zap.NewNop()
+	}
+	p := &FulfillmentPoller{
+		svc:      svc,
+		pharmacy: pharmacy,
+		log:      log,
+		cfg:      cfg,
+		state:    map[string]*pollState{},
+		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+		now:      time.Now,
+	}
+	p.OnStatusChanged(p.logStatusChanged)
+	return p
+}
+
+// OnStatusChanged registers a handler for fulfillment status change events
+func (p *FulfillmentPoller) OnStatusChanged(h FulfillmentEventHandler) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.handlers = append(p.handlers, h)
+}
+
+// Run polls until ctx is cancelled
+func (p *FulfillmentPoller) Run(ctx context.Context) {
+	p.log.Info("Fulfillment poller started",
+		zap.Duration("interval", p.cfg.Interval),
+		zap.Duration("max_backoff", p.cfg.MaxBackoff))
+
+	ticker := time.NewTicker(p.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		p.PollOnce(ctx)
+		select {
+		case <-ctx.Done():
+			p.log.Info("Fulfillment poller stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PollOnce pages through every in-flight prescription and polls the ones whose backoff has elapsed
+func (p *FulfillmentPoller) PollOnce(ctx context.Context) {
+	now := p.now()
+	inFlight := map[string]struct{}{}
+	afterID := ""
+	for {
+		prescriptions, err := p.svc.ListInFlight(ctx, afterID, p.cfg.BatchSize)
+		if err != nil {
+			p.log.Error("Failed to list in-flight prescriptions", zap.Error(err))
+			return
+		}
+
+		for _, prescription := range prescriptions {
+			if ctx.Err() != nil {
+				return
+			}
+			inFlight[prescription.ID] = struct{}{}
+			if !p.due(prescription.ID, now) {
+				continue
+			}
+			p.poll(ctx, prescription)
+		}
+
+		if len(prescriptions) < p.cfg.BatchSize {
+			break
+		}
+		afterID = prescriptions[len(prescriptions)-1].ID
+	}
+
+	p.forgetFinished(inFlight)
+}
+
+func (p *FulfillmentPoller) poll(ctx context.Context, prescription m.Prescription) {
+	resp, err := p.pharmacy.GetPrescription(ctx, prescription.ID)
+	if err != nil {
+		p.log.Warn("Failed to fetch pharmacy status",
+			zap.String("prescription_id", prescription.ID),
+			zap.Error(err))
+		p.backoff(prescription.ID)
+		return
+	}
+
+	status, ok := m.MapVendorFulfillmentStatus(resp.Status)
+	if !ok {
+		p.log.Debug("Unrecognized pharmacy status",
+			zap.String("prescription_id", prescription.ID),
+			zap.String("vendor_status", resp.Status))
+		p.backoff(prescription.ID)
+		return
+	}
+
+	if status == prescription.FulfillmentStatus {
+		p.backoff(prescription.ID)
+		return
+	}
+
+	if err := p.svc.UpdateFulfillmentStatus(ctx, prescription.ID, status); err != nil {
+		p.backoff(prescription.ID)
+		return
+	}
+
+	// A change usually means more are coming, so poll again soon
+	p.reset(prescription.ID)
+	p.emit(ctx, m.FulfillmentStatusChanged{
+		PrescriptionID: prescription.ID,
+		PatientID:      prescription.PatientID,
+		Previous:       prescription.FulfillmentStatus,
+		Current:        status,
+		VendorStatus:   resp.Status,
+		ChangedAt:      p.now(),
+	})
+}
+
+func (p *FulfillmentPoller) due(id string, now time.Time) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	st, ok := p.state[id]
+	return !ok || !now.Before(st.nextPoll)
+}
+
+// backoff schedules the next poll at min(base*2^attempts, max) with jitter
+func (p *FulfillmentPoller) backoff(id string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	st, ok := p.state[id]
+	if !ok {
+		st = &pollState{}
+		p.state[id] = st
+	}
+
+	delay := p.cfg.MaxBackoff
+	if st.attempts < 32 {
+		if d := p.cfg.BaseBackoff << st.attempts; d > 0 && d < p.cfg.MaxBackoff {
+			delay = d
+		}
+	}
+	st.attempts++
+	st.nextPoll = p.now().Add(p.jitter(delay))
+}
+
+func (p *FulfillmentPoller) reset(id string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.state[id] = &pollState{nextPoll: p.now().Add(p.jitter(p.cfg.BaseBackoff))}
+}
+
+// jitter spreads d by ±cfg.Jitter so prescriptions don't poll in lockstep.
+// Called with p.mu held.
+func (p *FulfillmentPoller) jitter(d time.Duration) time.Duration {
+	spread := (p.rand.Float64()*2 - 1) * p.cfg.Jitter
+	return time.Duration(float64(d) * (1 + spread))
+}
+
+// forgetFinished drops state for prescriptions that are no longer in flight
+func (p *FulfillmentPoller) forgetFinished(inFlight map[string]struct{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for id := range p.state {
+		if _, ok := inFlight[id]; !ok {
+			delete(p.state, id)
+		}
+	}
+}
+
+func (p *FulfillmentPoller) emit(ctx context.Context, event m.FulfillmentStatusChanged) {
+	p.mu.Lock()
+	handlers := append([]FulfillmentEventHandler(nil), p.handlers...)
+	p.mu.Unlock()
+
+	for _, h := range handlers {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					p.log.Error("Fulfillment event handler panicked",
+						zap.String("prescription_id", event.PrescriptionID),
+						zap.Any("panic", r))
+				}
+			}()
+			h(ctx, event)
+		}()
+	}
+}
+
+func (p *FulfillmentPoller) logStatusChanged(_ context.Context, event m.FulfillmentStatusChanged) {
+	p.log.Info("Prescription fulfillment status changed",
+		zap.String("prescription_id", event.PrescriptionID),
+		zap.String("previous", string(event.Previous)),
+		zap.String("current", string(event.Current)))
+}
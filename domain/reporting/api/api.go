@@ -0,0 +1,21 @@
+package api
+
+import (
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	controllers "pharmacy-modernization-project-model/domain/reporting/api/controllers"
+	"pharmacy-modernization-project-model/domain/reporting/service"
+	platformpaths "pharmacy-modernization-project-model/internal/platform/paths"
+)
+
+type Dependencies struct {
+	AdherenceService service.AdherenceService
+	Logger           *zap.Logger
+}
+
+func MountAPI(r chi.Router, deps *Dependencies) {
+	adherenceController := controllers.NewAdherenceController(deps.AdherenceService, deps.Logger)
+
+	r.Route(platformpaths.AdherenceReportsAPIPath, adherenceController.RegisterRoutes)
+}
@@ -0,0 +1,51 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/reporting/contracts/request"
+	reportingsecurity "pharmacy-modernization-project-model/domain/reporting/security"
+	"pharmacy-modernization-project-model/domain/reporting/service"
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+)
+
+type AdherenceController struct {
+	svc service.AdherenceService
+	log *zap.Logger
+}
+
+func NewAdherenceController(s service.AdherenceService, log *zap.Logger) *AdherenceController {
+	return &AdherenceController{svc: s, log: log}
+}
+
+func (c *AdherenceController) RegisterRoutes(r chi.Router) {
+	// All reporting API routes require authentication (header-based for API)
+	r.Use(auth.RequireAuthFromHeader())
+
+	// Adherence report - requires adherence:read or admin:all
+	r.With(auth.RequirePermissionsMatchAny(reportingsecurity.AdherenceAccess)).Get("/", c.Report)
+}
+
+// Report returns the patient's adherence with the nightly snapshots of the last month
+func (c *AdherenceController) Report(w http.ResponseWriter, r *http.Request) {
+	query, fieldErrors, err := bind.Query[request.AdherenceReportQueryRequest](r)
+	if err != nil {
+		c.log.Error("failed to bind query parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	report, err := c.svc.Report(r.Context(), query.PatientID)
+	if err != nil {
+		c.log.Error("adherence report", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, report)
+}
@@ -0,0 +1,30 @@
+package builder
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	reportingrepo "pharmacy-modernization-project-model/domain/reporting/repository"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// CreateAdherenceSnapshotRepository creates the appropriate adherence snapshot repository based
+// on dependencies; with MongoDB it creates the patient index, and with a retention the TTL index,
+// if missing
+func CreateAdherenceSnapshotRepository(logger *zap.Logger, mongoCollection *mongo.Collection, retrier *database.Retrier, retention time.Duration) reportingrepo.AdherenceSnapshotRepository {
+	if mongoCollection != nil {
+		repo := reportingrepo.NewAdherenceSnapshotMongoRepository(mongoCollection, logger)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := repo.CreateIndexes(ctx, retention); err != nil {
+			logger.Warn("Failed to create adherence snapshot indexes", zap.Error(err))
+		}
+		return reportingrepo.NewAdherenceSnapshotRetryRepository(repo, retrier)
+	}
+
+	return reportingrepo.NewAdherenceSnapshotMemoryRepository()
+}
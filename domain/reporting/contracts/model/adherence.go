@@ -0,0 +1,60 @@
+package model
+
+import "time"
+
+// AdherenceMetrics are the adherence figures of a patient's prescriptions
+type AdherenceMetrics struct {
+	ActivePrescriptions    int `json:"active_prescriptions" bson:"active_prescriptions"`
+	CompletedPrescriptions int `json:"completed_prescriptions" bson:"completed_prescriptions"`
+	// Refills counts the dispenses after the first one of each prescription; reversed ones are ignored
+	Refills int `json:"refills" bson:"refills"`
+	// AverageDaysBetweenRefills is the mean gap between consecutive dispenses of a prescription,
+	// over all the patient's prescriptions; nil without a refill
+	AverageDaysBetweenRefills *float64 `json:"average_days_between_refills,omitempty" bson:"average_days_between_refills,omitempty"`
+	// ProportionOfDaysCovered is the mean of the prescriptions' proportions of days covered,
+	// between 0 and 1; nil when no dispense has a days supply
+	ProportionOfDaysCovered *float64 `json:"proportion_of_days_covered,omitempty" bson:"proportion_of_days_covered,omitempty"`
+}
+
+// PrescriptionAdherence is how one prescription of the patient has been refilled
+type PrescriptionAdherence struct {
+	PrescriptionID  string     `json:"prescription_id"`
+	Drug            string     `json:"drug"`
+	Status          string     `json:"status"`
+	Dispenses       int        `json:"dispenses"`
+	LastDispensedAt *time.Time `json:"last_dispensed_at,omitempty"`
+	// AverageDaysBetweenRefills is nil with fewer than two dispenses
+	AverageDaysBetweenRefills *float64 `json:"average_days_between_refills,omitempty"`
+	// ProportionOfDaysCovered runs from the first dispense with a days supply until today, as on
+	// the dispense history page; nil without one
+	ProportionOfDaysCovered *float64 `json:"proportion_of_days_covered,omitempty"`
+}
+
+// AdherenceReport is a patient's adherence as of GeneratedAt, with the nightly snapshots that
+// show its trend
+type AdherenceReport struct {
+	PatientID string `json:"patient_id"`
+	AdherenceMetrics
+	Prescriptions []PrescriptionAdherence `json:"prescriptions"`
+	// History holds the nightly snapshots, newest first
+	History     []AdherenceSnapshot `json:"history"`
+	GeneratedAt time.Time           `json:"generated_at"`
+}
+
+// AdherenceSnapshot is a patient's adherence metrics as computed by the nightly snapshot job.
+// A patient has at most one snapshot per day; a later run the same day replaces it.
+type AdherenceSnapshot struct {
+	ID        string `json:"-" bson:"_id"`
+	PatientID string `json:"patient_id" bson:"patient_id"`
+	// Date is the UTC day of the snapshot, YYYY-MM-DD
+	Date             string `json:"date" bson:"date"`
+	AdherenceMetrics `bson:",inline"`
+	TakenAt          time.Time `json:"taken_at" bson:"taken_at"`
+	// OrgID is the organization of the patient
+	OrgID string `json:"org_id,omitempty" bson:"org_id,omitempty"`
+}
+
+// SnapshotID identifies the snapshot of a patient on a day
+func SnapshotID(patientID, date string) string {
+	return patientID + ":" + date
+}
@@ -0,0 +1,6 @@
+package request
+
+// AdherenceReportQueryRequest selects the patient of an adherence report
+type AdherenceReportQueryRequest struct {
+	PatientID string `form:"patientID" validate:"required,min=1"`
+}
@@ -0,0 +1,49 @@
+package graphql
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/reporting/contracts/model"
+	"pharmacy-modernization-project-model/domain/reporting/contracts/request"
+	reportingservice "pharmacy-modernization-project-model/domain/reporting/service"
+	"pharmacy-modernization-project-model/internal/graphql/validation"
+)
+
+// ReportingResolver handles all Reporting domain GraphQL operations
+type ReportingResolver struct {
+	AdherenceService reportingservice.AdherenceService
+	Logger           *zap.Logger
+}
+
+// NewReportingResolver creates a new reporting resolver
+func NewReportingResolver(
+	adherenceSvc reportingservice.AdherenceService,
+	logger *zap.Logger,
+) *ReportingResolver {
+	return &ReportingResolver{
+		AdherenceService: adherenceSvc,
+		Logger:           logger,
+	}
+}
+
+// ============================================================================
+// Query Resolvers
+// ============================================================================
+
+// AdherenceReport resolves the adherenceReport query
+func (r *ReportingResolver) AdherenceReport(ctx context.Context, patientID string) (*model.AdherenceReport, error) {
+	if _, validationErrors := validation.ValidateGraphQLInput(ctx, request.AdherenceReportQueryRequest{PatientID: patientID}); validationErrors != nil {
+		return nil, validationErrors
+	}
+
+	report, err := r.AdherenceService.Report(ctx, patientID)
+	if err != nil {
+		r.Logger.Error("Failed to build adherence report",
+			zap.String("patient_id", patientID),
+			zap.Error(err))
+		return nil, err
+	}
+	return &report, nil
+}
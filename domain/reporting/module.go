@@ -0,0 +1,49 @@
+package reporting
+
+import (
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	reportingapi "pharmacy-modernization-project-model/domain/reporting/api"
+	reportingbuilder "pharmacy-modernization-project-model/domain/reporting/builder"
+	reportingproviders "pharmacy-modernization-project-model/domain/reporting/providers"
+	reportingservice "pharmacy-modernization-project-model/domain/reporting/service"
+	reportingworker "pharmacy-modernization-project-model/domain/reporting/worker"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+type ModuleDependencies struct {
+	Logger               *zap.Logger
+	PatientProvider      reportingproviders.PatientProvider
+	PrescriptionProvider reportingproviders.PrescriptionProvider
+	DispenseProvider     reportingproviders.DispenseProvider
+	// SnapshotsMongoCollection keeps the nightly adherence snapshots; nil keeps them in memory
+	SnapshotsMongoCollection *mongo.Collection
+	Retrier                  *database.Retrier // Retries the calls of the MongoDB repository; nil runs them once
+	// SnapshotRetention is how long snapshots are kept in MongoDB; zero keeps them
+	SnapshotRetention time.Duration
+	Snapshots         reportingworker.SnapshotJobConfig
+}
+
+type ModuleExport struct {
+	AdherenceService reportingservice.AdherenceService
+	// SnapshotJob is registered on the scheduler when adherence snapshots are enabled
+	SnapshotJob *reportingworker.SnapshotJob
+}
+
+func Module(r chi.Router, deps *ModuleDependencies) ModuleExport {
+	snapshotRepo := reportingbuilder.CreateAdherenceSnapshotRepository(deps.Logger, deps.SnapshotsMongoCollection, deps.Retrier, deps.SnapshotRetention)
+	adherenceSvc := reportingservice.NewAdherenceService(deps.PatientProvider, deps.PrescriptionProvider, deps.DispenseProvider, snapshotRepo, deps.Logger)
+
+	reportingapi.MountAPI(r, &reportingapi.Dependencies{
+		AdherenceService: adherenceSvc,
+		Logger:           deps.Logger,
+	})
+
+	snapshots := reportingworker.NewSnapshotJob(deps.PatientProvider, adherenceSvc, deps.Logger, deps.Snapshots)
+
+	return ModuleExport{AdherenceService: adherenceSvc, SnapshotJob: snapshots}
+}
@@ -0,0 +1,23 @@
+package providers
+
+import (
+	"context"
+
+	patientmodel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+	prescriptionmodel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+)
+
+type PatientProvider interface {
+	GetByID(ctx context.Context, id string) (patientmodel.Patient, error)
+	List(ctx context.Context, req request.PatientListQueryRequest) ([]patientmodel.Patient, error)
+}
+
+type PrescriptionProvider interface {
+	ListForPatient(ctx context.Context, patientID string, status prescriptionmodel.Status, limit int) ([]prescriptionmodel.Prescription, error)
+}
+
+// DispenseProvider reads the dispense history, which is the refill history of a prescription
+type DispenseProvider interface {
+	ListByPrescription(ctx context.Context, prescriptionID string) ([]prescriptionmodel.DispenseRecord, error)
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"pharmacy-modernization-project-model/domain/reporting/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/tenancy"
+)
+
+type adherenceSnapshotMemoryRepository struct {
+	mu        sync.RWMutex
+	snapshots map[string]model.AdherenceSnapshot
+}
+
+func NewAdherenceSnapshotMemoryRepository() AdherenceSnapshotRepository {
+	return &adherenceSnapshotMemoryRepository{snapshots: map[string]model.AdherenceSnapshot{}}
+}
+
+func (r *adherenceSnapshotMemoryRepository) Save(_ context.Context, snapshot model.AdherenceSnapshot) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.snapshots[snapshot.ID] = snapshot
+	return nil
+}
+
+func (r *adherenceSnapshotMemoryRepository) ListByPatient(ctx context.Context, patientID string, limit int) ([]model.AdherenceSnapshot, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.AdherenceSnapshot{}
+	for _, snapshot := range r.snapshots {
+		if snapshot.PatientID == patientID && tenancy.Visible(ctx, snapshot.OrgID) {
+			out = append(out, snapshot)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Date > out[j].Date })
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/reporting/contracts/model"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/tenancy"
+)
+
+// AdherenceSnapshotMongoRepository implements AdherenceSnapshotRepository interface using MongoDB
+type AdherenceSnapshotMongoRepository struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewAdherenceSnapshotMongoRepository creates a new MongoDB adherence snapshot repository
+func NewAdherenceSnapshotMongoRepository(collection *mongo.Collection, logger *zap.Logger) *AdherenceSnapshotMongoRepository {
+	return &AdherenceSnapshotMongoRepository{
+		collection: collection,
+		logger:     logger,
+	}
+}
+
+// handleError processes MongoDB errors and converts them to appropriate repository errors
+func (r *AdherenceSnapshotMongoRepository) handleError(operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	r.logger.Error("MongoDB operation failed",
+		zap.String("operation", operation),
+		zap.Error(err))
+
+	return platformErrors.HandleMongoError(operation, err)
+}
+
+// Save upserts the snapshot by its ID
+func (r *AdherenceSnapshotMongoRepository) Save(ctx context.Context, snapshot model.AdherenceSnapshot) error {
+	_, err := r.collection.ReplaceOne(ctx, bson.M{"_id": snapshot.ID}, snapshot, options.Replace().SetUpsert(true))
+	return r.handleError("Save", err)
+}
+
+// ListByPatient retrieves the patient's snapshots, newest first
+func (r *AdherenceSnapshotMongoRepository) ListByPatient(ctx context.Context, patientID string, limit int) ([]model.AdherenceSnapshot, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "date", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	cursor, err := r.collection.Find(ctx, tenancy.Filter(ctx, bson.M{"patient_id": patientID}), opts)
+	if err != nil {
+		return nil, r.handleError("ListByPatient", err)
+	}
+	defer cursor.Close(ctx)
+
+	snapshots := []model.AdherenceSnapshot{}
+	if err := cursor.All(ctx, &snapshots); err != nil {
+		return nil, r.handleError("ListByPatient", err)
+	}
+	return snapshots, nil
+}
+
+// CreateIndexes creates the index used by ListByPatient and, with a retention, the TTL index
+// that removes older snapshots
+func (r *AdherenceSnapshotMongoRepository) CreateIndexes(ctx context.Context, retention time.Duration) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "patient_id", Value: 1}, {Key: "date", Value: -1}},
+			Options: options.Index().SetName("patient_id_1_date_-1"),
+		},
+	}
+	if retention > 0 {
+		indexes = append(indexes, mongo.IndexModel{
+			Keys:    bson.D{{Key: "taken_at", Value: 1}},
+			Options: options.Index().SetName("taken_at_ttl").SetExpireAfterSeconds(int32(retention.Seconds())),
+		})
+	}
+	if _, err := r.collection.Indexes().CreateMany(ctx, indexes); err != nil {
+		return r.handleError("CreateIndexes", err)
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"pharmacy-modernization-project-model/domain/reporting/contracts/model"
+)
+
+type AdherenceSnapshotRepository interface {
+	// Save creates the snapshot or replaces the one with its ID
+	Save(ctx context.Context, snapshot model.AdherenceSnapshot) error
+	// ListByPatient returns the patient's snapshots, newest first; limit 0 returns all of them
+	ListByPatient(ctx context.Context, patientID string, limit int) ([]model.AdherenceSnapshot, error)
+}
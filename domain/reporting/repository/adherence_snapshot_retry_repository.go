@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+
+	"pharmacy-modernization-project-model/domain/reporting/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// AdherenceSnapshotRetryRepository retries the calls of an adherence snapshot repository that
+// fail with a retryable error; all of them are idempotent
+type AdherenceSnapshotRetryRepository struct {
+	next    AdherenceSnapshotRepository
+	retrier *database.Retrier
+}
+
+// NewAdherenceSnapshotRetryRepository wraps next with retries; without a retrier next is returned as is
+func NewAdherenceSnapshotRetryRepository(next AdherenceSnapshotRepository, retrier *database.Retrier) AdherenceSnapshotRepository {
+	if retrier == nil {
+		return next
+	}
+	return &AdherenceSnapshotRetryRepository{next: next, retrier: retrier}
+}
+
+// Save is retried: replacing a snapshot by its ID again leaves the same document
+func (r *AdherenceSnapshotRetryRepository) Save(ctx context.Context, snapshot model.AdherenceSnapshot) error {
+	return r.retrier.Do(ctx, "adherence_snapshots.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, snapshot)
+	})
+}
+
+func (r *AdherenceSnapshotRetryRepository) ListByPatient(ctx context.Context, patientID string, limit int) ([]model.AdherenceSnapshot, error) {
+	return database.Retry(ctx, r.retrier, "adherence_snapshots.ListByPatient", func(ctx context.Context) ([]model.AdherenceSnapshot, error) {
+		return r.next.ListByPatient(ctx, patientID, limit)
+	})
+}
@@ -0,0 +1,14 @@
+package security
+
+import "pharmacy-modernization-project-model/internal/platform/permissions"
+
+// Reporting domain permissions
+const (
+	PermissionAdherence = permissions.AdherenceRead
+)
+
+// Common permission sets for reuse in routes
+var (
+	// AdherenceAccess - care-management users need ANY of these permissions to view adherence reports
+	AdherenceAccess = []string{PermissionAdherence, permissions.AdminAll}
+)
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	patientmodel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	prescriptionmodel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/domain/reporting/contracts/model"
+	"pharmacy-modernization-project-model/domain/reporting/providers"
+	"pharmacy-modernization-project-model/domain/reporting/repository"
+)
+
+// historyLimit caps the snapshots a report returns, a month of nightly runs
+const historyLimit = 30
+
+// AdherenceService computes how well patients keep up with their prescriptions, from the
+// active and completed prescriptions and their dispense history
+type AdherenceService interface {
+	// Report computes the patient's adherence now, with the snapshots of previous nights
+	Report(ctx context.Context, patientID string) (model.AdherenceReport, error)
+	// Snapshot computes the patient's adherence and saves it as the snapshot of today
+	Snapshot(ctx context.Context, patient patientmodel.Patient) (model.AdherenceSnapshot, error)
+}
+
+type adherenceSvc struct {
+	patients      providers.PatientProvider
+	prescriptions providers.PrescriptionProvider
+	dispenses     providers.DispenseProvider
+	snapshots     repository.AdherenceSnapshotRepository
+	log           *zap.Logger
+	now           func() time.Time
+}
+
+func NewAdherenceService(patients providers.PatientProvider, prescriptions providers.PrescriptionProvider, dispenses providers.DispenseProvider, snapshots repository.AdherenceSnapshotRepository, log *zap.Logger) AdherenceService {
+	return &adherenceSvc{
+		patients:      patients,
+		prescriptions: prescriptions,
+		dispenses:     dispenses,
+		snapshots:     snapshots,
+		log:           log,
+		now:           time.Now,
+	}
+}
+
+func (s *adherenceSvc) Report(ctx context.Context, patientID string) (model.AdherenceReport, error) {
+	// Reading the patient first answers unknown patients, and those of another organization, with a 404
+	if _, err := s.patients.GetByID(ctx, patientID); err != nil {
+		return model.AdherenceReport{}, err
+	}
+
+	now := s.now().UTC()
+	metrics, prescriptions, err := s.compute(ctx, patientID, now)
+	if err != nil {
+		return model.AdherenceReport{}, err
+	}
+	history, err := s.snapshots.ListByPatient(ctx, patientID, historyLimit)
+	if err != nil {
+		return model.AdherenceReport{}, err
+	}
+
+	return model.AdherenceReport{
+		PatientID:        patientID,
+		AdherenceMetrics: metrics,
+		Prescriptions:    prescriptions,
+		History:          history,
+		GeneratedAt:      now,
+	}, nil
+}
+
+func (s *adherenceSvc) Snapshot(ctx context.Context, patient patientmodel.Patient) (model.AdherenceSnapshot, error) {
+	now := s.now().UTC()
+	metrics, _, err := s.compute(ctx, patient.ID, now)
+	if err != nil {
+		return model.AdherenceSnapshot{}, err
+	}
+
+	date := now.Format(time.DateOnly)
+	snapshot := model.AdherenceSnapshot{
+		ID:               model.SnapshotID(patient.ID, date),
+		PatientID:        patient.ID,
+		Date:             date,
+		AdherenceMetrics: metrics,
+		TakenAt:          now,
+		OrgID:            patient.OrgID,
+	}
+	if err := s.snapshots.Save(ctx, snapshot); err != nil {
+		return model.AdherenceSnapshot{}, err
+	}
+	s.log.Debug("Adherence snapshot saved", zap.String("patient_id", patient.ID), zap.String("date", date))
+	return snapshot, nil
+}
+
+// compute returns the patient's metrics and the adherence of each active and completed
+// prescription, newest first
+func (s *adherenceSvc) compute(ctx context.Context, patientID string, now time.Time) (model.AdherenceMetrics, []model.PrescriptionAdherence, error) {
+	var metrics model.AdherenceMetrics
+	result := []model.PrescriptionAdherence{}
+	var gapDays, coverage []float64
+
+	for _, status := range []prescriptionmodel.Status{prescriptionmodel.Active, prescriptionmodel.Completed} {
+		prescriptions, err := s.prescriptions.ListForPatient(ctx, patientID, status, 0)
+		if err != nil {
+			return model.AdherenceMetrics{}, nil, err
+		}
+		if status == prescriptionmodel.Active {
+			metrics.ActivePrescriptions = len(prescriptions)
+		} else {
+			metrics.CompletedPrescriptions = len(prescriptions)
+		}
+
+		for _, prescription := range prescriptions {
+			dispenses, err := s.dispenses.ListByPrescription(ctx, prescription.ID)
+			if err != nil {
+				return model.AdherenceMetrics{}, nil, err
+			}
+			adherence, gaps := prescriptionAdherence(prescription, dispenses, now)
+			metrics.Refills += len(gaps)
+			gapDays = append(gapDays, gaps...)
+			if adherence.ProportionOfDaysCovered != nil {
+				coverage = append(coverage, *adherence.ProportionOfDaysCovered)
+			}
+			result = append(result, adherence)
+		}
+	}
+
+	metrics.AverageDaysBetweenRefills = mean(gapDays)
+	metrics.ProportionOfDaysCovered = mean(coverage)
+	return metrics, result, nil
+}
+
+// prescriptionAdherence returns the adherence of one prescription and the days between its
+// consecutive dispenses. Reversed dispenses and reversals are not refills.
+func prescriptionAdherence(prescription prescriptionmodel.Prescription, dispenses []prescriptionmodel.DispenseRecord, now time.Time) (model.PrescriptionAdherence, []float64) {
+	adherence := model.PrescriptionAdherence{
+		PrescriptionID: prescription.ID,
+		Drug:           prescription.Drug,
+		Status:         string(prescription.Status),
+	}
+
+	handed := make([]prescriptionmodel.DispenseRecord, 0, len(dispenses))
+	for _, d := range dispenses {
+		if !d.IsReversal() && !d.IsReversed() {
+			handed = append(handed, d)
+		}
+	}
+	if len(handed) == 0 {
+		return adherence, nil
+	}
+	sort.Slice(handed, func(i, j int) bool { return handed[i].DispensedAt.Before(handed[j].DispensedAt) })
+
+	adherence.Dispenses = len(handed)
+	last := handed[len(handed)-1].DispensedAt
+	adherence.LastDispensedAt = &last
+
+	gaps := make([]float64, 0, len(handed)-1)
+	for i := 1; i < len(handed); i++ {
+		gaps = append(gaps, handed[i].DispensedAt.Sub(handed[i-1].DispensedAt).Hours()/24)
+	}
+	adherence.AverageDaysBetweenRefills = mean(gaps)
+
+	var first time.Time
+	var supplyEnds time.Time
+	for _, d := range handed {
+		if d.DaysSupply <= 0 {
+			continue
+		}
+		if first.IsZero() {
+			first = d.DispensedAt
+		}
+		if ends := d.DispensedAt.AddDate(0, 0, d.DaysSupply); ends.After(supplyEnds) {
+			supplyEnds = ends
+		}
+	}
+	if !first.IsZero() {
+		// As on the dispense history page, today counts as covered when a supply reaches it. A
+		// completed prescription is measured until its last supply ran out, as nothing is due after.
+		to := now.AddDate(0, 0, 1)
+		if prescription.Status == prescriptionmodel.Completed && supplyEnds.Before(to) {
+			to = supplyEnds
+		}
+		pdc := prescriptionmodel.CalculateAdherence(handed, first, to).ProportionOfDaysCovered()
+		adherence.ProportionOfDaysCovered = &pdc
+	}
+	return adherence, gaps
+}
+
+// mean returns the average of the values, or nil without any
+func mean(values []float64) *float64 {
+	if len(values) == 0 {
+		return nil
+	}
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	avg := sum / float64(len(values))
+	return &avg
+}
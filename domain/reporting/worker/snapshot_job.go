@@ -0,0 +1,83 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+	"pharmacy-modernization-project-model/domain/reporting/providers"
+	reportingservice "pharmacy-modernization-project-model/domain/reporting/service"
+	"pharmacy-modernization-project-model/internal/platform/scheduler"
+)
+
+// SnapshotJobConfig controls how an adherence snapshot run pages through the patients
+type SnapshotJobConfig struct {
+	// BatchSize limits how many patients are loaded at a time; the patient list allows 100 at most
+	BatchSize int
+}
+
+func (c *SnapshotJobConfig) setDefaults() {
+	if c.BatchSize <= 0 || c.BatchSize > 100 {
+		c.BatchSize = 100
+	}
+}
+
+// SnapshotJob saves every patient's adherence metrics as the snapshot of the day, which adherence
+// reports show as the trend. It runs nightly on the scheduler.
+type SnapshotJob struct {
+	patients providers.PatientProvider
+	svc      reportingservice.AdherenceService
+	log      *zap.Logger
+	cfg      SnapshotJobConfig
+}
+
+// NewSnapshotJob creates the job; zero config values fall back to defaults
+func NewSnapshotJob(patients providers.PatientProvider, svc reportingservice.AdherenceService, log *zap.Logger, cfg SnapshotJobConfig) *SnapshotJob {
+	cfg.setDefaults()
+	if log == nil {
+		log = zap.NewNop()
+	}
+	return &SnapshotJob{patients: patients, svc: svc, log: log, cfg: cfg}
+}
+
+// Run snapshots the patients a batch at a time. The checkpoint is the number of patients gone
+// through, so a retry of a failed run continues after them; a snapshot taken again the same day
+// replaces the earlier one.
+func (j *SnapshotJob) Run(ctx context.Context) error {
+	offset := 0
+	if resume, err := strconv.Atoi(scheduler.ResumeFrom(ctx)); err == nil && resume > 0 {
+		offset = resume
+	}
+
+	saved := 0
+	for {
+		patients, err := j.patients.List(ctx, request.PatientListQueryRequest{Limit: j.cfg.BatchSize, Offset: offset})
+		if err != nil {
+			return err
+		}
+
+		for _, patient := range patients {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if _, err := j.svc.Snapshot(ctx, patient); err != nil {
+				scheduler.ItemFailed(ctx, fmt.Errorf("patient %s: %w", patient.ID, err))
+				continue
+			}
+			saved++
+			scheduler.Processed(ctx, 1)
+		}
+		offset += len(patients)
+		scheduler.SetCheckpoint(ctx, strconv.Itoa(offset))
+
+		if len(patients) < j.cfg.BatchSize {
+			break
+		}
+	}
+
+	j.log.Info("Adherence snapshots finished", zap.Int("saved", saved))
+	return nil
+}
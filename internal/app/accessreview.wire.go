@@ -0,0 +1,73 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/app/builder"
+	"pharmacy-modernization-project-model/internal/platform/accessreview"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// wireAccessReview mounts the access review reports and schedules the periodic review
+func (a *App) wireAccessReview(r chi.Router, mongoConnMgr *database.ConnectionManager) {
+	cfg := a.Cfg.Access
+	if !cfg.Enabled {
+		return
+	}
+
+	store := a.accessReviewStore(mongoConnMgr)
+	reviewer := accessreview.NewReviewer(a.accessReviewDirectory(), store, accessreview.Config{
+		Interval:               parseDuration(cfg.Interval, 7*24*time.Hour),
+		FuncRolePermissions:    cfg.FuncRolePermissions,
+		ConflictingPermissions: cfg.ConflictingPermissions,
+		RareGrantMaxUsers:      cfg.RareGrantMaxUsers,
+		RareGrantMinUsers:      cfg.RareGrantMinUsers,
+	}, a.Logger.Base)
+
+	accessreview.NewHandler(reviewer, store, a.Logger.Base).RegisterRoutes(r)
+	a.addWorker("access_review", stageProducers, reviewer.Run)
+}
+
+// accessReviewDirectory lists the login users defined in the config; users of an external
+// identity provider cannot be listed from here
+func (a *App) accessReviewDirectory() accessreview.Directory {
+	login := a.Cfg.Auth.Login
+	if login.UserStore != "" && login.UserStore != "config" {
+		a.Logger.Base.Warn("Access reviews only cover config login users", zap.String("user_store", login.UserStore))
+		return accessreview.StaticDirectory{}
+	}
+
+	users := make(accessreview.StaticDirectory, 0, len(login.Users))
+	for _, u := range login.Users {
+		users = append(users, accessreview.Subject{
+			Username:        u.Username,
+			Name:            u.Name,
+			Email:           u.Email,
+			Permissions:     u.Permissions,
+			DataAccessRoles: u.DataAccessRoles,
+			FuncRoles:       u.FuncRoles,
+		})
+	}
+	return users
+}
+
+// accessReviewStore creates the access review report store (MongoDB or Memory)
+func (a *App) accessReviewStore(mongoConnMgr *database.ConnectionManager) accessreview.Store {
+	if collection := builder.GetAccessReviewsCollection(mongoConnMgr); collection != nil {
+		store := accessreview.NewMongoStore(collection, a.Logger.Base)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := store.CreateIndexes(ctx); err != nil {
+			a.Logger.Base.Warn("Failed to create access review indexes", zap.Error(err))
+		}
+		return store
+	}
+
+	a.Logger.Base.Info("MongoDB not configured, access reviews are kept in memory")
+	return accessreview.NewMemoryStore()
+}
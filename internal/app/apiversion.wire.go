@@ -0,0 +1,53 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/apiversion"
+)
+
+// wireAPIVersions serves the REST API by version: version headers, deprecation notices and
+// Accept header negotiation for the routes registered after it
+func (a *App) wireAPIVersions(r chi.Router) error {
+	versions := make([]apiversion.Version, len(a.Cfg.API.Versions))
+	for i, v := range a.Cfg.API.Versions {
+		deprecatedAt, err := parseAPIDate(v.DeprecatedAt)
+		if err != nil {
+			return fmt.Errorf("invalid api.versions %s deprecated_at: %w", v.Name, err)
+		}
+		sunsetAt, err := parseAPIDate(v.SunsetAt)
+		if err != nil {
+			return fmt.Errorf("invalid api.versions %s sunset_at: %w", v.Name, err)
+		}
+		versions[i] = apiversion.Version{Name: v.Name, DeprecatedAt: deprecatedAt, SunsetAt: sunsetAt, Link: v.Link}
+	}
+	set, err := apiversion.New(versions, a.Cfg.API.DefaultVersion)
+	if err != nil {
+		return fmt.Errorf("invalid api config: %w", err)
+	}
+
+	r.Use(set.Middleware(r))
+	for _, v := range versions {
+		if v.Deprecated() {
+			a.Logger.Base.Info("REST API version is deprecated",
+				zap.String("version", v.Name),
+				zap.Time("sunset_at", v.SunsetAt))
+		}
+	}
+	return nil
+}
+
+// parseAPIDate accepts a date (2027-01-01) or an RFC 3339 timestamp; empty is the zero time
+func parseAPIDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
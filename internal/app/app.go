@@ -1,12 +1,18 @@
 package app
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
 
+	"pharmacy-modernization-project-model/internal/grpc"
 	"pharmacy-modernization-project-model/internal/platform/config"
 	"pharmacy-modernization-project-model/internal/platform/logging"
 )
@@ -16,21 +22,75 @@ type App struct {
 	Logger *logging.LoggerBundle
 	Router chi.Router
 	Server *http.Server
+	GRPC   *grpc.Server // nil when the gRPC API is disabled
+
+	started    time.Time
+	workers    []worker
+	closers    []closer
+	onShutdown []func() // Called when shutdown starts, e.g. to end open event streams
 }
 
 func New(cfg *config.Config) (*App, error) {
-	app := &App{Cfg: cfg}
+	app := &App{Cfg: cfg, started: time.Now()}
 	if err := app.wire(); err != nil {
 		return nil, err
 	}
 	return app, nil
 }
 
+// Run serves HTTP, and gRPC when enabled, and runs the background workers until SIGINT or
+// SIGTERM, then shuts everything down in order. A second signal exits at once.
 func (a *App) Run() error {
 	a.Server = &http.Server{
 		Addr:              fmt.Sprintf(":%d", a.Cfg.App.Port),
 		Handler:           a.Router,
 		ReadHeaderTimeout: 10 * time.Second,
 	}
-	return a.Server.ListenAndServe()
+	for _, f := range a.onShutdown {
+		a.Server.RegisterOnShutdown(f)
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+
+	stages := a.startWorkers()
+
+	failed := make(chan error, 2)
+	if a.GRPC != nil {
+		go func() {
+			if err := a.GRPC.Serve(fmt.Sprintf(":%d", a.Cfg.GRPC.Port)); err != nil {
+				failed <- fmt.Errorf("gRPC server: %w", err)
+			}
+		}()
+	}
+	go func() {
+		if err := a.Server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			failed <- err
+		}
+	}()
+
+	names := make([]string, 0, len(a.workers))
+	for _, w := range a.workers {
+		names = append(names, w.name)
+	}
+	a.Logger.Base.Info("Server started",
+		zap.String("address", a.Server.Addr),
+		zap.Bool("grpc", a.GRPC != nil),
+		zap.Strings("workers", names),
+		zap.Duration("startup", time.Since(a.started)))
+
+	var err error
+	var reason string
+	select {
+	case sig := <-signals:
+		reason = sig.String()
+	case err = <-failed:
+		reason = "server error"
+		a.Logger.Base.Error("Server stopped", zap.Error(err))
+	}
+	// From here on the signals are handled as usual, so a second one exits at once
+	signal.Stop(signals)
+
+	a.shutdown(reason, stages)
+	return err
 }
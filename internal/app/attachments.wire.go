@@ -0,0 +1,63 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/app/builder"
+	"pharmacy-modernization-project-model/internal/platform/attachments"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// wireAttachments creates the shared attachment store (MongoDB or Memory)
+func (a *App) wireAttachments(mongoConnMgr *database.ConnectionManager) attachments.Store {
+	if collection := builder.GetAttachmentsCollection(mongoConnMgr); collection != nil {
+		return attachments.NewMongoStore(collection, a.Logger.Base)
+	}
+
+	a.Logger.Base.Info("MongoDB not configured, attachments are kept in memory")
+	return attachments.NewMemoryStore()
+}
+
+// wireDocumentStorage creates the blob store holding patient documents and the virus scanner
+// checking them before they are stored
+func (a *App) wireDocumentStorage() (attachments.BlobStore, attachments.Scanner, error) {
+	cfg := a.Cfg.Documents
+
+	var blobs attachments.BlobStore
+	switch cfg.BlobStore.Type {
+	case "s3":
+		s3 := cfg.BlobStore.S3
+		store, err := attachments.NewS3BlobStore(attachments.S3Config{
+			Endpoint:        s3.Endpoint,
+			Region:          s3.Region,
+			Bucket:          s3.Bucket,
+			AccessKeyID:     s3.AccessKeyID,
+			SecretAccessKey: s3.SecretAccessKey,
+			UsePathStyle:    s3.UsePathStyle,
+			Timeout:         parseDuration(s3.Timeout, 30*time.Second),
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("patient document store: %w", err)
+		}
+		blobs = store
+		a.Logger.Base.Info("Patient documents are kept in S3", zap.String("bucket", s3.Bucket), zap.String("endpoint", s3.Endpoint))
+	default:
+		store, err := attachments.NewDiskBlobStore(cfg.BlobStore.Dir)
+		if err != nil {
+			return nil, nil, fmt.Errorf("patient document store: %w", err)
+		}
+		blobs = store
+		a.Logger.Base.Info("Patient documents are kept on disk", zap.String("dir", store.Dir()))
+	}
+
+	var scanner attachments.Scanner = attachments.NoopScanner{}
+	if cfg.VirusScan.Enabled {
+		scanner = attachments.NewClamdScanner(cfg.VirusScan.Address, parseDuration(cfg.VirusScan.Timeout, 30*time.Second))
+	} else {
+		a.Logger.Base.Warn("Virus scan disabled, patient documents are stored unscanned")
+	}
+	return blobs, scanner, nil
+}
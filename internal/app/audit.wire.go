@@ -0,0 +1,17 @@
+package app
+
+import (
+	"pharmacy-modernization-project-model/internal/app/builder"
+	"pharmacy-modernization-project-model/internal/platform/audit"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// wireAudit creates the shared audit trail store (MongoDB or Memory)
+func (a *App) wireAudit(mongoConnMgr *database.ConnectionManager) audit.Store {
+	if collection := builder.GetAuditLogCollection(mongoConnMgr); collection != nil {
+		return audit.NewMongoStore(collection, a.Logger.Base)
+	}
+
+	a.Logger.Base.Info("MongoDB not configured, audit trail is kept in memory")
+	return audit.NewMemoryStore()
+}
@@ -5,7 +5,10 @@ import "pharmacy-modernization-project-model/internal/platform/auth"
 func (a *App) wireAuth() error {
 	builder := auth.NewBuilder().
 		WithJWTConfig(a.Cfg.Auth.JWT.Cookie.Name).
+		WithSigningSecret(a.Cfg.Auth.JWT.Secret).
 		WithDevMode(a.Cfg.Auth.DevMode).
+		WithDevUsersFile(a.Cfg.Auth.DevUsersFile).
+		WithTenancy(a.Cfg.Auth.Tenancy.Enabled).
 		WithEnvironment(a.Cfg.App.Env).
 		WithLogger(a.Logger.Base)
 
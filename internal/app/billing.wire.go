@@ -0,0 +1,63 @@
+package app
+
+import (
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	billingModule "pharmacy-modernization-project-model/domain/billing"
+	billingservice "pharmacy-modernization-project-model/domain/billing/service"
+	billingworker "pharmacy-modernization-project-model/domain/billing/worker"
+	prescriptionModule "pharmacy-modernization-project-model/domain/prescription"
+	"pharmacy-modernization-project-model/internal/app/builder"
+	irisbilling "pharmacy-modernization-project-model/internal/integrations/iris_billing"
+	"pharmacy-modernization-project-model/internal/platform/cache"
+	"pharmacy-modernization-project-model/internal/platform/config"
+	"pharmacy-modernization-project-model/internal/platform/database"
+	"pharmacy-modernization-project-model/internal/platform/schemas"
+)
+
+// wireBilling mounts the billing API, IRIS webhooks and the reconciliation discrepancies, invoices prescriptions as they complete and corrects invoices of reversed dispenses
+func (a *App) wireBilling(r chi.Router, mongoConnMgr *database.ConnectionManager, retrier *database.Retrier, billingClient irisbilling.BillingClient, prescriptionMod prescriptionModule.ModuleExport, primaryCache cache.Cache, contracts *schemas.Registry, configReload *config.Watcher) billingModule.ModuleExport {
+	c := a.Cfg.Billing
+	billingMod := billingModule.Module(r, &billingModule.ModuleDependencies{
+		Logger:                       a.Logger.Base,
+		BillingClient:                billingClient,
+		PrescriptionProvider:         prescriptionMod.PrescriptionService,
+		CacheService:                 primaryCache,
+		Config:                       billingConfig(c),
+		WebhookSecret:                c.WebhookSecret,
+		Contracts:                    contracts,
+		EnforceContracts:             a.Cfg.Schemas.Enforce,
+		DiscrepanciesMongoCollection: builder.GetBillingDiscrepanciesCollection(mongoConnMgr),
+		Retrier:                      retrier,
+		Reconciliation: billingworker.ReconciliationJobConfig{
+			BatchSize:        a.Cfg.Scheduler.BillingReconciliation.BatchSize,
+			MaxPrescriptions: a.Cfg.Scheduler.BillingReconciliation.MaxPrescriptions,
+		},
+	})
+
+	configReload.Subscribe("billing",
+		[]string{"billing.cache_ttl", "billing.summary_cache_ttl", "billing.summary_max_stale",
+			"billing.estimate_cache_ttl", "billing.default_pricing.unit_price", "billing.default_pricing.drugs"},
+		func(cfg *config.Config) { billingMod.BillingService.UpdateConfig(billingConfig(cfg.Billing)) })
+
+	prescriptionMod.PrescriptionService.OnCompleted(billingMod.BillingService.HandlePrescriptionCompleted)
+	prescriptionMod.DispenseService.OnReversed(billingMod.BillingService.HandleDispenseReversed)
+	return billingMod
+}
+
+func billingConfig(c config.BillingConfig) billingservice.Config {
+	return billingservice.Config{
+		AutoInvoiceOnComplete: c.AutoInvoiceOnComplete,
+		DispensingFee:         c.DispensingFee,
+		CacheTTL:              parseDuration(c.CacheTTL, 5*time.Minute),
+		SummaryCacheTTL:       parseDuration(c.SummaryCacheTTL, 30*time.Second),
+		SummaryMaxStale:       parseDuration(c.SummaryMaxStale, 30*time.Minute),
+		EstimateCacheTTL:      parseDuration(c.EstimateCacheTTL, 2*time.Minute),
+		DefaultPricing: billingservice.PricingTable{
+			UnitPrice: c.DefaultPricing.UnitPrice,
+			Drugs:     c.DefaultPricing.Drugs,
+		},
+	}
+}
@@ -41,17 +41,19 @@ func CreateCacheMongoDBConnection(cfg *config.Config, logger *zap.Logger) (*data
 	}
 
 	mongoConfig := database.MongoDBConfig{
+		Name:     "cache",
 		URI:      cfg.Cache.MongoDB.URI,
 		Database: cfg.Cache.MongoDB.Database,
 		Collections: map[string]string{
 			"cache": cfg.Cache.MongoDB.Collection,
 		},
 		Connection: database.ConnectionConfig{
-			MaxPoolSize:    cfg.Cache.MongoDB.Connection.MaxPoolSize,
-			MinPoolSize:    cfg.Cache.MongoDB.Connection.MinPoolSize,
-			MaxIdleTime:    cfg.Cache.MongoDB.Connection.MaxIdleTime,
-			ConnectTimeout: cfg.Cache.MongoDB.Connection.ConnectTimeout,
-			SocketTimeout:  cfg.Cache.MongoDB.Connection.SocketTimeout,
+			MaxPoolSize:     cfg.Cache.MongoDB.Connection.MaxPoolSize,
+			MinPoolSize:     cfg.Cache.MongoDB.Connection.MinPoolSize,
+			MaxIdleTime:     cfg.Cache.MongoDB.Connection.MaxIdleTime,
+			ConnectTimeout:  cfg.Cache.MongoDB.Connection.ConnectTimeout,
+			SocketTimeout:   cfg.Cache.MongoDB.Connection.SocketTimeout,
+			SaturationAlarm: cfg.Cache.MongoDB.Connection.SaturationAlarm,
 		},
 		Options: database.OptionsConfig{
 			RetryWrites: true,
@@ -135,6 +137,7 @@ func (b *CacheBuilder) buildCacheConfig(strategy string, instanceConfig CacheIns
 		Strategy: strategy,
 		Memory:   memoryConfig,
 		MongoDB:  mongodbConfig,
+		Hybrid:   instanceConfig.Hybrid,
 	}
 }
 
@@ -155,6 +158,51 @@ func (b *CacheBuilder) configToMemory(cfg config.MemoryCacheConfig) cache.Memory
 	}
 }
 
+// BuildLoader creates the read-through loader of a service over the cache, configured by
+// cache.loaders.<service>. A service without configuration gets a loader that neither caches
+// not-found results nor coalesces loads.
+func (b *CacheBuilder) BuildLoader(c cache.Cache, service string) *cache.Loader {
+	cfg := b.config.Cache.Loaders[service]
+	negativeTTL := time.Duration(0)
+	if cfg.NegativeTTL != "" {
+		ttl, err := time.ParseDuration(cfg.NegativeTTL)
+		if err != nil || ttl < 0 {
+			b.logger.Warn("Invalid cache loader negative TTL, negative caching disabled",
+				zap.String("service", service),
+				zap.String("negative_ttl", cfg.NegativeTTL))
+		} else {
+			negativeTTL = ttl
+		}
+	}
+
+	b.logger.Info("Cache loader created",
+		zap.String("service", service),
+		zap.Duration("negative_ttl", negativeTTL),
+		zap.Bool("coalesce", cfg.Coalesce))
+	return cache.NewLoader(c, cache.LoaderConfig{NegativeTTL: negativeTTL, Coalesce: cfg.Coalesce}, platformErrors.IsNotFoundError)
+}
+
+// BuildSerializer creates the serializer the services encode cached values with, configured by
+// cache.serialization. Settings Validate refused cannot reach it, so an error falls back to JSON.
+func (b *CacheBuilder) BuildSerializer() *cache.Serializer {
+	cfg := b.config.Cache.Serialization
+	serializer, err := cache.NewSerializer(cache.SerializerConfig{
+		Codec:                cfg.Codec,
+		Compression:          cfg.Compression,
+		CompressionThreshold: cfg.CompressionMinBytes,
+	})
+	if err != nil {
+		b.logger.Warn("Invalid cache serialization, storing JSON", zap.Error(err))
+		return nil
+	}
+
+	b.logger.Info("Cache serializer created",
+		zap.String("codec", cfg.Codec),
+		zap.String("compression", cfg.Compression),
+		zap.Int("compression_min_bytes", cfg.CompressionMinBytes))
+	return serializer
+}
+
 // validateCacheConfig validates cache configuration based on strategy
 func (b *CacheBuilder) validateCacheConfig(strategy string, cfg CacheInstanceConfig) error {
 	switch strategy {
@@ -177,6 +225,7 @@ func (b *CacheBuilder) validateCacheConfig(strategy string, cfg CacheInstanceCon
 type CacheInstanceConfig struct {
 	Memory  cache.MemoryConfig
 	MongoDB cache.MongoDBConfig
+	Hybrid  cache.HybridConfig
 }
 
 // Helper methods for common cache configurations
@@ -193,15 +242,30 @@ func (b *CacheBuilder) BuildMemoryCache(maxCost int64) (cache.Cache, error) {
 	})
 }
 
-// BuildHybridCache creates a hybrid cache (memory + shared)
-func (b *CacheBuilder) BuildHybridCache(memoryMaxCost int64) (cache.Cache, error) {
+// BuildHybridCache creates a hybrid cache: a memory tier, sized by cache.memory, in front of the
+// MongoDB collection, with local copies kept at most cache.hybrid.local_ttl
+func (b *CacheBuilder) BuildHybridCache(collection *mongo.Collection, prefix string) (cache.Cache, error) {
+	if collection == nil {
+		return nil, platformErrors.NewConfigurationError("cache", "mongodb.collection", "MongoDB collection cannot be nil")
+	}
+
+	localTTL := 30 * time.Second
+	if raw := b.config.Cache.Hybrid.LocalTTL; raw != "" {
+		ttl, err := time.ParseDuration(raw)
+		if err != nil || ttl <= 0 {
+			b.logger.Warn("Invalid hybrid cache local TTL, using 30s", zap.String("local_ttl", raw))
+		} else {
+			localTTL = ttl
+		}
+	}
+
 	return b.BuildCache("hybrid", CacheInstanceConfig{
-		Memory: cache.MemoryConfig{
-			MaxCost:     memoryMaxCost,
-			BufferItems: 64,
-			Metrics:     true,
-			DefaultTTL:  30 * time.Minute,
+		MongoDB: cache.MongoDBConfig{
+			Collection: collection,
+			Prefix:     prefix,
+			TTLIndex:   true,
 		},
+		Hybrid: cache.HybridConfig{LocalTTL: localTTL},
 	})
 }
 
@@ -0,0 +1,27 @@
+package builder
+
+import (
+	"fmt"
+
+	"pharmacy-modernization-project-model/internal/platform/config"
+	"pharmacy-modernization-project-model/internal/platform/fieldcrypt"
+)
+
+// CreateFieldCipher creates the cipher for patient PHI from the configured keys. It returns nil
+// when field encryption is disabled.
+func CreateFieldCipher(cfg *config.Config) (*fieldcrypt.Cipher, error) {
+	enc := cfg.Encryption
+	if !enc.Enabled {
+		return nil, nil
+	}
+
+	provider, err := fieldcrypt.NewStaticKeyProvider(enc.Keys, enc.ActiveKey)
+	if err != nil {
+		return nil, fmt.Errorf("field_encryption: %w", err)
+	}
+	indexKey, err := fieldcrypt.DecodeKey(enc.IndexKey)
+	if err != nil {
+		return nil, fmt.Errorf("field_encryption.index_key: %w", err)
+	}
+	return fieldcrypt.New(provider, indexKey)
+}
@@ -23,24 +23,67 @@ func CreateMongoDBConnection(cfg *config.Config, logger *zap.Logger) (*database.
 	}
 
 	mongoConfig := database.MongoDBConfig{
+		Name:     "main",
 		URI:      cfg.Database.MongoDB.URI,
 		Database: cfg.Database.MongoDB.Database,
 		Collections: map[string]string{
-			"patients":      cfg.Database.MongoDB.Collections.Patients,
-			"addresses":     cfg.Database.MongoDB.Collections.Addresses,
-			"prescriptions": cfg.Database.MongoDB.Collections.Prescriptions,
+			"patients":                 cfg.Database.MongoDB.Collections.Patients,
+			"addresses":                cfg.Database.MongoDB.Collections.Addresses,
+			"prescriptions":            cfg.Database.MongoDB.Collections.Prescriptions,
+			"drug_interactions":        cfg.Database.MongoDB.Collections.DrugInteractions,
+			"drug_catalog":             cfg.Database.MongoDB.Collections.DrugCatalog,
+			"prescribers":              cfg.Database.MongoDB.Collections.Prescribers,
+			"measurements":             cfg.Database.MongoDB.Collections.Measurements,
+			"allergies":                cfg.Database.MongoDB.Collections.Allergies,
+			"audit_log":                cfg.Database.MongoDB.Collections.AuditLog,
+			"data_repairs":             cfg.Database.MongoDB.Collections.DataRepairs,
+			"dispenses":                cfg.Database.MongoDB.Collections.Dispenses,
+			"attachments":              cfg.Database.MongoDB.Collections.Attachments,
+			"insurance_records":        cfg.Database.MongoDB.Collections.InsuranceRecords,
+			"webhooks":                 cfg.Database.MongoDB.Collections.Webhooks,
+			"webhook_deliveries":       cfg.Database.MongoDB.Collections.WebhookDeliveries,
+			"access_reviews":           cfg.Database.MongoDB.Collections.AccessReviews,
+			"scheduler_locks":          cfg.Database.MongoDB.Collections.SchedulerLocks,
+			"job_runs":                 cfg.Database.MongoDB.Collections.JobRuns,
+			"jobs":                     cfg.Database.MongoDB.Collections.Jobs,
+			"capacity_status":          cfg.Database.MongoDB.Collections.CapacityStatus,
+			"patient_import_templates": cfg.Database.MongoDB.Collections.PatientImportTemplates,
+			"idempotency_keys":         cfg.Database.MongoDB.Collections.IdempotencyKeys,
+			"migrations":               cfg.Database.MongoDB.Collections.Migrations,
+			"transmissions":            cfg.Database.MongoDB.Collections.Transmissions,
+			"http_captures":            cfg.Database.MongoDB.Collections.HTTPCaptures,
+			"communications":           cfg.Database.MongoDB.Collections.Communications,
+			"billing_discrepancies":    cfg.Database.MongoDB.Collections.BillingDiscrepancies,
+			"patient_documents":        cfg.Database.MongoDB.Collections.PatientDocuments,
+			"prescription_drafts":      cfg.Database.MongoDB.Collections.PrescriptionDrafts,
+			"adherence_snapshots":      cfg.Database.MongoDB.Collections.AdherenceSnapshots,
+			"revoked_tokens":           cfg.Database.MongoDB.Collections.RevokedTokens,
 		},
 		Connection: database.ConnectionConfig{
-			MaxPoolSize:    cfg.Database.MongoDB.Connection.MaxPoolSize,
-			MinPoolSize:    cfg.Database.MongoDB.Connection.MinPoolSize,
-			MaxIdleTime:    cfg.Database.MongoDB.Connection.MaxIdleTime,
-			ConnectTimeout: cfg.Database.MongoDB.Connection.ConnectTimeout,
-			SocketTimeout:  cfg.Database.MongoDB.Connection.SocketTimeout,
+			MaxPoolSize:     cfg.Database.MongoDB.Connection.MaxPoolSize,
+			MinPoolSize:     cfg.Database.MongoDB.Connection.MinPoolSize,
+			MaxIdleTime:     cfg.Database.MongoDB.Connection.MaxIdleTime,
+			ConnectTimeout:  cfg.Database.MongoDB.Connection.ConnectTimeout,
+			SocketTimeout:   cfg.Database.MongoDB.Connection.SocketTimeout,
+			SaturationAlarm: cfg.Database.MongoDB.Connection.SaturationAlarm,
 		},
 		Options: database.OptionsConfig{
 			RetryWrites: cfg.Database.MongoDB.Options.RetryWrites,
 			RetryReads:  cfg.Database.MongoDB.Options.RetryReads,
 		},
+		ReadPreference: database.ReadPreferenceConfig{
+			Default:      cfg.Database.MongoDB.ReadPreference.Default,
+			Analytics:    cfg.Database.MongoDB.ReadPreference.Analytics,
+			MaxStaleness: cfg.Database.MongoDB.ReadPreference.MaxStaleness,
+		},
+		Concerns: database.ConcernConfig{
+			Read: cfg.Database.MongoDB.ReadConcern,
+			Write: database.WriteConcernConfig{
+				W:       cfg.Database.MongoDB.WriteConcern.W,
+				Journal: cfg.Database.MongoDB.WriteConcern.Journal,
+				Timeout: cfg.Database.MongoDB.WriteConcern.Timeout,
+			},
+		},
 	}
 
 	connMgr, err := database.NewConnectionManager(mongoConfig, logger)
@@ -81,3 +124,227 @@ func GetPrescriptionsCollection(mongoConnMgr *database.ConnectionManager) *mongo
 	}
 	return mongoConnMgr.GetCollection("prescriptions")
 }
+
+// GetDrugInteractionsCollection returns the drug interactions collection from MongoDB connection manager
+func GetDrugInteractionsCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("drug_interactions")
+}
+
+// GetDrugCatalogCollection returns the drug catalog collection from MongoDB connection manager
+func GetDrugCatalogCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("drug_catalog")
+}
+
+// GetPrescribersCollection returns the prescribers collection from MongoDB connection manager
+func GetPrescribersCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("prescribers")
+}
+
+// GetMeasurementsCollection returns the patient measurements collection from MongoDB connection manager
+func GetMeasurementsCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("measurements")
+}
+
+// GetAllergiesCollection returns the patient allergies collection from MongoDB connection manager
+func GetAllergiesCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("allergies")
+}
+
+// GetAuditLogCollection returns the audit trail collection from MongoDB connection manager
+func GetAuditLogCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("audit_log")
+}
+
+// GetDataRepairsCollection returns the data repair requests collection from MongoDB connection manager
+func GetDataRepairsCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("data_repairs")
+}
+
+// GetDispensesCollection returns the prescription dispense records collection from MongoDB connection manager
+func GetDispensesCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("dispenses")
+}
+
+// GetAttachmentsCollection returns the attachments collection from MongoDB connection manager
+func GetAttachmentsCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("attachments")
+}
+
+// GetInsuranceRecordsCollection returns the patient insurance records collection from MongoDB connection manager
+func GetInsuranceRecordsCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("insurance_records")
+}
+
+// GetWebhooksCollection returns the registered webhook endpoints collection from MongoDB connection manager
+func GetWebhooksCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("webhooks")
+}
+
+// GetWebhookDeliveriesCollection returns the webhook delivery log collection from MongoDB connection manager
+func GetWebhookDeliveriesCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("webhook_deliveries")
+}
+
+// GetAccessReviewsCollection returns the access review reports collection from MongoDB connection manager
+func GetAccessReviewsCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("access_reviews")
+}
+
+// GetSchedulerLocksCollection returns the scheduler job locks collection from MongoDB connection manager
+func GetSchedulerLocksCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("scheduler_locks")
+}
+
+// GetJobRunsCollection returns the scheduler job run history collection from MongoDB connection manager
+func GetJobRunsCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("job_runs")
+}
+
+// GetJobsCollection returns the background job queue collection from MongoDB connection manager
+func GetJobsCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("jobs")
+}
+
+// GetCapacityStatusCollection returns the collection capacity status collection from MongoDB connection manager
+func GetCapacityStatusCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("capacity_status")
+}
+
+// GetPatientImportTemplatesCollection returns the patient import templates collection from MongoDB connection manager
+func GetPatientImportTemplatesCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("patient_import_templates")
+}
+
+// GetIdempotencyKeysCollection returns the idempotency keys collection from MongoDB connection manager
+func GetIdempotencyKeysCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("idempotency_keys")
+}
+
+// GetRevokedTokensCollection returns the revoked refresh tokens collection from MongoDB connection manager
+func GetRevokedTokensCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("revoked_tokens")
+}
+
+// GetMigrationsCollection returns the migration history collection from MongoDB connection manager
+func GetMigrationsCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("migrations")
+}
+
+// GetTransmissionsCollection returns the prescription transmissions collection from MongoDB connection manager
+func GetTransmissionsCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("transmissions")
+}
+
+// GetHTTPCapturesCollection returns the captured external HTTP calls collection from MongoDB connection manager
+func GetHTTPCapturesCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("http_captures")
+}
+
+// GetCommunicationsCollection returns the patient communications log collection from MongoDB connection manager
+func GetCommunicationsCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("communications")
+}
+
+// GetBillingDiscrepanciesCollection returns the billing reconciliation discrepancies collection from MongoDB connection manager
+func GetBillingDiscrepanciesCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("billing_discrepancies")
+}
+
+// GetPatientDocumentsCollection returns the patient document metadata collection from MongoDB connection manager
+func GetPatientDocumentsCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("patient_documents")
+}
+
+// GetPrescriptionDraftsCollection returns the prescription drafts collection from MongoDB connection manager
+func GetPrescriptionDraftsCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("prescription_drafts")
+}
+
+// GetAdherenceSnapshotsCollection returns the nightly adherence snapshots collection from MongoDB connection manager
+func GetAdherenceSnapshotsCollection(mongoConnMgr *database.ConnectionManager) *mongo.Collection {
+	if mongoConnMgr == nil {
+		return nil
+	}
+	return mongoConnMgr.GetCollection("adherence_snapshots")
+}
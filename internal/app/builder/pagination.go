@@ -0,0 +1,23 @@
+package builder
+
+import (
+	"fmt"
+	"time"
+
+	"pharmacy-modernization-project-model/internal/platform/config"
+	"pharmacy-modernization-project-model/internal/platform/fieldcrypt"
+	"pharmacy-modernization-project-model/internal/platform/pagination"
+)
+
+// CreateCursorCodec creates the codec that seals pagination cursors with pagination.cursor_key
+func CreateCursorCodec(cfg *config.Config) (*pagination.Codec, error) {
+	if cfg.Pagination.CursorKey == "" {
+		return nil, fmt.Errorf("pagination.cursor_key is required")
+	}
+	key, err := fieldcrypt.DecodeKey(cfg.Pagination.CursorKey)
+	if err != nil {
+		return nil, fmt.Errorf("pagination.cursor_key: %w", err)
+	}
+	ttl, _ := time.ParseDuration(cfg.Pagination.CursorTTL)
+	return pagination.NewCodec(key, ttl)
+}
@@ -1,12 +1,24 @@
 package app
 
 import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
 
+	patientservice "pharmacy-modernization-project-model/domain/patient/service"
+	prescriptionservice "pharmacy-modernization-project-model/domain/prescription/service"
 	"pharmacy-modernization-project-model/internal/app/builder"
 	"pharmacy-modernization-project-model/internal/platform/cache"
+	cacheadmin "pharmacy-modernization-project-model/internal/platform/cache/admin"
+	"pharmacy-modernization-project-model/internal/platform/database"
 )
 
+// cacheKeyPrefix namespaces the application's entries in the MongoDB cache collection
+const cacheKeyPrefix = "rx:"
+
 func (a *App) wireCache() cache.Cache {
 	// Create separate MongoDB connection for cache
 	cacheMongoConnMgr, err := builder.CreateCacheMongoDBConnection(a.Cfg, a.Logger.Base)
@@ -14,15 +26,25 @@ func (a *App) wireCache() cache.Cache {
 		a.Logger.Base.Error("Failed to create cache MongoDB connection", zap.Error(err))
 		// Continue without cache MongoDB - will fallback to memory cache
 	}
+	if cacheMongoConnMgr != nil {
+		a.addCloser("cache_mongodb", cacheMongoConnMgr.Close)
+	}
 
 	// Create cache builder
 	cacheBuilder := builder.NewCacheBuilder(a.Cfg, a.Logger.Base)
 
-	// Create primary cache (MongoDB or Memory)
+	// Create primary cache (hybrid, MongoDB or Memory)
 	var primaryCache cache.Cache
 	if cacheMongoConnMgr != nil {
 		cacheCollection := builder.GetCacheMongoCollection(cacheMongoConnMgr, a.Cfg)
-		primaryCache, err = cacheBuilder.BuildMongoDBCache(cacheCollection, "rx:")
+		if a.Cfg.Cache.Hybrid.Enabled {
+			primaryCache, err = cacheBuilder.BuildHybridCache(cacheCollection, cacheKeyPrefix)
+			if err == nil {
+				a.wireHybridCacheInvalidation(cacheMongoConnMgr, primaryCache)
+			}
+		} else {
+			primaryCache, err = cacheBuilder.BuildMongoDBCache(cacheCollection, cacheKeyPrefix)
+		}
 		if err != nil {
 			a.Logger.Base.Warn("Failed to create MongoDB cache, falling back to memory cache", zap.Error(err))
 			primaryCache, _ = cacheBuilder.BuildMemoryCache(67108864) // 64MB fallback
@@ -31,5 +53,87 @@ func (a *App) wireCache() cache.Cache {
 		a.Logger.Base.Info("Cache MongoDB not configured, using memory cache")
 		primaryCache, _ = cacheBuilder.BuildMemoryCache(67108864) // 64MB fallback
 	}
+	a.addCloser("cache", primaryCache.Close)
 	return primaryCache
 }
+
+// wireHybridCacheInvalidation watches the MongoDB cache collection and drops the memory copy of
+// every entry written, deleted or expired there, so an instance stops serving a value another
+// instance replaced without waiting for cache.hybrid.local_ttl. The instance's own writes are seen
+// too; they only cost one MongoDB read on the next lookup.
+func (a *App) wireHybridCacheInvalidation(cacheMongoConnMgr *database.ConnectionManager, primaryCache cache.Cache) {
+	tiered, ok := cache.AsTiered(primaryCache)
+	if !ok || !a.Cfg.Cache.Hybrid.Watch {
+		return
+	}
+
+	listener := database.NewChangeStreamListener(cacheMongoConnMgr, a.Logger.Base)
+	listener.Watch("cache", func(ctx context.Context, event database.ChangeEvent) {
+		if key, ok := strings.CutPrefix(event.DocumentID, cacheKeyPrefix); ok {
+			tiered.Invalidate(key)
+		}
+	})
+	a.addWorker("cache_hybrid_invalidation", stageListeners, listener.Run)
+}
+
+// wireCacheAdmin mounts the admin API and page with the cache statistics, keys and invalidation
+func (a *App) wireCacheAdmin(r chi.Router, primaryCache cache.Cache) {
+	cacheadmin.NewHandler(map[string]cache.Cache{"primary": primaryCache}, a.Logger.Base).RegisterRoutes(r)
+}
+
+// wireCacheSerializer creates the encoding of cached values, see cache.serialization in app.yaml
+func (a *App) wireCacheSerializer() *cache.Serializer {
+	return builder.NewCacheBuilder(a.Cfg, a.Logger.Base).BuildSerializer()
+}
+
+// wireCacheLoader creates the read-through loader of a service, see cache.loaders in app.yaml
+func (a *App) wireCacheLoader(primaryCache cache.Cache, service string) *cache.Loader {
+	return builder.NewCacheBuilder(a.Cfg, a.Logger.Base).BuildLoader(primaryCache, service)
+}
+
+// wireCacheInvalidation watches the domain collections and evicts cache entries on every write,
+// so per-instance caches stay consistent across replicas
+func (a *App) wireCacheInvalidation(mongoConnMgr *database.ConnectionManager, primaryCache cache.Cache) {
+	if mongoConnMgr == nil || !a.Cfg.Database.MongoDB.ChangeStreams.Enabled {
+		return
+	}
+
+	listener := database.NewChangeStreamListener(mongoConnMgr, a.Logger.Base)
+	listener.Watch("patients", patientservice.NewCacheInvalidator(primaryCache, a.Logger.Base).HandleChange)
+	listener.WatchWithPreImages("prescriptions", prescriptionservice.NewCacheInvalidator(primaryCache, a.Logger.Base).HandleChange)
+
+	a.addWorker("cache_invalidation", stageListeners, listener.Run)
+}
+
+// wireCacheWarmup preloads the most recently updated patients and the prescription counts by
+// status before the server starts. It blocks startup for at most cache.warmup.timeout; entries
+// that fail to load are simply fetched on first use.
+func (a *App) wireCacheWarmup(patients patientservice.PatientService, prescriptions prescriptionservice.PrescriptionService) {
+	cfg := a.Cfg.Cache.Warmup
+	if !cfg.Enabled {
+		return
+	}
+	start := time.Now()
+	ctx := context.Background()
+
+	warmer := cache.NewWarmer(cache.WarmupConfig{
+		Concurrency: cfg.Concurrency,
+		Jitter:      parseDuration(cfg.Jitter, 0),
+		Timeout:     parseDuration(cfg.Timeout, 30*time.Second),
+	})
+	patientTasks, err := patients.CacheWarmupTasks(ctx, cfg.Patients)
+	if err != nil {
+		a.Logger.Base.Warn("Failed to list patients for cache warm-up", zap.Error(err))
+	}
+	warmer.Add("patients", patientTasks...)
+	warmer.Add("prescription_counts", prescriptions.CacheWarmupTasks()...)
+
+	results := warmer.Run(ctx)
+	fields := []zap.Field{zap.Duration("duration", time.Since(start))}
+	for _, result := range results {
+		fields = append(fields,
+			zap.Int(result.Group+"_loaded", result.Loaded),
+			zap.Int(result.Group+"_failed", result.Failed))
+	}
+	a.Logger.Base.Info("Cache warm-up completed", fields...)
+}
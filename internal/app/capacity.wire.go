@@ -0,0 +1,56 @@
+package app
+
+import (
+	"sort"
+	"time"
+
+	"pharmacy-modernization-project-model/internal/app/builder"
+	"pharmacy-modernization-project-model/internal/platform/capacity"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// megabyte converts the MB limits from config into bytes
+const megabyte = 1 << 20
+
+// wireCapacity creates the collection capacity monitor and the sampler that writers consult while
+// a collection is over its hard limit. Both are nil when the monitor is disabled or MongoDB is not
+// configured; the monitor runs on the scheduler.
+func (a *App) wireCapacity(mongoConnMgr *database.ConnectionManager) (*capacity.Monitor, *capacity.Sampler, error) {
+	cfg := a.Cfg.Scheduler.CapacityMonitor
+	if !cfg.Enabled {
+		return nil, nil, nil
+	}
+	status := builder.GetCapacityStatusCollection(mongoConnMgr)
+	if status == nil {
+		a.Logger.Base.Info("MongoDB not configured, collection capacity is not monitored")
+		return nil, nil, nil
+	}
+
+	limits := make([]capacity.Limit, 0, len(cfg.Collections))
+	for name, c := range cfg.Collections {
+		limits = append(limits, capacity.Limit{
+			Collection:     name,
+			SoftBytes:      c.SoftLimitMB * megabyte,
+			HardBytes:      c.HardLimitMB * megabyte,
+			IndexSoftBytes: c.IndexSoftLimitMB * megabyte,
+			IndexHardBytes: c.IndexHardLimitMB * megabyte,
+			Mitigation:     capacity.Mitigation(c.Mitigation),
+			ArchiveField:   c.ArchiveField,
+			ArchiveAfter:   time.Duration(c.ArchiveAfterDays) * 24 * time.Hour,
+		})
+	}
+	sort.Slice(limits, func(i, j int) bool { return limits[i].Collection < limits[j].Collection })
+
+	store := capacity.NewMongoStore(mongoConnMgr.GetCollection, status)
+	sampler := capacity.NewSampler(store, cfg.SampleRate, a.Logger.Base)
+	monitor, err := capacity.NewMonitor(store, sampler, a.Logger.Base, capacity.MonitorConfig{
+		Limits:           limits,
+		ArchiveBatchSize: cfg.ArchiveBatchSize,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a.addWorker("capacity_sampler", stageProducers, sampler.Run)
+	return monitor, sampler, nil
+}
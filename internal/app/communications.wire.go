@@ -0,0 +1,102 @@
+package app
+
+import (
+	"context"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	billingModule "pharmacy-modernization-project-model/domain/billing"
+	billingmodel "pharmacy-modernization-project-model/domain/billing/contracts/model"
+	communicationsModule "pharmacy-modernization-project-model/domain/communications"
+	commservice "pharmacy-modernization-project-model/domain/communications/service"
+	patientModule "pharmacy-modernization-project-model/domain/patient"
+	prescriptionModule "pharmacy-modernization-project-model/domain/prescription"
+	prescriptionmodel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/internal/app/builder"
+	"pharmacy-modernization-project-model/internal/integrations/notifications"
+	"pharmacy-modernization-project-model/internal/platform/database"
+	"pharmacy-modernization-project-model/internal/platform/jobs"
+)
+
+// Job types of patient notifications
+const (
+	jobNotifyPrescriptionActive = "communications.prescription_active"
+	jobNotifyInvoiceCreated     = "communications.invoice_created"
+)
+
+// Notification job payloads carry identifiers only; the job reads the patient when it runs
+type prescriptionActiveJob struct {
+	PrescriptionID string `bson:"prescription_id"`
+}
+
+type invoiceCreatedJob struct {
+	InvoiceID      string  `bson:"invoice_id"`
+	PrescriptionID string  `bson:"prescription_id"`
+	PatientID      string  `bson:"patient_id,omitempty"`
+	Amount         float64 `bson:"amount"`
+}
+
+// wireCommunications mounts the communications log API and, when enabled, notifies patients
+// when a prescription becomes active or an invoice is created. Messages are sent by the job
+// queue, so a slow or failing provider neither delays nor fails the change, and a failed send is
+// retried; the job ID is the message ID, so a retry does not send a message already logged as sent.
+func (a *App) wireCommunications(r chi.Router, mongoConnMgr *database.ConnectionManager, retrier *database.Retrier, queue *jobs.Queue, sender notifications.NotificationSender, patientMod patientModule.ModuleExport, prescriptionMod prescriptionModule.ModuleExport, billingMod billingModule.ModuleExport) {
+	cfg := a.Cfg.Comms
+	commMod := communicationsModule.Module(r, &communicationsModule.ModuleDependencies{
+		Logger:                        a.Logger.Base,
+		NotificationSender:            sender,
+		Patients:                      patientMod.PatientService,
+		Prescriptions:                 prescriptionMod.PrescriptionService,
+		CommunicationsMongoCollection: builder.GetCommunicationsCollection(mongoConnMgr),
+		Retrier:                       retrier,
+		Config:                        commservice.Config{PharmacyName: cfg.PharmacyName},
+	})
+	if !cfg.Enabled {
+		a.Logger.Base.Info("Patient notifications disabled")
+		return
+	}
+
+	svc := commMod.CommunicationService
+	jobs.Handle(queue, jobNotifyPrescriptionActive, func(ctx context.Context, p prescriptionActiveJob) error {
+		job, _ := jobs.Current(ctx)
+		_, err := svc.NotifyPrescriptionActive(ctx, job.ID, p.PrescriptionID)
+		return err
+	})
+	jobs.Handle(queue, jobNotifyInvoiceCreated, func(ctx context.Context, p invoiceCreatedJob) error {
+		job, _ := jobs.Current(ctx)
+		_, err := svc.NotifyInvoiceCreated(ctx, job.ID, billingmodel.Invoice{
+			ID:             p.InvoiceID,
+			PrescriptionID: p.PrescriptionID,
+			PatientID:      p.PatientID,
+			Amount:         p.Amount,
+		})
+		return err
+	})
+
+	enqueue := func(ctx context.Context, jobType string, payload any) {
+		if _, err := queue.Enqueue(ctx, jobType, payload, jobs.EnqueueOptions{}); err != nil {
+			a.Logger.Base.Warn("Failed to queue patient notification", zap.String("type", jobType), zap.Error(err))
+		}
+	}
+	prescriptionMod.PrescriptionService.OnCreated(func(ctx context.Context, prescription prescriptionmodel.Prescription) {
+		if prescription.Status == prescriptionmodel.Active {
+			enqueue(ctx, jobNotifyPrescriptionActive, prescriptionActiveJob{PrescriptionID: prescription.ID})
+		}
+	})
+	prescriptionMod.PrescriptionService.OnStatusChanged(func(ctx context.Context, prescription prescriptionmodel.Prescription, previous prescriptionmodel.Status) {
+		if prescription.Status == prescriptionmodel.Active && previous != prescriptionmodel.Active {
+			enqueue(ctx, jobNotifyPrescriptionActive, prescriptionActiveJob{PrescriptionID: prescription.ID})
+		}
+	})
+	billingMod.BillingService.OnInvoiceCreated(func(ctx context.Context, invoice billingmodel.Invoice) {
+		enqueue(ctx, jobNotifyInvoiceCreated, invoiceCreatedJob{
+			InvoiceID:      invoice.ID,
+			PrescriptionID: invoice.PrescriptionID,
+			PatientID:      invoice.PatientID,
+			Amount:         invoice.Amount,
+		})
+	})
+
+	a.Logger.Base.Info("Patient notifications enabled")
+}
@@ -0,0 +1,19 @@
+package app
+
+import (
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/config"
+)
+
+// wireConfigReload creates the watcher that passes reloaded settings to the components subscribed
+// to them; wire starts it once every component has subscribed, if config_reload is enabled
+func (a *App) wireConfigReload() *config.Watcher {
+	watcher := config.NewWatcher(a.Cfg, a.Logger.Base)
+	watcher.Subscribe("logging", []string{"logging.level"}, func(cfg *config.Config) {
+		if err := a.Logger.SetLevel(cfg.Logging.Level); err != nil {
+			a.Logger.Base.Error("Failed to apply reloaded log level", zap.Error(err))
+		}
+	})
+	return watcher
+}
@@ -0,0 +1,37 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	dashboardpage "pharmacy-modernization-project-model/domain/dashboard/ui/dashboard_page"
+	prescriptionModule "pharmacy-modernization-project-model/domain/prescription"
+	prescriptionmodel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/sse"
+)
+
+// wireDashboardLiveUpdates configures the event stream of the dashboard page. Open dashboards are
+// pushed right after a prescription is created or changes status on this instance; changes made
+// on other instances show up with the next interval.
+func (a *App) wireDashboardLiveUpdates(prescriptionMod prescriptionModule.ModuleExport) dashboardpage.LiveUpdates {
+	cfg := a.Cfg.Dashboard.LiveUpdates
+	live := dashboardpage.LiveUpdates{
+		Enabled:           cfg.Enabled,
+		Interval:          parseDuration(cfg.Interval, 30*time.Second),
+		HeartbeatInterval: parseDuration(cfg.HeartbeatInterval, 15*time.Second),
+		Changes:           sse.NewBroker(),
+	}
+	if !cfg.Enabled {
+		return live
+	}
+	// Open dashboards reconnect elsewhere instead of holding up the shutdown
+	a.onShutdown = append(a.onShutdown, live.Changes.Close)
+
+	prescriptionMod.PrescriptionService.OnCreated(func(context.Context, prescriptionmodel.Prescription) {
+		live.Changes.Publish()
+	})
+	prescriptionMod.PrescriptionService.OnStatusChanged(func(context.Context, prescriptionmodel.Prescription, prescriptionmodel.Status) {
+		live.Changes.Publish()
+	})
+	return live
+}
@@ -0,0 +1,43 @@
+package app
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	dataRepairModule "pharmacy-modernization-project-model/domain/datarepair"
+	repairservice "pharmacy-modernization-project-model/domain/datarepair/service"
+	patientrepo "pharmacy-modernization-project-model/domain/patient/repository"
+	patientservice "pharmacy-modernization-project-model/domain/patient/service"
+	prescriptionservice "pharmacy-modernization-project-model/domain/prescription/service"
+	"pharmacy-modernization-project-model/internal/app/builder"
+	"pharmacy-modernization-project-model/internal/platform/audit"
+	"pharmacy-modernization-project-model/internal/platform/cache"
+	"pharmacy-modernization-project-model/internal/platform/database"
+	"pharmacy-modernization-project-model/internal/platform/fieldcrypt"
+)
+
+// wireDataRepair mounts the break-fix data repair API used by support engineers
+func (a *App) wireDataRepair(r chi.Router, mongoConnMgr *database.ConnectionManager, transactions database.TransactionRunner, retrier *database.Retrier, auditStore audit.Store, primaryCache cache.Cache, fieldCipher *fieldcrypt.Cipher) {
+	// Patches would write encrypted patient fields in plaintext, without their blind indexes
+	protected := map[string][]string{}
+	if fieldCipher != nil {
+		protected["patients"] = patientrepo.EncryptedFields
+	}
+
+	repairMod := dataRepairModule.Module(r, &dataRepairModule.ModuleDependencies{
+		Logger:                 a.Logger.Base,
+		MongoConnection:        mongoConnMgr,
+		RepairsMongoCollection: builder.GetDataRepairsCollection(mongoConnMgr),
+		Transactions:           transactions,
+		Retrier:                retrier,
+		AuditStore:             auditStore,
+		Config: repairservice.Config{
+			RequireSecondApprover: a.Cfg.DataRepair.RequireSecondApprover,
+			Collections:           a.Cfg.DataRepair.Collections,
+			ProtectedFields:       protected,
+		},
+	})
+
+	// Repaired documents bypass the domain services, so evict their cache entries directly
+	repairMod.RepairService.OnExecuted("patients", patientservice.NewCacheInvalidator(primaryCache, a.Logger.Base).HandleChange)
+	repairMod.RepairService.OnExecuted("prescriptions", prescriptionservice.NewCacheInvalidator(primaryCache, a.Logger.Base).HandleChange)
+}
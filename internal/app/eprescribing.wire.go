@@ -0,0 +1,40 @@
+package app
+
+import (
+	"time"
+
+	eprescribingModule "pharmacy-modernization-project-model/domain/eprescribing"
+	transmissionservice "pharmacy-modernization-project-model/domain/eprescribing/service"
+	transmissionworker "pharmacy-modernization-project-model/domain/eprescribing/worker"
+	patientModule "pharmacy-modernization-project-model/domain/patient"
+	prescriptionModule "pharmacy-modernization-project-model/domain/prescription"
+	"pharmacy-modernization-project-model/internal/app/builder"
+	irispharmacy "pharmacy-modernization-project-model/internal/integrations/iris_pharmacy"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// wireEPrescribing sends prescriptions to their pharmacy as NCPDP SCRIPT messages
+func (a *App) wireEPrescribing(mongoConnMgr *database.ConnectionManager, retrier *database.Retrier, pharmacyClient irispharmacy.PharmacyClient, patientMod patientModule.ModuleExport, prescriptionMod prescriptionModule.ModuleExport) eprescribingModule.ModuleExport {
+	c := a.Cfg.Workers.Transmissions
+	return eprescribingModule.Module(&eprescribingModule.ModuleDependencies{
+		Logger:                       a.Logger.Base,
+		PharmacyClient:               pharmacyClient,
+		Patients:                     patientMod.PatientService,
+		Addresses:                    patientMod.AddressService,
+		Prescriptions:                prescriptionMod.PrescriptionService,
+		Prescribers:                  prescriptionMod.PrescriberService,
+		TransmissionsMongoCollection: builder.GetTransmissionsCollection(mongoConnMgr),
+		Retrier:                      retrier,
+		Transmission: transmissionservice.Config{
+			MaxAttempts:   c.MaxAttempts,
+			RetryBackoff:  parseDuration(c.RetryBackoff, time.Minute),
+			MaxBackoff:    parseDuration(c.MaxBackoff, 30*time.Minute),
+			PollInterval:  parseDuration(c.PollInterval, time.Minute),
+			VerifyTimeout: parseDuration(c.VerifyTimeout, 24*time.Hour),
+		},
+		Worker: transmissionworker.TransmissionWorkerConfig{
+			Interval:  parseDuration(c.Interval, 30*time.Second),
+			BatchSize: c.BatchSize,
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package app
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/events"
+	"pharmacy-modernization-project-model/internal/platform/logging"
+)
+
+// wireEvents creates the bus the services publish their domain events on. The async bus is
+// delivered by an outbox-stage worker, so events raised by the last requests and jobs still
+// reach the webhook store before it closes.
+func (a *App) wireEvents() events.Bus {
+	var bus events.Bus
+	if a.Cfg.Events.Mode == "sync" {
+		a.Logger.Base.Info("Domain events are delivered synchronously")
+		bus = events.NewSyncBus(a.Logger.Base)
+	} else {
+		async := events.NewAsyncBus(events.AsyncConfig{QueueSize: a.Cfg.Events.QueueSize}, a.Logger.Base)
+		a.addWorker("domain_events", stageOutbox, async.Run)
+		bus = async
+	}
+
+	bus.Subscribe(func(ctx context.Context, envelope events.Envelope) {
+		logging.WithContext(ctx, a.Logger.Base).Debug("Domain event",
+			zap.String("event_type", envelope.Type),
+			zap.String("event_id", envelope.ID),
+			zap.Int("schema_version", envelope.SchemaVersion),
+			zap.String("actor", envelope.Actor))
+	})
+	return bus
+}
@@ -0,0 +1,26 @@
+package app
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	patientModule "pharmacy-modernization-project-model/domain/patient"
+	prescriptionModule "pharmacy-modernization-project-model/domain/prescription"
+	"pharmacy-modernization-project-model/internal/interop/fhir"
+)
+
+// wireFHIR serves patients and prescriptions as FHIR resources for other health systems
+func (a *App) wireFHIR(r chi.Router, patientMod patientModule.ModuleExport, prescriptionMod prescriptionModule.ModuleExport) {
+	identifierSystem := a.Cfg.FHIR.IdentifierSystem
+	if identifierSystem == "" {
+		identifierSystem = "urn:rx"
+	}
+	fhir.Mount(r, &fhir.Dependencies{
+		PatientService:           patientMod.PatientService,
+		AddressService:           patientMod.AddressService,
+		PrescriptionService:      prescriptionMod.PrescriptionService,
+		PrescriberService:        prescriptionMod.PrescriberService,
+		IdentifierSystem:         identifierSystem,
+		InboundIdentifierSystems: a.Cfg.FHIR.InboundIdentifierSystems,
+		Logger:                   a.Logger.Base,
+	})
+}
@@ -0,0 +1,23 @@
+package app
+
+import (
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/app/builder"
+	"pharmacy-modernization-project-model/internal/platform/fieldcrypt"
+)
+
+// wireFieldEncryption creates the cipher that encrypts patient PHI at rest
+func (a *App) wireFieldEncryption() (*fieldcrypt.Cipher, error) {
+	cipher, err := builder.CreateFieldCipher(a.Cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cipher == nil {
+		a.Logger.Base.Warn("Field encryption is disabled, patient PHI is stored in plaintext")
+		return nil, nil
+	}
+
+	a.Logger.Base.Info("Field encryption enabled", zap.String("active_key", cipher.ActiveKeyID()))
+	return cipher, nil
+}
@@ -0,0 +1,22 @@
+package app
+
+import (
+	patientModule "pharmacy-modernization-project-model/domain/patient"
+	prescriptionModule "pharmacy-modernization-project-model/domain/prescription"
+	"pharmacy-modernization-project-model/internal/grpc"
+	"pharmacy-modernization-project-model/internal/platform/redaction"
+)
+
+// wireGRPC creates the gRPC API for internal services, started by Run on its own port
+func (a *App) wireGRPC(patientMod patientModule.ModuleExport, prescriptionMod prescriptionModule.ModuleExport, redactor *redaction.Redactor) {
+	if !a.Cfg.GRPC.Enabled {
+		return
+	}
+	a.GRPC = grpc.NewServer(&grpc.Dependencies{
+		PatientService:      patientMod.PatientService,
+		PrescriptionService: prescriptionMod.PrescriptionService,
+		Redactor:            redactor,
+		Reflection:          a.Cfg.GRPC.Reflection,
+		Logger:              a.Logger.Base,
+	})
+}
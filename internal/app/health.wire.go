@@ -0,0 +1,54 @@
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"pharmacy-modernization-project-model/internal/platform/cache"
+	"pharmacy-modernization-project-model/internal/platform/database"
+	"pharmacy-modernization-project-model/internal/platform/health"
+	"pharmacy-modernization-project-model/internal/platform/paths"
+)
+
+// wireHealth mounts the unauthenticated liveness and readiness endpoints
+func (a *App) wireHealth(r chi.Router, mongoConnMgr *database.ConnectionManager, primaryCache cache.Cache) {
+	external := a.Cfg.External
+
+	readiness := health.NewAggregator(3*time.Second,
+		// A live MongoDB connection that fails makes the app unhealthy;
+		// running on the in-memory fallback only degrades it
+		health.MongoCheck("mongodb", mongoConnMgr, mongoConnMgr != nil),
+		health.CacheCheck("cache", primaryCache),
+		health.ReachabilityCheck("iris_pharmacy", external.Pharmacy.Endpoints.GetPrescription, external.Pharmacy.UseMock),
+		health.ReachabilityCheck("iris_billing", external.Billing.Endpoints.GetInvoice, external.Billing.UseMock),
+	)
+
+	// Liveness: the process is up and serving requests
+	r.Get(paths.LivenessPath, func(w http.ResponseWriter, r *http.Request) {
+		writeHealthJSON(w, http.StatusOK, map[string]interface{}{
+			"status":    health.StatusHealthy,
+			"timestamp": time.Now(),
+		})
+	})
+
+	// Readiness: dependencies are available; degraded still accepts traffic
+	r.Get(paths.ReadinessPath, func(w http.ResponseWriter, r *http.Request) {
+		report := readiness.Run(r.Context())
+
+		status := http.StatusOK
+		if report.Status == health.StatusUnhealthy {
+			status = http.StatusServiceUnavailable
+		}
+		writeHealthJSON(w, status, report)
+	})
+}
+
+func writeHealthJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,54 @@
+package app
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/app/builder"
+	"pharmacy-modernization-project-model/internal/platform/config"
+	"pharmacy-modernization-project-model/internal/platform/database"
+	"pharmacy-modernization-project-model/internal/platform/httpclient"
+)
+
+// wireHTTPCapture creates the interceptor recording sampled IRIS request and response bodies.
+// It is always installed so that a config reload can turn it on without a restart.
+func (a *App) wireHTTPCapture(mongoConnMgr *database.ConnectionManager, configReload *config.Watcher) *httpclient.BodyCapture {
+	cfg := a.Cfg.External.HTTP.Capture
+
+	var sink httpclient.CaptureSink = httpclient.NewLogCaptureSink(a.Logger.Base)
+	if cfg.Sink == "collection" {
+		if collection := builder.GetHTTPCapturesCollection(mongoConnMgr); collection != nil {
+			sink = httpclient.NewMongoCaptureSink(collection, parseDuration(cfg.TTL, 72*time.Hour), a.Logger.Base)
+		} else {
+			a.Logger.Base.Info("MongoDB not configured, http captures are written to the log")
+		}
+	}
+
+	capture := httpclient.NewBodyCapture(captureConfig(cfg), sink)
+	configReload.Subscribe("http_capture", []string{
+		"external.http.capture.enabled",
+		"external.http.capture.sample_rate",
+		"external.http.capture.capture_errors",
+		"external.http.capture.max_body_bytes",
+	}, func(cfg *config.Config) {
+		capture.Apply(captureConfig(cfg.External.HTTP.Capture))
+		a.Logger.Base.Info("Applied reloaded http capture settings",
+			zap.Bool("enabled", cfg.External.HTTP.Capture.Enabled),
+			zap.Float64("sample_rate", cfg.External.HTTP.Capture.SampleRate))
+	})
+	if cfg.Enabled {
+		a.Logger.Base.Info("HTTP body capture enabled for IRIS calls",
+			zap.Float64("sample_rate", cfg.SampleRate), zap.String("sink", cfg.Sink))
+	}
+	return capture
+}
+
+func captureConfig(cfg config.HTTPCaptureConfig) httpclient.CaptureConfig {
+	return httpclient.CaptureConfig{
+		Enabled:       cfg.Enabled,
+		SampleRate:    cfg.SampleRate,
+		CaptureErrors: cfg.CaptureErrors,
+		MaxBodyBytes:  cfg.MaxBodyBytes,
+	}
+}
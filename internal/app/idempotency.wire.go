@@ -0,0 +1,41 @@
+package app
+
+import (
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/app/builder"
+	"pharmacy-modernization-project-model/internal/platform/database"
+	"pharmacy-modernization-project-model/internal/platform/idempotency"
+)
+
+// wireIdempotency replays stored responses to POST and PUT API requests retried with the same
+// X-Idempotency-Key. It runs inside redaction, so replayed responses are redacted like fresh ones.
+func (a *App) wireIdempotency(r chi.Router, mongoConnMgr *database.ConnectionManager) {
+	cfg := a.Cfg.Idempotency
+	if !cfg.Enabled {
+		return
+	}
+
+	var store idempotency.Store
+	if collection := builder.GetIdempotencyKeysCollection(mongoConnMgr); collection != nil {
+		store = idempotency.NewMongoStore(collection, a.Logger.Base)
+	} else {
+		a.Logger.Base.Info("MongoDB not configured, idempotency keys are kept in memory")
+		store = idempotency.NewMemoryStore()
+	}
+
+	maxBodyKB := cfg.MaxBodyKB
+	if maxBodyKB <= 0 {
+		maxBodyKB = 1024
+	}
+	ttl := parseDuration(cfg.TTL, 24*time.Hour)
+	r.Use(idempotency.Middleware(store, idempotency.Config{
+		TTL:            ttl,
+		PendingTimeout: parseDuration(cfg.PendingTimeout, 90*time.Second),
+		MaxBodyBytes:   int64(maxBodyKB) << 10,
+	}, a.Logger.Base))
+	a.Logger.Base.Info("Idempotency keys enabled for mutating API requests", zap.Duration("ttl", ttl))
+}
@@ -0,0 +1,17 @@
+package app
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"pharmacy-modernization-project-model/internal/integrations"
+	integrationsadmin "pharmacy-modernization-project-model/internal/integrations/admin"
+)
+
+// wireIntegrationsStatus mounts the admin API and page showing which integrations are mocked,
+// their endpoints, their last successful call and their circuit state
+func (a *App) wireIntegrationsStatus(r chi.Router, status *integrations.Status) {
+	if status == nil {
+		return
+	}
+	integrationsadmin.NewHandler(status, a.Logger.Base).RegisterRoutes(r)
+}
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/app/builder"
+	"pharmacy-modernization-project-model/internal/platform/database"
+	"pharmacy-modernization-project-model/internal/platform/jobs"
+)
+
+// wireJobQueue creates the background job queue (MongoDB or Memory). Modules register their
+// typed handlers on it with jobs.Handle while they are wired; wireJobs starts it afterwards.
+func (a *App) wireJobQueue(mongoConnMgr *database.ConnectionManager) *jobs.Queue {
+	cfg := a.Cfg.Jobs
+	return jobs.New(a.jobStore(mongoConnMgr), jobs.Config{
+		Workers:           cfg.Workers,
+		PollInterval:      parseDuration(cfg.PollInterval, 2*time.Second),
+		VisibilityTimeout: parseDuration(cfg.VisibilityTimeout, 5*time.Minute),
+		MaxAttempts:       cfg.MaxAttempts,
+		BaseBackoff:       parseDuration(cfg.BaseBackoff, 30*time.Second),
+		MaxBackoff:        parseDuration(cfg.MaxBackoff, time.Hour),
+	}, a.Logger.Base)
+}
+
+// wireJobs mounts the job status API and, unless disabled, starts the queue's workers with the
+// other workers
+func (a *App) wireJobs(r chi.Router, queue *jobs.Queue) {
+	jobs.NewHandler(queue, a.Logger.Base).RegisterRoutes(r)
+
+	if !a.Cfg.Jobs.Enabled {
+		a.Logger.Base.Info("Job queue workers disabled, jobs are only enqueued on this instance")
+		return
+	}
+	a.addWorker("job_queue", stageJobs, queue.Run)
+}
+
+// jobStore creates the job queue store (MongoDB or Memory)
+func (a *App) jobStore(mongoConnMgr *database.ConnectionManager) jobs.Store {
+	if collection := builder.GetJobsCollection(mongoConnMgr); collection != nil {
+		store := jobs.NewMongoStore(collection, a.Logger.Base)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		retention := time.Duration(a.Cfg.Jobs.RetentionDays) * 24 * time.Hour
+		if err := store.CreateIndexes(ctx, retention); err != nil {
+			a.Logger.Base.Warn("Failed to create job queue indexes", zap.Error(err))
+		}
+		return store
+	}
+
+	a.Logger.Base.Info("MongoDB not configured, the job queue is kept in memory")
+	return jobs.NewMemoryStore()
+}
@@ -0,0 +1,180 @@
+package app
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// stopStage orders the background workers at shutdown: every worker of a stage has stopped, or
+// timed out, before the workers of the next stage are told to stop
+type stopStage int
+
+const (
+	// stageProducers are the schedulers and pollers that start new work
+	stageProducers stopStage = iota
+	// stageJobs is the job queue, which runs the work they enqueue
+	stageJobs
+	// stageOutbox is the domain event bus and the webhook dispatcher, which deliver the events
+	// requests and jobs record
+	stageOutbox
+	// stageListeners are the change stream listeners, which keep caches consistent until the last write
+	stageListeners
+
+	stageCount
+)
+
+var stageNames = [stageCount]string{"producers", "jobs", "outbox", "listeners"}
+
+// worker is a background worker started by Run; run blocks until its context is cancelled
+type worker struct {
+	name  string
+	stage stopStage
+	run   func(ctx context.Context)
+}
+
+// closer releases a resource after every worker has stopped; closers run in reverse order of
+// registration, so a cache closes before the MongoDB connection it writes to
+type closer struct {
+	name  string
+	close func() error
+}
+
+// addWorker registers a background worker started by Run and stopped with its stage
+func (a *App) addWorker(name string, stage stopStage, run func(ctx context.Context)) {
+	a.workers = append(a.workers, worker{name: name, stage: stage, run: run})
+}
+
+// addCloser registers a resource closed at shutdown
+func (a *App) addCloser(name string, close func() error) {
+	a.closers = append(a.closers, closer{name: name, close: close})
+}
+
+// runningStage is the started workers of one stage
+type runningStage struct {
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	names  []string
+}
+
+// startWorkers runs every registered worker in its own goroutine, each stage under its own context
+func (a *App) startWorkers() *[stageCount]runningStage {
+	var stages [stageCount]runningStage
+	for i := range stages {
+		var ctx context.Context
+		ctx, stages[i].cancel = context.WithCancel(context.Background())
+		for _, w := range a.workers {
+			if w.stage != stopStage(i) {
+				continue
+			}
+			stages[i].names = append(stages[i].names, w.name)
+			stages[i].wg.Add(1)
+			go func(run func(ctx context.Context)) {
+				defer stages[i].wg.Done()
+				run(ctx)
+			}(w.run)
+		}
+	}
+	return &stages
+}
+
+// shutdownReport is logged once the server has stopped
+type shutdownReport struct {
+	reason   string
+	start    time.Time
+	drained  bool
+	drain    time.Duration
+	stages   []zap.Field
+	close    time.Duration
+	timedOut []string
+	failed   []string
+}
+
+func (r *shutdownReport) fields() []zap.Field {
+	fields := []zap.Field{
+		zap.String("reason", r.reason),
+		zap.Duration("duration", time.Since(r.start)),
+		zap.Bool("requests_drained", r.drained),
+		zap.Duration("drain", r.drain),
+	}
+	fields = append(fields, r.stages...)
+	fields = append(fields, zap.Duration("close", r.close))
+	if len(r.timedOut) > 0 {
+		fields = append(fields, zap.Strings("timed_out", r.timedOut))
+	}
+	if len(r.failed) > 0 {
+		fields = append(fields, zap.Strings("close_failed", r.failed))
+	}
+	return fields
+}
+
+// shutdown stops the server after Run stopped waiting for it: the HTTP and gRPC listeners close
+// and in-flight calls get app.shutdown.drain_timeout to finish, then the workers stop stage by
+// stage, each within app.shutdown.workers_timeout, and the connections and caches are closed.
+func (a *App) shutdown(reason string, stages *[stageCount]runningStage) {
+	cfg := a.Cfg.App.Shutdown
+	log := a.Logger.Base
+	report := shutdownReport{reason: reason, start: time.Now(), drained: true}
+	log.Info("Shutting down", zap.String("reason", reason))
+
+	drainCtx, cancel := context.WithTimeout(context.Background(), parseDuration(cfg.DrainTimeout, 30*time.Second))
+	var wg sync.WaitGroup
+	if a.GRPC != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := a.GRPC.Shutdown(drainCtx); err != nil {
+				report.timedOut = append(report.timedOut, "grpc")
+			}
+		}()
+	}
+	if err := a.Server.Shutdown(drainCtx); err != nil {
+		// Requests still running are cut off
+		report.drained = false
+		log.Warn("In-flight requests did not finish in time", zap.Error(err))
+		_ = a.Server.Close()
+	}
+	wg.Wait()
+	cancel()
+	report.drain = time.Since(report.start)
+
+	workersTimeout := parseDuration(cfg.WorkersTimeout, 30*time.Second)
+	for i := range stages {
+		stage := &stages[i]
+		if len(stage.names) == 0 {
+			continue
+		}
+		start := time.Now()
+		stage.cancel()
+		stopped := make(chan struct{})
+		go func() {
+			stage.wg.Wait()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(workersTimeout):
+			// Left running; the process exits without them
+			log.Warn("Workers did not stop in time",
+				zap.String("stage", stageNames[i]),
+				zap.Strings("workers", stage.names))
+			report.timedOut = append(report.timedOut, stage.names...)
+		}
+		report.stages = append(report.stages, zap.Duration("stop_"+stageNames[i], time.Since(start)))
+	}
+
+	closeStart := time.Now()
+	for i := len(a.closers) - 1; i >= 0; i-- {
+		c := a.closers[i]
+		if err := c.close(); err != nil {
+			log.Warn("Failed to close resource", zap.String("resource", c.name), zap.Error(err))
+			report.failed = append(report.failed, c.name)
+		}
+	}
+	report.close = time.Since(closeStart)
+
+	log.Info("Shutdown complete", report.fields()...)
+	_ = log.Sync()
+}
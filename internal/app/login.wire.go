@@ -0,0 +1,90 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"pharmacy-modernization-project-model/internal/app/builder"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/auth/login"
+	"pharmacy-modernization-project-model/internal/platform/database"
+	"pharmacy-modernization-project-model/internal/platform/httpclient"
+)
+
+// wireLogin mounts the login page and the /auth token endpoints
+func (a *App) wireLogin(r chi.Router, mongoConnMgr *database.ConnectionManager) error {
+	cfg := a.Cfg.Auth.Login
+	if !cfg.Enabled {
+		return nil
+	}
+
+	var revocations auth.RevocationStore
+	if collection := builder.GetRevokedTokensCollection(mongoConnMgr); collection != nil {
+		revocations = auth.NewMongoRevocationStore(collection, a.Logger.Base)
+	} else {
+		a.Logger.Base.Info("MongoDB not configured, revoked refresh tokens are kept in memory")
+		revocations = auth.NewMemoryRevocationStore()
+	}
+
+	cookie := a.Cfg.Auth.JWT.Cookie
+	issuer, err := auth.NewTokenIssuer(auth.IssuerConfig{
+		AccessTTL:  parseDuration(cfg.AccessTTL, time.Duration(cookie.MaxAge)*time.Second),
+		RefreshTTL: parseDuration(cfg.RefreshTTL, 12*time.Hour),
+		Refresh:    cfg.RefreshEnabled,
+	}, revocations)
+	if err != nil {
+		return fmt.Errorf("login: %w", err)
+	}
+
+	store, err := a.loginUserStore()
+	if err != nil {
+		return fmt.Errorf("login: %w", err)
+	}
+
+	login.NewHandler(store, issuer, login.CookieConfig{
+		Name:   cookie.Name,
+		Secure: cookie.Secure,
+		MaxAge: cookie.MaxAge,
+	}, a.Logger.Base).RegisterRoutes(r)
+
+	a.Logger.Base.Info("Login endpoints enabled")
+	return nil
+}
+
+func (a *App) loginUserStore() (login.UserStore, error) {
+	cfg := a.Cfg.Auth.Login
+	switch cfg.UserStore {
+	case "", "config":
+		users := make([]login.ConfigUser, 0, len(cfg.Users))
+		for _, u := range cfg.Users {
+			users = append(users, login.ConfigUser{
+				Username:        u.Username,
+				PasswordHash:    u.PasswordHash,
+				Name:            u.Name,
+				Email:           u.Email,
+				Permissions:     u.Permissions,
+				DataAccessRoles: u.DataAccessRoles,
+				FuncRoles:       u.FuncRoles,
+				OrgID:           u.OrgID,
+			})
+		}
+		return login.NewConfigUserStore(users)
+
+	case "idp":
+		client := httpclient.NewClient(httpclient.Config{
+			Timeout:     parseDuration(cfg.IdP.Timeout, 10*time.Second),
+			ServiceName: "login_idp",
+		}, a.Logger.Base)
+		return login.NewIdPUserStore(login.IdPConfig{
+			TokenURL:     cfg.IdP.TokenURL,
+			ClientID:     cfg.IdP.ClientID,
+			ClientSecret: cfg.IdP.ClientSecret,
+			Scope:        cfg.IdP.Scope,
+		}, client, a.Logger.Base)
+
+	default:
+		return nil, fmt.Errorf("unknown user_store %q", cfg.UserStore)
+	}
+}
@@ -0,0 +1,24 @@
+package app
+
+import (
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/cache"
+	"pharmacy-modernization-project-model/internal/platform/metrics"
+	"pharmacy-modernization-project-model/internal/platform/paths"
+)
+
+// wireMetrics records request metrics and mounts the unauthenticated Prometheus endpoint.
+// MongoDB and external call metrics are recorded by their clients whether or not this is enabled.
+func (a *App) wireMetrics(r chi.Router, primaryCache cache.Cache) {
+	if !a.Cfg.Metrics.Enabled {
+		return
+	}
+
+	r.Use(metrics.HTTPMiddleware)
+	if err := metrics.RegisterCache("primary", primaryCache); err != nil {
+		a.Logger.Base.Warn("Failed to register cache metrics", zap.Error(err))
+	}
+	r.Handle(paths.MetricsPath, metrics.Handler())
+}
@@ -1,6 +1,8 @@
 package app
 
 import (
+	"time"
+
 	"go.uber.org/zap"
 
 	"pharmacy-modernization-project-model/internal/app/builder"
@@ -14,6 +16,31 @@ func (a *App) wireMongodb() *database.ConnectionManager {
 		a.Logger.Base.Error("Failed to create MongoDB connection", zap.Error(err))
 		// Continue without MongoDB - will use memory repository as fallback
 	}
+	if mongoConnMgr != nil {
+		a.addCloser("mongodb", mongoConnMgr.Close)
+	}
 
 	return mongoConnMgr
 }
+
+// wireTransactions creates the runner that services use for writes spanning several documents
+func (a *App) wireTransactions(mongoConnMgr *database.ConnectionManager) database.TransactionRunner {
+	cfg := a.Cfg.Database.MongoDB.Transactions
+	return database.NewTransactionRunner(mongoConnMgr, database.TransactionConfig{
+		Enabled:     cfg.Enabled,
+		MaxAttempts: cfg.MaxAttempts,
+		Timeout:     parseDuration(cfg.Timeout, 30*time.Second),
+	}, a.Logger.Base)
+}
+
+// wireRepositoryRetry creates the retrier of the MongoDB repositories; nil when retries are disabled
+func (a *App) wireRepositoryRetry() *database.Retrier {
+	cfg := a.Cfg.Database.MongoDB.Retry
+	return database.NewRetrier(database.RetryConfig{
+		Enabled:        cfg.Enabled,
+		MaxAttempts:    cfg.MaxAttempts,
+		InitialBackoff: parseDuration(cfg.InitialBackoff, 100*time.Millisecond),
+		MaxBackoff:     parseDuration(cfg.MaxBackoff, 2*time.Second),
+		Jitter:         parseDuration(cfg.Jitter, 0),
+	}, a.Logger.Base)
+}
@@ -0,0 +1,20 @@
+package app
+
+import (
+	"time"
+
+	"pharmacy-modernization-project-model/internal/platform/cache"
+	"pharmacy-modernization-project-model/internal/platform/navigation"
+)
+
+// wireNavigation creates the back-stack behind the back links and breadcrumbs of the UI pages.
+// Back-stacks live in the primary cache, so with the MongoDB cache every instance shares them.
+func (a *App) wireNavigation(primaryCache cache.Cache) *navigation.BackStack {
+	cfg := a.Cfg.Navigation
+	return navigation.NewBackStack(primaryCache, navigation.BackStackConfig{
+		CookieName: cfg.CookieName,
+		SessionTTL: parseDuration(cfg.SessionTTL, 8*time.Hour),
+		MaxDepth:   cfg.MaxDepth,
+		Secure:     a.Cfg.Auth.JWT.Cookie.Secure,
+	}, a.Logger.Base)
+}
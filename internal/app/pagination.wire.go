@@ -0,0 +1,11 @@
+package app
+
+import (
+	"pharmacy-modernization-project-model/internal/app/builder"
+	"pharmacy-modernization-project-model/internal/platform/pagination"
+)
+
+// wirePagination creates the codec that seals the cursors of connection-style lists
+func (a *App) wirePagination() (*pagination.Codec, error) {
+	return builder.CreateCursorCodec(a.Cfg)
+}
@@ -0,0 +1,25 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"pharmacy-modernization-project-model/internal/graphql"
+	"pharmacy-modernization-project-model/internal/platform/cache"
+)
+
+// wirePersistedQueries loads the GraphQL allow-list and keeps the queries clients register with
+// APQ in the primary cache, so every instance knows them
+func (a *App) wirePersistedQueries(primaryCache cache.Cache) (graphql.PersistedQueries, error) {
+	cfg := a.Cfg.GraphQL.PersistedQueries
+	allowList, err := graphql.LoadAllowList(cfg.AllowListDir)
+	if err != nil {
+		return graphql.PersistedQueries{}, fmt.Errorf("failed to load GraphQL allow-list: %w", err)
+	}
+	return graphql.PersistedQueries{
+		Cache:         primaryCache,
+		CacheTTL:      parseDuration(cfg.CacheTTL, 24*time.Hour),
+		AllowList:     allowList,
+		AllowListOnly: cfg.AllowListOnly,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/redaction"
+)
+
+// wireRedaction applies the per-client response redaction profiles to every route registered
+// after it, and returns the redactor for the gRPC API; nil when redaction is disabled
+func (a *App) wireRedaction(r chi.Router) (*redaction.Redactor, error) {
+	if !a.Cfg.Redaction.Enabled {
+		a.Logger.Base.Warn("Response redaction is disabled, all clients see every field")
+		return nil, nil
+	}
+
+	profiles := make([]redaction.Profile, len(a.Cfg.Redaction.Profiles))
+	for i, p := range a.Cfg.Redaction.Profiles {
+		profiles[i] = redaction.Profile{
+			Name:      p.Name,
+			ClientIDs: p.ClientIDs,
+			Scopes:    p.Scopes,
+			Fields:    p.RedactFields,
+		}
+	}
+	redactor, err := redaction.New(profiles, a.Cfg.Redaction.DefaultProfile)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redaction config: %w", err)
+	}
+
+	r.Use(redaction.Middleware(redactor, a.Logger.Base))
+	a.Logger.Base.Info("Response redaction enabled",
+		zap.Int("profiles", len(profiles)),
+		zap.String("default_profile", a.Cfg.Redaction.DefaultProfile))
+	return redactor, nil
+}
@@ -0,0 +1,29 @@
+package app
+
+import (
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	patientModule "pharmacy-modernization-project-model/domain/patient"
+	prescriptionModule "pharmacy-modernization-project-model/domain/prescription"
+	reportingModule "pharmacy-modernization-project-model/domain/reporting"
+	reportingworker "pharmacy-modernization-project-model/domain/reporting/worker"
+	"pharmacy-modernization-project-model/internal/app/builder"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// wireReporting mounts the adherence report API; its nightly snapshots are registered by wireScheduler
+func (a *App) wireReporting(r chi.Router, mongoConnMgr *database.ConnectionManager, retrier *database.Retrier, patientMod patientModule.ModuleExport, prescriptionMod prescriptionModule.ModuleExport) reportingModule.ModuleExport {
+	c := a.Cfg.Scheduler.AdherenceSnapshots
+	return reportingModule.Module(r, &reportingModule.ModuleDependencies{
+		Logger:                   a.Logger.Base,
+		PatientProvider:          patientMod.PatientService,
+		PrescriptionProvider:     prescriptionMod.PrescriptionService,
+		DispenseProvider:         prescriptionMod.DispenseService,
+		SnapshotsMongoCollection: builder.GetAdherenceSnapshotsCollection(mongoConnMgr),
+		Retrier:                  retrier,
+		SnapshotRetention:        time.Duration(c.RetentionDays) * 24 * time.Hour,
+		Snapshots:                reportingworker.SnapshotJobConfig{BatchSize: c.BatchSize},
+	})
+}
@@ -0,0 +1,23 @@
+package app
+
+import (
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/bodylimit"
+)
+
+// wireRequestLimits rejects request bodies over the size limit of their route group. It runs
+// after the API version middleware, so unversioned paths are matched by their versioned form.
+func (a *App) wireRequestLimits(r chi.Router) {
+	cfg := a.Cfg.Limits
+	groups := make([]bodylimit.Group, len(cfg.Groups))
+	for i, g := range cfg.Groups {
+		groups[i] = bodylimit.Group{Prefix: g.Prefix, MaxBytes: int64(g.MaxBodyKB) << 10}
+	}
+
+	r.Use(bodylimit.Middleware(bodylimit.Config{MaxBytes: int64(cfg.MaxBodyKB) << 10, Groups: groups}))
+	a.Logger.Base.Info("Request body limits enabled",
+		zap.Int("max_body_kb", cfg.MaxBodyKB),
+		zap.Int("groups", len(groups)))
+}
@@ -0,0 +1,28 @@
+package app
+
+import (
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/permissions"
+)
+
+// wireRouteSecurity checks the registered routes against the route security manifest and stops
+// startup on any difference, so a route cannot ship with other permission checks than declared.
+// The checked mapping is served at GET /admin/routes.
+func (a *App) wireRouteSecurity(r chi.Routes) error {
+	manifest, err := permissions.LoadRouteManifest(a.Cfg.Auth.RouteManifest)
+	if err != nil {
+		return err
+	}
+	mappings, err := manifest.Check(auth.Routes(r))
+	if err != nil {
+		return err
+	}
+	permissions.RecordRoutes(mappings)
+	a.Logger.Base.Info("Routes match the route security manifest",
+		zap.Int("routes", len(mappings)),
+		zap.Int("rules", len(manifest.Rules)))
+	return nil
+}
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	billingModule "pharmacy-modernization-project-model/domain/billing"
+	prescriptionModule "pharmacy-modernization-project-model/domain/prescription"
+	prescriptionmodel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	prescriptionworker "pharmacy-modernization-project-model/domain/prescription/worker"
+	reportingModule "pharmacy-modernization-project-model/domain/reporting"
+	"pharmacy-modernization-project-model/internal/app/builder"
+	"pharmacy-modernization-project-model/internal/platform/capacity"
+	"pharmacy-modernization-project-model/internal/platform/database"
+	"pharmacy-modernization-project-model/internal/platform/scheduler"
+	schedulerAdmin "pharmacy-modernization-project-model/internal/platform/scheduler/admin"
+)
+
+// prescriptionExpirationConfig converts the expiration settings from config into the job's config
+func (a *App) prescriptionExpirationConfig() prescriptionworker.ExpirationJobConfig {
+	c := a.Cfg.Scheduler.PrescriptionExpiration
+	return prescriptionworker.ExpirationJobConfig{
+		MaxAge:    time.Duration(c.MaxAgeDays) * 24 * time.Hour,
+		Status:    prescriptionmodel.Status(c.Status),
+		BatchSize: c.BatchSize,
+	}
+}
+
+// wireScheduler registers the periodic jobs, mounts their run history with manual runs and
+// retries, and starts the scheduler with the other workers
+func (a *App) wireScheduler(r chi.Router, mongoConnMgr *database.ConnectionManager, prescriptionMod prescriptionModule.ModuleExport, billingMod billingModule.ModuleExport, reportingMod reportingModule.ModuleExport, capacityMonitor *capacity.Monitor) {
+	cfg := a.Cfg.Scheduler
+	if !cfg.PrescriptionExpiration.Enabled && !cfg.BillingReconciliation.Enabled && !cfg.AdherenceSnapshots.Enabled && capacityMonitor == nil {
+		return
+	}
+
+	var locker scheduler.Locker
+	if collection := builder.GetSchedulerLocksCollection(mongoConnMgr); collection != nil {
+		locker = scheduler.NewMongoLocker(collection)
+	} else {
+		a.Logger.Base.Info("MongoDB not configured, scheduler locks only cover this instance")
+		locker = scheduler.NewMemoryLocker()
+	}
+
+	sched := scheduler.New(locker, a.jobRunStore(mongoConnMgr), a.Logger.Base)
+	if cfg.PrescriptionExpiration.Enabled {
+		sched.Register(scheduler.Job{
+			Name:     "prescription_expiration",
+			Interval: parseDuration(cfg.PrescriptionExpiration.Interval, time.Hour),
+			Run:      prescriptionMod.ExpirationJob.Run,
+		})
+	}
+	if cfg.BillingReconciliation.Enabled {
+		sched.Register(scheduler.Job{
+			Name:     "billing_reconciliation",
+			Interval: parseDuration(cfg.BillingReconciliation.Interval, 24*time.Hour),
+			Run:      billingMod.ReconciliationJob.Run,
+		})
+	}
+	if cfg.AdherenceSnapshots.Enabled {
+		sched.Register(scheduler.Job{
+			Name:     "adherence_snapshots",
+			Interval: parseDuration(cfg.AdherenceSnapshots.Interval, 24*time.Hour),
+			Run:      reportingMod.SnapshotJob.Run,
+		})
+	}
+	if capacityMonitor != nil {
+		sched.Register(scheduler.Job{
+			Name:     "capacity_monitor",
+			Interval: parseDuration(cfg.CapacityMonitor.Interval, 15*time.Minute),
+			Run:      capacityMonitor.Run,
+		})
+	}
+
+	schedulerAdmin.NewHandler(sched, a.Logger.Base).RegisterRoutes(r)
+	a.addWorker("scheduler", stageProducers, sched.Run)
+}
+
+// jobRunStore creates the job run history store (MongoDB or Memory)
+func (a *App) jobRunStore(mongoConnMgr *database.ConnectionManager) scheduler.RunStore {
+	if collection := builder.GetJobRunsCollection(mongoConnMgr); collection != nil {
+		store := scheduler.NewMongoRunStore(collection, a.Logger.Base)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		retention := time.Duration(a.Cfg.Scheduler.JobRuns.RetentionDays) * 24 * time.Hour
+		if err := store.CreateIndexes(ctx, retention); err != nil {
+			a.Logger.Base.Warn("Failed to create job run indexes", zap.Error(err))
+		}
+		return store
+	}
+
+	a.Logger.Base.Info("MongoDB not configured, job runs are kept in memory")
+	return scheduler.NewMemoryRunStore()
+}
@@ -0,0 +1,24 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/schemas"
+)
+
+// wireSchemas loads the event contracts and serves them to downstream teams
+func (a *App) wireSchemas(r chi.Router) (*schemas.Registry, error) {
+	registry, err := schemas.Default()
+	if err != nil {
+		return nil, fmt.Errorf("invalid event contracts: %w", err)
+	}
+
+	schemas.NewHandler(registry, a.Logger.Base).RegisterRoutes(r)
+	a.Logger.Base.Info("Event contracts loaded",
+		zap.Int("contracts", len(registry.List())),
+		zap.Bool("enforce", a.Cfg.Schemas.Enforce))
+	return registry, nil
+}
@@ -0,0 +1,93 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	billingModule "pharmacy-modernization-project-model/domain/billing"
+	billingmodel "pharmacy-modernization-project-model/domain/billing/contracts/model"
+	"pharmacy-modernization-project-model/internal/app/builder"
+	"pharmacy-modernization-project-model/internal/platform/capacity"
+	"pharmacy-modernization-project-model/internal/platform/database"
+	"pharmacy-modernization-project-model/internal/platform/events"
+	"pharmacy-modernization-project-model/internal/platform/httpclient"
+	"pharmacy-modernization-project-model/internal/platform/schemas"
+	"pharmacy-modernization-project-model/internal/platform/webhooks"
+)
+
+// Webhook payloads carry identifiers and the change only; receivers read details through the API.
+// Domain events are sent as they are published, see internal/platform/events.
+type invoiceCreatedPayload struct {
+	InvoiceID      string  `json:"invoice_id"`
+	PrescriptionID string  `json:"prescription_id"`
+	PatientID      string  `json:"patient_id,omitempty"`
+	Amount         float64 `json:"amount"`
+	Status         string  `json:"status"`
+}
+
+// wireWebhooks mounts the webhook registration API and publishes domain events to registered endpoints
+func (a *App) wireWebhooks(r chi.Router, mongoConnMgr *database.ConnectionManager, bus events.Subscriber, billingMod billingModule.ModuleExport, contracts *schemas.Registry, sampler *capacity.Sampler) {
+	cfg := a.Cfg.Webhooks
+	if !cfg.Enabled {
+		return
+	}
+
+	store := a.webhookStore(mongoConnMgr)
+	webhooks.NewHandler(store, a.Logger.Base).RegisterRoutes(r)
+
+	timeout := parseDuration(cfg.Timeout, 10*time.Second)
+	client := httpclient.NewClient(httpclient.Config{
+		Timeout:     timeout,
+		ServiceName: "webhooks",
+	}, a.Logger.Base)
+	dispatcher := webhooks.NewDispatcher(store, client, webhooks.DispatcherConfig{
+		PollInterval: parseDuration(cfg.PollInterval, 2*time.Second),
+		Timeout:      timeout,
+		MaxAttempts:  cfg.MaxAttempts,
+		BaseBackoff:  parseDuration(cfg.BaseBackoff, 10*time.Second),
+		MaxBackoff:   parseDuration(cfg.MaxBackoff, time.Hour),
+		BatchSize:    cfg.BatchSize,
+	}, a.Logger.Base)
+	dispatcher.ValidateWith(contracts, a.Cfg.Schemas.Enforce)
+	if sampler != nil {
+		dispatcher.SampleSucceeded(func() bool { return sampler.Keep("webhook_deliveries") })
+	}
+
+	bus.Subscribe(func(ctx context.Context, envelope events.Envelope) {
+		dispatcher.Publish(ctx, envelope.Type, envelope.Data)
+	}, webhooks.EventPatientUpdated, webhooks.EventPrescriptionStatusChanged)
+	billingMod.BillingService.OnInvoiceCreated(func(ctx context.Context, invoice billingmodel.Invoice) {
+		dispatcher.Publish(ctx, webhooks.EventInvoiceCreated, invoiceCreatedPayload{
+			InvoiceID:      invoice.ID,
+			PrescriptionID: invoice.PrescriptionID,
+			PatientID:      invoice.PatientID,
+			Amount:         invoice.Amount,
+			Status:         invoice.Status,
+		})
+	})
+
+	a.addWorker("webhook_dispatcher", stageOutbox, dispatcher.Run)
+	a.Logger.Base.Info("Webhook delivery enabled")
+}
+
+// webhookStore creates the webhook endpoint and delivery store (MongoDB or Memory)
+func (a *App) webhookStore(mongoConnMgr *database.ConnectionManager) webhooks.Store {
+	endpoints := builder.GetWebhooksCollection(mongoConnMgr)
+	deliveries := builder.GetWebhookDeliveriesCollection(mongoConnMgr)
+	if endpoints != nil && deliveries != nil {
+		store := webhooks.NewMongoStore(endpoints, deliveries, a.Logger.Base)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := store.CreateIndexes(ctx); err != nil {
+			a.Logger.Base.Warn("Failed to create webhook indexes", zap.Error(err))
+		}
+		return store
+	}
+
+	a.Logger.Base.Info("MongoDB not configured, webhooks are kept in memory")
+	return webhooks.NewMemoryStore()
+}
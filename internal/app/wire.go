@@ -1,6 +1,7 @@
 package app
 
 import (
+	"fmt"
 	"net/http"
 	"time"
 
@@ -10,21 +11,36 @@ import (
 	"pharmacy-modernization-project-model/internal/app/builder"
 	"pharmacy-modernization-project-model/internal/integrations"
 	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/auth/devusers"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+	"pharmacy-modernization-project-model/internal/platform/i18n"
 	"pharmacy-modernization-project-model/internal/platform/logging"
 	"pharmacy-modernization-project-model/internal/platform/paths"
+	"pharmacy-modernization-project-model/internal/platform/permissions"
+	permissionsadmin "pharmacy-modernization-project-model/internal/platform/permissions/admin"
 
 	dashboardModule "pharmacy-modernization-project-model/domain/dashboard"
+	documentsModule "pharmacy-modernization-project-model/domain/documents"
 	patientModule "pharmacy-modernization-project-model/domain/patient"
-	patientproviders "pharmacy-modernization-project-model/domain/patient/providers"
+	patientservice "pharmacy-modernization-project-model/domain/patient/service"
 	prescriptionModule "pharmacy-modernization-project-model/domain/prescription"
+	prescriptionservice "pharmacy-modernization-project-model/domain/prescription/service"
 	"pharmacy-modernization-project-model/internal/graphql"
 )
 
 func (a *App) wire() error {
+	// Refuse settings that are unsafe for the environment, e.g. mocked integrations in prod
+	if err := a.Cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid configuration: %w", err)
+	}
+
 	// Logger
 	logger := logging.NewLogger(a.Cfg)
 	a.Logger = logger
 
+	// Applies edits of the reloadable settings while the server runs
+	configReload := a.wireConfigReload()
+
 	// Initialize authentication system
 	if err := a.wireAuth(); err != nil {
 		return err
@@ -33,69 +49,313 @@ func (a *App) wire() error {
 	// Create main MongoDB connection
 	mongoConnMgr := a.wireMongodb()
 
+	// Transactions for writes spanning several documents (direct writes without a replica set)
+	transactions := a.wireTransactions(mongoConnMgr)
+	retrier := a.wireRepositoryRetry()
+
 	// Create primary cache (MongoDB or Memory)
 	primaryCache := a.wireCache()
 
+	// Keep caches consistent with writes from other instances
+	a.wireCacheInvalidation(mongoConnMgr, primaryCache)
+
+	// Codec and compression of the values services cache
+	cacheSerializer := a.wireCacheSerializer()
+
+	// Background job queue; modules register their handlers on it
+	jobQueue := a.wireJobQueue(mongoConnMgr)
+
+	// Shared audit trail
+	auditStore := a.wireAudit(mongoConnMgr)
+
+	// Domain events published by the services
+	eventBus := a.wireEvents()
+
+	// Shared file attachments (pickup signatures)
+	attachmentStore := a.wireAttachments(mongoConnMgr)
+
+	// Patient documents: content in a blob store, checked by a virus scanner
+	documentBlobs, documentScanner, err := a.wireDocumentStorage()
+	if err != nil {
+		return err
+	}
+
+	// Patient PHI encryption at rest
+	fieldCipher, err := a.wireFieldEncryption()
+	if err != nil {
+		return err
+	}
+
+	// Signed pagination cursors
+	cursorCodec, err := a.wirePagination()
+	if err != nil {
+		return err
+	}
+
+	// Back links and breadcrumbs of the UI pages
+	backStack := a.wireNavigation(primaryCache)
+
 	// Router & middleware
 	r := chi.NewRouter()
-	r.Use(middleware.RequestID)
+	r.Use(logging.RequestIDs())
 	r.Use(middleware.RealIP)
 	r.Use(middleware.Recoverer)
-	r.Use(logging.CorrelationID())
+	r.Use(logging.ContextLogger(logger.Base))
 	r.Use(logging.ZapRequestLogger(logger.Base))
 	r.Use(middleware.Timeout(60 * time.Second))
 
+	// Language of validation messages and UI strings: ?lang=, the locale cookie, the user's
+	// locale claim, then Accept-Language
+	r.Use(i18n.Middleware(i18n.Config{DefaultLocale: a.Cfg.I18n.DefaultLocale}))
+
+	// Error response format: RFC 7807 problem details when configured or accepted
+	r.Use(httpx.Middleware(httpx.Config{ProblemDetails: a.Cfg.API.ProblemDetails}))
+
+	// REST API versions: /api/v1 and /api/v2 route groups, deprecation headers and Accept negotiation
+	if err := a.wireAPIVersions(r); err != nil {
+		return err
+	}
+
+	// Request body size limits per route group
+	a.wireRequestLimits(r)
+
+	// Per-client response field redaction (REST and GraphQL; gRPC redacts with the same profiles)
+	redactor, err := a.wireRedaction(r)
+	if err != nil {
+		return err
+	}
+
+	// Replay of mutating REST requests retried with an X-Idempotency-Key
+	a.wireIdempotency(r, mongoConnMgr)
+
+	// Prometheus metrics (registers the last middleware)
+	a.wireMetrics(r, primaryCache)
+
 	// Static assets
 	r.Handle(paths.AssetsPath+"*", http.StripPrefix(paths.AssetsPath, http.FileServer(http.Dir("web/public"))))
 
+	// Health endpoints (liveness/readiness)
+	a.wireHealth(r, mongoConnMgr, primaryCache)
+
 	// Register dev mode endpoints (only when dev mode is enabled)
 	auth.RegisterDevEndpoints(r, logger.Base)
+	devusers.NewHandler(logger.Base).RegisterRoutes(r)
+
+	// Permission catalogue and the current user's permissions
+	auth.RegisterPermissionRoutes(r)
+	permissionsadmin.NewHandler(logger.Base).RegisterRoutes(r)
+
+	// Cache statistics, keys and invalidation for admins
+	a.wireCacheAdmin(r, primaryCache)
+
+	// Login page and token endpoints (public)
+	if err := a.wireLogin(r, mongoConnMgr); err != nil {
+		return err
+	}
 
 	// Initialize integrations layer (handles its own HTTP client internally)
 	integration := integrations.New(integrations.Dependencies{
-		Config: a.Cfg,
-		Logger: logger.Base,
+		Config:  a.Cfg,
+		Logger:  logger.Base,
+		Capture: a.wireHTTPCapture(mongoConnMgr, configReload),
 	})
 
+	// Which integrations are mocked, and how the real ones are doing
+	a.wireIntegrationsStatus(r, integration.Status)
+
 	// Prescription Module
 	prescriptionMod := prescriptionModule.Module(r, &prescriptionModule.ModuleDependencies{
-		Logger:                       logger.Base,
-		PharmacyClient:               integration.PharmacyClient,
-		BillingClient:                integration.BillingClient,
-		PrescriptionsMongoCollection: builder.GetPrescriptionsCollection(mongoConnMgr),
-		CacheService:                 primaryCache,
+		Logger:                          logger.Base,
+		PharmacyClient:                  integration.PharmacyClient,
+		BillingClient:                   integration.BillingClient,
+		PrescriptionsMongoCollection:    builder.GetPrescriptionsCollection(mongoConnMgr),
+		DrugInteractionsMongoCollection: builder.GetDrugInteractionsCollection(mongoConnMgr),
+		DispensesMongoCollection:        builder.GetDispensesCollection(mongoConnMgr),
+		DrugCatalogMongoCollection:      builder.GetDrugCatalogCollection(mongoConnMgr),
+		PrescribersMongoCollection:      builder.GetPrescribersCollection(mongoConnMgr),
+		DraftsMongoCollection:           builder.GetPrescriptionDraftsCollection(mongoConnMgr),
+		Transactions:                    transactions,
+		Retrier:                         retrier,
+		Events:                          eventBus,
+		AttachmentProvider:              attachmentStore,
+		AuditStore:                      auditStore,
+		CacheService:                    primaryCache,
+		CacheLoader:                     a.wireCacheLoader(primaryCache, "prescriptions"),
+		CacheSerializer:                 cacheSerializer,
+		Navigation:                      backStack,
+		Cursors:                         cursorCodec,
+		FulfillmentPolling:              a.fulfillmentPollerConfig(),
+		Expiration:                      a.prescriptionExpirationConfig(),
+		Drafts: prescriptionservice.DraftConfig{
+			TTL:         parseDuration(a.Cfg.Drafts.TTL, 7*24*time.Hour),
+			MaxPerOwner: a.Cfg.Drafts.MaxPerOwner,
+		},
 	})
 
-	// Patient Module
-	// Create invoice provider using the billing client from integrations
-	invoiceProvider := patientproviders.NewInvoiceProvider(integration.BillingClient, logger.Base)
+	// Event contracts for published and consumed messages, served at /api/schemas
+	contracts, err := a.wireSchemas(r)
+	if err != nil {
+		return err
+	}
+
+	// Billing Module
+	billingMod := a.wireBilling(r, mongoConnMgr, retrier, integration.BillingClient, prescriptionMod, primaryCache, contracts, configReload)
+
+	// Prescription pages show price estimates; billing is built after the prescription module, so
+	// it is passed back here
+	prescriptionMod.PrescriptionService.UsePriceEstimateProvider(billingMod.BillingService)
 
+	// Patient Module
 	var patientModDeps = &patientModule.ModuleDependencies{
-		Logger:                   logger.Base,
-		PrescriptionProvider:     prescriptionMod.PrescriptionService,
-		InvoiceProvider:          invoiceProvider,
-		PatientsMongoCollection:  builder.GetPatientsCollection(mongoConnMgr),
-		AddressesMongoCollection: builder.GetAddressesCollection(mongoConnMgr),
-		CacheService:             primaryCache,
+		Logger:                         logger.Base,
+		PrescriptionProvider:           prescriptionMod.PrescriptionService,
+		InvoiceProvider:                billingMod.BillingService,
+		PatientsMongoCollection:        builder.GetPatientsCollection(mongoConnMgr),
+		AddressesMongoCollection:       builder.GetAddressesCollection(mongoConnMgr),
+		MeasurementsMongoCollection:    builder.GetMeasurementsCollection(mongoConnMgr),
+		AllergiesMongoCollection:       builder.GetAllergiesCollection(mongoConnMgr),
+		InsuranceMongoCollection:       builder.GetInsuranceRecordsCollection(mongoConnMgr),
+		ImportTemplatesMongoCollection: builder.GetPatientImportTemplatesCollection(mongoConnMgr),
+		DocumentsMongoCollection:       builder.GetPatientDocumentsCollection(mongoConnMgr),
+		PrescriptionsMongoCollection:   builder.GetPrescriptionsCollection(mongoConnMgr),
+		FieldCipher:                    fieldCipher,
+		Retrier:                        retrier,
+		Events:                         eventBus,
+		AttachmentProvider:             attachmentStore,
+		CardOCRProvider:                integration.CardOCRClient,
+		DocumentBlobProvider:           documentBlobs,
+		DocumentScanProvider:           documentScanner,
+		CacheService:                   primaryCache,
+		CacheLoader:                    a.wireCacheLoader(primaryCache, "patients"),
+		CacheSerializer:                cacheSerializer,
+		Navigation:                     backStack,
+		Export: patientservice.ExportConfig{
+			Dir:         a.Cfg.Export.Dir,
+			JobTTL:      parseDuration(a.Cfg.Export.JobTTL, time.Hour),
+			MaxSyncRows: a.Cfg.Export.MaxSyncRows,
+		},
+		Import: patientservice.ImportConfig{
+			MaxFileBytes: int64(a.Cfg.Import.MaxFileMB) << 20,
+			MaxRows:      a.Cfg.Import.MaxRows,
+			JobTTL:       parseDuration(a.Cfg.Import.JobTTL, time.Hour),
+		},
+		Duplicates: patientservice.DuplicateConfig{
+			Mode:            a.Cfg.Duplicates.Mode,
+			MaxNameDistance: a.Cfg.Duplicates.MaxNameDistance,
+		},
+		InsuranceIntake: patientservice.InsuranceIntakeConfig{
+			ReviewConfidence: a.Cfg.Insurance.ReviewConfidence,
+			MaxImageBytes:    a.Cfg.Insurance.MaxImageBytes,
+		},
+		Documents: patientservice.DocumentConfig{
+			MaxFileBytes: int64(a.Cfg.Documents.MaxFileMB) << 20,
+			ContentTypes: a.Cfg.Documents.ContentTypes,
+		},
 	}
 
 	patientMod := patientModule.Module(r, patientModDeps)
 
+	// Prescribing a drug the patient is allergic to is blocked, and a patient's invoices are only
+	// listed for a patient the caller may see; the patient module is built after the prescription
+	// and billing modules, so it is passed back here
+	prescriptionMod.PrescriptionService.UseAllergyProvider(patientMod.AllergyService)
+	billingMod.BillingService.UsePatientProvider(patientMod.PatientService)
+
 	// Dashboard Module
 	dashboardMod := dashboardModule.Module(r, &dashboardModule.ModuleDependencies{
+		Logger:            logger.Base,
 		PatientStats:      patientMod.PatientService,
 		PrescriptionStats: prescriptionMod.PrescriptionService,
+		InvoiceAging:      billingMod.BillingService,
+		Navigation:        backStack,
+		LiveUpdates:       a.wireDashboardLiveUpdates(prescriptionMod),
+	})
+
+	// Prescription labels and patient medication summaries as PDF
+	documentsModule.Module(r, &documentsModule.ModuleDependencies{
+		Logger:        logger.Base,
+		Patients:      patientMod.PatientService,
+		Prescriptions: prescriptionMod.PrescriptionService,
+		Prescribers:   prescriptionMod.PrescriberService,
+		AuditStore:    auditStore,
 	})
 
+	// Prescriptions sent to their pharmacy as NCPDP SCRIPT messages
+	eprescribingMod := a.wireEPrescribing(mongoConnMgr, retrier, integration.PharmacyClient, patientMod, prescriptionMod)
+
+	// Patient notifications and the communications log
+	a.wireCommunications(r, mongoConnMgr, retrier, jobQueue, integration.NotificationSender, patientMod, prescriptionMod, billingMod)
+
+	// Preload the cache before the first requests arrive
+	a.wireCacheWarmup(patientMod.PatientService, prescriptionMod.PrescriptionService)
+
+	// Patient adherence reports for care management
+	reportingMod := a.wireReporting(r, mongoConnMgr, retrier, patientMod, prescriptionMod)
+
+	// Data repair API
+	a.wireDataRepair(r, mongoConnMgr, transactions, retrier, auditStore, primaryCache, fieldCipher)
+
 	// GraphQL API
+	persistedQueries, err := a.wirePersistedQueries(primaryCache)
+	if err != nil {
+		return err
+	}
 	graphql.MountGraphQL(r, &graphql.Dependencies{
-		PatientService:      patientMod.PatientService,
-		AddressService:      patientMod.AddressService,
-		PrescriptionService: prescriptionMod.PrescriptionService,
-		DashboardService:    dashboardMod.DashboardService,
-		Logger:              logger.Base,
+		PatientService:       patientMod.PatientService,
+		AddressService:       patientMod.AddressService,
+		MeasurementService:   patientMod.MeasurementService,
+		AllergyService:       patientMod.AllergyService,
+		PatientSearchService: patientMod.SearchService,
+		InsuranceService:     patientMod.InsuranceService,
+		PrescriptionService:  prescriptionMod.PrescriptionService,
+		HistoryService:       prescriptionMod.HistoryService,
+		PrescriberService:    prescriptionMod.PrescriberService,
+		DashboardService:     dashboardMod.DashboardService,
+		BillingService:       billingMod.BillingService,
+		TransmissionService:  eprescribingMod.TransmissionService,
+		AdherenceService:     reportingMod.AdherenceService,
+		Limits:               graphql.LimitsFromConfig(a.Cfg.GraphQL),
+		PersistedQueries:     persistedQueries,
+		Federation:           a.Cfg.GraphQL.Federation.Enabled,
+		ConfigReload:         configReload,
+		Logger:               logger.Base,
 	})
 
+	// FHIR R4 interoperability endpoints
+	a.wireFHIR(r, patientMod, prescriptionMod)
+
+	// gRPC API for internal services
+	a.wireGRPC(patientMod, prescriptionMod, redactor)
+
+	// Access review reports of effective user permissions
+	a.wireAccessReview(r, mongoConnMgr)
+
+	// Collection growth limits and their mitigations
+	capacityMonitor, capacitySampler, err := a.wireCapacity(mongoConnMgr)
+	if err != nil {
+		return err
+	}
+
+	// Webhook registration API and delivery of domain events
+	a.wireWebhooks(r, mongoConnMgr, eventBus, billingMod, contracts, capacitySampler)
+
+	// Background workers
+	a.wireWorkers(prescriptionMod, eprescribingMod)
+	a.wireScheduler(r, mongoConnMgr, prescriptionMod, billingMod, reportingMod, capacityMonitor)
+	a.wireJobs(r, jobQueue)
+
+	// Which routes require which permissions, for GET /api/auth/permissions
+	permissions.Require(auth.RoutePermissions(r)...)
+
+	// Every route must carry the permission checks the route security manifest declares
+	if err := a.wireRouteSecurity(r); err != nil {
+		return err
+	}
+
+	if a.Cfg.Reload.Enabled {
+		configReload.Start()
+	}
+
 	a.Router = r
 	return nil
 }
@@ -0,0 +1,38 @@
+package app
+
+import (
+	"time"
+
+	eprescribingModule "pharmacy-modernization-project-model/domain/eprescribing"
+	prescriptionModule "pharmacy-modernization-project-model/domain/prescription"
+	prescriptionworker "pharmacy-modernization-project-model/domain/prescription/worker"
+)
+
+// fulfillmentPollerConfig converts the string durations from config into the worker's config
+func (a *App) fulfillmentPollerConfig() prescriptionworker.FulfillmentPollerConfig {
+	c := a.Cfg.Workers.FulfillmentPolling
+	return prescriptionworker.FulfillmentPollerConfig{
+		Interval:    parseDuration(c.Interval, 30*time.Second),
+		BaseBackoff: parseDuration(c.BaseBackoff, time.Minute),
+		MaxBackoff:  parseDuration(c.MaxBackoff, 30*time.Minute),
+		BatchSize:   c.BatchSize,
+	}
+}
+
+// wireWorkers registers the background workers started by Run
+func (a *App) wireWorkers(prescriptionMod prescriptionModule.ModuleExport, eprescribingMod eprescribingModule.ModuleExport) {
+	if a.Cfg.Workers.FulfillmentPolling.Enabled && prescriptionMod.FulfillmentPoller != nil {
+		a.addWorker("fulfillment_poller", stageProducers, prescriptionMod.FulfillmentPoller.Run)
+	}
+	if a.Cfg.Workers.Transmissions.Enabled && eprescribingMod.TransmissionWorker != nil {
+		a.addWorker("transmissions", stageProducers, eprescribingMod.TransmissionWorker.Run)
+	}
+}
+
+// parseDuration safely parses a duration string with a fallback
+func parseDuration(value string, fallback time.Duration) time.Duration {
+	if d, err := time.ParseDuration(value); err == nil {
+		return d
+	}
+	return fallback
+}
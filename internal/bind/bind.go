@@ -2,12 +2,18 @@
 package bind
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"reflect"
+	"strconv"
 	"strings"
 
+	"pharmacy-modernization-project-model/internal/platform/i18n"
 	"pharmacy-modernization-project-model/internal/validators"
 	"pharmacy-modernization-project-model/internal/validators/validation_logic"
 
@@ -33,7 +39,8 @@ func init() {
 // FieldError is a clean error for clients.
 type FieldError = validation_logic.FieldError
 
-func toFieldErrors(err error) []FieldError {
+// toFieldErrors converts validation errors, with their messages in the locale of ctx
+func toFieldErrors(ctx context.Context, err error) []FieldError {
 	var ferrs []FieldError
 	if err == nil {
 		return ferrs
@@ -43,9 +50,10 @@ func toFieldErrors(err error) []FieldError {
 	if errors.As(err, &ve) {
 		for _, fe := range ve {
 			ferrs = append(ferrs, FieldError{
-				Field: fe.Field(),
-				Tag:   fe.Tag(),
-				Param: fe.Param(),
+				Field:   fe.Field(),
+				Tag:     fe.Tag(),
+				Param:   fe.Param(),
+				Message: i18n.ValidationMessage(ctx, fe.Tag(), fe.Param()),
 			})
 		}
 		return ferrs
@@ -54,16 +62,73 @@ func toFieldErrors(err error) []FieldError {
 	return []FieldError{{Field: "", Tag: "invalid", Message: err.Error()}}
 }
 
-// JSON decodes a JSON body into T and validates it.
-func JSON[T any](r *http.Request) (T, []FieldError, error) {
+// Option changes how JSON decodes a request body
+type Option func(*jsonOptions)
+
+type jsonOptions struct {
+	allowUnknown bool
+	maxBytes     int64
+}
+
+// AllowUnknownFields ignores fields T has no field for, for payloads from external systems,
+// which may add fields over time.
+func AllowUnknownFields() Option {
+	return func(o *jsonOptions) { o.allowUnknown = true }
+}
+
+// MaxBytes rejects bodies larger than n bytes. Route groups get their limit from the bodylimit
+// middleware; this is for handlers needing a tighter one.
+func MaxBytes(n int64) Option {
+	return func(o *jsonOptions) { o.maxBytes = n }
+}
+
+// TooLarge is the error of a body over limit bytes
+func TooLarge(limit int64) FieldError {
+	return FieldError{
+		Tag:     "max_bytes",
+		Param:   strconv.FormatInt(limit, 10),
+		Message: fmt.Sprintf("request body must not exceed %d bytes", limit),
+	}
+}
+
+// JSON decodes a JSON body into T and validates it. Fields T has no field for are rejected,
+// every one of them listed with the "unknown" tag, unless AllowUnknownFields is given.
+func JSON[T any](r *http.Request, opts ...Option) (T, []FieldError, error) {
+	var o jsonOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	var dst T
-	dec := json.NewDecoder(r.Body)
-	dec.DisallowUnknownFields()
+	body := r.Body
+	if o.maxBytes > 0 {
+		body = http.MaxBytesReader(nil, body, o.maxBytes)
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return dst, []FieldError{TooLarge(tooLarge.Limit)}, err
+		}
+		return dst, []FieldError{{Tag: "json", Message: err.Error()}}, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if !o.allowUnknown {
+		dec.DisallowUnknownFields()
+	}
 	if err := dec.Decode(&dst); err != nil {
+		if unknown := unknownFields(data, reflect.TypeOf(dst), ""); len(unknown) > 0 {
+			ferrs := make([]FieldError, len(unknown))
+			for i, field := range unknown {
+				ferrs[i] = FieldError{Field: field, Tag: "unknown", Message: i18n.ValidationMessage(r.Context(), "unknown", "")}
+			}
+			return dst, ferrs, err
+		}
 		return dst, []FieldError{{Tag: "json", Message: err.Error()}}, err
 	}
 	if err := validate.Struct(dst); err != nil {
-		return dst, toFieldErrors(err), err
+		return dst, toFieldErrors(r.Context(), err), err
 	}
 	return dst, nil, nil
 }
@@ -75,7 +140,7 @@ func Query[T any](r *http.Request) (T, []FieldError, error) {
 		return dst, []FieldError{{Tag: "query", Message: err.Error()}}, err
 	}
 	if err := validate.Struct(dst); err != nil {
-		return dst, toFieldErrors(err), err
+		return dst, toFieldErrors(r.Context(), err), err
 	}
 	return dst, nil, nil
 }
@@ -100,7 +165,7 @@ func PathWith[T any](r *http.Request, get pathGetter) (T, []FieldError, error) {
 		}
 	}
 	if err := validate.Struct(dst); err != nil {
-		return dst, toFieldErrors(err), err
+		return dst, toFieldErrors(r.Context(), err), err
 	}
 	return dst, nil, nil
 }
@@ -136,7 +201,16 @@ func Form[T any](r *http.Request) (T, []FieldError, error) {
 		return dst, []FieldError{{Tag: "form", Message: err.Error()}}, err
 	}
 	if err := validate.Struct(dst); err != nil {
-		return dst, toFieldErrors(err), err
+		return dst, toFieldErrors(r.Context(), err), err
+	}
+	return dst, nil, nil
+}
+
+// Struct validates a value decoded elsewhere, e.g. from a gRPC message, with the same rules.
+// Its messages are in the default locale.
+func Struct[T any](dst T) (T, []FieldError, error) {
+	if err := validate.Struct(dst); err != nil {
+		return dst, toFieldErrors(context.Background(), err), err
 	}
 	return dst, nil, nil
 }
@@ -0,0 +1,125 @@
+package bind
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// unknownFields lists the paths of the keys in data that t has no field for, e.g.
+// "address.zip" or "items[1].note". The decoder stops at the first one; clients get all of them.
+func unknownFields(data []byte, t reflect.Type, path string) []string {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Implements(jsonUnmarshalerType) || reflect.PointerTo(t).Implements(jsonUnmarshalerType) {
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		var obj map[string]json.RawMessage
+		if json.Unmarshal(data, &obj) != nil {
+			return nil
+		}
+		fields := jsonFields(t)
+		keys := make([]string, 0, len(obj))
+		for key := range obj {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var out []string
+		for _, key := range keys {
+			field, ok := fields[key]
+			if !ok {
+				// encoding/json matches keys case-insensitively when no exact match exists
+				field, ok = fields[strings.ToLower(key)]
+			}
+			if !ok {
+				out = append(out, joinPath(path, key))
+				continue
+			}
+			out = append(out, unknownFields(obj[key], field, joinPath(path, key))...)
+		}
+		return out
+	case reflect.Slice, reflect.Array:
+		var items []json.RawMessage
+		if json.Unmarshal(data, &items) != nil {
+			return nil
+		}
+		var out []string
+		for i, item := range items {
+			out = append(out, unknownFields(item, t.Elem(), path+"["+strconv.Itoa(i)+"]")...)
+		}
+		return out
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String && !reflect.PointerTo(t.Key()).Implements(textUnmarshalerType) {
+			return nil
+		}
+		var obj map[string]json.RawMessage
+		if json.Unmarshal(data, &obj) != nil {
+			return nil
+		}
+		var out []string
+		for key, value := range obj {
+			out = append(out, unknownFields(value, t.Elem(), joinPath(path, key))...)
+		}
+		sort.Strings(out)
+		return out
+	}
+	return nil
+}
+
+// jsonFields maps the JSON names of the fields of struct t, including promoted ones, to their
+// types; every name is also keyed in lower case for case-insensitive matches
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		ft := f.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			for embeddedName, embeddedType := range jsonFields(ft) {
+				if _, ok := fields[embeddedName]; !ok {
+					fields[embeddedName] = embeddedType
+				}
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+		if _, ok := fields[strings.ToLower(name)]; !ok {
+			fields[strings.ToLower(name)] = f.Type
+		}
+	}
+	return fields
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
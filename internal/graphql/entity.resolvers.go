@@ -0,0 +1,25 @@
+package graphql
+
+import (
+	"context"
+	"pharmacy-modernization-project-model/domain/patient/contracts/model"
+	model1 "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/internal/graphql/generated"
+)
+
+// FindPatientByID is the resolver for the findPatientByID field.
+func (r *entityResolver) FindPatientByID(ctx context.Context, id string) (*model.Patient, error) {
+	// Delegate to patient domain resolver
+	return r.PatientResolver.FindPatientByID(ctx, id)
+}
+
+// FindPrescriptionByID is the resolver for the findPrescriptionByID field.
+func (r *entityResolver) FindPrescriptionByID(ctx context.Context, id string) (*model1.Prescription, error) {
+	// Delegate to prescription domain resolver
+	return r.PrescriptionResolver.FindPrescriptionByID(ctx, id)
+}
+
+// Entity returns generated.EntityResolver implementation.
+func (r *Resolver) Entity() generated.EntityResolver { return &entityResolver{r} }
+
+type entityResolver struct{ *Resolver }
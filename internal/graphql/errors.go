@@ -0,0 +1,148 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	gql "github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/graphql/validation"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/logging"
+	"pharmacy-modernization-project-model/internal/platform/pagination"
+)
+
+// Error codes set in extensions.code of errors returned by resolvers
+const (
+	ErrCodeBadUserInput  = "BAD_USER_INPUT"
+	ErrCodeNotFound      = "NOT_FOUND"
+	ErrCodeForbidden     = "FORBIDDEN"
+	ErrCodeRateLimited   = "RATE_LIMITED"
+	ErrCodeConflict      = "CONFLICT"
+	ErrCodeBusinessRule  = "BUSINESS_RULE_VIOLATION"
+	ErrCodeUnavailable   = "SERVICE_UNAVAILABLE"
+	ErrCodeInternalError = "INTERNAL_SERVER_ERROR"
+)
+
+// errorPresenter turns resolver errors into GraphQL errors the way httpx.ErrorHandler turns them
+// into REST responses: platform error types get a code and status in their extensions, along with
+// the field they concern, and unknown errors are logged and hidden behind a generic message.
+// Errors that already carry a code (auth directives, query limits) keep it. Every error gets the
+// request and correlation IDs so clients can quote them.
+func errorPresenter(log *zap.Logger) gql.ErrorPresenterFunc {
+	return func(ctx context.Context, err error) *gqlerror.Error {
+		gqlErr := gql.DefaultErrorPresenter(ctx, err)
+		if _, coded := gqlErr.Extensions["code"]; !coded {
+			presentError(ctx, log, gqlErr, err)
+		}
+
+		if gqlErr.Extensions == nil {
+			gqlErr.Extensions = map[string]any{}
+		}
+		if rid := logging.GetRequestID(ctx); rid != "" {
+			gqlErr.Extensions["request_id"] = rid
+		}
+		if cid := logging.GetCorrelationID(ctx); cid != "" {
+			gqlErr.Extensions["correlation_id"] = cid
+		}
+		return gqlErr
+	}
+}
+
+// presentError sets the message and extensions of gqlErr from the error a resolver returned
+func presentError(ctx context.Context, log *zap.Logger, gqlErr *gqlerror.Error, err error) {
+	var (
+		inputErrs    *validation.GraphQLValidationErrors
+		validErr     platformErrors.ValidationError
+		cursorErr    pagination.CursorError
+		notFoundErr  platformErrors.RecordNotFoundError
+		authErr      platformErrors.AuthorizationError
+		rateLimitErr platformErrors.RateLimitError
+		duplicateErr platformErrors.DuplicateRecordError
+		conflictErr  platformErrors.ConflictError
+		businessErr  platformErrors.BusinessLogicError
+		serviceErr   platformErrors.ExternalServiceError
+	)
+
+	switch {
+	case errors.As(err, &inputErrs):
+		fields := make([]map[string]any, 0, len(inputErrs.Errors))
+		for _, fieldErr := range inputErrs.Errors {
+			fields = append(fields, map[string]any{"field": fieldErr.Field, "code": fieldErr.Code, "message": fieldErr.Message})
+		}
+		setCode(gqlErr, ErrCodeBadUserInput, http.StatusBadRequest, map[string]any{"fields": fields})
+
+	case errors.As(err, &validErr):
+		gqlErr.Message = validErr.Error()
+		setCode(gqlErr, ErrCodeBadUserInput, http.StatusBadRequest, map[string]any{"field": validErr.Field})
+
+	case errors.As(err, &cursorErr):
+		gqlErr.Message = cursorErr.Reason
+		setCode(gqlErr, strings.ToUpper(cursorErr.Code), http.StatusBadRequest, map[string]any{"argument": pagination.CursorArgument})
+
+	case errors.As(err, &notFoundErr):
+		gqlErr.Message = notFoundErr.Error()
+		setCode(gqlErr, ErrCodeNotFound, http.StatusNotFound, map[string]any{"type": notFoundErr.Type, "id": notFoundErr.ID})
+
+	case platformErrors.IsNotFoundError(err):
+		gqlErr.Message = "Resource not found"
+		setCode(gqlErr, ErrCodeNotFound, http.StatusNotFound, nil)
+
+	case errors.As(err, &authErr):
+		gqlErr.Message = authErr.Error()
+		setCode(gqlErr, ErrCodeForbidden, http.StatusForbidden, map[string]any{"resource": authErr.Resource, "action": authErr.Action})
+
+	case errors.As(err, &rateLimitErr):
+		gqlErr.Message = rateLimitErr.Error()
+		setCode(gqlErr, ErrCodeRateLimited, http.StatusTooManyRequests, map[string]any{"limit": rateLimitErr.Limit, "window": rateLimitErr.Window})
+
+	case errors.As(err, &duplicateErr):
+		gqlErr.Message = duplicateErr.Error()
+		setCode(gqlErr, ErrCodeConflict, http.StatusConflict, map[string]any{"type": duplicateErr.Type})
+
+	case errors.As(err, &conflictErr):
+		gqlErr.Message = conflictErr.Error()
+		setCode(gqlErr, ErrCodeConflict, http.StatusConflict, map[string]any{"type": conflictErr.Type, "id": conflictErr.ID})
+
+	case errors.As(err, &businessErr):
+		gqlErr.Message = businessErr.Error()
+		setCode(gqlErr, ErrCodeBusinessRule, http.StatusUnprocessableEntity, map[string]any{"operation": businessErr.Operation})
+
+	case errors.As(err, &serviceErr):
+		logging.WithContext(ctx, log).Error("External service error in GraphQL resolver", zap.Error(err), zap.String("path", gqlErr.Path.String()))
+		gqlErr.Message = "External service temporarily unavailable"
+		setCode(gqlErr, ErrCodeUnavailable, http.StatusBadGateway, nil)
+
+	// Domain sentinels such as "patient not found" or "invalid patient data"
+	case strings.Contains(err.Error(), "not found"):
+		setCode(gqlErr, ErrCodeNotFound, http.StatusNotFound, nil)
+	case strings.Contains(err.Error(), "validation") || strings.Contains(err.Error(), "invalid"):
+		setCode(gqlErr, ErrCodeBadUserInput, http.StatusBadRequest, nil)
+
+	// Errors gqlgen raised itself, e.g. for arguments it could not coerce, are already meant for clients
+	case errors.As(err, new(*gqlerror.Error)):
+
+	default:
+		logging.WithContext(ctx, log).Error("GraphQL resolver failed", zap.Error(err), zap.String("path", gqlErr.Path.String()))
+		gqlErr.Message = "Internal server error"
+		setCode(gqlErr, ErrCodeInternalError, http.StatusInternalServerError, nil)
+	}
+}
+
+// setCode sets the code and status of gqlErr and any further extensions; empty values are left out
+func setCode(gqlErr *gqlerror.Error, code string, status int, extensions map[string]any) {
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = map[string]any{}
+	}
+	gqlErr.Extensions["code"] = code
+	gqlErr.Extensions["status"] = status
+	for key, value := range extensions {
+		if value != "" {
+			gqlErr.Extensions[key] = value
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	gql "github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/executor"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/go-chi/chi/v5"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.uber.org/zap"
+
+	authplatform "pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/paths"
+)
+
+// serviceQuery asks a subgraph for its SDL, as a federated gateway does
+const serviceQuery = "{ _service { sdl } }"
+
+// fromFederation reports whether a definition was added by gqlgen's federation plugin rather
+// than written in the schema files
+func fromFederation(pos *ast.Position) bool {
+	return pos != nil && pos.Src != nil && pos.Src.BuiltIn && strings.Contains(pos.Src.Name, "federation/")
+}
+
+// standaloneSchema returns a copy of the schema without what the federation plugin adds: the
+// _service and _entities queries, their types and the federation directives. Operations are
+// validated against it, so a standalone server refuses _entities like any unknown field and
+// introspection shows the schema as it was before federation.
+func standaloneSchema(schema *ast.Schema) *ast.Schema {
+	standalone := *schema
+
+	standalone.Types = maps.Clone(schema.Types)
+	maps.DeleteFunc(standalone.Types, func(_ string, def *ast.Definition) bool {
+		return fromFederation(def.Position)
+	})
+	query := *schema.Query
+	query.Fields = slices.DeleteFunc(slices.Clone(query.Fields), func(field *ast.FieldDefinition) bool {
+		return fromFederation(field.Position)
+	})
+	standalone.Query = &query
+	standalone.Types[query.Name] = &query
+
+	standalone.Directives = maps.Clone(schema.Directives)
+	maps.DeleteFunc(standalone.Directives, func(_ string, def *ast.DirectiveDefinition) bool {
+		return fromFederation(def.Position)
+	})
+
+	standalone.PossibleTypes = maps.Clone(schema.PossibleTypes)
+	maps.DeleteFunc(standalone.PossibleTypes, func(name string, _ []*ast.Definition) bool {
+		return standalone.Types[name] == nil
+	})
+	standalone.Implements = make(map[string][]*ast.Definition, len(schema.Implements))
+	for name, defs := range schema.Implements {
+		standalone.Implements[name] = slices.DeleteFunc(slices.Clone(defs), func(def *ast.Definition) bool {
+			return fromFederation(def.Position)
+		})
+	}
+	return &standalone
+}
+
+// serviceSDL runs the _service query a federated gateway sends, so the SDL served over HTTP is
+// the one composed into the supergraph
+func serviceSDL(schema gql.ExecutableSchema) (string, error) {
+	exec := executor.New(schema)
+	exec.Use(extension.Introspection{})
+	ctx := gql.StartOperationTrace(context.Background())
+	opCtx, errs := exec.CreateOperationContext(ctx, &gql.RawParams{Query: serviceQuery})
+	if errs != nil {
+		return "", fmt.Errorf("service SDL query: %w", errs)
+	}
+	handler, ctx := exec.DispatchOperation(ctx, opCtx)
+	resp := handler(ctx)
+	if len(resp.Errors) > 0 {
+		return "", fmt.Errorf("service SDL query: %w", resp.Errors)
+	}
+
+	var data struct {
+		Service struct {
+			SDL string `json:"sdl"`
+		} `json:"_service"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return "", fmt.Errorf("service SDL response: %w", err)
+	}
+	if data.Service.SDL == "" {
+		return "", errors.New("service SDL is empty")
+	}
+	return data.Service.SDL, nil
+}
+
+// mountSDL serves the subgraph SDL the gateway composes, behind the same auth as the endpoint
+func mountSDL(r chi.Router, schema gql.ExecutableSchema, deps *Dependencies) {
+	sdl, err := serviceSDL(schema)
+	if err != nil {
+		deps.Logger.Error("GraphQL subgraph SDL is not served", zap.Error(err))
+		return
+	}
+	r.Handle(paths.GraphQLSDLPath, authplatform.RequireAuthWithDevMode()(sdlHandler(sdl)))
+}
+
+// sdlHandler serves the subgraph SDL, e.g. for rover subgraph publish
+func sdlHandler(sdl string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = w.Write([]byte(sdl))
+	}
+}
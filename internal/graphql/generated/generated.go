@@ -7,8 +7,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	model2 "pharmacy-modernization-project-model/domain/billing/contracts/model"
+	model3 "pharmacy-modernization-project-model/domain/eprescribing/contracts/model"
 	"pharmacy-modernization-project-model/domain/patient/contracts/model"
 	model1 "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	model4 "pharmacy-modernization-project-model/domain/reporting/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/dates"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -16,6 +20,7 @@ import (
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/introspection"
+	"github.com/99designs/gqlgen/plugin/federation/fedruntime"
 	gqlparser "github.com/vektah/gqlparser/v2"
 	"github.com/vektah/gqlparser/v2/ast"
 )
@@ -40,14 +45,27 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	Allergy() AllergyResolver
+	ContactPreferences() ContactPreferencesResolver
+	Dose() DoseResolver
+	DrugInteractionWarning() DrugInteractionWarningResolver
+	Entity() EntityResolver
+	InsuranceRecord() InsuranceRecordResolver
+	Measurement() MeasurementResolver
 	Mutation() MutationResolver
 	Patient() PatientResolver
+	Prescriber() PrescriberResolver
 	Prescription() PrescriptionResolver
+	PrescriptionHistoryEvent() PrescriptionHistoryEventResolver
+	PrescriptionTransmission() PrescriptionTransmissionResolver
 	Query() QueryResolver
+	Sig() SigResolver
+	StatusCount() StatusCountResolver
 }
 
 type DirectiveRoot struct {
 	Auth          func(ctx context.Context, obj any, next graphql.Resolver) (res any, err error)
+	HasPermission func(ctx context.Context, obj any, next graphql.Resolver, permission string) (res any, err error)
 	PermissionAll func(ctx context.Context, obj any, next graphql.Resolver, requires []string) (res any, err error)
 	PermissionAny func(ctx context.Context, obj any, next graphql.Resolver, requires []string) (res any, err error)
 }
@@ -63,65 +81,539 @@ type ComplexityRoot struct {
 		Zip       func(childComplexity int) int
 	}
 
+	AdherenceReport struct {
+		ActivePrescriptions       func(childComplexity int) int
+		AverageDaysBetweenRefills func(childComplexity int) int
+		CompletedPrescriptions    func(childComplexity int) int
+		GeneratedAt               func(childComplexity int) int
+		History                   func(childComplexity int) int
+		PatientID                 func(childComplexity int) int
+		Prescriptions             func(childComplexity int) int
+		ProportionOfDaysCovered   func(childComplexity int) int
+		Refills                   func(childComplexity int) int
+	}
+
+	AdherenceSnapshot struct {
+		ActivePrescriptions       func(childComplexity int) int
+		AverageDaysBetweenRefills func(childComplexity int) int
+		CompletedPrescriptions    func(childComplexity int) int
+		Date                      func(childComplexity int) int
+		PatientID                 func(childComplexity int) int
+		ProportionOfDaysCovered   func(childComplexity int) int
+		Refills                   func(childComplexity int) int
+		TakenAt                   func(childComplexity int) int
+	}
+
+	Allergy struct {
+		ID         func(childComplexity int) int
+		PatientID  func(childComplexity int) int
+		Reaction   func(childComplexity int) int
+		RecordedAt func(childComplexity int) int
+		RecordedBy func(childComplexity int) int
+		Severity   func(childComplexity int) int
+		Substance  func(childComplexity int) int
+	}
+
+	ContactPreferences struct {
+		Channels     func(childComplexity int) int
+		DoNotContact func(childComplexity int) int
+		Email        func(childComplexity int) int
+	}
+
+	CreateAddressPayload struct {
+		Address    func(childComplexity int) int
+		UserErrors func(childComplexity int) int
+	}
+
+	CreatePatientPayload struct {
+		Patient             func(childComplexity int) int
+		PotentialDuplicates func(childComplexity int) int
+		UserErrors          func(childComplexity int) int
+	}
+
+	CreatePrescriberPayload struct {
+		Prescriber func(childComplexity int) int
+		UserErrors func(childComplexity int) int
+	}
+
+	CreatePrescriptionPayload struct {
+		Prescription func(childComplexity int) int
+		UserErrors   func(childComplexity int) int
+	}
+
 	DashboardStats struct {
-		ActivePrescriptions func(childComplexity int) int
-		TotalPatients       func(childComplexity int) int
+		ActivePrescriptions   func(childComplexity int) int
+		PatientsByState       func(childComplexity int) int
+		PrescriptionsByStatus func(childComplexity int) int
+		TotalPatients         func(childComplexity int) int
+	}
+
+	DeleteAddressPayload struct {
+		DeletedID  func(childComplexity int) int
+		UserErrors func(childComplexity int) int
+	}
+
+	DeleteAllergyPayload struct {
+		DeletedID  func(childComplexity int) int
+		UserErrors func(childComplexity int) int
+	}
+
+	DeleteMeasurementPayload struct {
+		DeletedID  func(childComplexity int) int
+		UserErrors func(childComplexity int) int
+	}
+
+	DeletePrescriberPayload struct {
+		DeletedID  func(childComplexity int) int
+		UserErrors func(childComplexity int) int
+	}
+
+	Dose struct {
+		Frequency func(childComplexity int) int
+		Route     func(childComplexity int) int
+		Unit      func(childComplexity int) int
+		Value     func(childComplexity int) int
+	}
+
+	DrugAllergyWarning struct {
+		AllergyID func(childComplexity int) int
+		Drug      func(childComplexity int) int
+		Reaction  func(childComplexity int) int
+		Severity  func(childComplexity int) int
+		Substance func(childComplexity int) int
+	}
+
+	DrugInteractionWarning struct {
+		Description               func(childComplexity int) int
+		Drug                      func(childComplexity int) int
+		InteractingDrug           func(childComplexity int) int
+		InteractingPrescriptionID func(childComplexity int) int
+		Severity                  func(childComplexity int) int
+	}
+
+	Entity struct {
+		FindPatientByID      func(childComplexity int, id string) int
+		FindPrescriptionByID func(childComplexity int, id string) int
+	}
+
+	InsuranceRecord struct {
+		Active        func(childComplexity int) int
+		EffectiveDate func(childComplexity int) int
+		ExpiryDate    func(childComplexity int) int
+		GroupNumber   func(childComplexity int) int
+		ID            func(childComplexity int) int
+		MemberID      func(childComplexity int) int
+		MemberName    func(childComplexity int) int
+		PatientID     func(childComplexity int) int
+		PayerName     func(childComplexity int) int
+		RxBIN         func(childComplexity int) int
+		RxPCN         func(childComplexity int) int
+		Status        func(childComplexity int) int
+	}
+
+	InteractionCheckResult struct {
+		AllergyWarnings func(childComplexity int) int
+		Blocked         func(childComplexity int) int
+		Warnings        func(childComplexity int) int
+	}
+
+	Invoice struct {
+		Amount         func(childComplexity int) int
+		CreatedAt      func(childComplexity int) int
+		ID             func(childComplexity int) int
+		PatientID      func(childComplexity int) int
+		PrescriptionID func(childComplexity int) int
+		Status         func(childComplexity int) int
+		UpdatedAt      func(childComplexity int) int
+	}
+
+	Measurement struct {
+		ID         func(childComplexity int) int
+		PatientID  func(childComplexity int) int
+		RecordedAt func(childComplexity int) int
+		RecordedBy func(childComplexity int) int
+		Type       func(childComplexity int) int
+		Unit       func(childComplexity int) int
+		Value      func(childComplexity int) int
 	}
 
 	Mutation struct {
-		CreatePatient      func(childComplexity int, input CreatePatientInput) int
-		CreatePrescription func(childComplexity int, input CreatePrescriptionInput) int
-		Empty              func(childComplexity int) int
-		UpdatePatient      func(childComplexity int, id string, input UpdatePatientInput) int
-		UpdatePrescription func(childComplexity int, id string, input UpdatePrescriptionInput) int
+		AcknowledgeInvoice           func(childComplexity int, prescriptionID string, notes *string) int
+		CreateAddress                func(childComplexity int, patientID string, input CreateAddressInput) int
+		CreateInvoiceForPrescription func(childComplexity int, prescriptionID string, amount *float64, description *string) int
+		CreatePatient                func(childComplexity int, input CreatePatientInput) int
+		CreatePrescriber             func(childComplexity int, input CreatePrescriberInput) int
+		CreatePrescription           func(childComplexity int, input CreatePrescriptionInput) int
+		DeleteAddress                func(childComplexity int, patientID string, id string) int
+		DeleteAllergy                func(childComplexity int, patientID string, id string) int
+		DeleteMeasurement            func(childComplexity int, patientID string, id string) int
+		DeletePrescriber             func(childComplexity int, id string) int
+		Empty                        func(childComplexity int) int
+		RecordAllergy                func(childComplexity int, patientID string, input RecordAllergyInput) int
+		RecordMeasurement            func(childComplexity int, patientID string, input RecordMeasurementInput) int
+		TransmitPrescription         func(childComplexity int, prescriptionID string) int
+		UpdateAddress                func(childComplexity int, patientID string, id string, input UpdateAddressInput) int
+		UpdateAllergy                func(childComplexity int, patientID string, id string, input UpdateAllergyInput) int
+		UpdateMeasurement            func(childComplexity int, patientID string, id string, input UpdateMeasurementInput) int
+		UpdatePatient                func(childComplexity int, id string, input UpdatePatientInput) int
+		UpdatePrescriber             func(childComplexity int, id string, input UpdatePrescriberInput) int
+		UpdatePrescription           func(childComplexity int, id string, input UpdatePrescriptionInput) int
 	}
 
 	Patient struct {
-		Addresses     func(childComplexity int) int
+		Addresses          func(childComplexity int) int
+		Allergies          func(childComplexity int) int
+		ContactPreferences func(childComplexity int) int
+		CreatedAt          func(childComplexity int) int
+		DOB                func(childComplexity int) int
+		ID                 func(childComplexity int) int
+		Insurance          func(childComplexity int, activeOnly *bool) int
+		LatestMeasurement  func(childComplexity int, typeArg string) int
+		Measurements       func(childComplexity int, typeArg *string, limit *int) int
+		Name               func(childComplexity int) int
+		Phone              func(childComplexity int) int
+		Prescriptions      func(childComplexity int, status *PrescriptionStatus, limit *int) int
+		State              func(childComplexity int) int
+	}
+
+	PatientSearchMatch struct {
+		Field       func(childComplexity int) int
+		Highlighted func(childComplexity int) int
+		Value       func(childComplexity int) int
+	}
+
+	PatientSearchResult struct {
+		MatchType func(childComplexity int) int
+		Matches   func(childComplexity int) int
+		Patient   func(childComplexity int) int
+		Score     func(childComplexity int) int
+	}
+
+	Pharmacy struct {
+		Address  func(childComplexity int) int
+		City     func(childComplexity int) int
+		ID       func(childComplexity int) int
+		Name     func(childComplexity int) int
+		Phone    func(childComplexity int) int
+		RoutedAt func(childComplexity int) int
+		State    func(childComplexity int) int
+		Type     func(childComplexity int) int
+		Zip      func(childComplexity int) int
+	}
+
+	PotentialDuplicate struct {
+		Patient func(childComplexity int) int
+		Reasons func(childComplexity int) int
+	}
+
+	Prescriber struct {
 		CreatedAt     func(childComplexity int) int
-		DOB           func(childComplexity int) int
+		Credential    func(childComplexity int) int
+		DisplayName   func(childComplexity int) int
+		Email         func(childComplexity int) int
+		Fax           func(childComplexity int) int
+		FirstName     func(childComplexity int) int
 		ID            func(childComplexity int) int
-		Name          func(childComplexity int) int
+		LastName      func(childComplexity int) int
+		NPI           func(childComplexity int) int
 		Phone         func(childComplexity int) int
-		Prescriptions func(childComplexity int) int
-		State         func(childComplexity int) int
+		Prescriptions func(childComplexity int, limit *int) int
+		Specialty     func(childComplexity int) int
+		UpdatedAt     func(childComplexity int) int
 	}
 
 	Prescription struct {
-		CreatedAt func(childComplexity int) int
-		Dose      func(childComplexity int) int
-		Drug      func(childComplexity int) int
-		ID        func(childComplexity int) int
-		Patient   func(childComplexity int) int
-		PatientID func(childComplexity int) int
-		Status    func(childComplexity int) int
+		CreatedAt            func(childComplexity int) int
+		DaysSupply           func(childComplexity int) int
+		Directions           func(childComplexity int, language *SigLanguage) int
+		Dosage               func(childComplexity int) int
+		Dose                 func(childComplexity int) int
+		Drug                 func(childComplexity int) int
+		ExpectedEndDate      func(childComplexity int) int
+		FulfillmentStatus    func(childComplexity int) int
+		FulfillmentUpdatedAt func(childComplexity int) int
+		History              func(childComplexity int, limit *int, after *string) int
+		ID                   func(childComplexity int) int
+		InteractionWarnings  func(childComplexity int) int
+		Patient              func(childComplexity int) int
+		PatientID            func(childComplexity int) int
+		Pharmacy             func(childComplexity int) int
+		Prescriber           func(childComplexity int) int
+		PrescriberID         func(childComplexity int) int
+		PriceEstimate        func(childComplexity int) int
+		Quantity             func(childComplexity int) int
+		Sig                  func(childComplexity int) int
+		Status               func(childComplexity int) int
+	}
+
+	PrescriptionAdherence struct {
+		AverageDaysBetweenRefills func(childComplexity int) int
+		Dispenses                 func(childComplexity int) int
+		Drug                      func(childComplexity int) int
+		LastDispensedAt           func(childComplexity int) int
+		PrescriptionID            func(childComplexity int) int
+		ProportionOfDaysCovered   func(childComplexity int) int
+		Status                    func(childComplexity int) int
+	}
+
+	PrescriptionHistoryConnection struct {
+		EndCursor   func(childComplexity int) int
+		Events      func(childComplexity int) int
+		HasNextPage func(childComplexity int) int
+	}
+
+	PrescriptionHistoryEvent struct {
+		Actor              func(childComplexity int) int
+		At                 func(childComplexity int) int
+		DispenseID         func(childComplexity int) int
+		FromStatus         func(childComplexity int) int
+		ID                 func(childComplexity int) int
+		PharmacyID         func(childComplexity int) int
+		PharmacyName       func(childComplexity int) int
+		PreviousPharmacyID func(childComplexity int) int
+		Reason             func(childComplexity int) int
+		ToStatus           func(childComplexity int) int
+		Type               func(childComplexity int) int
+	}
+
+	PrescriptionTransmission struct {
+		Acknowledgments func(childComplexity int) int
+		Attempts        func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		CreatedBy       func(childComplexity int) int
+		ID              func(childComplexity int) int
+		LastError       func(childComplexity int) int
+		Message         func(childComplexity int) int
+		NextAttemptAt   func(childComplexity int) int
+		PatientID       func(childComplexity int) int
+		PharmacyID      func(childComplexity int) int
+		PharmacyName    func(childComplexity int) int
+		PrescriptionID  func(childComplexity int) int
+		Status          func(childComplexity int) int
+		UpdatedAt       func(childComplexity int) int
+	}
+
+	PriceEstimate struct {
+		Currency       func(childComplexity int) int
+		DispensingFee  func(childComplexity int) int
+		Drug           func(childComplexity int) int
+		EstimatedAt    func(childComplexity int) int
+		IngredientCost func(childComplexity int) int
+		PrescriptionID func(childComplexity int) int
+		Quantity       func(childComplexity int) int
+		Source         func(childComplexity int) int
+		Total          func(childComplexity int) int
+		UnitPrice      func(childComplexity int) int
 	}
 
 	Query struct {
-		DashboardStats func(childComplexity int) int
-		Empty          func(childComplexity int) int
+		AdherenceReport           func(childComplexity int, patientID string) int
+		CheckDrugInteractions     func(childComplexity int, patientID string, drug string) int
+		DashboardStats            func(childComplexity int) int
+		Empty                     func(childComplexity int) int
+		InvoicesByPatient         func(childComplexity int, patientID string) int
+		PatientCountByState       func(childComplexity int) int
+		Patients                  func(childComplexity int, query *string, state *string, minAge *int, maxAge *int, hasActivePrescriptions *bool, limit *int, offset *int) int
+		Prescriber                func(childComplexity int, id string) int
+		Prescribers               func(childComplexity int, query *string, limit *int, offset *int) int
+		PrescriptionCountByStatus func(childComplexity int) int
+		PrescriptionTransmission  func(childComplexity int, id string, refresh *bool) int
+		PrescriptionTransmissions func(childComplexity int, prescriptionID string) int
+		SearchPatients            func(childComplexity int, query string, limit *int) int
+		__resolve__service        func(childComplexity int) int
+		__resolve_entities        func(childComplexity int, representations []map[string]any) int
+	}
+
+	RecordAllergyPayload struct {
+		Allergy    func(childComplexity int) int
+		UserErrors func(childComplexity int) int
+	}
+
+	RecordMeasurementPayload struct {
+		Measurement func(childComplexity int) int
+		UserErrors  func(childComplexity int) int
+	}
+
+	Sig struct {
+		AsNeeded     func(childComplexity int) int
+		DoseQuantity func(childComplexity int) int
+		DoseUnit     func(childComplexity int) int
+		Frequency    func(childComplexity int) int
+		Indication   func(childComplexity int) int
+		Route        func(childComplexity int) int
+		Timing       func(childComplexity int) int
+	}
+
+	StateCount struct {
+		Count func(childComplexity int) int
+		State func(childComplexity int) int
+	}
+
+	StatusCount struct {
+		Count  func(childComplexity int) int
+		Status func(childComplexity int) int
+	}
+
+	TransmissionAcknowledgment struct {
+		Code        func(childComplexity int) int
+		Description func(childComplexity int) int
+		Kind        func(childComplexity int) int
+		MessageID   func(childComplexity int) int
+		ReceivedAt  func(childComplexity int) int
+	}
+
+	TransmitPrescriptionPayload struct {
+		Transmission func(childComplexity int) int
+		UserErrors   func(childComplexity int) int
+	}
+
+	UpdateAddressPayload struct {
+		Address    func(childComplexity int) int
+		UserErrors func(childComplexity int) int
+	}
+
+	UpdateAllergyPayload struct {
+		Allergy    func(childComplexity int) int
+		UserErrors func(childComplexity int) int
+	}
+
+	UpdateMeasurementPayload struct {
+		Measurement func(childComplexity int) int
+		UserErrors  func(childComplexity int) int
+	}
+
+	UpdatePatientPayload struct {
+		Patient    func(childComplexity int) int
+		UserErrors func(childComplexity int) int
+	}
+
+	UpdatePrescriberPayload struct {
+		Prescriber func(childComplexity int) int
+		UserErrors func(childComplexity int) int
+	}
+
+	UpdatePrescriptionPayload struct {
+		Prescription func(childComplexity int) int
+		UserErrors   func(childComplexity int) int
+	}
+
+	UserError struct {
+		Code    func(childComplexity int) int
+		Field   func(childComplexity int) int
+		Message func(childComplexity int) int
+	}
+
+	_Service struct {
+		SDL func(childComplexity int) int
 	}
 }
 
+type AllergyResolver interface {
+	Severity(ctx context.Context, obj *model.Allergy) (string, error)
+}
+type ContactPreferencesResolver interface {
+	Channels(ctx context.Context, obj *model.ContactPreferences) ([]string, error)
+}
+type DoseResolver interface {
+	Unit(ctx context.Context, obj *model1.Dose) (string, error)
+	Frequency(ctx context.Context, obj *model1.Dose) (string, error)
+	Route(ctx context.Context, obj *model1.Dose) (string, error)
+}
+type DrugInteractionWarningResolver interface {
+	Severity(ctx context.Context, obj *model1.DrugInteractionWarning) (string, error)
+}
+type EntityResolver interface {
+	FindPatientByID(ctx context.Context, id string) (*model.Patient, error)
+	FindPrescriptionByID(ctx context.Context, id string) (*model1.Prescription, error)
+}
+type InsuranceRecordResolver interface {
+	Status(ctx context.Context, obj *model.InsuranceRecord) (string, error)
+
+	Active(ctx context.Context, obj *model.InsuranceRecord) (bool, error)
+}
+type MeasurementResolver interface {
+	Type(ctx context.Context, obj *model.Measurement) (string, error)
+}
 type MutationResolver interface {
 	Empty(ctx context.Context) (*string, error)
-	CreatePatient(ctx context.Context, input CreatePatientInput) (*model.Patient, error)
-	UpdatePatient(ctx context.Context, id string, input UpdatePatientInput) (*model.Patient, error)
-	CreatePrescription(ctx context.Context, input CreatePrescriptionInput) (*model1.Prescription, error)
-	UpdatePrescription(ctx context.Context, id string, input UpdatePrescriptionInput) (*model1.Prescription, error)
+	CreateInvoiceForPrescription(ctx context.Context, prescriptionID string, amount *float64, description *string) (*model2.Invoice, error)
+	AcknowledgeInvoice(ctx context.Context, prescriptionID string, notes *string) (*model2.Invoice, error)
+	TransmitPrescription(ctx context.Context, prescriptionID string) (*TransmitPrescriptionPayload, error)
+	CreatePatient(ctx context.Context, input CreatePatientInput) (*CreatePatientPayload, error)
+	UpdatePatient(ctx context.Context, id string, input UpdatePatientInput) (*UpdatePatientPayload, error)
+	CreateAddress(ctx context.Context, patientID string, input CreateAddressInput) (*CreateAddressPayload, error)
+	UpdateAddress(ctx context.Context, patientID string, id string, input UpdateAddressInput) (*UpdateAddressPayload, error)
+	DeleteAddress(ctx context.Context, patientID string, id string) (*DeleteAddressPayload, error)
+	RecordMeasurement(ctx context.Context, patientID string, input RecordMeasurementInput) (*RecordMeasurementPayload, error)
+	UpdateMeasurement(ctx context.Context, patientID string, id string, input UpdateMeasurementInput) (*UpdateMeasurementPayload, error)
+	DeleteMeasurement(ctx context.Context, patientID string, id string) (*DeleteMeasurementPayload, error)
+	RecordAllergy(ctx context.Context, patientID string, input RecordAllergyInput) (*RecordAllergyPayload, error)
+	UpdateAllergy(ctx context.Context, patientID string, id string, input UpdateAllergyInput) (*UpdateAllergyPayload, error)
+	DeleteAllergy(ctx context.Context, patientID string, id string) (*DeleteAllergyPayload, error)
+	CreatePrescription(ctx context.Context, input CreatePrescriptionInput) (*CreatePrescriptionPayload, error)
+	UpdatePrescription(ctx context.Context, id string, input UpdatePrescriptionInput) (*UpdatePrescriptionPayload, error)
+	CreatePrescriber(ctx context.Context, input CreatePrescriberInput) (*CreatePrescriberPayload, error)
+	UpdatePrescriber(ctx context.Context, id string, input UpdatePrescriberInput) (*UpdatePrescriberPayload, error)
+	DeletePrescriber(ctx context.Context, id string) (*DeletePrescriberPayload, error)
 }
 type PatientResolver interface {
 	Addresses(ctx context.Context, obj *model.Patient) ([]model.Address, error)
-	Prescriptions(ctx context.Context, obj *model.Patient) ([]model1.Prescription, error)
+	Measurements(ctx context.Context, obj *model.Patient, typeArg *string, limit *int) ([]model.Measurement, error)
+	LatestMeasurement(ctx context.Context, obj *model.Patient, typeArg string) (*model.Measurement, error)
+
+	Allergies(ctx context.Context, obj *model.Patient) ([]model.Allergy, error)
+	Insurance(ctx context.Context, obj *model.Patient, activeOnly *bool) ([]model.InsuranceRecord, error)
+	Prescriptions(ctx context.Context, obj *model.Patient, status *PrescriptionStatus, limit *int) ([]model1.Prescription, error)
+}
+type PrescriberResolver interface {
+	Prescriptions(ctx context.Context, obj *model1.Prescriber, limit *int) ([]model1.Prescription, error)
 }
 type PrescriptionResolver interface {
 	Patient(ctx context.Context, obj *model1.Prescription) (*model.Patient, error)
 
+	Prescriber(ctx context.Context, obj *model1.Prescription) (*model1.Prescriber, error)
+
 	Status(ctx context.Context, obj *model1.Prescription) (PrescriptionStatus, error)
+
+	Directions(ctx context.Context, obj *model1.Prescription, language *SigLanguage) (string, error)
+
+	FulfillmentStatus(ctx context.Context, obj *model1.Prescription) (*string, error)
+
+	History(ctx context.Context, obj *model1.Prescription, limit *int, after *string) (*model1.PrescriptionHistoryConnection, error)
+	PriceEstimate(ctx context.Context, obj *model1.Prescription) (*model2.PriceEstimate, error)
+}
+type PrescriptionHistoryEventResolver interface {
+	Type(ctx context.Context, obj *model1.PrescriptionHistoryEvent) (PrescriptionHistoryEventType, error)
+
+	FromStatus(ctx context.Context, obj *model1.PrescriptionHistoryEvent) (*PrescriptionStatus, error)
+	ToStatus(ctx context.Context, obj *model1.PrescriptionHistoryEvent) (*PrescriptionStatus, error)
+}
+type PrescriptionTransmissionResolver interface {
+	Status(ctx context.Context, obj *model3.PrescriptionTransmission) (TransmissionStatus, error)
 }
 type QueryResolver interface {
 	Empty(ctx context.Context) (*string, error)
+	InvoicesByPatient(ctx context.Context, patientID string) ([]model2.Invoice, error)
 	DashboardStats(ctx context.Context) (*DashboardStats, error)
+	PrescriptionTransmission(ctx context.Context, id string, refresh *bool) (*model3.PrescriptionTransmission, error)
+	PrescriptionTransmissions(ctx context.Context, prescriptionID string) ([]model3.PrescriptionTransmission, error)
+	Patients(ctx context.Context, query *string, state *string, minAge *int, maxAge *int, hasActivePrescriptions *bool, limit *int, offset *int) ([]model.Patient, error)
+	PatientCountByState(ctx context.Context) ([]model.StateCount, error)
+	SearchPatients(ctx context.Context, query string, limit *int) ([]model.PatientSearchResult, error)
+	PrescriptionCountByStatus(ctx context.Context) ([]model1.StatusCount, error)
+	CheckDrugInteractions(ctx context.Context, patientID string, drug string) (*model1.InteractionCheckResult, error)
+	Prescriber(ctx context.Context, id string) (*model1.Prescriber, error)
+	Prescribers(ctx context.Context, query *string, limit *int, offset *int) ([]model1.Prescriber, error)
+	AdherenceReport(ctx context.Context, patientID string) (*model4.AdherenceReport, error)
+}
+type SigResolver interface {
+	DoseUnit(ctx context.Context, obj *model1.Sig) (string, error)
+	Route(ctx context.Context, obj *model1.Sig) (string, error)
+	Frequency(ctx context.Context, obj *model1.Sig) (string, error)
+	Timing(ctx context.Context, obj *model1.Sig) (string, error)
+}
+type StatusCountResolver interface {
+	Status(ctx context.Context, obj *model1.StatusCount) (PrescriptionStatus, error)
 }
 
 type executableSchema struct {
@@ -186,4459 +678,23495 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Address.Zip(childComplexity), true
 
-	case "DashboardStats.activePrescriptions":
-		if e.complexity.DashboardStats.ActivePrescriptions == nil {
+	case "AdherenceReport.activePrescriptions":
+		if e.complexity.AdherenceReport.ActivePrescriptions == nil {
 			break
 		}
 
-		return e.complexity.DashboardStats.ActivePrescriptions(childComplexity), true
-	case "DashboardStats.totalPatients":
-		if e.complexity.DashboardStats.TotalPatients == nil {
+		return e.complexity.AdherenceReport.ActivePrescriptions(childComplexity), true
+	case "AdherenceReport.averageDaysBetweenRefills":
+		if e.complexity.AdherenceReport.AverageDaysBetweenRefills == nil {
 			break
 		}
 
-		return e.complexity.DashboardStats.TotalPatients(childComplexity), true
+		return e.complexity.AdherenceReport.AverageDaysBetweenRefills(childComplexity), true
+	case "AdherenceReport.completedPrescriptions":
+		if e.complexity.AdherenceReport.CompletedPrescriptions == nil {
+			break
+		}
 
-	case "Mutation.createPatient":
-		if e.complexity.Mutation.CreatePatient == nil {
+		return e.complexity.AdherenceReport.CompletedPrescriptions(childComplexity), true
+	case "AdherenceReport.generatedAt":
+		if e.complexity.AdherenceReport.GeneratedAt == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createPatient_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.AdherenceReport.GeneratedAt(childComplexity), true
+	case "AdherenceReport.history":
+		if e.complexity.AdherenceReport.History == nil {
+			break
 		}
 
-		return e.complexity.Mutation.CreatePatient(childComplexity, args["input"].(CreatePatientInput)), true
-	case "Mutation.createPrescription":
-		if e.complexity.Mutation.CreatePrescription == nil {
+		return e.complexity.AdherenceReport.History(childComplexity), true
+	case "AdherenceReport.patientID":
+		if e.complexity.AdherenceReport.PatientID == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createPrescription_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.AdherenceReport.PatientID(childComplexity), true
+	case "AdherenceReport.prescriptions":
+		if e.complexity.AdherenceReport.Prescriptions == nil {
+			break
 		}
 
-		return e.complexity.Mutation.CreatePrescription(childComplexity, args["input"].(CreatePrescriptionInput)), true
-	case "Mutation._empty":
-		if e.complexity.Mutation.Empty == nil {
+		return e.complexity.AdherenceReport.Prescriptions(childComplexity), true
+	case "AdherenceReport.proportionOfDaysCovered":
+		if e.complexity.AdherenceReport.ProportionOfDaysCovered == nil {
 			break
 		}
 
-		return e.complexity.Mutation.Empty(childComplexity), true
-	case "Mutation.updatePatient":
-		if e.complexity.Mutation.UpdatePatient == nil {
+		return e.complexity.AdherenceReport.ProportionOfDaysCovered(childComplexity), true
+	case "AdherenceReport.refills":
+		if e.complexity.AdherenceReport.Refills == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_updatePatient_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.AdherenceReport.Refills(childComplexity), true
+
+	case "AdherenceSnapshot.activePrescriptions":
+		if e.complexity.AdherenceSnapshot.ActivePrescriptions == nil {
+			break
 		}
 
-		return e.complexity.Mutation.UpdatePatient(childComplexity, args["id"].(string), args["input"].(UpdatePatientInput)), true
-	case "Mutation.updatePrescription":
-		if e.complexity.Mutation.UpdatePrescription == nil {
+		return e.complexity.AdherenceSnapshot.ActivePrescriptions(childComplexity), true
+	case "AdherenceSnapshot.averageDaysBetweenRefills":
+		if e.complexity.AdherenceSnapshot.AverageDaysBetweenRefills == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_updatePrescription_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.AdherenceSnapshot.AverageDaysBetweenRefills(childComplexity), true
+	case "AdherenceSnapshot.completedPrescriptions":
+		if e.complexity.AdherenceSnapshot.CompletedPrescriptions == nil {
+			break
 		}
 
-		return e.complexity.Mutation.UpdatePrescription(childComplexity, args["id"].(string), args["input"].(UpdatePrescriptionInput)), true
+		return e.complexity.AdherenceSnapshot.CompletedPrescriptions(childComplexity), true
+	case "AdherenceSnapshot.date":
+		if e.complexity.AdherenceSnapshot.Date == nil {
+			break
+		}
 
-	case "Patient.addresses":
-		if e.complexity.Patient.Addresses == nil {
+		return e.complexity.AdherenceSnapshot.Date(childComplexity), true
+	case "AdherenceSnapshot.patientID":
+		if e.complexity.AdherenceSnapshot.PatientID == nil {
 			break
 		}
 
-		return e.complexity.Patient.Addresses(childComplexity), true
-	case "Patient.createdAt":
-		if e.complexity.Patient.CreatedAt == nil {
+		return e.complexity.AdherenceSnapshot.PatientID(childComplexity), true
+	case "AdherenceSnapshot.proportionOfDaysCovered":
+		if e.complexity.AdherenceSnapshot.ProportionOfDaysCovered == nil {
 			break
 		}
 
-		return e.complexity.Patient.CreatedAt(childComplexity), true
-	case "Patient.dob":
-		if e.complexity.Patient.DOB == nil {
+		return e.complexity.AdherenceSnapshot.ProportionOfDaysCovered(childComplexity), true
+	case "AdherenceSnapshot.refills":
+		if e.complexity.AdherenceSnapshot.Refills == nil {
 			break
 		}
 
-		return e.complexity.Patient.DOB(childComplexity), true
-	case "Patient.id":
-		if e.complexity.Patient.ID == nil {
+		return e.complexity.AdherenceSnapshot.Refills(childComplexity), true
+	case "AdherenceSnapshot.takenAt":
+		if e.complexity.AdherenceSnapshot.TakenAt == nil {
 			break
 		}
 
-		return e.complexity.Patient.ID(childComplexity), true
-	case "Patient.name":
-		if e.complexity.Patient.Name == nil {
+		return e.complexity.AdherenceSnapshot.TakenAt(childComplexity), true
+
+	case "Allergy.id":
+		if e.complexity.Allergy.ID == nil {
 			break
 		}
 
-		return e.complexity.Patient.Name(childComplexity), true
-	case "Patient.phone":
-		if e.complexity.Patient.Phone == nil {
+		return e.complexity.Allergy.ID(childComplexity), true
+	case "Allergy.patientID":
+		if e.complexity.Allergy.PatientID == nil {
 			break
 		}
 
-		return e.complexity.Patient.Phone(childComplexity), true
-	case "Patient.prescriptions":
-		if e.complexity.Patient.Prescriptions == nil {
+		return e.complexity.Allergy.PatientID(childComplexity), true
+	case "Allergy.reaction":
+		if e.complexity.Allergy.Reaction == nil {
 			break
 		}
 
-		return e.complexity.Patient.Prescriptions(childComplexity), true
-	case "Patient.state":
-		if e.complexity.Patient.State == nil {
+		return e.complexity.Allergy.Reaction(childComplexity), true
+	case "Allergy.recordedAt":
+		if e.complexity.Allergy.RecordedAt == nil {
 			break
 		}
 
-		return e.complexity.Patient.State(childComplexity), true
+		return e.complexity.Allergy.RecordedAt(childComplexity), true
+	case "Allergy.recordedBy":
+		if e.complexity.Allergy.RecordedBy == nil {
+			break
+		}
 
-	case "Prescription.createdAt":
-		if e.complexity.Prescription.CreatedAt == nil {
+		return e.complexity.Allergy.RecordedBy(childComplexity), true
+	case "Allergy.severity":
+		if e.complexity.Allergy.Severity == nil {
 			break
 		}
 
-		return e.complexity.Prescription.CreatedAt(childComplexity), true
-	case "Prescription.dose":
-		if e.complexity.Prescription.Dose == nil {
+		return e.complexity.Allergy.Severity(childComplexity), true
+	case "Allergy.substance":
+		if e.complexity.Allergy.Substance == nil {
 			break
 		}
 
-		return e.complexity.Prescription.Dose(childComplexity), true
-	case "Prescription.drug":
-		if e.complexity.Prescription.Drug == nil {
+		return e.complexity.Allergy.Substance(childComplexity), true
+
+	case "ContactPreferences.channels":
+		if e.complexity.ContactPreferences.Channels == nil {
 			break
 		}
 
-		return e.complexity.Prescription.Drug(childComplexity), true
-	case "Prescription.id":
-		if e.complexity.Prescription.ID == nil {
+		return e.complexity.ContactPreferences.Channels(childComplexity), true
+	case "ContactPreferences.doNotContact":
+		if e.complexity.ContactPreferences.DoNotContact == nil {
 			break
 		}
 
-		return e.complexity.Prescription.ID(childComplexity), true
-	case "Prescription.patient":
-		if e.complexity.Prescription.Patient == nil {
+		return e.complexity.ContactPreferences.DoNotContact(childComplexity), true
+	case "ContactPreferences.email":
+		if e.complexity.ContactPreferences.Email == nil {
 			break
 		}
 
-		return e.complexity.Prescription.Patient(childComplexity), true
-	case "Prescription.patientID":
-		if e.complexity.Prescription.PatientID == nil {
+		return e.complexity.ContactPreferences.Email(childComplexity), true
+
+	case "CreateAddressPayload.address":
+		if e.complexity.CreateAddressPayload.Address == nil {
 			break
 		}
 
-		return e.complexity.Prescription.PatientID(childComplexity), true
-	case "Prescription.status":
-		if e.complexity.Prescription.Status == nil {
+		return e.complexity.CreateAddressPayload.Address(childComplexity), true
+	case "CreateAddressPayload.userErrors":
+		if e.complexity.CreateAddressPayload.UserErrors == nil {
 			break
 		}
 
-		return e.complexity.Prescription.Status(childComplexity), true
+		return e.complexity.CreateAddressPayload.UserErrors(childComplexity), true
 
-	case "Query.dashboardStats":
-		if e.complexity.Query.DashboardStats == nil {
+	case "CreatePatientPayload.patient":
+		if e.complexity.CreatePatientPayload.Patient == nil {
 			break
 		}
 
-		return e.complexity.Query.DashboardStats(childComplexity), true
-	case "Query._empty":
-		if e.complexity.Query.Empty == nil {
+		return e.complexity.CreatePatientPayload.Patient(childComplexity), true
+	case "CreatePatientPayload.potentialDuplicates":
+		if e.complexity.CreatePatientPayload.PotentialDuplicates == nil {
 			break
 		}
 
-		return e.complexity.Query.Empty(childComplexity), true
+		return e.complexity.CreatePatientPayload.PotentialDuplicates(childComplexity), true
+	case "CreatePatientPayload.userErrors":
+		if e.complexity.CreatePatientPayload.UserErrors == nil {
+			break
+		}
 
-	}
-	return 0, false
-}
+		return e.complexity.CreatePatientPayload.UserErrors(childComplexity), true
 
-func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	opCtx := graphql.GetOperationContext(ctx)
-	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
-	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
-		ec.unmarshalInputCreatePatientInput,
-		ec.unmarshalInputCreatePrescriptionInput,
-		ec.unmarshalInputUpdatePatientInput,
-		ec.unmarshalInputUpdatePrescriptionInput,
-	)
-	first := true
+	case "CreatePrescriberPayload.prescriber":
+		if e.complexity.CreatePrescriberPayload.Prescriber == nil {
+			break
+		}
 
-	switch opCtx.Operation.Operation {
-	case ast.Query:
-		return func(ctx context.Context) *graphql.Response {
-			var response graphql.Response
-			var data graphql.Marshaler
-			if first {
-				first = false
-				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
-			} else {
-				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
-					result := <-ec.deferredResults
-					atomic.AddInt32(&ec.pendingDeferred, -1)
-					data = result.Result
-					response.Path = result.Path
-					response.Label = result.Label
-					response.Errors = result.Errors
-				} else {
-					return nil
-				}
-			}
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
-			response.Data = buf.Bytes()
-			if atomic.LoadInt32(&ec.deferred) > 0 {
-				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
-				response.HasNext = &hasNext
-			}
+		return e.complexity.CreatePrescriberPayload.Prescriber(childComplexity), true
+	case "CreatePrescriberPayload.userErrors":
+		if e.complexity.CreatePrescriberPayload.UserErrors == nil {
+			break
+		}
 
-			return &response
+		return e.complexity.CreatePrescriberPayload.UserErrors(childComplexity), true
+
+	case "CreatePrescriptionPayload.prescription":
+		if e.complexity.CreatePrescriptionPayload.Prescription == nil {
+			break
 		}
-	case ast.Mutation:
-		return func(ctx context.Context) *graphql.Response {
-			if !first {
-				return nil
-			}
-			first = false
-			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-			data := ec._Mutation(ctx, opCtx.Operation.SelectionSet)
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
 
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
+		return e.complexity.CreatePrescriptionPayload.Prescription(childComplexity), true
+	case "CreatePrescriptionPayload.userErrors":
+		if e.complexity.CreatePrescriptionPayload.UserErrors == nil {
+			break
 		}
 
-	default:
-		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
-	}
-}
+		return e.complexity.CreatePrescriptionPayload.UserErrors(childComplexity), true
 
-type executionContext struct {
-	*graphql.OperationContext
-	*executableSchema
-	deferred        int32
-	pendingDeferred int32
-	deferredResults chan graphql.DeferredResult
-}
+	case "DashboardStats.activePrescriptions":
+		if e.complexity.DashboardStats.ActivePrescriptions == nil {
+			break
+		}
 
-func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
-	atomic.AddInt32(&ec.pendingDeferred, 1)
-	go func() {
-		ctx := graphql.WithFreshResponseContext(dg.Context)
-		dg.FieldSet.Dispatch(ctx)
-		ds := graphql.DeferredResult{
-			Path:   dg.Path,
-			Label:  dg.Label,
-			Result: dg.FieldSet,
-			Errors: graphql.GetErrors(ctx),
+		return e.complexity.DashboardStats.ActivePrescriptions(childComplexity), true
+	case "DashboardStats.patientsByState":
+		if e.complexity.DashboardStats.PatientsByState == nil {
+			break
 		}
-		// null fields should bubble up
-		if dg.FieldSet.Invalids > 0 {
-			ds.Result = graphql.Null
+
+		return e.complexity.DashboardStats.PatientsByState(childComplexity), true
+	case "DashboardStats.prescriptionsByStatus":
+		if e.complexity.DashboardStats.PrescriptionsByStatus == nil {
+			break
 		}
-		ec.deferredResults <- ds
-	}()
-}
 
-func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapSchema(ec.Schema()), nil
-}
+		return e.complexity.DashboardStats.PrescriptionsByStatus(childComplexity), true
+	case "DashboardStats.totalPatients":
+		if e.complexity.DashboardStats.TotalPatients == nil {
+			break
+		}
 
-func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
-}
+		return e.complexity.DashboardStats.TotalPatients(childComplexity), true
 
-var sources = []*ast.Source{
-	{Name: "../schema.graphql", Input: `# Root GraphQL Schema for Pharmacy Modernization Application
-# This schema defines the root types and common scalars
-# Domain-specific types are defined in domain/*/graphql/schema.graphql
+	case "DeleteAddressPayload.deletedID":
+		if e.complexity.DeleteAddressPayload.DeletedID == nil {
+			break
+		}
 
-# ============================================================================
-# Authentication & Authorization Directives
-# ============================================================================
+		return e.complexity.DeleteAddressPayload.DeletedID(childComplexity), true
+	case "DeleteAddressPayload.userErrors":
+		if e.complexity.DeleteAddressPayload.UserErrors == nil {
+			break
+		}
 
-# Requires user to be authenticated
-directive @auth on FIELD_DEFINITION
+		return e.complexity.DeleteAddressPayload.UserErrors(childComplexity), true
 
-# Requires user to have ANY of the specified permissions
-directive @permissionAny(requires: [String!]!) on FIELD_DEFINITION
+	case "DeleteAllergyPayload.deletedID":
+		if e.complexity.DeleteAllergyPayload.DeletedID == nil {
+			break
+		}
 
-# Requires user to have ALL of the specified permissions
-directive @permissionAll(requires: [String!]!) on FIELD_DEFINITION
+		return e.complexity.DeleteAllergyPayload.DeletedID(childComplexity), true
+	case "DeleteAllergyPayload.userErrors":
+		if e.complexity.DeleteAllergyPayload.UserErrors == nil {
+			break
+		}
 
-# ============================================================================
-# Common Scalars
-# ============================================================================
+		return e.complexity.DeleteAllergyPayload.UserErrors(childComplexity), true
 
-scalar Time
+	case "DeleteMeasurementPayload.deletedID":
+		if e.complexity.DeleteMeasurementPayload.DeletedID == nil {
+			break
+		}
 
-# ============================================================================
-# Root Query Type
-# ============================================================================
-# Domains extend this with their own queries using "extend type Query"
+		return e.complexity.DeleteMeasurementPayload.DeletedID(childComplexity), true
+	case "DeleteMeasurementPayload.userErrors":
+		if e.complexity.DeleteMeasurementPayload.UserErrors == nil {
+			break
+		}
 
-type Query {
-  _empty: String
-}
+		return e.complexity.DeleteMeasurementPayload.UserErrors(childComplexity), true
 
-# ============================================================================
-# Root Mutation Type
-# ============================================================================
-# Domains extend this with their own mutations using "extend type Mutation"
+	case "DeletePrescriberPayload.deletedID":
+		if e.complexity.DeletePrescriberPayload.DeletedID == nil {
+			break
+		}
 
-type Mutation {
-  _empty: String
-}
-`, BuiltIn: false},
-	{Name: "../../../domain/dashboard/graphql/schema.graphql", Input: `# Dashboard Domain GraphQL Schema
+		return e.complexity.DeletePrescriberPayload.DeletedID(childComplexity), true
+	case "DeletePrescriberPayload.userErrors":
+		if e.complexity.DeletePrescriberPayload.UserErrors == nil {
+			break
+		}
 
-type DashboardStats {
-  totalPatients: Int!
-  activePrescriptions: Int!
-}
+		return e.complexity.DeletePrescriberPayload.UserErrors(childComplexity), true
 
-extend type Query {
-  # Dashboard queries - requires authentication and dashboard:view or admin permission
-  dashboardStats: DashboardStats!
-    @auth
-    @permissionAny(requires: ["dashboard:view", "admin:all"])
-}
-`, BuiltIn: false},
-	{Name: "../../../domain/patient/graphql/schema.graphql", Input: `# Patient Domain GraphQL Schema
+	case "Dose.frequency":
+		if e.complexity.Dose.Frequency == nil {
+			break
+		}
 
-type Patient {
-  id: ID!
-  name: String!
-  dob: Time!
-  phone: String!
-  state: String!
-  createdAt: Time!
-  addresses: [Address!]!
-  prescriptions: [Prescription!]!
-    @auth
-    @permissionAny(
-      requires: [
-        "prescription:read"
-        "doctor:role"
-        "pharmacist:role"
-        "admin:all"
-      ]
-    )
-}
+		return e.complexity.Dose.Frequency(childComplexity), true
+	case "Dose.route":
+		if e.complexity.Dose.Route == nil {
+			break
+		}
 
-type Address {
-  id: ID!
-  patientID: ID!
-  line1: String!
-  line2: String
-  city: String!
-  state: String!
-  zip: String!
-}
+		return e.complexity.Dose.Route(childComplexity), true
+	case "Dose.unit":
+		if e.complexity.Dose.Unit == nil {
+			break
+		}
 
-input CreatePatientInput {
-  name: String!
-  dob: Time!
-  phone: String!
-  state: String!
-}
+		return e.complexity.Dose.Unit(childComplexity), true
+	case "Dose.value":
+		if e.complexity.Dose.Value == nil {
+			break
+		}
 
-input UpdatePatientInput {
-  name: String
-  dob: Time
-  phone: String
-  state: String
-}
+		return e.complexity.Dose.Value(childComplexity), true
 
-extend type Mutation {
-  # Patient mutations - requires authentication and patient:write or admin:all permission
-  createPatient(input: CreatePatientInput!): Patient
-    @auth
-    @permissionAny(requires: ["patient:write", "admin:all"])
+	case "DrugAllergyWarning.allergyID":
+		if e.complexity.DrugAllergyWarning.AllergyID == nil {
+			break
+		}
 
-  updatePatient(id: ID!, input: UpdatePatientInput!): Patient
-    @auth
-    @permissionAny(requires: ["patient:write", "admin:all"])
-}
-`, BuiltIn: false},
-	{Name: "../../../domain/prescription/graphql/schema.graphql", Input: `# Prescription Domain GraphQL Schema
+		return e.complexity.DrugAllergyWarning.AllergyID(childComplexity), true
+	case "DrugAllergyWarning.drug":
+		if e.complexity.DrugAllergyWarning.Drug == nil {
+			break
+		}
 
-type Prescription {
-  id: ID!
-  patientID: ID!
-  patient: Patient @auth @permissionAny(requires: ["patient:read", "admin:all"])
-  drug: String!
-  dose: String!
-  status: PrescriptionStatus!
-  createdAt: Time!
-}
+		return e.complexity.DrugAllergyWarning.Drug(childComplexity), true
+	case "DrugAllergyWarning.reaction":
+		if e.complexity.DrugAllergyWarning.Reaction == nil {
+			break
+		}
 
-enum PrescriptionStatus {
-  DRAFT
-  ACTIVE
-  PAUSED
-  COMPLETED
-}
+		return e.complexity.DrugAllergyWarning.Reaction(childComplexity), true
+	case "DrugAllergyWarning.severity":
+		if e.complexity.DrugAllergyWarning.Severity == nil {
+			break
+		}
 
-input CreatePrescriptionInput {
-  patientID: ID!
-  drug: String!
-  dose: String!
-  status: PrescriptionStatus!
-}
+		return e.complexity.DrugAllergyWarning.Severity(childComplexity), true
+	case "DrugAllergyWarning.substance":
+		if e.complexity.DrugAllergyWarning.Substance == nil {
+			break
+		}
 
-input UpdatePrescriptionInput {
-  drug: String
-  dose: String
-  status: PrescriptionStatus
-}
+		return e.complexity.DrugAllergyWarning.Substance(childComplexity), true
 
-extend type Mutation {
-  # Prescription mutations - requires authentication and prescription:write or healthcare role or admin
-  createPrescription(input: CreatePrescriptionInput!): Prescription
-    @auth
-    @permissionAny(
-      requires: [
-        "prescription:write"
-        "doctor:role"
-        "pharmacist:role"
-        "admin:all"
-      ]
-    )
+	case "DrugInteractionWarning.description":
+		if e.complexity.DrugInteractionWarning.Description == nil {
+			break
+		}
 
-  updatePrescription(id: ID!, input: UpdatePrescriptionInput!): Prescription
-    @auth
-    @permissionAny(
-      requires: [
-        "prescription:write"
-        "doctor:role"
-        "pharmacist:role"
-        "admin:all"
-      ]
-    )
-}
-`, BuiltIn: false},
-}
-var parsedSchema = gqlparser.MustLoadSchema(sources...)
+		return e.complexity.DrugInteractionWarning.Description(childComplexity), true
+	case "DrugInteractionWarning.drug":
+		if e.complexity.DrugInteractionWarning.Drug == nil {
+			break
+		}
 
-// endregion ************************** generated!.gotpl **************************
+		return e.complexity.DrugInteractionWarning.Drug(childComplexity), true
+	case "DrugInteractionWarning.interactingDrug":
+		if e.complexity.DrugInteractionWarning.InteractingDrug == nil {
+			break
+		}
 
-// region    ***************************** args.gotpl *****************************
+		return e.complexity.DrugInteractionWarning.InteractingDrug(childComplexity), true
+	case "DrugInteractionWarning.interactingPrescriptionID":
+		if e.complexity.DrugInteractionWarning.InteractingPrescriptionID == nil {
+			break
+		}
 
-func (ec *executionContext) dir_permissionAll_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "requires", ec.unmarshalNString2ᚕstringᚄ)
-	if err != nil {
-		return nil, err
-	}
-	args["requires"] = arg0
-	return args, nil
-}
+		return e.complexity.DrugInteractionWarning.InteractingPrescriptionID(childComplexity), true
+	case "DrugInteractionWarning.severity":
+		if e.complexity.DrugInteractionWarning.Severity == nil {
+			break
+		}
 
-func (ec *executionContext) dir_permissionAny_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "requires", ec.unmarshalNString2ᚕstringᚄ)
-	if err != nil {
-		return nil, err
-	}
-	args["requires"] = arg0
-	return args, nil
-}
+		return e.complexity.DrugInteractionWarning.Severity(childComplexity), true
 
-func (ec *executionContext) field_Mutation_createPatient_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreatePatientInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐCreatePatientInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg0
-	return args, nil
-}
+	case "Entity.findPatientByID":
+		if e.complexity.Entity.FindPatientByID == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_createPrescription_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreatePrescriptionInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐCreatePrescriptionInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg0
-	return args, nil
-}
+		args, err := ec.field_Entity_findPatientByID_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field_Mutation_updatePatient_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdatePatientInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdatePatientInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg1
-	return args, nil
-}
+		return e.complexity.Entity.FindPatientByID(childComplexity, args["id"].(string)), true
+	case "Entity.findPrescriptionByID":
+		if e.complexity.Entity.FindPrescriptionByID == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_updatePrescription_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdatePrescriptionInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdatePrescriptionInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg1
-	return args, nil
-}
+		args, err := ec.field_Entity_findPrescriptionByID_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
-	if err != nil {
-		return nil, err
-	}
-	args["name"] = arg0
-	return args, nil
-}
+		return e.complexity.Entity.FindPrescriptionByID(childComplexity, args["id"].(string)), true
 
-func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+	case "InsuranceRecord.active":
+		if e.complexity.InsuranceRecord.Active == nil {
+			break
+		}
 
-func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.complexity.InsuranceRecord.Active(childComplexity), true
+	case "InsuranceRecord.effectiveDate":
+		if e.complexity.InsuranceRecord.EffectiveDate == nil {
+			break
+		}
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.complexity.InsuranceRecord.EffectiveDate(childComplexity), true
+	case "InsuranceRecord.expiryDate":
+		if e.complexity.InsuranceRecord.ExpiryDate == nil {
+			break
+		}
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.complexity.InsuranceRecord.ExpiryDate(childComplexity), true
+	case "InsuranceRecord.groupNumber":
+		if e.complexity.InsuranceRecord.GroupNumber == nil {
+			break
+		}
 
-// endregion ***************************** args.gotpl *****************************
+		return e.complexity.InsuranceRecord.GroupNumber(childComplexity), true
+	case "InsuranceRecord.id":
+		if e.complexity.InsuranceRecord.ID == nil {
+			break
+		}
 
-// region    ************************** directives.gotpl **************************
+		return e.complexity.InsuranceRecord.ID(childComplexity), true
+	case "InsuranceRecord.memberID":
+		if e.complexity.InsuranceRecord.MemberID == nil {
+			break
+		}
 
-// endregion ************************** directives.gotpl **************************
+		return e.complexity.InsuranceRecord.MemberID(childComplexity), true
+	case "InsuranceRecord.memberName":
+		if e.complexity.InsuranceRecord.MemberName == nil {
+			break
+		}
 
-// region    **************************** field.gotpl *****************************
+		return e.complexity.InsuranceRecord.MemberName(childComplexity), true
+	case "InsuranceRecord.patientID":
+		if e.complexity.InsuranceRecord.PatientID == nil {
+			break
+		}
 
-func (ec *executionContext) _Address_id(ctx context.Context, field graphql.CollectedField, obj *model.Address) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Address_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.InsuranceRecord.PatientID(childComplexity), true
+	case "InsuranceRecord.payerName":
+		if e.complexity.InsuranceRecord.PayerName == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Address_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Address",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.InsuranceRecord.PayerName(childComplexity), true
+	case "InsuranceRecord.rxBIN":
+		if e.complexity.InsuranceRecord.RxBIN == nil {
+			break
+		}
 
-func (ec *executionContext) _Address_patientID(ctx context.Context, field graphql.CollectedField, obj *model.Address) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Address_patientID,
-		func(ctx context.Context) (any, error) {
-			return obj.PatientID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.InsuranceRecord.RxBIN(childComplexity), true
+	case "InsuranceRecord.rxPCN":
+		if e.complexity.InsuranceRecord.RxPCN == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Address_patientID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Address",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.InsuranceRecord.RxPCN(childComplexity), true
+	case "InsuranceRecord.status":
+		if e.complexity.InsuranceRecord.Status == nil {
+			break
+		}
 
-func (ec *executionContext) _Address_line1(ctx context.Context, field graphql.CollectedField, obj *model.Address) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Address_line1,
-		func(ctx context.Context) (any, error) {
-			return obj.Line1, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.InsuranceRecord.Status(childComplexity), true
 
-func (ec *executionContext) fieldContext_Address_line1(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Address",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	case "InteractionCheckResult.allergyWarnings":
+		if e.complexity.InteractionCheckResult.AllergyWarnings == nil {
+			break
+		}
 
-func (ec *executionContext) _Address_line2(ctx context.Context, field graphql.CollectedField, obj *model.Address) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Address_line2,
-		func(ctx context.Context) (any, error) {
-			return obj.Line2, nil
-		},
-		nil,
-		ec.marshalOString2string,
-		true,
-		false,
-	)
-}
+		return e.complexity.InteractionCheckResult.AllergyWarnings(childComplexity), true
+	case "InteractionCheckResult.blocked":
+		if e.complexity.InteractionCheckResult.Blocked == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Address_line2(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Address",
-		Field:      field,
+		return e.complexity.InteractionCheckResult.Blocked(childComplexity), true
+	case "InteractionCheckResult.warnings":
+		if e.complexity.InteractionCheckResult.Warnings == nil {
+			break
+		}
+
+		return e.complexity.InteractionCheckResult.Warnings(childComplexity), true
+
+	case "Invoice.amount":
+		if e.complexity.Invoice.Amount == nil {
+			break
+		}
+
+		return e.complexity.Invoice.Amount(childComplexity), true
+	case "Invoice.createdAt":
+		if e.complexity.Invoice.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Invoice.CreatedAt(childComplexity), true
+	case "Invoice.id":
+		if e.complexity.Invoice.ID == nil {
+			break
+		}
+
+		return e.complexity.Invoice.ID(childComplexity), true
+	case "Invoice.patientID":
+		if e.complexity.Invoice.PatientID == nil {
+			break
+		}
+
+		return e.complexity.Invoice.PatientID(childComplexity), true
+	case "Invoice.prescriptionID":
+		if e.complexity.Invoice.PrescriptionID == nil {
+			break
+		}
+
+		return e.complexity.Invoice.PrescriptionID(childComplexity), true
+	case "Invoice.status":
+		if e.complexity.Invoice.Status == nil {
+			break
+		}
+
+		return e.complexity.Invoice.Status(childComplexity), true
+	case "Invoice.updatedAt":
+		if e.complexity.Invoice.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Invoice.UpdatedAt(childComplexity), true
+
+	case "Measurement.id":
+		if e.complexity.Measurement.ID == nil {
+			break
+		}
+
+		return e.complexity.Measurement.ID(childComplexity), true
+	case "Measurement.patientID":
+		if e.complexity.Measurement.PatientID == nil {
+			break
+		}
+
+		return e.complexity.Measurement.PatientID(childComplexity), true
+	case "Measurement.recordedAt":
+		if e.complexity.Measurement.RecordedAt == nil {
+			break
+		}
+
+		return e.complexity.Measurement.RecordedAt(childComplexity), true
+	case "Measurement.recordedBy":
+		if e.complexity.Measurement.RecordedBy == nil {
+			break
+		}
+
+		return e.complexity.Measurement.RecordedBy(childComplexity), true
+	case "Measurement.type":
+		if e.complexity.Measurement.Type == nil {
+			break
+		}
+
+		return e.complexity.Measurement.Type(childComplexity), true
+	case "Measurement.unit":
+		if e.complexity.Measurement.Unit == nil {
+			break
+		}
+
+		return e.complexity.Measurement.Unit(childComplexity), true
+	case "Measurement.value":
+		if e.complexity.Measurement.Value == nil {
+			break
+		}
+
+		return e.complexity.Measurement.Value(childComplexity), true
+
+	case "Mutation.acknowledgeInvoice":
+		if e.complexity.Mutation.AcknowledgeInvoice == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_acknowledgeInvoice_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AcknowledgeInvoice(childComplexity, args["prescriptionID"].(string), args["notes"].(*string)), true
+	case "Mutation.createAddress":
+		if e.complexity.Mutation.CreateAddress == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createAddress_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateAddress(childComplexity, args["patientID"].(string), args["input"].(CreateAddressInput)), true
+	case "Mutation.createInvoiceForPrescription":
+		if e.complexity.Mutation.CreateInvoiceForPrescription == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createInvoiceForPrescription_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateInvoiceForPrescription(childComplexity, args["prescriptionID"].(string), args["amount"].(*float64), args["description"].(*string)), true
+	case "Mutation.createPatient":
+		if e.complexity.Mutation.CreatePatient == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createPatient_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreatePatient(childComplexity, args["input"].(CreatePatientInput)), true
+	case "Mutation.createPrescriber":
+		if e.complexity.Mutation.CreatePrescriber == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createPrescriber_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreatePrescriber(childComplexity, args["input"].(CreatePrescriberInput)), true
+	case "Mutation.createPrescription":
+		if e.complexity.Mutation.CreatePrescription == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createPrescription_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreatePrescription(childComplexity, args["input"].(CreatePrescriptionInput)), true
+	case "Mutation.deleteAddress":
+		if e.complexity.Mutation.DeleteAddress == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteAddress_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteAddress(childComplexity, args["patientID"].(string), args["id"].(string)), true
+	case "Mutation.deleteAllergy":
+		if e.complexity.Mutation.DeleteAllergy == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteAllergy_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteAllergy(childComplexity, args["patientID"].(string), args["id"].(string)), true
+	case "Mutation.deleteMeasurement":
+		if e.complexity.Mutation.DeleteMeasurement == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteMeasurement_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteMeasurement(childComplexity, args["patientID"].(string), args["id"].(string)), true
+	case "Mutation.deletePrescriber":
+		if e.complexity.Mutation.DeletePrescriber == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deletePrescriber_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeletePrescriber(childComplexity, args["id"].(string)), true
+	case "Mutation._empty":
+		if e.complexity.Mutation.Empty == nil {
+			break
+		}
+
+		return e.complexity.Mutation.Empty(childComplexity), true
+	case "Mutation.recordAllergy":
+		if e.complexity.Mutation.RecordAllergy == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_recordAllergy_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RecordAllergy(childComplexity, args["patientID"].(string), args["input"].(RecordAllergyInput)), true
+	case "Mutation.recordMeasurement":
+		if e.complexity.Mutation.RecordMeasurement == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_recordMeasurement_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RecordMeasurement(childComplexity, args["patientID"].(string), args["input"].(RecordMeasurementInput)), true
+	case "Mutation.transmitPrescription":
+		if e.complexity.Mutation.TransmitPrescription == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_transmitPrescription_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.TransmitPrescription(childComplexity, args["prescriptionID"].(string)), true
+	case "Mutation.updateAddress":
+		if e.complexity.Mutation.UpdateAddress == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateAddress_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateAddress(childComplexity, args["patientID"].(string), args["id"].(string), args["input"].(UpdateAddressInput)), true
+	case "Mutation.updateAllergy":
+		if e.complexity.Mutation.UpdateAllergy == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateAllergy_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateAllergy(childComplexity, args["patientID"].(string), args["id"].(string), args["input"].(UpdateAllergyInput)), true
+	case "Mutation.updateMeasurement":
+		if e.complexity.Mutation.UpdateMeasurement == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateMeasurement_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateMeasurement(childComplexity, args["patientID"].(string), args["id"].(string), args["input"].(UpdateMeasurementInput)), true
+	case "Mutation.updatePatient":
+		if e.complexity.Mutation.UpdatePatient == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updatePatient_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdatePatient(childComplexity, args["id"].(string), args["input"].(UpdatePatientInput)), true
+	case "Mutation.updatePrescriber":
+		if e.complexity.Mutation.UpdatePrescriber == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updatePrescriber_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdatePrescriber(childComplexity, args["id"].(string), args["input"].(UpdatePrescriberInput)), true
+	case "Mutation.updatePrescription":
+		if e.complexity.Mutation.UpdatePrescription == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updatePrescription_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdatePrescription(childComplexity, args["id"].(string), args["input"].(UpdatePrescriptionInput)), true
+
+	case "Patient.addresses":
+		if e.complexity.Patient.Addresses == nil {
+			break
+		}
+
+		return e.complexity.Patient.Addresses(childComplexity), true
+	case "Patient.allergies":
+		if e.complexity.Patient.Allergies == nil {
+			break
+		}
+
+		return e.complexity.Patient.Allergies(childComplexity), true
+	case "Patient.contactPreferences":
+		if e.complexity.Patient.ContactPreferences == nil {
+			break
+		}
+
+		return e.complexity.Patient.ContactPreferences(childComplexity), true
+	case "Patient.createdAt":
+		if e.complexity.Patient.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Patient.CreatedAt(childComplexity), true
+	case "Patient.dob":
+		if e.complexity.Patient.DOB == nil {
+			break
+		}
+
+		return e.complexity.Patient.DOB(childComplexity), true
+	case "Patient.id":
+		if e.complexity.Patient.ID == nil {
+			break
+		}
+
+		return e.complexity.Patient.ID(childComplexity), true
+	case "Patient.insurance":
+		if e.complexity.Patient.Insurance == nil {
+			break
+		}
+
+		args, err := ec.field_Patient_insurance_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Patient.Insurance(childComplexity, args["activeOnly"].(*bool)), true
+	case "Patient.latestMeasurement":
+		if e.complexity.Patient.LatestMeasurement == nil {
+			break
+		}
+
+		args, err := ec.field_Patient_latestMeasurement_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Patient.LatestMeasurement(childComplexity, args["type"].(string)), true
+	case "Patient.measurements":
+		if e.complexity.Patient.Measurements == nil {
+			break
+		}
+
+		args, err := ec.field_Patient_measurements_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Patient.Measurements(childComplexity, args["type"].(*string), args["limit"].(*int)), true
+	case "Patient.name":
+		if e.complexity.Patient.Name == nil {
+			break
+		}
+
+		return e.complexity.Patient.Name(childComplexity), true
+	case "Patient.phone":
+		if e.complexity.Patient.Phone == nil {
+			break
+		}
+
+		return e.complexity.Patient.Phone(childComplexity), true
+	case "Patient.prescriptions":
+		if e.complexity.Patient.Prescriptions == nil {
+			break
+		}
+
+		args, err := ec.field_Patient_prescriptions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Patient.Prescriptions(childComplexity, args["status"].(*PrescriptionStatus), args["limit"].(*int)), true
+	case "Patient.state":
+		if e.complexity.Patient.State == nil {
+			break
+		}
+
+		return e.complexity.Patient.State(childComplexity), true
+
+	case "PatientSearchMatch.field":
+		if e.complexity.PatientSearchMatch.Field == nil {
+			break
+		}
+
+		return e.complexity.PatientSearchMatch.Field(childComplexity), true
+	case "PatientSearchMatch.highlighted":
+		if e.complexity.PatientSearchMatch.Highlighted == nil {
+			break
+		}
+
+		return e.complexity.PatientSearchMatch.Highlighted(childComplexity), true
+	case "PatientSearchMatch.value":
+		if e.complexity.PatientSearchMatch.Value == nil {
+			break
+		}
+
+		return e.complexity.PatientSearchMatch.Value(childComplexity), true
+
+	case "PatientSearchResult.matchType":
+		if e.complexity.PatientSearchResult.MatchType == nil {
+			break
+		}
+
+		return e.complexity.PatientSearchResult.MatchType(childComplexity), true
+	case "PatientSearchResult.matches":
+		if e.complexity.PatientSearchResult.Matches == nil {
+			break
+		}
+
+		return e.complexity.PatientSearchResult.Matches(childComplexity), true
+	case "PatientSearchResult.patient":
+		if e.complexity.PatientSearchResult.Patient == nil {
+			break
+		}
+
+		return e.complexity.PatientSearchResult.Patient(childComplexity), true
+	case "PatientSearchResult.score":
+		if e.complexity.PatientSearchResult.Score == nil {
+			break
+		}
+
+		return e.complexity.PatientSearchResult.Score(childComplexity), true
+
+	case "Pharmacy.address":
+		if e.complexity.Pharmacy.Address == nil {
+			break
+		}
+
+		return e.complexity.Pharmacy.Address(childComplexity), true
+	case "Pharmacy.city":
+		if e.complexity.Pharmacy.City == nil {
+			break
+		}
+
+		return e.complexity.Pharmacy.City(childComplexity), true
+	case "Pharmacy.id":
+		if e.complexity.Pharmacy.ID == nil {
+			break
+		}
+
+		return e.complexity.Pharmacy.ID(childComplexity), true
+	case "Pharmacy.name":
+		if e.complexity.Pharmacy.Name == nil {
+			break
+		}
+
+		return e.complexity.Pharmacy.Name(childComplexity), true
+	case "Pharmacy.phone":
+		if e.complexity.Pharmacy.Phone == nil {
+			break
+		}
+
+		return e.complexity.Pharmacy.Phone(childComplexity), true
+	case "Pharmacy.routedAt":
+		if e.complexity.Pharmacy.RoutedAt == nil {
+			break
+		}
+
+		return e.complexity.Pharmacy.RoutedAt(childComplexity), true
+	case "Pharmacy.state":
+		if e.complexity.Pharmacy.State == nil {
+			break
+		}
+
+		return e.complexity.Pharmacy.State(childComplexity), true
+	case "Pharmacy.type":
+		if e.complexity.Pharmacy.Type == nil {
+			break
+		}
+
+		return e.complexity.Pharmacy.Type(childComplexity), true
+	case "Pharmacy.zip":
+		if e.complexity.Pharmacy.Zip == nil {
+			break
+		}
+
+		return e.complexity.Pharmacy.Zip(childComplexity), true
+
+	case "PotentialDuplicate.patient":
+		if e.complexity.PotentialDuplicate.Patient == nil {
+			break
+		}
+
+		return e.complexity.PotentialDuplicate.Patient(childComplexity), true
+	case "PotentialDuplicate.reasons":
+		if e.complexity.PotentialDuplicate.Reasons == nil {
+			break
+		}
+
+		return e.complexity.PotentialDuplicate.Reasons(childComplexity), true
+
+	case "Prescriber.createdAt":
+		if e.complexity.Prescriber.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Prescriber.CreatedAt(childComplexity), true
+	case "Prescriber.credential":
+		if e.complexity.Prescriber.Credential == nil {
+			break
+		}
+
+		return e.complexity.Prescriber.Credential(childComplexity), true
+	case "Prescriber.displayName":
+		if e.complexity.Prescriber.DisplayName == nil {
+			break
+		}
+
+		return e.complexity.Prescriber.DisplayName(childComplexity), true
+	case "Prescriber.email":
+		if e.complexity.Prescriber.Email == nil {
+			break
+		}
+
+		return e.complexity.Prescriber.Email(childComplexity), true
+	case "Prescriber.fax":
+		if e.complexity.Prescriber.Fax == nil {
+			break
+		}
+
+		return e.complexity.Prescriber.Fax(childComplexity), true
+	case "Prescriber.firstName":
+		if e.complexity.Prescriber.FirstName == nil {
+			break
+		}
+
+		return e.complexity.Prescriber.FirstName(childComplexity), true
+	case "Prescriber.id":
+		if e.complexity.Prescriber.ID == nil {
+			break
+		}
+
+		return e.complexity.Prescriber.ID(childComplexity), true
+	case "Prescriber.lastName":
+		if e.complexity.Prescriber.LastName == nil {
+			break
+		}
+
+		return e.complexity.Prescriber.LastName(childComplexity), true
+	case "Prescriber.npi":
+		if e.complexity.Prescriber.NPI == nil {
+			break
+		}
+
+		return e.complexity.Prescriber.NPI(childComplexity), true
+	case "Prescriber.phone":
+		if e.complexity.Prescriber.Phone == nil {
+			break
+		}
+
+		return e.complexity.Prescriber.Phone(childComplexity), true
+	case "Prescriber.prescriptions":
+		if e.complexity.Prescriber.Prescriptions == nil {
+			break
+		}
+
+		args, err := ec.field_Prescriber_prescriptions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Prescriber.Prescriptions(childComplexity, args["limit"].(*int)), true
+	case "Prescriber.specialty":
+		if e.complexity.Prescriber.Specialty == nil {
+			break
+		}
+
+		return e.complexity.Prescriber.Specialty(childComplexity), true
+	case "Prescriber.updatedAt":
+		if e.complexity.Prescriber.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Prescriber.UpdatedAt(childComplexity), true
+
+	case "Prescription.createdAt":
+		if e.complexity.Prescription.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Prescription.CreatedAt(childComplexity), true
+	case "Prescription.daysSupply":
+		if e.complexity.Prescription.DaysSupply == nil {
+			break
+		}
+
+		return e.complexity.Prescription.DaysSupply(childComplexity), true
+	case "Prescription.directions":
+		if e.complexity.Prescription.Directions == nil {
+			break
+		}
+
+		args, err := ec.field_Prescription_directions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Prescription.Directions(childComplexity, args["language"].(*SigLanguage)), true
+	case "Prescription.dosage":
+		if e.complexity.Prescription.Dosage == nil {
+			break
+		}
+
+		return e.complexity.Prescription.Dosage(childComplexity), true
+	case "Prescription.dose":
+		if e.complexity.Prescription.Dose == nil {
+			break
+		}
+
+		return e.complexity.Prescription.Dose(childComplexity), true
+	case "Prescription.drug":
+		if e.complexity.Prescription.Drug == nil {
+			break
+		}
+
+		return e.complexity.Prescription.Drug(childComplexity), true
+	case "Prescription.expectedEndDate":
+		if e.complexity.Prescription.ExpectedEndDate == nil {
+			break
+		}
+
+		return e.complexity.Prescription.ExpectedEndDate(childComplexity), true
+	case "Prescription.fulfillmentStatus":
+		if e.complexity.Prescription.FulfillmentStatus == nil {
+			break
+		}
+
+		return e.complexity.Prescription.FulfillmentStatus(childComplexity), true
+	case "Prescription.fulfillmentUpdatedAt":
+		if e.complexity.Prescription.FulfillmentUpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Prescription.FulfillmentUpdatedAt(childComplexity), true
+	case "Prescription.history":
+		if e.complexity.Prescription.History == nil {
+			break
+		}
+
+		args, err := ec.field_Prescription_history_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Prescription.History(childComplexity, args["limit"].(*int), args["after"].(*string)), true
+	case "Prescription.id":
+		if e.complexity.Prescription.ID == nil {
+			break
+		}
+
+		return e.complexity.Prescription.ID(childComplexity), true
+	case "Prescription.interactionWarnings":
+		if e.complexity.Prescription.InteractionWarnings == nil {
+			break
+		}
+
+		return e.complexity.Prescription.InteractionWarnings(childComplexity), true
+	case "Prescription.patient":
+		if e.complexity.Prescription.Patient == nil {
+			break
+		}
+
+		return e.complexity.Prescription.Patient(childComplexity), true
+	case "Prescription.patientID":
+		if e.complexity.Prescription.PatientID == nil {
+			break
+		}
+
+		return e.complexity.Prescription.PatientID(childComplexity), true
+	case "Prescription.pharmacy":
+		if e.complexity.Prescription.Pharmacy == nil {
+			break
+		}
+
+		return e.complexity.Prescription.Pharmacy(childComplexity), true
+	case "Prescription.prescriber":
+		if e.complexity.Prescription.Prescriber == nil {
+			break
+		}
+
+		return e.complexity.Prescription.Prescriber(childComplexity), true
+	case "Prescription.prescriberID":
+		if e.complexity.Prescription.PrescriberID == nil {
+			break
+		}
+
+		return e.complexity.Prescription.PrescriberID(childComplexity), true
+	case "Prescription.priceEstimate":
+		if e.complexity.Prescription.PriceEstimate == nil {
+			break
+		}
+
+		return e.complexity.Prescription.PriceEstimate(childComplexity), true
+	case "Prescription.quantity":
+		if e.complexity.Prescription.Quantity == nil {
+			break
+		}
+
+		return e.complexity.Prescription.Quantity(childComplexity), true
+	case "Prescription.sig":
+		if e.complexity.Prescription.Sig == nil {
+			break
+		}
+
+		return e.complexity.Prescription.Sig(childComplexity), true
+	case "Prescription.status":
+		if e.complexity.Prescription.Status == nil {
+			break
+		}
+
+		return e.complexity.Prescription.Status(childComplexity), true
+
+	case "PrescriptionAdherence.averageDaysBetweenRefills":
+		if e.complexity.PrescriptionAdherence.AverageDaysBetweenRefills == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionAdherence.AverageDaysBetweenRefills(childComplexity), true
+	case "PrescriptionAdherence.dispenses":
+		if e.complexity.PrescriptionAdherence.Dispenses == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionAdherence.Dispenses(childComplexity), true
+	case "PrescriptionAdherence.drug":
+		if e.complexity.PrescriptionAdherence.Drug == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionAdherence.Drug(childComplexity), true
+	case "PrescriptionAdherence.lastDispensedAt":
+		if e.complexity.PrescriptionAdherence.LastDispensedAt == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionAdherence.LastDispensedAt(childComplexity), true
+	case "PrescriptionAdherence.prescriptionID":
+		if e.complexity.PrescriptionAdherence.PrescriptionID == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionAdherence.PrescriptionID(childComplexity), true
+	case "PrescriptionAdherence.proportionOfDaysCovered":
+		if e.complexity.PrescriptionAdherence.ProportionOfDaysCovered == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionAdherence.ProportionOfDaysCovered(childComplexity), true
+	case "PrescriptionAdherence.status":
+		if e.complexity.PrescriptionAdherence.Status == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionAdherence.Status(childComplexity), true
+
+	case "PrescriptionHistoryConnection.endCursor":
+		if e.complexity.PrescriptionHistoryConnection.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionHistoryConnection.EndCursor(childComplexity), true
+	case "PrescriptionHistoryConnection.events":
+		if e.complexity.PrescriptionHistoryConnection.Events == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionHistoryConnection.Events(childComplexity), true
+	case "PrescriptionHistoryConnection.hasNextPage":
+		if e.complexity.PrescriptionHistoryConnection.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionHistoryConnection.HasNextPage(childComplexity), true
+
+	case "PrescriptionHistoryEvent.actor":
+		if e.complexity.PrescriptionHistoryEvent.Actor == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionHistoryEvent.Actor(childComplexity), true
+	case "PrescriptionHistoryEvent.at":
+		if e.complexity.PrescriptionHistoryEvent.At == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionHistoryEvent.At(childComplexity), true
+	case "PrescriptionHistoryEvent.dispenseID":
+		if e.complexity.PrescriptionHistoryEvent.DispenseID == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionHistoryEvent.DispenseID(childComplexity), true
+	case "PrescriptionHistoryEvent.fromStatus":
+		if e.complexity.PrescriptionHistoryEvent.FromStatus == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionHistoryEvent.FromStatus(childComplexity), true
+	case "PrescriptionHistoryEvent.id":
+		if e.complexity.PrescriptionHistoryEvent.ID == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionHistoryEvent.ID(childComplexity), true
+	case "PrescriptionHistoryEvent.pharmacyID":
+		if e.complexity.PrescriptionHistoryEvent.PharmacyID == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionHistoryEvent.PharmacyID(childComplexity), true
+	case "PrescriptionHistoryEvent.pharmacyName":
+		if e.complexity.PrescriptionHistoryEvent.PharmacyName == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionHistoryEvent.PharmacyName(childComplexity), true
+	case "PrescriptionHistoryEvent.previousPharmacyID":
+		if e.complexity.PrescriptionHistoryEvent.PreviousPharmacyID == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionHistoryEvent.PreviousPharmacyID(childComplexity), true
+	case "PrescriptionHistoryEvent.reason":
+		if e.complexity.PrescriptionHistoryEvent.Reason == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionHistoryEvent.Reason(childComplexity), true
+	case "PrescriptionHistoryEvent.toStatus":
+		if e.complexity.PrescriptionHistoryEvent.ToStatus == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionHistoryEvent.ToStatus(childComplexity), true
+	case "PrescriptionHistoryEvent.type":
+		if e.complexity.PrescriptionHistoryEvent.Type == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionHistoryEvent.Type(childComplexity), true
+
+	case "PrescriptionTransmission.acknowledgments":
+		if e.complexity.PrescriptionTransmission.Acknowledgments == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionTransmission.Acknowledgments(childComplexity), true
+	case "PrescriptionTransmission.attempts":
+		if e.complexity.PrescriptionTransmission.Attempts == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionTransmission.Attempts(childComplexity), true
+	case "PrescriptionTransmission.createdAt":
+		if e.complexity.PrescriptionTransmission.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionTransmission.CreatedAt(childComplexity), true
+	case "PrescriptionTransmission.createdBy":
+		if e.complexity.PrescriptionTransmission.CreatedBy == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionTransmission.CreatedBy(childComplexity), true
+	case "PrescriptionTransmission.id":
+		if e.complexity.PrescriptionTransmission.ID == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionTransmission.ID(childComplexity), true
+	case "PrescriptionTransmission.lastError":
+		if e.complexity.PrescriptionTransmission.LastError == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionTransmission.LastError(childComplexity), true
+	case "PrescriptionTransmission.message":
+		if e.complexity.PrescriptionTransmission.Message == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionTransmission.Message(childComplexity), true
+	case "PrescriptionTransmission.nextAttemptAt":
+		if e.complexity.PrescriptionTransmission.NextAttemptAt == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionTransmission.NextAttemptAt(childComplexity), true
+	case "PrescriptionTransmission.patientID":
+		if e.complexity.PrescriptionTransmission.PatientID == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionTransmission.PatientID(childComplexity), true
+	case "PrescriptionTransmission.pharmacyID":
+		if e.complexity.PrescriptionTransmission.PharmacyID == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionTransmission.PharmacyID(childComplexity), true
+	case "PrescriptionTransmission.pharmacyName":
+		if e.complexity.PrescriptionTransmission.PharmacyName == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionTransmission.PharmacyName(childComplexity), true
+	case "PrescriptionTransmission.prescriptionID":
+		if e.complexity.PrescriptionTransmission.PrescriptionID == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionTransmission.PrescriptionID(childComplexity), true
+	case "PrescriptionTransmission.status":
+		if e.complexity.PrescriptionTransmission.Status == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionTransmission.Status(childComplexity), true
+	case "PrescriptionTransmission.updatedAt":
+		if e.complexity.PrescriptionTransmission.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.PrescriptionTransmission.UpdatedAt(childComplexity), true
+
+	case "PriceEstimate.currency":
+		if e.complexity.PriceEstimate.Currency == nil {
+			break
+		}
+
+		return e.complexity.PriceEstimate.Currency(childComplexity), true
+	case "PriceEstimate.dispensingFee":
+		if e.complexity.PriceEstimate.DispensingFee == nil {
+			break
+		}
+
+		return e.complexity.PriceEstimate.DispensingFee(childComplexity), true
+	case "PriceEstimate.drug":
+		if e.complexity.PriceEstimate.Drug == nil {
+			break
+		}
+
+		return e.complexity.PriceEstimate.Drug(childComplexity), true
+	case "PriceEstimate.estimatedAt":
+		if e.complexity.PriceEstimate.EstimatedAt == nil {
+			break
+		}
+
+		return e.complexity.PriceEstimate.EstimatedAt(childComplexity), true
+	case "PriceEstimate.ingredientCost":
+		if e.complexity.PriceEstimate.IngredientCost == nil {
+			break
+		}
+
+		return e.complexity.PriceEstimate.IngredientCost(childComplexity), true
+	case "PriceEstimate.prescriptionID":
+		if e.complexity.PriceEstimate.PrescriptionID == nil {
+			break
+		}
+
+		return e.complexity.PriceEstimate.PrescriptionID(childComplexity), true
+	case "PriceEstimate.quantity":
+		if e.complexity.PriceEstimate.Quantity == nil {
+			break
+		}
+
+		return e.complexity.PriceEstimate.Quantity(childComplexity), true
+	case "PriceEstimate.source":
+		if e.complexity.PriceEstimate.Source == nil {
+			break
+		}
+
+		return e.complexity.PriceEstimate.Source(childComplexity), true
+	case "PriceEstimate.total":
+		if e.complexity.PriceEstimate.Total == nil {
+			break
+		}
+
+		return e.complexity.PriceEstimate.Total(childComplexity), true
+	case "PriceEstimate.unitPrice":
+		if e.complexity.PriceEstimate.UnitPrice == nil {
+			break
+		}
+
+		return e.complexity.PriceEstimate.UnitPrice(childComplexity), true
+
+	case "Query.adherenceReport":
+		if e.complexity.Query.AdherenceReport == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adherenceReport_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AdherenceReport(childComplexity, args["patientID"].(string)), true
+	case "Query.checkDrugInteractions":
+		if e.complexity.Query.CheckDrugInteractions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_checkDrugInteractions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CheckDrugInteractions(childComplexity, args["patientID"].(string), args["drug"].(string)), true
+	case "Query.dashboardStats":
+		if e.complexity.Query.DashboardStats == nil {
+			break
+		}
+
+		return e.complexity.Query.DashboardStats(childComplexity), true
+	case "Query._empty":
+		if e.complexity.Query.Empty == nil {
+			break
+		}
+
+		return e.complexity.Query.Empty(childComplexity), true
+	case "Query.invoicesByPatient":
+		if e.complexity.Query.InvoicesByPatient == nil {
+			break
+		}
+
+		args, err := ec.field_Query_invoicesByPatient_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.InvoicesByPatient(childComplexity, args["patientID"].(string)), true
+	case "Query.patientCountByState":
+		if e.complexity.Query.PatientCountByState == nil {
+			break
+		}
+
+		return e.complexity.Query.PatientCountByState(childComplexity), true
+	case "Query.patients":
+		if e.complexity.Query.Patients == nil {
+			break
+		}
+
+		args, err := ec.field_Query_patients_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Patients(childComplexity, args["query"].(*string), args["state"].(*string), args["minAge"].(*int), args["maxAge"].(*int), args["hasActivePrescriptions"].(*bool), args["limit"].(*int), args["offset"].(*int)), true
+	case "Query.prescriber":
+		if e.complexity.Query.Prescriber == nil {
+			break
+		}
+
+		args, err := ec.field_Query_prescriber_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Prescriber(childComplexity, args["id"].(string)), true
+	case "Query.prescribers":
+		if e.complexity.Query.Prescribers == nil {
+			break
+		}
+
+		args, err := ec.field_Query_prescribers_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Prescribers(childComplexity, args["query"].(*string), args["limit"].(*int), args["offset"].(*int)), true
+	case "Query.prescriptionCountByStatus":
+		if e.complexity.Query.PrescriptionCountByStatus == nil {
+			break
+		}
+
+		return e.complexity.Query.PrescriptionCountByStatus(childComplexity), true
+	case "Query.prescriptionTransmission":
+		if e.complexity.Query.PrescriptionTransmission == nil {
+			break
+		}
+
+		args, err := ec.field_Query_prescriptionTransmission_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PrescriptionTransmission(childComplexity, args["id"].(string), args["refresh"].(*bool)), true
+	case "Query.prescriptionTransmissions":
+		if e.complexity.Query.PrescriptionTransmissions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_prescriptionTransmissions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PrescriptionTransmissions(childComplexity, args["prescriptionID"].(string)), true
+	case "Query.searchPatients":
+		if e.complexity.Query.SearchPatients == nil {
+			break
+		}
+
+		args, err := ec.field_Query_searchPatients_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SearchPatients(childComplexity, args["query"].(string), args["limit"].(*int)), true
+	case "Query._service":
+		if e.complexity.Query.__resolve__service == nil {
+			break
+		}
+
+		return e.complexity.Query.__resolve__service(childComplexity), true
+	case "Query._entities":
+		if e.complexity.Query.__resolve_entities == nil {
+			break
+		}
+
+		args, err := ec.field_Query__entities_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.__resolve_entities(childComplexity, args["representations"].([]map[string]any)), true
+
+	case "RecordAllergyPayload.allergy":
+		if e.complexity.RecordAllergyPayload.Allergy == nil {
+			break
+		}
+
+		return e.complexity.RecordAllergyPayload.Allergy(childComplexity), true
+	case "RecordAllergyPayload.userErrors":
+		if e.complexity.RecordAllergyPayload.UserErrors == nil {
+			break
+		}
+
+		return e.complexity.RecordAllergyPayload.UserErrors(childComplexity), true
+
+	case "RecordMeasurementPayload.measurement":
+		if e.complexity.RecordMeasurementPayload.Measurement == nil {
+			break
+		}
+
+		return e.complexity.RecordMeasurementPayload.Measurement(childComplexity), true
+	case "RecordMeasurementPayload.userErrors":
+		if e.complexity.RecordMeasurementPayload.UserErrors == nil {
+			break
+		}
+
+		return e.complexity.RecordMeasurementPayload.UserErrors(childComplexity), true
+
+	case "Sig.asNeeded":
+		if e.complexity.Sig.AsNeeded == nil {
+			break
+		}
+
+		return e.complexity.Sig.AsNeeded(childComplexity), true
+	case "Sig.doseQuantity":
+		if e.complexity.Sig.DoseQuantity == nil {
+			break
+		}
+
+		return e.complexity.Sig.DoseQuantity(childComplexity), true
+	case "Sig.doseUnit":
+		if e.complexity.Sig.DoseUnit == nil {
+			break
+		}
+
+		return e.complexity.Sig.DoseUnit(childComplexity), true
+	case "Sig.frequency":
+		if e.complexity.Sig.Frequency == nil {
+			break
+		}
+
+		return e.complexity.Sig.Frequency(childComplexity), true
+	case "Sig.indication":
+		if e.complexity.Sig.Indication == nil {
+			break
+		}
+
+		return e.complexity.Sig.Indication(childComplexity), true
+	case "Sig.route":
+		if e.complexity.Sig.Route == nil {
+			break
+		}
+
+		return e.complexity.Sig.Route(childComplexity), true
+	case "Sig.timing":
+		if e.complexity.Sig.Timing == nil {
+			break
+		}
+
+		return e.complexity.Sig.Timing(childComplexity), true
+
+	case "StateCount.count":
+		if e.complexity.StateCount.Count == nil {
+			break
+		}
+
+		return e.complexity.StateCount.Count(childComplexity), true
+	case "StateCount.state":
+		if e.complexity.StateCount.State == nil {
+			break
+		}
+
+		return e.complexity.StateCount.State(childComplexity), true
+
+	case "StatusCount.count":
+		if e.complexity.StatusCount.Count == nil {
+			break
+		}
+
+		return e.complexity.StatusCount.Count(childComplexity), true
+	case "StatusCount.status":
+		if e.complexity.StatusCount.Status == nil {
+			break
+		}
+
+		return e.complexity.StatusCount.Status(childComplexity), true
+
+	case "TransmissionAcknowledgment.code":
+		if e.complexity.TransmissionAcknowledgment.Code == nil {
+			break
+		}
+
+		return e.complexity.TransmissionAcknowledgment.Code(childComplexity), true
+	case "TransmissionAcknowledgment.description":
+		if e.complexity.TransmissionAcknowledgment.Description == nil {
+			break
+		}
+
+		return e.complexity.TransmissionAcknowledgment.Description(childComplexity), true
+	case "TransmissionAcknowledgment.kind":
+		if e.complexity.TransmissionAcknowledgment.Kind == nil {
+			break
+		}
+
+		return e.complexity.TransmissionAcknowledgment.Kind(childComplexity), true
+	case "TransmissionAcknowledgment.messageID":
+		if e.complexity.TransmissionAcknowledgment.MessageID == nil {
+			break
+		}
+
+		return e.complexity.TransmissionAcknowledgment.MessageID(childComplexity), true
+	case "TransmissionAcknowledgment.receivedAt":
+		if e.complexity.TransmissionAcknowledgment.ReceivedAt == nil {
+			break
+		}
+
+		return e.complexity.TransmissionAcknowledgment.ReceivedAt(childComplexity), true
+
+	case "TransmitPrescriptionPayload.transmission":
+		if e.complexity.TransmitPrescriptionPayload.Transmission == nil {
+			break
+		}
+
+		return e.complexity.TransmitPrescriptionPayload.Transmission(childComplexity), true
+	case "TransmitPrescriptionPayload.userErrors":
+		if e.complexity.TransmitPrescriptionPayload.UserErrors == nil {
+			break
+		}
+
+		return e.complexity.TransmitPrescriptionPayload.UserErrors(childComplexity), true
+
+	case "UpdateAddressPayload.address":
+		if e.complexity.UpdateAddressPayload.Address == nil {
+			break
+		}
+
+		return e.complexity.UpdateAddressPayload.Address(childComplexity), true
+	case "UpdateAddressPayload.userErrors":
+		if e.complexity.UpdateAddressPayload.UserErrors == nil {
+			break
+		}
+
+		return e.complexity.UpdateAddressPayload.UserErrors(childComplexity), true
+
+	case "UpdateAllergyPayload.allergy":
+		if e.complexity.UpdateAllergyPayload.Allergy == nil {
+			break
+		}
+
+		return e.complexity.UpdateAllergyPayload.Allergy(childComplexity), true
+	case "UpdateAllergyPayload.userErrors":
+		if e.complexity.UpdateAllergyPayload.UserErrors == nil {
+			break
+		}
+
+		return e.complexity.UpdateAllergyPayload.UserErrors(childComplexity), true
+
+	case "UpdateMeasurementPayload.measurement":
+		if e.complexity.UpdateMeasurementPayload.Measurement == nil {
+			break
+		}
+
+		return e.complexity.UpdateMeasurementPayload.Measurement(childComplexity), true
+	case "UpdateMeasurementPayload.userErrors":
+		if e.complexity.UpdateMeasurementPayload.UserErrors == nil {
+			break
+		}
+
+		return e.complexity.UpdateMeasurementPayload.UserErrors(childComplexity), true
+
+	case "UpdatePatientPayload.patient":
+		if e.complexity.UpdatePatientPayload.Patient == nil {
+			break
+		}
+
+		return e.complexity.UpdatePatientPayload.Patient(childComplexity), true
+	case "UpdatePatientPayload.userErrors":
+		if e.complexity.UpdatePatientPayload.UserErrors == nil {
+			break
+		}
+
+		return e.complexity.UpdatePatientPayload.UserErrors(childComplexity), true
+
+	case "UpdatePrescriberPayload.prescriber":
+		if e.complexity.UpdatePrescriberPayload.Prescriber == nil {
+			break
+		}
+
+		return e.complexity.UpdatePrescriberPayload.Prescriber(childComplexity), true
+	case "UpdatePrescriberPayload.userErrors":
+		if e.complexity.UpdatePrescriberPayload.UserErrors == nil {
+			break
+		}
+
+		return e.complexity.UpdatePrescriberPayload.UserErrors(childComplexity), true
+
+	case "UpdatePrescriptionPayload.prescription":
+		if e.complexity.UpdatePrescriptionPayload.Prescription == nil {
+			break
+		}
+
+		return e.complexity.UpdatePrescriptionPayload.Prescription(childComplexity), true
+	case "UpdatePrescriptionPayload.userErrors":
+		if e.complexity.UpdatePrescriptionPayload.UserErrors == nil {
+			break
+		}
+
+		return e.complexity.UpdatePrescriptionPayload.UserErrors(childComplexity), true
+
+	case "UserError.code":
+		if e.complexity.UserError.Code == nil {
+			break
+		}
+
+		return e.complexity.UserError.Code(childComplexity), true
+	case "UserError.field":
+		if e.complexity.UserError.Field == nil {
+			break
+		}
+
+		return e.complexity.UserError.Field(childComplexity), true
+	case "UserError.message":
+		if e.complexity.UserError.Message == nil {
+			break
+		}
+
+		return e.complexity.UserError.Message(childComplexity), true
+
+	case "_Service.sdl":
+		if e.complexity._Service.SDL == nil {
+			break
+		}
+
+		return e.complexity._Service.SDL(childComplexity), true
+
+	}
+	return 0, false
+}
+
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
+	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputContactPreferencesInput,
+		ec.unmarshalInputCreateAddressInput,
+		ec.unmarshalInputCreatePatientInput,
+		ec.unmarshalInputCreatePrescriberInput,
+		ec.unmarshalInputCreatePrescriptionInput,
+		ec.unmarshalInputDoseInput,
+		ec.unmarshalInputRecordAllergyInput,
+		ec.unmarshalInputRecordMeasurementInput,
+		ec.unmarshalInputSigInput,
+		ec.unmarshalInputUpdateAddressInput,
+		ec.unmarshalInputUpdateAllergyInput,
+		ec.unmarshalInputUpdateMeasurementInput,
+		ec.unmarshalInputUpdatePatientInput,
+		ec.unmarshalInputUpdatePrescriberInput,
+		ec.unmarshalInputUpdatePrescriptionInput,
+	)
+	first := true
+
+	switch opCtx.Operation.Operation {
+	case ast.Query:
+		return func(ctx context.Context) *graphql.Response {
+			var response graphql.Response
+			var data graphql.Marshaler
+			if first {
+				first = false
+				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
+			} else {
+				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
+					result := <-ec.deferredResults
+					atomic.AddInt32(&ec.pendingDeferred, -1)
+					data = result.Result
+					response.Path = result.Path
+					response.Label = result.Label
+					response.Errors = result.Errors
+				} else {
+					return nil
+				}
+			}
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+			response.Data = buf.Bytes()
+			if atomic.LoadInt32(&ec.deferred) > 0 {
+				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
+				response.HasNext = &hasNext
+			}
+
+			return &response
+		}
+	case ast.Mutation:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+			data := ec._Mutation(ctx, opCtx.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+
+	default:
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
+	}
+}
+
+type executionContext struct {
+	*graphql.OperationContext
+	*executableSchema
+	deferred        int32
+	pendingDeferred int32
+	deferredResults chan graphql.DeferredResult
+}
+
+func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
+	atomic.AddInt32(&ec.pendingDeferred, 1)
+	go func() {
+		ctx := graphql.WithFreshResponseContext(dg.Context)
+		dg.FieldSet.Dispatch(ctx)
+		ds := graphql.DeferredResult{
+			Path:   dg.Path,
+			Label:  dg.Label,
+			Result: dg.FieldSet,
+			Errors: graphql.GetErrors(ctx),
+		}
+		// null fields should bubble up
+		if dg.FieldSet.Invalids > 0 {
+			ds.Result = graphql.Null
+		}
+		ec.deferredResults <- ds
+	}()
+}
+
+func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapSchema(ec.Schema()), nil
+}
+
+func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
+}
+
+var sources = []*ast.Source{
+	{Name: "../federation.graphql", Input: `# ============================================================================
+# Apollo Federation
+# ============================================================================
+# Marks the schema as a Federation v2 subgraph. Entities are the types with
+# @key; the gateway resolves them through _entities. Served only when
+# graphql.federation.enabled is set; otherwise the standalone schema leaves
+# _service and _entities out.
+
+extend schema
+  @link(url: "https://specs.apollo.dev/federation/v2.7", import: ["@key"])
+`, BuiltIn: false},
+	{Name: "../schema.graphql", Input: `# Root GraphQL Schema for Pharmacy Modernization Application
+# This schema defines the root types and common scalars
+# Domain-specific types are defined in domain/*/graphql/schema.graphql
+
+# ============================================================================
+# Authentication & Authorization Directives
+# ============================================================================
+
+# Requires user to be authenticated
+directive @auth on FIELD_DEFINITION
+
+# Requires user to have the permission, or one the permission catalogue accepts in its place
+# (admin:all)
+directive @hasPermission(permission: String!) on FIELD_DEFINITION
+
+# Requires user to have ANY of the specified permissions
+directive @permissionAny(requires: [String!]!) on FIELD_DEFINITION
+
+# Requires user to have ALL of the specified permissions
+directive @permissionAll(requires: [String!]!) on FIELD_DEFINITION
+
+# ============================================================================
+# Common Scalars
+# ============================================================================
+
+scalar Time
+
+# Calendar date that may be partly known: YYYY, YYYY-MM or YYYY-MM-DD
+scalar PartialDate
+
+# ============================================================================
+# Root Query Type
+# ============================================================================
+# Domains extend this with their own queries using "extend type Query"
+
+type Query {
+  _empty: String
+}
+
+# ============================================================================
+# Root Mutation Type
+# ============================================================================
+# Domains extend this with their own mutations using "extend type Mutation"
+
+type Mutation {
+  _empty: String
+}
+
+# ============================================================================
+# Mutation Results
+# ============================================================================
+# Mutations return a payload with the record they wrote and the userErrors that
+# stopped them, e.g. { patient, userErrors }. Errors a client can correct (invalid
+# input, a missing record, a conflicting write, a business rule) come back as
+# userErrors with the record null; others are returned in the response's errors.
+
+type UserError {
+  # Input field the error concerns, e.g. "name" or "sig.route"; null when it concerns the whole input
+  field: String
+  # Machine-readable reason: REQUIRED, OUT_OF_RANGE, NOT_ALLOWED, CONFLICTING_FIELDS, INVALID,
+  # NOT_FOUND, DUPLICATE, CONFLICT or BUSINESS_RULE_VIOLATION
+  code: String!
+  message: String!
+}
+`, BuiltIn: false},
+	{Name: "../../../domain/billing/graphql/schema.graphql", Input: `# Billing Domain GraphQL Schema
+
+# A prescription's invoice in IRIS billing; status is one of pending, acknowledged, paid
+type Invoice {
+  id: ID!
+  prescriptionID: ID!
+  patientID: ID
+  amount: Float!
+  status: String!
+  createdAt: String
+  updatedAt: String
+}
+
+# What a prescription is expected to cost before it is billed
+type PriceEstimate {
+  prescriptionID: ID!
+  drug: String!
+  # Units priced; a prescription without a quantity is priced as one unit
+  quantity: Int!
+  unitPrice: Float!
+  ingredientCost: Float!
+  dispensingFee: Float!
+  total: Float!
+  currency: String!
+  # iris, or default when IRIS billing was unavailable and the configured pricing table was used
+  source: String!
+  estimatedAt: Time!
+}
+
+extend type Prescription {
+  # Expected cost from IRIS billing, cached briefly
+  priceEstimate: PriceEstimate
+    @auth
+    @hasPermission(permission: "billing:read")
+}
+
+extend type Query {
+  # Billing queries - requires authentication and billing:read or admin:all permission
+  invoicesByPatient(patientID: ID!): [Invoice!]
+    @auth
+    @hasPermission(permission: "billing:read")
+}
+
+extend type Mutation {
+  # Bills a completed prescription; amount defaults to the configured dispensing fee
+  createInvoiceForPrescription(
+    prescriptionID: ID!
+    amount: Float
+    description: String
+  ): Invoice
+    @auth
+    @hasPermission(permission: "billing:write")
+
+  # Confirms a pending invoice on behalf of the current user
+  acknowledgeInvoice(prescriptionID: ID!, notes: String): Invoice
+    @auth
+    @hasPermission(permission: "billing:acknowledge")
+}
+`, BuiltIn: false},
+	{Name: "../../../domain/dashboard/graphql/schema.graphql", Input: `# Dashboard Domain GraphQL Schema
+
+type DashboardStats {
+  totalPatients: Int!
+  activePrescriptions: Int!
+  # Only the states in the caller's data-access scope
+  patientsByState: [StateCount!]!
+  prescriptionsByStatus: [StatusCount!]!
+}
+
+extend type Query {
+  # Dashboard queries - requires authentication and dashboard:view or admin permission
+  dashboardStats: DashboardStats
+    @auth
+    @hasPermission(permission: "dashboard:view")
+}
+`, BuiltIn: false},
+	{Name: "../../../domain/eprescribing/graphql/schema.graphql", Input: `# Electronic prescribing: prescriptions sent to their pharmacy as NCPDP SCRIPT NewRx messages
+
+enum TransmissionStatus {
+  QUEUED
+  SENT
+  VERIFIED
+  REJECTED
+  FAILED
+}
+
+type PrescriptionTransmission {
+  # The SCRIPT message ID
+  id: ID!
+  prescriptionID: ID!
+  patientID: ID!
+  pharmacyID: String!
+  pharmacyName: String!
+  status: TransmissionStatus!
+  # The NewRx XML message
+  message: String!
+  attempts: Int!
+  nextAttemptAt: Time
+  lastError: String
+  acknowledgments: [TransmissionAcknowledgment!]!
+  createdBy: String
+  createdAt: Time!
+  updatedAt: Time!
+}
+
+# A SCRIPT Status, Verify or Error message received for a transmission
+type TransmissionAcknowledgment {
+  messageID: String
+  kind: String!
+  code: String!
+  description: String
+  receivedAt: Time!
+}
+
+# The transmission is null when userErrors is not empty
+type TransmitPrescriptionPayload {
+  transmission: PrescriptionTransmission
+  userErrors: [UserError!]!
+}
+
+extend type Query {
+  # With refresh, a queued transmission is sent and a sent one polled before it is returned
+  prescriptionTransmission(id: ID!, refresh: Boolean = false): PrescriptionTransmission
+    @auth
+    @permissionAny(
+      requires: [
+        "prescription:read"
+        "doctor:role"
+        "pharmacist:role"
+        "admin:all"
+      ]
+    )
+
+  prescriptionTransmissions(prescriptionID: ID!): [PrescriptionTransmission!]
+    @auth
+    @permissionAny(
+      requires: [
+        "prescription:read"
+        "doctor:role"
+        "pharmacist:role"
+        "admin:all"
+      ]
+    )
+}
+
+extend type Mutation {
+  # Sends an active prescription routed to a pharmacy as a NewRx; failed sends are retried in the
+  # background. A prescription being or already transmitted to its pharmacy is a CONFLICT.
+  transmitPrescription(prescriptionID: ID!): TransmitPrescriptionPayload
+    @auth
+    @permissionAny(
+      requires: [
+        "prescription:write"
+        "doctor:role"
+        "pharmacist:role"
+        "admin:all"
+      ]
+    )
+}
+`, BuiltIn: false},
+	{Name: "../../../domain/patient/graphql/schema.graphql", Input: `# Patient Domain GraphQL Schema
+
+# A federation entity: other subgraphs reference patients by id
+type Patient @key(fields: "id") {
+  id: ID!
+  name: String!
+  dob: PartialDate!
+  phone: String!
+  state: String!
+  createdAt: Time!
+  addresses: [Address!]!
+  # Newest first; type is one of weight, height, temperature, heart_rate, bp_systolic, bp_diastolic
+  measurements(type: String, limit: Int): [Measurement!]!
+  latestMeasurement(type: String!): Measurement
+  # Null until the patient agrees to be notified
+  contactPreferences: ContactPreferences
+  # Newest first; prescribing a drug matching one of them is blocked
+  allergies: [Allergy!]!
+  # Newest first; activeOnly keeps the confirmed coverage in effect today
+  insurance(activeOnly: Boolean): [InsuranceRecord!]!
+  # Newest first, optionally only those with the status; every prescription when limit is omitted
+  prescriptions(status: PrescriptionStatus, limit: Int): [Prescription!]
+    @auth
+    @permissionAny(
+      requires: [
+        "prescription:read"
+        "doctor:role"
+        "pharmacist:role"
+        "admin:all"
+      ]
+    )
+}
+
+# The channels a patient agreed to be contacted on about prescriptions and invoices
+type ContactPreferences {
+  # sms, email or phone, in order of preference; phone means calls from the pharmacy staff
+  channels: [String!]!
+  email: String
+  # Stops every notification whatever the channels
+  doNotContact: Boolean!
+}
+
+type Address {
+  id: ID!
+  patientID: ID!
+  line1: String!
+  line2: String
+  city: String!
+  state: String!
+  zip: String!
+}
+
+type Measurement {
+  id: ID!
+  patientID: ID!
+  type: String!
+  value: Float!
+  unit: String!
+  recordedAt: Time!
+  recordedBy: String!
+}
+
+# A substance the patient is allergic to: a drug name, brand name or drug class such as "NSAID".
+# severity is one of mild, moderate, severe.
+type Allergy {
+  id: ID!
+  patientID: ID!
+  substance: String!
+  reaction: String!
+  severity: String!
+  recordedAt: Time!
+  recordedBy: String!
+}
+
+# Insurance coverage; status is one of pending_confirmation, confirmed, rejected. Dates are
+# calendar days at midnight UTC, and a null expiryDate means open-ended coverage.
+type InsuranceRecord {
+  id: ID!
+  patientID: ID!
+  payerName: String!
+  memberID: String!
+  groupNumber: String
+  memberName: String
+  rxBIN: String
+  rxPCN: String
+  status: String!
+  effectiveDate: Time
+  expiryDate: Time
+  # Confirmed and in effect today
+  active: Boolean!
+}
+
+# A ranked patient search hit; matchType is "text" for full-text matches or "fuzzy" for typo-tolerant ones
+type PatientSearchResult {
+  patient: Patient!
+  score: Float!
+  matchType: String!
+  matches: [PatientSearchMatch!]!
+}
+
+# A field that matched the query; highlighted is HTML-escaped with matched words wrapped in <mark>
+type PatientSearchMatch {
+  field: String!
+  value: String!
+  highlighted: String!
+}
+
+input RecordMeasurementInput {
+  type: String!
+  value: Float!
+  unit: String!
+  recordedAt: Time
+}
+
+input UpdateMeasurementInput {
+  value: Float
+  unit: String
+  recordedAt: Time
+}
+
+input RecordAllergyInput {
+  substance: String!
+  reaction: String
+  severity: String!
+}
+
+input UpdateAllergyInput {
+  substance: String
+  reaction: String
+  severity: String
+}
+
+input CreateAddressInput {
+  line1: String!
+  line2: String
+  city: String!
+  state: String!
+  zip: String!
+}
+
+# Only the fields that are set change; an empty line2 clears it
+input UpdateAddressInput {
+  line1: String
+  line2: String
+  city: String
+  state: String
+  zip: String
+}
+
+input CreatePatientInput {
+  name: String!
+  dob: PartialDate!
+  phone: String!
+  state: String!
+}
+
+# The number of patients living in a state
+type StateCount {
+  state: String!
+  count: Int!
+}
+
+input ContactPreferencesInput {
+  # sms, email or phone, in order of preference; email requires an email address
+  channels: [String!]!
+  email: String
+  doNotContact: Boolean!
+}
+
+input UpdatePatientInput {
+  name: String
+  dob: PartialDate
+  phone: String
+  state: String
+  # Replaces the patient's contact preferences
+  contactPreferences: ContactPreferencesInput
+}
+
+extend type Query {
+  # Patients matching every given filter, restricted to the caller's data-access scope - requires
+  # patient:read or admin:all. minAge and maxAge are completed years derived from the DOB; a partial
+  # DOB matches when any age it allows is in range.
+  patients(
+    query: String
+    state: String
+    minAge: Int
+    maxAge: Int
+    hasActivePrescriptions: Boolean
+    limit: Int
+    offset: Int
+  ): [Patient!]
+    @auth
+    @hasPermission(permission: "patient:read")
+
+  # Patient counts per state ordered by state, restricted to the caller's data-access scope and
+  # cached for a minute - requires patient:read or admin:all
+  patientCountByState: [StateCount!]
+    @auth
+    @hasPermission(permission: "patient:read")
+
+  # Full-text search over name, phone, state and address city/zip - requires patient:read or admin:all
+  searchPatients(query: String!, limit: Int): [PatientSearchResult!]
+    @auth
+    @hasPermission(permission: "patient:read")
+}
+
+# Mutation payloads; the record is null when userErrors is not empty
+type CreatePatientPayload {
+  patient: Patient
+  # Existing patients the new one likely duplicates; with patient_duplicates.mode block they
+  # are why it was not created
+  potentialDuplicates: [PotentialDuplicate!]!
+  userErrors: [UserError!]!
+}
+
+# An existing patient a new one likely duplicates; reasons are phone (the same phone number,
+# ignoring formatting) and name_dob (the same DOB and a similar name)
+type PotentialDuplicate {
+  patient: Patient!
+  reasons: [String!]!
+}
+
+type UpdatePatientPayload {
+  patient: Patient
+  userErrors: [UserError!]!
+}
+
+type CreateAddressPayload {
+  address: Address
+  userErrors: [UserError!]!
+}
+
+type UpdateAddressPayload {
+  address: Address
+  userErrors: [UserError!]!
+}
+
+type DeleteAddressPayload {
+  deletedID: ID
+  userErrors: [UserError!]!
+}
+
+type RecordMeasurementPayload {
+  measurement: Measurement
+  userErrors: [UserError!]!
+}
+
+type UpdateMeasurementPayload {
+  measurement: Measurement
+  userErrors: [UserError!]!
+}
+
+type DeleteMeasurementPayload {
+  deletedID: ID
+  userErrors: [UserError!]!
+}
+
+type RecordAllergyPayload {
+  allergy: Allergy
+  userErrors: [UserError!]!
+}
+
+type UpdateAllergyPayload {
+  allergy: Allergy
+  userErrors: [UserError!]!
+}
+
+type DeleteAllergyPayload {
+  deletedID: ID
+  userErrors: [UserError!]!
+}
+
+extend type Mutation {
+  # Patient mutations - requires authentication and patient:write or admin:all permission
+  createPatient(input: CreatePatientInput!): CreatePatientPayload
+    @auth
+    @hasPermission(permission: "patient:write")
+
+  updatePatient(id: ID!, input: UpdatePatientInput!): UpdatePatientPayload
+    @auth
+    @hasPermission(permission: "patient:write")
+
+  # Address mutations - requires authentication and patient:write or admin:all permission
+  createAddress(patientID: ID!, input: CreateAddressInput!): CreateAddressPayload
+    @auth
+    @hasPermission(permission: "patient:write")
+
+  updateAddress(patientID: ID!, id: ID!, input: UpdateAddressInput!): UpdateAddressPayload
+    @auth
+    @hasPermission(permission: "patient:write")
+
+  deleteAddress(patientID: ID!, id: ID!): DeleteAddressPayload
+    @auth
+    @hasPermission(permission: "patient:write")
+
+  # Measurement mutations - requires authentication and patient:write or admin:all permission
+  recordMeasurement(patientID: ID!, input: RecordMeasurementInput!): RecordMeasurementPayload
+    @auth
+    @hasPermission(permission: "patient:write")
+
+  updateMeasurement(patientID: ID!, id: ID!, input: UpdateMeasurementInput!): UpdateMeasurementPayload
+    @auth
+    @hasPermission(permission: "patient:write")
+
+  deleteMeasurement(patientID: ID!, id: ID!): DeleteMeasurementPayload
+    @auth
+    @hasPermission(permission: "patient:write")
+
+  # Allergy mutations - requires authentication and patient:write or admin:all permission
+  recordAllergy(patientID: ID!, input: RecordAllergyInput!): RecordAllergyPayload
+    @auth
+    @hasPermission(permission: "patient:write")
+
+  updateAllergy(patientID: ID!, id: ID!, input: UpdateAllergyInput!): UpdateAllergyPayload
+    @auth
+    @hasPermission(permission: "patient:write")
+
+  deleteAllergy(patientID: ID!, id: ID!): DeleteAllergyPayload
+    @auth
+    @hasPermission(permission: "patient:write")
+}
+`, BuiltIn: false},
+	{Name: "../../../domain/prescription/graphql/schema.graphql", Input: `# Prescription Domain GraphQL Schema
+
+# A federation entity: other subgraphs reference prescriptions by id
+type Prescription @key(fields: "id") {
+  id: ID!
+  patientID: ID!
+  patient: Patient @auth @hasPermission(permission: "patient:read")
+  # Clinician the prescription was written under; empty on prescriptions written before
+  # prescribers were recorded
+  prescriberID: ID!
+  prescriber: Prescriber
+  drug: String!
+  # The dosage as text, or the free text of an older dose that could not be read
+  dose: String!
+  # Structured dose; null when the free-text dose could not be read
+  dosage: Dose
+  status: PrescriptionStatus!
+  createdAt: Time!
+  # Dosing instruction; its fields are empty when not specified
+  sig: Sig!
+  # The sig as label text; empty when no sig is specified
+  directions(language: SigLanguage = EN): String!
+  # Supply; quantity and daysSupply are 0 when not specified
+  quantity: Int!
+  daysSupply: Int!
+  # When the days supply runs out, counted from creation
+  expectedEndDate: Time
+  interactionWarnings: [DrugInteractionWarning!]!
+  # Status reported by the external pharmacy; null until the first poll succeeds
+  fulfillmentStatus: String
+  fulfillmentUpdatedAt: Time
+  # Network pharmacy the prescription was routed to; null until it is routed
+  pharmacy: Pharmacy
+  # Lifecycle events, oldest first; pass endCursor as after to read the next page. Cursors are
+  # signed, expire, and only open for the prescription they were issued for
+  history(limit: Int, after: String): PrescriptionHistoryConnection
+    @auth
+    @permissionAny(
+      requires: [
+        "prescription:read"
+        "doctor:role"
+        "pharmacist:role"
+        "admin:all"
+      ]
+    )
+}
+
+type Prescriber {
+  id: ID!
+  # National Provider Identifier: 10 digits with a check digit
+  npi: String!
+  firstName: String!
+  lastName: String!
+  # Degree shown after the name, e.g. MD, DO or NP
+  credential: String!
+  specialty: String!
+  phone: String!
+  fax: String!
+  email: String!
+  # Name as printed on a prescription, e.g. "Dana Whitfield, MD"
+  displayName: String!
+  createdAt: Time!
+  updatedAt: Time!
+  # Prescriptions written under the prescriber, newest first
+  prescriptions(limit: Int): [Prescription!]!
+}
+
+type Sig {
+  # Units per dose; 0 means one unit
+  doseQuantity: Float!
+  doseUnit: String!
+  route: String!
+  # Frequency code (QD, BID, TID, QID, Q4H, Q6H, Q8H, Q12H, QHS, QWK)
+  frequency: String!
+  timing: String!
+  # With asNeeded the frequency is the most the patient may take
+  asNeeded: Boolean!
+  indication: String!
+}
+
+type Dose {
+  value: Float!
+  # mg, mcg, g, mL, unit, IU, mEq, %, tablet, capsule, puff, drop, spray, patch or application
+  unit: String!
+  # Frequency code and route the dose is written for; empty when not given
+  frequency: String!
+  route: String!
+}
+
+enum SigLanguage {
+  EN
+  ES
+}
+
+enum PrescriptionHistoryEventType {
+  CREATED
+  STATUS_CHANGED
+  DISPENSED
+  DISPENSE_REVERSED
+  # Sent to a network pharmacy; previousPharmacyID is set when it was moved from another one
+  ROUTED
+}
+
+type PrescriptionHistoryEvent {
+  id: ID!
+  type: PrescriptionHistoryEventType!
+  at: Time!
+  # Empty for changes made by scheduled jobs
+  actor: String!
+  reason: String!
+  # Set on CREATED (toStatus only) and STATUS_CHANGED events
+  fromStatus: PrescriptionStatus
+  toStatus: PrescriptionStatus
+  # Set on DISPENSED and DISPENSE_REVERSED events
+  dispenseID: String!
+  # Set on ROUTED events
+  pharmacyID: String!
+  pharmacyName: String!
+  previousPharmacyID: String!
+}
+
+type PrescriptionHistoryConnection {
+  events: [PrescriptionHistoryEvent!]!
+  endCursor: String
+  hasNextPage: Boolean!
+}
+
+type Pharmacy {
+  id: ID!
+  name: String!
+  type: String!
+  address: String!
+  city: String!
+  state: String!
+  zip: String!
+  phone: String!
+  routedAt: Time!
+}
+
+type DrugInteractionWarning {
+  drug: String!
+  interactingDrug: String!
+  interactingPrescriptionID: ID!
+  severity: String!
+  description: String!
+}
+
+# A recorded patient allergy the drug matches; it always blocks the prescription
+type DrugAllergyWarning {
+  drug: String!
+  allergyID: ID!
+  substance: String!
+  reaction: String!
+  severity: String!
+}
+
+type InteractionCheckResult {
+  warnings: [DrugInteractionWarning!]!
+  allergyWarnings: [DrugAllergyWarning!]!
+  blocked: Boolean!
+}
+
+enum PrescriptionStatus {
+  DRAFT
+  ACTIVE
+  PAUSED
+  COMPLETED
+  EXPIRED
+}
+
+# The number of prescriptions with a status
+type StatusCount {
+  status: PrescriptionStatus!
+  count: Int!
+}
+
+input CreatePrescriptionInput {
+  patientID: ID!
+  prescriberID: ID!
+  drug: String!
+  # The dose is given structured or as free text, e.g. "10mg" or "500 mg po bid", which is parsed
+  dose: String
+  dosage: DoseInput
+  status: PrescriptionStatus!
+  # The sig is given structured or as free text, e.g. "1 tab po bid prn pain"; with a sig
+  # frequency daysSupply is derived from quantity when omitted
+  sig: SigInput
+  sigText: String
+  quantity: Int
+  daysSupply: Int
+}
+
+input DoseInput {
+  value: Float!
+  # mg, mcg, g, mL, unit, IU, mEq, %, tablet, capsule, puff, drop, spray, patch or application
+  unit: String!
+  # QD, BID, TID, QID, Q4H, Q6H, Q8H, Q12H, QHS or QWK; must match the sig frequency when both are given
+  frequency: String
+  # A sig route; must match the sig route when both are given
+  route: String
+}
+
+input SigInput {
+  doseQuantity: Float
+  # tablet, capsule, mL, puff, drop, spray, patch, application or unit
+  doseUnit: String
+  # oral, sublingual, topical, transdermal, inhaled, nasal, ophthalmic, otic, rectal,
+  # subcutaneous or intramuscular
+  route: String
+  # QD, BID, TID, QID, Q4H, Q6H, Q8H, Q12H, QHS or QWK
+  frequency: String
+  # with_food, before_meals, after_meals, empty_stomach, morning, evening or bedtime
+  timing: String
+  asNeeded: Boolean
+  indication: String
+}
+
+input CreatePrescriberInput {
+  npi: String!
+  firstName: String!
+  lastName: String!
+  credential: String
+  specialty: String
+  phone: String
+  fax: String
+  email: String
+}
+
+# Omitted fields are left unchanged; an empty phone, fax or email clears it
+input UpdatePrescriberInput {
+  npi: String
+  firstName: String
+  lastName: String
+  credential: String
+  specialty: String
+  phone: String
+  fax: String
+  email: String
+}
+
+input UpdatePrescriptionInput {
+  drug: String
+  dose: String
+  dosage: DoseInput
+  status: PrescriptionStatus
+  # A new sig or quantity derives daysSupply again unless it is given too
+  sig: SigInput
+  sigText: String
+  quantity: Int
+  daysSupply: Int
+}
+
+extend type Query {
+  # Prescription counts per status ordered by status, cached for a minute; statuses without
+  # prescriptions are left out
+  prescriptionCountByStatus: [StatusCount!]
+    @auth
+    @permissionAny(
+      requires: [
+        "prescription:read"
+        "doctor:role"
+        "pharmacist:role"
+        "admin:all"
+      ]
+    )
+
+  # Checks a drug against the patient's current prescriptions before prescribing
+  checkDrugInteractions(patientID: ID!, drug: String!): InteractionCheckResult
+    @auth
+    @permissionAny(
+      requires: [
+        "prescription:read"
+        "doctor:role"
+        "pharmacist:role"
+        "admin:all"
+      ]
+    )
+
+  prescriber(id: ID!): Prescriber
+    @auth
+    @permissionAny(
+      requires: [
+        "prescription:read"
+        "doctor:role"
+        "pharmacist:role"
+        "admin:all"
+      ]
+    )
+
+  # Prescribers ordered by name; a query matches the start of a first or last name, or the NPI
+  prescribers(query: String, limit: Int, offset: Int): [Prescriber!]
+    @auth
+    @permissionAny(
+      requires: [
+        "prescription:read"
+        "doctor:role"
+        "pharmacist:role"
+        "admin:all"
+      ]
+    )
+}
+
+# Mutation payloads; the prescription is null when userErrors is not empty
+type CreatePrescriptionPayload {
+  prescription: Prescription
+  userErrors: [UserError!]!
+}
+
+type UpdatePrescriptionPayload {
+  prescription: Prescription
+  userErrors: [UserError!]!
+}
+
+type CreatePrescriberPayload {
+  prescriber: Prescriber
+  userErrors: [UserError!]!
+}
+
+type UpdatePrescriberPayload {
+  prescriber: Prescriber
+  userErrors: [UserError!]!
+}
+
+type DeletePrescriberPayload {
+  deletedID: ID
+  userErrors: [UserError!]!
+}
+
+extend type Mutation {
+  # Prescription mutations - requires authentication and prescription:write or healthcare role or admin
+  createPrescription(input: CreatePrescriptionInput!): CreatePrescriptionPayload
+    @auth
+    @permissionAny(
+      requires: [
+        "prescription:write"
+        "doctor:role"
+        "pharmacist:role"
+        "admin:all"
+      ]
+    )
+
+  updatePrescription(id: ID!, input: UpdatePrescriptionInput!): UpdatePrescriptionPayload
+    @auth
+    @permissionAny(
+      requires: [
+        "prescription:write"
+        "doctor:role"
+        "pharmacist:role"
+        "admin:all"
+      ]
+    )
+
+  # Prescriber mutations; an NPI already on file is a DUPLICATE error and deleting a prescriber
+  # with prescriptions is a CONFLICT
+  createPrescriber(input: CreatePrescriberInput!): CreatePrescriberPayload
+    @auth
+    @permissionAny(
+      requires: [
+        "prescription:write"
+        "doctor:role"
+        "pharmacist:role"
+        "admin:all"
+      ]
+    )
+
+  updatePrescriber(id: ID!, input: UpdatePrescriberInput!): UpdatePrescriberPayload
+    @auth
+    @permissionAny(
+      requires: [
+        "prescription:write"
+        "doctor:role"
+        "pharmacist:role"
+        "admin:all"
+      ]
+    )
+
+  deletePrescriber(id: ID!): DeletePrescriberPayload
+    @auth
+    @permissionAny(
+      requires: [
+        "prescription:write"
+        "doctor:role"
+        "pharmacist:role"
+        "admin:all"
+      ]
+    )
+}
+`, BuiltIn: false},
+	{Name: "../../../domain/reporting/graphql/schema.graphql", Input: `# Reporting Domain GraphQL Schema
+
+# How one of the patient's active or completed prescriptions has been refilled
+type PrescriptionAdherence {
+  prescriptionID: ID!
+  drug: String!
+  status: String!
+  # Dispenses handed over; reversed ones and reversals are not counted
+  dispenses: Int!
+  lastDispensedAt: Time
+  # Null with fewer than two dispenses
+  averageDaysBetweenRefills: Float
+  # Between 0 and 1; null when no dispense has a days supply
+  proportionOfDaysCovered: Float
+}
+
+# A patient's adherence metrics as saved by the nightly snapshot job
+type AdherenceSnapshot {
+  patientID: ID!
+  # UTC day of the snapshot, YYYY-MM-DD
+  date: String!
+  activePrescriptions: Int!
+  completedPrescriptions: Int!
+  refills: Int!
+  averageDaysBetweenRefills: Float
+  proportionOfDaysCovered: Float
+  takenAt: Time!
+}
+
+# A patient's adherence now, with the nightly snapshots of the last month as the trend
+type AdherenceReport {
+  patientID: ID!
+  activePrescriptions: Int!
+  completedPrescriptions: Int!
+  # Dispenses after the first one of each prescription
+  refills: Int!
+  # Mean gap between consecutive dispenses over all prescriptions; null without a refill
+  averageDaysBetweenRefills: Float
+  # Mean proportion of days covered of the prescriptions, between 0 and 1
+  proportionOfDaysCovered: Float
+  prescriptions: [PrescriptionAdherence!]!
+  # Newest first
+  history: [AdherenceSnapshot!]!
+  generatedAt: Time!
+}
+
+extend type Query {
+  # Care management report - requires authentication and adherence:read or admin:all permission
+  adherenceReport(patientID: ID!): AdherenceReport
+    @auth
+    @hasPermission(permission: "adherence:read")
+}
+`, BuiltIn: false},
+	{Name: "../../../federation/directives.graphql", Input: `
+	directive @authenticated on FIELD_DEFINITION | OBJECT | INTERFACE | SCALAR | ENUM
+	directive @composeDirective(name: String!) repeatable on SCHEMA
+	directive @extends on OBJECT | INTERFACE
+	directive @external on OBJECT | FIELD_DEFINITION
+	directive @key(fields: FieldSet!, resolvable: Boolean = true) repeatable on OBJECT | INTERFACE
+	directive @inaccessible on
+	  | ARGUMENT_DEFINITION
+	  | ENUM
+	  | ENUM_VALUE
+	  | FIELD_DEFINITION
+	  | INPUT_FIELD_DEFINITION
+	  | INPUT_OBJECT
+	  | INTERFACE
+	  | OBJECT
+	  | SCALAR
+	  | UNION
+	directive @interfaceObject on OBJECT
+	directive @link(import: [String!], url: String!) repeatable on SCHEMA
+	directive @override(from: String!, label: String) on FIELD_DEFINITION
+	directive @policy(policies: [[federation__Policy!]!]!) on
+	  | FIELD_DEFINITION
+	  | OBJECT
+	  | INTERFACE
+	  | SCALAR
+	  | ENUM
+	directive @provides(fields: FieldSet!) on FIELD_DEFINITION
+	directive @requires(fields: FieldSet!) on FIELD_DEFINITION
+	directive @requiresScopes(scopes: [[federation__Scope!]!]!) on
+	  | FIELD_DEFINITION
+	  | OBJECT
+	  | INTERFACE
+	  | SCALAR
+	  | ENUM
+	directive @shareable repeatable on FIELD_DEFINITION | OBJECT
+	directive @tag(name: String!) repeatable on
+	  | ARGUMENT_DEFINITION
+	  | ENUM
+	  | ENUM_VALUE
+	  | FIELD_DEFINITION
+	  | INPUT_FIELD_DEFINITION
+	  | INPUT_OBJECT
+	  | INTERFACE
+	  | OBJECT
+	  | SCALAR
+	  | UNION
+	scalar _Any
+	scalar FieldSet
+	scalar federation__Policy
+	scalar federation__Scope
+`, BuiltIn: true},
+	{Name: "../../../federation/entity.graphql", Input: `
+# a union of all types that use the @key directive
+union _Entity = Patient | Prescription
+
+# fake type to build resolver interfaces for users to implement
+type Entity {
+	findPatientByID(id: ID!,): Patient!
+	findPrescriptionByID(id: ID!,): Prescription!
+}
+
+type _Service {
+  sdl: String
+}
+
+extend type Query {
+  _entities(representations: [_Any!]!): [_Entity]!
+  _service: _Service!
+}
+`, BuiltIn: true},
+}
+var parsedSchema = gqlparser.MustLoadSchema(sources...)
+
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+func (ec *executionContext) dir_hasPermission_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "permission", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["permission"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) dir_permissionAll_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "requires", ec.unmarshalNString2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["requires"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) dir_permissionAny_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "requires", ec.unmarshalNString2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["requires"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Entity_findPatientByID_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Entity_findPrescriptionByID_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_acknowledgeInvoice_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "prescriptionID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["prescriptionID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "notes", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["notes"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_createAddress_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "patientID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["patientID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreateAddressInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐCreateAddressInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_createInvoiceForPrescription_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "prescriptionID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["prescriptionID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "amount", ec.unmarshalOFloat2ᚖfloat64)
+	if err != nil {
+		return nil, err
+	}
+	args["amount"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "description", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["description"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_createPatient_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreatePatientInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐCreatePatientInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_createPrescriber_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreatePrescriberInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐCreatePrescriberInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_createPrescription_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreatePrescriptionInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐCreatePrescriptionInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_deleteAddress_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "patientID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["patientID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_deleteAllergy_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "patientID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["patientID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_deleteMeasurement_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "patientID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["patientID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_deletePrescriber_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_recordAllergy_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "patientID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["patientID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNRecordAllergyInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐRecordAllergyInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_recordMeasurement_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "patientID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["patientID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNRecordMeasurementInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐRecordMeasurementInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_transmitPrescription_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "prescriptionID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["prescriptionID"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_updateAddress_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "patientID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["patientID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateAddressInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdateAddressInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_updateAllergy_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "patientID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["patientID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateAllergyInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdateAllergyInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_updateMeasurement_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "patientID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["patientID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateMeasurementInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdateMeasurementInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_updatePatient_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdatePatientInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdatePatientInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_updatePrescriber_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdatePrescriberInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdatePrescriberInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_updatePrescription_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdatePrescriptionInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdatePrescriptionInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Patient_insurance_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "activeOnly", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["activeOnly"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Patient_latestMeasurement_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "type", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["type"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Patient_measurements_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "type", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["type"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Patient_prescriptions_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "status", ec.unmarshalOPrescriptionStatus2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐPrescriptionStatus)
+	if err != nil {
+		return nil, err
+	}
+	args["status"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Prescriber_prescriptions_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Prescription_directions_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "language", ec.unmarshalOSigLanguage2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐSigLanguage)
+	if err != nil {
+		return nil, err
+	}
+	args["language"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Prescription_history_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "after", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["after"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query__entities_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "representations", ec.unmarshalN_Any2ᚕmapᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["representations"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_adherenceReport_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "patientID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["patientID"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_checkDrugInteractions_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "patientID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["patientID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "drug", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["drug"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_invoicesByPatient_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "patientID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["patientID"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_patients_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "query", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["query"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "state", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["state"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "minAge", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["minAge"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "maxAge", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["maxAge"] = arg3
+	arg4, err := graphql.ProcessArgField(ctx, rawArgs, "hasActivePrescriptions", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["hasActivePrescriptions"] = arg4
+	arg5, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg5
+	arg6, err := graphql.ProcessArgField(ctx, rawArgs, "offset", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["offset"] = arg6
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_prescriber_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_prescribers_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "query", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["query"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "offset", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["offset"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_prescriptionTransmission_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "refresh", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["refresh"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_prescriptionTransmissions_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "prescriptionID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["prescriptionID"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_searchPatients_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "query", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["query"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _Address_id(ctx context.Context, field graphql.CollectedField, obj *model.Address) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Address_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Address_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Address",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Address_patientID(ctx context.Context, field graphql.CollectedField, obj *model.Address) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Address_patientID,
+		func(ctx context.Context) (any, error) {
+			return obj.PatientID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Address_patientID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Address",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Address_line1(ctx context.Context, field graphql.CollectedField, obj *model.Address) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Address_line1,
+		func(ctx context.Context) (any, error) {
+			return obj.Line1, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Address_line1(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Address",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Address_line2(ctx context.Context, field graphql.CollectedField, obj *model.Address) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Address_line2,
+		func(ctx context.Context) (any, error) {
+			return obj.Line2, nil
+		},
+		nil,
+		ec.marshalOString2string,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Address_line2(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Address",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Address_city(ctx context.Context, field graphql.CollectedField, obj *model.Address) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Address_city,
+		func(ctx context.Context) (any, error) {
+			return obj.City, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Address_city(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Address",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Address_state(ctx context.Context, field graphql.CollectedField, obj *model.Address) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Address_state,
+		func(ctx context.Context) (any, error) {
+			return obj.State, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Address_state(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Address",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Address_zip(ctx context.Context, field graphql.CollectedField, obj *model.Address) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Address_zip,
+		func(ctx context.Context) (any, error) {
+			return obj.Zip, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Address_zip(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Address",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdherenceReport_patientID(ctx context.Context, field graphql.CollectedField, obj *model4.AdherenceReport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdherenceReport_patientID,
+		func(ctx context.Context) (any, error) {
+			return obj.PatientID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdherenceReport_patientID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdherenceReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdherenceReport_activePrescriptions(ctx context.Context, field graphql.CollectedField, obj *model4.AdherenceReport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdherenceReport_activePrescriptions,
+		func(ctx context.Context) (any, error) {
+			return obj.ActivePrescriptions, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdherenceReport_activePrescriptions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdherenceReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdherenceReport_completedPrescriptions(ctx context.Context, field graphql.CollectedField, obj *model4.AdherenceReport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdherenceReport_completedPrescriptions,
+		func(ctx context.Context) (any, error) {
+			return obj.CompletedPrescriptions, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdherenceReport_completedPrescriptions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdherenceReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdherenceReport_refills(ctx context.Context, field graphql.CollectedField, obj *model4.AdherenceReport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdherenceReport_refills,
+		func(ctx context.Context) (any, error) {
+			return obj.Refills, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdherenceReport_refills(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdherenceReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdherenceReport_averageDaysBetweenRefills(ctx context.Context, field graphql.CollectedField, obj *model4.AdherenceReport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdherenceReport_averageDaysBetweenRefills,
+		func(ctx context.Context) (any, error) {
+			return obj.AverageDaysBetweenRefills, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdherenceReport_averageDaysBetweenRefills(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdherenceReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdherenceReport_proportionOfDaysCovered(ctx context.Context, field graphql.CollectedField, obj *model4.AdherenceReport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdherenceReport_proportionOfDaysCovered,
+		func(ctx context.Context) (any, error) {
+			return obj.ProportionOfDaysCovered, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdherenceReport_proportionOfDaysCovered(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdherenceReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdherenceReport_prescriptions(ctx context.Context, field graphql.CollectedField, obj *model4.AdherenceReport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdherenceReport_prescriptions,
+		func(ctx context.Context) (any, error) {
+			return obj.Prescriptions, nil
+		},
+		nil,
+		ec.marshalNPrescriptionAdherence2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋreportingᚋcontractsᚋmodelᚐPrescriptionAdherenceᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdherenceReport_prescriptions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdherenceReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "prescriptionID":
+				return ec.fieldContext_PrescriptionAdherence_prescriptionID(ctx, field)
+			case "drug":
+				return ec.fieldContext_PrescriptionAdherence_drug(ctx, field)
+			case "status":
+				return ec.fieldContext_PrescriptionAdherence_status(ctx, field)
+			case "dispenses":
+				return ec.fieldContext_PrescriptionAdherence_dispenses(ctx, field)
+			case "lastDispensedAt":
+				return ec.fieldContext_PrescriptionAdherence_lastDispensedAt(ctx, field)
+			case "averageDaysBetweenRefills":
+				return ec.fieldContext_PrescriptionAdherence_averageDaysBetweenRefills(ctx, field)
+			case "proportionOfDaysCovered":
+				return ec.fieldContext_PrescriptionAdherence_proportionOfDaysCovered(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PrescriptionAdherence", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdherenceReport_history(ctx context.Context, field graphql.CollectedField, obj *model4.AdherenceReport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdherenceReport_history,
+		func(ctx context.Context) (any, error) {
+			return obj.History, nil
+		},
+		nil,
+		ec.marshalNAdherenceSnapshot2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋreportingᚋcontractsᚋmodelᚐAdherenceSnapshotᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdherenceReport_history(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdherenceReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "patientID":
+				return ec.fieldContext_AdherenceSnapshot_patientID(ctx, field)
+			case "date":
+				return ec.fieldContext_AdherenceSnapshot_date(ctx, field)
+			case "activePrescriptions":
+				return ec.fieldContext_AdherenceSnapshot_activePrescriptions(ctx, field)
+			case "completedPrescriptions":
+				return ec.fieldContext_AdherenceSnapshot_completedPrescriptions(ctx, field)
+			case "refills":
+				return ec.fieldContext_AdherenceSnapshot_refills(ctx, field)
+			case "averageDaysBetweenRefills":
+				return ec.fieldContext_AdherenceSnapshot_averageDaysBetweenRefills(ctx, field)
+			case "proportionOfDaysCovered":
+				return ec.fieldContext_AdherenceSnapshot_proportionOfDaysCovered(ctx, field)
+			case "takenAt":
+				return ec.fieldContext_AdherenceSnapshot_takenAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AdherenceSnapshot", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdherenceReport_generatedAt(ctx context.Context, field graphql.CollectedField, obj *model4.AdherenceReport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdherenceReport_generatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.GeneratedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdherenceReport_generatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdherenceReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdherenceSnapshot_patientID(ctx context.Context, field graphql.CollectedField, obj *model4.AdherenceSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdherenceSnapshot_patientID,
+		func(ctx context.Context) (any, error) {
+			return obj.PatientID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdherenceSnapshot_patientID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdherenceSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdherenceSnapshot_date(ctx context.Context, field graphql.CollectedField, obj *model4.AdherenceSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdherenceSnapshot_date,
+		func(ctx context.Context) (any, error) {
+			return obj.Date, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdherenceSnapshot_date(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdherenceSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdherenceSnapshot_activePrescriptions(ctx context.Context, field graphql.CollectedField, obj *model4.AdherenceSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdherenceSnapshot_activePrescriptions,
+		func(ctx context.Context) (any, error) {
+			return obj.ActivePrescriptions, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdherenceSnapshot_activePrescriptions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdherenceSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdherenceSnapshot_completedPrescriptions(ctx context.Context, field graphql.CollectedField, obj *model4.AdherenceSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdherenceSnapshot_completedPrescriptions,
+		func(ctx context.Context) (any, error) {
+			return obj.CompletedPrescriptions, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdherenceSnapshot_completedPrescriptions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdherenceSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdherenceSnapshot_refills(ctx context.Context, field graphql.CollectedField, obj *model4.AdherenceSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdherenceSnapshot_refills,
+		func(ctx context.Context) (any, error) {
+			return obj.Refills, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdherenceSnapshot_refills(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdherenceSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdherenceSnapshot_averageDaysBetweenRefills(ctx context.Context, field graphql.CollectedField, obj *model4.AdherenceSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdherenceSnapshot_averageDaysBetweenRefills,
+		func(ctx context.Context) (any, error) {
+			return obj.AverageDaysBetweenRefills, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdherenceSnapshot_averageDaysBetweenRefills(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdherenceSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdherenceSnapshot_proportionOfDaysCovered(ctx context.Context, field graphql.CollectedField, obj *model4.AdherenceSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdherenceSnapshot_proportionOfDaysCovered,
+		func(ctx context.Context) (any, error) {
+			return obj.ProportionOfDaysCovered, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdherenceSnapshot_proportionOfDaysCovered(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdherenceSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AdherenceSnapshot_takenAt(ctx context.Context, field graphql.CollectedField, obj *model4.AdherenceSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AdherenceSnapshot_takenAt,
+		func(ctx context.Context) (any, error) {
+			return obj.TakenAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AdherenceSnapshot_takenAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AdherenceSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Allergy_id(ctx context.Context, field graphql.CollectedField, obj *model.Allergy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Allergy_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Allergy_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Allergy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Allergy_patientID(ctx context.Context, field graphql.CollectedField, obj *model.Allergy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Allergy_patientID,
+		func(ctx context.Context) (any, error) {
+			return obj.PatientID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Allergy_patientID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Allergy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Allergy_substance(ctx context.Context, field graphql.CollectedField, obj *model.Allergy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Allergy_substance,
+		func(ctx context.Context) (any, error) {
+			return obj.Substance, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Allergy_substance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Allergy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Allergy_reaction(ctx context.Context, field graphql.CollectedField, obj *model.Allergy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Allergy_reaction,
+		func(ctx context.Context) (any, error) {
+			return obj.Reaction, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Allergy_reaction(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Allergy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Allergy_severity(ctx context.Context, field graphql.CollectedField, obj *model.Allergy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Allergy_severity,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Allergy().Severity(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Allergy_severity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Allergy",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Allergy_recordedAt(ctx context.Context, field graphql.CollectedField, obj *model.Allergy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Allergy_recordedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.RecordedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Allergy_recordedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Allergy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Allergy_recordedBy(ctx context.Context, field graphql.CollectedField, obj *model.Allergy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Allergy_recordedBy,
+		func(ctx context.Context) (any, error) {
+			return obj.RecordedBy, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Allergy_recordedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Allergy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ContactPreferences_channels(ctx context.Context, field graphql.CollectedField, obj *model.ContactPreferences) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ContactPreferences_channels,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.ContactPreferences().Channels(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ContactPreferences_channels(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ContactPreferences",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ContactPreferences_email(ctx context.Context, field graphql.CollectedField, obj *model.ContactPreferences) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ContactPreferences_email,
+		func(ctx context.Context) (any, error) {
+			return obj.Email, nil
+		},
+		nil,
+		ec.marshalOString2string,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ContactPreferences_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ContactPreferences",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ContactPreferences_doNotContact(ctx context.Context, field graphql.CollectedField, obj *model.ContactPreferences) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ContactPreferences_doNotContact,
+		func(ctx context.Context) (any, error) {
+			return obj.DoNotContact, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ContactPreferences_doNotContact(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ContactPreferences",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CreateAddressPayload_address(ctx context.Context, field graphql.CollectedField, obj *CreateAddressPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CreateAddressPayload_address,
+		func(ctx context.Context) (any, error) {
+			return obj.Address, nil
+		},
+		nil,
+		ec.marshalOAddress2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐAddress,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_CreateAddressPayload_address(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CreateAddressPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Address_id(ctx, field)
+			case "patientID":
+				return ec.fieldContext_Address_patientID(ctx, field)
+			case "line1":
+				return ec.fieldContext_Address_line1(ctx, field)
+			case "line2":
+				return ec.fieldContext_Address_line2(ctx, field)
+			case "city":
+				return ec.fieldContext_Address_city(ctx, field)
+			case "state":
+				return ec.fieldContext_Address_state(ctx, field)
+			case "zip":
+				return ec.fieldContext_Address_zip(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Address", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CreateAddressPayload_userErrors(ctx context.Context, field graphql.CollectedField, obj *CreateAddressPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CreateAddressPayload_userErrors,
+		func(ctx context.Context) (any, error) {
+			return obj.UserErrors, nil
+		},
+		nil,
+		ec.marshalNUserError2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUserErrorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CreateAddressPayload_userErrors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CreateAddressPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_UserError_field(ctx, field)
+			case "code":
+				return ec.fieldContext_UserError_code(ctx, field)
+			case "message":
+				return ec.fieldContext_UserError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CreatePatientPayload_patient(ctx context.Context, field graphql.CollectedField, obj *CreatePatientPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CreatePatientPayload_patient,
+		func(ctx context.Context) (any, error) {
+			return obj.Patient, nil
+		},
+		nil,
+		ec.marshalOPatient2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatient,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_CreatePatientPayload_patient(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CreatePatientPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Patient_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Patient_name(ctx, field)
+			case "dob":
+				return ec.fieldContext_Patient_dob(ctx, field)
+			case "phone":
+				return ec.fieldContext_Patient_phone(ctx, field)
+			case "state":
+				return ec.fieldContext_Patient_state(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Patient_createdAt(ctx, field)
+			case "addresses":
+				return ec.fieldContext_Patient_addresses(ctx, field)
+			case "measurements":
+				return ec.fieldContext_Patient_measurements(ctx, field)
+			case "latestMeasurement":
+				return ec.fieldContext_Patient_latestMeasurement(ctx, field)
+			case "contactPreferences":
+				return ec.fieldContext_Patient_contactPreferences(ctx, field)
+			case "allergies":
+				return ec.fieldContext_Patient_allergies(ctx, field)
+			case "insurance":
+				return ec.fieldContext_Patient_insurance(ctx, field)
+			case "prescriptions":
+				return ec.fieldContext_Patient_prescriptions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Patient", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CreatePatientPayload_potentialDuplicates(ctx context.Context, field graphql.CollectedField, obj *CreatePatientPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CreatePatientPayload_potentialDuplicates,
+		func(ctx context.Context) (any, error) {
+			return obj.PotentialDuplicates, nil
+		},
+		nil,
+		ec.marshalNPotentialDuplicate2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPotentialDuplicateᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CreatePatientPayload_potentialDuplicates(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CreatePatientPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "patient":
+				return ec.fieldContext_PotentialDuplicate_patient(ctx, field)
+			case "reasons":
+				return ec.fieldContext_PotentialDuplicate_reasons(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PotentialDuplicate", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CreatePatientPayload_userErrors(ctx context.Context, field graphql.CollectedField, obj *CreatePatientPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CreatePatientPayload_userErrors,
+		func(ctx context.Context) (any, error) {
+			return obj.UserErrors, nil
+		},
+		nil,
+		ec.marshalNUserError2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUserErrorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CreatePatientPayload_userErrors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CreatePatientPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_UserError_field(ctx, field)
+			case "code":
+				return ec.fieldContext_UserError_code(ctx, field)
+			case "message":
+				return ec.fieldContext_UserError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CreatePrescriberPayload_prescriber(ctx context.Context, field graphql.CollectedField, obj *CreatePrescriberPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CreatePrescriberPayload_prescriber,
+		func(ctx context.Context) (any, error) {
+			return obj.Prescriber, nil
+		},
+		nil,
+		ec.marshalOPrescriber2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescriber,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_CreatePrescriberPayload_prescriber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CreatePrescriberPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Prescriber_id(ctx, field)
+			case "npi":
+				return ec.fieldContext_Prescriber_npi(ctx, field)
+			case "firstName":
+				return ec.fieldContext_Prescriber_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_Prescriber_lastName(ctx, field)
+			case "credential":
+				return ec.fieldContext_Prescriber_credential(ctx, field)
+			case "specialty":
+				return ec.fieldContext_Prescriber_specialty(ctx, field)
+			case "phone":
+				return ec.fieldContext_Prescriber_phone(ctx, field)
+			case "fax":
+				return ec.fieldContext_Prescriber_fax(ctx, field)
+			case "email":
+				return ec.fieldContext_Prescriber_email(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Prescriber_displayName(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Prescriber_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Prescriber_updatedAt(ctx, field)
+			case "prescriptions":
+				return ec.fieldContext_Prescriber_prescriptions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Prescriber", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CreatePrescriberPayload_userErrors(ctx context.Context, field graphql.CollectedField, obj *CreatePrescriberPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CreatePrescriberPayload_userErrors,
+		func(ctx context.Context) (any, error) {
+			return obj.UserErrors, nil
+		},
+		nil,
+		ec.marshalNUserError2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUserErrorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CreatePrescriberPayload_userErrors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CreatePrescriberPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_UserError_field(ctx, field)
+			case "code":
+				return ec.fieldContext_UserError_code(ctx, field)
+			case "message":
+				return ec.fieldContext_UserError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CreatePrescriptionPayload_prescription(ctx context.Context, field graphql.CollectedField, obj *CreatePrescriptionPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CreatePrescriptionPayload_prescription,
+		func(ctx context.Context) (any, error) {
+			return obj.Prescription, nil
+		},
+		nil,
+		ec.marshalOPrescription2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescription,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_CreatePrescriptionPayload_prescription(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CreatePrescriptionPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Prescription_id(ctx, field)
+			case "patientID":
+				return ec.fieldContext_Prescription_patientID(ctx, field)
+			case "patient":
+				return ec.fieldContext_Prescription_patient(ctx, field)
+			case "prescriberID":
+				return ec.fieldContext_Prescription_prescriberID(ctx, field)
+			case "prescriber":
+				return ec.fieldContext_Prescription_prescriber(ctx, field)
+			case "drug":
+				return ec.fieldContext_Prescription_drug(ctx, field)
+			case "dose":
+				return ec.fieldContext_Prescription_dose(ctx, field)
+			case "dosage":
+				return ec.fieldContext_Prescription_dosage(ctx, field)
+			case "status":
+				return ec.fieldContext_Prescription_status(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Prescription_createdAt(ctx, field)
+			case "sig":
+				return ec.fieldContext_Prescription_sig(ctx, field)
+			case "directions":
+				return ec.fieldContext_Prescription_directions(ctx, field)
+			case "quantity":
+				return ec.fieldContext_Prescription_quantity(ctx, field)
+			case "daysSupply":
+				return ec.fieldContext_Prescription_daysSupply(ctx, field)
+			case "expectedEndDate":
+				return ec.fieldContext_Prescription_expectedEndDate(ctx, field)
+			case "interactionWarnings":
+				return ec.fieldContext_Prescription_interactionWarnings(ctx, field)
+			case "fulfillmentStatus":
+				return ec.fieldContext_Prescription_fulfillmentStatus(ctx, field)
+			case "fulfillmentUpdatedAt":
+				return ec.fieldContext_Prescription_fulfillmentUpdatedAt(ctx, field)
+			case "pharmacy":
+				return ec.fieldContext_Prescription_pharmacy(ctx, field)
+			case "history":
+				return ec.fieldContext_Prescription_history(ctx, field)
+			case "priceEstimate":
+				return ec.fieldContext_Prescription_priceEstimate(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Prescription", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CreatePrescriptionPayload_userErrors(ctx context.Context, field graphql.CollectedField, obj *CreatePrescriptionPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CreatePrescriptionPayload_userErrors,
+		func(ctx context.Context) (any, error) {
+			return obj.UserErrors, nil
+		},
+		nil,
+		ec.marshalNUserError2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUserErrorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CreatePrescriptionPayload_userErrors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CreatePrescriptionPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_UserError_field(ctx, field)
+			case "code":
+				return ec.fieldContext_UserError_code(ctx, field)
+			case "message":
+				return ec.fieldContext_UserError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DashboardStats_totalPatients(ctx context.Context, field graphql.CollectedField, obj *DashboardStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DashboardStats_totalPatients,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalPatients, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DashboardStats_totalPatients(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DashboardStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DashboardStats_activePrescriptions(ctx context.Context, field graphql.CollectedField, obj *DashboardStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DashboardStats_activePrescriptions,
+		func(ctx context.Context) (any, error) {
+			return obj.ActivePrescriptions, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DashboardStats_activePrescriptions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DashboardStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DashboardStats_patientsByState(ctx context.Context, field graphql.CollectedField, obj *DashboardStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DashboardStats_patientsByState,
+		func(ctx context.Context) (any, error) {
+			return obj.PatientsByState, nil
+		},
+		nil,
+		ec.marshalNStateCount2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐStateCountᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DashboardStats_patientsByState(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DashboardStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "state":
+				return ec.fieldContext_StateCount_state(ctx, field)
+			case "count":
+				return ec.fieldContext_StateCount_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StateCount", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DashboardStats_prescriptionsByStatus(ctx context.Context, field graphql.CollectedField, obj *DashboardStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DashboardStats_prescriptionsByStatus,
+		func(ctx context.Context) (any, error) {
+			return obj.PrescriptionsByStatus, nil
+		},
+		nil,
+		ec.marshalNStatusCount2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐStatusCountᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DashboardStats_prescriptionsByStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DashboardStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "status":
+				return ec.fieldContext_StatusCount_status(ctx, field)
+			case "count":
+				return ec.fieldContext_StatusCount_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StatusCount", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DeleteAddressPayload_deletedID(ctx context.Context, field graphql.CollectedField, obj *DeleteAddressPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DeleteAddressPayload_deletedID,
+		func(ctx context.Context) (any, error) {
+			return obj.DeletedID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_DeleteAddressPayload_deletedID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DeleteAddressPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DeleteAddressPayload_userErrors(ctx context.Context, field graphql.CollectedField, obj *DeleteAddressPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DeleteAddressPayload_userErrors,
+		func(ctx context.Context) (any, error) {
+			return obj.UserErrors, nil
+		},
+		nil,
+		ec.marshalNUserError2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUserErrorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DeleteAddressPayload_userErrors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DeleteAddressPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_UserError_field(ctx, field)
+			case "code":
+				return ec.fieldContext_UserError_code(ctx, field)
+			case "message":
+				return ec.fieldContext_UserError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DeleteAllergyPayload_deletedID(ctx context.Context, field graphql.CollectedField, obj *DeleteAllergyPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DeleteAllergyPayload_deletedID,
+		func(ctx context.Context) (any, error) {
+			return obj.DeletedID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_DeleteAllergyPayload_deletedID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DeleteAllergyPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DeleteAllergyPayload_userErrors(ctx context.Context, field graphql.CollectedField, obj *DeleteAllergyPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DeleteAllergyPayload_userErrors,
+		func(ctx context.Context) (any, error) {
+			return obj.UserErrors, nil
+		},
+		nil,
+		ec.marshalNUserError2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUserErrorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DeleteAllergyPayload_userErrors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DeleteAllergyPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_UserError_field(ctx, field)
+			case "code":
+				return ec.fieldContext_UserError_code(ctx, field)
+			case "message":
+				return ec.fieldContext_UserError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DeleteMeasurementPayload_deletedID(ctx context.Context, field graphql.CollectedField, obj *DeleteMeasurementPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DeleteMeasurementPayload_deletedID,
+		func(ctx context.Context) (any, error) {
+			return obj.DeletedID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_DeleteMeasurementPayload_deletedID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DeleteMeasurementPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DeleteMeasurementPayload_userErrors(ctx context.Context, field graphql.CollectedField, obj *DeleteMeasurementPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DeleteMeasurementPayload_userErrors,
+		func(ctx context.Context) (any, error) {
+			return obj.UserErrors, nil
+		},
+		nil,
+		ec.marshalNUserError2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUserErrorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DeleteMeasurementPayload_userErrors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DeleteMeasurementPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_UserError_field(ctx, field)
+			case "code":
+				return ec.fieldContext_UserError_code(ctx, field)
+			case "message":
+				return ec.fieldContext_UserError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DeletePrescriberPayload_deletedID(ctx context.Context, field graphql.CollectedField, obj *DeletePrescriberPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DeletePrescriberPayload_deletedID,
+		func(ctx context.Context) (any, error) {
+			return obj.DeletedID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_DeletePrescriberPayload_deletedID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DeletePrescriberPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DeletePrescriberPayload_userErrors(ctx context.Context, field graphql.CollectedField, obj *DeletePrescriberPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DeletePrescriberPayload_userErrors,
+		func(ctx context.Context) (any, error) {
+			return obj.UserErrors, nil
+		},
+		nil,
+		ec.marshalNUserError2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUserErrorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DeletePrescriberPayload_userErrors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DeletePrescriberPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_UserError_field(ctx, field)
+			case "code":
+				return ec.fieldContext_UserError_code(ctx, field)
+			case "message":
+				return ec.fieldContext_UserError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Dose_value(ctx context.Context, field graphql.CollectedField, obj *model1.Dose) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Dose_value,
+		func(ctx context.Context) (any, error) {
+			return obj.Value, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Dose_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Dose",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Dose_unit(ctx context.Context, field graphql.CollectedField, obj *model1.Dose) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Dose_unit,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Dose().Unit(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Dose_unit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Dose",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Dose_frequency(ctx context.Context, field graphql.CollectedField, obj *model1.Dose) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Dose_frequency,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Dose().Frequency(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Dose_frequency(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Dose",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Dose_route(ctx context.Context, field graphql.CollectedField, obj *model1.Dose) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Dose_route,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Dose().Route(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Dose_route(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Dose",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DrugAllergyWarning_drug(ctx context.Context, field graphql.CollectedField, obj *model1.DrugAllergyWarning) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DrugAllergyWarning_drug,
+		func(ctx context.Context) (any, error) {
+			return obj.Drug, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DrugAllergyWarning_drug(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DrugAllergyWarning",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DrugAllergyWarning_allergyID(ctx context.Context, field graphql.CollectedField, obj *model1.DrugAllergyWarning) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DrugAllergyWarning_allergyID,
+		func(ctx context.Context) (any, error) {
+			return obj.AllergyID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DrugAllergyWarning_allergyID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DrugAllergyWarning",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DrugAllergyWarning_substance(ctx context.Context, field graphql.CollectedField, obj *model1.DrugAllergyWarning) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DrugAllergyWarning_substance,
+		func(ctx context.Context) (any, error) {
+			return obj.Substance, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DrugAllergyWarning_substance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DrugAllergyWarning",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DrugAllergyWarning_reaction(ctx context.Context, field graphql.CollectedField, obj *model1.DrugAllergyWarning) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DrugAllergyWarning_reaction,
+		func(ctx context.Context) (any, error) {
+			return obj.Reaction, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DrugAllergyWarning_reaction(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DrugAllergyWarning",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DrugAllergyWarning_severity(ctx context.Context, field graphql.CollectedField, obj *model1.DrugAllergyWarning) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DrugAllergyWarning_severity,
+		func(ctx context.Context) (any, error) {
+			return obj.Severity, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DrugAllergyWarning_severity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DrugAllergyWarning",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DrugInteractionWarning_drug(ctx context.Context, field graphql.CollectedField, obj *model1.DrugInteractionWarning) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DrugInteractionWarning_drug,
+		func(ctx context.Context) (any, error) {
+			return obj.Drug, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DrugInteractionWarning_drug(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DrugInteractionWarning",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DrugInteractionWarning_interactingDrug(ctx context.Context, field graphql.CollectedField, obj *model1.DrugInteractionWarning) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DrugInteractionWarning_interactingDrug,
+		func(ctx context.Context) (any, error) {
+			return obj.InteractingDrug, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DrugInteractionWarning_interactingDrug(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DrugInteractionWarning",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DrugInteractionWarning_interactingPrescriptionID(ctx context.Context, field graphql.CollectedField, obj *model1.DrugInteractionWarning) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DrugInteractionWarning_interactingPrescriptionID,
+		func(ctx context.Context) (any, error) {
+			return obj.InteractingPrescriptionID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DrugInteractionWarning_interactingPrescriptionID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DrugInteractionWarning",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DrugInteractionWarning_severity(ctx context.Context, field graphql.CollectedField, obj *model1.DrugInteractionWarning) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DrugInteractionWarning_severity,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.DrugInteractionWarning().Severity(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DrugInteractionWarning_severity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DrugInteractionWarning",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DrugInteractionWarning_description(ctx context.Context, field graphql.CollectedField, obj *model1.DrugInteractionWarning) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DrugInteractionWarning_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DrugInteractionWarning_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DrugInteractionWarning",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Entity_findPatientByID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Entity_findPatientByID,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Entity().FindPatientByID(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalNPatient2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatient,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Entity_findPatientByID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Entity",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Patient_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Patient_name(ctx, field)
+			case "dob":
+				return ec.fieldContext_Patient_dob(ctx, field)
+			case "phone":
+				return ec.fieldContext_Patient_phone(ctx, field)
+			case "state":
+				return ec.fieldContext_Patient_state(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Patient_createdAt(ctx, field)
+			case "addresses":
+				return ec.fieldContext_Patient_addresses(ctx, field)
+			case "measurements":
+				return ec.fieldContext_Patient_measurements(ctx, field)
+			case "latestMeasurement":
+				return ec.fieldContext_Patient_latestMeasurement(ctx, field)
+			case "contactPreferences":
+				return ec.fieldContext_Patient_contactPreferences(ctx, field)
+			case "allergies":
+				return ec.fieldContext_Patient_allergies(ctx, field)
+			case "insurance":
+				return ec.fieldContext_Patient_insurance(ctx, field)
+			case "prescriptions":
+				return ec.fieldContext_Patient_prescriptions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Patient", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Entity_findPatientByID_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Entity_findPrescriptionByID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Entity_findPrescriptionByID,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Entity().FindPrescriptionByID(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalNPrescription2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescription,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Entity_findPrescriptionByID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Entity",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Prescription_id(ctx, field)
+			case "patientID":
+				return ec.fieldContext_Prescription_patientID(ctx, field)
+			case "patient":
+				return ec.fieldContext_Prescription_patient(ctx, field)
+			case "prescriberID":
+				return ec.fieldContext_Prescription_prescriberID(ctx, field)
+			case "prescriber":
+				return ec.fieldContext_Prescription_prescriber(ctx, field)
+			case "drug":
+				return ec.fieldContext_Prescription_drug(ctx, field)
+			case "dose":
+				return ec.fieldContext_Prescription_dose(ctx, field)
+			case "dosage":
+				return ec.fieldContext_Prescription_dosage(ctx, field)
+			case "status":
+				return ec.fieldContext_Prescription_status(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Prescription_createdAt(ctx, field)
+			case "sig":
+				return ec.fieldContext_Prescription_sig(ctx, field)
+			case "directions":
+				return ec.fieldContext_Prescription_directions(ctx, field)
+			case "quantity":
+				return ec.fieldContext_Prescription_quantity(ctx, field)
+			case "daysSupply":
+				return ec.fieldContext_Prescription_daysSupply(ctx, field)
+			case "expectedEndDate":
+				return ec.fieldContext_Prescription_expectedEndDate(ctx, field)
+			case "interactionWarnings":
+				return ec.fieldContext_Prescription_interactionWarnings(ctx, field)
+			case "fulfillmentStatus":
+				return ec.fieldContext_Prescription_fulfillmentStatus(ctx, field)
+			case "fulfillmentUpdatedAt":
+				return ec.fieldContext_Prescription_fulfillmentUpdatedAt(ctx, field)
+			case "pharmacy":
+				return ec.fieldContext_Prescription_pharmacy(ctx, field)
+			case "history":
+				return ec.fieldContext_Prescription_history(ctx, field)
+			case "priceEstimate":
+				return ec.fieldContext_Prescription_priceEstimate(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Prescription", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Entity_findPrescriptionByID_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _InsuranceRecord_id(ctx context.Context, field graphql.CollectedField, obj *model.InsuranceRecord) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_InsuranceRecord_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_InsuranceRecord_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InsuranceRecord",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _InsuranceRecord_patientID(ctx context.Context, field graphql.CollectedField, obj *model.InsuranceRecord) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_InsuranceRecord_patientID,
+		func(ctx context.Context) (any, error) {
+			return obj.PatientID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_InsuranceRecord_patientID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InsuranceRecord",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _InsuranceRecord_payerName(ctx context.Context, field graphql.CollectedField, obj *model.InsuranceRecord) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_InsuranceRecord_payerName,
+		func(ctx context.Context) (any, error) {
+			return obj.PayerName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_InsuranceRecord_payerName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InsuranceRecord",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _InsuranceRecord_memberID(ctx context.Context, field graphql.CollectedField, obj *model.InsuranceRecord) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_InsuranceRecord_memberID,
+		func(ctx context.Context) (any, error) {
+			return obj.MemberID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_InsuranceRecord_memberID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InsuranceRecord",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _InsuranceRecord_groupNumber(ctx context.Context, field graphql.CollectedField, obj *model.InsuranceRecord) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_InsuranceRecord_groupNumber,
+		func(ctx context.Context) (any, error) {
+			return obj.GroupNumber, nil
+		},
+		nil,
+		ec.marshalOString2string,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_InsuranceRecord_groupNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InsuranceRecord",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _InsuranceRecord_memberName(ctx context.Context, field graphql.CollectedField, obj *model.InsuranceRecord) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_InsuranceRecord_memberName,
+		func(ctx context.Context) (any, error) {
+			return obj.MemberName, nil
+		},
+		nil,
+		ec.marshalOString2string,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_InsuranceRecord_memberName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InsuranceRecord",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _InsuranceRecord_rxBIN(ctx context.Context, field graphql.CollectedField, obj *model.InsuranceRecord) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_InsuranceRecord_rxBIN,
+		func(ctx context.Context) (any, error) {
+			return obj.RxBIN, nil
+		},
+		nil,
+		ec.marshalOString2string,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_InsuranceRecord_rxBIN(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InsuranceRecord",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _InsuranceRecord_rxPCN(ctx context.Context, field graphql.CollectedField, obj *model.InsuranceRecord) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_InsuranceRecord_rxPCN,
+		func(ctx context.Context) (any, error) {
+			return obj.RxPCN, nil
+		},
+		nil,
+		ec.marshalOString2string,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_InsuranceRecord_rxPCN(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InsuranceRecord",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _InsuranceRecord_status(ctx context.Context, field graphql.CollectedField, obj *model.InsuranceRecord) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_InsuranceRecord_status,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.InsuranceRecord().Status(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_InsuranceRecord_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InsuranceRecord",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _InsuranceRecord_effectiveDate(ctx context.Context, field graphql.CollectedField, obj *model.InsuranceRecord) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_InsuranceRecord_effectiveDate,
+		func(ctx context.Context) (any, error) {
+			return obj.EffectiveDate, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_InsuranceRecord_effectiveDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InsuranceRecord",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _InsuranceRecord_expiryDate(ctx context.Context, field graphql.CollectedField, obj *model.InsuranceRecord) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_InsuranceRecord_expiryDate,
+		func(ctx context.Context) (any, error) {
+			return obj.ExpiryDate, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_InsuranceRecord_expiryDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InsuranceRecord",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _InsuranceRecord_active(ctx context.Context, field graphql.CollectedField, obj *model.InsuranceRecord) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_InsuranceRecord_active,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.InsuranceRecord().Active(ctx, obj)
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_InsuranceRecord_active(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InsuranceRecord",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _InteractionCheckResult_warnings(ctx context.Context, field graphql.CollectedField, obj *model1.InteractionCheckResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_InteractionCheckResult_warnings,
+		func(ctx context.Context) (any, error) {
+			return obj.Warnings, nil
+		},
+		nil,
+		ec.marshalNDrugInteractionWarning2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐDrugInteractionWarningᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_InteractionCheckResult_warnings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InteractionCheckResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "drug":
+				return ec.fieldContext_DrugInteractionWarning_drug(ctx, field)
+			case "interactingDrug":
+				return ec.fieldContext_DrugInteractionWarning_interactingDrug(ctx, field)
+			case "interactingPrescriptionID":
+				return ec.fieldContext_DrugInteractionWarning_interactingPrescriptionID(ctx, field)
+			case "severity":
+				return ec.fieldContext_DrugInteractionWarning_severity(ctx, field)
+			case "description":
+				return ec.fieldContext_DrugInteractionWarning_description(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DrugInteractionWarning", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _InteractionCheckResult_allergyWarnings(ctx context.Context, field graphql.CollectedField, obj *model1.InteractionCheckResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_InteractionCheckResult_allergyWarnings,
+		func(ctx context.Context) (any, error) {
+			return obj.AllergyWarnings, nil
+		},
+		nil,
+		ec.marshalNDrugAllergyWarning2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐDrugAllergyWarningᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_InteractionCheckResult_allergyWarnings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InteractionCheckResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "drug":
+				return ec.fieldContext_DrugAllergyWarning_drug(ctx, field)
+			case "allergyID":
+				return ec.fieldContext_DrugAllergyWarning_allergyID(ctx, field)
+			case "substance":
+				return ec.fieldContext_DrugAllergyWarning_substance(ctx, field)
+			case "reaction":
+				return ec.fieldContext_DrugAllergyWarning_reaction(ctx, field)
+			case "severity":
+				return ec.fieldContext_DrugAllergyWarning_severity(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DrugAllergyWarning", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _InteractionCheckResult_blocked(ctx context.Context, field graphql.CollectedField, obj *model1.InteractionCheckResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_InteractionCheckResult_blocked,
+		func(ctx context.Context) (any, error) {
+			return obj.Blocked, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_InteractionCheckResult_blocked(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InteractionCheckResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Invoice_id(ctx context.Context, field graphql.CollectedField, obj *model2.Invoice) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Invoice_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Invoice_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Invoice",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Invoice_prescriptionID(ctx context.Context, field graphql.CollectedField, obj *model2.Invoice) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Invoice_prescriptionID,
+		func(ctx context.Context) (any, error) {
+			return obj.PrescriptionID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Invoice_prescriptionID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Invoice",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Invoice_patientID(ctx context.Context, field graphql.CollectedField, obj *model2.Invoice) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Invoice_patientID,
+		func(ctx context.Context) (any, error) {
+			return obj.PatientID, nil
+		},
+		nil,
+		ec.marshalOID2string,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Invoice_patientID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Invoice",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Invoice_amount(ctx context.Context, field graphql.CollectedField, obj *model2.Invoice) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Invoice_amount,
+		func(ctx context.Context) (any, error) {
+			return obj.Amount, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Invoice_amount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Invoice",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Invoice_status(ctx context.Context, field graphql.CollectedField, obj *model2.Invoice) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Invoice_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Invoice_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Invoice",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Invoice_createdAt(ctx context.Context, field graphql.CollectedField, obj *model2.Invoice) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Invoice_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalOString2string,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Invoice_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Invoice",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Invoice_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model2.Invoice) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Invoice_updatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalOString2string,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Invoice_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Invoice",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Measurement_id(ctx context.Context, field graphql.CollectedField, obj *model.Measurement) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Measurement_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Measurement_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Measurement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Measurement_patientID(ctx context.Context, field graphql.CollectedField, obj *model.Measurement) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Measurement_patientID,
+		func(ctx context.Context) (any, error) {
+			return obj.PatientID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Measurement_patientID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Measurement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Measurement_type(ctx context.Context, field graphql.CollectedField, obj *model.Measurement) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Measurement_type,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Measurement().Type(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Measurement_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Measurement",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Measurement_value(ctx context.Context, field graphql.CollectedField, obj *model.Measurement) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Measurement_value,
+		func(ctx context.Context) (any, error) {
+			return obj.Value, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Measurement_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Measurement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Measurement_unit(ctx context.Context, field graphql.CollectedField, obj *model.Measurement) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Measurement_unit,
+		func(ctx context.Context) (any, error) {
+			return obj.Unit, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Measurement_unit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Measurement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Measurement_recordedAt(ctx context.Context, field graphql.CollectedField, obj *model.Measurement) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Measurement_recordedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.RecordedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Measurement_recordedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Measurement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Measurement_recordedBy(ctx context.Context, field graphql.CollectedField, obj *model.Measurement) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Measurement_recordedBy,
+		func(ctx context.Context) (any, error) {
+			return obj.RecordedBy, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Measurement_recordedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Measurement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation__empty(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation__empty,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Mutation().Empty(ctx)
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation__empty(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createInvoiceForPrescription(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_createInvoiceForPrescription,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CreateInvoiceForPrescription(ctx, fc.Args["prescriptionID"].(string), fc.Args["amount"].(*float64), fc.Args["description"].(*string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *model2.Invoice
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNString2string(ctx, "billing:write")
+				if err != nil {
+					var zeroVal *model2.Invoice
+					return zeroVal, err
+				}
+				if ec.directives.HasPermission == nil {
+					var zeroVal *model2.Invoice
+					return zeroVal, errors.New("directive hasPermission is not implemented")
+				}
+				return ec.directives.HasPermission(ctx, nil, directive1, permission)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOInvoice2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋbillingᚋcontractsᚋmodelᚐInvoice,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createInvoiceForPrescription(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Invoice_id(ctx, field)
+			case "prescriptionID":
+				return ec.fieldContext_Invoice_prescriptionID(ctx, field)
+			case "patientID":
+				return ec.fieldContext_Invoice_patientID(ctx, field)
+			case "amount":
+				return ec.fieldContext_Invoice_amount(ctx, field)
+			case "status":
+				return ec.fieldContext_Invoice_status(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Invoice_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Invoice_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Invoice", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createInvoiceForPrescription_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_acknowledgeInvoice(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_acknowledgeInvoice,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().AcknowledgeInvoice(ctx, fc.Args["prescriptionID"].(string), fc.Args["notes"].(*string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *model2.Invoice
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNString2string(ctx, "billing:acknowledge")
+				if err != nil {
+					var zeroVal *model2.Invoice
+					return zeroVal, err
+				}
+				if ec.directives.HasPermission == nil {
+					var zeroVal *model2.Invoice
+					return zeroVal, errors.New("directive hasPermission is not implemented")
+				}
+				return ec.directives.HasPermission(ctx, nil, directive1, permission)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOInvoice2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋbillingᚋcontractsᚋmodelᚐInvoice,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_acknowledgeInvoice(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Invoice_id(ctx, field)
+			case "prescriptionID":
+				return ec.fieldContext_Invoice_prescriptionID(ctx, field)
+			case "patientID":
+				return ec.fieldContext_Invoice_patientID(ctx, field)
+			case "amount":
+				return ec.fieldContext_Invoice_amount(ctx, field)
+			case "status":
+				return ec.fieldContext_Invoice_status(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Invoice_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Invoice_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Invoice", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_acknowledgeInvoice_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_transmitPrescription(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_transmitPrescription,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().TransmitPrescription(ctx, fc.Args["prescriptionID"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *TransmitPrescriptionPayload
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				requires, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"prescription:write", "doctor:role", "pharmacist:role", "admin:all"})
+				if err != nil {
+					var zeroVal *TransmitPrescriptionPayload
+					return zeroVal, err
+				}
+				if ec.directives.PermissionAny == nil {
+					var zeroVal *TransmitPrescriptionPayload
+					return zeroVal, errors.New("directive permissionAny is not implemented")
+				}
+				return ec.directives.PermissionAny(ctx, nil, directive1, requires)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOTransmitPrescriptionPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐTransmitPrescriptionPayload,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_transmitPrescription(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "transmission":
+				return ec.fieldContext_TransmitPrescriptionPayload_transmission(ctx, field)
+			case "userErrors":
+				return ec.fieldContext_TransmitPrescriptionPayload_userErrors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TransmitPrescriptionPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_transmitPrescription_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createPatient(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_createPatient,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CreatePatient(ctx, fc.Args["input"].(CreatePatientInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *CreatePatientPayload
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNString2string(ctx, "patient:write")
+				if err != nil {
+					var zeroVal *CreatePatientPayload
+					return zeroVal, err
+				}
+				if ec.directives.HasPermission == nil {
+					var zeroVal *CreatePatientPayload
+					return zeroVal, errors.New("directive hasPermission is not implemented")
+				}
+				return ec.directives.HasPermission(ctx, nil, directive1, permission)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOCreatePatientPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐCreatePatientPayload,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createPatient(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "patient":
+				return ec.fieldContext_CreatePatientPayload_patient(ctx, field)
+			case "potentialDuplicates":
+				return ec.fieldContext_CreatePatientPayload_potentialDuplicates(ctx, field)
+			case "userErrors":
+				return ec.fieldContext_CreatePatientPayload_userErrors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CreatePatientPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createPatient_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updatePatient(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_updatePatient,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UpdatePatient(ctx, fc.Args["id"].(string), fc.Args["input"].(UpdatePatientInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *UpdatePatientPayload
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNString2string(ctx, "patient:write")
+				if err != nil {
+					var zeroVal *UpdatePatientPayload
+					return zeroVal, err
+				}
+				if ec.directives.HasPermission == nil {
+					var zeroVal *UpdatePatientPayload
+					return zeroVal, errors.New("directive hasPermission is not implemented")
+				}
+				return ec.directives.HasPermission(ctx, nil, directive1, permission)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOUpdatePatientPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdatePatientPayload,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updatePatient(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "patient":
+				return ec.fieldContext_UpdatePatientPayload_patient(ctx, field)
+			case "userErrors":
+				return ec.fieldContext_UpdatePatientPayload_userErrors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UpdatePatientPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updatePatient_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createAddress(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_createAddress,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CreateAddress(ctx, fc.Args["patientID"].(string), fc.Args["input"].(CreateAddressInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *CreateAddressPayload
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNString2string(ctx, "patient:write")
+				if err != nil {
+					var zeroVal *CreateAddressPayload
+					return zeroVal, err
+				}
+				if ec.directives.HasPermission == nil {
+					var zeroVal *CreateAddressPayload
+					return zeroVal, errors.New("directive hasPermission is not implemented")
+				}
+				return ec.directives.HasPermission(ctx, nil, directive1, permission)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOCreateAddressPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐCreateAddressPayload,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createAddress(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "address":
+				return ec.fieldContext_CreateAddressPayload_address(ctx, field)
+			case "userErrors":
+				return ec.fieldContext_CreateAddressPayload_userErrors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CreateAddressPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createAddress_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updateAddress(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_updateAddress,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UpdateAddress(ctx, fc.Args["patientID"].(string), fc.Args["id"].(string), fc.Args["input"].(UpdateAddressInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *UpdateAddressPayload
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNString2string(ctx, "patient:write")
+				if err != nil {
+					var zeroVal *UpdateAddressPayload
+					return zeroVal, err
+				}
+				if ec.directives.HasPermission == nil {
+					var zeroVal *UpdateAddressPayload
+					return zeroVal, errors.New("directive hasPermission is not implemented")
+				}
+				return ec.directives.HasPermission(ctx, nil, directive1, permission)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOUpdateAddressPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdateAddressPayload,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updateAddress(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "address":
+				return ec.fieldContext_UpdateAddressPayload_address(ctx, field)
+			case "userErrors":
+				return ec.fieldContext_UpdateAddressPayload_userErrors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UpdateAddressPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateAddress_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_deleteAddress(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_deleteAddress,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DeleteAddress(ctx, fc.Args["patientID"].(string), fc.Args["id"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *DeleteAddressPayload
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNString2string(ctx, "patient:write")
+				if err != nil {
+					var zeroVal *DeleteAddressPayload
+					return zeroVal, err
+				}
+				if ec.directives.HasPermission == nil {
+					var zeroVal *DeleteAddressPayload
+					return zeroVal, errors.New("directive hasPermission is not implemented")
+				}
+				return ec.directives.HasPermission(ctx, nil, directive1, permission)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalODeleteAddressPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐDeleteAddressPayload,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_deleteAddress(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "deletedID":
+				return ec.fieldContext_DeleteAddressPayload_deletedID(ctx, field)
+			case "userErrors":
+				return ec.fieldContext_DeleteAddressPayload_userErrors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DeleteAddressPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteAddress_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_recordMeasurement(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_recordMeasurement,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RecordMeasurement(ctx, fc.Args["patientID"].(string), fc.Args["input"].(RecordMeasurementInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *RecordMeasurementPayload
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNString2string(ctx, "patient:write")
+				if err != nil {
+					var zeroVal *RecordMeasurementPayload
+					return zeroVal, err
+				}
+				if ec.directives.HasPermission == nil {
+					var zeroVal *RecordMeasurementPayload
+					return zeroVal, errors.New("directive hasPermission is not implemented")
+				}
+				return ec.directives.HasPermission(ctx, nil, directive1, permission)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalORecordMeasurementPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐRecordMeasurementPayload,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_recordMeasurement(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "measurement":
+				return ec.fieldContext_RecordMeasurementPayload_measurement(ctx, field)
+			case "userErrors":
+				return ec.fieldContext_RecordMeasurementPayload_userErrors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RecordMeasurementPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_recordMeasurement_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updateMeasurement(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_updateMeasurement,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UpdateMeasurement(ctx, fc.Args["patientID"].(string), fc.Args["id"].(string), fc.Args["input"].(UpdateMeasurementInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *UpdateMeasurementPayload
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNString2string(ctx, "patient:write")
+				if err != nil {
+					var zeroVal *UpdateMeasurementPayload
+					return zeroVal, err
+				}
+				if ec.directives.HasPermission == nil {
+					var zeroVal *UpdateMeasurementPayload
+					return zeroVal, errors.New("directive hasPermission is not implemented")
+				}
+				return ec.directives.HasPermission(ctx, nil, directive1, permission)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOUpdateMeasurementPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdateMeasurementPayload,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updateMeasurement(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "measurement":
+				return ec.fieldContext_UpdateMeasurementPayload_measurement(ctx, field)
+			case "userErrors":
+				return ec.fieldContext_UpdateMeasurementPayload_userErrors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UpdateMeasurementPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateMeasurement_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_deleteMeasurement(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_deleteMeasurement,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DeleteMeasurement(ctx, fc.Args["patientID"].(string), fc.Args["id"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *DeleteMeasurementPayload
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNString2string(ctx, "patient:write")
+				if err != nil {
+					var zeroVal *DeleteMeasurementPayload
+					return zeroVal, err
+				}
+				if ec.directives.HasPermission == nil {
+					var zeroVal *DeleteMeasurementPayload
+					return zeroVal, errors.New("directive hasPermission is not implemented")
+				}
+				return ec.directives.HasPermission(ctx, nil, directive1, permission)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalODeleteMeasurementPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐDeleteMeasurementPayload,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_deleteMeasurement(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "deletedID":
+				return ec.fieldContext_DeleteMeasurementPayload_deletedID(ctx, field)
+			case "userErrors":
+				return ec.fieldContext_DeleteMeasurementPayload_userErrors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DeleteMeasurementPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteMeasurement_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_recordAllergy(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_recordAllergy,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RecordAllergy(ctx, fc.Args["patientID"].(string), fc.Args["input"].(RecordAllergyInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *RecordAllergyPayload
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNString2string(ctx, "patient:write")
+				if err != nil {
+					var zeroVal *RecordAllergyPayload
+					return zeroVal, err
+				}
+				if ec.directives.HasPermission == nil {
+					var zeroVal *RecordAllergyPayload
+					return zeroVal, errors.New("directive hasPermission is not implemented")
+				}
+				return ec.directives.HasPermission(ctx, nil, directive1, permission)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalORecordAllergyPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐRecordAllergyPayload,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_recordAllergy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "allergy":
+				return ec.fieldContext_RecordAllergyPayload_allergy(ctx, field)
+			case "userErrors":
+				return ec.fieldContext_RecordAllergyPayload_userErrors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RecordAllergyPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_recordAllergy_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updateAllergy(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_updateAllergy,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UpdateAllergy(ctx, fc.Args["patientID"].(string), fc.Args["id"].(string), fc.Args["input"].(UpdateAllergyInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *UpdateAllergyPayload
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNString2string(ctx, "patient:write")
+				if err != nil {
+					var zeroVal *UpdateAllergyPayload
+					return zeroVal, err
+				}
+				if ec.directives.HasPermission == nil {
+					var zeroVal *UpdateAllergyPayload
+					return zeroVal, errors.New("directive hasPermission is not implemented")
+				}
+				return ec.directives.HasPermission(ctx, nil, directive1, permission)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOUpdateAllergyPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdateAllergyPayload,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updateAllergy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "allergy":
+				return ec.fieldContext_UpdateAllergyPayload_allergy(ctx, field)
+			case "userErrors":
+				return ec.fieldContext_UpdateAllergyPayload_userErrors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UpdateAllergyPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateAllergy_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_deleteAllergy(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_deleteAllergy,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DeleteAllergy(ctx, fc.Args["patientID"].(string), fc.Args["id"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *DeleteAllergyPayload
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNString2string(ctx, "patient:write")
+				if err != nil {
+					var zeroVal *DeleteAllergyPayload
+					return zeroVal, err
+				}
+				if ec.directives.HasPermission == nil {
+					var zeroVal *DeleteAllergyPayload
+					return zeroVal, errors.New("directive hasPermission is not implemented")
+				}
+				return ec.directives.HasPermission(ctx, nil, directive1, permission)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalODeleteAllergyPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐDeleteAllergyPayload,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_deleteAllergy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "deletedID":
+				return ec.fieldContext_DeleteAllergyPayload_deletedID(ctx, field)
+			case "userErrors":
+				return ec.fieldContext_DeleteAllergyPayload_userErrors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DeleteAllergyPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteAllergy_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createPrescription(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_createPrescription,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CreatePrescription(ctx, fc.Args["input"].(CreatePrescriptionInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *CreatePrescriptionPayload
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				requires, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"prescription:write", "doctor:role", "pharmacist:role", "admin:all"})
+				if err != nil {
+					var zeroVal *CreatePrescriptionPayload
+					return zeroVal, err
+				}
+				if ec.directives.PermissionAny == nil {
+					var zeroVal *CreatePrescriptionPayload
+					return zeroVal, errors.New("directive permissionAny is not implemented")
+				}
+				return ec.directives.PermissionAny(ctx, nil, directive1, requires)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOCreatePrescriptionPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐCreatePrescriptionPayload,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createPrescription(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "prescription":
+				return ec.fieldContext_CreatePrescriptionPayload_prescription(ctx, field)
+			case "userErrors":
+				return ec.fieldContext_CreatePrescriptionPayload_userErrors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CreatePrescriptionPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createPrescription_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updatePrescription(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_updatePrescription,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UpdatePrescription(ctx, fc.Args["id"].(string), fc.Args["input"].(UpdatePrescriptionInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *UpdatePrescriptionPayload
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				requires, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"prescription:write", "doctor:role", "pharmacist:role", "admin:all"})
+				if err != nil {
+					var zeroVal *UpdatePrescriptionPayload
+					return zeroVal, err
+				}
+				if ec.directives.PermissionAny == nil {
+					var zeroVal *UpdatePrescriptionPayload
+					return zeroVal, errors.New("directive permissionAny is not implemented")
+				}
+				return ec.directives.PermissionAny(ctx, nil, directive1, requires)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOUpdatePrescriptionPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdatePrescriptionPayload,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updatePrescription(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "prescription":
+				return ec.fieldContext_UpdatePrescriptionPayload_prescription(ctx, field)
+			case "userErrors":
+				return ec.fieldContext_UpdatePrescriptionPayload_userErrors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UpdatePrescriptionPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updatePrescription_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createPrescriber(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_createPrescriber,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CreatePrescriber(ctx, fc.Args["input"].(CreatePrescriberInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *CreatePrescriberPayload
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				requires, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"prescription:write", "doctor:role", "pharmacist:role", "admin:all"})
+				if err != nil {
+					var zeroVal *CreatePrescriberPayload
+					return zeroVal, err
+				}
+				if ec.directives.PermissionAny == nil {
+					var zeroVal *CreatePrescriberPayload
+					return zeroVal, errors.New("directive permissionAny is not implemented")
+				}
+				return ec.directives.PermissionAny(ctx, nil, directive1, requires)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOCreatePrescriberPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐCreatePrescriberPayload,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createPrescriber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "prescriber":
+				return ec.fieldContext_CreatePrescriberPayload_prescriber(ctx, field)
+			case "userErrors":
+				return ec.fieldContext_CreatePrescriberPayload_userErrors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CreatePrescriberPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createPrescriber_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updatePrescriber(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_updatePrescriber,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UpdatePrescriber(ctx, fc.Args["id"].(string), fc.Args["input"].(UpdatePrescriberInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *UpdatePrescriberPayload
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				requires, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"prescription:write", "doctor:role", "pharmacist:role", "admin:all"})
+				if err != nil {
+					var zeroVal *UpdatePrescriberPayload
+					return zeroVal, err
+				}
+				if ec.directives.PermissionAny == nil {
+					var zeroVal *UpdatePrescriberPayload
+					return zeroVal, errors.New("directive permissionAny is not implemented")
+				}
+				return ec.directives.PermissionAny(ctx, nil, directive1, requires)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOUpdatePrescriberPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdatePrescriberPayload,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updatePrescriber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "prescriber":
+				return ec.fieldContext_UpdatePrescriberPayload_prescriber(ctx, field)
+			case "userErrors":
+				return ec.fieldContext_UpdatePrescriberPayload_userErrors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UpdatePrescriberPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updatePrescriber_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_deletePrescriber(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_deletePrescriber,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DeletePrescriber(ctx, fc.Args["id"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *DeletePrescriberPayload
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				requires, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"prescription:write", "doctor:role", "pharmacist:role", "admin:all"})
+				if err != nil {
+					var zeroVal *DeletePrescriberPayload
+					return zeroVal, err
+				}
+				if ec.directives.PermissionAny == nil {
+					var zeroVal *DeletePrescriberPayload
+					return zeroVal, errors.New("directive permissionAny is not implemented")
+				}
+				return ec.directives.PermissionAny(ctx, nil, directive1, requires)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalODeletePrescriberPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐDeletePrescriberPayload,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_deletePrescriber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "deletedID":
+				return ec.fieldContext_DeletePrescriberPayload_deletedID(ctx, field)
+			case "userErrors":
+				return ec.fieldContext_DeletePrescriberPayload_userErrors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DeletePrescriberPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deletePrescriber_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Patient_id(ctx context.Context, field graphql.CollectedField, obj *model.Patient) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Patient_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Patient_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Patient",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Patient_name(ctx context.Context, field graphql.CollectedField, obj *model.Patient) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Patient_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Patient_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Patient",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Patient_dob(ctx context.Context, field graphql.CollectedField, obj *model.Patient) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Patient_dob,
+		func(ctx context.Context) (any, error) {
+			return obj.DOB, nil
+		},
+		nil,
+		ec.marshalNPartialDate2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋplatformᚋdatesᚐPartialDate,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Patient_dob(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Patient",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type PartialDate does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Patient_phone(ctx context.Context, field graphql.CollectedField, obj *model.Patient) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Patient_phone,
+		func(ctx context.Context) (any, error) {
+			return obj.Phone, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Patient_phone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Patient",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Patient_state(ctx context.Context, field graphql.CollectedField, obj *model.Patient) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Patient_state,
+		func(ctx context.Context) (any, error) {
+			return obj.State, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Patient_state(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Patient",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Patient_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Patient) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Patient_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Patient_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Patient",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Patient_addresses(ctx context.Context, field graphql.CollectedField, obj *model.Patient) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Patient_addresses,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Patient().Addresses(ctx, obj)
+		},
+		nil,
+		ec.marshalNAddress2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐAddressᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Patient_addresses(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Patient",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Address_id(ctx, field)
+			case "patientID":
+				return ec.fieldContext_Address_patientID(ctx, field)
+			case "line1":
+				return ec.fieldContext_Address_line1(ctx, field)
+			case "line2":
+				return ec.fieldContext_Address_line2(ctx, field)
+			case "city":
+				return ec.fieldContext_Address_city(ctx, field)
+			case "state":
+				return ec.fieldContext_Address_state(ctx, field)
+			case "zip":
+				return ec.fieldContext_Address_zip(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Address", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Patient_measurements(ctx context.Context, field graphql.CollectedField, obj *model.Patient) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Patient_measurements,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Patient().Measurements(ctx, obj, fc.Args["type"].(*string), fc.Args["limit"].(*int))
+		},
+		nil,
+		ec.marshalNMeasurement2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐMeasurementᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Patient_measurements(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Patient",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Measurement_id(ctx, field)
+			case "patientID":
+				return ec.fieldContext_Measurement_patientID(ctx, field)
+			case "type":
+				return ec.fieldContext_Measurement_type(ctx, field)
+			case "value":
+				return ec.fieldContext_Measurement_value(ctx, field)
+			case "unit":
+				return ec.fieldContext_Measurement_unit(ctx, field)
+			case "recordedAt":
+				return ec.fieldContext_Measurement_recordedAt(ctx, field)
+			case "recordedBy":
+				return ec.fieldContext_Measurement_recordedBy(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Measurement", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Patient_measurements_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Patient_latestMeasurement(ctx context.Context, field graphql.CollectedField, obj *model.Patient) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Patient_latestMeasurement,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Patient().LatestMeasurement(ctx, obj, fc.Args["type"].(string))
+		},
+		nil,
+		ec.marshalOMeasurement2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐMeasurement,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Patient_latestMeasurement(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Patient",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Measurement_id(ctx, field)
+			case "patientID":
+				return ec.fieldContext_Measurement_patientID(ctx, field)
+			case "type":
+				return ec.fieldContext_Measurement_type(ctx, field)
+			case "value":
+				return ec.fieldContext_Measurement_value(ctx, field)
+			case "unit":
+				return ec.fieldContext_Measurement_unit(ctx, field)
+			case "recordedAt":
+				return ec.fieldContext_Measurement_recordedAt(ctx, field)
+			case "recordedBy":
+				return ec.fieldContext_Measurement_recordedBy(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Measurement", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Patient_latestMeasurement_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Patient_contactPreferences(ctx context.Context, field graphql.CollectedField, obj *model.Patient) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Patient_contactPreferences,
+		func(ctx context.Context) (any, error) {
+			return obj.ContactPreferences, nil
+		},
+		nil,
+		ec.marshalOContactPreferences2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐContactPreferences,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Patient_contactPreferences(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Patient",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "channels":
+				return ec.fieldContext_ContactPreferences_channels(ctx, field)
+			case "email":
+				return ec.fieldContext_ContactPreferences_email(ctx, field)
+			case "doNotContact":
+				return ec.fieldContext_ContactPreferences_doNotContact(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ContactPreferences", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Patient_allergies(ctx context.Context, field graphql.CollectedField, obj *model.Patient) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Patient_allergies,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Patient().Allergies(ctx, obj)
+		},
+		nil,
+		ec.marshalNAllergy2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐAllergyᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Patient_allergies(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Patient",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Allergy_id(ctx, field)
+			case "patientID":
+				return ec.fieldContext_Allergy_patientID(ctx, field)
+			case "substance":
+				return ec.fieldContext_Allergy_substance(ctx, field)
+			case "reaction":
+				return ec.fieldContext_Allergy_reaction(ctx, field)
+			case "severity":
+				return ec.fieldContext_Allergy_severity(ctx, field)
+			case "recordedAt":
+				return ec.fieldContext_Allergy_recordedAt(ctx, field)
+			case "recordedBy":
+				return ec.fieldContext_Allergy_recordedBy(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Allergy", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Patient_insurance(ctx context.Context, field graphql.CollectedField, obj *model.Patient) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Patient_insurance,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Patient().Insurance(ctx, obj, fc.Args["activeOnly"].(*bool))
+		},
+		nil,
+		ec.marshalNInsuranceRecord2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐInsuranceRecordᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Patient_insurance(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Patient",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_InsuranceRecord_id(ctx, field)
+			case "patientID":
+				return ec.fieldContext_InsuranceRecord_patientID(ctx, field)
+			case "payerName":
+				return ec.fieldContext_InsuranceRecord_payerName(ctx, field)
+			case "memberID":
+				return ec.fieldContext_InsuranceRecord_memberID(ctx, field)
+			case "groupNumber":
+				return ec.fieldContext_InsuranceRecord_groupNumber(ctx, field)
+			case "memberName":
+				return ec.fieldContext_InsuranceRecord_memberName(ctx, field)
+			case "rxBIN":
+				return ec.fieldContext_InsuranceRecord_rxBIN(ctx, field)
+			case "rxPCN":
+				return ec.fieldContext_InsuranceRecord_rxPCN(ctx, field)
+			case "status":
+				return ec.fieldContext_InsuranceRecord_status(ctx, field)
+			case "effectiveDate":
+				return ec.fieldContext_InsuranceRecord_effectiveDate(ctx, field)
+			case "expiryDate":
+				return ec.fieldContext_InsuranceRecord_expiryDate(ctx, field)
+			case "active":
+				return ec.fieldContext_InsuranceRecord_active(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type InsuranceRecord", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Patient_insurance_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Patient_prescriptions(ctx context.Context, field graphql.CollectedField, obj *model.Patient) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Patient_prescriptions,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Patient().Prescriptions(ctx, obj, fc.Args["status"].(*PrescriptionStatus), fc.Args["limit"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal []model1.Prescription
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, obj, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				requires, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"prescription:read", "doctor:role", "pharmacist:role", "admin:all"})
+				if err != nil {
+					var zeroVal []model1.Prescription
+					return zeroVal, err
+				}
+				if ec.directives.PermissionAny == nil {
+					var zeroVal []model1.Prescription
+					return zeroVal, errors.New("directive permissionAny is not implemented")
+				}
+				return ec.directives.PermissionAny(ctx, obj, directive1, requires)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOPrescription2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescriptionᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Patient_prescriptions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Patient",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Prescription_id(ctx, field)
+			case "patientID":
+				return ec.fieldContext_Prescription_patientID(ctx, field)
+			case "patient":
+				return ec.fieldContext_Prescription_patient(ctx, field)
+			case "prescriberID":
+				return ec.fieldContext_Prescription_prescriberID(ctx, field)
+			case "prescriber":
+				return ec.fieldContext_Prescription_prescriber(ctx, field)
+			case "drug":
+				return ec.fieldContext_Prescription_drug(ctx, field)
+			case "dose":
+				return ec.fieldContext_Prescription_dose(ctx, field)
+			case "dosage":
+				return ec.fieldContext_Prescription_dosage(ctx, field)
+			case "status":
+				return ec.fieldContext_Prescription_status(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Prescription_createdAt(ctx, field)
+			case "sig":
+				return ec.fieldContext_Prescription_sig(ctx, field)
+			case "directions":
+				return ec.fieldContext_Prescription_directions(ctx, field)
+			case "quantity":
+				return ec.fieldContext_Prescription_quantity(ctx, field)
+			case "daysSupply":
+				return ec.fieldContext_Prescription_daysSupply(ctx, field)
+			case "expectedEndDate":
+				return ec.fieldContext_Prescription_expectedEndDate(ctx, field)
+			case "interactionWarnings":
+				return ec.fieldContext_Prescription_interactionWarnings(ctx, field)
+			case "fulfillmentStatus":
+				return ec.fieldContext_Prescription_fulfillmentStatus(ctx, field)
+			case "fulfillmentUpdatedAt":
+				return ec.fieldContext_Prescription_fulfillmentUpdatedAt(ctx, field)
+			case "pharmacy":
+				return ec.fieldContext_Prescription_pharmacy(ctx, field)
+			case "history":
+				return ec.fieldContext_Prescription_history(ctx, field)
+			case "priceEstimate":
+				return ec.fieldContext_Prescription_priceEstimate(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Prescription", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Patient_prescriptions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatientSearchMatch_field(ctx context.Context, field graphql.CollectedField, obj *model.PatientSearchMatch) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PatientSearchMatch_field,
+		func(ctx context.Context) (any, error) {
+			return obj.Field, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PatientSearchMatch_field(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatientSearchMatch",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatientSearchMatch_value(ctx context.Context, field graphql.CollectedField, obj *model.PatientSearchMatch) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PatientSearchMatch_value,
+		func(ctx context.Context) (any, error) {
+			return obj.Value, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PatientSearchMatch_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatientSearchMatch",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatientSearchMatch_highlighted(ctx context.Context, field graphql.CollectedField, obj *model.PatientSearchMatch) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PatientSearchMatch_highlighted,
+		func(ctx context.Context) (any, error) {
+			return obj.Highlighted, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PatientSearchMatch_highlighted(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatientSearchMatch",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatientSearchResult_patient(ctx context.Context, field graphql.CollectedField, obj *model.PatientSearchResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PatientSearchResult_patient,
+		func(ctx context.Context) (any, error) {
+			return obj.Patient, nil
+		},
+		nil,
+		ec.marshalNPatient2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatient,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PatientSearchResult_patient(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatientSearchResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Patient_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Patient_name(ctx, field)
+			case "dob":
+				return ec.fieldContext_Patient_dob(ctx, field)
+			case "phone":
+				return ec.fieldContext_Patient_phone(ctx, field)
+			case "state":
+				return ec.fieldContext_Patient_state(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Patient_createdAt(ctx, field)
+			case "addresses":
+				return ec.fieldContext_Patient_addresses(ctx, field)
+			case "measurements":
+				return ec.fieldContext_Patient_measurements(ctx, field)
+			case "latestMeasurement":
+				return ec.fieldContext_Patient_latestMeasurement(ctx, field)
+			case "contactPreferences":
+				return ec.fieldContext_Patient_contactPreferences(ctx, field)
+			case "allergies":
+				return ec.fieldContext_Patient_allergies(ctx, field)
+			case "insurance":
+				return ec.fieldContext_Patient_insurance(ctx, field)
+			case "prescriptions":
+				return ec.fieldContext_Patient_prescriptions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Patient", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatientSearchResult_score(ctx context.Context, field graphql.CollectedField, obj *model.PatientSearchResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PatientSearchResult_score,
+		func(ctx context.Context) (any, error) {
+			return obj.Score, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PatientSearchResult_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatientSearchResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatientSearchResult_matchType(ctx context.Context, field graphql.CollectedField, obj *model.PatientSearchResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PatientSearchResult_matchType,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchType, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PatientSearchResult_matchType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatientSearchResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatientSearchResult_matches(ctx context.Context, field graphql.CollectedField, obj *model.PatientSearchResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PatientSearchResult_matches,
+		func(ctx context.Context) (any, error) {
+			return obj.Matches, nil
+		},
+		nil,
+		ec.marshalNPatientSearchMatch2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatientSearchMatchᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PatientSearchResult_matches(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatientSearchResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_PatientSearchMatch_field(ctx, field)
+			case "value":
+				return ec.fieldContext_PatientSearchMatch_value(ctx, field)
+			case "highlighted":
+				return ec.fieldContext_PatientSearchMatch_highlighted(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PatientSearchMatch", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Pharmacy_id(ctx context.Context, field graphql.CollectedField, obj *model1.Pharmacy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Pharmacy_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Pharmacy_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Pharmacy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Pharmacy_name(ctx context.Context, field graphql.CollectedField, obj *model1.Pharmacy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Pharmacy_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Pharmacy_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Pharmacy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Pharmacy_type(ctx context.Context, field graphql.CollectedField, obj *model1.Pharmacy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Pharmacy_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Pharmacy_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Pharmacy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Pharmacy_address(ctx context.Context, field graphql.CollectedField, obj *model1.Pharmacy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Pharmacy_address,
+		func(ctx context.Context) (any, error) {
+			return obj.Address, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Pharmacy_address(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Pharmacy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Pharmacy_city(ctx context.Context, field graphql.CollectedField, obj *model1.Pharmacy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Pharmacy_city,
+		func(ctx context.Context) (any, error) {
+			return obj.City, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Pharmacy_city(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Pharmacy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Pharmacy_state(ctx context.Context, field graphql.CollectedField, obj *model1.Pharmacy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Pharmacy_state,
+		func(ctx context.Context) (any, error) {
+			return obj.State, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Pharmacy_state(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Pharmacy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Pharmacy_zip(ctx context.Context, field graphql.CollectedField, obj *model1.Pharmacy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Pharmacy_zip,
+		func(ctx context.Context) (any, error) {
+			return obj.Zip, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Pharmacy_zip(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Pharmacy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Pharmacy_phone(ctx context.Context, field graphql.CollectedField, obj *model1.Pharmacy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Pharmacy_phone,
+		func(ctx context.Context) (any, error) {
+			return obj.Phone, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Pharmacy_phone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Pharmacy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Pharmacy_routedAt(ctx context.Context, field graphql.CollectedField, obj *model1.Pharmacy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Pharmacy_routedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.RoutedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Pharmacy_routedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Pharmacy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PotentialDuplicate_patient(ctx context.Context, field graphql.CollectedField, obj *model.PotentialDuplicate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PotentialDuplicate_patient,
+		func(ctx context.Context) (any, error) {
+			return obj.Patient, nil
+		},
+		nil,
+		ec.marshalNPatient2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatient,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PotentialDuplicate_patient(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PotentialDuplicate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Patient_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Patient_name(ctx, field)
+			case "dob":
+				return ec.fieldContext_Patient_dob(ctx, field)
+			case "phone":
+				return ec.fieldContext_Patient_phone(ctx, field)
+			case "state":
+				return ec.fieldContext_Patient_state(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Patient_createdAt(ctx, field)
+			case "addresses":
+				return ec.fieldContext_Patient_addresses(ctx, field)
+			case "measurements":
+				return ec.fieldContext_Patient_measurements(ctx, field)
+			case "latestMeasurement":
+				return ec.fieldContext_Patient_latestMeasurement(ctx, field)
+			case "contactPreferences":
+				return ec.fieldContext_Patient_contactPreferences(ctx, field)
+			case "allergies":
+				return ec.fieldContext_Patient_allergies(ctx, field)
+			case "insurance":
+				return ec.fieldContext_Patient_insurance(ctx, field)
+			case "prescriptions":
+				return ec.fieldContext_Patient_prescriptions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Patient", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PotentialDuplicate_reasons(ctx context.Context, field graphql.CollectedField, obj *model.PotentialDuplicate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PotentialDuplicate_reasons,
+		func(ctx context.Context) (any, error) {
+			return obj.Reasons, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PotentialDuplicate_reasons(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PotentialDuplicate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescriber_id(ctx context.Context, field graphql.CollectedField, obj *model1.Prescriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescriber_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescriber_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescriber_npi(ctx context.Context, field graphql.CollectedField, obj *model1.Prescriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescriber_npi,
+		func(ctx context.Context) (any, error) {
+			return obj.NPI, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescriber_npi(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescriber_firstName(ctx context.Context, field graphql.CollectedField, obj *model1.Prescriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescriber_firstName,
+		func(ctx context.Context) (any, error) {
+			return obj.FirstName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescriber_firstName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescriber_lastName(ctx context.Context, field graphql.CollectedField, obj *model1.Prescriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescriber_lastName,
+		func(ctx context.Context) (any, error) {
+			return obj.LastName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescriber_lastName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescriber_credential(ctx context.Context, field graphql.CollectedField, obj *model1.Prescriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescriber_credential,
+		func(ctx context.Context) (any, error) {
+			return obj.Credential, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescriber_credential(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescriber_specialty(ctx context.Context, field graphql.CollectedField, obj *model1.Prescriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescriber_specialty,
+		func(ctx context.Context) (any, error) {
+			return obj.Specialty, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescriber_specialty(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescriber_phone(ctx context.Context, field graphql.CollectedField, obj *model1.Prescriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescriber_phone,
+		func(ctx context.Context) (any, error) {
+			return obj.Phone, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescriber_phone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescriber_fax(ctx context.Context, field graphql.CollectedField, obj *model1.Prescriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescriber_fax,
+		func(ctx context.Context) (any, error) {
+			return obj.Fax, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescriber_fax(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescriber_email(ctx context.Context, field graphql.CollectedField, obj *model1.Prescriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescriber_email,
+		func(ctx context.Context) (any, error) {
+			return obj.Email, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescriber_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescriber_displayName(ctx context.Context, field graphql.CollectedField, obj *model1.Prescriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescriber_displayName,
+		func(ctx context.Context) (any, error) {
+			return obj.DisplayName(), nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescriber_displayName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescriber",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescriber_createdAt(ctx context.Context, field graphql.CollectedField, obj *model1.Prescriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescriber_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescriber_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescriber_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model1.Prescriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescriber_updatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescriber_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescriber_prescriptions(ctx context.Context, field graphql.CollectedField, obj *model1.Prescriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescriber_prescriptions,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Prescriber().Prescriptions(ctx, obj, fc.Args["limit"].(*int))
+		},
+		nil,
+		ec.marshalNPrescription2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescriptionᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescriber_prescriptions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescriber",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Prescription_id(ctx, field)
+			case "patientID":
+				return ec.fieldContext_Prescription_patientID(ctx, field)
+			case "patient":
+				return ec.fieldContext_Prescription_patient(ctx, field)
+			case "prescriberID":
+				return ec.fieldContext_Prescription_prescriberID(ctx, field)
+			case "prescriber":
+				return ec.fieldContext_Prescription_prescriber(ctx, field)
+			case "drug":
+				return ec.fieldContext_Prescription_drug(ctx, field)
+			case "dose":
+				return ec.fieldContext_Prescription_dose(ctx, field)
+			case "dosage":
+				return ec.fieldContext_Prescription_dosage(ctx, field)
+			case "status":
+				return ec.fieldContext_Prescription_status(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Prescription_createdAt(ctx, field)
+			case "sig":
+				return ec.fieldContext_Prescription_sig(ctx, field)
+			case "directions":
+				return ec.fieldContext_Prescription_directions(ctx, field)
+			case "quantity":
+				return ec.fieldContext_Prescription_quantity(ctx, field)
+			case "daysSupply":
+				return ec.fieldContext_Prescription_daysSupply(ctx, field)
+			case "expectedEndDate":
+				return ec.fieldContext_Prescription_expectedEndDate(ctx, field)
+			case "interactionWarnings":
+				return ec.fieldContext_Prescription_interactionWarnings(ctx, field)
+			case "fulfillmentStatus":
+				return ec.fieldContext_Prescription_fulfillmentStatus(ctx, field)
+			case "fulfillmentUpdatedAt":
+				return ec.fieldContext_Prescription_fulfillmentUpdatedAt(ctx, field)
+			case "pharmacy":
+				return ec.fieldContext_Prescription_pharmacy(ctx, field)
+			case "history":
+				return ec.fieldContext_Prescription_history(ctx, field)
+			case "priceEstimate":
+				return ec.fieldContext_Prescription_priceEstimate(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Prescription", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Prescriber_prescriptions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescription_id(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescription_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescription_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescription_patientID(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescription_patientID,
+		func(ctx context.Context) (any, error) {
+			return obj.PatientID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescription_patientID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescription_patient(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescription_patient,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Prescription().Patient(ctx, obj)
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *model.Patient
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, obj, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNString2string(ctx, "patient:read")
+				if err != nil {
+					var zeroVal *model.Patient
+					return zeroVal, err
+				}
+				if ec.directives.HasPermission == nil {
+					var zeroVal *model.Patient
+					return zeroVal, errors.New("directive hasPermission is not implemented")
+				}
+				return ec.directives.HasPermission(ctx, obj, directive1, permission)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOPatient2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatient,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescription_patient(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Patient_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Patient_name(ctx, field)
+			case "dob":
+				return ec.fieldContext_Patient_dob(ctx, field)
+			case "phone":
+				return ec.fieldContext_Patient_phone(ctx, field)
+			case "state":
+				return ec.fieldContext_Patient_state(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Patient_createdAt(ctx, field)
+			case "addresses":
+				return ec.fieldContext_Patient_addresses(ctx, field)
+			case "measurements":
+				return ec.fieldContext_Patient_measurements(ctx, field)
+			case "latestMeasurement":
+				return ec.fieldContext_Patient_latestMeasurement(ctx, field)
+			case "contactPreferences":
+				return ec.fieldContext_Patient_contactPreferences(ctx, field)
+			case "allergies":
+				return ec.fieldContext_Patient_allergies(ctx, field)
+			case "insurance":
+				return ec.fieldContext_Patient_insurance(ctx, field)
+			case "prescriptions":
+				return ec.fieldContext_Patient_prescriptions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Patient", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescription_prescriberID(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescription_prescriberID,
+		func(ctx context.Context) (any, error) {
+			return obj.PrescriberID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescription_prescriberID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescription_prescriber(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescription_prescriber,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Prescription().Prescriber(ctx, obj)
+		},
+		nil,
+		ec.marshalOPrescriber2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescriber,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescription_prescriber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Prescriber_id(ctx, field)
+			case "npi":
+				return ec.fieldContext_Prescriber_npi(ctx, field)
+			case "firstName":
+				return ec.fieldContext_Prescriber_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_Prescriber_lastName(ctx, field)
+			case "credential":
+				return ec.fieldContext_Prescriber_credential(ctx, field)
+			case "specialty":
+				return ec.fieldContext_Prescriber_specialty(ctx, field)
+			case "phone":
+				return ec.fieldContext_Prescriber_phone(ctx, field)
+			case "fax":
+				return ec.fieldContext_Prescriber_fax(ctx, field)
+			case "email":
+				return ec.fieldContext_Prescriber_email(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Prescriber_displayName(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Prescriber_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Prescriber_updatedAt(ctx, field)
+			case "prescriptions":
+				return ec.fieldContext_Prescriber_prescriptions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Prescriber", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescription_drug(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescription_drug,
+		func(ctx context.Context) (any, error) {
+			return obj.Drug, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescription_drug(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescription_dose(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescription_dose,
+		func(ctx context.Context) (any, error) {
+			return obj.Dose, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescription_dose(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescription_dosage(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescription_dosage,
+		func(ctx context.Context) (any, error) {
+			return obj.Dosage, nil
+		},
+		nil,
+		ec.marshalODose2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐDose,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescription_dosage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "value":
+				return ec.fieldContext_Dose_value(ctx, field)
+			case "unit":
+				return ec.fieldContext_Dose_unit(ctx, field)
+			case "frequency":
+				return ec.fieldContext_Dose_frequency(ctx, field)
+			case "route":
+				return ec.fieldContext_Dose_route(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Dose", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescription_status(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescription_status,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Prescription().Status(ctx, obj)
+		},
+		nil,
+		ec.marshalNPrescriptionStatus2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐPrescriptionStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescription_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type PrescriptionStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescription_createdAt(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescription_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescription_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescription_sig(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescription_sig,
+		func(ctx context.Context) (any, error) {
+			return obj.Sig, nil
+		},
+		nil,
+		ec.marshalNSig2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐSig,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescription_sig(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "doseQuantity":
+				return ec.fieldContext_Sig_doseQuantity(ctx, field)
+			case "doseUnit":
+				return ec.fieldContext_Sig_doseUnit(ctx, field)
+			case "route":
+				return ec.fieldContext_Sig_route(ctx, field)
+			case "frequency":
+				return ec.fieldContext_Sig_frequency(ctx, field)
+			case "timing":
+				return ec.fieldContext_Sig_timing(ctx, field)
+			case "asNeeded":
+				return ec.fieldContext_Sig_asNeeded(ctx, field)
+			case "indication":
+				return ec.fieldContext_Sig_indication(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Sig", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescription_directions(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescription_directions,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Prescription().Directions(ctx, obj, fc.Args["language"].(*SigLanguage))
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescription_directions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Prescription_directions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescription_quantity(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescription_quantity,
+		func(ctx context.Context) (any, error) {
+			return obj.Quantity, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescription_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescription_daysSupply(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescription_daysSupply,
+		func(ctx context.Context) (any, error) {
+			return obj.DaysSupply, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescription_daysSupply(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescription_expectedEndDate(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescription_expectedEndDate,
+		func(ctx context.Context) (any, error) {
+			return obj.ExpectedEndDate, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescription_expectedEndDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescription_interactionWarnings(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescription_interactionWarnings,
+		func(ctx context.Context) (any, error) {
+			return obj.InteractionWarnings, nil
+		},
+		nil,
+		ec.marshalNDrugInteractionWarning2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐDrugInteractionWarningᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescription_interactionWarnings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "drug":
+				return ec.fieldContext_DrugInteractionWarning_drug(ctx, field)
+			case "interactingDrug":
+				return ec.fieldContext_DrugInteractionWarning_interactingDrug(ctx, field)
+			case "interactingPrescriptionID":
+				return ec.fieldContext_DrugInteractionWarning_interactingPrescriptionID(ctx, field)
+			case "severity":
+				return ec.fieldContext_DrugInteractionWarning_severity(ctx, field)
+			case "description":
+				return ec.fieldContext_DrugInteractionWarning_description(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DrugInteractionWarning", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescription_fulfillmentStatus(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescription_fulfillmentStatus,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Prescription().FulfillmentStatus(ctx, obj)
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescription_fulfillmentStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescription_fulfillmentUpdatedAt(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescription_fulfillmentUpdatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.FulfillmentUpdatedAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescription_fulfillmentUpdatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescription_pharmacy(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescription_pharmacy,
+		func(ctx context.Context) (any, error) {
+			return obj.Pharmacy, nil
+		},
+		nil,
+		ec.marshalOPharmacy2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPharmacy,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescription_pharmacy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Pharmacy_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Pharmacy_name(ctx, field)
+			case "type":
+				return ec.fieldContext_Pharmacy_type(ctx, field)
+			case "address":
+				return ec.fieldContext_Pharmacy_address(ctx, field)
+			case "city":
+				return ec.fieldContext_Pharmacy_city(ctx, field)
+			case "state":
+				return ec.fieldContext_Pharmacy_state(ctx, field)
+			case "zip":
+				return ec.fieldContext_Pharmacy_zip(ctx, field)
+			case "phone":
+				return ec.fieldContext_Pharmacy_phone(ctx, field)
+			case "routedAt":
+				return ec.fieldContext_Pharmacy_routedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Pharmacy", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescription_history(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescription_history,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Prescription().History(ctx, obj, fc.Args["limit"].(*int), fc.Args["after"].(*string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *model1.PrescriptionHistoryConnection
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, obj, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				requires, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"prescription:read", "doctor:role", "pharmacist:role", "admin:all"})
+				if err != nil {
+					var zeroVal *model1.PrescriptionHistoryConnection
+					return zeroVal, err
+				}
+				if ec.directives.PermissionAny == nil {
+					var zeroVal *model1.PrescriptionHistoryConnection
+					return zeroVal, errors.New("directive permissionAny is not implemented")
+				}
+				return ec.directives.PermissionAny(ctx, obj, directive1, requires)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOPrescriptionHistoryConnection2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescriptionHistoryConnection,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescription_history(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "events":
+				return ec.fieldContext_PrescriptionHistoryConnection_events(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PrescriptionHistoryConnection_endCursor(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PrescriptionHistoryConnection_hasNextPage(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PrescriptionHistoryConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Prescription_history_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Prescription_priceEstimate(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Prescription_priceEstimate,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Prescription().PriceEstimate(ctx, obj)
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *model2.PriceEstimate
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, obj, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNString2string(ctx, "billing:read")
+				if err != nil {
+					var zeroVal *model2.PriceEstimate
+					return zeroVal, err
+				}
+				if ec.directives.HasPermission == nil {
+					var zeroVal *model2.PriceEstimate
+					return zeroVal, errors.New("directive hasPermission is not implemented")
+				}
+				return ec.directives.HasPermission(ctx, obj, directive1, permission)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOPriceEstimate2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋbillingᚋcontractsᚋmodelᚐPriceEstimate,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Prescription_priceEstimate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Prescription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "prescriptionID":
+				return ec.fieldContext_PriceEstimate_prescriptionID(ctx, field)
+			case "drug":
+				return ec.fieldContext_PriceEstimate_drug(ctx, field)
+			case "quantity":
+				return ec.fieldContext_PriceEstimate_quantity(ctx, field)
+			case "unitPrice":
+				return ec.fieldContext_PriceEstimate_unitPrice(ctx, field)
+			case "ingredientCost":
+				return ec.fieldContext_PriceEstimate_ingredientCost(ctx, field)
+			case "dispensingFee":
+				return ec.fieldContext_PriceEstimate_dispensingFee(ctx, field)
+			case "total":
+				return ec.fieldContext_PriceEstimate_total(ctx, field)
+			case "currency":
+				return ec.fieldContext_PriceEstimate_currency(ctx, field)
+			case "source":
+				return ec.fieldContext_PriceEstimate_source(ctx, field)
+			case "estimatedAt":
+				return ec.fieldContext_PriceEstimate_estimatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PriceEstimate", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionAdherence_prescriptionID(ctx context.Context, field graphql.CollectedField, obj *model4.PrescriptionAdherence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionAdherence_prescriptionID,
+		func(ctx context.Context) (any, error) {
+			return obj.PrescriptionID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionAdherence_prescriptionID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionAdherence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionAdherence_drug(ctx context.Context, field graphql.CollectedField, obj *model4.PrescriptionAdherence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionAdherence_drug,
+		func(ctx context.Context) (any, error) {
+			return obj.Drug, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionAdherence_drug(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionAdherence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionAdherence_status(ctx context.Context, field graphql.CollectedField, obj *model4.PrescriptionAdherence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionAdherence_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionAdherence_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionAdherence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionAdherence_dispenses(ctx context.Context, field graphql.CollectedField, obj *model4.PrescriptionAdherence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionAdherence_dispenses,
+		func(ctx context.Context) (any, error) {
+			return obj.Dispenses, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionAdherence_dispenses(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionAdherence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionAdherence_lastDispensedAt(ctx context.Context, field graphql.CollectedField, obj *model4.PrescriptionAdherence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionAdherence_lastDispensedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.LastDispensedAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionAdherence_lastDispensedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionAdherence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionAdherence_averageDaysBetweenRefills(ctx context.Context, field graphql.CollectedField, obj *model4.PrescriptionAdherence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionAdherence_averageDaysBetweenRefills,
+		func(ctx context.Context) (any, error) {
+			return obj.AverageDaysBetweenRefills, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionAdherence_averageDaysBetweenRefills(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionAdherence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionAdherence_proportionOfDaysCovered(ctx context.Context, field graphql.CollectedField, obj *model4.PrescriptionAdherence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionAdherence_proportionOfDaysCovered,
+		func(ctx context.Context) (any, error) {
+			return obj.ProportionOfDaysCovered, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionAdherence_proportionOfDaysCovered(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionAdherence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionHistoryConnection_events(ctx context.Context, field graphql.CollectedField, obj *model1.PrescriptionHistoryConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionHistoryConnection_events,
+		func(ctx context.Context) (any, error) {
+			return obj.Events, nil
+		},
+		nil,
+		ec.marshalNPrescriptionHistoryEvent2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescriptionHistoryEventᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionHistoryConnection_events(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionHistoryConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_PrescriptionHistoryEvent_id(ctx, field)
+			case "type":
+				return ec.fieldContext_PrescriptionHistoryEvent_type(ctx, field)
+			case "at":
+				return ec.fieldContext_PrescriptionHistoryEvent_at(ctx, field)
+			case "actor":
+				return ec.fieldContext_PrescriptionHistoryEvent_actor(ctx, field)
+			case "reason":
+				return ec.fieldContext_PrescriptionHistoryEvent_reason(ctx, field)
+			case "fromStatus":
+				return ec.fieldContext_PrescriptionHistoryEvent_fromStatus(ctx, field)
+			case "toStatus":
+				return ec.fieldContext_PrescriptionHistoryEvent_toStatus(ctx, field)
+			case "dispenseID":
+				return ec.fieldContext_PrescriptionHistoryEvent_dispenseID(ctx, field)
+			case "pharmacyID":
+				return ec.fieldContext_PrescriptionHistoryEvent_pharmacyID(ctx, field)
+			case "pharmacyName":
+				return ec.fieldContext_PrescriptionHistoryEvent_pharmacyName(ctx, field)
+			case "previousPharmacyID":
+				return ec.fieldContext_PrescriptionHistoryEvent_previousPharmacyID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PrescriptionHistoryEvent", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionHistoryConnection_endCursor(ctx context.Context, field graphql.CollectedField, obj *model1.PrescriptionHistoryConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionHistoryConnection_endCursor,
+		func(ctx context.Context) (any, error) {
+			return obj.EndCursor, nil
+		},
+		nil,
+		ec.marshalOString2string,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionHistoryConnection_endCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionHistoryConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionHistoryConnection_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model1.PrescriptionHistoryConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionHistoryConnection_hasNextPage,
+		func(ctx context.Context) (any, error) {
+			return obj.HasNextPage, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionHistoryConnection_hasNextPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionHistoryConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionHistoryEvent_id(ctx context.Context, field graphql.CollectedField, obj *model1.PrescriptionHistoryEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionHistoryEvent_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionHistoryEvent_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionHistoryEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionHistoryEvent_type(ctx context.Context, field graphql.CollectedField, obj *model1.PrescriptionHistoryEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionHistoryEvent_type,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.PrescriptionHistoryEvent().Type(ctx, obj)
+		},
+		nil,
+		ec.marshalNPrescriptionHistoryEventType2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐPrescriptionHistoryEventType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionHistoryEvent_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionHistoryEvent",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type PrescriptionHistoryEventType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionHistoryEvent_at(ctx context.Context, field graphql.CollectedField, obj *model1.PrescriptionHistoryEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionHistoryEvent_at,
+		func(ctx context.Context) (any, error) {
+			return obj.At, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionHistoryEvent_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionHistoryEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionHistoryEvent_actor(ctx context.Context, field graphql.CollectedField, obj *model1.PrescriptionHistoryEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionHistoryEvent_actor,
+		func(ctx context.Context) (any, error) {
+			return obj.Actor, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionHistoryEvent_actor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionHistoryEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionHistoryEvent_reason(ctx context.Context, field graphql.CollectedField, obj *model1.PrescriptionHistoryEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionHistoryEvent_reason,
+		func(ctx context.Context) (any, error) {
+			return obj.Reason, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionHistoryEvent_reason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionHistoryEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionHistoryEvent_fromStatus(ctx context.Context, field graphql.CollectedField, obj *model1.PrescriptionHistoryEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionHistoryEvent_fromStatus,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.PrescriptionHistoryEvent().FromStatus(ctx, obj)
+		},
+		nil,
+		ec.marshalOPrescriptionStatus2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐPrescriptionStatus,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionHistoryEvent_fromStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionHistoryEvent",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type PrescriptionStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionHistoryEvent_toStatus(ctx context.Context, field graphql.CollectedField, obj *model1.PrescriptionHistoryEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionHistoryEvent_toStatus,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.PrescriptionHistoryEvent().ToStatus(ctx, obj)
+		},
+		nil,
+		ec.marshalOPrescriptionStatus2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐPrescriptionStatus,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionHistoryEvent_toStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionHistoryEvent",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type PrescriptionStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionHistoryEvent_dispenseID(ctx context.Context, field graphql.CollectedField, obj *model1.PrescriptionHistoryEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionHistoryEvent_dispenseID,
+		func(ctx context.Context) (any, error) {
+			return obj.DispenseID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionHistoryEvent_dispenseID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionHistoryEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionHistoryEvent_pharmacyID(ctx context.Context, field graphql.CollectedField, obj *model1.PrescriptionHistoryEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionHistoryEvent_pharmacyID,
+		func(ctx context.Context) (any, error) {
+			return obj.PharmacyID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionHistoryEvent_pharmacyID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionHistoryEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionHistoryEvent_pharmacyName(ctx context.Context, field graphql.CollectedField, obj *model1.PrescriptionHistoryEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionHistoryEvent_pharmacyName,
+		func(ctx context.Context) (any, error) {
+			return obj.PharmacyName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionHistoryEvent_pharmacyName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionHistoryEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionHistoryEvent_previousPharmacyID(ctx context.Context, field graphql.CollectedField, obj *model1.PrescriptionHistoryEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionHistoryEvent_previousPharmacyID,
+		func(ctx context.Context) (any, error) {
+			return obj.PreviousPharmacyID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionHistoryEvent_previousPharmacyID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionHistoryEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionTransmission_id(ctx context.Context, field graphql.CollectedField, obj *model3.PrescriptionTransmission) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionTransmission_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionTransmission_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionTransmission",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionTransmission_prescriptionID(ctx context.Context, field graphql.CollectedField, obj *model3.PrescriptionTransmission) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionTransmission_prescriptionID,
+		func(ctx context.Context) (any, error) {
+			return obj.PrescriptionID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionTransmission_prescriptionID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionTransmission",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionTransmission_patientID(ctx context.Context, field graphql.CollectedField, obj *model3.PrescriptionTransmission) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionTransmission_patientID,
+		func(ctx context.Context) (any, error) {
+			return obj.PatientID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionTransmission_patientID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionTransmission",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionTransmission_pharmacyID(ctx context.Context, field graphql.CollectedField, obj *model3.PrescriptionTransmission) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionTransmission_pharmacyID,
+		func(ctx context.Context) (any, error) {
+			return obj.PharmacyID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionTransmission_pharmacyID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionTransmission",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionTransmission_pharmacyName(ctx context.Context, field graphql.CollectedField, obj *model3.PrescriptionTransmission) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionTransmission_pharmacyName,
+		func(ctx context.Context) (any, error) {
+			return obj.PharmacyName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionTransmission_pharmacyName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionTransmission",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionTransmission_status(ctx context.Context, field graphql.CollectedField, obj *model3.PrescriptionTransmission) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionTransmission_status,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.PrescriptionTransmission().Status(ctx, obj)
+		},
+		nil,
+		ec.marshalNTransmissionStatus2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐTransmissionStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionTransmission_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionTransmission",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type TransmissionStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionTransmission_message(ctx context.Context, field graphql.CollectedField, obj *model3.PrescriptionTransmission) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionTransmission_message,
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionTransmission_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionTransmission",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionTransmission_attempts(ctx context.Context, field graphql.CollectedField, obj *model3.PrescriptionTransmission) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionTransmission_attempts,
+		func(ctx context.Context) (any, error) {
+			return obj.Attempts, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionTransmission_attempts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionTransmission",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionTransmission_nextAttemptAt(ctx context.Context, field graphql.CollectedField, obj *model3.PrescriptionTransmission) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionTransmission_nextAttemptAt,
+		func(ctx context.Context) (any, error) {
+			return obj.NextAttemptAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionTransmission_nextAttemptAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionTransmission",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionTransmission_lastError(ctx context.Context, field graphql.CollectedField, obj *model3.PrescriptionTransmission) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionTransmission_lastError,
+		func(ctx context.Context) (any, error) {
+			return obj.LastError, nil
+		},
+		nil,
+		ec.marshalOString2string,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionTransmission_lastError(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionTransmission",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionTransmission_acknowledgments(ctx context.Context, field graphql.CollectedField, obj *model3.PrescriptionTransmission) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionTransmission_acknowledgments,
+		func(ctx context.Context) (any, error) {
+			return obj.Acknowledgments, nil
+		},
+		nil,
+		ec.marshalNTransmissionAcknowledgment2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋeprescribingᚋcontractsᚋmodelᚐTransmissionAcknowledgmentᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionTransmission_acknowledgments(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionTransmission",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "messageID":
+				return ec.fieldContext_TransmissionAcknowledgment_messageID(ctx, field)
+			case "kind":
+				return ec.fieldContext_TransmissionAcknowledgment_kind(ctx, field)
+			case "code":
+				return ec.fieldContext_TransmissionAcknowledgment_code(ctx, field)
+			case "description":
+				return ec.fieldContext_TransmissionAcknowledgment_description(ctx, field)
+			case "receivedAt":
+				return ec.fieldContext_TransmissionAcknowledgment_receivedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TransmissionAcknowledgment", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionTransmission_createdBy(ctx context.Context, field graphql.CollectedField, obj *model3.PrescriptionTransmission) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionTransmission_createdBy,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedBy, nil
+		},
+		nil,
+		ec.marshalOString2string,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionTransmission_createdBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionTransmission",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionTransmission_createdAt(ctx context.Context, field graphql.CollectedField, obj *model3.PrescriptionTransmission) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionTransmission_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionTransmission_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionTransmission",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PrescriptionTransmission_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model3.PrescriptionTransmission) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PrescriptionTransmission_updatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PrescriptionTransmission_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PrescriptionTransmission",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PriceEstimate_prescriptionID(ctx context.Context, field graphql.CollectedField, obj *model2.PriceEstimate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PriceEstimate_prescriptionID,
+		func(ctx context.Context) (any, error) {
+			return obj.PrescriptionID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PriceEstimate_prescriptionID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PriceEstimate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PriceEstimate_drug(ctx context.Context, field graphql.CollectedField, obj *model2.PriceEstimate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PriceEstimate_drug,
+		func(ctx context.Context) (any, error) {
+			return obj.Drug, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PriceEstimate_drug(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PriceEstimate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PriceEstimate_quantity(ctx context.Context, field graphql.CollectedField, obj *model2.PriceEstimate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PriceEstimate_quantity,
+		func(ctx context.Context) (any, error) {
+			return obj.Quantity, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PriceEstimate_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PriceEstimate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PriceEstimate_unitPrice(ctx context.Context, field graphql.CollectedField, obj *model2.PriceEstimate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PriceEstimate_unitPrice,
+		func(ctx context.Context) (any, error) {
+			return obj.UnitPrice, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PriceEstimate_unitPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PriceEstimate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PriceEstimate_ingredientCost(ctx context.Context, field graphql.CollectedField, obj *model2.PriceEstimate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PriceEstimate_ingredientCost,
+		func(ctx context.Context) (any, error) {
+			return obj.IngredientCost, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PriceEstimate_ingredientCost(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PriceEstimate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PriceEstimate_dispensingFee(ctx context.Context, field graphql.CollectedField, obj *model2.PriceEstimate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PriceEstimate_dispensingFee,
+		func(ctx context.Context) (any, error) {
+			return obj.DispensingFee, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PriceEstimate_dispensingFee(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PriceEstimate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PriceEstimate_total(ctx context.Context, field graphql.CollectedField, obj *model2.PriceEstimate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PriceEstimate_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PriceEstimate_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PriceEstimate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PriceEstimate_currency(ctx context.Context, field graphql.CollectedField, obj *model2.PriceEstimate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PriceEstimate_currency,
+		func(ctx context.Context) (any, error) {
+			return obj.Currency, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PriceEstimate_currency(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PriceEstimate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PriceEstimate_source(ctx context.Context, field graphql.CollectedField, obj *model2.PriceEstimate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PriceEstimate_source,
+		func(ctx context.Context) (any, error) {
+			return obj.Source, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PriceEstimate_source(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PriceEstimate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PriceEstimate_estimatedAt(ctx context.Context, field graphql.CollectedField, obj *model2.PriceEstimate) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PriceEstimate_estimatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.EstimatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PriceEstimate_estimatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PriceEstimate",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query__empty(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query__empty,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().Empty(ctx)
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query__empty(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_invoicesByPatient(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_invoicesByPatient,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().InvoicesByPatient(ctx, fc.Args["patientID"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal []model2.Invoice
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNString2string(ctx, "billing:read")
+				if err != nil {
+					var zeroVal []model2.Invoice
+					return zeroVal, err
+				}
+				if ec.directives.HasPermission == nil {
+					var zeroVal []model2.Invoice
+					return zeroVal, errors.New("directive hasPermission is not implemented")
+				}
+				return ec.directives.HasPermission(ctx, nil, directive1, permission)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOInvoice2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋbillingᚋcontractsᚋmodelᚐInvoiceᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_invoicesByPatient(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Invoice_id(ctx, field)
+			case "prescriptionID":
+				return ec.fieldContext_Invoice_prescriptionID(ctx, field)
+			case "patientID":
+				return ec.fieldContext_Invoice_patientID(ctx, field)
+			case "amount":
+				return ec.fieldContext_Invoice_amount(ctx, field)
+			case "status":
+				return ec.fieldContext_Invoice_status(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Invoice_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Invoice_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Invoice", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_invoicesByPatient_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_dashboardStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_dashboardStats,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().DashboardStats(ctx)
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *DashboardStats
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNString2string(ctx, "dashboard:view")
+				if err != nil {
+					var zeroVal *DashboardStats
+					return zeroVal, err
+				}
+				if ec.directives.HasPermission == nil {
+					var zeroVal *DashboardStats
+					return zeroVal, errors.New("directive hasPermission is not implemented")
+				}
+				return ec.directives.HasPermission(ctx, nil, directive1, permission)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalODashboardStats2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐDashboardStats,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_dashboardStats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "totalPatients":
+				return ec.fieldContext_DashboardStats_totalPatients(ctx, field)
+			case "activePrescriptions":
+				return ec.fieldContext_DashboardStats_activePrescriptions(ctx, field)
+			case "patientsByState":
+				return ec.fieldContext_DashboardStats_patientsByState(ctx, field)
+			case "prescriptionsByStatus":
+				return ec.fieldContext_DashboardStats_prescriptionsByStatus(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DashboardStats", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_prescriptionTransmission(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_prescriptionTransmission,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().PrescriptionTransmission(ctx, fc.Args["id"].(string), fc.Args["refresh"].(*bool))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *model3.PrescriptionTransmission
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				requires, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"prescription:read", "doctor:role", "pharmacist:role", "admin:all"})
+				if err != nil {
+					var zeroVal *model3.PrescriptionTransmission
+					return zeroVal, err
+				}
+				if ec.directives.PermissionAny == nil {
+					var zeroVal *model3.PrescriptionTransmission
+					return zeroVal, errors.New("directive permissionAny is not implemented")
+				}
+				return ec.directives.PermissionAny(ctx, nil, directive1, requires)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOPrescriptionTransmission2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋeprescribingᚋcontractsᚋmodelᚐPrescriptionTransmission,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_prescriptionTransmission(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_PrescriptionTransmission_id(ctx, field)
+			case "prescriptionID":
+				return ec.fieldContext_PrescriptionTransmission_prescriptionID(ctx, field)
+			case "patientID":
+				return ec.fieldContext_PrescriptionTransmission_patientID(ctx, field)
+			case "pharmacyID":
+				return ec.fieldContext_PrescriptionTransmission_pharmacyID(ctx, field)
+			case "pharmacyName":
+				return ec.fieldContext_PrescriptionTransmission_pharmacyName(ctx, field)
+			case "status":
+				return ec.fieldContext_PrescriptionTransmission_status(ctx, field)
+			case "message":
+				return ec.fieldContext_PrescriptionTransmission_message(ctx, field)
+			case "attempts":
+				return ec.fieldContext_PrescriptionTransmission_attempts(ctx, field)
+			case "nextAttemptAt":
+				return ec.fieldContext_PrescriptionTransmission_nextAttemptAt(ctx, field)
+			case "lastError":
+				return ec.fieldContext_PrescriptionTransmission_lastError(ctx, field)
+			case "acknowledgments":
+				return ec.fieldContext_PrescriptionTransmission_acknowledgments(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_PrescriptionTransmission_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_PrescriptionTransmission_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_PrescriptionTransmission_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PrescriptionTransmission", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_prescriptionTransmission_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_prescriptionTransmissions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_prescriptionTransmissions,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().PrescriptionTransmissions(ctx, fc.Args["prescriptionID"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal []model3.PrescriptionTransmission
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				requires, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"prescription:read", "doctor:role", "pharmacist:role", "admin:all"})
+				if err != nil {
+					var zeroVal []model3.PrescriptionTransmission
+					return zeroVal, err
+				}
+				if ec.directives.PermissionAny == nil {
+					var zeroVal []model3.PrescriptionTransmission
+					return zeroVal, errors.New("directive permissionAny is not implemented")
+				}
+				return ec.directives.PermissionAny(ctx, nil, directive1, requires)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOPrescriptionTransmission2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋeprescribingᚋcontractsᚋmodelᚐPrescriptionTransmissionᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_prescriptionTransmissions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_PrescriptionTransmission_id(ctx, field)
+			case "prescriptionID":
+				return ec.fieldContext_PrescriptionTransmission_prescriptionID(ctx, field)
+			case "patientID":
+				return ec.fieldContext_PrescriptionTransmission_patientID(ctx, field)
+			case "pharmacyID":
+				return ec.fieldContext_PrescriptionTransmission_pharmacyID(ctx, field)
+			case "pharmacyName":
+				return ec.fieldContext_PrescriptionTransmission_pharmacyName(ctx, field)
+			case "status":
+				return ec.fieldContext_PrescriptionTransmission_status(ctx, field)
+			case "message":
+				return ec.fieldContext_PrescriptionTransmission_message(ctx, field)
+			case "attempts":
+				return ec.fieldContext_PrescriptionTransmission_attempts(ctx, field)
+			case "nextAttemptAt":
+				return ec.fieldContext_PrescriptionTransmission_nextAttemptAt(ctx, field)
+			case "lastError":
+				return ec.fieldContext_PrescriptionTransmission_lastError(ctx, field)
+			case "acknowledgments":
+				return ec.fieldContext_PrescriptionTransmission_acknowledgments(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_PrescriptionTransmission_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_PrescriptionTransmission_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_PrescriptionTransmission_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PrescriptionTransmission", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_prescriptionTransmissions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_patients(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_patients,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Patients(ctx, fc.Args["query"].(*string), fc.Args["state"].(*string), fc.Args["minAge"].(*int), fc.Args["maxAge"].(*int), fc.Args["hasActivePrescriptions"].(*bool), fc.Args["limit"].(*int), fc.Args["offset"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal []model.Patient
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNString2string(ctx, "patient:read")
+				if err != nil {
+					var zeroVal []model.Patient
+					return zeroVal, err
+				}
+				if ec.directives.HasPermission == nil {
+					var zeroVal []model.Patient
+					return zeroVal, errors.New("directive hasPermission is not implemented")
+				}
+				return ec.directives.HasPermission(ctx, nil, directive1, permission)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOPatient2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatientᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_patients(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Patient_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Patient_name(ctx, field)
+			case "dob":
+				return ec.fieldContext_Patient_dob(ctx, field)
+			case "phone":
+				return ec.fieldContext_Patient_phone(ctx, field)
+			case "state":
+				return ec.fieldContext_Patient_state(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Patient_createdAt(ctx, field)
+			case "addresses":
+				return ec.fieldContext_Patient_addresses(ctx, field)
+			case "measurements":
+				return ec.fieldContext_Patient_measurements(ctx, field)
+			case "latestMeasurement":
+				return ec.fieldContext_Patient_latestMeasurement(ctx, field)
+			case "contactPreferences":
+				return ec.fieldContext_Patient_contactPreferences(ctx, field)
+			case "allergies":
+				return ec.fieldContext_Patient_allergies(ctx, field)
+			case "insurance":
+				return ec.fieldContext_Patient_insurance(ctx, field)
+			case "prescriptions":
+				return ec.fieldContext_Patient_prescriptions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Patient", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_patients_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_patientCountByState(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_patientCountByState,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().PatientCountByState(ctx)
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal []model.StateCount
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNString2string(ctx, "patient:read")
+				if err != nil {
+					var zeroVal []model.StateCount
+					return zeroVal, err
+				}
+				if ec.directives.HasPermission == nil {
+					var zeroVal []model.StateCount
+					return zeroVal, errors.New("directive hasPermission is not implemented")
+				}
+				return ec.directives.HasPermission(ctx, nil, directive1, permission)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOStateCount2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐStateCountᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_patientCountByState(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "state":
+				return ec.fieldContext_StateCount_state(ctx, field)
+			case "count":
+				return ec.fieldContext_StateCount_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StateCount", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_searchPatients(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_searchPatients,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().SearchPatients(ctx, fc.Args["query"].(string), fc.Args["limit"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal []model.PatientSearchResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNString2string(ctx, "patient:read")
+				if err != nil {
+					var zeroVal []model.PatientSearchResult
+					return zeroVal, err
+				}
+				if ec.directives.HasPermission == nil {
+					var zeroVal []model.PatientSearchResult
+					return zeroVal, errors.New("directive hasPermission is not implemented")
+				}
+				return ec.directives.HasPermission(ctx, nil, directive1, permission)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOPatientSearchResult2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatientSearchResultᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_searchPatients(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "patient":
+				return ec.fieldContext_PatientSearchResult_patient(ctx, field)
+			case "score":
+				return ec.fieldContext_PatientSearchResult_score(ctx, field)
+			case "matchType":
+				return ec.fieldContext_PatientSearchResult_matchType(ctx, field)
+			case "matches":
+				return ec.fieldContext_PatientSearchResult_matches(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PatientSearchResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_searchPatients_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_prescriptionCountByStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_prescriptionCountByStatus,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().PrescriptionCountByStatus(ctx)
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal []model1.StatusCount
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				requires, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"prescription:read", "doctor:role", "pharmacist:role", "admin:all"})
+				if err != nil {
+					var zeroVal []model1.StatusCount
+					return zeroVal, err
+				}
+				if ec.directives.PermissionAny == nil {
+					var zeroVal []model1.StatusCount
+					return zeroVal, errors.New("directive permissionAny is not implemented")
+				}
+				return ec.directives.PermissionAny(ctx, nil, directive1, requires)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOStatusCount2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐStatusCountᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_prescriptionCountByStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "status":
+				return ec.fieldContext_StatusCount_status(ctx, field)
+			case "count":
+				return ec.fieldContext_StatusCount_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StatusCount", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_checkDrugInteractions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_checkDrugInteractions,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().CheckDrugInteractions(ctx, fc.Args["patientID"].(string), fc.Args["drug"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *model1.InteractionCheckResult
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				requires, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"prescription:read", "doctor:role", "pharmacist:role", "admin:all"})
+				if err != nil {
+					var zeroVal *model1.InteractionCheckResult
+					return zeroVal, err
+				}
+				if ec.directives.PermissionAny == nil {
+					var zeroVal *model1.InteractionCheckResult
+					return zeroVal, errors.New("directive permissionAny is not implemented")
+				}
+				return ec.directives.PermissionAny(ctx, nil, directive1, requires)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOInteractionCheckResult2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐInteractionCheckResult,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_checkDrugInteractions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "warnings":
+				return ec.fieldContext_InteractionCheckResult_warnings(ctx, field)
+			case "allergyWarnings":
+				return ec.fieldContext_InteractionCheckResult_allergyWarnings(ctx, field)
+			case "blocked":
+				return ec.fieldContext_InteractionCheckResult_blocked(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type InteractionCheckResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_checkDrugInteractions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_prescriber(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_prescriber,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Prescriber(ctx, fc.Args["id"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *model1.Prescriber
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				requires, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"prescription:read", "doctor:role", "pharmacist:role", "admin:all"})
+				if err != nil {
+					var zeroVal *model1.Prescriber
+					return zeroVal, err
+				}
+				if ec.directives.PermissionAny == nil {
+					var zeroVal *model1.Prescriber
+					return zeroVal, errors.New("directive permissionAny is not implemented")
+				}
+				return ec.directives.PermissionAny(ctx, nil, directive1, requires)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOPrescriber2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescriber,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_prescriber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Prescriber_id(ctx, field)
+			case "npi":
+				return ec.fieldContext_Prescriber_npi(ctx, field)
+			case "firstName":
+				return ec.fieldContext_Prescriber_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_Prescriber_lastName(ctx, field)
+			case "credential":
+				return ec.fieldContext_Prescriber_credential(ctx, field)
+			case "specialty":
+				return ec.fieldContext_Prescriber_specialty(ctx, field)
+			case "phone":
+				return ec.fieldContext_Prescriber_phone(ctx, field)
+			case "fax":
+				return ec.fieldContext_Prescriber_fax(ctx, field)
+			case "email":
+				return ec.fieldContext_Prescriber_email(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Prescriber_displayName(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Prescriber_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Prescriber_updatedAt(ctx, field)
+			case "prescriptions":
+				return ec.fieldContext_Prescriber_prescriptions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Prescriber", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_prescriber_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_prescribers(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_prescribers,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Prescribers(ctx, fc.Args["query"].(*string), fc.Args["limit"].(*int), fc.Args["offset"].(*int))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal []model1.Prescriber
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				requires, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"prescription:read", "doctor:role", "pharmacist:role", "admin:all"})
+				if err != nil {
+					var zeroVal []model1.Prescriber
+					return zeroVal, err
+				}
+				if ec.directives.PermissionAny == nil {
+					var zeroVal []model1.Prescriber
+					return zeroVal, errors.New("directive permissionAny is not implemented")
+				}
+				return ec.directives.PermissionAny(ctx, nil, directive1, requires)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOPrescriber2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescriberᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_prescribers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Prescriber_id(ctx, field)
+			case "npi":
+				return ec.fieldContext_Prescriber_npi(ctx, field)
+			case "firstName":
+				return ec.fieldContext_Prescriber_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_Prescriber_lastName(ctx, field)
+			case "credential":
+				return ec.fieldContext_Prescriber_credential(ctx, field)
+			case "specialty":
+				return ec.fieldContext_Prescriber_specialty(ctx, field)
+			case "phone":
+				return ec.fieldContext_Prescriber_phone(ctx, field)
+			case "fax":
+				return ec.fieldContext_Prescriber_fax(ctx, field)
+			case "email":
+				return ec.fieldContext_Prescriber_email(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Prescriber_displayName(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Prescriber_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Prescriber_updatedAt(ctx, field)
+			case "prescriptions":
+				return ec.fieldContext_Prescriber_prescriptions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Prescriber", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_prescribers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_adherenceReport(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_adherenceReport,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().AdherenceReport(ctx, fc.Args["patientID"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.Auth == nil {
+					var zeroVal *model4.AdherenceReport
+					return zeroVal, errors.New("directive auth is not implemented")
+				}
+				return ec.directives.Auth(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				permission, err := ec.unmarshalNString2string(ctx, "adherence:read")
+				if err != nil {
+					var zeroVal *model4.AdherenceReport
+					return zeroVal, err
+				}
+				if ec.directives.HasPermission == nil {
+					var zeroVal *model4.AdherenceReport
+					return zeroVal, errors.New("directive hasPermission is not implemented")
+				}
+				return ec.directives.HasPermission(ctx, nil, directive1, permission)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalOAdherenceReport2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋreportingᚋcontractsᚋmodelᚐAdherenceReport,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_adherenceReport(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "patientID":
+				return ec.fieldContext_AdherenceReport_patientID(ctx, field)
+			case "activePrescriptions":
+				return ec.fieldContext_AdherenceReport_activePrescriptions(ctx, field)
+			case "completedPrescriptions":
+				return ec.fieldContext_AdherenceReport_completedPrescriptions(ctx, field)
+			case "refills":
+				return ec.fieldContext_AdherenceReport_refills(ctx, field)
+			case "averageDaysBetweenRefills":
+				return ec.fieldContext_AdherenceReport_averageDaysBetweenRefills(ctx, field)
+			case "proportionOfDaysCovered":
+				return ec.fieldContext_AdherenceReport_proportionOfDaysCovered(ctx, field)
+			case "prescriptions":
+				return ec.fieldContext_AdherenceReport_prescriptions(ctx, field)
+			case "history":
+				return ec.fieldContext_AdherenceReport_history(ctx, field)
+			case "generatedAt":
+				return ec.fieldContext_AdherenceReport_generatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AdherenceReport", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_adherenceReport_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query__entities(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query__entities,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.__resolve_entities(ctx, fc.Args["representations"].([]map[string]any)), nil
+		},
+		nil,
+		ec.marshalN_Entity2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋpluginᚋfederationᚋfedruntimeᚐEntity,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query__entities(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type _Entity does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query__entities_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query__service(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query__service,
+		func(ctx context.Context) (any, error) {
+			return ec.__resolve__service(ctx)
+		},
+		nil,
+		ec.marshalN_Service2githubᚗcomᚋ99designsᚋgqlgenᚋpluginᚋfederationᚋfedruntimeᚐService,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query__service(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "sdl":
+				return ec.fieldContext__Service_sdl(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type _Service", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___type,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.introspectType(fc.Args["name"].(string))
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___schema,
+		func(ctx context.Context) (any, error) {
+			return ec.introspectSchema()
+		},
+		nil,
+		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordAllergyPayload_allergy(ctx context.Context, field graphql.CollectedField, obj *RecordAllergyPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordAllergyPayload_allergy,
+		func(ctx context.Context) (any, error) {
+			return obj.Allergy, nil
+		},
+		nil,
+		ec.marshalOAllergy2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐAllergy,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordAllergyPayload_allergy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordAllergyPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Allergy_id(ctx, field)
+			case "patientID":
+				return ec.fieldContext_Allergy_patientID(ctx, field)
+			case "substance":
+				return ec.fieldContext_Allergy_substance(ctx, field)
+			case "reaction":
+				return ec.fieldContext_Allergy_reaction(ctx, field)
+			case "severity":
+				return ec.fieldContext_Allergy_severity(ctx, field)
+			case "recordedAt":
+				return ec.fieldContext_Allergy_recordedAt(ctx, field)
+			case "recordedBy":
+				return ec.fieldContext_Allergy_recordedBy(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Allergy", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordAllergyPayload_userErrors(ctx context.Context, field graphql.CollectedField, obj *RecordAllergyPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordAllergyPayload_userErrors,
+		func(ctx context.Context) (any, error) {
+			return obj.UserErrors, nil
+		},
+		nil,
+		ec.marshalNUserError2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUserErrorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordAllergyPayload_userErrors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordAllergyPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_UserError_field(ctx, field)
+			case "code":
+				return ec.fieldContext_UserError_code(ctx, field)
+			case "message":
+				return ec.fieldContext_UserError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordMeasurementPayload_measurement(ctx context.Context, field graphql.CollectedField, obj *RecordMeasurementPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordMeasurementPayload_measurement,
+		func(ctx context.Context) (any, error) {
+			return obj.Measurement, nil
+		},
+		nil,
+		ec.marshalOMeasurement2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐMeasurement,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordMeasurementPayload_measurement(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordMeasurementPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Measurement_id(ctx, field)
+			case "patientID":
+				return ec.fieldContext_Measurement_patientID(ctx, field)
+			case "type":
+				return ec.fieldContext_Measurement_type(ctx, field)
+			case "value":
+				return ec.fieldContext_Measurement_value(ctx, field)
+			case "unit":
+				return ec.fieldContext_Measurement_unit(ctx, field)
+			case "recordedAt":
+				return ec.fieldContext_Measurement_recordedAt(ctx, field)
+			case "recordedBy":
+				return ec.fieldContext_Measurement_recordedBy(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Measurement", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecordMeasurementPayload_userErrors(ctx context.Context, field graphql.CollectedField, obj *RecordMeasurementPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecordMeasurementPayload_userErrors,
+		func(ctx context.Context) (any, error) {
+			return obj.UserErrors, nil
+		},
+		nil,
+		ec.marshalNUserError2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUserErrorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecordMeasurementPayload_userErrors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecordMeasurementPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_UserError_field(ctx, field)
+			case "code":
+				return ec.fieldContext_UserError_code(ctx, field)
+			case "message":
+				return ec.fieldContext_UserError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Sig_doseQuantity(ctx context.Context, field graphql.CollectedField, obj *model1.Sig) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Sig_doseQuantity,
+		func(ctx context.Context) (any, error) {
+			return obj.DoseQuantity, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Sig_doseQuantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sig",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Sig_doseUnit(ctx context.Context, field graphql.CollectedField, obj *model1.Sig) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Sig_doseUnit,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Sig().DoseUnit(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Sig_doseUnit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sig",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Sig_route(ctx context.Context, field graphql.CollectedField, obj *model1.Sig) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Sig_route,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Sig().Route(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Sig_route(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sig",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Sig_frequency(ctx context.Context, field graphql.CollectedField, obj *model1.Sig) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Sig_frequency,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Sig().Frequency(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Sig_frequency(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sig",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Sig_timing(ctx context.Context, field graphql.CollectedField, obj *model1.Sig) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Sig_timing,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Sig().Timing(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Sig_timing(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sig",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Sig_asNeeded(ctx context.Context, field graphql.CollectedField, obj *model1.Sig) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Sig_asNeeded,
+		func(ctx context.Context) (any, error) {
+			return obj.AsNeeded, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Sig_asNeeded(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sig",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Sig_indication(ctx context.Context, field graphql.CollectedField, obj *model1.Sig) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Sig_indication,
+		func(ctx context.Context) (any, error) {
+			return obj.Indication, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Sig_indication(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sig",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StateCount_state(ctx context.Context, field graphql.CollectedField, obj *model.StateCount) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StateCount_state,
+		func(ctx context.Context) (any, error) {
+			return obj.State, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_StateCount_state(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StateCount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StateCount_count(ctx context.Context, field graphql.CollectedField, obj *model.StateCount) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StateCount_count,
+		func(ctx context.Context) (any, error) {
+			return obj.Count, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_StateCount_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StateCount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StatusCount_status(ctx context.Context, field graphql.CollectedField, obj *model1.StatusCount) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StatusCount_status,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.StatusCount().Status(ctx, obj)
+		},
+		nil,
+		ec.marshalNPrescriptionStatus2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐPrescriptionStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_StatusCount_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StatusCount",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type PrescriptionStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StatusCount_count(ctx context.Context, field graphql.CollectedField, obj *model1.StatusCount) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StatusCount_count,
+		func(ctx context.Context) (any, error) {
+			return obj.Count, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_StatusCount_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StatusCount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TransmissionAcknowledgment_messageID(ctx context.Context, field graphql.CollectedField, obj *model3.TransmissionAcknowledgment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TransmissionAcknowledgment_messageID,
+		func(ctx context.Context) (any, error) {
+			return obj.MessageID, nil
+		},
+		nil,
+		ec.marshalOString2string,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_TransmissionAcknowledgment_messageID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TransmissionAcknowledgment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TransmissionAcknowledgment_kind(ctx context.Context, field graphql.CollectedField, obj *model3.TransmissionAcknowledgment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TransmissionAcknowledgment_kind,
+		func(ctx context.Context) (any, error) {
+			return obj.Kind, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TransmissionAcknowledgment_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TransmissionAcknowledgment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TransmissionAcknowledgment_code(ctx context.Context, field graphql.CollectedField, obj *model3.TransmissionAcknowledgment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TransmissionAcknowledgment_code,
+		func(ctx context.Context) (any, error) {
+			return obj.Code, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TransmissionAcknowledgment_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TransmissionAcknowledgment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TransmissionAcknowledgment_description(ctx context.Context, field graphql.CollectedField, obj *model3.TransmissionAcknowledgment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TransmissionAcknowledgment_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description, nil
+		},
+		nil,
+		ec.marshalOString2string,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_TransmissionAcknowledgment_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TransmissionAcknowledgment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TransmissionAcknowledgment_receivedAt(ctx context.Context, field graphql.CollectedField, obj *model3.TransmissionAcknowledgment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TransmissionAcknowledgment_receivedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.ReceivedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TransmissionAcknowledgment_receivedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TransmissionAcknowledgment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TransmitPrescriptionPayload_transmission(ctx context.Context, field graphql.CollectedField, obj *TransmitPrescriptionPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TransmitPrescriptionPayload_transmission,
+		func(ctx context.Context) (any, error) {
+			return obj.Transmission, nil
+		},
+		nil,
+		ec.marshalOPrescriptionTransmission2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋeprescribingᚋcontractsᚋmodelᚐPrescriptionTransmission,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_TransmitPrescriptionPayload_transmission(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TransmitPrescriptionPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_PrescriptionTransmission_id(ctx, field)
+			case "prescriptionID":
+				return ec.fieldContext_PrescriptionTransmission_prescriptionID(ctx, field)
+			case "patientID":
+				return ec.fieldContext_PrescriptionTransmission_patientID(ctx, field)
+			case "pharmacyID":
+				return ec.fieldContext_PrescriptionTransmission_pharmacyID(ctx, field)
+			case "pharmacyName":
+				return ec.fieldContext_PrescriptionTransmission_pharmacyName(ctx, field)
+			case "status":
+				return ec.fieldContext_PrescriptionTransmission_status(ctx, field)
+			case "message":
+				return ec.fieldContext_PrescriptionTransmission_message(ctx, field)
+			case "attempts":
+				return ec.fieldContext_PrescriptionTransmission_attempts(ctx, field)
+			case "nextAttemptAt":
+				return ec.fieldContext_PrescriptionTransmission_nextAttemptAt(ctx, field)
+			case "lastError":
+				return ec.fieldContext_PrescriptionTransmission_lastError(ctx, field)
+			case "acknowledgments":
+				return ec.fieldContext_PrescriptionTransmission_acknowledgments(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_PrescriptionTransmission_createdBy(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_PrescriptionTransmission_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_PrescriptionTransmission_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PrescriptionTransmission", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TransmitPrescriptionPayload_userErrors(ctx context.Context, field graphql.CollectedField, obj *TransmitPrescriptionPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TransmitPrescriptionPayload_userErrors,
+		func(ctx context.Context) (any, error) {
+			return obj.UserErrors, nil
+		},
+		nil,
+		ec.marshalNUserError2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUserErrorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TransmitPrescriptionPayload_userErrors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TransmitPrescriptionPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_UserError_field(ctx, field)
+			case "code":
+				return ec.fieldContext_UserError_code(ctx, field)
+			case "message":
+				return ec.fieldContext_UserError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UpdateAddressPayload_address(ctx context.Context, field graphql.CollectedField, obj *UpdateAddressPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UpdateAddressPayload_address,
+		func(ctx context.Context) (any, error) {
+			return obj.Address, nil
+		},
+		nil,
+		ec.marshalOAddress2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐAddress,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_UpdateAddressPayload_address(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UpdateAddressPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Address_id(ctx, field)
+			case "patientID":
+				return ec.fieldContext_Address_patientID(ctx, field)
+			case "line1":
+				return ec.fieldContext_Address_line1(ctx, field)
+			case "line2":
+				return ec.fieldContext_Address_line2(ctx, field)
+			case "city":
+				return ec.fieldContext_Address_city(ctx, field)
+			case "state":
+				return ec.fieldContext_Address_state(ctx, field)
+			case "zip":
+				return ec.fieldContext_Address_zip(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Address", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UpdateAddressPayload_userErrors(ctx context.Context, field graphql.CollectedField, obj *UpdateAddressPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UpdateAddressPayload_userErrors,
+		func(ctx context.Context) (any, error) {
+			return obj.UserErrors, nil
+		},
+		nil,
+		ec.marshalNUserError2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUserErrorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UpdateAddressPayload_userErrors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UpdateAddressPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_UserError_field(ctx, field)
+			case "code":
+				return ec.fieldContext_UserError_code(ctx, field)
+			case "message":
+				return ec.fieldContext_UserError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UpdateAllergyPayload_allergy(ctx context.Context, field graphql.CollectedField, obj *UpdateAllergyPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UpdateAllergyPayload_allergy,
+		func(ctx context.Context) (any, error) {
+			return obj.Allergy, nil
+		},
+		nil,
+		ec.marshalOAllergy2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐAllergy,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_UpdateAllergyPayload_allergy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UpdateAllergyPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Allergy_id(ctx, field)
+			case "patientID":
+				return ec.fieldContext_Allergy_patientID(ctx, field)
+			case "substance":
+				return ec.fieldContext_Allergy_substance(ctx, field)
+			case "reaction":
+				return ec.fieldContext_Allergy_reaction(ctx, field)
+			case "severity":
+				return ec.fieldContext_Allergy_severity(ctx, field)
+			case "recordedAt":
+				return ec.fieldContext_Allergy_recordedAt(ctx, field)
+			case "recordedBy":
+				return ec.fieldContext_Allergy_recordedBy(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Allergy", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UpdateAllergyPayload_userErrors(ctx context.Context, field graphql.CollectedField, obj *UpdateAllergyPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UpdateAllergyPayload_userErrors,
+		func(ctx context.Context) (any, error) {
+			return obj.UserErrors, nil
+		},
+		nil,
+		ec.marshalNUserError2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUserErrorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UpdateAllergyPayload_userErrors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UpdateAllergyPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_UserError_field(ctx, field)
+			case "code":
+				return ec.fieldContext_UserError_code(ctx, field)
+			case "message":
+				return ec.fieldContext_UserError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UpdateMeasurementPayload_measurement(ctx context.Context, field graphql.CollectedField, obj *UpdateMeasurementPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UpdateMeasurementPayload_measurement,
+		func(ctx context.Context) (any, error) {
+			return obj.Measurement, nil
+		},
+		nil,
+		ec.marshalOMeasurement2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐMeasurement,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_UpdateMeasurementPayload_measurement(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UpdateMeasurementPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Measurement_id(ctx, field)
+			case "patientID":
+				return ec.fieldContext_Measurement_patientID(ctx, field)
+			case "type":
+				return ec.fieldContext_Measurement_type(ctx, field)
+			case "value":
+				return ec.fieldContext_Measurement_value(ctx, field)
+			case "unit":
+				return ec.fieldContext_Measurement_unit(ctx, field)
+			case "recordedAt":
+				return ec.fieldContext_Measurement_recordedAt(ctx, field)
+			case "recordedBy":
+				return ec.fieldContext_Measurement_recordedBy(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Measurement", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UpdateMeasurementPayload_userErrors(ctx context.Context, field graphql.CollectedField, obj *UpdateMeasurementPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UpdateMeasurementPayload_userErrors,
+		func(ctx context.Context) (any, error) {
+			return obj.UserErrors, nil
+		},
+		nil,
+		ec.marshalNUserError2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUserErrorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UpdateMeasurementPayload_userErrors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UpdateMeasurementPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_UserError_field(ctx, field)
+			case "code":
+				return ec.fieldContext_UserError_code(ctx, field)
+			case "message":
+				return ec.fieldContext_UserError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UpdatePatientPayload_patient(ctx context.Context, field graphql.CollectedField, obj *UpdatePatientPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UpdatePatientPayload_patient,
+		func(ctx context.Context) (any, error) {
+			return obj.Patient, nil
+		},
+		nil,
+		ec.marshalOPatient2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatient,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_UpdatePatientPayload_patient(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UpdatePatientPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Patient_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Patient_name(ctx, field)
+			case "dob":
+				return ec.fieldContext_Patient_dob(ctx, field)
+			case "phone":
+				return ec.fieldContext_Patient_phone(ctx, field)
+			case "state":
+				return ec.fieldContext_Patient_state(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Patient_createdAt(ctx, field)
+			case "addresses":
+				return ec.fieldContext_Patient_addresses(ctx, field)
+			case "measurements":
+				return ec.fieldContext_Patient_measurements(ctx, field)
+			case "latestMeasurement":
+				return ec.fieldContext_Patient_latestMeasurement(ctx, field)
+			case "contactPreferences":
+				return ec.fieldContext_Patient_contactPreferences(ctx, field)
+			case "allergies":
+				return ec.fieldContext_Patient_allergies(ctx, field)
+			case "insurance":
+				return ec.fieldContext_Patient_insurance(ctx, field)
+			case "prescriptions":
+				return ec.fieldContext_Patient_prescriptions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Patient", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UpdatePatientPayload_userErrors(ctx context.Context, field graphql.CollectedField, obj *UpdatePatientPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UpdatePatientPayload_userErrors,
+		func(ctx context.Context) (any, error) {
+			return obj.UserErrors, nil
+		},
+		nil,
+		ec.marshalNUserError2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUserErrorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UpdatePatientPayload_userErrors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UpdatePatientPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_UserError_field(ctx, field)
+			case "code":
+				return ec.fieldContext_UserError_code(ctx, field)
+			case "message":
+				return ec.fieldContext_UserError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UpdatePrescriberPayload_prescriber(ctx context.Context, field graphql.CollectedField, obj *UpdatePrescriberPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UpdatePrescriberPayload_prescriber,
+		func(ctx context.Context) (any, error) {
+			return obj.Prescriber, nil
+		},
+		nil,
+		ec.marshalOPrescriber2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescriber,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_UpdatePrescriberPayload_prescriber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UpdatePrescriberPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Prescriber_id(ctx, field)
+			case "npi":
+				return ec.fieldContext_Prescriber_npi(ctx, field)
+			case "firstName":
+				return ec.fieldContext_Prescriber_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_Prescriber_lastName(ctx, field)
+			case "credential":
+				return ec.fieldContext_Prescriber_credential(ctx, field)
+			case "specialty":
+				return ec.fieldContext_Prescriber_specialty(ctx, field)
+			case "phone":
+				return ec.fieldContext_Prescriber_phone(ctx, field)
+			case "fax":
+				return ec.fieldContext_Prescriber_fax(ctx, field)
+			case "email":
+				return ec.fieldContext_Prescriber_email(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Prescriber_displayName(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Prescriber_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Prescriber_updatedAt(ctx, field)
+			case "prescriptions":
+				return ec.fieldContext_Prescriber_prescriptions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Prescriber", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UpdatePrescriberPayload_userErrors(ctx context.Context, field graphql.CollectedField, obj *UpdatePrescriberPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UpdatePrescriberPayload_userErrors,
+		func(ctx context.Context) (any, error) {
+			return obj.UserErrors, nil
+		},
+		nil,
+		ec.marshalNUserError2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUserErrorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UpdatePrescriberPayload_userErrors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UpdatePrescriberPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_UserError_field(ctx, field)
+			case "code":
+				return ec.fieldContext_UserError_code(ctx, field)
+			case "message":
+				return ec.fieldContext_UserError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UpdatePrescriptionPayload_prescription(ctx context.Context, field graphql.CollectedField, obj *UpdatePrescriptionPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UpdatePrescriptionPayload_prescription,
+		func(ctx context.Context) (any, error) {
+			return obj.Prescription, nil
+		},
+		nil,
+		ec.marshalOPrescription2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescription,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_UpdatePrescriptionPayload_prescription(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UpdatePrescriptionPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Prescription_id(ctx, field)
+			case "patientID":
+				return ec.fieldContext_Prescription_patientID(ctx, field)
+			case "patient":
+				return ec.fieldContext_Prescription_patient(ctx, field)
+			case "prescriberID":
+				return ec.fieldContext_Prescription_prescriberID(ctx, field)
+			case "prescriber":
+				return ec.fieldContext_Prescription_prescriber(ctx, field)
+			case "drug":
+				return ec.fieldContext_Prescription_drug(ctx, field)
+			case "dose":
+				return ec.fieldContext_Prescription_dose(ctx, field)
+			case "dosage":
+				return ec.fieldContext_Prescription_dosage(ctx, field)
+			case "status":
+				return ec.fieldContext_Prescription_status(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Prescription_createdAt(ctx, field)
+			case "sig":
+				return ec.fieldContext_Prescription_sig(ctx, field)
+			case "directions":
+				return ec.fieldContext_Prescription_directions(ctx, field)
+			case "quantity":
+				return ec.fieldContext_Prescription_quantity(ctx, field)
+			case "daysSupply":
+				return ec.fieldContext_Prescription_daysSupply(ctx, field)
+			case "expectedEndDate":
+				return ec.fieldContext_Prescription_expectedEndDate(ctx, field)
+			case "interactionWarnings":
+				return ec.fieldContext_Prescription_interactionWarnings(ctx, field)
+			case "fulfillmentStatus":
+				return ec.fieldContext_Prescription_fulfillmentStatus(ctx, field)
+			case "fulfillmentUpdatedAt":
+				return ec.fieldContext_Prescription_fulfillmentUpdatedAt(ctx, field)
+			case "pharmacy":
+				return ec.fieldContext_Prescription_pharmacy(ctx, field)
+			case "history":
+				return ec.fieldContext_Prescription_history(ctx, field)
+			case "priceEstimate":
+				return ec.fieldContext_Prescription_priceEstimate(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Prescription", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UpdatePrescriptionPayload_userErrors(ctx context.Context, field graphql.CollectedField, obj *UpdatePrescriptionPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UpdatePrescriptionPayload_userErrors,
+		func(ctx context.Context) (any, error) {
+			return obj.UserErrors, nil
+		},
+		nil,
+		ec.marshalNUserError2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUserErrorᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UpdatePrescriptionPayload_userErrors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UpdatePrescriptionPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_UserError_field(ctx, field)
+			case "code":
+				return ec.fieldContext_UserError_code(ctx, field)
+			case "message":
+				return ec.fieldContext_UserError_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserError", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserError_field(ctx context.Context, field graphql.CollectedField, obj *UserError) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserError_field,
+		func(ctx context.Context) (any, error) {
+			return obj.Field, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserError_field(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserError",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserError_code(ctx context.Context, field graphql.CollectedField, obj *UserError) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserError_code,
+		func(ctx context.Context) (any, error) {
+			return obj.Code, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserError_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserError",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserError_message(ctx context.Context, field graphql.CollectedField, obj *UserError) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserError_message,
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserError",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) __Service_sdl(ctx context.Context, field graphql.CollectedField, obj *fedruntime.Service) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext__Service_sdl,
+		func(ctx context.Context) (any, error) {
+			return obj.SDL, nil
+		},
+		nil,
+		ec.marshalOString2string,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext__Service_sdl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "_Service",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_isRepeatable,
+		func(ctx context.Context) (any, error) {
+			return obj.IsRepeatable, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_locations,
+		func(ctx context.Context) (any, error) {
+			return obj.Locations, nil
+		},
+		nil,
+		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_defaultValue,
+		func(ctx context.Context) (any, error) {
+			return obj.DefaultValue, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_types,
+		func(ctx context.Context) (any, error) {
+			return obj.Types(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_queryType,
+		func(ctx context.Context) (any, error) {
+			return obj.QueryType(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_mutationType,
+		func(ctx context.Context) (any, error) {
+			return obj.MutationType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_subscriptionType,
+		func(ctx context.Context) (any, error) {
+			return obj.SubscriptionType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_directives,
+		func(ctx context.Context) (any, error) {
+			return obj.Directives(), nil
+		},
+		nil,
+		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_kind,
+		func(ctx context.Context) (any, error) {
+			return obj.Kind(), nil
+		},
+		nil,
+		ec.marshalN__TypeKind2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_specifiedByURL,
+		func(ctx context.Context) (any, error) {
+			return obj.SpecifiedByURL(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_fields,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_interfaces,
+		func(ctx context.Context) (any, error) {
+			return obj.Interfaces(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_possibleTypes,
+		func(ctx context.Context) (any, error) {
+			return obj.PossibleTypes(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_enumValues,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___EnumValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___EnumValue_description(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_inputFields,
+		func(ctx context.Context) (any, error) {
+			return obj.InputFields(), nil
+		},
+		nil,
+		ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_ofType,
+		func(ctx context.Context) (any, error) {
+			return obj.OfType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_isOneOf,
+		func(ctx context.Context) (any, error) {
+			return obj.IsOneOf(), nil
+		},
+		nil,
+		ec.marshalOBoolean2bool,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
-	return fc, nil
-}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputContactPreferencesInput(ctx context.Context, obj any) (ContactPreferencesInput, error) {
+	var it ContactPreferencesInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"channels", "email", "doNotContact"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "channels":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channels"))
+			data, err := ec.unmarshalNString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Channels = data
+		case "email":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Email = data
+		case "doNotContact":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("doNotContact"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DoNotContact = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateAddressInput(ctx context.Context, obj any) (CreateAddressInput, error) {
+	var it CreateAddressInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"line1", "line2", "city", "state", "zip"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "line1":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("line1"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Line1 = data
+		case "line2":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("line2"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Line2 = data
+		case "city":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("city"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.City = data
+		case "state":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("state"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.State = data
+		case "zip":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("zip"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Zip = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreatePatientInput(ctx context.Context, obj any) (CreatePatientInput, error) {
+	var it CreatePatientInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "dob", "phone", "state"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "dob":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dob"))
+			data, err := ec.unmarshalNPartialDate2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋplatformᚋdatesᚐPartialDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Dob = data
+		case "phone":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("phone"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Phone = data
+		case "state":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("state"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.State = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreatePrescriberInput(ctx context.Context, obj any) (CreatePrescriberInput, error) {
+	var it CreatePrescriberInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"npi", "firstName", "lastName", "credential", "specialty", "phone", "fax", "email"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "npi":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("npi"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Npi = data
+		case "firstName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("firstName"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FirstName = data
+		case "lastName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lastName"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.LastName = data
+		case "credential":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("credential"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Credential = data
+		case "specialty":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("specialty"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Specialty = data
+		case "phone":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("phone"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Phone = data
+		case "fax":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fax"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Fax = data
+		case "email":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Email = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreatePrescriptionInput(ctx context.Context, obj any) (CreatePrescriptionInput, error) {
+	var it CreatePrescriptionInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"patientID", "prescriberID", "drug", "dose", "dosage", "status", "sig", "sigText", "quantity", "daysSupply"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "patientID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("patientID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PatientID = data
+		case "prescriberID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("prescriberID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PrescriberID = data
+		case "drug":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("drug"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Drug = data
+		case "dose":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dose"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Dose = data
+		case "dosage":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dosage"))
+			data, err := ec.unmarshalODoseInput2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐDoseInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Dosage = data
+		case "status":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
+			data, err := ec.unmarshalNPrescriptionStatus2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐPrescriptionStatus(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Status = data
+		case "sig":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sig"))
+			data, err := ec.unmarshalOSigInput2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐSigInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Sig = data
+		case "sigText":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sigText"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SigText = data
+		case "quantity":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("quantity"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Quantity = data
+		case "daysSupply":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("daysSupply"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DaysSupply = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputDoseInput(ctx context.Context, obj any) (DoseInput, error) {
+	var it DoseInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"value", "unit", "frequency", "route"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "value":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Value = data
+		case "unit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("unit"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Unit = data
+		case "frequency":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("frequency"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Frequency = data
+		case "route":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("route"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Route = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRecordAllergyInput(ctx context.Context, obj any) (RecordAllergyInput, error) {
+	var it RecordAllergyInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"substance", "reaction", "severity"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "substance":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("substance"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Substance = data
+		case "reaction":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reaction"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Reaction = data
+		case "severity":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("severity"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Severity = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRecordMeasurementInput(ctx context.Context, obj any) (RecordMeasurementInput, error) {
+	var it RecordMeasurementInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"type", "value", "unit", "recordedAt"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "type":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Type = data
+		case "value":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			data, err := ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Value = data
+		case "unit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("unit"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Unit = data
+		case "recordedAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("recordedAt"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RecordedAt = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSigInput(ctx context.Context, obj any) (SigInput, error) {
+	var it SigInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"doseQuantity", "doseUnit", "route", "frequency", "timing", "asNeeded", "indication"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "doseQuantity":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("doseQuantity"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DoseQuantity = data
+		case "doseUnit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("doseUnit"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DoseUnit = data
+		case "route":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("route"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Route = data
+		case "frequency":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("frequency"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Frequency = data
+		case "timing":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("timing"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Timing = data
+		case "asNeeded":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("asNeeded"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AsNeeded = data
+		case "indication":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("indication"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Indication = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateAddressInput(ctx context.Context, obj any) (UpdateAddressInput, error) {
+	var it UpdateAddressInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"line1", "line2", "city", "state", "zip"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "line1":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("line1"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Line1 = data
+		case "line2":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("line2"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Line2 = data
+		case "city":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("city"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.City = data
+		case "state":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("state"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.State = data
+		case "zip":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("zip"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Zip = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateAllergyInput(ctx context.Context, obj any) (UpdateAllergyInput, error) {
+	var it UpdateAllergyInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"substance", "reaction", "severity"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "substance":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("substance"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Substance = data
+		case "reaction":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reaction"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Reaction = data
+		case "severity":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("severity"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Severity = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateMeasurementInput(ctx context.Context, obj any) (UpdateMeasurementInput, error) {
+	var it UpdateMeasurementInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"value", "unit", "recordedAt"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "value":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Value = data
+		case "unit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("unit"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Unit = data
+		case "recordedAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("recordedAt"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RecordedAt = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdatePatientInput(ctx context.Context, obj any) (UpdatePatientInput, error) {
+	var it UpdatePatientInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "dob", "phone", "state", "contactPreferences"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "dob":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dob"))
+			data, err := ec.unmarshalOPartialDate2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋplatformᚋdatesᚐPartialDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Dob = data
+		case "phone":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("phone"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Phone = data
+		case "state":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("state"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.State = data
+		case "contactPreferences":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("contactPreferences"))
+			data, err := ec.unmarshalOContactPreferencesInput2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐContactPreferencesInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ContactPreferences = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdatePrescriberInput(ctx context.Context, obj any) (UpdatePrescriberInput, error) {
+	var it UpdatePrescriberInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"npi", "firstName", "lastName", "credential", "specialty", "phone", "fax", "email"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "npi":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("npi"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Npi = data
+		case "firstName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("firstName"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FirstName = data
+		case "lastName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lastName"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.LastName = data
+		case "credential":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("credential"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Credential = data
+		case "specialty":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("specialty"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Specialty = data
+		case "phone":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("phone"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Phone = data
+		case "fax":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fax"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Fax = data
+		case "email":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Email = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdatePrescriptionInput(ctx context.Context, obj any) (UpdatePrescriptionInput, error) {
+	var it UpdatePrescriptionInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"drug", "dose", "dosage", "status", "sig", "sigText", "quantity", "daysSupply"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "drug":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("drug"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Drug = data
+		case "dose":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dose"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Dose = data
+		case "dosage":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dosage"))
+			data, err := ec.unmarshalODoseInput2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐDoseInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Dosage = data
+		case "status":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
+			data, err := ec.unmarshalOPrescriptionStatus2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐPrescriptionStatus(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Status = data
+		case "sig":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sig"))
+			data, err := ec.unmarshalOSigInput2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐSigInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Sig = data
+		case "sigText":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sigText"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SigText = data
+		case "quantity":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("quantity"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Quantity = data
+		case "daysSupply":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("daysSupply"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DaysSupply = data
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+func (ec *executionContext) __Entity(ctx context.Context, sel ast.SelectionSet, obj fedruntime.Entity) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model1.Prescription:
+		return ec._Prescription(ctx, sel, &obj)
+	case *model1.Prescription:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Prescription(ctx, sel, obj)
+	case model.Patient:
+		return ec._Patient(ctx, sel, &obj)
+	case *model.Patient:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Patient(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var addressImplementors = []string{"Address"}
+
+func (ec *executionContext) _Address(ctx context.Context, sel ast.SelectionSet, obj *model.Address) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, addressImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Address")
+		case "id":
+			out.Values[i] = ec._Address_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "patientID":
+			out.Values[i] = ec._Address_patientID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "line1":
+			out.Values[i] = ec._Address_line1(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "line2":
+			out.Values[i] = ec._Address_line2(ctx, field, obj)
+		case "city":
+			out.Values[i] = ec._Address_city(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "state":
+			out.Values[i] = ec._Address_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "zip":
+			out.Values[i] = ec._Address_zip(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var adherenceReportImplementors = []string{"AdherenceReport"}
+
+func (ec *executionContext) _AdherenceReport(ctx context.Context, sel ast.SelectionSet, obj *model4.AdherenceReport) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, adherenceReportImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AdherenceReport")
+		case "patientID":
+			out.Values[i] = ec._AdherenceReport_patientID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "activePrescriptions":
+			out.Values[i] = ec._AdherenceReport_activePrescriptions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "completedPrescriptions":
+			out.Values[i] = ec._AdherenceReport_completedPrescriptions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "refills":
+			out.Values[i] = ec._AdherenceReport_refills(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "averageDaysBetweenRefills":
+			out.Values[i] = ec._AdherenceReport_averageDaysBetweenRefills(ctx, field, obj)
+		case "proportionOfDaysCovered":
+			out.Values[i] = ec._AdherenceReport_proportionOfDaysCovered(ctx, field, obj)
+		case "prescriptions":
+			out.Values[i] = ec._AdherenceReport_prescriptions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "history":
+			out.Values[i] = ec._AdherenceReport_history(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "generatedAt":
+			out.Values[i] = ec._AdherenceReport_generatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var adherenceSnapshotImplementors = []string{"AdherenceSnapshot"}
+
+func (ec *executionContext) _AdherenceSnapshot(ctx context.Context, sel ast.SelectionSet, obj *model4.AdherenceSnapshot) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, adherenceSnapshotImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AdherenceSnapshot")
+		case "patientID":
+			out.Values[i] = ec._AdherenceSnapshot_patientID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "date":
+			out.Values[i] = ec._AdherenceSnapshot_date(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "activePrescriptions":
+			out.Values[i] = ec._AdherenceSnapshot_activePrescriptions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "completedPrescriptions":
+			out.Values[i] = ec._AdherenceSnapshot_completedPrescriptions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "refills":
+			out.Values[i] = ec._AdherenceSnapshot_refills(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "averageDaysBetweenRefills":
+			out.Values[i] = ec._AdherenceSnapshot_averageDaysBetweenRefills(ctx, field, obj)
+		case "proportionOfDaysCovered":
+			out.Values[i] = ec._AdherenceSnapshot_proportionOfDaysCovered(ctx, field, obj)
+		case "takenAt":
+			out.Values[i] = ec._AdherenceSnapshot_takenAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var allergyImplementors = []string{"Allergy"}
+
+func (ec *executionContext) _Allergy(ctx context.Context, sel ast.SelectionSet, obj *model.Allergy) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, allergyImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Allergy")
+		case "id":
+			out.Values[i] = ec._Allergy_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "patientID":
+			out.Values[i] = ec._Allergy_patientID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "substance":
+			out.Values[i] = ec._Allergy_substance(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "reaction":
+			out.Values[i] = ec._Allergy_reaction(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "severity":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Allergy_severity(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "recordedAt":
+			out.Values[i] = ec._Allergy_recordedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "recordedBy":
+			out.Values[i] = ec._Allergy_recordedBy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var contactPreferencesImplementors = []string{"ContactPreferences"}
+
+func (ec *executionContext) _ContactPreferences(ctx context.Context, sel ast.SelectionSet, obj *model.ContactPreferences) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, contactPreferencesImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ContactPreferences")
+		case "channels":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ContactPreferences_channels(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "email":
+			out.Values[i] = ec._ContactPreferences_email(ctx, field, obj)
+		case "doNotContact":
+			out.Values[i] = ec._ContactPreferences_doNotContact(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var createAddressPayloadImplementors = []string{"CreateAddressPayload"}
+
+func (ec *executionContext) _CreateAddressPayload(ctx context.Context, sel ast.SelectionSet, obj *CreateAddressPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, createAddressPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CreateAddressPayload")
+		case "address":
+			out.Values[i] = ec._CreateAddressPayload_address(ctx, field, obj)
+		case "userErrors":
+			out.Values[i] = ec._CreateAddressPayload_userErrors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var createPatientPayloadImplementors = []string{"CreatePatientPayload"}
+
+func (ec *executionContext) _CreatePatientPayload(ctx context.Context, sel ast.SelectionSet, obj *CreatePatientPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, createPatientPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CreatePatientPayload")
+		case "patient":
+			out.Values[i] = ec._CreatePatientPayload_patient(ctx, field, obj)
+		case "potentialDuplicates":
+			out.Values[i] = ec._CreatePatientPayload_potentialDuplicates(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "userErrors":
+			out.Values[i] = ec._CreatePatientPayload_userErrors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var createPrescriberPayloadImplementors = []string{"CreatePrescriberPayload"}
+
+func (ec *executionContext) _CreatePrescriberPayload(ctx context.Context, sel ast.SelectionSet, obj *CreatePrescriberPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, createPrescriberPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CreatePrescriberPayload")
+		case "prescriber":
+			out.Values[i] = ec._CreatePrescriberPayload_prescriber(ctx, field, obj)
+		case "userErrors":
+			out.Values[i] = ec._CreatePrescriberPayload_userErrors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var createPrescriptionPayloadImplementors = []string{"CreatePrescriptionPayload"}
+
+func (ec *executionContext) _CreatePrescriptionPayload(ctx context.Context, sel ast.SelectionSet, obj *CreatePrescriptionPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, createPrescriptionPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CreatePrescriptionPayload")
+		case "prescription":
+			out.Values[i] = ec._CreatePrescriptionPayload_prescription(ctx, field, obj)
+		case "userErrors":
+			out.Values[i] = ec._CreatePrescriptionPayload_userErrors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var dashboardStatsImplementors = []string{"DashboardStats"}
+
+func (ec *executionContext) _DashboardStats(ctx context.Context, sel ast.SelectionSet, obj *DashboardStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dashboardStatsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DashboardStats")
+		case "totalPatients":
+			out.Values[i] = ec._DashboardStats_totalPatients(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "activePrescriptions":
+			out.Values[i] = ec._DashboardStats_activePrescriptions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "patientsByState":
+			out.Values[i] = ec._DashboardStats_patientsByState(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "prescriptionsByStatus":
+			out.Values[i] = ec._DashboardStats_prescriptionsByStatus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var deleteAddressPayloadImplementors = []string{"DeleteAddressPayload"}
+
+func (ec *executionContext) _DeleteAddressPayload(ctx context.Context, sel ast.SelectionSet, obj *DeleteAddressPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, deleteAddressPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DeleteAddressPayload")
+		case "deletedID":
+			out.Values[i] = ec._DeleteAddressPayload_deletedID(ctx, field, obj)
+		case "userErrors":
+			out.Values[i] = ec._DeleteAddressPayload_userErrors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var deleteAllergyPayloadImplementors = []string{"DeleteAllergyPayload"}
+
+func (ec *executionContext) _DeleteAllergyPayload(ctx context.Context, sel ast.SelectionSet, obj *DeleteAllergyPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, deleteAllergyPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DeleteAllergyPayload")
+		case "deletedID":
+			out.Values[i] = ec._DeleteAllergyPayload_deletedID(ctx, field, obj)
+		case "userErrors":
+			out.Values[i] = ec._DeleteAllergyPayload_userErrors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var deleteMeasurementPayloadImplementors = []string{"DeleteMeasurementPayload"}
+
+func (ec *executionContext) _DeleteMeasurementPayload(ctx context.Context, sel ast.SelectionSet, obj *DeleteMeasurementPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, deleteMeasurementPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DeleteMeasurementPayload")
+		case "deletedID":
+			out.Values[i] = ec._DeleteMeasurementPayload_deletedID(ctx, field, obj)
+		case "userErrors":
+			out.Values[i] = ec._DeleteMeasurementPayload_userErrors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var deletePrescriberPayloadImplementors = []string{"DeletePrescriberPayload"}
+
+func (ec *executionContext) _DeletePrescriberPayload(ctx context.Context, sel ast.SelectionSet, obj *DeletePrescriberPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, deletePrescriberPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DeletePrescriberPayload")
+		case "deletedID":
+			out.Values[i] = ec._DeletePrescriberPayload_deletedID(ctx, field, obj)
+		case "userErrors":
+			out.Values[i] = ec._DeletePrescriberPayload_userErrors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var doseImplementors = []string{"Dose"}
+
+func (ec *executionContext) _Dose(ctx context.Context, sel ast.SelectionSet, obj *model1.Dose) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, doseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Dose")
+		case "value":
+			out.Values[i] = ec._Dose_value(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "unit":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Dose_unit(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "frequency":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Dose_frequency(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "route":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Dose_route(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var drugAllergyWarningImplementors = []string{"DrugAllergyWarning"}
+
+func (ec *executionContext) _DrugAllergyWarning(ctx context.Context, sel ast.SelectionSet, obj *model1.DrugAllergyWarning) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, drugAllergyWarningImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DrugAllergyWarning")
+		case "drug":
+			out.Values[i] = ec._DrugAllergyWarning_drug(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "allergyID":
+			out.Values[i] = ec._DrugAllergyWarning_allergyID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "substance":
+			out.Values[i] = ec._DrugAllergyWarning_substance(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reaction":
+			out.Values[i] = ec._DrugAllergyWarning_reaction(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "severity":
+			out.Values[i] = ec._DrugAllergyWarning_severity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var drugInteractionWarningImplementors = []string{"DrugInteractionWarning"}
+
+func (ec *executionContext) _DrugInteractionWarning(ctx context.Context, sel ast.SelectionSet, obj *model1.DrugInteractionWarning) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, drugInteractionWarningImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DrugInteractionWarning")
+		case "drug":
+			out.Values[i] = ec._DrugInteractionWarning_drug(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "interactingDrug":
+			out.Values[i] = ec._DrugInteractionWarning_interactingDrug(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "interactingPrescriptionID":
+			out.Values[i] = ec._DrugInteractionWarning_interactingPrescriptionID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "severity":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DrugInteractionWarning_severity(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "description":
+			out.Values[i] = ec._DrugInteractionWarning_description(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var entityImplementors = []string{"Entity"}
+
+func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, entityImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Entity",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Entity")
+		case "findPatientByID":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Entity_findPatientByID(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "findPrescriptionByID":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Entity_findPrescriptionByID(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var insuranceRecordImplementors = []string{"InsuranceRecord"}
+
+func (ec *executionContext) _InsuranceRecord(ctx context.Context, sel ast.SelectionSet, obj *model.InsuranceRecord) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, insuranceRecordImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InsuranceRecord")
+		case "id":
+			out.Values[i] = ec._InsuranceRecord_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "patientID":
+			out.Values[i] = ec._InsuranceRecord_patientID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "payerName":
+			out.Values[i] = ec._InsuranceRecord_payerName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "memberID":
+			out.Values[i] = ec._InsuranceRecord_memberID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "groupNumber":
+			out.Values[i] = ec._InsuranceRecord_groupNumber(ctx, field, obj)
+		case "memberName":
+			out.Values[i] = ec._InsuranceRecord_memberName(ctx, field, obj)
+		case "rxBIN":
+			out.Values[i] = ec._InsuranceRecord_rxBIN(ctx, field, obj)
+		case "rxPCN":
+			out.Values[i] = ec._InsuranceRecord_rxPCN(ctx, field, obj)
+		case "status":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._InsuranceRecord_status(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "effectiveDate":
+			out.Values[i] = ec._InsuranceRecord_effectiveDate(ctx, field, obj)
+		case "expiryDate":
+			out.Values[i] = ec._InsuranceRecord_expiryDate(ctx, field, obj)
+		case "active":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._InsuranceRecord_active(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var interactionCheckResultImplementors = []string{"InteractionCheckResult"}
+
+func (ec *executionContext) _InteractionCheckResult(ctx context.Context, sel ast.SelectionSet, obj *model1.InteractionCheckResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, interactionCheckResultImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InteractionCheckResult")
+		case "warnings":
+			out.Values[i] = ec._InteractionCheckResult_warnings(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "allergyWarnings":
+			out.Values[i] = ec._InteractionCheckResult_allergyWarnings(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "blocked":
+			out.Values[i] = ec._InteractionCheckResult_blocked(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var invoiceImplementors = []string{"Invoice"}
+
+func (ec *executionContext) _Invoice(ctx context.Context, sel ast.SelectionSet, obj *model2.Invoice) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invoiceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Invoice")
+		case "id":
+			out.Values[i] = ec._Invoice_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "prescriptionID":
+			out.Values[i] = ec._Invoice_prescriptionID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "patientID":
+			out.Values[i] = ec._Invoice_patientID(ctx, field, obj)
+		case "amount":
+			out.Values[i] = ec._Invoice_amount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._Invoice_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._Invoice_createdAt(ctx, field, obj)
+		case "updatedAt":
+			out.Values[i] = ec._Invoice_updatedAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var measurementImplementors = []string{"Measurement"}
+
+func (ec *executionContext) _Measurement(ctx context.Context, sel ast.SelectionSet, obj *model.Measurement) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, measurementImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Measurement")
+		case "id":
+			out.Values[i] = ec._Measurement_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "patientID":
+			out.Values[i] = ec._Measurement_patientID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "type":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Measurement_type(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "value":
+			out.Values[i] = ec._Measurement_value(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "unit":
+			out.Values[i] = ec._Measurement_unit(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "recordedAt":
+			out.Values[i] = ec._Measurement_recordedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "recordedBy":
+			out.Values[i] = ec._Measurement_recordedBy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var mutationImplementors = []string{"Mutation"}
+
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "_empty":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation__empty(ctx, field)
+			})
+		case "createInvoiceForPrescription":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createInvoiceForPrescription(ctx, field)
+			})
+		case "acknowledgeInvoice":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_acknowledgeInvoice(ctx, field)
+			})
+		case "transmitPrescription":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_transmitPrescription(ctx, field)
+			})
+		case "createPatient":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createPatient(ctx, field)
+			})
+		case "updatePatient":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updatePatient(ctx, field)
+			})
+		case "createAddress":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createAddress(ctx, field)
+			})
+		case "updateAddress":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateAddress(ctx, field)
+			})
+		case "deleteAddress":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteAddress(ctx, field)
+			})
+		case "recordMeasurement":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_recordMeasurement(ctx, field)
+			})
+		case "updateMeasurement":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateMeasurement(ctx, field)
+			})
+		case "deleteMeasurement":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteMeasurement(ctx, field)
+			})
+		case "recordAllergy":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_recordAllergy(ctx, field)
+			})
+		case "updateAllergy":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateAllergy(ctx, field)
+			})
+		case "deleteAllergy":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteAllergy(ctx, field)
+			})
+		case "createPrescription":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createPrescription(ctx, field)
+			})
+		case "updatePrescription":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updatePrescription(ctx, field)
+			})
+		case "createPrescriber":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createPrescriber(ctx, field)
+			})
+		case "updatePrescriber":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updatePrescriber(ctx, field)
+			})
+		case "deletePrescriber":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deletePrescriber(ctx, field)
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var patientImplementors = []string{"Patient", "_Entity"}
+
+func (ec *executionContext) _Patient(ctx context.Context, sel ast.SelectionSet, obj *model.Patient) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, patientImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Patient")
+		case "id":
+			out.Values[i] = ec._Patient_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "name":
+			out.Values[i] = ec._Patient_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "dob":
+			out.Values[i] = ec._Patient_dob(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "phone":
+			out.Values[i] = ec._Patient_phone(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "state":
+			out.Values[i] = ec._Patient_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "createdAt":
+			out.Values[i] = ec._Patient_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "addresses":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Patient_addresses(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "measurements":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Patient_measurements(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "latestMeasurement":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Patient_latestMeasurement(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "contactPreferences":
+			out.Values[i] = ec._Patient_contactPreferences(ctx, field, obj)
+		case "allergies":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Patient_allergies(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "insurance":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Patient_insurance(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "prescriptions":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Patient_prescriptions(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var patientSearchMatchImplementors = []string{"PatientSearchMatch"}
+
+func (ec *executionContext) _PatientSearchMatch(ctx context.Context, sel ast.SelectionSet, obj *model.PatientSearchMatch) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, patientSearchMatchImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PatientSearchMatch")
+		case "field":
+			out.Values[i] = ec._PatientSearchMatch_field(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "value":
+			out.Values[i] = ec._PatientSearchMatch_value(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "highlighted":
+			out.Values[i] = ec._PatientSearchMatch_highlighted(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var patientSearchResultImplementors = []string{"PatientSearchResult"}
+
+func (ec *executionContext) _PatientSearchResult(ctx context.Context, sel ast.SelectionSet, obj *model.PatientSearchResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, patientSearchResultImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PatientSearchResult")
+		case "patient":
+			out.Values[i] = ec._PatientSearchResult_patient(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "score":
+			out.Values[i] = ec._PatientSearchResult_score(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "matchType":
+			out.Values[i] = ec._PatientSearchResult_matchType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "matches":
+			out.Values[i] = ec._PatientSearchResult_matches(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var pharmacyImplementors = []string{"Pharmacy"}
+
+func (ec *executionContext) _Pharmacy(ctx context.Context, sel ast.SelectionSet, obj *model1.Pharmacy) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pharmacyImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Pharmacy")
+		case "id":
+			out.Values[i] = ec._Pharmacy_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._Pharmacy_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "type":
+			out.Values[i] = ec._Pharmacy_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "address":
+			out.Values[i] = ec._Pharmacy_address(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "city":
+			out.Values[i] = ec._Pharmacy_city(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "state":
+			out.Values[i] = ec._Pharmacy_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "zip":
+			out.Values[i] = ec._Pharmacy_zip(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "phone":
+			out.Values[i] = ec._Pharmacy_phone(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "routedAt":
+			out.Values[i] = ec._Pharmacy_routedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var potentialDuplicateImplementors = []string{"PotentialDuplicate"}
+
+func (ec *executionContext) _PotentialDuplicate(ctx context.Context, sel ast.SelectionSet, obj *model.PotentialDuplicate) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, potentialDuplicateImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PotentialDuplicate")
+		case "patient":
+			out.Values[i] = ec._PotentialDuplicate_patient(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reasons":
+			out.Values[i] = ec._PotentialDuplicate_reasons(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var prescriberImplementors = []string{"Prescriber"}
+
+func (ec *executionContext) _Prescriber(ctx context.Context, sel ast.SelectionSet, obj *model1.Prescriber) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, prescriberImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Prescriber")
+		case "id":
+			out.Values[i] = ec._Prescriber_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "npi":
+			out.Values[i] = ec._Prescriber_npi(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "firstName":
+			out.Values[i] = ec._Prescriber_firstName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "lastName":
+			out.Values[i] = ec._Prescriber_lastName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "credential":
+			out.Values[i] = ec._Prescriber_credential(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "specialty":
+			out.Values[i] = ec._Prescriber_specialty(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "phone":
+			out.Values[i] = ec._Prescriber_phone(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "fax":
+			out.Values[i] = ec._Prescriber_fax(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "email":
+			out.Values[i] = ec._Prescriber_email(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "displayName":
+			out.Values[i] = ec._Prescriber_displayName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "createdAt":
+			out.Values[i] = ec._Prescriber_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "updatedAt":
+			out.Values[i] = ec._Prescriber_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "prescriptions":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Prescriber_prescriptions(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var prescriptionImplementors = []string{"Prescription", "_Entity"}
+
+func (ec *executionContext) _Prescription(ctx context.Context, sel ast.SelectionSet, obj *model1.Prescription) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, prescriptionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Prescription")
+		case "id":
+			out.Values[i] = ec._Prescription_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "patientID":
+			out.Values[i] = ec._Prescription_patientID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "patient":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Prescription_patient(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "prescriberID":
+			out.Values[i] = ec._Prescription_prescriberID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "prescriber":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Prescription_prescriber(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "drug":
+			out.Values[i] = ec._Prescription_drug(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "dose":
+			out.Values[i] = ec._Prescription_dose(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "dosage":
+			out.Values[i] = ec._Prescription_dosage(ctx, field, obj)
+		case "status":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Prescription_status(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "createdAt":
+			out.Values[i] = ec._Prescription_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "sig":
+			out.Values[i] = ec._Prescription_sig(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "directions":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Prescription_directions(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "quantity":
+			out.Values[i] = ec._Prescription_quantity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "daysSupply":
+			out.Values[i] = ec._Prescription_daysSupply(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "expectedEndDate":
+			out.Values[i] = ec._Prescription_expectedEndDate(ctx, field, obj)
+		case "interactionWarnings":
+			out.Values[i] = ec._Prescription_interactionWarnings(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "fulfillmentStatus":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Prescription_fulfillmentStatus(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "fulfillmentUpdatedAt":
+			out.Values[i] = ec._Prescription_fulfillmentUpdatedAt(ctx, field, obj)
+		case "pharmacy":
+			out.Values[i] = ec._Prescription_pharmacy(ctx, field, obj)
+		case "history":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Prescription_history(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "priceEstimate":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Prescription_priceEstimate(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var prescriptionAdherenceImplementors = []string{"PrescriptionAdherence"}
+
+func (ec *executionContext) _PrescriptionAdherence(ctx context.Context, sel ast.SelectionSet, obj *model4.PrescriptionAdherence) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, prescriptionAdherenceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PrescriptionAdherence")
+		case "prescriptionID":
+			out.Values[i] = ec._PrescriptionAdherence_prescriptionID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "drug":
+			out.Values[i] = ec._PrescriptionAdherence_drug(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._PrescriptionAdherence_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dispenses":
+			out.Values[i] = ec._PrescriptionAdherence_dispenses(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "lastDispensedAt":
+			out.Values[i] = ec._PrescriptionAdherence_lastDispensedAt(ctx, field, obj)
+		case "averageDaysBetweenRefills":
+			out.Values[i] = ec._PrescriptionAdherence_averageDaysBetweenRefills(ctx, field, obj)
+		case "proportionOfDaysCovered":
+			out.Values[i] = ec._PrescriptionAdherence_proportionOfDaysCovered(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var prescriptionHistoryConnectionImplementors = []string{"PrescriptionHistoryConnection"}
+
+func (ec *executionContext) _PrescriptionHistoryConnection(ctx context.Context, sel ast.SelectionSet, obj *model1.PrescriptionHistoryConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, prescriptionHistoryConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PrescriptionHistoryConnection")
+		case "events":
+			out.Values[i] = ec._PrescriptionHistoryConnection_events(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "endCursor":
+			out.Values[i] = ec._PrescriptionHistoryConnection_endCursor(ctx, field, obj)
+		case "hasNextPage":
+			out.Values[i] = ec._PrescriptionHistoryConnection_hasNextPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var prescriptionHistoryEventImplementors = []string{"PrescriptionHistoryEvent"}
+
+func (ec *executionContext) _PrescriptionHistoryEvent(ctx context.Context, sel ast.SelectionSet, obj *model1.PrescriptionHistoryEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, prescriptionHistoryEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PrescriptionHistoryEvent")
+		case "id":
+			out.Values[i] = ec._PrescriptionHistoryEvent_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "type":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._PrescriptionHistoryEvent_type(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "at":
+			out.Values[i] = ec._PrescriptionHistoryEvent_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "actor":
+			out.Values[i] = ec._PrescriptionHistoryEvent_actor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "reason":
+			out.Values[i] = ec._PrescriptionHistoryEvent_reason(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "fromStatus":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._PrescriptionHistoryEvent_fromStatus(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "toStatus":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._PrescriptionHistoryEvent_toStatus(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "dispenseID":
+			out.Values[i] = ec._PrescriptionHistoryEvent_dispenseID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "pharmacyID":
+			out.Values[i] = ec._PrescriptionHistoryEvent_pharmacyID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "pharmacyName":
+			out.Values[i] = ec._PrescriptionHistoryEvent_pharmacyName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "previousPharmacyID":
+			out.Values[i] = ec._PrescriptionHistoryEvent_previousPharmacyID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var prescriptionTransmissionImplementors = []string{"PrescriptionTransmission"}
+
+func (ec *executionContext) _PrescriptionTransmission(ctx context.Context, sel ast.SelectionSet, obj *model3.PrescriptionTransmission) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, prescriptionTransmissionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PrescriptionTransmission")
+		case "id":
+			out.Values[i] = ec._PrescriptionTransmission_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "prescriptionID":
+			out.Values[i] = ec._PrescriptionTransmission_prescriptionID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "patientID":
+			out.Values[i] = ec._PrescriptionTransmission_patientID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "pharmacyID":
+			out.Values[i] = ec._PrescriptionTransmission_pharmacyID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "pharmacyName":
+			out.Values[i] = ec._PrescriptionTransmission_pharmacyName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "status":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._PrescriptionTransmission_status(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "message":
+			out.Values[i] = ec._PrescriptionTransmission_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "attempts":
+			out.Values[i] = ec._PrescriptionTransmission_attempts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "nextAttemptAt":
+			out.Values[i] = ec._PrescriptionTransmission_nextAttemptAt(ctx, field, obj)
+		case "lastError":
+			out.Values[i] = ec._PrescriptionTransmission_lastError(ctx, field, obj)
+		case "acknowledgments":
+			out.Values[i] = ec._PrescriptionTransmission_acknowledgments(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "createdBy":
+			out.Values[i] = ec._PrescriptionTransmission_createdBy(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._PrescriptionTransmission_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "updatedAt":
+			out.Values[i] = ec._PrescriptionTransmission_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var priceEstimateImplementors = []string{"PriceEstimate"}
+
+func (ec *executionContext) _PriceEstimate(ctx context.Context, sel ast.SelectionSet, obj *model2.PriceEstimate) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, priceEstimateImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PriceEstimate")
+		case "prescriptionID":
+			out.Values[i] = ec._PriceEstimate_prescriptionID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "drug":
+			out.Values[i] = ec._PriceEstimate_drug(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "quantity":
+			out.Values[i] = ec._PriceEstimate_quantity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "unitPrice":
+			out.Values[i] = ec._PriceEstimate_unitPrice(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "ingredientCost":
+			out.Values[i] = ec._PriceEstimate_ingredientCost(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dispensingFee":
+			out.Values[i] = ec._PriceEstimate_dispensingFee(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "total":
+			out.Values[i] = ec._PriceEstimate_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "currency":
+			out.Values[i] = ec._PriceEstimate_currency(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "source":
+			out.Values[i] = ec._PriceEstimate_source(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "estimatedAt":
+			out.Values[i] = ec._PriceEstimate_estimatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) _Address_city(ctx context.Context, field graphql.CollectedField, obj *model.Address) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Address_city,
-		func(ctx context.Context) (any, error) {
-			return obj.City, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext_Address_city(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Address",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Address_state(ctx context.Context, field graphql.CollectedField, obj *model.Address) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Address_state,
-		func(ctx context.Context) (any, error) {
-			return obj.State, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_Address_state(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Address",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var queryImplementors = []string{"Query"}
+
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "_empty":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query__empty(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "invoicesByPatient":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_invoicesByPatient(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "dashboardStats":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_dashboardStats(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "prescriptionTransmission":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_prescriptionTransmission(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "prescriptionTransmissions":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_prescriptionTransmissions(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "patients":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_patients(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "patientCountByState":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_patientCountByState(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "searchPatients":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_searchPatients(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "prescriptionCountByStatus":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_prescriptionCountByStatus(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "checkDrugInteractions":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_checkDrugInteractions(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "prescriber":
+			field := field
 
-func (ec *executionContext) _Address_zip(ctx context.Context, field graphql.CollectedField, obj *model.Address) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Address_zip,
-		func(ctx context.Context) (any, error) {
-			return obj.Zip, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_prescriber(ctx, field)
+				return res
+			}
 
-func (ec *executionContext) fieldContext_Address_zip(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Address",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) _DashboardStats_totalPatients(ctx context.Context, field graphql.CollectedField, obj *DashboardStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_DashboardStats_totalPatients,
-		func(ctx context.Context) (any, error) {
-			return obj.TotalPatients, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "prescribers":
+			field := field
 
-func (ec *executionContext) fieldContext_DashboardStats_totalPatients(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DashboardStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_prescribers(ctx, field)
+				return res
+			}
 
-func (ec *executionContext) _DashboardStats_activePrescriptions(ctx context.Context, field graphql.CollectedField, obj *DashboardStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_DashboardStats_activePrescriptions,
-		func(ctx context.Context) (any, error) {
-			return obj.ActivePrescriptions, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext_DashboardStats_activePrescriptions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DashboardStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "adherenceReport":
+			field := field
 
-func (ec *executionContext) _Mutation__empty(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation__empty,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Mutation().Empty(ctx)
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_adherenceReport(ctx, field)
+				return res
+			}
 
-func (ec *executionContext) fieldContext_Mutation__empty(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) _Mutation_createPatient(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation_createPatient,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CreatePatient(ctx, fc.Args["input"].(CreatePatientInput))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "_entities":
+			field := field
 
-			directive1 := func(ctx context.Context) (any, error) {
-				if ec.directives.Auth == nil {
-					var zeroVal *model.Patient
-					return zeroVal, errors.New("directive auth is not implemented")
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query__entities(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
 				}
-				return ec.directives.Auth(ctx, nil, directive0)
+				return res
 			}
-			directive2 := func(ctx context.Context) (any, error) {
-				requires, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"patient:write", "admin:all"})
-				if err != nil {
-					var zeroVal *model.Patient
-					return zeroVal, err
-				}
-				if ec.directives.PermissionAny == nil {
-					var zeroVal *model.Patient
-					return zeroVal, errors.New("directive permissionAny is not implemented")
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "_service":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query__service(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
 				}
-				return ec.directives.PermissionAny(ctx, nil, directive1, requires)
+				return res
 			}
 
-			next = directive2
-			return next
-		},
-		ec.marshalOPatient2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatient,
-		true,
-		false,
-	)
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "__type":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___type(ctx, field)
+			})
+		case "__schema":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___schema(ctx, field)
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) fieldContext_Mutation_createPatient(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Patient_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Patient_name(ctx, field)
-			case "dob":
-				return ec.fieldContext_Patient_dob(ctx, field)
-			case "phone":
-				return ec.fieldContext_Patient_phone(ctx, field)
-			case "state":
-				return ec.fieldContext_Patient_state(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Patient_createdAt(ctx, field)
-			case "addresses":
-				return ec.fieldContext_Patient_addresses(ctx, field)
-			case "prescriptions":
-				return ec.fieldContext_Patient_prescriptions(ctx, field)
+var recordAllergyPayloadImplementors = []string{"RecordAllergyPayload"}
+
+func (ec *executionContext) _RecordAllergyPayload(ctx context.Context, sel ast.SelectionSet, obj *RecordAllergyPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, recordAllergyPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RecordAllergyPayload")
+		case "allergy":
+			out.Values[i] = ec._RecordAllergyPayload_allergy(ctx, field, obj)
+		case "userErrors":
+			out.Values[i] = ec._RecordAllergyPayload_userErrors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Patient", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createPatient_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) _Mutation_updatePatient(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation_updatePatient,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdatePatient(ctx, fc.Args["id"].(string), fc.Args["input"].(UpdatePatientInput))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-			directive1 := func(ctx context.Context) (any, error) {
-				if ec.directives.Auth == nil {
-					var zeroVal *model.Patient
-					return zeroVal, errors.New("directive auth is not implemented")
-				}
-				return ec.directives.Auth(ctx, nil, directive0)
-			}
-			directive2 := func(ctx context.Context) (any, error) {
-				requires, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"patient:write", "admin:all"})
-				if err != nil {
-					var zeroVal *model.Patient
-					return zeroVal, err
-				}
-				if ec.directives.PermissionAny == nil {
-					var zeroVal *model.Patient
-					return zeroVal, errors.New("directive permissionAny is not implemented")
-				}
-				return ec.directives.PermissionAny(ctx, nil, directive1, requires)
-			}
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-			next = directive2
-			return next
-		},
-		ec.marshalOPatient2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatient,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_Mutation_updatePatient(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Patient_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Patient_name(ctx, field)
-			case "dob":
-				return ec.fieldContext_Patient_dob(ctx, field)
-			case "phone":
-				return ec.fieldContext_Patient_phone(ctx, field)
-			case "state":
-				return ec.fieldContext_Patient_state(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Patient_createdAt(ctx, field)
-			case "addresses":
-				return ec.fieldContext_Patient_addresses(ctx, field)
-			case "prescriptions":
-				return ec.fieldContext_Patient_prescriptions(ctx, field)
+var recordMeasurementPayloadImplementors = []string{"RecordMeasurementPayload"}
+
+func (ec *executionContext) _RecordMeasurementPayload(ctx context.Context, sel ast.SelectionSet, obj *RecordMeasurementPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, recordMeasurementPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RecordMeasurementPayload")
+		case "measurement":
+			out.Values[i] = ec._RecordMeasurementPayload_measurement(ctx, field, obj)
+		case "userErrors":
+			out.Values[i] = ec._RecordMeasurementPayload_userErrors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Patient", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updatePatient_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) _Mutation_createPrescription(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation_createPrescription,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CreatePrescription(ctx, fc.Args["input"].(CreatePrescriptionInput))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
+var sigImplementors = []string{"Sig"}
 
-			directive1 := func(ctx context.Context) (any, error) {
-				if ec.directives.Auth == nil {
-					var zeroVal *model1.Prescription
-					return zeroVal, errors.New("directive auth is not implemented")
-				}
-				return ec.directives.Auth(ctx, nil, directive0)
+func (ec *executionContext) _Sig(ctx context.Context, sel ast.SelectionSet, obj *model1.Sig) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sigImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Sig")
+		case "doseQuantity":
+			out.Values[i] = ec._Sig_doseQuantity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-			directive2 := func(ctx context.Context) (any, error) {
-				requires, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"prescription:write", "doctor:role", "pharmacist:role", "admin:all"})
-				if err != nil {
-					var zeroVal *model1.Prescription
-					return zeroVal, err
-				}
-				if ec.directives.PermissionAny == nil {
-					var zeroVal *model1.Prescription
-					return zeroVal, errors.New("directive permissionAny is not implemented")
+		case "doseUnit":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Sig_doseUnit(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
 				}
-				return ec.directives.PermissionAny(ctx, nil, directive1, requires)
+				return res
 			}
 
-			next = directive2
-			return next
-		},
-		ec.marshalOPrescription2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescription,
-		true,
-		false,
-	)
-}
-
-func (ec *executionContext) fieldContext_Mutation_createPrescription(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Prescription_id(ctx, field)
-			case "patientID":
-				return ec.fieldContext_Prescription_patientID(ctx, field)
-			case "patient":
-				return ec.fieldContext_Prescription_patient(ctx, field)
-			case "drug":
-				return ec.fieldContext_Prescription_drug(ctx, field)
-			case "dose":
-				return ec.fieldContext_Prescription_dose(ctx, field)
-			case "status":
-				return ec.fieldContext_Prescription_status(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Prescription_createdAt(ctx, field)
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Prescription", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createPrescription_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Mutation_updatePrescription(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation_updatePrescription,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdatePrescription(ctx, fc.Args["id"].(string), fc.Args["input"].(UpdatePrescriptionInput))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "route":
+			field := field
 
-			directive1 := func(ctx context.Context) (any, error) {
-				if ec.directives.Auth == nil {
-					var zeroVal *model1.Prescription
-					return zeroVal, errors.New("directive auth is not implemented")
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Sig_route(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
 				}
-				return ec.directives.Auth(ctx, nil, directive0)
+				return res
 			}
-			directive2 := func(ctx context.Context) (any, error) {
-				requires, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"prescription:write", "doctor:role", "pharmacist:role", "admin:all"})
-				if err != nil {
-					var zeroVal *model1.Prescription
-					return zeroVal, err
-				}
-				if ec.directives.PermissionAny == nil {
-					var zeroVal *model1.Prescription
-					return zeroVal, errors.New("directive permissionAny is not implemented")
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
 				}
-				return ec.directives.PermissionAny(ctx, nil, directive1, requires)
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
 
-			next = directive2
-			return next
-		},
-		ec.marshalOPrescription2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescription,
-		true,
-		false,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "frequency":
+			field := field
 
-func (ec *executionContext) fieldContext_Mutation_updatePrescription(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Prescription_id(ctx, field)
-			case "patientID":
-				return ec.fieldContext_Prescription_patientID(ctx, field)
-			case "patient":
-				return ec.fieldContext_Prescription_patient(ctx, field)
-			case "drug":
-				return ec.fieldContext_Prescription_drug(ctx, field)
-			case "dose":
-				return ec.fieldContext_Prescription_dose(ctx, field)
-			case "status":
-				return ec.fieldContext_Prescription_status(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Prescription_createdAt(ctx, field)
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Sig_frequency(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Prescription", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updatePrescription_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Patient_id(ctx context.Context, field graphql.CollectedField, obj *model.Patient) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Patient_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-func (ec *executionContext) fieldContext_Patient_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Patient",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
 
-func (ec *executionContext) _Patient_name(ctx context.Context, field graphql.CollectedField, obj *model.Patient) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Patient_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "timing":
+			field := field
 
-func (ec *executionContext) fieldContext_Patient_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Patient",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Sig_timing(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) _Patient_dob(ctx context.Context, field graphql.CollectedField, obj *model.Patient) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Patient_dob,
-		func(ctx context.Context) (any, error) {
-			return obj.DOB, nil
-		},
-		nil,
-		ec.marshalNTime2timeᚐTime,
-		true,
-		true,
-	)
-}
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-func (ec *executionContext) fieldContext_Patient_dob(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Patient",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "asNeeded":
+			out.Values[i] = ec._Sig_asNeeded(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "indication":
+			out.Values[i] = ec._Sig_indication(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Patient_phone(ctx context.Context, field graphql.CollectedField, obj *model.Patient) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Patient_phone,
-		func(ctx context.Context) (any, error) {
-			return obj.Phone, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext_Patient_phone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Patient",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Patient_state(ctx context.Context, field graphql.CollectedField, obj *model.Patient) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Patient_state,
-		func(ctx context.Context) (any, error) {
-			return obj.State, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_Patient_state(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Patient",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var stateCountImplementors = []string{"StateCount"}
 
-func (ec *executionContext) _Patient_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Patient) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Patient_createdAt,
-		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
-		},
-		nil,
-		ec.marshalNTime2timeᚐTime,
-		true,
-		true,
-	)
-}
+func (ec *executionContext) _StateCount(ctx context.Context, sel ast.SelectionSet, obj *model.StateCount) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, stateCountImplementors)
 
-func (ec *executionContext) fieldContext_Patient_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Patient",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("StateCount")
+		case "state":
+			out.Values[i] = ec._StateCount_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "count":
+			out.Values[i] = ec._StateCount_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Patient_addresses(ctx context.Context, field graphql.CollectedField, obj *model.Patient) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Patient_addresses,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Patient().Addresses(ctx, obj)
-		},
-		nil,
-		ec.marshalNAddress2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐAddressᚄ,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext_Patient_addresses(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Patient",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Address_id(ctx, field)
-			case "patientID":
-				return ec.fieldContext_Address_patientID(ctx, field)
-			case "line1":
-				return ec.fieldContext_Address_line1(ctx, field)
-			case "line2":
-				return ec.fieldContext_Address_line2(ctx, field)
-			case "city":
-				return ec.fieldContext_Address_city(ctx, field)
-			case "state":
-				return ec.fieldContext_Address_state(ctx, field)
-			case "zip":
-				return ec.fieldContext_Address_zip(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Address", field.Name)
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
+
+	return out
 }
 
-func (ec *executionContext) _Patient_prescriptions(ctx context.Context, field graphql.CollectedField, obj *model.Patient) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Patient_prescriptions,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Patient().Prescriptions(ctx, obj)
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
+var statusCountImplementors = []string{"StatusCount"}
 
-			directive1 := func(ctx context.Context) (any, error) {
-				if ec.directives.Auth == nil {
-					var zeroVal []model1.Prescription
-					return zeroVal, errors.New("directive auth is not implemented")
+func (ec *executionContext) _StatusCount(ctx context.Context, sel ast.SelectionSet, obj *model1.StatusCount) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, statusCountImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("StatusCount")
+		case "status":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._StatusCount_status(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
 				}
-				return ec.directives.Auth(ctx, obj, directive0)
+				return res
 			}
-			directive2 := func(ctx context.Context) (any, error) {
-				requires, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"prescription:read", "doctor:role", "pharmacist:role", "admin:all"})
-				if err != nil {
-					var zeroVal []model1.Prescription
-					return zeroVal, err
-				}
-				if ec.directives.PermissionAny == nil {
-					var zeroVal []model1.Prescription
-					return zeroVal, errors.New("directive permissionAny is not implemented")
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
 				}
-				return ec.directives.PermissionAny(ctx, obj, directive1, requires)
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "count":
+			out.Values[i] = ec._StatusCount_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-			next = directive2
-			return next
-		},
-		ec.marshalNPrescription2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescriptionᚄ,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_Patient_prescriptions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Patient",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Prescription_id(ctx, field)
-			case "patientID":
-				return ec.fieldContext_Prescription_patientID(ctx, field)
-			case "patient":
-				return ec.fieldContext_Prescription_patient(ctx, field)
-			case "drug":
-				return ec.fieldContext_Prescription_drug(ctx, field)
-			case "dose":
-				return ec.fieldContext_Prescription_dose(ctx, field)
-			case "status":
-				return ec.fieldContext_Prescription_status(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Prescription_createdAt(ctx, field)
+var transmissionAcknowledgmentImplementors = []string{"TransmissionAcknowledgment"}
+
+func (ec *executionContext) _TransmissionAcknowledgment(ctx context.Context, sel ast.SelectionSet, obj *model3.TransmissionAcknowledgment) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, transmissionAcknowledgmentImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TransmissionAcknowledgment")
+		case "messageID":
+			out.Values[i] = ec._TransmissionAcknowledgment_messageID(ctx, field, obj)
+		case "kind":
+			out.Values[i] = ec._TransmissionAcknowledgment_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Prescription", field.Name)
-		},
+		case "code":
+			out.Values[i] = ec._TransmissionAcknowledgment_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec._TransmissionAcknowledgment_description(ctx, field, obj)
+		case "receivedAt":
+			out.Values[i] = ec._TransmissionAcknowledgment_receivedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Prescription_id(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Prescription_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext_Prescription_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Prescription",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Prescription_patientID(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Prescription_patientID,
-		func(ctx context.Context) (any, error) {
-			return obj.PatientID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_Prescription_patientID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Prescription",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var transmitPrescriptionPayloadImplementors = []string{"TransmitPrescriptionPayload"}
 
-func (ec *executionContext) _Prescription_patient(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Prescription_patient,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Prescription().Patient(ctx, obj)
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
+func (ec *executionContext) _TransmitPrescriptionPayload(ctx context.Context, sel ast.SelectionSet, obj *TransmitPrescriptionPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, transmitPrescriptionPayloadImplementors)
 
-			directive1 := func(ctx context.Context) (any, error) {
-				if ec.directives.Auth == nil {
-					var zeroVal *model.Patient
-					return zeroVal, errors.New("directive auth is not implemented")
-				}
-				return ec.directives.Auth(ctx, obj, directive0)
-			}
-			directive2 := func(ctx context.Context) (any, error) {
-				requires, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"patient:read", "admin:all"})
-				if err != nil {
-					var zeroVal *model.Patient
-					return zeroVal, err
-				}
-				if ec.directives.PermissionAny == nil {
-					var zeroVal *model.Patient
-					return zeroVal, errors.New("directive permissionAny is not implemented")
-				}
-				return ec.directives.PermissionAny(ctx, obj, directive1, requires)
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TransmitPrescriptionPayload")
+		case "transmission":
+			out.Values[i] = ec._TransmitPrescriptionPayload_transmission(ctx, field, obj)
+		case "userErrors":
+			out.Values[i] = ec._TransmitPrescriptionPayload_userErrors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			next = directive2
-			return next
-		},
-		ec.marshalOPatient2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatient,
-		true,
-		false,
-	)
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) fieldContext_Prescription_patient(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Prescription",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Patient_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Patient_name(ctx, field)
-			case "dob":
-				return ec.fieldContext_Patient_dob(ctx, field)
-			case "phone":
-				return ec.fieldContext_Patient_phone(ctx, field)
-			case "state":
-				return ec.fieldContext_Patient_state(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Patient_createdAt(ctx, field)
-			case "addresses":
-				return ec.fieldContext_Patient_addresses(ctx, field)
-			case "prescriptions":
-				return ec.fieldContext_Patient_prescriptions(ctx, field)
+var updateAddressPayloadImplementors = []string{"UpdateAddressPayload"}
+
+func (ec *executionContext) _UpdateAddressPayload(ctx context.Context, sel ast.SelectionSet, obj *UpdateAddressPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, updateAddressPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("UpdateAddressPayload")
+		case "address":
+			out.Values[i] = ec._UpdateAddressPayload_address(ctx, field, obj)
+		case "userErrors":
+			out.Values[i] = ec._UpdateAddressPayload_userErrors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Patient", field.Name)
-		},
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Prescription_drug(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Prescription_drug,
-		func(ctx context.Context) (any, error) {
-			return obj.Drug, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext_Prescription_drug(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Prescription",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Prescription_dose(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Prescription_dose,
-		func(ctx context.Context) (any, error) {
-			return obj.Dose, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_Prescription_dose(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Prescription",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var updateAllergyPayloadImplementors = []string{"UpdateAllergyPayload"}
 
-func (ec *executionContext) _Prescription_status(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Prescription_status,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Prescription().Status(ctx, obj)
-		},
-		nil,
-		ec.marshalNPrescriptionStatus2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐPrescriptionStatus,
-		true,
-		true,
-	)
-}
+func (ec *executionContext) _UpdateAllergyPayload(ctx context.Context, sel ast.SelectionSet, obj *UpdateAllergyPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, updateAllergyPayloadImplementors)
 
-func (ec *executionContext) fieldContext_Prescription_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Prescription",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type PrescriptionStatus does not have child fields")
-		},
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("UpdateAllergyPayload")
+		case "allergy":
+			out.Values[i] = ec._UpdateAllergyPayload_allergy(ctx, field, obj)
+		case "userErrors":
+			out.Values[i] = ec._UpdateAllergyPayload_userErrors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Prescription_createdAt(ctx context.Context, field graphql.CollectedField, obj *model1.Prescription) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Prescription_createdAt,
-		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
-		},
-		nil,
-		ec.marshalNTime2timeᚐTime,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext_Prescription_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Prescription",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query__empty(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query__empty,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().Empty(ctx)
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_Query__empty(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var updateMeasurementPayloadImplementors = []string{"UpdateMeasurementPayload"}
 
-func (ec *executionContext) _Query_dashboardStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_dashboardStats,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().DashboardStats(ctx)
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
+func (ec *executionContext) _UpdateMeasurementPayload(ctx context.Context, sel ast.SelectionSet, obj *UpdateMeasurementPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, updateMeasurementPayloadImplementors)
 
-			directive1 := func(ctx context.Context) (any, error) {
-				if ec.directives.Auth == nil {
-					var zeroVal *DashboardStats
-					return zeroVal, errors.New("directive auth is not implemented")
-				}
-				return ec.directives.Auth(ctx, nil, directive0)
-			}
-			directive2 := func(ctx context.Context) (any, error) {
-				requires, err := ec.unmarshalNString2ᚕstringᚄ(ctx, []any{"dashboard:view", "admin:all"})
-				if err != nil {
-					var zeroVal *DashboardStats
-					return zeroVal, err
-				}
-				if ec.directives.PermissionAny == nil {
-					var zeroVal *DashboardStats
-					return zeroVal, errors.New("directive permissionAny is not implemented")
-				}
-				return ec.directives.PermissionAny(ctx, nil, directive1, requires)
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("UpdateMeasurementPayload")
+		case "measurement":
+			out.Values[i] = ec._UpdateMeasurementPayload_measurement(ctx, field, obj)
+		case "userErrors":
+			out.Values[i] = ec._UpdateMeasurementPayload_userErrors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			next = directive2
-			return next
-		},
-		ec.marshalNDashboardStats2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐDashboardStats,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext_Query_dashboardStats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "totalPatients":
-				return ec.fieldContext_DashboardStats_totalPatients(ctx, field)
-			case "activePrescriptions":
-				return ec.fieldContext_DashboardStats_activePrescriptions(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type DashboardStats", field.Name)
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query___type,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.introspectType(fc.Args["name"].(string))
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+var updatePatientPayloadImplementors = []string{"UpdatePatientPayload"}
+
+func (ec *executionContext) _UpdatePatientPayload(ctx context.Context, sel ast.SelectionSet, obj *UpdatePatientPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, updatePatientPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("UpdatePatientPayload")
+		case "patient":
+			out.Values[i] = ec._UpdatePatientPayload_patient(ctx, field, obj)
+		case "userErrors":
+			out.Values[i] = ec._UpdatePatientPayload_userErrors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query___schema,
-		func(ctx context.Context) (any, error) {
-			return ec.introspectSchema()
-		},
-		nil,
-		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
-		true,
-		false,
-	)
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
+var updatePrescriberPayloadImplementors = []string{"UpdatePrescriberPayload"}
+
+func (ec *executionContext) _UpdatePrescriberPayload(ctx context.Context, sel ast.SelectionSet, obj *UpdatePrescriberPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, updatePrescriberPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("UpdatePrescriberPayload")
+		case "prescriber":
+			out.Values[i] = ec._UpdatePrescriberPayload_prescriber(ctx, field, obj)
+		case "userErrors":
+			out.Values[i] = ec._UpdatePrescriberPayload_userErrors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
-		},
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+var updatePrescriptionPayloadImplementors = []string{"UpdatePrescriptionPayload"}
+
+func (ec *executionContext) _UpdatePrescriptionPayload(ctx context.Context, sel ast.SelectionSet, obj *UpdatePrescriptionPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, updatePrescriptionPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("UpdatePrescriptionPayload")
+		case "prescription":
+			out.Values[i] = ec._UpdatePrescriptionPayload_prescription(ctx, field, obj)
+		case "userErrors":
+			out.Values[i] = ec._UpdatePrescriptionPayload_userErrors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_isRepeatable,
-		func(ctx context.Context) (any, error) {
-			return obj.IsRepeatable, nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_locations,
-		func(ctx context.Context) (any, error) {
-			return obj.Locations, nil
-		},
-		nil,
-		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
-		},
+var userErrorImplementors = []string{"UserError"}
+
+func (ec *executionContext) _UserError(ctx context.Context, sel ast.SelectionSet, obj *UserError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, userErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("UserError")
+		case "field":
+			out.Values[i] = ec._UserError_field(ctx, field, obj)
+		case "code":
+			out.Values[i] = ec._UserError_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._UserError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_args,
-		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
-		},
-		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+var _ServiceImplementors = []string{"_Service"}
+
+func (ec *executionContext) __Service(ctx context.Context, sel ast.SelectionSet, obj *fedruntime.Service) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, _ServiceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("_Service")
+		case "sdl":
+			out.Values[i] = ec.__Service_sdl(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___EnumValue_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___EnumValue_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+var __DirectiveImplementors = []string{"__Directive"}
+
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+		case "isRepeatable":
+			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "locations":
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "args":
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___EnumValue_isDeprecated,
-		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___EnumValue_deprecationReason,
-		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+var __EnumValueImplementors = []string{"__EnumValue"}
+
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deprecationReason":
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var __FieldImplementors = []string{"__Field"}
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_args,
-		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
-		},
-		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
-		true,
-	)
-}
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
 
-func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Field")
+		case "name":
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		case "description":
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "type":
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "isDeprecated":
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deprecationReason":
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_type,
-		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
-		},
-		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		true,
-	)
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+var __InputValueImplementors = []string{"__InputValue"}
+
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__InputValue")
+		case "name":
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+		case "description":
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "defaultValue":
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deprecationReason":
+			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_isDeprecated,
-		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var __SchemaImplementors = []string{"__Schema"}
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_deprecationReason,
-		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
 
-func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "description":
+			out.Values[i] = ec.___Schema_description(ctx, field, obj)
+		case "types":
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "queryType":
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "mutationType":
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
+		case "subscriptionType":
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+		case "directives":
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var __TypeImplementors = []string{"__Type"}
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_type,
-		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
-		},
-		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		true,
-	)
-}
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
 
-func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+		case "name":
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
+		case "specifiedByURL":
+			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
+		case "fields":
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
+		case "interfaces":
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
+		case "possibleTypes":
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
+		case "enumValues":
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+		case "inputFields":
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+		case "ofType":
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+		case "isOneOf":
+			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
 	}
-	return fc, nil
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_defaultValue,
-		func(ctx context.Context) (any, error) {
-			return obj.DefaultValue, nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) marshalNAddress2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐAddress(ctx context.Context, sel ast.SelectionSet, v model.Address) graphql.Marshaler {
+	return ec._Address(ctx, sel, &v)
 }
 
-func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) marshalNAddress2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐAddressᚄ(ctx context.Context, sel ast.SelectionSet, v []model.Address) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
-	return fc, nil
-}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAddress2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐAddress(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_isDeprecated,
-		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
-}
+	}
+	wg.Wait()
 
-func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
-	return fc, nil
+
+	return ret
 }
 
-func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_deprecationReason,
-		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+func (ec *executionContext) marshalNAdherenceSnapshot2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋreportingᚋcontractsᚋmodelᚐAdherenceSnapshot(ctx context.Context, sel ast.SelectionSet, v model4.AdherenceSnapshot) graphql.Marshaler {
+	return ec._AdherenceSnapshot(ctx, sel, &v)
 }
 
-func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) marshalNAdherenceSnapshot2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋreportingᚋcontractsᚋmodelᚐAdherenceSnapshotᚄ(ctx context.Context, sel ast.SelectionSet, v []model4.AdherenceSnapshot) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
-	return fc, nil
-}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAdherenceSnapshot2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋreportingᚋcontractsᚋmodelᚐAdherenceSnapshot(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+	}
+	wg.Wait()
 
-func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
-	return fc, nil
+
+	return ret
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_types,
-		func(ctx context.Context) (any, error) {
-			return obj.Types(), nil
-		},
-		nil,
-		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
-		true,
-		true,
-	)
+func (ec *executionContext) marshalNAllergy2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐAllergy(ctx context.Context, sel ast.SelectionSet, v model.Allergy) graphql.Marshaler {
+	return ec._Allergy(ctx, sel, &v)
 }
 
-func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+func (ec *executionContext) marshalNAllergy2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐAllergyᚄ(ctx context.Context, sel ast.SelectionSet, v []model.Allergy) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+			ret[i] = ec.marshalNAllergy2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐAllergy(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	return fc, nil
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_queryType,
-		func(ctx context.Context) (any, error) {
-			return obj.QueryType(), nil
-		},
-		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		true,
-	)
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 	}
-	return fc, nil
+	return res
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_mutationType,
-		func(ctx context.Context) (any, error) {
-			return obj.MutationType(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
+func (ec *executionContext) unmarshalNCreateAddressInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐCreateAddressInput(ctx context.Context, v any) (CreateAddressInput, error) {
+	res, err := ec.unmarshalInputCreateAddressInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+func (ec *executionContext) unmarshalNCreatePatientInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐCreatePatientInput(ctx context.Context, v any) (CreatePatientInput, error) {
+	res, err := ec.unmarshalInputCreatePatientInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCreatePrescriberInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐCreatePrescriberInput(ctx context.Context, v any) (CreatePrescriberInput, error) {
+	res, err := ec.unmarshalInputCreatePrescriberInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCreatePrescriptionInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐCreatePrescriptionInput(ctx context.Context, v any) (CreatePrescriptionInput, error) {
+	res, err := ec.unmarshalInputCreatePrescriptionInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNDrugAllergyWarning2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐDrugAllergyWarning(ctx context.Context, sel ast.SelectionSet, v model1.DrugAllergyWarning) graphql.Marshaler {
+	return ec._DrugAllergyWarning(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNDrugAllergyWarning2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐDrugAllergyWarningᚄ(ctx context.Context, sel ast.SelectionSet, v []model1.DrugAllergyWarning) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+			ret[i] = ec.marshalNDrugAllergyWarning2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐDrugAllergyWarning(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	return fc, nil
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_subscriptionType,
-		func(ctx context.Context) (any, error) {
-			return obj.SubscriptionType(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
+func (ec *executionContext) marshalNDrugInteractionWarning2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐDrugInteractionWarning(ctx context.Context, sel ast.SelectionSet, v model1.DrugInteractionWarning) graphql.Marshaler {
+	return ec._DrugInteractionWarning(ctx, sel, &v)
 }
 
-func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+func (ec *executionContext) marshalNDrugInteractionWarning2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐDrugInteractionWarningᚄ(ctx context.Context, sel ast.SelectionSet, v []model1.DrugInteractionWarning) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+			ret[i] = ec.marshalNDrugInteractionWarning2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐDrugInteractionWarning(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	return fc, nil
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_directives,
-		func(ctx context.Context) (any, error) {
-			return obj.Directives(), nil
-		},
-		nil,
-		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
-		true,
-		true,
-	)
+func (ec *executionContext) unmarshalNFieldSet2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Directive_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Directive_description(ctx, field)
-			case "isRepeatable":
-				return ec.fieldContext___Directive_isRepeatable(ctx, field)
-			case "locations":
-				return ec.fieldContext___Directive_locations(ctx, field)
-			case "args":
-				return ec.fieldContext___Directive_args(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
-		},
+func (ec *executionContext) marshalNFieldSet2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 	}
-	return fc, nil
+	return res
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_kind,
-		func(ctx context.Context) (any, error) {
-			return obj.Kind(), nil
-		},
-		nil,
-		ec.marshalN__TypeKind2string,
-		true,
-		true,
-	)
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v any) (float64, error) {
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __TypeKind does not have child fields")
-		},
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalFloatContext(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 	}
-	return fc, nil
+	return graphql.WrapContextMarshaler(ctx, res)
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalID(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalID(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 	}
-	return fc, nil
+	return res
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+func (ec *executionContext) marshalNInsuranceRecord2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐInsuranceRecord(ctx context.Context, sel ast.SelectionSet, v model.InsuranceRecord) graphql.Marshaler {
+	return ec._InsuranceRecord(ctx, sel, &v)
 }
 
-func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) marshalNInsuranceRecord2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐInsuranceRecordᚄ(ctx context.Context, sel ast.SelectionSet, v []model.InsuranceRecord) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
-	return fc, nil
-}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNInsuranceRecord2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐInsuranceRecord(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_specifiedByURL,
-		func(ctx context.Context) (any, error) {
-			return obj.SpecifiedByURL(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+	}
+	wg.Wait()
 
-func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
-	return fc, nil
+
+	return ret
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_fields,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
-		},
-		nil,
-		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
-		true,
-		false,
-	)
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Field_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Field_description(ctx, field)
-			case "args":
-				return ec.fieldContext___Field_args(ctx, field)
-			case "type":
-				return ec.fieldContext___Field_type(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___Field_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___Field_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
+	return res
+}
+
+func (ec *executionContext) marshalNInvoice2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋbillingᚋcontractsᚋmodelᚐInvoice(ctx context.Context, sel ast.SelectionSet, v model2.Invoice) graphql.Marshaler {
+	return ec._Invoice(ctx, sel, &v)
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_interfaces,
-		func(ctx context.Context) (any, error) {
-			return obj.Interfaces(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
-		true,
-		false,
-	)
+func (ec *executionContext) marshalNMeasurement2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐMeasurement(ctx context.Context, sel ast.SelectionSet, v model.Measurement) graphql.Marshaler {
+	return ec._Measurement(ctx, sel, &v)
 }
 
-func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+func (ec *executionContext) marshalNMeasurement2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐMeasurementᚄ(ctx context.Context, sel ast.SelectionSet, v []model.Measurement) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+			ret[i] = ec.marshalNMeasurement2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐMeasurement(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	return fc, nil
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_possibleTypes,
-		func(ctx context.Context) (any, error) {
-			return obj.PossibleTypes(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
-		true,
-		false,
-	)
+func (ec *executionContext) unmarshalNPartialDate2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋplatformᚋdatesᚐPartialDate(ctx context.Context, v any) (dates.PartialDate, error) {
+	var res dates.PartialDate
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
+func (ec *executionContext) marshalNPartialDate2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋplatformᚋdatesᚐPartialDate(ctx context.Context, sel ast.SelectionSet, v dates.PartialDate) graphql.Marshaler {
+	return v
 }
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_enumValues,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
-		},
-		nil,
-		ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ,
-		true,
-		false,
-	)
+func (ec *executionContext) marshalNPatient2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatient(ctx context.Context, sel ast.SelectionSet, v model.Patient) graphql.Marshaler {
+	return ec._Patient(ctx, sel, &v)
 }
 
-func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___EnumValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___EnumValue_description(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+func (ec *executionContext) marshalNPatient2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatient(ctx context.Context, sel ast.SelectionSet, v *model.Patient) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
+		return graphql.Null
 	}
-	return fc, nil
+	return ec._Patient(ctx, sel, v)
 }
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_inputFields,
-		func(ctx context.Context) (any, error) {
-			return obj.InputFields(), nil
-		},
-		nil,
-		ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
-		false,
-	)
+func (ec *executionContext) marshalNPatientSearchMatch2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatientSearchMatch(ctx context.Context, sel ast.SelectionSet, v model.PatientSearchMatch) graphql.Marshaler {
+	return ec._PatientSearchMatch(ctx, sel, &v)
 }
 
-func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+func (ec *executionContext) marshalNPatientSearchMatch2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatientSearchMatchᚄ(ctx context.Context, sel ast.SelectionSet, v []model.PatientSearchMatch) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-		},
+			ret[i] = ec.marshalNPatientSearchMatch2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatientSearchMatch(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_ofType,
-		func(ctx context.Context) (any, error) {
-			return obj.OfType(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
+	return ret
 }
 
-func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
+func (ec *executionContext) marshalNPatientSearchResult2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatientSearchResult(ctx context.Context, sel ast.SelectionSet, v model.PatientSearchResult) graphql.Marshaler {
+	return ec._PatientSearchResult(ctx, sel, &v)
 }
 
-func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_isOneOf,
-		func(ctx context.Context) (any, error) {
-			return obj.IsOneOf(), nil
-		},
-		nil,
-		ec.marshalOBoolean2bool,
-		true,
-		false,
-	)
+func (ec *executionContext) marshalNPotentialDuplicate2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPotentialDuplicate(ctx context.Context, sel ast.SelectionSet, v model.PotentialDuplicate) graphql.Marshaler {
+	return ec._PotentialDuplicate(ctx, sel, &v)
 }
 
-func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+func (ec *executionContext) marshalNPotentialDuplicate2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPotentialDuplicateᚄ(ctx context.Context, sel ast.SelectionSet, v []model.PotentialDuplicate) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
-	return fc, nil
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPotentialDuplicate2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPotentialDuplicate(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-// endregion **************************** field.gotpl *****************************
+func (ec *executionContext) marshalNPrescriber2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescriber(ctx context.Context, sel ast.SelectionSet, v model1.Prescriber) graphql.Marshaler {
+	return ec._Prescriber(ctx, sel, &v)
+}
 
-// region    **************************** input.gotpl *****************************
+func (ec *executionContext) marshalNPrescription2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescription(ctx context.Context, sel ast.SelectionSet, v model1.Prescription) graphql.Marshaler {
+	return ec._Prescription(ctx, sel, &v)
+}
 
-func (ec *executionContext) unmarshalInputCreatePatientInput(ctx context.Context, obj any) (CreatePatientInput, error) {
-	var it CreatePatientInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+func (ec *executionContext) marshalNPrescription2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescriptionᚄ(ctx context.Context, sel ast.SelectionSet, v []model1.Prescription) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
-
-	fieldsInOrder := [...]string{"name", "dob", "phone", "state"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
 		}
-		switch k {
-		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Name = data
-		case "dob":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dob"))
-			data, err := ec.unmarshalNTime2timeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Dob = data
-		case "phone":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("phone"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Phone = data
-		case "state":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("state"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-			it.State = data
+			ret[i] = ec.marshalNPrescription2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescription(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
 
-	return it, nil
+	return ret
 }
 
-func (ec *executionContext) unmarshalInputCreatePrescriptionInput(ctx context.Context, obj any) (CreatePrescriptionInput, error) {
-	var it CreatePrescriptionInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+func (ec *executionContext) marshalNPrescription2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescription(ctx context.Context, sel ast.SelectionSet, v *model1.Prescription) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._Prescription(ctx, sel, v)
+}
 
-	fieldsInOrder := [...]string{"patientID", "drug", "dose", "status"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
+func (ec *executionContext) marshalNPrescriptionAdherence2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋreportingᚋcontractsᚋmodelᚐPrescriptionAdherence(ctx context.Context, sel ast.SelectionSet, v model4.PrescriptionAdherence) graphql.Marshaler {
+	return ec._PrescriptionAdherence(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPrescriptionAdherence2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋreportingᚋcontractsᚋmodelᚐPrescriptionAdherenceᚄ(ctx context.Context, sel ast.SelectionSet, v []model4.PrescriptionAdherence) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
 		}
-		switch k {
-		case "patientID":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("patientID"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.PatientID = data
-		case "drug":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("drug"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Drug = data
-		case "dose":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dose"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Dose = data
-		case "status":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
-			data, err := ec.unmarshalNPrescriptionStatus2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐPrescriptionStatus(ctx, v)
-			if err != nil {
-				return it, err
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-			it.Status = data
+			ret[i] = ec.marshalNPrescriptionAdherence2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋreportingᚋcontractsᚋmodelᚐPrescriptionAdherence(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-	}
-
-	return it, nil
-}
 
-func (ec *executionContext) unmarshalInputUpdatePatientInput(ctx context.Context, obj any) (UpdatePatientInput, error) {
-	var it UpdatePatientInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
 	}
+	wg.Wait()
 
-	fieldsInOrder := [...]string{"name", "dob", "phone", "state"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Name = data
-		case "dob":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dob"))
-			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Dob = data
-		case "phone":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("phone"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Phone = data
-		case "state":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("state"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.State = data
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
 
-	return it, nil
+	return ret
 }
 
-func (ec *executionContext) unmarshalInputUpdatePrescriptionInput(ctx context.Context, obj any) (UpdatePrescriptionInput, error) {
-	var it UpdatePrescriptionInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+func (ec *executionContext) marshalNPrescriptionHistoryEvent2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescriptionHistoryEvent(ctx context.Context, sel ast.SelectionSet, v model1.PrescriptionHistoryEvent) graphql.Marshaler {
+	return ec._PrescriptionHistoryEvent(ctx, sel, &v)
+}
 
-	fieldsInOrder := [...]string{"drug", "dose", "status"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
+func (ec *executionContext) marshalNPrescriptionHistoryEvent2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescriptionHistoryEventᚄ(ctx context.Context, sel ast.SelectionSet, v []model1.PrescriptionHistoryEvent) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
 		}
-		switch k {
-		case "drug":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("drug"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Drug = data
-		case "dose":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dose"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Dose = data
-		case "status":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
-			data, err := ec.unmarshalOPrescriptionStatus2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐPrescriptionStatus(ctx, v)
-			if err != nil {
-				return it, err
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-			it.Status = data
+			ret[i] = ec.marshalNPrescriptionHistoryEvent2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescriptionHistoryEvent(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
+	wg.Wait()
 
-	return it, nil
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-// endregion **************************** input.gotpl *****************************
+func (ec *executionContext) unmarshalNPrescriptionHistoryEventType2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐPrescriptionHistoryEventType(ctx context.Context, v any) (PrescriptionHistoryEventType, error) {
+	var res PrescriptionHistoryEventType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-// region    ************************** interface.gotpl ***************************
+func (ec *executionContext) marshalNPrescriptionHistoryEventType2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐPrescriptionHistoryEventType(ctx context.Context, sel ast.SelectionSet, v PrescriptionHistoryEventType) graphql.Marshaler {
+	return v
+}
 
-// endregion ************************** interface.gotpl ***************************
+func (ec *executionContext) unmarshalNPrescriptionStatus2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐPrescriptionStatus(ctx context.Context, v any) (PrescriptionStatus, error) {
+	var res PrescriptionStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-// region    **************************** object.gotpl ****************************
+func (ec *executionContext) marshalNPrescriptionStatus2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐPrescriptionStatus(ctx context.Context, sel ast.SelectionSet, v PrescriptionStatus) graphql.Marshaler {
+	return v
+}
 
-var addressImplementors = []string{"Address"}
+func (ec *executionContext) marshalNPrescriptionTransmission2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋeprescribingᚋcontractsᚋmodelᚐPrescriptionTransmission(ctx context.Context, sel ast.SelectionSet, v model3.PrescriptionTransmission) graphql.Marshaler {
+	return ec._PrescriptionTransmission(ctx, sel, &v)
+}
 
-func (ec *executionContext) _Address(ctx context.Context, sel ast.SelectionSet, obj *model.Address) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, addressImplementors)
+func (ec *executionContext) unmarshalNRecordAllergyInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐRecordAllergyInput(ctx context.Context, v any) (RecordAllergyInput, error) {
+	res, err := ec.unmarshalInputRecordAllergyInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Address")
-		case "id":
-			out.Values[i] = ec._Address_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "patientID":
-			out.Values[i] = ec._Address_patientID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "line1":
-			out.Values[i] = ec._Address_line1(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "line2":
-			out.Values[i] = ec._Address_line2(ctx, field, obj)
-		case "city":
-			out.Values[i] = ec._Address_city(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "state":
-			out.Values[i] = ec._Address_state(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) unmarshalNRecordMeasurementInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐRecordMeasurementInput(ctx context.Context, v any) (RecordMeasurementInput, error) {
+	res, err := ec.unmarshalInputRecordMeasurementInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSig2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐSig(ctx context.Context, sel ast.SelectionSet, v model1.Sig) graphql.Marshaler {
+	return ec._Sig(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNStateCount2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐStateCount(ctx context.Context, sel ast.SelectionSet, v model.StateCount) graphql.Marshaler {
+	return ec._StateCount(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNStateCount2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐStateCountᚄ(ctx context.Context, sel ast.SelectionSet, v []model.StateCount) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "zip":
-			out.Values[i] = ec._Address_zip(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+			ret[i] = ec.marshalNStateCount2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐStateCount(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNStatusCount2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐStatusCount(ctx context.Context, sel ast.SelectionSet, v model1.StatusCount) graphql.Marshaler {
+	return ec._StatusCount(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNStatusCount2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐStatusCountᚄ(ctx context.Context, sel ast.SelectionSet, v []model1.StatusCount) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNStatusCount2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐStatusCount(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var dashboardStatsImplementors = []string{"DashboardStats"}
-
-func (ec *executionContext) _DashboardStats(ctx context.Context, sel ast.SelectionSet, obj *DashboardStats) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, dashboardStatsImplementors)
+func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("DashboardStats")
-		case "totalPatients":
-			out.Values[i] = ec._DashboardStats_totalPatients(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "activePrescriptions":
-			out.Values[i] = ec._DashboardStats_activePrescriptions(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	return res
+}
+
+func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
 	}
+	return res, nil
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var mutationImplementors = []string{"Mutation"}
-
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Mutation",
-	})
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
+func (ec *executionContext) unmarshalNTime2timeᚐTime(ctx context.Context, v any) (time.Time, error) {
+	res, err := graphql.UnmarshalTime(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "_empty":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation__empty(ctx, field)
-			})
-		case "createPatient":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createPatient(ctx, field)
-			})
-		case "updatePatient":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updatePatient(ctx, field)
-			})
-		case "createPrescription":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createPrescription(ctx, field)
-			})
-		case "updatePrescription":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updatePrescription(ctx, field)
-			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalTime(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	return res
+}
+
+func (ec *executionContext) marshalNTransmissionAcknowledgment2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋeprescribingᚋcontractsᚋmodelᚐTransmissionAcknowledgment(ctx context.Context, sel ast.SelectionSet, v model3.TransmissionAcknowledgment) graphql.Marshaler {
+	return ec._TransmissionAcknowledgment(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNTransmissionAcknowledgment2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋeprescribingᚋcontractsᚋmodelᚐTransmissionAcknowledgmentᚄ(ctx context.Context, sel ast.SelectionSet, v []model3.TransmissionAcknowledgment) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTransmissionAcknowledgment2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋeprescribingᚋcontractsᚋmodelᚐTransmissionAcknowledgment(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var patientImplementors = []string{"Patient"}
+func (ec *executionContext) unmarshalNTransmissionStatus2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐTransmissionStatus(ctx context.Context, v any) (TransmissionStatus, error) {
+	var res TransmissionStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) _Patient(ctx context.Context, sel ast.SelectionSet, obj *model.Patient) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, patientImplementors)
+func (ec *executionContext) marshalNTransmissionStatus2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐTransmissionStatus(ctx context.Context, sel ast.SelectionSet, v TransmissionStatus) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Patient")
-		case "id":
-			out.Values[i] = ec._Patient_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "name":
-			out.Values[i] = ec._Patient_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "dob":
-			out.Values[i] = ec._Patient_dob(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "phone":
-			out.Values[i] = ec._Patient_phone(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "state":
-			out.Values[i] = ec._Patient_state(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "createdAt":
-			out.Values[i] = ec._Patient_createdAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "addresses":
-			field := field
+func (ec *executionContext) unmarshalNUpdateAddressInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdateAddressInput(ctx context.Context, v any) (UpdateAddressInput, error) {
+	res, err := ec.unmarshalInputUpdateAddressInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Patient_addresses(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+func (ec *executionContext) unmarshalNUpdateAllergyInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdateAllergyInput(ctx context.Context, v any) (UpdateAllergyInput, error) {
+	res, err := ec.unmarshalInputUpdateAllergyInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+func (ec *executionContext) unmarshalNUpdateMeasurementInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdateMeasurementInput(ctx context.Context, v any) (UpdateMeasurementInput, error) {
+	res, err := ec.unmarshalInputUpdateMeasurementInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+func (ec *executionContext) unmarshalNUpdatePatientInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdatePatientInput(ctx context.Context, v any) (UpdatePatientInput, error) {
+	res, err := ec.unmarshalInputUpdatePatientInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "prescriptions":
-			field := field
+func (ec *executionContext) unmarshalNUpdatePrescriberInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdatePrescriberInput(ctx context.Context, v any) (UpdatePrescriberInput, error) {
+	res, err := ec.unmarshalInputUpdatePrescriberInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Patient_prescriptions(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+func (ec *executionContext) unmarshalNUpdatePrescriptionInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdatePrescriptionInput(ctx context.Context, v any) (UpdatePrescriptionInput, error) {
+	res, err := ec.unmarshalInputUpdatePrescriptionInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+func (ec *executionContext) marshalNUserError2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUserError(ctx context.Context, sel ast.SelectionSet, v UserError) graphql.Marshaler {
+	return ec._UserError(ctx, sel, &v)
+}
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+func (ec *executionContext) marshalNUserError2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUserErrorᚄ(ctx context.Context, sel ast.SelectionSet, v []UserError) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNUserError2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUserError(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalN_Any2map(ctx context.Context, v any) (map[string]any, error) {
+	res, err := graphql.UnmarshalMap(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalN_Any2map(ctx context.Context, sel ast.SelectionSet, v map[string]any) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	_ = sel
+	res := graphql.MarshalMap(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalN_Any2ᚕmapᚄ(ctx context.Context, v any) ([]map[string]any, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]map[string]any, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalN_Any2map(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalN_Any2ᚕmapᚄ(ctx context.Context, sel ast.SelectionSet, v []map[string]any) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalN_Any2map(ctx, sel, v[i])
 	}
 
-	return out
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-var prescriptionImplementors = []string{"Prescription"}
+func (ec *executionContext) marshalN_Entity2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋpluginᚋfederationᚋfedruntimeᚐEntity(ctx context.Context, sel ast.SelectionSet, v []fedruntime.Entity) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalO_Entity2githubᚗcomᚋ99designsᚋgqlgenᚋpluginᚋfederationᚋfedruntimeᚐEntity(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) _Prescription(ctx context.Context, sel ast.SelectionSet, obj *model1.Prescription) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, prescriptionImplementors)
+	}
+	wg.Wait()
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Prescription")
-		case "id":
-			out.Values[i] = ec._Prescription_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "patientID":
-			out.Values[i] = ec._Prescription_patientID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "patient":
-			field := field
+	return ret
+}
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Prescription_patient(ctx, field, obj)
-				return res
-			}
+func (ec *executionContext) marshalN_Service2githubᚗcomᚋ99designsᚋgqlgenᚋpluginᚋfederationᚋfedruntimeᚐService(ctx context.Context, sel ast.SelectionSet, v fedruntime.Service) graphql.Marshaler {
+	return ec.__Service(ctx, sel, &v)
+}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
+func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
+	return ec.___Directive(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Directive) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "drug":
-			out.Values[i] = ec._Prescription_drug(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "dose":
-			out.Values[i] = ec._Prescription_dose(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "status":
-			field := field
+	}
+	wg.Wait()
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Prescription_status(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+	return ret
+}
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+func (ec *executionContext) unmarshalN__DirectiveLocation2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "createdAt":
-			out.Values[i] = ec._Prescription_createdAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalN__DirectiveLocation2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	return res
+}
+
+func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalN__DirectiveLocation2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
 	}
+	return res, nil
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
 	}
+	wg.Wait()
 
-	return out
-}
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-var queryImplementors = []string{"Query"}
+	return ret
+}
 
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
+func (ec *executionContext) marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx context.Context, sel ast.SelectionSet, v introspection.EnumValue) graphql.Marshaler {
+	return ec.___EnumValue(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
+func (ec *executionContext) marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx context.Context, sel ast.SelectionSet, v introspection.Field) graphql.Marshaler {
+	return ec.___Field(ctx, sel, &v)
+}
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "_empty":
-			field := field
+func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
+	return ec.___InputValue(ctx, sel, &v)
+}
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query__empty(ctx, field)
-				return res
+func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	}
+	wg.Wait()
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "dashboardStats":
-			field := field
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_dashboardStats(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
+	return ret
+}
+
+func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
+	return ec.___Type(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	}
+	wg.Wait()
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "__type":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___type(ctx, field)
-			})
-		case "__schema":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___schema(ctx, field)
-			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	return ec.___Type(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 	}
+	return res
+}
 
-	return out
+func (ec *executionContext) unmarshalNfederation__Policy2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var __DirectiveImplementors = []string{"__Directive"}
+func (ec *executionContext) marshalNfederation__Policy2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
 
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+func (ec *executionContext) unmarshalNfederation__Policy2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNfederation__Policy2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
-		case "name":
-			out.Values[i] = ec.___Directive_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Directive_description(ctx, field, obj)
-		case "isRepeatable":
-			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "locations":
-			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "args":
-			out.Values[i] = ec.___Directive_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNfederation__Policy2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNfederation__Policy2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalNfederation__Policy2ᚕᚕstringᚄ(ctx context.Context, v any) ([][]string, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([][]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNfederation__Policy2ᚕstringᚄ(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
 	}
+	return res, nil
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNfederation__Policy2ᚕᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v [][]string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNfederation__Policy2ᚕstringᚄ(ctx, sel, v[i])
+	}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var __EnumValueImplementors = []string{"__EnumValue"}
-
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+func (ec *executionContext) unmarshalNfederation__Scope2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
-			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNfederation__Scope2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	return res
+}
+
+func (ec *executionContext) unmarshalNfederation__Scope2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNfederation__Scope2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
 	}
+	return res, nil
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNfederation__Scope2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNfederation__Scope2string(ctx, sel, v[i])
+	}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
+}
+
+func (ec *executionContext) unmarshalNfederation__Scope2ᚕᚕstringᚄ(ctx context.Context, v any) ([][]string, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([][]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNfederation__Scope2ᚕstringᚄ(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
-var __FieldImplementors = []string{"__Field"}
-
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+func (ec *executionContext) marshalNfederation__Scope2ᚕᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v [][]string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNfederation__Scope2ᚕstringᚄ(ctx, sel, v[i])
+	}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
-			out.Values[i] = ec.___Field_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Field_description(ctx, field, obj)
-		case "args":
-			out.Values[i] = ec.___Field_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "type":
-			out.Values[i] = ec.___Field_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "isDeprecated":
-			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalOAddress2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐAddress(ctx context.Context, sel ast.SelectionSet, v *model.Address) graphql.Marshaler {
+	if v == nil {
 		return graphql.Null
 	}
+	return ec._Address(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalOAdherenceReport2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋreportingᚋcontractsᚋmodelᚐAdherenceReport(ctx context.Context, sel ast.SelectionSet, v *model4.AdherenceReport) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
+	return ec._AdherenceReport(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) marshalOAllergy2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐAllergy(ctx context.Context, sel ast.SelectionSet, v *model.Allergy) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Allergy(ctx, sel, v)
 }
 
-var __InputValueImplementors = []string{"__InputValue"}
+func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v any) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalBoolean(v)
+	return res
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
-			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
-		case "type":
-			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "defaultValue":
-			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
+func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v any) (*bool, error) {
+	if v == nil {
+		return nil, nil
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	res, err := graphql.UnmarshalBoolean(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
+	if v == nil {
 		return graphql.Null
 	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalBoolean(*v)
+	return res
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalOContactPreferences2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐContactPreferences(ctx context.Context, sel ast.SelectionSet, v *model.ContactPreferences) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
-
-	return out
+	return ec._ContactPreferences(ctx, sel, v)
 }
 
-var __SchemaImplementors = []string{"__Schema"}
+func (ec *executionContext) unmarshalOContactPreferencesInput2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐContactPreferencesInput(ctx context.Context, v any) (*ContactPreferencesInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputContactPreferencesInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
+func (ec *executionContext) marshalOCreateAddressPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐCreateAddressPayload(ctx context.Context, sel ast.SelectionSet, v *CreateAddressPayload) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._CreateAddressPayload(ctx, sel, v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "description":
-			out.Values[i] = ec.___Schema_description(ctx, field, obj)
-		case "types":
-			out.Values[i] = ec.___Schema_types(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "queryType":
-			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "mutationType":
-			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
-		case "subscriptionType":
-			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
-		case "directives":
-			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
+func (ec *executionContext) marshalOCreatePatientPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐCreatePatientPayload(ctx context.Context, sel ast.SelectionSet, v *CreatePatientPayload) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	return ec._CreatePatientPayload(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOCreatePrescriberPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐCreatePrescriberPayload(ctx context.Context, sel ast.SelectionSet, v *CreatePrescriberPayload) graphql.Marshaler {
+	if v == nil {
 		return graphql.Null
 	}
+	return ec._CreatePrescriberPayload(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalOCreatePrescriptionPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐCreatePrescriptionPayload(ctx context.Context, sel ast.SelectionSet, v *CreatePrescriptionPayload) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._CreatePrescriptionPayload(ctx, sel, v)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalODashboardStats2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐDashboardStats(ctx context.Context, sel ast.SelectionSet, v *DashboardStats) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
+	return ec._DashboardStats(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) marshalODeleteAddressPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐDeleteAddressPayload(ctx context.Context, sel ast.SelectionSet, v *DeleteAddressPayload) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._DeleteAddressPayload(ctx, sel, v)
 }
 
-var __TypeImplementors = []string{"__Type"}
+func (ec *executionContext) marshalODeleteAllergyPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐDeleteAllergyPayload(ctx context.Context, sel ast.SelectionSet, v *DeleteAllergyPayload) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._DeleteAllergyPayload(ctx, sel, v)
+}
 
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+func (ec *executionContext) marshalODeleteMeasurementPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐDeleteMeasurementPayload(ctx context.Context, sel ast.SelectionSet, v *DeleteMeasurementPayload) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._DeleteMeasurementPayload(ctx, sel, v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
-			out.Values[i] = ec.___Type_kind(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec.___Type_name(ctx, field, obj)
-		case "description":
-			out.Values[i] = ec.___Type_description(ctx, field, obj)
-		case "specifiedByURL":
-			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
-		case "fields":
-			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-		case "interfaces":
-			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-		case "possibleTypes":
-			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
-		case "enumValues":
-			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
-		case "inputFields":
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
-		case "ofType":
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
-		case "isOneOf":
-			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
+func (ec *executionContext) marshalODeletePrescriberPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐDeletePrescriberPayload(ctx context.Context, sel ast.SelectionSet, v *DeletePrescriberPayload) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._DeletePrescriberPayload(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalODose2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐDose(ctx context.Context, sel ast.SelectionSet, v *model1.Dose) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	return ec._Dose(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalODoseInput2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐDoseInput(ctx context.Context, v any) (*DoseInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputDoseInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v any) (*float64, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel ast.SelectionSet, v *float64) graphql.Marshaler {
+	if v == nil {
 		return graphql.Null
 	}
+	_ = sel
+	res := graphql.MarshalFloatContext(*v)
+	return graphql.WrapContextMarshaler(ctx, res)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalOID2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalID(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalOID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalID(v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOID2ᚖstring(ctx context.Context, v any) (*string, error) {
+	if v == nil {
+		return nil, nil
 	}
+	res, err := graphql.UnmarshalID(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-	return out
+func (ec *executionContext) marshalOID2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalID(*v)
+	return res
 }
 
-// endregion **************************** object.gotpl ****************************
+func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v any) (*int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalInt(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-// region    ***************************** type.gotpl *****************************
+func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalInt(*v)
+	return res
+}
 
-func (ec *executionContext) marshalNAddress2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐAddress(ctx context.Context, sel ast.SelectionSet, v model.Address) graphql.Marshaler {
-	return ec._Address(ctx, sel, &v)
+func (ec *executionContext) marshalOInteractionCheckResult2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐInteractionCheckResult(ctx context.Context, sel ast.SelectionSet, v *model1.InteractionCheckResult) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._InteractionCheckResult(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNAddress2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐAddressᚄ(ctx context.Context, sel ast.SelectionSet, v []model.Address) graphql.Marshaler {
+func (ec *executionContext) marshalOInvoice2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋbillingᚋcontractsᚋmodelᚐInvoiceᚄ(ctx context.Context, sel ast.SelectionSet, v []model2.Invoice) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -4662,7 +24190,7 @@ func (ec *executionContext) marshalNAddress2ᚕpharmacyᚑmodernizationᚑprojec
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNAddress2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐAddress(ctx, sel, v[i])
+			ret[i] = ec.marshalNInvoice2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋbillingᚋcontractsᚋmodelᚐInvoice(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -4682,83 +24210,94 @@ func (ec *executionContext) marshalNAddress2ᚕpharmacyᚑmodernizationᚑprojec
 	return ret
 }
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalBoolean(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
+func (ec *executionContext) marshalOInvoice2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋbillingᚋcontractsᚋmodelᚐInvoice(ctx context.Context, sel ast.SelectionSet, v *model2.Invoice) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
-	return res
+	return ec._Invoice(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNCreatePatientInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐCreatePatientInput(ctx context.Context, v any) (CreatePatientInput, error) {
-	res, err := ec.unmarshalInputCreatePatientInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalOMeasurement2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐMeasurement(ctx context.Context, sel ast.SelectionSet, v *model.Measurement) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Measurement(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNCreatePrescriptionInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐCreatePrescriptionInput(ctx context.Context, v any) (CreatePrescriptionInput, error) {
-	res, err := ec.unmarshalInputCreatePrescriptionInput(ctx, v)
+func (ec *executionContext) unmarshalOPartialDate2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋplatformᚋdatesᚐPartialDate(ctx context.Context, v any) (*dates.PartialDate, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(dates.PartialDate)
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNDashboardStats2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐDashboardStats(ctx context.Context, sel ast.SelectionSet, v DashboardStats) graphql.Marshaler {
-	return ec._DashboardStats(ctx, sel, &v)
+func (ec *executionContext) marshalOPartialDate2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋplatformᚋdatesᚐPartialDate(ctx context.Context, sel ast.SelectionSet, v *dates.PartialDate) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
 }
 
-func (ec *executionContext) marshalNDashboardStats2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐDashboardStats(ctx context.Context, sel ast.SelectionSet, v *DashboardStats) graphql.Marshaler {
+func (ec *executionContext) marshalOPatient2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatientᚄ(ctx context.Context, sel ast.SelectionSet, v []model.Patient) graphql.Marshaler {
 	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
 		return graphql.Null
 	}
-	return ec._DashboardStats(ctx, sel, v)
-}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPatient2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatient(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalID(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
+	}
+	wg.Wait()
 
-func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalID(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	return res
-}
 
-func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
-	res, err := graphql.UnmarshalInt(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ret
 }
 
-func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalInt(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
+func (ec *executionContext) marshalOPatient2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatient(ctx context.Context, sel ast.SelectionSet, v *model.Patient) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
-	return res
-}
-
-func (ec *executionContext) marshalNPrescription2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescription(ctx context.Context, sel ast.SelectionSet, v model1.Prescription) graphql.Marshaler {
-	return ec._Prescription(ctx, sel, &v)
+	return ec._Patient(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNPrescription2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescriptionᚄ(ctx context.Context, sel ast.SelectionSet, v []model1.Prescription) graphql.Marshaler {
+func (ec *executionContext) marshalOPatientSearchResult2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatientSearchResultᚄ(ctx context.Context, sel ast.SelectionSet, v []model.PatientSearchResult) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -4782,7 +24321,7 @@ func (ec *executionContext) marshalNPrescription2ᚕpharmacyᚑmodernizationᚑp
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNPrescription2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescription(ctx, sel, v[i])
+			ret[i] = ec.marshalNPatientSearchResult2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatientSearchResult(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -4802,52 +24341,50 @@ func (ec *executionContext) marshalNPrescription2ᚕpharmacyᚑmodernizationᚑp
 	return ret
 }
 
-func (ec *executionContext) unmarshalNPrescriptionStatus2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐPrescriptionStatus(ctx context.Context, v any) (PrescriptionStatus, error) {
-	var res PrescriptionStatus
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNPrescriptionStatus2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐPrescriptionStatus(ctx context.Context, sel ast.SelectionSet, v PrescriptionStatus) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
+func (ec *executionContext) marshalOPharmacy2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPharmacy(ctx context.Context, sel ast.SelectionSet, v *model1.Pharmacy) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
-	return res
+	return ec._Pharmacy(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
-	var vSlice []any
-	vSlice = graphql.CoerceList(v)
-	var err error
-	res := make([]string, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
-		}
+func (ec *executionContext) marshalOPrescriber2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescriberᚄ(ctx context.Context, sel ast.SelectionSet, v []model1.Prescriber) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
-	return res, nil
-}
-
-func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
 	for i := range v {
-		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPrescriber2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescriber(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
+	wg.Wait()
 
 	for _, e := range ret {
 		if e == graphql.Null {
@@ -4858,37 +24395,17 @@ func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel
 	return ret
 }
 
-func (ec *executionContext) unmarshalNTime2timeᚐTime(ctx context.Context, v any) (time.Time, error) {
-	res, err := graphql.UnmarshalTime(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalTime(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
+func (ec *executionContext) marshalOPrescriber2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescriber(ctx context.Context, sel ast.SelectionSet, v *model1.Prescriber) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNUpdatePatientInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdatePatientInput(ctx context.Context, v any) (UpdatePatientInput, error) {
-	res, err := ec.unmarshalInputUpdatePatientInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNUpdatePrescriptionInput2pharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdatePrescriptionInput(ctx context.Context, v any) (UpdatePrescriptionInput, error) {
-	res, err := ec.unmarshalInputUpdatePrescriptionInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
-	return ec.___Directive(ctx, sel, &v)
+	return ec._Prescriber(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Directive) graphql.Marshaler {
+func (ec *executionContext) marshalOPrescription2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescriptionᚄ(ctx context.Context, sel ast.SelectionSet, v []model1.Prescription) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -4912,7 +24429,7 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, sel, v[i])
+			ret[i] = ec.marshalNPrescription2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescription(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -4932,38 +24449,40 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 	return ret
 }
 
-func (ec *executionContext) unmarshalN__DirectiveLocation2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalOPrescription2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescription(ctx context.Context, sel ast.SelectionSet, v *model1.Prescription) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Prescription(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalN__DirectiveLocation2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
+func (ec *executionContext) marshalOPrescriptionHistoryConnection2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescriptionHistoryConnection(ctx context.Context, sel ast.SelectionSet, v *model1.PrescriptionHistoryConnection) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
-	return res
+	return ec._PrescriptionHistoryConnection(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
-	var vSlice []any
-	vSlice = graphql.CoerceList(v)
-	var err error
-	res := make([]string, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalN__DirectiveLocation2string(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
-		}
+func (ec *executionContext) unmarshalOPrescriptionStatus2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐPrescriptionStatus(ctx context.Context, v any) (*PrescriptionStatus, error) {
+	if v == nil {
+		return nil, nil
 	}
-	return res, nil
+	var res = new(PrescriptionStatus)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+func (ec *executionContext) marshalOPrescriptionStatus2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐPrescriptionStatus(ctx context.Context, sel ast.SelectionSet, v *PrescriptionStatus) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOPrescriptionTransmission2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋeprescribingᚋcontractsᚋmodelᚐPrescriptionTransmissionᚄ(ctx context.Context, sel ast.SelectionSet, v []model3.PrescriptionTransmission) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -4987,7 +24506,7 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
+			ret[i] = ec.marshalNPrescriptionTransmission2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋeprescribingᚋcontractsᚋmodelᚐPrescriptionTransmission(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -5007,19 +24526,62 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 	return ret
 }
 
-func (ec *executionContext) marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx context.Context, sel ast.SelectionSet, v introspection.EnumValue) graphql.Marshaler {
-	return ec.___EnumValue(ctx, sel, &v)
+func (ec *executionContext) marshalOPrescriptionTransmission2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋeprescribingᚋcontractsᚋmodelᚐPrescriptionTransmission(ctx context.Context, sel ast.SelectionSet, v *model3.PrescriptionTransmission) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._PrescriptionTransmission(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx context.Context, sel ast.SelectionSet, v introspection.Field) graphql.Marshaler {
-	return ec.___Field(ctx, sel, &v)
+func (ec *executionContext) marshalOPriceEstimate2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋbillingᚋcontractsᚋmodelᚐPriceEstimate(ctx context.Context, sel ast.SelectionSet, v *model2.PriceEstimate) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._PriceEstimate(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
-	return ec.___InputValue(ctx, sel, &v)
+func (ec *executionContext) marshalORecordAllergyPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐRecordAllergyPayload(ctx context.Context, sel ast.SelectionSet, v *RecordAllergyPayload) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._RecordAllergyPayload(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
+func (ec *executionContext) marshalORecordMeasurementPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐRecordMeasurementPayload(ctx context.Context, sel ast.SelectionSet, v *RecordMeasurementPayload) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._RecordMeasurementPayload(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOSigInput2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐSigInput(ctx context.Context, v any) (*SigInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputSigInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOSigLanguage2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐSigLanguage(ctx context.Context, v any) (*SigLanguage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(SigLanguage)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOSigLanguage2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐSigLanguage(ctx context.Context, sel ast.SelectionSet, v *SigLanguage) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOStateCount2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐStateCountᚄ(ctx context.Context, sel ast.SelectionSet, v []model.StateCount) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -5043,7 +24605,7 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
+			ret[i] = ec.marshalNStateCount2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐStateCount(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -5063,11 +24625,10 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 	return ret
 }
 
-func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
-	return ec.___Type(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+func (ec *executionContext) marshalOStatusCount2ᚕpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐStatusCountᚄ(ctx context.Context, sel ast.SelectionSet, v []model1.StatusCount) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -5091,7 +24652,7 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
+			ret[i] = ec.marshalNStatusCount2pharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐStatusCount(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -5111,138 +24672,144 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	return ret
 }
 
-func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec.___Type(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v any) (string, error) {
+func (ec *executionContext) unmarshalOString2string(ctx context.Context, v any) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+func (ec *executionContext) marshalOString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
 	_ = sel
+	_ = ctx
 	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+	return res
+}
+
+func (ec *executionContext) unmarshalOString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
 		}
 	}
-	return res
+	return res, nil
 }
 
-func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v any) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalOString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v any) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalString(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	_ = sel
 	_ = ctx
-	res := graphql.MarshalBoolean(v)
+	res := graphql.MarshalString(*v)
 	return res
 }
 
-func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v any) (*bool, error) {
+func (ec *executionContext) unmarshalOTime2ᚖtimeᚐTime(ctx context.Context, v any) (*time.Time, error) {
 	if v == nil {
 		return nil, nil
 	}
-	res, err := graphql.UnmarshalBoolean(v)
+	res, err := graphql.UnmarshalTime(v)
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
+func (ec *executionContext) marshalOTime2ᚖtimeᚐTime(ctx context.Context, sel ast.SelectionSet, v *time.Time) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	_ = sel
 	_ = ctx
-	res := graphql.MarshalBoolean(*v)
+	res := graphql.MarshalTime(*v)
 	return res
 }
 
-func (ec *executionContext) marshalOPatient2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋpatientᚋcontractsᚋmodelᚐPatient(ctx context.Context, sel ast.SelectionSet, v *model.Patient) graphql.Marshaler {
+func (ec *executionContext) marshalOTransmitPrescriptionPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐTransmitPrescriptionPayload(ctx context.Context, sel ast.SelectionSet, v *TransmitPrescriptionPayload) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return ec._Patient(ctx, sel, v)
+	return ec._TransmitPrescriptionPayload(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOPrescription2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋdomainᚋprescriptionᚋcontractsᚋmodelᚐPrescription(ctx context.Context, sel ast.SelectionSet, v *model1.Prescription) graphql.Marshaler {
+func (ec *executionContext) marshalOUpdateAddressPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdateAddressPayload(ctx context.Context, sel ast.SelectionSet, v *UpdateAddressPayload) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return ec._Prescription(ctx, sel, v)
+	return ec._UpdateAddressPayload(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalOPrescriptionStatus2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐPrescriptionStatus(ctx context.Context, v any) (*PrescriptionStatus, error) {
+func (ec *executionContext) marshalOUpdateAllergyPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdateAllergyPayload(ctx context.Context, sel ast.SelectionSet, v *UpdateAllergyPayload) graphql.Marshaler {
 	if v == nil {
-		return nil, nil
+		return graphql.Null
 	}
-	var res = new(PrescriptionStatus)
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ec._UpdateAllergyPayload(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOPrescriptionStatus2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐPrescriptionStatus(ctx context.Context, sel ast.SelectionSet, v *PrescriptionStatus) graphql.Marshaler {
+func (ec *executionContext) marshalOUpdateMeasurementPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdateMeasurementPayload(ctx context.Context, sel ast.SelectionSet, v *UpdateMeasurementPayload) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return v
+	return ec._UpdateMeasurementPayload(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalOString2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	_ = ctx
-	res := graphql.MarshalString(v)
-	return res
-}
-
-func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v any) (*string, error) {
+func (ec *executionContext) marshalOUpdatePatientPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdatePatientPayload(ctx context.Context, sel ast.SelectionSet, v *UpdatePatientPayload) graphql.Marshaler {
 	if v == nil {
-		return nil, nil
+		return graphql.Null
 	}
-	res, err := graphql.UnmarshalString(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+	return ec._UpdatePatientPayload(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+func (ec *executionContext) marshalOUpdatePrescriberPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdatePrescriberPayload(ctx context.Context, sel ast.SelectionSet, v *UpdatePrescriberPayload) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	_ = sel
-	_ = ctx
-	res := graphql.MarshalString(*v)
-	return res
+	return ec._UpdatePrescriberPayload(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalOTime2ᚖtimeᚐTime(ctx context.Context, v any) (*time.Time, error) {
+func (ec *executionContext) marshalOUpdatePrescriptionPayload2ᚖpharmacyᚑmodernizationᚑprojectᚑmodelᚋinternalᚋgraphqlᚋgeneratedᚐUpdatePrescriptionPayload(ctx context.Context, sel ast.SelectionSet, v *UpdatePrescriptionPayload) graphql.Marshaler {
 	if v == nil {
-		return nil, nil
+		return graphql.Null
 	}
-	res, err := graphql.UnmarshalTime(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+	return ec._UpdatePrescriptionPayload(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOTime2ᚖtimeᚐTime(ctx context.Context, sel ast.SelectionSet, v *time.Time) graphql.Marshaler {
+func (ec *executionContext) marshalO_Entity2githubᚗcomᚋ99designsᚋgqlgenᚋpluginᚋfederationᚋfedruntimeᚐEntity(ctx context.Context, sel ast.SelectionSet, v fedruntime.Entity) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	_ = sel
-	_ = ctx
-	res := graphql.MarshalTime(*v)
-	return res
+	return ec.__Entity(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
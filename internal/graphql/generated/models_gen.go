@@ -6,27 +6,112 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	model2 "pharmacy-modernization-project-model/domain/eprescribing/contracts/model"
+	"pharmacy-modernization-project-model/domain/patient/contracts/model"
+	model1 "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/dates"
 	"strconv"
 	"time"
 )
 
+type ContactPreferencesInput struct {
+	Channels     []string `json:"channels"`
+	Email        *string  `json:"email,omitempty"`
+	DoNotContact bool     `json:"doNotContact"`
+}
+
+type CreateAddressInput struct {
+	Line1 string  `json:"line1"`
+	Line2 *string `json:"line2,omitempty"`
+	City  string  `json:"city"`
+	State string  `json:"state"`
+	Zip   string  `json:"zip"`
+}
+
+type CreateAddressPayload struct {
+	Address    *model.Address `json:"address,omitempty"`
+	UserErrors []UserError    `json:"userErrors"`
+}
+
 type CreatePatientInput struct {
-	Name  string    `json:"name"`
-	Dob   time.Time `json:"dob"`
-	Phone string    `json:"phone"`
-	State string    `json:"state"`
+	Name  string            `json:"name"`
+	Dob   dates.PartialDate `json:"dob"`
+	Phone string            `json:"phone"`
+	State string            `json:"state"`
+}
+
+type CreatePatientPayload struct {
+	Patient             *model.Patient             `json:"patient,omitempty"`
+	PotentialDuplicates []model.PotentialDuplicate `json:"potentialDuplicates"`
+	UserErrors          []UserError                `json:"userErrors"`
+}
+
+type CreatePrescriberInput struct {
+	Npi        string  `json:"npi"`
+	FirstName  string  `json:"firstName"`
+	LastName   string  `json:"lastName"`
+	Credential *string `json:"credential,omitempty"`
+	Specialty  *string `json:"specialty,omitempty"`
+	Phone      *string `json:"phone,omitempty"`
+	Fax        *string `json:"fax,omitempty"`
+	Email      *string `json:"email,omitempty"`
+}
+
+type CreatePrescriberPayload struct {
+	Prescriber *model1.Prescriber `json:"prescriber,omitempty"`
+	UserErrors []UserError        `json:"userErrors"`
 }
 
 type CreatePrescriptionInput struct {
-	PatientID string             `json:"patientID"`
-	Drug      string             `json:"drug"`
-	Dose      string             `json:"dose"`
-	Status    PrescriptionStatus `json:"status"`
+	PatientID    string             `json:"patientID"`
+	PrescriberID string             `json:"prescriberID"`
+	Drug         string             `json:"drug"`
+	Dose         *string            `json:"dose,omitempty"`
+	Dosage       *DoseInput         `json:"dosage,omitempty"`
+	Status       PrescriptionStatus `json:"status"`
+	Sig          *SigInput          `json:"sig,omitempty"`
+	SigText      *string            `json:"sigText,omitempty"`
+	Quantity     *int               `json:"quantity,omitempty"`
+	DaysSupply   *int               `json:"daysSupply,omitempty"`
+}
+
+type CreatePrescriptionPayload struct {
+	Prescription *model1.Prescription `json:"prescription,omitempty"`
+	UserErrors   []UserError          `json:"userErrors"`
 }
 
 type DashboardStats struct {
-	TotalPatients       int `json:"totalPatients"`
-	ActivePrescriptions int `json:"activePrescriptions"`
+	TotalPatients         int                  `json:"totalPatients"`
+	ActivePrescriptions   int                  `json:"activePrescriptions"`
+	PatientsByState       []model.StateCount   `json:"patientsByState"`
+	PrescriptionsByStatus []model1.StatusCount `json:"prescriptionsByStatus"`
+}
+
+type DeleteAddressPayload struct {
+	DeletedID  *string     `json:"deletedID,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
+}
+
+type DeleteAllergyPayload struct {
+	DeletedID  *string     `json:"deletedID,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
+}
+
+type DeleteMeasurementPayload struct {
+	DeletedID  *string     `json:"deletedID,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
+}
+
+type DeletePrescriberPayload struct {
+	DeletedID  *string     `json:"deletedID,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
+}
+
+type DoseInput struct {
+	Value     float64 `json:"value"`
+	Unit      string  `json:"unit"`
+	Frequency *string `json:"frequency,omitempty"`
+	Route     *string `json:"route,omitempty"`
 }
 
 type Mutation struct {
@@ -35,17 +120,189 @@ type Mutation struct {
 type Query struct {
 }
 
+type RecordAllergyInput struct {
+	Substance string  `json:"substance"`
+	Reaction  *string `json:"reaction,omitempty"`
+	Severity  string  `json:"severity"`
+}
+
+type RecordAllergyPayload struct {
+	Allergy    *model.Allergy `json:"allergy,omitempty"`
+	UserErrors []UserError    `json:"userErrors"`
+}
+
+type RecordMeasurementInput struct {
+	Type       string     `json:"type"`
+	Value      float64    `json:"value"`
+	Unit       string     `json:"unit"`
+	RecordedAt *time.Time `json:"recordedAt,omitempty"`
+}
+
+type RecordMeasurementPayload struct {
+	Measurement *model.Measurement `json:"measurement,omitempty"`
+	UserErrors  []UserError        `json:"userErrors"`
+}
+
+type SigInput struct {
+	DoseQuantity *float64 `json:"doseQuantity,omitempty"`
+	DoseUnit     *string  `json:"doseUnit,omitempty"`
+	Route        *string  `json:"route,omitempty"`
+	Frequency    *string  `json:"frequency,omitempty"`
+	Timing       *string  `json:"timing,omitempty"`
+	AsNeeded     *bool    `json:"asNeeded,omitempty"`
+	Indication   *string  `json:"indication,omitempty"`
+}
+
+type TransmitPrescriptionPayload struct {
+	Transmission *model2.PrescriptionTransmission `json:"transmission,omitempty"`
+	UserErrors   []UserError                      `json:"userErrors"`
+}
+
+type UpdateAddressInput struct {
+	Line1 *string `json:"line1,omitempty"`
+	Line2 *string `json:"line2,omitempty"`
+	City  *string `json:"city,omitempty"`
+	State *string `json:"state,omitempty"`
+	Zip   *string `json:"zip,omitempty"`
+}
+
+type UpdateAddressPayload struct {
+	Address    *model.Address `json:"address,omitempty"`
+	UserErrors []UserError    `json:"userErrors"`
+}
+
+type UpdateAllergyInput struct {
+	Substance *string `json:"substance,omitempty"`
+	Reaction  *string `json:"reaction,omitempty"`
+	Severity  *string `json:"severity,omitempty"`
+}
+
+type UpdateAllergyPayload struct {
+	Allergy    *model.Allergy `json:"allergy,omitempty"`
+	UserErrors []UserError    `json:"userErrors"`
+}
+
+type UpdateMeasurementInput struct {
+	Value      *float64   `json:"value,omitempty"`
+	Unit       *string    `json:"unit,omitempty"`
+	RecordedAt *time.Time `json:"recordedAt,omitempty"`
+}
+
+type UpdateMeasurementPayload struct {
+	Measurement *model.Measurement `json:"measurement,omitempty"`
+	UserErrors  []UserError        `json:"userErrors"`
+}
+
 type UpdatePatientInput struct {
-	Name  *string    `json:"name,omitempty"`
-	Dob   *time.Time `json:"dob,omitempty"`
-	Phone *string    `json:"phone,omitempty"`
-	State *string    `json:"state,omitempty"`
+	Name               *string                  `json:"name,omitempty"`
+	Dob                *dates.PartialDate       `json:"dob,omitempty"`
+	Phone              *string                  `json:"phone,omitempty"`
+	State              *string                  `json:"state,omitempty"`
+	ContactPreferences *ContactPreferencesInput `json:"contactPreferences,omitempty"`
+}
+
+type UpdatePatientPayload struct {
+	Patient    *model.Patient `json:"patient,omitempty"`
+	UserErrors []UserError    `json:"userErrors"`
+}
+
+type UpdatePrescriberInput struct {
+	Npi        *string `json:"npi,omitempty"`
+	FirstName  *string `json:"firstName,omitempty"`
+	LastName   *string `json:"lastName,omitempty"`
+	Credential *string `json:"credential,omitempty"`
+	Specialty  *string `json:"specialty,omitempty"`
+	Phone      *string `json:"phone,omitempty"`
+	Fax        *string `json:"fax,omitempty"`
+	Email      *string `json:"email,omitempty"`
+}
+
+type UpdatePrescriberPayload struct {
+	Prescriber *model1.Prescriber `json:"prescriber,omitempty"`
+	UserErrors []UserError        `json:"userErrors"`
 }
 
 type UpdatePrescriptionInput struct {
-	Drug   *string             `json:"drug,omitempty"`
-	Dose   *string             `json:"dose,omitempty"`
-	Status *PrescriptionStatus `json:"status,omitempty"`
+	Drug       *string             `json:"drug,omitempty"`
+	Dose       *string             `json:"dose,omitempty"`
+	Dosage     *DoseInput          `json:"dosage,omitempty"`
+	Status     *PrescriptionStatus `json:"status,omitempty"`
+	Sig        *SigInput           `json:"sig,omitempty"`
+	SigText    *string             `json:"sigText,omitempty"`
+	Quantity   *int                `json:"quantity,omitempty"`
+	DaysSupply *int                `json:"daysSupply,omitempty"`
+}
+
+type UpdatePrescriptionPayload struct {
+	Prescription *model1.Prescription `json:"prescription,omitempty"`
+	UserErrors   []UserError          `json:"userErrors"`
+}
+
+type UserError struct {
+	Field   *string `json:"field,omitempty"`
+	Code    string  `json:"code"`
+	Message string  `json:"message"`
+}
+
+type PrescriptionHistoryEventType string
+
+const (
+	PrescriptionHistoryEventTypeCreated          PrescriptionHistoryEventType = "CREATED"
+	PrescriptionHistoryEventTypeStatusChanged    PrescriptionHistoryEventType = "STATUS_CHANGED"
+	PrescriptionHistoryEventTypeDispensed        PrescriptionHistoryEventType = "DISPENSED"
+	PrescriptionHistoryEventTypeDispenseReversed PrescriptionHistoryEventType = "DISPENSE_REVERSED"
+	PrescriptionHistoryEventTypeRouted           PrescriptionHistoryEventType = "ROUTED"
+)
+
+var AllPrescriptionHistoryEventType = []PrescriptionHistoryEventType{
+	PrescriptionHistoryEventTypeCreated,
+	PrescriptionHistoryEventTypeStatusChanged,
+	PrescriptionHistoryEventTypeDispensed,
+	PrescriptionHistoryEventTypeDispenseReversed,
+	PrescriptionHistoryEventTypeRouted,
+}
+
+func (e PrescriptionHistoryEventType) IsValid() bool {
+	switch e {
+	case PrescriptionHistoryEventTypeCreated, PrescriptionHistoryEventTypeStatusChanged, PrescriptionHistoryEventTypeDispensed, PrescriptionHistoryEventTypeDispenseReversed, PrescriptionHistoryEventTypeRouted:
+		return true
+	}
+	return false
+}
+
+func (e PrescriptionHistoryEventType) String() string {
+	return string(e)
+}
+
+func (e *PrescriptionHistoryEventType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PrescriptionHistoryEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PrescriptionHistoryEventType", str)
+	}
+	return nil
+}
+
+func (e PrescriptionHistoryEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *PrescriptionHistoryEventType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e PrescriptionHistoryEventType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
 }
 
 type PrescriptionStatus string
@@ -55,6 +312,7 @@ const (
 	PrescriptionStatusActive    PrescriptionStatus = "ACTIVE"
 	PrescriptionStatusPaused    PrescriptionStatus = "PAUSED"
 	PrescriptionStatusCompleted PrescriptionStatus = "COMPLETED"
+	PrescriptionStatusExpired   PrescriptionStatus = "EXPIRED"
 )
 
 var AllPrescriptionStatus = []PrescriptionStatus{
@@ -62,11 +320,12 @@ var AllPrescriptionStatus = []PrescriptionStatus{
 	PrescriptionStatusActive,
 	PrescriptionStatusPaused,
 	PrescriptionStatusCompleted,
+	PrescriptionStatusExpired,
 }
 
 func (e PrescriptionStatus) IsValid() bool {
 	switch e {
-	case PrescriptionStatusDraft, PrescriptionStatusActive, PrescriptionStatusPaused, PrescriptionStatusCompleted:
+	case PrescriptionStatusDraft, PrescriptionStatusActive, PrescriptionStatusPaused, PrescriptionStatusCompleted, PrescriptionStatusExpired:
 		return true
 	}
 	return false
@@ -106,3 +365,119 @@ func (e PrescriptionStatus) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+type SigLanguage string
+
+const (
+	SigLanguageEn SigLanguage = "EN"
+	SigLanguageEs SigLanguage = "ES"
+)
+
+var AllSigLanguage = []SigLanguage{
+	SigLanguageEn,
+	SigLanguageEs,
+}
+
+func (e SigLanguage) IsValid() bool {
+	switch e {
+	case SigLanguageEn, SigLanguageEs:
+		return true
+	}
+	return false
+}
+
+func (e SigLanguage) String() string {
+	return string(e)
+}
+
+func (e *SigLanguage) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SigLanguage(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SigLanguage", str)
+	}
+	return nil
+}
+
+func (e SigLanguage) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SigLanguage) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SigLanguage) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TransmissionStatus string
+
+const (
+	TransmissionStatusQueued   TransmissionStatus = "QUEUED"
+	TransmissionStatusSent     TransmissionStatus = "SENT"
+	TransmissionStatusVerified TransmissionStatus = "VERIFIED"
+	TransmissionStatusRejected TransmissionStatus = "REJECTED"
+	TransmissionStatusFailed   TransmissionStatus = "FAILED"
+)
+
+var AllTransmissionStatus = []TransmissionStatus{
+	TransmissionStatusQueued,
+	TransmissionStatusSent,
+	TransmissionStatusVerified,
+	TransmissionStatusRejected,
+	TransmissionStatusFailed,
+}
+
+func (e TransmissionStatus) IsValid() bool {
+	switch e {
+	case TransmissionStatusQueued, TransmissionStatusSent, TransmissionStatusVerified, TransmissionStatusRejected, TransmissionStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e TransmissionStatus) String() string {
+	return string(e)
+}
+
+func (e *TransmissionStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TransmissionStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TransmissionStatus", str)
+	}
+	return nil
+}
+
+func (e TransmissionStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TransmissionStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TransmissionStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
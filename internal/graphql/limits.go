@@ -0,0 +1,202 @@
+package graphql
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+
+	gql "github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.uber.org/zap"
+
+	authplatform "pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/config"
+)
+
+// Error codes returned in the extensions of a rejected operation
+const (
+	ErrCodeQueryTooDeep    = "QUERY_TOO_DEEP"
+	ErrCodeQueryTooComplex = "QUERY_TOO_COMPLEX"
+)
+
+// maxLoggedQueryLength caps how much of an offending query is logged
+const maxLoggedQueryLength = 2000
+
+// QueryLimits caps how deep and how expensive a single operation may be. Each field costs 1 plus
+// the cost of its selections, multiplied by the expected size for list fields: the value of a
+// "limit" argument, or DefaultListSize. Introspection fields are not counted.
+type QueryLimits struct {
+	MaxDepth        int // 0 disables the depth limit
+	MaxComplexity   int // 0 disables the complexity limit
+	DefaultListSize int
+}
+
+// LimitsFromConfig reads the limits from the graphql settings
+func LimitsFromConfig(c config.GraphQLConfig) QueryLimits {
+	return QueryLimits{
+		MaxDepth:        c.MaxDepth,
+		MaxComplexity:   c.MaxComplexity,
+		DefaultListSize: c.DefaultListSize,
+	}
+}
+
+func (l *QueryLimits) setDefaults() {
+	if l.DefaultListSize <= 0 {
+		l.DefaultListSize = 10
+	}
+}
+
+// queryLimiter rejects operations over the limits before any resolver runs; setLimits replaces
+// them for the operations that start afterwards
+type queryLimiter struct {
+	limits *atomic.Pointer[QueryLimits]
+	log    *zap.Logger
+}
+
+var _ interface {
+	gql.HandlerExtension
+	gql.OperationContextMutator
+} = queryLimiter{}
+
+func newQueryLimiter(limits QueryLimits, log *zap.Logger) queryLimiter {
+	l := queryLimiter{limits: &atomic.Pointer[QueryLimits]{}, log: log}
+	l.setLimits(limits)
+	return l
+}
+
+func (l queryLimiter) setLimits(limits QueryLimits) {
+	limits.setDefaults()
+	l.limits.Store(&limits)
+}
+
+func (l queryLimiter) ExtensionName() string {
+	return "QueryLimits"
+}
+
+func (l queryLimiter) Validate(gql.ExecutableSchema) error {
+	return nil
+}
+
+func (l queryLimiter) MutateOperationContext(ctx context.Context, opCtx *gql.OperationContext) *gqlerror.Error {
+	if opCtx.Operation == nil {
+		return nil
+	}
+
+	limits := *l.limits.Load()
+	depth := selectionDepth(opCtx.Operation.SelectionSet)
+	if limits.MaxDepth > 0 && depth > limits.MaxDepth {
+		l.reject(ctx, opCtx, "depth", depth, limits.MaxDepth)
+		return limitError(ErrCodeQueryTooDeep, "query depth %d exceeds the limit of %d", depth, limits.MaxDepth)
+	}
+
+	complexity := selectionComplexity(limits, opCtx.Operation.SelectionSet, opCtx.Variables)
+	if limits.MaxComplexity > 0 && complexity > limits.MaxComplexity {
+		l.reject(ctx, opCtx, "complexity", complexity, limits.MaxComplexity)
+		return limitError(ErrCodeQueryTooComplex, "query complexity %d exceeds the limit of %d", complexity, limits.MaxComplexity)
+	}
+	return nil
+}
+
+// reject logs the offending query; variables are left out since they usually carry patient data
+func (l queryLimiter) reject(ctx context.Context, opCtx *gql.OperationContext, limit string, actual, max int) {
+	query := opCtx.RawQuery
+	if len(query) > maxLoggedQueryLength {
+		query = query[:maxLoggedQueryLength] + "…"
+	}
+	fields := []zap.Field{
+		zap.String("limit", limit),
+		zap.Int("actual", actual),
+		zap.Int("max", max),
+		zap.String("operation", opCtx.OperationName),
+		zap.String("query", query),
+	}
+	if user, err := authplatform.GetCurrentUser(ctx); err == nil {
+		fields = append(fields, zap.String("user_id", user.ID))
+	}
+	l.log.Warn("GraphQL operation rejected by query limits", fields...)
+}
+
+func limitError(code, format string, actual, max int) *gqlerror.Error {
+	err := gqlerror.Errorf(format, actual, max)
+	err.Extensions = map[string]any{
+		"code":   code,
+		"actual": actual,
+		"limit":  max,
+	}
+	return err
+}
+
+// selectionDepth is the number of nested field levels, following fragments
+func selectionDepth(selections ast.SelectionSet) int {
+	deepest := 0
+	for _, selection := range selections {
+		depth := 0
+		switch s := selection.(type) {
+		case *ast.Field:
+			if isIntrospection(s) {
+				continue
+			}
+			depth = 1 + selectionDepth(s.SelectionSet)
+		case *ast.InlineFragment:
+			depth = selectionDepth(s.SelectionSet)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				depth = selectionDepth(s.Definition.SelectionSet)
+			}
+		}
+		deepest = max(deepest, depth)
+	}
+	return deepest
+}
+
+// selectionComplexity estimates how many values resolving the selections produces
+func selectionComplexity(limits QueryLimits, selections ast.SelectionSet, variables map[string]any) int {
+	total := 0
+	for _, selection := range selections {
+		switch s := selection.(type) {
+		case *ast.Field:
+			if isIntrospection(s) {
+				continue
+			}
+			children := selectionComplexity(limits, s.SelectionSet, variables)
+			if s.Definition != nil && s.Definition.Type.Elem != nil {
+				children *= listSize(limits, s, variables)
+			}
+			total += 1 + children
+		case *ast.InlineFragment:
+			total += selectionComplexity(limits, s.SelectionSet, variables)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				total += selectionComplexity(limits, s.Definition.SelectionSet, variables)
+			}
+		}
+		// Saturate instead of overflowing on absurd queries
+		if limits.MaxComplexity > 0 && total > limits.MaxComplexity*1000 {
+			return total
+		}
+	}
+	return total
+}
+
+// listSize is the number of items a list field is expected to return
+func listSize(limits QueryLimits, field *ast.Field, variables map[string]any) int {
+	if field.Definition.Arguments.ForName("limit") == nil {
+		return limits.DefaultListSize
+	}
+	switch limit := field.ArgumentMap(variables)["limit"].(type) {
+	case int64:
+		if limit > 0 {
+			return int(limit)
+		}
+	case int:
+		if limit > 0 {
+			return limit
+		}
+	}
+	return limits.DefaultListSize
+}
+
+func isIntrospection(field *ast.Field) bool {
+	return strings.HasPrefix(field.Name, "__")
+}
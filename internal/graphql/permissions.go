@@ -0,0 +1,54 @@
+package graphql
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"pharmacy-modernization-project-model/internal/platform/permissions"
+)
+
+// schemaRequirements lists the fields guarded by the @hasPermission, @permissionAny and
+// @permissionAll directives of the schema, so their permissions can be checked against the
+// catalogue and documented when the server is mounted. A @hasPermission field is recorded with
+// every permission the catalogue accepts for it.
+func schemaRequirements(schema *ast.Schema) []permissions.Requirement {
+	var reqs []permissions.Requirement
+	for _, def := range schema.Types {
+		for _, field := range def.Fields {
+			for _, directive := range field.Directives {
+				var match string
+				switch directive.Name {
+				case "hasPermission":
+					req := permissions.Requirement{Kind: permissions.KindGraphQL, Path: def.Name + "." + field.Name, Match: "any"}
+					if arg := directive.Arguments.ForName("permission"); arg != nil && arg.Value != nil {
+						req.Permissions = permissions.Accepting(arg.Value.Raw)
+					}
+					reqs = append(reqs, req)
+					continue
+				case "permissionAny":
+					match = "any"
+				case "permissionAll":
+					match = "all"
+				default:
+					continue
+				}
+				req := permissions.Requirement{Kind: permissions.KindGraphQL, Path: def.Name + "." + field.Name, Match: match}
+				if arg := directive.Arguments.ForName("requires"); arg != nil && arg.Value != nil {
+					for _, child := range arg.Value.Children {
+						req.Permissions = append(req.Permissions, child.Value.Raw)
+					}
+				}
+				reqs = append(reqs, req)
+			}
+		}
+	}
+	return reqs
+}
+
+// schemaPermissions lists the permissions the schema's directives require
+func schemaPermissions(reqs []permissions.Requirement) []string {
+	var required []string
+	for _, req := range reqs {
+		required = append(required, req.Permissions...)
+	}
+	return required
+}
@@ -0,0 +1,171 @@
+package graphql
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	gql "github.com/99designs/gqlgen/graphql"
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/cache"
+)
+
+// Error codes returned in the extensions of a refused persisted query
+const (
+	ErrCodePersistedQueryNotFound   = "PERSISTED_QUERY_NOT_FOUND"
+	ErrCodePersistedQueryNotAllowed = "PERSISTED_QUERY_NOT_ALLOWED"
+)
+
+// PersistedQueries configures automatic persisted queries (APQ): clients send the SHA-256 hash of
+// a query in extensions.persistedQuery and only send the full query when the server answers
+// PERSISTED_QUERY_NOT_FOUND, registering it for the next requests. Queries of the allow-list are
+// known from the start.
+type PersistedQueries struct {
+	Cache    cache.Cache // Remembers registered queries across instances; nil keeps them nowhere
+	CacheTTL time.Duration
+	// AllowList holds the pre-registered queries by the hex SHA-256 of their text
+	AllowList map[string]string
+	// AllowListOnly refuses every query that is not in the allow-list, whether it is sent by hash
+	// or in full; clients cannot register new ones
+	AllowListOnly bool
+}
+
+// apqKeyPrefix namespaces registered queries in the cache
+const apqKeyPrefix = "graphql:apq:"
+
+// LoadAllowList reads the allow-list from the .graphql files in dir, one operation per file. A
+// query is registered under the hash of the file's content with surrounding whitespace trimmed,
+// which is what clients must send. A missing directory is an empty allow-list.
+func LoadAllowList(dir string) (map[string]string, error) {
+	allowList := map[string]string{}
+	if dir == "" {
+		return allowList, nil
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.graphql"))
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("persisted query %s: %w", file, err)
+		}
+		query := strings.TrimSpace(string(content))
+		allowList[QueryHash(query)] = query
+	}
+	return allowList, nil
+}
+
+// QueryHash is the hex SHA-256 of a query, the hash APQ clients send
+func QueryHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+// persistedQueries resolves hashed queries before they are parsed, and in allow-list mode refuses
+// the queries that are not registered
+type persistedQueries struct {
+	config PersistedQueries
+	log    *zap.Logger
+}
+
+var _ interface {
+	gql.HandlerExtension
+	gql.OperationParameterMutator
+} = persistedQueries{}
+
+func (p persistedQueries) ExtensionName() string {
+	return "PersistedQueries"
+}
+
+func (p persistedQueries) Validate(gql.ExecutableSchema) error {
+	return nil
+}
+
+func (p persistedQueries) MutateOperationParameters(ctx context.Context, params *gql.RawParams) *gqlerror.Error {
+	var extension struct {
+		Sha256  string `mapstructure:"sha256Hash"`
+		Version int64  `mapstructure:"version"`
+	}
+	if raw := params.Extensions["persistedQuery"]; raw != nil {
+		if err := mapstructure.Decode(raw, &extension); err != nil {
+			return gqlerror.Errorf("invalid persistedQuery extension")
+		}
+		if extension.Version != 1 {
+			return gqlerror.Errorf("unsupported persistedQuery version %d", extension.Version)
+		}
+	}
+
+	hash := extension.Sha256
+	if params.Query != "" {
+		actual := QueryHash(params.Query)
+		if hash != "" && hash != actual {
+			return gqlerror.Errorf("persistedQuery hash does not match the query")
+		}
+		hash = actual
+	}
+
+	if p.config.AllowListOnly {
+		query, ok := p.config.AllowList[hash]
+		if !ok {
+			p.log.Warn("GraphQL operation refused, not in the persisted query allow-list",
+				zap.String("hash", hash),
+				zap.String("operation", params.OperationName))
+			return persistedQueryError(ErrCodePersistedQueryNotAllowed, "query is not in the persisted query allow-list")
+		}
+		params.Query = query
+		return nil
+	}
+
+	if extension.Sha256 == "" {
+		return nil
+	}
+	if params.Query != "" {
+		p.register(ctx, hash, params.Query)
+		return nil
+	}
+	query, ok := p.lookup(ctx, hash)
+	if !ok {
+		// Apollo clients retry with the full query on this message
+		return persistedQueryError(ErrCodePersistedQueryNotFound, "PersistedQueryNotFound")
+	}
+	params.Query = query
+	return nil
+}
+
+func (p persistedQueries) lookup(ctx context.Context, hash string) (string, bool) {
+	if query, ok := p.config.AllowList[hash]; ok {
+		return query, true
+	}
+	if p.config.Cache == nil {
+		return "", false
+	}
+	query, err := p.config.Cache.Get(ctx, apqKeyPrefix+cache.SanitizeKey(hash))
+	if err != nil {
+		return "", false
+	}
+	return string(query), true
+}
+
+func (p persistedQueries) register(ctx context.Context, hash, query string) {
+	if _, ok := p.config.AllowList[hash]; ok || p.config.Cache == nil {
+		return
+	}
+	if err := p.config.Cache.Set(ctx, apqKeyPrefix+cache.SanitizeKey(hash), []byte(query), p.config.CacheTTL); err != nil {
+		p.log.Warn("Failed to register persisted query", zap.String("hash", hash), zap.Error(err))
+	}
+}
+
+func persistedQueryError(code, message string) *gqlerror.Error {
+	err := gqlerror.Errorf("%s", message)
+	err.Extensions = map[string]any{"code": code}
+	return err
+}
@@ -1,9 +1,12 @@
 package graphql
 
 import (
+	billinggraphql "pharmacy-modernization-project-model/domain/billing/graphql"
 	dashboardgraphql "pharmacy-modernization-project-model/domain/dashboard/graphql"
+	eprescribinggraphql "pharmacy-modernization-project-model/domain/eprescribing/graphql"
 	patientgraphql "pharmacy-modernization-project-model/domain/patient/graphql"
 	prescriptiongraphql "pharmacy-modernization-project-model/domain/prescription/graphql"
+	reportinggraphql "pharmacy-modernization-project-model/domain/reporting/graphql"
 )
 
 // This file will not be regenerated automatically.
@@ -15,4 +18,7 @@ type Resolver struct {
 	PatientResolver      *patientgraphql.PatientResolver
 	PrescriptionResolver *prescriptiongraphql.PrescriptionResolver
 	DashboardResolver    *dashboardgraphql.DashboardResolver
+	BillingResolver      *billinggraphql.BillingResolver
+	TransmissionResolver *eprescribinggraphql.TransmissionResolver
+	ReportingResolver    *reportinggraphql.ReportingResolver
 }
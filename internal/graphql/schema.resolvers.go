@@ -2,50 +2,201 @@ package graphql
 
 import (
 	"context"
+	model2 "pharmacy-modernization-project-model/domain/billing/contracts/model"
+	model3 "pharmacy-modernization-project-model/domain/eprescribing/contracts/model"
 	"pharmacy-modernization-project-model/domain/patient/contracts/model"
 	model1 "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	model4 "pharmacy-modernization-project-model/domain/reporting/contracts/model"
 	"pharmacy-modernization-project-model/internal/graphql/generated"
 )
 
+// Severity is the resolver for the severity field.
+func (r *allergyResolver) Severity(ctx context.Context, obj *model.Allergy) (string, error) {
+	return r.PatientResolver.AllergyResolver.Severity(ctx, obj)
+}
+
+// Channels is the resolver for the channels field.
+func (r *contactPreferencesResolver) Channels(ctx context.Context, obj *model.ContactPreferences) ([]string, error) {
+	return r.PatientResolver.ContactChannels(ctx, obj)
+}
+
+// Unit is the resolver for the unit field.
+func (r *doseResolver) Unit(ctx context.Context, obj *model1.Dose) (string, error) {
+	return string(obj.Unit), nil
+}
+
+// Frequency is the resolver for the frequency field.
+func (r *doseResolver) Frequency(ctx context.Context, obj *model1.Dose) (string, error) {
+	return string(obj.Frequency), nil
+}
+
+// Route is the resolver for the route field.
+func (r *doseResolver) Route(ctx context.Context, obj *model1.Dose) (string, error) {
+	return string(obj.Route), nil
+}
+
+// Severity is the resolver for the severity field.
+func (r *drugInteractionWarningResolver) Severity(ctx context.Context, obj *model1.DrugInteractionWarning) (string, error) {
+	// Delegate to prescription domain resolver
+	return r.PrescriptionResolver.Severity(ctx, obj)
+}
+
+// Status is the resolver for the status field.
+func (r *insuranceRecordResolver) Status(ctx context.Context, obj *model.InsuranceRecord) (string, error) {
+	return r.PatientResolver.InsuranceResolver.Status(ctx, obj)
+}
+
+// Active is the resolver for the active field.
+func (r *insuranceRecordResolver) Active(ctx context.Context, obj *model.InsuranceRecord) (bool, error) {
+	return r.PatientResolver.InsuranceResolver.Active(ctx, obj)
+}
+
+// Type is the resolver for the type field.
+func (r *measurementResolver) Type(ctx context.Context, obj *model.Measurement) (string, error) {
+	return r.PatientResolver.MeasurementResolver.Type(ctx, obj)
+}
+
 // Empty is the resolver for the _empty field.
 func (r *mutationResolver) Empty(ctx context.Context) (*string, error) {
 	return nil, nil
 }
 
+// CreateInvoiceForPrescription is the resolver for the createInvoiceForPrescription field.
+func (r *mutationResolver) CreateInvoiceForPrescription(ctx context.Context, prescriptionID string, amount *float64, description *string) (*model2.Invoice, error) {
+	// Delegate to billing domain resolver
+	return r.BillingResolver.CreateInvoiceForPrescription(ctx, prescriptionID, amount, description)
+}
+
+// AcknowledgeInvoice is the resolver for the acknowledgeInvoice field.
+func (r *mutationResolver) AcknowledgeInvoice(ctx context.Context, prescriptionID string, notes *string) (*model2.Invoice, error) {
+	// Delegate to billing domain resolver
+	return r.BillingResolver.AcknowledgeInvoice(ctx, prescriptionID, notes)
+}
+
+// TransmitPrescription is the resolver for the transmitPrescription field.
+func (r *mutationResolver) TransmitPrescription(ctx context.Context, prescriptionID string) (*generated.TransmitPrescriptionPayload, error) {
+	return r.TransmissionResolver.TransmitPrescription(ctx, prescriptionID)
+}
+
 // CreatePatient is the resolver for the createPatient field.
-func (r *mutationResolver) CreatePatient(ctx context.Context, input generated.CreatePatientInput) (*model.Patient, error) {
+func (r *mutationResolver) CreatePatient(ctx context.Context, input generated.CreatePatientInput) (*generated.CreatePatientPayload, error) {
 	// Delegate to patient domain resolver
 	return r.PatientResolver.CreatePatient(ctx, input)
 }
 
 // UpdatePatient is the resolver for the updatePatient field.
-func (r *mutationResolver) UpdatePatient(ctx context.Context, id string, input generated.UpdatePatientInput) (*model.Patient, error) {
+func (r *mutationResolver) UpdatePatient(ctx context.Context, id string, input generated.UpdatePatientInput) (*generated.UpdatePatientPayload, error) {
 	// Delegate to patient domain resolver
 	return r.PatientResolver.UpdatePatient(ctx, id, input)
 }
 
+// CreateAddress is the resolver for the createAddress field.
+func (r *mutationResolver) CreateAddress(ctx context.Context, patientID string, input generated.CreateAddressInput) (*generated.CreateAddressPayload, error) {
+	return r.PatientResolver.AddressResolver.CreateAddress(ctx, patientID, input)
+}
+
+// UpdateAddress is the resolver for the updateAddress field.
+func (r *mutationResolver) UpdateAddress(ctx context.Context, patientID string, id string, input generated.UpdateAddressInput) (*generated.UpdateAddressPayload, error) {
+	return r.PatientResolver.AddressResolver.UpdateAddress(ctx, patientID, id, input)
+}
+
+// DeleteAddress is the resolver for the deleteAddress field.
+func (r *mutationResolver) DeleteAddress(ctx context.Context, patientID string, id string) (*generated.DeleteAddressPayload, error) {
+	return r.PatientResolver.AddressResolver.DeleteAddress(ctx, patientID, id)
+}
+
+// RecordMeasurement is the resolver for the recordMeasurement field.
+func (r *mutationResolver) RecordMeasurement(ctx context.Context, patientID string, input generated.RecordMeasurementInput) (*generated.RecordMeasurementPayload, error) {
+	return r.PatientResolver.MeasurementResolver.RecordMeasurement(ctx, patientID, input)
+}
+
+// UpdateMeasurement is the resolver for the updateMeasurement field.
+func (r *mutationResolver) UpdateMeasurement(ctx context.Context, patientID string, id string, input generated.UpdateMeasurementInput) (*generated.UpdateMeasurementPayload, error) {
+	return r.PatientResolver.MeasurementResolver.UpdateMeasurement(ctx, patientID, id, input)
+}
+
+// DeleteMeasurement is the resolver for the deleteMeasurement field.
+func (r *mutationResolver) DeleteMeasurement(ctx context.Context, patientID string, id string) (*generated.DeleteMeasurementPayload, error) {
+	return r.PatientResolver.MeasurementResolver.DeleteMeasurement(ctx, patientID, id)
+}
+
+// RecordAllergy is the resolver for the recordAllergy field.
+func (r *mutationResolver) RecordAllergy(ctx context.Context, patientID string, input generated.RecordAllergyInput) (*generated.RecordAllergyPayload, error) {
+	return r.PatientResolver.AllergyResolver.RecordAllergy(ctx, patientID, input)
+}
+
+// UpdateAllergy is the resolver for the updateAllergy field.
+func (r *mutationResolver) UpdateAllergy(ctx context.Context, patientID string, id string, input generated.UpdateAllergyInput) (*generated.UpdateAllergyPayload, error) {
+	return r.PatientResolver.AllergyResolver.UpdateAllergy(ctx, patientID, id, input)
+}
+
+// DeleteAllergy is the resolver for the deleteAllergy field.
+func (r *mutationResolver) DeleteAllergy(ctx context.Context, patientID string, id string) (*generated.DeleteAllergyPayload, error) {
+	return r.PatientResolver.AllergyResolver.DeleteAllergy(ctx, patientID, id)
+}
+
 // CreatePrescription is the resolver for the createPrescription field.
-func (r *mutationResolver) CreatePrescription(ctx context.Context, input generated.CreatePrescriptionInput) (*model1.Prescription, error) {
+func (r *mutationResolver) CreatePrescription(ctx context.Context, input generated.CreatePrescriptionInput) (*generated.CreatePrescriptionPayload, error) {
 	// Delegate to prescription domain resolver
 	return r.PrescriptionResolver.CreatePrescription(ctx, input)
 }
 
 // UpdatePrescription is the resolver for the updatePrescription field.
-func (r *mutationResolver) UpdatePrescription(ctx context.Context, id string, input generated.UpdatePrescriptionInput) (*model1.Prescription, error) {
+func (r *mutationResolver) UpdatePrescription(ctx context.Context, id string, input generated.UpdatePrescriptionInput) (*generated.UpdatePrescriptionPayload, error) {
 	// Delegate to prescription domain resolver
 	return r.PrescriptionResolver.UpdatePrescription(ctx, id, input)
 }
 
+// CreatePrescriber is the resolver for the createPrescriber field.
+func (r *mutationResolver) CreatePrescriber(ctx context.Context, input generated.CreatePrescriberInput) (*generated.CreatePrescriberPayload, error) {
+	return r.PrescriptionResolver.PrescriberResolver.CreatePrescriber(ctx, input)
+}
+
+// UpdatePrescriber is the resolver for the updatePrescriber field.
+func (r *mutationResolver) UpdatePrescriber(ctx context.Context, id string, input generated.UpdatePrescriberInput) (*generated.UpdatePrescriberPayload, error) {
+	return r.PrescriptionResolver.PrescriberResolver.UpdatePrescriber(ctx, id, input)
+}
+
+// DeletePrescriber is the resolver for the deletePrescriber field.
+func (r *mutationResolver) DeletePrescriber(ctx context.Context, id string) (*generated.DeletePrescriberPayload, error) {
+	return r.PrescriptionResolver.PrescriberResolver.DeletePrescriber(ctx, id)
+}
+
 // Addresses is the resolver for the addresses field.
 func (r *patientResolver) Addresses(ctx context.Context, obj *model.Patient) ([]model.Address, error) {
 	// Delegate to patient domain resolver
 	return r.PatientResolver.Addresses(ctx, obj)
 }
 
+// Measurements is the resolver for the measurements field.
+func (r *patientResolver) Measurements(ctx context.Context, obj *model.Patient, typeArg *string, limit *int) ([]model.Measurement, error) {
+	return r.PatientResolver.Measurements(ctx, obj, typeArg, limit)
+}
+
+// LatestMeasurement is the resolver for the latestMeasurement field.
+func (r *patientResolver) LatestMeasurement(ctx context.Context, obj *model.Patient, typeArg string) (*model.Measurement, error) {
+	return r.PatientResolver.LatestMeasurement(ctx, obj, typeArg)
+}
+
+// Allergies is the resolver for the allergies field.
+func (r *patientResolver) Allergies(ctx context.Context, obj *model.Patient) ([]model.Allergy, error) {
+	return r.PatientResolver.Allergies(ctx, obj)
+}
+
+// Insurance is the resolver for the insurance field.
+func (r *patientResolver) Insurance(ctx context.Context, obj *model.Patient, activeOnly *bool) ([]model.InsuranceRecord, error) {
+	return r.PatientResolver.InsuranceResolver.Insurance(ctx, obj, activeOnly)
+}
+
 // Prescriptions is the resolver for the prescriptions field.
-func (r *patientResolver) Prescriptions(ctx context.Context, obj *model.Patient) ([]model1.Prescription, error) {
+func (r *patientResolver) Prescriptions(ctx context.Context, obj *model.Patient, status *generated.PrescriptionStatus, limit *int) ([]model1.Prescription, error) {
 	// Delegate to patient domain resolver
-	return r.PatientResolver.Prescriptions(ctx, obj)
+	return r.PatientResolver.Prescriptions(ctx, obj, status, limit)
+}
+
+// Prescriptions is the resolver for the prescriptions field.
+func (r *prescriberResolver) Prescriptions(ctx context.Context, obj *model1.Prescriber, limit *int) ([]model1.Prescription, error) {
+	return r.PrescriptionResolver.PrescriberResolver.Prescriptions(ctx, obj, limit)
 }
 
 // Patient is the resolver for the patient field.
@@ -54,36 +205,219 @@ func (r *prescriptionResolver) Patient(ctx context.Context, obj *model1.Prescrip
 	return r.PrescriptionResolver.Patient(ctx, obj)
 }
 
+// Prescriber is the resolver for the prescriber field.
+func (r *prescriptionResolver) Prescriber(ctx context.Context, obj *model1.Prescription) (*model1.Prescriber, error) {
+	return r.PrescriptionResolver.PrescriberResolver.PrescriptionPrescriber(ctx, obj)
+}
+
 // Status is the resolver for the status field.
 func (r *prescriptionResolver) Status(ctx context.Context, obj *model1.Prescription) (generated.PrescriptionStatus, error) {
 	// Delegate to prescription domain resolver
 	return r.PrescriptionResolver.Status(ctx, obj)
 }
 
+// Directions is the resolver for the directions field.
+func (r *prescriptionResolver) Directions(ctx context.Context, obj *model1.Prescription, language *generated.SigLanguage) (string, error) {
+	return r.PrescriptionResolver.Directions(ctx, obj, language)
+}
+
+// FulfillmentStatus is the resolver for the fulfillmentStatus field.
+func (r *prescriptionResolver) FulfillmentStatus(ctx context.Context, obj *model1.Prescription) (*string, error) {
+	return r.PrescriptionResolver.FulfillmentStatus(ctx, obj)
+}
+
+// History is the resolver for the history field.
+func (r *prescriptionResolver) History(ctx context.Context, obj *model1.Prescription, limit *int, after *string) (*model1.PrescriptionHistoryConnection, error) {
+	return r.PrescriptionResolver.History(ctx, obj, limit, after)
+}
+
+// PriceEstimate is the resolver for the priceEstimate field.
+func (r *prescriptionResolver) PriceEstimate(ctx context.Context, obj *model1.Prescription) (*model2.PriceEstimate, error) {
+	// Delegate to billing domain resolver
+	return r.BillingResolver.PrescriptionPriceEstimate(ctx, obj)
+}
+
+// Type is the resolver for the type field.
+func (r *prescriptionHistoryEventResolver) Type(ctx context.Context, obj *model1.PrescriptionHistoryEvent) (generated.PrescriptionHistoryEventType, error) {
+	return r.PrescriptionResolver.HistoryEventType(ctx, obj)
+}
+
+// FromStatus is the resolver for the fromStatus field.
+func (r *prescriptionHistoryEventResolver) FromStatus(ctx context.Context, obj *model1.PrescriptionHistoryEvent) (*generated.PrescriptionStatus, error) {
+	return r.PrescriptionResolver.HistoryStatus(obj.FromStatus), nil
+}
+
+// ToStatus is the resolver for the toStatus field.
+func (r *prescriptionHistoryEventResolver) ToStatus(ctx context.Context, obj *model1.PrescriptionHistoryEvent) (*generated.PrescriptionStatus, error) {
+	return r.PrescriptionResolver.HistoryStatus(obj.ToStatus), nil
+}
+
+// Status is the resolver for the status field.
+func (r *prescriptionTransmissionResolver) Status(ctx context.Context, obj *model3.PrescriptionTransmission) (generated.TransmissionStatus, error) {
+	return r.TransmissionResolver.Status(ctx, obj)
+}
+
 // Empty is the resolver for the _empty field.
 func (r *queryResolver) Empty(ctx context.Context) (*string, error) {
 	return nil, nil
 }
 
+// InvoicesByPatient is the resolver for the invoicesByPatient field.
+func (r *queryResolver) InvoicesByPatient(ctx context.Context, patientID string) ([]model2.Invoice, error) {
+	// Delegate to billing domain resolver
+	return r.BillingResolver.InvoicesByPatient(ctx, patientID)
+}
+
 // DashboardStats is the resolver for the dashboardStats field.
 func (r *queryResolver) DashboardStats(ctx context.Context) (*generated.DashboardStats, error) {
 	// Delegate to dashboard domain resolver
 	return r.DashboardResolver.DashboardStats(ctx)
 }
 
+// PrescriptionTransmission is the resolver for the prescriptionTransmission field.
+func (r *queryResolver) PrescriptionTransmission(ctx context.Context, id string, refresh *bool) (*model3.PrescriptionTransmission, error) {
+	return r.TransmissionResolver.PrescriptionTransmission(ctx, id, refresh)
+}
+
+// PrescriptionTransmissions is the resolver for the prescriptionTransmissions field.
+func (r *queryResolver) PrescriptionTransmissions(ctx context.Context, prescriptionID string) ([]model3.PrescriptionTransmission, error) {
+	return r.TransmissionResolver.PrescriptionTransmissions(ctx, prescriptionID)
+}
+
+// Patients is the resolver for the patients field.
+func (r *queryResolver) Patients(ctx context.Context, query *string, state *string, minAge *int, maxAge *int, hasActivePrescriptions *bool, limit *int, offset *int) ([]model.Patient, error) {
+	return r.PatientResolver.Patients(ctx, query, state, minAge, maxAge, hasActivePrescriptions, limit, offset)
+}
+
+// PatientCountByState is the resolver for the patientCountByState field.
+func (r *queryResolver) PatientCountByState(ctx context.Context) ([]model.StateCount, error) {
+	return r.PatientResolver.PatientCountByState(ctx)
+}
+
+// SearchPatients is the resolver for the searchPatients field.
+func (r *queryResolver) SearchPatients(ctx context.Context, query string, limit *int) ([]model.PatientSearchResult, error) {
+	return r.PatientResolver.SearchResolver.SearchPatients(ctx, query, limit)
+}
+
+// PrescriptionCountByStatus is the resolver for the prescriptionCountByStatus field.
+func (r *queryResolver) PrescriptionCountByStatus(ctx context.Context) ([]model1.StatusCount, error) {
+	return r.PrescriptionResolver.PrescriptionCountByStatus(ctx)
+}
+
+// CheckDrugInteractions is the resolver for the checkDrugInteractions field.
+func (r *queryResolver) CheckDrugInteractions(ctx context.Context, patientID string, drug string) (*model1.InteractionCheckResult, error) {
+	// Delegate to prescription domain resolver
+	return r.PrescriptionResolver.CheckDrugInteractions(ctx, patientID, drug)
+}
+
+// Prescriber is the resolver for the prescriber field.
+func (r *queryResolver) Prescriber(ctx context.Context, id string) (*model1.Prescriber, error) {
+	return r.PrescriptionResolver.PrescriberResolver.Prescriber(ctx, id)
+}
+
+// Prescribers is the resolver for the prescribers field.
+func (r *queryResolver) Prescribers(ctx context.Context, query *string, limit *int, offset *int) ([]model1.Prescriber, error) {
+	return r.PrescriptionResolver.PrescriberResolver.Prescribers(ctx, query, limit, offset)
+}
+
+// AdherenceReport is the resolver for the adherenceReport field.
+func (r *queryResolver) AdherenceReport(ctx context.Context, patientID string) (*model4.AdherenceReport, error) {
+	// Delegate to reporting domain resolver
+	return r.ReportingResolver.AdherenceReport(ctx, patientID)
+}
+
+// DoseUnit is the resolver for the doseUnit field.
+func (r *sigResolver) DoseUnit(ctx context.Context, obj *model1.Sig) (string, error) {
+	return string(obj.DoseUnit), nil
+}
+
+// Route is the resolver for the route field.
+func (r *sigResolver) Route(ctx context.Context, obj *model1.Sig) (string, error) {
+	return string(obj.Route), nil
+}
+
+// Frequency is the resolver for the frequency field.
+func (r *sigResolver) Frequency(ctx context.Context, obj *model1.Sig) (string, error) {
+	return string(obj.Frequency), nil
+}
+
+// Timing is the resolver for the timing field.
+func (r *sigResolver) Timing(ctx context.Context, obj *model1.Sig) (string, error) {
+	return string(obj.Timing), nil
+}
+
+// Status is the resolver for the status field.
+func (r *statusCountResolver) Status(ctx context.Context, obj *model1.StatusCount) (generated.PrescriptionStatus, error) {
+	return r.PrescriptionResolver.CountStatus(ctx, obj)
+}
+
+// Allergy returns generated.AllergyResolver implementation.
+func (r *Resolver) Allergy() generated.AllergyResolver { return &allergyResolver{r} }
+
+// ContactPreferences returns generated.ContactPreferencesResolver implementation.
+func (r *Resolver) ContactPreferences() generated.ContactPreferencesResolver {
+	return &contactPreferencesResolver{r}
+}
+
+// Dose returns generated.DoseResolver implementation.
+func (r *Resolver) Dose() generated.DoseResolver { return &doseResolver{r} }
+
+// DrugInteractionWarning returns generated.DrugInteractionWarningResolver implementation.
+func (r *Resolver) DrugInteractionWarning() generated.DrugInteractionWarningResolver {
+	return &drugInteractionWarningResolver{r}
+}
+
+// InsuranceRecord returns generated.InsuranceRecordResolver implementation.
+func (r *Resolver) InsuranceRecord() generated.InsuranceRecordResolver {
+	return &insuranceRecordResolver{r}
+}
+
+// Measurement returns generated.MeasurementResolver implementation.
+func (r *Resolver) Measurement() generated.MeasurementResolver { return &measurementResolver{r} }
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
 // Patient returns generated.PatientResolver implementation.
 func (r *Resolver) Patient() generated.PatientResolver { return &patientResolver{r} }
 
+// Prescriber returns generated.PrescriberResolver implementation.
+func (r *Resolver) Prescriber() generated.PrescriberResolver { return &prescriberResolver{r} }
+
 // Prescription returns generated.PrescriptionResolver implementation.
 func (r *Resolver) Prescription() generated.PrescriptionResolver { return &prescriptionResolver{r} }
 
+// PrescriptionHistoryEvent returns generated.PrescriptionHistoryEventResolver implementation.
+func (r *Resolver) PrescriptionHistoryEvent() generated.PrescriptionHistoryEventResolver {
+	return &prescriptionHistoryEventResolver{r}
+}
+
+// PrescriptionTransmission returns generated.PrescriptionTransmissionResolver implementation.
+func (r *Resolver) PrescriptionTransmission() generated.PrescriptionTransmissionResolver {
+	return &prescriptionTransmissionResolver{r}
+}
+
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+// Sig returns generated.SigResolver implementation.
+func (r *Resolver) Sig() generated.SigResolver { return &sigResolver{r} }
+
+// StatusCount returns generated.StatusCountResolver implementation.
+func (r *Resolver) StatusCount() generated.StatusCountResolver { return &statusCountResolver{r} }
+
+type allergyResolver struct{ *Resolver }
+type contactPreferencesResolver struct{ *Resolver }
+type doseResolver struct{ *Resolver }
+type drugInteractionWarningResolver struct{ *Resolver }
+type insuranceRecordResolver struct{ *Resolver }
+type measurementResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type patientResolver struct{ *Resolver }
+type prescriberResolver struct{ *Resolver }
 type prescriptionResolver struct{ *Resolver }
+type prescriptionHistoryEventResolver struct{ *Resolver }
+type prescriptionTransmissionResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type sigResolver struct{ *Resolver }
+type statusCountResolver struct{ *Resolver }
@@ -1,29 +1,56 @@
 package graphql
 
 import (
+	"time"
+
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/go-chi/chi/v5"
+	"github.com/vektah/gqlparser/v2/ast"
 	"go.uber.org/zap"
 
+	billinggraphql "pharmacy-modernization-project-model/domain/billing/graphql"
+	billingservice "pharmacy-modernization-project-model/domain/billing/service"
 	dashboardgraphql "pharmacy-modernization-project-model/domain/dashboard/graphql"
 	dashboardservice "pharmacy-modernization-project-model/domain/dashboard/service"
+	eprescribinggraphql "pharmacy-modernization-project-model/domain/eprescribing/graphql"
+	transmissionservice "pharmacy-modernization-project-model/domain/eprescribing/service"
 	patientgraphql "pharmacy-modernization-project-model/domain/patient/graphql"
 	patientservice "pharmacy-modernization-project-model/domain/patient/service"
 	prescriptiongraphql "pharmacy-modernization-project-model/domain/prescription/graphql"
 	prescriptionservice "pharmacy-modernization-project-model/domain/prescription/service"
+	reportinggraphql "pharmacy-modernization-project-model/domain/reporting/graphql"
+	reportingservice "pharmacy-modernization-project-model/domain/reporting/service"
 	"pharmacy-modernization-project-model/internal/graphql/generated"
 	authplatform "pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/config"
 	"pharmacy-modernization-project-model/internal/platform/paths"
+	"pharmacy-modernization-project-model/internal/platform/permissions"
 )
 
 // Dependencies holds all the service dependencies needed for GraphQL resolvers
 type Dependencies struct {
-	PatientService      patientservice.PatientService
-	AddressService      patientservice.AddressService
-	PrescriptionService prescriptionservice.PrescriptionService
-	DashboardService    dashboardservice.IDashboardService
-	Logger              *zap.Logger
+	PatientService       patientservice.PatientService
+	AddressService       patientservice.AddressService
+	MeasurementService   patientservice.MeasurementService
+	AllergyService       patientservice.AllergyService
+	PatientSearchService patientservice.PatientSearchService
+	InsuranceService     patientservice.InsuranceService
+	PrescriptionService  prescriptionservice.PrescriptionService
+	HistoryService       prescriptionservice.HistoryService
+	PrescriberService    prescriptionservice.PrescriberService
+	DashboardService     dashboardservice.IDashboardService
+	BillingService       billingservice.BillingService
+	TransmissionService  transmissionservice.TransmissionService
+	AdherenceService     reportingservice.AdherenceService
+	Limits               QueryLimits
+	PersistedQueries     PersistedQueries
+	Federation           bool            // Serves _service, _entities and the subgraph SDL for a federated gateway
+	ConfigReload         *config.Watcher // Applies reloaded limits; nil keeps the startup limits
+	Logger               *zap.Logger
 }
 
 // MountGraphQL mounts GraphQL endpoints on the provided router
@@ -33,13 +60,19 @@ func MountGraphQL(r chi.Router, deps *Dependencies) {
 	patientResolver := patientgraphql.NewPatientResolver(
 		deps.PatientService,
 		deps.AddressService,
+		deps.MeasurementService,
+		deps.AllergyService,
+		deps.PatientSearchService,
+		deps.InsuranceService,
 		deps.PrescriptionService,
 		deps.Logger,
 	)
 
 	prescriptionResolver := prescriptiongraphql.NewPrescriptionResolver(
 		deps.PrescriptionService,
+		deps.HistoryService,
 		deps.PatientService,
+		deps.PrescriberService,
 		deps.Logger,
 	)
 
@@ -48,30 +81,88 @@ func MountGraphQL(r chi.Router, deps *Dependencies) {
 		deps.Logger,
 	)
 
+	billingResolver := billinggraphql.NewBillingResolver(
+		deps.BillingService,
+		deps.Logger,
+	)
+
+	transmissionResolver := eprescribinggraphql.NewTransmissionResolver(
+		deps.TransmissionService,
+		deps.Logger,
+	)
+
+	reportingResolver := reportinggraphql.NewReportingResolver(
+		deps.AdherenceService,
+		deps.Logger,
+	)
+
 	// Aggregate domain resolvers into root resolver
 	resolver := &Resolver{
 		PatientResolver:      patientResolver,
 		PrescriptionResolver: prescriptionResolver,
 		DashboardResolver:    dashboardResolver,
+		BillingResolver:      billingResolver,
+		TransmissionResolver: transmissionResolver,
+		ReportingResolver:    reportingResolver,
 	}
 
 	// Create GraphQL server with auth directives
-	config := generated.Config{
+	schemaConfig := generated.Config{
 		Resolvers: resolver,
 		Directives: generated.DirectiveRoot{
 			Auth:          authplatform.AuthDirective(),
+			HasPermission: authplatform.HasPermissionDirective(),
 			PermissionAny: authplatform.PermissionAnyDirective(),
 			PermissionAll: authplatform.PermissionAllDirective(),
 		},
 	}
-	srv := handler.NewDefaultServer(generated.NewExecutableSchema(config))
+	if !deps.Federation {
+		// Operations and introspection see the schema without _service and _entities
+		schemaConfig.Schema = standaloneSchema(generated.NewExecutableSchema(schemaConfig).Schema())
+	}
+	schema := generated.NewExecutableSchema(schemaConfig)
+	reqs := schemaRequirements(schema.Schema())
+	permissions.MustBeRegistered(schemaPermissions(reqs)...)
+	permissions.Require(reqs...)
+	// The default server's transports and caches, with persisted queries handled by persistedQueries
+	srv := handler.New(schema)
+	srv.AddTransport(transport.Websocket{KeepAlivePingInterval: 10 * time.Second})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+	srv.Use(extension.Introspection{})
+	srv.Use(persistedQueries{config: deps.PersistedQueries, log: deps.Logger})
+	limiter := newQueryLimiter(deps.Limits, deps.Logger)
+	if deps.ConfigReload != nil {
+		deps.ConfigReload.Subscribe("graphql",
+			[]string{"graphql.max_depth", "graphql.max_complexity", "graphql.default_list_size"},
+			func(cfg *config.Config) { limiter.setLimits(LimitsFromConfig(cfg.GraphQL)) })
+	}
+	srv.Use(limiter)
+	srv.SetErrorPresenter(errorPresenter(deps.Logger))
 
 	// Mount GraphQL endpoint with auth middleware (to set user in context)
 	// Uses dev mode if enabled, otherwise requires real JWT
 	r.Handle(paths.GraphQLPath, authplatform.RequireAuthWithDevMode()(srv))
 	r.Handle(paths.GraphQLPlayground, playground.Handler("GraphQL Playground", paths.GraphQLPath))
+	if deps.Federation {
+		mountSDL(r, schema, deps)
+	}
 
 	deps.Logger.Info("GraphQL server mounted",
 		zap.String("endpoint", paths.GraphQLPath),
-		zap.String("playground", paths.GraphQLPlayground))
+		zap.String("playground", paths.GraphQLPlayground),
+		zap.Int("max_depth", deps.Limits.MaxDepth),
+		zap.Int("max_complexity", deps.Limits.MaxComplexity),
+		zap.Int("persisted_queries", len(deps.PersistedQueries.AllowList)),
+		zap.Bool("allow_list_only", deps.PersistedQueries.AllowListOnly),
+		zap.Bool("federation", deps.Federation))
+	if deps.PersistedQueries.AllowListOnly && len(deps.PersistedQueries.AllowList) == 0 {
+		deps.Logger.Warn("GraphQL allow-list mode is on but no persisted queries are registered; every operation will be refused")
+	}
+	if deps.Federation && deps.PersistedQueries.AllowListOnly {
+		deps.Logger.Warn("GraphQL federation is on in allow-list mode; gateway queries that are not pre-registered will be refused")
+	}
 }
@@ -0,0 +1,58 @@
+package validation
+
+import (
+	"errors"
+
+	"pharmacy-modernization-project-model/internal/graphql/generated"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// UserErrors splits the error of a mutation into the userErrors of its payload and the error
+// returned beside it. Errors the client can correct (input validation, a missing record, a
+// duplicate or conflicting write, a business rule) become userErrors; any other error is returned
+// as is, for the error presenter. The userErrors are never nil, so they can be set on a payload
+// whatever the outcome.
+func UserErrors(err error) ([]generated.UserError, error) {
+	if err == nil {
+		return []generated.UserError{}, nil
+	}
+
+	var (
+		inputErrs    *GraphQLValidationErrors
+		validErr     platformErrors.ValidationError
+		notFoundErr  platformErrors.RecordNotFoundError
+		duplicateErr platformErrors.DuplicateRecordError
+		conflictErr  platformErrors.ConflictError
+		businessErr  platformErrors.BusinessLogicError
+	)
+	switch {
+	case errors.As(err, &inputErrs):
+		userErrors := make([]generated.UserError, 0, len(inputErrs.Errors))
+		for _, fieldErr := range inputErrs.Errors {
+			userErrors = append(userErrors, userError(fieldErr.Field, fieldErr.Code, fieldErr.Message))
+		}
+		return userErrors, nil
+	case errors.As(err, &validErr):
+		return []generated.UserError{userError(validErr.Field, "INVALID", validErr.Message)}, nil
+	case errors.As(err, &notFoundErr):
+		return []generated.UserError{userError("", "NOT_FOUND", notFoundErr.Error())}, nil
+	case platformErrors.IsNotFoundError(err):
+		return []generated.UserError{userError("", "NOT_FOUND", "Record not found")}, nil
+	case errors.As(err, &duplicateErr):
+		return []generated.UserError{userError("", "DUPLICATE", duplicateErr.Error())}, nil
+	case errors.As(err, &conflictErr):
+		return []generated.UserError{userError("", "CONFLICT", conflictErr.Reason)}, nil
+	case errors.As(err, &businessErr):
+		return []generated.UserError{userError("", "BUSINESS_RULE_VIOLATION", businessErr.Reason)}, nil
+	default:
+		return nil, err
+	}
+}
+
+func userError(field, code, message string) generated.UserError {
+	userErr := generated.UserError{Code: code, Message: message}
+	if field != "" {
+		userErr.Field = &field
+	}
+	return userErr
+}
@@ -1,12 +1,15 @@
 package validation
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"pharmacy-modernization-project-model/internal/bind"
 	"pharmacy-modernization-project-model/internal/graphql/generated"
+	"pharmacy-modernization-project-model/internal/platform/i18n"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -14,6 +17,7 @@ import (
 // GraphQLValidationError represents a validation error for GraphQL responses
 type GraphQLValidationError struct {
 	Field   string `json:"field"`
+	Code    string `json:"code"`
 	Message string `json:"message"`
 }
 
@@ -31,53 +35,33 @@ func (e *GraphQLValidationErrors) Error() string {
 	return strings.Join(messages, ", ")
 }
 
-// ConvertBindErrorsToGraphQLErrors converts bind.FieldError to GraphQLValidationError
-func ConvertBindErrorsToGraphQLErrors(fieldErrors []bind.FieldError) *GraphQLValidationErrors {
+// ConvertBindErrorsToGraphQLErrors converts bind.FieldError to GraphQLValidationError, with
+// messages in the locale of the request
+func ConvertBindErrorsToGraphQLErrors(ctx context.Context, fieldErrors []bind.FieldError) *GraphQLValidationErrors {
 	var errors []GraphQLValidationError
 	for _, fe := range fieldErrors {
 		errors = append(errors, GraphQLValidationError{
 			Field:   fe.Field,
-			Message: getGraphQLErrorMessage(fe),
+			Code:    getGraphQLErrorCode(fe),
+			Message: i18n.ValidationMessage(ctx, fe.Tag, fe.Param),
 		})
 	}
 	return &GraphQLValidationErrors{Errors: errors}
 }
 
-// getGraphQLErrorMessage converts validation tags to user-friendly messages
-func getGraphQLErrorMessage(fe bind.FieldError) string {
+// getGraphQLErrorCode converts validation tags to the codes of userErrors
+func getGraphQLErrorCode(fe bind.FieldError) string {
 	switch fe.Tag {
-	case "required":
-		return "This field is required"
-	case "min":
-		if fe.Param != "" {
-			return fmt.Sprintf("Value must be at least %s", fe.Param)
-		}
-		return "Value is too short"
-	case "max":
-		if fe.Param != "" {
-			return fmt.Sprintf("Value must be no more than %s", fe.Param)
-		}
-		return "Value is too long"
-	case "email":
-		return "Please enter a valid email address"
-	case "numeric":
-		return "Please enter a valid number"
-	case "len":
-		if fe.Param != "" {
-			return fmt.Sprintf("Value must be exactly %s characters", fe.Param)
-		}
-		return "Value has incorrect length"
+	case "required", "required_without", "required_if":
+		return "REQUIRED"
+	case "min", "max", "len", "gt", "gte", "lt", "lte":
+		return "OUT_OF_RANGE"
 	case "oneof":
-		if fe.Param != "" {
-			return fmt.Sprintf("Value must be one of: %s", fe.Param)
-		}
-		return "Value is not in the allowed list"
-	case "dob":
-		return "Please enter a valid date of birth (YYYY-MM-DD). Date cannot be in the future or more than 150 years ago."
-	case "alphanum":
-		return "Value can only contain letters, numbers, hyphens, and underscores"
+		return "NOT_ALLOWED"
+	case "excluded_with":
+		return "CONFLICTING_FIELDS"
 	default:
-		return "Invalid value"
+		return "INVALID"
 	}
 }
 
@@ -98,21 +82,63 @@ type UpdatePatientInputValidation struct {
 	Dob   *string `json:"dob,omitempty" validate:"omitempty,dob"`
 	Phone *string `json:"phone,omitempty" validate:"omitempty,min=10,max=15"`
 	State *string `json:"state,omitempty" validate:"omitempty,min=2,max=50"`
+
+	ContactPreferences *ContactPreferencesInputValidation `json:"contactPreferences,omitempty" validate:"omitempty"`
+}
+
+// ContactPreferencesInputValidation represents validated contact preferences; an email address
+// is required when the email channel is chosen
+type ContactPreferencesInputValidation struct {
+	Channels []string `json:"channels" validate:"max=3,unique,dive,oneof=sms email phone"`
+	Email    *string  `json:"email,omitempty" validate:"required_if=EmailChannel true,omitempty,email,max=254"`
+	// EmailChannel is set when Channels holds email
+	EmailChannel bool `json:"-"`
 }
 
 // CreatePrescriptionInputValidation represents validated input for creating a prescription
 type CreatePrescriptionInputValidation struct {
-	PatientID string `json:"patientID" validate:"required,min=1,max=50,alphanum"`
-	Drug      string `json:"drug" validate:"required,min=2,max=100"`
-	Dose      string `json:"dose" validate:"required,min=1,max=50"`
-	Status    string `json:"status" validate:"required,oneof=DRAFT ACTIVE PAUSED COMPLETED"`
+	PatientID    string               `json:"patientID" validate:"required,min=1,max=50,alphanum"`
+	PrescriberID string               `json:"prescriberID" validate:"required,min=1,max=50"`
+	Drug         string               `json:"drug" validate:"required,min=2,max=100"`
+	Dose         *string              `json:"dose,omitempty" validate:"required_without=Dosage,excluded_with=Dosage,omitempty,min=1,max=50"`
+	Dosage       *DoseInputValidation `json:"dosage,omitempty"`
+	Status       string               `json:"status" validate:"required,oneof=DRAFT ACTIVE PAUSED COMPLETED"`
+
+	Sig        *SigInputValidation `json:"sig,omitempty"`
+	SigText    *string             `json:"sigText,omitempty" validate:"omitempty,max=200,excluded_with=Sig"`
+	Quantity   *int                `json:"quantity,omitempty" validate:"omitempty,min=1,max=10000"`
+	DaysSupply *int                `json:"daysSupply,omitempty" validate:"omitempty,min=1,max=365"`
 }
 
 // UpdatePrescriptionInputValidation represents validated input for updating a prescription
 type UpdatePrescriptionInputValidation struct {
-	Drug   *string `json:"drug,omitempty" validate:"omitempty,min=2,max=100"`
-	Dose   *string `json:"dose,omitempty" validate:"omitempty,min=1,max=50"`
-	Status *string `json:"status,omitempty" validate:"omitempty,oneof=DRAFT ACTIVE PAUSED COMPLETED"`
+	Drug   *string              `json:"drug,omitempty" validate:"omitempty,min=2,max=100"`
+	Dose   *string              `json:"dose,omitempty" validate:"omitempty,min=1,max=50,excluded_with=Dosage"`
+	Dosage *DoseInputValidation `json:"dosage,omitempty"`
+	Status *string              `json:"status,omitempty" validate:"omitempty,oneof=DRAFT ACTIVE PAUSED COMPLETED"`
+
+	Sig        *SigInputValidation `json:"sig,omitempty"`
+	SigText    *string             `json:"sigText,omitempty" validate:"omitempty,max=200,excluded_with=Sig"`
+	Quantity   *int                `json:"quantity,omitempty" validate:"omitempty,min=1,max=10000"`
+	DaysSupply *int                `json:"daysSupply,omitempty" validate:"omitempty,min=1,max=365"`
+}
+
+// DoseInputValidation represents validated input for a structured dose
+type DoseInputValidation struct {
+	Value     float64 `json:"value" validate:"gt=0,max=10000"`
+	Unit      string  `json:"unit" validate:"required,oneof=mg mcg g mL unit IU mEq % tablet capsule puff drop spray patch application"`
+	Frequency *string `json:"frequency,omitempty" validate:"omitempty,oneof=QD BID TID QID Q4H Q6H Q8H Q12H QHS QWK"`
+	Route     *string `json:"route,omitempty" validate:"omitempty,oneof=oral sublingual topical transdermal inhaled nasal ophthalmic otic rectal subcutaneous intramuscular"`
+}
+
+// SigInputValidation represents validated input for a structured sig
+type SigInputValidation struct {
+	DoseQuantity *float64 `json:"doseQuantity,omitempty" validate:"omitempty,gt=0,max=100"`
+	DoseUnit     *string  `json:"doseUnit,omitempty" validate:"omitempty,oneof=tablet capsule mL puff drop spray patch application unit"`
+	Route        *string  `json:"route,omitempty" validate:"omitempty,oneof=oral sublingual topical transdermal inhaled nasal ophthalmic otic rectal subcutaneous intramuscular"`
+	Frequency    *string  `json:"frequency,omitempty" validate:"omitempty,oneof=QD BID TID QID Q4H Q6H Q8H Q12H QHS QWK"`
+	Timing       *string  `json:"timing,omitempty" validate:"omitempty,oneof=with_food before_meals after_meals empty_stomach morning evening bedtime"`
+	Indication   *string  `json:"indication,omitempty" validate:"omitempty,max=100"`
 }
 
 // PatientQueryValidation represents validated input for patient queries
@@ -123,6 +149,9 @@ type PatientQueryValidation struct {
 // PatientsQueryValidation represents validated input for patients list query
 type PatientsQueryValidation struct {
 	Query  *string `json:"query,omitempty" validate:"omitempty,min=3,max=100"`
+	State  *string `json:"state,omitempty" validate:"omitempty,min=1,max=50"`
+	MinAge *int    `json:"minAge,omitempty" validate:"omitempty,min=0,max=150"`
+	MaxAge *int    `json:"maxAge,omitempty" validate:"omitempty,min=0,max=150"`
 	Limit  *int    `json:"limit,omitempty" validate:"omitempty,min=1,max=100"`
 	Offset *int    `json:"offset,omitempty" validate:"omitempty,min=0"`
 }
@@ -140,12 +169,12 @@ type PrescriptionsQueryValidation struct {
 }
 
 // ValidateGraphQLInput validates a GraphQL input using the bind validation system
-func ValidateGraphQLInput[T any](input T) (T, *GraphQLValidationErrors) {
+func ValidateGraphQLInput[T any](ctx context.Context, input T) (T, *GraphQLValidationErrors) {
 	// Use the bind validator directly
 	validator := bind.Validator()
 	if err := validator.Struct(input); err != nil {
 		fieldErrors := convertValidatorErrors(err)
-		return input, ConvertBindErrorsToGraphQLErrors(fieldErrors)
+		return input, ConvertBindErrorsToGraphQLErrors(ctx, fieldErrors)
 	}
 	return input, nil
 }
@@ -161,7 +190,7 @@ func convertValidatorErrors(err error) []bind.FieldError {
 	if errors.As(err, &ve) {
 		for _, fe := range ve {
 			ferrs = append(ferrs, bind.FieldError{
-				Field: fe.Field(),
+				Field: inputPath(fe.StructNamespace()),
 				Tag:   fe.Tag(),
 				Param: fe.Param(),
 			})
@@ -172,11 +201,29 @@ func convertValidatorErrors(err error) []bind.FieldError {
 	return []bind.FieldError{{Field: "", Tag: "invalid", Message: err.Error()}}
 }
 
+// inputPath turns the namespace of a validated field (e.g. CreatePrescriptionInputValidation.Sig.Route)
+// into the path of the input field it mirrors (sig.route)
+func inputPath(namespace string) string {
+	parts := strings.Split(namespace, ".")
+	if len(parts) > 1 {
+		parts = parts[1:]
+	}
+	for i, part := range parts {
+		switch {
+		case strings.ToUpper(part) == part: // e.g. ID
+			parts[i] = strings.ToLower(part)
+		case part != "":
+			parts[i] = strings.ToLower(part[:1]) + part[1:]
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
 // Helper function to convert generated input to validation struct
 func ConvertCreatePatientInput(input generated.CreatePatientInput) CreatePatientInputValidation {
 	return CreatePatientInputValidation{
 		Name:  input.Name,
-		Dob:   input.Dob.Format("2006-01-02"), // Convert time.Time to string for validation
+		Dob:   input.Dob.String(), // Convert the partial date to its text form for validation
 		Phone: input.Phone,
 		State: input.State,
 	}
@@ -189,7 +236,7 @@ func ConvertUpdatePatientInput(input generated.UpdatePatientInput) UpdatePatient
 		result.Name = input.Name
 	}
 	if input.Dob != nil {
-		dobStr := input.Dob.Format("2006-01-02")
+		dobStr := input.Dob.String()
 		result.Dob = &dobStr
 	}
 	if input.Phone != nil {
@@ -198,16 +245,30 @@ func ConvertUpdatePatientInput(input generated.UpdatePatientInput) UpdatePatient
 	if input.State != nil {
 		result.State = input.State
 	}
+	if input.ContactPreferences != nil {
+		result.ContactPreferences = &ContactPreferencesInputValidation{
+			Channels:     input.ContactPreferences.Channels,
+			Email:        input.ContactPreferences.Email,
+			EmailChannel: slices.Contains(input.ContactPreferences.Channels, "email"),
+		}
+	}
 
 	return result
 }
 
 func ConvertCreatePrescriptionInput(input generated.CreatePrescriptionInput) CreatePrescriptionInputValidation {
 	return CreatePrescriptionInputValidation{
-		PatientID: input.PatientID,
-		Drug:      input.Drug,
-		Dose:      input.Dose,
-		Status:    string(input.Status),
+		PatientID:    input.PatientID,
+		PrescriberID: input.PrescriberID,
+		Drug:         input.Drug,
+		Dose:         input.Dose,
+		Dosage:       convertDoseInput(input.Dosage),
+		Status:       string(input.Status),
+
+		Sig:        convertSigInput(input.Sig),
+		SigText:    input.SigText,
+		Quantity:   input.Quantity,
+		DaysSupply: input.DaysSupply,
 	}
 }
 
@@ -220,10 +281,41 @@ func ConvertUpdatePrescriptionInput(input generated.UpdatePrescriptionInput) Upd
 	if input.Dose != nil {
 		result.Dose = input.Dose
 	}
+	result.Dosage = convertDoseInput(input.Dosage)
 	if input.Status != nil {
 		statusStr := string(*input.Status)
 		result.Status = &statusStr
 	}
+	result.Sig = convertSigInput(input.Sig)
+	result.SigText = input.SigText
+	result.Quantity = input.Quantity
+	result.DaysSupply = input.DaysSupply
 
 	return result
 }
+
+func convertDoseInput(input *generated.DoseInput) *DoseInputValidation {
+	if input == nil {
+		return nil
+	}
+	return &DoseInputValidation{
+		Value:     input.Value,
+		Unit:      input.Unit,
+		Frequency: input.Frequency,
+		Route:     input.Route,
+	}
+}
+
+func convertSigInput(input *generated.SigInput) *SigInputValidation {
+	if input == nil {
+		return nil
+	}
+	return &SigInputValidation{
+		DoseQuantity: input.DoseQuantity,
+		DoseUnit:     input.DoseUnit,
+		Route:        input.Route,
+		Frequency:    input.Frequency,
+		Timing:       input.Timing,
+		Indication:   input.Indication,
+	}
+}
@@ -0,0 +1,106 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	prescriptionErrors "pharmacy-modernization-project-model/domain/prescription/errors"
+	"pharmacy-modernization-project-model/internal/bind"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/logging"
+)
+
+// errorDomain is the domain of the ErrorInfo details of errors
+const errorDomain = "rx.v1"
+
+// invalidArgument reports a request that failed validation, with a field violation per field error
+func invalidArgument(fieldErrors []bind.FieldError) error {
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(fieldErrors))
+	for _, fe := range fieldErrors {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: fe.Field, Description: fe.Error()})
+	}
+	return withDetails(codes.InvalidArgument, "validation failed: "+bind.JoinMessages(fieldErrors),
+		&errdetails.BadRequest{FieldViolations: violations})
+}
+
+// toStatus maps service errors to gRPC status codes, as the REST API maps them to HTTP status
+// codes. Errors it does not know are logged and reported as Internal without their message.
+func toStatus(ctx context.Context, err error) error {
+	var (
+		allergyErr     prescriptionErrors.DrugAllergyError
+		interactionErr prescriptionErrors.SevereInteractionError
+		validationErr  platformErrors.ValidationError
+		notFoundErr    platformErrors.RecordNotFoundError
+		duplicateErr   platformErrors.DuplicateRecordError
+		conflictErr    platformErrors.ConflictError
+		businessErr    platformErrors.BusinessLogicError
+		authErr        platformErrors.AuthorizationError
+		serviceErr     platformErrors.ExternalServiceError
+		rateLimitErr   platformErrors.RateLimitError
+	)
+
+	switch {
+	// Blocked prescriptions list the allergies or interactions that block them
+	case errors.As(err, &allergyErr):
+		failure := &errdetails.PreconditionFailure{}
+		for _, w := range allergyErr.Warnings {
+			failure.Violations = append(failure.Violations, &errdetails.PreconditionFailure_Violation{
+				Type:        "DRUG_ALLERGY",
+				Subject:     w.AllergyID,
+				Description: w.Drug + " matches allergy to " + w.Substance + " (" + w.Severity + ")",
+			})
+		}
+		return withDetails(codes.FailedPrecondition, allergyErr.Error(),
+			&errdetails.ErrorInfo{Reason: "DRUG_ALLERGY", Domain: errorDomain}, failure)
+	case errors.As(err, &interactionErr):
+		failure := &errdetails.PreconditionFailure{}
+		for _, w := range interactionErr.Warnings {
+			failure.Violations = append(failure.Violations, &errdetails.PreconditionFailure_Violation{
+				Type:        "DRUG_INTERACTION",
+				Subject:     w.InteractingPrescriptionID,
+				Description: w.Drug + " + " + w.InteractingDrug + " (" + string(w.Severity) + "): " + w.Description,
+			})
+		}
+		return withDetails(codes.FailedPrecondition, interactionErr.Error(),
+			&errdetails.ErrorInfo{Reason: "SEVERE_DRUG_INTERACTION", Domain: errorDomain}, failure)
+	case errors.As(err, &validationErr):
+		return withDetails(codes.InvalidArgument, validationErr.Error(), &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: validationErr.Field, Description: validationErr.Message}},
+		})
+	case errors.As(err, &notFoundErr):
+		return status.Error(codes.NotFound, notFoundErr.Error())
+	case errors.As(err, &duplicateErr):
+		return status.Error(codes.AlreadyExists, duplicateErr.Error())
+	case errors.As(err, &conflictErr):
+		return status.Error(codes.Aborted, conflictErr.Error())
+	case errors.As(err, &businessErr):
+		return status.Error(codes.FailedPrecondition, businessErr.Error())
+	case errors.As(err, &authErr):
+		return status.Error(codes.PermissionDenied, authErr.Error())
+	case errors.As(err, &serviceErr):
+		logging.FromContext(ctx).Error("External service error", zap.Error(err))
+		return status.Error(codes.Unavailable, "External service temporarily unavailable")
+	case errors.As(err, &rateLimitErr):
+		return status.Error(codes.ResourceExhausted, rateLimitErr.Error())
+	case errors.Is(err, platformErrors.ErrIDRequired):
+		return status.Error(codes.InvalidArgument, "ID is required")
+	}
+
+	logging.FromContext(ctx).Error("gRPC call failed", zap.Error(err))
+	return status.Error(codes.Internal, "internal error")
+}
+
+// withDetails returns a status error carrying the details, or without them if they cannot be encoded
+func withDetails(code codes.Code, message string, details ...protoadapt.MessageV1) error {
+	st := status.New(code, message)
+	if detailed, err := st.WithDetails(details...); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
@@ -0,0 +1,184 @@
+package grpc
+
+import (
+	"context"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	grpcgo "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	patientsecurity "pharmacy-modernization-project-model/domain/patient/security"
+	prescriptionsecurity "pharmacy-modernization-project-model/domain/prescription/security"
+	"pharmacy-modernization-project-model/internal/grpc/rxpb"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/logging"
+	"pharmacy-modernization-project-model/internal/platform/redaction"
+)
+
+// Metadata keys read from calls; gRPC metadata keys are lower case
+var (
+	authorizationKey = "authorization"
+	requestIDKey     = strings.ToLower(logging.RequestIDHeader)
+	correlationIDKey = strings.ToLower(logging.CorrelationIDHeader)
+	orgKey           = strings.ToLower(auth.OrgHeader)
+)
+
+// methodPermissions lists the permissions, any of which allows a method; the same as its REST route.
+// Methods missing here are refused.
+var methodPermissions = map[string][]string{
+	rxpb.PatientService_ListPatients_FullMethodName: patientsecurity.ReadAccess,
+	rxpb.PatientService_GetPatient_FullMethodName:   patientsecurity.ReadAccess,
+
+	rxpb.PrescriptionService_ListPrescriptions_FullMethodName: prescriptionsecurity.ReadAccess,
+	rxpb.PrescriptionService_GetPrescription_FullMethodName:   prescriptionsecurity.ReadAccess,
+	rxpb.PrescriptionService_CheckInteractions_FullMethodName: prescriptionsecurity.ReadAccess,
+	rxpb.PrescriptionService_SearchPharmacies_FullMethodName:  prescriptionsecurity.ReadAccess,
+
+	rxpb.PrescriptionService_CreatePrescription_FullMethodName: prescriptionsecurity.WriteAccess,
+	rxpb.PrescriptionService_UpdatePrescription_FullMethodName: prescriptionsecurity.WriteAccess,
+	rxpb.PrescriptionService_RouteToPharmacy_FullMethodName:    prescriptionsecurity.WriteAccess,
+}
+
+// contextInterceptor gives each call the request and correlation IDs it sent, or new ones, and
+// the logger, as the HTTP middleware does for requests
+func contextInterceptor(l *zap.Logger) grpcgo.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpcgo.UnaryServerInfo, handler grpcgo.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		rid, cid := logging.RequestIDsFrom(first(md, requestIDKey), first(md, correlationIDKey))
+		ctx = logging.WithRequestIDs(ctx, rid, cid)
+		ctx = logging.WithLogger(ctx, l.With(zap.String("request_id", rid), zap.String("correlation_id", cid)))
+
+		_ = grpcgo.SetHeader(ctx, metadata.Pairs(requestIDKey, rid, correlationIDKey, cid))
+		return handler(ctx, req)
+	}
+}
+
+// loggingInterceptor logs each call with its outcome
+func loggingInterceptor() grpcgo.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpcgo.UnaryServerInfo, handler grpcgo.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logging.FromContext(ctx).Info("grpc_request",
+			zap.String("method", info.FullMethod),
+			zap.String("code", status.Code(err).String()),
+			zap.Duration("duration", time.Since(start)),
+		)
+		return resp, err
+	}
+}
+
+// recoveryInterceptor turns a panic in a handler into an Internal error
+func recoveryInterceptor() grpcgo.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpcgo.UnaryServerInfo, handler grpcgo.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logging.FromContext(ctx).Error("panic in gRPC handler",
+					zap.String("method", info.FullMethod), zap.Any("panic", r), zap.ByteString("stack", debug.Stack()))
+				err = status.Error(codes.Internal, "internal error")
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// authInterceptor validates the bearer token of each call, scopes the call to the user's
+// organization when tenancy is enabled, and checks the user has a permission of the method
+func authInterceptor(required map[string][]string) grpcgo.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpcgo.UnaryServerInfo, handler grpcgo.UnaryHandler) (any, error) {
+		log := logging.FromContext(ctx)
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		token, ok := strings.CutPrefix(first(md, authorizationKey), "Bearer ")
+		if !ok || token == "" {
+			log.Info("Authentication required: no token", zap.String("method", info.FullMethod))
+			return nil, status.Error(codes.Unauthenticated, "Authentication required")
+		}
+		user, err := auth.ValidateToken(token)
+		if err != nil {
+			log.Warn("Authentication failed: invalid token", zap.String("method", info.FullMethod), zap.Error(err))
+			return nil, status.Error(codes.Unauthenticated, "Invalid or expired token")
+		}
+
+		ctx, refused := auth.ScopeToOrg(auth.SetUser(ctx, user), user, first(md, orgKey))
+		if refused != "" {
+			log.Warn("Organization access denied", zap.String("method", info.FullMethod), zap.String("reason", refused))
+			return nil, status.Error(codes.PermissionDenied, refused)
+		}
+
+		permissions, ok := required[info.FullMethod]
+		if !ok || !auth.HasAnyPermission(user.Permissions, permissions) {
+			log.Warn("Permission denied", zap.String("method", info.FullMethod), zap.String("user_id", user.ID))
+			return nil, status.Error(codes.PermissionDenied, "Insufficient permissions")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// redactionInterceptor clears the fields the caller's redaction profile hides from the response,
+// as the HTTP middleware nulls them in REST and GraphQL responses. Fields match on their proto or
+// JSON name, at any depth. Runs after authInterceptor, which puts the caller on the context.
+func redactionInterceptor(redactor *redaction.Redactor) grpcgo.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpcgo.UnaryServerInfo, handler grpcgo.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+		user, _ := auth.GetCurrentUser(ctx)
+		profile := redactor.ProfileFor(user)
+		if !redactor.Redacts(profile) {
+			return resp, nil
+		}
+		message, ok := resp.(proto.Message)
+		if !ok {
+			logging.FromContext(ctx).Error("Response is not a proto message, refusing it", zap.String("method", info.FullMethod))
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+		redactMessage(redactor, profile, message.ProtoReflect())
+		return resp, nil
+	}
+}
+
+// redactMessage clears the redacted fields of m and of the messages it holds
+func redactMessage(redactor *redaction.Redactor, profile redaction.Profile, m protoreflect.Message) {
+	var redacted []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if redactor.RedactsField(profile, string(fd.Name())) || redactor.RedactsField(profile, fd.JSONName()) {
+			redacted = append(redacted, fd)
+			return true
+		}
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				redactMessage(redactor, profile, list.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, value protoreflect.Value) bool {
+				redactMessage(redactor, profile, value.Message())
+				return true
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			redactMessage(redactor, profile, v.Message())
+		}
+		return true
+	})
+	for _, fd := range redacted {
+		m.Clear(fd)
+	}
+}
+
+// first returns the first value of the metadata key, or ""
+func first(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,90 @@
+package grpc
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	m "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/domain/patient/contracts/request"
+	patientsecurity "pharmacy-modernization-project-model/domain/patient/security"
+	patientservice "pharmacy-modernization-project-model/domain/patient/service"
+	"pharmacy-modernization-project-model/internal/bind"
+	"pharmacy-modernization-project-model/internal/grpc/rxpb"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/logging"
+)
+
+type patientServer struct {
+	rxpb.UnimplementedPatientServiceServer
+	patients patientservice.PatientService
+}
+
+func (s *patientServer) ListPatients(ctx context.Context, in *rxpb.ListPatientsRequest) (*rxpb.ListPatientsResponse, error) {
+	req, fieldErrors, err := bind.Struct(request.PatientListQueryRequest{
+		Limit:       int(in.GetLimit()),
+		Offset:      int(in.GetOffset()),
+		PatientName: in.GetPatientName(),
+		BirthDate:   in.GetBirthDate(),
+		State:       in.GetState(),
+	})
+	if err != nil {
+		return nil, invalidArgument(fieldErrors)
+	}
+
+	// Set default limit if not provided
+	if req.Limit == 0 {
+		req.Limit = 20
+	}
+
+	// Limit results to the caller's data-access scope
+	user, _ := auth.GetCurrentUser(ctx)
+	req.AllowedStates = patientsecurity.AllowedStates(user)
+
+	items, err := s.patients.List(ctx, req)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	// The counts are a convenience; without them the patients are still listed
+	if err := s.patients.AttachPrescriptionCounts(ctx, items); err != nil {
+		logging.FromContext(ctx).Warn("count prescriptions of patients", zap.Error(err))
+	}
+
+	out := &rxpb.ListPatientsResponse{Patients: make([]*rxpb.Patient, 0, len(items))}
+	for _, item := range items {
+		out.Patients = append(out.Patients, patientMessage(item))
+	}
+	return out, nil
+}
+
+func (s *patientServer) GetPatient(ctx context.Context, in *rxpb.GetPatientRequest) (*rxpb.Patient, error) {
+	pathVars, fieldErrors, err := bind.Struct(request.PatientPathVars{PatientID: in.GetPatientId()})
+	if err != nil {
+		return nil, invalidArgument(fieldErrors)
+	}
+
+	item, err := s.patients.GetByID(ctx, pathVars.PatientID)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return patientMessage(item), nil
+}
+
+func patientMessage(p m.Patient) *rxpb.Patient {
+	out := &rxpb.Patient{
+		Id:        p.ID,
+		Name:      p.Name,
+		Dob:       p.DOB.String(),
+		Phone:     p.Phone,
+		State:     p.State,
+		CreatedAt: timestamppb.New(p.CreatedAt),
+	}
+	if len(p.PrescriptionCounts) > 0 {
+		out.PrescriptionCounts = make(map[string]int32, len(p.PrescriptionCounts))
+		for status, count := range p.PrescriptionCounts {
+			out.PrescriptionCounts[status] = int32(count)
+		}
+	}
+	return out
+}
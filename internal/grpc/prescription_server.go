@@ -0,0 +1,309 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	m "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/domain/prescription/contracts/request"
+	prescriptionservice "pharmacy-modernization-project-model/domain/prescription/service"
+	"pharmacy-modernization-project-model/internal/bind"
+	"pharmacy-modernization-project-model/internal/grpc/rxpb"
+)
+
+type prescriptionServer struct {
+	rxpb.UnimplementedPrescriptionServiceServer
+	prescriptions prescriptionservice.PrescriptionService
+}
+
+func (s *prescriptionServer) ListPrescriptions(ctx context.Context, in *rxpb.ListPrescriptionsRequest) (*rxpb.ListPrescriptionsResponse, error) {
+	req, fieldErrors, err := bind.Struct(request.PrescriptionListQueryRequest{
+		Status: in.GetStatus(),
+		Limit:  int(in.GetLimit()),
+		Offset: int(in.GetOffset()),
+	})
+	if err != nil {
+		return nil, invalidArgument(fieldErrors)
+	}
+
+	// Set default limit if not provided
+	if req.Limit == 0 {
+		req.Limit = 20
+	}
+
+	items, err := s.prescriptions.List(ctx, req.Status, req.Limit, req.Offset)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	out := &rxpb.ListPrescriptionsResponse{Prescriptions: make([]*rxpb.Prescription, 0, len(items))}
+	for _, item := range items {
+		out.Prescriptions = append(out.Prescriptions, prescriptionMessage(item))
+	}
+	return out, nil
+}
+
+func (s *prescriptionServer) GetPrescription(ctx context.Context, in *rxpb.GetPrescriptionRequest) (*rxpb.Prescription, error) {
+	pathVars, fieldErrors, err := bind.Struct(request.PrescriptionPathVars{PrescriptionID: in.GetPrescriptionId()})
+	if err != nil {
+		return nil, invalidArgument(fieldErrors)
+	}
+
+	item, err := s.prescriptions.GetByID(ctx, pathVars.PrescriptionID)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	if item.ID == "" {
+		return nil, status.Error(codes.NotFound, "prescription not found")
+	}
+	return prescriptionMessage(item), nil
+}
+
+func (s *prescriptionServer) CreatePrescription(ctx context.Context, in *rxpb.CreatePrescriptionRequest) (*rxpb.Prescription, error) {
+	req, fieldErrors, err := bind.Struct(request.PrescriptionCreateRequest{
+		PatientID:    in.GetPatientId(),
+		PrescriberID: in.GetPrescriberId(),
+		Drug:         in.GetDrug(),
+		DrugID:       in.GetDrugId(),
+		Dose:         in.GetDose(),
+		Dosage:       doseRequest(in.GetDosage()),
+		Status:       in.GetStatus(),
+		Sig:          sigRequest(in.GetSig()),
+		SigText:      in.GetSigText(),
+		Quantity:     int(in.GetQuantity()),
+		DaysSupply:   int(in.GetDaysSupply()),
+	})
+	if err != nil {
+		return nil, invalidArgument(fieldErrors)
+	}
+
+	prescription, err := req.Prescription()
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	created, err := s.prescriptions.Create(ctx, prescription)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return prescriptionMessage(created), nil
+}
+
+func (s *prescriptionServer) UpdatePrescription(ctx context.Context, in *rxpb.UpdatePrescriptionRequest) (*rxpb.Prescription, error) {
+	pathVars, fieldErrors, err := bind.Struct(request.PrescriptionPathVars{PrescriptionID: in.GetPrescriptionId()})
+	if err != nil {
+		return nil, invalidArgument(fieldErrors)
+	}
+
+	req, fieldErrors, err := bind.Struct(request.PrescriptionUpdateRequest{
+		Drug:       in.Drug,
+		DrugID:     in.DrugId,
+		Dose:       in.Dose,
+		Dosage:     doseRequest(in.GetDosage()),
+		Status:     in.Status,
+		Sig:        sigRequest(in.GetSig()),
+		SigText:    in.SigText,
+		Quantity:   intPtr(in.Quantity),
+		DaysSupply: intPtr(in.DaysSupply),
+	})
+	if err != nil {
+		return nil, invalidArgument(fieldErrors)
+	}
+
+	existing, err := s.prescriptions.GetByID(ctx, pathVars.PrescriptionID)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	if existing.ID == "" {
+		return nil, status.Error(codes.NotFound, "prescription not found")
+	}
+	if err := req.Apply(&existing); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	if err := s.prescriptions.Update(ctx, existing); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	updated, err := s.prescriptions.GetByID(ctx, existing.ID)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return prescriptionMessage(updated), nil
+}
+
+func (s *prescriptionServer) RouteToPharmacy(ctx context.Context, in *rxpb.RouteToPharmacyRequest) (*rxpb.Prescription, error) {
+	pathVars, fieldErrors, err := bind.Struct(request.PrescriptionPathVars{PrescriptionID: in.GetPrescriptionId()})
+	if err != nil {
+		return nil, invalidArgument(fieldErrors)
+	}
+	req, fieldErrors, err := bind.Struct(request.RoutePrescriptionRequest{PharmacyID: in.GetPharmacyId()})
+	if err != nil {
+		return nil, invalidArgument(fieldErrors)
+	}
+
+	routed, err := s.prescriptions.RouteToPharmacy(ctx, pathVars.PrescriptionID, req.PharmacyID)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return prescriptionMessage(routed), nil
+}
+
+func (s *prescriptionServer) CheckInteractions(ctx context.Context, in *rxpb.CheckInteractionsRequest) (*rxpb.CheckInteractionsResponse, error) {
+	req, fieldErrors, err := bind.Struct(request.InteractionCheckQueryRequest{PatientID: in.GetPatientId(), Drug: in.GetDrug()})
+	if err != nil {
+		return nil, invalidArgument(fieldErrors)
+	}
+
+	result, err := s.prescriptions.CheckInteractions(ctx, req.PatientID, req.Drug)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &rxpb.CheckInteractionsResponse{Blocked: result.Blocked, Warnings: warningMessages(result.Warnings)}, nil
+}
+
+func (s *prescriptionServer) SearchPharmacies(ctx context.Context, in *rxpb.SearchPharmaciesRequest) (*rxpb.SearchPharmaciesResponse, error) {
+	req, fieldErrors, err := bind.Struct(request.PharmacySearchQueryRequest{
+		Zip:   in.GetZip(),
+		State: in.GetState(),
+		Limit: int(in.GetLimit()),
+	})
+	if err != nil {
+		return nil, invalidArgument(fieldErrors)
+	}
+
+	result, err := s.prescriptions.SearchPharmacies(ctx, req.Zip, req.State, req.Limit)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	out := &rxpb.SearchPharmaciesResponse{Total: int32(result.Total), Pharmacies: make([]*rxpb.NetworkPharmacy, 0, len(result.Pharmacies))}
+	for _, p := range result.Pharmacies {
+		out.Pharmacies = append(out.Pharmacies, &rxpb.NetworkPharmacy{
+			Id:                     p.ID,
+			Name:                   p.Name,
+			Type:                   p.Type,
+			Address:                p.Address,
+			City:                   p.City,
+			State:                  p.State,
+			Zip:                    p.Zip,
+			Phone:                  p.Phone,
+			AcceptingPrescriptions: p.AcceptingPrescriptions,
+		})
+	}
+	return out, nil
+}
+
+// prescriptionMessage mirrors response.FromModel of the REST API
+func prescriptionMessage(p m.Prescription) *rxpb.Prescription {
+	out := &rxpb.Prescription{
+		Id:           p.ID,
+		PatientId:    p.PatientID,
+		Drug:         p.Drug,
+		DrugId:       p.DrugID,
+		DrugEntered:  p.DrugEntered,
+		Dose:         p.Dose,
+		Status:       string(p.Status),
+		CreatedAt:    timestamppb.New(p.CreatedAt),
+		PrescribedBy: p.PrescribedBy,
+		PrescriberId: p.PrescriberID,
+
+		Directions:      p.Sig.Render(m.LanguageEnglish),
+		DirectionsEs:    p.Sig.Render(m.LanguageSpanish),
+		Quantity:        int32(p.Quantity),
+		DaysSupply:      int32(p.DaysSupply),
+		ExpectedEndDate: timestampOf(p.ExpectedEndDate),
+
+		InteractionWarnings: warningMessages(p.InteractionWarnings),
+
+		FulfillmentStatus:    string(p.FulfillmentStatus),
+		FulfillmentUpdatedAt: timestampOf(p.FulfillmentUpdatedAt),
+	}
+	if p.Dosage != nil {
+		out.Dosage = &rxpb.Dose{
+			Value:     p.Dosage.Value,
+			Unit:      string(p.Dosage.Unit),
+			Frequency: string(p.Dosage.Frequency),
+			Route:     string(p.Dosage.Route),
+		}
+	}
+	if !p.Sig.IsZero() {
+		out.Sig = &rxpb.Sig{
+			DoseQuantity: p.Sig.DoseQuantity,
+			DoseUnit:     string(p.Sig.DoseUnit),
+			Route:        string(p.Sig.Route),
+			Frequency:    string(p.Sig.Frequency),
+			Timing:       string(p.Sig.Timing),
+			AsNeeded:     p.Sig.AsNeeded,
+			Indication:   p.Sig.Indication,
+		}
+	}
+	if p.Pharmacy != nil {
+		out.Pharmacy = &rxpb.RoutedPharmacy{
+			Id:       p.Pharmacy.ID,
+			Name:     p.Pharmacy.Name,
+			Type:     p.Pharmacy.Type,
+			Address:  p.Pharmacy.Address,
+			City:     p.Pharmacy.City,
+			State:    p.Pharmacy.State,
+			Zip:      p.Pharmacy.Zip,
+			Phone:    p.Pharmacy.Phone,
+			RoutedAt: timestamppb.New(p.Pharmacy.RoutedAt),
+		}
+	}
+	return out
+}
+
+func warningMessages(warnings []m.DrugInteractionWarning) []*rxpb.InteractionWarning {
+	out := make([]*rxpb.InteractionWarning, 0, len(warnings))
+	for _, w := range warnings {
+		out = append(out, &rxpb.InteractionWarning{
+			Drug:                      w.Drug,
+			InteractingDrug:           w.InteractingDrug,
+			InteractingPrescriptionId: w.InteractingPrescriptionID,
+			Severity:                  string(w.Severity),
+			Description:               w.Description,
+		})
+	}
+	return out
+}
+
+func doseRequest(d *rxpb.Dose) *request.DoseRequest {
+	if d == nil {
+		return nil
+	}
+	return &request.DoseRequest{Value: d.GetValue(), Unit: d.GetUnit(), Frequency: d.GetFrequency(), Route: d.GetRoute()}
+}
+
+func sigRequest(s *rxpb.Sig) *request.SigRequest {
+	if s == nil {
+		return nil
+	}
+	return &request.SigRequest{
+		DoseQuantity: s.GetDoseQuantity(),
+		DoseUnit:     s.GetDoseUnit(),
+		Route:        s.GetRoute(),
+		Frequency:    s.GetFrequency(),
+		Timing:       s.GetTiming(),
+		AsNeeded:     s.GetAsNeeded(),
+		Indication:   s.GetIndication(),
+	}
+}
+
+func intPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	n := int(*v)
+	return &n
+}
+
+func timestampOf(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
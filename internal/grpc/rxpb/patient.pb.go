@@ -0,0 +1,376 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: patient.proto
+
+package rxpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Patient struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Date of birth as YYYY, YYYY-MM or YYYY-MM-DD
+	Dob       string                 `protobuf:"bytes,3,opt,name=dob,proto3" json:"dob,omitempty"`
+	Phone     string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	State     string                 `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Number of the patient's prescriptions by status; only filled in on lists
+	PrescriptionCounts map[string]int32 `protobuf:"bytes,7,rep,name=prescription_counts,json=prescriptionCounts,proto3" json:"prescription_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Patient) Reset() {
+	*x = Patient{}
+	mi := &file_patient_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Patient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Patient) ProtoMessage() {}
+
+func (x *Patient) ProtoReflect() protoreflect.Message {
+	mi := &file_patient_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Patient.ProtoReflect.Descriptor instead.
+func (*Patient) Descriptor() ([]byte, []int) {
+	return file_patient_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Patient) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Patient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Patient) GetDob() string {
+	if x != nil {
+		return x.Dob
+	}
+	return ""
+}
+
+func (x *Patient) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Patient) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Patient) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Patient) GetPrescriptionCounts() map[string]int32 {
+	if x != nil {
+		return x.PrescriptionCounts
+	}
+	return nil
+}
+
+type ListPatientsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to 20
+	Limit         int32  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	PatientName   string `protobuf:"bytes,3,opt,name=patient_name,json=patientName,proto3" json:"patient_name,omitempty"`
+	BirthDate     string `protobuf:"bytes,4,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
+	State         string `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPatientsRequest) Reset() {
+	*x = ListPatientsRequest{}
+	mi := &file_patient_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPatientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPatientsRequest) ProtoMessage() {}
+
+func (x *ListPatientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_patient_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPatientsRequest.ProtoReflect.Descriptor instead.
+func (*ListPatientsRequest) Descriptor() ([]byte, []int) {
+	return file_patient_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListPatientsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPatientsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListPatientsRequest) GetPatientName() string {
+	if x != nil {
+		return x.PatientName
+	}
+	return ""
+}
+
+func (x *ListPatientsRequest) GetBirthDate() string {
+	if x != nil {
+		return x.BirthDate
+	}
+	return ""
+}
+
+func (x *ListPatientsRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type ListPatientsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Patients      []*Patient             `protobuf:"bytes,1,rep,name=patients,proto3" json:"patients,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPatientsResponse) Reset() {
+	*x = ListPatientsResponse{}
+	mi := &file_patient_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPatientsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPatientsResponse) ProtoMessage() {}
+
+func (x *ListPatientsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_patient_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPatientsResponse.ProtoReflect.Descriptor instead.
+func (*ListPatientsResponse) Descriptor() ([]byte, []int) {
+	return file_patient_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPatientsResponse) GetPatients() []*Patient {
+	if x != nil {
+		return x.Patients
+	}
+	return nil
+}
+
+type GetPatientRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PatientId     string                 `protobuf:"bytes,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPatientRequest) Reset() {
+	*x = GetPatientRequest{}
+	mi := &file_patient_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPatientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPatientRequest) ProtoMessage() {}
+
+func (x *GetPatientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_patient_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPatientRequest.ProtoReflect.Descriptor instead.
+func (*GetPatientRequest) Descriptor() ([]byte, []int) {
+	return file_patient_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPatientRequest) GetPatientId() string {
+	if x != nil {
+		return x.PatientId
+	}
+	return ""
+}
+
+var File_patient_proto protoreflect.FileDescriptor
+
+const file_patient_proto_rawDesc = "" +
+	"\n" +
+	"\rpatient.proto\x12\x05rx.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc6\x02\n" +
+	"\aPatient\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03dob\x18\x03 \x01(\tR\x03dob\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05state\x18\x05 \x01(\tR\x05state\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12W\n" +
+	"\x13prescription_counts\x18\a \x03(\v2&.rx.v1.Patient.PrescriptionCountsEntryR\x12prescriptionCounts\x1aE\n" +
+	"\x17PrescriptionCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\x9b\x01\n" +
+	"\x13ListPatientsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12!\n" +
+	"\fpatient_name\x18\x03 \x01(\tR\vpatientName\x12\x1d\n" +
+	"\n" +
+	"birth_date\x18\x04 \x01(\tR\tbirthDate\x12\x14\n" +
+	"\x05state\x18\x05 \x01(\tR\x05state\"B\n" +
+	"\x14ListPatientsResponse\x12*\n" +
+	"\bpatients\x18\x01 \x03(\v2\x0e.rx.v1.PatientR\bpatients\"2\n" +
+	"\x11GetPatientRequest\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x01 \x01(\tR\tpatientId2\x91\x01\n" +
+	"\x0ePatientService\x12G\n" +
+	"\fListPatients\x12\x1a.rx.v1.ListPatientsRequest\x1a\x1b.rx.v1.ListPatientsResponse\x126\n" +
+	"\n" +
+	"GetPatient\x12\x18.rx.v1.GetPatientRequest\x1a\x0e.rx.v1.PatientB>Z<pharmacy-modernization-project-model/internal/grpc/rxpb;rxpbb\x06proto3"
+
+var (
+	file_patient_proto_rawDescOnce sync.Once
+	file_patient_proto_rawDescData []byte
+)
+
+func file_patient_proto_rawDescGZIP() []byte {
+	file_patient_proto_rawDescOnce.Do(func() {
+		file_patient_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_patient_proto_rawDesc), len(file_patient_proto_rawDesc)))
+	})
+	return file_patient_proto_rawDescData
+}
+
+var file_patient_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_patient_proto_goTypes = []any{
+	(*Patient)(nil),               // 0: rx.v1.Patient
+	(*ListPatientsRequest)(nil),   // 1: rx.v1.ListPatientsRequest
+	(*ListPatientsResponse)(nil),  // 2: rx.v1.ListPatientsResponse
+	(*GetPatientRequest)(nil),     // 3: rx.v1.GetPatientRequest
+	nil,                           // 4: rx.v1.Patient.PrescriptionCountsEntry
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_patient_proto_depIdxs = []int32{
+	5, // 0: rx.v1.Patient.created_at:type_name -> google.protobuf.Timestamp
+	4, // 1: rx.v1.Patient.prescription_counts:type_name -> rx.v1.Patient.PrescriptionCountsEntry
+	0, // 2: rx.v1.ListPatientsResponse.patients:type_name -> rx.v1.Patient
+	1, // 3: rx.v1.PatientService.ListPatients:input_type -> rx.v1.ListPatientsRequest
+	3, // 4: rx.v1.PatientService.GetPatient:input_type -> rx.v1.GetPatientRequest
+	2, // 5: rx.v1.PatientService.ListPatients:output_type -> rx.v1.ListPatientsResponse
+	0, // 6: rx.v1.PatientService.GetPatient:output_type -> rx.v1.Patient
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_patient_proto_init() }
+func file_patient_proto_init() {
+	if File_patient_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_patient_proto_rawDesc), len(file_patient_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_patient_proto_goTypes,
+		DependencyIndexes: file_patient_proto_depIdxs,
+		MessageInfos:      file_patient_proto_msgTypes,
+	}.Build()
+	File_patient_proto = out.File
+	file_patient_proto_goTypes = nil
+	file_patient_proto_depIdxs = nil
+}
@@ -0,0 +1,165 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: patient.proto
+
+package rxpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PatientService_ListPatients_FullMethodName = "/rx.v1.PatientService/ListPatients"
+	PatientService_GetPatient_FullMethodName   = "/rx.v1.PatientService/GetPatient"
+)
+
+// PatientServiceClient is the client API for PatientService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PatientService mirrors the patient REST API under /api/v1/patients.
+// Calls require patient:read; lists are limited to the caller's data-access scope.
+type PatientServiceClient interface {
+	ListPatients(ctx context.Context, in *ListPatientsRequest, opts ...grpc.CallOption) (*ListPatientsResponse, error)
+	GetPatient(ctx context.Context, in *GetPatientRequest, opts ...grpc.CallOption) (*Patient, error)
+}
+
+type patientServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPatientServiceClient(cc grpc.ClientConnInterface) PatientServiceClient {
+	return &patientServiceClient{cc}
+}
+
+func (c *patientServiceClient) ListPatients(ctx context.Context, in *ListPatientsRequest, opts ...grpc.CallOption) (*ListPatientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPatientsResponse)
+	err := c.cc.Invoke(ctx, PatientService_ListPatients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientServiceClient) GetPatient(ctx context.Context, in *GetPatientRequest, opts ...grpc.CallOption) (*Patient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Patient)
+	err := c.cc.Invoke(ctx, PatientService_GetPatient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatientServiceServer is the server API for PatientService service.
+// All implementations must embed UnimplementedPatientServiceServer
+// for forward compatibility.
+//
+// PatientService mirrors the patient REST API under /api/v1/patients.
+// Calls require patient:read; lists are limited to the caller's data-access scope.
+type PatientServiceServer interface {
+	ListPatients(context.Context, *ListPatientsRequest) (*ListPatientsResponse, error)
+	GetPatient(context.Context, *GetPatientRequest) (*Patient, error)
+	mustEmbedUnimplementedPatientServiceServer()
+}
+
+// UnimplementedPatientServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPatientServiceServer struct{}
+
+func (UnimplementedPatientServiceServer) ListPatients(context.Context, *ListPatientsRequest) (*ListPatientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPatients not implemented")
+}
+func (UnimplementedPatientServiceServer) GetPatient(context.Context, *GetPatientRequest) (*Patient, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPatient not implemented")
+}
+func (UnimplementedPatientServiceServer) mustEmbedUnimplementedPatientServiceServer() {}
+func (UnimplementedPatientServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafePatientServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PatientServiceServer will
+// result in compilation errors.
+type UnsafePatientServiceServer interface {
+	mustEmbedUnimplementedPatientServiceServer()
+}
+
+func RegisterPatientServiceServer(s grpc.ServiceRegistrar, srv PatientServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPatientServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PatientService_ServiceDesc, srv)
+}
+
+func _PatientService_ListPatients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPatientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientServiceServer).ListPatients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientService_ListPatients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientServiceServer).ListPatients(ctx, req.(*ListPatientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientService_GetPatient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPatientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientServiceServer).GetPatient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientService_GetPatient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientServiceServer).GetPatient(ctx, req.(*GetPatientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PatientService_ServiceDesc is the grpc.ServiceDesc for PatientService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PatientService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rx.v1.PatientService",
+	HandlerType: (*PatientServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPatients",
+			Handler:    _PatientService_ListPatients_Handler,
+		},
+		{
+			MethodName: "GetPatient",
+			Handler:    _PatientService_GetPatient_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "patient.proto",
+}
@@ -0,0 +1,1597 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: prescription.proto
+
+package rxpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Prescription struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PatientId string                 `protobuf:"bytes,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	Drug      string                 `protobuf:"bytes,3,opt,name=drug,proto3" json:"drug,omitempty"`
+	DrugId    string                 `protobuf:"bytes,4,opt,name=drug_id,json=drugId,proto3" json:"drug_id,omitempty"`
+	// The name as entered when it differs from the canonical name
+	DrugEntered string `protobuf:"bytes,5,opt,name=drug_entered,json=drugEntered,proto3" json:"drug_entered,omitempty"`
+	Dose        string `protobuf:"bytes,6,opt,name=dose,proto3" json:"dose,omitempty"`
+	// Draft, Active, Paused, Completed or Expired
+	Status    string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Unset when the free-text dose could not be read
+	Dosage *Dose `protobuf:"bytes,9,opt,name=dosage,proto3" json:"dosage,omitempty"`
+	Sig    *Sig  `protobuf:"bytes,10,opt,name=sig,proto3" json:"sig,omitempty"`
+	// The sig as label text, in English and Spanish
+	Directions           string                 `protobuf:"bytes,11,opt,name=directions,proto3" json:"directions,omitempty"`
+	DirectionsEs         string                 `protobuf:"bytes,12,opt,name=directions_es,json=directionsEs,proto3" json:"directions_es,omitempty"`
+	Quantity             int32                  `protobuf:"varint,13,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	DaysSupply           int32                  `protobuf:"varint,14,opt,name=days_supply,json=daysSupply,proto3" json:"days_supply,omitempty"`
+	ExpectedEndDate      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=expected_end_date,json=expectedEndDate,proto3" json:"expected_end_date,omitempty"`
+	PrescribedBy         string                 `protobuf:"bytes,16,opt,name=prescribed_by,json=prescribedBy,proto3" json:"prescribed_by,omitempty"`
+	PrescriberId         string                 `protobuf:"bytes,17,opt,name=prescriber_id,json=prescriberId,proto3" json:"prescriber_id,omitempty"`
+	InteractionWarnings  []*InteractionWarning  `protobuf:"bytes,18,rep,name=interaction_warnings,json=interactionWarnings,proto3" json:"interaction_warnings,omitempty"`
+	FulfillmentStatus    string                 `protobuf:"bytes,19,opt,name=fulfillment_status,json=fulfillmentStatus,proto3" json:"fulfillment_status,omitempty"`
+	FulfillmentUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=fulfillment_updated_at,json=fulfillmentUpdatedAt,proto3" json:"fulfillment_updated_at,omitempty"`
+	Pharmacy             *RoutedPharmacy        `protobuf:"bytes,21,opt,name=pharmacy,proto3" json:"pharmacy,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Prescription) Reset() {
+	*x = Prescription{}
+	mi := &file_prescription_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Prescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Prescription) ProtoMessage() {}
+
+func (x *Prescription) ProtoReflect() protoreflect.Message {
+	mi := &file_prescription_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Prescription.ProtoReflect.Descriptor instead.
+func (*Prescription) Descriptor() ([]byte, []int) {
+	return file_prescription_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Prescription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Prescription) GetPatientId() string {
+	if x != nil {
+		return x.PatientId
+	}
+	return ""
+}
+
+func (x *Prescription) GetDrug() string {
+	if x != nil {
+		return x.Drug
+	}
+	return ""
+}
+
+func (x *Prescription) GetDrugId() string {
+	if x != nil {
+		return x.DrugId
+	}
+	return ""
+}
+
+func (x *Prescription) GetDrugEntered() string {
+	if x != nil {
+		return x.DrugEntered
+	}
+	return ""
+}
+
+func (x *Prescription) GetDose() string {
+	if x != nil {
+		return x.Dose
+	}
+	return ""
+}
+
+func (x *Prescription) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Prescription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Prescription) GetDosage() *Dose {
+	if x != nil {
+		return x.Dosage
+	}
+	return nil
+}
+
+func (x *Prescription) GetSig() *Sig {
+	if x != nil {
+		return x.Sig
+	}
+	return nil
+}
+
+func (x *Prescription) GetDirections() string {
+	if x != nil {
+		return x.Directions
+	}
+	return ""
+}
+
+func (x *Prescription) GetDirectionsEs() string {
+	if x != nil {
+		return x.DirectionsEs
+	}
+	return ""
+}
+
+func (x *Prescription) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Prescription) GetDaysSupply() int32 {
+	if x != nil {
+		return x.DaysSupply
+	}
+	return 0
+}
+
+func (x *Prescription) GetExpectedEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedEndDate
+	}
+	return nil
+}
+
+func (x *Prescription) GetPrescribedBy() string {
+	if x != nil {
+		return x.PrescribedBy
+	}
+	return ""
+}
+
+func (x *Prescription) GetPrescriberId() string {
+	if x != nil {
+		return x.PrescriberId
+	}
+	return ""
+}
+
+func (x *Prescription) GetInteractionWarnings() []*InteractionWarning {
+	if x != nil {
+		return x.InteractionWarnings
+	}
+	return nil
+}
+
+func (x *Prescription) GetFulfillmentStatus() string {
+	if x != nil {
+		return x.FulfillmentStatus
+	}
+	return ""
+}
+
+func (x *Prescription) GetFulfillmentUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FulfillmentUpdatedAt
+	}
+	return nil
+}
+
+func (x *Prescription) GetPharmacy() *RoutedPharmacy {
+	if x != nil {
+		return x.Pharmacy
+	}
+	return nil
+}
+
+// Dose is the structured dose, e.g. 10 mg
+type Dose struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Unit          string                 `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	Frequency     string                 `protobuf:"bytes,3,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Route         string                 `protobuf:"bytes,4,opt,name=route,proto3" json:"route,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Dose) Reset() {
+	*x = Dose{}
+	mi := &file_prescription_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dose) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dose) ProtoMessage() {}
+
+func (x *Dose) ProtoReflect() protoreflect.Message {
+	mi := &file_prescription_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dose.ProtoReflect.Descriptor instead.
+func (*Dose) Descriptor() ([]byte, []int) {
+	return file_prescription_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Dose) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Dose) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Dose) GetFrequency() string {
+	if x != nil {
+		return x.Frequency
+	}
+	return ""
+}
+
+func (x *Dose) GetRoute() string {
+	if x != nil {
+		return x.Route
+	}
+	return ""
+}
+
+// Sig is the structured dosing instruction
+type Sig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DoseQuantity  float64                `protobuf:"fixed64,1,opt,name=dose_quantity,json=doseQuantity,proto3" json:"dose_quantity,omitempty"`
+	DoseUnit      string                 `protobuf:"bytes,2,opt,name=dose_unit,json=doseUnit,proto3" json:"dose_unit,omitempty"`
+	Route         string                 `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
+	Frequency     string                 `protobuf:"bytes,4,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Timing        string                 `protobuf:"bytes,5,opt,name=timing,proto3" json:"timing,omitempty"`
+	AsNeeded      bool                   `protobuf:"varint,6,opt,name=as_needed,json=asNeeded,proto3" json:"as_needed,omitempty"`
+	Indication    string                 `protobuf:"bytes,7,opt,name=indication,proto3" json:"indication,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sig) Reset() {
+	*x = Sig{}
+	mi := &file_prescription_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sig) ProtoMessage() {}
+
+func (x *Sig) ProtoReflect() protoreflect.Message {
+	mi := &file_prescription_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sig.ProtoReflect.Descriptor instead.
+func (*Sig) Descriptor() ([]byte, []int) {
+	return file_prescription_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Sig) GetDoseQuantity() float64 {
+	if x != nil {
+		return x.DoseQuantity
+	}
+	return 0
+}
+
+func (x *Sig) GetDoseUnit() string {
+	if x != nil {
+		return x.DoseUnit
+	}
+	return ""
+}
+
+func (x *Sig) GetRoute() string {
+	if x != nil {
+		return x.Route
+	}
+	return ""
+}
+
+func (x *Sig) GetFrequency() string {
+	if x != nil {
+		return x.Frequency
+	}
+	return ""
+}
+
+func (x *Sig) GetTiming() string {
+	if x != nil {
+		return x.Timing
+	}
+	return ""
+}
+
+func (x *Sig) GetAsNeeded() bool {
+	if x != nil {
+		return x.AsNeeded
+	}
+	return false
+}
+
+func (x *Sig) GetIndication() string {
+	if x != nil {
+		return x.Indication
+	}
+	return ""
+}
+
+type InteractionWarning struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Drug                      string                 `protobuf:"bytes,1,opt,name=drug,proto3" json:"drug,omitempty"`
+	InteractingDrug           string                 `protobuf:"bytes,2,opt,name=interacting_drug,json=interactingDrug,proto3" json:"interacting_drug,omitempty"`
+	InteractingPrescriptionId string                 `protobuf:"bytes,3,opt,name=interacting_prescription_id,json=interactingPrescriptionId,proto3" json:"interacting_prescription_id,omitempty"`
+	// Minor, Moderate or Severe
+	Severity      string `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	Description   string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InteractionWarning) Reset() {
+	*x = InteractionWarning{}
+	mi := &file_prescription_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InteractionWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InteractionWarning) ProtoMessage() {}
+
+func (x *InteractionWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_prescription_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InteractionWarning.ProtoReflect.Descriptor instead.
+func (*InteractionWarning) Descriptor() ([]byte, []int) {
+	return file_prescription_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InteractionWarning) GetDrug() string {
+	if x != nil {
+		return x.Drug
+	}
+	return ""
+}
+
+func (x *InteractionWarning) GetInteractingDrug() string {
+	if x != nil {
+		return x.InteractingDrug
+	}
+	return ""
+}
+
+func (x *InteractionWarning) GetInteractingPrescriptionId() string {
+	if x != nil {
+		return x.InteractingPrescriptionId
+	}
+	return ""
+}
+
+func (x *InteractionWarning) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *InteractionWarning) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// RoutedPharmacy is the network pharmacy a prescription was routed to
+type RoutedPharmacy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	City          string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	State         string                 `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	Zip           string                 `protobuf:"bytes,7,opt,name=zip,proto3" json:"zip,omitempty"`
+	Phone         string                 `protobuf:"bytes,8,opt,name=phone,proto3" json:"phone,omitempty"`
+	RoutedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=routed_at,json=routedAt,proto3" json:"routed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoutedPharmacy) Reset() {
+	*x = RoutedPharmacy{}
+	mi := &file_prescription_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoutedPharmacy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutedPharmacy) ProtoMessage() {}
+
+func (x *RoutedPharmacy) ProtoReflect() protoreflect.Message {
+	mi := &file_prescription_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoutedPharmacy.ProtoReflect.Descriptor instead.
+func (*RoutedPharmacy) Descriptor() ([]byte, []int) {
+	return file_prescription_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RoutedPharmacy) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RoutedPharmacy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoutedPharmacy) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RoutedPharmacy) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RoutedPharmacy) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *RoutedPharmacy) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *RoutedPharmacy) GetZip() string {
+	if x != nil {
+		return x.Zip
+	}
+	return ""
+}
+
+func (x *RoutedPharmacy) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *RoutedPharmacy) GetRoutedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RoutedAt
+	}
+	return nil
+}
+
+type NetworkPharmacy struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type                   string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Address                string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	City                   string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	State                  string                 `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	Zip                    string                 `protobuf:"bytes,7,opt,name=zip,proto3" json:"zip,omitempty"`
+	Phone                  string                 `protobuf:"bytes,8,opt,name=phone,proto3" json:"phone,omitempty"`
+	AcceptingPrescriptions bool                   `protobuf:"varint,9,opt,name=accepting_prescriptions,json=acceptingPrescriptions,proto3" json:"accepting_prescriptions,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *NetworkPharmacy) Reset() {
+	*x = NetworkPharmacy{}
+	mi := &file_prescription_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkPharmacy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkPharmacy) ProtoMessage() {}
+
+func (x *NetworkPharmacy) ProtoReflect() protoreflect.Message {
+	mi := &file_prescription_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkPharmacy.ProtoReflect.Descriptor instead.
+func (*NetworkPharmacy) Descriptor() ([]byte, []int) {
+	return file_prescription_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NetworkPharmacy) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NetworkPharmacy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetworkPharmacy) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NetworkPharmacy) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *NetworkPharmacy) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *NetworkPharmacy) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *NetworkPharmacy) GetZip() string {
+	if x != nil {
+		return x.Zip
+	}
+	return ""
+}
+
+func (x *NetworkPharmacy) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *NetworkPharmacy) GetAcceptingPrescriptions() bool {
+	if x != nil {
+		return x.AcceptingPrescriptions
+	}
+	return false
+}
+
+type ListPrescriptionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Defaults to 20
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPrescriptionsRequest) Reset() {
+	*x = ListPrescriptionsRequest{}
+	mi := &file_prescription_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPrescriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPrescriptionsRequest) ProtoMessage() {}
+
+func (x *ListPrescriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prescription_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPrescriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPrescriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_prescription_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListPrescriptionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListPrescriptionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPrescriptionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListPrescriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prescriptions []*Prescription        `protobuf:"bytes,1,rep,name=prescriptions,proto3" json:"prescriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPrescriptionsResponse) Reset() {
+	*x = ListPrescriptionsResponse{}
+	mi := &file_prescription_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPrescriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPrescriptionsResponse) ProtoMessage() {}
+
+func (x *ListPrescriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prescription_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPrescriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPrescriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_prescription_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListPrescriptionsResponse) GetPrescriptions() []*Prescription {
+	if x != nil {
+		return x.Prescriptions
+	}
+	return nil
+}
+
+type GetPrescriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PrescriptionId string                 `protobuf:"bytes,1,opt,name=prescription_id,json=prescriptionId,proto3" json:"prescription_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetPrescriptionRequest) Reset() {
+	*x = GetPrescriptionRequest{}
+	mi := &file_prescription_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrescriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrescriptionRequest) ProtoMessage() {}
+
+func (x *GetPrescriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prescription_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrescriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetPrescriptionRequest) Descriptor() ([]byte, []int) {
+	return file_prescription_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetPrescriptionRequest) GetPrescriptionId() string {
+	if x != nil {
+		return x.PrescriptionId
+	}
+	return ""
+}
+
+type CreatePrescriptionRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	PatientId    string                 `protobuf:"bytes,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	PrescriberId string                 `protobuf:"bytes,2,opt,name=prescriber_id,json=prescriberId,proto3" json:"prescriber_id,omitempty"`
+	Drug         string                 `protobuf:"bytes,3,opt,name=drug,proto3" json:"drug,omitempty"`
+	// Catalog ID picked from autocomplete
+	DrugId string `protobuf:"bytes,4,opt,name=drug_id,json=drugId,proto3" json:"drug_id,omitempty"`
+	// The dose is given structured or as free text, which is parsed
+	Dose   string `protobuf:"bytes,5,opt,name=dose,proto3" json:"dose,omitempty"`
+	Dosage *Dose  `protobuf:"bytes,6,opt,name=dosage,proto3" json:"dosage,omitempty"`
+	// Defaults to Draft
+	Status string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	// The sig is given structured or as free text, which is parsed
+	Sig           *Sig   `protobuf:"bytes,8,opt,name=sig,proto3" json:"sig,omitempty"`
+	SigText       string `protobuf:"bytes,9,opt,name=sig_text,json=sigText,proto3" json:"sig_text,omitempty"`
+	Quantity      int32  `protobuf:"varint,10,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	DaysSupply    int32  `protobuf:"varint,11,opt,name=days_supply,json=daysSupply,proto3" json:"days_supply,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePrescriptionRequest) Reset() {
+	*x = CreatePrescriptionRequest{}
+	mi := &file_prescription_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePrescriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePrescriptionRequest) ProtoMessage() {}
+
+func (x *CreatePrescriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prescription_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePrescriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreatePrescriptionRequest) Descriptor() ([]byte, []int) {
+	return file_prescription_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreatePrescriptionRequest) GetPatientId() string {
+	if x != nil {
+		return x.PatientId
+	}
+	return ""
+}
+
+func (x *CreatePrescriptionRequest) GetPrescriberId() string {
+	if x != nil {
+		return x.PrescriberId
+	}
+	return ""
+}
+
+func (x *CreatePrescriptionRequest) GetDrug() string {
+	if x != nil {
+		return x.Drug
+	}
+	return ""
+}
+
+func (x *CreatePrescriptionRequest) GetDrugId() string {
+	if x != nil {
+		return x.DrugId
+	}
+	return ""
+}
+
+func (x *CreatePrescriptionRequest) GetDose() string {
+	if x != nil {
+		return x.Dose
+	}
+	return ""
+}
+
+func (x *CreatePrescriptionRequest) GetDosage() *Dose {
+	if x != nil {
+		return x.Dosage
+	}
+	return nil
+}
+
+func (x *CreatePrescriptionRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreatePrescriptionRequest) GetSig() *Sig {
+	if x != nil {
+		return x.Sig
+	}
+	return nil
+}
+
+func (x *CreatePrescriptionRequest) GetSigText() string {
+	if x != nil {
+		return x.SigText
+	}
+	return ""
+}
+
+func (x *CreatePrescriptionRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CreatePrescriptionRequest) GetDaysSupply() int32 {
+	if x != nil {
+		return x.DaysSupply
+	}
+	return 0
+}
+
+// UpdatePrescriptionRequest changes only the fields it sets
+type UpdatePrescriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PrescriptionId string                 `protobuf:"bytes,1,opt,name=prescription_id,json=prescriptionId,proto3" json:"prescription_id,omitempty"`
+	Drug           *string                `protobuf:"bytes,2,opt,name=drug,proto3,oneof" json:"drug,omitempty"`
+	DrugId         *string                `protobuf:"bytes,3,opt,name=drug_id,json=drugId,proto3,oneof" json:"drug_id,omitempty"`
+	Dose           *string                `protobuf:"bytes,4,opt,name=dose,proto3,oneof" json:"dose,omitempty"`
+	Dosage         *Dose                  `protobuf:"bytes,5,opt,name=dosage,proto3" json:"dosage,omitempty"`
+	Status         *string                `protobuf:"bytes,6,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	// A new sig or quantity without a days supply derives the days supply again
+	Sig           *Sig    `protobuf:"bytes,7,opt,name=sig,proto3" json:"sig,omitempty"`
+	SigText       *string `protobuf:"bytes,8,opt,name=sig_text,json=sigText,proto3,oneof" json:"sig_text,omitempty"`
+	Quantity      *int32  `protobuf:"varint,9,opt,name=quantity,proto3,oneof" json:"quantity,omitempty"`
+	DaysSupply    *int32  `protobuf:"varint,10,opt,name=days_supply,json=daysSupply,proto3,oneof" json:"days_supply,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePrescriptionRequest) Reset() {
+	*x = UpdatePrescriptionRequest{}
+	mi := &file_prescription_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePrescriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePrescriptionRequest) ProtoMessage() {}
+
+func (x *UpdatePrescriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prescription_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePrescriptionRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePrescriptionRequest) Descriptor() ([]byte, []int) {
+	return file_prescription_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdatePrescriptionRequest) GetPrescriptionId() string {
+	if x != nil {
+		return x.PrescriptionId
+	}
+	return ""
+}
+
+func (x *UpdatePrescriptionRequest) GetDrug() string {
+	if x != nil && x.Drug != nil {
+		return *x.Drug
+	}
+	return ""
+}
+
+func (x *UpdatePrescriptionRequest) GetDrugId() string {
+	if x != nil && x.DrugId != nil {
+		return *x.DrugId
+	}
+	return ""
+}
+
+func (x *UpdatePrescriptionRequest) GetDose() string {
+	if x != nil && x.Dose != nil {
+		return *x.Dose
+	}
+	return ""
+}
+
+func (x *UpdatePrescriptionRequest) GetDosage() *Dose {
+	if x != nil {
+		return x.Dosage
+	}
+	return nil
+}
+
+func (x *UpdatePrescriptionRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *UpdatePrescriptionRequest) GetSig() *Sig {
+	if x != nil {
+		return x.Sig
+	}
+	return nil
+}
+
+func (x *UpdatePrescriptionRequest) GetSigText() string {
+	if x != nil && x.SigText != nil {
+		return *x.SigText
+	}
+	return ""
+}
+
+func (x *UpdatePrescriptionRequest) GetQuantity() int32 {
+	if x != nil && x.Quantity != nil {
+		return *x.Quantity
+	}
+	return 0
+}
+
+func (x *UpdatePrescriptionRequest) GetDaysSupply() int32 {
+	if x != nil && x.DaysSupply != nil {
+		return *x.DaysSupply
+	}
+	return 0
+}
+
+type RouteToPharmacyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PrescriptionId string                 `protobuf:"bytes,1,opt,name=prescription_id,json=prescriptionId,proto3" json:"prescription_id,omitempty"`
+	PharmacyId     string                 `protobuf:"bytes,2,opt,name=pharmacy_id,json=pharmacyId,proto3" json:"pharmacy_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RouteToPharmacyRequest) Reset() {
+	*x = RouteToPharmacyRequest{}
+	mi := &file_prescription_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteToPharmacyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteToPharmacyRequest) ProtoMessage() {}
+
+func (x *RouteToPharmacyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prescription_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteToPharmacyRequest.ProtoReflect.Descriptor instead.
+func (*RouteToPharmacyRequest) Descriptor() ([]byte, []int) {
+	return file_prescription_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RouteToPharmacyRequest) GetPrescriptionId() string {
+	if x != nil {
+		return x.PrescriptionId
+	}
+	return ""
+}
+
+func (x *RouteToPharmacyRequest) GetPharmacyId() string {
+	if x != nil {
+		return x.PharmacyId
+	}
+	return ""
+}
+
+type CheckInteractionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PatientId     string                 `protobuf:"bytes,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	Drug          string                 `protobuf:"bytes,2,opt,name=drug,proto3" json:"drug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInteractionsRequest) Reset() {
+	*x = CheckInteractionsRequest{}
+	mi := &file_prescription_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInteractionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInteractionsRequest) ProtoMessage() {}
+
+func (x *CheckInteractionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prescription_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInteractionsRequest.ProtoReflect.Descriptor instead.
+func (*CheckInteractionsRequest) Descriptor() ([]byte, []int) {
+	return file_prescription_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CheckInteractionsRequest) GetPatientId() string {
+	if x != nil {
+		return x.PatientId
+	}
+	return ""
+}
+
+func (x *CheckInteractionsRequest) GetDrug() string {
+	if x != nil {
+		return x.Drug
+	}
+	return ""
+}
+
+type CheckInteractionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A severe interaction blocks the prescription
+	Blocked       bool                  `protobuf:"varint,1,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	Warnings      []*InteractionWarning `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInteractionsResponse) Reset() {
+	*x = CheckInteractionsResponse{}
+	mi := &file_prescription_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInteractionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInteractionsResponse) ProtoMessage() {}
+
+func (x *CheckInteractionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prescription_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInteractionsResponse.ProtoReflect.Descriptor instead.
+func (*CheckInteractionsResponse) Descriptor() ([]byte, []int) {
+	return file_prescription_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CheckInteractionsResponse) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *CheckInteractionsResponse) GetWarnings() []*InteractionWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// SearchPharmaciesRequest needs a zip or a state
+type SearchPharmaciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Zip           string                 `protobuf:"bytes,1,opt,name=zip,proto3" json:"zip,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPharmaciesRequest) Reset() {
+	*x = SearchPharmaciesRequest{}
+	mi := &file_prescription_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPharmaciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPharmaciesRequest) ProtoMessage() {}
+
+func (x *SearchPharmaciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prescription_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPharmaciesRequest.ProtoReflect.Descriptor instead.
+func (*SearchPharmaciesRequest) Descriptor() ([]byte, []int) {
+	return file_prescription_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchPharmaciesRequest) GetZip() string {
+	if x != nil {
+		return x.Zip
+	}
+	return ""
+}
+
+func (x *SearchPharmaciesRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *SearchPharmaciesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchPharmaciesResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Pharmacies []*NetworkPharmacy     `protobuf:"bytes,1,rep,name=pharmacies,proto3" json:"pharmacies,omitempty"`
+	// Counts every match
+	Total         int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPharmaciesResponse) Reset() {
+	*x = SearchPharmaciesResponse{}
+	mi := &file_prescription_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPharmaciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPharmaciesResponse) ProtoMessage() {}
+
+func (x *SearchPharmaciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prescription_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPharmaciesResponse.ProtoReflect.Descriptor instead.
+func (*SearchPharmaciesResponse) Descriptor() ([]byte, []int) {
+	return file_prescription_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchPharmaciesResponse) GetPharmacies() []*NetworkPharmacy {
+	if x != nil {
+		return x.Pharmacies
+	}
+	return nil
+}
+
+func (x *SearchPharmaciesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_prescription_proto protoreflect.FileDescriptor
+
+const file_prescription_proto_rawDesc = "" +
+	"\n" +
+	"\x12prescription.proto\x12\x05rx.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcd\x06\n" +
+	"\fPrescription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\tR\tpatientId\x12\x12\n" +
+	"\x04drug\x18\x03 \x01(\tR\x04drug\x12\x17\n" +
+	"\adrug_id\x18\x04 \x01(\tR\x06drugId\x12!\n" +
+	"\fdrug_entered\x18\x05 \x01(\tR\vdrugEntered\x12\x12\n" +
+	"\x04dose\x18\x06 \x01(\tR\x04dose\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12#\n" +
+	"\x06dosage\x18\t \x01(\v2\v.rx.v1.DoseR\x06dosage\x12\x1c\n" +
+	"\x03sig\x18\n" +
+	" \x01(\v2\n" +
+	".rx.v1.SigR\x03sig\x12\x1e\n" +
+	"\n" +
+	"directions\x18\v \x01(\tR\n" +
+	"directions\x12#\n" +
+	"\rdirections_es\x18\f \x01(\tR\fdirectionsEs\x12\x1a\n" +
+	"\bquantity\x18\r \x01(\x05R\bquantity\x12\x1f\n" +
+	"\vdays_supply\x18\x0e \x01(\x05R\n" +
+	"daysSupply\x12F\n" +
+	"\x11expected_end_date\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\x0fexpectedEndDate\x12#\n" +
+	"\rprescribed_by\x18\x10 \x01(\tR\fprescribedBy\x12#\n" +
+	"\rprescriber_id\x18\x11 \x01(\tR\fprescriberId\x12L\n" +
+	"\x14interaction_warnings\x18\x12 \x03(\v2\x19.rx.v1.InteractionWarningR\x13interactionWarnings\x12-\n" +
+	"\x12fulfillment_status\x18\x13 \x01(\tR\x11fulfillmentStatus\x12P\n" +
+	"\x16fulfillment_updated_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\x14fulfillmentUpdatedAt\x121\n" +
+	"\bpharmacy\x18\x15 \x01(\v2\x15.rx.v1.RoutedPharmacyR\bpharmacy\"d\n" +
+	"\x04Dose\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12\x12\n" +
+	"\x04unit\x18\x02 \x01(\tR\x04unit\x12\x1c\n" +
+	"\tfrequency\x18\x03 \x01(\tR\tfrequency\x12\x14\n" +
+	"\x05route\x18\x04 \x01(\tR\x05route\"\xd0\x01\n" +
+	"\x03Sig\x12#\n" +
+	"\rdose_quantity\x18\x01 \x01(\x01R\fdoseQuantity\x12\x1b\n" +
+	"\tdose_unit\x18\x02 \x01(\tR\bdoseUnit\x12\x14\n" +
+	"\x05route\x18\x03 \x01(\tR\x05route\x12\x1c\n" +
+	"\tfrequency\x18\x04 \x01(\tR\tfrequency\x12\x16\n" +
+	"\x06timing\x18\x05 \x01(\tR\x06timing\x12\x1b\n" +
+	"\tas_needed\x18\x06 \x01(\bR\basNeeded\x12\x1e\n" +
+	"\n" +
+	"indication\x18\a \x01(\tR\n" +
+	"indication\"\xd1\x01\n" +
+	"\x12InteractionWarning\x12\x12\n" +
+	"\x04drug\x18\x01 \x01(\tR\x04drug\x12)\n" +
+	"\x10interacting_drug\x18\x02 \x01(\tR\x0finteractingDrug\x12>\n" +
+	"\x1binteracting_prescription_id\x18\x03 \x01(\tR\x19interactingPrescriptionId\x12\x1a\n" +
+	"\bseverity\x18\x04 \x01(\tR\bseverity\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"\xed\x01\n" +
+	"\x0eRoutedPharmacy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04city\x18\x05 \x01(\tR\x04city\x12\x14\n" +
+	"\x05state\x18\x06 \x01(\tR\x05state\x12\x10\n" +
+	"\x03zip\x18\a \x01(\tR\x03zip\x12\x14\n" +
+	"\x05phone\x18\b \x01(\tR\x05phone\x127\n" +
+	"\trouted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\broutedAt\"\xee\x01\n" +
+	"\x0fNetworkPharmacy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04city\x18\x05 \x01(\tR\x04city\x12\x14\n" +
+	"\x05state\x18\x06 \x01(\tR\x05state\x12\x10\n" +
+	"\x03zip\x18\a \x01(\tR\x03zip\x12\x14\n" +
+	"\x05phone\x18\b \x01(\tR\x05phone\x127\n" +
+	"\x17accepting_prescriptions\x18\t \x01(\bR\x16acceptingPrescriptions\"`\n" +
+	"\x18ListPrescriptionsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"V\n" +
+	"\x19ListPrescriptionsResponse\x129\n" +
+	"\rprescriptions\x18\x01 \x03(\v2\x13.rx.v1.PrescriptionR\rprescriptions\"A\n" +
+	"\x16GetPrescriptionRequest\x12'\n" +
+	"\x0fprescription_id\x18\x01 \x01(\tR\x0eprescriptionId\"\xd3\x02\n" +
+	"\x19CreatePrescriptionRequest\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x01 \x01(\tR\tpatientId\x12#\n" +
+	"\rprescriber_id\x18\x02 \x01(\tR\fprescriberId\x12\x12\n" +
+	"\x04drug\x18\x03 \x01(\tR\x04drug\x12\x17\n" +
+	"\adrug_id\x18\x04 \x01(\tR\x06drugId\x12\x12\n" +
+	"\x04dose\x18\x05 \x01(\tR\x04dose\x12#\n" +
+	"\x06dosage\x18\x06 \x01(\v2\v.rx.v1.DoseR\x06dosage\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1c\n" +
+	"\x03sig\x18\b \x01(\v2\n" +
+	".rx.v1.SigR\x03sig\x12\x19\n" +
+	"\bsig_text\x18\t \x01(\tR\asigText\x12\x1a\n" +
+	"\bquantity\x18\n" +
+	" \x01(\x05R\bquantity\x12\x1f\n" +
+	"\vdays_supply\x18\v \x01(\x05R\n" +
+	"daysSupply\"\xae\x03\n" +
+	"\x19UpdatePrescriptionRequest\x12'\n" +
+	"\x0fprescription_id\x18\x01 \x01(\tR\x0eprescriptionId\x12\x17\n" +
+	"\x04drug\x18\x02 \x01(\tH\x00R\x04drug\x88\x01\x01\x12\x1c\n" +
+	"\adrug_id\x18\x03 \x01(\tH\x01R\x06drugId\x88\x01\x01\x12\x17\n" +
+	"\x04dose\x18\x04 \x01(\tH\x02R\x04dose\x88\x01\x01\x12#\n" +
+	"\x06dosage\x18\x05 \x01(\v2\v.rx.v1.DoseR\x06dosage\x12\x1b\n" +
+	"\x06status\x18\x06 \x01(\tH\x03R\x06status\x88\x01\x01\x12\x1c\n" +
+	"\x03sig\x18\a \x01(\v2\n" +
+	".rx.v1.SigR\x03sig\x12\x1e\n" +
+	"\bsig_text\x18\b \x01(\tH\x04R\asigText\x88\x01\x01\x12\x1f\n" +
+	"\bquantity\x18\t \x01(\x05H\x05R\bquantity\x88\x01\x01\x12$\n" +
+	"\vdays_supply\x18\n" +
+	" \x01(\x05H\x06R\n" +
+	"daysSupply\x88\x01\x01B\a\n" +
+	"\x05_drugB\n" +
+	"\n" +
+	"\b_drug_idB\a\n" +
+	"\x05_doseB\t\n" +
+	"\a_statusB\v\n" +
+	"\t_sig_textB\v\n" +
+	"\t_quantityB\x0e\n" +
+	"\f_days_supply\"b\n" +
+	"\x16RouteToPharmacyRequest\x12'\n" +
+	"\x0fprescription_id\x18\x01 \x01(\tR\x0eprescriptionId\x12\x1f\n" +
+	"\vpharmacy_id\x18\x02 \x01(\tR\n" +
+	"pharmacyId\"M\n" +
+	"\x18CheckInteractionsRequest\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x01 \x01(\tR\tpatientId\x12\x12\n" +
+	"\x04drug\x18\x02 \x01(\tR\x04drug\"l\n" +
+	"\x19CheckInteractionsResponse\x12\x18\n" +
+	"\ablocked\x18\x01 \x01(\bR\ablocked\x125\n" +
+	"\bwarnings\x18\x02 \x03(\v2\x19.rx.v1.InteractionWarningR\bwarnings\"W\n" +
+	"\x17SearchPharmaciesRequest\x12\x10\n" +
+	"\x03zip\x18\x01 \x01(\tR\x03zip\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"h\n" +
+	"\x18SearchPharmaciesResponse\x126\n" +
+	"\n" +
+	"pharmacies\x18\x01 \x03(\v2\x16.rx.v1.NetworkPharmacyR\n" +
+	"pharmacies\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total2\xc2\x04\n" +
+	"\x13PrescriptionService\x12V\n" +
+	"\x11ListPrescriptions\x12\x1f.rx.v1.ListPrescriptionsRequest\x1a .rx.v1.ListPrescriptionsResponse\x12E\n" +
+	"\x0fGetPrescription\x12\x1d.rx.v1.GetPrescriptionRequest\x1a\x13.rx.v1.Prescription\x12K\n" +
+	"\x12CreatePrescription\x12 .rx.v1.CreatePrescriptionRequest\x1a\x13.rx.v1.Prescription\x12K\n" +
+	"\x12UpdatePrescription\x12 .rx.v1.UpdatePrescriptionRequest\x1a\x13.rx.v1.Prescription\x12E\n" +
+	"\x0fRouteToPharmacy\x12\x1d.rx.v1.RouteToPharmacyRequest\x1a\x13.rx.v1.Prescription\x12V\n" +
+	"\x11CheckInteractions\x12\x1f.rx.v1.CheckInteractionsRequest\x1a .rx.v1.CheckInteractionsResponse\x12S\n" +
+	"\x10SearchPharmacies\x12\x1e.rx.v1.SearchPharmaciesRequest\x1a\x1f.rx.v1.SearchPharmaciesResponseB>Z<pharmacy-modernization-project-model/internal/grpc/rxpb;rxpbb\x06proto3"
+
+var (
+	file_prescription_proto_rawDescOnce sync.Once
+	file_prescription_proto_rawDescData []byte
+)
+
+func file_prescription_proto_rawDescGZIP() []byte {
+	file_prescription_proto_rawDescOnce.Do(func() {
+		file_prescription_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_prescription_proto_rawDesc), len(file_prescription_proto_rawDesc)))
+	})
+	return file_prescription_proto_rawDescData
+}
+
+var file_prescription_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_prescription_proto_goTypes = []any{
+	(*Prescription)(nil),              // 0: rx.v1.Prescription
+	(*Dose)(nil),                      // 1: rx.v1.Dose
+	(*Sig)(nil),                       // 2: rx.v1.Sig
+	(*InteractionWarning)(nil),        // 3: rx.v1.InteractionWarning
+	(*RoutedPharmacy)(nil),            // 4: rx.v1.RoutedPharmacy
+	(*NetworkPharmacy)(nil),           // 5: rx.v1.NetworkPharmacy
+	(*ListPrescriptionsRequest)(nil),  // 6: rx.v1.ListPrescriptionsRequest
+	(*ListPrescriptionsResponse)(nil), // 7: rx.v1.ListPrescriptionsResponse
+	(*GetPrescriptionRequest)(nil),    // 8: rx.v1.GetPrescriptionRequest
+	(*CreatePrescriptionRequest)(nil), // 9: rx.v1.CreatePrescriptionRequest
+	(*UpdatePrescriptionRequest)(nil), // 10: rx.v1.UpdatePrescriptionRequest
+	(*RouteToPharmacyRequest)(nil),    // 11: rx.v1.RouteToPharmacyRequest
+	(*CheckInteractionsRequest)(nil),  // 12: rx.v1.CheckInteractionsRequest
+	(*CheckInteractionsResponse)(nil), // 13: rx.v1.CheckInteractionsResponse
+	(*SearchPharmaciesRequest)(nil),   // 14: rx.v1.SearchPharmaciesRequest
+	(*SearchPharmaciesResponse)(nil),  // 15: rx.v1.SearchPharmaciesResponse
+	(*timestamppb.Timestamp)(nil),     // 16: google.protobuf.Timestamp
+}
+var file_prescription_proto_depIdxs = []int32{
+	16, // 0: rx.v1.Prescription.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 1: rx.v1.Prescription.dosage:type_name -> rx.v1.Dose
+	2,  // 2: rx.v1.Prescription.sig:type_name -> rx.v1.Sig
+	16, // 3: rx.v1.Prescription.expected_end_date:type_name -> google.protobuf.Timestamp
+	3,  // 4: rx.v1.Prescription.interaction_warnings:type_name -> rx.v1.InteractionWarning
+	16, // 5: rx.v1.Prescription.fulfillment_updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 6: rx.v1.Prescription.pharmacy:type_name -> rx.v1.RoutedPharmacy
+	16, // 7: rx.v1.RoutedPharmacy.routed_at:type_name -> google.protobuf.Timestamp
+	0,  // 8: rx.v1.ListPrescriptionsResponse.prescriptions:type_name -> rx.v1.Prescription
+	1,  // 9: rx.v1.CreatePrescriptionRequest.dosage:type_name -> rx.v1.Dose
+	2,  // 10: rx.v1.CreatePrescriptionRequest.sig:type_name -> rx.v1.Sig
+	1,  // 11: rx.v1.UpdatePrescriptionRequest.dosage:type_name -> rx.v1.Dose
+	2,  // 12: rx.v1.UpdatePrescriptionRequest.sig:type_name -> rx.v1.Sig
+	3,  // 13: rx.v1.CheckInteractionsResponse.warnings:type_name -> rx.v1.InteractionWarning
+	5,  // 14: rx.v1.SearchPharmaciesResponse.pharmacies:type_name -> rx.v1.NetworkPharmacy
+	6,  // 15: rx.v1.PrescriptionService.ListPrescriptions:input_type -> rx.v1.ListPrescriptionsRequest
+	8,  // 16: rx.v1.PrescriptionService.GetPrescription:input_type -> rx.v1.GetPrescriptionRequest
+	9,  // 17: rx.v1.PrescriptionService.CreatePrescription:input_type -> rx.v1.CreatePrescriptionRequest
+	10, // 18: rx.v1.PrescriptionService.UpdatePrescription:input_type -> rx.v1.UpdatePrescriptionRequest
+	11, // 19: rx.v1.PrescriptionService.RouteToPharmacy:input_type -> rx.v1.RouteToPharmacyRequest
+	12, // 20: rx.v1.PrescriptionService.CheckInteractions:input_type -> rx.v1.CheckInteractionsRequest
+	14, // 21: rx.v1.PrescriptionService.SearchPharmacies:input_type -> rx.v1.SearchPharmaciesRequest
+	7,  // 22: rx.v1.PrescriptionService.ListPrescriptions:output_type -> rx.v1.ListPrescriptionsResponse
+	0,  // 23: rx.v1.PrescriptionService.GetPrescription:output_type -> rx.v1.Prescription
+	0,  // 24: rx.v1.PrescriptionService.CreatePrescription:output_type -> rx.v1.Prescription
+	0,  // 25: rx.v1.PrescriptionService.UpdatePrescription:output_type -> rx.v1.Prescription
+	0,  // 26: rx.v1.PrescriptionService.RouteToPharmacy:output_type -> rx.v1.Prescription
+	13, // 27: rx.v1.PrescriptionService.CheckInteractions:output_type -> rx.v1.CheckInteractionsResponse
+	15, // 28: rx.v1.PrescriptionService.SearchPharmacies:output_type -> rx.v1.SearchPharmaciesResponse
+	22, // [22:29] is the sub-list for method output_type
+	15, // [15:22] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_prescription_proto_init() }
+func file_prescription_proto_init() {
+	if File_prescription_proto != nil {
+		return
+	}
+	file_prescription_proto_msgTypes[10].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_prescription_proto_rawDesc), len(file_prescription_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_prescription_proto_goTypes,
+		DependencyIndexes: file_prescription_proto_depIdxs,
+		MessageInfos:      file_prescription_proto_msgTypes,
+	}.Build()
+	File_prescription_proto = out.File
+	file_prescription_proto_goTypes = nil
+	file_prescription_proto_depIdxs = nil
+}
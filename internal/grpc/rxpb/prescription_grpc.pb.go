@@ -0,0 +1,355 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: prescription.proto
+
+package rxpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PrescriptionService_ListPrescriptions_FullMethodName  = "/rx.v1.PrescriptionService/ListPrescriptions"
+	PrescriptionService_GetPrescription_FullMethodName    = "/rx.v1.PrescriptionService/GetPrescription"
+	PrescriptionService_CreatePrescription_FullMethodName = "/rx.v1.PrescriptionService/CreatePrescription"
+	PrescriptionService_UpdatePrescription_FullMethodName = "/rx.v1.PrescriptionService/UpdatePrescription"
+	PrescriptionService_RouteToPharmacy_FullMethodName    = "/rx.v1.PrescriptionService/RouteToPharmacy"
+	PrescriptionService_CheckInteractions_FullMethodName  = "/rx.v1.PrescriptionService/CheckInteractions"
+	PrescriptionService_SearchPharmacies_FullMethodName   = "/rx.v1.PrescriptionService/SearchPharmacies"
+)
+
+// PrescriptionServiceClient is the client API for PrescriptionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PrescriptionService mirrors the prescription REST API under /api/v1/prescriptions.
+// Reads require prescription read access and writes prescription write access.
+type PrescriptionServiceClient interface {
+	ListPrescriptions(ctx context.Context, in *ListPrescriptionsRequest, opts ...grpc.CallOption) (*ListPrescriptionsResponse, error)
+	GetPrescription(ctx context.Context, in *GetPrescriptionRequest, opts ...grpc.CallOption) (*Prescription, error)
+	CreatePrescription(ctx context.Context, in *CreatePrescriptionRequest, opts ...grpc.CallOption) (*Prescription, error)
+	UpdatePrescription(ctx context.Context, in *UpdatePrescriptionRequest, opts ...grpc.CallOption) (*Prescription, error)
+	RouteToPharmacy(ctx context.Context, in *RouteToPharmacyRequest, opts ...grpc.CallOption) (*Prescription, error)
+	CheckInteractions(ctx context.Context, in *CheckInteractionsRequest, opts ...grpc.CallOption) (*CheckInteractionsResponse, error)
+	SearchPharmacies(ctx context.Context, in *SearchPharmaciesRequest, opts ...grpc.CallOption) (*SearchPharmaciesResponse, error)
+}
+
+type prescriptionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPrescriptionServiceClient(cc grpc.ClientConnInterface) PrescriptionServiceClient {
+	return &prescriptionServiceClient{cc}
+}
+
+func (c *prescriptionServiceClient) ListPrescriptions(ctx context.Context, in *ListPrescriptionsRequest, opts ...grpc.CallOption) (*ListPrescriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPrescriptionsResponse)
+	err := c.cc.Invoke(ctx, PrescriptionService_ListPrescriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prescriptionServiceClient) GetPrescription(ctx context.Context, in *GetPrescriptionRequest, opts ...grpc.CallOption) (*Prescription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Prescription)
+	err := c.cc.Invoke(ctx, PrescriptionService_GetPrescription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prescriptionServiceClient) CreatePrescription(ctx context.Context, in *CreatePrescriptionRequest, opts ...grpc.CallOption) (*Prescription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Prescription)
+	err := c.cc.Invoke(ctx, PrescriptionService_CreatePrescription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prescriptionServiceClient) UpdatePrescription(ctx context.Context, in *UpdatePrescriptionRequest, opts ...grpc.CallOption) (*Prescription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Prescription)
+	err := c.cc.Invoke(ctx, PrescriptionService_UpdatePrescription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prescriptionServiceClient) RouteToPharmacy(ctx context.Context, in *RouteToPharmacyRequest, opts ...grpc.CallOption) (*Prescription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Prescription)
+	err := c.cc.Invoke(ctx, PrescriptionService_RouteToPharmacy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prescriptionServiceClient) CheckInteractions(ctx context.Context, in *CheckInteractionsRequest, opts ...grpc.CallOption) (*CheckInteractionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckInteractionsResponse)
+	err := c.cc.Invoke(ctx, PrescriptionService_CheckInteractions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prescriptionServiceClient) SearchPharmacies(ctx context.Context, in *SearchPharmaciesRequest, opts ...grpc.CallOption) (*SearchPharmaciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPharmaciesResponse)
+	err := c.cc.Invoke(ctx, PrescriptionService_SearchPharmacies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PrescriptionServiceServer is the server API for PrescriptionService service.
+// All implementations must embed UnimplementedPrescriptionServiceServer
+// for forward compatibility.
+//
+// PrescriptionService mirrors the prescription REST API under /api/v1/prescriptions.
+// Reads require prescription read access and writes prescription write access.
+type PrescriptionServiceServer interface {
+	ListPrescriptions(context.Context, *ListPrescriptionsRequest) (*ListPrescriptionsResponse, error)
+	GetPrescription(context.Context, *GetPrescriptionRequest) (*Prescription, error)
+	CreatePrescription(context.Context, *CreatePrescriptionRequest) (*Prescription, error)
+	UpdatePrescription(context.Context, *UpdatePrescriptionRequest) (*Prescription, error)
+	RouteToPharmacy(context.Context, *RouteToPharmacyRequest) (*Prescription, error)
+	CheckInteractions(context.Context, *CheckInteractionsRequest) (*CheckInteractionsResponse, error)
+	SearchPharmacies(context.Context, *SearchPharmaciesRequest) (*SearchPharmaciesResponse, error)
+	mustEmbedUnimplementedPrescriptionServiceServer()
+}
+
+// UnimplementedPrescriptionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPrescriptionServiceServer struct{}
+
+func (UnimplementedPrescriptionServiceServer) ListPrescriptions(context.Context, *ListPrescriptionsRequest) (*ListPrescriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPrescriptions not implemented")
+}
+func (UnimplementedPrescriptionServiceServer) GetPrescription(context.Context, *GetPrescriptionRequest) (*Prescription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrescription not implemented")
+}
+func (UnimplementedPrescriptionServiceServer) CreatePrescription(context.Context, *CreatePrescriptionRequest) (*Prescription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePrescription not implemented")
+}
+func (UnimplementedPrescriptionServiceServer) UpdatePrescription(context.Context, *UpdatePrescriptionRequest) (*Prescription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePrescription not implemented")
+}
+func (UnimplementedPrescriptionServiceServer) RouteToPharmacy(context.Context, *RouteToPharmacyRequest) (*Prescription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RouteToPharmacy not implemented")
+}
+func (UnimplementedPrescriptionServiceServer) CheckInteractions(context.Context, *CheckInteractionsRequest) (*CheckInteractionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInteractions not implemented")
+}
+func (UnimplementedPrescriptionServiceServer) SearchPharmacies(context.Context, *SearchPharmaciesRequest) (*SearchPharmaciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPharmacies not implemented")
+}
+func (UnimplementedPrescriptionServiceServer) mustEmbedUnimplementedPrescriptionServiceServer() {}
+func (UnimplementedPrescriptionServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafePrescriptionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PrescriptionServiceServer will
+// result in compilation errors.
+type UnsafePrescriptionServiceServer interface {
+	mustEmbedUnimplementedPrescriptionServiceServer()
+}
+
+func RegisterPrescriptionServiceServer(s grpc.ServiceRegistrar, srv PrescriptionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPrescriptionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PrescriptionService_ServiceDesc, srv)
+}
+
+func _PrescriptionService_ListPrescriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPrescriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrescriptionServiceServer).ListPrescriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrescriptionService_ListPrescriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrescriptionServiceServer).ListPrescriptions(ctx, req.(*ListPrescriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrescriptionService_GetPrescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrescriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrescriptionServiceServer).GetPrescription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrescriptionService_GetPrescription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrescriptionServiceServer).GetPrescription(ctx, req.(*GetPrescriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrescriptionService_CreatePrescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePrescriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrescriptionServiceServer).CreatePrescription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrescriptionService_CreatePrescription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrescriptionServiceServer).CreatePrescription(ctx, req.(*CreatePrescriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrescriptionService_UpdatePrescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePrescriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrescriptionServiceServer).UpdatePrescription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrescriptionService_UpdatePrescription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrescriptionServiceServer).UpdatePrescription(ctx, req.(*UpdatePrescriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrescriptionService_RouteToPharmacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouteToPharmacyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrescriptionServiceServer).RouteToPharmacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrescriptionService_RouteToPharmacy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrescriptionServiceServer).RouteToPharmacy(ctx, req.(*RouteToPharmacyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrescriptionService_CheckInteractions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInteractionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrescriptionServiceServer).CheckInteractions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrescriptionService_CheckInteractions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrescriptionServiceServer).CheckInteractions(ctx, req.(*CheckInteractionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrescriptionService_SearchPharmacies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPharmaciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrescriptionServiceServer).SearchPharmacies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrescriptionService_SearchPharmacies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrescriptionServiceServer).SearchPharmacies(ctx, req.(*SearchPharmaciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PrescriptionService_ServiceDesc is the grpc.ServiceDesc for PrescriptionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PrescriptionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rx.v1.PrescriptionService",
+	HandlerType: (*PrescriptionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPrescriptions",
+			Handler:    _PrescriptionService_ListPrescriptions_Handler,
+		},
+		{
+			MethodName: "GetPrescription",
+			Handler:    _PrescriptionService_GetPrescription_Handler,
+		},
+		{
+			MethodName: "CreatePrescription",
+			Handler:    _PrescriptionService_CreatePrescription_Handler,
+		},
+		{
+			MethodName: "UpdatePrescription",
+			Handler:    _PrescriptionService_UpdatePrescription_Handler,
+		},
+		{
+			MethodName: "RouteToPharmacy",
+			Handler:    _PrescriptionService_RouteToPharmacy_Handler,
+		},
+		{
+			MethodName: "CheckInteractions",
+			Handler:    _PrescriptionService_CheckInteractions_Handler,
+		},
+		{
+			MethodName: "SearchPharmacies",
+			Handler:    _PrescriptionService_SearchPharmacies_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "prescription.proto",
+}
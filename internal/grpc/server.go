@@ -0,0 +1,89 @@
+// Package grpc serves the patient and prescription operations of the REST API over gRPC for
+// internal services, on a port of its own. The services are generated into rxpb from the .proto
+// files kept there (make proto-generate). Calls run on the same services and validation rules as
+// the REST API, authenticate with the same bearer tokens sent as "authorization" metadata, need
+// the same permissions, and have the fields of the caller's redaction profile cleared.
+package grpc
+
+import (
+	"context"
+	"net"
+
+	"go.uber.org/zap"
+	grpcgo "google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	patientservice "pharmacy-modernization-project-model/domain/patient/service"
+	prescriptionservice "pharmacy-modernization-project-model/domain/prescription/service"
+	"pharmacy-modernization-project-model/internal/grpc/rxpb"
+	"pharmacy-modernization-project-model/internal/platform/redaction"
+)
+
+type Dependencies struct {
+	PatientService      patientservice.PatientService
+	PrescriptionService prescriptionservice.PrescriptionService
+	// Redactor clears the fields the caller's redaction profile hides; nil when redaction is disabled
+	Redactor *redaction.Redactor
+	// Reflection lets tools such as grpcurl list the services without the .proto files
+	Reflection bool
+	Logger     *zap.Logger
+}
+
+// Server is the gRPC server of the API
+type Server struct {
+	server *grpcgo.Server
+	log    *zap.Logger
+}
+
+// NewServer registers the services; Serve starts accepting calls
+func NewServer(deps *Dependencies) *Server {
+	interceptors := []grpcgo.UnaryServerInterceptor{
+		contextInterceptor(deps.Logger),
+		loggingInterceptor(),
+		recoveryInterceptor(),
+		authInterceptor(methodPermissions),
+	}
+	if deps.Redactor != nil {
+		interceptors = append(interceptors, redactionInterceptor(deps.Redactor))
+	}
+	server := grpcgo.NewServer(grpcgo.ChainUnaryInterceptor(interceptors...))
+
+	rxpb.RegisterPatientServiceServer(server, &patientServer{patients: deps.PatientService})
+	rxpb.RegisterPrescriptionServiceServer(server, &prescriptionServer{prescriptions: deps.PrescriptionService})
+	if deps.Reflection {
+		reflection.Register(server)
+	}
+
+	return &Server{server: server, log: deps.Logger}
+}
+
+// Serve accepts calls on the address, e.g. ":9090", until Stop is called
+func (s *Server) Serve(address string) error {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+	s.log.Info("gRPC server listening", zap.String("address", listener.Addr().String()))
+	return s.server.Serve(listener)
+}
+
+// Stop stops accepting calls and waits for the ones in flight to finish
+func (s *Server) Stop() {
+	s.server.GracefulStop()
+}
+
+// Shutdown is Stop bounded by ctx: calls still running when ctx is done are cancelled
+func (s *Server) Shutdown(ctx context.Context) error {
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.server.Stop()
+		return ctx.Err()
+	}
+}
@@ -42,6 +42,13 @@ func WriteCreated(w http.ResponseWriter, v any) {
 	_ = json.NewEncoder(w).Encode(v)
 }
 
+// WriteAccepted sends a 202 Accepted response with the provided data
+func WriteAccepted(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
 // WriteNoContent sends a 204 No Content response
 func WriteNoContent(w http.ResponseWriter) {
 	w.WriteHeader(http.StatusNoContent)
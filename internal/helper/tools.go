@@ -4,22 +4,26 @@ import (
 	"context"
 	"fmt"
 	"time"
+
+	"pharmacy-modernization-project-model/internal/platform/dates"
 )
 
-func CalculateAge(dob time.Time) int {
+// CalculateAge returns the age in completed years; for a partial DOB it is the youngest possible age
+func CalculateAge(dob dates.PartialDate) int {
+	age, _ := dob.Age(time.Now())
+	return age
+}
+
+// FormatAge renders the age, or the possible range (e.g. "44-45") when a partial DOB leaves it ambiguous
+func FormatAge(dob dates.PartialDate) string {
 	if dob.IsZero() {
-		return 0
-	}
-	now := time.Now()
-	age := now.Year() - dob.Year()
-	anniversary := time.Date(now.Year(), dob.Month(), dob.Day(), dob.Hour(), dob.Minute(), dob.Second(), dob.Nanosecond(), dob.Location())
-	if now.Before(anniversary) {
-		age--
+		return "-"
 	}
-	if age < 0 {
-		age = 0
+	minAge, maxAge := dob.AgeRange(time.Now())
+	if minAge == maxAge {
+		return fmt.Sprintf("%d", minAge)
 	}
-	return age
+	return fmt.Sprintf("%d-%d", minAge, maxAge)
 }
 
 func FormatShortDate(t time.Time) string {
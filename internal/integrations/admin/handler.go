@@ -0,0 +1,67 @@
+// Package admin serves the runtime status of the external integrations: as JSON for tooling and
+// as a page for admins
+package admin
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/integrations"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/paths"
+	"pharmacy-modernization-project-model/internal/platform/permissions"
+	admincomponents "pharmacy-modernization-project-model/web/components/admin"
+)
+
+// StatusAccess - only admins can see which integrations are mocked and where they send requests
+var StatusAccess = []string{permissions.AdminAll}
+
+// StatusResponse is the body of GET /api/v1/integrations/status
+type StatusResponse struct {
+	Environment  string                           `json:"environment"`
+	Integrations []integrations.IntegrationStatus `json:"integrations"`
+}
+
+// Handler serves the integrations status
+type Handler struct {
+	status *integrations.Status
+	log    *zap.Logger
+}
+
+func NewHandler(status *integrations.Status, log *zap.Logger) *Handler {
+	return &Handler{status: status, log: log}
+}
+
+// RegisterRoutes mounts the status API and the admin page
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.With(
+		auth.RequireAuthFromHeader(),
+		auth.RequirePermissionsMatchAny(StatusAccess),
+	).Get(paths.IntegrationsStatusAPIPath, h.Status)
+
+	r.With(
+		auth.RequireAuthWithDevMode(),
+		auth.RequirePermissionsMatchAny(StatusAccess),
+	).Get(paths.AdminIntegrationsPath, h.Page)
+}
+
+func (h *Handler) Status(w http.ResponseWriter, r *http.Request) {
+	helper.WriteOK(w, StatusResponse{
+		Environment:  h.status.Environment(),
+		Integrations: h.status.Integrations(),
+	})
+}
+
+func (h *Handler) Page(w http.ResponseWriter, r *http.Request) {
+	page := admincomponents.IntegrationsPage(admincomponents.IntegrationsPageParam{
+		Environment:  h.status.Environment(),
+		Integrations: h.status.Integrations(),
+	})
+	if err := page.Render(r.Context(), w); err != nil {
+		h.log.Error("failed to render integrations page", zap.Error(err))
+		helper.WriteUIInternalError(w, "Failed to render integrations page")
+	}
+}
@@ -0,0 +1,8 @@
+package card_ocr
+
+import "context"
+
+// CardOCRClient defines the interface for an OCR provider that reads insurance cards
+type CardOCRClient interface {
+	ExtractInsuranceCard(ctx context.Context, request ExtractCardRequest) (*ExtractCardResponse, error)
+}
@@ -0,0 +1,20 @@
+package card_ocr
+
+// Config holds the configuration for the insurance card OCR provider
+type Config struct {
+	// API Endpoints (full URLs from YAML config)
+	ExtractCardURL string
+}
+
+// EndpointsConfig defines the interface for OCR endpoints configuration
+type EndpointsConfig interface {
+	ExtractCardEndpoint() string
+}
+
+// Verify Config implements EndpointsConfig
+var _ EndpointsConfig = (*Config)(nil)
+
+// ExtractCardEndpoint returns the full URL for reading an insurance card
+func (c *Config) ExtractCardEndpoint() string {
+	return c.ExtractCardURL
+}
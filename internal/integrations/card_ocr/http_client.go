@@ -0,0 +1,59 @@
+package card_ocr
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"pharmacy-modernization-project-model/internal/platform/httpclient"
+
+	"go.uber.org/zap"
+)
+
+// HTTPClient implements CardOCRClient using HTTP requests
+type HTTPClient struct {
+	client    *httpclient.Client
+	endpoints EndpointsConfig
+	logger    *zap.Logger
+	opts      []httpclient.RequestOption // Timeout and slow-request threshold for every call
+}
+
+// NewHTTPClient creates a new HTTP-based OCR client
+func NewHTTPClient(cfg Config, client *httpclient.Client, logger *zap.Logger, opts ...httpclient.RequestOption) *HTTPClient {
+	return &HTTPClient{
+		client:    client,
+		endpoints: &cfg,
+		logger:    logger,
+		opts:      opts,
+	}
+}
+
+// requestOptions returns the OCR call options for the named endpoint
+func (c *HTTPClient) requestOptions(endpoint string) []httpclient.RequestOption {
+	return append(slices.Clone(c.opts), httpclient.WithEndpoint(endpoint))
+}
+
+// ExtractInsuranceCard sends the card images to the OCR provider
+func (c *HTTPClient) ExtractInsuranceCard(ctx context.Context, request ExtractCardRequest) (*ExtractCardResponse, error) {
+	url := c.endpoints.ExtractCardEndpoint()
+
+	c.logger.Debug("extracting insurance card",
+		zap.Int("images", len(request.Images)),
+		zap.String("url", url),
+	)
+
+	var response ExtractCardResponse
+	if err := c.client.PostJSON(ctx, url, request, &response, c.requestOptions("extract_card")...); err != nil {
+		return nil, fmt.Errorf("failed to extract insurance card: %w", err)
+	}
+
+	c.logger.Debug("insurance card extracted",
+		zap.String("provider", response.Provider),
+		zap.Int("fields", len(response.Fields)),
+	)
+
+	return &response, nil
+}
+
+// Verify HTTPClient implements CardOCRClient
+var _ CardOCRClient = (*HTTPClient)(nil)
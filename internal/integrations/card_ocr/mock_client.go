@@ -0,0 +1,52 @@
+package card_ocr
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// MockClient implements CardOCRClient with a fixed sample card
+type MockClient struct {
+	logger *zap.Logger
+}
+
+// NewMockClient creates a new mock OCR client
+func NewMockClient(logger *zap.Logger) *MockClient {
+	return &MockClient{logger: logger}
+}
+
+// ExtractInsuranceCard returns sample card fields. The pharmacy benefit fields are only
+// returned when the back of the card was sent, and some fields come back with low confidence
+// so the review flow can be exercised.
+func (c *MockClient) ExtractInsuranceCard(ctx context.Context, request ExtractCardRequest) (*ExtractCardResponse, error) {
+	response := &ExtractCardResponse{
+		Provider: "mock",
+		Fields: []ExtractedField{
+			{Name: FieldPayerName, Value: "Blue Cross Blue Shield", Confidence: 0.97},
+			{Name: FieldMemberID, Value: "XZB123456789", Confidence: 0.93},
+			{Name: FieldGroupNumber, Value: "GRP-0042", Confidence: 0.71},
+			{Name: FieldMemberName, Value: "JOHN A DOE", Confidence: 0.88},
+		},
+	}
+
+	for _, image := range request.Images {
+		if image.Side == SideBack {
+			response.Fields = append(response.Fields,
+				ExtractedField{Name: FieldRxBIN, Value: "610014", Confidence: 0.95},
+				ExtractedField{Name: FieldRxPCN, Value: "MEDDPRIME", Confidence: 0.62},
+			)
+			break
+		}
+	}
+
+	c.logger.Debug("mock insurance card extracted",
+		zap.Int("images", len(request.Images)),
+		zap.Int("fields", len(response.Fields)),
+	)
+
+	return response, nil
+}
+
+// Verify MockClient implements CardOCRClient
+var _ CardOCRClient = (*MockClient)(nil)
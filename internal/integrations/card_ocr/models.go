@@ -0,0 +1,42 @@
+package card_ocr
+
+// Card sides accepted by the OCR provider
+const (
+	SideFront = "front"
+	SideBack  = "back"
+)
+
+// Field names returned by the OCR provider
+const (
+	FieldPayerName   = "payer_name"
+	FieldMemberID    = "member_id"
+	FieldGroupNumber = "group_number"
+	FieldMemberName  = "member_name"
+	FieldRxBIN       = "rx_bin"
+	FieldRxPCN       = "rx_pcn"
+)
+
+// CardImage is one photographed side of an insurance card; Data is sent base64 encoded
+type CardImage struct {
+	Side        string `json:"side"`
+	ContentType string `json:"content_type"`
+	Data        []byte `json:"data"`
+}
+
+// ExtractCardRequest asks the provider to read the fields printed on a card
+type ExtractCardRequest struct {
+	Images []CardImage `json:"images"`
+}
+
+// ExtractedField is one value read from the card with the provider's confidence (0 to 1)
+type ExtractedField struct {
+	Name       string  `json:"name"`
+	Value      string  `json:"value"`
+	Confidence float64 `json:"confidence"`
+}
+
+// ExtractCardResponse holds the fields the provider could read; unreadable fields are omitted
+type ExtractCardResponse struct {
+	Provider string           `json:"provider"`
+	Fields   []ExtractedField `json:"fields"`
+}
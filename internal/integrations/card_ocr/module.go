@@ -0,0 +1,67 @@
+package card_ocr
+
+import (
+	"time"
+
+	"pharmacy-modernization-project-model/internal/platform/httpclient"
+
+	"go.uber.org/zap"
+)
+
+// ModuleDependencies holds all dependencies required to initialize the card OCR module
+type ModuleDependencies struct {
+	Config     Config
+	Logger     *zap.Logger
+	HTTPClient *httpclient.Client
+	UseMock    bool
+	Timeout    time.Duration // Per-request timeout for this service, capped by the caller's deadline
+	// SlowRequestThreshold logs calls to this service taking at least this long (0 uses the client default)
+	SlowRequestThreshold time.Duration
+}
+
+// ModuleExport contains the exported services from the card OCR module
+type ModuleExport struct {
+	CardOCRClient CardOCRClient
+}
+
+// Module initializes and returns the card OCR module with its dependencies
+func Module(deps ModuleDependencies) ModuleExport {
+	// Use mock client if configured
+	if deps.UseMock {
+		deps.Logger.Info("initializing mock card OCR client")
+		return ModuleExport{
+			CardOCRClient: NewMockClient(deps.Logger),
+		}
+	}
+
+	// Create HTTP client if not provided (fallback for tests/edge cases)
+	if deps.HTTPClient == nil {
+		timeout := deps.Timeout
+		if timeout <= 0 {
+			timeout = 30 * time.Second
+		}
+
+		deps.Logger.Warn("no shared http client provided, creating dedicated client for card OCR service",
+			zap.Duration("timeout", timeout),
+		)
+
+		deps.HTTPClient = httpclient.NewClient(
+			httpclient.Config{
+				Timeout:     timeout,
+				ServiceName: "card_ocr",
+			},
+			deps.Logger,
+		)
+	}
+
+	deps.Logger.Info("initializing HTTP card OCR client",
+		zap.String("extract_card_url", deps.Config.ExtractCardURL),
+	)
+
+	client := NewHTTPClient(deps.Config, deps.HTTPClient, deps.Logger,
+		httpclient.WithService("card_ocr"),
+		httpclient.WithTimeout(deps.Timeout),
+		httpclient.WithSlowThreshold(deps.SlowRequestThreshold),
+	)
+	return ModuleExport{CardOCRClient: client}
+}
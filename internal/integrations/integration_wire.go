@@ -5,23 +5,29 @@ import (
 
 	"go.uber.org/zap"
 
+	cardocr "pharmacy-modernization-project-model/internal/integrations/card_ocr"
 	irisbilling "pharmacy-modernization-project-model/internal/integrations/iris_billing"
 	irispharmacy "pharmacy-modernization-project-model/internal/integrations/iris_pharmacy"
+	"pharmacy-modernization-project-model/internal/integrations/notifications"
+	"pharmacy-modernization-project-model/internal/integrations/stargate"
 	"pharmacy-modernization-project-model/internal/platform/config"
 	"pharmacy-modernization-project-model/internal/platform/httpclient"
-	"pharmacy-modernization-project-model/internal/platform/httpclient/interceptors"
 )
 
 // Dependencies holds all required dependencies for the integrations layer
 type Dependencies struct {
-	Config *config.Config
-	Logger *zap.Logger
+	Config  *config.Config
+	Logger  *zap.Logger
+	Capture *httpclient.BodyCapture // Records sampled IRIS bodies; optional
 }
 
 // Export contains all integration services exported by this package
 type Export struct {
-	PharmacyClient irispharmacy.PharmacyClient
-	BillingClient  irisbilling.BillingClient
+	PharmacyClient     irispharmacy.PharmacyClient
+	BillingClient      irisbilling.BillingClient
+	CardOCRClient      cardocr.CardOCRClient
+	NotificationSender notifications.NotificationSender
+	Status             *Status // Which clients are mocked and how the real ones are doing
 }
 
 // New initializes all integration services with their dependencies
@@ -32,9 +38,7 @@ func New(deps Dependencies) Export {
 	}
 
 	logger := deps.Logger.With(zap.String("layer", "integrations"))
-
-	// Create metrics interceptor to track API call timing
-	metricsInterceptor := interceptors.NewMetricsInterceptor(logger)
+	status := &Status{env: deps.Config.App.Env}
 
 	// Create global header provider for all API requests
 	// These headers will be added to ALL requests across all integrations
@@ -45,18 +49,31 @@ func New(deps Dependencies) Export {
 		// "X-Request-Source": "rxintake-app",
 	})
 
+	// Add a Stargate access token to IRIS calls when enabled
+	var headerProvider httpclient.HeaderProvider = globalHeaderProvider
+	if tokens := stargateTokenProvider(deps.Config, globalHeaderProvider, status, logger); tokens != nil {
+		headerProvider = httpclient.NewMultiHeaderProvider(globalHeaderProvider, tokens)
+	}
+
 	// Create shared HTTP client for all external API integrations
+	httpCfg := deps.Config.External.HTTP
 	// This client is reused across all integration services for efficient connection pooling
+	var interceptors []httpclient.Interceptor
+	if deps.Capture != nil {
+		interceptors = append(interceptors, deps.Capture)
+	}
 	sharedHTTPClient := httpclient.NewClient(
 		httpclient.Config{
-			Timeout:        30 * time.Second,     // Default timeout for all external APIs
-			MaxIdleConns:   100,                  // Connection pool size
-			ServiceName:    "external_apis",      // For observability/logging
-			HeaderProvider: globalHeaderProvider, // ✅ Global headers for ALL requests
-			// For auth tokens, see integration_wire_with_auth_example.go (Stargate example)
+			Timeout:              parseDuration(httpCfg.Timeout, 30*time.Second), // Default timeout; services override it below
+			DeadlineMargin:       parseDuration(httpCfg.DeadlineMargin, 250*time.Millisecond),
+			SlowRequestThreshold: parseDuration(httpCfg.SlowRequestThreshold, 0),
+			MaxIdleConns:         100,             // Connection pool size
+			ServiceName:          "external_apis", // For observability/logging
+			HeaderProvider:       headerProvider,  // ✅ Global headers (and the Stargate token) for ALL requests
+			CircuitBreaker:       circuitBreaker(httpCfg),
 		},
-		logger,
-		metricsInterceptor, // ✅ Track timing for all API calls
+		logger, // Call latency is exported per service and endpoint by the metrics package
+		interceptors...,
 	)
 
 	logger.Info("shared http client created with global headers",
@@ -66,13 +83,26 @@ func New(deps Dependencies) Export {
 	// Initialize pharmacy client
 	pharmacy := irispharmacy.Module(irispharmacy.ModuleDependencies{
 		Config: irispharmacy.Config{
-			GetPrescriptionURL: deps.Config.External.Pharmacy.Endpoints.GetPrescription,
+			GetPrescriptionURL:   deps.Config.External.Pharmacy.Endpoints.GetPrescription,
+			SearchPharmaciesURL:  deps.Config.External.Pharmacy.Endpoints.SearchPharmacies,
+			GetPharmacyURL:       deps.Config.External.Pharmacy.Endpoints.GetPharmacy,
+			RoutePrescriptionURL: deps.Config.External.Pharmacy.Endpoints.RoutePrescription,
+			SendScriptURL:        deps.Config.External.Pharmacy.Endpoints.SendScript,
+			MessageStatusURL:     deps.Config.External.Pharmacy.Endpoints.MessageStatus,
 		},
-		Logger:     logger.With(zap.String("service", "pharmacy")),
-		HTTPClient: sharedHTTPClient, // Use the shared client
-		UseMock:    deps.Config.External.Pharmacy.UseMock,
-		Timeout:    parseDuration(deps.Config.External.Pharmacy.Timeout, 30*time.Second),
+		Logger:               logger.With(zap.String("service", "pharmacy")),
+		HTTPClient:           sharedHTTPClient, // Use the shared client
+		UseMock:              deps.Config.External.Pharmacy.UseMock,
+		Timeout:              parseDuration(deps.Config.External.Pharmacy.Timeout, 30*time.Second),
+		SlowRequestThreshold: parseDuration(deps.Config.External.Pharmacy.SlowRequestThreshold, 0),
 	}).PharmacyClient
+	status.add(integration{
+		name:      "pharmacy",
+		service:   "iris_pharmacy",
+		mocked:    deps.Config.External.Pharmacy.UseMock,
+		endpoints: endpointsOf(deps.Config.External.Pharmacy.Endpoints),
+		client:    sharedHTTPClient,
+	})
 
 	// Initialize billing client
 	billing := irisbilling.Module(irisbilling.ModuleDependencies{
@@ -81,19 +111,141 @@ func New(deps Dependencies) Export {
 			GetInvoicesByPatientURL: deps.Config.External.Billing.Endpoints.GetInvoicesByPatient,
 			CreateInvoiceURL:        deps.Config.External.Billing.Endpoints.CreateInvoice,
 			AcknowledgeInvoiceURL:   deps.Config.External.Billing.Endpoints.AcknowledgeInvoice,
+			VoidInvoiceURL:          deps.Config.External.Billing.Endpoints.VoidInvoice,
+			AdjustInvoiceURL:        deps.Config.External.Billing.Endpoints.AdjustInvoice,
 			GetInvoicePaymentURL:    deps.Config.External.Billing.Endpoints.GetInvoicePayment,
+			EstimatePriceURL:        deps.Config.External.Billing.Endpoints.EstimatePrice,
 		},
-		Logger:     logger.With(zap.String("service", "billing")),
-		HTTPClient: sharedHTTPClient, // Use the shared client
-		UseMock:    deps.Config.External.Billing.UseMock,
-		Timeout:    parseDuration(deps.Config.External.Billing.Timeout, 30*time.Second),
+		Logger:               logger.With(zap.String("service", "billing")),
+		HTTPClient:           sharedHTTPClient, // Use the shared client
+		UseMock:              deps.Config.External.Billing.UseMock,
+		Timeout:              parseDuration(deps.Config.External.Billing.Timeout, 30*time.Second),
+		SlowRequestThreshold: parseDuration(deps.Config.External.Billing.SlowRequestThreshold, 0),
 	}).BillingClient
+	status.add(integration{
+		name:      "billing",
+		service:   "iris_billing",
+		mocked:    deps.Config.External.Billing.UseMock,
+		endpoints: endpointsOf(deps.Config.External.Billing.Endpoints),
+		client:    sharedHTTPClient,
+	})
+
+	// Initialize insurance card OCR client
+	cardOCR := cardocr.Module(cardocr.ModuleDependencies{
+		Config: cardocr.Config{
+			ExtractCardURL: deps.Config.External.CardOCR.Endpoints.ExtractCard,
+		},
+		Logger:               logger.With(zap.String("service", "card_ocr")),
+		HTTPClient:           sharedHTTPClient, // Use the shared client
+		UseMock:              deps.Config.External.CardOCR.UseMock,
+		Timeout:              parseDuration(deps.Config.External.CardOCR.Timeout, 30*time.Second),
+		SlowRequestThreshold: parseDuration(deps.Config.External.CardOCR.SlowRequestThreshold, 0),
+	}).CardOCRClient
+	status.add(integration{
+		name:      "card_ocr",
+		service:   "card_ocr",
+		mocked:    deps.Config.External.CardOCR.UseMock,
+		endpoints: endpointsOf(deps.Config.External.CardOCR.Endpoints),
+		client:    sharedHTTPClient,
+	})
+
+	// Initialize patient notification senders (SMS provider and mail server)
+	notificationsCfg := deps.Config.External.Notifications
+	notificationSender := notifications.Module(notifications.ModuleDependencies{
+		Config: notifications.Config{
+			SendSMSURL: notificationsCfg.Endpoints.SendSMS,
+			SMSFrom:    notificationsCfg.SMSFrom,
+			SMTP: notifications.SMTPConfig{
+				Host:     notificationsCfg.SMTP.Host,
+				Port:     notificationsCfg.SMTP.Port,
+				Username: notificationsCfg.SMTP.Username,
+				Password: notificationsCfg.SMTP.Password,
+				From:     notificationsCfg.SMTP.From,
+			},
+		},
+		Logger:               logger.With(zap.String("service", "notifications")),
+		HTTPClient:           sharedHTTPClient, // Use the shared client
+		UseMock:              notificationsCfg.UseMock,
+		Timeout:              parseDuration(notificationsCfg.Timeout, 30*time.Second),
+		SlowRequestThreshold: parseDuration(notificationsCfg.SlowRequestThreshold, 0),
+	}).NotificationSender
+	status.add(integration{
+		name:      "notifications",
+		service:   "notifications",
+		mocked:    notificationsCfg.UseMock,
+		endpoints: endpointsOf(notificationsCfg.Endpoints),
+		client:    sharedHTTPClient,
+	})
 
 	logger.Info("integrations layer initialized successfully")
 
 	return Export{
-		PharmacyClient: pharmacy,
-		BillingClient:  billing,
+		PharmacyClient:     pharmacy,
+		BillingClient:      billing,
+		CardOCRClient:      cardOCR,
+		NotificationSender: notificationSender,
+		Status:             status,
+	}
+}
+
+// stargateTokenProvider returns the header provider for Stargate access tokens, or nil when disabled.
+// Token requests use their own client: going through the shared one would ask for a token to get a token.
+func stargateTokenProvider(cfg *config.Config, globalHeaders httpclient.HeaderProvider, status *Status, logger *zap.Logger) *httpclient.TokenHeaderProvider {
+	stargateCfg := cfg.External.Stargate
+	stargateStatus := integration{
+		name:      "stargate",
+		service:   "stargate_auth",
+		mocked:    stargateCfg.UseMock,
+		disabled:  !stargateCfg.Enabled,
+		endpoints: endpointsOf(stargateCfg.Endpoints),
+	}
+	if !stargateCfg.Enabled {
+		status.add(stargateStatus)
+		logger.Info("stargate disabled, IRIS calls are sent without an access token")
+		return nil
+	}
+
+	stargateLogger := logger.With(zap.String("service", "stargate"))
+	timeout := parseDuration(stargateCfg.Timeout, 10*time.Second)
+	stargateStatus.client = httpclient.NewClient(
+		httpclient.Config{
+			Timeout:              timeout,
+			DeadlineMargin:       parseDuration(cfg.External.HTTP.DeadlineMargin, 250*time.Millisecond),
+			SlowRequestThreshold: parseDuration(cfg.External.HTTP.SlowRequestThreshold, 0),
+			MaxIdleConns:         10,
+			ServiceName:          stargateStatus.service,
+			HeaderProvider:       globalHeaders,
+			CircuitBreaker:       circuitBreaker(cfg.External.HTTP),
+		},
+		stargateLogger,
+	)
+	tokenClient := stargate.Module(stargate.ModuleDependencies{
+		Config: stargate.Config{
+			TokenURL:        stargateCfg.Endpoints.Token,
+			RefreshTokenURL: stargateCfg.Endpoints.RefreshToken,
+			ClientID:        stargateCfg.ClientID,
+			ClientSecret:    stargateCfg.ClientSecret,
+			Scope:           stargateCfg.Scope,
+		},
+		Logger:     stargateLogger,
+		HTTPClient: stargateStatus.client,
+		UseMock:    stargateCfg.UseMock,
+		Timeout:    timeout,
+	}).TokenClient
+	status.add(stargateStatus)
+
+	return httpclient.NewTokenHeaderProvider(
+		stargate.NewTokenProviderAdapter(tokenClient, stargateLogger),
+		parseDuration(stargateCfg.RefreshBefore, 5*time.Minute),
+		stargateLogger,
+	)
+}
+
+// circuitBreaker reads the circuit breaker shared by the clients of every service
+func circuitBreaker(cfg config.ExternalHTTPConfig) httpclient.CircuitBreakerConfig {
+	return httpclient.CircuitBreakerConfig{
+		FailureThreshold: cfg.CircuitBreaker.FailureThreshold,
+		OpenTimeout:      parseDuration(cfg.CircuitBreaker.OpenTimeout, 30*time.Second),
 	}
 }
 
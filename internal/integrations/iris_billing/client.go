@@ -9,7 +9,12 @@ type BillingClient interface {
 	GetInvoicesByPatientID(ctx context.Context, patientID string) (*InvoiceListResponse, error)
 	CreateInvoice(ctx context.Context, req CreateInvoiceRequest) (*CreateInvoiceResponse, error)
 	AcknowledgeInvoice(ctx context.Context, invoiceID string, req AcknowledgeInvoiceRequest) (*AcknowledgeInvoiceResponse, error)
+	VoidInvoice(ctx context.Context, invoiceID string, req VoidInvoiceRequest) (*VoidInvoiceResponse, error)
+	AdjustInvoice(ctx context.Context, invoiceID string, req AdjustInvoiceRequest) (*AdjustInvoiceResponse, error)
 
 	// Payment operations
 	GetInvoicePayment(ctx context.Context, invoiceID string) (*InvoicePaymentResponse, error)
+
+	// Pricing operations
+	EstimatePrice(ctx context.Context, req PriceEstimateRequest) (*PriceEstimateResponse, error)
 }
@@ -7,7 +7,10 @@ type Config struct {
 	GetInvoicesByPatientURL string
 	CreateInvoiceURL        string
 	AcknowledgeInvoiceURL   string
+	VoidInvoiceURL          string
+	AdjustInvoiceURL        string
 	GetInvoicePaymentURL    string
+	EstimatePriceURL        string
 }
 
 // EndpointsConfig defines the interface for billing endpoints configuration
@@ -16,7 +19,10 @@ type EndpointsConfig interface {
 	GetInvoicesByPatientEndpoint() string
 	CreateInvoiceEndpoint() string
 	AcknowledgeInvoiceEndpoint() string
+	VoidInvoiceEndpoint() string
+	AdjustInvoiceEndpoint() string
 	GetInvoicePaymentEndpoint() string
+	EstimatePriceEndpoint() string
 }
 
 // Verify Config implements EndpointsConfig
@@ -42,7 +48,22 @@ func (c *Config) AcknowledgeInvoiceEndpoint() string {
 	return c.AcknowledgeInvoiceURL
 }
 
+// VoidInvoiceEndpoint returns the full URL for voiding an invoice
+func (c *Config) VoidInvoiceEndpoint() string {
+	return c.VoidInvoiceURL
+}
+
+// AdjustInvoiceEndpoint returns the full URL for adjusting an invoice
+func (c *Config) AdjustInvoiceEndpoint() string {
+	return c.AdjustInvoiceURL
+}
+
 // GetInvoicePaymentEndpoint returns the full URL for getting invoice payment
 func (c *Config) GetInvoicePaymentEndpoint() string {
 	return c.GetInvoicePaymentURL
 }
+
+// EstimatePriceEndpoint returns the full URL for estimating a prescription's price
+func (c *Config) EstimatePriceEndpoint() string {
+	return c.EstimatePriceURL
+}
@@ -6,6 +6,7 @@ import (
 	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	"pharmacy-modernization-project-model/internal/platform/httpclient"
 
@@ -17,21 +18,33 @@ type HTTPClient struct {
 	client    *httpclient.Client
 	endpoints EndpointsConfig
 	logger    *zap.Logger
+	opts      []httpclient.RequestOption // Timeout and slow-request threshold for every call
 }
 
 // NewHTTPClient creates a new HTTP-based billing client
-func NewHTTPClient(cfg Config, client *httpclient.Client, logger *zap.Logger) *HTTPClient {
+func NewHTTPClient(cfg Config, client *httpclient.Client, logger *zap.Logger, opts ...httpclient.RequestOption) *HTTPClient {
 	return &HTTPClient{
 		client:    client,
 		endpoints: &cfg,
 		logger:    logger,
+		opts:      opts,
 	}
 }
 
+// requestOptions returns the billing call options for the named endpoint
+func (c *HTTPClient) requestOptions(endpoint string) []httpclient.RequestOption {
+	return append(slices.Clone(c.opts), httpclient.WithEndpoint(endpoint))
+}
+
 // generateIdempotencyKey creates a deterministic idempotency key based on prescription ID
-// This ensures the same invoice creation request always generates the same key
-func generateIdempotencyKey(prescriptionID string) string {
-	hash := sha256.Sum256([]byte("create-invoice-" + prescriptionID))
+// This ensures the same invoice creation request always generates the same key. A re-bill after
+// a void also hashes the replaced invoice, so it is not mistaken for a retry of the first invoice.
+func generateIdempotencyKey(prescriptionID, replacesInvoiceID string) string {
+	key := "create-invoice-" + prescriptionID
+	if replacesInvoiceID != "" {
+		key += "-replaces-" + replacesInvoiceID
+	}
+	hash := sha256.Sum256([]byte(key))
 	return fmt.Sprintf("%x", hash[:16]) // Use first 16 bytes of hash
 }
 
@@ -51,7 +64,7 @@ func (c *HTTPClient) GetInvoice(ctx context.Context, prescriptionID string) (*In
 		"Content-Type":    "application/json",
 		"Accept":          "application/json",
 		"X-IRIS-Env-Name": "IRIS_stage", // ✅ Only for GetInvoice endpoint
-	})
+	}, c.requestOptions("get_invoice")...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get invoice: %w", err)
 	}
@@ -90,7 +103,7 @@ func (c *HTTPClient) GetInvoicesByPatientID(ctx context.Context, patientID strin
 	resp, err := c.client.Get(ctx, url, map[string]string{
 		"Content-Type": "application/json",
 		"Accept":       "application/json",
-	})
+	}, c.requestOptions("get_invoices_by_patient")...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get invoices by patient ID: %w", err)
 	}
@@ -121,7 +134,7 @@ func (c *HTTPClient) CreateInvoice(ctx context.Context, req CreateInvoiceRequest
 	url := c.endpoints.CreateInvoiceEndpoint()
 
 	// Generate idempotency key to prevent duplicate invoice creation
-	idempotencyKey := generateIdempotencyKey(req.PrescriptionID)
+	idempotencyKey := generateIdempotencyKey(req.PrescriptionID, req.ReplacesInvoiceID)
 
 	c.logger.Debug("creating invoice",
 		zap.Float64("amount", req.Amount),
@@ -140,7 +153,7 @@ func (c *HTTPClient) CreateInvoice(ctx context.Context, req CreateInvoiceRequest
 		"Content-Type":      "application/json",
 		"Accept":            "application/json",
 		"X-Idempotency-Key": idempotencyKey, // ✅ Prevents duplicate invoices
-	})
+	}, c.requestOptions("create_invoice")...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create invoice: %w", err)
 	}
@@ -180,7 +193,7 @@ func (c *HTTPClient) AcknowledgeInvoice(ctx context.Context, invoiceID string, r
 	)
 
 	var response AcknowledgeInvoiceResponse
-	err = c.client.PostJSON(ctx, url, req, &response)
+	err = c.client.PostJSON(ctx, url, req, &response, c.requestOptions("acknowledge_invoice")...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to acknowledge invoice: %w", err)
 	}
@@ -192,6 +205,63 @@ func (c *HTTPClient) AcknowledgeInvoice(ctx context.Context, invoiceID string, r
 	return &response, nil
 }
 
+// VoidInvoice voids an invoice that has not been paid
+func (c *HTTPClient) VoidInvoice(ctx context.Context, invoiceID string, req VoidInvoiceRequest) (*VoidInvoiceResponse, error) {
+	url, err := httpclient.ReplacePathParams(c.endpoints.VoidInvoiceEndpoint(), map[string]string{
+		"invoiceID": invoiceID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build URL: %w", err)
+	}
+
+	c.logger.Debug("voiding invoice",
+		zap.String("invoice_id", invoiceID),
+		zap.String("url", url),
+	)
+
+	var response VoidInvoiceResponse
+	err = c.client.PostJSON(ctx, url, req, &response, c.requestOptions("void_invoice")...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to void invoice: %w", err)
+	}
+
+	c.logger.Info("invoice voided successfully",
+		zap.String("invoice_id", invoiceID),
+		zap.String("reason_code", req.ReasonCode),
+	)
+
+	return &response, nil
+}
+
+// AdjustInvoice records an adjustment against a paid invoice
+func (c *HTTPClient) AdjustInvoice(ctx context.Context, invoiceID string, req AdjustInvoiceRequest) (*AdjustInvoiceResponse, error) {
+	url, err := httpclient.ReplacePathParams(c.endpoints.AdjustInvoiceEndpoint(), map[string]string{
+		"invoiceID": invoiceID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build URL: %w", err)
+	}
+
+	c.logger.Debug("adjusting invoice",
+		zap.String("invoice_id", invoiceID),
+		zap.Float64("amount", req.Amount),
+		zap.String("url", url),
+	)
+
+	var response AdjustInvoiceResponse
+	err = c.client.PostJSON(ctx, url, req, &response, c.requestOptions("adjust_invoice")...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to adjust invoice: %w", err)
+	}
+
+	c.logger.Info("invoice adjusted successfully",
+		zap.String("invoice_id", invoiceID),
+		zap.String("adjustment_id", response.AdjustmentID),
+	)
+
+	return &response, nil
+}
+
 // GetInvoicePayment retrieves payment details for an invoice
 func (c *HTTPClient) GetInvoicePayment(ctx context.Context, invoiceID string) (*InvoicePaymentResponse, error) {
 	url, err := httpclient.ReplacePathParams(c.endpoints.GetInvoicePaymentEndpoint(), map[string]string{
@@ -207,7 +277,7 @@ func (c *HTTPClient) GetInvoicePayment(ctx context.Context, invoiceID string) (*
 	)
 
 	var response InvoicePaymentResponse
-	err = c.client.GetJSON(ctx, url, &response)
+	err = c.client.GetJSON(ctx, url, &response, c.requestOptions("get_invoice_payment")...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get invoice payment: %w", err)
 	}
@@ -221,5 +291,29 @@ func (c *HTTPClient) GetInvoicePayment(ctx context.Context, invoiceID string) (*
 	return &response, nil
 }
 
+// EstimatePrice asks IRIS billing what a prescription would cost
+func (c *HTTPClient) EstimatePrice(ctx context.Context, req PriceEstimateRequest) (*PriceEstimateResponse, error) {
+	url := c.endpoints.EstimatePriceEndpoint()
+
+	c.logger.Debug("estimating prescription price",
+		zap.String("prescription_id", req.PrescriptionID),
+		zap.Int("quantity", req.Quantity),
+		zap.String("url", url),
+	)
+
+	var response PriceEstimateResponse
+	err := c.client.PostJSON(ctx, url, req, &response, c.requestOptions("estimate_price")...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate price: %w", err)
+	}
+
+	c.logger.Debug("price estimated successfully",
+		zap.String("prescription_id", req.PrescriptionID),
+		zap.Float64("total", response.Total),
+	)
+
+	return &response, nil
+}
+
 // Verify HTTPClient implements BillingClient
 var _ BillingClient = (*HTTPClient)(nil)
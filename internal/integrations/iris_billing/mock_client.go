@@ -3,10 +3,25 @@ package iris_billing
 import (
 	"context"
 	"fmt"
+	"math"
+	"strings"
 
 	"go.uber.org/zap"
 )
 
+// mockUnitPrices are the unit prices the mock quotes by lower-case drug name; other drugs cost mockDefaultUnitPrice
+var mockUnitPrices = map[string]float64{
+	"amoxicillin":  0.35,
+	"atorvastatin": 0.20,
+	"lisinopril":   0.12,
+	"metformin":    0.10,
+}
+
+const (
+	mockDefaultUnitPrice = 0.50
+	mockDispensingFee    = 12.50
+)
+
 // MockClient implements BillingClient with in-memory mock data
 type MockClient struct {
 	invoices          map[string]InvoiceResponse
@@ -82,14 +97,21 @@ func (c *MockClient) GetInvoicesByPatientID(ctx context.Context, patientID strin
 
 // CreateInvoice creates a mock invoice
 func (c *MockClient) CreateInvoice(ctx context.Context, req CreateInvoiceRequest) (*CreateInvoiceResponse, error) {
+	id := fmt.Sprintf("mock-invoice-%s", req.PrescriptionID)
+	if req.ReplacesInvoiceID != "" {
+		id = fmt.Sprintf("%s-r%d", id, len(c.invoicesByPatient[req.PatientID]))
+	}
 	invoice := InvoiceResponse{
-		ID:             fmt.Sprintf("mock-invoice-%s", req.PrescriptionID),
+		ID:             id,
 		PrescriptionID: req.PrescriptionID,
 		Amount:         req.Amount,
 		Status:         "pending",
 	}
 
 	c.invoices[req.PrescriptionID] = invoice
+	if req.PatientID != "" {
+		c.invoicesByPatient[req.PatientID] = append(c.invoicesByPatient[req.PatientID], invoice)
+	}
 
 	c.logger.Debug("mock invoice created",
 		zap.String("invoice_id", invoice.ID),
@@ -105,27 +127,71 @@ func (c *MockClient) CreateInvoice(ctx context.Context, req CreateInvoiceRequest
 
 // AcknowledgeInvoice acknowledges a mock invoice
 func (c *MockClient) AcknowledgeInvoice(ctx context.Context, invoiceID string, req AcknowledgeInvoiceRequest) (*AcknowledgeInvoiceResponse, error) {
-	// Find invoice by ID
-	for prescID, invoice := range c.invoices {
-		if invoice.ID == invoiceID {
-			invoice.Status = "acknowledged"
-			c.invoices[prescID] = invoice
+	invoice, ok := c.setStatus(invoiceID, "acknowledged")
+	if !ok {
+		c.logger.Warn("mock invoice not found for acknowledgement")
+		return nil, fmt.Errorf("invoice not found")
+	}
 
-			c.logger.Debug("mock invoice acknowledged",
-				zap.String("invoice_id", invoiceID),
-			)
+	c.logger.Debug("mock invoice acknowledged",
+		zap.String("invoice_id", invoiceID),
+	)
 
-			response := &AcknowledgeInvoiceResponse{
-				InvoiceResponse: invoice,
-			}
+	return &AcknowledgeInvoiceResponse{InvoiceResponse: invoice}, nil
+}
 
-			return response, nil
-		}
+// VoidInvoice voids a mock invoice
+func (c *MockClient) VoidInvoice(ctx context.Context, invoiceID string, req VoidInvoiceRequest) (*VoidInvoiceResponse, error) {
+	invoice, ok := c.setStatus(invoiceID, "voided")
+	if !ok {
+		c.logger.Warn("mock invoice not found for void")
+		return nil, fmt.Errorf("invoice not found")
+	}
+
+	c.logger.Debug("mock invoice voided",
+		zap.String("invoice_id", invoiceID),
+		zap.String("reason_code", req.ReasonCode),
+	)
+
+	return &VoidInvoiceResponse{InvoiceResponse: invoice}, nil
+}
+
+// AdjustInvoice credits a mock invoice; the mock only supports full credits
+func (c *MockClient) AdjustInvoice(ctx context.Context, invoiceID string, req AdjustInvoiceRequest) (*AdjustInvoiceResponse, error) {
+	invoice, ok := c.setStatus(invoiceID, "credited")
+	if !ok {
+		c.logger.Warn("mock invoice not found for adjustment")
+		return nil, fmt.Errorf("invoice not found")
 	}
 
-	c.logger.Warn("mock invoice not found for acknowledgement")
+	c.logger.Debug("mock invoice adjusted",
+		zap.String("invoice_id", invoiceID),
+		zap.Float64("amount", req.Amount),
+	)
+
+	return &AdjustInvoiceResponse{
+		InvoiceResponse: invoice,
+		AdjustmentID:    fmt.Sprintf("mock-adjustment-%s", invoiceID),
+	}, nil
+}
 
-	return nil, fmt.Errorf("invoice not found")
+// setStatus updates the status of the mock invoice with the given ID in both indexes
+func (c *MockClient) setStatus(invoiceID, status string) (InvoiceResponse, bool) {
+	for prescID, invoice := range c.invoices {
+		if invoice.ID == invoiceID {
+			invoice.Status = status
+			c.invoices[prescID] = invoice
+			for patientID, invoices := range c.invoicesByPatient {
+				for i := range invoices {
+					if invoices[i].ID == invoiceID {
+						c.invoicesByPatient[patientID][i] = invoice
+					}
+				}
+			}
+			return invoice, true
+		}
+	}
+	return InvoiceResponse{}, false
 }
 
 // GetInvoicePayment retrieves mock payment details
@@ -150,5 +216,28 @@ func (c *MockClient) GetInvoicePayment(ctx context.Context, invoiceID string) (*
 	return defaultPayment, nil
 }
 
+// EstimatePrice quotes a mock price from mockUnitPrices plus a flat dispensing fee
+func (c *MockClient) EstimatePrice(ctx context.Context, req PriceEstimateRequest) (*PriceEstimateResponse, error) {
+	unitPrice, ok := mockUnitPrices[strings.ToLower(req.Drug)]
+	if !ok {
+		unitPrice = mockDefaultUnitPrice
+	}
+	ingredientCost := math.Round(unitPrice*float64(req.Quantity)*100) / 100
+
+	c.logger.Debug("mock price estimated",
+		zap.String("prescription_id", req.PrescriptionID),
+		zap.Float64("unit_price", unitPrice),
+	)
+
+	return &PriceEstimateResponse{
+		PrescriptionID: req.PrescriptionID,
+		UnitPrice:      unitPrice,
+		IngredientCost: ingredientCost,
+		DispensingFee:  mockDispensingFee,
+		Total:          ingredientCost + mockDispensingFee,
+		Currency:       "USD",
+	}, nil
+}
+
 // Verify MockClient implements BillingClient
 var _ BillingClient = (*MockClient)(nil)
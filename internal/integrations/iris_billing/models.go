@@ -13,8 +13,11 @@ type InvoiceResponse struct {
 // CreateInvoiceRequest represents a request to create an invoice
 type CreateInvoiceRequest struct {
 	PrescriptionID string  `json:"prescription_id"`
+	PatientID      string  `json:"patient_id,omitempty"`
 	Amount         float64 `json:"amount"`
 	Description    string  `json:"description,omitempty"`
+	// ReplacesInvoiceID is set when re-billing a prescription whose invoice was voided or credited
+	ReplacesInvoiceID string `json:"replaces_invoice_id,omitempty"`
 }
 
 // CreateInvoiceResponse represents the response from creating an invoice
@@ -33,6 +36,32 @@ type AcknowledgeInvoiceResponse struct {
 	InvoiceResponse
 }
 
+// VoidInvoiceRequest represents a request to void an unpaid invoice
+type VoidInvoiceRequest struct {
+	VoidedBy   string `json:"voided_by"`
+	ReasonCode string `json:"reason_code"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// VoidInvoiceResponse represents the response from voiding an invoice
+type VoidInvoiceResponse struct {
+	InvoiceResponse
+}
+
+// AdjustInvoiceRequest represents a request to adjust a paid invoice; a negative amount is a credit
+type AdjustInvoiceRequest struct {
+	Amount     float64 `json:"amount"`
+	AdjustedBy string  `json:"adjusted_by"`
+	ReasonCode string  `json:"reason_code"`
+	Reason     string  `json:"reason,omitempty"`
+}
+
+// AdjustInvoiceResponse represents the response from adjusting an invoice
+type AdjustInvoiceResponse struct {
+	InvoiceResponse
+	AdjustmentID string `json:"adjustment_id"`
+}
+
 // InvoicePaymentResponse represents payment details for an invoice
 type InvoicePaymentResponse struct {
 	InvoiceID     string  `json:"invoice_id"`
@@ -49,3 +78,24 @@ type InvoiceListResponse struct {
 	Invoices  []InvoiceResponse `json:"invoices"`
 	Total     int               `json:"total"`
 }
+
+// PriceEstimateRequest asks what a prescription would cost before it is billed
+type PriceEstimateRequest struct {
+	PrescriptionID string `json:"prescription_id"`
+	PatientID      string `json:"patient_id,omitempty"`
+	Drug           string `json:"drug"`
+	DrugID         string `json:"drug_id,omitempty"`
+	Dose           string `json:"dose,omitempty"`
+	Quantity       int    `json:"quantity"`
+	DaysSupply     int    `json:"days_supply,omitempty"`
+}
+
+// PriceEstimateResponse represents the estimated cost of a prescription
+type PriceEstimateResponse struct {
+	PrescriptionID string  `json:"prescription_id"`
+	UnitPrice      float64 `json:"unit_price"`
+	IngredientCost float64 `json:"ingredient_cost"`
+	DispensingFee  float64 `json:"dispensing_fee"`
+	Total          float64 `json:"total"`
+	Currency       string  `json:"currency"`
+}
@@ -14,7 +14,9 @@ type ModuleDependencies struct {
 	Logger     *zap.Logger
 	HTTPClient *httpclient.Client
 	UseMock    bool
-	Timeout    time.Duration
+	Timeout    time.Duration // Per-request timeout for this service, capped by the caller's deadline
+	// SlowRequestThreshold logs calls to this service taking at least this long (0 uses the client default)
+	SlowRequestThreshold time.Duration
 }
 
 // ModuleExport contains the exported services from the billing module
@@ -58,6 +60,10 @@ func Module(deps ModuleDependencies) ModuleExport {
 		zap.String("create_invoice_url", deps.Config.CreateInvoiceURL),
 	)
 
-	client := NewHTTPClient(deps.Config, deps.HTTPClient, deps.Logger)
+	client := NewHTTPClient(deps.Config, deps.HTTPClient, deps.Logger,
+		httpclient.WithService("iris_billing"),
+		httpclient.WithTimeout(deps.Timeout),
+		httpclient.WithSlowThreshold(deps.SlowRequestThreshold),
+	)
 	return ModuleExport{BillingClient: client}
 }
@@ -0,0 +1,215 @@
+package iris_mock
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"pharmacy-modernization-project-model/internal/platform/sanitizer"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Billing handlers
+func (s *Server) handleGetInvoice(w http.ResponseWriter, r *http.Request) {
+	prescriptionID := chi.URLParam(r, "prescriptionID")
+
+	s.invoicesMu.RLock()
+	response, ok := s.invoices[prescriptionID]
+	s.invoicesMu.RUnlock()
+	if ok {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+		s.log.Printf("✅ Returned invoice for prescription: %s", sanitizer.ForLogging(prescriptionID))
+		return
+	}
+
+	// A prescription never invoiced here is unbilled, so the app can bill it
+	response = InvoiceResponse{
+		PrescriptionID: prescriptionID,
+		Status:         "unbilled",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+	s.log.Printf("✅ Returned unbilled invoice for prescription: %s", sanitizer.ForLogging(prescriptionID))
+}
+
+func (s *Server) handleGetInvoicesByPatient(w http.ResponseWriter, r *http.Request) {
+	patientID := chi.URLParam(r, "patientID")
+
+	// Mock data - return a list of invoices for the patient
+	invoices := []InvoiceResponse{
+		{
+			ID:             "INV-001",
+			PrescriptionID: "RX-" + patientID + "-001",
+			Amount:         125.50,
+			Status:         "paid",
+			CreatedAt:      "2025-10-01T10:00:00Z",
+			UpdatedAt:      "2025-10-02T14:30:00Z",
+		},
+		{
+			ID:             "INV-002",
+			PrescriptionID: "RX-" + patientID + "-002",
+			Amount:         89.99,
+			Status:         "pending",
+			CreatedAt:      "2025-10-10T09:15:00Z",
+			UpdatedAt:      "2025-10-10T09:15:00Z",
+		},
+		{
+			ID:             "INV-003",
+			PrescriptionID: "RX-" + patientID + "-003",
+			Amount:         250.00,
+			Status:         "overdue",
+			CreatedAt:      "2025-09-15T11:20:00Z",
+			UpdatedAt:      "2025-09-15T11:20:00Z",
+		},
+	}
+
+	response := InvoiceListResponse{
+		PatientID: patientID,
+		Invoices:  invoices,
+		Total:     len(invoices),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+	s.log.Printf("✅ Returned %d invoices for patient: %s", len(invoices), sanitizer.ForLogging(patientID))
+}
+
+func (s *Server) handleCreateInvoice(w http.ResponseWriter, r *http.Request) {
+	var req CreateInvoiceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Check for idempotency key
+	idempotencyKey := r.Header.Get("X-Idempotency-Key")
+	s.log.Printf("💡 Idempotency key: %s", sanitizer.ForLogging(idempotencyKey))
+
+	response := InvoiceResponse{
+		ID:             "INV-NEW-" + req.PrescriptionID,
+		PrescriptionID: req.PrescriptionID,
+		Amount:         req.Amount,
+		Status:         "pending",
+		CreatedAt:      "2025-10-14T10:00:00Z",
+	}
+	s.invoicesMu.Lock()
+	s.invoices[req.PrescriptionID] = response
+	s.invoicesMu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(response)
+	s.log.Printf("✅ Created invoice: %s (Amount: %.2f)", sanitizer.ForLogging(response.ID), response.Amount)
+}
+
+func (s *Server) handleAcknowledgeInvoice(w http.ResponseWriter, r *http.Request) {
+	invoiceID := chi.URLParam(r, "invoiceID")
+
+	var req AcknowledgeInvoiceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := InvoiceResponse{
+		ID:             invoiceID,
+		PrescriptionID: "RX-123",
+		Amount:         125.50,
+		Status:         "acknowledged",
+		UpdatedAt:      "2025-10-14T10:00:00Z",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+	s.log.Printf("✅ Acknowledged invoice: %s by %s", sanitizer.ForLogging(invoiceID), sanitizer.ForLogging(req.AcknowledgedBy))
+}
+
+func (s *Server) handleVoidInvoice(w http.ResponseWriter, r *http.Request) {
+	invoiceID := chi.URLParam(r, "invoiceID")
+
+	var req VoidInvoiceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := InvoiceResponse{
+		ID:             invoiceID,
+		PrescriptionID: "RX-123",
+		Amount:         125.50,
+		Status:         "voided",
+		UpdatedAt:      "2025-10-14T10:00:00Z",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+	s.log.Printf("✅ Voided invoice: %s (%s)", sanitizer.ForLogging(invoiceID), sanitizer.ForLogging(req.ReasonCode))
+}
+
+func (s *Server) handleAdjustInvoice(w http.ResponseWriter, r *http.Request) {
+	invoiceID := chi.URLParam(r, "invoiceID")
+
+	var req AdjustInvoiceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := AdjustInvoiceResponse{
+		InvoiceResponse: InvoiceResponse{
+			ID:             invoiceID,
+			PrescriptionID: "RX-123",
+			Amount:         125.50 + req.Amount,
+			Status:         "credited",
+			UpdatedAt:      "2025-10-14T10:00:00Z",
+		},
+		AdjustmentID: "ADJ-" + invoiceID,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(response)
+	s.log.Printf("✅ Adjusted invoice: %s (Amount: %.2f)", sanitizer.ForLogging(invoiceID), req.Amount)
+}
+
+func (s *Server) handleGetInvoicePayment(w http.ResponseWriter, r *http.Request) {
+	invoiceID := chi.URLParam(r, "invoiceID")
+
+	response := InvoicePaymentResponse{
+		InvoiceID:     invoiceID,
+		PaymentID:     "PAY-" + invoiceID,
+		Amount:        125.50,
+		PaymentMethod: "credit_card",
+		Status:        "completed",
+		PaidAt:        "2025-10-14T10:00:00Z",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+	s.log.Printf("✅ Returned payment for invoice: %s", sanitizer.ForLogging(invoiceID))
+}
+
+func (s *Server) handleEstimatePrice(w http.ResponseWriter, r *http.Request) {
+	var req PriceEstimateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// A flat 0.50 per unit plus the dispensing fee
+	ingredientCost := 0.50 * float64(req.Quantity)
+	response := PriceEstimateResponse{
+		PrescriptionID: req.PrescriptionID,
+		UnitPrice:      0.50,
+		IngredientCost: ingredientCost,
+		DispensingFee:  12.50,
+		Total:          ingredientCost + 12.50,
+		Currency:       "USD",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+	s.log.Printf("✅ Estimated price for prescription: %s (Total: %.2f)", sanitizer.ForLogging(req.PrescriptionID), response.Total)
+}
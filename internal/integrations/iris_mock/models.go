@@ -0,0 +1,202 @@
+package iris_mock
+
+import "encoding/xml"
+
+// Mock data structures matching the new Request/Response naming
+
+// Pharmacy models
+type PrescriptionResponse struct {
+	ID           string `json:"id"`
+	PatientID    string `json:"patient_id"`
+	Drug         string `json:"drug"`
+	Dose         string `json:"dose"`
+	Status       string `json:"status"`
+	PharmacyName string `json:"pharmacy_name"`
+	PharmacyType string `json:"pharmacy_type"`
+}
+
+type PharmacyResponse struct {
+	ID                     string `json:"id"`
+	Name                   string `json:"name"`
+	Type                   string `json:"type"`
+	Address                string `json:"address"`
+	City                   string `json:"city"`
+	State                  string `json:"state"`
+	Zip                    string `json:"zip"`
+	Phone                  string `json:"phone"`
+	AcceptingPrescriptions bool   `json:"accepting_prescriptions"`
+}
+
+type PharmacySearchResponse struct {
+	Pharmacies []PharmacyResponse `json:"pharmacies"`
+	Total      int                `json:"total"`
+}
+
+type RoutePrescriptionRequest struct {
+	PharmacyID string `json:"pharmacy_id"`
+	PatientID  string `json:"patient_id"`
+	Drug       string `json:"drug"`
+	Dose       string `json:"dose"`
+}
+
+type RoutePrescriptionResponse struct {
+	PrescriptionID string `json:"prescription_id"`
+	PharmacyID     string `json:"pharmacy_id"`
+	Status         string `json:"status"`
+}
+
+// Billing models
+type InvoiceResponse struct {
+	ID             string  `json:"id"`
+	PrescriptionID string  `json:"prescription_id"`
+	Amount         float64 `json:"amount"`
+	Status         string  `json:"status"`
+	CreatedAt      string  `json:"created_at,omitempty"`
+	UpdatedAt      string  `json:"updated_at,omitempty"`
+}
+
+type CreateInvoiceRequest struct {
+	PrescriptionID    string  `json:"prescription_id"`
+	PatientID         string  `json:"patient_id,omitempty"`
+	Amount            float64 `json:"amount"`
+	Description       string  `json:"description,omitempty"`
+	ReplacesInvoiceID string  `json:"replaces_invoice_id,omitempty"`
+}
+
+type AcknowledgeInvoiceRequest struct {
+	AcknowledgedBy string `json:"acknowledged_by"`
+	Notes          string `json:"notes,omitempty"`
+}
+
+type VoidInvoiceRequest struct {
+	VoidedBy   string `json:"voided_by"`
+	ReasonCode string `json:"reason_code"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+type AdjustInvoiceRequest struct {
+	Amount     float64 `json:"amount"`
+	AdjustedBy string  `json:"adjusted_by"`
+	ReasonCode string  `json:"reason_code"`
+	Reason     string  `json:"reason,omitempty"`
+}
+
+type AdjustInvoiceResponse struct {
+	InvoiceResponse
+	AdjustmentID string `json:"adjustment_id"`
+}
+
+type InvoicePaymentResponse struct {
+	InvoiceID     string  `json:"invoice_id"`
+	PaymentID     string  `json:"payment_id"`
+	Amount        float64 `json:"amount"`
+	PaymentMethod string  `json:"payment_method"`
+	Status        string  `json:"status"`
+	PaidAt        string  `json:"paid_at,omitempty"`
+}
+
+type InvoiceListResponse struct {
+	PatientID string            `json:"patient_id"`
+	Invoices  []InvoiceResponse `json:"invoices"`
+	Total     int               `json:"total"`
+}
+
+type PriceEstimateRequest struct {
+	PrescriptionID string `json:"prescription_id"`
+	PatientID      string `json:"patient_id,omitempty"`
+	Drug           string `json:"drug"`
+	DrugID         string `json:"drug_id,omitempty"`
+	Dose           string `json:"dose,omitempty"`
+	Quantity       int    `json:"quantity"`
+	DaysSupply     int    `json:"days_supply,omitempty"`
+}
+
+type PriceEstimateResponse struct {
+	PrescriptionID string  `json:"prescription_id"`
+	UnitPrice      float64 `json:"unit_price"`
+	IngredientCost float64 `json:"ingredient_cost"`
+	DispensingFee  float64 `json:"dispensing_fee"`
+	Total          float64 `json:"total"`
+	Currency       string  `json:"currency"`
+}
+
+// Card OCR models
+type CardImage struct {
+	Side        string `json:"side"`
+	ContentType string `json:"content_type"`
+	Data        []byte `json:"data"`
+}
+
+type ExtractCardRequest struct {
+	Images []CardImage `json:"images"`
+}
+
+type ExtractedField struct {
+	Name       string  `json:"name"`
+	Value      string  `json:"value"`
+	Confidence float64 `json:"confidence"`
+}
+
+type ExtractCardResponse struct {
+	Provider string           `json:"provider"`
+	Fields   []ExtractedField `json:"fields"`
+}
+
+// SMS models
+type SendSMSRequest struct {
+	From string `json:"from,omitempty"`
+	To   string `json:"to"`
+	Body string `json:"body"`
+}
+
+type SendSMSResponse struct {
+	MessageID string `json:"message_id"`
+	Status    string `json:"status"`
+}
+
+// Stargate auth models
+type TokenRequest struct {
+	GrantType    string `json:"grant_type"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+// SCRIPT message models, limited to the elements the mock reads and answers with
+type ScriptMessage struct {
+	XMLName xml.Name     `xml:"Message"`
+	Header  ScriptHeader `xml:"Header"`
+	Body    ScriptBody   `xml:"Body"`
+}
+
+type ScriptHeader struct {
+	To                 string `xml:"To"`
+	From               string `xml:"From"`
+	MessageID          string `xml:"MessageID"`
+	RelatesToMessageID string `xml:"RelatesToMessageID,omitempty"`
+	SentTime           string `xml:"SentTime"`
+}
+
+type ScriptBody struct {
+	NewRx  *struct{}     `xml:"NewRx,omitempty"`
+	Status *ScriptStatus `xml:"Status,omitempty"`
+	Verify *ScriptVerify `xml:"Verify,omitempty"`
+	Error  *ScriptStatus `xml:"Error,omitempty"`
+}
+
+type ScriptStatus struct {
+	Code        string `xml:"Code"`
+	Description string `xml:"Description,omitempty"`
+}
+
+type ScriptVerify struct {
+	VerifyStatus ScriptStatus `xml:"VerifyStatus"`
+}
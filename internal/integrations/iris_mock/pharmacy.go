@@ -0,0 +1,222 @@
+package iris_mock
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"pharmacy-modernization-project-model/internal/platform/sanitizer"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Mock pharmacy network, same as the pharmacy mock client
+var pharmacies = []PharmacyResponse{
+	{ID: "PH-1001", Name: "CVS Pharmacy #1001", Type: "Retail", Address: "1 Main St", City: "Seattle", State: "WA", Zip: "98101", Phone: "206-555-0101", AcceptingPrescriptions: true},
+	{ID: "PH-1002", Name: "Walgreens #1002", Type: "Retail", Address: "200 Pine St", City: "Seattle", State: "WA", Zip: "98101", Phone: "206-555-0102", AcceptingPrescriptions: true},
+	{ID: "PH-1003", Name: "Bartell Drugs #1003", Type: "Retail", Address: "45 Bellevue Way", City: "Bellevue", State: "WA", Zip: "98004", Phone: "425-555-0103", AcceptingPrescriptions: false},
+	{ID: "PH-2001", Name: "CVS Pharmacy #2001", Type: "Retail", Address: "10 Market St", City: "San Francisco", State: "CA", Zip: "94105", Phone: "415-555-0201", AcceptingPrescriptions: true},
+	{ID: "PH-2002", Name: "Accredo Specialty Pharmacy", Type: "Specialty", Address: "500 Mission St", City: "San Francisco", State: "CA", Zip: "94105", Phone: "415-555-0202", AcceptingPrescriptions: true},
+	{ID: "PH-3001", Name: "Walgreens #3001", Type: "Retail", Address: "12 Congress Ave", City: "Austin", State: "TX", Zip: "78701", Phone: "512-555-0301", AcceptingPrescriptions: true},
+	{ID: "PH-9001", Name: "Optum Home Delivery", Type: "Mail Order", Address: "2858 Loker Ave", City: "Carlsbad", State: "CA", Zip: "92010", Phone: "800-555-0901", AcceptingPrescriptions: true},
+}
+
+// Pharmacy handlers
+func (s *Server) handleGetPrescription(w http.ResponseWriter, r *http.Request) {
+	prescriptionID := chi.URLParam(r, "prescriptionID")
+
+	s.routedMu.RLock()
+	response, ok := s.routed[prescriptionID]
+	s.routedMu.RUnlock()
+	if ok {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+		s.log.Printf("✅ Returned routed prescription: %s", sanitizer.ForLogging(prescriptionID))
+		return
+	}
+
+	response = PrescriptionResponse{
+		ID:           prescriptionID,
+		PatientID:    "PAT-001",
+		Drug:         "Lisinopril",
+		Dose:         "10mg",
+		Status:       "active",
+		PharmacyName: "CVS Pharmacy",
+		PharmacyType: "Retail",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+	s.log.Printf("✅ Returned prescription: %s", sanitizer.ForLogging(prescriptionID))
+}
+
+func (s *Server) handleSearchPharmacies(w http.ResponseWriter, r *http.Request) {
+	zip := r.URL.Query().Get("zip")
+	state := r.URL.Query().Get("state")
+	if zip == "" && state == "" {
+		http.Error(w, "zip or state is required", http.StatusBadRequest)
+		return
+	}
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+
+	matches := []PharmacyResponse{}
+	for _, pharmacy := range pharmacies {
+		if zip != "" && pharmacy.Zip != zip {
+			continue
+		}
+		if state != "" && !strings.EqualFold(pharmacy.State, state) {
+			continue
+		}
+		matches = append(matches, pharmacy)
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].Name < matches[j].Name })
+
+	response := PharmacySearchResponse{Pharmacies: matches, Total: len(matches)}
+	if len(matches) > limit {
+		response.Pharmacies = matches[:limit]
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+	s.log.Printf("✅ Returned %d pharmacies for zip=%s state=%s", response.Total, sanitizer.ForLogging(zip), sanitizer.ForLogging(state))
+}
+
+func (s *Server) handleGetPharmacy(w http.ResponseWriter, r *http.Request) {
+	pharmacyID := chi.URLParam(r, "pharmacyID")
+
+	pharmacy, ok := findPharmacy(pharmacyID)
+	if !ok {
+		http.Error(w, "pharmacy not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(pharmacy)
+	s.log.Printf("✅ Returned pharmacy: %s", sanitizer.ForLogging(pharmacyID))
+}
+
+func (s *Server) handleRoutePrescription(w http.ResponseWriter, r *http.Request) {
+	prescriptionID := chi.URLParam(r, "prescriptionID")
+
+	var req RoutePrescriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	pharmacy, ok := findPharmacy(req.PharmacyID)
+	if !ok {
+		http.Error(w, "pharmacy not found", http.StatusNotFound)
+		return
+	}
+	if !pharmacy.AcceptingPrescriptions {
+		http.Error(w, "pharmacy is not accepting prescriptions", http.StatusConflict)
+		return
+	}
+
+	s.routedMu.Lock()
+	s.routed[prescriptionID] = PrescriptionResponse{
+		ID:           prescriptionID,
+		PatientID:    req.PatientID,
+		Drug:         req.Drug,
+		Dose:         req.Dose,
+		Status:       "sent",
+		PharmacyName: pharmacy.Name,
+		PharmacyType: pharmacy.Type,
+	}
+	s.routedMu.Unlock()
+
+	response := RoutePrescriptionResponse{
+		PrescriptionID: prescriptionID,
+		PharmacyID:     pharmacy.ID,
+		Status:         "sent",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+	s.log.Printf("✅ Routed prescription %s to pharmacy %s", sanitizer.ForLogging(prescriptionID), sanitizer.ForLogging(pharmacy.ID))
+}
+
+func (s *Server) handleSendScriptMessage(w http.ResponseWriter, r *http.Request) {
+	var message ScriptMessage
+	if err := xml.NewDecoder(r.Body).Decode(&message); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if message.Header.MessageID == "" || message.Body.NewRx == nil {
+		http.Error(w, "a NewRx message with a MessageID is required", http.StatusBadRequest)
+		return
+	}
+
+	s.scriptMu.Lock()
+	response, seen := s.scriptMessages[message.Header.MessageID]
+	if !seen {
+		// Unknown pharmacies and ones not accepting prescriptions reject the message for good
+		if pharmacy, ok := findPharmacy(message.Header.To); !ok || !pharmacy.AcceptingPrescriptions {
+			response = scriptResponse(message.Header, ScriptBody{Error: &ScriptStatus{Code: "900", Description: "pharmacy is not accepting electronic prescriptions"}})
+		} else {
+			response = scriptResponse(message.Header, ScriptBody{Status: &ScriptStatus{Code: "000"}})
+		}
+		s.scriptMessages[message.Header.MessageID] = response
+	}
+	s.scriptMu.Unlock()
+
+	writeScript(w, response)
+	s.log.Printf("✅ Received SCRIPT message %s for pharmacy %s", sanitizer.ForLogging(message.Header.MessageID), sanitizer.ForLogging(message.Header.To))
+}
+
+func (s *Server) handleGetScriptMessageStatus(w http.ResponseWriter, r *http.Request) {
+	messageID := chi.URLParam(r, "messageID")
+
+	s.scriptMu.Lock()
+	response, ok := s.scriptMessages[messageID]
+	if ok && response.Body.Status != nil {
+		response = scriptResponse(ScriptHeader{To: response.Header.From, From: response.Header.To, MessageID: messageID},
+			ScriptBody{Verify: &ScriptVerify{VerifyStatus: ScriptStatus{Code: "010", Description: "accepted by the pharmacy"}}})
+		s.scriptMessages[messageID] = response
+	}
+	s.scriptMu.Unlock()
+	if !ok {
+		http.Error(w, "message not found", http.StatusNotFound)
+		return
+	}
+
+	writeScript(w, response)
+	s.log.Printf("✅ Returned SCRIPT message status: %s", sanitizer.ForLogging(messageID))
+}
+
+// scriptResponse answers a message: addressed back to its sender and relating to it
+func scriptResponse(message ScriptHeader, body ScriptBody) *ScriptMessage {
+	return &ScriptMessage{
+		Header: ScriptHeader{
+			To:                 message.From,
+			From:               message.To,
+			MessageID:          strconv.FormatInt(time.Now().UnixNano(), 36),
+			RelatesToMessageID: message.MessageID,
+			SentTime:           time.Now().UTC().Format(time.RFC3339),
+		},
+		Body: body,
+	}
+}
+
+func writeScript(w http.ResponseWriter, message *ScriptMessage) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.Write([]byte(xml.Header))
+	xml.NewEncoder(w).Encode(message)
+}
+
+func findPharmacy(pharmacyID string) (PharmacyResponse, bool) {
+	for _, pharmacy := range pharmacies {
+		if pharmacy.ID == pharmacyID {
+			return pharmacy, true
+		}
+	}
+	return PharmacyResponse{}, false
+}
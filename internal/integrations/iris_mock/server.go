@@ -0,0 +1,149 @@
+// Package iris_mock serves the IRIS APIs the integrations call: pharmacy, billing, card OCR, SMS
+// and Stargate auth. cmd/iris_mock runs it for local development; cmd/contracts runs the real
+// clients against it in-process, so the clients and the mock cannot drift apart.
+package iris_mock
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"pharmacy-modernization-project-model/internal/platform/sanitizer"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Server is an IRIS mock; routed prescriptions, created invoices and received SCRIPT messages are
+// kept per server
+type Server struct {
+	log *log.Logger
+
+	// Prescriptions routed to a pharmacy, so GET prescription reports them as sent
+	routedMu sync.RWMutex
+	routed   map[string]PrescriptionResponse
+
+	// Invoices created, by prescription ID, so GET invoice reports what was billed
+	invoicesMu sync.RWMutex
+	invoices   map[string]InvoiceResponse
+
+	// SCRIPT messages received, by message ID; the pharmacy verifies a message on the first status request
+	scriptMu       sync.Mutex
+	scriptMessages map[string]*ScriptMessage
+}
+
+// New creates an IRIS mock logging to logger
+func New(logger *log.Logger) *Server {
+	return &Server{
+		log:            logger,
+		routed:         map[string]PrescriptionResponse{},
+		invoices:       map[string]InvoiceResponse{},
+		scriptMessages: map[string]*ScriptMessage{},
+	}
+}
+
+// Handler routes the IRIS APIs behind middlewares
+func (s *Server) Handler(middlewares ...func(http.Handler) http.Handler) http.Handler {
+	r := chi.NewRouter()
+	r.Use(middlewares...)
+
+	// Root welcome handler
+	r.Get("/", s.handleWelcome)
+
+	// Pharmacy API routes
+	r.Route("/pharmacy/v1", func(r chi.Router) {
+		r.Get("/prescriptions/{prescriptionID}", s.handleGetPrescription)
+		r.Post("/prescriptions/{prescriptionID}/route", s.handleRoutePrescription)
+		r.Get("/pharmacies", s.handleSearchPharmacies)
+		r.Get("/pharmacies/{pharmacyID}", s.handleGetPharmacy)
+		r.Post("/script/messages", s.handleSendScriptMessage)
+		r.Get("/script/messages/{messageID}/status", s.handleGetScriptMessageStatus)
+	})
+
+	// Billing API routes
+	r.Route("/billing/v1", func(r chi.Router) {
+		r.Get("/invoices/{prescriptionID}", s.handleGetInvoice)
+		r.Get("/patients/{patientID}/invoices", s.handleGetInvoicesByPatient)
+		r.Post("/invoices", s.handleCreateInvoice)
+		r.Post("/invoices/{invoiceID}/acknowledge", s.handleAcknowledgeInvoice)
+		r.Post("/invoices/{invoiceID}/void", s.handleVoidInvoice)
+		r.Post("/invoices/{invoiceID}/adjustments", s.handleAdjustInvoice)
+		r.Get("/invoices/{invoiceID}/payment", s.handleGetInvoicePayment)
+		r.Post("/price-estimates", s.handleEstimatePrice)
+	})
+
+	// Card OCR API routes
+	r.Route("/ocr/v1", func(r chi.Router) {
+		r.Post("/insurance-cards", s.handleExtractInsuranceCard)
+	})
+
+	// SMS API routes
+	r.Route("/sms/v1", func(r chi.Router) {
+		r.Post("/messages", s.handleSendSMS)
+	})
+
+	// Stargate OAuth routes
+	r.Route("/oauth", func(r chi.Router) {
+		r.Post("/token", s.handleGetToken)
+		r.Post("/refresh", s.handleRefreshToken)
+	})
+
+	return r
+}
+
+// LogHeaders is middleware logging the IRIS headers of every request
+func (s *Server) LogHeaders(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.log.Printf("📥 %s %s", sanitizer.ForLogging(r.Method), sanitizer.ForLogging(r.URL.Path))
+
+		// Log important headers
+		if userID := r.Header.Get("X-IRIS-User-ID"); userID != "" {
+			s.log.Printf("   └─ X-IRIS-User-ID: %s", sanitizer.ForLogging(userID))
+		}
+		if envName := r.Header.Get("X-IRIS-Env-Name"); envName != "" {
+			s.log.Printf("   └─ X-IRIS-Env-Name: %s", sanitizer.ForLogging(envName))
+		}
+		if requestID := r.Header.Get("X-Request-ID"); requestID != "" {
+			s.log.Printf("   └─ X-Request-ID: %s", sanitizer.ForLogging(requestID))
+		}
+		if correlationID := r.Header.Get("X-Correlation-ID"); correlationID != "" {
+			s.log.Printf("   └─ X-Correlation-ID: %s", sanitizer.ForLogging(correlationID))
+		}
+		if idempotency := r.Header.Get("X-Idempotency-Key"); idempotency != "" {
+			s.log.Printf("   └─ X-Idempotency-Key: %s", sanitizer.ForLogging(idempotency))
+		}
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			s.log.Printf("   └─ Authorization: %s", sanitizer.ForLogging(maskToken(auth)))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// maskToken masks the token for logging
+func maskToken(auth string) string {
+	parts := strings.SplitN(auth, " ", 2)
+	if len(parts) == 2 && len(parts[1]) > 10 {
+		return parts[0] + " " + parts[1][:10] + "..."
+	}
+	return auth
+}
+
+// Welcome handler
+func (s *Server) handleWelcome(w http.ResponseWriter, r *http.Request) {
+	response := map[string]interface{}{
+		"message": "Welcome to IRIS Mock Server",
+		"version": "1.0.0",
+		"endpoints": map[string]string{
+			"pharmacy":      "/pharmacy/v1",
+			"billing":       "/billing/v1",
+			"stargate_auth": "/oauth",
+		},
+		"status": "running",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+	s.log.Printf("✅ Welcome page accessed")
+}
@@ -1,8 +1,32 @@
 package iris_pharmacy
 
-import "context"
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrPharmacyNotFound is returned when the pharmacy network has no pharmacy with the requested ID
+	ErrPharmacyNotFound = errors.New("pharmacy not found")
+	// ErrPharmacyUnavailable is returned when routing to a pharmacy that is not accepting prescriptions
+	ErrPharmacyUnavailable = errors.New("pharmacy is not accepting prescriptions")
+)
 
 // PharmacyClient defines the interface for interacting with the IRIS pharmacy API
 type PharmacyClient interface {
 	GetPrescription(ctx context.Context, prescriptionID string) (*PrescriptionResponse, error)
+	// SearchPharmacies finds network pharmacies by zip code and/or state
+	SearchPharmacies(ctx context.Context, req PharmacySearchRequest) (*PharmacySearchResponse, error)
+	// GetPharmacy returns ErrPharmacyNotFound for unknown IDs
+	GetPharmacy(ctx context.Context, pharmacyID string) (*PharmacyResponse, error)
+	// RoutePrescription sends a prescription to a pharmacy; routing again moves it to the new pharmacy.
+	// It returns ErrPharmacyNotFound or ErrPharmacyUnavailable when the pharmacy cannot take it.
+	RoutePrescription(ctx context.Context, prescriptionID string, req RoutePrescriptionRequest) (*RoutePrescriptionResponse, error)
+	// SendScriptMessage posts an NCPDP SCRIPT message, such as one built by BuildNewRx, and returns
+	// the Status or Error IRIS answers with. Sending a message ID again is safe: IRIS answers
+	// with the response to the first copy.
+	SendScriptMessage(ctx context.Context, messageID string, message []byte) (*ScriptResponse, error)
+	// GetMessageStatus returns the latest response to a sent message: a Status while the pharmacy
+	// has not taken it in, then its Verify or Error
+	GetMessageStatus(ctx context.Context, messageID string) (*ScriptResponse, error)
 }
@@ -3,12 +3,22 @@ package iris_pharmacy
 // Config holds the configuration for the IRIS pharmacy service
 type Config struct {
 	// API Endpoints (full URLs from YAML config)
-	GetPrescriptionURL string
+	GetPrescriptionURL   string
+	SearchPharmaciesURL  string
+	GetPharmacyURL       string
+	RoutePrescriptionURL string
+	SendScriptURL        string
+	MessageStatusURL     string
 }
 
 // EndpointsConfig defines the interface for pharmacy endpoints configuration
 type EndpointsConfig interface {
 	GetPrescriptionEndpoint() string
+	SearchPharmaciesEndpoint() string
+	GetPharmacyEndpoint() string
+	RoutePrescriptionEndpoint() string
+	SendScriptEndpoint() string
+	MessageStatusEndpoint() string
 }
 
 // Verify Config implements EndpointsConfig
@@ -18,3 +28,28 @@ var _ EndpointsConfig = (*Config)(nil)
 func (c *Config) GetPrescriptionEndpoint() string {
 	return c.GetPrescriptionURL
 }
+
+// SearchPharmaciesEndpoint returns the full URL for searching pharmacies; filters are added as query parameters
+func (c *Config) SearchPharmaciesEndpoint() string {
+	return c.SearchPharmaciesURL
+}
+
+// GetPharmacyEndpoint returns the full URL for getting a pharmacy
+func (c *Config) GetPharmacyEndpoint() string {
+	return c.GetPharmacyURL
+}
+
+// RoutePrescriptionEndpoint returns the full URL for routing a prescription to a pharmacy
+func (c *Config) RoutePrescriptionEndpoint() string {
+	return c.RoutePrescriptionURL
+}
+
+// SendScriptEndpoint returns the full URL NCPDP SCRIPT messages are posted to
+func (c *Config) SendScriptEndpoint() string {
+	return c.SendScriptURL
+}
+
+// MessageStatusEndpoint returns the full URL for the latest response to a SCRIPT message
+func (c *Config) MessageStatusEndpoint() string {
+	return c.MessageStatusURL
+}
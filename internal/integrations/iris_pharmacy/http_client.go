@@ -1,8 +1,14 @@
 package iris_pharmacy
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
 
 	"pharmacy-modernization-project-model/internal/platform/httpclient"
 
@@ -14,17 +20,24 @@ type HTTPClient struct {
 	client    *httpclient.Client
 	endpoints EndpointsConfig
 	logger    *zap.Logger
+	opts      []httpclient.RequestOption // Timeout and slow-request threshold for every call
 }
 
 // NewHTTPClient creates a new HTTP-based pharmacy client
-func NewHTTPClient(cfg Config, client *httpclient.Client, logger *zap.Logger) *HTTPClient {
+func NewHTTPClient(cfg Config, client *httpclient.Client, logger *zap.Logger, opts ...httpclient.RequestOption) *HTTPClient {
 	return &HTTPClient{
 		client:    client,
 		endpoints: &cfg,
 		logger:    logger,
+		opts:      opts,
 	}
 }
 
+// requestOptions returns the pharmacy call options for the named endpoint
+func (c *HTTPClient) requestOptions(endpoint string) []httpclient.RequestOption {
+	return append(slices.Clone(c.opts), httpclient.WithEndpoint(endpoint))
+}
+
 // GetPrescription retrieves a prescription for a given prescription ID
 func (c *HTTPClient) GetPrescription(ctx context.Context, prescriptionID string) (*PrescriptionResponse, error) {
 	url, err := httpclient.ReplacePathParams(c.endpoints.GetPrescriptionEndpoint(), map[string]string{
@@ -40,7 +53,7 @@ func (c *HTTPClient) GetPrescription(ctx context.Context, prescriptionID string)
 	)
 
 	var response PrescriptionResponse
-	err = c.client.GetJSON(ctx, url, &response)
+	err = c.client.GetJSON(ctx, url, &response, c.requestOptions("get_prescription")...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get prescription: %w", err)
 	}
@@ -55,5 +68,181 @@ func (c *HTTPClient) GetPrescription(ctx context.Context, prescriptionID string)
 	return &response, nil
 }
 
+// SearchPharmacies finds network pharmacies by zip code and/or state
+func (c *HTTPClient) SearchPharmacies(ctx context.Context, req PharmacySearchRequest) (*PharmacySearchResponse, error) {
+	if req.Zip == "" && req.State == "" {
+		return nil, fmt.Errorf("zip or state is required")
+	}
+
+	query := url.Values{}
+	if req.Zip != "" {
+		query.Set("zip", req.Zip)
+	}
+	if req.State != "" {
+		query.Set("state", req.State)
+	}
+	if req.Limit > 0 {
+		query.Set("limit", strconv.Itoa(req.Limit))
+	}
+	searchURL := c.endpoints.SearchPharmaciesEndpoint() + "?" + query.Encode()
+
+	c.logger.Debug("searching pharmacies",
+		zap.String("zip", req.Zip),
+		zap.String("state", req.State),
+	)
+
+	var response PharmacySearchResponse
+	if err := c.client.GetJSON(ctx, searchURL, &response, c.requestOptions("search_pharmacies")...); err != nil {
+		return nil, fmt.Errorf("failed to search pharmacies: %w", err)
+	}
+	if response.Pharmacies == nil {
+		response.Pharmacies = []PharmacyResponse{}
+	}
+
+	c.logger.Debug("pharmacy search completed", zap.Int("total", response.Total))
+
+	return &response, nil
+}
+
+// GetPharmacy retrieves a pharmacy, or ErrPharmacyNotFound when the network has no such pharmacy
+func (c *HTTPClient) GetPharmacy(ctx context.Context, pharmacyID string) (*PharmacyResponse, error) {
+	pharmacyURL, err := httpclient.ReplacePathParams(c.endpoints.GetPharmacyEndpoint(), map[string]string{
+		"pharmacyID": pharmacyID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build URL: %w", err)
+	}
+
+	c.logger.Debug("fetching pharmacy", zap.String("pharmacy_id", pharmacyID))
+
+	resp, err := c.client.Get(ctx, pharmacyURL, map[string]string{
+		"Content-Type": "application/json",
+		"Accept":       "application/json",
+	}, c.requestOptions("get_pharmacy")...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pharmacy: %w", err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrPharmacyNotFound
+	}
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("HTTP %d: request failed", resp.StatusCode)
+	}
+
+	var response PharmacyResponse
+	if err := json.Unmarshal(resp.Body, &response); err != nil {
+		return nil, fmt.Errorf("failed to decode pharmacy response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// RoutePrescription sends a prescription to a pharmacy. The API answers 404 for unknown pharmacies
+// and 409 for pharmacies not accepting prescriptions.
+func (c *HTTPClient) RoutePrescription(ctx context.Context, prescriptionID string, req RoutePrescriptionRequest) (*RoutePrescriptionResponse, error) {
+	routeURL, err := httpclient.ReplacePathParams(c.endpoints.RoutePrescriptionEndpoint(), map[string]string{
+		"prescriptionID": prescriptionID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build URL: %w", err)
+	}
+
+	c.logger.Debug("routing prescription",
+		zap.String("prescription_id", prescriptionID),
+		zap.String("pharmacy_id", req.PharmacyID),
+	)
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+	resp, err := c.client.Post(ctx, routeURL, bytes.NewReader(body), map[string]string{
+		"Content-Type": "application/json",
+		"Accept":       "application/json",
+	}, c.requestOptions("route_prescription")...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to route prescription: %w", err)
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, ErrPharmacyNotFound
+	case resp.StatusCode == http.StatusConflict:
+		return nil, ErrPharmacyUnavailable
+	case resp.StatusCode >= 400:
+		return nil, fmt.Errorf("HTTP %d: request failed", resp.StatusCode)
+	}
+
+	var response RoutePrescriptionResponse
+	if err := json.Unmarshal(resp.Body, &response); err != nil {
+		return nil, fmt.Errorf("failed to decode route response: %w", err)
+	}
+
+	c.logger.Debug("prescription routed",
+		zap.String("prescription_id", prescriptionID),
+		zap.String("pharmacy_id", response.PharmacyID),
+		zap.String("status", response.Status),
+	)
+
+	return &response, nil
+}
+
+// SendScriptMessage posts a SCRIPT message. A SCRIPT Error comes back as a response; transport
+// failures and other HTTP errors are returned as errors, and the message can be sent again.
+func (c *HTTPClient) SendScriptMessage(ctx context.Context, messageID string, message []byte) (*ScriptResponse, error) {
+	c.logger.Debug("sending SCRIPT message", zap.String("message_id", messageID))
+
+	resp, err := c.client.Post(ctx, c.endpoints.SendScriptEndpoint(), bytes.NewReader(message), map[string]string{
+		"Content-Type": scriptContentType,
+		"Accept":       scriptContentType,
+	}, c.requestOptions("send_script_message")...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send SCRIPT message: %w", err)
+	}
+	response, err := scriptResponseOf(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send SCRIPT message: %w", err)
+	}
+
+	c.logger.Debug("SCRIPT message sent",
+		zap.String("message_id", messageID),
+		zap.String("response", string(response.Kind)),
+		zap.String("code", response.Code),
+	)
+	return response, nil
+}
+
+// GetMessageStatus returns the latest response to a SCRIPT message
+func (c *HTTPClient) GetMessageStatus(ctx context.Context, messageID string) (*ScriptResponse, error) {
+	statusURL, err := httpclient.ReplacePathParams(c.endpoints.MessageStatusEndpoint(), map[string]string{
+		"messageID": messageID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build URL: %w", err)
+	}
+
+	resp, err := c.client.Get(ctx, statusURL, map[string]string{
+		"Accept": scriptContentType,
+	}, c.requestOptions("get_message_status")...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SCRIPT message status: %w", err)
+	}
+	response, err := scriptResponseOf(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SCRIPT message status: %w", err)
+	}
+	return response, nil
+}
+
+// scriptContentType is the media type of SCRIPT messages
+const scriptContentType = "application/xml"
+
+// scriptResponseOf reads the SCRIPT message of a response; IRIS answers SCRIPT Errors with 200
+func scriptResponseOf(resp *httpclient.Response) (*ScriptResponse, error) {
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("HTTP %d: request failed", resp.StatusCode)
+	}
+	return ParseScriptResponse(resp.Body)
+}
+
 // Verify HTTPClient implements PharmacyClient
 var _ PharmacyClient = (*HTTPClient)(nil)
@@ -2,32 +2,72 @@ package iris_pharmacy
 
 import (
 	"context"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
 
 	"go.uber.org/zap"
 )
 
+// defaultSearchLimit caps search results when the request does not set a limit, as the IRIS API does
+const defaultSearchLimit = 20
+
+// mockPharmacies is the network served by the mock client
+var mockPharmacies = []PharmacyResponse{
+	{ID: "PH-1001", Name: "CVS Pharmacy #1001", Type: "Retail", Address: "1 Main St", City: "Seattle", State: "WA", Zip: "98101", Phone: "206-555-0101", AcceptingPrescriptions: true},
+	{ID: "PH-1002", Name: "Walgreens #1002", Type: "Retail", Address: "200 Pine St", City: "Seattle", State: "WA", Zip: "98101", Phone: "206-555-0102", AcceptingPrescriptions: true},
+	{ID: "PH-1003", Name: "Bartell Drugs #1003", Type: "Retail", Address: "45 Bellevue Way", City: "Bellevue", State: "WA", Zip: "98004", Phone: "425-555-0103", AcceptingPrescriptions: false},
+	{ID: "PH-2001", Name: "CVS Pharmacy #2001", Type: "Retail", Address: "10 Market St", City: "San Francisco", State: "CA", Zip: "94105", Phone: "415-555-0201", AcceptingPrescriptions: true},
+	{ID: "PH-2002", Name: "Accredo Specialty Pharmacy", Type: "Specialty", Address: "500 Mission St", City: "San Francisco", State: "CA", Zip: "94105", Phone: "415-555-0202", AcceptingPrescriptions: true},
+	{ID: "PH-3001", Name: "Walgreens #3001", Type: "Retail", Address: "12 Congress Ave", City: "Austin", State: "TX", Zip: "78701", Phone: "512-555-0301", AcceptingPrescriptions: true},
+	{ID: "PH-9001", Name: "Optum Home Delivery", Type: "Mail Order", Address: "2858 Loker Ave", City: "Carlsbad", State: "CA", Zip: "92010", Phone: "800-555-0901", AcceptingPrescriptions: true},
+}
+
 // MockClient implements PharmacyClient with in-memory mock data
 type MockClient struct {
-	data   map[string]PrescriptionResponse
-	logger *zap.Logger
+	mu         sync.RWMutex
+	data       map[string]PrescriptionResponse
+	pharmacies map[string]PharmacyResponse
+	messages   map[string]ScriptResponse // Latest response to each SCRIPT message sent
+	logger     *zap.Logger
 }
 
-// NewMockClient creates a new mock pharmacy client
+// NewMockClient creates a new mock pharmacy client with a small pharmacy network in WA, CA and TX
 func NewMockClient(logger *zap.Logger) *MockClient {
-	return &MockClient{
-		data:   make(map[string]PrescriptionResponse),
-		logger: logger,
+	c := &MockClient{
+		data:       make(map[string]PrescriptionResponse),
+		pharmacies: make(map[string]PharmacyResponse),
+		messages:   make(map[string]ScriptResponse),
+		logger:     logger,
 	}
+	for _, pharmacy := range mockPharmacies {
+		c.pharmacies[pharmacy.ID] = pharmacy
+	}
+	return c
 }
 
 // SeedPrescription adds a mock prescription (useful for testing)
 func (c *MockClient) SeedPrescription(prescription PrescriptionResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.data[prescription.ID] = prescription
 }
 
+// SeedPharmacy adds a mock pharmacy to the network (useful for testing)
+func (c *MockClient) SeedPharmacy(pharmacy PharmacyResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pharmacies[pharmacy.ID] = pharmacy
+}
+
 // GetPrescription retrieves a mock prescription for a given prescription ID
 func (c *MockClient) GetPrescription(ctx context.Context, prescriptionID string) (*PrescriptionResponse, error) {
-	if prescription, ok := c.data[prescriptionID]; ok {
+	c.mu.RLock()
+	prescription, ok := c.data[prescriptionID]
+	c.mu.RUnlock()
+	if ok {
 		c.logger.Debug("mock prescription found",
 			zap.String("prescription_id", prescriptionID),
 			zap.String("drug", prescription.Drug),
@@ -51,5 +91,125 @@ func (c *MockClient) GetPrescription(ctx context.Context, prescriptionID string)
 	return defaultPrescription, nil
 }
 
+// SearchPharmacies filters the mock network by exact zip code and state, sorted by name
+func (c *MockClient) SearchPharmacies(ctx context.Context, req PharmacySearchRequest) (*PharmacySearchResponse, error) {
+	if req.Zip == "" && req.State == "" {
+		return nil, fmt.Errorf("zip or state is required")
+	}
+
+	c.mu.RLock()
+	matches := []PharmacyResponse{}
+	for _, pharmacy := range c.pharmacies {
+		if req.Zip != "" && pharmacy.Zip != req.Zip {
+			continue
+		}
+		if req.State != "" && !strings.EqualFold(pharmacy.State, req.State) {
+			continue
+		}
+		matches = append(matches, pharmacy)
+	}
+	c.mu.RUnlock()
+	sort.Slice(matches, func(i, j int) bool { return matches[i].Name < matches[j].Name })
+
+	total := len(matches)
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	c.logger.Debug("mock pharmacy search",
+		zap.String("zip", req.Zip),
+		zap.String("state", req.State),
+		zap.Int("total", total),
+	)
+	return &PharmacySearchResponse{Pharmacies: matches, Total: total}, nil
+}
+
+// GetPharmacy retrieves a mock pharmacy, or ErrPharmacyNotFound
+func (c *MockClient) GetPharmacy(ctx context.Context, pharmacyID string) (*PharmacyResponse, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	pharmacy, ok := c.pharmacies[pharmacyID]
+	if !ok {
+		return nil, ErrPharmacyNotFound
+	}
+	return &pharmacy, nil
+}
+
+// RoutePrescription records the route so later GetPrescription calls report the prescription as
+// sent to that pharmacy
+func (c *MockClient) RoutePrescription(ctx context.Context, prescriptionID string, req RoutePrescriptionRequest) (*RoutePrescriptionResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	pharmacy, ok := c.pharmacies[req.PharmacyID]
+	if !ok {
+		return nil, ErrPharmacyNotFound
+	}
+	if !pharmacy.AcceptingPrescriptions {
+		return nil, ErrPharmacyUnavailable
+	}
+
+	c.data[prescriptionID] = PrescriptionResponse{
+		ID:           prescriptionID,
+		PatientID:    req.PatientID,
+		Drug:         req.Drug,
+		Dose:         req.Dose,
+		Status:       "sent",
+		PharmacyName: pharmacy.Name,
+		PharmacyType: pharmacy.Type,
+	}
+
+	c.logger.Debug("mock prescription routed",
+		zap.String("prescription_id", prescriptionID),
+		zap.String("pharmacy_id", pharmacy.ID),
+	)
+	return &RoutePrescriptionResponse{PrescriptionID: prescriptionID, PharmacyID: pharmacy.ID, Status: "sent"}, nil
+}
+
+// SendScriptMessage acknowledges a message addressed to a network pharmacy that is accepting
+// prescriptions with Status 000; messages to other pharmacies get Error 900
+func (c *MockClient) SendScriptMessage(ctx context.Context, messageID string, message []byte) (*ScriptResponse, error) {
+	var sent scriptMessage
+	if err := xml.Unmarshal(message, &sent); err != nil {
+		return nil, fmt.Errorf("HTTP 400: request failed")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if previous, ok := c.messages[messageID]; ok {
+		return &previous, nil
+	}
+	response := ScriptResponse{MessageID: "IRIS-" + messageID, RelatesToMessageID: messageID, Kind: ResponseStatus, Code: StatusReceived}
+	if pharmacy, ok := c.pharmacies[sent.Header.To.Value]; !ok || !pharmacy.AcceptingPrescriptions {
+		response.Kind, response.Code, response.Description = ResponseError, "900", "Pharmacy is not accepting electronic prescriptions"
+	}
+	c.messages[messageID] = response
+
+	c.logger.Debug("mock SCRIPT message received",
+		zap.String("message_id", messageID),
+		zap.String("pharmacy_id", sent.Header.To.Value),
+		zap.String("response", string(response.Kind)),
+	)
+	return &response, nil
+}
+
+// GetMessageStatus reports a received message as verified by the pharmacy
+func (c *MockClient) GetMessageStatus(ctx context.Context, messageID string) (*ScriptResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	response, ok := c.messages[messageID]
+	if !ok {
+		return nil, fmt.Errorf("HTTP 404: request failed")
+	}
+	if response.Kind == ResponseStatus {
+		response.Kind, response.Code = ResponseVerify, StatusAccepted
+		c.messages[messageID] = response
+	}
+	return &response, nil
+}
+
 // Verify MockClient implements PharmacyClient
 var _ PharmacyClient = (*MockClient)(nil)
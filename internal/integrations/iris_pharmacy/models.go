@@ -10,3 +10,44 @@ type PrescriptionResponse struct {
 	PharmacyName string `json:"pharmacy_name"`
 	PharmacyType string `json:"pharmacy_type"`
 }
+
+// PharmacyResponse is a pharmacy in the IRIS pharmacy network
+type PharmacyResponse struct {
+	ID                     string `json:"id"`
+	Name                   string `json:"name"`
+	Type                   string `json:"type"` // Retail, Mail Order, Specialty
+	Address                string `json:"address"`
+	City                   string `json:"city"`
+	State                  string `json:"state"`
+	Zip                    string `json:"zip"`
+	Phone                  string `json:"phone"`
+	AcceptingPrescriptions bool   `json:"accepting_prescriptions"` // False while the pharmacy is not taking new prescriptions
+}
+
+// PharmacySearchRequest filters the pharmacy network; at least one of Zip and State is required
+type PharmacySearchRequest struct {
+	Zip   string
+	State string
+	Limit int // 0 lets the service choose
+}
+
+// PharmacySearchResponse lists the pharmacies matching a search
+type PharmacySearchResponse struct {
+	Pharmacies []PharmacyResponse `json:"pharmacies"`
+	Total      int                `json:"total"`
+}
+
+// RoutePrescriptionRequest sends a prescription to a pharmacy for fulfillment
+type RoutePrescriptionRequest struct {
+	PharmacyID string `json:"pharmacy_id"`
+	PatientID  string `json:"patient_id"`
+	Drug       string `json:"drug"`
+	Dose       string `json:"dose"`
+}
+
+// RoutePrescriptionResponse confirms a prescription was accepted by the pharmacy
+type RoutePrescriptionResponse struct {
+	PrescriptionID string `json:"prescription_id"`
+	PharmacyID     string `json:"pharmacy_id"`
+	Status         string `json:"status"` // Vendor fulfillment status, e.g. "sent"
+}
@@ -14,7 +14,9 @@ type ModuleDependencies struct {
 	Logger     *zap.Logger
 	HTTPClient *httpclient.Client
 	UseMock    bool
-	Timeout    time.Duration
+	Timeout    time.Duration // Per-request timeout for this service, capped by the caller's deadline
+	// SlowRequestThreshold logs calls to this service taking at least this long (0 uses the client default)
+	SlowRequestThreshold time.Duration
 }
 
 // ModuleExport contains the exported services from the pharmacy module
@@ -57,6 +59,10 @@ func Module(deps ModuleDependencies) ModuleExport {
 		zap.String("get_prescription_url", deps.Config.GetPrescriptionURL),
 	)
 
-	client := NewHTTPClient(deps.Config, deps.HTTPClient, deps.Logger)
+	client := NewHTTPClient(deps.Config, deps.HTTPClient, deps.Logger,
+		httpclient.WithService("iris_pharmacy"),
+		httpclient.WithTimeout(deps.Timeout),
+		httpclient.WithSlowThreshold(deps.SlowRequestThreshold),
+	)
 	return ModuleExport{PharmacyClient: client}
 }
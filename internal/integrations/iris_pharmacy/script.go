@@ -0,0 +1,409 @@
+package iris_pharmacy
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ScriptVersion is the NCPDP SCRIPT standard version of the messages exchanged with IRIS
+const ScriptVersion = "2017071"
+
+const scriptNamespace = "http://www.ncpdp.org/schema/SCRIPT"
+
+// ErrIncompleteNewRx is returned by BuildNewRx when an element SCRIPT requires is missing
+var ErrIncompleteNewRx = errors.New("prescription is missing data a NewRx requires")
+
+// NewRx is the content of a new prescription message, sent from the prescriber to a pharmacy
+type NewRx struct {
+	MessageID             string    // Unique per message; a retry sends the same ID so IRIS can drop duplicates
+	SentAt                time.Time // Header sent time
+	PrescriberOrderNumber string    // The prescription ID
+
+	Patient    ScriptPatient
+	Pharmacy   ScriptPharmacy
+	Prescriber ScriptPrescriber
+	Medication ScriptMedication
+}
+
+type ScriptPatient struct {
+	FirstName   string
+	LastName    string
+	DateOfBirth string // YYYY-MM-DD
+	Phone       string
+	Address     ScriptAddress
+}
+
+type ScriptPharmacy struct {
+	ID      string // IRIS pharmacy network ID, sent as the pharmacy's NCPDP ID
+	Name    string
+	Phone   string
+	Address ScriptAddress
+}
+
+type ScriptPrescriber struct {
+	NPI       string
+	FirstName string
+	LastName  string
+	Phone     string
+	Fax       string
+}
+
+type ScriptAddress struct {
+	Line1 string
+	Line2 string
+	City  string
+	State string
+	Zip   string
+}
+
+type ScriptMedication struct {
+	DrugDescription string // Drug name with strength, e.g. "Lisinopril 10 mg"
+	Quantity        int
+	DaysSupply      int
+	WrittenDate     time.Time
+	SigText         string // Directions as printed on the label
+	Refills         int
+	// SubstitutionAllowed is false for "dispense as written"
+	SubstitutionAllowed bool
+}
+
+// BuildNewRx renders a NewRx as an NCPDP SCRIPT XML message. It returns ErrIncompleteNewRx,
+// naming the missing elements, when the message would be rejected for lacking them.
+func BuildNewRx(rx NewRx) ([]byte, error) {
+	if missing := rx.missing(); len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrIncompleteNewRx, strings.Join(missing, ", "))
+	}
+
+	substitutions := "1" // Substitution not allowed by prescriber
+	if rx.Medication.SubstitutionAllowed {
+		substitutions = "0"
+	}
+	message := scriptMessage{
+		Xmlns:              scriptNamespace,
+		DatatypesVersion:   ScriptVersion,
+		TransportVersion:   ScriptVersion,
+		TransactionDomain:  "SCRIPT",
+		TransactionVersion: ScriptVersion,
+		StructuresVersion:  ScriptVersion,
+		ECLVersion:         ScriptVersion,
+		Header: scriptHeader{
+			To:                    scriptQualified{Qualifier: "P", Value: rx.Pharmacy.ID},
+			From:                  scriptQualified{Qualifier: "C", Value: rx.Prescriber.NPI},
+			MessageID:             rx.MessageID,
+			SentTime:              rx.SentAt.UTC().Format(time.RFC3339),
+			PrescriberOrderNumber: rx.PrescriberOrderNumber,
+		},
+		Body: scriptBody{NewRx: &scriptNewRx{
+			Patient: scriptPatient{HumanPatient: scriptHumanPatient{
+				Name:                 scriptName{LastName: rx.Patient.LastName, FirstName: rx.Patient.FirstName},
+				Gender:               "U",
+				DateOfBirth:          scriptDate{Date: rx.Patient.DateOfBirth},
+				Address:              addressOf(rx.Patient.Address),
+				CommunicationNumbers: numbersOf(rx.Patient.Phone, ""),
+			}},
+			Pharmacy: scriptPharmacy{
+				Identification:       scriptIdentification{NCPDPID: rx.Pharmacy.ID},
+				BusinessName:         rx.Pharmacy.Name,
+				Address:              addressOf(rx.Pharmacy.Address),
+				CommunicationNumbers: numbersOf(rx.Pharmacy.Phone, ""),
+			},
+			Prescriber: scriptPrescriber{NonVeterinarian: scriptNonVeterinarian{
+				Identification:       scriptIdentification{NPI: rx.Prescriber.NPI},
+				Name:                 scriptName{LastName: rx.Prescriber.LastName, FirstName: rx.Prescriber.FirstName},
+				CommunicationNumbers: numbersOf(rx.Prescriber.Phone, rx.Prescriber.Fax),
+			}},
+			MedicationPrescribed: scriptMedication{
+				DrugDescription: rx.Medication.DrugDescription,
+				Quantity: scriptQuantity{
+					Value:             strconv.Itoa(rx.Medication.Quantity),
+					CodeListQualifier: "38",                       // Original quantity
+					UnitOfMeasure:     scriptCode{Code: "C38046"}, // Unspecified; the dosage form is not recorded
+				},
+				DaysSupply:      daysSupply(rx.Medication.DaysSupply),
+				WrittenDate:     scriptDate{Date: rx.Medication.WrittenDate.Format(time.DateOnly)},
+				Substitutions:   substitutions,
+				NumberOfRefills: strconv.Itoa(rx.Medication.Refills),
+				Sig:             scriptSig{SigText: rx.Medication.SigText},
+			},
+		}},
+	}
+
+	out, err := xml.MarshalIndent(message, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to render NewRx: %w", err)
+	}
+	return append([]byte(xml.Header), out...), nil
+}
+
+// missing lists the required elements that are empty
+func (rx NewRx) missing() []string {
+	var missing []string
+	check := func(name, value string) {
+		if strings.TrimSpace(value) == "" {
+			missing = append(missing, name)
+		}
+	}
+	check("message ID", rx.MessageID)
+	check("patient last name", rx.Patient.LastName)
+	check("patient date of birth", rx.Patient.DateOfBirth)
+	check("pharmacy", rx.Pharmacy.ID)
+	check("prescriber NPI", rx.Prescriber.NPI)
+	check("prescriber last name", rx.Prescriber.LastName)
+	check("drug", rx.Medication.DrugDescription)
+	check("directions", rx.Medication.SigText)
+	if rx.Medication.Quantity <= 0 {
+		missing = append(missing, "quantity")
+	}
+	return missing
+}
+
+// ResponseKind is the SCRIPT message IRIS answers with
+type ResponseKind string
+
+const (
+	// ResponseStatus acknowledges the message; code 000 means it was received, 010 that the
+	// pharmacy accepted it
+	ResponseStatus ResponseKind = "Status"
+	// ResponseVerify confirms the pharmacy system took the prescription in
+	ResponseVerify ResponseKind = "Verify"
+	// ResponseError reports that the message failed; see ScriptResponse.Retryable
+	ResponseError ResponseKind = "Error"
+)
+
+// SCRIPT status and error codes
+const (
+	StatusReceived = "000" // Transaction successful, not yet delivered
+	StatusAccepted = "010" // Successful, accepted by the ultimate receiver
+
+	ErrorCommunication  = "600" // Communication problem, try again later
+	ErrorReceiverSystem = "602" // Receiver system error
+)
+
+// ScriptResponse is a Status, Verify or Error message relating to a sent message
+type ScriptResponse struct {
+	MessageID          string       `json:"message_id"`
+	RelatesToMessageID string       `json:"relates_to_message_id"`
+	Kind               ResponseKind `json:"kind"`
+	Code               string       `json:"code"`
+	DescriptionCode    string       `json:"description_code,omitempty"`
+	Description        string       `json:"description,omitempty"`
+}
+
+// Retryable reports whether an Error may succeed when the same message is sent again
+func (r ScriptResponse) Retryable() bool {
+	return r.Kind == ResponseError && (r.Code == ErrorCommunication || r.Code == ErrorReceiverSystem)
+}
+
+// ParseScriptResponse reads a Status, Verify or Error message
+func ParseScriptResponse(data []byte) (*ScriptResponse, error) {
+	var message scriptMessage
+	if err := xml.Unmarshal(data, &message); err != nil {
+		return nil, fmt.Errorf("failed to decode SCRIPT response: %w", err)
+	}
+	response := &ScriptResponse{
+		MessageID:          message.Header.MessageID,
+		RelatesToMessageID: message.Header.RelatesToMessageID,
+	}
+	switch body := message.Body; {
+	case body.Status != nil:
+		response.Kind, response.Code, response.Description = ResponseStatus, body.Status.Code, body.Status.Description
+	case body.Verify != nil:
+		response.Kind, response.Code, response.Description = ResponseVerify, body.Verify.VerifyStatus.Code, body.Verify.VerifyStatus.Description
+	case body.Error != nil:
+		response.Kind, response.Code = ResponseError, body.Error.Code
+		response.DescriptionCode, response.Description = body.Error.DescriptionCode, body.Error.Description
+	default:
+		return nil, fmt.Errorf("SCRIPT response has no Status, Verify or Error")
+	}
+	return response, nil
+}
+
+func addressOf(a ScriptAddress) *scriptAddress {
+	if a == (ScriptAddress{}) {
+		return nil
+	}
+	return &scriptAddress{
+		AddressLine1:  a.Line1,
+		AddressLine2:  a.Line2,
+		City:          a.City,
+		StateProvince: a.State,
+		PostalCode:    a.Zip,
+		CountryCode:   "US",
+	}
+}
+
+func numbersOf(phone, fax string) *scriptCommunicationNumbers {
+	phone, fax = digits(phone), digits(fax)
+	if phone == "" {
+		return nil
+	}
+	numbers := &scriptCommunicationNumbers{PrimaryTelephone: scriptNumber{Number: phone}}
+	if fax != "" {
+		numbers.Fax = &scriptNumber{Number: fax}
+	}
+	return numbers
+}
+
+// digits keeps the digits of a phone number, as SCRIPT carries them without punctuation
+func digits(number string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, number)
+}
+
+func daysSupply(days int) string {
+	if days <= 0 {
+		return ""
+	}
+	return strconv.Itoa(days)
+}
+
+// The XML elements of the SCRIPT messages, limited to those exchanged with IRIS
+
+type scriptMessage struct {
+	XMLName            xml.Name     `xml:"Message"`
+	Xmlns              string       `xml:"xmlns,attr,omitempty"`
+	DatatypesVersion   string       `xml:"DatatypesVersion,attr,omitempty"`
+	TransportVersion   string       `xml:"TransportVersion,attr,omitempty"`
+	TransactionDomain  string       `xml:"TransactionDomain,attr,omitempty"`
+	TransactionVersion string       `xml:"TransactionVersion,attr,omitempty"`
+	StructuresVersion  string       `xml:"StructuresVersion,attr,omitempty"`
+	ECLVersion         string       `xml:"ECLVersion,attr,omitempty"`
+	Header             scriptHeader `xml:"Header"`
+	Body               scriptBody   `xml:"Body"`
+}
+
+type scriptHeader struct {
+	To                    scriptQualified `xml:"To"`
+	From                  scriptQualified `xml:"From"`
+	MessageID             string          `xml:"MessageID"`
+	RelatesToMessageID    string          `xml:"RelatesToMessageID,omitempty"`
+	SentTime              string          `xml:"SentTime"`
+	PrescriberOrderNumber string          `xml:"PrescriberOrderNumber,omitempty"`
+}
+
+type scriptQualified struct {
+	Qualifier string `xml:"Qualifier,attr"`
+	Value     string `xml:",chardata"`
+}
+
+type scriptBody struct {
+	NewRx  *scriptNewRx  `xml:"NewRx,omitempty"`
+	Status *scriptStatus `xml:"Status,omitempty"`
+	Verify *scriptVerify `xml:"Verify,omitempty"`
+	Error  *scriptError  `xml:"Error,omitempty"`
+}
+
+type scriptNewRx struct {
+	Patient              scriptPatient    `xml:"Patient"`
+	Pharmacy             scriptPharmacy   `xml:"Pharmacy"`
+	Prescriber           scriptPrescriber `xml:"Prescriber"`
+	MedicationPrescribed scriptMedication `xml:"MedicationPrescribed"`
+}
+
+type scriptPatient struct {
+	HumanPatient scriptHumanPatient `xml:"HumanPatient"`
+}
+
+type scriptHumanPatient struct {
+	Name                 scriptName                  `xml:"Name"`
+	Gender               string                      `xml:"Gender"`
+	DateOfBirth          scriptDate                  `xml:"DateOfBirth"`
+	Address              *scriptAddress              `xml:"Address,omitempty"`
+	CommunicationNumbers *scriptCommunicationNumbers `xml:"CommunicationNumbers,omitempty"`
+}
+
+type scriptPharmacy struct {
+	Identification       scriptIdentification        `xml:"Identification"`
+	BusinessName         string                      `xml:"BusinessName"`
+	Address              *scriptAddress              `xml:"Address,omitempty"`
+	CommunicationNumbers *scriptCommunicationNumbers `xml:"CommunicationNumbers,omitempty"`
+}
+
+type scriptPrescriber struct {
+	NonVeterinarian scriptNonVeterinarian `xml:"NonVeterinarian"`
+}
+
+type scriptNonVeterinarian struct {
+	Identification       scriptIdentification        `xml:"Identification"`
+	Name                 scriptName                  `xml:"Name"`
+	CommunicationNumbers *scriptCommunicationNumbers `xml:"CommunicationNumbers,omitempty"`
+}
+
+type scriptIdentification struct {
+	NCPDPID string `xml:"NCPDPID,omitempty"`
+	NPI     string `xml:"NPI,omitempty"`
+}
+
+type scriptName struct {
+	LastName  string `xml:"LastName"`
+	FirstName string `xml:"FirstName,omitempty"`
+}
+
+type scriptDate struct {
+	Date string `xml:"Date"`
+}
+
+type scriptAddress struct {
+	AddressLine1  string `xml:"AddressLine1,omitempty"`
+	AddressLine2  string `xml:"AddressLine2,omitempty"`
+	City          string `xml:"City,omitempty"`
+	StateProvince string `xml:"StateProvince,omitempty"`
+	PostalCode    string `xml:"PostalCode,omitempty"`
+	CountryCode   string `xml:"CountryCode"`
+}
+
+type scriptCommunicationNumbers struct {
+	PrimaryTelephone scriptNumber  `xml:"PrimaryTelephone"`
+	Fax              *scriptNumber `xml:"Fax,omitempty"`
+}
+
+type scriptNumber struct {
+	Number string `xml:"Number"`
+}
+
+type scriptMedication struct {
+	DrugDescription string         `xml:"DrugDescription"`
+	Quantity        scriptQuantity `xml:"Quantity"`
+	DaysSupply      string         `xml:"DaysSupply,omitempty"`
+	WrittenDate     scriptDate     `xml:"WrittenDate"`
+	Substitutions   string         `xml:"Substitutions"`
+	NumberOfRefills string         `xml:"NumberOfRefills"`
+	Sig             scriptSig      `xml:"Sig"`
+}
+
+type scriptQuantity struct {
+	Value             string     `xml:"Value"`
+	CodeListQualifier string     `xml:"CodeListQualifier"`
+	UnitOfMeasure     scriptCode `xml:"QuantityUnitOfMeasure"`
+}
+
+type scriptCode struct {
+	Code string `xml:"Code"`
+}
+
+type scriptSig struct {
+	SigText string `xml:"SigText"`
+}
+
+type scriptStatus struct {
+	Code        string `xml:"Code"`
+	Description string `xml:"Description,omitempty"`
+}
+
+type scriptVerify struct {
+	VerifyStatus scriptStatus `xml:"VerifyStatus"`
+}
+
+type scriptError struct {
+	Code            string `xml:"Code"`
+	DescriptionCode string `xml:"DescriptionCode,omitempty"`
+	Description     string `xml:"Description,omitempty"`
+}
@@ -0,0 +1,25 @@
+package notifications
+
+import "context"
+
+// ChannelSender sends each notification through the provider of its channel
+type ChannelSender struct {
+	senders map[string]NotificationSender
+}
+
+// NewChannelSender routes notifications by channel; channels without a sender are not supported
+func NewChannelSender(senders map[string]NotificationSender) *ChannelSender {
+	return &ChannelSender{senders: senders}
+}
+
+// Send sends the notification through the sender of its channel
+func (s *ChannelSender) Send(ctx context.Context, notification Notification) (*SendResult, error) {
+	sender, ok := s.senders[notification.Channel]
+	if !ok {
+		return nil, ErrUnsupportedChannel
+	}
+	return sender.Send(ctx, notification)
+}
+
+// Verify ChannelSender implements NotificationSender
+var _ NotificationSender = (*ChannelSender)(nil)
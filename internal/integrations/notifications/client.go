@@ -0,0 +1,14 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+)
+
+// NotificationSender delivers notifications to patients over SMS or email
+type NotificationSender interface {
+	Send(ctx context.Context, notification Notification) (*SendResult, error)
+}
+
+// ErrUnsupportedChannel is returned for a channel without a configured provider
+var ErrUnsupportedChannel = errors.New("notification channel is not configured")
@@ -0,0 +1,45 @@
+package notifications
+
+import (
+	"net"
+	"strconv"
+)
+
+// Config holds the configuration of the SMS and email providers
+type Config struct {
+	// API Endpoints (full URLs from YAML config)
+	SendSMSURL string
+
+	// SMSFrom is the sender number or ID of text messages; empty uses the provider's default
+	SMSFrom string
+	SMTP    SMTPConfig
+}
+
+// SMTPConfig is the mail server emails are sent through
+type SMTPConfig struct {
+	Host string
+	Port int
+	// Username and Password authenticate with PLAIN, which is only used over TLS or to localhost;
+	// an empty username sends without authentication
+	Username string
+	Password string
+	From     string
+}
+
+// Addr returns the host:port of the mail server
+func (c SMTPConfig) Addr() string {
+	return net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
+}
+
+// EndpointsConfig defines the interface for notification endpoints configuration
+type EndpointsConfig interface {
+	SendSMSEndpoint() string
+}
+
+// Verify Config implements EndpointsConfig
+var _ EndpointsConfig = (*Config)(nil)
+
+// SendSMSEndpoint returns the full URL for sending a text message
+func (c *Config) SendSMSEndpoint() string {
+	return c.SendSMSURL
+}
@@ -0,0 +1,36 @@
+package notifications
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// MockClient implements NotificationSender by logging notifications instead of sending them
+type MockClient struct {
+	logger *zap.Logger
+}
+
+// NewMockClient creates a new mock notification sender
+func NewMockClient(logger *zap.Logger) *MockClient {
+	return &MockClient{logger: logger}
+}
+
+// Send accepts SMS and email notifications; the recipient and body are not logged, as they are PHI
+func (c *MockClient) Send(ctx context.Context, notification Notification) (*SendResult, error) {
+	if notification.Channel != ChannelSMS && notification.Channel != ChannelEmail {
+		return nil, ErrUnsupportedChannel
+	}
+
+	result := &SendResult{Provider: "mock", MessageID: "mock-" + uuid.NewString()}
+	c.logger.Info("mock notification sent",
+		zap.String("channel", notification.Channel),
+		zap.String("message_id", result.MessageID),
+		zap.Int("body_length", len(notification.Body)),
+	)
+	return result, nil
+}
+
+// Verify MockClient implements NotificationSender
+var _ NotificationSender = (*MockClient)(nil)
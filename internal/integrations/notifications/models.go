@@ -0,0 +1,35 @@
+package notifications
+
+// Channels notifications are sent over
+const (
+	ChannelSMS   = "sms"
+	ChannelEmail = "email"
+)
+
+// Notification is a message to one recipient. To is a phone number for SMS and an email address
+// for email; Subject is only sent with emails.
+type Notification struct {
+	Channel string
+	To      string
+	Subject string
+	Body    string
+}
+
+// SendResult identifies a notification at the provider that accepted it
+type SendResult struct {
+	Provider  string `json:"provider"`
+	MessageID string `json:"message_id"`
+}
+
+// SendSMSRequest is a text message for the SMS provider
+type SendSMSRequest struct {
+	From string `json:"from,omitempty"`
+	To   string `json:"to"`
+	Body string `json:"body"`
+}
+
+// SendSMSResponse is the SMS provider's receipt of a text message
+type SendSMSResponse struct {
+	MessageID string `json:"message_id"`
+	Status    string `json:"status"`
+}
@@ -0,0 +1,70 @@
+package notifications
+
+import (
+	"time"
+
+	"pharmacy-modernization-project-model/internal/platform/httpclient"
+
+	"go.uber.org/zap"
+)
+
+// ModuleDependencies holds all dependencies required to initialize the notifications module
+type ModuleDependencies struct {
+	Config     Config
+	Logger     *zap.Logger
+	HTTPClient *httpclient.Client
+	UseMock    bool
+	Timeout    time.Duration // Per-request timeout for the SMS provider and the mail server
+	// SlowRequestThreshold logs SMS provider calls taking at least this long (0 uses the client default)
+	SlowRequestThreshold time.Duration
+}
+
+// ModuleExport contains the exported services from the notifications module
+type ModuleExport struct {
+	NotificationSender NotificationSender
+}
+
+// Module initializes and returns the notifications module with its dependencies. A channel
+// without a configured provider is left out, and notifications over it fail.
+func Module(deps ModuleDependencies) ModuleExport {
+	// Use mock client if configured
+	if deps.UseMock {
+		deps.Logger.Info("initializing mock notification sender")
+		return ModuleExport{
+			NotificationSender: NewMockClient(deps.Logger),
+		}
+	}
+
+	senders := map[string]NotificationSender{}
+	if deps.Config.SendSMSURL != "" {
+		// Create HTTP client if not provided (fallback for tests/edge cases)
+		if deps.HTTPClient == nil {
+			deps.Logger.Warn("no shared http client provided, creating dedicated client for notifications service")
+			deps.HTTPClient = httpclient.NewClient(
+				httpclient.Config{
+					Timeout:     deps.Timeout,
+					ServiceName: "notifications",
+				},
+				deps.Logger,
+			)
+		}
+		senders[ChannelSMS] = NewSMSClient(deps.Config, deps.HTTPClient, deps.Logger,
+			httpclient.WithService("notifications"),
+			httpclient.WithTimeout(deps.Timeout),
+			httpclient.WithSlowThreshold(deps.SlowRequestThreshold),
+		)
+	} else {
+		deps.Logger.Warn("no SMS endpoint configured, text messages cannot be sent")
+	}
+	if deps.Config.SMTP.Host != "" {
+		senders[ChannelEmail] = NewSMTPClient(deps.Config.SMTP, deps.Timeout, deps.Logger)
+	} else {
+		deps.Logger.Warn("no SMTP host configured, emails cannot be sent")
+	}
+
+	deps.Logger.Info("initializing notification senders",
+		zap.String("send_sms_url", deps.Config.SendSMSURL),
+		zap.String("smtp_addr", deps.Config.SMTP.Addr()),
+	)
+	return ModuleExport{NotificationSender: NewChannelSender(senders)}
+}
@@ -0,0 +1,55 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"pharmacy-modernization-project-model/internal/platform/httpclient"
+
+	"go.uber.org/zap"
+)
+
+// SMSClient implements NotificationSender for text messages through the SMS provider's HTTP API
+type SMSClient struct {
+	client    *httpclient.Client
+	endpoints EndpointsConfig
+	from      string
+	logger    *zap.Logger
+	opts      []httpclient.RequestOption // Timeout and slow-request threshold for every call
+}
+
+// NewSMSClient creates a new HTTP-based SMS client
+func NewSMSClient(cfg Config, client *httpclient.Client, logger *zap.Logger, opts ...httpclient.RequestOption) *SMSClient {
+	return &SMSClient{
+		client:    client,
+		endpoints: &cfg,
+		from:      cfg.SMSFrom,
+		logger:    logger,
+		opts:      opts,
+	}
+}
+
+// Send sends a text message; only the SMS channel is supported
+func (c *SMSClient) Send(ctx context.Context, notification Notification) (*SendResult, error) {
+	if notification.Channel != ChannelSMS {
+		return nil, ErrUnsupportedChannel
+	}
+
+	var response SendSMSResponse
+	request := SendSMSRequest{From: c.from, To: notification.To, Body: notification.Body}
+	opts := append(slices.Clone(c.opts), httpclient.WithEndpoint("send_sms"))
+	if err := c.client.PostJSON(ctx, c.endpoints.SendSMSEndpoint(), request, &response, opts...); err != nil {
+		return nil, fmt.Errorf("failed to send text message: %w", err)
+	}
+
+	c.logger.Debug("text message sent",
+		zap.String("message_id", response.MessageID),
+		zap.String("status", response.Status),
+	)
+
+	return &SendResult{Provider: "sms", MessageID: response.MessageID}, nil
+}
+
+// Verify SMSClient implements NotificationSender
+var _ NotificationSender = (*SMSClient)(nil)
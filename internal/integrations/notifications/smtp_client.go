@@ -0,0 +1,123 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// SMTPClient implements NotificationSender for emails through a mail server
+type SMTPClient struct {
+	cfg     SMTPConfig
+	timeout time.Duration
+	logger  *zap.Logger
+}
+
+// NewSMTPClient creates an email client for the mail server; timeout bounds each email when the
+// caller's context has no earlier deadline
+func NewSMTPClient(cfg SMTPConfig, timeout time.Duration, logger *zap.Logger) *SMTPClient {
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	return &SMTPClient{cfg: cfg, timeout: timeout, logger: logger}
+}
+
+// Send emails a plain text message; only the email channel is supported
+func (c *SMTPClient) Send(ctx context.Context, notification Notification) (*SendResult, error) {
+	if notification.Channel != ChannelEmail {
+		return nil, ErrUnsupportedChannel
+	}
+	// A line break in an address would start a new header
+	if strings.ContainsAny(notification.To, "\r\n") {
+		return nil, fmt.Errorf("invalid email address %q", notification.To)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	messageID := fmt.Sprintf("<%s@%s>", uuid.NewString(), c.cfg.Host)
+	if err := c.send(ctx, notification, messageID); err != nil {
+		return nil, fmt.Errorf("failed to send email: %w", err)
+	}
+
+	c.logger.Debug("email sent", zap.String("message_id", messageID))
+	return &SendResult{Provider: "smtp", MessageID: messageID}, nil
+}
+
+func (c *SMTPClient) send(ctx context.Context, notification Notification, messageID string) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", c.cfg.Addr())
+	if err != nil {
+		return err
+	}
+	// net/smtp has no context, so the deadline bounds the whole conversation
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, c.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: c.cfg.Host}); err != nil {
+			return err
+		}
+	}
+	if c.cfg.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", c.cfg.Username, c.cfg.Password, c.cfg.Host)); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(c.cfg.From); err != nil {
+		return err
+	}
+	if err := client.Rcpt(notification.To); err != nil {
+		return err
+	}
+
+	body, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := body.Write(c.message(notification, messageID)); err != nil {
+		return err
+	}
+	if err := body.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// message renders the headers and body of the email
+func (c *SMTPClient) message(notification Notification, messageID string) []byte {
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", c.cfg.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", notification.To)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", notification.Subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&msg, "Message-ID: %s\r\n", messageID)
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	// The data writer ends lines with CRLF and escapes leading dots
+	msg.WriteString(notification.Body)
+	msg.WriteString("\n")
+	return msg.Bytes()
+}
+
+// Verify SMTPClient implements NotificationSender
+var _ NotificationSender = (*SMTPClient)(nil)
@@ -4,9 +4,12 @@ import (
 	"context"
 
 	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/httpclient"
 )
 
-// TokenProviderAdapter adapts the Stargate TokenClient to httpclient.TokenProvider interface
+// TokenProviderAdapter adapts the Stargate TokenClient to the httpclient.TokenProvider and
+// httpclient.TokenSource interfaces
 // This allows Stargate to be used as a token provider for other HTTP clients
 type TokenProviderAdapter struct {
 	client TokenClient
@@ -38,3 +41,36 @@ func (a *TokenProviderAdapter) GetToken(ctx context.Context) (string, error) {
 
 	return tokenResp.AccessToken, nil
 }
+
+// FetchToken implements the httpclient.TokenSource interface
+func (a *TokenProviderAdapter) FetchToken(ctx context.Context) (httpclient.Token, error) {
+	tokenResp, err := a.client.GetAccessToken(ctx)
+	if err != nil {
+		return httpclient.Token{}, err
+	}
+	return toToken(tokenResp), nil
+}
+
+// RefreshToken implements the httpclient.TokenSource interface
+func (a *TokenProviderAdapter) RefreshToken(ctx context.Context, refreshToken string) (httpclient.Token, error) {
+	tokenResp, err := a.client.RefreshToken(ctx, refreshToken)
+	if err != nil {
+		return httpclient.Token{}, err
+	}
+	return toToken(tokenResp), nil
+}
+
+func toToken(resp *TokenResponse) httpclient.Token {
+	return httpclient.Token{
+		AccessToken:  resp.AccessToken,
+		TokenType:    resp.TokenType,
+		RefreshToken: resp.RefreshToken,
+		ExpiresAt:    resp.ExpiresAt(),
+	}
+}
+
+// Verify TokenProviderAdapter implements the httpclient token interfaces
+var (
+	_ httpclient.TokenProvider = (*TokenProviderAdapter)(nil)
+	_ httpclient.TokenSource   = (*TokenProviderAdapter)(nil)
+)
@@ -0,0 +1,76 @@
+package integrations
+
+import (
+	"reflect"
+
+	"pharmacy-modernization-project-model/internal/platform/httpclient"
+)
+
+// Endpoint is one configured URL of an integration
+type Endpoint struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// IntegrationStatus is the runtime view of one external integration: whether it is mocked, where
+// it sends requests and, for real clients, how its calls have gone since startup
+type IntegrationStatus struct {
+	Name      string     `json:"name"` // The section under external in app.yaml
+	Mocked    bool       `json:"mocked"`
+	Disabled  bool       `json:"disabled,omitempty"` // Not called at all, e.g. Stargate without tokens
+	Endpoints []Endpoint `json:"endpoints"`
+	// ServiceHealth holds the last successful call and the circuit state; nil while mocked or disabled
+	*httpclient.ServiceHealth
+}
+
+// Status reports the integrations the application was started with
+type Status struct {
+	env          string
+	integrations []integration
+}
+
+type integration struct {
+	name      string
+	service   string // Service name of the calls in logs and metrics
+	mocked    bool
+	disabled  bool
+	endpoints []Endpoint
+	client    *httpclient.Client
+}
+
+// Environment is the app.env the integrations were configured for
+func (s *Status) Environment() string {
+	return s.env
+}
+
+// Integrations returns the current status of every integration, in configuration order
+func (s *Status) Integrations() []IntegrationStatus {
+	statuses := make([]IntegrationStatus, 0, len(s.integrations))
+	for _, i := range s.integrations {
+		status := IntegrationStatus{Name: i.name, Mocked: i.mocked, Disabled: i.disabled, Endpoints: i.endpoints}
+		if !i.mocked && !i.disabled && i.client != nil {
+			health := i.client.Health(i.service)
+			status.ServiceHealth = &health
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+func (s *Status) add(i integration) {
+	s.integrations = append(s.integrations, i)
+}
+
+// endpointsOf lists the URL fields of an endpoints config struct under their app.yaml names
+func endpointsOf(cfg any) []Endpoint {
+	v := reflect.ValueOf(cfg)
+	endpoints := make([]Endpoint, 0, v.NumField())
+	for i := 0; i < v.NumField(); i++ {
+		name := v.Type().Field(i).Tag.Get("mapstructure")
+		if name == "" {
+			name = v.Type().Field(i).Name
+		}
+		endpoints = append(endpoints, Endpoint{Name: name, URL: v.Field(i).String()})
+	}
+	return endpoints
+}
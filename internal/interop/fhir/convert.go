@@ -0,0 +1,232 @@
+package fhir
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	patientmodel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	prescriptionmodel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/redaction"
+)
+
+// medicationRequestStatus maps prescription statuses to the FHIR medicationrequest-status codes
+var medicationRequestStatus = map[prescriptionmodel.Status]string{
+	prescriptionmodel.Draft:     "draft",
+	prescriptionmodel.Active:    "active",
+	prescriptionmodel.Paused:    "on-hold",
+	prescriptionmodel.Completed: "completed",
+	prescriptionmodel.Expired:   "stopped",
+}
+
+// routeCodes are the SNOMED CT codes of the administration routes, as used by FHIR Dosage.route
+var routeCodes = map[prescriptionmodel.Route]Coding{
+	prescriptionmodel.RouteOral:          {Code: "26643006", Display: "Oral route"},
+	prescriptionmodel.RouteSublingual:    {Code: "37839007", Display: "Sublingual route"},
+	prescriptionmodel.RouteTopical:       {Code: "6064005", Display: "Topical route"},
+	prescriptionmodel.RouteTransdermal:   {Code: "45890007", Display: "Transdermal route"},
+	prescriptionmodel.RouteInhaled:       {Code: "447694001", Display: "Respiratory tract route"},
+	prescriptionmodel.RouteNasal:         {Code: "46713006", Display: "Nasal route"},
+	prescriptionmodel.RouteOphthalmic:    {Code: "54485002", Display: "Ophthalmic route"},
+	prescriptionmodel.RouteOtic:          {Code: "10547007", Display: "Otic route"},
+	prescriptionmodel.RouteRectal:        {Code: "37161004", Display: "Rectal route"},
+	prescriptionmodel.RouteSubcutaneous:  {Code: "34206005", Display: "Subcutaneous route"},
+	prescriptionmodel.RouteIntramuscular: {Code: "78421000", Display: "Intramuscular route"},
+}
+
+// ucumUnits are the UCUM codes of the dose units that have one; dosage forms such as tablets do not
+var ucumUnits = map[prescriptionmodel.StrengthUnit]string{
+	prescriptionmodel.StrengthMilligram:  "mg",
+	prescriptionmodel.StrengthMicrogram:  "ug",
+	prescriptionmodel.StrengthGram:       "g",
+	prescriptionmodel.StrengthMilliliter: "mL",
+	prescriptionmodel.StrengthUnitUnit:   "[U]",
+	prescriptionmodel.StrengthIU:         "[iU]",
+	prescriptionmodel.StrengthMilliequiv: "meq",
+	prescriptionmodel.StrengthPercent:    "%",
+}
+
+// Converter turns domain records into FHIR resources
+type Converter struct {
+	// IdentifierSystem namespaces the business identifiers of the resources, e.g. "urn:rx"; the
+	// patient ID is published under IdentifierSystem+"/patient"
+	IdentifierSystem string
+}
+
+// Patient converts a patient with their addresses; addresses may be nil. The response redaction
+// only knows the patient fields by their own names, so the elements holding a field the caller's
+// profile hides (name, dob, phone, line1, line2, zip) are left out here; FHIR has no null values.
+func (c Converter) Patient(ctx context.Context, p patientmodel.Patient, addresses []patientmodel.Address) Patient {
+	// shown returns the value unless the caller may not see the field
+	shown := func(field, value string) string {
+		if redaction.FieldRedacted(ctx, field) {
+			return ""
+		}
+		return value
+	}
+
+	resource := Patient{
+		ResourceType: "Patient",
+		ID:           p.ID,
+		Meta:         meta(profilePatient, p.CreatedAt, p.EditTime),
+		Identifier:   []Identifier{{Use: "usual", System: c.IdentifierSystem + "/patient", Value: p.ID}},
+		Active:       true,
+		BirthDate:    shown("dob", p.DOB.String()),
+	}
+	if name := shown("name", p.Name); name != "" {
+		resource.Name = []HumanName{humanName(name)}
+	}
+	if phone := shown("phone", p.Phone); phone != "" {
+		resource.Telecom = []ContactPoint{{System: "phone", Value: phone}}
+	}
+	for _, a := range addresses {
+		resource.Address = append(resource.Address, Address{
+			Use:        "home",
+			Line:       nonEmpty(shown("line1", a.Line1), shown("line2", a.Line2)),
+			City:       a.City,
+			State:      a.State,
+			PostalCode: shown("zip", a.Zip),
+			Country:    "US",
+		})
+	}
+	if len(resource.Address) == 0 && p.State != "" {
+		resource.Address = []Address{{State: p.State, Country: "US"}}
+	}
+	return resource
+}
+
+// MedicationRequest converts a prescription; prescriber may be nil when none is recorded or it
+// could not be loaded, in which case only the reference is given
+func (c Converter) MedicationRequest(p prescriptionmodel.Prescription, prescriber *prescriptionmodel.Prescriber) MedicationRequest {
+	updated := p.FulfillmentUpdatedAt
+	if p.Pharmacy != nil && (updated == nil || p.Pharmacy.RoutedAt.After(*updated)) {
+		updated = &p.Pharmacy.RoutedAt
+	}
+	resource := MedicationRequest{
+		ResourceType: "MedicationRequest",
+		ID:           p.ID,
+		Meta:         meta(profileMedicationRequest, p.CreatedAt, updated),
+		Identifier:   []Identifier{{Use: "official", System: c.IdentifierSystem + "/prescription", Value: p.ID}},
+		Status:       statusOf(p.Status),
+		Intent:       "order",
+		MedicationCodeableConcept: CodeableConcept{
+			Text: p.Drug,
+		},
+		Subject:    Reference{Reference: "Patient/" + p.PatientID},
+		AuthoredOn: instant(p.CreatedAt),
+	}
+	if p.Status == prescriptionmodel.Expired {
+		resource.StatusReason = &CodeableConcept{Text: "Expired before it was completed"}
+	}
+	if p.PrescriberID != "" {
+		resource.Requester = &Reference{Reference: "Practitioner/" + p.PrescriberID}
+		if prescriber != nil {
+			resource.Requester.Display = prescriber.DisplayName()
+			resource.Requester.Identifier = &Identifier{System: systemNPI, Value: prescriber.NPI}
+		}
+	}
+	if dosage, ok := dosageOf(p); ok {
+		resource.DosageInstruction = []Dosage{dosage}
+	}
+	if dispense := dispenseRequestOf(p); dispense != nil {
+		resource.DispenseRequest = dispense
+	}
+	return resource
+}
+
+func dosageOf(p prescriptionmodel.Prescription) (Dosage, bool) {
+	var dosage Dosage
+	dosage.Text = p.Sig.Render(prescriptionmodel.LanguageEnglish)
+	if dosage.Text == "" {
+		dosage.Text = p.Dose
+	}
+	if p.Sig.AsNeeded {
+		asNeeded := true
+		dosage.AsNeeded = &asNeeded
+	}
+
+	route := p.Sig.Route
+	if p.Dosage != nil {
+		if route == "" {
+			route = p.Dosage.Route
+		}
+		quantity := &Quantity{Value: p.Dosage.Value, Unit: string(p.Dosage.Unit)}
+		if code, ok := ucumUnits[p.Dosage.Unit]; ok {
+			quantity.System, quantity.Code = systemUCUM, code
+		}
+		dosage.DoseAndRate = []DoseAndRate{{DoseQuantity: quantity}}
+	}
+	if coding, ok := routeCodes[route]; ok {
+		coding.System = systemSNOMED
+		dosage.Route = &CodeableConcept{Coding: []Coding{coding}, Text: string(route)}
+	}
+
+	ok := dosage.Text != "" || dosage.Route != nil || dosage.DoseAndRate != nil
+	return dosage, ok
+}
+
+func dispenseRequestOf(p prescriptionmodel.Prescription) *DispenseRequest {
+	var dispense DispenseRequest
+	if p.Quantity > 0 {
+		dispense.Quantity = &Quantity{Value: float64(p.Quantity)}
+	}
+	if p.DaysSupply > 0 {
+		dispense.ExpectedSupplyDuration = &Quantity{Value: float64(p.DaysSupply), Unit: "days", System: systemUCUM, Code: "d"}
+	}
+	if p.Pharmacy != nil {
+		dispense.Performer = &Reference{Display: p.Pharmacy.Name}
+	}
+	if dispense == (DispenseRequest{}) {
+		return nil
+	}
+	return &dispense
+}
+
+func statusOf(status prescriptionmodel.Status) string {
+	if code, ok := medicationRequestStatus[status]; ok {
+		return code
+	}
+	return "unknown"
+}
+
+// humanName splits a full name at its last space, as the domain keeps names in one field
+func humanName(name string) HumanName {
+	name = strings.TrimSpace(name)
+	hn := HumanName{Use: "official", Text: name}
+	if i := strings.LastIndex(name, " "); i > 0 {
+		hn.Family = name[i+1:]
+		hn.Given = strings.Fields(name[:i])
+	} else {
+		hn.Family = name
+	}
+	return hn
+}
+
+func meta(profile string, created time.Time, updated *time.Time) *Meta {
+	last := created
+	if updated != nil && updated.After(last) {
+		last = *updated
+	}
+	m := &Meta{Profile: []string{profile}}
+	if !last.IsZero() {
+		m.LastUpdated = instant(last)
+	}
+	return m
+}
+
+func instant(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func nonEmpty(values ...string) []string {
+	var out []string
+	for _, v := range values {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,83 @@
+package fhir
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	patientmodel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/dates"
+	"pharmacy-modernization-project-model/internal/platform/redaction"
+)
+
+// servePatient converts the patient for the user behind the response redaction, as /fhir/Patient does
+func servePatient(t *testing.T, user *auth.User, p patientmodel.Patient, addresses []patientmodel.Address) Patient {
+	t.Helper()
+	redactor, err := redaction.New([]redaction.Profile{
+		{Name: "internal"},
+		{Name: "reporting", Scopes: []string{"reporting"},
+			Fields: []string{"name", "dob", "phone", "phone_masked", "line1", "line2", "zip", "matches", "edit_by", "recorded_by"}},
+	}, "internal")
+	if err != nil {
+		t.Fatalf("redaction.New: %v", err)
+	}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r = r.WithContext(auth.SetUser(r.Context(), user))
+		writeResource(w, http.StatusOK, Converter{IdentifierSystem: "urn:rx"}.Patient(r.Context(), p, addresses))
+	})
+
+	rec := httptest.NewRecorder()
+	redaction.Middleware(redactor, zap.NewNop())(handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/fhir/Patient/"+p.ID, nil))
+	var resource Patient
+	if err := json.Unmarshal(rec.Body.Bytes(), &resource); err != nil {
+		t.Fatalf("response is not a Patient: %v\n%s", err, rec.Body.String())
+	}
+	return resource
+}
+
+func TestPatientRedaction(t *testing.T) {
+	p := patientmodel.Patient{
+		ID:        "P001",
+		Name:      "Ava Thompson",
+		DOB:       dates.Full(1988, time.January, 12),
+		Phone:     "(206) 417-8842",
+		State:     "WA",
+		CreatedAt: time.Date(2024, time.May, 1, 10, 0, 0, 0, time.UTC),
+	}
+	addresses := []patientmodel.Address{{ID: "A1", Line1: "1 Main St", Line2: "Apt 4", City: "Seattle", State: "WA", Zip: "98101"}}
+
+	t.Run("internal", func(t *testing.T) {
+		got := servePatient(t, &auth.User{ID: "doctor"}, p, addresses)
+		if got.BirthDate != "1988-01-12" || len(got.Telecom) != 1 || len(got.Name) != 1 {
+			t.Errorf("demographics missing: %+v", got)
+		}
+		want := []Address{{Use: "home", Line: []string{"1 Main St", "Apt 4"}, City: "Seattle", State: "WA", PostalCode: "98101", Country: "US"}}
+		if !reflect.DeepEqual(got.Address, want) {
+			t.Errorf("address = %+v, want %+v", got.Address, want)
+		}
+	})
+
+	t.Run("reporting", func(t *testing.T) {
+		got := servePatient(t, &auth.User{ID: "r1", Scopes: []string{"reporting"}}, p, addresses)
+		if got.Name != nil || got.BirthDate != "" || got.Telecom != nil {
+			t.Errorf("name, birthDate and telecom must be left out: %+v", got)
+		}
+		want := []Address{{Use: "home", City: "Seattle", State: "WA", Country: "US"}}
+		if !reflect.DeepEqual(got.Address, want) {
+			t.Errorf("address = %+v, want %+v", got.Address, want)
+		}
+		body, _ := json.Marshal(got)
+		for _, phi := range []string{"Thompson", "1988", "417-8842", "Main St", "98101"} {
+			if strings.Contains(string(body), phi) {
+				t.Errorf("response holds %q: %s", phi, body)
+			}
+		}
+	})
+}
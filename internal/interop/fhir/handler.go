@@ -0,0 +1,403 @@
+package fhir
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	patientmodel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	patientrequest "pharmacy-modernization-project-model/domain/patient/contracts/request"
+	patientsecurity "pharmacy-modernization-project-model/domain/patient/security"
+	patientservice "pharmacy-modernization-project-model/domain/patient/service"
+	prescriptionmodel "pharmacy-modernization-project-model/domain/prescription/contracts/model"
+	prescriptionsecurity "pharmacy-modernization-project-model/domain/prescription/security"
+	prescriptionservice "pharmacy-modernization-project-model/domain/prescription/service"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// BasePath is where the FHIR endpoints are served
+const BasePath = "/fhir"
+
+const (
+	defaultCount = 20
+	maxCount     = 100
+
+	maxResourceBytes = 1 << 20 // Largest resource accepted from a client
+)
+
+type Dependencies struct {
+	PatientService      patientservice.PatientService
+	AddressService      patientservice.AddressService
+	PrescriptionService prescriptionservice.PrescriptionService
+	PrescriberService   prescriptionservice.PrescriberService
+	IdentifierSystem    string // Namespace of the resources' business identifiers, e.g. "urn:rx"
+	// InboundIdentifierSystems are the identifier systems, such as an EHR's MRN, whose value is
+	// used as the patient ID of a pushed Patient, after the patient identifiers of IdentifierSystem
+	InboundIdentifierSystems []string
+	Logger                   *zap.Logger
+}
+
+type handler struct {
+	deps    *Dependencies
+	convert Converter
+	log     *zap.Logger
+}
+
+// Mount registers the FHIR endpoints. Reads, searches and pushes need the same permissions as the
+// REST API of the record; the capability statement at /fhir/metadata is public, as FHIR clients read
+// it before they authenticate.
+func Mount(r chi.Router, deps *Dependencies) {
+	h := &handler{deps: deps, convert: Converter{IdentifierSystem: deps.IdentifierSystem}, log: deps.Logger}
+
+	r.Route(BasePath, func(r chi.Router) {
+		r.Get("/metadata", h.metadata)
+
+		r.Group(func(r chi.Router) {
+			r.Use(auth.RequireAuthFromHeader())
+
+			r.With(auth.RequirePermissionsMatchAny(patientsecurity.ReadAccess)).Get("/Patient", h.searchPatients)
+			r.With(auth.RequirePermissionsMatchAny(patientsecurity.ReadAccess)).Get("/Patient/{id}", h.readPatient)
+			r.With(auth.RequirePermissionsMatchAny(patientsecurity.WriteAccess)).Post("/Patient", h.upsertPatient)
+			r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.ReadAccess)).Get("/MedicationRequest", h.searchMedicationRequests)
+			r.With(auth.RequirePermissionsMatchAny(prescriptionsecurity.ReadAccess)).Get("/MedicationRequest/{id}", h.readMedicationRequest)
+		})
+	})
+}
+
+func (h *handler) readPatient(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	patient, err := h.deps.PatientService.GetByID(r.Context(), id)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	addresses, err := h.deps.AddressService.GetByPatientID(r.Context(), id)
+	if err != nil {
+		// The patient is still served, with the state it records
+		h.log.Warn("Failed to load addresses for FHIR Patient", zap.String("patient_id", id), zap.Error(err))
+	}
+	writeResource(w, http.StatusOK, h.convert.Patient(r.Context(), patient, addresses))
+}
+
+// upsertPatient takes the demographics of a Patient pushed by another system. The patient with
+// the ID of its identifier is updated, or created when there is none, so pushing the same
+// resource again is harmless; that is also how a push whose address failed is completed.
+func (h *handler) upsertPatient(w http.ResponseWriter, r *http.Request) {
+	var resource Patient
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxResourceBytes)).Decode(&resource); err != nil {
+		writeOutcome(w, http.StatusBadRequest, "structure", "body is not a FHIR JSON resource")
+		return
+	}
+	idSystems := append([]string{h.deps.IdentifierSystem + "/patient"}, h.deps.InboundIdentifierSystems...)
+	patient, address, issues := h.convert.ToPatient(resource, idSystems)
+	if len(issues) > 0 {
+		writeResource(w, http.StatusBadRequest, OperationOutcome{ResourceType: "OperationOutcome", Issue: issues})
+		return
+	}
+
+	ctx := r.Context()
+	user, _ := auth.GetCurrentUser(ctx)
+	allowed := patientsecurity.AllowedStates(user)
+	if allowed != nil && !slices.Contains(allowed, patient.State) {
+		writeOutcome(w, http.StatusForbidden, "forbidden", "you cannot add patients in "+patient.State)
+		return
+	}
+
+	existing, err := h.deps.PatientService.GetByID(ctx, patient.ID)
+	var notFound platformErrors.RecordNotFoundError
+	created := errors.As(err, &notFound)
+	switch {
+	case created:
+		patient, err = h.deps.PatientService.Create(ctx, patient)
+	case err != nil:
+	case allowed != nil && !slices.Contains(allowed, existing.State):
+		writeOutcome(w, http.StatusForbidden, "forbidden", "you cannot update patients in "+existing.State)
+		return
+	default:
+		existing.Name, existing.DOB, existing.Phone, existing.State = patient.Name, patient.DOB, patient.Phone, patient.State
+		patient = existing
+		err = h.deps.PatientService.Update(ctx, patient)
+	}
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	addresses, err := h.deps.AddressService.GetByPatientID(ctx, patient.ID)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if address != nil {
+		// The patient has one home address; it is replaced rather than added to
+		if len(addresses) > 0 {
+			address.ID = addresses[0].ID
+		}
+		saved, err := h.deps.AddressService.Upsert(ctx, patient.ID, *address)
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		addresses = []patientmodel.Address{saved}
+	}
+
+	h.log.Info("FHIR Patient received", zap.String("patient_id", patient.ID), zap.Bool("created", created))
+	status := http.StatusOK
+	if created {
+		w.Header().Set("Location", baseURL(r)+"/Patient/"+patient.ID)
+		status = http.StatusCreated
+	}
+	writeResource(w, status, h.convert.Patient(r.Context(), patient, addresses))
+}
+
+// searchPatients supports name, birthdate and address-state, paged with _count and _offset
+func (h *handler) searchPatients(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	count, offset, err := paging(params)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	user, _ := auth.GetCurrentUser(r.Context())
+	req := patientrequest.PatientListQueryRequest{
+		Limit:         count,
+		Offset:        offset,
+		PatientName:   params.Get("name"),
+		BirthDate:     params.Get("birthdate"),
+		State:         params.Get("address-state"),
+		AllowedStates: patientsecurity.AllowedStates(user),
+	}
+	if name := req.PatientName; name != "" && len(name) < 3 {
+		writeOutcome(w, http.StatusBadRequest, "invalid", "name must be at least 3 characters")
+		return
+	}
+
+	patients, err := h.deps.PatientService.List(r.Context(), req)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	total, err := h.deps.PatientService.Count(r.Context(), req)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	bundle := newSearchBundle(r, "Patient", count, offset, offset+len(patients) < total)
+	bundle.Total = &total
+	for _, p := range patients {
+		bundle.add(r, "Patient", p.ID, h.convert.Patient(r.Context(), p, nil))
+	}
+	writeResource(w, http.StatusOK, bundle.Bundle)
+}
+
+func (h *handler) readMedicationRequest(w http.ResponseWriter, r *http.Request) {
+	prescription, err := h.deps.PrescriptionService.GetByID(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	writeResource(w, http.StatusOK, h.convert.MedicationRequest(prescription, h.prescriber(r, prescription.PrescriberID)))
+}
+
+// searchMedicationRequests supports patient and status, paged with _count and _offset
+func (h *handler) searchMedicationRequests(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	count, offset, err := paging(params)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	var status prescriptionmodel.Status
+	if code := params.Get("status"); code != "" {
+		var ok bool
+		if status, ok = statusFromCode(code); !ok {
+			writeOutcome(w, http.StatusBadRequest, "invalid", "unsupported status "+strconv.Quote(code))
+			return
+		}
+	}
+
+	// One extra record tells whether another page follows
+	var prescriptions []prescriptionmodel.Prescription
+	if patient := params.Get("patient"); patient != "" {
+		prescriptions, err = h.deps.PrescriptionService.ListForPatient(r.Context(), strings.TrimPrefix(patient, "Patient/"), status, offset+count+1)
+		if err == nil {
+			prescriptions = prescriptions[min(offset, len(prescriptions)):]
+		}
+	} else {
+		prescriptions, err = h.deps.PrescriptionService.List(r.Context(), string(status), count+1, offset)
+	}
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	more := len(prescriptions) > count
+	prescriptions = prescriptions[:min(count, len(prescriptions))]
+	bundle := newSearchBundle(r, "MedicationRequest", count, offset, more)
+	prescribers := map[string]*prescriptionmodel.Prescriber{}
+	for _, p := range prescriptions {
+		prescriber, ok := prescribers[p.PrescriberID]
+		if !ok {
+			prescriber = h.prescriber(r, p.PrescriberID)
+			prescribers[p.PrescriberID] = prescriber
+		}
+		bundle.add(r, "MedicationRequest", p.ID, h.convert.MedicationRequest(p, prescriber))
+	}
+	writeResource(w, http.StatusOK, bundle.Bundle)
+}
+
+func (h *handler) metadata(w http.ResponseWriter, r *http.Request) {
+	read := []CapabilityCode{{Code: "read"}, {Code: "search-type"}}
+	write := []CapabilityCode{{Code: "read"}, {Code: "search-type"}, {Code: "create"}}
+	writeResource(w, http.StatusOK, CapabilityStatement{
+		ResourceType: "CapabilityStatement",
+		Status:       "active",
+		Date:         instant(time.Now()),
+		Kind:         "instance",
+		FHIRVersion:  Version,
+		Format:       []string{"json"},
+		Rest: []CapabilityStatementRest{{
+			Mode: "server",
+			Resource: []CapabilityResource{
+				{
+					Type:        "Patient",
+					Profile:     profilePatient,
+					Interaction: write,
+					SearchParam: []CapabilitySearch{{Name: "name", Type: "string"}, {Name: "birthdate", Type: "date"}, {Name: "address-state", Type: "string"}},
+				},
+				{
+					Type:        "MedicationRequest",
+					Profile:     profileMedicationRequest,
+					Interaction: read,
+					SearchParam: []CapabilitySearch{{Name: "patient", Type: "reference"}, {Name: "status", Type: "token"}},
+				},
+			},
+		}},
+	})
+}
+
+// prescriber loads the prescriber named on a resource; nil when there is none or it cannot be loaded
+func (h *handler) prescriber(r *http.Request, id string) *prescriptionmodel.Prescriber {
+	if id == "" {
+		return nil
+	}
+	prescriber, err := h.deps.PrescriberService.GetByID(r.Context(), id)
+	if err != nil {
+		h.log.Warn("Failed to load prescriber for FHIR MedicationRequest", zap.String("prescriber_id", id), zap.Error(err))
+		return nil
+	}
+	return &prescriber
+}
+
+func (h *handler) writeError(w http.ResponseWriter, err error) {
+	var notFound platformErrors.RecordNotFoundError
+	var invalid platformErrors.ValidationError
+	var duplicate platformErrors.DuplicateRecordError
+	switch {
+	case errors.As(err, &notFound):
+		writeOutcome(w, http.StatusNotFound, "not-found", err.Error())
+	case errors.As(err, &duplicate):
+		// Another patient has the phone number
+		writeOutcome(w, http.StatusConflict, "duplicate", "a patient with this ID or phone number already exists")
+	case errors.As(err, &invalid):
+		writeOutcome(w, http.StatusBadRequest, "invalid", err.Error())
+	default:
+		h.log.Error("FHIR request failed", zap.Error(err))
+		writeOutcome(w, http.StatusInternalServerError, "exception", "internal error")
+	}
+}
+
+func statusFromCode(code string) (prescriptionmodel.Status, bool) {
+	for status, c := range medicationRequestStatus {
+		if c == code {
+			return status, true
+		}
+	}
+	return "", false
+}
+
+// paging reads _count and _offset
+func paging(params url.Values) (count, offset int, err error) {
+	count, offset = defaultCount, 0
+	if v := params.Get("_count"); v != "" {
+		if count, err = strconv.Atoi(v); err != nil || count < 1 || count > maxCount {
+			return 0, 0, platformErrors.NewValidationError("_count", v, "_count must be between 1 and "+strconv.Itoa(maxCount))
+		}
+	}
+	if v := params.Get("_offset"); v != "" {
+		if offset, err = strconv.Atoi(v); err != nil || offset < 0 {
+			return 0, 0, platformErrors.NewValidationError("_offset", v, "_offset must be 0 or more")
+		}
+	}
+	return count, offset, nil
+}
+
+// searchBundle builds a searchset Bundle with absolute URLs derived from the request
+type searchBundle struct {
+	Bundle
+}
+
+func newSearchBundle(r *http.Request, resourceType string, count, offset int, more bool) *searchBundle {
+	b := &searchBundle{Bundle{
+		ResourceType: "Bundle",
+		Meta:         &Meta{LastUpdated: instant(time.Now())},
+		Type:         "searchset",
+		Entry:        []BundleEntry{},
+	}}
+	page := func(offset int) string {
+		query := r.URL.Query()
+		query.Set("_count", strconv.Itoa(count))
+		query.Set("_offset", strconv.Itoa(offset))
+		return baseURL(r) + "/" + resourceType + "?" + query.Encode()
+	}
+	b.Link = append(b.Link, BundleLink{Relation: "self", URL: page(offset)})
+	if more {
+		b.Link = append(b.Link, BundleLink{Relation: "next", URL: page(offset + count)})
+	}
+	if offset > 0 {
+		b.Link = append(b.Link, BundleLink{Relation: "previous", URL: page(max(offset-count, 0))})
+	}
+	return b
+}
+
+func (b *searchBundle) add(r *http.Request, resourceType, id string, resource any) {
+	b.Entry = append(b.Entry, BundleEntry{
+		FullURL:  baseURL(r) + "/" + resourceType + "/" + id,
+		Resource: resource,
+		Search:   &EntrySearch{Mode: "match"},
+	})
+}
+
+// baseURL is the absolute URL of the FHIR endpoints as the client reached them
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host + BasePath
+}
+
+func writeResource(w http.ResponseWriter, status int, resource any) {
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(resource)
+}
+
+func writeOutcome(w http.ResponseWriter, status int, code, diagnostics string) {
+	writeResource(w, status, OperationOutcome{
+		ResourceType: "OperationOutcome",
+		Issue:        []Issue{{Severity: "error", Code: code, Diagnostics: diagnostics}},
+	})
+}
@@ -0,0 +1,144 @@
+package fhir
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	patientmodel "pharmacy-modernization-project-model/domain/patient/contracts/model"
+	"pharmacy-modernization-project-model/internal/platform/dates"
+	"pharmacy-modernization-project-model/internal/validators/validation_logic"
+)
+
+// ToPatient maps a FHIR Patient pushed by another system into a patient and its home address,
+// with the rules of the patient edit form. The patient ID is taken from the identifier of the
+// first system in idSystems the resource carries. Problems are returned as issues, one per element.
+func (c Converter) ToPatient(resource Patient, idSystems []string) (patientmodel.Patient, *patientmodel.Address, []Issue) {
+	var issues []Issue
+	invalid := func(expression string, err error) {
+		var fieldErr *validation_logic.FieldError
+		if errors.As(err, &fieldErr) {
+			issues = append(issues, Issue{Severity: "error", Code: "invalid", Diagnostics: fieldErr.Error(), Expression: []string{expression}})
+		}
+	}
+
+	if resource.ResourceType != "Patient" {
+		return patientmodel.Patient{}, nil, []Issue{{Severity: "error", Code: "structure",
+			Diagnostics: "resourceType must be Patient", Expression: []string{"resourceType"}}}
+	}
+
+	var patient patientmodel.Patient
+	if id, ok := identifierOf(resource.Identifier, idSystems); ok {
+		patient.ID = id
+		invalid("Patient.identifier", validation_logic.ValidateID("identifier", id))
+	} else {
+		issues = append(issues, Issue{Severity: "error", Code: "required",
+			Diagnostics: "an identifier of system " + strings.Join(idSystems, " or ") + " is required",
+			Expression:  []string{"Patient.identifier"}})
+	}
+
+	patient.Name = nameOf(resource.Name)
+	if err := validation_logic.ValidateRequired("name", patient.Name); err != nil {
+		invalid("Patient.name", err)
+	} else {
+		invalid("Patient.name", validation_logic.ValidateLength("name", patient.Name, 2, 100))
+	}
+
+	if err := validation_logic.ValidateDOBValue("birthDate", resource.BirthDate); err != nil {
+		invalid("Patient.birthDate", err)
+	} else {
+		patient.DOB, _ = dates.Parse(resource.BirthDate)
+	}
+
+	patient.Phone = phoneOf(resource.Telecom)
+	invalid("Patient.telecom", validation_logic.ValidatePhone("telecom", patient.Phone))
+
+	address := homeAddressOf(resource.Address)
+	if address != nil {
+		patient.State = address.State
+	}
+	if len(patient.State) != 2 || strings.IndexFunc(patient.State, func(c rune) bool { return c < 'A' || c > 'Z' }) >= 0 {
+		issues = append(issues, Issue{Severity: "error", Code: "invalid",
+			Diagnostics: "a current address with a two-letter state code is required",
+			Expression:  []string{"Patient.address.state"}})
+	}
+
+	if len(issues) > 0 {
+		return patientmodel.Patient{}, nil, issues
+	}
+	if address != nil {
+		address.PatientID = patient.ID
+	}
+	return patient, address, nil
+}
+
+// identifierOf returns the value of the identifier of the first system that has one
+func identifierOf(identifiers []Identifier, systems []string) (string, bool) {
+	for _, system := range systems {
+		for _, identifier := range identifiers {
+			if identifier.System == system && identifier.Use != "old" && strings.TrimSpace(identifier.Value) != "" {
+				return strings.TrimSpace(identifier.Value), true
+			}
+		}
+	}
+	return "", false
+}
+
+// nameOf is the official name, or the first current one; its text is preferred over its parts
+func nameOf(names []HumanName) string {
+	names = slices.DeleteFunc(slices.Clone(names), func(n HumanName) bool { return n.Use == "old" })
+	if len(names) == 0 {
+		return ""
+	}
+	name := names[0]
+	if i := slices.IndexFunc(names, func(n HumanName) bool { return n.Use == "official" }); i >= 0 {
+		name = names[i]
+	}
+	if text := strings.TrimSpace(name.Text); text != "" {
+		return text
+	}
+	return strings.Join(strings.Fields(strings.Join(append(slices.Clone(name.Given), name.Family), " ")), " ")
+}
+
+// phoneOf is the mobile phone number, else the home one, else the first current one
+func phoneOf(telecom []ContactPoint) string {
+	var phones []ContactPoint
+	for _, t := range telecom {
+		if t.System == "phone" && t.Use != "old" && strings.TrimSpace(t.Value) != "" {
+			phones = append(phones, t)
+		}
+	}
+	for _, use := range []string{"mobile", "home"} {
+		if i := slices.IndexFunc(phones, func(t ContactPoint) bool { return t.Use == use }); i >= 0 {
+			return strings.TrimSpace(phones[i].Value)
+		}
+	}
+	if len(phones) > 0 {
+		return strings.TrimSpace(phones[0].Value)
+	}
+	return ""
+}
+
+// homeAddressOf is the home address, else the first current one; nil when there is none
+func homeAddressOf(addresses []Address) *patientmodel.Address {
+	addresses = slices.DeleteFunc(slices.Clone(addresses), func(a Address) bool { return a.Use == "old" })
+	if len(addresses) == 0 {
+		return nil
+	}
+	a := addresses[0]
+	if i := slices.IndexFunc(addresses, func(a Address) bool { return a.Use == "home" }); i >= 0 {
+		a = addresses[i]
+	}
+	address := &patientmodel.Address{
+		City:  strings.TrimSpace(a.City),
+		State: strings.ToUpper(strings.TrimSpace(a.State)),
+		Zip:   strings.TrimSpace(a.PostalCode),
+	}
+	if len(a.Line) > 0 {
+		address.Line1 = strings.TrimSpace(a.Line[0])
+	}
+	if len(a.Line) > 1 {
+		address.Line2 = strings.TrimSpace(strings.Join(a.Line[1:], ", "))
+	}
+	return address
+}
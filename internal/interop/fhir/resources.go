@@ -0,0 +1,196 @@
+// Package fhir serves patients and prescriptions as HL7 FHIR R4 resources for interoperability:
+// Patient and MedicationRequest reads and searches under /fhir, returned as application/fhir+json.
+// Only the elements the domain records are filled in. Other systems may push patient demographics
+// as Patient resources; prescriptions are read-only.
+package fhir
+
+// Version is the FHIR release the resources conform to
+const Version = "4.0.1"
+
+// ContentType is the media type of FHIR JSON responses
+const ContentType = "application/fhir+json"
+
+// Code systems and profiles referenced by the resources
+const (
+	systemNPI                = "http://hl7.org/fhir/sid/us-npi"
+	systemUCUM               = "http://unitsofmeasure.org"
+	systemSNOMED             = "http://snomed.info/sct"
+	profilePatient           = "http://hl7.org/fhir/StructureDefinition/Patient"
+	profileMedicationRequest = "http://hl7.org/fhir/StructureDefinition/MedicationRequest"
+)
+
+// Meta is the metadata of a resource
+type Meta struct {
+	LastUpdated string   `json:"lastUpdated,omitempty"` // instant, e.g. 2024-05-01T10:00:00Z
+	Profile     []string `json:"profile,omitempty"`
+}
+
+type Identifier struct {
+	Use    string `json:"use,omitempty"`
+	System string `json:"system,omitempty"`
+	Value  string `json:"value"`
+}
+
+type HumanName struct {
+	Use    string   `json:"use,omitempty"`
+	Text   string   `json:"text,omitempty"`
+	Family string   `json:"family,omitempty"`
+	Given  []string `json:"given,omitempty"`
+}
+
+type ContactPoint struct {
+	System string `json:"system"`
+	Value  string `json:"value"`
+	Use    string `json:"use,omitempty"`
+}
+
+type Address struct {
+	Use        string   `json:"use,omitempty"`
+	Line       []string `json:"line,omitempty"`
+	City       string   `json:"city,omitempty"`
+	State      string   `json:"state,omitempty"`
+	PostalCode string   `json:"postalCode,omitempty"`
+	Country    string   `json:"country,omitempty"`
+}
+
+type Coding struct {
+	System  string `json:"system,omitempty"`
+	Code    string `json:"code,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+type CodeableConcept struct {
+	Coding []Coding `json:"coding,omitempty"`
+	Text   string   `json:"text,omitempty"`
+}
+
+type Reference struct {
+	Reference  string      `json:"reference,omitempty"` // e.g. "Patient/P001"
+	Identifier *Identifier `json:"identifier,omitempty"`
+	Display    string      `json:"display,omitempty"`
+}
+
+type Quantity struct {
+	Value  float64 `json:"value"`
+	Unit   string  `json:"unit,omitempty"`
+	System string  `json:"system,omitempty"`
+	Code   string  `json:"code,omitempty"`
+}
+
+// Patient is the FHIR Patient resource
+type Patient struct {
+	ResourceType string         `json:"resourceType"`
+	ID           string         `json:"id"`
+	Meta         *Meta          `json:"meta,omitempty"`
+	Identifier   []Identifier   `json:"identifier,omitempty"`
+	Active       bool           `json:"active"`
+	Name         []HumanName    `json:"name,omitempty"`
+	Telecom      []ContactPoint `json:"telecom,omitempty"`
+	BirthDate    string         `json:"birthDate,omitempty"` // YYYY, YYYY-MM or YYYY-MM-DD
+	Address      []Address      `json:"address,omitempty"`
+}
+
+// MedicationRequest is the FHIR MedicationRequest resource
+type MedicationRequest struct {
+	ResourceType              string           `json:"resourceType"`
+	ID                        string           `json:"id"`
+	Meta                      *Meta            `json:"meta,omitempty"`
+	Identifier                []Identifier     `json:"identifier,omitempty"`
+	Status                    string           `json:"status"`
+	StatusReason              *CodeableConcept `json:"statusReason,omitempty"`
+	Intent                    string           `json:"intent"`
+	MedicationCodeableConcept CodeableConcept  `json:"medicationCodeableConcept"`
+	Subject                   Reference        `json:"subject"`
+	AuthoredOn                string           `json:"authoredOn,omitempty"`
+	Requester                 *Reference       `json:"requester,omitempty"`
+	DosageInstruction         []Dosage         `json:"dosageInstruction,omitempty"`
+	DispenseRequest           *DispenseRequest `json:"dispenseRequest,omitempty"`
+}
+
+type Dosage struct {
+	Text        string           `json:"text,omitempty"`
+	AsNeeded    *bool            `json:"asNeededBoolean,omitempty"`
+	Route       *CodeableConcept `json:"route,omitempty"`
+	DoseAndRate []DoseAndRate    `json:"doseAndRate,omitempty"`
+}
+
+type DoseAndRate struct {
+	DoseQuantity *Quantity `json:"doseQuantity,omitempty"`
+}
+
+type DispenseRequest struct {
+	Quantity               *Quantity  `json:"quantity,omitempty"`
+	ExpectedSupplyDuration *Quantity  `json:"expectedSupplyDuration,omitempty"` // A Duration, in days
+	Performer              *Reference `json:"performer,omitempty"`
+}
+
+// Bundle is a search result set
+type Bundle struct {
+	ResourceType string        `json:"resourceType"`
+	ID           string        `json:"id,omitempty"`
+	Meta         *Meta         `json:"meta,omitempty"`
+	Type         string        `json:"type"`
+	Total        *int          `json:"total,omitempty"`
+	Link         []BundleLink  `json:"link,omitempty"`
+	Entry        []BundleEntry `json:"entry,omitempty"`
+}
+
+type BundleLink struct {
+	Relation string `json:"relation"`
+	URL      string `json:"url"`
+}
+
+type BundleEntry struct {
+	FullURL  string       `json:"fullUrl"`
+	Resource any          `json:"resource"`
+	Search   *EntrySearch `json:"search,omitempty"`
+}
+
+type EntrySearch struct {
+	Mode string `json:"mode"`
+}
+
+// OperationOutcome reports an error in place of a resource
+type OperationOutcome struct {
+	ResourceType string  `json:"resourceType"`
+	Issue        []Issue `json:"issue"`
+}
+
+type Issue struct {
+	Severity    string   `json:"severity"`
+	Code        string   `json:"code"`
+	Diagnostics string   `json:"diagnostics,omitempty"`
+	Expression  []string `json:"expression,omitempty"` // FHIRPath of the element at fault, e.g. Patient.birthDate
+}
+
+// CapabilityStatement describes what the server supports, served at /fhir/metadata
+type CapabilityStatement struct {
+	ResourceType string                    `json:"resourceType"`
+	Status       string                    `json:"status"`
+	Date         string                    `json:"date"`
+	Kind         string                    `json:"kind"`
+	FHIRVersion  string                    `json:"fhirVersion"`
+	Format       []string                  `json:"format"`
+	Rest         []CapabilityStatementRest `json:"rest"`
+}
+
+type CapabilityStatementRest struct {
+	Mode     string               `json:"mode"`
+	Resource []CapabilityResource `json:"resource"`
+}
+
+type CapabilityResource struct {
+	Type        string             `json:"type"`
+	Profile     string             `json:"profile,omitempty"`
+	Interaction []CapabilityCode   `json:"interaction"`
+	SearchParam []CapabilitySearch `json:"searchParam,omitempty"`
+}
+
+type CapabilityCode struct {
+	Code string `json:"code"`
+}
+
+type CapabilitySearch struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
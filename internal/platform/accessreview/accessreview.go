@@ -0,0 +1,116 @@
+// Package accessreview materializes every user's effective permissions, direct grants plus those of
+// their functional roles, into periodic access review reports. Each report flags unusual grants and
+// lists what changed since the previous review.
+package accessreview
+
+import (
+	"context"
+	"time"
+)
+
+// Grant sources
+const (
+	SourceDirect     = "direct"
+	SourceRolePrefix = "role:" // Followed by the functional role name
+)
+
+// Flag reasons
+const (
+	FlagAdmin                  = "admin_all"               // Holds admin:all, which bypasses every permission check
+	FlagDirectGrant            = "direct_grant"            // Granted directly, outside the user's functional roles
+	FlagConflictingPermissions = "conflicting_permissions" // Holds every permission of a conflicting set
+	FlagRareGrant              = "rare_grant"              // Held by very few users
+	FlagUnknownRole            = "unknown_role"            // Functional role with no permission mapping
+)
+
+// Triggers
+const (
+	TriggerScheduled = "scheduled"
+	TriggerManual    = "manual"
+)
+
+// Subject is a user as listed by the user directory
+type Subject struct {
+	Username        string
+	Name            string
+	Email           string
+	Permissions     []string // Granted directly
+	DataAccessRoles []string
+	FuncRoles       []string
+}
+
+// Directory lists the users to review
+type Directory interface {
+	ListUsers(ctx context.Context) ([]Subject, error)
+}
+
+// UserAccess is a user's effective permissions in a review
+type UserAccess struct {
+	Username        string   `json:"username" bson:"username"`
+	Name            string   `json:"name,omitempty" bson:"name,omitempty"`
+	Email           string   `json:"email,omitempty" bson:"email,omitempty"`
+	FuncRoles       []string `json:"func_roles" bson:"func_roles"`
+	DataAccessRoles []string `json:"data_access_roles" bson:"data_access_roles"`
+	Permissions     []string `json:"permissions" bson:"permissions"` // Effective, sorted
+	// Grants lists where each effective permission comes from ("direct", "role:pharmacist")
+	Grants map[string][]string `json:"grants" bson:"grants"`
+}
+
+// Flag is an unusual grant for a reviewer to confirm or revoke
+type Flag struct {
+	Username   string `json:"username" bson:"username"`
+	Reason     string `json:"reason" bson:"reason"`
+	Permission string `json:"permission,omitempty" bson:"permission,omitempty"`
+	Detail     string `json:"detail" bson:"detail"`
+}
+
+// Change is a permission granted to or revoked from a user since the previous review
+type Change struct {
+	Username   string `json:"username" bson:"username"`
+	Permission string `json:"permission" bson:"permission"`
+}
+
+// Diff compares a review with the one before it
+type Diff struct {
+	PreviousID          string    `json:"previous_id" bson:"previous_id"`
+	PreviousGeneratedAt time.Time `json:"previous_generated_at" bson:"previous_generated_at"`
+	AddedUsers          []string  `json:"added_users" bson:"added_users"`
+	RemovedUsers        []string  `json:"removed_users" bson:"removed_users"`
+	Granted             []Change  `json:"granted" bson:"granted"`
+	Revoked             []Change  `json:"revoked" bson:"revoked"`
+}
+
+// Report is one access review. Lists of reports leave out Users, Flags and Diff.
+type Report struct {
+	ID          string    `json:"id" bson:"_id"`
+	GeneratedAt time.Time `json:"generated_at" bson:"generated_at"`
+	GeneratedBy string    `json:"generated_by" bson:"generated_by"`
+	Trigger     string    `json:"trigger" bson:"trigger"`
+	UserCount   int       `json:"user_count" bson:"user_count"`
+	FlagCount   int       `json:"flag_count" bson:"flag_count"`
+	ChangeCount int       `json:"change_count" bson:"change_count"` // Users added or removed plus grants and revocations
+	// Permissions are the columns of the users × permissions matrix
+	Permissions []string     `json:"permissions" bson:"permissions"`
+	Users       []UserAccess `json:"users,omitempty" bson:"users"`
+	Flags       []Flag       `json:"flags,omitempty" bson:"flags"`
+	Diff        *Diff        `json:"diff,omitempty" bson:"diff,omitempty"` // Nil for the first review
+}
+
+// Store persists reports; reports are immutable
+type Store interface {
+	Save(ctx context.Context, report Report) (Report, error)
+	// Get returns a RecordNotFoundError for unknown IDs
+	Get(ctx context.Context, id string) (Report, error)
+	// Latest returns the newest report, or a RecordNotFoundError when none was generated yet
+	Latest(ctx context.Context) (Report, error)
+	// List returns report summaries, newest first
+	List(ctx context.Context, limit int) ([]Report, error)
+}
+
+// summary strips the per-user data from a report
+func summary(report Report) Report {
+	report.Users = nil
+	report.Flags = nil
+	report.Diff = nil
+	return report
+}
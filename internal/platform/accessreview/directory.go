@@ -0,0 +1,10 @@
+package accessreview
+
+import "context"
+
+// StaticDirectory lists a fixed set of users, such as the login users defined in the config
+type StaticDirectory []Subject
+
+func (d StaticDirectory) ListUsers(_ context.Context) ([]Subject, error) {
+	return d, nil
+}
@@ -0,0 +1,139 @@
+package accessreview
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+	"pharmacy-modernization-project-model/internal/platform/paths"
+	"pharmacy-modernization-project-model/internal/platform/permissions"
+	"pharmacy-modernization-project-model/internal/platform/tabular"
+)
+
+// ReviewAccess - access reviewers or admins can read and generate access reviews
+var ReviewAccess = []string{permissions.AccessReviewRead, permissions.AdminAll}
+
+// Handler serves the access review reports
+type Handler struct {
+	reviewer *Reviewer
+	store    Store
+	log      *zap.Logger
+}
+
+func NewHandler(reviewer *Reviewer, store Store, log *zap.Logger) *Handler {
+	return &Handler{reviewer: reviewer, store: store, log: log}
+}
+
+// RegisterRoutes mounts the reports under /api/v1/reports/access-reviews
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route(paths.AccessReviewsAPIPath, func(router chi.Router) {
+		router.Use(auth.RequireAuthFromHeader())
+		router.Use(auth.RequirePermissionsMatchAny(ReviewAccess))
+
+		router.Get("/", h.List)
+		router.Post("/", h.Generate)
+		router.Get("/latest", h.Latest)
+		router.Get("/{reviewID}", h.GetByID)
+	})
+}
+
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	query, fieldErrors, err := bind.Query[ListQuery](r)
+	if err != nil {
+		h.log.Error("failed to bind query parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+	if query.Limit == 0 {
+		query.Limit = 20
+	}
+
+	reports, err := h.store.List(r.Context(), query.Limit)
+	if err != nil {
+		h.log.Error("list access reviews", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, reports)
+}
+
+// Generate recalculates effective permissions now instead of waiting for the scheduled review
+func (h *Handler) Generate(w http.ResponseWriter, r *http.Request) {
+	report, err := h.reviewer.Generate(r.Context(), TriggerManual, actor(r))
+	if err != nil {
+		h.log.Error("generate access review", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteCreated(w, report)
+}
+
+func (h *Handler) Latest(w http.ResponseWriter, r *http.Request) {
+	report, err := h.store.Latest(r.Context())
+	if err != nil {
+		httpx.WriteError(w, r, err)
+		return
+	}
+	h.write(w, r, report)
+}
+
+func (h *Handler) GetByID(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[PathVars](r, chi.URLParam)
+	if err != nil {
+		h.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	report, err := h.store.Get(r.Context(), pathVars.ReviewID)
+	if err != nil {
+		httpx.WriteError(w, r, err)
+		return
+	}
+	h.write(w, r, report)
+}
+
+// write returns the report as JSON, or its matrix as a CSV or XLSX download
+func (h *Handler) write(w http.ResponseWriter, r *http.Request, report Report) {
+	query, fieldErrors, err := bind.Query[ReportQuery](r)
+	if err != nil {
+		h.log.Error("failed to bind query parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+	if query.Format == "" || query.Format == "json" {
+		helper.WriteOK(w, report)
+		return
+	}
+
+	w.Header().Set("Content-Type", tabular.ContentType(query.Format))
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="access-review-%s.%s"`,
+		report.GeneratedAt.UTC().Format("20060102-150405"), query.Format))
+	w.Header().Set("Cache-Control", "no-store")
+	tw, err := tabular.NewWriter(query.Format, w)
+	if err != nil {
+		h.log.Error("create access review matrix writer", zap.Error(err))
+		return
+	}
+	if err := WriteMatrix(tw, report); err != nil {
+		h.log.Warn("write access review matrix", zap.String("review_id", report.ID), zap.Error(err))
+	}
+}
+
+// actor identifies the authenticated user requesting a review
+func actor(r *http.Request) string {
+	user, err := auth.GetCurrentUser(r.Context())
+	if err != nil {
+		return ""
+	}
+	if user.Email != "" {
+		return user.Email
+	}
+	return user.ID
+}
@@ -0,0 +1,38 @@
+package accessreview
+
+import (
+	"strconv"
+	"strings"
+
+	"pharmacy-modernization-project-model/internal/platform/tabular"
+)
+
+// WriteMatrix writes the users × permissions matrix; each cell lists where the grant comes from
+func WriteMatrix(w tabular.Writer, report Report) error {
+	flags := map[string]int{}
+	for _, flag := range report.Flags {
+		flags[flag.Username]++
+	}
+
+	header := append([]string{"username", "name", "email", "func_roles", "data_access_roles", "flags"}, report.Permissions...)
+	if err := w.WriteRow(header); err != nil {
+		return err
+	}
+	for _, user := range report.Users {
+		row := []string{
+			user.Username,
+			user.Name,
+			user.Email,
+			strings.Join(user.FuncRoles, ";"),
+			strings.Join(user.DataAccessRoles, ";"),
+			strconv.Itoa(flags[user.Username]),
+		}
+		for _, permission := range report.Permissions {
+			row = append(row, strings.Join(user.Grants[permission], ";"))
+		}
+		if err := w.WriteRow(row); err != nil {
+			return err
+		}
+	}
+	return w.Close()
+}
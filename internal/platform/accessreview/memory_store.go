@@ -0,0 +1,60 @@
+package accessreview
+
+import (
+	"context"
+	"sync"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// MemoryStore keeps reports in process memory; used when MongoDB is not configured
+type MemoryStore struct {
+	mu      sync.RWMutex
+	reports []Report // Oldest first
+}
+
+// NewMemoryStore creates an empty in-memory report store
+func NewMemoryStore() Store {
+	return &MemoryStore{}
+}
+
+func (s *MemoryStore) Save(_ context.Context, report Report) (Report, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reports = append(s.reports, report)
+	return report, nil
+}
+
+func (s *MemoryStore) Get(_ context.Context, id string) (Report, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, report := range s.reports {
+		if report.ID == id {
+			return report, nil
+		}
+	}
+	return Report{}, platformErrors.NewRecordNotFoundError("access review", id)
+}
+
+func (s *MemoryStore) Latest(_ context.Context) (Report, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if len(s.reports) == 0 {
+		return Report{}, platformErrors.NewRecordNotFoundError("access review", "latest")
+	}
+	return s.reports[len(s.reports)-1], nil
+}
+
+func (s *MemoryStore) List(_ context.Context, limit int) ([]Report, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	out := []Report{}
+	for i := len(s.reports) - 1; i >= 0; i-- {
+		out = append(out, summary(s.reports[i]))
+		if limit > 0 && len(out) == limit {
+			break
+		}
+	}
+	return out, nil
+}
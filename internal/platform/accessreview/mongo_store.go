@@ -0,0 +1,85 @@
+package accessreview
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// MongoStore persists reports in a MongoDB collection
+type MongoStore struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewMongoStore creates a MongoDB-backed report store
+func NewMongoStore(collection *mongo.Collection, logger *zap.Logger) *MongoStore {
+	return &MongoStore{collection: collection, logger: logger}
+}
+
+func (s *MongoStore) Save(ctx context.Context, report Report) (Report, error) {
+	if _, err := s.collection.InsertOne(ctx, report); err != nil {
+		s.logger.Error("Failed to save access review", zap.String("review_id", report.ID), zap.Error(err))
+		return Report{}, platformErrors.HandleMongoError("Save", err)
+	}
+	return report, nil
+}
+
+func (s *MongoStore) Get(ctx context.Context, id string) (Report, error) {
+	return s.findOne(ctx, "Get", bson.M{"_id": id}, options.FindOne(), id)
+}
+
+func (s *MongoStore) Latest(ctx context.Context) (Report, error) {
+	opts := options.FindOne().SetSort(bson.D{{Key: "generated_at", Value: -1}})
+	return s.findOne(ctx, "Latest", bson.M{}, opts, "latest")
+}
+
+func (s *MongoStore) findOne(ctx context.Context, op string, filter bson.M, opts *options.FindOneOptions, id string) (Report, error) {
+	var report Report
+	if err := s.collection.FindOne(ctx, filter, opts).Decode(&report); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return Report{}, platformErrors.NewRecordNotFoundError("access review", id)
+		}
+		return Report{}, platformErrors.HandleMongoError(op, err)
+	}
+	return report, nil
+}
+
+func (s *MongoStore) List(ctx context.Context, limit int) ([]Report, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "generated_at", Value: -1}}).
+		SetProjection(bson.M{"users": 0, "flags": 0, "diff": 0})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := s.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, platformErrors.HandleMongoError("List", err)
+	}
+	defer cursor.Close(ctx)
+
+	reports := []Report{}
+	if err := cursor.All(ctx, &reports); err != nil {
+		return nil, platformErrors.HandleMongoError("List", err)
+	}
+	return reports, nil
+}
+
+// CreateIndexes creates the index used to find the latest reports
+func (s *MongoStore) CreateIndexes(ctx context.Context) error {
+	_, err := s.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "generated_at", Value: -1}},
+		Options: options.Index().SetName("generated_at_-1"),
+	})
+	if err != nil {
+		return platformErrors.HandleMongoError("CreateIndexes", err)
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package accessreview
+
+// ListQuery pages through review summaries
+type ListQuery struct {
+	Limit int `form:"limit" validate:"omitempty,min=1,max=200"`
+}
+
+// ReportQuery selects the representation of a review; the matrix is downloaded as CSV or XLSX
+type ReportQuery struct {
+	Format string `form:"format" validate:"omitempty,oneof=json csv xlsx"`
+}
+
+// PathVars represents path parameters for review endpoints
+type PathVars struct {
+	ReviewID string `path:"reviewID" validate:"required,min=1"`
+}
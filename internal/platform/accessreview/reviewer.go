@@ -0,0 +1,339 @@
+package accessreview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/permissions"
+)
+
+// adminPermission bypasses every permission check
+const adminPermission = permissions.AdminAll
+
+// Config controls how often reviews are generated and which grants are flagged
+type Config struct {
+	// Interval is the maximum age of the latest review before the scheduled job generates a new one
+	Interval time.Duration
+	// FuncRolePermissions lists the permissions each functional role grants
+	FuncRolePermissions map[string][]string
+	// ConflictingPermissions are sets of permissions no single user should hold together
+	ConflictingPermissions [][]string
+	// RareGrantMaxUsers flags permissions held by at most this many users (0 disables)
+	RareGrantMaxUsers int
+	// RareGrantMinUsers is the review size below which rare grants are not flagged
+	RareGrantMinUsers int
+}
+
+func (c *Config) setDefaults() {
+	if c.Interval <= 0 {
+		c.Interval = 7 * 24 * time.Hour
+	}
+	if c.RareGrantMinUsers <= c.RareGrantMaxUsers {
+		c.RareGrantMinUsers = c.RareGrantMaxUsers + 1
+	}
+	// Role names are matched case-insensitively; config keys arrive lower-cased anyway
+	roles := make(map[string][]string, len(c.FuncRolePermissions))
+	for role, permissions := range c.FuncRolePermissions {
+		roles[strings.ToLower(role)] = permissions
+	}
+	c.FuncRolePermissions = roles
+}
+
+// Reviewer generates access review reports
+type Reviewer struct {
+	directory Directory
+	store     Store
+	cfg       Config
+	log       *zap.Logger
+	now       func() time.Time
+}
+
+// NewReviewer creates a reviewer; zero config values fall back to defaults
+func NewReviewer(directory Directory, store Store, cfg Config, log *zap.Logger) *Reviewer {
+	cfg.setDefaults()
+	if log == nil {
+		log = zap.NewNop()
+	}
+	return &Reviewer{directory: directory, store: store, cfg: cfg, log: log, now: time.Now}
+}
+
+// Run generates a scheduled review whenever the latest one is older than the interval, until ctx is cancelled
+func (r *Reviewer) Run(ctx context.Context) {
+	r.log.Info("Access review job started", zap.Duration("interval", r.cfg.Interval))
+
+	// Checking hourly keeps the schedule after restarts without waiting a whole interval
+	ticker := time.NewTicker(min(r.cfg.Interval, time.Hour))
+	defer ticker.Stop()
+
+	for {
+		r.generateIfDue(ctx)
+		select {
+		case <-ctx.Done():
+			r.log.Info("Access review job stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Reviewer) generateIfDue(ctx context.Context) {
+	latest, err := r.store.Latest(ctx)
+	var notFound platformErrors.RecordNotFoundError
+	switch {
+	case errors.As(err, &notFound):
+	case err != nil:
+		r.log.Error("Failed to load latest access review", zap.Error(err))
+		return
+	case r.now().Sub(latest.GeneratedAt) < r.cfg.Interval:
+		return
+	}
+
+	if _, err := r.Generate(ctx, TriggerScheduled, ""); err != nil {
+		r.log.Error("Failed to generate access review", zap.Error(err))
+	}
+}
+
+// Generate recalculates every user's effective permissions and saves the review, diffed against the latest one
+func (r *Reviewer) Generate(ctx context.Context, trigger, generatedBy string) (Report, error) {
+	subjects, err := r.directory.ListUsers(ctx)
+	if err != nil {
+		return Report{}, fmt.Errorf("list users: %w", err)
+	}
+
+	report := Report{
+		ID:          uuid.NewString(),
+		GeneratedAt: r.now(),
+		GeneratedBy: generatedBy,
+		Trigger:     trigger,
+		Users:       make([]UserAccess, 0, len(subjects)),
+		Flags:       []Flag{},
+	}
+	for _, subject := range subjects {
+		access, flags := r.effectiveAccess(subject)
+		report.Users = append(report.Users, access)
+		report.Flags = append(report.Flags, flags...)
+	}
+	sort.Slice(report.Users, func(i, j int) bool { return report.Users[i].Username < report.Users[j].Username })
+
+	report.Permissions = permissionColumns(report.Users)
+	report.Flags = append(report.Flags, r.rareGrants(report.Users, report.Permissions)...)
+	report.UserCount = len(report.Users)
+	report.FlagCount = len(report.Flags)
+
+	previous, err := r.store.Latest(ctx)
+	var notFound platformErrors.RecordNotFoundError
+	switch {
+	case errors.As(err, &notFound):
+	case err != nil:
+		return Report{}, err
+	default:
+		report.Diff = diff(previous, report)
+		report.ChangeCount = len(report.Diff.AddedUsers) + len(report.Diff.RemovedUsers) +
+			len(report.Diff.Granted) + len(report.Diff.Revoked)
+	}
+
+	saved, err := r.store.Save(ctx, report)
+	if err != nil {
+		return Report{}, err
+	}
+
+	r.log.Info("Access review generated",
+		zap.String("review_id", saved.ID),
+		zap.String("trigger", trigger),
+		zap.Int("users", saved.UserCount),
+		zap.Int("flags", saved.FlagCount),
+		zap.Int("changes", saved.ChangeCount))
+	return saved, nil
+}
+
+// effectiveAccess merges the user's direct grants with those of their functional roles
+func (r *Reviewer) effectiveAccess(subject Subject) (UserAccess, []Flag) {
+	access := UserAccess{
+		Username:        subject.Username,
+		Name:            subject.Name,
+		Email:           subject.Email,
+		FuncRoles:       nonNil(subject.FuncRoles),
+		DataAccessRoles: nonNil(subject.DataAccessRoles),
+		Grants:          map[string][]string{},
+	}
+	var flags []Flag
+
+	roleGrants := map[string]bool{}
+	for _, role := range subject.FuncRoles {
+		permissions, ok := r.cfg.FuncRolePermissions[strings.ToLower(role)]
+		if !ok {
+			flags = append(flags, Flag{
+				Username: subject.Username,
+				Reason:   FlagUnknownRole,
+				Detail:   fmt.Sprintf("functional role %q grants no known permissions", role),
+			})
+			continue
+		}
+		for _, permission := range permissions {
+			access.Grants[permission] = appendUnique(access.Grants[permission], SourceRolePrefix+role)
+			roleGrants[permission] = true
+		}
+	}
+	for _, permission := range subject.Permissions {
+		access.Grants[permission] = appendUnique(access.Grants[permission], SourceDirect)
+		// Users without mapped roles have nothing to compare their grants against
+		if len(roleGrants) > 0 && !roleGrants[permission] {
+			flags = append(flags, Flag{
+				Username:   subject.Username,
+				Reason:     FlagDirectGrant,
+				Permission: permission,
+				Detail:     "granted directly, outside the user's functional roles",
+			})
+		}
+	}
+
+	for permission := range access.Grants {
+		access.Permissions = append(access.Permissions, permission)
+	}
+	sort.Strings(access.Permissions)
+	access.Permissions = nonNil(access.Permissions)
+
+	if slices.Contains(access.Permissions, adminPermission) {
+		flags = append(flags, Flag{
+			Username:   subject.Username,
+			Reason:     FlagAdmin,
+			Permission: adminPermission,
+			Detail:     "bypasses every permission check",
+		})
+	}
+	for _, set := range r.cfg.ConflictingPermissions {
+		if len(set) > 1 && containsAll(access.Permissions, set) {
+			flags = append(flags, Flag{
+				Username: subject.Username,
+				Reason:   FlagConflictingPermissions,
+				Detail:   "holds " + strings.Join(set, " and "),
+			})
+		}
+	}
+	return access, flags
+}
+
+// rareGrants flags permissions held by very few users, once the review is large enough for that to stand out
+func (r *Reviewer) rareGrants(users []UserAccess, permissions []string) []Flag {
+	if r.cfg.RareGrantMaxUsers <= 0 || len(users) < r.cfg.RareGrantMinUsers {
+		return nil
+	}
+
+	var flags []Flag
+	for _, permission := range permissions {
+		var holders []string
+		for _, user := range users {
+			if slices.Contains(user.Permissions, permission) {
+				holders = append(holders, user.Username)
+			}
+		}
+		if len(holders) > r.cfg.RareGrantMaxUsers {
+			continue
+		}
+		for _, username := range holders {
+			flags = append(flags, Flag{
+				Username:   username,
+				Reason:     FlagRareGrant,
+				Permission: permission,
+				Detail:     fmt.Sprintf("held by %d of %d users", len(holders), len(users)),
+			})
+		}
+	}
+	return flags
+}
+
+// permissionColumns returns every permission held by at least one user, sorted
+func permissionColumns(users []UserAccess) []string {
+	seen := map[string]bool{}
+	columns := []string{}
+	for _, user := range users {
+		for _, permission := range user.Permissions {
+			if !seen[permission] {
+				seen[permission] = true
+				columns = append(columns, permission)
+			}
+		}
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+// diff lists the users and grants that changed between two reviews
+func diff(previous, current Report) *Diff {
+	d := &Diff{
+		PreviousID:          previous.ID,
+		PreviousGeneratedAt: previous.GeneratedAt,
+		AddedUsers:          []string{},
+		RemovedUsers:        []string{},
+		Granted:             []Change{},
+		Revoked:             []Change{},
+	}
+
+	before := make(map[string]UserAccess, len(previous.Users))
+	for _, user := range previous.Users {
+		before[user.Username] = user
+	}
+	after := make(map[string]UserAccess, len(current.Users))
+	for _, user := range current.Users {
+		after[user.Username] = user
+	}
+
+	for _, user := range current.Users {
+		old, ok := before[user.Username]
+		if !ok {
+			d.AddedUsers = append(d.AddedUsers, user.Username)
+		}
+		for _, permission := range user.Permissions {
+			if !slices.Contains(old.Permissions, permission) {
+				d.Granted = append(d.Granted, Change{Username: user.Username, Permission: permission})
+			}
+		}
+		for _, permission := range old.Permissions {
+			if !slices.Contains(user.Permissions, permission) {
+				d.Revoked = append(d.Revoked, Change{Username: user.Username, Permission: permission})
+			}
+		}
+	}
+	for _, user := range previous.Users {
+		if _, ok := after[user.Username]; !ok {
+			d.RemovedUsers = append(d.RemovedUsers, user.Username)
+			for _, permission := range user.Permissions {
+				d.Revoked = append(d.Revoked, Change{Username: user.Username, Permission: permission})
+			}
+		}
+	}
+	return d
+}
+
+func containsAll(have, want []string) bool {
+	for _, w := range want {
+		if !slices.Contains(have, w) {
+			return false
+		}
+	}
+	return true
+}
+
+func appendUnique(values []string, value string) []string {
+	if slices.Contains(values, value) {
+		return values
+	}
+	return append(values, value)
+}
+
+// nonNil keeps empty lists as [] in JSON
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,124 @@
+// Package apiversion versions the REST API. Controllers register their routes under a version
+// prefix (/api/v1/..., /api/v2/...); the middleware tells handlers which version a request is
+// for, adds Deprecation and Sunset headers for versions being retired, and routes unversioned
+// paths (/api/patients) by the Accept header or the default version. A new version can reuse
+// the handlers of the previous one with a Mapper that rewrites request and response bodies.
+package apiversion
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Versions the REST API is served in
+const (
+	V1 = "v1"
+	V2 = "v2"
+)
+
+// MediaTypePrefix starts the vendor media type that selects a version, e.g. application/vnd.rx.v2+json
+const MediaTypePrefix = "application/vnd.rx."
+
+var versionName = regexp.MustCompile(`^v[1-9][0-9]*$`)
+
+// Prefix returns the path prefix of a version's routes, e.g. /api/v1
+func Prefix(version string) string {
+	return "/api/" + version
+}
+
+// Version is one supported version of the REST API
+type Version struct {
+	Name string
+	// DeprecatedAt announces the version's retirement in the Deprecation header; zero while supported
+	DeprecatedAt time.Time
+	// SunsetAt is when the version stops being served, sent in the Sunset header
+	SunsetAt time.Time
+	// Link points to the migration guide, sent as a Link with rel="deprecation"
+	Link string
+}
+
+// Deprecated reports whether clients are told to move off the version
+func (v Version) Deprecated() bool {
+	return !v.DeprecatedAt.IsZero() || !v.SunsetAt.IsZero()
+}
+
+// Set is the supported versions, oldest first
+type Set struct {
+	versions       []Version
+	defaultVersion string
+}
+
+// New checks the versions and returns the set; defaultVersion serves unversioned requests that
+// name no version in their Accept header
+func New(versions []Version, defaultVersion string) (*Set, error) {
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("no API versions configured")
+	}
+	seen := map[string]bool{}
+	for _, v := range versions {
+		if !versionName.MatchString(v.Name) {
+			return nil, fmt.Errorf("API version %q must look like v1", v.Name)
+		}
+		if seen[v.Name] {
+			return nil, fmt.Errorf("API version %s is configured twice", v.Name)
+		}
+		seen[v.Name] = true
+	}
+	if !seen[defaultVersion] {
+		return nil, fmt.Errorf("default API version %q is not configured", defaultVersion)
+	}
+
+	sorted := slices.Clone(versions)
+	slices.SortFunc(sorted, func(a, b Version) int { return number(a.Name) - number(b.Name) })
+	return &Set{versions: sorted, defaultVersion: defaultVersion}, nil
+}
+
+// Lookup returns a supported version by name
+func (s *Set) Lookup(name string) (Version, bool) {
+	i := slices.IndexFunc(s.versions, func(v Version) bool { return v.Name == name })
+	if i < 0 {
+		return Version{}, false
+	}
+	return s.versions[i], true
+}
+
+// Names lists the supported versions, oldest first
+func (s *Set) Names() []string {
+	names := make([]string, len(s.versions))
+	for i, v := range s.versions {
+		names[i] = v.Name
+	}
+	return names
+}
+
+// successor returns the next version after name, if any
+func (s *Set) successor(name string) (Version, bool) {
+	i := slices.IndexFunc(s.versions, func(v Version) bool { return v.Name == name })
+	if i < 0 || i == len(s.versions)-1 {
+		return Version{}, false
+	}
+	return s.versions[i+1], true
+}
+
+func number(name string) int {
+	var n int
+	fmt.Sscanf(strings.TrimPrefix(name, "v"), "%d", &n)
+	return n
+}
+
+type versionKey struct{}
+
+// FromContext returns the API version of the request, or "" outside the REST API
+func FromContext(ctx context.Context) string {
+	version, _ := ctx.Value(versionKey{}).(string)
+	return version
+}
+
+// WithVersion returns a context carrying the API version
+func WithVersion(ctx context.Context, version string) context.Context {
+	return context.WithValue(ctx, versionKey{}, version)
+}
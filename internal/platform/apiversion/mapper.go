@@ -0,0 +1,124 @@
+package apiversion
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+)
+
+// Mapper adapts a handler written for one version to the contract of another, so a route of a
+// new version can reuse the previous version's handler and only translate what changed:
+//
+//	router.Route(paths.APIV2Path+"/patients", func(r chi.Router) {
+//		r.With(patientV2.Middleware).Get("/{patientID}", controller.GetByID)
+//	})
+type Mapper struct {
+	// Request rewrites a JSON request body into the form the handler expects; nil leaves it as is
+	Request func(body map[string]any) (map[string]any, error)
+	// Response rewrites a JSON response body into the form of the route's version; nil leaves it
+	// as is. Numbers are json.Number, so IDs and amounts survive the round trip unchanged.
+	Response func(status int, body any) (any, error)
+}
+
+// Middleware applies the mapper to the handlers below it
+func (m Mapper) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if m.Request != nil && r.Body != nil && strings.Contains(r.Header.Get("Content-Type"), "json") {
+			body, err := m.mapRequest(r.Body)
+			if err != nil {
+				httpx.WriteError(w, r, err)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			r.ContentLength = int64(len(body))
+			r.Header.Set("Content-Length", strconv.Itoa(len(body)))
+		}
+		if m.Response == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rec := &responseRecorder{header: http.Header{}, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		body := rec.body.Bytes()
+		if len(body) > 0 && strings.Contains(rec.header.Get("Content-Type"), "json") {
+			mapped, err := m.mapResponse(rec.status, body)
+			if err != nil {
+				httpx.WriteError(w, r, err)
+				return
+			}
+			body = mapped
+			rec.header.Set("Content-Length", strconv.Itoa(len(body)))
+		}
+		for key, values := range rec.header {
+			w.Header()[key] = values
+		}
+		w.WriteHeader(rec.status)
+		_, _ = w.Write(body)
+	})
+}
+
+func (m Mapper) mapRequest(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, platformErrors.NewValidationError("body", nil, "failed to read request body")
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return data, nil
+	}
+	var body map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&body); err != nil {
+		return nil, platformErrors.NewValidationError("body", nil, "request body is not a JSON object")
+	}
+	mapped, err := m.Request(body)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(mapped)
+}
+
+func (m Mapper) mapResponse(status int, data []byte) ([]byte, error) {
+	var body any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&body); err != nil {
+		return nil, err
+	}
+	mapped, err := m.Response(status, body)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(mapped)
+}
+
+// responseRecorder buffers a response so its body can be mapped before it is sent
+type responseRecorder struct {
+	header      http.Header
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (rec *responseRecorder) Header() http.Header { return rec.header }
+
+func (rec *responseRecorder) WriteHeader(status int) {
+	if rec.wroteHeader {
+		return
+	}
+	rec.wroteHeader = true
+	rec.status = status
+}
+
+func (rec *responseRecorder) Write(b []byte) (int, error) {
+	rec.WriteHeader(http.StatusOK)
+	return rec.body.Write(b)
+}
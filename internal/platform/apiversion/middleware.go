@@ -0,0 +1,131 @@
+package apiversion
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+)
+
+// Headers set on versioned responses
+const (
+	VersionHeader     = "API-Version"
+	DeprecationHeader = "Deprecation"
+	SunsetHeader      = "Sunset"
+)
+
+// Middleware serves the REST API by version. It must be registered on the root router, which
+// it needs to tell routes that only exist under a version prefix:
+//
+//   - /api/v1/... is served as is, and /api/v9/... is a 404 when v9 is not supported
+//   - unversioned paths that are routes of their own (/api/auth/me) are served as is
+//   - other unversioned paths are served by the version the Accept header names
+//     (application/vnd.rx.v2+json, or application/json; version=2) or else the default version
+//
+// Versioned responses carry API-Version, plus Deprecation, Sunset and Link headers when the
+// version is being retired.
+func (s *Set) Middleware(routes chi.Routes) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rest, ok := strings.CutPrefix(r.URL.Path, "/api/")
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if name, _, _ := strings.Cut(rest, "/"); versionName.MatchString(name) {
+				version, supported := s.Lookup(name)
+				if !supported {
+					s.writeUnsupported(w, r, http.StatusNotFound, name)
+					return
+				}
+				s.serve(w, r, next, version)
+				return
+			}
+
+			if routes.Match(chi.NewRouteContext(), r.Method, r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			name := acceptedVersion(r.Header.Values("Accept"))
+			w.Header().Add("Vary", "Accept")
+			if name == "" {
+				name = s.defaultVersion
+			}
+			version, supported := s.Lookup(name)
+			if !supported {
+				s.writeUnsupported(w, r, http.StatusNotAcceptable, name)
+				return
+			}
+			versioned := Prefix(version.Name) + "/" + rest
+			if !routes.Match(chi.NewRouteContext(), r.Method, versioned) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			r2 := r.Clone(r.Context())
+			r2.URL.Path = versioned
+			r2.URL.RawPath = ""
+			s.serve(w, r2, next, version)
+		})
+	}
+}
+
+// serve sets the version headers and context of a versioned request
+func (s *Set) serve(w http.ResponseWriter, r *http.Request, next http.Handler, version Version) {
+	w.Header().Set(VersionHeader, version.Name)
+	if version.Deprecated() {
+		if !version.DeprecatedAt.IsZero() {
+			// RFC 9745: the moment of deprecation as a structured field date
+			w.Header().Set(DeprecationHeader, "@"+strconv.FormatInt(version.DeprecatedAt.Unix(), 10))
+		}
+		if !version.SunsetAt.IsZero() {
+			w.Header().Set(SunsetHeader, version.SunsetAt.UTC().Format(http.TimeFormat))
+		}
+		if version.Link != "" {
+			w.Header().Add("Link", "<"+version.Link+`>; rel="deprecation"`)
+		}
+		if successor, ok := s.successor(version.Name); ok {
+			w.Header().Add("Link", "<"+Prefix(successor.Name)+`>; rel="successor-version"`)
+		}
+	}
+	next.ServeHTTP(w, r.WithContext(WithVersion(r.Context(), version.Name)))
+}
+
+func (s *Set) writeUnsupported(w http.ResponseWriter, r *http.Request, status int, name string) {
+	httpx.WriteAPIError(w, r, status, httpx.APIError{
+		Code:    "unsupported_api_version",
+		Message: "API version " + name + " is not supported",
+		Details: "supported versions: " + strings.Join(s.Names(), ", "),
+	})
+}
+
+// acceptedVersion returns the version named by the first Accept media type that names one:
+// application/vnd.rx.v2+json, or a version parameter such as application/json; version=2
+func acceptedVersion(accept []string) string {
+	for _, header := range accept {
+		for _, part := range strings.Split(header, ",") {
+			mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+			if err != nil {
+				continue
+			}
+			if rest, ok := strings.CutPrefix(mediaType, MediaTypePrefix); ok {
+				if name, _, _ := strings.Cut(rest, "+"); name != "" {
+					return name
+				}
+			}
+			if version := params["version"]; version != "" {
+				if !strings.HasPrefix(version, "v") {
+					version = "v" + version
+				}
+				return version
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,50 @@
+// Package attachments stores small files (signatures, scanned documents) linked to a record,
+// with a SHA-256 hash that is checked every time the file is read back.
+package attachments
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrIntegrity is returned when a stored file no longer matches its recorded hash
+var ErrIntegrity = errors.New("attachment content does not match its integrity hash")
+
+// Attachment describes a stored file
+type Attachment struct {
+	ID          string    `json:"id" bson:"_id"`
+	OwnerType   string    `json:"owner_type" bson:"owner_type"` // Kind of record the file belongs to, e.g. "dispense"
+	OwnerID     string    `json:"owner_id" bson:"owner_id"`
+	Name        string    `json:"name" bson:"name"`
+	ContentType string    `json:"content_type" bson:"content_type"`
+	Size        int       `json:"size" bson:"size"`
+	SHA256      string    `json:"sha256" bson:"sha256"` // Hex-encoded hash of the content
+	CreatedBy   string    `json:"created_by" bson:"created_by"`
+	CreatedAt   time.Time `json:"created_at" bson:"created_at"`
+}
+
+// Store persists attachments; stored files are immutable
+type Store interface {
+	// Put stores the content, assigning the ID, size, hash and timestamp
+	Put(ctx context.Context, attachment Attachment, data []byte) (Attachment, error)
+	// Get returns the attachment and its content, or ErrIntegrity when the content was altered
+	Get(ctx context.Context, id string) (Attachment, []byte, error)
+}
+
+// Hash returns the hex-encoded SHA-256 of data
+func Hash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Verify checks data against the attachment's recorded hash
+func Verify(attachment Attachment, data []byte) error {
+	if Hash(data) != attachment.SHA256 {
+		return fmt.Errorf("attachment %s: %w", attachment.ID, ErrIntegrity)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package attachments
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// BlobStore keeps the content of larger files (patient documents) outside the database; the
+// metadata, hash included, is kept by the caller
+type BlobStore interface {
+	// Put stores data under key, replacing any content already there
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// Get returns the content stored under key, or a not-found error
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete removes the content under key; deleting a missing key is not an error
+	Delete(ctx context.Context, key string) error
+	// Name identifies the store in logs and health output, e.g. "disk"
+	Name() string
+}
+
+// checkKey rejects keys that are not a clean relative slash-separated path, so a key cannot reach
+// outside the store's directory or bucket prefix
+func checkKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, `\`) || path.Clean(key) != key || key == ".." || strings.HasPrefix(key, "../") {
+		return fmt.Errorf("invalid blob key %q", key)
+	}
+	return nil
+}
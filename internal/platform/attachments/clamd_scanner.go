@@ -0,0 +1,84 @@
+package attachments
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// clamdChunkSize is the size of the chunks a file is streamed to clamd in
+const clamdChunkSize = 64 << 10
+
+// ClamdScanner sends files to a ClamAV daemon over TCP with the INSTREAM command
+type ClamdScanner struct {
+	address string
+	timeout time.Duration
+}
+
+// NewClamdScanner scans with the clamd listening at address, e.g. "localhost:3310"
+func NewClamdScanner(address string, timeout time.Duration) *ClamdScanner {
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	return &ClamdScanner{address: address, timeout: timeout}
+}
+
+func (s *ClamdScanner) Scan(ctx context.Context, name string, data []byte) (ScanResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", s.address)
+	if err != nil {
+		return ScanResult{}, platformErrors.NewExternalServiceError("clamd", "scan", err.Error())
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return ScanResult{}, platformErrors.NewExternalServiceError("clamd", "scan", err.Error())
+	}
+	size := make([]byte, 4)
+	for chunk := range slices.Chunk(data, clamdChunkSize) {
+		binary.BigEndian.PutUint32(size, uint32(len(chunk)))
+		if _, err := conn.Write(size); err != nil {
+			return ScanResult{}, platformErrors.NewExternalServiceError("clamd", "scan", err.Error())
+		}
+		if _, err := conn.Write(chunk); err != nil {
+			return ScanResult{}, platformErrors.NewExternalServiceError("clamd", "scan", err.Error())
+		}
+	}
+	if _, err := conn.Write([]byte{0, 0, 0, 0}); err != nil {
+		return ScanResult{}, platformErrors.NewExternalServiceError("clamd", "scan", err.Error())
+	}
+
+	reply, err := io.ReadAll(conn)
+	if err != nil {
+		return ScanResult{}, platformErrors.NewExternalServiceError("clamd", "scan", err.Error())
+	}
+	return parseClamdReply(name, reply)
+}
+
+// parseClamdReply reads "stream: OK" or "stream: {signature} FOUND"
+func parseClamdReply(name string, reply []byte) (ScanResult, error) {
+	line := strings.TrimSpace(string(bytes.TrimRight(reply, "\x00")))
+	_, verdict, _ := strings.Cut(line, ": ")
+	switch {
+	case verdict == "OK":
+		return ScanResult{Status: ScanClean, Engine: "clamd"}, nil
+	case strings.HasSuffix(verdict, " FOUND"):
+		return ScanResult{Status: ScanInfected, Threat: strings.TrimSuffix(verdict, " FOUND"), Engine: "clamd"}, nil
+	default:
+		return ScanResult{}, platformErrors.NewExternalServiceError("clamd", "scan", fmt.Sprintf("could not scan %s: %s", name, line))
+	}
+}
@@ -0,0 +1,95 @@
+package attachments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// DiskBlobStore keeps blobs as files under a directory, one file per key
+type DiskBlobStore struct {
+	dir string
+}
+
+// NewDiskBlobStore creates the directory when missing; an empty dir uses one under the OS temp
+// dir, which suits local development only
+func NewDiskBlobStore(dir string) (*DiskBlobStore, error) {
+	if dir == "" {
+		dir = filepath.Join(os.TempDir(), "rx-blobs")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("create blob directory %s: %w", dir, err)
+	}
+	return &DiskBlobStore{dir: dir}, nil
+}
+
+func (s *DiskBlobStore) Name() string { return "disk" }
+
+// Dir returns the directory the blobs are kept in
+func (s *DiskBlobStore) Dir() string { return s.dir }
+
+// Put writes to a temporary file first, so a reader never sees a partly written blob
+func (s *DiskBlobStore) Put(_ context.Context, key string, data []byte, _ string) error {
+	file, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0o700); err != nil {
+		return fmt.Errorf("create blob directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(file), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("create blob file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write blob %s: %w", key, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("write blob %s: %w", key, err)
+	}
+	if err := os.Rename(tmp.Name(), file); err != nil {
+		return fmt.Errorf("store blob %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *DiskBlobStore) Get(_ context.Context, key string) ([]byte, error) {
+	file, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(file)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, platformErrors.NewRecordNotFoundError("blob", key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read blob %s: %w", key, err)
+	}
+	return data, nil
+}
+
+func (s *DiskBlobStore) Delete(_ context.Context, key string) error {
+	file, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(file); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("delete blob %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *DiskBlobStore) path(key string) (string, error) {
+	if err := checkKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,61 @@
+package attachments
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+type storedFile struct {
+	attachment Attachment
+	data       []byte
+}
+
+// MemoryStore keeps attachments in process memory; used when MongoDB is not configured
+type MemoryStore struct {
+	mu    sync.RWMutex
+	files map[string]storedFile
+}
+
+// NewMemoryStore creates an empty in-memory attachment store
+func NewMemoryStore() Store {
+	return &MemoryStore{files: map[string]storedFile{}}
+}
+
+func (s *MemoryStore) Put(_ context.Context, attachment Attachment, data []byte) (Attachment, error) {
+	attachment = prepare(attachment, data)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.files[attachment.ID] = storedFile{attachment: attachment, data: slices.Clone(data)}
+	return attachment, nil
+}
+
+func (s *MemoryStore) Get(_ context.Context, id string) (Attachment, []byte, error) {
+	s.mu.RLock()
+	f, ok := s.files[id]
+	s.mu.RUnlock()
+	if !ok {
+		return Attachment{}, nil, platformErrors.NewRecordNotFoundError("attachment", id)
+	}
+	if err := Verify(f.attachment, f.data); err != nil {
+		return Attachment{}, nil, err
+	}
+	return f.attachment, slices.Clone(f.data), nil
+}
+
+// prepare fills in the fields Put assigns
+func prepare(attachment Attachment, data []byte) Attachment {
+	if attachment.ID == "" {
+		attachment.ID = uuid.NewString()
+	}
+	attachment.Size = len(data)
+	attachment.SHA256 = Hash(data)
+	attachment.CreatedAt = time.Now()
+	return attachment
+}
@@ -0,0 +1,70 @@
+package attachments
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// mongoFile is the stored document: the metadata plus the content. Attachments are small
+// (signatures, single-page scans), well within MongoDB's 16MB document limit.
+type mongoFile struct {
+	Attachment `bson:",inline"`
+	Data       []byte `bson:"data"`
+}
+
+// MongoStore persists attachments in a MongoDB collection
+type MongoStore struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewMongoStore creates a MongoDB-backed attachment store
+func NewMongoStore(collection *mongo.Collection, logger *zap.Logger) Store {
+	return &MongoStore{collection: collection, logger: logger}
+}
+
+func (s *MongoStore) Put(ctx context.Context, attachment Attachment, data []byte) (Attachment, error) {
+	attachment = prepare(attachment, data)
+
+	if _, err := s.collection.InsertOne(ctx, mongoFile{Attachment: attachment, Data: data}); err != nil {
+		s.logger.Error("Failed to store attachment",
+			zap.String("owner_type", attachment.OwnerType),
+			zap.String("owner_id", attachment.OwnerID),
+			zap.Error(err))
+		return Attachment{}, platformErrors.HandleMongoError("Put", err)
+	}
+	return attachment, nil
+}
+
+func (s *MongoStore) Get(ctx context.Context, id string) (Attachment, []byte, error) {
+	var f mongoFile
+	if err := s.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&f); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return Attachment{}, nil, platformErrors.NewRecordNotFoundError("attachment", id)
+		}
+		return Attachment{}, nil, platformErrors.HandleMongoError("Get", err)
+	}
+	if err := Verify(f.Attachment, f.Data); err != nil {
+		s.logger.Error("Attachment failed integrity check", zap.String("attachment_id", id))
+		return Attachment{}, nil, err
+	}
+	return f.Attachment, f.Data, nil
+}
+
+// CreateIndexes creates the owner lookup index
+func (s *MongoStore) CreateIndexes(ctx context.Context) error {
+	_, err := s.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "owner_type", Value: 1}, {Key: "owner_id", Value: 1}},
+		Options: options.Index().SetName("owner_type_1_owner_id_1"),
+	})
+	if err != nil {
+		return platformErrors.HandleMongoError("CreateIndexes", err)
+	}
+	return nil
+}
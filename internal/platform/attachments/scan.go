@@ -0,0 +1,34 @@
+package attachments
+
+import (
+	"context"
+)
+
+// ScanStatus is the outcome of a virus scan
+type ScanStatus string
+
+const (
+	ScanClean     ScanStatus = "clean"
+	ScanInfected  ScanStatus = "infected"
+	ScanUnscanned ScanStatus = "unscanned" // No scanner is configured
+)
+
+// ScanResult is what a scanner found in one file
+type ScanResult struct {
+	Status ScanStatus
+	Threat string // Signature name of what was found, e.g. "Eicar-Signature"
+	Engine string // Scanner that checked the file, e.g. "clamd"
+}
+
+// Scanner checks uploaded files for malware before they are stored. An error means the file
+// could not be checked, not that it is infected.
+type Scanner interface {
+	Scan(ctx context.Context, name string, data []byte) (ScanResult, error)
+}
+
+// NoopScanner accepts every file without checking it; used when no scanner is configured
+type NoopScanner struct{}
+
+func (NoopScanner) Scan(context.Context, string, []byte) (ScanResult, error) {
+	return ScanResult{Status: ScanUnscanned}, nil
+}
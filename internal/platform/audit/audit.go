@@ -0,0 +1,54 @@
+// Package audit records who changed which document, when and why, with before/after snapshots.
+package audit
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Entry is a single audit trail record
+type Entry struct {
+	ID         string    `json:"id" bson:"_id"`
+	Action     string    `json:"action" bson:"action"`
+	Collection string    `json:"collection" bson:"collection"`
+	DocumentID string    `json:"document_id" bson:"document_id"`
+	Actor      string    `json:"actor" bson:"actor"`
+	Reason     string    `json:"reason,omitempty" bson:"reason,omitempty"`
+	Before     bson.M    `json:"before,omitempty" bson:"before,omitempty"`
+	After      bson.M    `json:"after,omitempty" bson:"after,omitempty"`
+	Metadata   bson.M    `json:"metadata,omitempty" bson:"metadata,omitempty"`
+	At         time.Time `json:"at" bson:"at"`
+}
+
+// Store persists audit entries; entries are append-only
+type Store interface {
+	// Record stores an entry, assigning its ID and timestamp when unset
+	Record(ctx context.Context, entry Entry) (Entry, error)
+	// ListByDocument returns the entries for a document, newest first
+	ListByDocument(ctx context.Context, collection, documentID string, limit int) ([]Entry, error)
+	// ListByDocumentAfter returns the entries for a document oldest first, starting after the
+	// position; the zero Position starts at the first entry
+	ListByDocumentAfter(ctx context.Context, collection, documentID string, after Position, limit int) ([]Entry, error)
+}
+
+// Position is the place of an entry in a document's trail. Entries are ordered by time, and by ID
+// for entries recorded at the same time.
+type Position struct {
+	At time.Time
+	ID string
+}
+
+// PositionOf returns the position of the entry
+func PositionOf(entry Entry) Position {
+	return Position{At: entry.At, ID: entry.ID}
+}
+
+// Before reports whether p comes before other in the trail
+func (p Position) Before(other Position) bool {
+	if !p.At.Equal(other.At) {
+		return p.At.Before(other.At)
+	}
+	return p.ID < other.ID
+}
@@ -0,0 +1,76 @@
+package audit
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MemoryStore keeps audit entries in process memory; used when MongoDB is not configured
+type MemoryStore struct {
+	mu      sync.RWMutex
+	entries []Entry
+}
+
+// NewMemoryStore creates an empty in-memory audit store
+func NewMemoryStore() Store {
+	return &MemoryStore{}
+}
+
+func (s *MemoryStore) Record(_ context.Context, entry Entry) (Entry, error) {
+	if entry.ID == "" {
+		entry.ID = uuid.NewString()
+	}
+	if entry.At.IsZero() {
+		entry.At = time.Now()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = append(s.entries, entry)
+	return entry, nil
+}
+
+func (s *MemoryStore) ListByDocument(_ context.Context, collection, documentID string, limit int) ([]Entry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	out := []Entry{}
+	for i := len(s.entries) - 1; i >= 0; i-- {
+		e := s.entries[i]
+		if e.Collection != collection || e.DocumentID != documentID {
+			continue
+		}
+		out = append(out, e)
+		if limit > 0 && len(out) == limit {
+			break
+		}
+	}
+	return out, nil
+}
+
+func (s *MemoryStore) ListByDocumentAfter(_ context.Context, collection, documentID string, after Position, limit int) ([]Entry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	out := []Entry{}
+	for _, e := range s.entries {
+		if e.Collection != collection || e.DocumentID != documentID {
+			continue
+		}
+		if !after.At.IsZero() && !after.Before(PositionOf(e)) {
+			continue
+		}
+		out = append(out, e)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return PositionOf(out[i]).Before(PositionOf(out[j]))
+	})
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
@@ -0,0 +1,100 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// MongoStore persists audit entries in a MongoDB collection
+type MongoStore struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewMongoStore creates a MongoDB-backed audit store
+func NewMongoStore(collection *mongo.Collection, logger *zap.Logger) Store {
+	return &MongoStore{collection: collection, logger: logger}
+}
+
+func (s *MongoStore) Record(ctx context.Context, entry Entry) (Entry, error) {
+	if entry.ID == "" {
+		entry.ID = uuid.NewString()
+	}
+	if entry.At.IsZero() {
+		entry.At = time.Now()
+	}
+
+	if _, err := s.collection.InsertOne(ctx, entry); err != nil {
+		s.logger.Error("Failed to record audit entry",
+			zap.String("action", entry.Action),
+			zap.String("collection", entry.Collection),
+			zap.Error(err))
+		return Entry{}, platformErrors.HandleMongoError("Record", err)
+	}
+	return entry, nil
+}
+
+func (s *MongoStore) ListByDocument(ctx context.Context, collection, documentID string, limit int) ([]Entry, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "at", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := s.collection.Find(ctx, bson.M{"collection": collection, "document_id": documentID}, opts)
+	if err != nil {
+		return nil, platformErrors.HandleMongoError("ListByDocument", err)
+	}
+	defer cursor.Close(ctx)
+
+	entries := []Entry{}
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, platformErrors.HandleMongoError("ListByDocument", err)
+	}
+	return entries, nil
+}
+
+func (s *MongoStore) ListByDocumentAfter(ctx context.Context, collection, documentID string, after Position, limit int) ([]Entry, error) {
+	filter := bson.M{"collection": collection, "document_id": documentID}
+	if !after.At.IsZero() {
+		filter["$or"] = bson.A{
+			bson.M{"at": bson.M{"$gt": after.At}},
+			bson.M{"at": after.At, "_id": bson.M{"$gt": after.ID}},
+		}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "at", Value: 1}, {Key: "_id", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := s.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, platformErrors.HandleMongoError("ListByDocumentAfter", err)
+	}
+	defer cursor.Close(ctx)
+
+	entries := []Entry{}
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, platformErrors.HandleMongoError("ListByDocumentAfter", err)
+	}
+	return entries, nil
+}
+
+// CreateIndexes creates the lookup index used by ListByDocument and ListByDocumentAfter
+func (s *MongoStore) CreateIndexes(ctx context.Context) error {
+	_, err := s.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "collection", Value: 1}, {Key: "document_id", Value: 1}, {Key: "at", Value: -1}},
+		Options: options.Index().SetName("collection_1_document_id_1_at_-1"),
+	})
+	if err != nil {
+		return platformErrors.HandleMongoError("CreateIndexes", err)
+	}
+	return nil
+}
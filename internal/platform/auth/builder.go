@@ -1,15 +1,22 @@
 package auth
 
 import (
+	"crypto/rand"
 	"fmt"
+	"slices"
 
 	"go.uber.org/zap"
 )
 
+// minSigningSecretLength is the shortest HMAC secret accepted for HS256 local tokens
+const minSigningSecretLength = 32
+
 // Builder helps configure and initialize the authentication system
 type Builder struct {
 	jwtConfig JWTConfig
 	devMode   bool
+	devUsers  string
+	tenancy   bool
 	env       string
 	logger    *zap.Logger
 }
@@ -40,12 +47,30 @@ func (b *Builder) WithTokenTypes(tokenTypes []TokenType) *Builder {
 	return b
 }
 
+// WithSigningSecret sets the HMAC secret for local tokens
+func (b *Builder) WithSigningSecret(secret string) *Builder {
+	b.jwtConfig.SigningSecret = []byte(secret)
+	return b
+}
+
 // WithDevMode enables or disables development mode
 func (b *Builder) WithDevMode(enabled bool) *Builder {
 	b.devMode = enabled
 	return b
 }
 
+// WithDevUsersFile adds the mock users of a YAML file to the built-in ones in dev mode
+func (b *Builder) WithDevUsersFile(path string) *Builder {
+	b.devUsers = path
+	return b
+}
+
+// WithTenancy enables or disables scoping requests to the user's organization
+func (b *Builder) WithTenancy(enabled bool) *Builder {
+	b.tenancy = enabled
+	return b
+}
+
 // WithEnvironment sets the application environment (dev, prod, etc.)
 func (b *Builder) WithEnvironment(env string) *Builder {
 	b.env = env
@@ -61,6 +86,25 @@ func (b *Builder) WithLogger(logger *zap.Logger) *Builder {
 // Build initializes the authentication system with all configured options
 // Returns an error if configuration is invalid or unsafe
 func (b *Builder) Build() error {
+	// Local tokens need a secret shared by every instance; outside production a random one is
+	// generated so logins work out of the box, but tokens do not survive a restart
+	if slices.Contains(b.jwtConfig.TokenTypes, TokenTypeLocal) {
+		switch {
+		case len(b.jwtConfig.SigningSecret) >= minSigningSecretLength:
+		case b.env == "prod":
+			return fmt.Errorf("FATAL: local tokens need a signing secret of at least %d bytes (RX_AUTH_JWT_SECRET)", minSigningSecretLength)
+		default:
+			secret := make([]byte, minSigningSecretLength)
+			if _, err := rand.Read(secret); err != nil {
+				return fmt.Errorf("failed to generate signing secret: %w", err)
+			}
+			b.jwtConfig.SigningSecret = secret
+			if b.logger != nil {
+				b.logger.Warn("No JWT signing secret configured; using a random one, local tokens will not survive a restart")
+			}
+		}
+	}
+
 	// Initialize JWT configuration
 	if err := InitJWTConfig(b.jwtConfig); err != nil {
 		return fmt.Errorf("failed to initialize JWT config: %w", err)
@@ -71,8 +115,22 @@ func (b *Builder) Build() error {
 		return fmt.Errorf("FATAL: Dev mode cannot be enabled in production environment")
 	}
 
+	if b.logger != nil {
+		logger = b.logger
+	}
+
 	// Initialize dev mode
 	InitDevMode(b.devMode)
+	InitTenancy(b.tenancy)
+	if b.devMode && b.devUsers != "" {
+		users, err := LoadMockUsers(b.devUsers)
+		if err != nil {
+			return err
+		}
+		for _, mock := range users {
+			AddMockUser(mock.Key, mock.User)
+		}
+	}
 
 	// Log warnings if dev mode is active
 	if b.devMode {
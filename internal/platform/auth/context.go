@@ -3,17 +3,49 @@ package auth
 import (
 	"context"
 	"errors"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/i18n"
+	"pharmacy-modernization-project-model/internal/platform/logging"
 )
 
 type contextKey string
 
-const userContextKey contextKey = "auth_user"
+const (
+	userContextKey     contextKey = "auth_user"
+	userSlotContextKey contextKey = "auth_user_slot"
+)
+
+// userSlot records the user authenticated further down the handler chain
+type userSlot struct {
+	user *User
+}
 
-// SetUser stores the authenticated user in the context
+// SetUser stores the authenticated user in the context and adds the user and their organization
+// to the request's log entries
 func SetUser(ctx context.Context, user *User) context.Context {
+	if slot, ok := ctx.Value(userSlotContextKey).(*userSlot); ok {
+		slot.user = user
+	}
+	logging.AddFields(ctx, zap.String("user_id", user.ID))
+	if user.OrgID != "" {
+		logging.AddFields(ctx, zap.String("tenant", user.OrgID))
+	}
+	if user.Locale != "" {
+		ctx = i18n.WithUserLocale(ctx, user.Locale)
+	}
 	return context.WithValue(ctx, userContextKey, user)
 }
 
+// TrackUser lets middleware that runs before authentication see who was authenticated.
+// The returned function reports the user set by SetUser on the returned context (or one
+// derived from it), or nil when the request was not authenticated.
+func TrackUser(ctx context.Context) (context.Context, func() *User) {
+	slot := &userSlot{}
+	return context.WithValue(ctx, userSlotContextKey, slot), func() *User { return slot.user }
+}
+
 // GetCurrentUser retrieves the authenticated user from the context
 func GetCurrentUser(ctx context.Context) (*User, error) {
 	user, ok := ctx.Value(userContextKey).(*User)
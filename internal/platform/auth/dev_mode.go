@@ -2,21 +2,38 @@ package auth
 
 import (
 	"encoding/json"
-	"log"
+	"errors"
+	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 
-	"pharmacy-modernization-project-model/internal/platform/sanitizer"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/permissions"
+	"pharmacy-modernization-project-model/internal/platform/tenancy"
 )
 
 // DevMode configuration
 var devModeEnabled bool
 var mockUsers map[string]*User
 
+// Mock user selection
+const (
+	MockUserHeader       = "X-Mock-User"
+	MockUserCookie       = "mock-user"
+	mockUserCookieMaxAge = 3600 // 1 hour
+	defaultMockUser      = "admin"
+)
+
+// ErrDevModeDisabled is returned when switching mock users outside dev mode
+var ErrDevModeDisabled = errors.New("dev mode not enabled")
+
 // InitDevMode initializes development mode with mock users
 func InitDevMode(enabled bool) {
 	devModeEnabled = enabled
 	if enabled {
-		log.Println("⚠️  AUTH DEV MODE ENABLED - Security bypassed with mock users")
+		logger.Warn("⚠️  AUTH DEV MODE ENABLED - Security bypassed with mock users")
 		initializeMockUsers()
 	}
 }
@@ -34,7 +51,7 @@ func initializeMockUsers() {
 			Email: "admin@dev.local",
 			Name:  "Mock Admin",
 			Permissions: []string{
-				"admin:all",
+				permissions.AdminAll,
 			},
 		},
 		"doctor": {
@@ -42,13 +59,14 @@ func initializeMockUsers() {
 			Email: "doctor@dev.local",
 			Name:  "Dr. Dev",
 			Permissions: []string{
-				"patient:read",
-				"patient:write",
-				"prescription:read",
-				"prescription:write",
-				"prescription:approve",
-				"doctor:role",
-				"dashboard:view",
+				permissions.PatientRead,
+				permissions.PatientWrite,
+				permissions.PrescriptionRead,
+				permissions.PrescriptionWrite,
+				permissions.PrescriptionApprove,
+				permissions.DoctorRole,
+				permissions.DashboardView,
+				permissions.AdherenceRead,
 			},
 		},
 		"pharmacist": {
@@ -56,10 +74,14 @@ func initializeMockUsers() {
 			Email: "pharmacist@dev.local",
 			Name:  "Dev Pharmacist",
 			Permissions: []string{
-				"prescription:read",
-				"prescription:dispense",
-				"pharmacist:role",
-				"dashboard:view",
+				permissions.PrescriptionRead,
+				permissions.PrescriptionDispense,
+				permissions.PrescriptionReverseDispense,
+				permissions.BillingRead,
+				permissions.BillingWrite,
+				permissions.BillingAcknowledge,
+				permissions.PharmacistRole,
+				permissions.DashboardView,
 			},
 		},
 		"nurse": {
@@ -67,9 +89,10 @@ func initializeMockUsers() {
 			Email: "nurse@dev.local",
 			Name:  "Dev Nurse",
 			Permissions: []string{
-				"patient:read",
-				"nurse:role",
-				"dashboard:view",
+				permissions.PatientRead,
+				permissions.NurseRole,
+				permissions.DashboardView,
+				permissions.AdherenceRead,
 			},
 		},
 		"readonly": {
@@ -77,25 +100,51 @@ func initializeMockUsers() {
 			Email: "readonly@dev.local",
 			Name:  "Dev Readonly User",
 			Permissions: []string{
-				"patient:read",
-				"prescription:read",
-				"dashboard:view",
+				permissions.PatientRead,
+				permissions.PrescriptionRead,
+				permissions.DashboardView,
+			},
+		},
+		"portal": {
+			ID:       "mock-portal-001",
+			Email:    "portal@dev.local",
+			Name:     "Dev Patient Portal",
+			ClientID: "patient-portal",
+			Permissions: []string{
+				permissions.PatientRead,
+				permissions.PrescriptionRead,
+			},
+		},
+		"reporting": {
+			ID:     "mock-reporting-001",
+			Email:  "reporting@dev.local",
+			Name:   "Dev Reporting Service",
+			Scopes: []string{"reporting"},
+			Permissions: []string{
+				permissions.PatientRead,
+				permissions.PatientExport,
+				permissions.PrescriptionRead,
+				permissions.DashboardView,
 			},
 		},
 	}
+	// Mock users share the organization of the seeded in-memory data
+	for _, user := range mockUsers {
+		user.OrgID = tenancy.DefaultOrgID
+	}
 }
 
 // AddMockUser allows adding custom mock users for testing
 func AddMockUser(key string, user *User) {
 	if !devModeEnabled {
-		log.Println("Warning: Cannot add mock user - dev mode not enabled")
+		logger.Warn("Cannot add mock user - dev mode not enabled")
 		return
 	}
 	if mockUsers == nil {
 		mockUsers = make(map[string]*User)
 	}
 	mockUsers[key] = user
-	log.Printf("✓ Added mock user: %s (%s)", sanitizer.ForLogging(key), sanitizer.ForLogging(user.Name))
+	logger.Info("Added mock user", zap.String("mock_user", key), zap.String("user_id", user.ID))
 }
 
 // GetMockUser retrieves a mock user by key
@@ -114,6 +163,19 @@ func ListMockUsers() map[string]*User {
 	return mockUsers
 }
 
+// SortedMockUsers returns all available mock users ordered by key
+func SortedMockUsers() []MockUser {
+	if !devModeEnabled {
+		return nil
+	}
+	users := make([]MockUser, 0, len(mockUsers))
+	for key, user := range mockUsers {
+		users = append(users, MockUser{Key: key, User: user})
+	}
+	slices.SortFunc(users, func(a, b MockUser) int { return strings.Compare(a.Key, b.Key) })
+	return users
+}
+
 // DevAuthMiddleware bypasses real authentication in dev mode
 // Uses mock users based on X-Mock-User header, mock-user cookie, or defaults to admin
 func DevAuthMiddleware() func(http.Handler) http.Handler {
@@ -125,36 +187,80 @@ func DevAuthMiddleware() func(http.Handler) http.Handler {
 				return
 			}
 
-			// Check for mock user selection via header first, then cookie
-			mockUserKey := r.Header.Get("X-Mock-User")
-			if mockUserKey == "" {
-				// Try cookie as fallback
-				if cookie, err := r.Cookie("mock-user"); err == nil {
-					mockUserKey = cookie.Value
-				}
-			}
-
-			if mockUserKey == "" {
-				// Default to admin user
-				mockUserKey = "admin"
-			}
-
+			requested := requestedMockUser(r)
+			mockUserKey := requested
 			user := mockUsers[mockUserKey]
 			if user == nil {
-				log.Printf("Warning: Unknown mock user '%s', using admin", sanitizer.ForLogging(mockUserKey))
-				user = mockUsers["admin"]
+				requestLog(r).Warn("Unknown mock user, using admin", zap.String("mock_user", mockUserKey))
+				mockUserKey = defaultMockUser
+				user = mockUsers[mockUserKey]
 			}
 
-			log.Printf("DEV AUTH: Using mock user '%s' (%s) with permissions: %v",
-				sanitizer.ForLogging(mockUserKey), sanitizer.ForLogging(user.Email), user.Permissions)
+			// Every request made by a mock user says so, so a log never passes for real traffic
+			requestLog(r).Warn("🎭 MOCK USER REQUEST - authentication bypassed",
+				zap.String("mock_user", mockUserKey),
+				zap.String("user_id", user.ID),
+				zap.String("path", r.URL.Path),
+				zap.Strings("permissions", user.Permissions))
 
 			// Set user in context
-			ctx := SetUser(r.Context(), user)
+			ctx, refused := scopeToOrg(SetUser(r.Context(), user), r, user)
+			if refused != "" {
+				handleOrgForbidden(w, r, user, refused)
+				return
+			}
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
 
+// requestedMockUser returns the mock user a request selects: the X-Mock-User header first, then
+// the mock-user cookie, then admin
+func requestedMockUser(r *http.Request) string {
+	if key := r.Header.Get(MockUserHeader); key != "" {
+		return key
+	}
+	if cookie, err := r.Cookie(MockUserCookie); err == nil && cookie.Value != "" {
+		return cookie.Value
+	}
+	return defaultMockUser
+}
+
+// ActiveMockUser returns the key of the mock user DevAuthMiddleware acts as for a request;
+// unknown users fall back to admin
+func ActiveMockUser(r *http.Request) string {
+	if key := requestedMockUser(r); mockUsers[key] != nil {
+		return key
+	}
+	return defaultMockUser
+}
+
+// SwitchMockUser sets the mock-user cookie, so browser requests without an X-Mock-User header
+// act as the given mock user
+func SwitchMockUser(w http.ResponseWriter, key string) error {
+	if !devModeEnabled {
+		return ErrDevModeDisabled
+	}
+	if _, exists := mockUsers[key]; !exists {
+		return fmt.Errorf("unknown mock user %q", key)
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     MockUserCookie,
+		Value:    key,
+		Path:     "/",
+		MaxAge:   mockUserCookieMaxAge,
+		HttpOnly: false, // Allow JavaScript access for easier testing
+		Secure:   false, // Allow on localhost
+		SameSite: http.SameSiteLaxMode,
+	})
+	return nil
+}
+
+// ResetMockUser clears the mock-user cookie, returning browser requests to admin
+func ResetMockUser(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{Name: MockUserCookie, Value: "", Path: "/", MaxAge: -1})
+}
+
 // DevAuthInfo returns information about dev mode and available users
 func DevAuthInfo(w http.ResponseWriter, r *http.Request) {
 	if !devModeEnabled {
@@ -184,6 +290,7 @@ func DevAuthInfo(w http.ResponseWriter, r *http.Request) {
 			"default":      "admin",
 			"example_curl": "curl -H 'X-Mock-User: doctor' http://localhost:8080/patients",
 			"switch_user":  "/__dev/switch?user=<key>",
+			"users_page":   "/dev/users",
 		},
 	}
 
@@ -204,22 +311,11 @@ func SetMockUserCookie(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Validate user exists
-	if _, exists := mockUsers[userKey]; !exists {
+	if err := SwitchMockUser(w, userKey); err != nil {
 		http.Error(w, "Invalid mock user", http.StatusBadRequest)
 		return
 	}
 
-	// Set cookie
-	http.SetCookie(w, &http.Cookie{
-		Name:     "mock-user",
-		Value:    userKey,
-		Path:     "/",
-		MaxAge:   3600,  // 1 hour
-		HttpOnly: false, // Allow JavaScript access for easier testing
-		Secure:   false, // Allow on localhost
-	})
-
 	// Redirect back to home or return success
 	redirect := r.URL.Query().Get("redirect")
 	if redirect == "" {
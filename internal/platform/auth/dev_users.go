@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"pharmacy-modernization-project-model/internal/platform/i18n"
+	"pharmacy-modernization-project-model/internal/platform/permissions"
+	"pharmacy-modernization-project-model/internal/platform/tenancy"
+)
+
+// mockUsersFile is the YAML form of custom mock users, see internal/configs/dev_users.example.yaml
+type mockUsersFile struct {
+	Users []struct {
+		Key             string   `yaml:"key"`
+		ID              string   `yaml:"id"`
+		Name            string   `yaml:"name"`
+		Email           string   `yaml:"email"`
+		Permissions     []string `yaml:"permissions"`
+		DataAccessRoles []string `yaml:"data_access_roles"`
+		FuncRoles       []string `yaml:"func_roles"`
+		ClientID        string   `yaml:"client_id"`
+		Scopes          []string `yaml:"scopes"`
+		OrgID           string   `yaml:"org_id"`
+		Locale          string   `yaml:"locale"`
+	} `yaml:"users"`
+}
+
+// MockUser is a mock user with the key it is selected by
+type MockUser struct {
+	Key  string
+	User *User
+}
+
+// LoadMockUsers reads custom mock users from a YAML file
+func LoadMockUsers(path string) ([]MockUser, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("dev users file: %w", err)
+	}
+	return ParseMockUsers(data)
+}
+
+// ParseMockUsers reads mock users in the YAML form of dev_users.example.yaml. Every user needs a
+// unique key and may only list catalogued permissions; the ID defaults to "mock-<key>" and the
+// organization to the one of the seeded in-memory data.
+func ParseMockUsers(data []byte) ([]MockUser, error) {
+	var file mockUsersFile
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil {
+		return nil, fmt.Errorf("dev users file: %w", err)
+	}
+
+	users := make([]MockUser, 0, len(file.Users))
+	seen := make(map[string]bool, len(file.Users))
+	for i, entry := range file.Users {
+		if entry.Key == "" {
+			return nil, fmt.Errorf("dev users file: users[%d] needs a key", i)
+		}
+		if seen[entry.Key] {
+			return nil, fmt.Errorf("dev users file: users[%d] repeats key %q", i, entry.Key)
+		}
+		seen[entry.Key] = true
+		if err := permissions.Validate(entry.Permissions...); err != nil {
+			return nil, fmt.Errorf("dev users file: users[%d] %s: %w", i, entry.Key, err)
+		}
+		if entry.Locale != "" && !i18n.Supported(entry.Locale) {
+			return nil, fmt.Errorf("dev users file: users[%d] %s: unsupported locale %q (supported: %s)", i, entry.Key, entry.Locale, strings.Join(i18n.Locales(), ", "))
+		}
+
+		user := &User{
+			ID:              entry.ID,
+			Email:           entry.Email,
+			Name:            entry.Name,
+			Permissions:     entry.Permissions,
+			DataAccessRoles: entry.DataAccessRoles,
+			ClientID:        entry.ClientID,
+			Scopes:          entry.Scopes,
+			OrgID:           entry.OrgID,
+			Locale:          entry.Locale,
+		}
+		if user.ID == "" {
+			user.ID = "mock-" + entry.Key
+		}
+		if user.Name == "" {
+			user.Name = entry.Key
+		}
+		if user.OrgID == "" {
+			user.OrgID = tenancy.DefaultOrgID
+		}
+		for _, role := range entry.FuncRoles {
+			user.FuncRoles = append(user.FuncRoles, FuncRole{RoleName: role})
+		}
+		users = append(users, MockUser{Key: entry.Key, User: user})
+	}
+	return users, nil
+}
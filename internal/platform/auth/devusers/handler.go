@@ -0,0 +1,155 @@
+// Package devusers serves the dev mode mock users and switches between them: as JSON for tooling
+// and as a page for developers. Nothing is mounted outside dev mode.
+package devusers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+	"pharmacy-modernization-project-model/internal/platform/paths"
+	admincomponents "pharmacy-modernization-project-model/web/components/admin"
+)
+
+// SwitchRequest selects the mock user browser requests act as
+type SwitchRequest struct {
+	Key string `json:"key" form:"key" validate:"required,max=100"`
+}
+
+// UserResponse is a mock user
+type UserResponse struct {
+	Key             string   `json:"key"`
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	Email           string   `json:"email"`
+	Permissions     []string `json:"permissions"`
+	DataAccessRoles []string `json:"data_access_roles,omitempty"`
+	FuncRoles       []string `json:"func_roles,omitempty"`
+	ClientID        string   `json:"client_id,omitempty"`
+	Scopes          []string `json:"scopes,omitempty"`
+	OrgID           string   `json:"org_id,omitempty"`
+	Locale          string   `json:"locale,omitempty"`
+}
+
+// UsersResponse is the body of GET /dev/api/users; Active is the user the request acted as
+type UsersResponse struct {
+	Active string         `json:"active"`
+	Users  []UserResponse `json:"users"`
+}
+
+// Handler serves the mock users
+type Handler struct {
+	log *zap.Logger
+}
+
+func NewHandler(log *zap.Logger) *Handler {
+	return &Handler{log: log}
+}
+
+// RegisterRoutes mounts the mock user API and page when dev mode is enabled
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	if !auth.IsDevModeEnabled() {
+		return
+	}
+	r.Group(func(r chi.Router) {
+		r.Use(auth.RequireAuthWithDevMode())
+		r.Get(paths.DevUsersPath, h.Page)
+		r.Post(paths.DevUsersPath+"/active", h.SwitchFromPage)
+		r.Get(paths.DevUsersAPIPath, h.List)
+		r.Put(paths.DevUsersAPIPath+"/active", h.Switch)
+		r.Delete(paths.DevUsersAPIPath+"/active", h.Reset)
+	})
+	h.log.Info("Dev mode mock user switcher registered", zap.String("path", paths.DevUsersPath))
+}
+
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	helper.WriteOK(w, usersResponse(auth.ActiveMockUser(r)))
+}
+
+// Switch sets the mock-user cookie; requests sending an X-Mock-User header still act as that user
+func (h *Handler) Switch(w http.ResponseWriter, r *http.Request) {
+	req, fieldErrors, err := bind.JSON[SwitchRequest](r)
+	if err != nil {
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+	if err := h.switchTo(w, r, req.Key); err != nil {
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, usersResponse(req.Key))
+}
+
+// Reset clears the mock-user cookie, so browser requests act as admin again
+func (h *Handler) Reset(w http.ResponseWriter, r *http.Request) {
+	auth.ResetMockUser(w)
+	h.log.Info("mock user reset", zap.String("from", auth.ActiveMockUser(r)))
+	helper.WriteNoContent(w)
+}
+
+func (h *Handler) Page(w http.ResponseWriter, r *http.Request) {
+	h.renderPage(w, r, auth.ActiveMockUser(r), "", http.StatusOK)
+}
+
+// SwitchFromPage switches the mock user and returns to the page, or shows the page with why it
+// failed
+func (h *Handler) SwitchFromPage(w http.ResponseWriter, r *http.Request) {
+	req, _, err := bind.Form[SwitchRequest](r)
+	if err == nil {
+		err = h.switchTo(w, r, req.Key)
+	}
+	if err == nil {
+		http.Redirect(w, r, paths.DevUsersPath, http.StatusSeeOther)
+		return
+	}
+	h.renderPage(w, r, auth.ActiveMockUser(r), err.Error(), http.StatusBadRequest)
+}
+
+func (h *Handler) switchTo(w http.ResponseWriter, r *http.Request, key string) error {
+	if err := auth.SwitchMockUser(w, key); err != nil {
+		return platformErrors.NewValidationError("key", key, "not a mock user")
+	}
+	h.log.Info("mock user switched",
+		zap.String("from", auth.ActiveMockUser(r)),
+		zap.String("to", key))
+	return nil
+}
+
+func (h *Handler) renderPage(w http.ResponseWriter, r *http.Request, active, message string, status int) {
+	w.WriteHeader(status)
+	page := admincomponents.DevUsersPage(admincomponents.DevUsersPageParam{
+		Active: active,
+		Users:  auth.SortedMockUsers(),
+		Error:  message,
+	})
+	if err := page.Render(r.Context(), w); err != nil {
+		h.log.Error("failed to render mock users page", zap.Error(err))
+	}
+}
+
+func usersResponse(active string) UsersResponse {
+	mocks := auth.SortedMockUsers()
+	users := make([]UserResponse, 0, len(mocks))
+	for _, mock := range mocks {
+		users = append(users, UserResponse{
+			Key:             mock.Key,
+			ID:              mock.User.ID,
+			Name:            mock.User.Name,
+			Email:           mock.User.Email,
+			Permissions:     mock.User.Permissions,
+			DataAccessRoles: mock.User.DataAccessRoles,
+			FuncRoles:       mock.User.GetFuncRoleNames(),
+			ClientID:        mock.User.ClientID,
+			Scopes:          mock.User.Scopes,
+			OrgID:           mock.User.OrgID,
+			Locale:          mock.User.Locale,
+		})
+	}
+	return UsersResponse{Active: active, Users: users}
+}
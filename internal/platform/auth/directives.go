@@ -6,6 +6,8 @@ import (
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"pharmacy-modernization-project-model/internal/platform/permissions"
 )
 
 // AuthDirective implements @auth directive for GraphQL
@@ -31,27 +33,49 @@ func AuthDirective() func(ctx context.Context, obj interface{}, next graphql.Res
 // Returns FORBIDDEN error (403) if user lacks any of the required permissions
 func PermissionAnyDirective() func(ctx context.Context, obj interface{}, next graphql.Resolver, requires []string) (interface{}, error) {
 	return func(ctx context.Context, obj interface{}, next graphql.Resolver, requires []string) (interface{}, error) {
-		user, err := GetCurrentUser(ctx)
-		if err != nil || user == nil {
-			return nil, &gqlerror.Error{
-				Message: "Unauthenticated",
-				Extensions: map[string]interface{}{
-					"code":   "UNAUTHENTICATED",
-					"status": 401,
-				},
-			}
+		if err := RequireAnyPermission(ctx, requires); err != nil {
+			return nil, err
 		}
 
-		if !HasAnyPermission(user.Permissions, requires) {
-			return nil, &gqlerror.Error{
-				Message: fmt.Sprintf("Forbidden: requires at least one of: %v", requires),
-				Extensions: map[string]interface{}{
-					"code":                 "FORBIDDEN",
-					"status":               403,
-					"required_permissions": requires,
-					"match":                "any",
-				},
-			}
+		return next(ctx)
+	}
+}
+
+// RequireAnyPermission returns the error of the @permissionAny directive unless the user has one
+// of the permissions, for resolvers of fields that cannot carry the directive
+func RequireAnyPermission(ctx context.Context, requires []string) error {
+	user, err := GetCurrentUser(ctx)
+	if err != nil || user == nil {
+		return &gqlerror.Error{
+			Message: "Unauthenticated",
+			Extensions: map[string]interface{}{
+				"code":   "UNAUTHENTICATED",
+				"status": 401,
+			},
+		}
+	}
+
+	if !HasAnyPermission(user.Permissions, requires) {
+		return &gqlerror.Error{
+			Message: fmt.Sprintf("Forbidden: requires at least one of: %v", requires),
+			Extensions: map[string]interface{}{
+				"code":                 "FORBIDDEN",
+				"status":               403,
+				"required_permissions": requires,
+				"match":                "any",
+			},
+		}
+	}
+	return nil
+}
+
+// HasPermissionDirective implements @hasPermission directive for GraphQL
+// Returns FORBIDDEN error (403) unless the user has the permission or one the permission
+// catalogue accepts in its place, such as admin:all
+func HasPermissionDirective() func(ctx context.Context, obj interface{}, next graphql.Resolver, permission string) (interface{}, error) {
+	return func(ctx context.Context, obj interface{}, next graphql.Resolver, permission string) (interface{}, error) {
+		if err := RequireAnyPermission(ctx, permissions.Accepting(permission)); err != nil {
+			return nil, err
 		}
 
 		return next(ctx)
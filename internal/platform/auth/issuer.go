@@ -0,0 +1,199 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// ErrInvalidRefreshToken is returned for refresh tokens that are malformed, expired or revoked
+var ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+
+// IssuerConfig controls the lifetime of tokens issued by the login endpoint
+type IssuerConfig struct {
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+	Refresh    bool // Issue a refresh token with each access token
+}
+
+// TokenPair is an access token with the refresh token that renews it, if refresh is enabled
+type TokenPair struct {
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+}
+
+// TokenIssuer mints local tokens, which RequireAuth accepts once the local token type is enabled.
+// Refresh tokens are signed with a key derived from the secret, so they never pass as access tokens.
+type TokenIssuer struct {
+	secret        []byte
+	refreshSecret []byte
+	issuer        string
+	audience      []string
+	accessTTL     time.Duration
+	refreshTTL    time.Duration
+	refresh       bool
+	revocations   RevocationStore
+}
+
+// NewTokenIssuer creates an issuer for the local token type, remembering used and revoked refresh
+// tokens in revocations; call after the JWT config is initialized
+func NewTokenIssuer(cfg IssuerConfig, revocations RevocationStore) (*TokenIssuer, error) {
+	if !slices.Contains(jwtConfig.TokenTypes, TokenTypeLocal) {
+		return nil, errors.New("local token type is not enabled")
+	}
+	tokenConfig := jwtConfig.TokenTypesConfig[TokenTypeLocal]
+	if len(tokenConfig.Issuer) == 0 {
+		return nil, errors.New("issuer is required for local tokens")
+	}
+	if cfg.AccessTTL <= 0 {
+		cfg.AccessTTL = time.Hour
+	}
+	if cfg.RefreshTTL <= 0 {
+		cfg.RefreshTTL = 24 * time.Hour
+	}
+
+	mac := hmac.New(sha256.New, jwtConfig.SigningSecret)
+	mac.Write([]byte("refresh-token"))
+
+	return &TokenIssuer{
+		secret:        jwtConfig.SigningSecret,
+		refreshSecret: mac.Sum(nil),
+		issuer:        tokenConfig.Issuer[0],
+		audience:      tokenConfig.Audience,
+		accessTTL:     cfg.AccessTTL,
+		refreshTTL:    cfg.RefreshTTL,
+		refresh:       cfg.Refresh,
+		revocations:   revocations,
+	}, nil
+}
+
+// RefreshEnabled reports whether Issue signs refresh tokens
+func (ti *TokenIssuer) RefreshEnabled() bool {
+	return ti.refresh
+}
+
+// Issue signs a new access token for the user, and a refresh token when refresh is enabled
+func (ti *TokenIssuer) Issue(user *User) (TokenPair, error) {
+	now := time.Now()
+	pair := TokenPair{AccessExpiresAt: now.Add(ti.accessTTL)}
+
+	access := userClaims(user)
+	access.RegisteredClaims = jwt.RegisteredClaims{
+		ID:        uuid.NewString(),
+		Subject:   user.ID,
+		Issuer:    ti.issuer,
+		Audience:  ti.audience,
+		IssuedAt:  jwt.NewNumericDate(now),
+		NotBefore: jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(pair.AccessExpiresAt),
+	}
+	var err error
+	if pair.AccessToken, err = jwt.NewWithClaims(jwt.SigningMethodHS256, access).SignedString(ti.secret); err != nil {
+		return TokenPair{}, fmt.Errorf("sign access token: %w", err)
+	}
+	if !ti.refresh {
+		return pair, nil
+	}
+
+	// Refresh re-reads the user's claims from the user store, so the refresh token only names the user
+	pair.RefreshExpiresAt = now.Add(ti.refreshTTL)
+	refresh := JWTClaims{UserID: user.ID}
+	refresh.RegisteredClaims = jwt.RegisteredClaims{
+		ID:        uuid.NewString(),
+		Subject:   user.ID,
+		Issuer:    ti.issuer,
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(pair.RefreshExpiresAt),
+	}
+	if pair.RefreshToken, err = jwt.NewWithClaims(jwt.SigningMethodHS256, refresh).SignedString(ti.refreshSecret); err != nil {
+		return TokenPair{}, fmt.Errorf("sign refresh token: %w", err)
+	}
+
+	return pair, nil
+}
+
+// UserLookup reads a user's current identity, e.g. from the login user store
+type UserLookup func(ctx context.Context, userID string) (*User, error)
+
+// Refresh exchanges a refresh token for a new pair issued to the user as lookup reads them now,
+// so removed permissions and users apply at the next refresh. The old refresh token is revoked,
+// so each one can only be used once.
+func (ti *TokenIssuer) Refresh(ctx context.Context, refreshToken string, lookup UserLookup) (*User, TokenPair, error) {
+	claims, err := ti.parseRefresh(refreshToken)
+	if err != nil {
+		return nil, TokenPair{}, err
+	}
+
+	user, err := lookup(ctx, claims.Subject)
+	if err != nil {
+		return nil, TokenPair{}, err
+	}
+
+	revoked, err := ti.revocations.Revoke(ctx, claims.ID, claims.ExpiresAt.Time)
+	if err != nil {
+		return nil, TokenPair{}, fmt.Errorf("revoke refresh token: %w", err)
+	}
+	if !revoked {
+		return nil, TokenPair{}, ErrInvalidRefreshToken
+	}
+
+	pair, err := ti.Issue(user)
+	if err != nil {
+		return nil, TokenPair{}, err
+	}
+	return user, pair, nil
+}
+
+// Revoke invalidates a refresh token; invalid tokens are ignored
+func (ti *TokenIssuer) Revoke(ctx context.Context, refreshToken string) error {
+	claims, err := ti.parseRefresh(refreshToken)
+	if err != nil {
+		return nil
+	}
+	_, err = ti.revocations.Revoke(ctx, claims.ID, claims.ExpiresAt.Time)
+	return err
+}
+
+func (ti *TokenIssuer) parseRefresh(refreshToken string) (*JWTClaims, error) {
+	if !ti.refresh {
+		return nil, ErrInvalidRefreshToken
+	}
+	claims := &JWTClaims{}
+	parser := jwt.NewParser(
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(ti.issuer),
+		jwt.WithExpirationRequired(),
+	)
+	token, err := parser.ParseWithClaims(refreshToken, claims, func(*jwt.Token) (interface{}, error) {
+		return ti.refreshSecret, nil
+	})
+	if err != nil || !token.Valid || claims.ID == "" || claims.Subject == "" {
+		return nil, ErrInvalidRefreshToken
+	}
+	return claims, nil
+}
+
+func userClaims(user *User) JWTClaims {
+	return JWTClaims{
+		UserID:          user.ID,
+		Email:           user.Email,
+		Name:            user.Name,
+		Permissions:     user.Permissions,
+		DataAccessRoles: user.DataAccessRoles,
+		FuncRoles:       user.FuncRoles,
+		ClientId:        user.ClientID,
+		Scope:           strings.Join(user.Scopes, " "),
+		OrgID:           user.OrgID,
+		Locale:          user.Locale,
+	}
+}
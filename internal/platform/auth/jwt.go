@@ -46,6 +46,13 @@ func InitJWTConfig(config JWTConfig) error {
 			}
 			tokenManager.RegisterIdentifier(identifier)
 
+		case types.TokenTypeLocal:
+			identifier, err := token_identifiers.NewLocalTokenIdentifier(tokenConfig, config)
+			if err != nil {
+				return fmt.Errorf("failed to create local token identifier: %w", err)
+			}
+			tokenManager.RegisterIdentifier(identifier)
+
 		default:
 			identifier, err := token_identifiers.NewDefaultTokenIdentifier(tokenType, tokenConfig, config)
 			if err != nil {
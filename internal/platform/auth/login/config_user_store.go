@@ -0,0 +1,97 @@
+package login
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"pharmacy-modernization-project-model/internal/platform/auth"
+)
+
+// ConfigUser is a user defined in the application config
+type ConfigUser struct {
+	Username        string
+	PasswordHash    string // bcrypt
+	Name            string
+	Email           string
+	Permissions     []string
+	DataAccessRoles []string
+	FuncRoles       []string
+	OrgID           string
+}
+
+// ConfigUserStore authenticates against users listed in the config; meant for local development
+type ConfigUserStore struct {
+	users map[string]ConfigUser
+	// dummyHash is compared against when the user does not exist, so unknown usernames take as
+	// long to reject as wrong passwords
+	dummyHash []byte
+}
+
+// NewConfigUserStore validates the configured users; usernames are case-insensitive
+func NewConfigUserStore(users []ConfigUser) (*ConfigUserStore, error) {
+	store := &ConfigUserStore{users: make(map[string]ConfigUser, len(users))}
+	for _, u := range users {
+		key := strings.ToLower(strings.TrimSpace(u.Username))
+		if key == "" {
+			return nil, fmt.Errorf("login user without a username")
+		}
+		if _, exists := store.users[key]; exists {
+			return nil, fmt.Errorf("duplicate login user %q", u.Username)
+		}
+		if _, err := bcrypt.Cost([]byte(u.PasswordHash)); err != nil {
+			return nil, fmt.Errorf("login user %q: password_hash is not a bcrypt hash: %w", u.Username, err)
+		}
+		store.users[key] = u
+	}
+
+	dummyHash, err := bcrypt.GenerateFromPassword([]byte("not-a-real-password"), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	store.dummyHash = dummyHash
+	return store, nil
+}
+
+func (s *ConfigUserStore) Authenticate(ctx context.Context, username, password string) (*auth.User, error) {
+	u, ok := s.users[strings.ToLower(strings.TrimSpace(username))]
+	if !ok {
+		_ = bcrypt.CompareHashAndPassword(s.dummyHash, []byte(password))
+		return nil, ErrInvalidCredentials
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+	return configUser(u), nil
+}
+
+func (s *ConfigUserStore) Lookup(ctx context.Context, userID string) (*auth.User, error) {
+	u, ok := s.users[strings.ToLower(strings.TrimSpace(userID))]
+	if !ok {
+		return nil, ErrUnknownUser
+	}
+	return configUser(u), nil
+}
+
+// configUser is the identity of a configured user; its ID is the username
+func configUser(u ConfigUser) *auth.User {
+	return &auth.User{
+		ID:              u.Username,
+		Email:           u.Email,
+		Name:            u.Name,
+		Permissions:     u.Permissions,
+		DataAccessRoles: u.DataAccessRoles,
+		FuncRoles:       funcRoles(u.FuncRoles),
+		OrgID:           u.OrgID,
+	}
+}
+
+func funcRoles(names []string) []auth.FuncRole {
+	roles := make([]auth.FuncRole, 0, len(names))
+	for _, name := range names {
+		roles = append(roles, auth.FuncRole{RoleName: name})
+	}
+	return roles
+}
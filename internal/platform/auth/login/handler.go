@@ -0,0 +1,287 @@
+package login
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/paths"
+	authcomponents "pharmacy-modernization-project-model/web/components/auth"
+)
+
+// refreshCookieSuffix is appended to the auth cookie name for the refresh token cookie
+const refreshCookieSuffix = "_refresh"
+
+// CookieConfig mirrors Auth.JWT.Cookie; the cookies are always HttpOnly
+type CookieConfig struct {
+	Name   string
+	Secure bool
+	MaxAge int // Seconds
+}
+
+// LoginRequest is the JSON body or form posted to /auth/login
+type LoginRequest struct {
+	Username string `json:"username" form:"username" validate:"required,max=256"`
+	Password string `json:"password" form:"password" validate:"required,max=256"`
+	Redirect string `json:"-" form:"redirect"` // Browser form only: where to go after signing in
+}
+
+// RefreshRequest is the optional JSON body of /auth/refresh and /auth/logout; browsers send the cookie
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// TokenResponse is returned to API clients on login and refresh
+type TokenResponse struct {
+	AccessToken      string     `json:"access_token"`
+	TokenType        string     `json:"token_type"`
+	ExpiresIn        int        `json:"expires_in"`
+	RefreshToken     string     `json:"refresh_token,omitempty"` // Empty when refresh is disabled
+	RefreshExpiresIn int        `json:"refresh_expires_in,omitempty"`
+	User             *auth.User `json:"user"`
+}
+
+// Handler serves the login page and the token endpoints
+type Handler struct {
+	store  UserStore
+	issuer *auth.TokenIssuer
+	cookie CookieConfig
+	log    *zap.Logger
+}
+
+func NewHandler(store UserStore, issuer *auth.TokenIssuer, cookie CookieConfig, log *zap.Logger) *Handler {
+	return &Handler{store: store, issuer: issuer, cookie: cookie, log: log}
+}
+
+// RegisterRoutes mounts the public login routes; they must not sit behind RequireAuth. The
+// refresh route is only mounted while the issuer signs refresh tokens.
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Get(paths.LoginPath, h.LoginPage)
+	r.Post(paths.AuthLoginPath, h.Login)
+	if h.issuer.RefreshEnabled() {
+		r.Post(paths.AuthRefreshPath, h.Refresh)
+	}
+	r.Post(paths.AuthLogoutPath, h.Logout)
+}
+
+func (h *Handler) LoginPage(w http.ResponseWriter, r *http.Request) {
+	param := authcomponents.LoginPageParam{
+		Action:   paths.AuthLoginPath,
+		Redirect: safeRedirect(r.URL.Query().Get("redirect")),
+	}
+	switch r.URL.Query().Get("error") {
+	case "invalid":
+		param.Error = "Invalid username or password."
+	case "unavailable":
+		param.Error = "Sign in is unavailable right now. Please try again later."
+	}
+	if r.URL.Query().Get("signed_out") != "" {
+		param.Notice = "You have been signed out."
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	if err := authcomponents.LoginPage(param).Render(r.Context(), w); err != nil {
+		h.log.Error("failed to render login page", zap.Error(err))
+		helper.WriteUIInternalError(w, "Failed to render login page")
+	}
+}
+
+// Login checks the credentials, sets the auth cookies and returns the tokens. Browser form posts
+// are redirected instead.
+func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
+	browser := !isJSONRequest(r)
+
+	var req LoginRequest
+	var fieldErrors []bind.FieldError
+	var err error
+	if browser {
+		req, fieldErrors, err = bind.Form[LoginRequest](r)
+	} else {
+		req, fieldErrors, err = bind.JSON[LoginRequest](r)
+	}
+	if err != nil {
+		if browser {
+			h.redirectToLogin(w, r, "invalid", req.Redirect)
+			return
+		}
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	user, err := h.store.Authenticate(r.Context(), req.Username, req.Password)
+	if err != nil {
+		if errors.Is(err, ErrInvalidCredentials) {
+			h.log.Warn("Login failed", zap.String("username", req.Username))
+			if browser {
+				h.redirectToLogin(w, r, "invalid", req.Redirect)
+				return
+			}
+			helper.WriteUnauthorized(w, ErrInvalidCredentials.Error())
+			return
+		}
+		h.log.Error("Login could not check credentials", zap.String("username", req.Username), zap.Error(err))
+		if browser {
+			h.redirectToLogin(w, r, "unavailable", req.Redirect)
+			return
+		}
+		helper.WriteError(w, http.StatusServiceUnavailable, helper.APIError{
+			Code:    "login_unavailable",
+			Message: "Sign in is unavailable right now",
+		})
+		return
+	}
+
+	pair, err := h.issuer.Issue(user)
+	if err != nil {
+		h.log.Error("Failed to issue tokens", zap.String("user_id", user.ID), zap.Error(err))
+		helper.WriteInternalError(w, "Failed to issue tokens")
+		return
+	}
+	h.setCookies(w, pair)
+	h.log.Info("User logged in", zap.String("user_id", user.ID))
+
+	if browser {
+		http.Redirect(w, r, safeRedirect(req.Redirect), http.StatusSeeOther)
+		return
+	}
+	helper.WriteOK(w, tokenResponse(user, pair))
+}
+
+// Refresh exchanges the refresh token for a new pair carrying the user's current claims from the
+// user store; each refresh token works once
+func (h *Handler) Refresh(w http.ResponseWriter, r *http.Request) {
+	user, pair, err := h.issuer.Refresh(r.Context(), h.refreshToken(r), h.store.Lookup)
+	if err != nil {
+		if !errors.Is(err, auth.ErrInvalidRefreshToken) && !errors.Is(err, ErrUnknownUser) {
+			h.log.Error("Refresh could not check the token", zap.Error(err))
+			helper.WriteError(w, http.StatusServiceUnavailable, helper.APIError{
+				Code:    "login_unavailable",
+				Message: "Sign in is unavailable right now",
+			})
+			return
+		}
+		h.clearCookies(w)
+		helper.WriteUnauthorized(w, auth.ErrInvalidRefreshToken.Error())
+		return
+	}
+	h.setCookies(w, pair)
+	helper.WriteOK(w, tokenResponse(user, pair))
+}
+
+// Logout revokes the refresh token and clears the cookies. Access tokens stay valid until they
+// expire, so their lifetime should be kept short.
+func (h *Handler) Logout(w http.ResponseWriter, r *http.Request) {
+	browser := !isJSONRequest(r)
+	if token := h.refreshToken(r); token != "" {
+		if err := h.issuer.Revoke(r.Context(), token); err != nil {
+			h.log.Error("Failed to revoke refresh token", zap.Error(err))
+		}
+	}
+	h.clearCookies(w)
+
+	if browser {
+		http.Redirect(w, r, paths.LoginPath+"?signed_out=1", http.StatusSeeOther)
+		return
+	}
+	helper.WriteNoContent(w)
+}
+
+// refreshToken reads the token from the JSON body, falling back to the refresh cookie
+func (h *Handler) refreshToken(r *http.Request) string {
+	if isJSONRequest(r) && r.Body != nil {
+		var req RefreshRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err == nil && req.RefreshToken != "" {
+			return req.RefreshToken
+		}
+	}
+	if cookie, err := r.Cookie(h.cookie.Name + refreshCookieSuffix); err == nil {
+		return cookie.Value
+	}
+	return ""
+}
+
+func (h *Handler) setCookies(w http.ResponseWriter, pair auth.TokenPair) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     h.cookie.Name,
+		Value:    pair.AccessToken,
+		Path:     "/",
+		MaxAge:   h.cookie.MaxAge,
+		Secure:   h.cookie.Secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	if pair.RefreshToken == "" {
+		return
+	}
+	// Only the auth endpoints need the refresh token
+	http.SetCookie(w, &http.Cookie{
+		Name:     h.cookie.Name + refreshCookieSuffix,
+		Value:    pair.RefreshToken,
+		Path:     paths.AuthPath,
+		MaxAge:   int(time.Until(pair.RefreshExpiresAt).Seconds()),
+		Secure:   h.cookie.Secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+func (h *Handler) clearCookies(w http.ResponseWriter) {
+	for _, c := range []struct{ name, path string }{
+		{h.cookie.Name, "/"},
+		{h.cookie.Name + refreshCookieSuffix, paths.AuthPath},
+	} {
+		http.SetCookie(w, &http.Cookie{
+			Name:     c.name,
+			Value:    "",
+			Path:     c.path,
+			MaxAge:   -1,
+			Secure:   h.cookie.Secure,
+			HttpOnly: true,
+		})
+	}
+}
+
+func (h *Handler) redirectToLogin(w http.ResponseWriter, r *http.Request, errorCode, redirect string) {
+	q := url.Values{}
+	q.Set("error", errorCode)
+	if redirect = safeRedirect(redirect); redirect != paths.DashboardPath {
+		q.Set("redirect", redirect)
+	}
+	http.Redirect(w, r, paths.LoginPath+"?"+q.Encode(), http.StatusSeeOther)
+}
+
+func tokenResponse(user *auth.User, pair auth.TokenPair) TokenResponse {
+	resp := TokenResponse{
+		AccessToken: pair.AccessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(time.Until(pair.AccessExpiresAt).Seconds()),
+		User:        user,
+	}
+	if pair.RefreshToken != "" {
+		resp.RefreshToken = pair.RefreshToken
+		resp.RefreshExpiresIn = int(time.Until(pair.RefreshExpiresAt).Seconds())
+	}
+	return resp
+}
+
+// safeRedirect only allows local paths, so the login form cannot be used as an open redirect
+func safeRedirect(target string) string {
+	if !strings.HasPrefix(target, "/") || strings.HasPrefix(target, "//") || strings.ContainsAny(target, "\\\r\n") {
+		return paths.DashboardPath
+	}
+	return target
+}
+
+func isJSONRequest(r *http.Request) bool {
+	return strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") ||
+		strings.Contains(r.Header.Get("Accept"), "application/json")
+}
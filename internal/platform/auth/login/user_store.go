@@ -0,0 +1,21 @@
+package login
+
+import (
+	"context"
+	"errors"
+
+	"pharmacy-modernization-project-model/internal/platform/auth"
+)
+
+// ErrInvalidCredentials is returned when the username or password is wrong
+var ErrInvalidCredentials = errors.New("invalid username or password")
+
+// ErrUnknownUser is returned by Lookup for users the store no longer has or cannot read
+var ErrUnknownUser = errors.New("unknown user")
+
+// UserStore checks a user's credentials and returns the identity to put in the issued token
+type UserStore interface {
+	Authenticate(ctx context.Context, username, password string) (*auth.User, error)
+	// Lookup returns the current identity of a user a token was issued to, for token refresh
+	Lookup(ctx context.Context, userID string) (*auth.User, error)
+}
@@ -2,13 +2,22 @@ package auth
 
 import (
 	"encoding/json"
-	"log"
 	"net/http"
 	"strings"
 
-	"pharmacy-modernization-project-model/internal/platform/sanitizer"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/logging"
 )
 
+// logger receives the auth messages; Builder.Build sets the application logger
+var logger = zap.NewNop()
+
+// requestLog is the logger for messages about r, with its request IDs, route and user
+func requestLog(r *http.Request) *zap.Logger {
+	return logging.WithContext(r.Context(), logger).With(zap.String("method", r.Method))
+}
+
 // ==========================================
 // AUTHENTICATION MIDDLEWARE (401 errors)
 // ==========================================
@@ -34,14 +43,14 @@ func requireAuthWithSource(source TokenSource) func(http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			tokenString, err := ExtractToken(r, source)
 			if err != nil {
-				log.Printf("AUTH 401: %s %s - No token: %v", sanitizer.ForLogging(r.Method), sanitizer.ForLogging(r.URL.Path), err)
+				requestLog(r).Info("Authentication required: no token", zap.Error(err))
 				handleUnauthorized(w, r, "Authentication required", source)
 				return
 			}
 
 			user, err := ValidateToken(tokenString)
 			if err != nil {
-				log.Printf("AUTH 401: %s %s - Invalid token: %v", sanitizer.ForLogging(r.Method), sanitizer.ForLogging(r.URL.Path), err)
+				requestLog(r).Warn("Authentication failed: invalid token", zap.Error(err))
 
 				// Try to detect token type for better error reporting
 				tokenType, detectErr := DetectTokenType(tokenString)
@@ -53,13 +62,33 @@ func requireAuthWithSource(source TokenSource) func(http.Handler) http.Handler {
 				return
 			}
 
-			log.Printf("AUTH OK: %s %s - User: %s (%s)", sanitizer.ForLogging(r.Method), sanitizer.ForLogging(r.URL.Path), sanitizer.ForLogging(user.Name), sanitizer.ForLogging(user.Email))
-			ctx := SetUser(r.Context(), user)
+			ctx, refused := scopeToOrg(SetUser(r.Context(), user), r, user)
+			requestLog(r).Debug("Authenticated")
+			if refused != "" {
+				handleOrgForbidden(w, r, user, refused)
+				return
+			}
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
 
+// IdentifyRequest returns the user the request authenticates as, the way the auth middleware for
+// source would, without enforcing anything. Middleware running before the per-route
+// authentication uses it to tell callers apart; ok is false for anonymous requests and invalid
+// tokens.
+func IdentifyRequest(r *http.Request, source TokenSource) (user *User, ok bool) {
+	tokenString, err := ExtractToken(r, source)
+	if err != nil {
+		return nil, false
+	}
+	user, err = ValidateToken(tokenString)
+	if err != nil {
+		return nil, false
+	}
+	return user, true
+}
+
 // handleUnauthorized returns 401 Unauthorized response
 func handleUnauthorized(w http.ResponseWriter, r *http.Request, message string, source TokenSource, tokenType ...string) {
 	// Default token type if not provided
@@ -118,10 +147,14 @@ func handleUnauthorized(w http.ResponseWriter, r *http.Request, message string,
 // PERMISSION MIDDLEWARE (403 errors)
 // ==========================================
 
+// The constructors below run while routes are wired and panic on permissions missing from the
+// catalogue in internal/platform/permissions, so a misspelled permission stops startup
+
 // RequirePermission checks for a single permission
 func RequirePermission(permission string) func(http.Handler) http.Handler {
+	mustBeRegistered(permission)
 	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		return guard([]string{permission}, "all", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			user, err := GetCurrentUser(r.Context())
 			if err != nil {
 				handleUnauthorized(w, r, "No authenticated user", TokenSourceAuto)
@@ -129,21 +162,21 @@ func RequirePermission(permission string) func(http.Handler) http.Handler {
 			}
 
 			if !hasPermission(user.Permissions, permission) {
-				log.Printf("AUTHZ 403: %s %s - User %s lacks permission: %s",
-					sanitizer.ForLogging(r.Method), sanitizer.ForLogging(r.URL.Path), sanitizer.ForLogging(user.Email), sanitizer.ForLogging(permission))
+				requestLog(r).Warn("Permission denied", zap.String("permission", permission))
 				handleForbidden(w, r, []string{permission}, "all")
 				return
 			}
 
 			next.ServeHTTP(w, r)
-		})
+		}))
 	}
 }
 
 // RequirePermissionsMatchAll checks user has ALL permissions
 func RequirePermissionsMatchAll(permissions []string) func(http.Handler) http.Handler {
+	mustBeRegistered(permissions...)
 	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		return guard(permissions, "all", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			user, err := GetCurrentUser(r.Context())
 			if err != nil {
 				handleUnauthorized(w, r, "No authenticated user", TokenSourceAuto)
@@ -151,21 +184,21 @@ func RequirePermissionsMatchAll(permissions []string) func(http.Handler) http.Ha
 			}
 
 			if !HasAllPermissions(user.Permissions, permissions) {
-				log.Printf("AUTHZ 403: %s %s - User %s lacks all permissions: %v",
-					sanitizer.ForLogging(r.Method), sanitizer.ForLogging(r.URL.Path), sanitizer.ForLogging(user.Email), permissions)
+				requestLog(r).Warn("Permission denied", zap.Strings("required_all", permissions))
 				handleForbidden(w, r, permissions, "all")
 				return
 			}
 
 			next.ServeHTTP(w, r)
-		})
+		}))
 	}
 }
 
 // RequirePermissionsMatchAny checks user has ANY of the permissions
 func RequirePermissionsMatchAny(permissions []string) func(http.Handler) http.Handler {
+	mustBeRegistered(permissions...)
 	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		return guard(permissions, "any", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			user, err := GetCurrentUser(r.Context())
 			if err != nil {
 				handleUnauthorized(w, r, "No authenticated user", TokenSourceAuto)
@@ -173,14 +206,13 @@ func RequirePermissionsMatchAny(permissions []string) func(http.Handler) http.Ha
 			}
 
 			if !HasAnyPermission(user.Permissions, permissions) {
-				log.Printf("AUTHZ 403: %s %s - User %s lacks any permission: %v",
-					sanitizer.ForLogging(r.Method), sanitizer.ForLogging(r.URL.Path), sanitizer.ForLogging(user.Email), permissions)
+				requestLog(r).Warn("Permission denied", zap.Strings("required_any", permissions))
 				handleForbidden(w, r, permissions, "any")
 				return
 			}
 
 			next.ServeHTTP(w, r)
-		})
+		}))
 	}
 }
 
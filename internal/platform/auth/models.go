@@ -25,6 +25,7 @@ type TokenTypeConfig = types.TokenTypeConfig
 const (
 	TokenTypeAuthPass = types.TokenTypeAuthPass
 	TokenTypeAzureB2C = types.TokenTypeAzureB2C
+	TokenTypeLocal    = types.TokenTypeLocal
 )
 
 // TokenSource defines where to extract the JWT token from
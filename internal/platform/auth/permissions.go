@@ -1,6 +1,16 @@
 package auth
 
-import "context"
+import (
+	"context"
+
+	"pharmacy-modernization-project-model/internal/platform/permissions"
+)
+
+// mustBeRegistered refuses permissions missing from the catalogue; the middleware constructors
+// call it while routes are wired
+func mustBeRegistered(names ...string) {
+	permissions.MustBeRegistered(names...)
+}
 
 // hasPermission checks if user has a single permission
 func hasPermission(userPermissions []string, required string) bool {
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/paths"
+	"pharmacy-modernization-project-model/internal/platform/permissions"
+)
+
+// PermissionsResponse is the body of GET /api/auth/permissions
+type PermissionsResponse struct {
+	Permissions []permissions.Documented `json:"permissions"`
+}
+
+// MeResponse is the body of GET /api/auth/me
+type MeResponse struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Email     string   `json:"email"`
+	OrgID     string   `json:"org_id,omitempty"`
+	FuncRoles []string `json:"func_roles"`
+	// Permissions are the catalogued permissions of the user's token, sorted; the UI shows or
+	// hides features with the same any/all rules the routes apply
+	Permissions []string `json:"permissions"`
+}
+
+// RegisterPermissionRoutes mounts the permission catalogue and the current user's permissions;
+// both require a signed-in user, from the Authorization header or the auth cookie
+func RegisterPermissionRoutes(r chi.Router) {
+	r.With(RequireAuthWithDevMode()).Get(paths.AuthPermissionsAPIPath, PermissionCatalogue)
+	r.With(RequireAuthWithDevMode()).Get(paths.AuthMeAPIPath, Me)
+}
+
+// PermissionCatalogue lists every permission the application checks, with its description and
+// the routes and GraphQL fields that require it
+func PermissionCatalogue(w http.ResponseWriter, r *http.Request) {
+	helper.WriteOK(w, PermissionsResponse{Permissions: permissions.Describe()})
+}
+
+// Me returns the current user and their effective permissions
+func Me(w http.ResponseWriter, r *http.Request) {
+	user, err := GetCurrentUser(r.Context())
+	if err != nil {
+		handleUnauthorized(w, r, "No authenticated user", TokenSourceAuto)
+		return
+	}
+	helper.WriteOK(w, MeResponse{
+		ID:          user.ID,
+		Name:        user.Name,
+		Email:       user.Email,
+		OrgID:       user.OrgID,
+		FuncRoles:   user.GetFuncRoleNames(),
+		Permissions: permissions.Effective(user.Permissions),
+	})
+}
@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// MongoRevocationStore keeps revoked token IDs in a MongoDB collection shared by all instances.
+// A TTL index removes them once the tokens expire.
+type MongoRevocationStore struct {
+	collection *mongo.Collection
+}
+
+type revokedToken struct {
+	ID        string    `bson:"_id"`
+	ExpiresAt time.Time `bson:"expires_at"`
+}
+
+// NewMongoRevocationStore creates a MongoDB-backed revocation store and ensures its TTL index
+func NewMongoRevocationStore(collection *mongo.Collection, logger *zap.Logger) RevocationStore {
+	store := &MongoRevocationStore{collection: collection}
+	if err := store.ensureIndexes(); err != nil {
+		logger.Warn("Failed to create revoked token indexes", zap.Error(err))
+	}
+	return store
+}
+
+func (s *MongoRevocationStore) ensureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := s.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetName("expires_at_ttl").SetExpireAfterSeconds(0),
+	})
+	return err
+}
+
+func (s *MongoRevocationStore) Revoke(ctx context.Context, tokenID string, expiresAt time.Time) (bool, error) {
+	// The insert collides on _id when another request or instance revoked the token first
+	_, err := s.collection.InsertOne(ctx, revokedToken{ID: tokenID, ExpiresAt: expiresAt})
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, platformErrors.HandleMongoError("RevokeRefreshToken", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RevocationStore remembers the IDs of used and revoked refresh tokens until the tokens expire,
+// so each refresh token works once across restarts and instances
+type RevocationStore interface {
+	// Revoke records the token ID until expiresAt; revoked is false when it was recorded already
+	Revoke(ctx context.Context, tokenID string, expiresAt time.Time) (revoked bool, err error)
+}
+
+// MemoryRevocationStore keeps revoked token IDs in process memory; used when MongoDB is not
+// configured. A token used on one instance can still be used once on each of the others.
+type MemoryRevocationStore struct {
+	mu      sync.Mutex
+	revoked map[string]time.Time // Token ID -> expiry
+}
+
+// NewMemoryRevocationStore creates an empty in-memory revocation store
+func NewMemoryRevocationStore() RevocationStore {
+	return &MemoryRevocationStore{revoked: make(map[string]time.Time)}
+}
+
+func (s *MemoryRevocationStore) Revoke(_ context.Context, tokenID string, expiresAt time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.purgeExpired(time.Now())
+	if _, ok := s.revoked[tokenID]; ok {
+		return false, nil
+	}
+	s.revoked[tokenID] = expiresAt
+	return true, nil
+}
+
+// purgeExpired forgets revoked tokens that have expired anyway; the caller holds the lock
+func (s *MemoryRevocationStore) purgeExpired(now time.Time) {
+	for id, expiresAt := range s.revoked {
+		if now.After(expiresAt) {
+			delete(s.revoked, id)
+		}
+	}
+}
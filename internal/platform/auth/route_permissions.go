@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/go-chi/chi/v5"
+
+	"pharmacy-modernization-project-model/internal/platform/permissions"
+)
+
+// permissionGuard is the handler the permission middlewares return; Routes recognizes it to tell
+// which permissions a route checks
+type permissionGuard struct {
+	http.Handler
+	permissions []string
+	match       string
+}
+
+func guard(required []string, match string, h http.Handler) http.Handler {
+	return &permissionGuard{Handler: h, permissions: slices.Clone(required), match: match}
+}
+
+// Routes lists every route of r with the RequirePermission* middleware in front of it, whether
+// added with Use on a router or group or inline with With
+func Routes(r chi.Routes) []permissions.Route {
+	var routes []permissions.Route
+	_ = chi.Walk(r, func(method, route string, handler http.Handler, middlewares ...func(http.Handler) http.Handler) error {
+		if chain, ok := handler.(*chi.ChainHandler); ok {
+			middlewares = append(slices.Clone(middlewares), chain.Middlewares...)
+		}
+		guards := []permissions.Guard{}
+		for _, mw := range middlewares {
+			// Building the handler is enough to tell a guard apart; it is never served
+			g, ok := mw(http.NotFoundHandler()).(*permissionGuard)
+			if !ok {
+				continue
+			}
+			guards = append(guards, permissions.Guard{Match: g.match, Permissions: g.permissions})
+		}
+		routes = append(routes, permissions.Route{Method: method, Path: route, Guards: guards})
+		return nil
+	})
+	return routes
+}
+
+// RoutePermissions lists the routes of r guarded by RequirePermission* middleware. A route behind
+// several guards is listed once per guard.
+func RoutePermissions(r chi.Routes) []permissions.Requirement {
+	var reqs []permissions.Requirement
+	for _, route := range Routes(r) {
+		for _, g := range route.Guards {
+			reqs = append(reqs, permissions.Requirement{
+				Kind:        permissions.KindRoute,
+				Method:      route.Method,
+				Path:        route.Path,
+				Match:       g.Match,
+				Permissions: g.Permissions,
+			})
+		}
+	}
+	return reqs
+}
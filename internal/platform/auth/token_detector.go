@@ -78,11 +78,8 @@ func (tm *TokenManager) ValidateToken(ctx context.Context, tokenString string) (
 		return nil, fmt.Errorf("token type mismatch: expected %s, got %s", string(tokenType), string(validatedTokenType))
 	}
 
-	// Parse the token to extract user information
-	token, err := jwt.ParseWithClaims(tokenString, &types.JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-		// This will be handled by the specific identifier
-		return []byte("dummy"), nil
-	})
+	// Parse the token to extract user information; the identifier has already verified the signature
+	token, _, err := jwt.NewParser().ParseUnverified(tokenString, &types.JWTClaims{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse token: %w", err)
 	}
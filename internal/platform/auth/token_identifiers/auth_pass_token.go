@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"pharmacy-modernization-project-model/internal/platform/auth/types"
 
@@ -44,7 +45,7 @@ func NewAuthPassTokenIdentifier(tokenConfig types.TokenTypeConfig, config types.
 // DetectTokenType attempts to detect if this is an auth_pass token based on issuer
 func (apti *AuthPassTokenIdentifier) DetectTokenType(ctx context.Context, tokenString string) (types.TokenType, error) {
 	// Parse token without signature validation to extract issuer
-	token, _, err := jwt.NewParser().ParseUnverified(tokenString, &jwt.MapClaims{})
+	token, _, err := jwt.NewParser().ParseUnverified(tokenString, jwt.MapClaims{})
 	if err != nil {
 		return "", fmt.Errorf("failed to parse token: %w", err)
 	}
@@ -166,6 +167,10 @@ func (apti *AuthPassTokenIdentifier) ExtractUser(token *jwt.Token) (*types.User,
 		Permissions:     claims.Permissions,
 		DataAccessRoles: claims.DataAccessRoles,
 		FuncRoles:       claims.FuncRoles,
+		ClientID:        claims.ClientId,
+		Scopes:          strings.Fields(claims.Scope),
+		OrgID:           claims.OrgID,
+		Locale:          claims.Locale,
 	}
 
 	return user, nil
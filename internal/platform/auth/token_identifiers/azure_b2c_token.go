@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"pharmacy-modernization-project-model/internal/platform/auth/types"
 
@@ -44,7 +45,7 @@ func NewAzureB2CTokenIdentifier(tokenConfig types.TokenTypeConfig, config types.
 // DetectTokenType attempts to detect if this is an Azure B2C token based on issuer
 func (abti *AzureB2CTokenIdentifier) DetectTokenType(ctx context.Context, tokenString string) (types.TokenType, error) {
 	// Parse token without signature validation to extract issuer
-	token, _, err := jwt.NewParser().ParseUnverified(tokenString, &jwt.MapClaims{})
+	token, _, err := jwt.NewParser().ParseUnverified(tokenString, jwt.MapClaims{})
 	if err != nil {
 		return "", fmt.Errorf("failed to parse token: %w", err)
 	}
@@ -147,6 +148,9 @@ func (abti *AzureB2CTokenIdentifier) ExtractUser(token *jwt.Token) (*types.User,
 		Permissions:     claims.Roles,                                     // Map Azure roles to permissions
 		DataAccessRoles: claims.Groups,                                    // Map Azure groups to data access roles
 		FuncRoles:       convertAzureRolesToFuncRoles(claims.CustomRoles), // Convert custom roles
+		ClientID:        claims.AppId,
+		Scopes:          strings.Fields(claims.Scope),
+		OrgID:           claims.OrgID,
 	}
 
 	return user, nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"pharmacy-modernization-project-model/internal/platform/auth/types"
 
@@ -43,7 +44,7 @@ func NewDefaultTokenIdentifier(tokenType types.TokenType, tokenConfig types.Toke
 
 // DetectTokenType attempts to detect if this is the configured token based on issuer claim
 func (dti *DefaultTokenIdentifier) DetectTokenType(ctx context.Context, tokenString string) (types.TokenType, error) {
-	token, _, err := jwt.NewParser().ParseUnverified(tokenString, &jwt.MapClaims{})
+	token, _, err := jwt.NewParser().ParseUnverified(tokenString, jwt.MapClaims{})
 	if err != nil {
 		return "", fmt.Errorf("failed to parse token: %w", err)
 	}
@@ -162,6 +163,10 @@ func (dti *DefaultTokenIdentifier) ExtractUser(token *jwt.Token) (*types.User, e
 		Permissions:     claims.Permissions,
 		DataAccessRoles: claims.DataAccessRoles,
 		FuncRoles:       claims.FuncRoles,
+		ClientID:        claims.ClientId,
+		Scopes:          strings.Fields(claims.Scope),
+		OrgID:           claims.OrgID,
+		Locale:          claims.Locale,
 	}
 
 	return user, nil
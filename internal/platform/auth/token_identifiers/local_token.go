@@ -0,0 +1,135 @@
+package token_identifiers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"pharmacy-modernization-project-model/internal/platform/auth/types"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// LocalTokenIdentifier handles tokens issued by this application's login endpoint.
+// They are signed with the shared HMAC secret rather than a key published via JWKS.
+type LocalTokenIdentifier struct {
+	secret         []byte
+	signingMethods []string
+	issuer         []string
+	audience       []string
+}
+
+// NewLocalTokenIdentifier creates a new local token identifier
+func NewLocalTokenIdentifier(tokenConfig types.TokenTypeConfig, config types.JWTConfig) (*LocalTokenIdentifier, error) {
+	if len(config.SigningSecret) == 0 {
+		return nil, errors.New("signing secret is required for local tokens")
+	}
+	if len(tokenConfig.Issuer) == 0 {
+		return nil, errors.New("issuer is required for local tokens")
+	}
+
+	signingMethods := tokenConfig.SigningMethods
+	if len(signingMethods) == 0 {
+		signingMethods = []string{jwt.SigningMethodHS256.Alg()}
+	}
+
+	return &LocalTokenIdentifier{
+		secret:         config.SigningSecret,
+		signingMethods: signingMethods,
+		issuer:         tokenConfig.Issuer,
+		audience:       tokenConfig.Audience,
+	}, nil
+}
+
+// DetectTokenType attempts to detect if this is a local token based on issuer
+func (lti *LocalTokenIdentifier) DetectTokenType(ctx context.Context, tokenString string) (types.TokenType, error) {
+	token, _, err := jwt.NewParser().ParseUnverified(tokenString, jwt.MapClaims{})
+	if err != nil {
+		return "", fmt.Errorf("failed to parse token: %w", err)
+	}
+
+	claims := token.Claims.(jwt.MapClaims)
+	issuer, ok := claims["iss"].(string)
+	if !ok {
+		return "", fmt.Errorf("token does not contain issuer claim")
+	}
+
+	if slices.Contains(lti.issuer, issuer) {
+		return types.TokenTypeLocal, nil
+	}
+
+	return "", fmt.Errorf("issuer '%s' does not match local token issuers", issuer)
+}
+
+// IsValidToken validates the token signature with the shared secret
+func (lti *LocalTokenIdentifier) IsValidToken(ctx context.Context, tokenString string) (types.TokenType, error) {
+	// Restricting the methods also rules out tokens signed with a public key used as an HMAC secret
+	parser := jwt.NewParser(jwt.WithValidMethods(lti.signingMethods), jwt.WithExpirationRequired())
+	token, err := parser.ParseWithClaims(tokenString, &types.JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %s", token.Method.Alg())
+		}
+		return lti.secret, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to parse token: %w", err)
+	}
+
+	if !token.Valid {
+		return "", errors.New("invalid token")
+	}
+
+	return types.TokenTypeLocal, nil
+}
+
+// GetTokenType returns the token type this identifier handles
+func (lti *LocalTokenIdentifier) GetTokenType() types.TokenType {
+	return types.TokenTypeLocal
+}
+
+// GetJWKSURL returns an empty string; local tokens are not published via JWKS
+func (lti *LocalTokenIdentifier) GetJWKSURL() string {
+	return ""
+}
+
+// ExtractUser extracts user information from validated token
+func (lti *LocalTokenIdentifier) ExtractUser(token *jwt.Token) (*types.User, error) {
+	claims, ok := token.Claims.(*types.JWTClaims)
+	if !ok {
+		return nil, errors.New("invalid claims for local token type")
+	}
+
+	if !slices.Contains(lti.issuer, claims.Issuer) {
+		return nil, errors.New("invalid issuer")
+	}
+
+	if len(lti.audience) > 0 {
+		validAudience := false
+		for _, aud := range claims.Audience {
+			if slices.Contains(lti.audience, aud) {
+				validAudience = true
+				break
+			}
+		}
+		if !validAudience {
+			return nil, fmt.Errorf("invalid audience for local token: expected one of %v, got %v", lti.audience, claims.Audience)
+		}
+	}
+
+	user := &types.User{
+		ID:              claims.UserID,
+		Email:           claims.Email,
+		Name:            claims.Name,
+		Permissions:     claims.Permissions,
+		DataAccessRoles: claims.DataAccessRoles,
+		FuncRoles:       claims.FuncRoles,
+		ClientID:        claims.ClientId,
+		Scopes:          strings.Fields(claims.Scope),
+		OrgID:           claims.OrgID,
+		Locale:          claims.Locale,
+	}
+
+	return user, nil
+}
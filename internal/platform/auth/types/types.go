@@ -10,6 +10,7 @@ type TokenType string
 const (
 	TokenTypeAuthPass TokenType = "auth_pass" // Your custom token format
 	TokenTypeAzureB2C TokenType = "azure_b2c" // Azure B2C token format
+	TokenTypeLocal    TokenType = "local"     // Tokens issued by this application's login endpoint
 )
 
 // User represents an authenticated user
@@ -20,6 +21,10 @@ type User struct {
 	Permissions     []string   `json:"permissions"`
 	DataAccessRoles []string   `json:"dataAccessRoles"`
 	FuncRoles       []FuncRole `json:"func-roles"`
+	ClientID        string     `json:"clientId,omitempty"` // Client application the token was issued to
+	Scopes          []string   `json:"scopes,omitempty"`
+	OrgID           string     `json:"orgId,omitempty"`  // Organization (clinic) whose data the user works with
+	Locale          string     `json:"locale,omitempty"` // Preferred language of messages, e.g. es
 }
 
 // FuncRole represents a functional role
@@ -51,7 +56,8 @@ type JWTConfig struct {
 	CookieName       string                        // Name of the authentication cookie
 	TokenTypesConfig map[TokenType]TokenTypeConfig // Configuration for each token type
 	JWKSCache        int                           // Cache duration in minutes (default: 15)
-	TokenTypes       []TokenType                   // Supported token types (auth_pass, azure_b2c)
+	TokenTypes       []TokenType                   // Supported token types (auth_pass, azure_b2c, local)
+	SigningSecret    []byte                        // HMAC key for local tokens
 }
 
 // TokenTypeConfig represents configuration for a specific token type
@@ -70,9 +76,12 @@ type JWTClaims struct {
 	Name            string     `json:"name"`
 	Permissions     []string   `json:"permissions"`
 	ClientId        string     `json:"client_id"`
+	Scope           string     `json:"scope"` // Space-separated OAuth scopes
 	SubjectIdType   string     `json:"subjectIdType"`
 	DataAccessRoles []string   `json:"dataAccessRoles"`
 	FuncRoles       []FuncRole `json:"func-roles"`
+	OrgID           string     `json:"org_id,omitempty"`
+	Locale          string     `json:"locale,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -95,6 +104,7 @@ type AzureB2CClaims struct {
 	TenantId       string `json:"tid"`
 	AppId          string `json:"appid"`
 	AppIdAcr       string `json:"appidacr"`
+	Scope          string `json:"scp"` // Space-separated delegated scopes
 	Idp            string `json:"idp"`
 	IdpAccessToken string `json:"idp_access_token"`
 
@@ -102,6 +112,7 @@ type AzureB2CClaims struct {
 	Roles       []string `json:"roles"`
 	Groups      []string `json:"groups"`
 	CustomRoles []string `json:"custom_roles"`
+	OrgID       string   `json:"extension_OrgId"` // Custom user attribute holding the organization
 
 	jwt.RegisteredClaims
 }
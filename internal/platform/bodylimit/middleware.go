@@ -0,0 +1,67 @@
+package bodylimit
+
+import (
+	"net/http"
+	"strings"
+
+	"pharmacy-modernization-project-model/internal/bind"
+	"pharmacy-modernization-project-model/internal/helper"
+)
+
+// Group limits the bodies of the routes under a path prefix
+type Group struct {
+	// Prefix is matched segment by segment; * matches any one segment, e.g. /api/*/patients
+	Prefix   string
+	MaxBytes int64
+}
+
+// Config holds the body size limits
+type Config struct {
+	MaxBytes int64   // Limit of routes in no group; 0 leaves them unlimited
+	Groups   []Group // The first group matching a path wins, so list longer prefixes first
+}
+
+// Limit returns the body limit of a path; 0 is unlimited
+func (c Config) Limit(path string) int64 {
+	for _, g := range c.Groups {
+		if matches(g.Prefix, path) {
+			return g.MaxBytes
+		}
+	}
+	return c.MaxBytes
+}
+
+// Middleware rejects request bodies over the limit of their route with a 400 listing a
+// max_bytes error. Bodies declaring a larger Content-Length are rejected before they are read;
+// others are cut off at the limit, so the handler reading them fails the same way.
+func Middleware(cfg Config) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			limit := cfg.Limit(r.URL.Path)
+			if limit <= 0 || r.Body == nil || r.Body == http.NoBody {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if r.ContentLength > limit {
+				helper.Respond400(w, []bind.FieldError{bind.TooLarge(limit)})
+				return
+			}
+			r.Body = http.MaxBytesReader(w, r.Body, limit)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func matches(prefix, path string) bool {
+	want := strings.Split(strings.Trim(prefix, "/"), "/")
+	got := strings.Split(strings.Trim(path, "/"), "/")
+	if len(got) < len(want) {
+		return false
+	}
+	for i, segment := range want {
+		if segment != "*" && segment != got[i] {
+			return false
+		}
+	}
+	return true
+}
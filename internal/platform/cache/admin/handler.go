@@ -0,0 +1,285 @@
+// Package admin serves the cache statistics, key listing and invalidation: as JSON for tooling
+// and as a page for admins
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/cache"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+	"pharmacy-modernization-project-model/internal/platform/paths"
+	"pharmacy-modernization-project-model/internal/platform/permissions"
+	admincomponents "pharmacy-modernization-project-model/web/components/admin"
+)
+
+// CacheAccess - only admins can read cache keys, which carry record IDs, and drop entries
+var CacheAccess = []string{permissions.AdminAll}
+
+// defaultKeysLimit is how many keys GET /admin/cache/keys lists when no limit is given
+const defaultKeysLimit = 100
+
+// CacheQuery selects the cache instance; it may be omitted when there is only one
+type CacheQuery struct {
+	Cache string `form:"cache" validate:"omitempty,max=50"`
+}
+
+// KeysQuery filters the key listing
+type KeysQuery struct {
+	Cache  string `form:"cache" validate:"omitempty,max=50"`
+	Prefix string `form:"prefix" validate:"omitempty,max=200"`
+	Limit  int    `form:"limit" validate:"omitempty,min=1,max=1000"`
+}
+
+// KeyPathVars represents the path parameter of key endpoints
+type KeyPathVars struct {
+	Key string `path:"key" validate:"required,min=1,max=200"`
+}
+
+// InstancePathVars represents the path parameter of the page actions
+type InstancePathVars struct {
+	Cache string `path:"cache" validate:"required,min=1,max=50"`
+}
+
+// StatsResponse is the body of GET /admin/cache/stats
+type StatsResponse struct {
+	Caches []cache.InstanceStats `json:"caches"`
+}
+
+// KeysResponse is the body of GET /admin/cache/keys; Truncated is set when more keys match
+type KeysResponse struct {
+	Cache     string   `json:"cache"`
+	Prefix    string   `json:"prefix"`
+	Keys      []string `json:"keys"`
+	Truncated bool     `json:"truncated"`
+}
+
+// Handler serves the cache instances
+type Handler struct {
+	caches map[string]cache.Cache
+	names  []string
+	log    *zap.Logger
+}
+
+// NewHandler serves the given cache instances, keyed by the name they are listed under
+func NewHandler(caches map[string]cache.Cache, log *zap.Logger) *Handler {
+	names := make([]string, 0, len(caches))
+	for name := range caches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return &Handler{caches: caches, names: names, log: log}
+}
+
+// RegisterRoutes mounts the cache API and the admin page under /admin/cache
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route(paths.AdminCachePath, func(router chi.Router) {
+		router.Use(auth.RequireAuthWithDevMode())
+		router.Use(auth.RequirePermissionsMatchAny(CacheAccess))
+
+		router.Get("/", h.Page)
+		router.Get("/stats", h.Stats)
+		router.Get("/keys", h.ListKeys)
+		router.Delete("/keys", h.Flush)
+		router.Delete("/keys/{key}", h.DeleteKey)
+		router.Post("/{cache}/flush", h.FlushFromPage)
+	})
+}
+
+func (h *Handler) Stats(w http.ResponseWriter, r *http.Request) {
+	helper.WriteOK(w, StatsResponse{Caches: h.stats()})
+}
+
+// ListKeys lists the keys of a cache, in order, optionally only those starting with a prefix
+func (h *Handler) ListKeys(w http.ResponseWriter, r *http.Request) {
+	query, fieldErrors, err := bind.Query[KeysQuery](r)
+	if err != nil {
+		h.log.Error("failed to bind query parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultKeysLimit
+	}
+
+	name, c, err := h.instance(query.Cache)
+	if err != nil {
+		httpx.WriteError(w, r, err)
+		return
+	}
+	scanner, ok := cache.AsScanner(c)
+	if !ok {
+		httpx.WriteError(w, r, platformErrors.NewBusinessLogicError("list cache keys", "the "+name+" cache cannot list its keys"))
+		return
+	}
+
+	// One key more than the limit tells whether the listing is complete
+	keys, err := scanner.Scan(r.Context(), query.Prefix, query.Limit+1)
+	if err != nil {
+		h.writeCacheError(w, r, "list cache keys", name, err)
+		return
+	}
+	response := KeysResponse{Cache: name, Prefix: query.Prefix, Keys: keys}
+	if len(keys) > query.Limit {
+		response.Keys, response.Truncated = keys[:query.Limit], true
+	}
+	helper.WriteOK(w, response)
+}
+
+// DeleteKey drops one entry; deleting a key that is not cached succeeds too
+func (h *Handler) DeleteKey(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[KeyPathVars](r, chi.URLParam)
+	if err != nil {
+		h.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+	query, fieldErrors, err := bind.Query[CacheQuery](r)
+	if err != nil {
+		h.log.Error("failed to bind query parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	name, c, err := h.instance(query.Cache)
+	if err != nil {
+		httpx.WriteError(w, r, err)
+		return
+	}
+	if err := c.Delete(r.Context(), pathVars.Key); err != nil {
+		if errors.Is(err, cache.ErrInvalidKey) {
+			httpx.WriteError(w, r, platformErrors.NewValidationError("key", pathVars.Key, "not a valid cache key"))
+			return
+		}
+		h.writeCacheError(w, r, "delete cache key", name, err)
+		return
+	}
+	h.log.Info("cache key deleted",
+		zap.String("cache", name),
+		zap.String("key", pathVars.Key),
+		zap.String("actor", actor(r)))
+	helper.WriteNoContent(w)
+}
+
+// Flush drops every entry of a cache
+func (h *Handler) Flush(w http.ResponseWriter, r *http.Request) {
+	query, fieldErrors, err := bind.Query[CacheQuery](r)
+	if err != nil {
+		h.log.Error("failed to bind query parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+	if err := h.flush(r, query.Cache); err != nil {
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteNoContent(w)
+}
+
+func (h *Handler) Page(w http.ResponseWriter, r *http.Request) {
+	h.renderPage(w, r, "", http.StatusOK)
+}
+
+// FlushFromPage flushes a cache and returns to the page, or shows the page with why it failed
+func (h *Handler) FlushFromPage(w http.ResponseWriter, r *http.Request) {
+	pathVars, _, err := bind.ChiPath[InstancePathVars](r, chi.URLParam)
+	if err == nil {
+		err = h.flush(r, pathVars.Cache)
+	}
+	if err == nil {
+		http.Redirect(w, r, paths.AdminCachePath, http.StatusSeeOther)
+		return
+	}
+
+	status := http.StatusInternalServerError
+	var business platformErrors.BusinessLogicError
+	switch {
+	case platformErrors.IsNotFoundError(err):
+		status = http.StatusNotFound
+	case errors.As(err, &business):
+		status = http.StatusUnprocessableEntity
+	}
+	h.renderPage(w, r, err.Error(), status)
+}
+
+func (h *Handler) renderPage(w http.ResponseWriter, r *http.Request, message string, status int) {
+	w.WriteHeader(status)
+	page := admincomponents.CachePage(admincomponents.CachePageParam{
+		Caches: h.stats(),
+		Error:  message,
+	})
+	if err := page.Render(r.Context(), w); err != nil {
+		h.log.Error("failed to render cache page", zap.Error(err))
+	}
+}
+
+func (h *Handler) flush(r *http.Request, cacheName string) error {
+	name, c, err := h.instance(cacheName)
+	if err != nil {
+		return err
+	}
+	flusher, ok := cache.AsFlusher(c)
+	if !ok {
+		return platformErrors.NewBusinessLogicError("flush cache", "the "+name+" cache cannot be flushed")
+	}
+	if err := flusher.Flush(r.Context()); err != nil {
+		h.log.Error("flush cache", zap.String("cache", name), zap.Error(err))
+		return platformErrors.NewExternalServiceError("cache", "flush", "failed to flush the "+name+" cache")
+	}
+	h.log.Info("cache flushed",
+		zap.String("cache", name),
+		zap.String("actor", actor(r)))
+	return nil
+}
+
+func (h *Handler) stats() []cache.InstanceStats {
+	stats := make([]cache.InstanceStats, 0, len(h.names))
+	for _, name := range h.names {
+		stats = append(stats, cache.StatsOf(name, h.caches[name]))
+	}
+	return stats
+}
+
+// instance returns the named cache; an empty name selects the only one when there is one
+func (h *Handler) instance(name string) (string, cache.Cache, error) {
+	if name == "" && len(h.names) == 1 {
+		name = h.names[0]
+	}
+	if name == "" {
+		return "", nil, platformErrors.NewValidationError("cache", name, "select one of the cache instances")
+	}
+	c, ok := h.caches[name]
+	if !ok {
+		return "", nil, platformErrors.NewRecordNotFoundError("cache", name)
+	}
+	return name, c, nil
+}
+
+func (h *Handler) writeCacheError(w http.ResponseWriter, r *http.Request, operation, name string, err error) {
+	if errors.Is(err, cache.ErrUnsupported) {
+		httpx.WriteError(w, r, platformErrors.NewBusinessLogicError(operation, "the "+name+" cache does not support it"))
+		return
+	}
+	h.log.Error(operation, zap.String("cache", name), zap.Error(err))
+	httpx.WriteError(w, r, platformErrors.NewExternalServiceError("cache", operation, "failed to "+operation))
+}
+
+// actor identifies the user changing the cache
+func actor(r *http.Request) string {
+	user, err := auth.GetCurrentUser(r.Context())
+	if err != nil {
+		return ""
+	}
+	if user.Email != "" {
+		return user.Email
+	}
+	return user.ID
+}
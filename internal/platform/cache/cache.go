@@ -17,17 +17,76 @@ type Cache interface {
 
 // CacheStats provides cache performance metrics
 type CacheStats struct {
-	Hits      int64
-	Misses    int64
-	Evictions int64
-	Errors    int64
-	HitRate   float64
-	Size      int64
-	MaxSize   int64
+	Hits      int64   `json:"hits"`
+	Misses    int64   `json:"misses"`
+	Evictions int64   `json:"evictions"`
+	Errors    int64   `json:"errors"`
+	HitRate   float64 `json:"hit_rate"`
+	Size      int64   `json:"size"`
+	MaxSize   int64   `json:"max_size"`
+}
+
+// InstanceStats is the statistics of a named cache and, for a tiered cache, of each tier
+type InstanceStats struct {
+	Name      string      `json:"name"`
+	Stats     CacheStats  `json:"stats"`
+	Tiers     []TierStats `json:"tiers,omitempty"`
+	Scannable bool        `json:"scannable"` // Its keys can be listed
+	Flushable bool        `json:"flushable"`
+}
+
+// StatsOf reports the statistics of the cache c, named name
+func StatsOf(name string, c Cache) InstanceStats {
+	stats := InstanceStats{Name: name, Stats: c.Stats()}
+	if tiered, ok := AsTiered(c); ok {
+		stats.Tiers = tiered.TierStats()
+	}
+	_, stats.Scannable = AsScanner(c)
+	_, stats.Flushable = AsFlusher(c)
+	return stats
+}
+
+// Scanner is implemented by caches that can list their keys
+type Scanner interface {
+	// Scan returns up to limit keys starting with prefix, in order; limit <= 0 returns them all
+	Scan(ctx context.Context, prefix string, limit int) ([]string, error)
+}
+
+// Flusher is implemented by caches that can drop every entry at once
+type Flusher interface {
+	Flush(ctx context.Context) error
+}
+
+// AsScanner returns the scanner behind c, looking through wrappers such as CacheMiddleware
+func AsScanner(c Cache) (Scanner, bool) {
+	return find[Scanner](c)
+}
+
+// AsFlusher returns the flusher behind c, looking through wrappers such as CacheMiddleware
+func AsFlusher(c Cache) (Flusher, bool) {
+	return find[Flusher](c)
+}
+
+// find returns the first cache in the chain of wrappers from c that implements T
+func find[T any](c Cache) (T, bool) {
+	for c != nil {
+		if t, ok := c.(T); ok {
+			return t, true
+		}
+		u, ok := c.(interface{ Unwrap() Cache })
+		if !ok {
+			break
+		}
+		c = u.Unwrap()
+	}
+	var zero T
+	return zero, false
 }
 
 var (
 	ErrNotFound   = errors.New("cache: key not found")
 	ErrClosed     = errors.New("cache: cache is closed")
 	ErrInvalidKey = errors.New("cache: invalid key format")
+	// ErrUnsupported is returned by Scan and Flush when a tier cannot list or flush its keys
+	ErrUnsupported = errors.New("cache: operation not supported")
 )
@@ -11,6 +11,7 @@ type CacheConfig struct {
 	Strategy string
 	Memory   MemoryConfig
 	MongoDB  MongoDBConfig
+	Hybrid   HybridConfig
 }
 
 func NewCache(strategy string, config CacheConfig, logger *zap.Logger) (Cache, error) {
@@ -51,5 +52,5 @@ func NewHybridCacheFromConfig(config CacheConfig, logger *zap.Logger) (Cache, er
 	}
 	logger.Info("Hybrid cache using MongoDB as shared tier")
 
-	return NewHybridCache(localCache, sharedCache), nil
+	return NewHybridCache(localCache, sharedCache, config.Hybrid), nil
 }
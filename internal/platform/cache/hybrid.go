@@ -5,84 +5,154 @@ import (
 	"time"
 )
 
+// HybridConfig controls the local tier of a HybridCache
+type HybridConfig struct {
+	// LocalTTL caps how long an entry stays in the local tier, and so how stale an instance can
+	// be when the shared tier changes without it hearing of it; defaults to 30s
+	LocalTTL time.Duration
+}
+
+// TierStats is the statistics of one tier of a tiered cache
+type TierStats struct {
+	Tier  string     `json:"tier"` // "l1" (local) or "l2" (shared)
+	Stats CacheStats `json:"stats"`
+}
+
+// Tiered is implemented by caches with a local tier in front of a shared one
+type Tiered interface {
+	// Invalidate drops the local copy of key only, e.g. when another instance changed the shared one
+	Invalidate(key string)
+	// TierStats reports each tier on its own, fastest first
+	TierStats() []TierStats
+}
+
+// AsTiered returns the tiered cache behind c, looking through wrappers such as CacheMiddleware
+func AsTiered(c Cache) (Tiered, bool) {
+	return find[Tiered](c)
+}
+
+// HybridCache is a two-tier cache: a per-instance memory tier (L1) in front of a store shared by
+// every instance (L2), such as MongoDB or Redis. Reads go through L1 and fill it from L2; writes go
+// to L2 first and then L1, so L2 always holds the latest value. L1 entries live at most
+// LocalTTL, and Invalidate drops them early when a change signal arrives from another instance.
 type HybridCache struct {
-	Local  Cache // fast tier (memory)
-	Shared Cache // distributed tier (redis/memcached)
+	local  Cache
+	shared Cache
+	cfg    HybridConfig
 }
 
-func NewHybridCache(local Cache, shared Cache) Cache {
-	return &HybridCache{
-		Local:  local,
-		Shared: shared,
+// NewHybridCache creates a hybrid cache over a local and a shared tier
+func NewHybridCache(local Cache, shared Cache, cfg HybridConfig) *HybridCache {
+	if cfg.LocalTTL <= 0 {
+		cfg.LocalTTL = 30 * time.Second
 	}
+	return &HybridCache{local: local, shared: shared, cfg: cfg}
 }
 
 func (h *HybridCache) Get(ctx context.Context, key string) ([]byte, error) {
-	// 1) Try local cache first
-	if b, err := h.Local.Get(ctx, key); err == nil {
+	if b, err := h.local.Get(ctx, key); err == nil {
 		return b, nil
 	}
 
-	// 2) Try shared cache
-	b, err := h.Shared.Get(ctx, key)
+	b, err := h.shared.Get(ctx, key)
 	if err != nil {
 		return nil, err
 	}
 
-	// 3) Backfill local with shorter TTL to reduce memory churn
-	_ = h.Local.Set(ctx, key, b, 30*time.Second)
+	// The remaining L2 TTL is unknown, so the copy keeps LocalTTL; it can outlive the L2 entry by
+	// at most that long
+	_ = h.local.Set(ctx, key, b, h.cfg.LocalTTL)
 	return b, nil
 }
 
+// Set writes through to the shared tier. When that fails the local copy is dropped rather than
+// updated, so this instance does not serve a value no other instance sees.
 func (h *HybridCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
-	// Set in local with shorter TTL
-	_ = h.Local.Set(ctx, key, value, min(ttl, 30*time.Second))
-
-	// Set in shared with full TTL
-	return h.Shared.Set(ctx, key, value, ttl)
+	if err := h.shared.Set(ctx, key, value, ttl); err != nil {
+		_ = h.local.Delete(ctx, key)
+		return err
+	}
+	return h.local.Set(ctx, key, value, h.localTTL(ttl))
 }
 
 func (h *HybridCache) Delete(ctx context.Context, key string) error {
-	// Delete from both
-	_ = h.Local.Delete(ctx, key)
-	return h.Shared.Delete(ctx, key)
+	_ = h.local.Delete(ctx, key)
+	return h.shared.Delete(ctx, key)
+}
+
+// Invalidate drops the local copy of key; the shared tier is left as is
+func (h *HybridCache) Invalidate(key string) {
+	_ = h.local.Delete(context.Background(), key)
+}
+
+// Scan lists the keys of the shared tier, which holds every entry the local one has
+func (h *HybridCache) Scan(ctx context.Context, prefix string, limit int) ([]string, error) {
+	scanner, ok := AsScanner(h.shared)
+	if !ok {
+		return nil, ErrUnsupported
+	}
+	return scanner.Scan(ctx, prefix, limit)
+}
+
+// Flush empties the shared tier, then this instance's local tier. Other instances keep their
+// local copies until they expire or a change signal drops them.
+func (h *HybridCache) Flush(ctx context.Context) error {
+	flusher, ok := AsFlusher(h.shared)
+	if !ok {
+		return ErrUnsupported
+	}
+	if err := flusher.Flush(ctx); err != nil {
+		return err
+	}
+	if local, ok := AsFlusher(h.local); ok {
+		return local.Flush(ctx)
+	}
+	return nil
 }
 
 func (h *HybridCache) Close() error {
-	_ = h.Local.Close()
-	return h.Shared.Close()
+	_ = h.local.Close()
+	return h.shared.Close()
 }
 
+// Stats reports the cache as a whole: a lookup is a hit when either tier has the key and a miss
+// only when both miss, which is what the shared tier counts. Size is the shared tier's, as the
+// local one only holds copies of its entries.
 func (h *HybridCache) Stats() CacheStats {
-	localStats := h.Local.Stats()
-	sharedStats := h.Shared.Stats()
-
-	totalHits := localStats.Hits + sharedStats.Hits
-	totalMisses := localStats.Misses + sharedStats.Misses
-	total := totalHits + totalMisses
+	localStats := h.local.Stats()
+	sharedStats := h.shared.Stats()
 
+	hits := localStats.Hits + sharedStats.Hits
+	misses := sharedStats.Misses
 	var hitRate float64
-	if total > 0 {
-		hitRate = float64(totalHits) / float64(total)
+	if total := hits + misses; total > 0 {
+		hitRate = float64(hits) / float64(total)
 	}
 
 	return CacheStats{
-		Hits:      totalHits,
-		Misses:    totalMisses,
+		Hits:      hits,
+		Misses:    misses,
 		Evictions: localStats.Evictions + sharedStats.Evictions,
 		Errors:    localStats.Errors + sharedStats.Errors,
 		HitRate:   hitRate,
-		Size:      localStats.Size + sharedStats.Size,
-		MaxSize:   localStats.MaxSize + sharedStats.MaxSize,
+		Size:      sharedStats.Size,
+		MaxSize:   sharedStats.MaxSize,
 	}
 }
 
-func min(a, b time.Duration) time.Duration {
-	if a == 0 || b == 0 {
-		return a
+// TierStats reports L1 and L2 on their own; L2 only sees the lookups L1 missed
+func (h *HybridCache) TierStats() []TierStats {
+	return []TierStats{
+		{Tier: "l1", Stats: h.local.Stats()},
+		{Tier: "l2", Stats: h.shared.Stats()},
 	}
-	if a < b {
-		return a
+}
+
+// localTTL is the TTL of a local copy: the entry's own TTL capped by LocalTTL; ttl <= 0 means
+// the tier's default, which the cap replaces
+func (h *HybridCache) localTTL(ttl time.Duration) time.Duration {
+	if ttl <= 0 || ttl > h.cfg.LocalTTL {
+		return h.cfg.LocalTTL
 	}
-	return b
+	return ttl
 }
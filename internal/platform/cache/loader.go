@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// ErrCachedNotFound is returned by Loader.Load while a not-found result is cached for the key
+var ErrCachedNotFound = errors.New("cache: record not found (cached)")
+
+// notFoundMarker is stored in place of a value to remember that the record does not exist
+var notFoundMarker = []byte("\x00rx:not-found")
+
+// LoaderConfig sets how a Loader protects the repository behind a cache
+type LoaderConfig struct {
+	// NegativeTTL caches not-found results for this long; 0 disables negative caching
+	NegativeTTL time.Duration
+	// Coalesce makes concurrent misses for the same key share one load
+	Coalesce bool
+}
+
+// LoadFunc reads a value from the repository on a cache miss
+type LoadFunc func(ctx context.Context) ([]byte, error)
+
+// Loader reads through a cache: a miss calls the load function and caches its result. With
+// negative caching a load failing with a not-found error is remembered for a short time, and
+// with coalescing concurrent misses for the same key wait for one load instead of each calling
+// the repository.
+type Loader struct {
+	cache      Cache
+	cfg        LoaderConfig
+	isNotFound func(error) bool
+	group      singleflight.Group
+}
+
+// NewLoader creates a loader over the cache. isNotFound decides which load errors mean the
+// record does not exist.
+func NewLoader(c Cache, cfg LoaderConfig, isNotFound func(error) bool) *Loader {
+	return &Loader{cache: c, cfg: cfg, isNotFound: isNotFound}
+}
+
+// Load returns the cached value of key, or loads it and caches it for ttl. While a not-found
+// result is cached it returns ErrCachedNotFound without calling load. Errors writing the cache
+// are ignored; the value is still returned.
+func (l *Loader) Load(ctx context.Context, key string, ttl time.Duration, load LoadFunc) ([]byte, error) {
+	if cached, err := l.cache.Get(ctx, key); err == nil {
+		if bytes.Equal(cached, notFoundMarker) {
+			return nil, ErrCachedNotFound
+		}
+		return cached, nil
+	}
+
+	if !l.cfg.Coalesce {
+		return l.loadAndStore(ctx, key, ttl, load)
+	}
+
+	// The shared load must not end because the caller that started it went away, so it keeps
+	// the context values but not the cancellation; each caller still stops waiting on its own
+	shared := context.WithoutCancel(ctx)
+	result := l.group.DoChan(key, func() (any, error) {
+		return l.loadAndStore(shared, key, ttl, load)
+	})
+	select {
+	case r := <-result:
+		if r.Err != nil {
+			return nil, r.Err
+		}
+		return r.Val.([]byte), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Forget drops the cached value, including a cached not-found result, of key
+func (l *Loader) Forget(ctx context.Context, key string) error {
+	l.group.Forget(key)
+	return l.cache.Delete(ctx, key)
+}
+
+func (l *Loader) loadAndStore(ctx context.Context, key string, ttl time.Duration, load LoadFunc) ([]byte, error) {
+	value, err := load(ctx)
+	if err != nil {
+		if l.cfg.NegativeTTL > 0 && l.isNotFound != nil && l.isNotFound(err) {
+			_ = l.cache.Set(ctx, key, notFoundMarker, l.cfg.NegativeTTL)
+		}
+		return nil, err
+	}
+	_ = l.cache.Set(ctx, key, value, ttl)
+	return value, nil
+}
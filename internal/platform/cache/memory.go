@@ -2,9 +2,13 @@ package cache
 
 import (
 	"context"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/dgraph-io/ristretto"
+	"github.com/dgraph-io/ristretto/z"
 	"go.uber.org/zap"
 )
 
@@ -12,6 +16,11 @@ type MemoryCache struct {
 	rc     *ristretto.Cache
 	config MemoryConfig
 	logger *zap.Logger
+
+	// Ristretto only keeps key hashes, so the keys are indexed by hash for Scan; entries leave
+	// the index when ristretto evicts, expires or rejects them
+	keysMu sync.Mutex
+	keys   map[uint64]string
 }
 
 type MemoryConfig struct {
@@ -23,21 +32,25 @@ type MemoryConfig struct {
 }
 
 func NewMemoryCache(config MemoryConfig, logger *zap.Logger) (Cache, error) {
+	m := &MemoryCache{
+		config: config,
+		logger: logger,
+		keys:   make(map[uint64]string),
+	}
+
 	rc, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: config.MaxCost * 10, // 10x recommended
 		MaxCost:     config.MaxCost,
 		BufferItems: config.BufferItems,
 		Metrics:     config.Metrics,
+		OnEvict:     m.forget,
+		OnReject:    m.forget,
 	})
 	if err != nil {
 		return nil, err
 	}
-
-	return &MemoryCache{
-		rc:     rc,
-		config: config,
-		logger: logger,
-	}, nil
+	m.rc = rc
+	return m, nil
 }
 
 func (m *MemoryCache) Get(ctx context.Context, key string) ([]byte, error) {
@@ -53,15 +66,68 @@ func (m *MemoryCache) Set(ctx context.Context, key string, value []byte, ttl tim
 		ttl = m.config.DefaultTTL
 	}
 
-	m.rc.Set(key, value, int64(ttl.Nanoseconds()))
+	// Cost is the value size so MaxCost bounds memory; Wait makes the write visible to the next Get
+	hash, _ := z.KeyToHash(key)
+	m.keysMu.Lock()
+	m.keys[hash] = key
+	m.keysMu.Unlock()
+
+	m.rc.SetWithTTL(key, value, int64(len(value)), ttl)
+	m.rc.Wait()
 	return nil
 }
 
 func (m *MemoryCache) Delete(ctx context.Context, key string) error {
 	m.rc.Del(key)
+
+	hash, _ := z.KeyToHash(key)
+	m.keysMu.Lock()
+	delete(m.keys, hash)
+	m.keysMu.Unlock()
 	return nil
 }
 
+// Scan lists the keys still in the cache; the lookups it makes are not counted as hits or misses
+func (m *MemoryCache) Scan(ctx context.Context, prefix string, limit int) ([]string, error) {
+	m.keysMu.Lock()
+	keys := make([]string, 0, len(m.keys))
+	for _, key := range m.keys {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	m.keysMu.Unlock()
+
+	sort.Strings(keys)
+	live := keys[:0]
+	for _, key := range keys {
+		if limit > 0 && len(live) == limit {
+			break
+		}
+		if _, found := m.rc.GetTTL(key); found {
+			live = append(live, key)
+		}
+	}
+	return live, nil
+}
+
+// Flush drops every entry; ristretto resets its hit and miss counters with them
+func (m *MemoryCache) Flush(ctx context.Context) error {
+	m.rc.Clear()
+
+	m.keysMu.Lock()
+	clear(m.keys)
+	m.keysMu.Unlock()
+	return nil
+}
+
+// forget removes an entry ristretto dropped from the key index
+func (m *MemoryCache) forget(item *ristretto.Item) {
+	m.keysMu.Lock()
+	delete(m.keys, item.Key)
+	m.keysMu.Unlock()
+}
+
 func (m *MemoryCache) Close() error {
 	m.rc.Close()
 	return nil
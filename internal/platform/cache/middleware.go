@@ -78,6 +78,11 @@ func (m *CacheMiddleware) Delete(ctx context.Context, key string) error {
 	return err
 }
 
+// Unwrap returns the wrapped cache, see AsTiered
+func (m *CacheMiddleware) Unwrap() Cache {
+	return m.cache
+}
+
 func (m *CacheMiddleware) Close() error {
 	return m.cache.Close()
 }
@@ -2,6 +2,8 @@ package cache
 
 import (
 	"context"
+	"regexp"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -170,6 +172,53 @@ func (m *MongoDBCache) Delete(ctx context.Context, key string) error {
 	return nil
 }
 
+// Scan lists the unexpired keys of this cache's prefix. Only keys that pass ValidateID are ever
+// stored, so a prefix with other characters matches none.
+func (m *MongoDBCache) Scan(ctx context.Context, prefix string, limit int) ([]string, error) {
+	if prefix != "" && !ValidateID(prefix) {
+		return []string{}, nil
+	}
+	filter := bson.M{
+		"_id":      bson.M{"$regex": "^" + regexp.QuoteMeta(m.prefix+prefix)},
+		"expireAt": bson.M{"$gt": time.Now()},
+	}
+	opts := options.Find().
+		SetProjection(bson.M{"_id": 1}).
+		SetSort(bson.D{{Key: "_id", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := m.collection.Find(ctx, filter, opts)
+	if err != nil {
+		m.errors.Add(1)
+		return nil, err
+	}
+	var docs []struct {
+		Key string `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &docs); err != nil {
+		m.errors.Add(1)
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		keys = append(keys, strings.TrimPrefix(doc.Key, m.prefix))
+	}
+	return keys, nil
+}
+
+// Flush deletes every entry of this cache's prefix; other prefixes sharing the collection stay
+func (m *MongoDBCache) Flush(ctx context.Context) error {
+	filter := bson.M{"_id": bson.M{"$regex": "^" + regexp.QuoteMeta(m.prefix)}}
+	if _, err := m.collection.DeleteMany(ctx, filter); err != nil {
+		m.errors.Add(1)
+		return err
+	}
+	return nil
+}
+
 func (m *MongoDBCache) Close() error {
 	// MongoDB client is managed externally, so we don't close it here
 	return nil
@@ -0,0 +1,75 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strings"
+
+	"pharmacy-modernization-project-model/internal/platform/tenancy"
+)
+
+// scopeSeparator starts the scope of a scoped key. Sanitized key parts never hold ':', so a
+// scoped key can't collide with an unscoped one.
+const scopeSeparator = ":scope-"
+
+// Scope is the part of the data a read may see: the organization of its context and, for users
+// with state data-access roles, a set of states. Results read in a scope are cached under keys
+// holding a hash of it, so they are only served to reads with the same scope.
+type Scope struct {
+	OrgID  string
+	States []string
+}
+
+// ScopeOf returns the scope of a read in ctx limited to states; no states means every state
+func ScopeOf(ctx context.Context, states []string) Scope {
+	orgID, _ := tenancy.OrgID(ctx)
+	return Scope{OrgID: orgID, States: states}
+}
+
+// Unscoped reports whether the read sees all the data, as background jobs and admins outside
+// tenancy do. Unscoped reads keep the shared keys that writes evict.
+func (s Scope) Unscoped() bool {
+	return s.OrgID == "" && len(s.States) == 0
+}
+
+// Hash identifies the scope; the order and case of the states don't change it
+func (s Scope) Hash() string {
+	states := make([]string, len(s.States))
+	for i, state := range s.States {
+		states[i] = strings.ToUpper(state)
+	}
+	slices.Sort(states)
+	states = slices.Compact(states)
+
+	sum := sha256.Sum256([]byte(s.OrgID + "|" + strings.Join(states, ",")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// Key returns the key of the scope's entry for key; unscoped reads use key itself
+func (s Scope) Key(key string) string {
+	if s.Unscoped() {
+		return key
+	}
+	return key + scopeSeparator + s.Hash()
+}
+
+// DeleteScoped deletes the entries of every scope for key, leaving key itself. Caches that can't
+// list their keys leave the entries to expire with their TTL.
+func DeleteScoped(ctx context.Context, c Cache, key string) error {
+	scanner, ok := AsScanner(c)
+	if !ok {
+		return nil
+	}
+	keys, err := scanner.Scan(ctx, key+scopeSeparator, 0)
+	if err != nil {
+		return err
+	}
+	for _, scoped := range keys {
+		if err := c.Delete(ctx, scoped); err != nil {
+			return err
+		}
+	}
+	return nil
+}
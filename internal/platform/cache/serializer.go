@@ -0,0 +1,249 @@
+package cache
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Codec turns cached values into bytes and back
+type Codec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+// JSONCodec stores values as JSON, readable in the cache admin and by other tools
+type JSONCodec struct{}
+
+func (JSONCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (JSONCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+// MsgpackCodec stores values as MessagePack, smaller and faster than JSON. Struct fields are
+// named by their json tags, so a value has the same fields in both codecs.
+type MsgpackCodec struct{}
+
+func (MsgpackCodec) Marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := msgpack.NewEncoder(&buf)
+	enc.SetCustomStructTag("json")
+	enc.UseCompactInts(true)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (MsgpackCodec) Unmarshal(data []byte, v any) error {
+	dec := msgpack.NewDecoder(bytes.NewReader(data))
+	dec.SetCustomStructTag("json")
+	return dec.Decode(v)
+}
+
+// msgpackTimeExtID is the MessagePack extension type of timestamps
+const msgpackTimeExtID = -1
+
+// MessagePack decodes timestamps in the local zone; decoding them in UTC, as the MongoDB driver
+// does, keeps a cached value identical to one read from the repository
+func init() {
+	msgpack.RegisterExtDecoder(msgpackTimeExtID, time.Time{}, func(d *msgpack.Decoder, v reflect.Value, extLen int) error {
+		b := make([]byte, extLen)
+		if err := d.ReadFull(b); err != nil {
+			return err
+		}
+		var tm time.Time
+		switch len(b) {
+		case 4: // timestamp 32: seconds
+			tm = time.Unix(int64(binary.BigEndian.Uint32(b)), 0)
+		case 8: // timestamp 64: 30 bits of nanoseconds, 34 bits of seconds
+			packed := binary.BigEndian.Uint64(b)
+			tm = time.Unix(int64(packed&(1<<34-1)), int64(packed>>34))
+		case 12: // timestamp 96: nanoseconds, then seconds
+			tm = time.Unix(int64(binary.BigEndian.Uint64(b[4:])), int64(binary.BigEndian.Uint32(b)))
+		default:
+			return fmt.Errorf("msgpack: invalid timestamp length %d", extLen)
+		}
+		v.Set(reflect.ValueOf(tm.UTC()))
+		return nil
+	})
+}
+
+// Codec and compression names of SerializerConfig
+const (
+	CodecJSON    = "json"
+	CodecMsgpack = "msgpack"
+
+	CompressionNone   = "none"
+	CompressionGzip   = "gzip"
+	CompressionSnappy = "snappy"
+)
+
+// SerializerConfig sets how typed cache values are stored
+type SerializerConfig struct {
+	Codec       string // CodecJSON (default) or CodecMsgpack
+	Compression string // CompressionNone (default), CompressionGzip or CompressionSnappy
+	// CompressionThreshold is the encoded size in bytes from which values are compressed
+	CompressionThreshold int
+}
+
+// Serialized values start with serializedMagic and a format byte: the codec in the high four
+// bits and the compression in the low four. Values without it are plain JSON, as cached before
+// the serializer existed.
+const serializedMagic = 0xfe
+
+// Format IDs, stored in the format byte; never reuse one
+const (
+	formatJSON    byte = 1
+	formatMsgpack byte = 2
+
+	formatUncompressed byte = 0
+	formatGzip         byte = 1
+	formatSnappy       byte = 2
+)
+
+var codecIDs = map[string]byte{CodecJSON: formatJSON, CodecMsgpack: formatMsgpack}
+
+var compressionIDs = map[string]byte{
+	CompressionNone:   formatUncompressed,
+	CompressionGzip:   formatGzip,
+	CompressionSnappy: formatSnappy,
+}
+
+// errUnknownFormat is returned for a value written with a codec or compression this release
+// does not know
+var errUnknownFormat = errors.New("cache: unknown value format")
+
+// Serializer encodes typed cache values with a codec, compressing the large ones. It decodes
+// values written with any codec and compression, so changing the settings does not invalidate
+// the cache. A nil Serializer stores JSON without compression.
+type Serializer struct {
+	codec       byte
+	compression byte
+	threshold   int
+}
+
+// NewSerializer creates a serializer; empty names use JSON without compression
+func NewSerializer(cfg SerializerConfig) (*Serializer, error) {
+	if cfg.Codec == "" {
+		cfg.Codec = CodecJSON
+	}
+	if cfg.Compression == "" {
+		cfg.Compression = CompressionNone
+	}
+	codec, ok := codecIDs[cfg.Codec]
+	if !ok {
+		return nil, fmt.Errorf("cache: unknown codec %q", cfg.Codec)
+	}
+	compression, ok := compressionIDs[cfg.Compression]
+	if !ok {
+		return nil, fmt.Errorf("cache: unknown compression %q", cfg.Compression)
+	}
+	if cfg.CompressionThreshold < 0 {
+		return nil, fmt.Errorf("cache: compression threshold must not be negative, got %d", cfg.CompressionThreshold)
+	}
+	return &Serializer{codec: codec, compression: compression, threshold: cfg.CompressionThreshold}, nil
+}
+
+// Encode marshals v with the codec and compresses it when it reaches the threshold and
+// compression makes it smaller
+func (s *Serializer) Encode(v any) ([]byte, error) {
+	settings := Serializer{codec: formatJSON, compression: formatUncompressed}
+	if s != nil {
+		settings = *s
+	}
+	data, err := codecByID(settings.codec).Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if settings.compression != formatUncompressed && len(data) >= settings.threshold {
+		compressed, err := compress(settings.compression, data)
+		if err != nil {
+			return nil, err
+		}
+		if len(compressed) < len(data) {
+			return append([]byte{serializedMagic, settings.codec<<4 | settings.compression}, compressed...), nil
+		}
+	}
+	return append([]byte{serializedMagic, settings.codec << 4}, data...), nil
+}
+
+// Decode unmarshals a value written by Encode with any settings, or a plain JSON value
+func (s *Serializer) Decode(data []byte, v any) error {
+	if len(data) < 2 || data[0] != serializedMagic {
+		return json.Unmarshal(data, v)
+	}
+	codec := codecByID(data[1] >> 4)
+	if codec == nil {
+		return errUnknownFormat
+	}
+	payload, err := decompress(data[1]&0x0f, data[2:])
+	if err != nil {
+		return err
+	}
+	return codec.Unmarshal(payload, v)
+}
+
+func codecByID(id byte) Codec {
+	switch id {
+	case formatJSON:
+		return JSONCodec{}
+	case formatMsgpack:
+		return MsgpackCodec{}
+	default:
+		return nil
+	}
+}
+
+func compress(compression byte, data []byte) ([]byte, error) {
+	switch compression {
+	case formatGzip:
+		var buf bytes.Buffer
+		w, err := gzip.NewWriterLevel(&buf, gzip.BestSpeed)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case formatSnappy:
+		return snappy.Encode(nil, data), nil
+	default:
+		return nil, errUnknownFormat
+	}
+}
+
+func decompress(compression byte, data []byte) ([]byte, error) {
+	switch compression {
+	case formatUncompressed:
+		return data, nil
+	case formatGzip:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return io.ReadAll(r)
+	case formatSnappy:
+		return snappy.Decode(nil, data)
+	default:
+		return nil, errUnknownFormat
+	}
+}
@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// TypedCache reads and writes values of type T, encoded by a Serializer, so services do not
+// marshal cache entries themselves. Without a cache it caches nothing: Get misses, Set and Delete
+// do nothing and Load calls the load function.
+type TypedCache[T any] struct {
+	cache      Cache
+	loader     *Loader
+	serializer *Serializer
+}
+
+// NewTypedCache creates a typed view of the cache. Load reads through the loader when there is
+// one; c, loader and serializer may each be nil.
+func NewTypedCache[T any](c Cache, loader *Loader, serializer *Serializer) *TypedCache[T] {
+	return &TypedCache[T]{cache: c, loader: loader, serializer: serializer}
+}
+
+// Get returns the cached value of key, or ErrNotFound
+func (t *TypedCache[T]) Get(ctx context.Context, key string) (T, error) {
+	var value T
+	if t.cache == nil {
+		return value, ErrNotFound
+	}
+	data, err := t.cache.Get(ctx, key)
+	if err != nil {
+		return value, err
+	}
+	if err := t.serializer.Decode(data, &value); err != nil {
+		return value, fmt.Errorf("cache: decode %s: %w", key, err)
+	}
+	return value, nil
+}
+
+// Set caches value under key for ttl
+func (t *TypedCache[T]) Set(ctx context.Context, key string, value T, ttl time.Duration) error {
+	if t.cache == nil {
+		return nil
+	}
+	data, err := t.serializer.Encode(value)
+	if err != nil {
+		return fmt.Errorf("cache: encode %s: %w", key, err)
+	}
+	return t.cache.Set(ctx, key, data, ttl)
+}
+
+// Delete drops the cached value of key
+func (t *TypedCache[T]) Delete(ctx context.Context, key string) error {
+	if t.cache == nil {
+		return nil
+	}
+	return t.cache.Delete(ctx, key)
+}
+
+// Load returns the cached value of key, or loads it and caches it for ttl; see Loader.Load for
+// the not-found caching and coalescing a loader adds. An entry that does not decode, e.g. one
+// written for an older version of T, is replaced by a fresh load. Errors writing the cache are
+// ignored.
+func (t *TypedCache[T]) Load(ctx context.Context, key string, ttl time.Duration, load func(ctx context.Context) (T, error)) (T, error) {
+	if t.loader == nil {
+		if value, err := t.Get(ctx, key); err == nil {
+			return value, nil
+		}
+		value, err := load(ctx)
+		if err != nil {
+			return value, err
+		}
+		_ = t.Set(ctx, key, value, ttl)
+		return value, nil
+	}
+
+	encodedLoad := func(ctx context.Context) ([]byte, error) {
+		value, err := load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return t.serializer.Encode(value)
+	}
+	var value T
+	data, err := t.loader.Load(ctx, key, ttl, encodedLoad)
+	if err != nil {
+		return value, err
+	}
+	if err := t.serializer.Decode(data, &value); err == nil {
+		return value, nil
+	}
+
+	_ = t.loader.Forget(ctx, key)
+	if data, err = t.loader.Load(ctx, key, ttl, encodedLoad); err != nil {
+		return value, err
+	}
+	if err := t.serializer.Decode(data, &value); err != nil {
+		return value, fmt.Errorf("cache: decode %s: %w", key, err)
+	}
+	return value, nil
+}
+
+// Forget drops the cached value of key, including a not-found result cached by the loader
+func (t *TypedCache[T]) Forget(ctx context.Context, key string) error {
+	if t.loader != nil {
+		return t.loader.Forget(ctx, key)
+	}
+	return t.Delete(ctx, key)
+}
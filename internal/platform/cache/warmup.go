@@ -0,0 +1,106 @@
+package cache
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// WarmupTask loads one entry into the cache
+type WarmupTask func(ctx context.Context) error
+
+// WarmupConfig bounds the load a warm-up puts on the database
+type WarmupConfig struct {
+	Concurrency int           // Tasks running at once; at least 1
+	Jitter      time.Duration // Each task first waits a random delay up to this
+	Timeout     time.Duration // The whole warm-up stops after this; 0 for no limit
+}
+
+// WarmupResult counts the tasks of one group
+type WarmupResult struct {
+	Group  string
+	Loaded int
+	Failed int
+}
+
+// Warmer preloads cache entries before the first requests need them. Tasks are added in groups,
+// whose results are reported separately.
+type Warmer struct {
+	cfg    WarmupConfig
+	groups []string
+	tasks  map[string][]WarmupTask
+}
+
+// NewWarmer creates an empty warm-up
+func NewWarmer(cfg WarmupConfig) *Warmer {
+	if cfg.Concurrency < 1 {
+		cfg.Concurrency = 1
+	}
+	return &Warmer{cfg: cfg, tasks: make(map[string][]WarmupTask)}
+}
+
+// Add queues tasks under a group name
+func (w *Warmer) Add(group string, tasks ...WarmupTask) {
+	if _, ok := w.tasks[group]; !ok {
+		w.groups = append(w.groups, group)
+	}
+	w.tasks[group] = append(w.tasks[group], tasks...)
+}
+
+// Run executes every task and returns the results in the order the groups were added. Tasks not
+// started before the timeout or ctx ends count as failed.
+func (w *Warmer) Run(ctx context.Context) []WarmupResult {
+	if w.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.cfg.Timeout)
+		defer cancel()
+	}
+
+	results := make([]WarmupResult, len(w.groups))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, w.cfg.Concurrency)
+
+	for i, group := range w.groups {
+		results[i].Group = group
+		for _, task := range w.tasks[group] {
+			wg.Add(1)
+			go func(result *WarmupResult, task WarmupTask) {
+				defer wg.Done()
+				err := w.runTask(ctx, slots, task)
+
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					result.Failed++
+				} else {
+					result.Loaded++
+				}
+			}(&results[i], task)
+		}
+	}
+	wg.Wait()
+	return results
+}
+
+func (w *Warmer) runTask(ctx context.Context, slots chan struct{}, task WarmupTask) error {
+	select {
+	case slots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-slots }()
+
+	// Spread the loads, so a burst of tasks does not reach the database in lockstep
+	if w.cfg.Jitter > 0 {
+		timer := time.NewTimer(rand.N(w.cfg.Jitter))
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return task(ctx)
+}
@@ -0,0 +1,107 @@
+// Package capacity watches how large MongoDB collections and their indexes grow. A scheduler job
+// compares each configured collection with a soft and a hard limit, alerts through logs and
+// metrics, and at the hard limit applies the collection's mitigation: sampling new records or
+// moving old documents to an archive collection.
+package capacity
+
+import (
+	"context"
+	"time"
+)
+
+// Level is how close a collection is to its limits
+type Level string
+
+const (
+	LevelOK   Level = "ok"
+	LevelSoft Level = "soft" // Over the soft limit: alert
+	LevelHard Level = "hard" // Over the hard limit: alert and mitigate
+)
+
+// rank orders levels for comparison and for the level gauge
+func (l Level) rank() int {
+	switch l {
+	case LevelSoft:
+		return 1
+	case LevelHard:
+		return 2
+	}
+	return 0
+}
+
+// Mitigation is what the monitor does about a collection over its hard limit
+type Mitigation string
+
+const (
+	MitigationNone Mitigation = "none"
+	// MitigationSample keeps only a share of new records; writers check Sampler.Keep
+	MitigationSample Mitigation = "sample"
+	// MitigationArchive moves documents older than the archive age to <collection>_archive
+	MitigationArchive Mitigation = "archive"
+)
+
+// Limit is the capacity policy of one collection; zero byte limits are not checked
+type Limit struct {
+	Collection     string // Key in database.mongodb.collections
+	SoftBytes      int64
+	HardBytes      int64
+	IndexSoftBytes int64
+	IndexHardBytes int64
+	Mitigation     Mitigation
+	// ArchiveField is the date field compared with ArchiveAfter by the archive mitigation
+	ArchiveField string
+	ArchiveAfter time.Duration
+}
+
+// level compares stats with the limits; the data and index levels are checked separately and
+// the higher one wins
+func (l Limit) level(stats Stats) Level {
+	return maxLevel(levelOf(stats.DataBytes, l.SoftBytes, l.HardBytes), levelOf(stats.IndexBytes, l.IndexSoftBytes, l.IndexHardBytes))
+}
+
+func levelOf(size, soft, hard int64) Level {
+	switch {
+	case hard > 0 && size >= hard:
+		return LevelHard
+	case soft > 0 && size >= soft:
+		return LevelSoft
+	}
+	return LevelOK
+}
+
+func maxLevel(a, b Level) Level {
+	if b.rank() > a.rank() {
+		return b
+	}
+	return a
+}
+
+// Stats is the size of a collection
+type Stats struct {
+	Documents int64 `json:"documents" bson:"documents"`
+	// DataBytes is the uncompressed size of the documents; it shrinks when documents are removed
+	DataBytes int64 `json:"data_bytes" bson:"data_bytes"`
+	// StorageBytes is the space allocated on disk; MongoDB reuses freed space instead of returning it
+	StorageBytes int64 `json:"storage_bytes" bson:"storage_bytes"`
+	IndexBytes   int64 `json:"index_bytes" bson:"index_bytes"`
+}
+
+// Status is the outcome of the last check of a collection, shared by every instance
+type Status struct {
+	Collection string    `json:"collection" bson:"_id"`
+	Level      Level     `json:"level" bson:"level"`
+	Sampling   bool      `json:"sampling" bson:"sampling"`
+	Stats      Stats     `json:"stats" bson:"stats"`
+	CheckedAt  time.Time `json:"checked_at" bson:"checked_at"`
+}
+
+// Store reads collection sizes, archives old documents and keeps the check results
+type Store interface {
+	// Stats returns the size of a collection; a collection that does not exist yet is empty
+	Stats(ctx context.Context, collection string) (Stats, error)
+	// Archive moves up to limit documents whose field is before cutoff, oldest first, to
+	// <collection>_archive and returns how many were moved
+	Archive(ctx context.Context, collection, field string, cutoff time.Time, limit int) (int, error)
+	SaveStatus(ctx context.Context, status Status) error
+	ListStatus(ctx context.Context) ([]Status, error)
+}
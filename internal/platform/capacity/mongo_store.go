@@ -0,0 +1,146 @@
+package capacity
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// archiveSuffix is appended to a collection's name to name its archive
+const archiveSuffix = "_archive"
+
+// codeNamespaceNotFound is returned by $collStats for a collection that does not exist yet
+const codeNamespaceNotFound = 26
+
+// MongoStore reads sizes with $collStats and keeps one status document per collection
+type MongoStore struct {
+	collection func(name string) *mongo.Collection
+	status     *mongo.Collection
+}
+
+// NewMongoStore creates a MongoDB-backed store; collection resolves a configured collection key
+// to its collection
+func NewMongoStore(collection func(name string) *mongo.Collection, status *mongo.Collection) *MongoStore {
+	return &MongoStore{collection: collection, status: status}
+}
+
+// collStats is the part of a $collStats result the monitor reads; sizes are doubles or integers
+// depending on the server
+type collStats struct {
+	StorageStats struct {
+		Count          float64 `bson:"count"`
+		Size           float64 `bson:"size"`
+		StorageSize    float64 `bson:"storageSize"`
+		TotalIndexSize float64 `bson:"totalIndexSize"`
+	} `bson:"storageStats"`
+}
+
+func (s *MongoStore) Stats(ctx context.Context, collection string) (Stats, error) {
+	pipeline := mongo.Pipeline{{{Key: "$collStats", Value: bson.M{"storageStats": bson.M{}}}}}
+	cursor, err := s.collection(collection).Aggregate(ctx, pipeline)
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) && cmdErr.Code == codeNamespaceNotFound {
+		return Stats{}, nil
+	}
+	if err != nil {
+		return Stats{}, platformErrors.HandleMongoError("CollectionStats", err)
+	}
+	defer cursor.Close(ctx)
+
+	// A sharded collection has one result per shard
+	stats := Stats{}
+	for cursor.Next(ctx) {
+		var shard collStats
+		if err := cursor.Decode(&shard); err != nil {
+			return Stats{}, platformErrors.HandleMongoError("CollectionStats", err)
+		}
+		stats.Documents += int64(shard.StorageStats.Count)
+		stats.DataBytes += int64(shard.StorageStats.Size)
+		stats.StorageBytes += int64(shard.StorageStats.StorageSize)
+		stats.IndexBytes += int64(shard.StorageStats.TotalIndexSize)
+	}
+	if err := cursor.Err(); err != nil {
+		return Stats{}, platformErrors.HandleMongoError("CollectionStats", err)
+	}
+	return stats, nil
+}
+
+func (s *MongoStore) Archive(ctx context.Context, collection, field string, cutoff time.Time, limit int) (int, error) {
+	source := s.collection(collection)
+	archive := source.Database().Collection(source.Name() + archiveSuffix)
+
+	cursor, err := source.Find(ctx, bson.M{field: bson.M{"$lt": cutoff}}, options.Find().
+		SetSort(bson.D{{Key: field, Value: 1}}).
+		SetLimit(int64(limit)))
+	if err != nil {
+		return 0, platformErrors.HandleMongoError("Archive", err)
+	}
+	var docs []bson.Raw
+	if err := cursor.All(ctx, &docs); err != nil {
+		return 0, platformErrors.HandleMongoError("Archive", err)
+	}
+	if len(docs) == 0 {
+		return 0, nil
+	}
+
+	batch := make([]any, len(docs))
+	ids := make(bson.A, len(docs))
+	for i, doc := range docs {
+		batch[i] = doc
+		ids[i] = doc.Lookup("_id")
+	}
+	// Copy before deleting, so an interrupted run never loses documents. Documents copied by an
+	// earlier interrupted run are already in the archive and collide on _id.
+	_, err = archive.InsertMany(ctx, batch, options.InsertMany().SetOrdered(false))
+	if err != nil && !onlyDuplicateKeys(err) {
+		return 0, platformErrors.HandleMongoError("Archive", err)
+	}
+
+	deleted, err := source.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return 0, platformErrors.HandleMongoError("Archive", err)
+	}
+	return int(deleted.DeletedCount), nil
+}
+
+// onlyDuplicateKeys reports whether every failed insert of a bulk write hit an existing _id
+func onlyDuplicateKeys(err error) bool {
+	var bulkErr mongo.BulkWriteException
+	if !errors.As(err, &bulkErr) || bulkErr.WriteConcernError != nil || len(bulkErr.WriteErrors) == 0 {
+		return false
+	}
+	for _, writeErr := range bulkErr.WriteErrors {
+		if !mongo.IsDuplicateKeyError(writeErr) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *MongoStore) SaveStatus(ctx context.Context, status Status) error {
+	_, err := s.status.ReplaceOne(ctx, bson.M{"_id": status.Collection}, status, options.Replace().SetUpsert(true))
+	if err != nil {
+		return platformErrors.HandleMongoError("SaveStatus", err)
+	}
+	return nil
+}
+
+func (s *MongoStore) ListStatus(ctx context.Context) ([]Status, error) {
+	cursor, err := s.status.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, platformErrors.HandleMongoError("ListStatus", err)
+	}
+	defer cursor.Close(ctx)
+
+	statuses := []Status{}
+	if err := cursor.All(ctx, &statuses); err != nil {
+		return nil, platformErrors.HandleMongoError("ListStatus", err)
+	}
+	return statuses, nil
+}
@@ -0,0 +1,191 @@
+package capacity
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/metrics"
+	"pharmacy-modernization-project-model/internal/platform/scheduler"
+)
+
+// MonitorConfig lists the collections to watch
+type MonitorConfig struct {
+	Limits []Limit
+	// ArchiveBatchSize is how many documents are moved per round trip
+	ArchiveBatchSize int
+}
+
+func (c *MonitorConfig) setDefaults() {
+	if c.ArchiveBatchSize <= 0 {
+		c.ArchiveBatchSize = 500
+	}
+}
+
+func (c MonitorConfig) validate() error {
+	for _, limit := range c.Limits {
+		if limit.SoftBytes > 0 && limit.HardBytes > 0 && limit.SoftBytes > limit.HardBytes ||
+			limit.IndexSoftBytes > 0 && limit.IndexHardBytes > 0 && limit.IndexSoftBytes > limit.IndexHardBytes {
+			return fmt.Errorf("capacity of %s: soft limit is above the hard limit", limit.Collection)
+		}
+		switch limit.Mitigation {
+		case "", MitigationNone, MitigationSample:
+		case MitigationArchive:
+			if limit.ArchiveField == "" || limit.ArchiveAfter <= 0 {
+				return fmt.Errorf("capacity of %s: archive mitigation needs an archive field and age", limit.Collection)
+			}
+		default:
+			return fmt.Errorf("capacity of %s: unknown mitigation %q", limit.Collection, limit.Mitigation)
+		}
+	}
+	return nil
+}
+
+// Monitor is the capacity check job. Each run records the size of every configured collection,
+// logs a warning over the soft limit and an error over the hard limit, and mitigates at the hard
+// limit. Sampling stays on until the collection is back under its soft limit.
+type Monitor struct {
+	store   Store
+	sampler *Sampler
+	log     *zap.Logger
+	cfg     MonitorConfig
+	now     func() time.Time
+}
+
+// NewMonitor creates the job; it fails on inconsistent limits or mitigations
+func NewMonitor(store Store, sampler *Sampler, log *zap.Logger, cfg MonitorConfig) (*Monitor, error) {
+	cfg.setDefaults()
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	if log == nil {
+		log = zap.NewNop()
+	}
+	return &Monitor{store: store, sampler: sampler, log: log, cfg: cfg, now: time.Now}, nil
+}
+
+// Run checks every collection; a failure on one collection does not stop the others. A retry of
+// a failed run only checks the collections that failed.
+func (m *Monitor) Run(ctx context.Context) error {
+	previous := map[string]Status{}
+	statuses, err := m.store.ListStatus(ctx)
+	if err != nil {
+		return err
+	}
+	for _, status := range statuses {
+		previous[status.Collection] = status
+	}
+
+	limits := m.cfg.Limits
+	if resume := scheduler.ResumeFrom(ctx); resume != "" {
+		retry := strings.Split(resume, ",")
+		limits = slices.DeleteFunc(slices.Clone(limits), func(limit Limit) bool {
+			return !slices.Contains(retry, limit.Collection)
+		})
+	}
+
+	var failed []string
+	for _, limit := range limits {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := m.check(ctx, limit, previous[limit.Collection]); err != nil {
+			m.log.Error("Capacity check failed", zap.String("collection", limit.Collection), zap.Error(err))
+			scheduler.ItemFailed(ctx, fmt.Errorf("%s: %w", limit.Collection, err))
+			failed = append(failed, limit.Collection)
+			continue
+		}
+		scheduler.Processed(ctx, 1)
+	}
+	if len(failed) > 0 {
+		scheduler.SetCheckpoint(ctx, strings.Join(failed, ","))
+		return fmt.Errorf("capacity check failed for %d of %d collections", len(failed), len(limits))
+	}
+	return nil
+}
+
+func (m *Monitor) check(ctx context.Context, limit Limit, previous Status) error {
+	stats, err := m.store.Stats(ctx, limit.Collection)
+	if err != nil {
+		return err
+	}
+	level := limit.level(stats)
+	log := m.log.With(
+		zap.String("collection", limit.Collection),
+		zap.String("level", string(level)),
+		zap.Int64("documents", stats.Documents),
+		zap.Int64("data_bytes", stats.DataBytes),
+		zap.Int64("index_bytes", stats.IndexBytes))
+
+	switch level {
+	case LevelHard:
+		log.Error("Collection is over its hard capacity limit",
+			zap.Int64("hard_bytes", limit.HardBytes),
+			zap.Int64("index_hard_bytes", limit.IndexHardBytes),
+			zap.String("mitigation", string(limit.Mitigation)))
+	case LevelSoft:
+		log.Warn("Collection is over its soft capacity limit",
+			zap.Int64("soft_bytes", limit.SoftBytes),
+			zap.Int64("index_soft_bytes", limit.IndexSoftBytes))
+	default:
+		if previous.Level.rank() > LevelOK.rank() {
+			log.Info("Collection is back under its capacity limits", zap.String("previous_level", string(previous.Level)))
+		}
+	}
+
+	// Sampling starts at the hard limit and only stops under the soft limit, so it does not flap
+	sampling := previous.Sampling
+	switch {
+	case limit.Mitigation == MitigationSample && level == LevelHard:
+		sampling = true
+	case limit.Mitigation != MitigationSample || level == LevelOK:
+		sampling = false
+	}
+	if sampling != previous.Sampling {
+		log.Warn("Capacity sampling changed", zap.Bool("sampling", sampling))
+	}
+	if m.sampler != nil {
+		m.sampler.set(limit.Collection, sampling)
+	}
+
+	if limit.Mitigation == MitigationArchive && level == LevelHard {
+		if err := m.archive(ctx, log, limit); err != nil {
+			return err
+		}
+	}
+
+	metrics.ObserveCollectionCapacity(limit.Collection, stats.DataBytes, stats.IndexBytes, level.rank(), sampling)
+	return m.store.SaveStatus(ctx, Status{
+		Collection: limit.Collection,
+		Level:      level,
+		Sampling:   sampling,
+		Stats:      stats,
+		CheckedAt:  m.now(),
+	})
+}
+
+// archive moves every document older than the archive age, a batch at a time
+func (m *Monitor) archive(ctx context.Context, log *zap.Logger, limit Limit) error {
+	cutoff := m.now().Add(-limit.ArchiveAfter)
+	archived := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		moved, err := m.store.Archive(ctx, limit.Collection, limit.ArchiveField, cutoff, m.cfg.ArchiveBatchSize)
+		archived += moved
+		metrics.ObserveArchivedDocuments(limit.Collection, moved)
+		if err != nil {
+			return fmt.Errorf("archive after %d documents: %w", archived, err)
+		}
+		if moved < m.cfg.ArchiveBatchSize {
+			break
+		}
+	}
+	log.Warn("Archived old documents", zap.Int("archived", archived), zap.Time("cutoff", cutoff))
+	return nil
+}
@@ -0,0 +1,98 @@
+package capacity
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// samplerRefreshInterval is how often instances pick up sampling switched on or off by the monitor
+const samplerRefreshInterval = time.Minute
+
+// Sampler tells writers whether to store a record while its collection is being sampled. The
+// monitor switches sampling on and off; Run keeps other instances in step through the Store.
+type Sampler struct {
+	store Store
+	rate  float64
+	log   *zap.Logger
+
+	mu       sync.RWMutex
+	sampling map[string]bool
+	random   func() float64
+}
+
+// NewSampler creates a sampler that keeps the given share of records while sampling; a rate
+// outside (0, 1] falls back to 0.1
+func NewSampler(store Store, rate float64, log *zap.Logger) *Sampler {
+	if rate <= 0 || rate > 1 {
+		rate = 0.1
+	}
+	if log == nil {
+		log = zap.NewNop()
+	}
+	return &Sampler{store: store, rate: rate, log: log, sampling: map[string]bool{}, random: rand.Float64}
+}
+
+// Keep reports whether a new record of collection should be stored. It always does when the
+// collection is not being sampled or the sampler is nil.
+func (s *Sampler) Keep(collection string) bool {
+	if s == nil {
+		return true
+	}
+	s.mu.RLock()
+	sampling := s.sampling[collection]
+	s.mu.RUnlock()
+	return !sampling || s.random() < s.rate
+}
+
+// Sampling reports whether collection is being sampled
+func (s *Sampler) Sampling(collection string) bool {
+	if s == nil {
+		return false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.sampling[collection]
+}
+
+func (s *Sampler) set(collection string, sampling bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sampling[collection] = sampling
+}
+
+// Refresh loads the sampling state saved by the monitor
+func (s *Sampler) Refresh(ctx context.Context) error {
+	statuses, err := s.store.ListStatus(ctx)
+	if err != nil {
+		return err
+	}
+	sampling := make(map[string]bool, len(statuses))
+	for _, status := range statuses {
+		sampling[status.Collection] = status.Sampling
+	}
+	s.mu.Lock()
+	s.sampling = sampling
+	s.mu.Unlock()
+	return nil
+}
+
+// Run refreshes the sampling state until ctx is cancelled
+func (s *Sampler) Run(ctx context.Context) {
+	ticker := time.NewTicker(samplerRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Refresh(ctx); err != nil && ctx.Err() == nil {
+			s.log.Warn("Failed to refresh capacity sampling state", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
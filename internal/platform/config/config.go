@@ -1,6 +1,8 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"strings"
 
@@ -9,9 +11,10 @@ import (
 
 type Config struct {
 	App struct {
-		Name string `mapstructure:"name"`
-		Env  string `mapstructure:"env"`
-		Port int    `mapstructure:"port"`
+		Name     string         `mapstructure:"name"`
+		Env      string         `mapstructure:"env"`
+		Port     int            `mapstructure:"port"`
+		Shutdown ShutdownConfig `mapstructure:"shutdown"`
 	} `mapstructure:"app"`
 	Logging struct {
 		Enabled        bool   `mapstructure:"enabled"`
@@ -25,56 +28,601 @@ type Config struct {
 	} `mapstructure:"logging"`
 	Auth struct {
 		DevMode bool `mapstructure:"dev_mode"`
-		JWT     struct {
+		// DevUsersFile is a YAML file of mock users added to the built-in ones in dev mode
+		DevUsersFile string `mapstructure:"dev_users_file"`
+		JWT          struct {
 			Cookie           CookieConfig               `mapstructure:"cookie"`
 			TokenTypesConfig map[string]TokenTypeConfig `mapstructure:"token_types_config"`
 			JWKSCache        int                        `mapstructure:"jwks_cache"`
 			TokenTypes       []string                   `mapstructure:"token_types"`
+			Secret           string                     `mapstructure:"secret"` // HMAC key for local tokens
 		} `mapstructure:"jwt"`
+		Login   LoginConfig   `mapstructure:"login"`
+		Tenancy TenancyConfig `mapstructure:"tenancy"`
+		// RouteManifest is a route security manifest file replacing the built-in
+		// internal/platform/permissions/routes.yaml
+		RouteManifest string `mapstructure:"route_manifest"`
 	} `mapstructure:"auth"`
 	Database struct {
 		MongoDB struct {
 			URI         string `mapstructure:"uri"`
 			Database    string `mapstructure:"database"`
 			Collections struct {
-				Patients      string `mapstructure:"patients"`
-				Addresses     string `mapstructure:"addresses"`
-				Prescriptions string `mapstructure:"prescriptions"`
+				Patients               string `mapstructure:"patients"`
+				Addresses              string `mapstructure:"addresses"`
+				Prescriptions          string `mapstructure:"prescriptions"`
+				DrugInteractions       string `mapstructure:"drug_interactions"`
+				DrugCatalog            string `mapstructure:"drug_catalog"`
+				Prescribers            string `mapstructure:"prescribers"`
+				Measurements           string `mapstructure:"measurements"`
+				Allergies              string `mapstructure:"allergies"`
+				AuditLog               string `mapstructure:"audit_log"`
+				DataRepairs            string `mapstructure:"data_repairs"`
+				Dispenses              string `mapstructure:"dispenses"`
+				Attachments            string `mapstructure:"attachments"`
+				InsuranceRecords       string `mapstructure:"insurance_records"`
+				Webhooks               string `mapstructure:"webhooks"`
+				WebhookDeliveries      string `mapstructure:"webhook_deliveries"`
+				AccessReviews          string `mapstructure:"access_reviews"`
+				SchedulerLocks         string `mapstructure:"scheduler_locks"`
+				JobRuns                string `mapstructure:"job_runs"`
+				Jobs                   string `mapstructure:"jobs"`
+				CapacityStatus         string `mapstructure:"capacity_status"`
+				PatientImportTemplates string `mapstructure:"patient_import_templates"`
+				IdempotencyKeys        string `mapstructure:"idempotency_keys"`
+				Migrations             string `mapstructure:"migrations"`
+				Transmissions          string `mapstructure:"transmissions"`
+				HTTPCaptures           string `mapstructure:"http_captures"`
+				Communications         string `mapstructure:"communications"`
+				BillingDiscrepancies   string `mapstructure:"billing_discrepancies"`
+				PatientDocuments       string `mapstructure:"patient_documents"`
+				PrescriptionDrafts     string `mapstructure:"prescription_drafts"`
+				AdherenceSnapshots     string `mapstructure:"adherence_snapshots"`
+				RevokedTokens          string `mapstructure:"revoked_tokens"`
 			} `mapstructure:"collections"`
 			Connection struct {
-				MaxPoolSize    uint64 `mapstructure:"max_pool_size"`
-				MinPoolSize    uint64 `mapstructure:"min_pool_size"`
-				MaxIdleTime    string `mapstructure:"max_idle_time"`
-				ConnectTimeout string `mapstructure:"connect_timeout"`
-				SocketTimeout  string `mapstructure:"socket_timeout"`
+				MaxPoolSize     uint64  `mapstructure:"max_pool_size"`
+				MinPoolSize     uint64  `mapstructure:"min_pool_size"`
+				MaxIdleTime     string  `mapstructure:"max_idle_time"`
+				ConnectTimeout  string  `mapstructure:"connect_timeout"`
+				SocketTimeout   string  `mapstructure:"socket_timeout"`
+				SaturationAlarm float64 `mapstructure:"saturation_alarm"`
 			} `mapstructure:"connection"`
 			Options struct {
 				RetryWrites bool `mapstructure:"retry_writes"`
 				RetryReads  bool `mapstructure:"retry_reads"`
 			} `mapstructure:"options"`
+			ReadPreference struct {
+				Default      string `mapstructure:"default"`       // Reads that must see the latest writes
+				Analytics    string `mapstructure:"analytics"`     // Counts, exports and dashboards
+				MaxStaleness string `mapstructure:"max_staleness"` // How far behind a secondary serving reads may be; at least 90s
+			} `mapstructure:"read_preference"`
+			ReadConcern  string `mapstructure:"read_concern"` // local, available, majority or linearizable; server default when empty
+			WriteConcern struct {
+				W       string `mapstructure:"w"` // "majority" or a number of members; server default when empty
+				Journal bool   `mapstructure:"journal"`
+				Timeout string `mapstructure:"timeout"`
+			} `mapstructure:"write_concern"`
+			ChangeStreams struct {
+				Enabled bool `mapstructure:"enabled"` // Requires a replica set
+			} `mapstructure:"change_streams"`
+			Transactions struct {
+				Enabled     bool   `mapstructure:"enabled"`      // Requires a replica set; writes run without transactions otherwise
+				MaxAttempts int    `mapstructure:"max_attempts"` // Runs of a transaction failing with a transient error
+				Timeout     string `mapstructure:"timeout"`      // Bounds a transaction with all of its attempts
+			} `mapstructure:"transactions"`
+			Retry struct {
+				Enabled        bool   `mapstructure:"enabled"`
+				MaxAttempts    int    `mapstructure:"max_attempts"`    // Runs of a repository operation, including the first
+				InitialBackoff string `mapstructure:"initial_backoff"` // Doubles with each attempt up to max_backoff
+				MaxBackoff     string `mapstructure:"max_backoff"`
+				Jitter         string `mapstructure:"jitter"` // Random delay added to each backoff
+			} `mapstructure:"retry"`
 		} `mapstructure:"mongodb"`
 	} `mapstructure:"database"`
 	External struct {
+		HTTP     ExternalHTTPConfig `mapstructure:"http"`
 		Stargate struct {
-			UseMock      bool              `mapstructure:"use_mock"`
-			Timeout      string            `mapstructure:"timeout"`
-			ClientID     string            `mapstructure:"client_id"`
-			ClientSecret string            `mapstructure:"client_secret"`
-			Scope        string            `mapstructure:"scope"`
-			Endpoints    StargateEndpoints `mapstructure:"endpoints"`
+			Enabled       bool              `mapstructure:"enabled"` // Send a Stargate access token with IRIS calls
+			UseMock       bool              `mapstructure:"use_mock"`
+			Timeout       string            `mapstructure:"timeout"`
+			RefreshBefore string            `mapstructure:"refresh_before"` // Renew tokens this long before they expire
+			ClientID      string            `mapstructure:"client_id"`
+			ClientSecret  string            `mapstructure:"client_secret"`
+			Scope         string            `mapstructure:"scope"`
+			Endpoints     StargateEndpoints `mapstructure:"endpoints"`
 		} `mapstructure:"stargate"`
 		Pharmacy struct {
-			UseMock   bool              `mapstructure:"use_mock"`
-			Timeout   string            `mapstructure:"timeout"`
-			Endpoints PharmacyEndpoints `mapstructure:"endpoints"`
+			UseMock              bool              `mapstructure:"use_mock"`
+			Timeout              string            `mapstructure:"timeout"`
+			SlowRequestThreshold string            `mapstructure:"slow_request_threshold"`
+			Endpoints            PharmacyEndpoints `mapstructure:"endpoints"`
 		} `mapstructure:"pharmacy"`
 		Billing struct {
-			UseMock   bool             `mapstructure:"use_mock"`
-			Timeout   string           `mapstructure:"timeout"`
-			Endpoints BillingEndpoints `mapstructure:"endpoints"`
+			UseMock              bool             `mapstructure:"use_mock"`
+			Timeout              string           `mapstructure:"timeout"`
+			SlowRequestThreshold string           `mapstructure:"slow_request_threshold"`
+			Endpoints            BillingEndpoints `mapstructure:"endpoints"`
 		} `mapstructure:"billing"`
+		CardOCR struct {
+			UseMock              bool             `mapstructure:"use_mock"`
+			Timeout              string           `mapstructure:"timeout"`
+			SlowRequestThreshold string           `mapstructure:"slow_request_threshold"`
+			Endpoints            CardOCREndpoints `mapstructure:"endpoints"`
+		} `mapstructure:"card_ocr"`
+		Notifications struct {
+			UseMock              bool                  `mapstructure:"use_mock"`
+			Timeout              string                `mapstructure:"timeout"`
+			SlowRequestThreshold string                `mapstructure:"slow_request_threshold"`
+			SMSFrom              string                `mapstructure:"sms_from"` // Sender number or ID of text messages
+			SMTP                 SMTPConfig            `mapstructure:"smtp"`
+			Endpoints            NotificationEndpoints `mapstructure:"endpoints"`
+		} `mapstructure:"notifications"`
 	} `mapstructure:"external"`
-	Cache CacheConfig `mapstructure:"cache"`
+	Cache       CacheConfig           `mapstructure:"cache"`
+	Workers     WorkersConfig         `mapstructure:"workers"`
+	Scheduler   SchedulerConfig       `mapstructure:"scheduler"`
+	Jobs        JobsConfig            `mapstructure:"jobs"`
+	Metrics     MetricsConfig         `mapstructure:"metrics"`
+	DataRepair  DataRepairConfig      `mapstructure:"data_repair"`
+	Billing     BillingConfig         `mapstructure:"billing"`
+	Redaction   RedactionConfig       `mapstructure:"redaction"`
+	Idempotency IdempotencyConfig     `mapstructure:"idempotency"`
+	Limits      RequestLimitsConfig   `mapstructure:"request_limits"`
+	Export      ExportConfig          `mapstructure:"patient_export"`
+	Import      ImportConfig          `mapstructure:"patient_import"`
+	Duplicates  DuplicatesConfig      `mapstructure:"patient_duplicates"`
+	Insurance   InsuranceConfig       `mapstructure:"insurance_intake"`
+	Documents   DocumentsConfig       `mapstructure:"patient_documents"`
+	Drafts      DraftsConfig          `mapstructure:"prescription_drafts"`
+	Events      EventsConfig          `mapstructure:"events"`
+	I18n        I18nConfig            `mapstructure:"i18n"`
+	Webhooks    WebhooksConfig        `mapstructure:"webhooks"`
+	Access      AccessReviewConfig    `mapstructure:"access_review"`
+	Encryption  FieldEncryptionConfig `mapstructure:"field_encryption"`
+	Schemas     SchemasConfig         `mapstructure:"schemas"`
+	GraphQL     GraphQLConfig         `mapstructure:"graphql"`
+	Navigation  NavigationConfig      `mapstructure:"navigation"`
+	Pagination  PaginationConfig      `mapstructure:"pagination"`
+	API         APIConfig             `mapstructure:"api"`
+	Reload      ReloadConfig          `mapstructure:"config_reload"`
+	FHIR        FHIRConfig            `mapstructure:"fhir"`
+	GRPC        GRPCConfig            `mapstructure:"grpc"`
+	Dashboard   DashboardConfig       `mapstructure:"dashboard"`
+	Comms       CommunicationsConfig  `mapstructure:"communications"`
+
+	files    []string // Config files read, in the order they were merged
+	loadErrs []error  // Problems reading the files, reported by Validate
+}
+
+// ShutdownConfig bounds how long the server takes to stop after SIGINT or SIGTERM
+type ShutdownConfig struct {
+	DrainTimeout   string `mapstructure:"drain_timeout"`   // How long in-flight requests may run after the listeners close
+	WorkersTimeout string `mapstructure:"workers_timeout"` // How long each group of background workers may take to stop
+}
+
+// FHIRConfig controls the HL7 FHIR R4 endpoints under /fhir
+type FHIRConfig struct {
+	IdentifierSystem         string   `mapstructure:"identifier_system"`          // Namespace URI of the patient and prescription identifiers, e.g. "urn:rx"
+	InboundIdentifierSystems []string `mapstructure:"inbound_identifier_systems"` // Identifier systems of pushed Patients used as the patient ID, e.g. an EHR's MRN
+}
+
+// GRPCConfig controls the gRPC API for internal services, served on a port of its own
+type GRPCConfig struct {
+	Enabled    bool `mapstructure:"enabled"`
+	Port       int  `mapstructure:"port"`
+	Reflection bool `mapstructure:"reflection"` // Lets tools such as grpcurl list the services
+}
+
+// DashboardConfig controls the dashboard page
+type DashboardConfig struct {
+	LiveUpdates DashboardLiveUpdatesConfig `mapstructure:"live_updates"`
+}
+
+// DashboardLiveUpdatesConfig controls the Server-Sent Events stream that updates an open dashboard
+type DashboardLiveUpdatesConfig struct {
+	Enabled           bool   `mapstructure:"enabled"`
+	Interval          string `mapstructure:"interval"`           // The dashboard is pushed this often, besides after prescription changes
+	HeartbeatInterval string `mapstructure:"heartbeat_interval"` // An idle stream gets a comment this often, so proxies keep it open
+}
+
+// CommunicationsConfig controls notifying patients of their prescriptions and invoices
+type CommunicationsConfig struct {
+	Enabled      bool   `mapstructure:"enabled"`
+	PharmacyName string `mapstructure:"pharmacy_name"` // Signs the messages sent to patients
+}
+
+// ReloadConfig controls applying edits of the config files without a restart; only the settings
+// listed in ReloadableSettings take effect, others are reported as needing a restart
+type ReloadConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// APIConfig lists the versions the REST API is served in
+type APIConfig struct {
+	DefaultVersion string             `mapstructure:"default_version"` // Serves unversioned paths whose Accept header names no version
+	Versions       []APIVersionConfig `mapstructure:"versions"`
+	ProblemDetails bool               `mapstructure:"problem_details"` // Answer every API error with application/problem+json (RFC 7807), not only requests that accept it
+}
+
+// APIVersionConfig is one REST API version; setting deprecated_at or sunset_at starts its retirement
+type APIVersionConfig struct {
+	Name         string `mapstructure:"name"`          // e.g. v1, served under /api/v1
+	DeprecatedAt string `mapstructure:"deprecated_at"` // RFC 3339 date sent in the Deprecation header
+	SunsetAt     string `mapstructure:"sunset_at"`     // RFC 3339 date sent in the Sunset header
+	Link         string `mapstructure:"link"`          // Migration guide, sent as a Link with rel="deprecation"
+}
+
+// PaginationConfig controls the cursors of connection-style lists
+type PaginationConfig struct {
+	CursorKey string `mapstructure:"cursor_key"` // Base64 32-byte key that encrypts and signs cursors; shared by all instances
+	CursorTTL string `mapstructure:"cursor_ttl"` // Cursors older than this are rejected
+}
+
+// NavigationConfig controls the back-stack behind the back links and breadcrumbs of the UI
+type NavigationConfig struct {
+	CookieName string `mapstructure:"cookie_name"` // Holds the navigation session ID
+	SessionTTL string `mapstructure:"session_ttl"` // A back-stack is forgotten after this long without navigation
+	MaxDepth   int    `mapstructure:"max_depth"`   // Pages kept per back-stack
+}
+
+// GraphQLConfig limits the cost of a single GraphQL operation
+type GraphQLConfig struct {
+	MaxDepth        int `mapstructure:"max_depth"`         // Nested field levels; 0 disables
+	MaxComplexity   int `mapstructure:"max_complexity"`    // Estimated values resolved; 0 disables
+	DefaultListSize int `mapstructure:"default_list_size"` // Assumed size of list fields without a limit argument
+
+	PersistedQueries PersistedQueriesConfig `mapstructure:"persisted_queries"`
+	Federation       FederationConfig       `mapstructure:"federation"`
+}
+
+// PersistedQueriesConfig controls automatic persisted queries and the allow-list of queries
+type PersistedQueriesConfig struct {
+	CacheTTL      string `mapstructure:"cache_ttl"`       // How long a query registered by a client is remembered
+	AllowListDir  string `mapstructure:"allow_list_dir"`  // .graphql files of the pre-registered queries, one operation per file
+	AllowListOnly bool   `mapstructure:"allow_list_only"` // Only run pre-registered queries; clients cannot register others
+}
+
+// FederationConfig controls whether the server acts as an Apollo Federation v2 subgraph
+type FederationConfig struct {
+	// Enabled serves _service, _entities and the subgraph SDL; off, the schema is the standalone one
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// SchemasConfig controls validation of published and consumed events against their contracts
+type SchemasConfig struct {
+	// Enforce drops published events and rejects consumed ones that break their contract; otherwise violations are only logged and counted
+	Enforce bool `mapstructure:"enforce"`
+}
+
+// FieldEncryptionConfig controls encryption of patient PHI (name, DOB and phone) in MongoDB
+type FieldEncryptionConfig struct {
+	Enabled   bool   `mapstructure:"enabled"`
+	ActiveKey string `mapstructure:"active_key"` // ID of the key new values are encrypted with
+	// Keys maps key IDs to base64 32-byte AES keys; retired keys stay listed until every document was re-encrypted
+	Keys     map[string]string `mapstructure:"keys"`
+	IndexKey string            `mapstructure:"index_key"` // Base64 32-byte HMAC key for the blind indexes
+}
+
+// AccessReviewConfig controls the periodic access review of login users' effective permissions
+type AccessReviewConfig struct {
+	Enabled  bool   `mapstructure:"enabled"`
+	Interval string `mapstructure:"interval"` // A review is generated when the latest one is older than this
+	// FuncRolePermissions lists the permissions each functional role grants, as provisioned in the identity provider
+	FuncRolePermissions map[string][]string `mapstructure:"func_role_permissions"`
+	// ConflictingPermissions are sets of permissions no single user should hold together
+	ConflictingPermissions [][]string `mapstructure:"conflicting_permissions"`
+	// A permission held by at most RareGrantMaxUsers users is flagged once the review covers RareGrantMinUsers users
+	RareGrantMaxUsers int `mapstructure:"rare_grant_max_users"`
+	RareGrantMinUsers int `mapstructure:"rare_grant_min_users"`
+}
+
+// EventsConfig controls how domain events reach their subscribers
+type EventsConfig struct {
+	Mode      string `mapstructure:"mode"`       // "async" (default): delivered by a background worker; "sync": before the change returns
+	QueueSize int    `mapstructure:"queue_size"` // Events the async worker may fall behind by
+}
+
+// I18nConfig controls the language of messages shown to users
+type I18nConfig struct {
+	DefaultLocale string `mapstructure:"default_locale"` // For requests that choose none and whose Accept-Language matches none; "en" when empty
+}
+
+// WebhooksConfig controls delivery of domain events to registered webhook endpoints
+type WebhooksConfig struct {
+	Enabled      bool   `mapstructure:"enabled"`
+	PollInterval string `mapstructure:"poll_interval"` // How often due deliveries are looked for
+	Timeout      string `mapstructure:"timeout"`       // Per delivery attempt
+	MaxAttempts  int    `mapstructure:"max_attempts"`  // A delivery fails after this many attempts
+	BaseBackoff  string `mapstructure:"base_backoff"`  // Delay after the first failed attempt, doubled after each
+	MaxBackoff   string `mapstructure:"max_backoff"`
+	BatchSize    int    `mapstructure:"batch_size"`
+}
+
+// InsuranceConfig controls insurance card intake
+type InsuranceConfig struct {
+	ReviewConfidence float64 `mapstructure:"review_confidence"` // OCR fields read below this confidence are flagged for review
+	MaxImageBytes    int     `mapstructure:"max_image_bytes"`   // Size limit of each card photo
+}
+
+// DocumentsConfig controls the documents and photos attached to patients
+type DocumentsConfig struct {
+	MaxFileMB    int             `mapstructure:"max_file_mb"`   // Largest file accepted
+	ContentTypes []string        `mapstructure:"content_types"` // Types accepted, as detected from the content
+	BlobStore    BlobStoreConfig `mapstructure:"blob_store"`
+	VirusScan    VirusScanConfig `mapstructure:"virus_scan"`
+}
+
+// BlobStoreConfig selects where file content is kept
+type BlobStoreConfig struct {
+	Type string `mapstructure:"type"` // "disk" (the default) or "s3"
+	Dir  string `mapstructure:"dir"`  // Disk store directory; a directory under the OS temp dir when empty
+	S3   struct {
+		Endpoint        string `mapstructure:"endpoint"` // S3-compatible store URL; AWS when empty
+		Region          string `mapstructure:"region"`
+		Bucket          string `mapstructure:"bucket"`
+		AccessKeyID     string `mapstructure:"access_key_id"`
+		SecretAccessKey string `mapstructure:"secret_access_key"`
+		UsePathStyle    bool   `mapstructure:"use_path_style"`
+		Timeout         string `mapstructure:"timeout"`
+	} `mapstructure:"s3"`
+}
+
+// VirusScanConfig controls the malware scan of uploads
+type VirusScanConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Address string `mapstructure:"address"` // clamd TCP address, e.g. "localhost:3310"
+	Timeout string `mapstructure:"timeout"`
+}
+
+// ExportConfig controls the patient list CSV/XLSX export
+type ExportConfig struct {
+	Dir         string `mapstructure:"dir"`           // Files of background exports; OS temp dir when empty
+	JobTTL      string `mapstructure:"job_ttl"`       // How long a finished background export can be downloaded
+	MaxSyncRows int    `mapstructure:"max_sync_rows"` // Larger exports must use async=true; 0 means no limit
+}
+
+// ImportConfig controls the patient CSV import wizard
+type ImportConfig struct {
+	MaxFileMB int    `mapstructure:"max_file_mb"` // Largest CSV file accepted
+	MaxRows   int    `mapstructure:"max_rows"`    // Most patients one file may hold
+	JobTTL    string `mapstructure:"job_ttl"`     // How long an upload waits for its mapping, and a finished import shows its result
+}
+
+// DraftsConfig controls the prescription forms the UI autosaves as drafts
+type DraftsConfig struct {
+	TTL         string `mapstructure:"ttl"`          // Drafts not saved for this long are removed, e.g. "168h"
+	MaxPerOwner int    `mapstructure:"max_per_user"` // Drafts each user may keep; -1 for no limit
+}
+
+// DuplicatesConfig controls the check for likely duplicates before a patient is created
+type DuplicatesConfig struct {
+	Mode            string `mapstructure:"mode"`              // "off", "warn" (return them with the new patient) or "block" (refuse to create it)
+	MaxNameDistance int    `mapstructure:"max_name_distance"` // Edits allowed between the names of patients with the same DOB
+}
+
+// TenancyConfig controls soft multi-tenancy: when enabled, patients, prescriptions and addresses
+// are scoped to the organization in the caller's token
+type TenancyConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// LoginConfig controls the /auth login endpoints that issue local tokens
+type LoginConfig struct {
+	Enabled        bool              `mapstructure:"enabled"`
+	UserStore      string            `mapstructure:"user_store"`      // "config" (the users below) or "idp" (delegated password grant)
+	AccessTTL      string            `mapstructure:"access_ttl"`      // Defaults to the auth cookie max_age
+	RefreshTTL     string            `mapstructure:"refresh_ttl"`     // How long a login can be renewed without the password
+	RefreshEnabled bool              `mapstructure:"refresh_enabled"` // Refresh re-reads the user, which the "idp" store cannot do
+	Users          []LoginUserConfig `mapstructure:"users"`
+	IdP            LoginIdPConfig    `mapstructure:"idp"`
+}
+
+// LoginUserConfig is a user of the "config" user store
+type LoginUserConfig struct {
+	Username        string   `mapstructure:"username"`
+	PasswordHash    string   `mapstructure:"password_hash"` // bcrypt
+	Name            string   `mapstructure:"name"`
+	Email           string   `mapstructure:"email"`
+	Permissions     []string `mapstructure:"permissions"`
+	DataAccessRoles []string `mapstructure:"data_access_roles"`
+	FuncRoles       []string `mapstructure:"func_roles"`
+	OrgID           string   `mapstructure:"org_id"` // Organization the user's tokens are scoped to
+}
+
+// LoginIdPConfig is the identity provider behind the "idp" user store
+type LoginIdPConfig struct {
+	TokenURL     string `mapstructure:"token_url"`
+	ClientID     string `mapstructure:"client_id"`
+	ClientSecret string `mapstructure:"client_secret"`
+	Scope        string `mapstructure:"scope"`
+	Timeout      string `mapstructure:"timeout"`
+}
+
+// RedactionConfig selects which response fields each client application may see
+type RedactionConfig struct {
+	Enabled        bool                     `mapstructure:"enabled"`
+	DefaultProfile string                   `mapstructure:"default_profile"` // Profile for tokens matching no other profile
+	Profiles       []RedactionProfileConfig `mapstructure:"profiles"`
+}
+
+// RedactionProfileConfig is one client application's field set; the first matching profile wins
+type RedactionProfileConfig struct {
+	Name         string   `mapstructure:"name"`
+	ClientIDs    []string `mapstructure:"client_ids"`
+	Scopes       []string `mapstructure:"scopes"`
+	RedactFields []string `mapstructure:"redact_fields"` // JSON field names replaced with null
+}
+
+// IdempotencyConfig controls replaying responses to REST requests retried with an X-Idempotency-Key
+type IdempotencyConfig struct {
+	Enabled        bool   `mapstructure:"enabled"`
+	TTL            string `mapstructure:"ttl"`             // How long a response is replayed for retries
+	PendingTimeout string `mapstructure:"pending_timeout"` // How long a key stays locked while its first request runs
+	MaxBodyKB      int    `mapstructure:"max_body_kb"`     // Larger requests are handled without idempotency
+}
+
+// RequestLimitsConfig caps the size of request bodies by route group
+type RequestLimitsConfig struct {
+	MaxBodyKB int                       `mapstructure:"max_body_kb"` // Limit of routes in no group; 0 is unlimited
+	Groups    []RequestLimitGroupConfig `mapstructure:"groups"`
+}
+
+// RequestLimitGroupConfig is the body limit of the routes under a path prefix; the first matching group wins
+type RequestLimitGroupConfig struct {
+	Prefix    string `mapstructure:"prefix"` // * matches one path segment, e.g. /api/*/patients
+	MaxBodyKB int    `mapstructure:"max_body_kb"`
+}
+
+// BillingConfig controls invoicing on top of the IRIS billing client
+type BillingConfig struct {
+	AutoInvoiceOnComplete bool    `mapstructure:"auto_invoice_on_complete"`
+	DispensingFee         float64 `mapstructure:"dispensing_fee"` // Amount billed when none is given
+	CacheTTL              string  `mapstructure:"cache_ttl"`
+	SummaryCacheTTL       string  `mapstructure:"summary_cache_ttl"`  // Patient invoice lists, refreshed sooner than single invoices
+	SummaryMaxStale       string  `mapstructure:"summary_max_stale"`  // How long an expired list may be shown while IRIS is down
+	WebhookSecret         string  `mapstructure:"webhook_secret"`     // Verifies IRIS invoice webhooks; they are disabled when empty
+	EstimateCacheTTL      string  `mapstructure:"estimate_cache_ttl"` // Prescription price estimates
+	// DefaultPricing prices estimates while IRIS billing is unavailable
+	DefaultPricing DefaultPricingConfig `mapstructure:"default_pricing"`
+}
+
+// DefaultPricingConfig is the pricing table used for estimates when IRIS billing cannot be reached;
+// the dispensing fee is added to the drug cost
+type DefaultPricingConfig struct {
+	UnitPrice float64            `mapstructure:"unit_price"` // Per unit of drugs not listed
+	Drugs     map[string]float64 `mapstructure:"drugs"`      // Unit price by drug name; names are matched ignoring case
+}
+
+// DataRepairConfig controls the break-fix data repair API
+type DataRepairConfig struct {
+	RequireSecondApprover bool     `mapstructure:"require_second_approver"`
+	Collections           []string `mapstructure:"collections"` // Logical collection names that may be repaired
+}
+
+// MetricsConfig controls the Prometheus /metrics endpoint
+type MetricsConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// WorkersConfig holds background worker configuration
+type WorkersConfig struct {
+	FulfillmentPolling FulfillmentPollingConfig `mapstructure:"fulfillment_polling"`
+	Transmissions      TransmissionsConfig      `mapstructure:"transmissions"`
+}
+
+// FulfillmentPollingConfig controls the pharmacy fulfillment status poller
+type FulfillmentPollingConfig struct {
+	Enabled     bool   `mapstructure:"enabled"`
+	Interval    string `mapstructure:"interval"`
+	BaseBackoff string `mapstructure:"base_backoff"`
+	MaxBackoff  string `mapstructure:"max_backoff"`
+	BatchSize   int    `mapstructure:"batch_size"`
+}
+
+// TransmissionsConfig controls the sending of prescriptions to pharmacies as SCRIPT messages
+type TransmissionsConfig struct {
+	Enabled       bool   `mapstructure:"enabled"` // Runs the worker that retries sends and polls status
+	Interval      string `mapstructure:"interval"`
+	BatchSize     int    `mapstructure:"batch_size"`
+	MaxAttempts   int    `mapstructure:"max_attempts"`
+	RetryBackoff  string `mapstructure:"retry_backoff"`
+	MaxBackoff    string `mapstructure:"max_backoff"`
+	PollInterval  string `mapstructure:"poll_interval"`
+	VerifyTimeout string `mapstructure:"verify_timeout"`
+}
+
+// SchedulerConfig holds the periodic jobs; each job runs on one instance at a time
+type SchedulerConfig struct {
+	JobRuns                JobRunsConfig                `mapstructure:"job_runs"`
+	PrescriptionExpiration PrescriptionExpirationConfig `mapstructure:"prescription_expiration"`
+	CapacityMonitor        CapacityMonitorConfig        `mapstructure:"capacity_monitor"`
+	BillingReconciliation  BillingReconciliationConfig  `mapstructure:"billing_reconciliation"`
+	AdherenceSnapshots     AdherenceSnapshotsConfig     `mapstructure:"adherence_snapshots"`
+}
+
+// JobsConfig controls the background job queue and this instance's workers
+type JobsConfig struct {
+	Enabled           bool   `mapstructure:"enabled"` // Runs workers; without them jobs are only enqueued
+	Workers           int    `mapstructure:"workers"`
+	PollInterval      string `mapstructure:"poll_interval"`
+	VisibilityTimeout string `mapstructure:"visibility_timeout"` // A job whose worker stops renewing it runs again after this long
+	MaxAttempts       int    `mapstructure:"max_attempts"`
+	BaseBackoff       string `mapstructure:"base_backoff"`
+	MaxBackoff        string `mapstructure:"max_backoff"`
+	RetentionDays     int    `mapstructure:"retention_days"` // Finished jobs older than this are deleted; 0 keeps them
+}
+
+// JobRunsConfig controls the job run history
+type JobRunsConfig struct {
+	RetentionDays int `mapstructure:"retention_days"` // Runs older than this are deleted; 0 keeps them
+}
+
+// PrescriptionExpirationConfig controls the job that closes old active prescriptions
+type PrescriptionExpirationConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	Interval   string `mapstructure:"interval"`
+	MaxAgeDays int    `mapstructure:"max_age_days"` // Active prescriptions older than this are expired
+	Status     string `mapstructure:"status"`       // "Expired" or "Completed"
+	BatchSize  int    `mapstructure:"batch_size"`
+}
+
+// BillingReconciliationConfig controls the job that compares completed prescriptions with their IRIS invoices
+type BillingReconciliationConfig struct {
+	Enabled          bool   `mapstructure:"enabled"`
+	Interval         string `mapstructure:"interval"`
+	BatchSize        int    `mapstructure:"batch_size"`
+	MaxPrescriptions int    `mapstructure:"max_prescriptions"` // The newest completed prescriptions checked per run
+}
+
+// AdherenceSnapshotsConfig controls the job that saves every patient's adherence metrics nightly
+type AdherenceSnapshotsConfig struct {
+	Enabled       bool   `mapstructure:"enabled"`
+	Interval      string `mapstructure:"interval"`
+	BatchSize     int    `mapstructure:"batch_size"`
+	RetentionDays int    `mapstructure:"retention_days"` // Snapshots older than this are deleted; 0 keeps them
+}
+
+// CapacityMonitorConfig controls the job that checks collection growth against soft and hard limits
+type CapacityMonitorConfig struct {
+	Enabled          bool                                `mapstructure:"enabled"`
+	Interval         string                              `mapstructure:"interval"`
+	SampleRate       float64                             `mapstructure:"sample_rate"` // Share of new records kept while a collection is sampled
+	ArchiveBatchSize int                                 `mapstructure:"archive_batch_size"`
+	Collections      map[string]CollectionCapacityConfig `mapstructure:"collections"` // Keyed like database.mongodb.collections
+}
+
+// CollectionCapacityConfig holds the limits of one collection; a zero limit is not checked
+type CollectionCapacityConfig struct {
+	SoftLimitMB      int64  `mapstructure:"soft_limit_mb"`
+	HardLimitMB      int64  `mapstructure:"hard_limit_mb"`
+	IndexSoftLimitMB int64  `mapstructure:"index_soft_limit_mb"`
+	IndexHardLimitMB int64  `mapstructure:"index_hard_limit_mb"`
+	Mitigation       string `mapstructure:"mitigation"`         // At the hard limit: "none", "sample" or "archive"
+	ArchiveField     string `mapstructure:"archive_field"`      // Date field that decides a document's age
+	ArchiveAfterDays int    `mapstructure:"archive_after_days"` // Older documents are archived
+}
+
+// ExternalHTTPConfig holds the shared HTTP client defaults for external APIs
+type ExternalHTTPConfig struct {
+	Timeout              string `mapstructure:"timeout"`                // Default per-request timeout
+	DeadlineMargin       string `mapstructure:"deadline_margin"`        // Kept free before the caller's deadline
+	SlowRequestThreshold string `mapstructure:"slow_request_threshold"` // Slower requests are logged as warnings
+	CircuitBreaker       struct {
+		FailureThreshold int    `mapstructure:"failure_threshold"` // Consecutive failures that open a service's circuit; 0 disables
+		OpenTimeout      string `mapstructure:"open_timeout"`      // How long calls fail fast before a trial call
+	} `mapstructure:"circuit_breaker"`
+	Capture HTTPCaptureConfig `mapstructure:"capture"`
+}
+
+// HTTPCaptureConfig controls the capture of IRIS request and response bodies for debugging
+type HTTPCaptureConfig struct {
+	Enabled       bool    `mapstructure:"enabled"`
+	SampleRate    float64 `mapstructure:"sample_rate"`    // Share of calls captured, from 0 to 1
+	CaptureErrors bool    `mapstructure:"capture_errors"` // Capture every 4xx/5xx answer whatever the sample rate
+	MaxBodyBytes  int     `mapstructure:"max_body_bytes"` // Redacted bodies are cut to this size
+	Sink          string  `mapstructure:"sink"`           // "log" or "collection"
+	TTL           string  `mapstructure:"ttl"`            // How long captures are kept in the collection
 }
 
 // StargateEndpoints holds the full URLs for Stargate authentication endpoints
@@ -85,7 +633,31 @@ type StargateEndpoints struct {
 
 // PharmacyEndpoints holds the full URLs for pharmacy API endpoints
 type PharmacyEndpoints struct {
-	GetPrescription string `mapstructure:"get_prescription"`
+	GetPrescription   string `mapstructure:"get_prescription"`
+	SearchPharmacies  string `mapstructure:"search_pharmacies"`
+	GetPharmacy       string `mapstructure:"get_pharmacy"`
+	RoutePrescription string `mapstructure:"route_prescription"`
+	SendScript        string `mapstructure:"send_script"`
+	MessageStatus     string `mapstructure:"message_status"`
+}
+
+// CardOCREndpoints holds the full URLs for the insurance card OCR provider
+type CardOCREndpoints struct {
+	ExtractCard string `mapstructure:"extract_card"`
+}
+
+// NotificationEndpoints holds the full URLs for the SMS provider
+type NotificationEndpoints struct {
+	SendSMS string `mapstructure:"send_sms"`
+}
+
+// SMTPConfig is the mail server patient emails are sent through; no host leaves email off
+type SMTPConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"` // Empty sends without authentication
+	Password string `mapstructure:"password"`
+	From     string `mapstructure:"from"`
 }
 
 // BillingEndpoints holds the full URLs for billing API endpoints
@@ -94,13 +666,51 @@ type BillingEndpoints struct {
 	GetInvoicesByPatient string `mapstructure:"get_invoices_by_patient"`
 	CreateInvoice        string `mapstructure:"create_invoice"`
 	AcknowledgeInvoice   string `mapstructure:"acknowledge_invoice"`
+	VoidInvoice          string `mapstructure:"void_invoice"`
+	AdjustInvoice        string `mapstructure:"adjust_invoice"`
 	GetInvoicePayment    string `mapstructure:"get_invoice_payment"`
+	EstimatePrice        string `mapstructure:"estimate_price"`
 }
 
 // CacheConfig holds cache configuration
 type CacheConfig struct {
 	MongoDB CacheMongoDBConfig `mapstructure:"mongodb"`
 	Memory  MemoryCacheConfig  `mapstructure:"memory"`
+	Hybrid  HybridCacheConfig  `mapstructure:"hybrid"`
+	Warmup  CacheWarmupConfig  `mapstructure:"warmup"`
+	// Loaders configures the read-through loader of each service, keyed by service name
+	Loaders       map[string]CacheLoaderConfig `mapstructure:"loaders"`
+	Serialization CacheSerializationConfig     `mapstructure:"serialization"`
+}
+
+// CacheSerializationConfig sets how services encode the values they cache
+type CacheSerializationConfig struct {
+	Codec               string `mapstructure:"codec"`                 // "json" or "msgpack"
+	Compression         string `mapstructure:"compression"`           // "none", "gzip" or "snappy"
+	CompressionMinBytes int    `mapstructure:"compression_min_bytes"` // Smaller encoded values are stored uncompressed
+}
+
+// CacheLoaderConfig protects a service's repository from repeated and concurrent cache misses
+type CacheLoaderConfig struct {
+	NegativeTTL string `mapstructure:"negative_ttl"` // How long a not-found result is cached; empty or 0 disables it
+	Coalesce    bool   `mapstructure:"coalesce"`     // Concurrent misses for the same key share one load
+}
+
+// HybridCacheConfig puts the memory cache in front of the MongoDB cache
+type HybridCacheConfig struct {
+	Enabled  bool   `mapstructure:"enabled"`
+	LocalTTL string `mapstructure:"local_ttl"` // Longest an instance keeps its memory copy of an entry
+	// Watch drops memory copies as soon as another instance changes the MongoDB entry (requires a replica set)
+	Watch bool `mapstructure:"watch"`
+}
+
+// CacheWarmupConfig controls preloading the cache at startup
+type CacheWarmupConfig struct {
+	Enabled     bool   `mapstructure:"enabled"`
+	Patients    int    `mapstructure:"patients"`    // Most recently updated patients to preload
+	Concurrency int    `mapstructure:"concurrency"` // Loads running at once
+	Jitter      string `mapstructure:"jitter"`      // Random delay up to this before each load
+	Timeout     string `mapstructure:"timeout"`     // Startup continues after this, warm or not
 }
 
 // CacheMongoDBConfig holds MongoDB cache configuration
@@ -109,11 +719,12 @@ type CacheMongoDBConfig struct {
 	Database   string `mapstructure:"database"`
 	Collection string `mapstructure:"collection"`
 	Connection struct {
-		MaxPoolSize    uint64 `mapstructure:"max_pool_size"`
-		MinPoolSize    uint64 `mapstructure:"min_pool_size"`
-		MaxIdleTime    string `mapstructure:"max_idle_time"`
-		ConnectTimeout string `mapstructure:"connect_timeout"`
-		SocketTimeout  string `mapstructure:"socket_timeout"`
+		MaxPoolSize     uint64  `mapstructure:"max_pool_size"`
+		MinPoolSize     uint64  `mapstructure:"min_pool_size"`
+		MaxIdleTime     string  `mapstructure:"max_idle_time"`
+		ConnectTimeout  string  `mapstructure:"connect_timeout"`
+		SocketTimeout   string  `mapstructure:"socket_timeout"`
+		SaturationAlarm float64 `mapstructure:"saturation_alarm"`
 	} `mapstructure:"connection"`
 }
 
@@ -125,21 +736,38 @@ type MemoryCacheConfig struct {
 	DefaultTTL  string `mapstructure:"default_ttl"`
 }
 
+// configDir holds app.yaml and the env-specific app.<env>.yaml files
+const configDir = "./internal/configs"
+
+// Load reads app.yaml, the optional app.<RX_APP_ENV>.yaml and RX_ environment variables. A file
+// that cannot be parsed, or a setting that is unknown or of the wrong type, is kept for Validate to
+// report, so the server refuses to start rather than run with the setting dropped.
 func Load() *Config {
 	v := viper.New()
 	v.SetConfigName("app")
 	v.SetConfigType("yaml")
-	v.AddConfigPath("./internal/configs")
-	_ = v.ReadInConfig()
+	v.AddConfigPath(configDir)
+
+	cfg := &Config{}
+	if err := v.ReadInConfig(); err != nil {
+		cfg.loadErrs = append(cfg.loadErrs, readError("app.yaml", err)...)
+	} else {
+		cfg.files = append(cfg.files, v.ConfigFileUsed())
+	}
 
 	// optional env-specific file: RX_APP_ENV=prod -> app.prod.yaml
 	if env := os.Getenv("RX_APP_ENV"); env != "" {
 		v2 := viper.New()
 		v2.SetConfigName("app." + env)
-		v2.AddConfigPath("./internal/configs")
+		v2.AddConfigPath(configDir)
 		v2.SetConfigType("yaml")
 		if err := v2.ReadInConfig(); err == nil {
-			v.MergeConfigMap(v2.AllSettings())
+			cfg.files = append(cfg.files, v2.ConfigFileUsed())
+			if err := v.MergeConfigMap(v2.AllSettings()); err != nil {
+				cfg.loadErrs = append(cfg.loadErrs, fmt.Errorf("app.%s.yaml: %w", env, err))
+			}
+		} else {
+			cfg.loadErrs = append(cfg.loadErrs, readError("app."+env+".yaml", err)...)
 		}
 	}
 
@@ -147,8 +775,9 @@ func Load() *Config {
 	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	v.AutomaticEnv()
 
-	cfg := &Config{}
-	_ = v.Unmarshal(cfg)
+	if err := v.UnmarshalExact(cfg); err != nil {
+		cfg.loadErrs = append(cfg.loadErrs, decodeErrors(err)...)
+	}
 	if cfg.App.Port == 0 {
 		cfg.App.Port = 8080
 	}
@@ -183,6 +812,14 @@ func Load() *Config {
 	if !v.IsSet("logging.enabled") {
 		cfg.Logging.Enabled = true
 	}
+	// Repairs need a second approver unless explicitly disabled
+	if !v.IsSet("data_repair.require_second_approver") {
+		cfg.DataRepair.RequireSecondApprover = true
+	}
+	// Completed prescriptions are invoiced unless explicitly disabled
+	if !v.IsSet("billing.auto_invoice_on_complete") {
+		cfg.Billing.AutoInvoiceOnComplete = true
+	}
 	// Auth defaults
 	// JWT Secret is REQUIRED via RX_AUTH_JWT_SECRET environment variable
 	// No default provided for security reasons
@@ -196,6 +833,31 @@ func Load() *Config {
 	return cfg
 }
 
+// readError reports a config file that exists but cannot be read; a missing file is not an error
+func readError(file string, err error) []error {
+	var notFound viper.ConfigFileNotFoundError
+	if errors.As(err, &notFound) {
+		return nil
+	}
+	return []error{fmt.Errorf("%s: %w", file, err)}
+}
+
+// decodeErrors splits the error of decoding the settings into one error per setting
+func decodeErrors(err error) []error {
+	var errs []error
+	for _, line := range strings.Split(err.Error(), "\n") {
+		line = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "*"))
+		if line == "" || strings.HasPrefix(line, "decoding failed due to") || strings.HasSuffix(line, "error(s) decoding:") {
+			continue
+		}
+		errs = append(errs, errors.New(line))
+	}
+	if len(errs) == 0 {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
 // CookieConfig represents cookie configuration
 type CookieConfig struct {
 	Name     string `mapstructure:"name"`
@@ -0,0 +1,286 @@
+package config
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"pharmacy-modernization-project-model/internal/platform/i18n"
+)
+
+// mockForbiddenEnvs are the tiers whose integrations must call the real services
+var mockForbiddenEnvs = []string{"prod"}
+
+// durationSuffixes mark the string settings that hold a Go duration, e.g. "30s"
+var durationSuffixes = []string{"ttl", "timeout", "interval", "backoff", "threshold", "jitter", "max_stale", "staleness", "margin", "idle_time", "refresh_before"}
+
+var (
+	logLevels           = []string{"debug", "info", "warn", "error", "dpanic", "panic", "fatal"}
+	logFormats          = []string{"console", "json"}
+	logOutputs          = []string{"console", "file", "both"}
+	userStores          = []string{"config", "idp"}
+	expireStatus        = []string{"Expired", "Completed"}
+	mitigations         = []string{"none", "sample", "archive"}
+	captureSinks        = []string{"log", "collection"}
+	blobStores          = []string{"disk", "s3"}
+	cacheCodecs         = []string{"json", "msgpack"}
+	cacheCompressions   = []string{"none", "gzip", "snappy"}
+	duplicateModes      = []string{"off", "warn", "block"}
+	eventModes          = []string{"async", "sync"}
+	readPreferences     = []string{"primary", "primaryPreferred", "secondary", "secondaryPreferred", "nearest"}
+	readConcerns        = []string{"local", "available", "majority", "linearizable"}
+	nonNegativeSettings = []string{
+		"graphql.max_depth", "graphql.max_complexity", "graphql.default_list_size",
+		"navigation.max_depth", "jobs.workers", "jobs.max_attempts", "jobs.retention_days",
+		"webhooks.max_attempts", "webhooks.batch_size", "patient_export.max_sync_rows",
+		"patient_import.max_file_mb", "patient_import.max_rows", "idempotency.max_body_kb",
+		"patient_documents.max_file_mb",
+		"request_limits.max_body_kb",
+		"external.http.capture.max_body_bytes",
+		"database.mongodb.retry.max_attempts",
+		"cache.serialization.compression_min_bytes",
+		"patient_duplicates.max_name_distance",
+		"events.queue_size",
+		"scheduler.adherence_snapshots.batch_size", "scheduler.adherence_snapshots.retention_days",
+	}
+)
+
+// MockToggle is the use_mock setting of one external integration
+type MockToggle struct {
+	Name    string // The section under external, e.g. "pharmacy"
+	UseMock bool
+}
+
+// MockToggles lists the use_mock setting of every external integration. Stargate only counts
+// while it is enabled, as a disabled Stargate is not called at all.
+func (c *Config) MockToggles() []MockToggle {
+	return []MockToggle{
+		{Name: "stargate", UseMock: c.External.Stargate.Enabled && c.External.Stargate.UseMock},
+		{Name: "pharmacy", UseMock: c.External.Pharmacy.UseMock},
+		{Name: "billing", UseMock: c.External.Billing.UseMock},
+		{Name: "card_ocr", UseMock: c.External.CardOCR.UseMock},
+		{Name: "notifications", UseMock: c.External.Notifications.UseMock},
+	}
+}
+
+// Validate checks the rules the defaults cannot fix and reports every problem at once, including
+// files and settings Load could not read; the server does not start while it fails
+func (c *Config) Validate() error {
+	errs := slices.Clone(c.loadErrs)
+	if slices.Contains(mockForbiddenEnvs, c.App.Env) {
+		for _, toggle := range c.MockToggles() {
+			if toggle.UseMock {
+				errs = append(errs, fmt.Errorf("external.%s.use_mock cannot be enabled in the %s environment", toggle.Name, c.App.Env))
+			}
+		}
+	}
+
+	if c.App.Port < 1 || c.App.Port > 65535 {
+		errs = append(errs, fmt.Errorf("app.port must be between 1 and 65535, got %d", c.App.Port))
+	}
+	errs = appendOneOf(errs, "logging.level", c.Logging.Level, logLevels)
+	errs = appendOneOf(errs, "logging.format", c.Logging.Format, logFormats)
+	errs = appendOneOf(errs, "logging.output", c.Logging.Output, logOutputs)
+	if c.Auth.Login.Enabled {
+		errs = appendOneOf(errs, "auth.login.user_store", c.Auth.Login.UserStore, userStores)
+		if c.Auth.Login.UserStore == "idp" && c.Auth.Login.RefreshEnabled {
+			errs = append(errs, fmt.Errorf("auth.login.refresh_enabled cannot be used with user_store \"idp\": the identity provider cannot re-read a user without their password"))
+		}
+	}
+	if c.Scheduler.PrescriptionExpiration.Enabled {
+		errs = appendOneOf(errs, "scheduler.prescription_expiration.status", c.Scheduler.PrescriptionExpiration.Status, expireStatus)
+	}
+	for name, coll := range c.Scheduler.CapacityMonitor.Collections {
+		if coll.Mitigation != "" {
+			errs = appendOneOf(errs, "scheduler.capacity_monitor.collections."+name+".mitigation", coll.Mitigation, mitigations)
+		}
+	}
+
+	errs = appendShare(errs, "database.mongodb.connection.saturation_alarm", c.Database.MongoDB.Connection.SaturationAlarm)
+	errs = appendShare(errs, "cache.mongodb.connection.saturation_alarm", c.Cache.MongoDB.Connection.SaturationAlarm)
+	if c.Cache.Serialization.Codec != "" {
+		errs = appendOneOf(errs, "cache.serialization.codec", c.Cache.Serialization.Codec, cacheCodecs)
+	}
+	if c.Cache.Serialization.Compression != "" {
+		errs = appendOneOf(errs, "cache.serialization.compression", c.Cache.Serialization.Compression, cacheCompressions)
+	}
+	if c.Duplicates.Mode != "" {
+		errs = appendOneOf(errs, "patient_duplicates.mode", c.Duplicates.Mode, duplicateModes)
+	}
+	if c.Events.Mode != "" {
+		errs = appendOneOf(errs, "events.mode", c.Events.Mode, eventModes)
+	}
+	reads := c.Database.MongoDB.ReadPreference
+	if reads.Default != "" {
+		errs = appendOneOf(errs, "database.mongodb.read_preference.default", reads.Default, readPreferences)
+	}
+	if reads.Analytics != "" {
+		errs = appendOneOf(errs, "database.mongodb.read_preference.analytics", reads.Analytics, readPreferences)
+	}
+	if staleness, err := time.ParseDuration(reads.MaxStaleness); err == nil && staleness < 90*time.Second {
+		errs = append(errs, fmt.Errorf("database.mongodb.read_preference.max_staleness must be at least 90s, got %s", reads.MaxStaleness))
+	}
+	if c.Database.MongoDB.ReadConcern != "" {
+		errs = appendOneOf(errs, "database.mongodb.read_concern", c.Database.MongoDB.ReadConcern, readConcerns)
+	}
+	if w := c.Database.MongoDB.WriteConcern.W; w != "" && w != "majority" {
+		if members, err := strconv.Atoi(w); err != nil || members < 0 {
+			errs = append(errs, fmt.Errorf("database.mongodb.write_concern.w must be \"majority\" or a number of members, got %q", w))
+		}
+	}
+	if c.I18n.DefaultLocale != "" {
+		errs = appendOneOf(errs, "i18n.default_locale", c.I18n.DefaultLocale, i18n.Locales())
+	}
+	if c.External.HTTP.Capture.Sink != "" {
+		errs = appendOneOf(errs, "external.http.capture.sink", c.External.HTTP.Capture.Sink, captureSinks)
+	}
+	errs = appendShare(errs, "external.http.capture.sample_rate", c.External.HTTP.Capture.SampleRate)
+	if smtp := c.External.Notifications.SMTP; smtp.Host != "" {
+		if smtp.Port < 1 || smtp.Port > 65535 {
+			errs = append(errs, fmt.Errorf("external.notifications.smtp.port must be between 1 and 65535, got %d", smtp.Port))
+		}
+		if smtp.From == "" {
+			errs = append(errs, fmt.Errorf("external.notifications.smtp.from is required when a host is set"))
+		}
+	}
+
+	if c.Documents.BlobStore.Type != "" {
+		errs = appendOneOf(errs, "patient_documents.blob_store.type", c.Documents.BlobStore.Type, blobStores)
+	}
+	if s3 := c.Documents.BlobStore.S3; c.Documents.BlobStore.Type == "s3" && (s3.Bucket == "" || s3.Region == "") {
+		errs = append(errs, fmt.Errorf("patient_documents.blob_store.s3.bucket and region are required when the type is s3"))
+	}
+	if c.Documents.VirusScan.Enabled && c.Documents.VirusScan.Address == "" {
+		errs = append(errs, fmt.Errorf("patient_documents.virus_scan.address is required when the scan is enabled"))
+	}
+
+	if c.Billing.DefaultPricing.UnitPrice < 0 {
+		errs = append(errs, fmt.Errorf("billing.default_pricing.unit_price cannot be negative, got %g", c.Billing.DefaultPricing.UnitPrice))
+	}
+	for _, drug := range slices.Sorted(maps.Keys(c.Billing.DefaultPricing.Drugs)) {
+		if price := c.Billing.DefaultPricing.Drugs[drug]; price < 0 {
+			errs = append(errs, fmt.Errorf("billing.default_pricing.drugs.%s cannot be negative, got %g", drug, price))
+		}
+	}
+
+	for i, group := range c.Limits.Groups {
+		if !strings.HasPrefix(group.Prefix, "/") {
+			errs = append(errs, fmt.Errorf("request_limits.groups[%d].prefix must start with /, got %q", i, group.Prefix))
+		}
+		if group.MaxBodyKB < 0 {
+			errs = append(errs, fmt.Errorf("request_limits.groups[%d].max_body_kb cannot be negative, got %d", i, group.MaxBodyKB))
+		}
+	}
+
+	settings := c.settings()
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		switch value := settings[key].(type) {
+		case string:
+			if value != "" && isDurationSetting(key) {
+				if d, err := time.ParseDuration(value); err != nil {
+					errs = append(errs, fmt.Errorf("%s must be a duration such as \"30s\" or \"5m\", got %q", key, value))
+				} else if d < 0 {
+					errs = append(errs, fmt.Errorf("%s cannot be negative, got %q", key, value))
+				}
+			}
+		case int:
+			if value < 0 && slices.Contains(nonNegativeSettings, key) {
+				errs = append(errs, fmt.Errorf("%s cannot be negative, got %d", key, value))
+			}
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return ValidationError{Problems: errs}
+}
+
+// ValidationError lists every problem Validate found, one per line
+type ValidationError struct {
+	Problems []error
+}
+
+func (e ValidationError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d problem(s):", len(e.Problems))
+	for _, problem := range e.Problems {
+		b.WriteString("\n  - ")
+		b.WriteString(strings.ReplaceAll(problem.Error(), "\n", "\n    "))
+	}
+	return b.String()
+}
+
+func (e ValidationError) Unwrap() []error {
+	return e.Problems
+}
+
+func appendOneOf(errs []error, key, value string, allowed []string) []error {
+	if slices.Contains(allowed, value) {
+		return errs
+	}
+	return append(errs, fmt.Errorf("%s must be one of %s, got %q", key, strings.Join(allowed, ", "), value))
+}
+
+func appendShare(errs []error, key string, value float64) []error {
+	if value >= 0 && value <= 1 {
+		return errs
+	}
+	return append(errs, fmt.Errorf("%s must be between 0 and 1, got %g", key, value))
+}
+
+func isDurationSetting(key string) bool {
+	name := key[strings.LastIndex(key, ".")+1:]
+	for _, suffix := range durationSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// settings flattens the config into its setting keys, e.g. "logging.level", with their values;
+// maps of sections are keyed by their entries, other maps and lists are one value
+func (c *Config) settings() map[string]any {
+	out := map[string]any{}
+	flattenSettings(out, "", reflect.ValueOf(*c))
+	return out
+}
+
+func flattenSettings(out map[string]any, prefix string, v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("mapstructure")
+		if tag == "" || !field.IsExported() {
+			continue
+		}
+		key := tag
+		if prefix != "" {
+			key = prefix + "." + tag
+		}
+		value := v.Field(i)
+		switch {
+		case value.Kind() == reflect.Struct:
+			flattenSettings(out, key, value)
+		case value.Kind() == reflect.Map && value.Type().Elem().Kind() == reflect.Struct:
+			if value.Len() == 0 {
+				out[key] = nil
+			}
+			for _, name := range value.MapKeys() {
+				flattenSettings(out, key+"."+fmt.Sprint(name.Interface()), value.MapIndex(name))
+			}
+		default:
+			out[key] = value.Interface()
+		}
+	}
+}
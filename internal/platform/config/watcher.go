@@ -0,0 +1,196 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// ReloadableSettings are the settings a config file edit changes without a restart. Components
+// apply them from their Watcher subscription; every other setting keeps its startup value.
+var ReloadableSettings = []string{
+	"logging.level",
+	"billing.cache_ttl",
+	"billing.summary_cache_ttl",
+	"billing.summary_max_stale",
+	"billing.estimate_cache_ttl",
+	"billing.default_pricing.unit_price",
+	"billing.default_pricing.drugs",
+	"graphql.max_depth",
+	"graphql.max_complexity",
+	"graphql.default_list_size",
+	"external.http.capture.enabled",
+	"external.http.capture.sample_rate",
+	"external.http.capture.capture_errors",
+	"external.http.capture.max_body_bytes",
+}
+
+// reloadDelay lets an editor finish writing a file before it is read; saving often truncates the
+// file first, which would otherwise reload an empty config
+const reloadDelay = 250 * time.Millisecond
+
+// Watcher reloads the config files when they change and passes the reloadable settings that
+// changed to the components subscribed to them. A reload that fails Validate is logged and
+// ignored, so the running settings stay in effect.
+type Watcher struct {
+	mu      sync.Mutex
+	current *Config
+	subs    []subscription
+	log     *zap.Logger
+
+	timerMu sync.Mutex
+	timer   *time.Timer // Pending reload after a file change
+}
+
+type subscription struct {
+	name     string
+	settings []string
+	apply    func(cfg *Config)
+}
+
+// NewWatcher starts from the config the application was built with; it keeps its own copy, so
+// the application's config is never written to
+func NewWatcher(cfg *Config, log *zap.Logger) *Watcher {
+	current := *cfg
+	return &Watcher{current: &current, log: log}
+}
+
+// Subscribe calls apply with the reloaded config whenever one of the settings changes; name
+// identifies the component in logs. Subscribing to a setting that is not reloadable panics, as
+// the component would never see it change.
+func (w *Watcher) Subscribe(name string, settings []string, apply func(cfg *Config)) {
+	for _, setting := range settings {
+		if !slices.Contains(ReloadableSettings, setting) {
+			panic(fmt.Sprintf("config: %s subscribed to %q, which is not in ReloadableSettings", name, setting))
+		}
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.subs = append(w.subs, subscription{name: name, settings: settings, apply: apply})
+}
+
+// Start watches the config files the current config was read from
+func (w *Watcher) Start() {
+	w.mu.Lock()
+	files := slices.Clone(w.current.files)
+	w.mu.Unlock()
+
+	for _, file := range files {
+		v := viper.New()
+		v.SetConfigFile(file)
+		v.OnConfigChange(func(e fsnotify.Event) {
+			w.log.Info("Config file changed", zap.String("file", e.Name))
+			w.scheduleReload()
+		})
+		v.WatchConfig()
+	}
+	w.log.Info("Watching config files for changes",
+		zap.Strings("files", files),
+		zap.Strings("reloadable", ReloadableSettings))
+}
+
+// scheduleReload reloads once the files have not changed for reloadDelay
+func (w *Watcher) scheduleReload() {
+	w.timerMu.Lock()
+	defer w.timerMu.Unlock()
+	if w.timer != nil {
+		w.timer.Stop()
+	}
+	w.timer = time.AfterFunc(reloadDelay, w.Reload)
+}
+
+// Reload reads the config again and applies the reloadable settings that changed
+func (w *Watcher) Reload() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	next := Load()
+	if err := next.Validate(); err != nil {
+		w.log.Error("Ignoring config reload; the running settings stay in effect", zap.Error(err))
+		return
+	}
+
+	changed := changedSettings(w.current, next)
+	if len(changed) == 0 {
+		return
+	}
+
+	var reloaded, restart []string
+	for _, setting := range changed {
+		if slices.Contains(ReloadableSettings, setting) {
+			reloaded = append(reloaded, setting)
+		} else {
+			restart = append(restart, setting)
+		}
+	}
+	if len(restart) > 0 {
+		// Values are not logged, as some settings are secrets
+		w.log.Warn("Changed settings take effect after a restart", zap.Strings("settings", restart))
+	}
+	if len(reloaded) == 0 {
+		return
+	}
+
+	for _, sub := range w.subs {
+		if slices.ContainsFunc(sub.settings, func(s string) bool { return slices.Contains(reloaded, s) }) {
+			sub.apply(next)
+			w.log.Info("Applied reloaded settings", zap.String("component", sub.name))
+		}
+	}
+	w.log.Info("Config reloaded", zap.Strings("settings", reloaded))
+
+	// Restart-only settings keep their startup values here, so later reloads report them until the restart
+	for _, setting := range reloaded {
+		setSetting(w.current, setting, next)
+	}
+}
+
+// changedSettings lists the keys whose values differ, sorted
+func changedSettings(previous, next *Config) []string {
+	before, after := previous.settings(), next.settings()
+	var changed []string
+	for key, value := range after {
+		if old, ok := before[key]; !ok || !reflect.DeepEqual(old, value) {
+			changed = append(changed, key)
+		}
+	}
+	for key := range before {
+		if _, ok := after[key]; !ok {
+			changed = append(changed, key)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// setSetting copies one setting, e.g. "logging.level", from src into dst
+func setSetting(dst *Config, key string, src *Config) {
+	to, from := reflect.ValueOf(dst).Elem(), reflect.ValueOf(src).Elem()
+	for _, name := range strings.Split(key, ".") {
+		to, from = fieldByTag(to, name), fieldByTag(from, name)
+		if !to.IsValid() || !from.IsValid() {
+			return
+		}
+	}
+	to.Set(from)
+}
+
+func fieldByTag(v reflect.Value, tag string) reflect.Value {
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).Tag.Get("mapstructure") == tag {
+			return v.Field(i)
+		}
+	}
+	return reflect.Value{}
+}
@@ -0,0 +1,243 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// ChangeEvent is a simplified MongoDB change stream event
+type ChangeEvent struct {
+	Collection string
+	Operation  string // insert, update, replace or delete
+	DocumentID string
+	// FullDocument is the document after the change; nil for deletes
+	FullDocument bson.M
+	// FullDocumentBeforeChange is the document before an update, replace or delete, for
+	// collections watched with WatchWithPreImages; nil otherwise and for inserts
+	FullDocumentBeforeChange bson.M
+}
+
+// ChangeHandler reacts to a change on a watched collection
+type ChangeHandler func(ctx context.Context, event ChangeEvent)
+
+// ChangeStreamListener watches collections through MongoDB change streams and
+// dispatches every write to the registered handlers. Change streams require a
+// replica set; on a standalone server the listener logs a warning and stops.
+type ChangeStreamListener struct {
+	connMgr    *ConnectionManager
+	logger     *zap.Logger
+	maxBackoff time.Duration
+
+	mu        sync.Mutex
+	handlers  map[string][]ChangeHandler
+	preImages map[string]bool
+}
+
+// NewChangeStreamListener creates a listener on the given connection
+func NewChangeStreamListener(connMgr *ConnectionManager, logger *zap.Logger) *ChangeStreamListener {
+	return &ChangeStreamListener{
+		connMgr:    connMgr,
+		logger:     logger,
+		maxBackoff: time.Minute,
+		handlers:   map[string][]ChangeHandler{},
+		preImages:  map[string]bool{},
+	}
+}
+
+// Watch registers a handler for a collection, by its logical name (e.g. "patients")
+func (l *ChangeStreamListener) Watch(collection string, handler ChangeHandler) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.handlers[collection] = append(l.handlers[collection], handler)
+}
+
+// WatchWithPreImages is Watch for handlers that need the document as it was before the change,
+// e.g. to know what a deleted document belonged to. The listener turns on pre-images for the
+// collection (MongoDB 6.0+); where that fails, events come without FullDocumentBeforeChange.
+func (l *ChangeStreamListener) WatchWithPreImages(collection string, handler ChangeHandler) {
+	l.mu.Lock()
+	l.preImages[collection] = true
+	l.mu.Unlock()
+	l.Watch(collection, handler)
+}
+
+// Run opens one change stream per watched collection and blocks until ctx is cancelled
+func (l *ChangeStreamListener) Run(ctx context.Context) {
+	l.mu.Lock()
+	collections := make([]string, 0, len(l.handlers))
+	for name := range l.handlers {
+		collections = append(collections, name)
+	}
+	l.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, name := range collections {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			l.watchCollection(ctx, name)
+		}(name)
+	}
+	wg.Wait()
+}
+
+// watchCollection keeps a change stream open, resuming after the last seen event on errors
+func (l *ChangeStreamListener) watchCollection(ctx context.Context, name string) {
+	var resumeToken bson.Raw
+	attempt := 0
+
+	l.mu.Lock()
+	preImages := l.preImages[name]
+	l.mu.Unlock()
+	if preImages {
+		preImages = l.enablePreImages(ctx, name)
+	}
+
+	for ctx.Err() == nil {
+		token, err := l.stream(ctx, name, resumeToken, preImages)
+		if token != nil {
+			resumeToken = token
+			attempt = 0
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		if isChangeStreamUnsupported(err) {
+			l.logger.Warn("Change streams not supported by this MongoDB deployment (replica set required); cache invalidation across instances is disabled",
+				zap.String("collection", name))
+			return
+		}
+
+		attempt++
+		delay := l.backoff(attempt)
+		l.logger.Warn("Change stream interrupted, retrying",
+			zap.String("collection", name),
+			zap.Int("attempt", attempt),
+			zap.Duration("retry_in", delay),
+			zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(delay):
+		}
+	}
+
+	l.logger.Info("Change stream stopped", zap.String("collection", name))
+}
+
+// enablePreImages makes MongoDB keep the document before each change of the collection, so
+// change events can carry it; it reports whether they are kept
+func (l *ChangeStreamListener) enablePreImages(ctx context.Context, name string) bool {
+	collection := l.connMgr.GetCollection(name)
+	err := collection.Database().RunCommand(ctx, bson.D{
+		{Key: "collMod", Value: collection.Name()},
+		{Key: "changeStreamPreAndPostImages", Value: bson.M{"enabled": true}},
+	}).Err()
+	if err != nil {
+		l.logger.Warn("Could not enable change stream pre-images (MongoDB 6.0+ required); deleted documents are not seen",
+			zap.String("collection", name),
+			zap.Error(err))
+		return false
+	}
+	return true
+}
+
+// stream consumes a single change stream until it fails; it returns the last resume token seen
+func (l *ChangeStreamListener) stream(ctx context.Context, name string, resumeToken bson.Raw, preImages bool) (bson.Raw, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"operationType": bson.M{"$in": []string{"insert", "update", "replace", "delete"}},
+		}}},
+	}
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+	if preImages {
+		opts.SetFullDocumentBeforeChange(options.WhenAvailable)
+	}
+	if resumeToken != nil {
+		opts.SetResumeAfter(resumeToken)
+	}
+
+	cs, err := l.connMgr.GetCollection(name).Watch(ctx, pipeline, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open change stream: %w", err)
+	}
+	defer cs.Close(context.Background())
+
+	l.logger.Info("Watching collection for changes", zap.String("collection", name))
+
+	var lastToken bson.Raw
+	for cs.Next(ctx) {
+		var raw struct {
+			OperationType string `bson:"operationType"`
+			DocumentKey   struct {
+				ID interface{} `bson:"_id"`
+			} `bson:"documentKey"`
+			FullDocument             bson.M `bson:"fullDocument"`
+			FullDocumentBeforeChange bson.M `bson:"fullDocumentBeforeChange"`
+		}
+		if err := cs.Decode(&raw); err != nil {
+			l.logger.Warn("Failed to decode change event",
+				zap.String("collection", name),
+				zap.Error(err))
+		} else {
+			l.dispatch(ctx, ChangeEvent{
+				Collection:               name,
+				Operation:                raw.OperationType,
+				DocumentID:               fmt.Sprint(raw.DocumentKey.ID),
+				FullDocument:             raw.FullDocument,
+				FullDocumentBeforeChange: raw.FullDocumentBeforeChange,
+			})
+		}
+		lastToken = cs.ResumeToken()
+	}
+
+	return lastToken, cs.Err()
+}
+
+func (l *ChangeStreamListener) dispatch(ctx context.Context, event ChangeEvent) {
+	l.mu.Lock()
+	handlers := append([]ChangeHandler(nil), l.handlers[event.Collection]...)
+	l.mu.Unlock()
+
+	for _, h := range handlers {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					l.logger.Error("Change handler panicked",
+						zap.String("collection", event.Collection),
+						zap.Any("panic", r))
+				}
+			}()
+			h(ctx, event)
+		}()
+	}
+}
+
+// isChangeStreamUnsupported reports the error returned when watching a standalone server
+func isChangeStreamUnsupported(err error) bool {
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) {
+		return cmdErr.Code == 40573
+	}
+	return false
+}
+
+// backoff doubles from one second up to maxBackoff, with up to 20% jitter
+func (l *ChangeStreamListener) backoff(attempt int) time.Duration {
+	delay := l.maxBackoff
+	if attempt < 16 {
+		if d := time.Second << (attempt - 1); d < l.maxBackoff {
+			delay = d
+		}
+	}
+	return delay + time.Duration(rand.Int63n(int64(delay)/5+1))
+}
@@ -8,15 +8,21 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/metrics"
 )
 
 // MongoDBConfig represents MongoDB configuration
 type MongoDBConfig struct {
+	Name        string // Labels the connection pool metrics, e.g. "main" or "cache"
 	URI         string
 	Database    string
 	Collections map[string]string
 	Connection  ConnectionConfig
 	Options     OptionsConfig
+	// ReadPreference sets where reads are served; analytics reads may go to secondaries
+	ReadPreference ReadPreferenceConfig
+	Concerns       ConcernConfig
 }
 
 // ConnectionConfig represents connection pool configuration
@@ -26,6 +32,9 @@ type ConnectionConfig struct {
 	MaxIdleTime    string
 	ConnectTimeout string
 	SocketTimeout  string
+	// SaturationAlarm is the share of the pool checked out, e.g. 0.8, at which an alarm is logged;
+	// 0 disables it
+	SaturationAlarm float64
 }
 
 // OptionsConfig represents MongoDB client options
@@ -40,6 +49,7 @@ type ConnectionManager struct {
 	database *mongo.Database
 	config   MongoDBConfig
 	logger   *zap.Logger
+	pool     *poolMonitor
 }
 
 // NewConnectionManager creates a new MongoDB connection manager
@@ -47,6 +57,7 @@ func NewConnectionManager(config MongoDBConfig, logger *zap.Logger) (*Connection
 	cm := &ConnectionManager{
 		config: config,
 		logger: logger,
+		pool:   newPoolMonitor(config.Name, config.Connection.MaxPoolSize, config.Connection.SaturationAlarm, logger),
 	}
 
 	if err := cm.connect(); err != nil {
@@ -74,6 +85,15 @@ func (cm *ConnectionManager) connect() error {
 		return fmt.Errorf("invalid socket_timeout: %w", err)
 	}
 
+	readPolicy, err := cm.config.ReadPreference.Policy()
+	if err != nil {
+		return err
+	}
+	writeConcern, err := cm.config.Concerns.writeConcern()
+	if err != nil {
+		return err
+	}
+
 	// Configure client options
 	clientOptions := options.Client().
 		ApplyURI(cm.config.URI).
@@ -83,7 +103,16 @@ func (cm *ConnectionManager) connect() error {
 		SetConnectTimeout(connectTimeout).
 		SetSocketTimeout(socketTimeout).
 		SetRetryWrites(cm.config.Options.RetryWrites).
-		SetRetryReads(cm.config.Options.RetryReads)
+		SetRetryReads(cm.config.Options.RetryReads).
+		SetMonitor(metrics.MongoCommandMonitor()).
+		SetPoolMonitor(cm.pool.Monitor()).
+		SetReadPreference(readPolicy.Default)
+	if readConcern := cm.config.Concerns.readConcern(); readConcern != nil {
+		clientOptions.SetReadConcern(readConcern)
+	}
+	if writeConcern != nil {
+		clientOptions.SetWriteConcern(writeConcern)
+	}
 
 	// Create client
 	ctx, cancel := context.WithTimeout(context.Background(), connectTimeout)
@@ -96,6 +125,7 @@ func (cm *ConnectionManager) connect() error {
 
 	cm.client = client
 	cm.database = client.Database(cm.config.Database)
+	readPolicies.Store(client, readPolicy)
 
 	// Test connection
 	if err := cm.Ping(ctx); err != nil {
@@ -106,7 +136,9 @@ func (cm *ConnectionManager) connect() error {
 	cm.logger.Info("Successfully connected to MongoDB",
 		zap.String("database", cm.config.Database),
 		zap.Uint64("max_pool_size", cm.config.Connection.MaxPoolSize),
-		zap.Uint64("min_pool_size", cm.config.Connection.MinPoolSize))
+		zap.Uint64("min_pool_size", cm.config.Connection.MinPoolSize),
+		zap.String("read_preference", readPolicy.Default.String()),
+		zap.String("analytics_read_preference", readPolicy.Analytics.String()))
 
 	return nil
 }
@@ -120,6 +152,16 @@ func (cm *ConnectionManager) GetCollection(name string) *mongo.Collection {
 	return cm.database.Collection(collectionName)
 }
 
+// GetCollectionFor returns a collection by name, with the read preference of the kind of read
+func (cm *ConnectionManager) GetCollectionFor(name string, kind ReadKind) *mongo.Collection {
+	return ForReads(cm.GetCollection(name), kind)
+}
+
+// PoolStats returns the connections of the client's pool to each server
+func (cm *ConnectionManager) PoolStats() []PoolStats {
+	return cm.pool.Stats()
+}
+
 // Ping tests the connection to MongoDB
 func (cm *ConnectionManager) Ping(ctx context.Context) error {
 	if cm.client == nil {
@@ -137,6 +179,7 @@ func (cm *ConnectionManager) Close() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	readPolicies.Delete(cm.client)
 	if err := cm.client.Disconnect(ctx); err != nil {
 		cm.logger.Error("Failed to disconnect from MongoDB", zap.Error(err))
 		return err
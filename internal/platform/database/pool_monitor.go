@@ -0,0 +1,126 @@
+package database
+
+import (
+	"sync"
+
+	"go.mongodb.org/mongo-driver/event"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/metrics"
+)
+
+// PoolStats are the connections of a client's pool to one server
+type PoolStats struct {
+	Address string `json:"address"`
+	Open    int64  `json:"open"`
+	InUse   int64  `json:"in_use"`
+	MaxSize uint64 `json:"max_size"` // 0 means unlimited
+}
+
+// Saturation is the share of the pool checked out, from 0 to 1; 0 for an unlimited pool
+func (s PoolStats) Saturation() float64 {
+	if s.MaxSize == 0 {
+		return 0
+	}
+	return float64(s.InUse) / float64(s.MaxSize)
+}
+
+// poolMonitor counts the connections of a client from the driver's pool events, exports them as
+// metrics and logs an alarm when a pool stays checked out beyond the saturation threshold. The
+// driver keeps one pool per server, so everything is tracked by server address.
+type poolMonitor struct {
+	name      string  // Labels the metrics, e.g. "main" or "cache"
+	maxSize   uint64  // The client's max_pool_size, which applies to each server
+	threshold float64 // Saturation that raises the alarm; 0 disables it
+	logger    *zap.Logger
+
+	mu        sync.Mutex
+	pools     map[string]*PoolStats
+	saturated map[string]bool // Servers whose alarm is raised, so it is logged once per crossing
+}
+
+func newPoolMonitor(name string, maxSize uint64, threshold float64, logger *zap.Logger) *poolMonitor {
+	return &poolMonitor{
+		name:      name,
+		maxSize:   maxSize,
+		threshold: threshold,
+		logger:    logger,
+		pools:     make(map[string]*PoolStats),
+		saturated: make(map[string]bool),
+	}
+}
+
+// Monitor is set on the client options
+func (pm *poolMonitor) Monitor() *event.PoolMonitor {
+	return &event.PoolMonitor{Event: pm.handle}
+}
+
+func (pm *poolMonitor) handle(e *event.PoolEvent) {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	stats, ok := pm.pools[e.Address]
+	if !ok {
+		stats = &PoolStats{Address: e.Address, MaxSize: pm.maxSize}
+		pm.pools[e.Address] = stats
+	}
+
+	switch e.Type {
+	case event.ConnectionCreated:
+		stats.Open++
+	case event.ConnectionClosed:
+		stats.Open--
+	case event.GetSucceeded:
+		stats.InUse++
+	case event.ConnectionReturned:
+		stats.InUse--
+	case event.GetFailed:
+		metrics.ObserveMongoCheckoutFailure(pm.name, e.Address, e.Reason)
+		pm.logger.Warn("MongoDB connection checkout failed",
+			zap.String("pool", pm.name),
+			zap.String("address", e.Address),
+			zap.String("reason", e.Reason))
+	case event.PoolClosedEvent:
+		stats.Open, stats.InUse = 0, 0
+	}
+	stats.Open, stats.InUse = max(stats.Open, 0), max(stats.InUse, 0)
+
+	metrics.ObserveMongoPool(pm.name, e.Address, stats.Open, stats.InUse, stats.MaxSize)
+	pm.checkSaturation(*stats)
+}
+
+// checkSaturation logs when a pool crosses the threshold, and again when it drops back under it
+func (pm *poolMonitor) checkSaturation(stats PoolStats) {
+	if pm.threshold <= 0 || stats.MaxSize == 0 {
+		return
+	}
+	saturated := stats.Saturation() >= pm.threshold
+	if saturated == pm.saturated[stats.Address] {
+		return
+	}
+	pm.saturated[stats.Address] = saturated
+
+	fields := []zap.Field{
+		zap.String("pool", pm.name),
+		zap.String("address", stats.Address),
+		zap.Int64("in_use", stats.InUse),
+		zap.Uint64("max_pool_size", stats.MaxSize),
+		zap.Float64("threshold", pm.threshold),
+	}
+	if saturated {
+		pm.logger.Error("MongoDB connection pool saturated; requests may wait for a connection", fields...)
+	} else {
+		pm.logger.Info("MongoDB connection pool back under its saturation threshold", fields...)
+	}
+}
+
+// Stats returns the pools by server address
+func (pm *poolMonitor) Stats() []PoolStats {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+	stats := make([]PoolStats, 0, len(pm.pools))
+	for _, s := range pm.pools {
+		stats = append(stats, *s)
+	}
+	return stats
+}
@@ -0,0 +1,159 @@
+package database
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+)
+
+// ReadKind says how current the data of a read must be
+type ReadKind int
+
+const (
+	// ReadCurrent is a read that must see the latest writes, e.g. of a record about to be changed;
+	// it uses the connection's default read preference
+	ReadCurrent ReadKind = iota
+	// ReadAnalytics is a count, export or dashboard read, which tolerates data a moment old and
+	// should not compete with writes on the primary; it uses the analytics read preference
+	ReadAnalytics
+)
+
+// ReadPreferenceConfig sets where reads are served in a replica set
+type ReadPreferenceConfig struct {
+	Default   string // primary (default), primaryPreferred, secondary, secondaryPreferred or nearest
+	Analytics string // Read preference of ReadAnalytics reads; secondaryPreferred when empty
+	// MaxStaleness bounds how far behind the primary a secondary serving reads may be, e.g.
+	// "120s" (at least 90s); unbounded when empty. Ignored for primary reads.
+	MaxStaleness string
+}
+
+// ConcernConfig sets the read and write concerns of the connection; empty values leave the
+// server defaults
+type ConcernConfig struct {
+	Read  string // local, available, majority or linearizable
+	Write WriteConcernConfig
+}
+
+// WriteConcernConfig sets the acknowledgement writes wait for
+type WriteConcernConfig struct {
+	W       string // "majority", or the number of members that must acknowledge
+	Journal bool   // Wait for the write to reach the on-disk journal
+	Timeout string // Give up waiting for the acknowledgement after this long, e.g. "5s"
+}
+
+// ReadPolicy is the read preference of each kind of read
+type ReadPolicy struct {
+	Default   *readpref.ReadPref
+	Analytics *readpref.ReadPref
+}
+
+// Policy returns the read preferences the configuration describes
+func (c ReadPreferenceConfig) Policy() (ReadPolicy, error) {
+	var maxStaleness time.Duration
+	if c.MaxStaleness != "" {
+		var err error
+		if maxStaleness, err = time.ParseDuration(c.MaxStaleness); err != nil {
+			return ReadPolicy{}, fmt.Errorf("invalid read_preference.max_staleness: %w", err)
+		}
+	}
+	defaultMode, analyticsMode := c.Default, c.Analytics
+	if defaultMode == "" {
+		defaultMode = "primary"
+	}
+	if analyticsMode == "" {
+		analyticsMode = "secondaryPreferred"
+	}
+
+	var policy ReadPolicy
+	var err error
+	if policy.Default, err = readPreference(defaultMode, maxStaleness); err != nil {
+		return ReadPolicy{}, fmt.Errorf("invalid read_preference.default: %w", err)
+	}
+	if policy.Analytics, err = readPreference(analyticsMode, maxStaleness); err != nil {
+		return ReadPolicy{}, fmt.Errorf("invalid read_preference.analytics: %w", err)
+	}
+	return policy, nil
+}
+
+// readPreference builds a read preference; the staleness bound only applies to modes that may
+// read from a secondary
+func readPreference(mode string, maxStaleness time.Duration) (*readpref.ReadPref, error) {
+	parsed, err := readpref.ModeFromString(mode)
+	if err != nil {
+		return nil, err
+	}
+	if parsed == readpref.PrimaryMode || maxStaleness == 0 {
+		return readpref.New(parsed)
+	}
+	return readpref.New(parsed, readpref.WithMaxStaleness(maxStaleness))
+}
+
+// readConcern returns the read concern of a level, or nil for the server default
+func (c ConcernConfig) readConcern() *readconcern.ReadConcern {
+	if c.Read == "" {
+		return nil
+	}
+	return &readconcern.ReadConcern{Level: c.Read}
+}
+
+// writeConcern returns the configured write concern, or nil for the server default
+func (c ConcernConfig) writeConcern() (*writeconcern.WriteConcern, error) {
+	cfg := c.Write
+	if cfg.W == "" && !cfg.Journal && cfg.Timeout == "" {
+		return nil, nil
+	}
+	wc := &writeconcern.WriteConcern{}
+	switch cfg.W {
+	case "":
+	case "majority":
+		wc.W = "majority"
+	default:
+		members, err := strconv.Atoi(cfg.W)
+		if err != nil || members < 0 {
+			return nil, fmt.Errorf("invalid write_concern.w %q: want majority or a number of members", cfg.W)
+		}
+		wc.W = members
+	}
+	if cfg.Journal {
+		journal := true
+		wc.Journal = &journal
+	}
+	if cfg.Timeout != "" {
+		timeout, err := time.ParseDuration(cfg.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid write_concern.timeout: %w", err)
+		}
+		wc.WTimeout = timeout
+	}
+	return wc, nil
+}
+
+// readPolicies holds the read policy of each connected client, so repositories holding only a
+// collection can find the analytics read preference of its connection
+var readPolicies sync.Map // *mongo.Client → ReadPolicy
+
+// ForReads returns the handle of the collection to run reads of the kind on. Repositories tag
+// the methods that are safe to serve from a secondary by reading through
+// ForReads(collection, ReadAnalytics). Collections of clients not opened by a ConnectionManager,
+// e.g. in tools, are returned as they are.
+func ForReads(coll *mongo.Collection, kind ReadKind) *mongo.Collection {
+	if coll == nil || kind == ReadCurrent {
+		return coll
+	}
+	value, ok := readPolicies.Load(coll.Database().Client())
+	if !ok {
+		return coll
+	}
+	clone, err := coll.Clone(options.Collection().SetReadPreference(value.(ReadPolicy).Analytics))
+	if err != nil {
+		return coll
+	}
+	return clone
+}
@@ -0,0 +1,116 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"go.uber.org/zap"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/logging"
+	"pharmacy-modernization-project-model/internal/platform/metrics"
+)
+
+// RetryConfig controls how repository operations are retried
+type RetryConfig struct {
+	Enabled        bool
+	MaxAttempts    int           // Runs of an operation, including the first
+	InitialBackoff time.Duration // Delay before the second attempt; it doubles with each attempt
+	MaxBackoff     time.Duration // Caps the doubled delay
+	Jitter         time.Duration // Up to this much random delay is added to each backoff
+}
+
+// Retrier retries repository operations failing with an error RepositoryError.IsRetryable
+// accepts: timeouts, network and connection errors. Other errors, and operations running in a
+// transaction, which the transaction runner retries as a whole, are returned at once. A nil
+// Retrier runs operations once.
+type Retrier struct {
+	cfg    RetryConfig
+	logger *zap.Logger
+}
+
+// NewRetrier returns a retrier, or nil when retries are disabled
+func NewRetrier(cfg RetryConfig, logger *zap.Logger) *Retrier {
+	if !cfg.Enabled || cfg.MaxAttempts <= 1 {
+		return nil
+	}
+	if cfg.InitialBackoff <= 0 {
+		cfg.InitialBackoff = 100 * time.Millisecond
+	}
+	if cfg.MaxBackoff < cfg.InitialBackoff {
+		cfg.MaxBackoff = cfg.InitialBackoff
+	}
+	return &Retrier{cfg: cfg, logger: logger}
+}
+
+// Do runs fn, again while it fails with a retryable error and attempts remain. operation names
+// the operation in logs and metrics, e.g. "patients.GetByID".
+func (r *Retrier) Do(ctx context.Context, operation string, fn func(ctx context.Context) error) error {
+	if r == nil || InTransaction(ctx) {
+		return fn(ctx)
+	}
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = fn(ctx)
+		if err == nil || !IsRetryable(err) {
+			return err
+		}
+		if attempt == r.cfg.MaxAttempts {
+			break
+		}
+
+		delay := r.backoff(attempt)
+		logging.WithContext(ctx, r.logger).Warn("Repository operation failed with a retryable error, retrying",
+			zap.String("operation", operation),
+			zap.Int("attempt", attempt),
+			zap.Duration("delay", delay),
+			zap.Error(err))
+		metrics.CountRepositoryRetry(operation)
+		select {
+		case <-ctx.Done():
+			metrics.CountRepositoryRetriesExhausted(operation)
+			return err
+		case <-time.After(delay):
+		}
+	}
+
+	logging.WithContext(ctx, r.logger).Error("Repository operation failed after retries",
+		zap.String("operation", operation),
+		zap.Int("attempts", r.cfg.MaxAttempts),
+		zap.Error(err))
+	metrics.CountRepositoryRetriesExhausted(operation)
+	return err
+}
+
+// Retry runs fn through the retrier and returns its result
+func Retry[T any](ctx context.Context, r *Retrier, operation string, fn func(ctx context.Context) (T, error)) (T, error) {
+	var result T
+	err := r.Do(ctx, operation, func(ctx context.Context) error {
+		var err error
+		result, err = fn(ctx)
+		return err
+	})
+	return result, err
+}
+
+// IsRetryable reports whether err is a repository error worth another attempt
+func IsRetryable(err error) bool {
+	var repoErr *platformErrors.RepositoryError
+	return errors.As(err, &repoErr) && repoErr.IsRetryable()
+}
+
+// backoff is InitialBackoff doubled for each attempt made, capped by MaxBackoff, plus jitter
+func (r *Retrier) backoff(attempt int) time.Duration {
+	delay := r.cfg.InitialBackoff
+	for i := 1; i < attempt && delay < r.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	delay = min(delay, r.cfg.MaxBackoff)
+	if r.cfg.Jitter > 0 {
+		delay += time.Duration(rand.Int63n(int64(r.cfg.Jitter) + 1))
+	}
+	return delay
+}
@@ -0,0 +1,160 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+	"go.uber.org/zap"
+)
+
+// TxFunc is the work of a transaction. Repositories called with ctx take part in the
+// transaction; the function may run more than once, so it must not have other side effects.
+type TxFunc func(ctx context.Context) error
+
+// TransactionRunner runs multi-document writes atomically where the deployment allows it
+type TransactionRunner interface {
+	// RunInTransaction calls fn and commits its writes when it returns nil, or discards them
+	// when it returns an error. Without transactions fn simply runs once.
+	RunInTransaction(ctx context.Context, fn TxFunc) error
+}
+
+// TransactionConfig controls the transaction runner
+type TransactionConfig struct {
+	Enabled     bool          // Transactions require a replica set or sharded cluster
+	MaxAttempts int           // Runs of a transaction that fails with a transient error, including the first
+	Timeout     time.Duration // Bounds a transaction, with all of its attempts
+}
+
+// InTransaction reports whether ctx belongs to a running transaction; services use it to skip
+// compensating writes that the transaction makes unnecessary
+func InTransaction(ctx context.Context) bool {
+	return mongo.SessionFromContext(ctx) != nil
+}
+
+// NewTransactionRunner returns a runner on the given connection. It falls back to running
+// functions directly when MongoDB is not configured, transactions are disabled, or the server
+// is a standalone one that cannot run them.
+func NewTransactionRunner(connMgr *ConnectionManager, cfg TransactionConfig, logger *zap.Logger) TransactionRunner {
+	if connMgr == nil || !cfg.Enabled {
+		return directRunner{}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	supported, err := supportsTransactions(ctx, connMgr.client)
+	if err != nil {
+		logger.Warn("Failed to check MongoDB topology, multi-document writes run without transactions", zap.Error(err))
+		return directRunner{}
+	}
+	if !supported {
+		logger.Warn("MongoDB is a standalone server, multi-document writes run without transactions")
+		return directRunner{}
+	}
+
+	if cfg.MaxAttempts < 1 {
+		cfg.MaxAttempts = 1
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 30 * time.Second
+	}
+	return &mongoRunner{client: connMgr.client, cfg: cfg, logger: logger}
+}
+
+// supportsTransactions reports whether the server is a replica set member or a mongos
+func supportsTransactions(ctx context.Context, client *mongo.Client) (bool, error) {
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+	err := client.Database("admin").RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello)
+	if err != nil {
+		return false, err
+	}
+	return hello.SetName != "" || hello.Msg == "isdbgrid", nil
+}
+
+// directRunner runs functions without a transaction, e.g. on memory repositories
+type directRunner struct{}
+
+func (directRunner) RunInTransaction(ctx context.Context, fn TxFunc) error { return fn(ctx) }
+
+type mongoRunner struct {
+	client *mongo.Client
+	cfg    TransactionConfig
+	logger *zap.Logger
+}
+
+// RunInTransaction wraps session.WithTransaction, which retries on its own until its fixed
+// limit; transient errors that still surface, e.g. an election during the commit, get a few
+// more attempts with backoff within cfg.Timeout
+func (r *mongoRunner) RunInTransaction(ctx context.Context, fn TxFunc) error {
+	// Nested calls join the transaction already running
+	if InTransaction(ctx) {
+		return fn(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.Timeout)
+	defer cancel()
+
+	// Transactions read from the primary whatever the connection's read preference
+	txnOpts := options.Transaction().
+		SetReadPreference(readpref.Primary()).
+		SetReadConcern(readconcern.Snapshot()).
+		SetWriteConcern(writeconcern.Majority())
+
+	var err error
+	for attempt := 1; attempt <= r.cfg.MaxAttempts; attempt++ {
+		err = r.run(ctx, fn, txnOpts)
+		if err == nil || !isTransient(err) || attempt == r.cfg.MaxAttempts {
+			break
+		}
+		delay := retryDelay(attempt)
+		r.logger.Warn("Transaction failed with a transient error, retrying",
+			zap.Int("attempt", attempt),
+			zap.Duration("delay", delay),
+			zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("transaction: %w", errors.Join(err, ctx.Err()))
+		case <-time.After(delay):
+		}
+	}
+	return err
+}
+
+func (r *mongoRunner) run(ctx context.Context, fn TxFunc, txnOpts *options.TransactionOptions) error {
+	session, err := r.client.StartSession()
+	if err != nil {
+		return fmt.Errorf("start session: %w", err)
+	}
+	defer session.EndSession(context.Background())
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sc)
+	}, txnOpts)
+	return err
+}
+
+// isTransient reports errors the server labels as safe to retry the whole transaction for
+func isTransient(err error) bool {
+	var labeled mongo.LabeledError
+	if !errors.As(err, &labeled) {
+		return false
+	}
+	return labeled.HasErrorLabel("TransientTransactionError") || labeled.HasErrorLabel("UnknownTransactionCommitResult")
+}
+
+// retryDelay grows from 50ms with the attempt, with up to 50% jitter
+func retryDelay(attempt int) time.Duration {
+	delay := time.Duration(attempt*attempt) * 50 * time.Millisecond
+	return delay + time.Duration(rand.Int63n(int64(delay)/2+1))
+}
@@ -0,0 +1,309 @@
+package dates
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// Precision is how much of a PartialDate is known
+type Precision string
+
+const (
+	PrecisionNone  Precision = ""
+	PrecisionYear  Precision = "year"
+	PrecisionMonth Precision = "month"
+	PrecisionDay   Precision = "day"
+)
+
+const (
+	layoutYear  = "2006"
+	layoutMonth = "2006-01"
+	layoutDay   = "2006-01-02"
+)
+
+// PartialDate is a calendar date of which only the year, or the year and month, may be known.
+// Legacy records sometimes carry nothing but a birth year.
+//
+// Text form is YYYY, YYYY-MM or YYYY-MM-DD (JSON, GraphQL and forms). In MongoDB full dates stay
+// BSON datetimes at midnight UTC, so existing documents and range queries keep working, while
+// partial dates are stored as their text form.
+type PartialDate struct {
+	Year  int
+	Month time.Month // zero when unknown
+	Day   int        // zero when unknown
+}
+
+// Full returns a PartialDate with day precision
+func Full(year int, month time.Month, day int) PartialDate {
+	return PartialDate{Year: year, Month: month, Day: day}
+}
+
+// FromTime returns the calendar date of t with day precision; a zero time gives a zero PartialDate
+func FromTime(t time.Time) PartialDate {
+	if t.IsZero() {
+		return PartialDate{}
+	}
+	return Full(t.Year(), t.Month(), t.Day())
+}
+
+// Parse reads YYYY, YYYY-MM or YYYY-MM-DD. RFC 3339 timestamps are accepted as full dates so
+// clients that still send the old time format keep working.
+func Parse(s string) (PartialDate, error) {
+	s = strings.TrimSpace(s)
+	var layout string
+	switch len(s) {
+	case len(layoutYear):
+		layout = layoutYear
+	case len(layoutMonth):
+		layout = layoutMonth
+	case len(layoutDay):
+		layout = layoutDay
+	default:
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return PartialDate{}, fmt.Errorf("invalid date %q: expected YYYY, YYYY-MM or YYYY-MM-DD", s)
+		}
+		return Full(t.Year(), t.Month(), t.Day()), nil
+	}
+
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		return PartialDate{}, fmt.Errorf("invalid date %q: expected YYYY, YYYY-MM or YYYY-MM-DD", s)
+	}
+	d := PartialDate{Year: t.Year()}
+	if layout != layoutYear {
+		d.Month = t.Month()
+	}
+	if layout == layoutDay {
+		d.Day = t.Day()
+	}
+	return d, nil
+}
+
+// Precision reports how much of the date is known
+func (d PartialDate) Precision() Precision {
+	switch {
+	case d.Year == 0:
+		return PrecisionNone
+	case d.Month == 0:
+		return PrecisionYear
+	case d.Day == 0:
+		return PrecisionMonth
+	default:
+		return PrecisionDay
+	}
+}
+
+func (d PartialDate) IsZero() bool {
+	return d.Year == 0
+}
+
+// IsFull reports whether the day is known
+func (d PartialDate) IsFull() bool {
+	return d.Precision() == PrecisionDay
+}
+
+// String returns the text form, or "" for a zero date
+func (d PartialDate) String() string {
+	switch d.Precision() {
+	case PrecisionYear:
+		return fmt.Sprintf("%04d", d.Year)
+	case PrecisionMonth:
+		return fmt.Sprintf("%04d-%02d", d.Year, int(d.Month))
+	case PrecisionDay:
+		return fmt.Sprintf("%04d-%02d-%02d", d.Year, int(d.Month), d.Day)
+	default:
+		return ""
+	}
+}
+
+// Earliest is the first day the date can refer to, at midnight UTC
+func (d PartialDate) Earliest() time.Time {
+	if d.IsZero() {
+		return time.Time{}
+	}
+	month, day := d.Month, d.Day
+	if month == 0 {
+		month = time.January
+	}
+	if day == 0 {
+		day = 1
+	}
+	return time.Date(d.Year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// End is midnight UTC after the last day the date can refer to
+func (d PartialDate) End() time.Time {
+	switch d.Precision() {
+	case PrecisionYear:
+		return d.Earliest().AddDate(1, 0, 0)
+	case PrecisionMonth:
+		return d.Earliest().AddDate(0, 1, 0)
+	case PrecisionDay:
+		return d.Earliest().AddDate(0, 0, 1)
+	default:
+		return time.Time{}
+	}
+}
+
+// Overlaps reports whether d and other can refer to the same day, e.g. 1988 overlaps 1988-01-12
+func (d PartialDate) Overlaps(other PartialDate) bool {
+	if d.IsZero() || other.IsZero() {
+		return false
+	}
+	return d.Earliest().Before(other.End()) && other.Earliest().Before(d.End())
+}
+
+// IsFuture reports whether every day the date can refer to is after the day of now
+func (d PartialDate) IsFuture(now time.Time) bool {
+	return !d.IsZero() && d.Earliest().After(FromTime(now).Earliest())
+}
+
+// AgeRange returns the youngest and oldest possible age in completed years at now. For a full
+// date both are equal; for a year-only date born in a year whose anniversary period is still
+// running they differ by one. Ages never go below zero.
+func (d PartialDate) AgeRange(now time.Time) (minAge, maxAge int) {
+	if d.IsZero() {
+		return 0, 0
+	}
+	// The latest possible birthday gives the youngest age and the earliest gives the oldest
+	latest := d.End().AddDate(0, 0, -1)
+	return max(completedYears(latest, now), 0), max(completedYears(d.Earliest(), now), 0)
+}
+
+// Age returns the age in completed years at now and whether it is exact. For a partial date whose
+// possible ages differ the youngest possible age is returned, so an age threshold is only treated
+// as reached once it certainly has been.
+func (d PartialDate) Age(now time.Time) (int, bool) {
+	minAge, maxAge := d.AgeRange(now)
+	return minAge, minAge == maxAge
+}
+
+// BirthDates returns the first and last birthday of people aged minAge to maxAge in completed
+// years at now. A nil age leaves its end of the range open, returned as a zero date.
+func BirthDates(now time.Time, minAge, maxAge *int) (first, last PartialDate) {
+	today := FromTime(now).Earliest()
+	if maxAge != nil {
+		// The day after the birthday that would make them one year older
+		first = FromTime(today.AddDate(-*maxAge-1, 0, 1))
+	}
+	if minAge != nil {
+		last = FromTime(today.AddDate(-*minAge, 0, 0))
+	}
+	return first, last
+}
+
+func completedYears(born, now time.Time) int {
+	years := now.Year() - born.Year()
+	if now.Month() < born.Month() || (now.Month() == born.Month() && now.Day() < born.Day()) {
+		years--
+	}
+	return years
+}
+
+// Display renders the date for people: "1988", "Jan 1988" or "Jan 12, 1988" with the short
+// layout, and "1988", "January 1988" or "January 12, 1988" otherwise. Unknown dates render as "Unknown".
+func (d PartialDate) Display(short bool) string {
+	monthLayout, dayLayout := "January 2006", "January 2, 2006"
+	if short {
+		monthLayout, dayLayout = "Jan 2006", "Jan 2, 2006"
+	}
+	switch d.Precision() {
+	case PrecisionYear:
+		return strconv.Itoa(d.Year)
+	case PrecisionMonth:
+		return d.Earliest().Format(monthLayout)
+	case PrecisionDay:
+		return d.Earliest().Format(dayLayout)
+	default:
+		return "Unknown"
+	}
+}
+
+func (d PartialDate) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+func (d *PartialDate) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == nil || *s == "" {
+		*d = PartialDate{}
+		return nil
+	}
+	parsed, err := Parse(*s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalBSONValue stores full dates as datetimes and partial dates as strings
+func (d PartialDate) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	switch {
+	case d.IsZero():
+		return bson.TypeNull, nil, nil
+	case d.IsFull():
+		return bson.MarshalValue(d.Earliest())
+	default:
+		return bson.MarshalValue(d.String())
+	}
+}
+
+// UnmarshalBSONValue reads both datetimes (legacy and full dates) and strings
+func (d *PartialDate) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	raw := bson.RawValue{Type: t, Value: data}
+	switch t {
+	case bson.TypeNull, bson.TypeUndefined:
+		*d = PartialDate{}
+		return nil
+	case bson.TypeDateTime:
+		*d = FromTime(raw.Time().UTC())
+		return nil
+	case bson.TypeString:
+		if raw.StringValue() == "" {
+			*d = PartialDate{}
+			return nil
+		}
+		parsed, err := Parse(raw.StringValue())
+		if err != nil {
+			return err
+		}
+		*d = parsed
+		return nil
+	default:
+		return fmt.Errorf("cannot decode BSON %s into a partial date", t)
+	}
+}
+
+// MarshalGQL implements the GraphQL PartialDate scalar
+func (d PartialDate) MarshalGQL(w io.Writer) {
+	_, _ = io.WriteString(w, strconv.Quote(d.String()))
+}
+
+// UnmarshalGQL implements the GraphQL PartialDate scalar
+func (d *PartialDate) UnmarshalGQL(v any) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("PartialDate must be a string in YYYY, YYYY-MM or YYYY-MM-DD form")
+	}
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
@@ -191,3 +191,22 @@ func IsNotFoundError(err error) bool {
 
 	return false
 }
+
+// IsDuplicateError checks if the error is a duplicate key or duplicate record error
+func IsDuplicateError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var repoErr *RepositoryError
+	if errors.As(err, &repoErr) {
+		return repoErr.IsDuplicateKey()
+	}
+
+	var duplicateErr DuplicateRecordError
+	if errors.As(err, &duplicateErr) {
+		return true
+	}
+
+	return false
+}
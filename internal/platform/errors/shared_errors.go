@@ -168,6 +168,26 @@ func NewRateLimitError(resource string, limit int, window string) RateLimitError
 	}
 }
 
+// ConflictError represents a write rejected because the record changed concurrently
+type ConflictError struct {
+	Type   string
+	ID     string
+	Reason string
+}
+
+func (e ConflictError) Error() string {
+	return fmt.Sprintf("conflict on %s with ID %s: %s", e.Type, e.ID, e.Reason)
+}
+
+// NewConflictError creates a new conflict error
+func NewConflictError(recordType, id, reason string) ConflictError {
+	return ConflictError{
+		Type:   recordType,
+		ID:     id,
+		Reason: reason,
+	}
+}
+
 // Common domain-specific errors that can be used across domains
 var (
 	ErrIDRequired    = errors.New("ID is required")
@@ -0,0 +1,151 @@
+package events
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// subscription is a handler and the event types it receives; none means every type
+type subscription struct {
+	types   []string
+	handler Handler
+}
+
+// subscribers is the registry both buses deliver to
+type subscribers struct {
+	mu   sync.RWMutex
+	list []subscription
+	log  *zap.Logger
+}
+
+func (s *subscribers) Subscribe(handler Handler, eventTypes ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list = append(s.list, subscription{types: eventTypes, handler: handler})
+}
+
+// deliver calls every handler subscribed to the event in order of subscription; a handler that
+// panics is logged and skipped, so one consumer never keeps an event from the others
+func (s *subscribers) deliver(ctx context.Context, envelope Envelope) {
+	s.mu.RLock()
+	list := s.list
+	s.mu.RUnlock()
+
+	for _, sub := range list {
+		if len(sub.types) > 0 && !slices.Contains(sub.types, envelope.Type) {
+			continue
+		}
+		func() {
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					s.log.Error("Event handler panicked",
+						zap.String("event_type", envelope.Type),
+						zap.String("event_id", envelope.ID),
+						zap.Any("panic", recovered))
+				}
+			}()
+			sub.handler(ctx, envelope)
+		}()
+	}
+}
+
+// SyncBus delivers every event to its handlers before Publish returns, in the publishing
+// goroutine; tests and command-line tools use it to see events in order
+type SyncBus struct {
+	subscribers
+}
+
+func NewSyncBus(log *zap.Logger) *SyncBus {
+	return &SyncBus{subscribers: subscribers{log: log}}
+}
+
+func (b *SyncBus) Publish(ctx context.Context, event Event) {
+	b.deliver(ctx, Wrap(ctx, event, time.Now()))
+}
+
+// AsyncConfig sizes the queue of the asynchronous bus
+type AsyncConfig struct {
+	QueueSize int // Events waiting for the worker; 1000 when zero
+}
+
+// defaultQueueSize is the queue size of an AsyncBus configured without one
+const defaultQueueSize = 1000
+
+// queuedEvent is an event waiting for the worker, with the publishing context's values
+type queuedEvent struct {
+	ctx      context.Context
+	envelope Envelope
+}
+
+// AsyncBus queues events and delivers them from Run, so handlers add no latency to the change
+// that raised them. When the queue is full, or the worker has stopped, an event is delivered in
+// the publishing goroutine instead of being dropped; handlers must therefore be safe to call
+// concurrently.
+type AsyncBus struct {
+	subscribers
+	queue   chan queuedEvent
+	mu      sync.RWMutex // Held for writing while the worker stops
+	stopped bool
+}
+
+func NewAsyncBus(cfg AsyncConfig, log *zap.Logger) *AsyncBus {
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = defaultQueueSize
+	}
+	return &AsyncBus{subscribers: subscribers{log: log}, queue: make(chan queuedEvent, cfg.QueueSize)}
+}
+
+func (b *AsyncBus) Publish(ctx context.Context, event Event) {
+	envelope := Wrap(ctx, event, time.Now())
+
+	b.mu.RLock()
+	queued := false
+	if !b.stopped {
+		select {
+		case b.queue <- queuedEvent{ctx: context.WithoutCancel(ctx), envelope: envelope}:
+			queued = true
+		default:
+		}
+	}
+	b.mu.RUnlock()
+	if queued {
+		return
+	}
+
+	b.log.Warn("Event queue full or stopped, delivering in the publisher",
+		zap.String("event_type", envelope.Type),
+		zap.String("event_id", envelope.ID))
+	b.deliver(ctx, envelope)
+}
+
+// Run delivers queued events until ctx is cancelled, then delivers the events still queued and
+// returns; events published afterwards are delivered by their publisher
+func (b *AsyncBus) Run(ctx context.Context) {
+	for {
+		select {
+		case item := <-b.queue:
+			b.deliver(item.ctx, item.envelope)
+		case <-ctx.Done():
+			b.mu.Lock()
+			b.stopped = true
+			b.mu.Unlock()
+			for {
+				select {
+				case item := <-b.queue:
+					b.deliver(item.ctx, item.envelope)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// Pending is the number of queued events
+func (b *AsyncBus) Pending() int {
+	return len(b.queue)
+}
@@ -0,0 +1,60 @@
+package events
+
+import "time"
+
+// Event types
+const (
+	TypePatientCreated            = "patient.created"
+	TypePatientUpdated            = "patient.updated"
+	TypeAddressUpserted           = "address.upserted"
+	TypePrescriptionStatusChanged = "prescription.status_changed"
+)
+
+// Types lists every event type services publish, with the current version of its payload
+var Types = map[string]int{
+	TypePatientCreated:            PatientCreated{}.SchemaVersion(),
+	TypePatientUpdated:            PatientUpdated{}.SchemaVersion(),
+	TypeAddressUpserted:           AddressUpserted{}.SchemaVersion(),
+	TypePrescriptionStatusChanged: PrescriptionStatusChanged{}.SchemaVersion(),
+}
+
+// PatientCreated is published after a patient is saved for the first time
+type PatientCreated struct {
+	PatientID string    `json:"patient_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (PatientCreated) EventType() string  { return TypePatientCreated }
+func (PatientCreated) SchemaVersion() int { return 1 }
+
+// PatientUpdated is published after a change to a patient's details is saved. The payload is
+// the patient.updated webhook contract.
+type PatientUpdated struct {
+	PatientID string    `json:"patient_id"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (PatientUpdated) EventType() string  { return TypePatientUpdated }
+func (PatientUpdated) SchemaVersion() int { return 1 }
+
+// AddressUpserted is published after a patient's address is created or changed
+type AddressUpserted struct {
+	AddressID string `json:"address_id"`
+	PatientID string `json:"patient_id"`
+}
+
+func (AddressUpserted) EventType() string  { return TypeAddressUpserted }
+func (AddressUpserted) SchemaVersion() int { return 1 }
+
+// PrescriptionStatusChanged is published after a prescription moves to another status, by an
+// update, a reopen or the expiration job. The payload is the prescription.status_changed webhook
+// contract.
+type PrescriptionStatusChanged struct {
+	PrescriptionID string `json:"prescription_id"`
+	PatientID      string `json:"patient_id"`
+	PreviousStatus string `json:"previous_status"`
+	Status         string `json:"status"`
+}
+
+func (PrescriptionStatusChanged) EventType() string  { return TypePrescriptionStatusChanged }
+func (PrescriptionStatusChanged) SchemaVersion() int { return 1 }
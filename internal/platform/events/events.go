@@ -0,0 +1,95 @@
+// Package events is the one model of the domain events services publish: typed, versioned event
+// structs wrapped in an envelope, a Publisher the services emit them through, and Subscribers
+// such as webhooks that consume them. A synchronous bus delivers events before Publish returns,
+// for tests and tools; the asynchronous bus delivers them from a background worker.
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/tenancy"
+)
+
+// Event is a typed domain event. Its fields are the payload consumers receive, so they carry
+// identifiers and the change only, never PHI; consumers read details through the services.
+type Event interface {
+	// EventType names the event, e.g. patient.created
+	EventType() string
+	// SchemaVersion is the version of the payload; it goes up when a field is renamed or removed
+	SchemaVersion() int
+}
+
+// Envelope is a published event with what is known about its origin
+type Envelope struct {
+	ID            string    `json:"id"`
+	Type          string    `json:"type"`
+	SchemaVersion int       `json:"schema_version"`
+	OccurredAt    time.Time `json:"occurred_at"`
+	OrgID         string    `json:"org_id,omitempty"` // Organization of the request that raised the event
+	Actor         string    `json:"actor,omitempty"`  // User ID behind the change; empty for jobs
+	Data          Event     `json:"data"`
+}
+
+// Handler consumes published events. Handlers of the asynchronous bus run on its worker, with
+// the values of the publishing context but not its cancellation.
+type Handler func(ctx context.Context, envelope Envelope)
+
+// Publisher is what services emit events through. Publishing never fails the change that
+// raised the event: delivery problems are logged by the bus.
+type Publisher interface {
+	Publish(ctx context.Context, event Event)
+}
+
+// Subscriber registers event handlers; subscribe before events are published
+type Subscriber interface {
+	// Subscribe calls handler for every event of the given types, or of every type when none
+	// are given
+	Subscribe(handler Handler, eventTypes ...string)
+}
+
+// Bus publishes events to its subscribers
+type Bus interface {
+	Publisher
+	Subscriber
+}
+
+// On subscribes a handler to one event type, given as the handler's parameter type:
+//
+//	events.On(bus, func(ctx context.Context, e events.PatientCreated, env events.Envelope) { ... })
+func On[E Event](s Subscriber, handler func(ctx context.Context, event E, envelope Envelope)) {
+	var zero E
+	s.Subscribe(func(ctx context.Context, envelope Envelope) {
+		if event, ok := envelope.Data.(E); ok {
+			handler(ctx, event, envelope)
+		}
+	}, zero.EventType())
+}
+
+// Discard publishes nothing; services use it when no bus is configured
+var Discard Publisher = discard{}
+
+type discard struct{}
+
+func (discard) Publish(context.Context, Event) {}
+
+// Wrap puts an event in an envelope stamped with the request's organization and user
+func Wrap(ctx context.Context, event Event, now time.Time) Envelope {
+	envelope := Envelope{
+		ID:            uuid.NewString(),
+		Type:          event.EventType(),
+		SchemaVersion: event.SchemaVersion(),
+		OccurredAt:    now,
+		Data:          event,
+	}
+	if orgID, ok := tenancy.OrgID(ctx); ok {
+		envelope.OrgID = orgID
+	}
+	if user, err := auth.GetCurrentUser(ctx); err == nil {
+		envelope.Actor = user.ID
+	}
+	return envelope
+}
@@ -0,0 +1,135 @@
+package fieldcrypt
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Cipher encrypts and decrypts field values and derives their blind indexes
+type Cipher struct {
+	provider KeyProvider
+	indexKey []byte
+
+	mu    sync.Mutex
+	aeads map[string]cipher.AEAD
+}
+
+// New creates a cipher. indexKey keys the blind index HMAC; it is separate from the data keys
+// because changing it invalidates every stored index.
+func New(provider KeyProvider, indexKey []byte) (*Cipher, error) {
+	if provider == nil {
+		return nil, errors.New("key provider is required")
+	}
+	if len(indexKey) < keySize {
+		return nil, fmt.Errorf("index key must be at least %d bytes", keySize)
+	}
+	return &Cipher{provider: provider, indexKey: indexKey, aeads: map[string]cipher.AEAD{}}, nil
+}
+
+// ActiveKeyID names the key Encrypt uses
+func (c *Cipher) ActiveKeyID() string {
+	return c.provider.ActiveKeyID()
+}
+
+// Encrypt seals plaintext with the active key. Empty values stay empty so optional fields keep
+// their meaning.
+func (c *Cipher) Encrypt(ctx context.Context, plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	id := c.provider.ActiveKeyID()
+	aead, err := c.aead(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("generate nonce: %w", err)
+	}
+	// The key ID is authenticated, so an envelope cannot be relabelled to another key
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(id))
+	return envelopePrefix + id + ":" + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt opens an envelope with the key it names. Values that are not envelopes are returned
+// unchanged, so documents written before encryption was enabled stay readable.
+func (c *Cipher) Decrypt(ctx context.Context, value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	id, encoded, ok := strings.Cut(strings.TrimPrefix(value, envelopePrefix), ":")
+	if !ok {
+		return "", errors.New("malformed encrypted value")
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("malformed encrypted value: %w", err)
+	}
+	aead, err := c.aead(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("malformed encrypted value")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(id))
+	if err != nil {
+		return "", fmt.Errorf("decrypt with key %q: %w", id, err)
+	}
+	return string(plaintext), nil
+}
+
+// NeedsRotation reports whether value is plaintext or sealed with a key other than the active one
+func (c *Cipher) NeedsRotation(value string) bool {
+	if value == "" {
+		return false
+	}
+	return KeyID(value) != c.provider.ActiveKeyID()
+}
+
+// BlindIndex returns a keyed hash of value, scoped by kind so equal values of different fields
+// hash differently. Callers normalize values (case, separators) before hashing.
+func (c *Cipher) BlindIndex(kind, value string) string {
+	mac := hmac.New(sha256.New, c.indexKey)
+	mac.Write([]byte(kind))
+	mac.Write([]byte{0})
+	mac.Write([]byte(value))
+	// 128 bits is plenty to avoid collisions and keeps indexes small
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
+
+// aead returns the cached AES-GCM instance for a key, fetching the key on first use
+func (c *Cipher) aead(ctx context.Context, id string) (cipher.AEAD, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if aead, ok := c.aeads[id]; ok {
+		return aead, nil
+	}
+
+	key, err := c.provider.Key(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("key %q: %w", id, err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("key %q: %w", id, err)
+	}
+	c.aeads[id] = aead
+	return aead, nil
+}
@@ -0,0 +1,44 @@
+// Package fieldcrypt encrypts individual document fields at rest with AES-256-GCM. Each value is
+// stored as an envelope naming the key it was encrypted with, so keys can be rotated: new writes
+// use the active key while values under older keys stay readable until they are re-encrypted.
+//
+// Encrypted values cannot be queried, so Cipher also derives blind indexes: keyed HMACs of
+// normalized values that support exact-match filters without revealing the value.
+package fieldcrypt
+
+import (
+	"context"
+	"strings"
+)
+
+// Envelope format: enc:v1:<key id>:<base64 nonce+ciphertext>
+const (
+	envelopePrefix = "enc:v1:"
+	keySize        = 32 // AES-256
+)
+
+// KeyProvider supplies data keys by ID. The config provider holds keys in memory; a KMS-backed
+// provider can unwrap data keys on demand instead, Cipher caches them after first use.
+type KeyProvider interface {
+	// ActiveKeyID names the key new values are encrypted with
+	ActiveKeyID() string
+	// Key returns the 32-byte data key with the given ID
+	Key(ctx context.Context, id string) ([]byte, error)
+}
+
+// IsEncrypted reports whether value is an envelope written by Cipher
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, envelopePrefix)
+}
+
+// KeyID returns the ID of the key an envelope was encrypted with, or "" for plaintext
+func KeyID(value string) string {
+	if !IsEncrypted(value) {
+		return ""
+	}
+	id, _, ok := strings.Cut(strings.TrimPrefix(value, envelopePrefix), ":")
+	if !ok {
+		return ""
+	}
+	return id
+}
@@ -0,0 +1,57 @@
+package fieldcrypt
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// StaticKeyProvider serves base64-encoded keys from configuration
+type StaticKeyProvider struct {
+	active string
+	keys   map[string][]byte
+}
+
+// NewStaticKeyProvider decodes the keys and checks that the active key is among them
+func NewStaticKeyProvider(keys map[string]string, active string) (*StaticKeyProvider, error) {
+	p := &StaticKeyProvider{active: active, keys: make(map[string][]byte, len(keys))}
+	for id, encoded := range keys {
+		if id == "" || strings.Contains(id, ":") {
+			return nil, fmt.Errorf("invalid key id %q", id)
+		}
+		key, err := DecodeKey(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", id, err)
+		}
+		p.keys[id] = key
+	}
+	if _, ok := p.keys[active]; !ok {
+		return nil, fmt.Errorf("active key %q is not configured", active)
+	}
+	return p, nil
+}
+
+func (p *StaticKeyProvider) ActiveKeyID() string {
+	return p.active
+}
+
+func (p *StaticKeyProvider) Key(_ context.Context, id string) ([]byte, error) {
+	key, ok := p.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown encryption key %q", id)
+	}
+	return key, nil
+}
+
+// DecodeKey reads a base64-encoded 32-byte key
+func DecodeKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("key is not valid base64: %w", err)
+	}
+	if len(key) != keySize {
+		return nil, fmt.Errorf("key must be %d bytes, got %d", keySize, len(key))
+	}
+	return key, nil
+}
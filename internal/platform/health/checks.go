@@ -0,0 +1,106 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"pharmacy-modernization-project-model/internal/platform/cache"
+	"pharmacy-modernization-project-model/internal/platform/database"
+)
+
+// MongoCheck probes MongoDB through the connection manager's HealthCheck.
+// A nil manager means the app is running on in-memory repositories, which is degraded.
+func MongoCheck(name string, connMgr *database.ConnectionManager, critical bool) Check {
+	return Check{
+		Name:     name,
+		Critical: critical,
+		Run: func(ctx context.Context) (Status, string, map[string]interface{}) {
+			if connMgr == nil {
+				return StatusDegraded, "not connected, using in-memory fallback", nil
+			}
+			if err := connMgr.HealthCheck(ctx); err != nil {
+				return StatusUnhealthy, err.Error(), nil
+			}
+			return StatusHealthy, "ping ok", nil
+		},
+	}
+}
+
+// CacheCheck performs a set/get round trip against the cache and reports its stats
+func CacheCheck(name string, c cache.Cache) Check {
+	return Check{
+		Name: name,
+		Run: func(ctx context.Context) (Status, string, map[string]interface{}) {
+			if c == nil {
+				return StatusDegraded, "cache not configured", nil
+			}
+
+			stats := c.Stats()
+			details := map[string]interface{}{
+				"hits":     stats.Hits,
+				"misses":   stats.Misses,
+				"errors":   stats.Errors,
+				"hit_rate": stats.HitRate,
+				"size":     stats.Size,
+			}
+
+			if err := cache.NewCacheHealthChecker(c).Check(ctx); err != nil {
+				return StatusUnhealthy, err.Error(), details
+			}
+			return StatusHealthy, "ping ok", details
+		},
+	}
+}
+
+// ReachabilityCheck issues a lightweight GET against the host of endpoint.
+// Any response below 500 counts as reachable. Mocked integrations are reported healthy.
+func ReachabilityCheck(name, endpoint string, useMock bool) Check {
+	client := &http.Client{
+		// Don't follow redirects; reaching the host is enough
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+
+	return Check{
+		Name: name,
+		Run: func(ctx context.Context) (Status, string, map[string]interface{}) {
+			if useMock {
+				return StatusHealthy, "using mock client", map[string]interface{}{"mock": true}
+			}
+
+			target, err := baseURL(endpoint)
+			if err != nil {
+				return StatusUnhealthy, err.Error(), nil
+			}
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+			if err != nil {
+				return StatusUnhealthy, err.Error(), nil
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				return StatusUnhealthy, "unreachable", map[string]interface{}{"target": target}
+			}
+			resp.Body.Close()
+
+			details := map[string]interface{}{"target": target, "status_code": resp.StatusCode}
+			if resp.StatusCode >= http.StatusInternalServerError {
+				return StatusDegraded, fmt.Sprintf("responded with %d", resp.StatusCode), details
+			}
+			return StatusHealthy, "reachable", details
+		},
+	}
+}
+
+// baseURL reduces a configured endpoint (which may contain path templates) to scheme://host/
+func baseURL(endpoint string) (string, error) {
+	if endpoint == "" {
+		return "", fmt.Errorf("endpoint not configured")
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return "", fmt.Errorf("invalid endpoint")
+	}
+	return u.Scheme + "://" + u.Host + "/", nil
+}
@@ -0,0 +1,138 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Status represents the health of a single dependency or of the whole application
+type Status string
+
+const (
+	StatusHealthy   Status = "healthy"
+	StatusDegraded  Status = "degraded"
+	StatusUnhealthy Status = "unhealthy"
+)
+
+// CheckFunc probes a dependency and returns its status with a short message and optional details
+type CheckFunc func(ctx context.Context) (Status, string, map[string]interface{})
+
+// Check describes a dependency probe.
+// A failing critical check makes the application unhealthy; a failing
+// non-critical check only degrades it.
+type Check struct {
+	Name     string
+	Critical bool
+	Run      CheckFunc
+}
+
+// DependencyResult is the outcome of a single dependency check
+type DependencyResult struct {
+	Name       string                 `json:"name"`
+	Status     Status                 `json:"status"`
+	Critical   bool                   `json:"critical"`
+	Message    string                 `json:"message,omitempty"`
+	DurationMs int64                  `json:"duration_ms"`
+	Details    map[string]interface{} `json:"details,omitempty"`
+}
+
+// Report is the aggregated readiness report
+type Report struct {
+	Status       Status             `json:"status"`
+	Timestamp    time.Time          `json:"timestamp"`
+	DurationMs   int64              `json:"duration_ms"`
+	Dependencies []DependencyResult `json:"dependencies"`
+}
+
+// Aggregator runs dependency checks concurrently and combines their results
+type Aggregator struct {
+	checks  []Check
+	timeout time.Duration
+}
+
+// NewAggregator creates an aggregator; timeout bounds each check
+func NewAggregator(timeout time.Duration, checks ...Check) *Aggregator {
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	return &Aggregator{checks: checks, timeout: timeout}
+}
+
+// Register adds a check to the aggregator
+func (a *Aggregator) Register(check Check) {
+	a.checks = append(a.checks, check)
+}
+
+// Run executes all checks and returns the aggregated report
+func (a *Aggregator) Run(ctx context.Context) Report {
+	start := time.Now()
+	results := make([]DependencyResult, len(a.checks))
+
+	var wg sync.WaitGroup
+	for i, check := range a.checks {
+		wg.Add(1)
+		go func(i int, check Check) {
+			defer wg.Done()
+			results[i] = a.runCheck(ctx, check)
+		}(i, check)
+	}
+	wg.Wait()
+
+	return Report{
+		Status:       overallStatus(results),
+		Timestamp:    start,
+		DurationMs:   time.Since(start).Milliseconds(),
+		Dependencies: results,
+	}
+}
+
+func (a *Aggregator) runCheck(ctx context.Context, check Check) DependencyResult {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	start := time.Now()
+
+	// Buffered so a check that outlives the timeout can still finish without blocking
+	done := make(chan DependencyResult, 1)
+	go func() {
+		r := DependencyResult{Name: check.Name, Critical: check.Critical}
+		defer func() {
+			if recover() != nil {
+				r.Status = StatusUnhealthy
+				r.Message = "health check panicked"
+			}
+			done <- r
+		}()
+		r.Status, r.Message, r.Details = check.Run(ctx)
+	}()
+
+	var result DependencyResult
+	select {
+	case result = <-done:
+	case <-ctx.Done():
+		result = DependencyResult{
+			Name:     check.Name,
+			Critical: check.Critical,
+			Status:   StatusUnhealthy,
+			Message:  "health check timed out",
+		}
+	}
+
+	result.DurationMs = time.Since(start).Milliseconds()
+	return result
+}
+
+// overallStatus applies degraded/unhealthy semantics across all dependency results
+func overallStatus(results []DependencyResult) Status {
+	status := StatusHealthy
+	for _, r := range results {
+		switch {
+		case r.Status == StatusUnhealthy && r.Critical:
+			return StatusUnhealthy
+		case r.Status != StatusHealthy:
+			status = StatusDegraded
+		}
+	}
+	return status
+}
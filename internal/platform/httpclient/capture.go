@@ -0,0 +1,188 @@
+package httpclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/logging"
+)
+
+// CaptureConfig controls which calls BodyCapture records
+type CaptureConfig struct {
+	Enabled    bool
+	SampleRate float64 // Share of calls captured, from 0 to 1
+	// CaptureErrors captures every call answered with a 4xx or 5xx status, whatever the sample rate
+	CaptureErrors bool
+	MaxBodyBytes  int // Bodies are cut to this size once redacted; 0 keeps 4 KB
+}
+
+const defaultMaxCaptureBytes = 4 << 10
+
+// Capture is one call recorded with its bodies. Bodies are PII-redacted; those that cannot be
+// redacted, such as plain text, are left out and their size alone is recorded.
+type Capture struct {
+	ID            string    `json:"id" bson:"_id"`
+	Method        string    `json:"method" bson:"method"`
+	Host          string    `json:"host" bson:"host"`
+	Path          string    `json:"path" bson:"path"` // Without the query string, which may carry PHI
+	StatusCode    int       `json:"status_code" bson:"status_code"`
+	DurationMs    int64     `json:"duration_ms" bson:"duration_ms"`
+	RequestID     string    `json:"request_id,omitempty" bson:"request_id,omitempty"`
+	CorrelationID string    `json:"correlation_id,omitempty" bson:"correlation_id,omitempty"`
+	Request       Body      `json:"request" bson:"request"`
+	Response      Body      `json:"response" bson:"response"`
+	CapturedAt    time.Time `json:"captured_at" bson:"captured_at"`
+}
+
+// Body is a captured request or response body
+type Body struct {
+	ContentType string `json:"content_type,omitempty" bson:"content_type,omitempty"`
+	Size        int    `json:"size" bson:"size"`                           // Bytes sent or received
+	Content     string `json:"content,omitempty" bson:"content,omitempty"` // Redacted, cut to the size limit
+	Truncated   bool   `json:"truncated,omitempty" bson:"truncated,omitempty"`
+	// Omitted explains why a body is missing, e.g. a content type that cannot be redacted
+	Omitted string `json:"omitted,omitempty" bson:"omitted,omitempty"`
+}
+
+// CaptureSink stores captured calls
+type CaptureSink interface {
+	Store(ctx context.Context, capture Capture) error
+}
+
+// BodyCapture is an interceptor recording a sample of the calls a client makes with their
+// request and response bodies, to debug integrations. Calls that fail before a response arrives
+// are not captured. Its settings can be changed while the client runs.
+type BodyCapture struct {
+	config atomic.Pointer[CaptureConfig]
+	sink   CaptureSink
+}
+
+// NewBodyCapture creates the interceptor; it records nothing until cfg enables it
+func NewBodyCapture(cfg CaptureConfig, sink CaptureSink) *BodyCapture {
+	c := &BodyCapture{sink: sink}
+	c.Apply(cfg)
+	return c
+}
+
+// Apply replaces the settings, e.g. after a config reload
+func (c *BodyCapture) Apply(cfg CaptureConfig) {
+	if cfg.MaxBodyBytes <= 0 {
+		cfg.MaxBodyBytes = defaultMaxCaptureBytes
+	}
+	c.config.Store(&cfg)
+}
+
+// Before does nothing; the request body is read again from the request once the response is in
+func (c *BodyCapture) Before(ctx context.Context, req *http.Request) error {
+	return nil
+}
+
+// After records the call when it is sampled
+func (c *BodyCapture) After(ctx context.Context, resp *http.Response, response *Response) error {
+	cfg := c.config.Load()
+	if !cfg.Enabled || !c.sampled(cfg, resp.StatusCode) {
+		return nil
+	}
+
+	capture := Capture{
+		ID:            uuid.New().String(),
+		StatusCode:    resp.StatusCode,
+		DurationMs:    response.Duration.Milliseconds(),
+		RequestID:     logging.GetRequestID(ctx),
+		CorrelationID: logging.GetCorrelationID(ctx),
+		Response:      captureBody(resp.Header.Get("Content-Type"), response.Body, cfg.MaxBodyBytes),
+		CapturedAt:    time.Now().UTC(),
+	}
+	if req := resp.Request; req != nil {
+		capture.Method = req.Method
+		capture.Host = req.URL.Host
+		capture.Path = req.URL.Path
+		capture.Request = requestBody(req, cfg.MaxBodyBytes)
+	}
+
+	// The sink must not hold up the caller for long, nor fail with its context
+	storeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 2*time.Second)
+	defer cancel()
+	if err := c.sink.Store(storeCtx, capture); err != nil {
+		return fmt.Errorf("store http capture: %w", err)
+	}
+	return nil
+}
+
+func (c *BodyCapture) sampled(cfg *CaptureConfig, statusCode int) bool {
+	if cfg.CaptureErrors && statusCode >= http.StatusBadRequest {
+		return true
+	}
+	return cfg.SampleRate > 0 && rand.Float64() < cfg.SampleRate
+}
+
+// requestBody reads the sent body again; bodies that cannot be replayed are left out
+func requestBody(req *http.Request, maxBytes int) Body {
+	contentType := req.Header.Get("Content-Type")
+	if req.GetBody == nil {
+		if req.ContentLength == 0 {
+			return Body{ContentType: contentType}
+		}
+		return Body{ContentType: contentType, Size: int(req.ContentLength), Omitted: "body cannot be read again"}
+	}
+	reader, err := req.GetBody()
+	if err != nil {
+		return Body{ContentType: contentType, Omitted: "body cannot be read again"}
+	}
+	defer reader.Close()
+	payload, err := io.ReadAll(reader)
+	if err != nil {
+		return Body{ContentType: contentType, Omitted: "body cannot be read again"}
+	}
+	return captureBody(contentType, payload, maxBytes)
+}
+
+// captureBody redacts the payload and cuts it to maxBytes
+func captureBody(contentType string, payload []byte, maxBytes int) Body {
+	body := Body{ContentType: contentType, Size: len(payload)}
+	if len(payload) == 0 {
+		return body
+	}
+	redacted, ok := logging.RedactBody(contentType, payload)
+	if !ok {
+		body.Omitted = "content cannot be redacted"
+		return body
+	}
+	if len(redacted) > maxBytes {
+		redacted, body.Truncated = redacted[:maxBytes], true
+	}
+	body.Content = string(redacted)
+	return body
+}
+
+// LogCaptureSink writes captured calls to the log
+type LogCaptureSink struct {
+	logger *zap.Logger
+}
+
+// NewLogCaptureSink creates a sink writing each capture as one structured log entry
+func NewLogCaptureSink(logger *zap.Logger) *LogCaptureSink {
+	return &LogCaptureSink{logger: logger}
+}
+
+func (s *LogCaptureSink) Store(ctx context.Context, capture Capture) error {
+	logging.WithContext(ctx, s.logger).Info("http call captured",
+		zap.String("capture_id", capture.ID),
+		zap.String("method", capture.Method),
+		zap.String("host", capture.Host),
+		zap.String("path", capture.Path),
+		zap.Int("status_code", capture.StatusCode),
+		zap.Int64("duration_ms", capture.DurationMs),
+		zap.Any("request", capture.Request),
+		zap.Any("response", capture.Response),
+	)
+	return nil
+}
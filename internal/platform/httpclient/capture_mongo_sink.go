@@ -0,0 +1,55 @@
+package httpclient
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// MongoCaptureSink keeps captured calls in a debug collection. A TTL index removes them once
+// they are older than the retention.
+type MongoCaptureSink struct {
+	collection *mongo.Collection
+	ttl        time.Duration
+}
+
+type captureDocument struct {
+	Capture   `bson:",inline"`
+	ExpiresAt time.Time `bson:"expires_at"`
+}
+
+// NewMongoCaptureSink creates a MongoDB-backed capture sink and ensures its indexes
+func NewMongoCaptureSink(collection *mongo.Collection, ttl time.Duration, logger *zap.Logger) *MongoCaptureSink {
+	sink := &MongoCaptureSink{collection: collection, ttl: ttl}
+	if err := sink.ensureIndexes(); err != nil {
+		logger.Warn("Failed to create http capture indexes", zap.Error(err))
+	}
+	return sink
+}
+
+func (s *MongoCaptureSink) ensureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := s.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetName("expires_at_ttl").SetExpireAfterSeconds(0),
+		},
+		{
+			// Captures of one inbound request, newest first
+			Keys:    bson.D{{Key: "request_id", Value: 1}, {Key: "captured_at", Value: -1}},
+			Options: options.Index().SetName("request_id_captured_at"),
+		},
+	})
+	return err
+}
+
+func (s *MongoCaptureSink) Store(ctx context.Context, capture Capture) error {
+	_, err := s.collection.InsertOne(ctx, captureDocument{Capture: capture, ExpiresAt: capture.CapturedAt.Add(s.ttl)})
+	return err
+}
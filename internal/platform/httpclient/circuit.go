@@ -0,0 +1,134 @@
+package httpclient
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without calling the service while its circuit is open
+var ErrCircuitOpen = errors.New("circuit open: service is failing, request not sent")
+
+// CircuitState is the state of the circuit breaker of a service
+type CircuitState string
+
+const (
+	CircuitClosed   CircuitState = "closed"    // Requests are sent
+	CircuitOpen     CircuitState = "open"      // Requests fail fast until OpenTimeout passes
+	CircuitHalfOpen CircuitState = "half_open" // One trial request decides whether to close again
+)
+
+// CircuitBreakerConfig stops calling a service that keeps failing. Transport errors and 5xx
+// responses count as failures.
+type CircuitBreakerConfig struct {
+	FailureThreshold int           // Consecutive failures that open the circuit; 0 disables the breaker
+	OpenTimeout      time.Duration // How long the circuit stays open before a trial request
+}
+
+// ServiceHealth is what the client has seen of one service since it started
+type ServiceHealth struct {
+	Service             string       `json:"service"`
+	Circuit             CircuitState `json:"circuit"`
+	ConsecutiveFailures int          `json:"consecutive_failures"`
+	LastSuccess         *time.Time   `json:"last_success,omitempty"`
+	LastFailure         *time.Time   `json:"last_failure,omitempty"`
+	LastError           string       `json:"last_error,omitempty"`
+}
+
+// circuits tracks the health and circuit breaker of every service called through a client
+type circuits struct {
+	cfg      CircuitBreakerConfig
+	mu       sync.Mutex
+	services map[string]*circuit
+}
+
+type circuit struct {
+	health   ServiceHealth
+	openedAt time.Time
+	probing  bool // A half-open trial request is in flight
+}
+
+func newCircuits(cfg CircuitBreakerConfig) *circuits {
+	if cfg.OpenTimeout <= 0 {
+		cfg.OpenTimeout = 30 * time.Second
+	}
+	return &circuits{cfg: cfg, services: make(map[string]*circuit)}
+}
+
+// get returns the circuit of a service; the caller holds the lock
+func (c *circuits) get(service string) *circuit {
+	s, ok := c.services[service]
+	if !ok {
+		s = &circuit{health: ServiceHealth{Service: service, Circuit: CircuitClosed}}
+		c.services[service] = s
+	}
+	return s
+}
+
+// allow reports whether a request to the service may be sent. After OpenTimeout an open circuit
+// lets a single trial request through.
+func (c *circuits) allow(service string) bool {
+	if c.cfg.FailureThreshold <= 0 {
+		return true
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s := c.get(service)
+	switch s.health.Circuit {
+	case CircuitOpen:
+		if time.Since(s.openedAt) < c.cfg.OpenTimeout {
+			return false
+		}
+		s.health.Circuit = CircuitHalfOpen
+		s.probing = true
+		return true
+	case CircuitHalfOpen:
+		if s.probing {
+			return false
+		}
+		s.probing = true
+		return true
+	}
+	return true
+}
+
+// record notes the outcome of a request; err is nil for a successful one
+func (c *circuits) record(service string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s := c.get(service)
+	now := time.Now()
+	s.probing = false
+	if err == nil {
+		s.health.LastSuccess = &now
+		s.health.ConsecutiveFailures = 0
+		s.health.Circuit = CircuitClosed
+		return
+	}
+
+	s.health.LastFailure = &now
+	s.health.LastError = err.Error()
+	s.health.ConsecutiveFailures++
+	if c.cfg.FailureThreshold > 0 && (s.health.Circuit == CircuitHalfOpen || s.health.ConsecutiveFailures >= c.cfg.FailureThreshold) {
+		s.health.Circuit = CircuitOpen
+		s.openedAt = now
+	}
+}
+
+// health returns a copy of the health of a service; a service not called yet is closed
+func (c *circuits) health(service string) ServiceHealth {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, ok := c.services[service]
+	if !ok {
+		return ServiceHealth{Service: service, Circuit: CircuitClosed}
+	}
+	health := s.health
+	if health.Circuit == CircuitOpen && time.Since(s.openedAt) >= c.cfg.OpenTimeout {
+		health.Circuit = CircuitHalfOpen // The next request is the trial
+	}
+	return health
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -12,6 +13,9 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/logging"
+	"pharmacy-modernization-project-model/internal/platform/metrics"
 )
 
 // Client is a centralized HTTP client with built-in observability, logging, and middleware support
@@ -21,14 +25,27 @@ type Client struct {
 	interceptors   []Interceptor
 	headerProvider HeaderProvider
 	serviceName    string
+	circuits       *circuits
+
+	timeout              time.Duration
+	deadlineMargin       time.Duration
+	slowRequestThreshold time.Duration
 }
 
 // Config holds configuration for the HTTP client
 type Config struct {
-	Timeout        time.Duration
+	Timeout        time.Duration // Default per-request timeout; requests may override it
 	MaxIdleConns   int
 	ServiceName    string
 	HeaderProvider HeaderProvider // Optional: provides headers for all requests
+
+	// DeadlineMargin is kept free before the caller's context deadline, so a request that
+	// times out still leaves the caller time to handle the failure
+	DeadlineMargin time.Duration
+	// SlowRequestThreshold logs a warning for requests taking at least this long (0 disables)
+	SlowRequestThreshold time.Duration
+	// CircuitBreaker stops calling a service after repeated failures, per service name
+	CircuitBreaker CircuitBreakerConfig
 }
 
 // NewClient creates a new instrumented HTTP client
@@ -46,15 +63,19 @@ func NewClient(cfg Config, logger *zap.Logger, interceptors ...Interceptor) *Cli
 		IdleConnTimeout:     90 * time.Second,
 	}
 
+	// Timeouts are applied per request through the context, see requestBudget
 	return &Client{
 		httpClient: &http.Client{
-			Timeout:   cfg.Timeout,
 			Transport: transport,
 		},
-		logger:         logger,
-		interceptors:   interceptors,
-		headerProvider: cfg.HeaderProvider,
-		serviceName:    cfg.ServiceName,
+		logger:               logger,
+		interceptors:         interceptors,
+		headerProvider:       cfg.HeaderProvider,
+		serviceName:          cfg.ServiceName,
+		circuits:             newCircuits(cfg.CircuitBreaker),
+		timeout:              cfg.Timeout,
+		deadlineMargin:       cfg.DeadlineMargin,
+		slowRequestThreshold: cfg.SlowRequestThreshold,
 	}
 }
 
@@ -64,6 +85,11 @@ type Request struct {
 	URL     string
 	Headers map[string]string
 	Body    io.Reader
+
+	Endpoint      string        // Optional name for logs and metrics
+	Service       string        // Service called, for metrics; the client's ServiceName when empty
+	Timeout       time.Duration // Overrides the client default when set
+	SlowThreshold time.Duration // Overrides the client's slow-request threshold when set
 }
 
 // Response represents an HTTP response with metadata
@@ -122,45 +148,107 @@ func (c *Client) additionalValidation(requestURL string) {
 	}
 }
 
-// Do executes an HTTP request with full observability
+// Do executes an HTTP request with full observability. When the header provider can renew its
+// credentials, a 401 response invalidates them and the request is sent once more.
 func (c *Client) Do(ctx context.Context, req Request) (*Response, error) {
+	refreshable, ok := c.headerProvider.(RefreshableHeaderProvider)
+	if !ok {
+		response, _, err := c.send(ctx, req)
+		return response, err
+	}
+
+	// Buffer the body so it can be sent again
+	var payload []byte
+	if req.Body != nil {
+		var err error
+		if payload, err = io.ReadAll(req.Body); err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+		req.Body = bytes.NewReader(payload)
+	}
+
+	response, sentHeaders, err := c.send(ctx, req)
+	if err != nil || response.StatusCode != http.StatusUnauthorized {
+		return response, err
+	}
+
+	logging.WithContext(ctx, c.logger).Warn("http request unauthorized, retrying with renewed credentials",
+		zap.String("service", c.serviceName),
+		zap.String("endpoint", req.Endpoint),
+		zap.String("method", req.Method),
+	)
+	refreshable.Invalidate(sentHeaders)
+	if req.Body != nil {
+		req.Body = bytes.NewReader(payload)
+	}
+	response, _, err = c.send(ctx, req)
+	return response, err
+}
+
+// send executes a single attempt of the request with full observability. It also returns the
+// headers taken from the header provider, so a rejected credential can be identified.
+func (c *Client) send(ctx context.Context, req Request) (*Response, map[string]string, error) {
 	startTime := time.Now()
+	logger := logging.WithContext(ctx, c.logger)
 
 	// Validate URL for security
 	if err := c.validateURL(req.URL); err != nil {
-		c.logger.Error("URL validation failed",
+		logger.Error("URL validation failed",
 			zap.String("service", c.serviceName),
 			zap.Error(err),
 		)
-		return nil, fmt.Errorf("URL validation failed: %w", err)
+		return nil, nil, fmt.Errorf("URL validation failed: %w", err)
+	}
+
+	// Fit the request into the caller's deadline
+	timeout, err := c.requestBudget(ctx, req)
+	if err != nil {
+		logger.Warn("http request skipped, caller deadline too close",
+			zap.String("service", c.serviceName),
+			zap.String("endpoint", req.Endpoint),
+			zap.String("method", req.Method),
+			zap.Duration("deadline_margin", c.deadlineMargin),
+		)
+		return nil, nil, err
 	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
 	// Create HTTP request
 	httpReq, err := http.NewRequestWithContext(ctx, req.Method, req.URL, req.Body)
 	if err != nil {
-		c.logger.Error("failed to create request",
+		logger.Error("failed to create request",
 			zap.String("service", c.serviceName),
 			zap.String("method", req.Method),
 			zap.Error(err),
 		)
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Add headers from provider (if configured)
+	var providedHeaders map[string]string
 	if c.headerProvider != nil {
-		providedHeaders, err := c.headerProvider.GetHeaders(ctx)
+		providedHeaders, err = c.headerProvider.GetHeaders(ctx)
 		if err != nil {
-			c.logger.Error("failed to get headers from provider",
+			logger.Error("failed to get headers from provider",
 				zap.String("service", c.serviceName),
 				zap.Error(err),
 			)
-			return nil, fmt.Errorf("header provider failed: %w", err)
+			return nil, nil, fmt.Errorf("header provider failed: %w", err)
 		}
 		for key, value := range providedHeaders {
 			httpReq.Header.Set(key, value)
 		}
 	}
 
+	// Propagate request/correlation IDs so calls can be traced across services
+	if rid := logging.GetRequestID(ctx); rid != "" {
+		httpReq.Header.Set(logging.RequestIDHeader, rid)
+	}
+	if cid := logging.GetCorrelationID(ctx); cid != "" {
+		httpReq.Header.Set(logging.CorrelationIDHeader, cid)
+	}
+
 	// Set request-specific headers (can override provider headers)
 	for key, value := range req.Headers {
 		httpReq.Header.Set(key, value)
@@ -169,41 +257,60 @@ func (c *Client) Do(ctx context.Context, req Request) (*Response, error) {
 	// Execute interceptors (before)
 	for _, interceptor := range c.interceptors {
 		if err := interceptor.Before(ctx, httpReq); err != nil {
-			return nil, fmt.Errorf("interceptor before failed: %w", err)
+			return nil, nil, fmt.Errorf("interceptor before failed: %w", err)
 		}
 	}
 
 	// Log request
-	c.logger.Info("http request initiated",
+	logger.Info("http request initiated",
 		zap.String("service", c.serviceName),
+		zap.String("endpoint", req.Endpoint),
 		zap.String("method", req.Method),
+		zap.Duration("timeout", timeout),
 	)
 
+	// Fail fast while the service keeps failing
+	service := c.metricsService(req)
+	if !c.circuits.allow(service) {
+		logger.Warn("http request skipped, circuit open",
+			zap.String("service", service),
+			zap.String("endpoint", req.Endpoint),
+			zap.String("method", req.Method),
+		)
+		return nil, nil, ErrCircuitOpen
+	}
+
 	// Execute request
 	httpResp, err := c.httpClient.Do(httpReq)
 	duration := time.Since(startTime)
 
 	if err != nil {
-		c.logger.Error("http request failed",
+		c.circuits.record(service, err)
+		metrics.ObserveExternalRequest(service, req.Endpoint, 0, err, duration)
+		logger.Error("http request failed",
 			zap.String("service", c.serviceName),
+			zap.String("endpoint", req.Endpoint),
 			zap.String("method", req.Method),
 			zap.Duration("duration", duration),
+			zap.Duration("timeout", timeout),
+			zap.Bool("timed_out", errors.Is(err, context.DeadlineExceeded)),
 			zap.Error(err),
 		)
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer httpResp.Body.Close()
 
 	// Read response body
 	body, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		c.logger.Error("failed to read response body",
+		c.circuits.record(service, err)
+		logger.Error("failed to read response body",
 			zap.String("service", c.serviceName),
 			zap.String("method", req.Method),
 			zap.Duration("duration", duration),
 			zap.Error(err),
 		)
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
 	response := &Response{
@@ -213,10 +320,17 @@ func (c *Client) Do(ctx context.Context, req Request) (*Response, error) {
 		Duration:   duration,
 	}
 
+	metrics.ObserveExternalRequest(service, req.Endpoint, httpResp.StatusCode, nil, duration)
+	if httpResp.StatusCode >= http.StatusInternalServerError {
+		c.circuits.record(service, fmt.Errorf("HTTP %d", httpResp.StatusCode))
+	} else {
+		c.circuits.record(service, nil)
+	}
+
 	// Execute interceptors (after)
 	for _, interceptor := range c.interceptors {
 		if err := interceptor.After(ctx, httpResp, response); err != nil {
-			c.logger.Warn("interceptor after failed",
+			logger.Warn("interceptor after failed",
 				zap.String("service", c.serviceName),
 				zap.Error(err),
 			)
@@ -229,61 +343,79 @@ func (c *Client) Do(ctx context.Context, req Request) (*Response, error) {
 		logLevel = zap.ErrorLevel
 	}
 
-	c.logger.Log(logLevel, "http request completed",
+	logger.Log(logLevel, "http request completed",
 		zap.String("service", c.serviceName),
+		zap.String("endpoint", req.Endpoint),
 		zap.String("method", req.Method),
 		zap.Int("status_code", httpResp.StatusCode),
 		zap.Duration("duration", duration),
 		zap.Int("response_size", len(body)),
 	)
 
-	return response, nil
+	if threshold := c.slowThreshold(req); threshold > 0 && duration >= threshold {
+		logger.Warn("slow http request",
+			zap.String("service", c.serviceName),
+			zap.String("endpoint", req.Endpoint),
+			zap.String("method", req.Method),
+			zap.Duration("duration", duration),
+			zap.Duration("threshold", threshold),
+			zap.Duration("timeout", timeout),
+		)
+	}
+
+	return response, providedHeaders, nil
+}
+
+// Health returns what the client has seen of a service: its last successful and failed calls
+// and the state of its circuit breaker
+func (c *Client) Health(service string) ServiceHealth {
+	return c.circuits.health(service)
 }
 
 // Get performs a GET request
-func (c *Client) Get(ctx context.Context, url string, headers map[string]string) (*Response, error) {
-	return c.Do(ctx, Request{
+func (c *Client) Get(ctx context.Context, url string, headers map[string]string, opts ...RequestOption) (*Response, error) {
+	return c.Do(ctx, applyOptions(Request{
 		Method:  http.MethodGet,
 		URL:     url,
 		Headers: headers,
-	})
+	}, opts))
 }
 
 // Post performs a POST request
-func (c *Client) Post(ctx context.Context, url string, body io.Reader, headers map[string]string) (*Response, error) {
-	return c.Do(ctx, Request{
+func (c *Client) Post(ctx context.Context, url string, body io.Reader, headers map[string]string, opts ...RequestOption) (*Response, error) {
+	return c.Do(ctx, applyOptions(Request{
 		Method:  http.MethodPost,
 		URL:     url,
 		Body:    body,
 		Headers: headers,
-	})
+	}, opts))
 }
 
 // Put performs a PUT request
-func (c *Client) Put(ctx context.Context, url string, body io.Reader, headers map[string]string) (*Response, error) {
-	return c.Do(ctx, Request{
+func (c *Client) Put(ctx context.Context, url string, body io.Reader, headers map[string]string, opts ...RequestOption) (*Response, error) {
+	return c.Do(ctx, applyOptions(Request{
 		Method:  http.MethodPut,
 		URL:     url,
 		Body:    body,
 		Headers: headers,
-	})
+	}, opts))
 }
 
 // Delete performs a DELETE request
-func (c *Client) Delete(ctx context.Context, url string, headers map[string]string) (*Response, error) {
-	return c.Do(ctx, Request{
+func (c *Client) Delete(ctx context.Context, url string, headers map[string]string, opts ...RequestOption) (*Response, error) {
+	return c.Do(ctx, applyOptions(Request{
 		Method:  http.MethodDelete,
 		URL:     url,
 		Headers: headers,
-	})
+	}, opts))
 }
 
 // GetJSON performs a GET request and unmarshals JSON response
-func (c *Client) GetJSON(ctx context.Context, url string, result interface{}) error {
+func (c *Client) GetJSON(ctx context.Context, url string, result interface{}, opts ...RequestOption) error {
 	resp, err := c.Get(ctx, url, map[string]string{
 		"Content-Type": "application/json",
 		"Accept":       "application/json",
-	})
+	}, opts...)
 	if err != nil {
 		return err
 	}
@@ -302,7 +434,7 @@ func (c *Client) GetJSON(ctx context.Context, url string, result interface{}) er
 }
 
 // PostJSON performs a POST request with JSON body and unmarshals JSON response
-func (c *Client) PostJSON(ctx context.Context, url string, body interface{}, result interface{}) error {
+func (c *Client) PostJSON(ctx context.Context, url string, body interface{}, result interface{}, opts ...RequestOption) error {
 	var bodyReader io.Reader
 	if body != nil {
 		bodyBytes, err := json.Marshal(body)
@@ -315,7 +447,7 @@ func (c *Client) PostJSON(ctx context.Context, url string, body interface{}, res
 	resp, err := c.Post(ctx, url, bodyReader, map[string]string{
 		"Content-Type": "application/json",
 		"Accept":       "application/json",
-	})
+	}, opts...)
 	if err != nil {
 		return err
 	}
@@ -29,3 +29,45 @@ type HeaderProviderFunc func(ctx context.Context) (map[string]string, error)
 func (f HeaderProviderFunc) GetHeaders(ctx context.Context) (map[string]string, error) {
 	return f(ctx)
 }
+
+// RefreshableHeaderProvider is a HeaderProvider holding credentials the server may reject. After a
+// 401 the client calls Invalidate with the headers it sent and retries the request once.
+type RefreshableHeaderProvider interface {
+	HeaderProvider
+	Invalidate(rejected map[string]string)
+}
+
+// MultiHeaderProvider merges the headers of several providers; later providers win on conflicts
+type MultiHeaderProvider struct {
+	providers []HeaderProvider
+}
+
+// NewMultiHeaderProvider creates a provider combining the given providers
+func NewMultiHeaderProvider(providers ...HeaderProvider) *MultiHeaderProvider {
+	return &MultiHeaderProvider{
+		providers: providers,
+	}
+}
+
+func (p *MultiHeaderProvider) GetHeaders(ctx context.Context) (map[string]string, error) {
+	headers := make(map[string]string)
+	for _, provider := range p.providers {
+		provided, err := provider.GetHeaders(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range provided {
+			headers[key] = value
+		}
+	}
+	return headers, nil
+}
+
+// Invalidate forwards the rejected headers to every refreshable provider
+func (p *MultiHeaderProvider) Invalidate(rejected map[string]string) {
+	for _, provider := range p.providers {
+		if refreshable, ok := provider.(RefreshableHeaderProvider); ok {
+			refreshable.Invalidate(rejected)
+		}
+	}
+}
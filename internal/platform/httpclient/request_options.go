@@ -0,0 +1,75 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrDeadlineBudgetExhausted is returned when the caller's context deadline, less the safety
+// margin, leaves no time to make the request
+var ErrDeadlineBudgetExhausted = errors.New("deadline budget exhausted before request")
+
+// RequestOption overrides client defaults for a single request
+type RequestOption func(*Request)
+
+// WithTimeout overrides the client's default timeout; the caller's deadline still caps it
+func WithTimeout(timeout time.Duration) RequestOption {
+	return func(r *Request) { r.Timeout = timeout }
+}
+
+// WithSlowThreshold overrides the client's slow-request logging threshold
+func WithSlowThreshold(threshold time.Duration) RequestOption {
+	return func(r *Request) { r.SlowThreshold = threshold }
+}
+
+// WithEndpoint names the endpoint in request logs, e.g. "get_invoice"
+func WithEndpoint(name string) RequestOption {
+	return func(r *Request) { r.Endpoint = name }
+}
+
+// WithService names the service called in metrics, e.g. "iris_billing", when several
+// services share one client
+func WithService(name string) RequestOption {
+	return func(r *Request) { r.Service = name }
+}
+
+func applyOptions(req Request, opts []RequestOption) Request {
+	for _, opt := range opts {
+		opt(&req)
+	}
+	return req
+}
+
+// requestBudget returns how long the request may take: its timeout (or the client default),
+// shortened so it ends DeadlineMargin before the caller's context deadline
+func (c *Client) requestBudget(ctx context.Context, req Request) (time.Duration, error) {
+	timeout := req.Timeout
+	if timeout <= 0 {
+		timeout = c.timeout
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return timeout, nil
+	}
+	remaining := time.Until(deadline) - c.deadlineMargin
+	if remaining <= 0 {
+		return 0, ErrDeadlineBudgetExhausted
+	}
+	return min(timeout, remaining), nil
+}
+
+func (c *Client) slowThreshold(req Request) time.Duration {
+	if req.SlowThreshold > 0 {
+		return req.SlowThreshold
+	}
+	return c.slowRequestThreshold
+}
+
+func (c *Client) metricsService(req Request) string {
+	if req.Service != "" {
+		return req.Service
+	}
+	return c.serviceName
+}
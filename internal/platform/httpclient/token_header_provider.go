@@ -0,0 +1,167 @@
+package httpclient
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Token is an access token together with what is needed to renew it
+type Token struct {
+	AccessToken  string
+	TokenType    string // "Bearer" when empty
+	RefreshToken string // Optional; used before falling back to a new token
+	ExpiresAt    time.Time
+}
+
+// TokenSource issues access tokens, for example an OAuth client-credentials endpoint
+type TokenSource interface {
+	FetchToken(ctx context.Context) (Token, error)
+	RefreshToken(ctx context.Context, refreshToken string) (Token, error)
+}
+
+// TokenHeaderProvider adds an Authorization header with a cached access token.
+//
+// The token is renewed refreshBefore its expiry. Only one renewal runs at a time: callers holding a
+// token that has not yet expired keep using it meanwhile, callers without one wait for the result.
+type TokenHeaderProvider struct {
+	source        TokenSource
+	refreshBefore time.Duration
+	logger        *zap.Logger
+
+	mu      sync.Mutex
+	token   Token
+	renewal *tokenRenewal
+}
+
+// tokenRenewal is a renewal in flight; done is closed once token and err are set
+type tokenRenewal struct {
+	done  chan struct{}
+	token Token
+	err   error
+}
+
+// NewTokenHeaderProvider creates a header provider backed by the token source
+func NewTokenHeaderProvider(source TokenSource, refreshBefore time.Duration, logger *zap.Logger) *TokenHeaderProvider {
+	if refreshBefore <= 0 {
+		refreshBefore = 5 * time.Minute // Default: refresh 5 min before expiry
+	}
+
+	return &TokenHeaderProvider{
+		source:        source,
+		refreshBefore: refreshBefore,
+		logger:        logger,
+	}
+}
+
+// GetHeaders implements HeaderProvider
+func (p *TokenHeaderProvider) GetHeaders(ctx context.Context) (map[string]string, error) {
+	token, err := p.currentToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get access token: %w", err)
+	}
+
+	return map[string]string{
+		"Authorization": authorization(token),
+	}, nil
+}
+
+// Invalidate implements RefreshableHeaderProvider. The cached token is dropped only when it is the
+// one that was rejected, so concurrent 401s for the same token cause a single renewal.
+func (p *TokenHeaderProvider) Invalidate(rejected map[string]string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.token.AccessToken == "" || rejected["Authorization"] != authorization(p.token) {
+		return
+	}
+
+	p.logger.Info("access token rejected, renewing on next request")
+	p.token.AccessToken = ""
+	p.token.ExpiresAt = time.Time{}
+}
+
+// currentToken returns the cached token, starting a renewal when it is due
+func (p *TokenHeaderProvider) currentToken(ctx context.Context) (Token, error) {
+	p.mu.Lock()
+	token := p.token
+	now := time.Now()
+	if token.AccessToken != "" && now.Before(token.ExpiresAt.Add(-p.refreshBefore)) {
+		p.mu.Unlock()
+		return token, nil
+	}
+
+	renewal := p.renewal
+	if renewal == nil {
+		renewal = &tokenRenewal{done: make(chan struct{})}
+		p.renewal = renewal
+		// Detached from the caller so one cancelled request does not fail everyone waiting
+		go p.renew(context.WithoutCancel(ctx), renewal, token)
+	}
+	p.mu.Unlock()
+
+	// Still valid: keep using it while the renewal runs
+	if token.AccessToken != "" && now.Before(token.ExpiresAt) {
+		return token, nil
+	}
+
+	select {
+	case <-renewal.done:
+		return renewal.token, renewal.err
+	case <-ctx.Done():
+		return Token{}, ctx.Err()
+	}
+}
+
+// renew replaces the cached token, trying its refresh token before asking for a new one
+func (p *TokenHeaderProvider) renew(ctx context.Context, renewal *tokenRenewal, current Token) {
+	var (
+		token Token
+		err   error
+	)
+	if current.RefreshToken != "" {
+		p.logger.Info("refreshing access token", zap.Time("expires_at", current.ExpiresAt))
+		token, err = p.source.RefreshToken(ctx, current.RefreshToken)
+		if err != nil {
+			p.logger.Warn("access token refresh failed, requesting a new token", zap.Error(err))
+		}
+	}
+	if current.RefreshToken == "" || err != nil {
+		p.logger.Info("fetching new access token")
+		token, err = p.source.FetchToken(ctx)
+	}
+
+	p.mu.Lock()
+	switch {
+	case err == nil:
+		if token.RefreshToken == "" {
+			token.RefreshToken = current.RefreshToken
+		}
+		p.token = token
+		p.logger.Info("access token renewed", zap.Time("expires_at", token.ExpiresAt))
+	case current.AccessToken != "" && time.Now().Before(current.ExpiresAt):
+		p.logger.Warn("access token renewal failed, keeping current token until it expires",
+			zap.Time("expires_at", current.ExpiresAt),
+			zap.Error(err),
+		)
+	default:
+		p.logger.Error("access token renewal failed", zap.Error(err))
+	}
+	p.renewal = nil
+	p.mu.Unlock()
+
+	renewal.token, renewal.err = token, err
+	close(renewal.done)
+}
+
+// authorization formats the Authorization header value for a token
+func authorization(token Token) string {
+	tokenType := token.TokenType
+	if tokenType == "" {
+		tokenType = "Bearer"
+	}
+	return tokenType + " " + token.AccessToken
+}
@@ -1,19 +1,25 @@
 package httpx
 
 import (
+	"encoding/json"
 	"errors"
-	"fmt"
 	"net/http"
 	"strings"
 
 	"go.uber.org/zap"
 
 	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/logging"
+	"pharmacy-modernization-project-model/internal/platform/pagination"
 )
 
 // ErrorHandler provides centralized error handling for HTTP responses
 type ErrorHandler struct {
-	logger *zap.Logger
+	logger        *zap.Logger
+	requestID     string
+	correlationID string
+	problem       bool   // Write problem details instead of APIError
+	instance      string // Path of the request, the instance of its problem details
 }
 
 // NewErrorHandler creates a new error handler
@@ -23,11 +29,26 @@ func NewErrorHandler(logger *zap.Logger) *ErrorHandler {
 	}
 }
 
+// NewRequestErrorHandler creates an error handler that echoes the request's
+// request and correlation IDs in every error response. The response is problem
+// details when the request accepts application/problem+json or Middleware enables them.
+func NewRequestErrorHandler(r *http.Request) *ErrorHandler {
+	return &ErrorHandler{
+		logger:        logging.FromContext(r.Context()),
+		requestID:     logging.GetRequestID(r.Context()),
+		correlationID: logging.GetCorrelationID(r.Context()),
+		problem:       wantsProblem(r),
+		instance:      r.URL.Path,
+	}
+}
+
 // APIError represents a standardized API error response
 type APIError struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-	Details string `json:"details,omitempty"`
+	Code          string `json:"code"`
+	Message       string `json:"message"`
+	Details       string `json:"details"`
+	RequestID     string `json:"request_id,omitempty"`
+	CorrelationID string `json:"correlation_id,omitempty"`
 }
 
 // HandleError handles different types of errors and returns appropriate HTTP responses
@@ -73,6 +94,19 @@ func (eh *ErrorHandler) HandleError(w http.ResponseWriter, err error) {
 			Details: duplicateErr.Type,
 		})
 
+	// Handle ConflictError
+	case func() bool {
+		var conflictErr platformErrors.ConflictError
+		return errors.As(err, &conflictErr)
+	}():
+		var conflictErr platformErrors.ConflictError
+		errors.As(err, &conflictErr)
+		eh.writeError(w, http.StatusConflict, APIError{
+			Code:    "conflict",
+			Message: conflictErr.Error(),
+			Details: conflictErr.Type,
+		})
+
 	// Handle BusinessLogicError
 	case func() bool {
 		var businessErr platformErrors.BusinessLogicError
@@ -138,6 +172,19 @@ func (eh *ErrorHandler) HandleError(w http.ResponseWriter, err error) {
 			Details: rateLimitErr.Resource,
 		})
 
+	// Handle rejected pagination cursors
+	case func() bool {
+		var cursorErr pagination.CursorError
+		return errors.As(err, &cursorErr)
+	}():
+		var cursorErr pagination.CursorError
+		errors.As(err, &cursorErr)
+		eh.writeError(w, http.StatusBadRequest, APIError{
+			Code:    cursorErr.Code,
+			Message: cursorErr.Reason,
+			Details: pagination.CursorArgument,
+		})
+
 	// Handle common domain errors
 	case errors.Is(err, platformErrors.ErrIDRequired):
 		eh.writeError(w, http.StatusBadRequest, APIError{
@@ -208,25 +255,36 @@ func (eh *ErrorHandler) HandleError(w http.ResponseWriter, err error) {
 
 // writeError writes an error response to the HTTP response writer
 func (eh *ErrorHandler) writeError(w http.ResponseWriter, statusCode int, apiError APIError) {
+	apiError.RequestID = eh.requestID
+	apiError.CorrelationID = eh.correlationID
+	if eh.requestID != "" {
+		w.Header().Set(logging.RequestIDHeader, eh.requestID)
+	}
+	if eh.correlationID != "" {
+		w.Header().Set(logging.CorrelationIDHeader, eh.correlationID)
+	}
+
+	w.Header().Add("Vary", "Accept")
+
+	if eh.problem {
+		w.Header().Set("Content-Type", ProblemContentType)
+		w.WriteHeader(statusCode)
+		_ = json.NewEncoder(w).Encode(newProblem(statusCode, apiError, eh.instance))
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
-
-	// Simple JSON response (in a real app, you'd use a JSON encoder)
-	jsonResponse := fmt.Sprintf(`{"code":"%s","message":"%s","details":"%s"}`,
-		apiError.Code, apiError.Message, apiError.Details)
-	w.Write([]byte(jsonResponse))
+	_ = json.NewEncoder(w).Encode(apiError)
 }
 
 // WriteError is a convenience function for writing error responses
 func WriteError(w http.ResponseWriter, r *http.Request, err error) {
-	// Extract logger from request context if available
-	logger := zap.NewNop() // Default no-op logger
-	if ctxLogger := r.Context().Value("logger"); ctxLogger != nil {
-		if l, ok := ctxLogger.(*zap.Logger); ok {
-			logger = l
-		}
-	}
+	// Uses the request-scoped logger and IDs set by the logging middleware
+	NewRequestErrorHandler(r).HandleError(w, err)
+}
 
-	errorHandler := NewErrorHandler(logger)
-	errorHandler.HandleError(w, err)
+// WriteAPIError writes an error response whose status and code no platform error type maps to
+func WriteAPIError(w http.ResponseWriter, r *http.Request, statusCode int, apiError APIError) {
+	NewRequestErrorHandler(r).writeError(w, statusCode, apiError)
 }
@@ -0,0 +1,93 @@
+package httpx
+
+import (
+	"context"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ProblemContentType is the media type of RFC 7807 problem details
+const ProblemContentType = "application/problem+json"
+
+// problemTypePrefix makes an error code a problem type URI, e.g. urn:rx:error:record_not_found
+const problemTypePrefix = "urn:rx:error:"
+
+// Problem is an error response in the RFC 7807 problem details format. Code, Details, RequestID
+// and CorrelationID are extension members carrying what APIError carries.
+type Problem struct {
+	Type          string `json:"type"`
+	Title         string `json:"title"`
+	Status        int    `json:"status"`
+	Detail        string `json:"detail,omitempty"`
+	Instance      string `json:"instance,omitempty"`
+	Code          string `json:"code"`
+	Details       string `json:"details,omitempty"`
+	RequestID     string `json:"request_id,omitempty"`
+	CorrelationID string `json:"correlation_id,omitempty"`
+}
+
+// newProblem returns the problem details of an APIError
+func newProblem(statusCode int, apiError APIError, instance string) Problem {
+	return Problem{
+		Type:          problemTypePrefix + apiError.Code,
+		Title:         http.StatusText(statusCode),
+		Status:        statusCode,
+		Detail:        apiError.Message,
+		Instance:      instance,
+		Code:          apiError.Code,
+		Details:       apiError.Details,
+		RequestID:     apiError.RequestID,
+		CorrelationID: apiError.CorrelationID,
+	}
+}
+
+// Config sets the format of error responses
+type Config struct {
+	// ProblemDetails answers every error with application/problem+json; without it only requests
+	// whose Accept header names that media type get it
+	ProblemDetails bool
+}
+
+type problemDetailsKey struct{}
+
+// Middleware applies the config to the error responses of the routes registered after it
+func Middleware(cfg Config) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if cfg.ProblemDetails {
+				r = r.WithContext(context.WithValue(r.Context(), problemDetailsKey{}, true))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// wantsProblem reports whether the request's errors are written as problem details
+func wantsProblem(r *http.Request) bool {
+	if enabled, _ := r.Context().Value(problemDetailsKey{}).(bool); enabled {
+		return true
+	}
+	return acceptsProblem(r.Header.Values("Accept"))
+}
+
+// acceptsProblem reports whether an Accept media type is application/problem+json
+func acceptsProblem(accept []string) bool {
+	for _, header := range accept {
+		for _, part := range strings.Split(header, ",") {
+			mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+			if err != nil || mediaType != ProblemContentType {
+				continue
+			}
+			// q=0 declines the media type
+			if q, ok := params["q"]; ok {
+				if weight, err := strconv.ParseFloat(q, 64); err != nil || weight <= 0 {
+					continue
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
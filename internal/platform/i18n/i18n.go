@@ -0,0 +1,169 @@
+// Package i18n translates the messages users see: validation errors of the REST, GraphQL and UI
+// forms, and the strings of the templ pages. Messages live in one catalog per locale
+// (locales/*.yaml), keyed by dotted names such as validation.required. The locale of a request
+// is the one the user chose in the UI, else the user's preference, else the best match of the
+// Accept-Language header, else the configured default.
+package i18n
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Supported locales
+const (
+	English = "en"
+	Spanish = "es"
+)
+
+// DefaultLocale is the locale of messages when nothing else applies; its catalog is the
+// reference every other catalog must match
+const DefaultLocale = English
+
+//go:embed locales/*.yaml
+var localeFiles embed.FS
+
+// catalogs holds the messages of each locale by key
+var catalogs = mustLoadCatalogs()
+
+func mustLoadCatalogs() map[string]map[string]string {
+	loaded, err := loadCatalogs()
+	if err != nil {
+		panic(err)
+	}
+	return loaded
+}
+
+func loadCatalogs() (map[string]map[string]string, error) {
+	files, err := localeFiles.ReadDir("locales")
+	if err != nil {
+		return nil, err
+	}
+	loaded := make(map[string]map[string]string, len(files))
+	for _, file := range files {
+		data, err := localeFiles.ReadFile(path.Join("locales", file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		messages := map[string]string{}
+		if err := yaml.Unmarshal(data, &messages); err != nil {
+			return nil, fmt.Errorf("i18n: catalog %s: %w", file.Name(), err)
+		}
+		loaded[strings.TrimSuffix(file.Name(), path.Ext(file.Name()))] = messages
+	}
+	if _, ok := loaded[DefaultLocale]; !ok {
+		return nil, fmt.Errorf("i18n: no catalog for the default locale %s", DefaultLocale)
+	}
+	return loaded, nil
+}
+
+// Locales returns the locales with a catalog, sorted
+func Locales() []string {
+	locales := make([]string, 0, len(catalogs))
+	for locale := range catalogs {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// Supported reports whether the locale has a catalog
+func Supported(locale string) bool {
+	_, ok := catalogs[locale]
+	return ok
+}
+
+// Messages returns the catalog of a locale, or nil when it has none; the lint reads it
+func Messages(locale string) map[string]string {
+	return catalogs[locale]
+}
+
+// Translate returns the message of the key in the locale, filled with args. A key missing from
+// the locale's catalog falls back to the default locale, then to the key itself, so a missing
+// translation shows up rather than an empty string.
+func Translate(locale, key string, args ...any) string {
+	message, ok := catalogs[locale][key]
+	if !ok {
+		message, ok = catalogs[DefaultLocale][key]
+	}
+	if !ok {
+		return key
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(message, args...)
+	}
+	return message
+}
+
+// T returns the message of the key in the locale of the request, filled with args. Keys are
+// string literals so the lint can check every one has a message.
+func T(ctx context.Context, key string, args ...any) string {
+	return Translate(Locale(ctx), key, args...)
+}
+
+// Key marks a string literal as a message key translated later, e.g. a navigation label, so the
+// lint checks it like the keys given to T
+func Key(key string) string {
+	return key
+}
+
+type contextKey string
+
+const (
+	requestLocaleKey contextKey = "i18n_request_locale"
+	userLocaleKey    contextKey = "i18n_user_locale"
+)
+
+// requestLocale is what the request says about its locale
+type requestLocale struct {
+	chosen   string // Chosen in the UI, remembered in the locale cookie
+	accepted string // Best supported match of Accept-Language
+	fallback string // Configured default
+}
+
+// WithUserLocale records the authenticated user's preferred locale; unsupported locales are
+// ignored
+func WithUserLocale(ctx context.Context, locale string) context.Context {
+	if !Supported(locale) {
+		return ctx
+	}
+	return context.WithValue(ctx, userLocaleKey, locale)
+}
+
+// Locale returns the locale messages are shown in. Outside a request, e.g. in jobs, it is the
+// default locale.
+func Locale(ctx context.Context) string {
+	request, _ := ctx.Value(requestLocaleKey).(requestLocale)
+	if request.chosen != "" {
+		return request.chosen
+	}
+	if user, ok := ctx.Value(userLocaleKey).(string); ok {
+		return user
+	}
+	for _, locale := range []string{request.accepted, request.fallback} {
+		if locale != "" {
+			return locale
+		}
+	}
+	return DefaultLocale
+}
+
+// withRequestLocale stores what the request says about its locale
+func withRequestLocale(ctx context.Context, request requestLocale) context.Context {
+	return context.WithValue(ctx, requestLocaleKey, request)
+}
+
+// matchLocale returns the supported locale of a language tag such as es-MX, or ""
+func matchLocale(tag string) string {
+	base, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+	if Supported(base) {
+		return base
+	}
+	return ""
+}
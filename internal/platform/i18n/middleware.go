@@ -0,0 +1,90 @@
+package i18n
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// LocaleCookie remembers the locale chosen in the UI
+	LocaleCookie = "locale"
+	// LocaleParam chooses a locale for this and later requests, e.g. ?lang=es
+	LocaleParam = "lang"
+
+	localeCookieMaxAge = 365 * 24 * 60 * 60
+)
+
+// Config sets the locale of requests that do not say which one they want
+type Config struct {
+	DefaultLocale string // A locale with a catalog; DefaultLocale when empty
+}
+
+// Middleware detects the locale of each request. A supported ?lang= parameter is remembered in
+// the locale cookie, so the choice made in the UI sticks; the user's preference is added to the
+// context once the user is authenticated.
+func Middleware(cfg Config) func(http.Handler) http.Handler {
+	fallback := cfg.DefaultLocale
+	if !Supported(fallback) {
+		fallback = DefaultLocale
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			request := requestLocale{
+				accepted: AcceptedLocale(r.Header.Get("Accept-Language")),
+				fallback: fallback,
+			}
+			if chosen := r.URL.Query().Get(LocaleParam); Supported(chosen) {
+				request.chosen = chosen
+				http.SetCookie(w, &http.Cookie{
+					Name:     LocaleCookie,
+					Value:    chosen,
+					Path:     "/",
+					MaxAge:   localeCookieMaxAge,
+					HttpOnly: true,
+					SameSite: http.SameSiteLaxMode,
+				})
+			} else if cookie, err := r.Cookie(LocaleCookie); err == nil && Supported(cookie.Value) {
+				request.chosen = cookie.Value
+			}
+
+			w.Header().Add("Vary", "Accept-Language")
+			next.ServeHTTP(w, r.WithContext(withRequestLocale(r.Context(), request)))
+		})
+	}
+}
+
+// AcceptedLocale returns the supported locale the Accept-Language header prefers most, or ""
+// when it names none. Regional tags match their language, so es-MX is served es.
+func AcceptedLocale(header string) string {
+	type weighted struct {
+		tag    string
+		weight float64
+	}
+	var tags []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		weight := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			weight = parsed
+		}
+		if weight > 0 {
+			tags = append(tags, weighted{tag: tag, weight: weight})
+		}
+	}
+	// Stable, so tags of equal weight keep the order the client gave them
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].weight > tags[j].weight })
+
+	for _, t := range tags {
+		if locale := matchLocale(t.tag); locale != "" {
+			return locale
+		}
+	}
+	return ""
+}
@@ -0,0 +1,59 @@
+package i18n
+
+import "context"
+
+// ValidationMessage returns the message of a failed validation rule, given by its validator tag
+// and parameter, e.g. min and 2
+func ValidationMessage(ctx context.Context, tag, param string) string {
+	switch tag {
+	case "required", "required_if":
+		return T(ctx, "validation.required")
+	case "min":
+		if param != "" {
+			return T(ctx, "validation.min", param)
+		}
+		return T(ctx, "validation.too_short")
+	case "max":
+		if param != "" {
+			return T(ctx, "validation.max", param)
+		}
+		return T(ctx, "validation.too_long")
+	case "len":
+		if param != "" {
+			return T(ctx, "validation.len", param)
+		}
+		return T(ctx, "validation.incorrect_length")
+	case "oneof":
+		if param != "" {
+			return T(ctx, "validation.oneof", param)
+		}
+		return T(ctx, "validation.not_allowed")
+	case "email":
+		return T(ctx, "validation.email")
+	case "numeric":
+		return T(ctx, "validation.numeric")
+	case "dob":
+		return T(ctx, "validation.dob")
+	case "npi":
+		return T(ctx, "validation.npi")
+	case "alphanum":
+		return T(ctx, "validation.alphanum")
+	case "unknown":
+		return T(ctx, "validation.unknown")
+	default:
+		return T(ctx, "validation.invalid")
+	}
+}
+
+// LengthValidationMessage is ValidationMessage for text fields, whose min and max count
+// characters
+func LengthValidationMessage(ctx context.Context, tag, param string) string {
+	switch {
+	case tag == "min" && param != "":
+		return T(ctx, "validation.min_length", param)
+	case tag == "max" && param != "":
+		return T(ctx, "validation.max_length", param)
+	default:
+		return ValidationMessage(ctx, tag, param)
+	}
+}
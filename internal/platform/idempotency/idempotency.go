@@ -0,0 +1,68 @@
+// Package idempotency lets clients retry mutating REST requests safely. A POST or PUT sent with
+// an X-Idempotency-Key header runs once per user and key; a retry with the same key gets the
+// stored response replayed instead of running the handler again.
+//
+// Keys are scoped to the authenticated user, so two clients cannot see each other's responses by
+// choosing the same key. Reusing a key for a different request is refused, as is a retry that
+// arrives while the first request is still being handled.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"time"
+)
+
+// Headers of the idempotency protocol
+const (
+	HeaderKey      = "X-Idempotency-Key"
+	HeaderReplayed = "Idempotent-Replayed" // "true" on responses replayed from the store
+)
+
+// MaxKeyLength is the longest accepted idempotency key; UUIDs and request hashes fit easily
+const MaxKeyLength = 255
+
+// replayedHeaders are the response headers stored with a record and replayed with it
+var replayedHeaders = []string{"Content-Type", "Location"}
+
+// Record is the first request sent with a key and, once handled, its response
+type Record struct {
+	ID          string            `bson:"_id"` // Hash of the user and the key
+	UserID      string            `bson:"user_id"`
+	Method      string            `bson:"method"`
+	Path        string            `bson:"path"`
+	RequestHash string            `bson:"request_hash"` // Hash of the method, path, query and body
+	Completed   bool              `bson:"completed"`    // False while the first request is being handled
+	Status      int               `bson:"status,omitempty"`
+	Header      map[string]string `bson:"header,omitempty"`
+	Body        []byte            `bson:"body,omitempty"`
+	CreatedAt   time.Time         `bson:"created_at"`
+	ExpiresAt   time.Time         `bson:"expires_at"`
+}
+
+// Store keeps idempotency records until they expire
+type Store interface {
+	// Reserve saves a pending record unless its key already has one that has not expired; that
+	// record is returned instead and nothing is saved
+	Reserve(ctx context.Context, record Record) (*Record, error)
+	// Complete stores the response of a reserved key, replacing the pending record
+	Complete(ctx context.Context, record Record) error
+	// Release removes a pending record so the request can be sent again, e.g. after a server error
+	Release(ctx context.Context, id string) error
+}
+
+// recordID scopes a key to the user sending it
+func recordID(userID, key string) string {
+	sum := sha256.Sum256([]byte(userID + "\x00" + key))
+	return hex.EncodeToString(sum[:])
+}
+
+// requestHash identifies what a request asks for, so a key reused for another request is noticed
+func requestHash(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + "\x00" + r.URL.Path + "\x00" + r.URL.RawQuery + "\x00"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
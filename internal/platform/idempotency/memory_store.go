@@ -0,0 +1,61 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps idempotency records in process memory; used when MongoDB is not configured.
+// Records only protect retries that reach the same instance.
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[string]Record
+}
+
+// NewMemoryStore creates an empty in-memory idempotency store
+func NewMemoryStore() Store {
+	return &MemoryStore{records: make(map[string]Record)}
+}
+
+func (s *MemoryStore) Reserve(_ context.Context, record Record) (*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.purgeExpired(now)
+	if existing, ok := s.records[record.ID]; ok {
+		return &existing, nil
+	}
+	s.records[record.ID] = record
+	return nil, nil
+}
+
+func (s *MemoryStore) Complete(_ context.Context, record Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, ok := s.records[record.ID]; ok && !existing.Completed {
+		s.records[record.ID] = record
+	}
+	return nil
+}
+
+func (s *MemoryStore) Release(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, ok := s.records[id]; ok && !existing.Completed {
+		delete(s.records, id)
+	}
+	return nil
+}
+
+// purgeExpired drops expired records; the caller holds the lock
+func (s *MemoryStore) purgeExpired(now time.Time) {
+	for id, record := range s.records {
+		if !record.ExpiresAt.After(now) {
+			delete(s.records, id)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/bind"
+	"pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+	"pharmacy-modernization-project-model/internal/platform/logging"
+)
+
+// apiPrefix limits idempotency to the REST API; pages and the login endpoints are left alone
+const apiPrefix = "/api/"
+
+// Config controls how long keys are kept
+type Config struct {
+	TTL            time.Duration // How long a response is replayed for retries
+	PendingTimeout time.Duration // How long a key stays locked while its first request runs
+	MaxBodyBytes   int64         // Requests with larger bodies are handled without idempotency
+}
+
+// Middleware replays stored responses for POST and PUT requests to the REST API that carry an
+// X-Idempotency-Key. It runs before the per-route authentication, so the caller is identified
+// from the bearer token the API routes require; anonymous requests pass through and are
+// rejected further down.
+//
+// Responses are stored unless they are server errors or depend on the moment they were sent
+// (401, 403, 408, 409, 429); those release the key so the request can be retried. When the store
+// cannot be reached requests are handled as if they carried no key.
+func Middleware(store Store, cfg Config, logger *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(HeaderKey)
+			if key == "" || !applies(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if !validKey(key) {
+				httpx.WriteError(w, r, platformErrors.NewValidationError(HeaderKey, nil,
+					"must be at most "+strconv.Itoa(MaxKeyLength)+" printable ASCII characters"))
+				return
+			}
+			user, ok := auth.IdentifyRequest(r, auth.TokenSourceHeader)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			log := logging.WithContext(r.Context(), logger)
+
+			body, complete, err := readBody(r, cfg.MaxBodyBytes)
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				helper.Respond400(w, []bind.FieldError{bind.TooLarge(tooLarge.Limit)})
+				return
+			}
+			if err != nil {
+				httpx.WriteError(w, r, platformErrors.NewValidationError("body", nil, "failed to read request body"))
+				return
+			}
+			if !complete {
+				log.Debug("Request body too large for idempotency, handling without it", zap.String("path", r.URL.Path))
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			userID := user.ID
+			if userID == "" {
+				userID = user.Email
+			}
+			now := time.Now()
+			record := Record{
+				ID:          recordID(userID, key),
+				UserID:      userID,
+				Method:      r.Method,
+				Path:        r.URL.Path,
+				RequestHash: requestHash(r, body),
+				CreatedAt:   now,
+				ExpiresAt:   now.Add(cfg.PendingTimeout),
+			}
+
+			existing, err := store.Reserve(r.Context(), record)
+			if err != nil {
+				var conflict platformErrors.ConflictError
+				if errors.As(err, &conflict) {
+					httpx.WriteError(w, r, err)
+					return
+				}
+				log.Error("Idempotency store unavailable, handling request without it", zap.Error(err))
+				next.ServeHTTP(w, r)
+				return
+			}
+			if existing != nil {
+				respondExisting(w, r, existing, record, key)
+				return
+			}
+
+			// Store writes must outlive a request cancelled by the timeout middleware
+			storeCtx := context.WithoutCancel(r.Context())
+			recorder := &responseRecorder{ResponseWriter: w}
+			defer func() {
+				if p := recover(); p != nil {
+					_ = store.Release(storeCtx, record.ID)
+					panic(p)
+				}
+			}()
+			next.ServeHTTP(recorder, r)
+
+			status := recorder.statusCode()
+			if !storable(status) {
+				if err := store.Release(storeCtx, record.ID); err != nil {
+					log.Warn("Failed to release idempotency key", zap.Error(err))
+				}
+				return
+			}
+			record.Completed = true
+			record.Status = status
+			record.Header = make(map[string]string)
+			for _, name := range replayedHeaders {
+				if value := recorder.Header().Get(name); value != "" {
+					record.Header[name] = value
+				}
+			}
+			record.Body = recorder.body.Bytes()
+			record.ExpiresAt = time.Now().Add(cfg.TTL)
+			if err := store.Complete(storeCtx, record); err != nil {
+				log.Warn("Failed to store idempotent response, a retry will run the request again", zap.Error(err))
+			}
+		})
+	}
+}
+
+// respondExisting answers a request whose key was used before
+func respondExisting(w http.ResponseWriter, r *http.Request, existing *Record, record Record, key string) {
+	switch {
+	case existing.RequestHash != record.RequestHash:
+		httpx.WriteError(w, r, platformErrors.NewBusinessLogicError("idempotency",
+			HeaderKey+" was already used for a different request"))
+	case !existing.Completed:
+		w.Header().Set("Retry-After", "1")
+		httpx.WriteError(w, r, platformErrors.NewConflictError("idempotency_key", key,
+			"a request with this key is still being processed"))
+	default:
+		for name, value := range existing.Header {
+			w.Header().Set(name, value)
+		}
+		w.Header().Set(HeaderReplayed, "true")
+		w.WriteHeader(existing.Status)
+		_, _ = w.Write(existing.Body)
+	}
+}
+
+// applies reports whether the request is a mutating REST call
+func applies(r *http.Request) bool {
+	return (r.Method == http.MethodPost || r.Method == http.MethodPut) && strings.HasPrefix(r.URL.Path, apiPrefix)
+}
+
+func validKey(key string) bool {
+	if len(key) > MaxKeyLength {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < 0x20 || key[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// readBody buffers the request body for hashing and restores it for the handler. complete is
+// false when the body exceeds limit; the handler then still receives all of it.
+func readBody(r *http.Request, limit int64) (body []byte, complete bool, err error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, true, nil
+	}
+	body, err = io.ReadAll(io.LimitReader(r.Body, limit+1))
+	if err != nil {
+		return nil, false, err
+	}
+	if int64(len(body)) > limit {
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+		return nil, false, nil
+	}
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return body, true, nil
+}
+
+// storable reports whether a response may be replayed for retries
+func storable(status int) bool {
+	switch status {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusRequestTimeout,
+		http.StatusConflict, http.StatusTooManyRequests:
+		return false
+	}
+	return status < http.StatusInternalServerError
+}
+
+// responseRecorder passes the response through while keeping a copy to store
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (rr *responseRecorder) WriteHeader(status int) {
+	if rr.status == 0 {
+		rr.status = status
+	}
+	rr.ResponseWriter.WriteHeader(status)
+}
+
+func (rr *responseRecorder) Write(b []byte) (int, error) {
+	if rr.status == 0 {
+		rr.status = http.StatusOK
+	}
+	rr.body.Write(b)
+	return rr.ResponseWriter.Write(b)
+}
+
+func (rr *responseRecorder) statusCode() int {
+	if rr.status == 0 {
+		return http.StatusOK
+	}
+	return rr.status
+}
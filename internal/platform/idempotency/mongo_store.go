@@ -0,0 +1,80 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// MongoStore keeps idempotency records in a MongoDB collection shared by all instances. A TTL
+// index removes records once they expire.
+type MongoStore struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewMongoStore creates a MongoDB-backed idempotency store and ensures its TTL index
+func NewMongoStore(collection *mongo.Collection, logger *zap.Logger) Store {
+	store := &MongoStore{collection: collection, logger: logger}
+	if err := store.ensureIndexes(); err != nil {
+		logger.Warn("Failed to create idempotency key indexes", zap.Error(err))
+	}
+	return store
+}
+
+func (s *MongoStore) ensureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := s.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetName("expires_at_ttl").SetExpireAfterSeconds(0),
+	})
+	return err
+}
+
+func (s *MongoStore) Reserve(ctx context.Context, record Record) (*Record, error) {
+	// Only an expired record may be replaced; while a live one exists the filter misses and the
+	// upsert collides on _id. The TTL monitor runs once a minute, so expiry is checked here too.
+	filter := bson.M{"_id": record.ID, "expires_at": bson.M{"$lte": time.Now()}}
+	_, err := s.collection.ReplaceOne(ctx, filter, record, options.Replace().SetUpsert(true))
+	if err == nil {
+		return nil, nil
+	}
+	if !mongo.IsDuplicateKeyError(err) {
+		return nil, platformErrors.HandleMongoError("ReserveIdempotencyKey", err)
+	}
+
+	var existing Record
+	if err := s.collection.FindOne(ctx, bson.M{"_id": record.ID}).Decode(&existing); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			// Released between the two calls; the client may simply retry
+			return nil, platformErrors.NewConflictError("idempotency_key", record.ID, "key was released concurrently")
+		}
+		return nil, platformErrors.HandleMongoError("ReserveIdempotencyKey", err)
+	}
+	return &existing, nil
+}
+
+func (s *MongoStore) Complete(ctx context.Context, record Record) error {
+	_, err := s.collection.ReplaceOne(ctx, bson.M{"_id": record.ID, "completed": false}, record)
+	if err != nil {
+		s.logger.Error("Failed to store idempotent response", zap.String("path", record.Path), zap.Error(err))
+		return platformErrors.HandleMongoError("CompleteIdempotencyKey", err)
+	}
+	return nil
+}
+
+func (s *MongoStore) Release(ctx context.Context, id string) error {
+	if _, err := s.collection.DeleteOne(ctx, bson.M{"_id": id, "completed": false}); err != nil {
+		return platformErrors.HandleMongoError("ReleaseIdempotencyKey", err)
+	}
+	return nil
+}
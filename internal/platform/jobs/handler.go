@@ -0,0 +1,78 @@
+package jobs
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+	"pharmacy-modernization-project-model/internal/platform/paths"
+	"pharmacy-modernization-project-model/internal/platform/permissions"
+	"pharmacy-modernization-project-model/internal/platform/tenancy"
+)
+
+// AnyJobAccess - admins can read every job; other users only the jobs they enqueued
+var AnyJobAccess = []string{permissions.AdminAll}
+
+// JobPathVars represents the path parameter of job endpoints
+type JobPathVars struct {
+	JobID string `path:"jobID" validate:"required,min=1"`
+}
+
+// Handler serves the status of queued jobs
+type Handler struct {
+	queue *Queue
+	log   *zap.Logger
+}
+
+func NewHandler(queue *Queue, log *zap.Logger) *Handler {
+	return &Handler{queue: queue, log: log}
+}
+
+// RegisterRoutes mounts the job status API under /api/jobs
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route(paths.JobQueueAPIPath, func(router chi.Router) {
+		router.Use(auth.RequireAuthFromHeader())
+
+		router.Get("/{jobID}", h.GetByID)
+	})
+}
+
+// GetByID returns the status, attempts and last error of a job. Jobs of other users are
+// reported as missing rather than forbidden.
+func (h *Handler) GetByID(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[JobPathVars](r, chi.URLParam)
+	if err != nil {
+		h.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	job, err := h.queue.Get(r.Context(), pathVars.JobID)
+	if err != nil {
+		httpx.WriteError(w, r, err)
+		return
+	}
+	if !visible(r, job) {
+		httpx.WriteError(w, r, platformErrors.NewRecordNotFoundError("job", pathVars.JobID))
+		return
+	}
+	helper.WriteOK(w, job)
+}
+
+func visible(r *http.Request, job Job) bool {
+	ctx := r.Context()
+	if !tenancy.Visible(ctx, job.OrgID) {
+		return false
+	}
+	if auth.HasAnyPermissionCtx(ctx, AnyJobAccess) {
+		return true
+	}
+	user, err := auth.GetCurrentUser(ctx)
+	return err == nil && job.CreatedBy != "" && job.CreatedBy == user.ID
+}
@@ -0,0 +1,104 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Status is where a job is in the queue
+type Status string
+
+const (
+	StatusQueued    Status = "queued"    // Waiting for a worker, or for its next attempt
+	StatusRunning   Status = "running"   // Claimed by a worker until LockedUntil
+	StatusSucceeded Status = "succeeded" // Its handler returned nil
+	StatusFailed    Status = "failed"    // Out of attempts, or failed permanently
+)
+
+// Job is one unit of background work, as kept in the jobs collection
+type Job struct {
+	ID   string `json:"id" bson:"_id"`
+	Type string `json:"type" bson:"type"`
+	// Payload is the handler's input, decoded into the type its handler was registered with
+	Payload     bson.Raw   `json:"-" bson:"payload"`
+	Priority    int        `json:"priority" bson:"priority"` // Higher runs first
+	Status      Status     `json:"status" bson:"status"`
+	Attempts    int        `json:"attempts" bson:"attempts"`
+	MaxAttempts int        `json:"max_attempts" bson:"max_attempts"`
+	RunAt       time.Time  `json:"run_at" bson:"run_at"` // Not claimed before this time
+	LockedBy    string     `json:"-" bson:"locked_by,omitempty"`
+	LockedUntil *time.Time `json:"-" bson:"locked_until,omitempty"` // Visibility timeout of a running job
+	LastError   string     `json:"last_error,omitempty" bson:"last_error,omitempty"`
+	CreatedBy   string     `json:"created_by,omitempty" bson:"created_by,omitempty"` // User ID of the enqueuer
+	OrgID       string     `json:"-" bson:"org_id,omitempty"`
+	CreatedAt   time.Time  `json:"created_at" bson:"created_at"`
+	StartedAt   *time.Time `json:"started_at,omitempty" bson:"started_at,omitempty"` // Of the latest attempt
+	FinishedAt  *time.Time `json:"finished_at,omitempty" bson:"finished_at,omitempty"`
+}
+
+// Finished reports whether the job will not run again
+func (j Job) Finished() bool {
+	return j.Status == StatusSucceeded || j.Status == StatusFailed
+}
+
+// EnqueueOptions controls how a job is queued; zero values take the queue's defaults
+type EnqueueOptions struct {
+	Priority    int
+	Delay       time.Duration // Time before the first attempt
+	MaxAttempts int
+}
+
+// Store persists the queue. Claim and Release are conditional, so several instances can share
+// one store: a job is handed to one worker at a time, and a worker that lost its claim, because
+// the visibility timeout expired and another worker took the job, cannot overwrite it.
+type Store interface {
+	// Enqueue inserts a new job
+	Enqueue(ctx context.Context, job Job) error
+	// Claim takes the queued job of one of the types that is due, highest priority first, or a
+	// running one whose visibility timeout expired; it counts an attempt. It returns false when
+	// nothing is due.
+	Claim(ctx context.Context, types []string, owner string, now, lockedUntil time.Time) (Job, bool, error)
+	// Extend moves the visibility timeout of a job the owner still holds
+	Extend(ctx context.Context, id, owner string, lockedUntil time.Time) error
+	// Release saves the outcome of an attempt, provided the owner still holds the job
+	Release(ctx context.Context, job Job, owner string) error
+	// Get returns a RecordNotFoundError for unknown IDs
+	Get(ctx context.Context, id string) (Job, error)
+}
+
+// ErrLost is returned by Extend and Release when the worker no longer holds the job
+var ErrLost = errors.New("job claim lost")
+
+// permanentError marks a handler error that retrying cannot fix
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps a handler error so the job fails at once instead of being retried, e.g. for a
+// payload that refers to a deleted record
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return permanentError{err: err}
+}
+
+// IsPermanent reports whether err was wrapped by Permanent
+func IsPermanent(err error) bool {
+	var p permanentError
+	return errors.As(err, &p)
+}
+
+type currentKey struct{}
+
+// Current returns the job a handler runs for, e.g. to read its attempt or who enqueued it
+func Current(ctx context.Context) (Job, bool) {
+	job, ok := ctx.Value(currentKey{}).(Job)
+	return job, ok
+}
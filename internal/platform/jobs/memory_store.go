@@ -0,0 +1,121 @@
+package jobs
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// memoryJobsKept bounds how many finished jobs the in-memory store remembers
+const memoryJobsKept = 1000
+
+// MemoryStore keeps the queue in process memory; used when MongoDB is not configured. Queued
+// jobs are lost on restart.
+type MemoryStore struct {
+	mu   sync.Mutex
+	jobs map[string]*Job
+	done []string // IDs of finished jobs, oldest first
+}
+
+// NewMemoryStore creates an empty in-memory queue store
+func NewMemoryStore() Store {
+	return &MemoryStore{jobs: map[string]*Job{}}
+}
+
+func (s *MemoryStore) Enqueue(_ context.Context, job Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.jobs[job.ID]; exists {
+		return platformErrors.NewConflictError("job", job.ID, "a job with this ID already exists")
+	}
+	s.jobs[job.ID] = &job
+	return nil
+}
+
+func (s *MemoryStore) Claim(_ context.Context, types []string, owner string, now, lockedUntil time.Time) (Job, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var next *Job
+	for _, job := range s.jobs {
+		if !slices.Contains(types, job.Type) || !claimable(*job, now) {
+			continue
+		}
+		if next == nil || before(*job, *next) {
+			next = job
+		}
+	}
+	if next == nil {
+		return Job{}, false, nil
+	}
+
+	next.Status = StatusRunning
+	next.Attempts++
+	next.LockedBy = owner
+	next.LockedUntil = &lockedUntil
+	next.StartedAt = &now
+	return *next, true, nil
+}
+
+func (s *MemoryStore) Extend(_ context.Context, id, owner string, lockedUntil time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[id]
+	if !ok || job.Status != StatusRunning || job.LockedBy != owner {
+		return ErrLost
+	}
+	job.LockedUntil = &lockedUntil
+	return nil
+}
+
+func (s *MemoryStore) Release(_ context.Context, job Job, owner string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	current, ok := s.jobs[job.ID]
+	if !ok || current.Status != StatusRunning || current.LockedBy != owner || current.Attempts != job.Attempts {
+		return ErrLost
+	}
+	*current = job
+	if job.Finished() {
+		s.done = append(s.done, job.ID)
+		if len(s.done) > memoryJobsKept {
+			for _, id := range s.done[:len(s.done)-memoryJobsKept] {
+				delete(s.jobs, id)
+			}
+			s.done = s.done[len(s.done)-memoryJobsKept:]
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) Get(_ context.Context, id string) (Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[id]
+	if !ok {
+		return Job{}, platformErrors.NewRecordNotFoundError("job", id)
+	}
+	return *job, nil
+}
+
+// claimable reports whether a job is due, or running past its visibility timeout
+func claimable(job Job, now time.Time) bool {
+	switch job.Status {
+	case StatusQueued:
+		return !job.RunAt.After(now)
+	case StatusRunning:
+		return job.LockedUntil != nil && !job.LockedUntil.After(now)
+	}
+	return false
+}
+
+// before orders jobs the way Claim takes them: highest priority, then longest due
+func before(a, b Job) bool {
+	if a.Priority != b.Priority {
+		return a.Priority > b.Priority
+	}
+	return a.RunAt.Before(b.RunAt)
+}
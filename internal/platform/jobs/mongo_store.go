@@ -0,0 +1,122 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// MongoStore keeps the queue in the jobs collection, shared by every instance
+type MongoStore struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewMongoStore creates a MongoDB-backed queue store
+func NewMongoStore(collection *mongo.Collection, logger *zap.Logger) *MongoStore {
+	return &MongoStore{collection: collection, logger: logger}
+}
+
+func (s *MongoStore) Enqueue(ctx context.Context, job Job) error {
+	if _, err := s.collection.InsertOne(ctx, job); err != nil {
+		s.logger.Error("Failed to enqueue job", zap.String("job_id", job.ID), zap.String("type", job.Type), zap.Error(err))
+		return platformErrors.HandleMongoError("Enqueue", err)
+	}
+	return nil
+}
+
+func (s *MongoStore) Claim(ctx context.Context, types []string, owner string, now, lockedUntil time.Time) (Job, bool, error) {
+	filter := bson.M{
+		"type": bson.M{"$in": types},
+		"$or": bson.A{
+			bson.M{"status": StatusQueued, "run_at": bson.M{"$lte": now}},
+			bson.M{"status": StatusRunning, "locked_until": bson.M{"$lte": now}},
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{"status": StatusRunning, "locked_by": owner, "locked_until": lockedUntil, "started_at": now},
+		"$inc": bson.M{"attempts": 1},
+	}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "priority", Value: -1}, {Key: "run_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var job Job
+	if err := s.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&job); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return Job{}, false, nil
+		}
+		return Job{}, false, platformErrors.HandleMongoError("Claim", err)
+	}
+	return job, true, nil
+}
+
+func (s *MongoStore) Extend(ctx context.Context, id, owner string, lockedUntil time.Time) error {
+	filter := bson.M{"_id": id, "status": StatusRunning, "locked_by": owner}
+	result, err := s.collection.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"locked_until": lockedUntil}})
+	if err != nil {
+		return platformErrors.HandleMongoError("Extend", err)
+	}
+	if result.MatchedCount == 0 {
+		return ErrLost
+	}
+	return nil
+}
+
+func (s *MongoStore) Release(ctx context.Context, job Job, owner string) error {
+	// The attempt count tells this claim apart from a later one by the same instance
+	filter := bson.M{"_id": job.ID, "status": StatusRunning, "locked_by": owner, "attempts": job.Attempts}
+	result, err := s.collection.ReplaceOne(ctx, filter, job)
+	if err != nil {
+		s.logger.Error("Failed to save job", zap.String("job_id", job.ID), zap.String("type", job.Type), zap.Error(err))
+		return platformErrors.HandleMongoError("Release", err)
+	}
+	if result.MatchedCount == 0 {
+		return ErrLost
+	}
+	return nil
+}
+
+func (s *MongoStore) Get(ctx context.Context, id string) (Job, error) {
+	var job Job
+	if err := s.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&job); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return Job{}, platformErrors.NewRecordNotFoundError("job", id)
+		}
+		return Job{}, platformErrors.HandleMongoError("Get", err)
+	}
+	return job, nil
+}
+
+// CreateIndexes creates the indexes Claim uses and, when retention is set, the TTL index that
+// deletes finished jobs older than it
+func (s *MongoStore) CreateIndexes(ctx context.Context, retention time.Duration) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "type", Value: 1}, {Key: "priority", Value: -1}, {Key: "run_at", Value: 1}},
+			Options: options.Index().SetName("status_1_type_1_priority_-1_run_at_1"),
+		},
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "locked_until", Value: 1}},
+			Options: options.Index().SetName("status_1_locked_until_1"),
+		},
+	}
+	if retention > 0 {
+		// Unfinished jobs have no finished_at, so the TTL index never deletes them
+		indexes = append(indexes, mongo.IndexModel{
+			Keys:    bson.D{{Key: "finished_at", Value: 1}},
+			Options: options.Index().SetName("finished_at_ttl").SetExpireAfterSeconds(int32(retention.Seconds())),
+		})
+	}
+	if _, err := s.collection.Indexes().CreateMany(ctx, indexes); err != nil {
+		return platformErrors.HandleMongoError("CreateIndexes", err)
+	}
+	return nil
+}
@@ -0,0 +1,337 @@
+// Package jobs is a background job queue. Work is enqueued as a typed payload and run by a pool
+// of workers on whichever instance claims it first. A running job is hidden from other workers
+// until its visibility timeout, which its worker keeps renewing, so the job of an instance that
+// died is picked up again. Failed jobs are retried with exponential backoff until they run out
+// of attempts, and higher priority jobs are claimed first.
+//
+// Handlers are registered at startup with Handle, before the queue runs:
+//
+//	jobs.Handle(queue, "patient_export", func(ctx context.Context, p ExportPayload) error { ... })
+//	job, err := queue.Enqueue(ctx, "patient_export", ExportPayload{...}, jobs.EnqueueOptions{})
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/metrics"
+	"pharmacy-modernization-project-model/internal/platform/tenancy"
+)
+
+// Config controls the workers of a queue; zero values take the defaults
+type Config struct {
+	Workers           int           // Jobs run at once by this instance; defaults to 4
+	PollInterval      time.Duration // Wait between claims while nothing is due; defaults to 2s
+	VisibilityTimeout time.Duration // A job whose worker stops renewing it runs again after this long; defaults to 5m
+	MaxAttempts       int           // Attempts of jobs enqueued without their own; defaults to 5
+	BaseBackoff       time.Duration // Wait before the second attempt, doubled after each failure; defaults to 30s
+	MaxBackoff        time.Duration // Longest wait between attempts; defaults to 1h
+}
+
+// HandlerFunc runs one attempt of a job; returning an error schedules a retry unless the job is
+// out of attempts or the error is Permanent
+type HandlerFunc func(ctx context.Context, job Job) error
+
+// Queue enqueues jobs and, once running, works them off with a pool of workers
+type Queue struct {
+	store    Store
+	cfg      Config
+	log      *zap.Logger
+	owner    string
+	now      func() time.Time
+	handlers map[string]HandlerFunc
+	types    []string
+	wake     chan struct{} // Signalled on enqueue, so idle workers do not wait for the next poll
+}
+
+// New creates a queue over a store; nothing runs until Run
+func New(store Store, cfg Config, log *zap.Logger) *Queue {
+	if log == nil {
+		log = zap.NewNop()
+	}
+	if cfg.Workers <= 0 {
+		cfg.Workers = 4
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = 2 * time.Second
+	}
+	if cfg.VisibilityTimeout <= 0 {
+		cfg.VisibilityTimeout = 5 * time.Minute
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 5
+	}
+	if cfg.BaseBackoff <= 0 {
+		cfg.BaseBackoff = 30 * time.Second
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = time.Hour
+	}
+	host, _ := os.Hostname()
+	return &Queue{
+		store:    store,
+		cfg:      cfg,
+		log:      log,
+		owner:    fmt.Sprintf("%s-%s", host, uuid.NewString()[:8]),
+		now:      time.Now,
+		handlers: map[string]HandlerFunc{},
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+// Register adds the handler of a job type; it must be called before Run and panics when the type
+// already has one. Handle registers a handler that takes the decoded payload instead.
+func (q *Queue) Register(jobType string, handler HandlerFunc) {
+	if _, exists := q.handlers[jobType]; exists {
+		panic(fmt.Sprintf("jobs: handler for %q registered twice", jobType))
+	}
+	q.handlers[jobType] = handler
+	q.types = append(q.types, jobType)
+}
+
+// Handle registers the handler of a job type whose payload is a T. A payload that does not decode
+// into a T fails the job without retries.
+func Handle[T any](q *Queue, jobType string, handle func(ctx context.Context, payload T) error) {
+	q.Register(jobType, func(ctx context.Context, job Job) error {
+		var payload T
+		if err := bson.Unmarshal(job.Payload, &payload); err != nil {
+			return Permanent(fmt.Errorf("decode %s payload: %w", jobType, err))
+		}
+		return handle(ctx, payload)
+	})
+}
+
+// Enqueue queues a job of a registered type. The payload is a struct or map, stored as a BSON
+// document. The job remembers the current user and organization: the user may read its status,
+// and the handler runs in the organization.
+func (q *Queue) Enqueue(ctx context.Context, jobType string, payload any, opts EnqueueOptions) (Job, error) {
+	if _, ok := q.handlers[jobType]; !ok {
+		return Job{}, platformErrors.NewValidationError("type", jobType, "no handler is registered for this job type")
+	}
+	raw, err := bson.Marshal(payload)
+	if err != nil {
+		return Job{}, fmt.Errorf("encode %s payload: %w", jobType, err)
+	}
+
+	now := q.now()
+	job := Job{
+		ID:          uuid.NewString(),
+		Type:        jobType,
+		Payload:     raw,
+		Priority:    opts.Priority,
+		Status:      StatusQueued,
+		MaxAttempts: opts.MaxAttempts,
+		RunAt:       now.Add(opts.Delay),
+		CreatedAt:   now,
+	}
+	if job.MaxAttempts <= 0 {
+		job.MaxAttempts = q.cfg.MaxAttempts
+	}
+	if user, err := auth.GetCurrentUser(ctx); err == nil && user != nil {
+		job.CreatedBy = user.ID
+	}
+	if orgID, ok := tenancy.OrgID(ctx); ok {
+		job.OrgID = orgID
+	}
+
+	if err := q.store.Enqueue(ctx, job); err != nil {
+		return Job{}, err
+	}
+	metrics.CountQueuedJob(jobType)
+	if opts.Delay <= 0 {
+		select {
+		case q.wake <- struct{}{}:
+		default:
+		}
+	}
+	return job, nil
+}
+
+// Get returns a job by ID
+func (q *Queue) Get(ctx context.Context, id string) (Job, error) {
+	return q.store.Get(ctx, id)
+}
+
+// Run starts the workers and blocks until ctx is cancelled. Jobs running at that point are put
+// back in the queue.
+func (q *Queue) Run(ctx context.Context) {
+	if len(q.types) == 0 {
+		q.log.Info("No job handlers registered, job queue workers not started")
+		return
+	}
+	q.log.Info("Job queue started", zap.String("owner", q.owner), zap.Int("workers", q.cfg.Workers), zap.Strings("types", q.types))
+
+	var wg sync.WaitGroup
+	for i := 0; i < q.cfg.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			q.work(ctx)
+		}()
+	}
+	wg.Wait()
+
+	q.log.Info("Job queue stopped")
+}
+
+// work claims and runs due jobs, waiting for the next poll or enqueue while there are none
+func (q *Queue) work(ctx context.Context) {
+	timer := time.NewTimer(q.cfg.PollInterval)
+	defer timer.Stop()
+
+	for ctx.Err() == nil {
+		now := q.now()
+		job, ok, err := q.store.Claim(ctx, q.types, q.owner, now, now.Add(q.cfg.VisibilityTimeout))
+		if err != nil && ctx.Err() == nil {
+			q.log.Error("Failed to claim job", zap.Error(err))
+		}
+		if ok {
+			q.process(ctx, job)
+			continue
+		}
+
+		timer.Reset(q.cfg.PollInterval)
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.wake:
+		case <-timer.C:
+		}
+	}
+}
+
+// process runs one attempt of a claimed job and records its outcome
+func (q *Queue) process(ctx context.Context, job Job) {
+	log := q.log.With(zap.String("job_id", job.ID), zap.String("type", job.Type), zap.Int("attempt", job.Attempts))
+	start := q.now()
+
+	var err error
+	if job.Attempts > job.MaxAttempts {
+		// Claimed again after the last attempt's worker stopped renewing it, e.g. because its
+		// instance died
+		err = Permanent(errors.New("out of attempts: the last attempt did not finish within the visibility timeout"))
+	} else {
+		err = q.attempt(ctx, job, log)
+	}
+
+	outcome := job
+	outcome.LockedBy = ""
+	outcome.LockedUntil = nil
+	end := q.now()
+	switch {
+	case err == nil:
+		outcome.Status = StatusSucceeded
+		outcome.LastError = ""
+		outcome.FinishedAt = &end
+	case ctx.Err() != nil:
+		// Shutting down: the attempt is counted, but the job runs again as soon as it is claimed
+		outcome.Status = StatusQueued
+		outcome.LastError = "interrupted by shutdown"
+		outcome.RunAt = end
+	case IsPermanent(err) || job.Attempts >= job.MaxAttempts:
+		outcome.Status = StatusFailed
+		outcome.LastError = err.Error()
+		outcome.FinishedAt = &end
+	default:
+		outcome.Status = StatusQueued
+		outcome.LastError = err.Error()
+		outcome.RunAt = end.Add(q.backoff(job.Attempts))
+	}
+
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancel()
+	if saveErr := q.store.Release(saveCtx, outcome, q.owner); saveErr != nil {
+		if errors.Is(saveErr, ErrLost) {
+			log.Warn("Job was claimed by another worker before it finished; its outcome is dropped")
+		} else {
+			log.Error("Failed to save job outcome", zap.Error(saveErr))
+		}
+		return
+	}
+
+	metricOutcome := string(outcome.Status)
+	if outcome.Status == StatusQueued {
+		metricOutcome = "retried"
+	}
+	metrics.ObserveQueuedJob(job.Type, metricOutcome, end.Sub(start))
+
+	fields := []zap.Field{zap.Duration("duration", end.Sub(start))}
+	switch outcome.Status {
+	case StatusSucceeded:
+		log.Debug("Job succeeded", fields...)
+	case StatusFailed:
+		log.Error("Job failed", append(fields, zap.Error(err))...)
+	default:
+		log.Warn("Job attempt failed, will retry", append(fields, zap.Time("run_at", outcome.RunAt), zap.Error(err))...)
+	}
+}
+
+// attempt calls the job's handler while renewing its visibility timeout. The handler's context
+// is cancelled when the queue stops or another worker took the job.
+func (q *Queue) attempt(ctx context.Context, job Job, log *zap.Logger) error {
+	handler, ok := q.handlers[job.Type]
+	if !ok {
+		return Permanent(fmt.Errorf("no handler for job type %q", job.Type))
+	}
+
+	runCtx, cancel := context.WithCancel(context.WithValue(ctx, currentKey{}, job))
+	defer cancel()
+	if job.OrgID != "" {
+		runCtx = tenancy.WithOrg(runCtx, job.OrgID)
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(q.cfg.VisibilityTimeout / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				err := q.store.Extend(runCtx, job.ID, q.owner, q.now().Add(q.cfg.VisibilityTimeout))
+				if errors.Is(err, ErrLost) {
+					log.Warn("Job was claimed by another worker, cancelling this attempt")
+					cancel()
+					return
+				}
+				if err != nil && runCtx.Err() == nil {
+					log.Warn("Failed to renew job visibility timeout", zap.Error(err))
+				}
+			}
+		}
+	}()
+
+	return call(runCtx, handler, job)
+}
+
+// call runs the handler, turning a panic into an error
+func call(ctx context.Context, handler HandlerFunc, job Job) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("job panicked: %v", rec)
+		}
+	}()
+	return handler(ctx, job)
+}
+
+// backoff is the wait after the given failed attempt: BaseBackoff doubled per earlier failure,
+// capped at MaxBackoff
+func (q *Queue) backoff(attempt int) time.Duration {
+	wait := q.cfg.BaseBackoff
+	for i := 1; i < attempt && wait < q.cfg.MaxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, q.cfg.MaxBackoff)
+}
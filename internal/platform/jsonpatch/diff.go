@@ -0,0 +1,115 @@
+package jsonpatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Change is a single field-level difference between two documents
+type Change struct {
+	Path   string      `json:"path"`
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+	// Kind is added, removed or changed
+	Kind string `json:"kind"`
+}
+
+// Diff compares two JSON documents and lists the changed fields, sorted by path.
+// Objects are compared key by key; arrays and scalars are reported as whole values.
+func Diff(before, after []byte) ([]Change, error) {
+	var b, a interface{}
+	if err := decode(before, &b); err != nil {
+		return nil, fmt.Errorf("invalid before document: %w", err)
+	}
+	if err := decode(after, &a); err != nil {
+		return nil, fmt.Errorf("invalid after document: %w", err)
+	}
+
+	changes := []Change{}
+	diffValues("", b, a, &changes)
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+	return changes, nil
+}
+
+func diffValues(path string, before, after interface{}, changes *[]Change) {
+	bObj, bIsObj := before.(map[string]interface{})
+	aObj, aIsObj := after.(map[string]interface{})
+	if bIsObj && aIsObj {
+		for key, bVal := range bObj {
+			child := path + "/" + escapeToken(key)
+			if aVal, ok := aObj[key]; ok {
+				diffValues(child, bVal, aVal, changes)
+			} else {
+				*changes = append(*changes, Change{Path: child, Before: bVal, Kind: "removed"})
+			}
+		}
+		for key, aVal := range aObj {
+			if _, ok := bObj[key]; !ok {
+				*changes = append(*changes, Change{Path: path + "/" + escapeToken(key), After: aVal, Kind: "added"})
+			}
+		}
+		return
+	}
+
+	if !Equal(before, after) {
+		*changes = append(*changes, Change{Path: path, Before: before, After: after, Kind: "changed"})
+	}
+}
+
+func escapeToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// EqualJSON reports whether two JSON documents hold the same value, ignoring key order and number formatting
+func EqualJSON(a, b []byte) bool {
+	var av, bv interface{}
+	if decode(a, &av) != nil || decode(b, &bv) != nil {
+		return false
+	}
+	return Equal(av, bv)
+}
+
+// Equal compares two decoded JSON values, treating numbers by value rather than representation
+func Equal(a, b interface{}) bool {
+	switch av := a.(type) {
+	case json.Number:
+		bv, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		if av == bv {
+			return true
+		}
+		af, errA := av.Float64()
+		bf, errB := bv.Float64()
+		return errA == nil && errB == nil && af == bf
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for k, v := range av {
+			other, ok := bv[k]
+			if !ok || !Equal(v, other) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !Equal(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
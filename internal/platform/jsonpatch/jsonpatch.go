@@ -0,0 +1,289 @@
+// Package jsonpatch applies RFC 6902 JSON Patch documents and reports field-level differences
+// between two JSON documents.
+package jsonpatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Operation is a single JSON Patch operation
+type Operation struct {
+	Op    string      `json:"op" bson:"op" validate:"required,oneof=add remove replace move copy test"`
+	Path  string      `json:"path" bson:"path"`
+	From  string      `json:"from,omitempty" bson:"from,omitempty"`
+	Value interface{} `json:"value,omitempty" bson:"value,omitempty"`
+}
+
+// Touches reports whether the operation reads or writes the given pointer or anything below it
+func (o Operation) Touches(pointer string) bool {
+	within := func(p string) bool {
+		return p == pointer || strings.HasPrefix(p, pointer+"/")
+	}
+	if within(o.Path) {
+		return true
+	}
+	return (o.Op == "move" || o.Op == "copy") && within(o.From)
+}
+
+// Apply applies the operations in order to a JSON document and returns the patched document.
+// The patch is atomic: any failing operation leaves the input untouched and returns an error.
+func Apply(doc []byte, ops []Operation) ([]byte, error) {
+	var root interface{}
+	if err := decode(doc, &root); err != nil {
+		return nil, fmt.Errorf("invalid document: %w", err)
+	}
+
+	for i, op := range ops {
+		value, err := normalize(op.Value)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d: invalid value: %w", i, err)
+		}
+
+		switch op.Op {
+		case "add":
+			root, err = add(root, op.Path, value)
+		case "remove":
+			root, _, err = remove(root, op.Path)
+		case "replace":
+			if _, err = get(root, op.Path); err == nil {
+				root, _, err = remove(root, op.Path)
+				if err == nil {
+					root, err = add(root, op.Path, value)
+				}
+			}
+		case "move":
+			if op.Path == op.From {
+				break
+			}
+			if strings.HasPrefix(op.Path, op.From+"/") {
+				err = fmt.Errorf("cannot move %q into one of its children", op.From)
+				break
+			}
+			var moved interface{}
+			root, moved, err = remove(root, op.From)
+			if err == nil {
+				root, err = add(root, op.Path, moved)
+			}
+		case "copy":
+			var copied interface{}
+			if copied, err = get(root, op.From); err == nil {
+				root, err = add(root, op.Path, deepCopy(copied))
+			}
+		case "test":
+			var current interface{}
+			if current, err = get(root, op.Path); err == nil && !Equal(current, value) {
+				err = fmt.Errorf("test failed at %q", op.Path)
+			}
+		default:
+			err = fmt.Errorf("unsupported op %q", op.Op)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("operation %d (%s %s): %w", i, op.Op, op.Path, err)
+		}
+	}
+
+	return json.Marshal(root)
+}
+
+// decode unmarshals JSON keeping numbers as json.Number so integers round-trip exactly
+func decode(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+// normalize converts an operation value into the same representation as decoded documents
+func normalize(v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = decode(data, &out)
+	return out, err
+}
+
+func deepCopy(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, val := range t {
+			out[k] = deepCopy(val)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, val := range t {
+			out[i] = deepCopy(val)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// parsePointer splits an RFC 6901 JSON Pointer into unescaped reference tokens
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, t := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+func arrayIndex(token string, length int, allowEnd bool) (int, error) {
+	if allowEnd && token == "-" {
+		return length, nil
+	}
+	idx, err := strconv.Atoi(token)
+	if err != nil || idx < 0 || (token != "0" && strings.HasPrefix(token, "0")) {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	limit := length - 1
+	if allowEnd {
+		limit = length
+	}
+	if idx > limit {
+		return 0, fmt.Errorf("array index %d out of range", idx)
+	}
+	return idx, nil
+}
+
+func get(root interface{}, pointer string) (interface{}, error) {
+	tokens, err := parsePointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+	current := root
+	for _, token := range tokens {
+		switch node := current.(type) {
+		case map[string]interface{}:
+			value, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("path %q does not exist", pointer)
+			}
+			current = value
+		case []interface{}:
+			idx, err := arrayIndex(token, len(node), false)
+			if err != nil {
+				return nil, err
+			}
+			current = node[idx]
+		default:
+			return nil, fmt.Errorf("path %q does not exist", pointer)
+		}
+	}
+	return current, nil
+}
+
+// add sets value at pointer and returns the (possibly replaced) root
+func add(root interface{}, pointer string, value interface{}) (interface{}, error) {
+	tokens, err := parsePointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return value, nil
+	}
+	updated, err := update(root, tokens, func(parent interface{}, last string) (interface{}, error) {
+		switch node := parent.(type) {
+		case map[string]interface{}:
+			node[last] = value
+			return node, nil
+		case []interface{}:
+			idx, err := arrayIndex(last, len(node), true)
+			if err != nil {
+				return nil, err
+			}
+			node = append(node, nil)
+			copy(node[idx+1:], node[idx:])
+			node[idx] = value
+			return node, nil
+		default:
+			return nil, fmt.Errorf("parent of %q is not a container", pointer)
+		}
+	})
+	return updated, err
+}
+
+// remove deletes the value at pointer and returns the new root and the removed value
+func remove(root interface{}, pointer string) (interface{}, interface{}, error) {
+	tokens, err := parsePointer(pointer)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, root, nil
+	}
+	var removed interface{}
+	updated, err := update(root, tokens, func(parent interface{}, last string) (interface{}, error) {
+		switch node := parent.(type) {
+		case map[string]interface{}:
+			value, ok := node[last]
+			if !ok {
+				return nil, fmt.Errorf("path %q does not exist", pointer)
+			}
+			removed = value
+			delete(node, last)
+			return node, nil
+		case []interface{}:
+			idx, err := arrayIndex(last, len(node), false)
+			if err != nil {
+				return nil, err
+			}
+			removed = node[idx]
+			return append(node[:idx:idx], node[idx+1:]...), nil
+		default:
+			return nil, fmt.Errorf("path %q does not exist", pointer)
+		}
+	})
+	return updated, removed, err
+}
+
+// update walks to the parent of the last token, applies fn to it and writes the
+// result back up the tree, since slices may be reallocated
+func update(node interface{}, tokens []string, fn func(parent interface{}, last string) (interface{}, error)) (interface{}, error) {
+	if len(tokens) == 1 {
+		return fn(node, tokens[0])
+	}
+	token := tokens[0]
+	switch container := node.(type) {
+	case map[string]interface{}:
+		child, ok := container[token]
+		if !ok {
+			return nil, fmt.Errorf("path segment %q does not exist", token)
+		}
+		updated, err := update(child, tokens[1:], fn)
+		if err != nil {
+			return nil, err
+		}
+		container[token] = updated
+		return container, nil
+	case []interface{}:
+		idx, err := arrayIndex(token, len(container), false)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := update(container[idx], tokens[1:], fn)
+		if err != nil {
+			return nil, err
+		}
+		container[idx] = updated
+		return container, nil
+	default:
+		return nil, fmt.Errorf("path segment %q does not exist", token)
+	}
+}
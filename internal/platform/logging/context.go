@@ -0,0 +1,78 @@
+package logging
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+const (
+	loggerKey        ctxKey = "logger"
+	requestFieldsKey ctxKey = "request-fields"
+)
+
+// requestFields are the fields learned while a request is handled, e.g. the user once it is
+// authenticated; they are shared by every context derived from the request's
+type requestFields struct {
+	mu     sync.Mutex
+	fields []zap.Field
+}
+
+// AddFields adds fields to every entry logged for the request from now on, including its
+// http_request entry; outside a request it does nothing
+func AddFields(ctx context.Context, fields ...zap.Field) {
+	if rf, ok := ctx.Value(requestFieldsKey).(*requestFields); ok {
+		rf.mu.Lock()
+		defer rf.mu.Unlock()
+		rf.fields = append(rf.fields, fields...)
+	}
+}
+
+// WithContext returns l enriched with the request and correlation IDs found in ctx, the route
+// and the fields added with AddFields, e.g. user_id and tenant, so every log line written for a
+// request can be traced across services
+func WithContext(ctx context.Context, l *zap.Logger) *zap.Logger {
+	if l == nil {
+		l = zap.NewNop()
+	}
+
+	var fields []zap.Field
+	if rid := GetRequestID(ctx); rid != "" {
+		fields = append(fields, zap.String("request_id", rid))
+	}
+	if cid := GetCorrelationID(ctx); cid != "" {
+		fields = append(fields, zap.String("correlation_id", cid))
+	}
+	// The pattern grows as sub-routers match, so it is read when the entry is written
+	if rctx := chi.RouteContext(ctx); rctx != nil {
+		if route := rctx.RoutePattern(); route != "" {
+			fields = append(fields, zap.String("route", route))
+		}
+	}
+	if rf, ok := ctx.Value(requestFieldsKey).(*requestFields); ok {
+		rf.mu.Lock()
+		fields = append(fields, slices.Clone(rf.fields)...)
+		rf.mu.Unlock()
+	}
+	if len(fields) == 0 {
+		return l
+	}
+	return l.With(fields...)
+}
+
+// WithLogger stores a logger in the context
+func WithLogger(ctx context.Context, l *zap.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey, l)
+}
+
+// FromContext returns the logger stored by ContextLogger enriched with the request's fields as
+// they are now, or a no-op logger when none is present
+func FromContext(ctx context.Context) *zap.Logger {
+	if l, ok := ctx.Value(loggerKey).(*zap.Logger); ok && l != nil {
+		return WithContext(ctx, l)
+	}
+	return zap.NewNop()
+}
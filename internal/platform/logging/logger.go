@@ -13,6 +13,13 @@ import (
 
 type LoggerBundle struct {
 	Base *zap.Logger
+	// Level is shared by all outputs, so SetLevel changes what every output writes
+	Level zap.AtomicLevel
+}
+
+// SetLevel changes the level of the running logger, e.g. after logging.level was reloaded
+func (b *LoggerBundle) SetLevel(level string) error {
+	return b.Level.UnmarshalText([]byte(level))
 }
 
 func NewLogger(cfg *config.Config) *LoggerBundle {
@@ -21,11 +28,11 @@ func NewLogger(cfg *config.Config) *LoggerBundle {
 	// If logging is disabled, use a no-op logger
 	if !cfg.Logging.Enabled {
 		l = zap.NewNop()
-		return &LoggerBundle{Base: l}
+		return &LoggerBundle{Base: l, Level: zap.NewAtomicLevel()}
 	}
 
 	// Determine log level
-	lvl := zapcore.DebugLevel
+	lvl := zap.NewAtomicLevelAt(zapcore.DebugLevel)
 	_ = lvl.UnmarshalText([]byte(cfg.Logging.Level))
 
 	// Determine encoder (console vs json)
@@ -60,11 +67,11 @@ func NewLogger(cfg *config.Config) *LoggerBundle {
 		cores = append(cores, zapcore.NewCore(encoder, consoleWriter, lvl))
 	}
 
-	// Combine cores and create logger
-	core := zapcore.NewTee(cores...)
+	// Combine cores and create logger; PHI in fields is masked before any output
+	core := RedactPII(zapcore.NewTee(cores...))
 	l = zap.New(core, zap.AddCaller(), zap.AddStacktrace(zapcore.ErrorLevel))
 
-	return &LoggerBundle{Base: l}
+	return &LoggerBundle{Base: l, Level: lvl}
 }
 
 func getFileWriter(cfg *config.Config) zapcore.WriteSyncer {
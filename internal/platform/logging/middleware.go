@@ -3,6 +3,7 @@ package logging
 import (
 	"context"
 	"net/http"
+	"regexp"
 	"time"
 
 	"pharmacy-modernization-project-model/internal/platform/sanitizer"
@@ -12,24 +13,68 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	// RequestIDHeader identifies a single HTTP request
+	RequestIDHeader = "X-Request-ID"
+	// CorrelationIDHeader ties together every request made on behalf of one user action
+	CorrelationIDHeader = "X-Correlation-ID"
+)
+
 type ctxKey string
 
-const correlationKey ctxKey = "correlation-id"
+const (
+	requestIDKey   ctxKey = "request-id"
+	correlationKey ctxKey = "correlation-id"
+)
+
+// validID limits accepted inbound IDs so untrusted headers can't inject into logs or responses
+var validID = regexp.MustCompile(`^[A-Za-z0-9._:/-]{1,128}$`)
 
-func CorrelationID() func(http.Handler) http.Handler {
+// RequestIDs extracts X-Request-ID and X-Correlation-ID from the request (generating them
+// when missing or malformed), stores them in the context and echoes them on the response.
+// The correlation ID defaults to the request ID when the caller did not send one.
+func RequestIDs() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			cid := r.Header.Get("X-Correlation-Id")
-			if cid == "" {
-				cid = uuid.New().String()
-			}
-			w.Header().Set("X-Correlation-Id", cid)
-			r = r.WithContext(context.WithValue(r.Context(), correlationKey, cid))
-			next.ServeHTTP(w, r)
+			rid, cid := RequestIDsFrom(r.Header.Get(RequestIDHeader), r.Header.Get(CorrelationIDHeader))
+
+			w.Header().Set(RequestIDHeader, rid)
+			w.Header().Set(CorrelationIDHeader, cid)
+
+			ctx := WithRequestIDs(r.Context(), rid, cid)
+			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
 
+// RequestIDsFrom returns the request and correlation IDs a caller sent, replacing missing or
+// malformed ones the way RequestIDs does. Transports other than HTTP use it on their own headers.
+func RequestIDsFrom(requestID, correlationID string) (string, string) {
+	if !validID.MatchString(requestID) {
+		requestID = uuid.New().String()
+	}
+	if !validID.MatchString(correlationID) {
+		correlationID = requestID
+	}
+	return requestID, correlationID
+}
+
+// WithRequestIDs returns a context carrying the given request and correlation IDs.
+// Use it to keep IDs when work continues outside the original request.
+func WithRequestIDs(ctx context.Context, requestID, correlationID string) context.Context {
+	ctx = context.WithValue(ctx, requestIDKey, requestID)
+	// Keep chi's request ID in sync for middleware that relies on middleware.GetReqID
+	ctx = context.WithValue(ctx, middleware.RequestIDKey, requestID)
+	return context.WithValue(ctx, correlationKey, correlationID)
+}
+
+func GetRequestID(ctx context.Context) string {
+	if v, ok := ctx.Value(requestIDKey).(string); ok {
+		return v
+	}
+	return middleware.GetReqID(ctx)
+}
+
 func GetCorrelationID(ctx context.Context) string {
 	if v, ok := ctx.Value(correlationKey).(string); ok {
 		return v
@@ -37,19 +82,29 @@ func GetCorrelationID(ctx context.Context) string {
 	return ""
 }
 
+// ContextLogger stores the logger in the context, along with a place for the fields AddFields
+// adds while the request is handled; FromContext enriches it with them
+func ContextLogger(l *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), requestFieldsKey, &requestFields{})
+			ctx = WithLogger(ctx, l)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
 func ZapRequestLogger(l *zap.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
 			start := time.Now()
 			next.ServeHTTP(ww, r)
-			l.Info("http_request",
+			WithContext(r.Context(), l).Info("http_request",
 				zap.String("method", sanitizer.ForLogging(r.Method)),
 				zap.Int("status", ww.Status()),
 				zap.Int("bytes", ww.BytesWritten()),
 				zap.Duration("duration", time.Since(start)),
-				zap.String("request_id", sanitizer.ForLogging(middleware.GetReqID(r.Context()))),
-				zap.String("correlation_id", sanitizer.ForLogging(GetCorrelationID(r.Context()))),
 				zap.String("remote_ip", sanitizer.ForLogging(r.RemoteAddr)),
 				zap.String("user_agent", sanitizer.ForLogging(r.UserAgent())),
 			)
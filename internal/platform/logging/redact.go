@@ -0,0 +1,156 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"pharmacy-modernization-project-model/internal/platform/sanitizer"
+)
+
+// piiMasks maps log field names, lowercased without "_" or "-", to the mask applied to their values.
+// Names are matched at any depth, so zap.Any("patient", p) masks the name, dob and phone inside it.
+var piiMasks = map[string]func(string) string{
+	"name":             sanitizer.MaskName,
+	"patientname":      sanitizer.MaskName,
+	"fullname":         sanitizer.MaskName,
+	"firstname":        sanitizer.MaskName,
+	"middlename":       sanitizer.MaskName,
+	"lastname":         sanitizer.MaskName,
+	"givenname":        sanitizer.MaskName,
+	"familyname":       sanitizer.MaskName,
+	"phone":            sanitizer.MaskPhone,
+	"phonenumber":      sanitizer.MaskPhone,
+	"mobile":           sanitizer.MaskPhone,
+	"telephone":        sanitizer.MaskPhone,
+	"primarytelephone": sanitizer.MaskPhone, // NCPDP SCRIPT
+	"dob":              sanitizer.MaskDOB,
+	"dateofbirth":      sanitizer.MaskDOB,
+	"birthdate":        sanitizer.MaskDOB,
+}
+
+// redactedValue replaces a PHI field whose value cannot be masked, e.g. a number
+const redactedValue = "[redacted]"
+
+// RedactPII wraps core so patient names, phones and dates of birth in log fields are masked
+// before any output sees them. Messages are written as given, so they must not carry PHI.
+func RedactPII(core zapcore.Core) zapcore.Core {
+	return redactingCore{core}
+}
+
+type redactingCore struct {
+	zapcore.Core
+}
+
+func (c redactingCore) With(fields []zapcore.Field) zapcore.Core {
+	return redactingCore{c.Core.With(redactFields(fields))}
+}
+
+func (c redactingCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c redactingCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	return c.Core.Write(entry, redactFields(fields))
+}
+
+func redactFields(fields []zapcore.Field) []zapcore.Field {
+	out := make([]zapcore.Field, len(fields))
+	for i, field := range fields {
+		out[i] = redactField(field)
+	}
+	return out
+}
+
+func redactField(field zapcore.Field) zapcore.Field {
+	if mask := piiMask(field.Key); mask != nil {
+		switch field.Type {
+		case zapcore.StringType:
+			field.String = mask(field.String)
+			return field
+		case zapcore.StringerType:
+			return zap.String(field.Key, mask(fmt.Sprint(field.Interface)))
+		case zapcore.TimeType, zapcore.TimeFullType:
+			return zap.String(field.Key, mask(timeValue(field).Format(time.DateOnly)))
+		default:
+			return zap.String(field.Key, redactedValue)
+		}
+	}
+
+	switch field.Type {
+	case zapcore.ReflectType:
+		// Structs are inspected through their JSON form, which is also how the encoder writes them
+		raw, err := json.Marshal(field.Interface)
+		if err != nil {
+			return field
+		}
+		var value any
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return field
+		}
+		if masked, changed := redactValue(value); changed {
+			return zap.Any(field.Key, masked)
+		}
+	case zapcore.ObjectMarshalerType, zapcore.ArrayMarshalerType:
+		enc := zapcore.NewMapObjectEncoder()
+		field.AddTo(enc)
+		if masked, changed := redactValue(enc.Fields[field.Key]); changed {
+			return zap.Any(field.Key, masked)
+		}
+	}
+	return field
+}
+
+// redactValue masks the PHI keys of decoded JSON-like values; changed is false when there were none
+func redactValue(value any) (any, bool) {
+	changed := false
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if mask := piiMask(key); mask != nil {
+				if s, ok := item.(string); ok {
+					v[key] = mask(s)
+				} else if item != nil {
+					v[key] = redactedValue
+				}
+				changed = true
+				continue
+			}
+			if masked, ok := redactValue(item); ok {
+				v[key] = masked
+				changed = true
+			}
+		}
+	case []any:
+		for i, item := range v {
+			if masked, ok := redactValue(item); ok {
+				v[i] = masked
+				changed = true
+			}
+		}
+	}
+	return value, changed
+}
+
+func piiMask(key string) func(string) string {
+	key = strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+	return piiMasks[key]
+}
+
+func timeValue(field zapcore.Field) time.Time {
+	if field.Type == zapcore.TimeFullType {
+		return field.Interface.(time.Time)
+	}
+	t := time.Unix(0, field.Integer)
+	if loc, ok := field.Interface.(*time.Location); ok {
+		t = t.In(loc)
+	}
+	return t
+}
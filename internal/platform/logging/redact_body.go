@@ -0,0 +1,88 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"mime"
+	"strings"
+)
+
+// RedactBody masks the patient names, phones and dates of birth in a JSON or XML payload, with the
+// masks applied to log fields. JSON keys are matched like field names; in XML every text inside
+// a matching element is masked, e.g. the Date in <DateOfBirth><Date>. ok is false for other
+// content types and for bodies that do not parse, which must not be recorded as they are.
+func RedactBody(contentType string, body []byte) (redacted []byte, ok bool) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, false
+	}
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		return redactJSON(body)
+	case mediaType == "application/xml" || mediaType == "text/xml" || strings.HasSuffix(mediaType, "+xml"):
+		return redactXML(body)
+	}
+	return nil, false
+}
+
+func redactJSON(body []byte) ([]byte, bool) {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return nil, false
+	}
+	value, _ = redactValue(value)
+	out, err := json.Marshal(value)
+	if err != nil {
+		return nil, false
+	}
+	return out, true
+}
+
+// redactXML rewrites only the masked texts, so the rest of the document keeps its exact form
+func redactXML(body []byte) ([]byte, bool) {
+	dec := xml.NewDecoder(bytes.NewReader(body))
+	var (
+		masks []func(string) string // Mask of each open element; nil when it has none
+		out   bytes.Buffer
+		last  int64 // End of the input already copied to out
+	)
+	for {
+		start := dec.InputOffset()
+		token, err := dec.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, false
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			mask := piiMask(t.Name.Local)
+			if mask == nil && len(masks) > 0 {
+				mask = masks[len(masks)-1]
+			}
+			masks = append(masks, mask)
+		case xml.EndElement:
+			if len(masks) > 0 {
+				masks = masks[:len(masks)-1]
+			}
+		case xml.CharData:
+			text := strings.TrimSpace(string(t))
+			if len(masks) == 0 || masks[len(masks)-1] == nil || text == "" {
+				continue
+			}
+			out.Write(body[last:start])
+			if err := xml.EscapeText(&out, []byte(masks[len(masks)-1](text))); err != nil {
+				return nil, false
+			}
+			last = dec.InputOffset()
+		}
+	}
+	out.Write(body[last:])
+	return out.Bytes(), true
+}
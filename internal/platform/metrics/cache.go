@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"pharmacy-modernization-project-model/internal/platform/cache"
+)
+
+var (
+	cacheHitsDesc      = prometheus.NewDesc(namespace+"_cache_hits_total", "Cache lookups that found the key.", []string{"cache"}, nil)
+	cacheMissesDesc    = prometheus.NewDesc(namespace+"_cache_misses_total", "Cache lookups that did not find the key.", []string{"cache"}, nil)
+	cacheEvictionsDesc = prometheus.NewDesc(namespace+"_cache_evictions_total", "Keys evicted from the cache.", []string{"cache"}, nil)
+	cacheErrorsDesc    = prometheus.NewDesc(namespace+"_cache_errors_total", "Cache operations that failed.", []string{"cache"}, nil)
+	cacheHitRatioDesc  = prometheus.NewDesc(namespace+"_cache_hit_ratio", "Hits divided by lookups since start.", []string{"cache"}, nil)
+	cacheSizeDesc      = prometheus.NewDesc(namespace+"_cache_entries", "Entries currently in the cache.", []string{"cache"}, nil)
+)
+
+// cacheCollector reads a cache's own counters when metrics are scraped
+type cacheCollector struct {
+	name  string
+	cache cache.Cache
+}
+
+// RegisterCache exports the statistics of a cache under the given name
+func RegisterCache(name string, c cache.Cache) error {
+	return registry.Register(&cacheCollector{name: name, cache: c})
+}
+
+func (c *cacheCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- cacheHitsDesc
+	ch <- cacheMissesDesc
+	ch <- cacheEvictionsDesc
+	ch <- cacheErrorsDesc
+	ch <- cacheHitRatioDesc
+	ch <- cacheSizeDesc
+}
+
+// Collect reports the cache as a whole and, for a tiered cache, each tier under the cache name
+// followed by the tier, e.g. primary_l1
+func (c *cacheCollector) Collect(ch chan<- prometheus.Metric) {
+	collectCacheStats(ch, c.name, c.cache.Stats())
+	if tiered, ok := cache.AsTiered(c.cache); ok {
+		for _, tier := range tiered.TierStats() {
+			collectCacheStats(ch, c.name+"_"+tier.Tier, tier.Stats)
+		}
+	}
+}
+
+func collectCacheStats(ch chan<- prometheus.Metric, name string, stats cache.CacheStats) {
+	ch <- prometheus.MustNewConstMetric(cacheHitsDesc, prometheus.CounterValue, float64(stats.Hits), name)
+	ch <- prometheus.MustNewConstMetric(cacheMissesDesc, prometheus.CounterValue, float64(stats.Misses), name)
+	ch <- prometheus.MustNewConstMetric(cacheEvictionsDesc, prometheus.CounterValue, float64(stats.Evictions), name)
+	ch <- prometheus.MustNewConstMetric(cacheErrorsDesc, prometheus.CounterValue, float64(stats.Errors), name)
+	ch <- prometheus.MustNewConstMetric(cacheHitRatioDesc, prometheus.GaugeValue, stats.HitRate, name)
+	ch <- prometheus.MustNewConstMetric(cacheSizeDesc, prometheus.GaugeValue, float64(stats.Size), name)
+}
@@ -0,0 +1,18 @@
+package metrics
+
+// ObserveCollectionCapacity records the size and capacity level of a monitored collection
+func ObserveCollectionCapacity(collection string, dataBytes, indexBytes int64, level int, sampling bool) {
+	capacityDataBytes.WithLabelValues(collection).Set(float64(dataBytes))
+	capacityIndexBytes.WithLabelValues(collection).Set(float64(indexBytes))
+	capacityLevel.WithLabelValues(collection).Set(float64(level))
+	value := 0.0
+	if sampling {
+		value = 1
+	}
+	capacitySampling.WithLabelValues(collection).Set(value)
+}
+
+// ObserveArchivedDocuments counts documents moved to a collection's archive
+func ObserveArchivedDocuments(collection string, count int) {
+	capacityArchived.WithLabelValues(collection).Add(float64(count))
+}
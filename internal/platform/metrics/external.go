@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+)
+
+// ObserveExternalRequest records a call to an external service. Calls that got no response are
+// labelled "timeout" or "error" instead of a status code.
+func ObserveExternalRequest(service, endpoint string, statusCode int, err error, duration time.Duration) {
+	status := strconv.Itoa(statusCode)
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		status = "timeout"
+	case err != nil:
+		status = "error"
+	}
+	if endpoint == "" {
+		endpoint = "unnamed"
+	}
+	externalRequestDuration.WithLabelValues(service, endpoint, status).Observe(duration.Seconds())
+}
+
+// Results of a lookup of cached external service data
+const (
+	CacheHit   = "hit"
+	CacheMiss  = "miss"
+	CacheStale = "stale" // Expired copy served because the service could not be reached
+)
+
+// ObserveCacheLookup records whether a service-level cache answered a lookup for an integration
+func ObserveCacheLookup(service, operation, result string) {
+	externalCacheLookups.WithLabelValues(service, operation, result).Inc()
+}
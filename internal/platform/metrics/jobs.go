@@ -0,0 +1,23 @@
+package metrics
+
+import "time"
+
+// ObserveJobRun records a finished scheduler job run and the items it processed and gave up on
+func ObserveJobRun(job, outcome string, duration time.Duration, processed, failed int) {
+	jobRunDuration.WithLabelValues(job, outcome).Observe(duration.Seconds())
+	jobItemsProcessed.WithLabelValues(job).Add(float64(processed))
+	jobItemsFailed.WithLabelValues(job).Add(float64(failed))
+	if outcome == "succeeded" {
+		jobLastSuccess.WithLabelValues(job).SetToCurrentTime()
+	}
+}
+
+// CountQueuedJob records a job added to the background job queue
+func CountQueuedJob(jobType string) {
+	queueJobsEnqueued.WithLabelValues(jobType).Inc()
+}
+
+// ObserveQueuedJob records an attempt of a background job: succeeded, retried or failed for good
+func ObserveQueuedJob(jobType, outcome string, duration time.Duration) {
+	queueAttemptDuration.WithLabelValues(jobType, outcome).Observe(duration.Seconds())
+}
@@ -0,0 +1,220 @@
+// Package metrics exports Prometheus metrics for HTTP requests, MongoDB operations, cache
+// usage, calls to external services, collection capacity, scheduler jobs and the background job
+// queue, served by Handler on the /metrics endpoint.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "rx"
+
+// registry holds the application's metrics plus the Go runtime and process collectors
+var registry = prometheus.NewRegistry()
+
+var (
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of HTTP requests served, by route pattern.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	mongoOperationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "mongodb",
+		Name:      "operation_duration_seconds",
+		Help:      "Duration of MongoDB commands, by command name.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"command", "outcome"})
+
+	mongoPoolOpen = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "mongodb",
+		Name:      "pool_open_connections",
+		Help:      "Connections open in a MongoDB client pool, by pool and server address.",
+	}, []string{"pool", "address"})
+
+	mongoPoolInUse = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "mongodb",
+		Name:      "pool_in_use_connections",
+		Help:      "Connections checked out of a MongoDB client pool, by pool and server address.",
+	}, []string{"pool", "address"})
+
+	mongoPoolMaxSize = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "mongodb",
+		Name:      "pool_max_size",
+		Help:      "Maximum connections of a MongoDB client pool per server; 0 means unlimited.",
+	}, []string{"pool", "address"})
+
+	mongoPoolCheckoutFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "mongodb",
+		Name:      "pool_checkout_failures_total",
+		Help:      "Connections a MongoDB client pool could not hand out, by reason (e.g. timeout).",
+	}, []string{"pool", "address", "reason"})
+
+	repositoryRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "mongodb",
+		Name:      "repository_retries_total",
+		Help:      "Repository operations retried after a timeout, network or connection error, by operation.",
+	}, []string{"operation"})
+
+	repositoryRetriesExhausted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "mongodb",
+		Name:      "repository_retries_exhausted_total",
+		Help:      "Repository operations that still failed after their retries, by operation.",
+	}, []string{"operation"})
+
+	externalRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "external",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of calls to external services such as IRIS, by endpoint.",
+		Buckets:   []float64{.01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"service", "endpoint", "status"})
+
+	externalCacheLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "external",
+		Name:      "cache_lookups_total",
+		Help:      "Lookups of cached external service data, by service, operation and result (hit, miss or stale).",
+	}, []string{"service", "operation", "result"})
+
+	schemaValidations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "schema",
+		Name:      "validations_total",
+		Help:      "Messages validated against their contract, by contract and direction (published or consumed).",
+	}, []string{"contract", "direction"})
+
+	schemaViolations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "schema",
+		Name:      "violations_total",
+		Help:      "Messages that broke their contract, by contract version (\"none\" when no contract is registered).",
+	}, []string{"contract", "version", "direction"})
+
+	capacityDataBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "capacity",
+		Name:      "data_bytes",
+		Help:      "Uncompressed size of the documents of a monitored MongoDB collection.",
+	}, []string{"collection"})
+
+	capacityIndexBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "capacity",
+		Name:      "index_bytes",
+		Help:      "Size of the indexes of a monitored MongoDB collection.",
+	}, []string{"collection"})
+
+	capacityLevel = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "capacity",
+		Name:      "level",
+		Help:      "Capacity level of a monitored collection: 0 under its limits, 1 over the soft limit, 2 over the hard limit.",
+	}, []string{"collection"})
+
+	capacitySampling = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "capacity",
+		Name:      "sampling",
+		Help:      "1 while only a share of new records of a collection is stored.",
+	}, []string{"collection"})
+
+	capacityArchived = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "capacity",
+		Name:      "archived_documents_total",
+		Help:      "Documents moved to the archive collection by the capacity monitor.",
+	}, []string{"collection"})
+
+	jobRunDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "job",
+		Name:      "run_duration_seconds",
+		Help:      "Duration of scheduler job runs, by job and outcome (succeeded, partial or failed).",
+		Buckets:   []float64{.1, .5, 1, 5, 15, 30, 60, 300, 900, 1800, 3600},
+	}, []string{"job", "outcome"})
+
+	jobItemsProcessed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "job",
+		Name:      "items_processed_total",
+		Help:      "Items completed by scheduler job runs.",
+	}, []string{"job"})
+
+	jobItemsFailed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "job",
+		Name:      "items_failed_total",
+		Help:      "Items scheduler job runs gave up on.",
+	}, []string{"job"})
+
+	jobLastSuccess = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "job",
+		Name:      "last_success_timestamp_seconds",
+		Help:      "Unix time of the last run of a job that succeeded, for alerting on jobs that stopped succeeding.",
+	}, []string{"job"})
+
+	queueJobsEnqueued = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "queue",
+		Name:      "jobs_enqueued_total",
+		Help:      "Jobs added to the background job queue, by type.",
+	}, []string{"type"})
+
+	queueAttemptDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "queue",
+		Name:      "attempt_duration_seconds",
+		Help:      "Duration of background job attempts, by type and outcome (succeeded, retried or failed).",
+		Buckets:   []float64{.05, .1, .5, 1, 5, 15, 30, 60, 300, 900},
+	}, []string{"type", "outcome"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequestDuration,
+		mongoOperationDuration,
+		mongoPoolOpen,
+		mongoPoolInUse,
+		mongoPoolMaxSize,
+		mongoPoolCheckoutFailures,
+		repositoryRetries,
+		repositoryRetriesExhausted,
+		externalRequestDuration,
+		externalCacheLookups,
+		schemaValidations,
+		schemaViolations,
+		capacityDataBytes,
+		capacityIndexBytes,
+		capacityLevel,
+		capacitySampling,
+		capacityArchived,
+		jobRunDuration,
+		jobItemsProcessed,
+		jobItemsFailed,
+		jobLastSuccess,
+		queueJobsEnqueued,
+		queueAttemptDuration,
+	)
+}
+
+// Handler serves the metrics in the Prometheus text format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
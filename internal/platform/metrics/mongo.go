@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/event"
+)
+
+// MongoCommandMonitor records the duration of every MongoDB command; set it on the client options
+func MongoCommandMonitor() *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Succeeded: func(_ context.Context, e *event.CommandSucceededEvent) {
+			mongoOperationDuration.WithLabelValues(e.CommandName, "success").Observe(e.Duration.Seconds())
+		},
+		Failed: func(_ context.Context, e *event.CommandFailedEvent) {
+			mongoOperationDuration.WithLabelValues(e.CommandName, "error").Observe(e.Duration.Seconds())
+		},
+	}
+}
+
+// ObserveMongoPool records the open and checked out connections of a client's pool to one server
+func ObserveMongoPool(pool, address string, open, inUse int64, maxSize uint64) {
+	mongoPoolOpen.WithLabelValues(pool, address).Set(float64(open))
+	mongoPoolInUse.WithLabelValues(pool, address).Set(float64(inUse))
+	mongoPoolMaxSize.WithLabelValues(pool, address).Set(float64(maxSize))
+}
+
+// ObserveMongoCheckoutFailure counts a connection the pool could not hand out, by the driver's reason
+func ObserveMongoCheckoutFailure(pool, address, reason string) {
+	mongoPoolCheckoutFailures.WithLabelValues(pool, address, reason).Inc()
+}
+
+// CountRepositoryRetry counts a repository operation attempted again after a retryable error
+func CountRepositoryRetry(operation string) {
+	repositoryRetries.WithLabelValues(operation).Inc()
+}
+
+// CountRepositoryRetriesExhausted counts a repository operation that failed after its retries
+func CountRepositoryRetriesExhausted(operation string) {
+	repositoryRetriesExhausted.WithLabelValues(operation).Inc()
+}
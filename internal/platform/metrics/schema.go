@@ -0,0 +1,9 @@
+package metrics
+
+// ObserveSchemaValidation records a message checked against its contract
+func ObserveSchemaValidation(contract, version, direction string, valid bool) {
+	schemaValidations.WithLabelValues(contract, direction).Inc()
+	if !valid {
+		schemaViolations.WithLabelValues(contract, version, direction).Inc()
+	}
+}
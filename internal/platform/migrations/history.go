@@ -0,0 +1,169 @@
+// Package migrations keeps the history of the maintenance commands that change the database, such
+// as cmd/reindex: what ran, when, from which host, each step's outcome, and the input needed to
+// resume a run that was paused or interrupted.
+package migrations
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// Status is the state of a run or one of its steps
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusPaused    Status = "paused" // Stopped on request; resume it to continue with the remaining steps
+	StatusSucceeded Status = "succeeded"
+	StatusSkipped   Status = "skipped" // Nothing to do, e.g. the index already existed
+	StatusFailed    Status = "failed"
+)
+
+// Run is one execution of a migration, as kept in the migrations collection
+type Run struct {
+	ID         string     `json:"id" bson:"_id"`
+	Kind       string     `json:"kind" bson:"kind"` // Command that ran it, e.g. reindex
+	Name       string     `json:"name" bson:"name"`
+	Host       string     `json:"host" bson:"host"`
+	Status     Status     `json:"status" bson:"status"`
+	StartedAt  time.Time  `json:"started_at" bson:"started_at"`
+	UpdatedAt  time.Time  `json:"updated_at" bson:"updated_at"`
+	FinishedAt *time.Time `json:"finished_at,omitempty" bson:"finished_at,omitempty"`
+	Steps      []Step     `json:"steps" bson:"steps"`
+	Error      string     `json:"error,omitempty" bson:"error,omitempty"`
+	// PauseRequested asks the process running the migration to stop after its current step
+	PauseRequested bool `json:"pause_requested,omitempty" bson:"pause_requested,omitempty"`
+	// Input is what the command needs to resume the run, e.g. the reindex plan
+	Input bson.Raw `json:"-" bson:"input,omitempty"`
+}
+
+// Step is one unit of work of a run
+type Step struct {
+	Name       string     `json:"name" bson:"name"`
+	Status     Status     `json:"status" bson:"status"`
+	StartedAt  *time.Time `json:"started_at,omitempty" bson:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty" bson:"finished_at,omitempty"`
+	DurationMs int64      `json:"duration_ms,omitempty" bson:"duration_ms,omitempty"`
+	Notes      []string   `json:"notes,omitempty" bson:"notes,omitempty"`
+	Error      string     `json:"error,omitempty" bson:"error,omitempty"`
+}
+
+// Done reports whether a resumed run can skip the step
+func (s Step) Done() bool {
+	return s.Status == StatusSucceeded || s.Status == StatusSkipped
+}
+
+// History persists migration runs in MongoDB
+type History struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewHistory creates the history over the migrations collection
+func NewHistory(collection *mongo.Collection, logger *zap.Logger) *History {
+	return &History{collection: collection, logger: logger}
+}
+
+// Save inserts the run or replaces the one with the same ID. A pause requested meanwhile is kept.
+func (h *History) Save(ctx context.Context, run Run) error {
+	run.UpdatedAt = time.Now()
+	stored, err := h.Get(ctx, run.ID)
+	if err == nil && stored.PauseRequested {
+		run.PauseRequested = true
+	}
+	if _, err := h.collection.ReplaceOne(ctx, bson.M{"_id": run.ID}, run, options.Replace().SetUpsert(true)); err != nil {
+		h.logger.Error("Failed to save migration run", zap.String("run_id", run.ID), zap.Error(err))
+		return platformErrors.HandleMongoError("Save", err)
+	}
+	return nil
+}
+
+// Get returns a RecordNotFoundError for unknown IDs
+func (h *History) Get(ctx context.Context, id string) (Run, error) {
+	var run Run
+	if err := h.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&run); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return Run{}, platformErrors.NewRecordNotFoundError("migration run", id)
+		}
+		return Run{}, platformErrors.HandleMongoError("Get", err)
+	}
+	return run, nil
+}
+
+// List returns the latest runs of a kind, newest first
+func (h *History) List(ctx context.Context, kind string, limit int) ([]Run, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "started_at", Value: -1}}).SetProjection(bson.M{"input": 0})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	cursor, err := h.collection.Find(ctx, bson.M{"kind": kind}, opts)
+	if err != nil {
+		return nil, platformErrors.HandleMongoError("List", err)
+	}
+	defer cursor.Close(ctx)
+
+	runs := []Run{}
+	if err := cursor.All(ctx, &runs); err != nil {
+		return nil, platformErrors.HandleMongoError("List", err)
+	}
+	return runs, nil
+}
+
+// RequestPause asks the process running a migration to stop after its current step. It returns a
+// ConflictError when the run is not running.
+func (h *History) RequestPause(ctx context.Context, id string) error {
+	result, err := h.collection.UpdateOne(ctx,
+		bson.M{"_id": id, "status": StatusRunning},
+		bson.M{"$set": bson.M{"pause_requested": true, "updated_at": time.Now()}})
+	if err != nil {
+		return platformErrors.HandleMongoError("RequestPause", err)
+	}
+	if result.MatchedCount == 0 {
+		if _, err := h.Get(ctx, id); err != nil {
+			return err
+		}
+		return platformErrors.NewConflictError("migration run", id, "only a running migration can be paused")
+	}
+	return nil
+}
+
+// PauseRequested reports whether a pause of the run was requested
+func (h *History) PauseRequested(ctx context.Context, id string) (bool, error) {
+	var run struct {
+		PauseRequested bool `bson:"pause_requested"`
+	}
+	err := h.collection.FindOne(ctx, bson.M{"_id": id}, options.FindOne().SetProjection(bson.M{"pause_requested": 1})).Decode(&run)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return false, platformErrors.HandleMongoError("PauseRequested", err)
+	}
+	return run.PauseRequested, nil
+}
+
+// ClearPause forgets a pause request, before a paused run is resumed
+func (h *History) ClearPause(ctx context.Context, id string) error {
+	if _, err := h.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$unset": bson.M{"pause_requested": ""}}); err != nil {
+		return platformErrors.HandleMongoError("ClearPause", err)
+	}
+	return nil
+}
+
+// CreateIndexes creates the index listing the runs of a kind
+func (h *History) CreateIndexes(ctx context.Context) error {
+	_, err := h.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "kind", Value: 1}, {Key: "started_at", Value: -1}},
+		Options: options.Index().SetName("kind_1_started_at_-1"),
+	})
+	if err != nil {
+		return platformErrors.HandleMongoError("CreateIndexes", err)
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package navigation
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/cache"
+)
+
+// Crumb is one page of the user's path through the UI
+type Crumb struct {
+	Label string `json:"label"`
+	Path  string `json:"path"` // Includes the query, so going back restores filters and search terms
+}
+
+// Trail is the navigation context of a page: the crumbs leading to it, ending with the page
+// itself, and the page its back link returns to
+type Trail struct {
+	Crumbs []Crumb
+	Back   Crumb
+}
+
+// BackStackConfig controls the navigation sessions
+type BackStackConfig struct {
+	CookieName string        // Holds the navigation session ID
+	SessionTTL time.Duration // A back-stack is forgotten after this long without navigation
+	MaxDepth   int           // Crumbs kept per session; the first (top-level) crumb is always kept
+	Secure     bool          // Sends the cookie over HTTPS only
+}
+
+// BackStack remembers, per browser session, the pages a user went through, so back links and
+// breadcrumbs follow the user's actual path rather than a fixed parent. Stacks live in the cache,
+// so they are shared by every instance using the same cache.
+type BackStack struct {
+	cache cache.Cache
+	cfg   BackStackConfig
+	log   *zap.Logger
+}
+
+// NewBackStack creates the back-stack service over the cache
+func NewBackStack(c cache.Cache, cfg BackStackConfig, log *zap.Logger) *BackStack {
+	if cfg.CookieName == "" {
+		cfg.CookieName = "rx_nav"
+	}
+	if cfg.SessionTTL <= 0 {
+		cfg.SessionTTL = 8 * time.Hour
+	}
+	if cfg.MaxDepth < 2 {
+		cfg.MaxDepth = 6
+	}
+	return &BackStack{cache: c, cfg: cfg, log: log}
+}
+
+// Root records a top-level page, such as a list, search or the dashboard: the user's path
+// starts over from it
+func (b *BackStack) Root(w http.ResponseWriter, r *http.Request, label string) Trail {
+	current := Crumb{Label: label, Path: r.URL.RequestURI()}
+	if b == nil {
+		return trailOf([]Crumb{current})
+	}
+	stack := []Crumb{current}
+	b.save(w, r, stack)
+	return trailOf(stack)
+}
+
+// Visit records a page below a top-level one and returns its trail. When the user came from a
+// page on their stack the trail continues from that page, and going back to a page already on
+// the stack drops the pages after it. Otherwise, e.g. for a bookmark or a pasted link, the trail
+// is parents, the default path to the route. A nil BackStack always uses parents.
+func (b *BackStack) Visit(w http.ResponseWriter, r *http.Request, label string, parents ...Crumb) Trail {
+	current := Crumb{Label: label, Path: r.URL.RequestURI()}
+	if b == nil {
+		return trailOf(append(append([]Crumb{}, parents...), current))
+	}
+
+	stack := b.load(r)
+	if i := indexOf(stack, r.URL.Path); i >= 0 {
+		// Back on a page of the stack, e.g. after saving an edit form; a POST keeps the URL it
+		// was reached with
+		if r.Method != http.MethodGet {
+			current.Path = stack[i].Path
+		}
+		stack = append(stack[:i], current)
+	} else if i := indexOf(stack, refererPath(r)); i >= 0 {
+		stack = append(stack[:i+1], current)
+	} else {
+		stack = append(append([]Crumb{}, parents...), current)
+	}
+
+	if len(stack) > b.cfg.MaxDepth {
+		stack = append(stack[:1], stack[len(stack)-b.cfg.MaxDepth+1:]...)
+	}
+	b.save(w, r, stack)
+	return trailOf(stack)
+}
+
+func (b *BackStack) load(r *http.Request) []Crumb {
+	sessionID := b.sessionID(r)
+	if sessionID == "" {
+		return nil
+	}
+	data, err := b.cache.Get(r.Context(), stackKey(sessionID))
+	if err != nil {
+		return nil
+	}
+	var stack []Crumb
+	if err := json.Unmarshal(data, &stack); err != nil {
+		return nil
+	}
+	return stack
+}
+
+// save stores the stack, starting a navigation session when the request has none. Failures only
+// cost the user their trail, so they are logged and ignored.
+func (b *BackStack) save(w http.ResponseWriter, r *http.Request, stack []Crumb) {
+	sessionID := b.sessionID(r)
+	if sessionID == "" {
+		id, err := newSessionID()
+		if err != nil {
+			b.log.Warn("failed to start navigation session", zap.Error(err))
+			return
+		}
+		sessionID = id
+		http.SetCookie(w, &http.Cookie{
+			Name:     b.cfg.CookieName,
+			Value:    sessionID,
+			Path:     "/",
+			Secure:   b.cfg.Secure,
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		})
+	}
+
+	data, err := json.Marshal(stack)
+	if err != nil {
+		return
+	}
+	if err := b.cache.Set(context.WithoutCancel(r.Context()), stackKey(sessionID), data, b.cfg.SessionTTL); err != nil {
+		b.log.Warn("failed to save navigation back-stack", zap.Error(err))
+	}
+}
+
+// sessionID returns the navigation session of the request; a malformed ID counts as none
+func (b *BackStack) sessionID(r *http.Request) string {
+	cookie, err := r.Cookie(b.cfg.CookieName)
+	if err != nil || len(cookie.Value) != sessionIDLength {
+		return ""
+	}
+	if _, err := hex.DecodeString(cookie.Value); err != nil {
+		return ""
+	}
+	return cookie.Value
+}
+
+func trailOf(stack []Crumb) Trail {
+	trail := Trail{Crumbs: stack}
+	if len(stack) > 1 {
+		trail.Back = stack[len(stack)-2]
+	}
+	return trail
+}
+
+// indexOf finds the crumb of a URL path; queries are ignored
+func indexOf(stack []Crumb, path string) int {
+	if path == "" {
+		return -1
+	}
+	for i, crumb := range stack {
+		if p, _, _ := strings.Cut(crumb.Path, "?"); p == path {
+			return i
+		}
+	}
+	return -1
+}
+
+// refererPath is the path of the page the request came from; htmx sends it for boosted links too
+func refererPath(r *http.Request) string {
+	referer, err := url.Parse(r.Referer())
+	if err != nil || (referer.Host != "" && referer.Host != r.Host) {
+		return ""
+	}
+	return referer.Path
+}
+
+func stackKey(sessionID string) string {
+	return "nav:" + sessionID
+}
+
+const sessionIDLength = 32
+
+func newSessionID() (string, error) {
+	b := make([]byte, sessionIDLength/2)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,51 @@
+// Package navigation describes the sidebar as data: sections of links, each link listing the
+// permissions that make it visible. The layout renders whatever Visible returns for the current user.
+// BackStack adds the user's own path on top: the breadcrumbs and back links of detail pages.
+package navigation
+
+import (
+	"context"
+
+	"pharmacy-modernization-project-model/internal/platform/auth"
+)
+
+// Item is a single navigation link
+type Item struct {
+	Label string // Message key of the link text, see i18n.Key
+	Path  string
+	// Permissions shows the link to users with ANY of them; empty shows it to everyone
+	Permissions []string
+	// NoBoost makes a full page load instead of an htmx swap, for pages with their own scripts
+	NoBoost bool
+	// External opens the link in a new tab
+	External bool
+}
+
+// Section is a titled group of links; the first section usually has no title
+type Section struct {
+	Title string // Message key of the title, see i18n.Key
+	Items []Item
+}
+
+// Visible returns the sections with only the links the user in ctx may see, dropping sections
+// that end up empty
+func Visible(ctx context.Context, sections []Section) []Section {
+	visible := make([]Section, 0, len(sections))
+	for _, section := range sections {
+		items := make([]Item, 0, len(section.Items))
+		for _, item := range section.Items {
+			if Allowed(ctx, item.Permissions) {
+				items = append(items, item)
+			}
+		}
+		if len(items) > 0 {
+			visible = append(visible, Section{Title: section.Title, Items: items})
+		}
+	}
+	return visible
+}
+
+// Allowed reports whether the user in ctx has any of the permissions; an empty list allows everyone
+func Allowed(ctx context.Context, permissions []string) bool {
+	return len(permissions) == 0 || auth.HasAnyPermissionCtx(ctx, permissions)
+}
@@ -0,0 +1,175 @@
+// Package pagination seals the cursors of connection-style lists. A cursor is the position after
+// the last item of a page, encrypted so clients cannot read or build one, and signed with an HMAC
+// so they cannot alter it. It also carries a hash of the list and filters it was issued for and
+// an expiry, so a cursor cannot be replayed against other filters to skip them, or kept forever.
+package pagination
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// Error codes of rejected cursors, in REST error responses and, upper-cased, in the extensions
+// of GraphQL errors
+const (
+	CodeInvalidCursor  = "invalid_cursor"  // Malformed, altered, or sealed with another key
+	CodeExpiredCursor  = "expired_cursor"  // Older than the cursor TTL; start again from the first page
+	CodeCursorMismatch = "cursor_mismatch" // Issued for another list or other filters
+)
+
+// CursorArgument is the argument or query parameter that takes the cursor of the next page
+const CursorArgument = "after"
+
+const (
+	cursorVersion = 1
+	keySize       = 32
+	ivSize        = aes.BlockSize
+	macSize       = sha256.Size
+)
+
+// CursorError explains why a cursor was rejected
+type CursorError struct {
+	Code   string
+	Reason string
+}
+
+func (e CursorError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Reason)
+}
+
+// Codec seals and opens cursors with a server key
+type Codec struct {
+	encKey []byte
+	macKey []byte
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+// NewCodec creates a codec from a 32-byte key; cursors it seals expire after ttl (24h when not
+// positive). Instances that serve the same lists must share the key.
+func NewCodec(key []byte, ttl time.Duration) (*Codec, error) {
+	if len(key) != keySize {
+		return nil, fmt.Errorf("cursor key must be %d bytes, got %d", keySize, len(key))
+	}
+	if ttl <= 0 {
+		ttl = 24 * time.Hour
+	}
+	return &Codec{
+		encKey: deriveKey(key, "pagination cursor encryption"),
+		macKey: deriveKey(key, "pagination cursor signature"),
+		ttl:    ttl,
+		now:    time.Now,
+	}, nil
+}
+
+// Filters identifies what a cursor pages through: the list's name and the value of every filter
+// that narrows it, e.g. Filters("prescription.history", prescriptionID). A cursor only opens for
+// the same list and filters.
+func Filters(list string, values ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(append([]string{list}, values...), "\x00")))
+	return hex.EncodeToString(sum[:16])
+}
+
+// sealed is the content of a cursor before encryption
+type sealed struct {
+	Filters  string          `json:"f"`
+	Expires  int64           `json:"e"`
+	Position json.RawMessage `json:"p"`
+}
+
+// Encode seals a position, any JSON-encodable value, into a cursor for the filters
+func (c *Codec) Encode(filters string, position any) (string, error) {
+	raw, err := json.Marshal(position)
+	if err != nil {
+		return "", fmt.Errorf("encode cursor position: %w", err)
+	}
+	plain, err := json.Marshal(sealed{Filters: filters, Expires: c.now().Add(c.ttl).Unix(), Position: raw})
+	if err != nil {
+		return "", err
+	}
+
+	token := make([]byte, 1+ivSize+len(plain), 1+ivSize+len(plain)+macSize)
+	token[0] = cursorVersion
+	iv := token[1 : 1+ivSize]
+	if _, err := rand.Read(iv); err != nil {
+		return "", err
+	}
+	block, err := aes.NewCipher(c.encKey)
+	if err != nil {
+		return "", err
+	}
+	cipher.NewCTR(block, iv).XORKeyStream(token[1+ivSize:], plain)
+	token = append(token, c.mac(token)...)
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// Decode opens a cursor issued for the filters into position, a pointer. It returns a
+// CursorError when the cursor is malformed, altered, expired or issued for other filters.
+func (c *Codec) Decode(cursor, filters string, position any) error {
+	token, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || len(token) < 1+ivSize+macSize || token[0] != cursorVersion {
+		return CursorError{Code: CodeInvalidCursor, Reason: "the cursor is malformed"}
+	}
+	body, tag := token[:len(token)-macSize], token[len(token)-macSize:]
+	if !hmac.Equal(tag, c.mac(body)) {
+		return CursorError{Code: CodeInvalidCursor, Reason: "the cursor was altered or not issued by this server"}
+	}
+
+	block, err := aes.NewCipher(c.encKey)
+	if err != nil {
+		return err
+	}
+	plain := make([]byte, len(body)-1-ivSize)
+	cipher.NewCTR(block, body[1:1+ivSize]).XORKeyStream(plain, body[1+ivSize:])
+
+	var content sealed
+	if err := json.Unmarshal(plain, &content); err != nil {
+		return CursorError{Code: CodeInvalidCursor, Reason: "the cursor is malformed"}
+	}
+	if content.Filters != filters {
+		return CursorError{Code: CodeCursorMismatch, Reason: "the cursor belongs to another list or other filters; start from the first page"}
+	}
+	if c.now().Unix() > content.Expires {
+		return CursorError{Code: CodeExpiredCursor, Reason: "the cursor expired; start from the first page"}
+	}
+	if err := json.Unmarshal(content.Position, position); err != nil {
+		return CursorError{Code: CodeInvalidCursor, Reason: "the cursor does not hold a position of this list"}
+	}
+	return nil
+}
+
+func (c *Codec) mac(data []byte) []byte {
+	h := hmac.New(sha256.New, c.macKey)
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// deriveKey gives the encryption and the signature their own key from the configured one
+func deriveKey(key []byte, purpose string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(purpose))
+	return h.Sum(nil)
+}
+
+// PageSize returns the page size to use for a requested limit: defaultSize when it is not set,
+// and a ValidationError when it exceeds maxSize
+func PageSize(limit, defaultSize, maxSize int) (int, error) {
+	if limit <= 0 {
+		return defaultSize, nil
+	}
+	if limit > maxSize {
+		return 0, platformErrors.NewValidationError("limit", limit, fmt.Sprintf("limit cannot exceed %d", maxSize))
+	}
+	return limit, nil
+}
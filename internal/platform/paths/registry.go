@@ -14,7 +14,69 @@ const (
 	ThemeJSPath = "/assets/vendor/theme-change.js"
 	MainJSPath  = "/assets/js/dist/main.js"
 
+	// Login
+	LoginPath       = "/login"
+	AuthPath        = "/auth"
+	AuthLoginPath   = "/auth/login"
+	AuthRefreshPath = "/auth/refresh"
+	AuthLogoutPath  = "/auth/logout"
+
+	// Permission catalogue and the caller's effective permissions, for UI feature gating
+	AuthPermissionsAPIPath = "/api/auth/permissions"
+	AuthMeAPIPath          = "/api/auth/me"
+
+	// Health endpoints
+	LivenessPath  = "/healthz"
+	ReadinessPath = "/readyz"
+
+	// Prometheus metrics
+	MetricsPath = "/metrics"
+
+	// Versioned REST API route groups, see internal/platform/apiversion
+	APIV1Path = "/api/v1"
+	APIV2Path = "/api/v2"
+
+	// Webhook registration API
+	WebhooksAPIPath = APIV1Path + "/webhooks"
+
+	// Access review reports
+	AccessReviewsAPIPath = APIV1Path + "/reports/access-reviews"
+
+	// Patient adherence reports for care management
+	AdherenceReportsAPIPath = APIV1Path + "/reports/adherence"
+
+	// Event contract registry
+	SchemasAPIPath = "/api/schemas"
+
+	// Integration status (mocked or real, circuit state)
+	IntegrationsStatusAPIPath = APIV1Path + "/integrations/status"
+	AdminIntegrationsPath     = "/admin/integrations"
+
+	// Scheduler job runs, manual runs and retries
+	JobsAPIPath   = APIV1Path + "/jobs"
+	AdminJobsPath = "/admin/jobs"
+
+	// Background job queue status
+	JobQueueAPIPath = "/api/jobs"
+
+	// Permission catalogue with the routes and fields that require each permission
+	AdminPermissionsPath = "/admin/permissions"
+
+	// Registered routes with the permission checks the route security manifest declares for them
+	AdminRoutesPath = "/admin/routes"
+
+	// Cache statistics, keys and invalidation
+	AdminCachePath = "/admin/cache"
+
+	// Dev mode mock users and the switch between them
+	DevUsersPath    = "/dev/users"
+	DevUsersAPIPath = "/dev/api/users"
+
+	// Billing discrepancies found by reconciliation with IRIS invoices
+	AdminBillingDiscrepanciesPath = "/admin/billing/discrepancies"
+
 	// GraphQL API
 	GraphQLPath       = "/graphql"
+	GraphQLSDLPath    = "/graphql/sdl" // Subgraph SDL, served in federation mode
 	GraphQLPlayground = "/playground"
 )
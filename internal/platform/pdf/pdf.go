@@ -0,0 +1,261 @@
+// Package pdf writes simple text documents, such as labels and summaries, as PDF without external
+// tools. Text flows from the top of the page down, wrapping at the margins and starting a new page
+// when the current one is full. The standard Helvetica fonts are used, so no font is embedded;
+// characters outside Latin-1 print as "?".
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// PageSize is the size of the pages in points (1/72 inch) and the margin kept on every side
+type PageSize struct {
+	Width, Height, Margin float64
+}
+
+var (
+	// Letter is a US Letter page
+	Letter = PageSize{Width: 612, Height: 792, Margin: 54}
+	// Label4x6 is a 4x6 inch label, the usual size of pharmacy label stock
+	Label4x6 = PageSize{Width: 288, Height: 432, Margin: 18}
+)
+
+const lineSpacing = 1.25 // Line height as a multiple of the font size
+
+// Document is a PDF being written; the zero value is not usable, use New
+type Document struct {
+	size  PageSize
+	title string
+	pages []*bytes.Buffer
+	y     float64 // Top of the next line on the current page, from the bottom
+}
+
+// New starts a document with one empty page; title is shown by PDF viewers
+func New(size PageSize, title string) *Document {
+	d := &Document{size: size, title: title}
+	d.newPage()
+	return d
+}
+
+// Title writes a large bold line
+func (d *Document) Title(text string) {
+	d.Paragraph(text, 16, true)
+	d.Space(4)
+}
+
+// Heading writes a bold line that starts a section
+func (d *Document) Heading(text string) {
+	d.Space(6)
+	d.Paragraph(text, 12, true)
+	d.Space(2)
+}
+
+// Text writes a wrapped paragraph in the body font
+func (d *Document) Text(text string) {
+	d.Paragraph(text, 10, false)
+}
+
+// Field writes "label: value" with the label in bold; empty values are skipped
+func (d *Document) Field(label, value string) {
+	if strings.TrimSpace(value) == "" {
+		return
+	}
+	const size = 10
+	prefix := label + ": "
+	indent := textWidth(prefix, size, true)
+	for i, line := range wrap(value, d.contentWidth()-indent, size, false) {
+		d.ensureSpace(size * lineSpacing)
+		if i == 0 {
+			d.showText(d.size.Margin, size, true, prefix)
+		}
+		d.showText(d.size.Margin+indent, size, false, line)
+		d.y -= size * lineSpacing
+	}
+}
+
+// Paragraph writes text wrapped to the page width; "\n" starts a new line
+func (d *Document) Paragraph(text string, size float64, bold bool) {
+	for _, line := range wrap(text, d.contentWidth(), size, bold) {
+		d.ensureSpace(size * lineSpacing)
+		d.showText(d.size.Margin, size, bold, line)
+		d.y -= size * lineSpacing
+	}
+}
+
+// Rule draws a thin horizontal line across the page
+func (d *Document) Rule() {
+	d.Space(4)
+	d.ensureSpace(1)
+	fmt.Fprintf(d.page(), "0.5 w %.2f %.2f m %.2f %.2f l S\n", d.size.Margin, d.y, d.size.Width-d.size.Margin, d.y)
+	d.Space(8)
+}
+
+// Space moves the next line down by points; it does not carry over to a new page
+func (d *Document) Space(points float64) {
+	d.y = max(d.y-points, d.size.Margin)
+}
+
+// WriteTo writes the finished document
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	var out bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	// Objects 1-4 are fixed; each page then takes two: the page and its content stream
+	const firstPage = 5
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPage+2*i)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	for i, content := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			d.size.Width, d.size.Height, firstPage+2*i+1))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.Bytes()))
+	}
+	object(fmt.Sprintf("<< /Title %s /Producer (pharmacy-modernization-project-model) /CreationDate (D:%s) >>",
+		literal(d.title), time.Now().UTC().Format("20060102150405Z")))
+	info := len(offsets)
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, info, xref)
+
+	return out.WriteTo(w)
+}
+
+func (d *Document) newPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+	d.y = d.size.Height - d.size.Margin
+}
+
+func (d *Document) page() *bytes.Buffer {
+	return d.pages[len(d.pages)-1]
+}
+
+// ensureSpace starts a new page when a line of height does not fit on the current one
+func (d *Document) ensureSpace(height float64) {
+	if d.y-height < d.size.Margin {
+		d.newPage()
+	}
+}
+
+func (d *Document) contentWidth() float64 {
+	return d.size.Width - 2*d.size.Margin
+}
+
+// showText writes text on the current line, its baseline one font size under the top
+func (d *Document) showText(x, size float64, bold bool, text string) {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+	fmt.Fprintf(d.page(), "BT /%s %.1f Tf %.2f %.2f Td %s Tj ET\n", font, size, x, d.y-size, literal(text))
+}
+
+// literal encodes text as a PDF string in WinAnsiEncoding
+func literal(text string) string {
+	var b strings.Builder
+	b.WriteByte('(')
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 0x20 && r < 0x7f:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+// wrap breaks text into lines no wider than width; words longer than a line are split
+func wrap(text string, width, size float64, bold bool) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+			candidate := word
+			if line != "" {
+				candidate = line + " " + word
+			}
+			if textWidth(candidate, size, bold) <= width {
+				line = candidate
+				continue
+			}
+			if line != "" {
+				lines = append(lines, line)
+			}
+			for textWidth(word, size, bold) > width {
+				cut := fitRunes(word, width, size, bold)
+				lines = append(lines, word[:cut])
+				word = word[cut:]
+			}
+			line = word
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// fitRunes returns the byte length of the longest prefix of word that fits in width, at least one rune
+func fitRunes(word string, width, size float64, bold bool) int {
+	cut := 0
+	for i, r := range word {
+		next := i + len(string(r))
+		if cut > 0 && textWidth(word[:next], size, bold) > width {
+			break
+		}
+		cut = next
+	}
+	return cut
+}
+
+// textWidth measures text in points with the Helvetica metrics
+func textWidth(text string, size float64, bold bool) float64 {
+	units := 0
+	for _, r := range text {
+		if r >= 0x20 && r < 0x7f {
+			units += helveticaWidths[r-0x20]
+		} else {
+			units += 556
+		}
+	}
+	width := float64(units) * size / 1000
+	if bold {
+		// Helvetica-Bold runs about 5% wider
+		width *= 1.05
+	}
+	return width
+}
+
+// helveticaWidths are the advance widths of the printable ASCII characters, from space to "~",
+// in 1/1000 of the font size
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
@@ -0,0 +1,60 @@
+// Package admin serves the permission catalogue as a page for admins, with the routes and
+// GraphQL fields that require each permission, and the effective route security mapping
+package admin
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	"pharmacy-modernization-project-model/internal/platform/paths"
+	"pharmacy-modernization-project-model/internal/platform/permissions"
+	admincomponents "pharmacy-modernization-project-model/web/components/admin"
+)
+
+// CatalogueAccess - the page lists every guarded route, so it is for admins; signed-in users
+// get the same catalogue as JSON from GET /api/auth/permissions
+var CatalogueAccess = []string{permissions.AdminAll}
+
+// RoutesResponse is the body of GET /admin/routes
+type RoutesResponse struct {
+	Routes []permissions.RouteMapping `json:"routes"`
+}
+
+// Handler serves the permissions page and the route mapping
+type Handler struct {
+	log *zap.Logger
+}
+
+func NewHandler(log *zap.Logger) *Handler {
+	return &Handler{log: log}
+}
+
+// RegisterRoutes mounts the admin page and the route mapping
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Group(func(r chi.Router) {
+		r.Use(auth.RequireAuthWithDevMode())
+		r.Use(auth.RequirePermissionsMatchAny(CatalogueAccess))
+		r.Get(paths.AdminPermissionsPath, h.Page)
+		r.Get(paths.AdminRoutesPath, h.Routes)
+	})
+}
+
+func (h *Handler) Page(w http.ResponseWriter, r *http.Request) {
+	page := admincomponents.PermissionsPage(admincomponents.PermissionsPageParam{
+		Permissions: permissions.Describe(),
+	})
+	if err := page.Render(r.Context(), w); err != nil {
+		h.log.Error("failed to render permissions page", zap.Error(err))
+		helper.WriteUIInternalError(w, "Failed to render permissions page")
+	}
+}
+
+// Routes lists every registered route with its permission checks and the manifest rule that
+// declares them
+func (h *Handler) Routes(w http.ResponseWriter, r *http.Request) {
+	helper.WriteOK(w, RoutesResponse{Routes: permissions.RouteMappings()})
+}
@@ -0,0 +1,185 @@
+// Package permissions is the catalogue of every permission the application checks. Routes,
+// GraphQL directives and UI gates refer to the constants here, and the route middleware refuses,
+// at wiring time, permissions that are not in the catalogue, so a typo fails at startup instead
+// of silently denying everyone.
+package permissions
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Permission is one entry of the catalogue
+type Permission struct {
+	Name        string `json:"name"`
+	Domain      string `json:"domain"`
+	Description string `json:"description"`
+	// Role marks a coarse role permission, such as doctor:role, granted with a functional role
+	Role bool `json:"role,omitempty"`
+	// Superuser marks a permission that satisfies every @hasPermission check, such as admin:all
+	Superuser bool `json:"superuser,omitempty"`
+}
+
+// Administration
+const (
+	AdminAll = "admin:all"
+)
+
+// Patients
+const (
+	PatientRead   = "patient:read"
+	PatientWrite  = "patient:write"
+	PatientDelete = "patient:delete"
+	PatientExport = "patient:export"
+)
+
+// Prescriptions
+const (
+	PrescriptionRead            = "prescription:read"
+	PrescriptionWrite           = "prescription:write"
+	PrescriptionApprove         = "prescription:approve"
+	PrescriptionDispense        = "prescription:dispense"
+	PrescriptionCancel          = "prescription:cancel"
+	PrescriptionReverseDispense = "prescription:reverse_dispense"
+)
+
+// Billing
+const (
+	BillingRead        = "billing:read"
+	BillingWrite       = "billing:write"
+	BillingAcknowledge = "billing:acknowledge"
+)
+
+// Dashboard
+const (
+	DashboardView      = "dashboard:view"
+	DashboardAnalytics = "dashboard:analytics"
+	DashboardReports   = "dashboard:reports"
+)
+
+// Reporting
+const (
+	AdherenceRead = "adherence:read"
+)
+
+// Operations
+const (
+	DataRepairRequest = "datarepair:request"
+	DataRepairApprove = "datarepair:approve"
+	WebhooksManage    = "webhooks:manage"
+	AccessReviewRead  = "accessreview:read"
+)
+
+// Roles
+const (
+	DoctorRole     = "doctor:role"
+	PharmacistRole = "pharmacist:role"
+	NurseRole      = "nurse:role"
+)
+
+var catalogue = []Permission{
+	{Name: AdminAll, Domain: "admin", Description: "Full access: passes every check that accepts admins and opens the admin pages", Superuser: true},
+
+	{Name: PatientRead, Domain: "patient", Description: "View patients, their addresses, insurance and measurements"},
+	{Name: PatientWrite, Domain: "patient", Description: "Create and edit patients and their addresses, insurance and measurements"},
+	{Name: PatientDelete, Domain: "patient", Description: "Delete patients, together with patient:write"},
+	{Name: PatientExport, Domain: "patient", Description: "Export patient data, together with patient:read"},
+
+	{Name: PrescriptionRead, Domain: "prescription", Description: "View prescriptions and their history"},
+	{Name: PrescriptionWrite, Domain: "prescription", Description: "Create and edit prescriptions"},
+	{Name: PrescriptionApprove, Domain: "prescription", Description: "Approve prescriptions, together with prescription:write"},
+	{Name: PrescriptionDispense, Domain: "prescription", Description: "Dispense prescriptions and record pickups"},
+	{Name: PrescriptionCancel, Domain: "prescription", Description: "Cancel prescriptions, together with prescription:write"},
+	{Name: PrescriptionReverseDispense, Domain: "prescription", Description: "Reverse a dispense; the pharmacist role alone does not allow it"},
+
+	{Name: BillingRead, Domain: "billing", Description: "View invoices and payments"},
+	{Name: BillingWrite, Domain: "billing", Description: "Create, adjust and void invoices"},
+	{Name: BillingAcknowledge, Domain: "billing", Description: "Acknowledge invoices"},
+
+	{Name: DashboardView, Domain: "dashboard", Description: "View the dashboard"},
+	{Name: DashboardAnalytics, Domain: "dashboard", Description: "View dashboard analytics, together with dashboard:view"},
+	{Name: DashboardReports, Domain: "dashboard", Description: "View dashboard reports, together with dashboard:view"},
+
+	{Name: AdherenceRead, Domain: "adherence", Description: "View patient adherence reports for care management"},
+
+	{Name: DataRepairRequest, Domain: "datarepair", Description: "Preview, request and execute data repairs"},
+	{Name: DataRepairApprove, Domain: "datarepair", Description: "Approve or reject pending data repairs"},
+	{Name: WebhooksManage, Domain: "webhooks", Description: "Register and remove webhook subscriptions"},
+	{Name: AccessReviewRead, Domain: "accessreview", Description: "View and generate access review reports"},
+
+	{Name: DoctorRole, Domain: "role", Description: "Prescriber role: create and edit prescriptions", Role: true},
+	{Name: PharmacistRole, Domain: "role", Description: "Pharmacist role: dispense prescriptions", Role: true},
+	{Name: NurseRole, Domain: "role", Description: "Nurse role", Role: true},
+}
+
+var byName = func() map[string]Permission {
+	m := make(map[string]Permission, len(catalogue))
+	for _, p := range catalogue {
+		m[p.Name] = p
+	}
+	return m
+}()
+
+// All returns the catalogue, grouped by domain
+func All() []Permission {
+	return slices.Clone(catalogue)
+}
+
+// Lookup returns the catalogue entry of a permission
+func Lookup(name string) (Permission, bool) {
+	p, ok := byName[name]
+	return p, ok
+}
+
+// IsRegistered reports whether the permission is in the catalogue
+func IsRegistered(name string) bool {
+	_, ok := byName[name]
+	return ok
+}
+
+// Validate returns an error naming the permissions that are not in the catalogue
+func Validate(names ...string) error {
+	var unknown []string
+	for _, name := range names {
+		if !IsRegistered(name) {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("unregistered permissions %q: add them to the catalogue in internal/platform/permissions", unknown)
+	}
+	return nil
+}
+
+// MustBeRegistered panics when a permission is not in the catalogue; route wiring calls it so a
+// misspelled permission stops the server from starting
+func MustBeRegistered(names ...string) {
+	if err := Validate(names...); err != nil {
+		panic(err)
+	}
+}
+
+// Accepting returns the permissions that satisfy a check for the named one: the permission itself,
+// then the superuser permissions of the catalogue
+func Accepting(name string) []string {
+	accepting := []string{name}
+	for _, p := range catalogue {
+		if p.Superuser && p.Name != name {
+			accepting = append(accepting, p.Name)
+		}
+	}
+	return accepting
+}
+
+// Effective returns the catalogued permissions among the granted ones, sorted and without
+// duplicates. Grants outside the catalogue are dropped: no check can require them.
+func Effective(granted []string) []string {
+	effective := make([]string, 0, len(granted))
+	for _, name := range granted {
+		if IsRegistered(name) {
+			effective = append(effective, name)
+		}
+	}
+	slices.Sort(effective)
+	return slices.Compact(effective)
+}
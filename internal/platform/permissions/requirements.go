@@ -0,0 +1,96 @@
+package permissions
+
+import (
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Requirement kinds
+const (
+	KindRoute   = "route"   // An HTTP route guarded by the auth permission middleware
+	KindGraphQL = "graphql" // A GraphQL field with a @hasPermission, @permissionAny or @permissionAll directive
+)
+
+// Requirement is one place that checks permissions
+type Requirement struct {
+	Kind   string `json:"kind"`
+	Method string `json:"method,omitempty"` // HTTP method of a route
+	// Path is the route pattern, e.g. /api/v1/patients/{patientID}, or Type.field for GraphQL
+	Path        string   `json:"path"`
+	Match       string   `json:"match"` // "any" or "all" of Permissions
+	Permissions []string `json:"permissions"`
+}
+
+// Documented is a catalogue entry with the routes and GraphQL fields that require it
+type Documented struct {
+	Permission
+	RequiredBy []Requirement `json:"required_by"`
+}
+
+var (
+	requirementsMu sync.RWMutex
+	requirements   = map[string]Requirement{}
+)
+
+// Require records where permissions are checked, for Describe. Route wiring and the GraphQL
+// server call it; recording the same check again has no effect.
+func Require(reqs ...Requirement) {
+	requirementsMu.Lock()
+	defer requirementsMu.Unlock()
+	for _, req := range reqs {
+		key := strings.Join([]string{req.Kind, req.Method, req.Path, req.Match, strings.Join(req.Permissions, ",")}, " ")
+		requirements[key] = req
+	}
+}
+
+// Requirements returns the recorded requirements, routes first, ordered by path and method
+func Requirements() []Requirement {
+	requirementsMu.RLock()
+	reqs := make([]Requirement, 0, len(requirements))
+	for _, req := range requirements {
+		reqs = append(reqs, req)
+	}
+	requirementsMu.RUnlock()
+
+	slices.SortFunc(reqs, func(a, b Requirement) int {
+		if c := strings.Compare(kindOrder(a.Kind), kindOrder(b.Kind)); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.Path, b.Path); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.Method, b.Method); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.Match, b.Match); c != 0 {
+			return c
+		}
+		return slices.Compare(a.Permissions, b.Permissions)
+	})
+	return reqs
+}
+
+// Describe returns the catalogue with, for each permission, the recorded requirements that
+// accept or need it
+func Describe() []Documented {
+	reqs := Requirements()
+	docs := make([]Documented, 0, len(catalogue))
+	for _, p := range catalogue {
+		doc := Documented{Permission: p, RequiredBy: []Requirement{}}
+		for _, req := range reqs {
+			if slices.Contains(req.Permissions, p.Name) {
+				doc.RequiredBy = append(doc.RequiredBy, req)
+			}
+		}
+		docs = append(docs, doc)
+	}
+	return docs
+}
+
+func kindOrder(kind string) string {
+	if kind == KindRoute {
+		return "0"
+	}
+	return "1" + kind
+}
@@ -0,0 +1,280 @@
+package permissions
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Guard is one permission check in front of a route
+type Guard struct {
+	Match       string   `json:"match"` // "any" or "all" of Permissions
+	Permissions []string `json:"permissions"`
+}
+
+// Route is a registered route with the permission checks in front of it
+type Route struct {
+	Method string  `json:"method"`
+	Path   string  `json:"path"` // Route pattern, e.g. /api/v1/patients/{patientID}
+	Guards []Guard `json:"guards"`
+}
+
+// RouteMapping is a registered route with the manifest rule that declares it
+type RouteMapping struct {
+	Route
+	Rule string `json:"rule"`
+}
+
+// RouteRule declares the permission checks of the routes it matches
+type RouteRule struct {
+	// Path is the route pattern; * matches one segment and a trailing ** the rest of the path
+	Path    string
+	Methods []string // All methods when empty
+	Guards  []Guard  // None for an unguarded rule
+	// Optional rules cover routes only mounted with some settings and may match nothing
+	Optional bool
+}
+
+// RouteManifest declares the permission checks of every route; the first rule matching a route
+// applies
+type RouteManifest struct {
+	Rules []RouteRule
+}
+
+// ManifestError lists every problem of a manifest, or every difference between the manifest
+// and the registered routes
+type ManifestError struct {
+	Problems []error
+}
+
+func (e ManifestError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "route security manifest: %d problem(s):", len(e.Problems))
+	for _, problem := range e.Problems {
+		b.WriteString("\n  - ")
+		b.WriteString(problem.Error())
+	}
+	return b.String()
+}
+
+func (e ManifestError) Unwrap() []error {
+	return e.Problems
+}
+
+//go:embed routes.yaml
+var defaultManifest []byte
+
+// manifestFile is the YAML form of a manifest, see routes.yaml
+type manifestFile struct {
+	Routes []struct {
+		Path      string   `yaml:"path"`
+		Methods   []string `yaml:"methods"`
+		Unguarded bool     `yaml:"unguarded"`
+		Optional  bool     `yaml:"optional"`
+		Guards    []struct {
+			Any []string `yaml:"any"`
+			All []string `yaml:"all"`
+		} `yaml:"guards"`
+	} `yaml:"routes"`
+}
+
+// routeMethods are the methods chi registers routes for
+var routeMethods = []string{
+	http.MethodConnect, http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodOptions,
+	http.MethodPatch, http.MethodPost, http.MethodPut, http.MethodTrace,
+}
+
+// DefaultRouteManifest returns the manifest shipped with the service
+// (internal/platform/permissions/routes.yaml)
+func DefaultRouteManifest() (*RouteManifest, error) {
+	return ParseRouteManifest(defaultManifest)
+}
+
+// LoadRouteManifest reads a manifest file, or returns the default one when path is empty
+func LoadRouteManifest(path string) (*RouteManifest, error) {
+	if path == "" {
+		return DefaultRouteManifest()
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("route security manifest: %w", err)
+	}
+	return ParseRouteManifest(data)
+}
+
+// ParseRouteManifest reads a manifest in the YAML form of routes.yaml. Every rule must either
+// list its guards or be marked unguarded, and guards may only name catalogued permissions.
+func ParseRouteManifest(data []byte) (*RouteManifest, error) {
+	var file manifestFile
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil {
+		return nil, fmt.Errorf("route security manifest: %w", err)
+	}
+
+	var problems []error
+	manifest := &RouteManifest{Rules: make([]RouteRule, 0, len(file.Routes))}
+	for i, entry := range file.Routes {
+		rule := RouteRule{Path: entry.Path, Methods: entry.Methods, Optional: entry.Optional}
+		name := fmt.Sprintf("routes[%d] %s", i, entry.Path)
+
+		if !strings.HasPrefix(entry.Path, "/") {
+			problems = append(problems, fmt.Errorf("%s: path must start with /", name))
+		}
+		if strings.Count(entry.Path, "**") > 1 || strings.Contains(entry.Path, "**") && !strings.HasSuffix(entry.Path, "/**") {
+			problems = append(problems, fmt.Errorf("%s: ** may only be the last segment", name))
+		}
+		for _, method := range entry.Methods {
+			if !slices.Contains(routeMethods, method) {
+				problems = append(problems, fmt.Errorf("%s: unknown method %q", name, method))
+			}
+		}
+		switch {
+		case entry.Unguarded && len(entry.Guards) > 0:
+			problems = append(problems, fmt.Errorf("%s: an unguarded rule cannot list guards", name))
+		case !entry.Unguarded && len(entry.Guards) == 0:
+			problems = append(problems, fmt.Errorf("%s: list the guards or mark the rule unguarded", name))
+		}
+		for _, g := range entry.Guards {
+			var guard Guard
+			switch {
+			case len(g.Any) > 0 && len(g.All) == 0:
+				guard = Guard{Match: "any", Permissions: g.Any}
+			case len(g.All) > 0 && len(g.Any) == 0:
+				guard = Guard{Match: "all", Permissions: g.All}
+			default:
+				problems = append(problems, fmt.Errorf("%s: each guard needs either any or all permissions", name))
+				continue
+			}
+			if err := Validate(guard.Permissions...); err != nil {
+				problems = append(problems, fmt.Errorf("%s: %w", name, err))
+			}
+			rule.Guards = append(rule.Guards, guard)
+		}
+		manifest.Rules = append(manifest.Rules, rule)
+	}
+	if len(problems) > 0 {
+		return nil, ManifestError{Problems: problems}
+	}
+	return manifest, nil
+}
+
+// Check maps every registered route to the rule declaring it. It fails with a ManifestError when
+// a route matches no rule, checks other permissions than its rule declares, or a rule that is
+// not optional matches no route; the mappings, ordered by path and method, are returned either way.
+func (m *RouteManifest) Check(routes []Route) ([]RouteMapping, error) {
+	routes = slices.Clone(routes)
+	slices.SortFunc(routes, func(a, b Route) int {
+		if c := strings.Compare(a.Path, b.Path); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Method, b.Method)
+	})
+
+	var problems []error
+	used := make([]bool, len(m.Rules))
+	mappings := make([]RouteMapping, 0, len(routes))
+	for _, route := range routes {
+		i := slices.IndexFunc(m.Rules, func(rule RouteRule) bool { return rule.matches(route) })
+		if i < 0 {
+			problems = append(problems, fmt.Errorf("%s %s is not declared", route.Method, route.Path))
+			mappings = append(mappings, RouteMapping{Route: route})
+			continue
+		}
+		used[i] = true
+		rule := m.Rules[i]
+		if !sameGuards(route.Guards, rule.Guards) {
+			problems = append(problems, fmt.Errorf("%s %s checks %s, but rule %s declares %s",
+				route.Method, route.Path, describeGuards(route.Guards), rule, describeGuards(rule.Guards)))
+		}
+		mappings = append(mappings, RouteMapping{Route: route, Rule: rule.String()})
+	}
+	for i, rule := range m.Rules {
+		if !used[i] && !rule.Optional {
+			problems = append(problems, fmt.Errorf("rule %s matches no registered route; remove it or mark it optional", rule))
+		}
+	}
+
+	if len(problems) > 0 {
+		return mappings, ManifestError{Problems: problems}
+	}
+	return mappings, nil
+}
+
+// String names the rule in problems and mappings, e.g. "/api/v1/patients/** [GET]"
+func (r RouteRule) String() string {
+	if len(r.Methods) == 0 {
+		return r.Path
+	}
+	return r.Path + " [" + strings.Join(r.Methods, " ") + "]"
+}
+
+func (r RouteRule) matches(route Route) bool {
+	if len(r.Methods) > 0 && !slices.Contains(r.Methods, route.Method) {
+		return false
+	}
+	pattern := strings.Split(strings.TrimPrefix(r.Path, "/"), "/")
+	segments := strings.Split(strings.TrimPrefix(route.Path, "/"), "/")
+	for i, want := range pattern {
+		if want == "**" {
+			return true
+		}
+		if i >= len(segments) {
+			return false
+		}
+		if want == "*" && segments[i] == "" || want != "*" && want != segments[i] {
+			return false
+		}
+	}
+	return len(segments) == len(pattern)
+}
+
+// sameGuards compares guards regardless of their order and the order of their permissions
+func sameGuards(a, b []Guard) bool {
+	return slices.Equal(guardKeys(a), guardKeys(b))
+}
+
+func guardKeys(guards []Guard) []string {
+	keys := make([]string, len(guards))
+	for i, g := range guards {
+		names := slices.Clone(g.Permissions)
+		slices.Sort(names)
+		keys[i] = g.Match + " of " + strings.Join(names, ", ")
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func describeGuards(guards []Guard) string {
+	if len(guards) == 0 {
+		return "no permissions"
+	}
+	return "(" + strings.Join(guardKeys(guards), ") and (") + ")"
+}
+
+var (
+	routesMu sync.RWMutex
+	routes   []RouteMapping
+)
+
+// RecordRoutes keeps the checked route mappings for RouteMappings; route wiring calls it once
+// every route is registered
+func RecordRoutes(mappings []RouteMapping) {
+	routesMu.Lock()
+	defer routesMu.Unlock()
+	routes = slices.Clone(mappings)
+}
+
+// RouteMappings returns the recorded route mappings, ordered by path and method
+func RouteMappings() []RouteMapping {
+	routesMu.RLock()
+	defer routesMu.RUnlock()
+	return slices.Clone(routes)
+}
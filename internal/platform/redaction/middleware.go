@@ -0,0 +1,135 @@
+package redaction
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/auth"
+)
+
+// Middleware redacts the JSON responses of every handler below it, REST and GraphQL alike.
+// It must run before authentication: the profile is chosen from the user the auth middleware
+// sets further down the chain, once the handler starts writing.
+func Middleware(redactor *Redactor, logger *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, currentUser := auth.TrackUser(r.Context())
+			ctx = context.WithValue(ctx, fieldCheckKey{}, func(field string) bool {
+				return redactor.RedactsField(redactor.ProfileFor(currentUser()), field)
+			})
+			rw := &responseWriter{ResponseWriter: w, redactor: redactor, currentUser: currentUser}
+			next.ServeHTTP(rw, r.WithContext(ctx))
+
+			if rw.buffer == nil {
+				return
+			}
+			if rw.buffer.Len() == 0 {
+				w.WriteHeader(rw.status)
+				return
+			}
+			if !rw.declaredJSON && !json.Valid(rw.buffer.Bytes()) {
+				// Plain text after all; JSON that arrived without a JSON content type is redacted below
+				if w.Header().Get("Content-Type") == "" {
+					w.Header().Set("Content-Type", http.DetectContentType(rw.buffer.Bytes()))
+				}
+				w.WriteHeader(rw.status)
+				_, _ = w.Write(rw.buffer.Bytes())
+				return
+			}
+			if w.Header().Get("Content-Type") == "" {
+				w.Header().Set("Content-Type", "application/json")
+			}
+			body, err := redactor.Apply(rw.profile, rw.buffer.Bytes())
+			if err != nil {
+				// Never fall back to the unredacted body
+				logger.Error("failed to redact response",
+					zap.String("profile", rw.profile.Name),
+					zap.String("path", r.URL.Path),
+					zap.Error(err))
+				http.Error(w, "failed to prepare response", http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+			w.WriteHeader(rw.status)
+			_, _ = w.Write(body)
+		})
+	}
+}
+
+type fieldCheckKey struct{}
+
+// FieldRedacted reports whether the current user's profile hides the JSON field. Handlers that
+// write other formats (CSV, spreadsheets) use it to blank the same fields themselves. It is false
+// when the request did not pass through Middleware.
+func FieldRedacted(ctx context.Context, field string) bool {
+	check, ok := ctx.Value(fieldCheckKey{}).(func(string) bool)
+	return ok && check(field)
+}
+
+// responseWriter buffers the responses that need redacting and passes the rest through. Only
+// responses known not to be JSON pass through: a missing or plain text content type is decided
+// from the body, so JSON written without a content type cannot leave unredacted. It deliberately
+// does not support hijacking, so no connection can bypass redaction.
+type responseWriter struct {
+	http.ResponseWriter
+	redactor    *Redactor
+	currentUser func() *auth.User
+
+	decided      bool
+	status       int
+	profile      Profile
+	buffer       *bytes.Buffer
+	declaredJSON bool // The content type says JSON, so a body that is not JSON is an error
+}
+
+func (rw *responseWriter) WriteHeader(status int) {
+	if rw.decided {
+		return
+	}
+	rw.decided = true
+	rw.status = status
+
+	rw.profile = rw.redactor.ProfileFor(rw.currentUser())
+	contentType := rw.Header().Get("Content-Type")
+	rw.declaredJSON = strings.Contains(contentType, "json")
+	if rw.redactor.Redacts(rw.profile) && (rw.declaredJSON || mayBeJSON(contentType)) {
+		rw.buffer = &bytes.Buffer{}
+		rw.Header().Del("Content-Length")
+		return
+	}
+	rw.ResponseWriter.WriteHeader(status)
+}
+
+func (rw *responseWriter) Write(b []byte) (int, error) {
+	if !rw.decided {
+		rw.WriteHeader(http.StatusOK)
+	}
+	if rw.buffer != nil {
+		return rw.buffer.Write(b)
+	}
+	return rw.ResponseWriter.Write(b)
+}
+
+// mayBeJSON reports whether a response of the content type may hold JSON all the same: no
+// content type, which net/http would sniff as text/plain, or plain text itself
+func mayBeJSON(contentType string) bool {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	mediaType = strings.TrimSpace(strings.ToLower(mediaType))
+	return mediaType == "" || mediaType == "text/plain"
+}
+
+// Flush is a no-op while buffering, since a partial JSON document cannot be redacted
+func (rw *responseWriter) Flush() {
+	if rw.buffer != nil {
+		return
+	}
+	if f, ok := rw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
@@ -0,0 +1,139 @@
+package redaction
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/auth"
+)
+
+// testProfiles are the profiles of internal/configs/app.yaml
+var testProfiles = []Profile{
+	{Name: "internal"},
+	{Name: "portal", ClientIDs: []string{"patient-portal"}, Scopes: []string{"portal"},
+		Fields: []string{"edit_by", "edit_time", "recorded_by", "interaction_warnings"}},
+	{Name: "reporting", ClientIDs: []string{"reporting-service"}, Scopes: []string{"reporting"},
+		Fields: []string{"name", "dob", "phone", "phone_masked", "line1", "line2", "zip", "matches", "edit_by", "recorded_by"}},
+}
+
+// serve runs one request through Middleware, authenticating user (nil for none) below it as
+// the auth middleware does, with a handler writing body under the content type
+func serve(t *testing.T, user *auth.User, contentType, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	redactor, err := New(testProfiles, "internal")
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user != nil {
+			r = r.WithContext(auth.SetUser(r.Context(), user))
+		}
+		if contentType != "" {
+			w.Header().Set("Content-Type", contentType)
+		}
+		_, _ = w.Write([]byte(body))
+	})
+
+	rec := httptest.NewRecorder()
+	Middleware(redactor, zap.NewNop())(handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	return rec
+}
+
+// wantJSON checks that the response holds the JSON document want
+func wantJSON(t *testing.T, rec *httptest.ResponseRecorder, want string) {
+	t.Helper()
+	var got, expected any
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("response is not JSON: %v\n%s", err, rec.Body.String())
+	}
+	if err := json.Unmarshal([]byte(want), &expected); err != nil {
+		t.Fatalf("want is not JSON: %v", err)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %s\nwant %s", rec.Body.String(), want)
+	}
+}
+
+const restPatient = `{"id":"P001","name":"Ava Thompson","dob":"1988-01-12","phone":"(206) 417-8842",` +
+	`"phone_masked":"***-***-8842","address":{"line1":"1 Main St","city":"Seattle","zip":"98101"},` +
+	`"edit_by":"doctor","recorded_by":"nurse","interaction_warnings":["warfarin"]}`
+
+const graphqlPatient = `{"data":{"patient":{"id":"P001","name":"Ava Thompson","dob":"1988-01-12","phone":"(206) 417-8842",` +
+	`"addresses":[{"line1":"1 Main St","zip":"98101"}],"measurements":[{"value":70,"recordedBy":"nurse"}],` +
+	`"interactionWarnings":["warfarin"]}}}`
+
+func TestMiddlewareREST(t *testing.T) {
+	tests := []struct {
+		name string
+		user *auth.User
+		want string
+	}{
+		{"internal", &auth.User{ID: "doctor"}, restPatient},
+		{"portal", &auth.User{ID: "p1", ClientID: "patient-portal"},
+			`{"id":"P001","name":"Ava Thompson","dob":"1988-01-12","phone":"(206) 417-8842",` +
+				`"phone_masked":"***-***-8842","address":{"line1":"1 Main St","city":"Seattle","zip":"98101"},` +
+				`"edit_by":null,"recorded_by":null,"interaction_warnings":null}`},
+		{"reporting", &auth.User{ID: "r1", Scopes: []string{"reporting"}},
+			`{"id":"P001","name":null,"dob":null,"phone":null,"phone_masked":null,` +
+				`"address":{"line1":null,"city":"Seattle","zip":null},` +
+				`"edit_by":null,"recorded_by":null,"interaction_warnings":["warfarin"]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(t, tt.user, "application/json", restPatient)
+			wantJSON(t, rec, tt.want)
+		})
+	}
+}
+
+func TestMiddlewareGraphQL(t *testing.T) {
+	tests := []struct {
+		name string
+		user *auth.User
+		want string
+	}{
+		{"internal", &auth.User{ID: "doctor"}, graphqlPatient},
+		{"portal", &auth.User{ID: "p1", Scopes: []string{"portal"}},
+			`{"data":{"patient":{"id":"P001","name":"Ava Thompson","dob":"1988-01-12","phone":"(206) 417-8842",` +
+				`"addresses":[{"line1":"1 Main St","zip":"98101"}],"measurements":[{"value":70,"recordedBy":null}],` +
+				`"interactionWarnings":null}}}`},
+		{"reporting", &auth.User{ID: "r1", ClientID: "reporting-service"},
+			`{"data":{"patient":{"id":"P001","name":null,"dob":null,"phone":null,` +
+				`"addresses":[{"line1":null,"zip":null}],"measurements":[{"value":70,"recordedBy":null}],` +
+				`"interactionWarnings":["warfarin"]}}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(t, tt.user, "application/json", graphqlPatient)
+			wantJSON(t, rec, tt.want)
+		})
+	}
+}
+
+func TestMiddlewareMissingContentType(t *testing.T) {
+	rec := serve(t, &auth.User{ID: "r1", Scopes: []string{"reporting"}}, "", `{"id":"P001","dob":"1988-01-12"}`)
+	wantJSON(t, rec, `{"id":"P001","dob":null}`)
+	if got := rec.Header().Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", got)
+	}
+
+	// Plain text is passed through as written
+	rec = serve(t, &auth.User{ID: "r1", Scopes: []string{"reporting"}}, "", "name: Ava Thompson")
+	if got := rec.Body.String(); got != "name: Ava Thompson" {
+		t.Errorf("body = %q, want the text unchanged", got)
+	}
+}
+
+func TestMiddlewareProblemDetails(t *testing.T) {
+	problem := `{"type":"about:blank","title":"Conflict","status":409,"detail":"duplicate patient","matches":[{"id":"P001","name":"Ava Thompson"}]}`
+	rec := serve(t, &auth.User{ID: "r1", ClientID: "reporting-service"}, "application/problem+json", problem)
+	wantJSON(t, rec, `{"type":"about:blank","title":"Conflict","status":409,"detail":"duplicate patient","matches":null}`)
+	if got := rec.Header().Get("Content-Type"); got != "application/problem+json" {
+		t.Errorf("Content-Type = %q, want application/problem+json", got)
+	}
+}
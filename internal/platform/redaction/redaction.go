@@ -0,0 +1,204 @@
+// Package redaction hides response fields from client applications that must not see them.
+// Each client application is mapped to a profile (by token client ID or scope) listing the
+// JSON fields it may not receive; those fields are replaced with null in every JSON response.
+package redaction
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"pharmacy-modernization-project-model/internal/platform/auth"
+)
+
+// Profile is the field set one kind of client application is allowed to see
+type Profile struct {
+	Name      string
+	ClientIDs []string // Token client IDs (client_id / appid) that use this profile
+	Scopes    []string // Token scopes that select this profile
+	Fields    []string // JSON field names to redact, e.g. "dob" or "patient_id"
+}
+
+// Redactor selects a profile for a user and redacts JSON documents for it
+type Redactor struct {
+	profiles       []Profile
+	fields         map[string]map[string]bool // profile name -> normalized field names
+	defaultProfile Profile
+}
+
+// New validates the profiles and returns a Redactor. Users whose token matches no profile
+// get defaultProfile.
+func New(profiles []Profile, defaultProfile string) (*Redactor, error) {
+	r := &Redactor{fields: map[string]map[string]bool{}}
+	for _, p := range profiles {
+		if p.Name == "" {
+			return nil, fmt.Errorf("redaction profile without a name")
+		}
+		if _, dup := r.fields[p.Name]; dup {
+			return nil, fmt.Errorf("duplicate redaction profile %q", p.Name)
+		}
+		fields := make(map[string]bool, len(p.Fields))
+		for _, f := range p.Fields {
+			fields[normalize(f)] = true
+		}
+		r.fields[p.Name] = fields
+		r.profiles = append(r.profiles, p)
+	}
+
+	if defaultProfile == "" {
+		return nil, fmt.Errorf("no default redaction profile configured")
+	}
+	i := slices.IndexFunc(r.profiles, func(p Profile) bool { return p.Name == defaultProfile })
+	if i < 0 {
+		return nil, fmt.Errorf("default redaction profile %q is not defined", defaultProfile)
+	}
+	r.defaultProfile = r.profiles[i]
+	return r, nil
+}
+
+// ProfileFor returns the first profile, in configuration order, matching the user's client ID
+// or one of its scopes; otherwise, and for unauthenticated requests, the default profile
+func (r *Redactor) ProfileFor(user *auth.User) Profile {
+	if user == nil {
+		return r.defaultProfile
+	}
+	for _, p := range r.profiles {
+		if user.ClientID != "" && slices.Contains(p.ClientIDs, user.ClientID) {
+			return p
+		}
+		for _, scope := range user.Scopes {
+			if slices.Contains(p.Scopes, scope) {
+				return p
+			}
+		}
+	}
+	return r.defaultProfile
+}
+
+// Redacts reports whether the profile hides any field
+func (r *Redactor) Redacts(profile Profile) bool {
+	return len(r.fields[profile.Name]) > 0
+}
+
+// RedactsField reports whether the profile hides the JSON field
+func (r *Redactor) RedactsField(profile Profile, field string) bool {
+	return r.fields[profile.Name][normalize(field)]
+}
+
+// Apply replaces every redacted field of the JSON document with null, at any depth, keeping
+// the order of the remaining fields (GraphQL responses follow the query's field order). Field
+// names match regardless of case, underscores and hyphens, so "patient_id" also covers the
+// GraphQL "patientID". A document that is not valid JSON is an error, never passed through.
+func (r *Redactor) Apply(profile Profile, document []byte) ([]byte, error) {
+	fields := r.fields[profile.Name]
+	if len(fields) == 0 {
+		return document, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(document))
+	dec.UseNumber()
+	var out bytes.Buffer
+	if err := redactValue(dec, &out, fields); err != nil {
+		return nil, fmt.Errorf("redaction: %w", err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("redaction: response holds more than one JSON value")
+	}
+	out.WriteByte('\n')
+	return out.Bytes(), nil
+}
+
+// redactValue copies the next JSON value from dec to out, nulling redacted object fields
+func redactValue(dec *json.Decoder, out *bytes.Buffer, fields map[string]bool) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	switch t := tok.(type) {
+	case json.Delim:
+		if t == '{' {
+			out.WriteByte('{')
+			for first := true; dec.More(); first = false {
+				keyTok, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				key, _ := keyTok.(string)
+				if !first {
+					out.WriteByte(',')
+				}
+				if err := writeScalar(out, key); err != nil {
+					return err
+				}
+				out.WriteByte(':')
+				if fields[normalize(key)] {
+					if err := skipValue(dec); err != nil {
+						return err
+					}
+					out.WriteString("null")
+					continue
+				}
+				if err := redactValue(dec, out, fields); err != nil {
+					return err
+				}
+			}
+			out.WriteByte('}')
+		} else {
+			out.WriteByte('[')
+			for first := true; dec.More(); first = false {
+				if !first {
+					out.WriteByte(',')
+				}
+				if err := redactValue(dec, out, fields); err != nil {
+					return err
+				}
+			}
+			out.WriteByte(']')
+		}
+		// Closing delimiter
+		_, err := dec.Token()
+		return err
+	default:
+		return writeScalar(out, t)
+	}
+}
+
+// skipValue consumes the next JSON value from dec
+func skipValue(dec *json.Decoder) error {
+	depth := 0
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if d, ok := tok.(json.Delim); ok {
+			if d == '{' || d == '[' {
+				depth++
+			} else {
+				depth--
+			}
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+func writeScalar(out *bytes.Buffer, v any) error {
+	enc := json.NewEncoder(out)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	// Encode appends a newline
+	out.Truncate(out.Len() - 1)
+	return nil
+}
+
+func normalize(field string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(field))
+}
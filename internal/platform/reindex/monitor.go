@@ -0,0 +1,114 @@
+package reindex
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// errNoReplication is returned by replicationLag on a standalone server
+var errNoReplication = errors.New("not a replica set")
+
+// Progress is the state of an index build as the server reports it
+type Progress struct {
+	Collection string
+	Indexes    []string
+	Phase      string // e.g. "Index Build: scanning collection"
+	Done       int64
+	Total      int64
+}
+
+// Percent is the share of the current phase that is done, or -1 when the server did not say
+func (p Progress) Percent() float64 {
+	if p.Total <= 0 {
+		return -1
+	}
+	return float64(p.Done) * 100 / float64(p.Total)
+}
+
+// currentOp is the part of a $currentOp entry of an index build the monitor reads
+type currentOp struct {
+	Command struct {
+		CreateIndexes string `bson:"createIndexes"`
+		Indexes       []struct {
+			Name string `bson:"name"`
+		} `bson:"indexes"`
+	} `bson:"command"`
+	Msg      string `bson:"msg"`
+	Progress struct {
+		Done  int64 `bson:"done"`
+		Total int64 `bson:"total"`
+	} `bson:"progress"`
+}
+
+// buildsInProgress returns the index builds running on a namespace, e.g. ones started by an
+// interrupted run that the server carried on with
+func buildsInProgress(ctx context.Context, admin *mongo.Database, namespace string) ([]Progress, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$currentOp", Value: bson.D{{Key: "allUsers", Value: true}, {Key: "idleConnections", Value: false}}}},
+		{{Key: "$match", Value: bson.D{
+			{Key: "ns", Value: namespace},
+			{Key: "command.createIndexes", Value: bson.D{{Key: "$exists", Value: true}}},
+		}}},
+	}
+	cursor, err := admin.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var ops []currentOp
+	if err := cursor.All(ctx, &ops); err != nil {
+		return nil, err
+	}
+	builds := make([]Progress, 0, len(ops))
+	for _, op := range ops {
+		build := Progress{
+			Collection: op.Command.CreateIndexes,
+			Phase:      op.Msg,
+			Done:       op.Progress.Done,
+			Total:      op.Progress.Total,
+		}
+		for _, index := range op.Command.Indexes {
+			build.Indexes = append(build.Indexes, index.Name)
+		}
+		builds = append(builds, build)
+	}
+	return builds, nil
+}
+
+// replicationLag returns how far the slowest secondary is behind the primary, or errNoReplication
+// on a standalone server
+func replicationLag(ctx context.Context, admin *mongo.Database) (time.Duration, error) {
+	var status struct {
+		Members []struct {
+			StateStr   string    `bson:"stateStr"`
+			OptimeDate time.Time `bson:"optimeDate"`
+		} `bson:"members"`
+	}
+	err := admin.RunCommand(ctx, bson.D{{Key: "replSetGetStatus", Value: 1}}).Decode(&status)
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) && cmdErr.Code == 76 { // NoReplicationEnabled
+		return 0, errNoReplication
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	var primary time.Time
+	for _, member := range status.Members {
+		if member.StateStr == "PRIMARY" {
+			primary = member.OptimeDate
+		}
+	}
+	var lag time.Duration
+	for _, member := range status.Members {
+		if member.StateStr == "SECONDARY" && !primary.IsZero() {
+			lag = max(lag, primary.Sub(member.OptimeDate))
+		}
+	}
+	return lag, nil
+}
@@ -0,0 +1,137 @@
+// Package reindex builds MongoDB indexes on large collections without blocking writes. Index builds
+// run in the background (the only kind from MongoDB 4.2), one at a time or one collection at a
+// time, waiting for secondaries to catch up between builds so replication lag does not pile up.
+// Progress is read from the server while a build runs, each index's target queries are explained
+// afterwards to check they use it, and every run is recorded in the migration history so it can be
+// paused and resumed.
+package reindex
+
+import (
+	"fmt"
+	"os"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Strategy decides how the indexes of a plan are grouped into builds
+type Strategy string
+
+const (
+	// StrategyRolling builds one index at a time and waits for secondaries to catch up before the
+	// next, keeping the load of each build small
+	StrategyRolling Strategy = "rolling"
+	// StrategyBatch builds all indexes of a collection in one build, scanning it once; faster, but
+	// each build holds more memory and produces a larger burst of oplog entries
+	StrategyBatch Strategy = "batch"
+)
+
+// Plan lists the indexes to build, read from an extended JSON file:
+//
+//	{
+//	  "name": "prescriptions-by-patient",
+//	  "strategy": "rolling",
+//	  "indexes": [{
+//	    "collection": "prescriptions",
+//	    "name": "patient_id_1_created_at_-1",
+//	    "keys": {"patient_id": 1, "created_at": -1},
+//	    "verify": [{"filter": {"patient_id": "P001"}, "sort": {"created_at": -1}}]
+//	  }]
+//	}
+type Plan struct {
+	Name     string   `bson:"name"`
+	Strategy Strategy `bson:"strategy,omitempty"`
+	Indexes  []Index  `bson:"indexes"`
+}
+
+// Index is an index of a plan and the queries that must use it once built
+type Index struct {
+	Collection         string  `bson:"collection"` // Logical name, as under database.mongodb.collections
+	Name               string  `bson:"name"`
+	Keys               bson.D  `bson:"keys"`
+	Unique             bool    `bson:"unique,omitempty"`
+	Sparse             bool    `bson:"sparse,omitempty"`
+	PartialFilter      bson.D  `bson:"partial_filter,omitempty"`
+	ExpireAfterSeconds *int32  `bson:"expire_after_seconds,omitempty"`
+	Verify             []Query `bson:"verify,omitempty"`
+}
+
+// Query is a find whose winning plan must scan the index
+type Query struct {
+	Filter bson.D `bson:"filter"`
+	Sort   bson.D `bson:"sort,omitempty"`
+}
+
+// StepName identifies the index in the steps of a run
+func (i Index) StepName() string {
+	return i.Collection + "." + i.Name
+}
+
+// LoadPlan reads and checks a plan file
+func LoadPlan(path string) (Plan, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Plan{}, err
+	}
+	var plan Plan
+	if err := bson.UnmarshalExtJSON(data, false, &plan); err != nil {
+		return Plan{}, fmt.Errorf("parse plan %s: %w", path, err)
+	}
+	if err := plan.Validate(); err != nil {
+		return Plan{}, fmt.Errorf("plan %s: %w", path, err)
+	}
+	return plan, nil
+}
+
+// Validate checks the plan and defaults its strategy to rolling
+func (p *Plan) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	switch p.Strategy {
+	case "":
+		p.Strategy = StrategyRolling
+	case StrategyRolling, StrategyBatch:
+	default:
+		return fmt.Errorf("unknown strategy %q (use %s or %s)", p.Strategy, StrategyRolling, StrategyBatch)
+	}
+	if len(p.Indexes) == 0 {
+		return fmt.Errorf("no indexes to build")
+	}
+
+	seen := map[string]bool{}
+	for i, index := range p.Indexes {
+		if index.Collection == "" || index.Name == "" || len(index.Keys) == 0 {
+			return fmt.Errorf("index %d: collection, name and keys are required", i+1)
+		}
+		if seen[index.StepName()] {
+			return fmt.Errorf("index %s is listed twice", index.StepName())
+		}
+		seen[index.StepName()] = true
+	}
+	return nil
+}
+
+// groups splits the indexes into builds: one per index when rolling, one per collection when
+// batching, in the order the plan lists them
+func (p Plan) groups() [][]Index {
+	if p.Strategy != StrategyBatch {
+		groups := make([][]Index, len(p.Indexes))
+		for i, index := range p.Indexes {
+			groups[i] = []Index{index}
+		}
+		return groups
+	}
+
+	var groups [][]Index
+	position := map[string]int{}
+	for _, index := range p.Indexes {
+		i, ok := position[index.Collection]
+		if !ok {
+			i = len(groups)
+			position[index.Collection] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], index)
+	}
+	return groups
+}
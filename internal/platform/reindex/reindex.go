@@ -0,0 +1,491 @@
+package reindex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/migrations"
+)
+
+// Kind identifies reindex runs in the migration history
+const Kind = "reindex"
+
+// ErrPaused is returned when a run stopped on a pause request or an interrupt; resume it to build
+// the remaining indexes
+var ErrPaused = errors.New("reindex paused")
+
+// Options controls a reindex; zero values take the defaults
+type Options struct {
+	MaxLag       time.Duration        // Rolling builds wait until secondaries are this close to the primary; defaults to 10s
+	PollInterval time.Duration        // Progress, lag and pause requests are checked this often; defaults to 5s
+	Notify       func(message string) // Receives progress and outcome messages; optional
+}
+
+// Reindexer builds the indexes of plans and records each run in the migration history
+type Reindexer struct {
+	admin      *mongo.Database
+	collection func(name string) *mongo.Collection
+	history    *migrations.History
+	opts       Options
+	paused     atomic.Bool
+}
+
+// New creates a reindexer; collection resolves the logical collection names of plans
+func New(client *mongo.Client, collection func(name string) *mongo.Collection, history *migrations.History, opts Options) *Reindexer {
+	if opts.MaxLag <= 0 {
+		opts.MaxLag = 10 * time.Second
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = 5 * time.Second
+	}
+	if opts.Notify == nil {
+		opts.Notify = func(string) {}
+	}
+	return &Reindexer{admin: client.Database("admin"), collection: collection, history: history, opts: opts}
+}
+
+// Pause stops the run once the build in progress finishes
+func (r *Reindexer) Pause() {
+	r.paused.Store(true)
+}
+
+// Start records a new run of the plan and builds its indexes. It returns the run as recorded,
+// with ErrPaused when it stopped before the end.
+func (r *Reindexer) Start(ctx context.Context, plan Plan) (migrations.Run, error) {
+	input, err := bson.Marshal(plan)
+	if err != nil {
+		return migrations.Run{}, err
+	}
+	host, _ := os.Hostname()
+	run := migrations.Run{
+		ID:        uuid.NewString(),
+		Kind:      Kind,
+		Name:      plan.Name,
+		Host:      host,
+		Status:    migrations.StatusRunning,
+		StartedAt: time.Now(),
+		Input:     input,
+	}
+	for _, index := range plan.Indexes {
+		run.Steps = append(run.Steps, migrations.Step{Name: index.StepName(), Status: migrations.StatusPending})
+	}
+	if err := r.history.Save(ctx, run); err != nil {
+		return run, err
+	}
+	return r.run(ctx, plan, run)
+}
+
+// Resume continues a paused, failed or interrupted run with the indexes it has not built yet
+func (r *Reindexer) Resume(ctx context.Context, id string) (migrations.Run, error) {
+	run, err := r.history.Get(ctx, id)
+	if err != nil {
+		return run, err
+	}
+	if run.Kind != Kind {
+		return run, platformErrors.NewConflictError("migration run", id, "not a reindex run")
+	}
+	if run.Status == migrations.StatusSucceeded {
+		return run, platformErrors.NewConflictError("migration run", id, "the run already succeeded")
+	}
+	var plan Plan
+	if err := bson.Unmarshal(run.Input, &plan); err != nil {
+		return run, fmt.Errorf("read plan of run %s: %w", id, err)
+	}
+
+	if err := r.history.ClearPause(ctx, id); err != nil {
+		return run, err
+	}
+	run.PauseRequested = false
+	run.Status = migrations.StatusRunning
+	run.Error = ""
+	run.FinishedAt = nil
+	host, _ := os.Hostname()
+	run.Host = host
+	if err := r.history.Save(ctx, run); err != nil {
+		return run, err
+	}
+	return r.run(ctx, plan, run)
+}
+
+func (r *Reindexer) run(ctx context.Context, plan Plan, run migrations.Run) (migrations.Run, error) {
+	for _, group := range plan.groups() {
+		var pending []Index
+		for _, index := range group {
+			if !step(&run, index).Done() {
+				pending = append(pending, index)
+			}
+		}
+		if len(pending) == 0 {
+			continue
+		}
+		if ctx.Err() != nil || r.pauseRequested(ctx, run.ID) {
+			return r.finish(ctx, run, migrations.StatusPaused)
+		}
+
+		if err := r.build(ctx, plan.Strategy, pending, &run); err != nil {
+			if errors.Is(err, ErrPaused) || ctx.Err() != nil {
+				return r.finish(ctx, run, migrations.StatusPaused)
+			}
+			run.Error = err.Error()
+			return r.finish(ctx, run, migrations.StatusFailed)
+		}
+		if err := r.history.Save(ctx, run); err != nil {
+			return run, err
+		}
+	}
+
+	status := migrations.StatusSucceeded
+	for _, s := range run.Steps {
+		if s.Status == migrations.StatusFailed {
+			status = migrations.StatusFailed
+		}
+	}
+	return r.finish(ctx, run, status)
+}
+
+// build builds a group of indexes of one collection, skipping those that already exist, and
+// verifies their target queries
+func (r *Reindexer) build(ctx context.Context, strategy Strategy, indexes []Index, run *migrations.Run) error {
+	coll := r.collection(indexes[0].Collection)
+	namespace := coll.Database().Name() + "." + coll.Name()
+
+	existing, err := existingIndexes(ctx, coll)
+	if err != nil {
+		return fmt.Errorf("list indexes of %s: %w", namespace, err)
+	}
+	var missing []Index
+	for _, index := range indexes {
+		s := step(run, index)
+		keys, ok := existing[index.Name]
+		switch {
+		case !ok:
+			missing = append(missing, index)
+		case keys == keyString(index.Keys):
+			s.Status = migrations.StatusSkipped
+			s.Notes = append(s.Notes, "the index already exists")
+			r.verify(ctx, coll, index, s)
+		default:
+			s.Status = migrations.StatusFailed
+			s.Error = fmt.Sprintf("an index named %s already exists with other keys %s", index.Name, keys)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	// A build of an interrupted run may still be running on the server; wait for it rather than
+	// starting a second one
+	if waited, err := r.awaitBuilds(ctx, namespace, missing); err != nil {
+		return err
+	} else if waited {
+		existing, err = existingIndexes(ctx, coll)
+		if err != nil {
+			return fmt.Errorf("list indexes of %s: %w", namespace, err)
+		}
+		var stillMissing []Index
+		for _, index := range missing {
+			if _, ok := existing[index.Name]; ok {
+				s := step(run, index)
+				s.Status = migrations.StatusSucceeded
+				s.Notes = append(s.Notes, "built by a build that was already in progress")
+				r.verify(ctx, coll, index, s)
+				continue
+			}
+			stillMissing = append(stillMissing, index)
+		}
+		if missing = stillMissing; len(missing) == 0 {
+			return nil
+		}
+	}
+
+	if strategy == StrategyRolling {
+		if err := r.awaitReplication(ctx, run.ID); err != nil {
+			return err
+		}
+	}
+
+	start := time.Now()
+	names := make([]string, len(missing))
+	models := make([]mongo.IndexModel, len(missing))
+	for i, index := range missing {
+		names[i] = index.Name
+		models[i] = index.model()
+		s := step(run, index)
+		s.Status = migrations.StatusRunning
+		s.StartedAt = &start
+		s.Error = ""
+	}
+	if err := r.history.Save(ctx, *run); err != nil {
+		return err
+	}
+	r.opts.Notify(fmt.Sprintf("Building %s on %s", names, namespace))
+
+	buildErr := r.createIndexes(ctx, coll, namespace, models, run.ID)
+
+	end := time.Now()
+	existing, listErr := existingIndexes(context.WithoutCancel(ctx), coll)
+	for _, index := range missing {
+		s := step(run, index)
+		s.FinishedAt = &end
+		s.DurationMs = end.Sub(start).Milliseconds()
+		switch _, built := existing[index.Name]; {
+		case listErr == nil && built:
+			s.Status = migrations.StatusSucceeded
+			r.verify(ctx, coll, index, s)
+		case ctx.Err() != nil:
+			// The server may carry on with the build; a resumed run waits for it
+			s.Status = migrations.StatusPending
+			s.Notes = append(s.Notes, "interrupted; the server may still be building the index")
+		default:
+			s.Status = migrations.StatusFailed
+			if buildErr != nil {
+				s.Error = buildErr.Error()
+			} else if listErr != nil {
+				s.Error = listErr.Error()
+			} else {
+				s.Error = "the index was not created"
+			}
+		}
+	}
+	if ctx.Err() != nil {
+		return ErrPaused
+	}
+	return nil
+}
+
+// createIndexes runs the build and reports its progress until it ends. A build outliving the
+// client's socket timeout keeps running on the server, so it is followed until it finishes.
+func (r *Reindexer) createIndexes(ctx context.Context, coll *mongo.Collection, namespace string, models []mongo.IndexModel, runID string) error {
+	done := make(chan error, 1)
+	go func() {
+		_, err := coll.Indexes().CreateMany(ctx, models)
+		done <- err
+	}()
+
+	ticker := time.NewTicker(r.opts.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case err := <-done:
+			if err != nil && mongo.IsTimeout(err) && ctx.Err() == nil {
+				r.opts.Notify("The build outlived the client timeout; following it on the server")
+				_, err = r.awaitBuilds(ctx, namespace, nil)
+			}
+			return err
+		case <-ticker.C:
+			r.report(ctx, namespace)
+			r.pauseRequested(ctx, runID)
+		}
+	}
+}
+
+// awaitBuilds waits while the server builds any of the indexes (any index when nil) on the
+// namespace, reporting their progress; it returns whether there was such a build
+func (r *Reindexer) awaitBuilds(ctx context.Context, namespace string, indexes []Index) (bool, error) {
+	waited := false
+	for {
+		builds, err := buildsInProgress(ctx, r.admin, namespace)
+		if err != nil {
+			// Without the inprog privilege builds cannot be seen; build as if there were none
+			r.opts.Notify(fmt.Sprintf("Cannot read index builds in progress: %v", err))
+			return waited, nil
+		}
+		running := false
+		for _, build := range builds {
+			if indexes == nil || buildsAny(build, indexes) {
+				running = true
+				r.opts.Notify(describeProgress(build))
+			}
+		}
+		if !running {
+			return waited, nil
+		}
+		waited = true
+
+		select {
+		case <-ctx.Done():
+			return waited, ctx.Err()
+		case <-time.After(r.opts.PollInterval):
+		}
+	}
+}
+
+// awaitReplication waits until the slowest secondary is within MaxLag of the primary
+func (r *Reindexer) awaitReplication(ctx context.Context, runID string) error {
+	for {
+		lag, err := replicationLag(ctx, r.admin)
+		if errors.Is(err, errNoReplication) {
+			return nil
+		}
+		if err != nil {
+			r.opts.Notify(fmt.Sprintf("Cannot read replication lag, building anyway: %v", err))
+			return nil
+		}
+		if lag <= r.opts.MaxLag {
+			return nil
+		}
+		r.opts.Notify(fmt.Sprintf("Waiting for secondaries to catch up (%s behind, at most %s)", lag.Round(time.Second), r.opts.MaxLag))
+		if r.pauseRequested(ctx, runID) {
+			return ErrPaused
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.opts.PollInterval):
+		}
+	}
+}
+
+// verify explains the index's target queries, failing the step when one does not use it
+func (r *Reindexer) verify(ctx context.Context, coll *mongo.Collection, index Index, s *migrations.Step) {
+	if len(index.Verify) == 0 {
+		return
+	}
+	for _, query := range index.Verify {
+		if err := verifyQuery(ctx, coll, index.Name, query); err != nil {
+			s.Status = migrations.StatusFailed
+			s.Error = "query plan check failed: " + err.Error()
+			return
+		}
+	}
+	s.Notes = append(s.Notes, fmt.Sprintf("%d target queries use the index", len(index.Verify)))
+}
+
+func (r *Reindexer) report(ctx context.Context, namespace string) {
+	builds, err := buildsInProgress(ctx, r.admin, namespace)
+	if err != nil {
+		return
+	}
+	for _, build := range builds {
+		r.opts.Notify(describeProgress(build))
+	}
+}
+
+// pauseRequested reports whether the run should stop after the current build, because Pause was
+// called or a pause was requested in the history
+func (r *Reindexer) pauseRequested(ctx context.Context, runID string) bool {
+	if r.paused.Load() {
+		return true
+	}
+	if requested, err := r.history.PauseRequested(ctx, runID); err == nil && requested {
+		r.opts.Notify("Pause requested, stopping after the current build")
+		r.paused.Store(true)
+	}
+	return r.paused.Load()
+}
+
+func (r *Reindexer) finish(ctx context.Context, run migrations.Run, status migrations.Status) (migrations.Run, error) {
+	run.Status = status
+	if status != migrations.StatusPaused {
+		now := time.Now()
+		run.FinishedAt = &now
+	}
+	if err := r.history.Save(context.WithoutCancel(ctx), run); err != nil {
+		return run, err
+	}
+	if status == migrations.StatusPaused {
+		return run, ErrPaused
+	}
+	return run, nil
+}
+
+// model converts the index into the driver's model. Background only matters before MongoDB 4.2,
+// where foreground builds lock the database; later versions ignore it.
+func (i Index) model() mongo.IndexModel {
+	opts := options.Index().SetName(i.Name).SetBackground(true)
+	if i.Unique {
+		opts.SetUnique(true)
+	}
+	if i.Sparse {
+		opts.SetSparse(true)
+	}
+	if len(i.PartialFilter) > 0 {
+		opts.SetPartialFilterExpression(i.PartialFilter)
+	}
+	if i.ExpireAfterSeconds != nil {
+		opts.SetExpireAfterSeconds(*i.ExpireAfterSeconds)
+	}
+	return mongo.IndexModel{Keys: i.Keys, Options: opts}
+}
+
+// step returns the run's step of an index
+func step(run *migrations.Run, index Index) *migrations.Step {
+	for i := range run.Steps {
+		if run.Steps[i].Name == index.StepName() {
+			return &run.Steps[i]
+		}
+	}
+	run.Steps = append(run.Steps, migrations.Step{Name: index.StepName(), Status: migrations.StatusPending})
+	return &run.Steps[len(run.Steps)-1]
+}
+
+// existingIndexes returns the keys of a collection's indexes by name, as keyString writes them
+func existingIndexes(ctx context.Context, coll *mongo.Collection) (map[string]string, error) {
+	specs, err := coll.Indexes().ListSpecifications(ctx)
+	if err != nil {
+		return nil, err
+	}
+	indexes := make(map[string]string, len(specs))
+	for _, spec := range specs {
+		var keys bson.D
+		if err := bson.Unmarshal(spec.KeysDocument, &keys); err != nil {
+			return nil, err
+		}
+		indexes[spec.Name] = keyString(keys)
+	}
+	return indexes, nil
+}
+
+// keyString writes index keys so that 1, 1.0 and int64(1) compare equal
+func keyString(keys bson.D) string {
+	text := "{"
+	for i, key := range keys {
+		if i > 0 {
+			text += ", "
+		}
+		value := fmt.Sprint(key.Value)
+		switch v := key.Value.(type) {
+		case int32:
+			value = strconv.FormatFloat(float64(v), 'g', -1, 64)
+		case int64:
+			value = strconv.FormatFloat(float64(v), 'g', -1, 64)
+		case int:
+			value = strconv.FormatFloat(float64(v), 'g', -1, 64)
+		case float64:
+			value = strconv.FormatFloat(v, 'g', -1, 64)
+		}
+		text += key.Key + ": " + value
+	}
+	return text + "}"
+}
+
+func buildsAny(build Progress, indexes []Index) bool {
+	for _, name := range build.Indexes {
+		for _, index := range indexes {
+			if index.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func describeProgress(build Progress) string {
+	text := fmt.Sprintf("%s %v: %s", build.Collection, build.Indexes, build.Phase)
+	if percent := build.Percent(); percent >= 0 {
+		text += fmt.Sprintf(" (%d/%d, %.0f%%)", build.Done, build.Total, percent)
+	}
+	return text
+}
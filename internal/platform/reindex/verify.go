@@ -0,0 +1,94 @@
+package reindex
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// verifyQuery explains a target query and returns an error unless its winning plan scans the index
+func verifyQuery(ctx context.Context, coll *mongo.Collection, index string, query Query) error {
+	find := bson.D{{Key: "find", Value: coll.Name()}, {Key: "filter", Value: orEmpty(query.Filter)}}
+	if len(query.Sort) > 0 {
+		find = append(find, bson.E{Key: "sort", Value: query.Sort})
+	}
+	var explain struct {
+		QueryPlanner struct {
+			WinningPlan bson.Raw `bson:"winningPlan"`
+		} `bson:"queryPlanner"`
+	}
+	err := coll.Database().RunCommand(ctx, bson.D{
+		{Key: "explain", Value: find},
+		{Key: "verbosity", Value: "queryPlanner"},
+	}).Decode(&explain)
+	if err != nil {
+		return fmt.Errorf("explain %s: %w", describe(query), err)
+	}
+
+	stages, indexes := planStages(explain.QueryPlanner.WinningPlan)
+	if !slices.Contains(indexes, index) {
+		return fmt.Errorf("%s does not use the index; its plan is %v", describe(query), stages)
+	}
+	return nil
+}
+
+// planStages walks a winning plan and returns its stages and the indexes it scans. Plans nest
+// their inputs under inputStage, inputStages or, on the slot-based engine, queryPlan.
+func planStages(plan bson.Raw) (stages []string, indexes []string) {
+	if len(plan) == 0 {
+		return nil, nil
+	}
+	if stage, ok := plan.Lookup("stage").StringValueOK(); ok {
+		stages = append(stages, stage)
+	}
+	if name, ok := plan.Lookup("indexName").StringValueOK(); ok {
+		indexes = append(indexes, name)
+	}
+
+	var inputs []bson.Raw
+	for _, key := range []string{"queryPlan", "inputStage"} {
+		if doc, ok := plan.Lookup(key).DocumentOK(); ok {
+			inputs = append(inputs, doc)
+		}
+	}
+	if array, ok := plan.Lookup("inputStages").ArrayOK(); ok {
+		values, _ := array.Values()
+		for _, value := range values {
+			if doc, ok := value.DocumentOK(); ok {
+				inputs = append(inputs, doc)
+			}
+		}
+	}
+	for _, input := range inputs {
+		s, i := planStages(input)
+		stages = append(stages, s...)
+		indexes = append(indexes, i...)
+	}
+	return stages, indexes
+}
+
+func describe(query Query) string {
+	text := "find " + extJSON(orEmpty(query.Filter))
+	if len(query.Sort) > 0 {
+		text += " sort " + extJSON(query.Sort)
+	}
+	return text
+}
+
+func extJSON(doc bson.D) string {
+	data, err := bson.MarshalExtJSON(doc, false, false)
+	if err != nil {
+		return fmt.Sprint(doc)
+	}
+	return string(data)
+}
+
+func orEmpty(doc bson.D) bson.D {
+	if doc == nil {
+		return bson.D{}
+	}
+	return doc
+}
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 // Sanitizer provides centralized sanitization functions for various input types
@@ -190,6 +191,40 @@ func (s *Sanitizer) ForPhone(input string) string {
 	return sanitized
 }
 
+// MaskName keeps the first letter of each word of a person's name, e.g. "Jane Doe" -> "J*** D***"
+func (s *Sanitizer) MaskName(input string) string {
+	words := strings.Fields(input)
+	for i, word := range words {
+		first, _ := utf8.DecodeRuneInString(word)
+		words[i] = string(first) + "***"
+	}
+	return strings.Join(words, " ")
+}
+
+// MaskPhone keeps the last four digits of a phone number, e.g. "555-123-4567" -> "***-***-4567"
+func (s *Sanitizer) MaskPhone(input string) string {
+	if input == "" {
+		return ""
+	}
+	digits := regexp.MustCompile(`\D`).ReplaceAllString(input, "")
+	if len(digits) < 4 {
+		return "***"
+	}
+	return "***-***-" + digits[len(digits)-4:]
+}
+
+// MaskDOB keeps the year of a date of birth, e.g. "1980-05-17" -> "1980-**-**"; the year alone
+// does not identify anyone
+func (s *Sanitizer) MaskDOB(input string) string {
+	if input == "" {
+		return ""
+	}
+	if year := regexp.MustCompile(`^\d{4}`).FindString(input); year != "" {
+		return year + "-**-**"
+	}
+	return "[redacted]"
+}
+
 // Truncate truncates a string to the specified length with optional suffix
 func (s *Sanitizer) Truncate(input string, maxLength int, suffix string) string {
 	if input == "" || maxLength <= 0 {
@@ -262,6 +297,18 @@ func ForPhone(input string) string {
 	return Default.ForPhone(input)
 }
 
+func MaskName(input string) string {
+	return Default.MaskName(input)
+}
+
+func MaskPhone(input string) string {
+	return Default.MaskPhone(input)
+}
+
+func MaskDOB(input string) string {
+	return Default.MaskDOB(input)
+}
+
 func Truncate(input string, maxLength int, suffix string) string {
 	return Default.Truncate(input, maxLength, suffix)
 }
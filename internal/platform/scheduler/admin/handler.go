@@ -0,0 +1,234 @@
+// Package admin serves the scheduler job run history, with manual runs and retries of failed
+// runs: as JSON for tooling and as a page for admins
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+	"pharmacy-modernization-project-model/internal/platform/paths"
+	"pharmacy-modernization-project-model/internal/platform/permissions"
+	"pharmacy-modernization-project-model/internal/platform/scheduler"
+	admincomponents "pharmacy-modernization-project-model/web/components/admin"
+)
+
+// JobsAccess - only admins can see job runs and start jobs by hand
+var JobsAccess = []string{permissions.AdminAll}
+
+// pageRuns is how many recent runs the admin page lists
+const pageRuns = 50
+
+// RunsQuery filters the run history
+type RunsQuery struct {
+	Job   string `form:"job" validate:"omitempty,max=100"`
+	Limit int    `form:"limit" validate:"omitempty,min=1,max=200"`
+}
+
+// JobPathVars represents the path parameter of job endpoints
+type JobPathVars struct {
+	Job string `path:"job" validate:"required,min=1,max=100"`
+}
+
+// RunPathVars represents the path parameter of run endpoints
+type RunPathVars struct {
+	RunID string `path:"runID" validate:"required,min=1"`
+}
+
+// Handler serves the job runs
+type Handler struct {
+	scheduler *scheduler.Scheduler
+	log       *zap.Logger
+}
+
+func NewHandler(s *scheduler.Scheduler, log *zap.Logger) *Handler {
+	return &Handler{scheduler: s, log: log}
+}
+
+// RegisterRoutes mounts the job API under /api/v1/jobs and the admin page under /admin/jobs
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route(paths.JobsAPIPath, func(router chi.Router) {
+		router.Use(auth.RequireAuthFromHeader())
+		router.Use(auth.RequirePermissionsMatchAny(JobsAccess))
+
+		router.Get("/", h.ListJobs)
+		router.Get("/runs", h.ListRuns)
+		router.Get("/runs/{runID}", h.GetRun)
+		router.Post("/runs/{runID}/retry", h.Retry)
+		router.Post("/{job}/run", h.Trigger)
+	})
+
+	r.Route(paths.AdminJobsPath, func(router chi.Router) {
+		router.Use(auth.RequireAuthWithDevMode())
+		router.Use(auth.RequirePermissionsMatchAny(JobsAccess))
+
+		router.Get("/", h.Page)
+		router.Post("/runs/{runID}/retry", h.RetryFromPage)
+		router.Post("/{job}/run", h.TriggerFromPage)
+	})
+}
+
+func (h *Handler) ListJobs(w http.ResponseWriter, r *http.Request) {
+	jobs, err := h.scheduler.Jobs(r.Context())
+	if err != nil {
+		h.log.Error("list jobs", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, jobs)
+}
+
+func (h *Handler) ListRuns(w http.ResponseWriter, r *http.Request) {
+	query, fieldErrors, err := bind.Query[RunsQuery](r)
+	if err != nil {
+		h.log.Error("failed to bind query parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+	if query.Limit == 0 {
+		query.Limit = 20
+	}
+
+	runs, err := h.scheduler.ListRuns(r.Context(), scheduler.RunFilter{Job: query.Job, Limit: query.Limit})
+	if err != nil {
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, runs)
+}
+
+func (h *Handler) GetRun(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[RunPathVars](r, chi.URLParam)
+	if err != nil {
+		h.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	run, err := h.scheduler.GetRun(r.Context(), pathVars.RunID)
+	if err != nil {
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, run)
+}
+
+// Trigger starts the job now; the run continues in the background
+func (h *Handler) Trigger(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[JobPathVars](r, chi.URLParam)
+	if err != nil {
+		h.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	run, err := h.scheduler.Trigger(r.Context(), pathVars.Job, actor(r))
+	if err != nil {
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteAccepted(w, run)
+}
+
+// Retry starts a new run from where a failed or partial run stopped
+func (h *Handler) Retry(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[RunPathVars](r, chi.URLParam)
+	if err != nil {
+		h.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	run, err := h.scheduler.Retry(r.Context(), pathVars.RunID, actor(r))
+	if err != nil {
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteAccepted(w, run)
+}
+
+func (h *Handler) Page(w http.ResponseWriter, r *http.Request) {
+	query, _, err := bind.Query[RunsQuery](r)
+	if err != nil {
+		query = RunsQuery{}
+	}
+	h.renderPage(w, r, query.Job, "", http.StatusOK)
+}
+
+func (h *Handler) TriggerFromPage(w http.ResponseWriter, r *http.Request) {
+	_, err := h.scheduler.Trigger(r.Context(), chi.URLParam(r, "job"), actor(r))
+	h.afterAction(w, r, err)
+}
+
+func (h *Handler) RetryFromPage(w http.ResponseWriter, r *http.Request) {
+	_, err := h.scheduler.Retry(r.Context(), chi.URLParam(r, "runID"), actor(r))
+	h.afterAction(w, r, err)
+}
+
+// afterAction returns to the page once a run started, or shows the page with why it did not
+func (h *Handler) afterAction(w http.ResponseWriter, r *http.Request, err error) {
+	if err == nil {
+		http.Redirect(w, r, paths.AdminJobsPath, http.StatusSeeOther)
+		return
+	}
+
+	status := http.StatusInternalServerError
+	var conflict platformErrors.ConflictError
+	var business platformErrors.BusinessLogicError
+	switch {
+	case platformErrors.IsNotFoundError(err):
+		status = http.StatusNotFound
+	case errors.As(err, &conflict):
+		status = http.StatusConflict
+	case errors.As(err, &business):
+		status = http.StatusUnprocessableEntity
+	default:
+		h.log.Error("start job run", zap.Error(err))
+	}
+	h.renderPage(w, r, "", err.Error(), status)
+}
+
+func (h *Handler) renderPage(w http.ResponseWriter, r *http.Request, job, message string, status int) {
+	jobs, err := h.scheduler.Jobs(r.Context())
+	if err != nil {
+		h.log.Error("list jobs", zap.Error(err))
+		helper.WriteUIInternalError(w, "Failed to load jobs")
+		return
+	}
+	runs, err := h.scheduler.ListRuns(r.Context(), scheduler.RunFilter{Job: job, Limit: pageRuns})
+	if err != nil {
+		h.log.Error("list job runs", zap.Error(err))
+		helper.WriteUIInternalError(w, "Failed to load job runs")
+		return
+	}
+
+	w.WriteHeader(status)
+	page := admincomponents.JobsPage(admincomponents.JobsPageParam{
+		Jobs:  jobs,
+		Runs:  runs,
+		Job:   job,
+		Error: message,
+	})
+	if err := page.Render(r.Context(), w); err != nil {
+		h.log.Error("failed to render jobs page", zap.Error(err))
+	}
+}
+
+// actor identifies the user starting a run
+func actor(r *http.Request) string {
+	user, err := auth.GetCurrentUser(r.Context())
+	if err != nil {
+		return ""
+	}
+	if user.Email != "" {
+		return user.Email
+	}
+	return user.ID
+}
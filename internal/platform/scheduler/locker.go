@@ -0,0 +1,41 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Locker hands out named leases
+type Locker interface {
+	// Acquire takes or renews the lease on name until the given time; it reports false while
+	// another owner holds an unexpired lease
+	Acquire(ctx context.Context, name, owner string, until time.Time) (bool, error)
+}
+
+type lease struct {
+	owner string
+	until time.Time
+}
+
+// MemoryLocker holds leases in process memory; it only coordinates jobs within one instance
+type MemoryLocker struct {
+	mu     sync.Mutex
+	leases map[string]lease
+	now    func() time.Time
+}
+
+// NewMemoryLocker creates an empty in-memory locker; used when MongoDB is not configured
+func NewMemoryLocker() Locker {
+	return &MemoryLocker{leases: map[string]lease{}, now: time.Now}
+}
+
+func (l *MemoryLocker) Acquire(_ context.Context, name, owner string, until time.Time) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if current, ok := l.leases[name]; ok && current.owner != owner && current.until.After(l.now()) {
+		return false, nil
+	}
+	l.leases[name] = lease{owner: owner, until: until}
+	return true, nil
+}
@@ -0,0 +1,66 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// memoryRunsKept bounds the in-memory history
+const memoryRunsKept = 500
+
+// MemoryRunStore keeps job runs in process memory; used when MongoDB is not configured
+type MemoryRunStore struct {
+	mu   sync.RWMutex
+	runs []Run // Oldest first
+}
+
+// NewMemoryRunStore creates an empty in-memory run store
+func NewMemoryRunStore() RunStore {
+	return &MemoryRunStore{}
+}
+
+func (s *MemoryRunStore) Save(_ context.Context, run Run) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.runs {
+		if s.runs[i].ID == run.ID {
+			s.runs[i] = run
+			return nil
+		}
+	}
+	s.runs = append(s.runs, run)
+	if len(s.runs) > memoryRunsKept {
+		s.runs = s.runs[len(s.runs)-memoryRunsKept:]
+	}
+	return nil
+}
+
+func (s *MemoryRunStore) Get(_ context.Context, id string) (Run, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, run := range s.runs {
+		if run.ID == id {
+			return run, nil
+		}
+	}
+	return Run{}, platformErrors.NewRecordNotFoundError("job run", id)
+}
+
+func (s *MemoryRunStore) List(_ context.Context, filter RunFilter) ([]Run, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	out := []Run{}
+	for i := len(s.runs) - 1; i >= 0; i-- {
+		if filter.Job != "" && s.runs[i].Job != filter.Job {
+			continue
+		}
+		out = append(out, s.runs[i])
+		if filter.Limit > 0 && len(out) == filter.Limit {
+			break
+		}
+	}
+	return out, nil
+}
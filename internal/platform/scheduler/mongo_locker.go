@@ -0,0 +1,45 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// MongoLocker keeps one lease document per job, so instances sharing the database run each job once
+type MongoLocker struct {
+	collection *mongo.Collection
+	now        func() time.Time
+}
+
+// NewMongoLocker creates a MongoDB-backed locker
+func NewMongoLocker(collection *mongo.Collection) Locker {
+	return &MongoLocker{collection: collection, now: time.Now}
+}
+
+func (l *MongoLocker) Acquire(ctx context.Context, name, owner string, until time.Time) (bool, error) {
+	now := l.now()
+	filter := bson.M{
+		"_id": name,
+		"$or": bson.A{
+			bson.M{"owner": owner},
+			bson.M{"expires_at": bson.M{"$lte": now}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"owner": owner, "expires_at": until, "acquired_at": now}}
+
+	// When another owner holds the lease the filter misses and the upsert collides on _id
+	_, err := l.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, platformErrors.HandleMongoError("AcquireLock", err)
+	}
+	return true, nil
+}
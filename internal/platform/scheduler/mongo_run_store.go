@@ -0,0 +1,87 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// MongoRunStore persists job runs in the job_runs collection
+type MongoRunStore struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewMongoRunStore creates a MongoDB-backed run store
+func NewMongoRunStore(collection *mongo.Collection, logger *zap.Logger) *MongoRunStore {
+	return &MongoRunStore{collection: collection, logger: logger}
+}
+
+func (s *MongoRunStore) Save(ctx context.Context, run Run) error {
+	_, err := s.collection.ReplaceOne(ctx, bson.M{"_id": run.ID}, run, options.Replace().SetUpsert(true))
+	if err != nil {
+		s.logger.Error("Failed to save job run", zap.String("run_id", run.ID), zap.String("job", run.Job), zap.Error(err))
+		return platformErrors.HandleMongoError("Save", err)
+	}
+	return nil
+}
+
+func (s *MongoRunStore) Get(ctx context.Context, id string) (Run, error) {
+	var run Run
+	if err := s.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&run); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return Run{}, platformErrors.NewRecordNotFoundError("job run", id)
+		}
+		return Run{}, platformErrors.HandleMongoError("Get", err)
+	}
+	return run, nil
+}
+
+func (s *MongoRunStore) List(ctx context.Context, filter RunFilter) ([]Run, error) {
+	query := bson.M{}
+	if filter.Job != "" {
+		query["job"] = filter.Job
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "started_at", Value: -1}})
+	if filter.Limit > 0 {
+		opts.SetLimit(int64(filter.Limit))
+	}
+
+	cursor, err := s.collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, platformErrors.HandleMongoError("List", err)
+	}
+	defer cursor.Close(ctx)
+
+	runs := []Run{}
+	if err := cursor.All(ctx, &runs); err != nil {
+		return nil, platformErrors.HandleMongoError("List", err)
+	}
+	return runs, nil
+}
+
+// CreateIndexes creates the index listing a job's runs and, when retention is set, the TTL index
+// that deletes runs older than it
+func (s *MongoRunStore) CreateIndexes(ctx context.Context, retention time.Duration) error {
+	indexes := []mongo.IndexModel{{
+		Keys:    bson.D{{Key: "job", Value: 1}, {Key: "started_at", Value: -1}},
+		Options: options.Index().SetName("job_1_started_at_-1"),
+	}}
+	if retention > 0 {
+		indexes = append(indexes, mongo.IndexModel{
+			Keys:    bson.D{{Key: "started_at", Value: 1}},
+			Options: options.Index().SetName("started_at_ttl").SetExpireAfterSeconds(int32(retention.Seconds())),
+		})
+	}
+	if _, err := s.collection.Indexes().CreateMany(ctx, indexes); err != nil {
+		return platformErrors.HandleMongoError("CreateIndexes", err)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Outcome is the result of a job run
+type Outcome string
+
+const (
+	OutcomeRunning   Outcome = "running"
+	OutcomeSucceeded Outcome = "succeeded"
+	OutcomePartial   Outcome = "partial" // Finished, but some items failed
+	OutcomeFailed    Outcome = "failed"  // Returned an error or panicked
+)
+
+// Triggers
+const (
+	TriggerScheduled = "scheduled"
+	TriggerManual    = "manual"
+	TriggerRetry     = "retry"
+)
+
+// maxRunErrors caps the item errors kept on a run; the count keeps going
+const maxRunErrors = 20
+
+// Run is one execution of a job, as kept in the job_runs collection
+type Run struct {
+	ID          string     `json:"id" bson:"_id"`
+	Job         string     `json:"job" bson:"job"`
+	Trigger     string     `json:"trigger" bson:"trigger"`
+	TriggeredBy string     `json:"triggered_by,omitempty" bson:"triggered_by,omitempty"` // User of a manual run or retry
+	RetryOf     string     `json:"retry_of,omitempty" bson:"retry_of,omitempty"`         // Run a retry resumes
+	Owner       string     `json:"owner" bson:"owner"`                                   // Instance that ran it
+	StartedAt   time.Time  `json:"started_at" bson:"started_at"`
+	EndedAt     *time.Time `json:"ended_at,omitempty" bson:"ended_at,omitempty"`
+	DurationMs  int64      `json:"duration_ms" bson:"duration_ms"`
+	Outcome     Outcome    `json:"outcome" bson:"outcome"`
+	Processed   int        `json:"processed" bson:"processed"`     // Items the job completed
+	Failed      int        `json:"failed" bson:"failed"`           // Items the job gave up on
+	Errors      []string   `json:"errors,omitempty" bson:"errors"` // First item errors
+	Error       string     `json:"error,omitempty" bson:"error,omitempty"`
+	// Checkpoint is where a retry of this run starts; its meaning is up to the job
+	Checkpoint string `json:"checkpoint,omitempty" bson:"checkpoint,omitempty"`
+}
+
+// Retryable reports whether the run ended with failures a retry can pick up
+func (r Run) Retryable() bool {
+	return r.Outcome == OutcomeFailed || r.Outcome == OutcomePartial
+}
+
+// RunFilter selects runs; zero values match every run
+type RunFilter struct {
+	Job   string
+	Limit int
+}
+
+// RunStore persists job runs
+type RunStore interface {
+	// Save inserts the run or replaces the one with the same ID
+	Save(ctx context.Context, run Run) error
+	// Get returns a RecordNotFoundError for unknown IDs
+	Get(ctx context.Context, id string) (Run, error)
+	// List returns runs, newest first
+	List(ctx context.Context, filter RunFilter) ([]Run, error)
+}
+
+// progress collects what a job reports about its run through the run context
+type progress struct {
+	mu         sync.Mutex
+	processed  int
+	failed     int
+	errors     []string
+	checkpoint string
+	resumeFrom string
+}
+
+type progressKey struct{}
+
+func withProgress(ctx context.Context, p *progress) context.Context {
+	return context.WithValue(ctx, progressKey{}, p)
+}
+
+func progressFrom(ctx context.Context) *progress {
+	p, _ := ctx.Value(progressKey{}).(*progress)
+	return p
+}
+
+// Processed adds n completed items to the current run. Like the other progress functions it
+// does nothing outside a scheduled run.
+func Processed(ctx context.Context, n int) {
+	if p := progressFrom(ctx); p != nil {
+		p.mu.Lock()
+		p.processed += n
+		p.mu.Unlock()
+	}
+}
+
+// ItemFailed records an item the job gave up on; the run ends as partial unless the job fails
+func ItemFailed(ctx context.Context, err error) {
+	if p := progressFrom(ctx); p != nil {
+		p.mu.Lock()
+		p.failed++
+		if len(p.errors) < maxRunErrors && err != nil {
+			p.errors = append(p.errors, err.Error())
+		}
+		p.mu.Unlock()
+	}
+}
+
+// SetCheckpoint records where a retry of the current run should start
+func SetCheckpoint(ctx context.Context, checkpoint string) {
+	if p := progressFrom(ctx); p != nil {
+		p.mu.Lock()
+		p.checkpoint = checkpoint
+		p.mu.Unlock()
+	}
+}
+
+// ResumeFrom returns the checkpoint of the run being retried; empty for a fresh run
+func ResumeFrom(ctx context.Context) string {
+	if p := progressFrom(ctx); p != nil {
+		return p.resumeFrom
+	}
+	return ""
+}
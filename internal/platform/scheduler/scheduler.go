@@ -0,0 +1,313 @@
+// Package scheduler runs periodic jobs. A lease taken in a shared Locker before every run keeps
+// each job to one instance at a time when several instances run. Every run is recorded in a
+// RunStore, and runs can be started by hand or retried from the point where a failed run stopped.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/metrics"
+)
+
+// Job is a periodic task
+type Job struct {
+	// Name identifies the job in logs and locks; it must be unique
+	Name string
+	// Interval is the time between runs
+	Interval time.Duration
+	// Run does the work; an error is logged and the job runs again at the next interval. It can
+	// report its items with Processed and ItemFailed, and a retry point with SetCheckpoint.
+	Run func(ctx context.Context) error
+}
+
+// Scheduler runs registered jobs until its context is cancelled. Every run, scheduled or started
+// by hand, is recorded in the run store with its duration, item counts and outcome.
+type Scheduler struct {
+	locker Locker
+	runs   RunStore
+	owner  string
+	log    *zap.Logger
+	jobs   []Job
+	now    func() time.Time
+
+	mu      sync.Mutex
+	ctx     context.Context // Set by Run; manual runs last as long as the scheduler
+	running map[string]bool // Jobs running on this instance
+}
+
+// JobInfo is a registered job with its latest run
+type JobInfo struct {
+	Name     string `json:"name"`
+	Interval string `json:"interval"`
+	LastRun  *Run   `json:"last_run,omitempty"`
+}
+
+// New creates a scheduler; the owner ID names this instance in the locks it holds. Runs are kept
+// in memory when runs is nil.
+func New(locker Locker, runs RunStore, log *zap.Logger) *Scheduler {
+	if log == nil {
+		log = zap.NewNop()
+	}
+	if runs == nil {
+		runs = NewMemoryRunStore()
+	}
+	host, _ := os.Hostname()
+	return &Scheduler{
+		locker:  locker,
+		runs:    runs,
+		owner:   fmt.Sprintf("%s-%s", host, uuid.NewString()[:8]),
+		log:     log,
+		now:     time.Now,
+		running: map[string]bool{},
+	}
+}
+
+// Register adds a job; it must be called before Run
+func (s *Scheduler) Register(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+// Run starts every job and blocks until ctx is cancelled
+func (s *Scheduler) Run(ctx context.Context) {
+	s.mu.Lock()
+	s.ctx = ctx
+	s.mu.Unlock()
+	s.log.Info("Scheduler started", zap.String("owner", s.owner), zap.Int("jobs", len(s.jobs)))
+
+	var wg sync.WaitGroup
+	for _, job := range s.jobs {
+		wg.Add(1)
+		go func(job Job) {
+			defer wg.Done()
+			s.loop(ctx, job)
+		}(job)
+	}
+	wg.Wait()
+
+	s.log.Info("Scheduler stopped")
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.RunOnce(ctx, job)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce runs the job if this instance gets its lock. The lock is held for the whole interval
+// and renewed by the holder, so other instances skip the job until the holder stops.
+func (s *Scheduler) RunOnce(ctx context.Context, job Job) {
+	log := s.log.With(zap.String("job", job.Name))
+	if !s.claim(job.Name) {
+		log.Debug("Job is still running")
+		return
+	}
+	defer s.release(job.Name)
+
+	acquired, err := s.locker.Acquire(ctx, job.Name, s.owner, s.now().Add(job.Interval))
+	if err != nil {
+		log.Error("Failed to acquire job lock", zap.Error(err))
+		return
+	}
+	if !acquired {
+		log.Debug("Job is running on another instance")
+		return
+	}
+
+	run := s.begin(ctx, job, TriggerScheduled, "", "")
+	s.execute(ctx, job, run, "")
+}
+
+// Trigger starts a run of the job now, in the background, and returns it as running. It fails
+// with a ConflictError while the job runs on this instance or another instance holds its lease.
+func (s *Scheduler) Trigger(ctx context.Context, name, actor string) (Run, error) {
+	return s.startManual(ctx, name, TriggerManual, actor, Run{})
+}
+
+// Retry starts a new run of a failed or partial run's job from the failed run's checkpoint, so
+// the job picks up where it stopped instead of starting over
+func (s *Scheduler) Retry(ctx context.Context, runID, actor string) (Run, error) {
+	failed, err := s.runs.Get(ctx, runID)
+	if err != nil {
+		return Run{}, err
+	}
+	if !failed.Retryable() {
+		return Run{}, platformErrors.NewBusinessLogicError("retry job run", "only failed or partial runs can be retried")
+	}
+	return s.startManual(ctx, failed.Job, TriggerRetry, actor, failed)
+}
+
+// Jobs lists the registered jobs with their latest run
+func (s *Scheduler) Jobs(ctx context.Context) ([]JobInfo, error) {
+	jobs := make([]JobInfo, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		info := JobInfo{Name: job.Name, Interval: job.Interval.String()}
+		runs, err := s.runs.List(ctx, RunFilter{Job: job.Name, Limit: 1})
+		if err != nil {
+			return nil, err
+		}
+		if len(runs) > 0 {
+			info.LastRun = &runs[0]
+		}
+		jobs = append(jobs, info)
+	}
+	return jobs, nil
+}
+
+// ListRuns returns recorded runs, newest first
+func (s *Scheduler) ListRuns(ctx context.Context, filter RunFilter) ([]Run, error) {
+	if filter.Job != "" {
+		if _, ok := s.job(filter.Job); !ok {
+			return nil, platformErrors.NewRecordNotFoundError("job", filter.Job)
+		}
+	}
+	return s.runs.List(ctx, filter)
+}
+
+// GetRun returns a recorded run
+func (s *Scheduler) GetRun(ctx context.Context, id string) (Run, error) {
+	return s.runs.Get(ctx, id)
+}
+
+func (s *Scheduler) startManual(ctx context.Context, name, trigger, actor string, retryOf Run) (Run, error) {
+	job, ok := s.job(name)
+	if !ok {
+		return Run{}, platformErrors.NewRecordNotFoundError("job", name)
+	}
+	s.mu.Lock()
+	base := s.ctx
+	s.mu.Unlock()
+	if base == nil {
+		return Run{}, platformErrors.NewBusinessLogicError("run job", "the scheduler is not running")
+	}
+
+	if !s.claim(name) {
+		return Run{}, platformErrors.NewConflictError("job", name, "the job is already running")
+	}
+	acquired, err := s.locker.Acquire(ctx, name, s.owner, s.now().Add(job.Interval))
+	if err != nil || !acquired {
+		s.release(name)
+		if err != nil {
+			return Run{}, err
+		}
+		return Run{}, platformErrors.NewConflictError("job", name, "another instance holds the job's lease; try again after its interval")
+	}
+
+	run := s.begin(ctx, job, trigger, actor, retryOf.ID)
+	go func() {
+		defer s.release(name)
+		s.execute(base, job, run, retryOf.Checkpoint)
+	}()
+	return run, nil
+}
+
+// begin records the start of a run
+func (s *Scheduler) begin(ctx context.Context, job Job, trigger, actor, retryOf string) Run {
+	run := Run{
+		ID:          uuid.NewString(),
+		Job:         job.Name,
+		Trigger:     trigger,
+		TriggeredBy: actor,
+		RetryOf:     retryOf,
+		Owner:       s.owner,
+		StartedAt:   s.now(),
+		Outcome:     OutcomeRunning,
+	}
+	// The history is for visibility; a failed write must not stop the job
+	_ = s.runs.Save(context.WithoutCancel(ctx), run)
+	return run
+}
+
+// execute runs the job and records how it went
+func (s *Scheduler) execute(ctx context.Context, job Job, run Run, resumeFrom string) {
+	log := s.log.With(zap.String("job", job.Name), zap.String("run_id", run.ID), zap.String("trigger", run.Trigger))
+	p := &progress{resumeFrom: resumeFrom}
+	err := s.call(withProgress(ctx, p), job)
+
+	end := s.now()
+	run.EndedAt = &end
+	run.DurationMs = end.Sub(run.StartedAt).Milliseconds()
+	p.mu.Lock()
+	run.Processed, run.Failed, run.Errors, run.Checkpoint = p.processed, p.failed, p.errors, p.checkpoint
+	p.mu.Unlock()
+	if run.Checkpoint == "" {
+		// A retry the job did not checkpoint again resumes from the same place next time
+		run.Checkpoint = resumeFrom
+	}
+	switch {
+	case err != nil:
+		run.Outcome = OutcomeFailed
+		run.Error = err.Error()
+	case run.Failed > 0:
+		run.Outcome = OutcomePartial
+	default:
+		run.Outcome = OutcomeSucceeded
+	}
+	_ = s.runs.Save(context.WithoutCancel(ctx), run)
+	metrics.ObserveJobRun(job.Name, string(run.Outcome), end.Sub(run.StartedAt), run.Processed, run.Failed)
+
+	fields := []zap.Field{
+		zap.Duration("duration", end.Sub(run.StartedAt)),
+		zap.Int("processed", run.Processed),
+		zap.Int("failed", run.Failed),
+	}
+	switch run.Outcome {
+	case OutcomeFailed:
+		log.Error("Job failed", append(fields, zap.Error(err))...)
+	case OutcomePartial:
+		log.Warn("Job finished with failed items", fields...)
+	default:
+		log.Debug("Job finished", fields...)
+	}
+}
+
+// call runs the job, turning a panic into an error
+func (s *Scheduler) call(ctx context.Context, job Job) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("job panicked: %v", rec)
+		}
+	}()
+	return job.Run(ctx)
+}
+
+func (s *Scheduler) job(name string) (Job, bool) {
+	for _, job := range s.jobs {
+		if job.Name == name {
+			return job, true
+		}
+	}
+	return Job{}, false
+}
+
+// claim marks the job as running on this instance; it reports false when it already is
+func (s *Scheduler) claim(name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running[name] {
+		return false
+	}
+	s.running[name] = true
+	return true
+}
+
+func (s *Scheduler) release(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.running, name)
+}
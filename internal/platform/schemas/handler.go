@@ -0,0 +1,55 @@
+package schemas
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+	"pharmacy-modernization-project-model/internal/platform/paths"
+)
+
+// Handler serves the contracts to downstream teams. Contracts describe message shapes only and
+// carry no data, so they are served without authentication.
+type Handler struct {
+	registry *Registry
+	log      *zap.Logger
+}
+
+func NewHandler(registry *Registry, log *zap.Logger) *Handler {
+	return &Handler{registry: registry, log: log}
+}
+
+// RegisterRoutes mounts the contracts under /api/schemas
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route(paths.SchemasAPIPath, func(router chi.Router) {
+		router.Get("/", h.List)
+		router.Get("/{name}", h.Versions)
+	})
+}
+
+// List returns the current version of every contract
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	helper.WriteOK(w, h.registry.List())
+}
+
+// Versions returns every version of one contract, oldest first
+func (h *Handler) Versions(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[PathVars](r, chi.URLParam)
+	if err != nil {
+		h.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	versions := h.registry.Versions(pathVars.Name)
+	if len(versions) == 0 {
+		httpx.WriteError(w, r, platformErrors.NewRecordNotFoundError("Contract", pathVars.Name))
+		return
+	}
+	helper.WriteOK(w, versions)
+}
@@ -0,0 +1,170 @@
+// Package schemas is the registry of message contracts exchanged with other services: a versioned
+// JSON Schema per event type this service publishes (webhooks) or consumes (IRIS billing events).
+// Payloads are validated against the current version at runtime; violations are logged and
+// counted, and rejected when contracts are enforced. Contracts are served at /api/schemas.
+package schemas
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"pharmacy-modernization-project-model/internal/platform/metrics"
+)
+
+// Direction says whether this service sends or receives a message
+type Direction string
+
+const (
+	Published Direction = "published"
+	Consumed  Direction = "consumed"
+)
+
+// Contract is one version of the schema of a message
+type Contract struct {
+	Name      string    `json:"name"` // Event type, e.g. prescription.status_changed
+	Version   int       `json:"version"`
+	Direction Direction `json:"direction"`
+	Schema    *Schema   `json:"schema"`
+}
+
+// ErrUnknownContract is returned when no contract is registered for a message
+var ErrUnknownContract = errors.New("no contract registered")
+
+// ViolationError lists how a payload breaks its contract
+type ViolationError struct {
+	Contract   string
+	Version    int // 0 when no contract is registered
+	Violations []Violation
+}
+
+func (e *ViolationError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		messages[i] = strings.TrimPrefix(v.Path+" "+v.Message, " ")
+	}
+	return fmt.Sprintf("%s v%d contract violated: %s", e.Contract, e.Version, strings.Join(messages, "; "))
+}
+
+// Registry holds every version of every contract
+type Registry struct {
+	contracts map[string][]Contract // Sorted by version, newest last
+}
+
+func NewRegistry() *Registry {
+	return &Registry{contracts: map[string][]Contract{}}
+}
+
+//go:embed contracts
+var contractFiles embed.FS
+
+// contractFile matches <direction>/<name>.v<version>.json
+var contractFile = regexp.MustCompile(`^(published|consumed)/([a-z0-9_.]+)\.v([0-9]+)\.json$`)
+
+// Default loads the contracts shipped with the service (internal/platform/schemas/contracts)
+func Default() (*Registry, error) {
+	sub, err := fs.Sub(contractFiles, "contracts")
+	if err != nil {
+		return nil, err
+	}
+	return Load(sub)
+}
+
+// Load reads contracts from <direction>/<name>.v<version>.json files
+func Load(fsys fs.FS) (*Registry, error) {
+	r := NewRegistry()
+	err := fs.WalkDir(fsys, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || path.Ext(name) != ".json" {
+			return err
+		}
+		match := contractFile.FindStringSubmatch(name)
+		if match == nil {
+			return fmt.Errorf("%s: expected <published|consumed>/<name>.v<version>.json", name)
+		}
+		version, _ := strconv.Atoi(match[3])
+
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		var schema Schema
+		if err := json.Unmarshal(data, &schema); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		return r.Register(Contract{Name: match[2], Version: version, Direction: Direction(match[1]), Schema: &schema})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Register adds a contract version; versions of a contract must share its direction
+func (r *Registry) Register(c Contract) error {
+	if c.Name == "" || c.Version < 1 || c.Schema == nil {
+		return fmt.Errorf("contract needs a name, a version from 1 and a schema")
+	}
+	versions := r.contracts[c.Name]
+	for _, existing := range versions {
+		if existing.Version == c.Version {
+			return fmt.Errorf("%s v%d is already registered", c.Name, c.Version)
+		}
+		if existing.Direction != c.Direction {
+			return fmt.Errorf("%s is registered as %s, not %s", c.Name, existing.Direction, c.Direction)
+		}
+	}
+	versions = append(versions, c)
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Version < versions[j].Version })
+	r.contracts[c.Name] = versions
+	return nil
+}
+
+// Current returns the newest version of a contract
+func (r *Registry) Current(name string) (Contract, bool) {
+	versions := r.contracts[name]
+	if len(versions) == 0 {
+		return Contract{}, false
+	}
+	return versions[len(versions)-1], true
+}
+
+// Versions returns every version of a contract, oldest first
+func (r *Registry) Versions(name string) []Contract {
+	return append([]Contract{}, r.contracts[name]...)
+}
+
+// List returns the current version of every contract, sorted by name
+func (r *Registry) List() []Contract {
+	list := make([]Contract, 0, len(r.contracts))
+	for name := range r.contracts {
+		current, _ := r.Current(name)
+		list = append(list, current)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// Validate checks a payload against the current version of its contract and records the result.
+// It returns a *ViolationError when the payload breaks the contract, or wraps ErrUnknownContract
+// when no contract is registered for name in that direction.
+func (r *Registry) Validate(direction Direction, name string, payload []byte) error {
+	contract, ok := r.Current(name)
+	if !ok || contract.Direction != direction {
+		metrics.ObserveSchemaValidation(name, "none", string(direction), false)
+		return fmt.Errorf("%s %s: %w", direction, name, ErrUnknownContract)
+	}
+
+	violations := contract.Schema.Validate(payload)
+	metrics.ObserveSchemaValidation(name, strconv.Itoa(contract.Version), string(direction), len(violations) == 0)
+	if len(violations) > 0 {
+		return &ViolationError{Contract: name, Version: contract.Version, Violations: violations}
+	}
+	return nil
+}
@@ -0,0 +1,6 @@
+package schemas
+
+// PathVars represents path parameters for contract endpoints
+type PathVars struct {
+	Name string `path:"name" validate:"required,max=100"`
+}
@@ -0,0 +1,75 @@
+package sse
+
+import "sync"
+
+// Broker fans change notifications out to the open streams. Notifications carry no data: a stream
+// told about a change reads and sends the current state. Notifications a stream has not taken
+// yet are merged, so a burst of changes costs a slow stream one update and never blocks Publish.
+type Broker struct {
+	mu          sync.Mutex
+	subscribers map[chan struct{}]struct{}
+	closed      bool
+}
+
+// NewBroker creates a broker without subscribers
+func NewBroker() *Broker {
+	return &Broker{subscribers: map[chan struct{}]struct{}{}}
+}
+
+// Subscribe returns a channel that receives a value after changes are published, and the function
+// that unsubscribes it; streams unsubscribe when their client disconnects. The channel is closed
+// when the broker is.
+func (b *Broker) Subscribe() (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	b.mu.Lock()
+	if b.closed {
+		close(ch)
+	} else {
+		b.subscribers[ch] = struct{}{}
+	}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers, ch)
+			b.mu.Unlock()
+		})
+	}
+}
+
+// Publish tells every subscriber that something changed
+func (b *Broker) Publish() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// A notification is already pending for this subscriber
+		}
+	}
+}
+
+// Subscribers is the number of open streams
+func (b *Broker) Subscribers() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subscribers)
+}
+
+// Close closes the channel of every subscriber, so open streams end and the server can shut down
+// without waiting for their clients to go away
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	b.closed = true
+	for ch := range b.subscribers {
+		close(ch)
+		delete(b.subscribers, ch)
+	}
+}
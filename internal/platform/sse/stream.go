@@ -0,0 +1,68 @@
+// Package sse serves Server-Sent Events: Stream writes events to one client and Broker tells the
+// open streams that something changed, so they push fresh data instead of clients polling.
+package sse
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Stream writes Server-Sent Events to one client, flushing after each one
+type Stream struct {
+	w  http.ResponseWriter
+	rc *http.ResponseController
+}
+
+// Open starts the event stream of the request. retry is how long the browser waits before it
+// reconnects a closed stream; 0 leaves the browser's default. It fails when the response writer
+// cannot flush, as events would then sit in a buffer.
+func Open(w http.ResponseWriter, retry time.Duration) (*Stream, error) {
+	s := &Stream{w: w, rc: http.NewResponseController(w)}
+
+	header := w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	// Stops nginx from buffering the stream
+	header.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if retry > 0 {
+		if _, err := fmt.Fprintf(w, "retry: %d\n\n", retry.Milliseconds()); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.rc.Flush(); err != nil {
+		return nil, fmt.Errorf("sse: response cannot be streamed: %w", err)
+	}
+	return s, nil
+}
+
+// Send writes an event; every line of data becomes a data field, which the browser joins again
+// with newlines
+func (s *Stream) Send(event, data string) error {
+	var b strings.Builder
+	if event != "" {
+		fmt.Fprintf(&b, "event: %s\n", event)
+	}
+	for _, line := range strings.Split(data, "\n") {
+		fmt.Fprintf(&b, "data: %s\n", strings.TrimSuffix(line, "\r"))
+	}
+	b.WriteString("\n")
+	return s.write(b.String())
+}
+
+// Heartbeat writes a comment, which browsers ignore; it keeps proxies from closing an idle stream
+// and finds clients that went away
+func (s *Stream) Heartbeat() error {
+	return s.write(": heartbeat\n\n")
+}
+
+func (s *Stream) write(message string) error {
+	if _, err := s.w.Write([]byte(message)); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
@@ -0,0 +1,47 @@
+package tabular
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+)
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) *csvWriter {
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+func (c *csvWriter) WriteRow(cells []string) error {
+	escaped := make([]string, len(cells))
+	for i, cell := range cells {
+		escaped[i] = escapeFormula(cell)
+	}
+	// csv.Writer buffers internally and flushes as its buffer fills
+	return c.w.Write(escaped)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// escapeFormula prefixes cells that spreadsheets would evaluate as formulas (CSV injection).
+// Phone numbers like "+1 206..." are data, so a leading "+" followed by a digit is kept.
+func escapeFormula(cell string) string {
+	if cell == "" {
+		return cell
+	}
+	switch cell[0] {
+	case '=', '@', '\t', '\r':
+		return "'" + cell
+	case '+', '-':
+		if len(cell) > 1 && strings.ContainsRune("0123456789( ", rune(cell[1])) {
+			return cell
+		}
+		return "'" + cell
+	}
+	return cell
+}
@@ -0,0 +1,54 @@
+// Package tenancy scopes patient data to the organization (clinic) of the caller.
+//
+// The organization travels in the request context. Repositories filter reads and stamp writes
+// with it; a context without an organization is unscoped, which is how tenancy is turned off and
+// how background jobs work across organizations.
+package tenancy
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Field is the document field holding the organization
+const Field = "org_id"
+
+// DefaultOrgID is the organization of the seeded in-memory data and the development users
+const DefaultOrgID = "clinic-main"
+
+type contextKey struct{}
+
+// WithOrg scopes the context to the organization
+func WithOrg(ctx context.Context, orgID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, orgID)
+}
+
+// OrgID returns the organization the context is scoped to; ok is false for unscoped contexts
+func OrgID(ctx context.Context) (string, bool) {
+	orgID, ok := ctx.Value(contextKey{}).(string)
+	return orgID, ok && orgID != ""
+}
+
+// Visible reports whether a document of the organization may be seen in the context
+func Visible(ctx context.Context, orgID string) bool {
+	scoped, ok := OrgID(ctx)
+	return !ok || scoped == orgID
+}
+
+// Assign returns the organization a document written in the context belongs to: the scoped
+// organization, or current when the context is unscoped
+func Assign(ctx context.Context, current string) string {
+	if orgID, ok := OrgID(ctx); ok {
+		return orgID
+	}
+	return current
+}
+
+// Filter adds the scoped organization to a MongoDB filter; filter is modified and returned
+func Filter(ctx context.Context, filter bson.M) bson.M {
+	if orgID, ok := OrgID(ctx); ok {
+		filter[Field] = orgID
+	}
+	return filter
+}
@@ -0,0 +1,324 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/platform/httpclient"
+	"pharmacy-modernization-project-model/internal/platform/schemas"
+)
+
+// maxResponseLog caps how much of an endpoint's response body is kept on an attempt
+const maxResponseLog = 1024
+
+// DispatcherConfig controls delivery timing and retries
+type DispatcherConfig struct {
+	// PollInterval is how often the dispatcher looks for due deliveries when it is not woken by Publish
+	PollInterval time.Duration
+	// Timeout bounds each delivery attempt
+	Timeout time.Duration
+	// MaxAttempts is how many attempts are made before a delivery is marked failed
+	MaxAttempts int
+	// BaseBackoff is the delay after the first failed attempt; it doubles after each further failure
+	BaseBackoff time.Duration
+	// MaxBackoff caps the delay between attempts
+	MaxBackoff time.Duration
+	// BatchSize limits how many due deliveries are sent per tick
+	BatchSize int
+}
+
+func (c *DispatcherConfig) setDefaults() {
+	if c.PollInterval <= 0 {
+		c.PollInterval = 2 * time.Second
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = 10 * time.Second
+	}
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = 8
+	}
+	if c.BaseBackoff <= 0 {
+		c.BaseBackoff = 10 * time.Second
+	}
+	if c.MaxBackoff < c.BaseBackoff {
+		c.MaxBackoff = c.BaseBackoff
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = 50
+	}
+}
+
+// Dispatcher records a delivery per subscribed endpoint when an event is published and sends
+// due deliveries in the background
+type Dispatcher struct {
+	store  Store
+	client *httpclient.Client
+	cfg    DispatcherConfig
+	log    *zap.Logger
+	wake   chan struct{}
+	now    func() time.Time
+
+	schemas *schemas.Registry
+	enforce bool
+
+	// keepSucceeded decides whether a successful delivery stays in the delivery log
+	keepSucceeded func() bool
+}
+
+// NewDispatcher creates a dispatcher; zero config values fall back to defaults
+func NewDispatcher(store Store, client *httpclient.Client, cfg DispatcherConfig, log *zap.Logger) *Dispatcher {
+	cfg.setDefaults()
+	if log == nil {
+		log = zap.NewNop()
+	}
+	return &Dispatcher{
+		store:  store,
+		client: client,
+		cfg:    cfg,
+		log:    log,
+		wake:   make(chan struct{}, 1),
+		now:    time.Now,
+	}
+}
+
+// Publish queues the event for every active endpoint subscribed to eventType. Failures are
+// logged, not returned: a webhook must never fail the change that raised the event.
+func (d *Dispatcher) Publish(ctx context.Context, eventType string, data any) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		d.log.Error("Failed to encode webhook event", zap.String("event_type", eventType), zap.Error(err))
+		return
+	}
+	if !d.validate(eventType, body) {
+		return
+	}
+
+	endpoints, err := d.store.ListEndpointsForEvent(ctx, eventType)
+	if err != nil {
+		d.log.Error("Failed to list webhooks for event", zap.String("event_type", eventType), zap.Error(err))
+		return
+	}
+	if len(endpoints) == 0 {
+		return
+	}
+
+	now := d.now()
+	event := Event{ID: uuid.NewString(), Type: eventType, CreatedAt: now, Data: json.RawMessage(body)}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		d.log.Error("Failed to encode webhook event", zap.String("event_type", eventType), zap.Error(err))
+		return
+	}
+
+	for _, endpoint := range endpoints {
+		_, err := d.store.CreateDelivery(ctx, Delivery{
+			WebhookID:     endpoint.ID,
+			EventID:       event.ID,
+			EventType:     eventType,
+			Payload:       payload,
+			Status:        DeliveryPending,
+			Attempts:      []Attempt{},
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		})
+		if err != nil {
+			d.log.Error("Failed to queue webhook delivery",
+				zap.String("webhook_id", endpoint.ID),
+				zap.String("event_type", eventType),
+				zap.Error(err))
+		}
+	}
+
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+// ValidateWith checks every published event against its contract. Violations are logged and
+// counted; with enforce the event is dropped instead of delivered.
+func (d *Dispatcher) ValidateWith(registry *schemas.Registry, enforce bool) {
+	d.schemas = registry
+	d.enforce = enforce
+}
+
+// validate reports whether the event may be published
+func (d *Dispatcher) validate(eventType string, body []byte) bool {
+	if d.schemas == nil {
+		return true
+	}
+	err := d.schemas.Validate(schemas.Published, eventType, body)
+	if err == nil {
+		return true
+	}
+	d.log.Warn("Webhook event breaks its contract",
+		zap.String("event_type", eventType),
+		zap.Bool("dropped", d.enforce),
+		zap.Error(err))
+	return !d.enforce
+}
+
+// SampleSucceeded keeps a successful delivery in the delivery log only when keep returns true.
+// Failed deliveries are always kept.
+func (d *Dispatcher) SampleSucceeded(keep func() bool) {
+	d.keepSucceeded = keep
+}
+
+// Run sends due deliveries until ctx is cancelled
+func (d *Dispatcher) Run(ctx context.Context) {
+	d.log.Info("Webhook dispatcher started",
+		zap.Duration("poll_interval", d.cfg.PollInterval),
+		zap.Int("max_attempts", d.cfg.MaxAttempts))
+
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		d.DeliverDue(ctx)
+		select {
+		case <-ctx.Done():
+			d.log.Info("Webhook dispatcher stopped")
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+	}
+}
+
+// DeliverDue makes one attempt at every pending delivery whose next attempt is due
+func (d *Dispatcher) DeliverDue(ctx context.Context) {
+	now := d.now()
+	deliveries, err := d.store.ListDue(ctx, now, d.cfg.BatchSize)
+	if err != nil {
+		d.log.Error("Failed to list due webhook deliveries", zap.Error(err))
+		return
+	}
+
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return
+		}
+		// The lease keeps other instances off the delivery while this attempt is in flight
+		claimed, err := d.store.Claim(ctx, delivery.ID, now, now.Add(2*d.cfg.Timeout))
+		if err != nil {
+			d.log.Error("Failed to claim webhook delivery", zap.String("delivery_id", delivery.ID), zap.Error(err))
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		d.deliver(ctx, delivery)
+	}
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, delivery Delivery) {
+	log := d.log.With(
+		zap.String("delivery_id", delivery.ID),
+		zap.String("webhook_id", delivery.WebhookID),
+		zap.String("event_type", delivery.EventType))
+
+	endpoint, err := d.store.GetEndpoint(ctx, delivery.WebhookID)
+	switch {
+	case err != nil:
+		d.finish(ctx, log, delivery, DeliveryFailed, "webhook was deleted")
+		return
+	case !endpoint.Active:
+		d.finish(ctx, log, delivery, DeliveryFailed, "webhook was deactivated")
+		return
+	}
+
+	attempt := d.send(ctx, endpoint, delivery)
+	delivery.Attempts = append(delivery.Attempts, attempt)
+
+	switch {
+	case attempt.Error == "":
+		d.finish(ctx, log, delivery, DeliverySucceeded, "")
+	case len(delivery.Attempts) >= d.cfg.MaxAttempts:
+		log.Warn("Webhook delivery failed, giving up", zap.Int("attempts", len(delivery.Attempts)), zap.String("error", attempt.Error))
+		d.finish(ctx, log, delivery, DeliveryFailed, "")
+	default:
+		delivery.NextAttemptAt = d.now().Add(d.backoff(len(delivery.Attempts)))
+		log.Info("Webhook delivery failed, will retry",
+			zap.Int("attempts", len(delivery.Attempts)),
+			zap.Time("next_attempt_at", delivery.NextAttemptAt),
+			zap.String("error", attempt.Error))
+		if err := d.store.UpdateDelivery(ctx, delivery); err != nil {
+			log.Error("Failed to save webhook delivery", zap.Error(err))
+		}
+	}
+}
+
+// send posts the payload once; any non-2xx response counts as a failed attempt
+func (d *Dispatcher) send(ctx context.Context, endpoint Endpoint, delivery Delivery) Attempt {
+	at := d.now()
+	attempt := Attempt{Number: len(delivery.Attempts) + 1, At: at}
+
+	timestamp := at.Unix()
+	headers := map[string]string{
+		"Content-Type":  "application/json",
+		HeaderEvent:     delivery.EventType,
+		HeaderDelivery:  delivery.ID,
+		HeaderTimestamp: strconv.FormatInt(timestamp, 10),
+		HeaderSignature: Sign(endpoint.Secret, timestamp, delivery.Payload),
+	}
+
+	resp, err := d.client.Post(ctx, endpoint.URL, bytes.NewReader(delivery.Payload), headers,
+		httpclient.WithEndpoint(delivery.EventType),
+		httpclient.WithTimeout(d.cfg.Timeout))
+	attempt.DurationMs = d.now().Sub(at).Milliseconds()
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+
+	attempt.StatusCode = resp.StatusCode
+	attempt.Response = truncate(string(resp.Body), maxResponseLog)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		attempt.Error = fmt.Sprintf("endpoint responded with status %d", resp.StatusCode)
+	}
+	return attempt
+}
+
+// finish records the final status of a delivery; a reason is recorded as a last attempt that was never
+// sent. Successful deliveries left out by sampling are removed from the log instead.
+func (d *Dispatcher) finish(ctx context.Context, log *zap.Logger, delivery Delivery, status DeliveryStatus, reason string) {
+	now := d.now()
+	if status == DeliverySucceeded && d.keepSucceeded != nil && !d.keepSucceeded() {
+		if err := d.store.DeleteDelivery(ctx, delivery.ID); err != nil {
+			log.Error("Failed to drop sampled webhook delivery", zap.Error(err))
+		}
+		return
+	}
+	delivery.Status = status
+	delivery.CompletedAt = &now
+	if reason != "" {
+		delivery.Attempts = append(delivery.Attempts, Attempt{Number: len(delivery.Attempts) + 1, At: now, Error: reason})
+		log.Info("Webhook delivery dropped", zap.String("reason", reason))
+	}
+	if err := d.store.UpdateDelivery(ctx, delivery); err != nil {
+		log.Error("Failed to save webhook delivery", zap.Error(err))
+	}
+}
+
+// backoff returns min(base*2^(attempts-1), max)
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.cfg.BaseBackoff
+	for i := 1; i < attempts && delay < d.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, d.cfg.MaxBackoff)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
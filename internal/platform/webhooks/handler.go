@@ -0,0 +1,248 @@
+package webhooks
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"pharmacy-modernization-project-model/internal/bind"
+	helper "pharmacy-modernization-project-model/internal/helper"
+	"pharmacy-modernization-project-model/internal/platform/auth"
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+	"pharmacy-modernization-project-model/internal/platform/httpx"
+	"pharmacy-modernization-project-model/internal/platform/paths"
+	"pharmacy-modernization-project-model/internal/platform/permissions"
+)
+
+// ManageAccess - webhook managers or admins can register endpoints and read their delivery logs
+var ManageAccess = []string{permissions.WebhooksManage, permissions.AdminAll}
+
+// Handler serves the webhook registration API
+type Handler struct {
+	store Store
+	log   *zap.Logger
+}
+
+func NewHandler(store Store, log *zap.Logger) *Handler {
+	return &Handler{store: store, log: log}
+}
+
+// RegisterRoutes mounts the webhook API under /api/v1/webhooks
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route(paths.WebhooksAPIPath, func(router chi.Router) {
+		router.Use(auth.RequireAuthFromHeader())
+		router.Use(auth.RequirePermissionsMatchAny(ManageAccess))
+
+		router.Get("/", h.List)
+		router.Post("/", h.Create)
+		router.Get("/{webhookID}", h.GetByID)
+		router.Patch("/{webhookID}", h.Update)
+		router.Delete("/{webhookID}", h.Delete)
+		router.Get("/{webhookID}/deliveries", h.Deliveries)
+	})
+}
+
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	endpoints, err := h.store.ListEndpoints(r.Context())
+	if err != nil {
+		h.log.Error("list webhooks", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, endpoints)
+}
+
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	req, fieldErrors, err := bind.JSON[CreateRequest](r)
+	if err != nil {
+		h.log.Warn("invalid webhook payload", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+	if err := validateEventTypes(req.EventTypes); err != nil {
+		httpx.WriteError(w, r, err)
+		return
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		if secret, err = generateSecret(); err != nil {
+			h.log.Error("generate webhook secret", zap.Error(err))
+			httpx.WriteError(w, r, err)
+			return
+		}
+	}
+
+	now := time.Now()
+	endpoint, err := h.store.CreateEndpoint(r.Context(), Endpoint{
+		URL:         req.URL,
+		Secret:      secret,
+		EventTypes:  req.EventTypes,
+		Active:      true,
+		Description: req.Description,
+		CreatedBy:   actor(r),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	})
+	if err != nil {
+		h.log.Error("create webhook", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+
+	h.log.Info("Webhook registered",
+		zap.String("webhook_id", endpoint.ID),
+		zap.Strings("event_types", endpoint.EventTypes))
+	helper.WriteCreated(w, CreateResponse{Endpoint: endpoint, Secret: secret})
+}
+
+func (h *Handler) GetByID(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[PathVars](r, chi.URLParam)
+	if err != nil {
+		h.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	endpoint, err := h.store.GetEndpoint(r.Context(), pathVars.WebhookID)
+	if err != nil {
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, endpoint)
+}
+
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[PathVars](r, chi.URLParam)
+	if err != nil {
+		h.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	req, fieldErrors, err := bind.JSON[UpdateRequest](r)
+	if err != nil {
+		h.log.Warn("invalid webhook payload", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	endpoint, err := h.store.GetEndpoint(r.Context(), pathVars.WebhookID)
+	if err != nil {
+		httpx.WriteError(w, r, err)
+		return
+	}
+
+	if req.URL != nil {
+		endpoint.URL = *req.URL
+	}
+	if req.EventTypes != nil {
+		if err := validateEventTypes(*req.EventTypes); err != nil {
+			httpx.WriteError(w, r, err)
+			return
+		}
+		endpoint.EventTypes = *req.EventTypes
+	}
+	if req.Active != nil {
+		endpoint.Active = *req.Active
+	}
+	if req.Description != nil {
+		endpoint.Description = *req.Description
+	}
+	endpoint.UpdatedAt = time.Now()
+
+	endpoint, err = h.store.UpdateEndpoint(r.Context(), endpoint)
+	if err != nil {
+		h.log.Error("update webhook", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, endpoint)
+}
+
+// Delete removes the endpoint; its pending deliveries are marked failed and its log is kept
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[PathVars](r, chi.URLParam)
+	if err != nil {
+		h.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	if err := h.store.DeleteEndpoint(r.Context(), pathVars.WebhookID); err != nil {
+		httpx.WriteError(w, r, err)
+		return
+	}
+
+	h.log.Info("Webhook deleted", zap.String("webhook_id", pathVars.WebhookID))
+	helper.WriteNoContent(w)
+}
+
+// Deliveries returns the endpoint's delivery log, newest first, with every attempt made
+func (h *Handler) Deliveries(w http.ResponseWriter, r *http.Request) {
+	pathVars, fieldErrors, err := bind.ChiPath[PathVars](r, chi.URLParam)
+	if err != nil {
+		h.log.Error("failed to bind path parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+
+	query, fieldErrors, err := bind.Query[DeliveryListQuery](r)
+	if err != nil {
+		h.log.Error("failed to bind query parameters", zap.Error(err))
+		helper.Respond400(w, fieldErrors)
+		return
+	}
+	if query.Limit == 0 {
+		query.Limit = 50
+	}
+
+	if _, err := h.store.GetEndpoint(r.Context(), pathVars.WebhookID); err != nil {
+		httpx.WriteError(w, r, err)
+		return
+	}
+
+	deliveries, err := h.store.ListDeliveries(r.Context(), pathVars.WebhookID, DeliveryStatus(query.Status), query.Limit)
+	if err != nil {
+		h.log.Error("list webhook deliveries", zap.Error(err))
+		httpx.WriteError(w, r, err)
+		return
+	}
+	helper.WriteOK(w, deliveries)
+}
+
+func validateEventTypes(eventTypes []string) error {
+	for _, eventType := range eventTypes {
+		if !ValidEventType(eventType) {
+			return platformErrors.NewValidationError("event_types", eventType,
+				fmt.Sprintf("unknown event type, expected one of %v", EventTypes))
+		}
+	}
+	return nil
+}
+
+// generateSecret returns a random signing secret for endpoints registered without one
+func generateSecret() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(buf), nil
+}
+
+// actor identifies the authenticated user registering an endpoint
+func actor(r *http.Request) string {
+	user, err := auth.GetCurrentUser(r.Context())
+	if err != nil {
+		return ""
+	}
+	if user.Email != "" {
+		return user.Email
+	}
+	return user.ID
+}
@@ -0,0 +1,181 @@
+package webhooks
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// MemoryStore keeps endpoints and deliveries in process memory; used when MongoDB is not configured
+type MemoryStore struct {
+	mu         sync.RWMutex
+	endpoints  map[string]Endpoint
+	deliveries map[string]Delivery
+}
+
+// NewMemoryStore creates an empty in-memory webhook store
+func NewMemoryStore() Store {
+	return &MemoryStore{
+		endpoints:  map[string]Endpoint{},
+		deliveries: map[string]Delivery{},
+	}
+}
+
+func (s *MemoryStore) CreateEndpoint(_ context.Context, endpoint Endpoint) (Endpoint, error) {
+	if endpoint.ID == "" {
+		endpoint.ID = uuid.NewString()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.endpoints[endpoint.ID] = cloneEndpoint(endpoint)
+	return endpoint, nil
+}
+
+func (s *MemoryStore) GetEndpoint(_ context.Context, id string) (Endpoint, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	endpoint, ok := s.endpoints[id]
+	if !ok {
+		return Endpoint{}, platformErrors.NewRecordNotFoundError("webhook", id)
+	}
+	return cloneEndpoint(endpoint), nil
+}
+
+func (s *MemoryStore) ListEndpoints(_ context.Context) ([]Endpoint, error) {
+	return s.listEndpoints(func(Endpoint) bool { return true }), nil
+}
+
+func (s *MemoryStore) ListEndpointsForEvent(_ context.Context, eventType string) ([]Endpoint, error) {
+	return s.listEndpoints(func(e Endpoint) bool { return e.Subscribes(eventType) }), nil
+}
+
+func (s *MemoryStore) listEndpoints(match func(Endpoint) bool) []Endpoint {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	out := []Endpoint{}
+	for _, endpoint := range s.endpoints {
+		if match(endpoint) {
+			out = append(out, cloneEndpoint(endpoint))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	return out
+}
+
+func (s *MemoryStore) UpdateEndpoint(_ context.Context, endpoint Endpoint) (Endpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.endpoints[endpoint.ID]; !ok {
+		return Endpoint{}, platformErrors.NewRecordNotFoundError("webhook", endpoint.ID)
+	}
+	s.endpoints[endpoint.ID] = cloneEndpoint(endpoint)
+	return endpoint, nil
+}
+
+func (s *MemoryStore) DeleteEndpoint(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.endpoints[id]; !ok {
+		return platformErrors.NewRecordNotFoundError("webhook", id)
+	}
+	delete(s.endpoints, id)
+	return nil
+}
+
+func (s *MemoryStore) CreateDelivery(_ context.Context, delivery Delivery) (Delivery, error) {
+	if delivery.ID == "" {
+		delivery.ID = uuid.NewString()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deliveries[delivery.ID] = cloneDelivery(delivery)
+	return delivery, nil
+}
+
+func (s *MemoryStore) UpdateDelivery(_ context.Context, delivery Delivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.deliveries[delivery.ID]; !ok {
+		return platformErrors.NewRecordNotFoundError("webhook delivery", delivery.ID)
+	}
+	s.deliveries[delivery.ID] = cloneDelivery(delivery)
+	return nil
+}
+
+func (s *MemoryStore) DeleteDelivery(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.deliveries[id]; !ok {
+		return platformErrors.NewRecordNotFoundError("webhook delivery", id)
+	}
+	delete(s.deliveries, id)
+	return nil
+}
+
+func (s *MemoryStore) ListDeliveries(_ context.Context, webhookID string, status DeliveryStatus, limit int) ([]Delivery, error) {
+	out := s.listDeliveries(func(d Delivery) bool {
+		return d.WebhookID == webhookID && (status == "" || d.Status == status)
+	})
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.After(out[j].CreatedAt) })
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (s *MemoryStore) ListDue(_ context.Context, now time.Time, limit int) ([]Delivery, error) {
+	out := s.listDeliveries(func(d Delivery) bool {
+		return d.Status == DeliveryPending && !d.NextAttemptAt.After(now)
+	})
+	sort.Slice(out, func(i, j int) bool { return out[i].NextAttemptAt.Before(out[j].NextAttemptAt) })
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (s *MemoryStore) listDeliveries(match func(Delivery) bool) []Delivery {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	out := []Delivery{}
+	for _, delivery := range s.deliveries {
+		if match(delivery) {
+			out = append(out, cloneDelivery(delivery))
+		}
+	}
+	return out
+}
+
+func (s *MemoryStore) Claim(_ context.Context, id string, now, leaseUntil time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delivery, ok := s.deliveries[id]
+	if !ok || delivery.Status != DeliveryPending || delivery.NextAttemptAt.After(now) {
+		return false, nil
+	}
+	delivery.NextAttemptAt = leaseUntil
+	s.deliveries[id] = delivery
+	return true, nil
+}
+
+// cloneEndpoint copies the event type slice so callers cannot change stored endpoints
+func cloneEndpoint(endpoint Endpoint) Endpoint {
+	endpoint.EventTypes = slices.Clone(endpoint.EventTypes)
+	return endpoint
+}
+
+// cloneDelivery copies the attempt log so callers cannot change stored deliveries
+func cloneDelivery(delivery Delivery) Delivery {
+	delivery.Attempts = slices.Clone(delivery.Attempts)
+	return delivery
+}
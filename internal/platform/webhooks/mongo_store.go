@@ -0,0 +1,204 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	platformErrors "pharmacy-modernization-project-model/internal/platform/errors"
+)
+
+// MongoStore persists endpoints and deliveries in two MongoDB collections
+type MongoStore struct {
+	endpoints  *mongo.Collection
+	deliveries *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewMongoStore creates a MongoDB-backed webhook store
+func NewMongoStore(endpoints, deliveries *mongo.Collection, logger *zap.Logger) *MongoStore {
+	return &MongoStore{endpoints: endpoints, deliveries: deliveries, logger: logger}
+}
+
+func (s *MongoStore) CreateEndpoint(ctx context.Context, endpoint Endpoint) (Endpoint, error) {
+	if endpoint.ID == "" {
+		endpoint.ID = uuid.NewString()
+	}
+
+	if _, err := s.endpoints.InsertOne(ctx, endpoint); err != nil {
+		s.logger.Error("Failed to create webhook", zap.String("webhook_id", endpoint.ID), zap.Error(err))
+		return Endpoint{}, platformErrors.HandleMongoError("CreateEndpoint", err)
+	}
+	return endpoint, nil
+}
+
+func (s *MongoStore) GetEndpoint(ctx context.Context, id string) (Endpoint, error) {
+	var endpoint Endpoint
+	if err := s.endpoints.FindOne(ctx, bson.M{"_id": id}).Decode(&endpoint); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return Endpoint{}, platformErrors.NewRecordNotFoundError("webhook", id)
+		}
+		return Endpoint{}, platformErrors.HandleMongoError("GetEndpoint", err)
+	}
+	return endpoint, nil
+}
+
+func (s *MongoStore) ListEndpoints(ctx context.Context) ([]Endpoint, error) {
+	return s.findEndpoints(ctx, "ListEndpoints", bson.M{})
+}
+
+func (s *MongoStore) ListEndpointsForEvent(ctx context.Context, eventType string) ([]Endpoint, error) {
+	return s.findEndpoints(ctx, "ListEndpointsForEvent", bson.M{"active": true, "event_types": eventType})
+}
+
+func (s *MongoStore) findEndpoints(ctx context.Context, op string, filter bson.M) ([]Endpoint, error) {
+	cursor, err := s.endpoints.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+	if err != nil {
+		return nil, platformErrors.HandleMongoError(op, err)
+	}
+	defer cursor.Close(ctx)
+
+	endpoints := []Endpoint{}
+	if err := cursor.All(ctx, &endpoints); err != nil {
+		return nil, platformErrors.HandleMongoError(op, err)
+	}
+	return endpoints, nil
+}
+
+func (s *MongoStore) UpdateEndpoint(ctx context.Context, endpoint Endpoint) (Endpoint, error) {
+	result, err := s.endpoints.ReplaceOne(ctx, bson.M{"_id": endpoint.ID}, endpoint)
+	if err != nil {
+		s.logger.Error("Failed to update webhook", zap.String("webhook_id", endpoint.ID), zap.Error(err))
+		return Endpoint{}, platformErrors.HandleMongoError("UpdateEndpoint", err)
+	}
+	if result.MatchedCount == 0 {
+		return Endpoint{}, platformErrors.NewRecordNotFoundError("webhook", endpoint.ID)
+	}
+	return endpoint, nil
+}
+
+func (s *MongoStore) DeleteEndpoint(ctx context.Context, id string) error {
+	result, err := s.endpoints.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return platformErrors.HandleMongoError("DeleteEndpoint", err)
+	}
+	if result.DeletedCount == 0 {
+		return platformErrors.NewRecordNotFoundError("webhook", id)
+	}
+	return nil
+}
+
+func (s *MongoStore) CreateDelivery(ctx context.Context, delivery Delivery) (Delivery, error) {
+	if delivery.ID == "" {
+		delivery.ID = uuid.NewString()
+	}
+
+	if _, err := s.deliveries.InsertOne(ctx, delivery); err != nil {
+		s.logger.Error("Failed to create webhook delivery",
+			zap.String("webhook_id", delivery.WebhookID),
+			zap.String("event_type", delivery.EventType),
+			zap.Error(err))
+		return Delivery{}, platformErrors.HandleMongoError("CreateDelivery", err)
+	}
+	return delivery, nil
+}
+
+func (s *MongoStore) UpdateDelivery(ctx context.Context, delivery Delivery) error {
+	result, err := s.deliveries.ReplaceOne(ctx, bson.M{"_id": delivery.ID}, delivery)
+	if err != nil {
+		return platformErrors.HandleMongoError("UpdateDelivery", err)
+	}
+	if result.MatchedCount == 0 {
+		return platformErrors.NewRecordNotFoundError("webhook delivery", delivery.ID)
+	}
+	return nil
+}
+
+func (s *MongoStore) DeleteDelivery(ctx context.Context, id string) error {
+	result, err := s.deliveries.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return platformErrors.HandleMongoError("DeleteDelivery", err)
+	}
+	if result.DeletedCount == 0 {
+		return platformErrors.NewRecordNotFoundError("webhook delivery", id)
+	}
+	return nil
+}
+
+func (s *MongoStore) ListDeliveries(ctx context.Context, webhookID string, status DeliveryStatus, limit int) ([]Delivery, error) {
+	filter := bson.M{"webhook_id": webhookID}
+	if status != "" {
+		filter["status"] = status
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	return s.findDeliveries(ctx, "ListDeliveries", filter, opts)
+}
+
+func (s *MongoStore) ListDue(ctx context.Context, now time.Time, limit int) ([]Delivery, error) {
+	filter := bson.M{"status": DeliveryPending, "next_attempt_at": bson.M{"$lte": now}}
+	opts := options.Find().SetSort(bson.D{{Key: "next_attempt_at", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	return s.findDeliveries(ctx, "ListDue", filter, opts)
+}
+
+func (s *MongoStore) findDeliveries(ctx context.Context, op string, filter bson.M, opts *options.FindOptions) ([]Delivery, error) {
+	cursor, err := s.deliveries.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, platformErrors.HandleMongoError(op, err)
+	}
+	defer cursor.Close(ctx)
+
+	deliveries := []Delivery{}
+	if err := cursor.All(ctx, &deliveries); err != nil {
+		return nil, platformErrors.HandleMongoError(op, err)
+	}
+	return deliveries, nil
+}
+
+func (s *MongoStore) Claim(ctx context.Context, id string, now, leaseUntil time.Time) (bool, error) {
+	result, err := s.deliveries.UpdateOne(ctx,
+		bson.M{"_id": id, "status": DeliveryPending, "next_attempt_at": bson.M{"$lte": now}},
+		bson.M{"$set": bson.M{"next_attempt_at": leaseUntil}})
+	if err != nil {
+		return false, platformErrors.HandleMongoError("Claim", err)
+	}
+	return result.ModifiedCount == 1, nil
+}
+
+// CreateIndexes creates the indexes used to find due deliveries, list an endpoint's
+// deliveries and match endpoints to events
+func (s *MongoStore) CreateIndexes(ctx context.Context) error {
+	_, err := s.deliveries.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "next_attempt_at", Value: 1}},
+			Options: options.Index().SetName("status_1_next_attempt_at_1"),
+		},
+		{
+			Keys:    bson.D{{Key: "webhook_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("webhook_id_1_created_at_-1"),
+		},
+	})
+	if err != nil {
+		return platformErrors.HandleMongoError("CreateIndexes", err)
+	}
+
+	_, err = s.endpoints.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "event_types", Value: 1}, {Key: "active", Value: 1}},
+		Options: options.Index().SetName("event_types_1_active_1"),
+	})
+	if err != nil {
+		return platformErrors.HandleMongoError("CreateIndexes", err)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package webhooks
+
+// CreateRequest registers a webhook endpoint; a secret is generated when none is given
+type CreateRequest struct {
+	URL         string   `json:"url" validate:"required,http_url,max=2048"`
+	Secret      string   `json:"secret" validate:"omitempty,min=16,max=256"`
+	EventTypes  []string `json:"event_types" validate:"required,min=1,max=10,dive,required"`
+	Description string   `json:"description" validate:"max=500"`
+}
+
+// UpdateRequest changes the fields that are set; the secret cannot be changed
+type UpdateRequest struct {
+	URL         *string   `json:"url" validate:"omitempty,http_url,max=2048"`
+	EventTypes  *[]string `json:"event_types" validate:"omitempty,min=1,max=10,dive,required"`
+	Active      *bool     `json:"active"`
+	Description *string   `json:"description" validate:"omitempty,max=500"`
+}
+
+// DeliveryListQuery filters an endpoint's delivery log
+type DeliveryListQuery struct {
+	Status string `form:"status" validate:"omitempty,oneof=pending succeeded failed"`
+	Limit  int    `form:"limit" validate:"omitempty,min=1,max=200"`
+}
+
+// PathVars represents path parameters for webhook endpoints
+type PathVars struct {
+	WebhookID string `path:"webhookID" validate:"required,min=1"`
+}
+
+// CreateResponse is the registered endpoint with its secret, which is not returned again
+type CreateResponse struct {
+	Endpoint
+	Secret string `json:"secret"`
+}
@@ -0,0 +1,132 @@
+// Package webhooks delivers domain events to endpoints registered by admins. Every delivery is
+// signed with the endpoint's secret, retried with exponential backoff and kept with its attempts
+// so failures can be inspected per webhook.
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"time"
+
+	"pharmacy-modernization-project-model/internal/platform/events"
+)
+
+// Event types that can be subscribed to; the patient and prescription ones are domain events
+const (
+	EventPatientUpdated            = events.TypePatientUpdated
+	EventPrescriptionStatusChanged = events.TypePrescriptionStatusChanged
+	EventInvoiceCreated            = "invoice.created"
+)
+
+// EventTypes lists every event type an endpoint can subscribe to
+var EventTypes = []string{EventPatientUpdated, EventPrescriptionStatusChanged, EventInvoiceCreated}
+
+// ValidEventType reports whether eventType can be subscribed to
+func ValidEventType(eventType string) bool {
+	return slices.Contains(EventTypes, eventType)
+}
+
+// Headers sent with every delivery
+const (
+	HeaderEvent     = "X-Rx-Event"
+	HeaderDelivery  = "X-Rx-Delivery"
+	HeaderTimestamp = "X-Rx-Timestamp"
+	HeaderSignature = "X-Rx-Signature"
+)
+
+// Endpoint is a registered webhook receiver
+type Endpoint struct {
+	ID          string    `json:"id" bson:"_id"`
+	URL         string    `json:"url" bson:"url"`
+	Secret      string    `json:"-" bson:"secret"` // Only returned once, when the endpoint is registered
+	EventTypes  []string  `json:"event_types" bson:"event_types"`
+	Active      bool      `json:"active" bson:"active"`
+	Description string    `json:"description,omitempty" bson:"description,omitempty"`
+	CreatedBy   string    `json:"created_by" bson:"created_by"`
+	CreatedAt   time.Time `json:"created_at" bson:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" bson:"updated_at"`
+}
+
+// Subscribes reports whether the endpoint should receive eventType
+func (e Endpoint) Subscribes(eventType string) bool {
+	return e.Active && slices.Contains(e.EventTypes, eventType)
+}
+
+// Event is the JSON body posted to an endpoint
+type Event struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+// DeliveryStatus tracks a delivery through its retries
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliverySucceeded DeliveryStatus = "succeeded"
+	DeliveryFailed    DeliveryStatus = "failed" // Gave up: attempts exhausted or endpoint removed
+)
+
+// Delivery is one event sent to one endpoint, with every attempt made
+type Delivery struct {
+	ID        string          `json:"id" bson:"_id"`
+	WebhookID string          `json:"webhook_id" bson:"webhook_id"`
+	EventID   string          `json:"event_id" bson:"event_id"`
+	EventType string          `json:"event_type" bson:"event_type"`
+	Payload   json.RawMessage `json:"payload" bson:"payload"` // Exact body that is signed and sent
+	Status    DeliveryStatus  `json:"status" bson:"status"`
+	Attempts  []Attempt       `json:"attempts" bson:"attempts"`
+	// NextAttemptAt is when a pending delivery is due; claiming a delivery moves it forward
+	NextAttemptAt time.Time  `json:"next_attempt_at" bson:"next_attempt_at"`
+	CreatedAt     time.Time  `json:"created_at" bson:"created_at"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty" bson:"completed_at,omitempty"`
+}
+
+// Attempt records one try at delivering an event
+type Attempt struct {
+	Number     int       `json:"number" bson:"number"`
+	At         time.Time `json:"at" bson:"at"`
+	StatusCode int       `json:"status_code,omitempty" bson:"status_code,omitempty"`
+	Error      string    `json:"error,omitempty" bson:"error,omitempty"`
+	DurationMs int64     `json:"duration_ms" bson:"duration_ms"`
+	Response   string    `json:"response,omitempty" bson:"response,omitempty"` // Start of the response body
+}
+
+// Store persists endpoints and their deliveries
+type Store interface {
+	CreateEndpoint(ctx context.Context, endpoint Endpoint) (Endpoint, error)
+	// GetEndpoint returns a RecordNotFoundError for unknown IDs
+	GetEndpoint(ctx context.Context, id string) (Endpoint, error)
+	ListEndpoints(ctx context.Context) ([]Endpoint, error)
+	// ListEndpointsForEvent returns the active endpoints subscribed to eventType
+	ListEndpointsForEvent(ctx context.Context, eventType string) ([]Endpoint, error)
+	UpdateEndpoint(ctx context.Context, endpoint Endpoint) (Endpoint, error)
+	DeleteEndpoint(ctx context.Context, id string) error
+
+	CreateDelivery(ctx context.Context, delivery Delivery) (Delivery, error)
+	UpdateDelivery(ctx context.Context, delivery Delivery) error
+	DeleteDelivery(ctx context.Context, id string) error
+	// ListDeliveries returns an endpoint's deliveries, newest first; an empty status matches all
+	ListDeliveries(ctx context.Context, webhookID string, status DeliveryStatus, limit int) ([]Delivery, error)
+	// ListDue returns pending deliveries whose next attempt is due at now
+	ListDue(ctx context.Context, now time.Time, limit int) ([]Delivery, error)
+	// Claim moves a due delivery's next attempt to leaseUntil; it reports false when another
+	// instance claimed it first
+	Claim(ctx context.Context, id string, now, leaseUntil time.Time) (bool, error)
+}
+
+// Sign returns the X-Rx-Signature value: the hex HMAC-SHA256 of "<timestamp>.<body>" keyed by the secret
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
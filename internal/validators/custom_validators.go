@@ -11,4 +11,6 @@ import (
 func RegisterCustomValidators(validate *validator.Validate) {
 	// Register DOB validator using validation logic
 	validate.RegisterValidation("dob", validation_logic.ValidateDOB)
+	// Register NPI validator for prescriber identifiers
+	validate.RegisterValidation("npi", validation_logic.ValidateNPI)
 }
@@ -5,9 +5,12 @@ import (
 	"time"
 
 	"github.com/go-playground/validator/v10"
+
+	"pharmacy-modernization-project-model/internal/platform/dates"
 )
 
-// ValidateDOB validates date of birth fields
+// ValidateDOB validates date of birth fields. Partial dates (YYYY or YYYY-MM) are accepted
+// for legacy records that only carry a birth year or month.
 func ValidateDOB(fl validator.FieldLevel) bool {
 	dobStr := fl.Field().String()
 
@@ -15,19 +18,29 @@ func ValidateDOB(fl validator.FieldLevel) bool {
 	if dobStr == "" {
 		return true
 	}
+	return ValidateDOBValue("dob", dobStr) == nil
+}
+
+// ValidateDOBValue validates a date of birth outside struct validation, with the same rules as
+// the dob tag
+func ValidateDOBValue(field, value string) error {
+	invalid := &FieldError{Field: field, Tag: "dob", Message: "must be a past date of birth (YYYY-MM-DD, YYYY-MM or YYYY)"}
 
 	// Parse the date
-	dob, err := time.Parse("2006-01-02", dobStr)
-	if err != nil {
-		return false
+	dob, err := dates.Parse(value)
+	if err != nil || dob.IsZero() {
+		return invalid
 	}
 
 	// Check if DOB is not in the future
-	if dob.After(time.Now()) {
-		return false
+	now := time.Now()
+	if dob.IsFuture(now) {
+		return invalid
 	}
 
 	// Check if patient is not too old (reasonable limit)
-	age := time.Now().Year() - dob.Year()
-	return age <= 150
+	if _, oldest := dob.AgeRange(now); oldest > 150 {
+		return invalid
+	}
+	return nil
 }
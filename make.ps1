@@ -53,6 +53,8 @@ function Show-Help {
     Write-Host "  watch-ts         - Watch TypeScript files for changes"
     Write-Host "  graphql-generate - Generate GraphQL code from schemas"
     Write-Host "  graphql-install  - Install gqlgen CLI tool"
+    Write-Host "  proto-generate   - Generate gRPC code from internal/grpc/rxpb/*.proto"
+    Write-Host "  client-generate  - Generate typed REST API clients from api/openapi.yaml"
     Write-Host "  podman-up        - Start MongoDB and Memcached containers"
     Write-Host "  podman-down      - Stop MongoDB and Memcached containers"
     Write-Host "  podman-logs      - Show container logs"
@@ -294,6 +296,30 @@ function Install-GraphQLGen {
     }
 }
 
+function Invoke-ProtoGenerate {
+    Write-Host "🔄 Generating gRPC code..." -ForegroundColor Yellow
+    $protoFiles = Get-ChildItem internal/grpc/rxpb/*.proto | ForEach-Object { $_.FullName }
+    protoc --proto_path=internal/grpc/rxpb `
+        --go_out=internal/grpc/rxpb --go_opt=paths=source_relative `
+        --go-grpc_out=internal/grpc/rxpb --go-grpc_opt=paths=source_relative `
+        $protoFiles
+    if ($LASTEXITCODE -eq 0) {
+        Write-Host "✅ gRPC code generated successfully!" -ForegroundColor Green
+    }
+}
+
+function Invoke-ClientGenerate {
+    Write-Host "🔄 Generating API clients..." -ForegroundColor Yellow
+    go run ./cmd/permdoc -out api/permissions.json
+    if ($LASTEXITCODE -ne 0) { return }
+    go run ./cmd/genclient -lang go -permissions api/permissions.json -out api/rxclient/client.gen.go
+    if ($LASTEXITCODE -ne 0) { return }
+    go run ./cmd/genclient -lang ts -permissions api/permissions.json -out api/ts/rxclient.ts
+    if ($LASTEXITCODE -eq 0) {
+        Write-Host "✅ API clients generated successfully!" -ForegroundColor Green
+    }
+}
+
 function Start-PodmanContainers {
     $podmanScript = Join-Path $PSScriptRoot "podman" "make.ps1"
     if (Test-Path $podmanScript) {
@@ -338,6 +364,8 @@ switch ($Command.ToLower()) {
     "watch-ts" { Watch-TypeScript }
     "graphql-generate" { Invoke-GraphQLGenerate }
     "graphql-install" { Install-GraphQLGen }
+    "proto-generate" { Invoke-ProtoGenerate }
+    "client-generate" { Invoke-ClientGenerate }
     "podman-up" { Start-PodmanContainers }
     "podman-down" { Stop-PodmanContainers }
     "podman-logs" { Show-PodmanLogs }
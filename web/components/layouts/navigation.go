@@ -0,0 +1,39 @@
+package layouts
+
+import (
+	commonsecurity "pharmacy-modernization-project-model/domain/common/security"
+	"pharmacy-modernization-project-model/internal/platform/i18n"
+	"pharmacy-modernization-project-model/internal/platform/navigation"
+	"pharmacy-modernization-project-model/internal/platform/paths"
+)
+
+// Navigation is the sidebar; links are shown to users with any of their permissions. Labels and
+// titles are message keys, translated when the sidebar is rendered.
+var Navigation = []navigation.Section{
+	{
+		Items: []navigation.Item{
+			{Label: i18n.Key("nav.dashboard"), Path: paths.DashboardPath, Permissions: commonsecurity.DashboardAccess},
+			{Label: i18n.Key("nav.patients"), Path: paths.PatientsPath, Permissions: commonsecurity.PatientReadAccess},
+			{Label: i18n.Key("nav.patient_search"), Path: paths.PatientSearchPath, Permissions: commonsecurity.PatientReadAccess, NoBoost: true},
+			{Label: i18n.Key("nav.prescriptions"), Path: paths.PrescriptionsPath, Permissions: commonsecurity.PrescriptionReadAccess},
+		},
+	},
+	{
+		Title: i18n.Key("nav.admin"),
+		Items: []navigation.Item{
+			{Label: i18n.Key("nav.billing_discrepancies"), Path: paths.AdminBillingDiscrepanciesPath, Permissions: commonsecurity.BillingWriteAccess},
+			{Label: i18n.Key("nav.cache"), Path: paths.AdminCachePath, Permissions: commonsecurity.AdminAccess},
+			{Label: i18n.Key("nav.integrations"), Path: paths.AdminIntegrationsPath, Permissions: commonsecurity.AdminAccess},
+			{Label: i18n.Key("nav.jobs"), Path: paths.AdminJobsPath, Permissions: commonsecurity.AdminAccess},
+			{Label: i18n.Key("nav.permissions"), Path: paths.AdminPermissionsPath, Permissions: commonsecurity.AdminAccess},
+		},
+	},
+	{
+		Title: i18n.Key("nav.developer"),
+		Items: []navigation.Item{
+			{Label: i18n.Key("nav.graphql_playground"), Path: paths.GraphQLPlayground, NoBoost: true, External: true},
+			{Label: i18n.Key("nav.mongo_express"), Path: "http://localhost:8081", NoBoost: true, External: true},
+			{Label: i18n.Key("nav.redis_commander"), Path: "http://localhost:8082", NoBoost: true, External: true},
+		},
+	},
+}